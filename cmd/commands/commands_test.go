@@ -147,6 +147,16 @@ func Test_Commands(t *testing.T) {
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "unrecognized processor type")
 	})
+
+	t.Run("replay", func(t *testing.T) {
+		cmd := NewReplayCommand()
+		assert.Equal(t, "replay", cmd.Use)
+		assert.Equal(t, "bool", cmd.Flag("sequential").Value.Type())
+		cmd.SetArgs([]string{})
+		err := cmd.Execute()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "either --dir or --s3-endpoint is required")
+	})
 }
 
 func generateEncodedVertexSpecs() string {
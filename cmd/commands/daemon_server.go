@@ -28,6 +28,7 @@ import (
 	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/daemon/server"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/watermark/wmb"
 )
 
 func NewDaemonServerCommand() *cobra.Command {
@@ -45,6 +46,7 @@ func NewDaemonServerCommand() *cobra.Command {
 			}
 			logger := logging.NewLogger().Named("daemon-server").With("pipeline", pl.Name)
 			ctx := logging.WithLogger(signals.SetupSignalHandler(), logger)
+			wmb.SetPrecision(pl.Spec.Watermark.GetTimeUnit())
 			server := server.NewDaemonServer(pl, v1alpha1.ISBSvcType(isbSvcType))
 			return server.Run(ctx)
 		},
@@ -39,6 +39,7 @@ func NewISBSvcCreateCommand() *cobra.Command {
 		buffers         []string
 		buckets         []string
 		sideInputsStore string
+		domains         map[string]string
 	)
 
 	command := &cobra.Command{
@@ -67,9 +68,14 @@ func NewISBSvcCreateCommand() *cobra.Command {
 			ctx := logging.WithLogger(context.Background(), logger)
 			switch v1alpha1.ISBSvcType(isbSvcType) {
 			case v1alpha1.ISBSvcTypeRedis:
-				isbsClient = isbsvc.NewISBRedisSvc(redisclient.NewInClusterRedisClient())
+				redisClient, err := redisclient.NewInClusterRedisClient()
+				if err != nil {
+					logger.Errorw("Failed to create redis client", zap.Error(err))
+					return err
+				}
+				isbsClient = isbsvc.NewISBRedisSvc(redisClient)
 			case v1alpha1.ISBSvcTypeJetStream:
-				isbsClient, err = isbsvc.NewISBJetStreamSvc(pipelineName)
+				isbsClient, err = isbsvc.NewISBJetStreamSvc(pipelineName, isbsvc.WithJetStreamDomains(domains))
 				if err != nil {
 					logger.Errorw("Failed to get a ISB Service client.", zap.Error(err))
 					return err
@@ -92,5 +98,6 @@ func NewISBSvcCreateCommand() *cobra.Command {
 	command.Flags().StringSliceVar(&buffers, "buffers", []string{}, "Buffers to create") // --buffers=a,b, --buffers=c
 	command.Flags().StringSliceVar(&buckets, "buckets", []string{}, "Buckets to create") // --buckets=xxa,xxb --buckets=xxc
 	command.Flags().StringVar(&sideInputsStore, "side-inputs-store", "", "Name of the side inputs store")
+	command.Flags().StringToStringVar(&domains, "jetstream-domains", map[string]string{}, "JetStream domains of the buffers and buckets not in the domain of the ISB Service") // --jetstream-domains=buffer=domain,bucket=domain
 	return command
 }
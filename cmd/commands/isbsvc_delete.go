@@ -36,6 +36,8 @@ func NewISBSvcDeleteCommand() *cobra.Command {
 		buffers         []string
 		buckets         []string
 		sideInputsStore string
+		sideInputs      []string
+		domains         map[string]string
 	)
 
 	command := &cobra.Command{
@@ -52,9 +54,14 @@ func NewISBSvcDeleteCommand() *cobra.Command {
 			ctx := logging.WithLogger(context.Background(), logger)
 			switch v1alpha1.ISBSvcType(isbSvcType) {
 			case v1alpha1.ISBSvcTypeRedis:
-				isbsClient = isbsvc.NewISBRedisSvc(redisclient.NewInClusterRedisClient())
+				redisClient, err := redisclient.NewInClusterRedisClient()
+				if err != nil {
+					logger.Errorw("Failed to create redis client", zap.Error(err))
+					return err
+				}
+				isbsClient = isbsvc.NewISBRedisSvc(redisClient)
 			case v1alpha1.ISBSvcTypeJetStream:
-				isbsClient, err = isbsvc.NewISBJetStreamSvc(pipelineName)
+				isbsClient, err = isbsvc.NewISBJetStreamSvc(pipelineName, isbsvc.WithJetStreamDomains(domains))
 				if err != nil {
 					logger.Errorw("Failed to get a ISB Service client.", zap.Error(err))
 					return err
@@ -63,6 +70,14 @@ func NewISBSvcDeleteCommand() *cobra.Command {
 				cmd.HelpFunc()(cmd, args)
 				return fmt.Errorf("unsupported isb service type %q", isbSvcType)
 			}
+			if len(sideInputs) > 0 {
+				// Only the values of the given side inputs are deleted, the side inputs store is kept
+				if err = isbsClient.DeleteSideInputs(ctx, sideInputsStore, sideInputs); err != nil {
+					logger.Errorw("Failed on side inputs deletion.", zap.Error(err))
+					return err
+				}
+				sideInputsStore = ""
+			}
 			if err = isbsClient.DeleteBuffersAndBuckets(ctx, buffers, buckets, sideInputsStore); err != nil {
 				logger.Errorw("Failed on buffers, buckets and side inputs store deletion.", zap.Error(err))
 				return err
@@ -75,5 +90,7 @@ func NewISBSvcDeleteCommand() *cobra.Command {
 	command.Flags().StringSliceVar(&buffers, "buffers", []string{}, "Buffers to delete") // --buffers=a,b, --buffers=c
 	command.Flags().StringSliceVar(&buckets, "buckets", []string{}, "Buckets to delete") // --buckets=xxa,xxb --buckets=xxc	return command
 	command.Flags().StringVar(&sideInputsStore, "side-inputs-store", "", "Name of the side inputs store")
+	command.Flags().StringSliceVar(&sideInputs, "side-inputs", []string{}, "Side inputs to delete from the side inputs store, instead of the whole store")                     // --side-inputs=a,b
+	command.Flags().StringToStringVar(&domains, "jetstream-domains", map[string]string{}, "JetStream domains of the buffers and buckets not in the domain of the ISB Service") // --jetstream-domains=buffer=domain,bucket=domain
 	return command
 }
@@ -23,12 +23,14 @@ import (
 
 	"github.com/spf13/cobra"
 	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
 
 	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/isbsvc"
 	redisclient "github.com/numaproj/numaflow/pkg/shared/clients/redis"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/shared/termination"
 	sharedutil "github.com/numaproj/numaflow/pkg/shared/util"
 )
 
@@ -39,28 +41,38 @@ func NewISBSvcValidateCommand() *cobra.Command {
 		buffers         []string
 		buckets         []string
 		sideInputsStore string
+		domains         map[string]string
 	)
 
 	command := &cobra.Command{
 		Use:   "isbsvc-validate",
 		Short: "Validate ISB Service buffers, buckets and side inputs store",
-		RunE: func(cmd *cobra.Command, args []string) error {
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			defer func() {
+				if err != nil {
+					_ = termination.WriteMessage(corev1.TerminationMessagePathDefault, err)
+				}
+			}()
 			pipelineName, existing := os.LookupEnv(v1alpha1.EnvPipelineName)
 			if !existing {
 				return fmt.Errorf("environment variable %q not existing", v1alpha1.EnvPipelineName)
 			}
 			logger := logging.NewLogger().Named("isbsvc-validate").With("pipeline", pipelineName)
 			var isbsClient isbsvc.ISBService
-			var err error
 			ctx := logging.WithLogger(context.Background(), logger)
 			switch v1alpha1.ISBSvcType(isbSvcType) {
 			case v1alpha1.ISBSvcTypeRedis:
-				isbsClient = isbsvc.NewISBRedisSvc(redisclient.NewInClusterRedisClient())
+				redisClient, err := redisclient.NewInClusterRedisClient()
+				if err != nil {
+					logger.Errorw("Failed to create redis client", zap.Error(err))
+					return termination.WithCause(v1alpha1.RestartCauseISBConnectFailed, err)
+				}
+				isbsClient = isbsvc.NewISBRedisSvc(redisClient)
 			case v1alpha1.ISBSvcTypeJetStream:
-				isbsClient, err = isbsvc.NewISBJetStreamSvc(pipelineName)
+				isbsClient, err = isbsvc.NewISBJetStreamSvc(pipelineName, isbsvc.WithJetStreamDomains(domains))
 				if err != nil {
 					logger.Errorw("Failed to get an ISB Service client.", zap.Error(err))
-					return err
+					return termination.WithCause(v1alpha1.RestartCauseISBConnectFailed, err)
 				}
 			default:
 				cmd.HelpFunc()(cmd, args)
@@ -85,5 +97,6 @@ func NewISBSvcValidateCommand() *cobra.Command {
 	command.Flags().StringSliceVar(&buffers, "buffers", []string{}, "Buffers to validate") // --buffers=a,b, --buffers=c
 	command.Flags().StringSliceVar(&buckets, "buckets", []string{}, "Buckets to validate") // --buckets=xxa,xxb --buckets=xxc
 	command.Flags().StringVar(&sideInputsStore, "side-inputs-store", "", "Name of the side inputs store")
+	command.Flags().StringToStringVar(&domains, "jetstream-domains", map[string]string{}, "JetStream domains of the buffers and buckets not in the domain of the ISB Service") // --jetstream-domains=buffer=domain,bucket=domain
 	return command
 }
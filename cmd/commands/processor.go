@@ -17,6 +17,7 @@ limitations under the License.
 package commands
 
 import (
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
@@ -24,13 +25,19 @@ import (
 	"strconv"
 
 	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/manager/signals"
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/packing"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/shared/termination"
+	"github.com/numaproj/numaflow/pkg/shared/tracing"
 	"github.com/numaproj/numaflow/pkg/sinks"
 	"github.com/numaproj/numaflow/pkg/sources"
 	"github.com/numaproj/numaflow/pkg/udf"
+	"github.com/numaproj/numaflow/pkg/watermark/wmb"
 )
 
 func NewProcessorCommand() *cobra.Command {
@@ -42,7 +49,13 @@ func NewProcessorCommand() *cobra.Command {
 	command := &cobra.Command{
 		Use:   "processor",
 		Short: "Start a processor",
-		RunE: func(cmd *cobra.Command, args []string) error {
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			defer func() {
+				if err != nil {
+					// Surface the categorized cause to the controller for the restart diagnosis of the vertex.
+					_ = termination.WriteMessage(corev1.TerminationMessagePathDefault, err)
+				}
+			}()
 			log := logging.NewLogger().Named(fmt.Sprintf("%s-processor", processorType))
 			encodedVertex, defined := os.LookupEnv(dfv1.EnvVertexObject)
 			if !defined {
@@ -75,8 +88,40 @@ func NewProcessorCommand() *cobra.Command {
 				Replica:  int32(replica),
 			}
 			ctx := logging.WithLogger(signals.SetupSignalHandler(), log)
+			wmb.SetPrecision(vertex.Spec.Watermark.GetTimeUnit())
+			if vertex.Spec.Tracing != nil {
+				shutdown, err := tracing.Init(ctx, vertexInstance)
+				if err != nil {
+					return fmt.Errorf("failed to initialize tracing, error: %w", err)
+				}
+				defer func() {
+					if err := shutdown(context.Background()); err != nil {
+						log.Warnw("Failed to shutdown the tracer provider", zap.Error(err))
+					}
+				}()
+			}
 			switch dfv1.VertexType(processorType) {
 			case dfv1.VertexTypeSource:
+				if len(vertex.Spec.PackedVertices) > 0 {
+					encodedPackedVertices, defined := os.LookupEnv(dfv1.EnvPackedVertexObjects)
+					if !defined {
+						return fmt.Errorf("required environment variable '%s' not defined", dfv1.EnvPackedVertexObjects)
+					}
+					packedVerticesBytes, err := base64.StdEncoding.DecodeString(encodedPackedVertices)
+					if err != nil {
+						return fmt.Errorf("failed to decode packed vertices string, error: %w", err)
+					}
+					var packedVertices []dfv1.Vertex
+					if err = json.Unmarshal(packedVerticesBytes, &packedVertices); err != nil {
+						return fmt.Errorf("failed to unmarshal packed vertex objects, error: %w", err)
+					}
+					p := &packing.Processor{
+						ISBSvcType:     dfv1.ISBSvcType(isbSvcType),
+						VertexInstance: vertexInstance,
+						PackedVertices: packedVertices,
+					}
+					return p.Start(ctx)
+				}
 				p := &sources.SourceProcessor{
 					ISBSvcType:     dfv1.ISBSvcType(isbSvcType),
 					VertexInstance: vertexInstance,
@@ -0,0 +1,110 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/spf13/cobra"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/sdkclient/mapper"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/shared/objectstore"
+	"github.com/numaproj/numaflow/pkg/udf/replay"
+	"github.com/numaproj/numaflow/pkg/udf/rpc"
+)
+
+func NewReplayCommand() *cobra.Command {
+	var (
+		dir        string
+		endpoint   string
+		bucket     string
+		region     string
+		prefix     string
+		runtimeDir string
+		udfAddr    string
+		sequential bool
+	)
+	command := &cobra.Command{
+		Use:   "replay",
+		Short: "Replay the input messages recorded from a vertex through a map UDF",
+		Long: `Replay the input messages recorded from a vertex through a map UDF, batch by batch in the order they were read.
+The recordings are read from a local directory with --dir, or from an S3 compatible object storage with --s3-endpoint
+and --s3-bucket, using the credentials in the environment variables AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY.
+The results are written to the standard output as lines of JSON.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			logger := logging.NewLogger().Named("replay")
+			ctx, stop := signal.NotifyContext(logging.WithLogger(context.Background(), logger), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			var store objectstore.Store
+			switch {
+			case dir != "":
+				store = objectstore.NewFileStore(dir)
+			case endpoint != "":
+				var err error
+				store, err = objectstore.NewS3Store(objectstore.S3Config{
+					Endpoint:        endpoint,
+					Bucket:          bucket,
+					Region:          region,
+					AccessKeyID:     os.Getenv("AWS_ACCESS_KEY_ID"),
+					SecretAccessKey: os.Getenv("AWS_SECRET_ACCESS_KEY"),
+				})
+				if err != nil {
+					return err
+				}
+			default:
+				return fmt.Errorf("either --dir or --s3-endpoint is required")
+			}
+
+			opts := []mapper.Option{mapper.WithRuntimeDir(runtimeDir)}
+			if udfAddr != "" {
+				opts = append(opts, mapper.WithRemoteEndpoint(udfAddr, nil))
+			}
+			client, err := mapper.New(opts...)
+			if err != nil {
+				return fmt.Errorf("failed to create the map client, %w", err)
+			}
+			mapHandler := rpc.NewUDSgRPCBasedMap(client)
+			defer func() { _ = mapHandler.CloseConn(context.Background()) }()
+			if err := mapHandler.WaitUntilReady(ctx); err != nil {
+				return err
+			}
+
+			summary, err := replay.NewReplayer(ctx, store, prefix, mapHandler, os.Stdout, replay.WithSequential(sequential)).Run(ctx)
+			if err != nil {
+				return err
+			}
+			logger.Infow("Replay finished", "recordings", summary.Recordings, "batches", summary.Batches, "messages", summary.Messages, "errors", summary.Errors)
+			return nil
+		},
+	}
+	command.Flags().StringVar(&dir, "dir", "", "Local directory of the recordings, e.g. downloaded from the object storage")
+	command.Flags().StringVar(&endpoint, "s3-endpoint", "", "Endpoint of the S3 compatible object storage, e.g. https://s3.us-west-2.amazonaws.com")
+	command.Flags().StringVar(&bucket, "s3-bucket", "", "Bucket of the recordings")
+	command.Flags().StringVar(&region, "s3-region", dfv1.DefaultS3Region, "Region of the bucket")
+	command.Flags().StringVar(&prefix, "prefix", "", "Key prefix of the recordings to replay, e.g. <namespace>/<pipeline>/<vertex>/<replica>/")
+	command.Flags().StringVar(&runtimeDir, "runtime-dir", "/var/run/numaflow", "Directory of the socket and the server info file of the map UDF")
+	command.Flags().StringVar(&udfAddr, "udf-addr", "", "Address of a map UDF served over TCP, e.g. localhost:55551, instead of the socket in the runtime directory")
+	command.Flags().BoolVar(&sequential, "sequential", false, "Apply the messages of a batch one by one instead of concurrently")
+	return command
+}
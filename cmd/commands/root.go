@@ -49,4 +49,5 @@ func init() {
 	rootCmd.AddCommand(NewSideInputsInitCommand())
 	rootCmd.AddCommand(NewSideInputsManagerCommand())
 	rootCmd.AddCommand(NewSideInputsWatcherCommand())
+	rootCmd.AddCommand(NewReplayCommand())
 }
@@ -66,3 +66,19 @@ gen-protoc(){
 }
 
 gen-protoc pkg/apis/proto/daemon/daemon.proto
+
+gen-protoc pkg/apis/proto/windower/windower.proto
+
+gen-protoc pkg/apis/proto/trigger/trigger.proto
+
+gen-protoc pkg/apis/proto/counter/counter.proto
+
+gen-protoc pkg/apis/proto/cache/cache.proto
+
+gen-protoc pkg/apis/proto/sourcetransformbatch/sourcetransformbatch.proto
+
+gen-protoc pkg/apis/proto/component/component.proto
+
+gen-protoc pkg/apis/proto/lookup/lookup.proto
+
+gen-protoc pkg/apis/proto/interceptor/interceptor.proto
@@ -0,0 +1,61 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AdaptiveReadBatch makes the read batch size of a vertex adapt to the back pressure. The batch shrinks by half when
+// writing to the to buffers fails, e.g. the buffers are full, or when processing a batch takes longer than the target
+// latency; it grows additively when a full batch is processed in time. It applies to map UDF and sink vertices.
+type AdaptiveReadBatch struct {
+	// Min batch size, defaults to 1.
+	// +optional
+	Min *uint64 `json:"min,omitempty" protobuf:"varint,1,opt,name=min"`
+	// Max batch size, defaults to the read batch size.
+	// +optional
+	Max *uint64 `json:"max,omitempty" protobuf:"varint,2,opt,name=max"`
+	// TargetLatency is the target latency of processing a batch, including the UDF calls and the writes to the to
+	// buffers or the sink. Defaults to 1s.
+	// +optional
+	TargetLatency *metav1.Duration `json:"targetLatency,omitempty" protobuf:"bytes,3,opt,name=targetLatency"`
+}
+
+func (arb AdaptiveReadBatch) GetMin() int64 {
+	if arb.Min == nil {
+		return 1
+	}
+	return int64(*arb.Min)
+}
+
+// GetMax returns the max batch size, or the given read batch size if it's not specified.
+func (arb AdaptiveReadBatch) GetMax(readBatchSize int64) int64 {
+	if arb.Max == nil {
+		return readBatchSize
+	}
+	return int64(*arb.Max)
+}
+
+func (arb AdaptiveReadBatch) GetTargetLatency() time.Duration {
+	if arb.TargetLatency == nil {
+		return DefaultAdaptiveReadBatchTargetLatency
+	}
+	return arb.TargetLatency.Duration
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	apiresource "k8s.io/apimachinery/pkg/api/resource"
+)
+
+// BurstBuffer describes the temporary local disk buffering of a source vertex. When the writes to the inter-step
+// buffers fail, e.g. the buffers are full or the ISB Service is under maintenance, the messages are spooled to a
+// volume instead of blocking the source, and drained to the buffers in order once the writes succeed again.
+type BurstBuffer struct {
+	// VolumeName is the name of a volume of the vertex, e.g. a PersistentVolumeClaim, which is mounted to the numa
+	// container to spool the messages. Each replica spools to a separate directory.
+	VolumeName string `json:"volumeName" protobuf:"bytes,1,opt,name=volumeName"`
+	// MaxSize of the spooled messages of a replica, which is shared evenly by the partitions of the to edges. The
+	// source is blocked as before when it's reached. Defaults to 1Gi.
+	// +optional
+	MaxSize *apiresource.Quantity `json:"maxSize,omitempty" protobuf:"bytes,2,opt,name=maxSize"`
+}
+
+func (bb BurstBuffer) GetMaxSize() int64 {
+	if bb.MaxSize == nil {
+		return DefaultBurstBufferMaxSize
+	}
+	return bb.MaxSize.Value()
+}
+
+// GenerateBurstBufferDirName generates the name of the directory to spool the messages of a replica.
+func GenerateBurstBufferDirName(vertexName string, replica int32) string {
+	return fmt.Sprintf("%s-%d", vertexName, replica)
+}
@@ -0,0 +1,36 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apiresource "k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestBurstBuffer_GetMaxSize(t *testing.T) {
+	bb := BurstBuffer{VolumeName: "my-vol"}
+	assert.Equal(t, int64(1024*1024*1024), bb.GetMaxSize())
+	q := apiresource.MustParse("100Mi")
+	bb.MaxSize = &q
+	assert.Equal(t, int64(100*1024*1024), bb.GetMaxSize())
+}
+
+func TestGenerateBurstBufferDirName(t *testing.T) {
+	assert.Equal(t, "my-vertex-2", GenerateBurstBufferDirName("my-vertex", 2))
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Cache describes a local cache of a vertex, which is served by the numa container to the user defined container
+// over a unix domain socket, so that the UDFs in any language share the same caching behavior. Each replica has its
+// own cache, the entries are not shared across the replicas.
+type Cache struct {
+	// MaxEntries is the max number of the entries in the cache of a replica, the least recently used ones are evicted
+	// beyond it. Defaults to 10000.
+	// +optional
+	MaxEntries *int32 `json:"maxEntries,omitempty" protobuf:"varint,1,opt,name=maxEntries"`
+	// TTL of the entries, the entries never expire if it's not specified.
+	// +optional
+	TTL *metav1.Duration `json:"ttl,omitempty" protobuf:"bytes,2,opt,name=ttl"`
+	// Persistence specifies a volume to save the entries, which are loaded when the container restarts.
+	// +optional
+	Persistence *CachePersistence `json:"persistence,omitempty" protobuf:"bytes,3,opt,name=persistence"`
+	// SideInput is the name of a side input of the vertex to warm the cache with, the value of which is a JSON object
+	// of the keys and the string values. The entries are reloaded when the side input is updated.
+	// +optional
+	SideInput string `json:"sideInput,omitempty" protobuf:"bytes,4,opt,name=sideInput"`
+}
+
+func (c Cache) GetMaxEntries() int {
+	if c.MaxEntries == nil {
+		return DefaultCacheMaxEntries
+	}
+	return int(*c.MaxEntries)
+}
+
+func (c Cache) GetTTL() time.Duration {
+	if c.TTL == nil {
+		return 0
+	}
+	return c.TTL.Duration
+}
+
+// CachePersistence describes how the cache entries are persisted.
+type CachePersistence struct {
+	// VolumeName is the name of a volume of the vertex, e.g. a PersistentVolumeClaim, which is mounted to the numa
+	// container to save the entries. Each replica saves its entries to a separate file.
+	VolumeName string `json:"volumeName" protobuf:"bytes,1,opt,name=volumeName"`
+	// Interval of saving the entries, defaults to 1m. The entries are also saved when the container exits.
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty" protobuf:"bytes,2,opt,name=interval"`
+}
+
+func (cp CachePersistence) GetInterval() time.Duration {
+	if cp.Interval == nil {
+		return DefaultCachePersistenceInterval
+	}
+	return cp.Interval.Duration
+}
+
+// GenerateCacheFileName generates the name of the file to persist the cache entries of a replica.
+func GenerateCacheFileName(vertexName string, replica int32) string {
+	return fmt.Sprintf("%s-%d.json", vertexName, replica)
+}
@@ -0,0 +1,36 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	apiresource "k8s.io/apimachinery/pkg/api/resource"
+)
+
+// ClaimCheck describes the offloading of the large payloads.
+type ClaimCheck struct {
+	// Threshold of the payload size, the messages with larger payloads are offloaded to the object store. It needs to be
+	// smaller than the max payload size of the ISB Service. Defaults to 512Ki.
+	// +optional
+	Threshold *apiresource.Quantity `json:"threshold,omitempty" protobuf:"bytes,1,opt,name=threshold"`
+}
+
+func (cc *ClaimCheck) GetThreshold() int64 {
+	if cc == nil || cc.Threshold == nil {
+		return DefaultClaimCheckThreshold
+	}
+	return cc.Threshold.Value()
+}
@@ -0,0 +1,32 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apiresource "k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestClaimCheck_GetThreshold(t *testing.T) {
+	var cc *ClaimCheck
+	assert.Equal(t, int64(512*1024), cc.GetThreshold())
+	q := apiresource.MustParse("1Mi")
+	cc = &ClaimCheck{Threshold: &q}
+	assert.Equal(t, int64(1024*1024), cc.GetThreshold())
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type ClickHouseSink struct {
+	// URL of the HTTP interface of ClickHouse, e.g. "http://clickhouse:8123".
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+	// Database of the table, defaults to "default".
+	// +optional
+	Database string `json:"database,omitempty" protobuf:"bytes,2,opt,name=database"`
+	// Table the JSON payloads of the messages are inserted into.
+	Table string `json:"table" protobuf:"bytes,3,opt,name=table"`
+	// Columns maps the columns of the table to the fields of the JSON payloads, keyed by the column names, e.g.
+	// {"user_id": "user.id"}, the nested fields are separated by dots. The columns missing from a payload get their
+	// default values. If not specified, the payloads are inserted as they are, the top level fields go to the columns
+	// with the same names.
+	// +optional
+	Columns map[string]string `json:"columns,omitempty" protobuf:"bytes,4,rep,name=columns"`
+	// Batch specifies how the messages are batched into inserts.
+	// +optional
+	Batch *ClickHouseBatch `json:"batch,omitempty" protobuf:"bytes,5,opt,name=batch"`
+	// AsyncInsert enables the asynchronous inserts of ClickHouse, so that the small batches are buffered and merged
+	// by the server. The inserts still wait for the data to be flushed, and are deduplicated by the tokens derived
+	// from the message IDs if they are retried.
+	// +optional
+	AsyncInsert bool `json:"asyncInsert,omitempty" protobuf:"varint,6,opt,name=asyncInsert"`
+	// TLS configuration for the client.
+	// +optional
+	TLS *TLS `json:"tls,omitempty" protobuf:"bytes,7,opt,name=tls"`
+	// BasicAuth used to authenticate the client.
+	// +optional
+	BasicAuth *BasicAuth `json:"basicAuth,omitempty" protobuf:"bytes,8,opt,name=basicAuth"`
+}
+
+// ClickHouseBatch describes how the messages are batched into inserts, a batch is inserted once any of the limits
+// is reached. The messages are not acknowledged until they are inserted.
+type ClickHouseBatch struct {
+	// MaxRows is the max number of rows in an insert, defaults to 10000.
+	// +optional
+	MaxRows *uint32 `json:"maxRows,omitempty" protobuf:"varint,1,opt,name=maxRows"`
+	// MaxBytes is the max total size of the payloads in an insert, defaults to 10MiB.
+	// +optional
+	MaxBytes *uint64 `json:"maxBytes,omitempty" protobuf:"varint,2,opt,name=maxBytes"`
+	// MaxWait is the max time to wait for more messages before inserting a batch not reaching the other limits,
+	// defaults to 1s. It should be less than the ack wait of the Inter-Step Buffer.
+	// +optional
+	MaxWait *metav1.Duration `json:"maxWait,omitempty" protobuf:"bytes,3,opt,name=maxWait"`
+}
+
+func (cs *ClickHouseSink) GetDatabase() string {
+	if cs.Database == "" {
+		return "default"
+	}
+	return cs.Database
+}
+
+func (cb *ClickHouseBatch) GetMaxRows() int {
+	if cb == nil || cb.MaxRows == nil || *cb.MaxRows == 0 {
+		return DefaultClickHouseBatchMaxRows
+	}
+	return int(*cb.MaxRows)
+}
+
+func (cb *ClickHouseBatch) GetMaxBytes() int {
+	if cb == nil || cb.MaxBytes == nil || *cb.MaxBytes == 0 {
+		return DefaultClickHouseBatchMaxBytes
+	}
+	return int(*cb.MaxBytes)
+}
+
+func (cb *ClickHouseBatch) GetMaxWait() time.Duration {
+	if cb == nil || cb.MaxWait == nil {
+		return DefaultClickHouseBatchMaxWait
+	}
+	return cb.MaxWait.Duration
+}
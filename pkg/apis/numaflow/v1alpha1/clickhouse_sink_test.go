@@ -0,0 +1,42 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+)
+
+func TestClickHouseSink(t *testing.T) {
+	cs := &ClickHouseSink{}
+	assert.Equal(t, "default", cs.GetDatabase())
+	cs.Database = "events"
+	assert.Equal(t, "events", cs.GetDatabase())
+
+	var cb *ClickHouseBatch
+	assert.Equal(t, DefaultClickHouseBatchMaxRows, cb.GetMaxRows())
+	assert.Equal(t, DefaultClickHouseBatchMaxBytes, cb.GetMaxBytes())
+	assert.Equal(t, DefaultClickHouseBatchMaxWait, cb.GetMaxWait())
+	cb = &ClickHouseBatch{MaxRows: pointer.Uint32(100), MaxBytes: pointer.Uint64(1024), MaxWait: &metav1.Duration{Duration: 5 * time.Second}}
+	assert.Equal(t, 100, cb.GetMaxRows())
+	assert.Equal(t, 1024, cb.GetMaxBytes())
+	assert.Equal(t, 5*time.Second, cb.GetMaxWait())
+}
@@ -37,13 +37,22 @@ const (
 	KeyISBSvcType       = "numaflow.numaproj.io/isbsvc-type"
 	KeyPipelineName     = "numaflow.numaproj.io/pipeline-name"
 	KeyVertexName       = "numaflow.numaproj.io/vertex-name"
+	KeyVertexNamespace  = "numaflow.numaproj.io/vertex-namespace" // namespace of the vertex object, set on the objects created in another namespace
 	KeyReplica          = "numaflow.numaproj.io/replica"
 	KeySideInputName    = "numaflow.numaproj.io/side-input-name"
 	KeyDefaultContainer = "kubectl.kubernetes.io/default-container"
+	// annotation of a pipeline requesting to reset the committed offsets of a source vertex
+	KeyResetSourceOffsets = "numaflow.numaproj.io/reset-source-offsets"
 
 	// ID key in the header of sources like http
 	KeyMetaID        = "x-numaflow-id"
 	KeyMetaEventTime = "x-numaflow-event-time"
+	// Schema version key in the header of sources like http and kafka, and the gRPC metadata of the transformers
+	KeyMetaSchemaVersion = "x-numaflow-schema-version"
+	// W3C trace context key in the header of sources like http and kafka, and the gRPC metadata of the user defined containers
+	KeyMetaTraceParent = "traceparent"
+	// IDs of the messages being processed in the gRPC metadata of the user defined containers, to correlate the logs
+	KeyMetaMessageIDs = "x-numaflow-message-ids"
 
 	DefaultISBSvcName = "default"
 
@@ -79,6 +88,7 @@ const (
 	ComponentVertex           = "vertex"
 	ComponentJob              = "job"
 	ComponentSideInputManager = "side-inputs-manager"
+	ComponentController       = "controller"
 
 	// controllers
 	ControllerISBSvc   = "isbsvc-controller"
@@ -93,6 +103,7 @@ const (
 	EnvReplica                        = "NUMAFLOW_REPLICA"
 	EnvVertexObject                   = "NUMAFLOW_VERTEX_OBJECT"
 	EnvPipelineObject                 = "NUMAFLOW_PIPELINE_OBJECT"
+	EnvPackedVertexObjects            = "NUMAFLOW_PACKED_VERTEX_OBJECTS"
 	EnvSideInputObject                = "NUMAFLOW_SIDE_INPUT_OBJECT"
 	EnvImage                          = "NUMAFLOW_IMAGE"
 	EnvImagePullPolicy                = "NUMAFLOW_IMAGE_PULL_POLICY"
@@ -103,16 +114,39 @@ const (
 	EnvISBSvcRedisPassword            = "NUMAFLOW_ISBSVC_REDIS_PASSWORD"
 	EnvISBSvcRedisSentinelPassword    = "NUMAFLOW_ISBSVC_REDIS_SENTINEL_PASSWORD"
 	EnvISBSvcRedisClusterMaxRedirects = "NUMAFLOW_ISBSVC_REDIS_CLUSTER_MAX_REDIRECTS"
+	EnvISBSvcRedisSentinelUser        = "NUMAFLOW_ISBSVC_REDIS_SENTINEL_USER"
+	EnvISBSvcRedisTLSEnabled          = "NUMAFLOW_ISBSVC_REDIS_TLS_ENABLED"
+	EnvISBSvcRedisTLSInsecure         = "NUMAFLOW_ISBSVC_REDIS_TLS_INSECURE_SKIP_VERIFY"
+	EnvISBSvcRedisTLSCACert           = "NUMAFLOW_ISBSVC_REDIS_TLS_CA_CERT"
+	EnvISBSvcRedisTLSCert             = "NUMAFLOW_ISBSVC_REDIS_TLS_CERT"
+	EnvISBSvcRedisTLSKey              = "NUMAFLOW_ISBSVC_REDIS_TLS_KEY"
 	EnvISBSvcJetStreamUser            = "NUMAFLOW_ISBSVC_JETSTREAM_USER"
 	EnvISBSvcJetStreamPassword        = "NUMAFLOW_ISBSVC_JETSTREAM_PASSWORD"
 	EnvISBSvcJetStreamURL             = "NUMAFLOW_ISBSVC_JETSTREAM_URL"
 	EnvISBSvcJetStreamTLSEnabled      = "NUMAFLOW_ISBSVC_JETSTREAM_TLS_ENABLED"
+	EnvISBSvcJetStreamDomain          = "NUMAFLOW_ISBSVC_JETSTREAM_DOMAIN"
+	EnvToPipelineJetStreamUser        = "NUMAFLOW_TO_PIPELINE_JETSTREAM_USER"
+	EnvToPipelineJetStreamPassword    = "NUMAFLOW_TO_PIPELINE_JETSTREAM_PASSWORD"
+	EnvToPipelineJetStreamURL         = "NUMAFLOW_TO_PIPELINE_JETSTREAM_URL"
+	EnvToPipelineJetStreamTLSEnabled  = "NUMAFLOW_TO_PIPELINE_JETSTREAM_TLS_ENABLED"
+	EnvToPipelineJetStreamDomain      = "NUMAFLOW_TO_PIPELINE_JETSTREAM_DOMAIN"
 	EnvISBSvcConfig                   = "NUMAFLOW_ISBSVC_CONFIG"
 	EnvLeaderElectionDisabled         = "NUMAFLOW_LEADER_ELECTION_DISABLED"
 	EnvDebug                          = "NUMAFLOW_DEBUG"
 	EnvPPROF                          = "NUMAFLOW_PPROF"
 	EnvHealthCheckDisabled            = "NUMAFLOW_HEALTH_CHECK_DISABLED"
+	EnvMetricsDisabledHistograms      = "NUMAFLOW_METRICS_DISABLED_HISTOGRAMS"
+	EnvMetricsPrunedLabels            = "NUMAFLOW_METRICS_PRUNED_LABELS"
+	EnvMetricsMaxLabelValues          = "NUMAFLOW_METRICS_MAX_LABEL_VALUES"
 	EnvGRPCMaxMessageSize             = "NUMAFLOW_GRPC_MAX_MESSAGE_SIZE"
+	EnvGRPCKeepAliveTime              = "NUMAFLOW_GRPC_KEEPALIVE_TIME"
+	EnvGRPCKeepAliveTimeout           = "NUMAFLOW_GRPC_KEEPALIVE_TIMEOUT"
+	EnvGRPCRetryMaxAttempts           = "NUMAFLOW_GRPC_RETRY_MAX_ATTEMPTS"
+	EnvGRPCRetryInitialBackoff        = "NUMAFLOW_GRPC_RETRY_INITIAL_BACKOFF"
+	EnvGRPCRetryMaxBackoff            = "NUMAFLOW_GRPC_RETRY_MAX_BACKOFF"
+	EnvGRPCHedgingMaxAttempts         = "NUMAFLOW_GRPC_HEDGING_MAX_ATTEMPTS"
+	EnvGRPCHedgingDelay               = "NUMAFLOW_GRPC_HEDGING_DELAY"
+	EnvMapConnectionPoolSize          = "NUMAFLOW_MAP_CONNECTION_POOL_SIZE"
 	EnvCPURequest                     = "NUMAFLOW_CPU_REQUEST"
 	EnvCPULimit                       = "NUMAFLOW_CPU_LIMIT"
 	EnvMemoryRequest                  = "NUMAFLOW_MEMORY_REQUEST"
@@ -125,11 +159,25 @@ const (
 	VertexHTTPSPort       = 8443
 	VertexHTTPSPortName   = "https"
 	DaemonServicePort     = 4327
+	// Port of the component gRPC service of the controller
+	ControllerComponentPort = 9091
 
 	DefaultRequeueAfter = 10 * time.Second
 
 	PathSideInputsMount = "/var/numaflow/side-inputs"
 
+	// Mount path in the main container of the runtime directories of the source transformer chain stages
+	PathTransformerStagesVarRun = PathVarRun + "/transformers"
+
+	// Mount path of the message signing keys
+	PathSigningKeysMount = "/var/numaflow/signing-keys"
+
+	// Mount path of the volume to persist the cache entries
+	PathCachePersistenceMount = "/var/numaflow/cache"
+
+	// Mount path of the volume to spool the messages of a source vertex
+	PathBurstBufferMount = "/var/numaflow/burst-buffer"
+
 	// ISB
 	DefaultBufferLength     = 30000
 	DefaultBufferUsageLimit = 0.8
@@ -143,6 +191,7 @@ const (
 	DefaultTargetProcessingSeconds  = 20  // Default targeted time in seconds to finish processing all the pending messages for a source
 	DefaultTargetBufferAvailability = 50  // Default targeted percentage of buffer availability
 	DefaultReplicasPerScale         = 2   // Default maximum replicas to be scaled up or down at once
+	DefaultScalingMetricWeight      = 1   // Default weight of a scaling metric with the "weighted" metrics policy
 
 	// Default persistent buffer queue options
 	DefaultPBQChannelBufferSize = 100             // Default channel size in int (what should be right value?)
@@ -163,8 +212,83 @@ const (
 	// Default gRPC max message size
 	DefaultGRPCMaxMessageSize = 20 * 1024 * 1024
 
+	// Default threshold of the payload size to offload a message with claim check
+	DefaultClaimCheckThreshold = 512 * 1024
+
+	// Default max size of the burst buffer of a source vertex replica
+	DefaultBurstBufferMaxSize = 1024 * 1024 * 1024
+
+	// Default interval of rotating the message signing key
+	DefaultSigningKeyRotationInterval = 24 * time.Hour
+
+	// Default interval of generating a new data key of the message encryption
+	DefaultDataKeyRotationInterval = time.Hour
+
+	// Default endpoint of Google Cloud KMS
+	DefaultGCPKMSEndpoint = "https://cloudkms.googleapis.com"
+
+	// Default min idle time of the pending entries of a Redis Streams source to be claimed by another consumer
+	DefaultRedisStreamsClaimMinIdleTime = 5 * time.Minute
+
+	// Default max number of concurrent client connections of a WebSocket source pod
+	DefaultWebSocketMaxConnections = 100
+
+	// Default max number of deliveries of a message failing the checksum verification
+	DefaultChecksumMaxDeliveries = 3
+
+	// Default max number of the entries of the vertex cache
+	DefaultCacheMaxEntries = 10000
+
+	// Default interval of persisting the entries of the vertex cache
+	DefaultCachePersistenceInterval = time.Minute
+
+	// Default target latency of processing a batch with adaptive read batch sizing
+	DefaultAdaptiveReadBatchTargetLatency = time.Second
+
+	// Default max number of the container restarts of a vertex with a restart budget
+	DefaultRestartBudgetMaxRestarts = 10
+
+	// Default max number of the messages held by a partition of a watermark gated sink
+	DefaultWatermarkGateMaxHeldMessages = 10000
+
+	// Default max number of the messages mirrored per second by a pod
+	DefaultMirrorRatePerSecond = 100
+
+	// Default time without breaching the thresholds before the degraded edges of a pipeline are restored
+	DefaultDegradationRecoveryPeriod = 2 * time.Minute
+
+	// Default percentage of the messages forwarded to a degraded edge with the "sample" action
+	DefaultEdgeDegradationSamplePercent = 10
+
+	// Interval of the controller syncing the degradation state of a pipeline from the daemon service
+	DefaultDegradationSyncInterval = 30 * time.Second
+
+	// Interval of the controller resolving the to pipelines of the cross-pipeline edges of a pipeline
+	DefaultCrossPipelineSyncInterval = time.Minute
+
+	// Default max number of rows in an insert of a ClickHouse sink
+	DefaultClickHouseBatchMaxRows = 10000
+
+	// Default max total size of the payloads in an insert of a ClickHouse sink
+	DefaultClickHouseBatchMaxBytes = 10 * 1024 * 1024
+
+	// Default max time to wait for more messages before inserting a batch of a ClickHouse sink
+	DefaultClickHouseBatchMaxWait = time.Second
+
+	// Default max number of the input messages recorded by a pod of a vertex
+	DefaultRecordMaxMessages = 10000
+
+	// Default max time a pod of a vertex records its input messages for
+	DefaultRecordDuration = 10 * time.Minute
+
+	// Default region of an S3 compatible object storage
+	DefaultS3Region = "us-east-1"
+
 	// UDF map streaming
 	MapUdfStreamKey = "numaflow.numaproj.io/map-stream"
+
+	// Source transformer batch mode
+	SourceTransformerBatchKey = "numaflow.numaproj.io/source-transformer-batch"
 )
 
 var (
@@ -0,0 +1,53 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import "fmt"
+
+// PipelineVertex is a reference to a vertex of another pipeline, which is connected to a vertex of the pipeline
+// through a cross-pipeline edge.
+type PipelineVertex struct {
+	// Namespace of the pipeline, defaults to the namespace of the pipeline referring to it.
+	// +optional
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,1,opt,name=namespace"`
+	// Pipeline is the name of the pipeline.
+	Pipeline string `json:"pipeline" protobuf:"bytes,2,opt,name=pipeline"`
+	// Vertex is the name of the vertex of the pipeline.
+	Vertex string `json:"vertex" protobuf:"bytes,3,opt,name=vertex"`
+}
+
+// GetNamespace returns the namespace of the pipeline, or the given namespace of the pipeline referring to it if
+// it's not specified.
+func (pv PipelineVertex) GetNamespace(namespace string) string {
+	if pv.Namespace == "" {
+		return namespace
+	}
+	return pv.Namespace
+}
+
+// CrossPipelineVertexName returns the name of a vertex of another pipeline used in the edges of a pipeline, e.g. the
+// from vertex of the edge of a cross-pipeline bucket, which never conflicts with the vertex names of the pipeline since
+// "_" is not allowed in them.
+func CrossPipelineVertexName(namespace, pipeline, vertex string) string {
+	return fmt.Sprintf("%s_%s_%s", namespace, pipeline, vertex)
+}
+
+// GenerateToPipelineSecretName generates the name of the secret holding the credentials of the ISB Service of the to
+// pipeline of a vertex, if it's not the ISB Service of the vertex.
+func GenerateToPipelineSecretName(pipelineName, vertexName string) string {
+	return fmt.Sprintf("%s-%s-to-pipeline-isbsvc", pipelineName, vertexName)
+}
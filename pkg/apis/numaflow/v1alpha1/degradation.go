@@ -0,0 +1,98 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Degradation is the policy to degrade the best-effort edges of a pipeline when it's overloaded, i.e. any of the
+// thresholds is breached, and restore them when it's healthy again. The edges to degrade, and how they are degraded,
+// are specified by the "degradation" of the edges.
+type Degradation struct {
+	// MaxPending is the max number of the pending messages of any edge, the pipeline is overloaded when it's exceeded.
+	// +optional
+	MaxPending *int64 `json:"maxPending,omitempty" protobuf:"varint,1,opt,name=maxPending"`
+	// MaxLatency is the max watermark lag of any edge, the pipeline is overloaded when it's exceeded.
+	// +optional
+	MaxLatency *metav1.Duration `json:"maxLatency,omitempty" protobuf:"bytes,2,opt,name=maxLatency"`
+	// RecoveryPeriod is how long none of the thresholds is breached before the degraded edges are restored,
+	// defaults to 2m.
+	// +optional
+	RecoveryPeriod *metav1.Duration `json:"recoveryPeriod,omitempty" protobuf:"bytes,3,opt,name=recoveryPeriod"`
+}
+
+func (d Degradation) GetRecoveryPeriod() time.Duration {
+	if d.RecoveryPeriod == nil || d.RecoveryPeriod.Duration <= 0 {
+		return DefaultDegradationRecoveryPeriod
+	}
+	return d.RecoveryPeriod.Duration
+}
+
+// +kubebuilder:validation:Enum=disable;sample
+type EdgeDegradationAction string
+
+const (
+	// EdgeDegradationActionDisable stops forwarding the messages to the edge.
+	EdgeDegradationActionDisable EdgeDegradationAction = "disable"
+	// EdgeDegradationActionSample only forwards a percentage of the messages to the edge.
+	EdgeDegradationActionSample EdgeDegradationAction = "sample"
+)
+
+// EdgeDegradation describes how a best-effort edge is degraded when the pipeline is overloaded.
+type EdgeDegradation struct {
+	// Action is how the edge is degraded, "disable" or "sample", defaults to "disable".
+	// +optional
+	Action EdgeDegradationAction `json:"action,omitempty" protobuf:"bytes,1,opt,name=action,casttype=EdgeDegradationAction"`
+	// SamplePercent is the percentage of the messages still forwarded to the edge with the "sample" action,
+	// defaults to 10.
+	// +optional
+	SamplePercent *uint32 `json:"samplePercent,omitempty" protobuf:"varint,2,opt,name=samplePercent"`
+}
+
+func (ed EdgeDegradation) GetAction() EdgeDegradationAction {
+	if ed.Action == "" {
+		return EdgeDegradationActionDisable
+	}
+	return ed.Action
+}
+
+// GetSamplePercent returns the percentage of the messages forwarded to the degraded edge, which is 0 with the
+// "disable" action.
+func (ed EdgeDegradation) GetSamplePercent() uint32 {
+	if ed.GetAction() == EdgeDegradationActionDisable {
+		return 0
+	}
+	if ed.SamplePercent == nil {
+		return DefaultEdgeDegradationSamplePercent
+	}
+	return *ed.SamplePercent
+}
+
+// DegradationStatus is the degradation state of a pipeline.
+type DegradationStatus struct {
+	// Degraded tells if the best-effort edges of the pipeline are degraded.
+	Degraded bool `json:"degraded" protobuf:"varint,1,opt,name=degraded"`
+	// Reason of the last transition.
+	// +optional
+	Reason string `json:"reason,omitempty" protobuf:"bytes,2,opt,name=reason"`
+	// LastTransitionTime is the last time the pipeline was degraded or restored.
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty" protobuf:"bytes,3,opt,name=lastTransitionTime"`
+}
@@ -0,0 +1,43 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+)
+
+func TestDegradation_GetRecoveryPeriod(t *testing.T) {
+	d := Degradation{}
+	assert.Equal(t, DefaultDegradationRecoveryPeriod, d.GetRecoveryPeriod())
+	d.RecoveryPeriod = &metav1.Duration{Duration: 5 * time.Minute}
+	assert.Equal(t, 5*time.Minute, d.GetRecoveryPeriod())
+}
+
+func TestEdgeDegradation_GetSamplePercent(t *testing.T) {
+	ed := EdgeDegradation{SamplePercent: pointer.Uint32(50)}
+	assert.Equal(t, EdgeDegradationActionDisable, ed.GetAction())
+	assert.Equal(t, uint32(0), ed.GetSamplePercent())
+	ed.Action = EdgeDegradationActionSample
+	assert.Equal(t, uint32(50), ed.GetSamplePercent())
+	ed.SamplePercent = nil
+	assert.Equal(t, uint32(DefaultEdgeDegradationSamplePercent), ed.GetSamplePercent())
+}
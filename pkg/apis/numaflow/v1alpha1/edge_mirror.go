@@ -0,0 +1,49 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+// EdgeMirror describes a rate limited copy of the messages forwarded to an edge, which is written to the buffers of a
+// vertex of another pipeline, e.g. a staging pipeline validating a new version with the production traffic.
+type EdgeMirror struct {
+	// Pipeline is the name of the pipeline to mirror to, it needs to be in the same namespace and use the same
+	// Inter-Step Buffer Service.
+	Pipeline string `json:"pipeline" protobuf:"bytes,1,opt,name=pipeline"`
+	// Vertex is the name of the vertex of the pipeline to mirror to, the copies are written to its buffers.
+	Vertex string `json:"vertex" protobuf:"bytes,2,opt,name=vertex"`
+	// Partitions is the number of the partitions of the vertex to mirror to, defaults to 1.
+	// +optional
+	Partitions *int32 `json:"partitions,omitempty" protobuf:"varint,3,opt,name=partitions"`
+	// RatePerSecond is the max number of the messages mirrored per second by each pod, defaults to 100.
+	// The messages over the rate, or not accepted by the mirror target in time, are not mirrored, so that
+	// the edge is never slowed down by the mirror.
+	// +optional
+	RatePerSecond *uint32 `json:"ratePerSecond,omitempty" protobuf:"varint,4,opt,name=ratePerSecond"`
+}
+
+func (m EdgeMirror) GetPartitions() int {
+	if m.Partitions == nil || *m.Partitions < 1 {
+		return 1
+	}
+	return int(*m.Partitions)
+}
+
+func (m EdgeMirror) GetRatePerSecond() int {
+	if m.RatePerSecond == nil || *m.RatePerSecond == 0 {
+		return DefaultMirrorRatePerSecond
+	}
+	return int(*m.RatePerSecond)
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+type SchemaFormat string
+
+const (
+	SchemaFormatJSON     SchemaFormat = "json"
+	SchemaFormatAvro     SchemaFormat = "avro"
+	SchemaFormatProtobuf SchemaFormat = "protobuf"
+)
+
+// EdgeSchema describes the schema the payloads of the messages forwarded to an edge are validated against.
+type EdgeSchema struct {
+	// Format of the schema, "json" for JSON Schema, "avro" or "protobuf".
+	// +kubebuilder:validation:Enum=json;avro;protobuf
+	Format SchemaFormat `json:"format" protobuf:"bytes,1,opt,name=format,casttype=SchemaFormat"`
+	// Definition is the inline schema, for "protobuf" it is a base64 encoded serialized FileDescriptorSet,
+	// e.g. generated by "protoc --include_imports --descriptor_set_out". Either Definition or Registry is required.
+	// +optional
+	Definition string `json:"definition,omitempty" protobuf:"bytes,2,opt,name=definition"`
+	// Registry specifies the schema registry to fetch the schema from when the vertex starts.
+	// +optional
+	Registry *SchemaRegistry `json:"registry,omitempty" protobuf:"bytes,3,opt,name=registry"`
+	// MessageType is the fully qualified name of the protobuf message type, it is required for "protobuf".
+	// +optional
+	MessageType string `json:"messageType,omitempty" protobuf:"bytes,4,opt,name=messageType"`
+	// ErrorTo is the name of the to vertex of another edge from the same vertex, the invalid messages are forwarded
+	// to that edge instead, and the error edge only receives the invalid messages. If not provided, the invalid messages
+	// are dropped.
+	// +optional
+	ErrorTo string `json:"errorTo,omitempty" protobuf:"bytes,5,opt,name=errorTo"`
+}
+
+// SchemaRegistry describes a schema in a Confluent compatible schema registry.
+type SchemaRegistry struct {
+	// URL of the schema registry, e.g. http://schema-registry:8081.
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+	// Subject of the schema.
+	Subject string `json:"subject" protobuf:"bytes,2,opt,name=subject"`
+	// Version of the schema, defaults to the latest version.
+	// +optional
+	Version *int32 `json:"version,omitempty" protobuf:"varint,3,opt,name=version"`
+	// BasicAuth for the schema registry.
+	// +optional
+	BasicAuth *BasicAuth `json:"basicAuth,omitempty" protobuf:"bytes,4,opt,name=basicAuth"`
+	// TLS configuration for the schema registry.
+	// +optional
+	TLS *TLS `json:"tls,omitempty" protobuf:"bytes,5,opt,name=tls"`
+}
@@ -16,7 +16,12 @@ limitations under the License.
 
 package v1alpha1
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
 
 type Edge struct {
 	From string `json:"from" protobuf:"bytes,1,opt,name=from"`
@@ -30,6 +35,52 @@ type Edge struct {
 	// +kubebuilder:validation:Enum=retryUntilSuccess;discardLatest
 	// +optional
 	OnFull *BufferFullWritingStrategy `json:"onFull,omitempty" protobuf:"bytes,4,opt,name=onFull"`
+	// Shuffle specifies how the messages are distributed to the partitions of the to vertex,
+	// it only applies to the edges pointing to a keyed reduce vertex with multiple partitions.
+	// If not provided, the messages are distributed by hashing all the keys.
+	// +optional
+	Shuffle *ShuffleStrategy `json:"shuffle,omitempty" protobuf:"bytes,5,opt,name=shuffle"`
+	// MessageTTL specifies the max age of the messages read from the buffers of the edge, the older ones are dropped
+	// by the to vertex instead of being processed.
+	// +optional
+	MessageTTL *MessageTTL `json:"messageTTL,omitempty" protobuf:"bytes,6,opt,name=messageTTL"`
+	// Weight of the edge, when a message matches multiple edges with a weight from the same vertex, it's only
+	// forwarded to one of them, which is chosen in proportion to the weights, e.g. 90 and 10 for a canary split.
+	// The matching edges without a weight still get the message.
+	// +optional
+	Weight *int32 `json:"weight,omitempty" protobuf:"varint,7,opt,name=weight"`
+	// Priority of the edge, when a message matches multiple edges from the same vertex, it's only forwarded to the
+	// matching edges with the highest priority. Defaults to 0.
+	// +optional
+	Priority *int32 `json:"priority,omitempty" protobuf:"varint,8,opt,name=priority"`
+	// Schema specifies the schema the payloads of the messages forwarded to the edge are validated against,
+	// the invalid messages are dropped or forwarded to an error edge.
+	// +optional
+	Schema *EdgeSchema `json:"schema,omitempty" protobuf:"bytes,9,opt,name=schema"`
+	// Mirror specifies a rate limited copy of the messages forwarded to the edge to be written to a vertex of
+	// another pipeline, e.g. a staging pipeline. The mirror never slows down the edge, the copies are sampled instead.
+	// +optional
+	Mirror *EdgeMirror `json:"mirror,omitempty" protobuf:"bytes,10,opt,name=mirror"`
+	// Degradation makes the edge a best-effort one, which is disabled or sampled when the pipeline is overloaded,
+	// according to the degradation policy of the pipeline.
+	// +optional
+	Degradation *EdgeDegradation `json:"degradation,omitempty" protobuf:"bytes,11,opt,name=degradation"`
+	// WriteAckPolicy specifies how the writes to the buffers of the edge are acknowledged, it only applies to the
+	// JetStream ISB Service. There are three options, sync, async and fireAndForget.
+	// "sync" waits for the acknowledgement of each message before returning, "async" publishes the messages of a batch
+	// with a bounded in-flight window and retries the failed ones, "fireAndForget" does not wait for the
+	// acknowledgements at all, the messages might be lost, so it should only be used for non-critical edges.
+	// If not provided, the default value is set to "sync".
+	// +kubebuilder:validation:Enum=sync;async;fireAndForget
+	// +optional
+	WriteAckPolicy *WriteAckPolicy `json:"writeAckPolicy,omitempty" protobuf:"bytes,12,opt,name=writeAckPolicy"`
+	// StrictFIFO guarantees the messages of the edge are processed by the to vertex in the order they are written,
+	// by publishing them one after another, and by reading, processing, writing and acknowledging them in a single
+	// batch at a time with the UDF concurrency of 1. It trades the throughput for the global ordering, both the from
+	// and the to vertices need to have a single partition and at most one replica. It only applies to the JetStream
+	// ISB Service.
+	// +optional
+	StrictFIFO bool `json:"strictFIFO,omitempty" protobuf:"varint,13,opt,name=strictFIFO"`
 }
 
 // CombinedEdge is a combination of Edge and some other properties such as vertex type, partitions, limits.
@@ -52,6 +103,18 @@ type CombinedEdge struct {
 	ToVertexPartitionCount *int32 `json:"toVertexPartitionCount,omitempty" protobuf:"bytes,6,opt,name=toVertexPartitionCount"`
 	// +optional
 	ToVertexLimits *VertexLimits `json:"toVertexLimits,omitempty" protobuf:"bytes,7,opt,name=toVertexLimits"`
+	// The JetStream domain of the from vertex, where the buffers and the bucket of the edge are kept. Empty means the
+	// domain of the ISB Service.
+	// +optional
+	FromVertexJetStreamDomain string `json:"fromVertexJetStreamDomain,omitempty" protobuf:"bytes,8,opt,name=fromVertexJetStreamDomain"`
+	// ToPipeline is set for a cross-pipeline edge, whose to vertex is a vertex of another pipeline. The buffers and
+	// the bucket of the edge are kept in the ISB Service of that pipeline.
+	// +optional
+	ToPipeline *PipelineVertex `json:"toPipeline,omitempty" protobuf:"bytes,9,opt,name=toPipeline"`
+	// ToPipelineJetStream is the configuration of the JetStream ISB Service of the to pipeline of a cross-pipeline
+	// edge, it's only set if it's not the ISB Service of the from vertex.
+	// +optional
+	ToPipelineJetStream *JetStreamConfig `json:"toPipelineJetStream,omitempty" protobuf:"bytes,10,opt,name=toPipelineJetStream"`
 }
 
 func (ce CombinedEdge) GetFromVertexPartitions() int {
@@ -70,9 +133,44 @@ func (ce CombinedEdge) GetToVertexPartitionCount() int {
 	return int(*ce.ToVertexPartitionCount)
 }
 
+// GetToBufferNames returns the names of the buffers the edge writes to, the from vertex is in the given pipeline.
+func (ce CombinedEdge) GetToBufferNames(namespace, pipeline string) []string {
+	if x := ce.ToPipeline; x != nil {
+		return GenerateBufferNames(x.Namespace, x.Pipeline, x.Vertex, ce.GetToVertexPartitionCount())
+	}
+	return GenerateBufferNames(namespace, pipeline, ce.To, ce.GetToVertexPartitionCount())
+}
+
+// GetBucketName returns the name of the watermark bucket of the edge, the from vertex is in the given pipeline.
+func (ce CombinedEdge) GetBucketName(namespace, pipeline string) string {
+	if x := ce.ToPipeline; x != nil {
+		return GenerateEdgeBucketName(x.Namespace, x.Pipeline, CrossPipelineVertexName(namespace, pipeline, ce.From), x.Vertex)
+	}
+	return GenerateEdgeBucketName(namespace, pipeline, ce.From, ce.To)
+}
+
 type ForwardConditions struct {
 	// Tags used to specify tags for conditional forwarding
-	Tags *TagConditions `json:"tags" protobuf:"bytes,1,opt,name=tags"`
+	// +optional
+	Tags *TagConditions `json:"tags,omitempty" protobuf:"bytes,1,opt,name=tags"`
+	// SchemaVersions used to specify the schema versions of the messages to be forwarded, an empty string matches the
+	// messages without a schema version. If it's specified together with Tags, both of them need to be satisfied.
+	// +optional
+	SchemaVersions []string `json:"schemaVersions,omitempty" protobuf:"bytes,2,rep,name=schemaVersions"`
+}
+
+// MatchSchemaVersion returns true if the schema version satisfies the conditions, it's always true if no schema
+// versions are specified.
+func (fc *ForwardConditions) MatchSchemaVersion(schemaVersion string) bool {
+	if fc == nil || len(fc.SchemaVersions) == 0 {
+		return true
+	}
+	for _, v := range fc.SchemaVersions {
+		if v == schemaVersion {
+			return true
+		}
+	}
+	return false
 }
 
 type LogicOperator string
@@ -117,6 +215,25 @@ func (e Edge) BufferFullWritingStrategy() BufferFullWritingStrategy {
 	}
 }
 
+func (e Edge) GetWriteAckPolicy() WriteAckPolicy {
+	if e.WriteAckPolicy == nil {
+		return WriteAckPolicySync
+	}
+	switch *e.WriteAckPolicy {
+	case WriteAckPolicySync, WriteAckPolicyAsync, WriteAckPolicyFireAndForget:
+		return *e.WriteAckPolicy
+	default:
+		return WriteAckPolicySync
+	}
+}
+
+func (e Edge) GetPriority() int32 {
+	if e.Priority == nil {
+		return 0
+	}
+	return *e.Priority
+}
+
 func (e Edge) GetEdgeName() string {
 	return fmt.Sprintf("%s-%s", e.From, e.To)
 }
@@ -128,6 +245,99 @@ const (
 	DiscardLatest     BufferFullWritingStrategy = "discardLatest"
 )
 
+type WriteAckPolicy string
+
+const (
+	// WriteAckPolicySync waits for the acknowledgement of each message written to the buffer.
+	WriteAckPolicySync WriteAckPolicy = "sync"
+	// WriteAckPolicyAsync publishes the messages asynchronously, and waits for the acknowledgements of the batch.
+	WriteAckPolicyAsync WriteAckPolicy = "async"
+	// WriteAckPolicyFireAndForget publishes the messages asynchronously without waiting for the acknowledgements.
+	WriteAckPolicyFireAndForget WriteAckPolicy = "fireAndForget"
+)
+
+type ShuffleType string
+
+const (
+	// ShuffleTypeHash distributes the messages by the hash of the keys modulo the partition count.
+	ShuffleTypeHash ShuffleType = "hash"
+	// ShuffleTypeConsistentHash distributes the messages with jump consistent hashing, which minimizes the
+	// number of keys moving to a different partition when the partition count changes.
+	ShuffleTypeConsistentHash ShuffleType = "consistentHash"
+	// ShuffleTypeRoundRobin distributes the messages evenly across the partitions regardless of the keys.
+	ShuffleTypeRoundRobin ShuffleType = "roundRobin"
+	// ShuffleTypeHeader distributes the messages by the hash of the value of a message header modulo the partition count.
+	ShuffleTypeHeader ShuffleType = "header"
+)
+
+type ShuffleStrategy struct {
+	// Type of the shuffle strategy, could be "hash", "consistentHash", "roundRobin" or "header".
+	// "roundRobin" and "header" do not keep the key affinity, they should only be used when the reduce function
+	// is able to tolerate the same key being processed in different partitions.
+	// if not provided, the default value is set to "hash"
+	// +kubebuilder:validation:Enum=hash;consistentHash;roundRobin;header
+	// +optional
+	Type *ShuffleType `json:"type,omitempty" protobuf:"bytes,1,opt,name=type"`
+	// KeyIndexes specifies the indexes of the message keys used to calculate the hash,
+	// all the keys are used if not provided. Indexes out of the range of the message keys are ignored.
+	// +optional
+	KeyIndexes []int32 `json:"keyIndexes,omitempty" protobuf:"varint,2,rep,name=keyIndexes"`
+	// HeaderName is the name of the message header used to calculate the hash, required by the "header" type.
+	// The messages without the header are all written to the same partition.
+	// +optional
+	HeaderName string `json:"headerName,omitempty" protobuf:"bytes,3,opt,name=headerName"`
+}
+
+func (ss ShuffleStrategy) GetType() ShuffleType {
+	if ss.Type == nil {
+		return ShuffleTypeHash
+	}
+	switch *ss.Type {
+	case ShuffleTypeHash, ShuffleTypeConsistentHash, ShuffleTypeRoundRobin, ShuffleTypeHeader:
+		return *ss.Type
+	default:
+		return ShuffleTypeHash
+	}
+}
+
+type MessageTTLBasis string
+
+const (
+	// MessageTTLBasisEventTime measures the age of a message by its event time.
+	MessageTTLBasisEventTime MessageTTLBasis = "eventTime"
+	// MessageTTLBasisIngestTime measures the age of a message by the time it was read by the source vertex.
+	MessageTTLBasisIngestTime MessageTTLBasis = "ingestTime"
+)
+
+type MessageTTL struct {
+	// Duration is the max age of the messages.
+	Duration *metav1.Duration `json:"duration" protobuf:"bytes,1,opt,name=duration"`
+	// Basis specifies how the age of a message is measured, could be "eventTime" or "ingestTime".
+	// if not provided, the default value is set to "eventTime"
+	// +kubebuilder:validation:Enum=eventTime;ingestTime
+	// +optional
+	Basis *MessageTTLBasis `json:"basis,omitempty" protobuf:"bytes,2,opt,name=basis"`
+}
+
+func (mt MessageTTL) GetDuration() time.Duration {
+	if mt.Duration == nil {
+		return 0
+	}
+	return mt.Duration.Duration
+}
+
+func (mt MessageTTL) GetBasis() MessageTTLBasis {
+	if mt.Basis == nil {
+		return MessageTTLBasisEventTime
+	}
+	switch *mt.Basis {
+	case MessageTTLBasisEventTime, MessageTTLBasisIngestTime:
+		return *mt.Basis
+	default:
+		return MessageTTLBasisEventTime
+	}
+}
+
 func GenerateEdgeBucketName(namespace, pipeline, from, to string) string {
 	return fmt.Sprintf("%s-%s-%s-%s", namespace, pipeline, from, to)
 }
@@ -0,0 +1,79 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+)
+
+func TestForwardConditions_MatchSchemaVersion(t *testing.T) {
+	var fc *ForwardConditions
+	assert.True(t, fc.MatchSchemaVersion("v1"))
+	fc = &ForwardConditions{}
+	assert.True(t, fc.MatchSchemaVersion(""))
+	fc.SchemaVersions = []string{"v1", ""}
+	assert.True(t, fc.MatchSchemaVersion("v1"))
+	assert.True(t, fc.MatchSchemaVersion(""))
+	assert.False(t, fc.MatchSchemaVersion("v2"))
+}
+
+func TestMessageTTL(t *testing.T) {
+	mt := MessageTTL{}
+	assert.Equal(t, time.Duration(0), mt.GetDuration())
+	assert.Equal(t, MessageTTLBasisEventTime, mt.GetBasis())
+	basis := MessageTTLBasisIngestTime
+	mt = MessageTTL{Duration: &metav1.Duration{Duration: time.Minute}, Basis: &basis}
+	assert.Equal(t, time.Minute, mt.GetDuration())
+	assert.Equal(t, MessageTTLBasisIngestTime, mt.GetBasis())
+	basis = "unknown"
+	assert.Equal(t, MessageTTLBasisEventTime, mt.GetBasis())
+}
+
+func TestEdgeMirror(t *testing.T) {
+	m := EdgeMirror{Pipeline: "staging", Vertex: "in"}
+	assert.Equal(t, 1, m.GetPartitions())
+	assert.Equal(t, DefaultMirrorRatePerSecond, m.GetRatePerSecond())
+	m.Partitions = pointer.Int32(3)
+	m.RatePerSecond = pointer.Uint32(10)
+	assert.Equal(t, 3, m.GetPartitions())
+	assert.Equal(t, 10, m.GetRatePerSecond())
+}
+
+func TestEdge_GetWriteAckPolicy(t *testing.T) {
+	e := Edge{}
+	assert.Equal(t, WriteAckPolicySync, e.GetWriteAckPolicy())
+	p := WriteAckPolicyFireAndForget
+	e.WriteAckPolicy = &p
+	assert.Equal(t, WriteAckPolicyFireAndForget, e.GetWriteAckPolicy())
+	p = "unknown"
+	assert.Equal(t, WriteAckPolicySync, e.GetWriteAckPolicy())
+}
+
+func TestCombinedEdge_CrossPipeline(t *testing.T) {
+	e := CombinedEdge{Edge: Edge{From: "a", To: "b"}, ToVertexPartitionCount: pointer.Int32(2)}
+	assert.Equal(t, []string{"ns-pl-b-0", "ns-pl-b-1"}, e.GetToBufferNames("ns", "pl"))
+	assert.Equal(t, "ns-pl-a-b", e.GetBucketName("ns", "pl"))
+	e.ToPipeline = &PipelineVertex{Namespace: "ns2", Pipeline: "pl2", Vertex: "b"}
+	assert.Equal(t, []string{"ns2-pl2-b-0", "ns2-pl2-b-1"}, e.GetToBufferNames("ns", "pl"))
+	assert.Equal(t, "ns2-pl2-ns_pl_a-b", e.GetBucketName("ns", "pl"))
+	assert.Equal(t, "ns", PipelineVertex{}.GetNamespace("ns"))
+}
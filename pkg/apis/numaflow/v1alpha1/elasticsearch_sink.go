@@ -0,0 +1,53 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+type ElasticsearchSink struct {
+	// URLs of the Elasticsearch or OpenSearch nodes, e.g. "https://elasticsearch:9200".
+	URLs []string `json:"urls" protobuf:"bytes,1,rep,name=urls"`
+	// Index is a Go template of the index name, executed with the message, e.g. "logs-{{ index .Keys 0 }}" or
+	// "events-{{ .EventTime.Format \"2006.01.02\" }}". The fields available are Keys, ID and EventTime.
+	Index string `json:"index" protobuf:"bytes,2,opt,name=index"`
+	// BulkSize is the max number of documents in a bulk request, defaults to 500.
+	// +optional
+	BulkSize *uint32 `json:"bulkSize,omitempty" protobuf:"varint,3,opt,name=bulkSize"`
+	// FallbackIndex is the index the documents rejected with a non-retryable error (e.g. mapping errors) are routed to,
+	// the original payload is wrapped with the error. If not specified, the rejected documents are retried like the
+	// other failures.
+	// +optional
+	FallbackIndex string `json:"fallbackIndex,omitempty" protobuf:"bytes,4,opt,name=fallbackIndex"`
+	// TLS configuration for the client.
+	// +optional
+	TLS *TLS `json:"tls,omitempty" protobuf:"bytes,5,opt,name=tls"`
+	// BasicAuth used to authenticate the client.
+	// +optional
+	BasicAuth *BasicAuth `json:"basicAuth,omitempty" protobuf:"bytes,6,opt,name=basicAuth"`
+	// APIKey refers to the secret of a base64 encoded API key, it can not be used together with basicAuth.
+	// +optional
+	APIKey *corev1.SecretKeySelector `json:"apiKey,omitempty" protobuf:"bytes,7,opt,name=apiKey"`
+}
+
+func (es *ElasticsearchSink) GetBulkSize() int {
+	if es == nil || es.BulkSize == nil || *es.BulkSize == 0 {
+		return 500
+	}
+	return int(*es.BulkSize)
+}
@@ -50,6 +50,8 @@ type GetVertexPodSpecReq struct {
 	PullPolicy          corev1.PullPolicy `protobuf:"bytes,3,opt,name=pullPolicy,casttype=k8s.io/api/core/v1.PullPolicy"`
 	Env                 []corev1.EnvVar   `protobuf:"bytes,4,rep,name=env"`
 	SideInputsStoreName string            `protobuf:"bytes,5,opt,name=sideInputsStoreName"`
+	// PackedVertices are the vertex objects of the vertices packed into the pods.
+	PackedVertices []Vertex `protobuf:"bytes,6,rep,name=packedVertices"`
 }
 
 type GetDaemonDeploymentReq struct {
@@ -74,14 +76,16 @@ type GetJetStreamStatefulSetSpecReq struct {
 	ConfigMapName              string            `protobuf:"bytes,12,opt,name=configMapName"`
 	PvcNameIfNeeded            string            `protobuf:"bytes,13,opt,name=pvcNameIfNeeded"`
 	StartCommand               string            `protobuf:"bytes,14,opt,name=startCommand"`
+	LeafNodePort               int32             `protobuf:"bytes,15,opt,name=leafNodePort"`
 }
 
 type GetJetStreamServiceSpecReq struct {
-	Labels      map[string]string `protobuf:"bytes,1,rep,name=labels"`
-	ClusterPort int32             `protobuf:"bytes,2,opt,name=clusterPort"`
-	ClientPort  int32             `protobuf:"bytes,3,opt,name=clientPort"`
-	MonitorPort int32             `protobuf:"bytes,4,opt,name=monitorPort"`
-	MetricsPort int32             `protobuf:"bytes,5,opt,name=metricsPort"`
+	Labels       map[string]string `protobuf:"bytes,1,rep,name=labels"`
+	ClusterPort  int32             `protobuf:"bytes,2,opt,name=clusterPort"`
+	ClientPort   int32             `protobuf:"bytes,3,opt,name=clientPort"`
+	MonitorPort  int32             `protobuf:"bytes,4,opt,name=monitorPort"`
+	MetricsPort  int32             `protobuf:"bytes,5,opt,name=metricsPort"`
+	LeafNodePort int32             `protobuf:"bytes,6,opt,name=leafNodePort"`
 }
 
 type GetSideInputDeploymentReq struct {
@@ -0,0 +1,83 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:validation:Enum=preRead;postUDF;preWrite
+type InterceptorHook string
+
+const (
+	// InterceptorHookPreRead is called before a batch of messages is read from a partition.
+	InterceptorHookPreRead InterceptorHook = "preRead"
+	// InterceptorHookPostUDF is called with the results of a message returned by the UDF.
+	InterceptorHookPostUDF InterceptorHook = "postUDF"
+	// InterceptorHookPreWrite is called with the messages about to be written to a partition of the to buffers.
+	InterceptorHookPreWrite InterceptorHook = "preWrite"
+)
+
+// Interceptor intercepts the messages forwarded by a map or sink vertex, to add the concerns like auditing, tagging or
+// encryption without changing the user-defined containers.
+type Interceptor struct {
+	// Name of the interceptor. Without grpc, it's the name of an interceptor compiled into the numaflow image, which
+	// registers itself when the image is built with its build tag.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// KWArgs are passed to the compiled-in interceptor.
+	// +optional
+	KWArgs map[string]string `json:"kwargs,omitempty" protobuf:"bytes,2,rep,name=kwargs"`
+	// GRPC is an external interceptor implementing the Interceptor service defined in interceptor.proto, e.g. served
+	// by a sidecar of the vertex.
+	// +optional
+	GRPC *GRPCInterceptor `json:"grpc,omitempty" protobuf:"bytes,3,opt,name=grpc"`
+}
+
+// GRPCInterceptor is an external interceptor called over gRPC.
+type GRPCInterceptor struct {
+	// Address of the interceptor service, e.g. "localhost:9090" for a sidecar.
+	Address string `json:"address" protobuf:"bytes,1,opt,name=address"`
+	// Hooks to call, defaults to all of them. Each call adds a round trip, so only the ones implemented by the service
+	// should be listed.
+	// +optional
+	Hooks []InterceptorHook `json:"hooks,omitempty" protobuf:"bytes,2,rep,name=hooks,casttype=InterceptorHook"`
+	// Timeout of each call, defaults to 5s.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty" protobuf:"bytes,3,opt,name=timeout"`
+}
+
+// HasHook returns if the hook is called.
+func (g GRPCInterceptor) HasHook(hook InterceptorHook) bool {
+	if len(g.Hooks) == 0 {
+		return true
+	}
+	for _, h := range g.Hooks {
+		if h == hook {
+			return true
+		}
+	}
+	return false
+}
+
+func (g GRPCInterceptor) GetTimeout() time.Duration {
+	if g.Timeout == nil {
+		return 5 * time.Second
+	}
+	return g.Timeout.Duration
+}
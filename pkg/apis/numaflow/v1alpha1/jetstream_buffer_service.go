@@ -73,6 +73,15 @@ type JetStreamBufferService struct {
 	// Enabling TLS might impact the performance
 	// +optional
 	TLS bool `json:"tls,omitempty" protobuf:"bytes,12,opt,name=tls"`
+	// JetStream domain of the service, required for the vertices connecting through leaf nodes, which run in their own
+	// domains.
+	// See https://docs.nats.io/running-a-nats-service/configuration/leafnodes/jetstream_leafnodes for the detail.
+	// +optional
+	Domain string `json:"domain,omitempty" protobuf:"bytes,13,opt,name=domain"`
+	// Whether to accept the connections from NATS leaf nodes, defaults to false. The leaf nodes connect to port 7422
+	// with the credentials of the service.
+	// +optional
+	LeafNodes bool `json:"leafNodes,omitempty" protobuf:"bytes,14,opt,name=leafNodes"`
 }
 
 func (j JetStreamBufferService) GetReplicas() int {
@@ -86,7 +95,7 @@ func (j JetStreamBufferService) GetReplicas() int {
 }
 
 func (j JetStreamBufferService) GetServiceSpec(req GetJetStreamServiceSpecReq) corev1.ServiceSpec {
-	return corev1.ServiceSpec{
+	spec := corev1.ServiceSpec{
 		Ports: []corev1.ServicePort{
 			{Name: "tcp-client", Port: req.ClientPort},
 			{Name: "cluster", Port: req.ClusterPort},
@@ -98,6 +107,10 @@ func (j JetStreamBufferService) GetServiceSpec(req GetJetStreamServiceSpecReq) c
 		PublishNotReadyAddresses: true,
 		Selector:                 req.Labels,
 	}
+	if j.LeafNodes {
+		spec.Ports = append(spec.Ports, corev1.ServicePort{Name: "leafnodes", Port: req.LeafNodePort})
+	}
+	return spec
 }
 
 func (j JetStreamBufferService) GetStatefulSetSpec(req GetJetStreamStatefulSetSpecReq) appv1.StatefulSetSpec {
@@ -253,6 +266,9 @@ func (j JetStreamBufferService) GetStatefulSetSpec(req GetJetStreamStatefulSetSp
 			},
 		},
 	}
+	if j.LeafNodes {
+		podSpec.Containers[0].Ports = append(podSpec.Containers[0].Ports, corev1.ContainerPort{Name: "leafnodes", ContainerPort: req.LeafNodePort})
+	}
 	j.AbstractPodTemplate.ApplyToPodSpec(podSpec)
 	spec := appv1.StatefulSetSpec{
 		PodManagementPolicy: appv1.ParallelPodManagement,
@@ -309,4 +325,7 @@ type JetStreamConfig struct {
 	StreamConfig string `json:"streamConfig,omitempty" protobuf:"bytes,3,opt,name=streamConfig"`
 	// TLS enabled or not
 	TLSEnabled bool `json:"tlsEnabled,omitempty" protobuf:"bytes,4,opt,name=tlsEnabled"`
+	// JetStream domain
+	// +optional
+	Domain string `json:"domain,omitempty" protobuf:"bytes,5,opt,name=domain"`
 }
@@ -29,4 +29,12 @@ type KafkaSink struct {
 	// SASL.enable=true default for SASL.
 	// +optional
 	SASL *SASL `json:"sasl" protobuf:"bytes,5,opt,name=sasl"`
+	// Idempotent enables the idempotent producer, so that the retries of the producer don't write duplicates.
+	// +optional
+	Idempotent bool `json:"idempotent,omitempty" protobuf:"varint,6,opt,name=idempotent"`
+	// Transactional enables the transactional producer, the messages of a batch are written in a transaction, which is
+	// committed only after the batch is acked from the inter-step buffer, so that the output is not duplicated when a sink
+	// pod restarts in the middle of a batch. It implies Idempotent.
+	// +optional
+	Transactional bool `json:"transactional,omitempty" protobuf:"varint,7,opt,name=transactional"`
 }
@@ -0,0 +1,77 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+// ChecksumAlgorithm is the algorithm of the message checksums.
+// +kubebuilder:validation:Enum="";crc32c;xxhash
+type ChecksumAlgorithm string
+
+const (
+	ChecksumAlgorithmCRC32C ChecksumAlgorithm = "crc32c"
+	ChecksumAlgorithmXXHash ChecksumAlgorithm = "xxhash"
+)
+
+// ChecksumQuarantinePolicy is the policy of handling the messages failing the checksum verification.
+// +kubebuilder:validation:Enum="";Drop;Redeliver
+type ChecksumQuarantinePolicy string
+
+const (
+	// ChecksumQuarantineDrop acknowledges and drops the corrupted messages.
+	ChecksumQuarantineDrop ChecksumQuarantinePolicy = "Drop"
+	// ChecksumQuarantineRedeliver leaves the corrupted messages unacknowledged, so that they are redelivered by the
+	// Inter-Step Buffer, which might read them from a healthy replica. They are dropped once delivered for
+	// MaxDeliveries times.
+	ChecksumQuarantineRedeliver ChecksumQuarantinePolicy = "Redeliver"
+)
+
+// MessageChecksum describes the checksums of the messages written to the Inter-Step Buffers. Each message is
+// checksummed by the writer and verified by the reader, to detect the messages corrupted in the buffers, e.g. by a
+// faulty storage of the Inter-Step Buffer Service.
+type MessageChecksum struct {
+	// Algorithm is the checksum algorithm, "crc32c" or "xxhash", defaults to "crc32c".
+	// +optional
+	Algorithm ChecksumAlgorithm `json:"algorithm,omitempty" protobuf:"bytes,1,opt,name=algorithm,casttype=ChecksumAlgorithm"`
+	// Quarantine is the policy of handling the corrupted messages, "Drop" or "Redeliver", defaults to "Drop".
+	// +optional
+	Quarantine ChecksumQuarantinePolicy `json:"quarantine,omitempty" protobuf:"bytes,2,opt,name=quarantine,casttype=ChecksumQuarantinePolicy"`
+	// MaxDeliveries is the max number of deliveries of a corrupted message with the "Redeliver" policy, before it's
+	// dropped, defaults to 3. A corrupted message is dropped at once if the Inter-Step Buffer doesn't track the
+	// deliveries.
+	// +optional
+	MaxDeliveries *uint32 `json:"maxDeliveries,omitempty" protobuf:"varint,3,opt,name=maxDeliveries"`
+}
+
+func (mc *MessageChecksum) GetAlgorithm() ChecksumAlgorithm {
+	if mc == nil || mc.Algorithm == "" {
+		return ChecksumAlgorithmCRC32C
+	}
+	return mc.Algorithm
+}
+
+func (mc *MessageChecksum) GetQuarantine() ChecksumQuarantinePolicy {
+	if mc == nil || mc.Quarantine == "" {
+		return ChecksumQuarantineDrop
+	}
+	return mc.Quarantine
+}
+
+func (mc *MessageChecksum) GetMaxDeliveries() uint32 {
+	if mc == nil || mc.MaxDeliveries == nil {
+		return DefaultChecksumMaxDeliveries
+	}
+	return *mc.MaxDeliveries
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MessageEncryption describes the envelope encryption of the payloads of the messages written to the Inter-Step
+// Buffers, for the pipelines carrying sensitive data. The payload of each message is encrypted by the writer with a
+// data key, which is wrapped by a KMS and carried in the message, and decrypted by the reader.
+type MessageEncryption struct {
+	// KMS wraps the data keys, exactly one of the providers is required.
+	KMS EncryptionKMS `json:"kms" protobuf:"bytes,1,opt,name=kms"`
+	// DataKeyRotationInterval is the interval of generating a new data key by each writer, defaults to 1h.
+	// +optional
+	DataKeyRotationInterval *metav1.Duration `json:"dataKeyRotationInterval,omitempty" protobuf:"bytes,2,opt,name=dataKeyRotationInterval"`
+}
+
+func (me *MessageEncryption) GetDataKeyRotationInterval() time.Duration {
+	if me == nil || me.DataKeyRotationInterval == nil || me.DataKeyRotationInterval.Duration <= 0 {
+		return DefaultDataKeyRotationInterval
+	}
+	return me.DataKeyRotationInterval.Duration
+}
+
+// EncryptionKMS is the KMS wrapping the data keys of the message encryption.
+type EncryptionKMS struct {
+	// Static wraps the data keys with the master keys in Secrets.
+	// +optional
+	Static *StaticKMS `json:"static,omitempty" protobuf:"bytes,1,opt,name=static"`
+	// AWS wraps the data keys with a key of AWS KMS.
+	// +optional
+	AWS *AWSKMS `json:"aws,omitempty" protobuf:"bytes,2,opt,name=aws"`
+	// GCP wraps the data keys with a key of Google Cloud KMS.
+	// +optional
+	GCP *GCPKMS `json:"gcp,omitempty" protobuf:"bytes,3,opt,name=gcp"`
+}
+
+// GetProviders returns the names of the configured providers.
+func (k EncryptionKMS) GetProviders() []string {
+	var result []string
+	if k.Static != nil {
+		result = append(result, "static")
+	}
+	if k.AWS != nil {
+		result = append(result, "aws")
+	}
+	if k.GCP != nil {
+		result = append(result, "gcp")
+	}
+	return result
+}
+
+// StaticKMS wraps the data keys with the AES-256 master keys in Secrets, each of them is 32 random bytes encoded in
+// base64, e.g. generated by "openssl rand -base64 32".
+type StaticKMS struct {
+	// Key refers to the secret that contains the master key wrapping the data keys.
+	Key *corev1.SecretKeySelector `json:"key" protobuf:"bytes,1,opt,name=key"`
+	// PreviousKey refers to the secret that contains the master key used before the last rotation, it's only used to
+	// unwrap the data keys of the messages written before the rotation.
+	// +optional
+	PreviousKey *corev1.SecretKeySelector `json:"previousKey,omitempty" protobuf:"bytes,2,opt,name=previousKey"`
+}
+
+// AWSKMS wraps the data keys with a symmetric key of AWS KMS.
+type AWSKMS struct {
+	// KeyID is the ID, the ARN, the alias name or the alias ARN of the KMS key, e.g. "alias/numaflow".
+	KeyID string `json:"keyId" protobuf:"bytes,1,opt,name=keyId"`
+	// Region of the KMS key, e.g. "us-west-2".
+	Region string `json:"region" protobuf:"bytes,2,opt,name=region"`
+	// Endpoint of AWS KMS, defaults to "https://kms.<region>.amazonaws.com".
+	// +optional
+	Endpoint string `json:"endpoint,omitempty" protobuf:"bytes,3,opt,name=endpoint"`
+	// AccessKeyID refers to the secret that contains the access key ID. If the access keys are not specified, the
+	// credentials are read from the environment variables "AWS_ACCESS_KEY_ID", "AWS_SECRET_ACCESS_KEY" and
+	// "AWS_SESSION_TOKEN", or obtained with the web identity token of IAM Roles for Service Accounts.
+	// +optional
+	AccessKeyID *corev1.SecretKeySelector `json:"accessKeyId,omitempty" protobuf:"bytes,4,opt,name=accessKeyId"`
+	// SecretAccessKey refers to the secret that contains the secret access key.
+	// +optional
+	SecretAccessKey *corev1.SecretKeySelector `json:"secretAccessKey,omitempty" protobuf:"bytes,5,opt,name=secretAccessKey"`
+}
+
+// GetEndpoint returns the endpoint of AWS KMS.
+func (k AWSKMS) GetEndpoint() string {
+	if k.Endpoint != "" {
+		return k.Endpoint
+	}
+	return fmt.Sprintf("https://kms.%s.amazonaws.com", k.Region)
+}
+
+// GCPKMS wraps the data keys with a symmetric key of Google Cloud KMS.
+type GCPKMS struct {
+	// KeyName is the resource name of the KMS key, e.g.
+	// "projects/my-project/locations/global/keyRings/my-ring/cryptoKeys/my-key". The primary version of the key is
+	// used for wrapping.
+	KeyName string `json:"keyName" protobuf:"bytes,1,opt,name=keyName"`
+	// Endpoint of Google Cloud KMS, defaults to "https://cloudkms.googleapis.com".
+	// +optional
+	Endpoint string `json:"endpoint,omitempty" protobuf:"bytes,2,opt,name=endpoint"`
+	// Credentials refers to the secret that contains the JSON key of a service account. If not specified, the token
+	// of the service account of the pods is obtained from the metadata server, e.g. with GKE Workload Identity.
+	// +optional
+	Credentials *corev1.SecretKeySelector `json:"credentials,omitempty" protobuf:"bytes,3,opt,name=credentials"`
+}
+
+// GetEndpoint returns the endpoint of Google Cloud KMS.
+func (k GCPKMS) GetEndpoint() string {
+	if k.Endpoint != "" {
+		return k.Endpoint
+	}
+	return DefaultGCPKMSEndpoint
+}
@@ -0,0 +1,47 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MessageSigning describes the signing of the messages written to the Inter-Step Buffers. Each message is signed by
+// the writer with a key of the pipeline and verified by the reader, the ones failing the verification are dropped,
+// so that the messages not written by the vertices of the pipeline can't be injected into the middle of it.
+// The keys are generated and rotated by the controller.
+type MessageSigning struct {
+	// RotationInterval is the interval of rotating the signing key, defaults to 24h. The rotation is disabled if it's 0.
+	// A message is rejected if it stays in a buffer for longer than two rotation intervals.
+	// +optional
+	RotationInterval *metav1.Duration `json:"rotationInterval,omitempty" protobuf:"bytes,1,opt,name=rotationInterval"`
+}
+
+func (ms *MessageSigning) GetRotationInterval() time.Duration {
+	if ms == nil || ms.RotationInterval == nil {
+		return DefaultSigningKeyRotationInterval
+	}
+	return ms.RotationInterval.Duration
+}
+
+// GenerateSigningKeysSecretName generates the name of the secret holding the message signing keys of a pipeline.
+func GenerateSigningKeysSecretName(pipelineName string) string {
+	return fmt.Sprintf("%s-signing-keys", pipelineName)
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import "fmt"
+
+type MQTTProtocolVersion string
+
+const (
+	MQTTProtocolVersion311 MQTTProtocolVersion = "3.1.1"
+	MQTTProtocolVersion5   MQTTProtocolVersion = "5"
+)
+
+type MQTTSource struct {
+	// URL of the MQTT broker, e.g. "tcp://mqtt-broker:1883", or "ssl://mqtt-broker:8883" for TLS connections.
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+	// Topics to subscribe to, the wildcards "+" and "#" are supported.
+	Topics []string `json:"topics" protobuf:"bytes,2,rep,name=topics"`
+	// ProtocolVersion could be "3.1.1" or "5", defaults to "3.1.1".
+	// +kubebuilder:validation:Enum="3.1.1";"5"
+	// +optional
+	ProtocolVersion *MQTTProtocolVersion `json:"protocolVersion,omitempty" protobuf:"bytes,3,opt,name=protocolVersion"`
+	// QoS of the subscriptions, 0 or 1, defaults to 1. With QoS 1, a message is acknowledged to the broker after it's
+	// written to the Inter-Step Buffers, the messages not acknowledged are redelivered by the broker after reconnecting.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=1
+	// +optional
+	QoS *int32 `json:"qos,omitempty" protobuf:"varint,4,opt,name=qos"`
+	// SharedSubscription makes the pods of the vertex subscribe to the topics with a shared subscription, so that
+	// each message is only delivered to one of them, defaults to true. Shared subscriptions are part of MQTT v5, and
+	// supported by most of the MQTT v3.1.1 brokers as an extension. If it's disabled, the vertex can only run one pod.
+	// +optional
+	SharedSubscription *bool `json:"sharedSubscription,omitempty" protobuf:"varint,5,opt,name=sharedSubscription"`
+	// SharedSubscriptionGroup is the group name of the shared subscription, defaults to "{namespace}-{pipeline}-{vertex}".
+	// +optional
+	SharedSubscriptionGroup string `json:"sharedSubscriptionGroup,omitempty" protobuf:"bytes,6,opt,name=sharedSubscriptionGroup"`
+	// TLS configuration for the MQTT client.
+	// +optional
+	TLS *TLS `json:"tls,omitempty" protobuf:"bytes,7,opt,name=tls"`
+	// Auth is the user name and password to connect to the broker.
+	// +optional
+	Auth *BasicAuth `json:"auth,omitempty" protobuf:"bytes,8,opt,name=auth"`
+}
+
+func (ms MQTTSource) GetProtocolVersion() MQTTProtocolVersion {
+	if ms.ProtocolVersion == nil {
+		return MQTTProtocolVersion311
+	}
+	switch *ms.ProtocolVersion {
+	case MQTTProtocolVersion311, MQTTProtocolVersion5:
+		return *ms.ProtocolVersion
+	default:
+		return MQTTProtocolVersion311
+	}
+}
+
+func (ms MQTTSource) GetQoS() byte {
+	if ms.QoS == nil || *ms.QoS != 0 {
+		return 1
+	}
+	return 0
+}
+
+func (ms MQTTSource) IsSharedSubscription() bool {
+	return ms.SharedSubscription == nil || *ms.SharedSubscription
+}
+
+// GetSharedSubscriptionGroup returns the group name of the shared subscription of a vertex.
+func (ms MQTTSource) GetSharedSubscriptionGroup(namespace, pipeline, vertex string) string {
+	if ms.SharedSubscriptionGroup != "" {
+		return ms.SharedSubscriptionGroup
+	}
+	return fmt.Sprintf("%s-%s-%s", namespace, pipeline, vertex)
+}
@@ -0,0 +1,44 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/utils/pointer"
+)
+
+func TestMQTTSource(t *testing.T) {
+	ms := MQTTSource{}
+	assert.Equal(t, MQTTProtocolVersion311, ms.GetProtocolVersion())
+	assert.Equal(t, byte(1), ms.GetQoS())
+	assert.True(t, ms.IsSharedSubscription())
+	assert.Equal(t, "ns-p-v", ms.GetSharedSubscriptionGroup("ns", "p", "v"))
+
+	v5 := MQTTProtocolVersion5
+	ms = MQTTSource{
+		ProtocolVersion:         &v5,
+		QoS:                     pointer.Int32(0),
+		SharedSubscription:      pointer.Bool(false),
+		SharedSubscriptionGroup: "group",
+	}
+	assert.Equal(t, MQTTProtocolVersion5, ms.GetProtocolVersion())
+	assert.Equal(t, byte(0), ms.GetQoS())
+	assert.False(t, ms.IsSharedSubscription())
+	assert.Equal(t, "group", ms.GetSharedSubscriptionGroup("ns", "p", "v"))
+}
@@ -30,20 +30,40 @@ import (
 
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.AWSKMS":                         schema_pkg_apis_numaflow_v1alpha1_AWSKMS(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.AbstractPodTemplate":            schema_pkg_apis_numaflow_v1alpha1_AbstractPodTemplate(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.AbstractVertex":                 schema_pkg_apis_numaflow_v1alpha1_AbstractVertex(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.AccumulatorWindow":              schema_pkg_apis_numaflow_v1alpha1_AccumulatorWindow(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.AdaptiveReadBatch":              schema_pkg_apis_numaflow_v1alpha1_AdaptiveReadBatch(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Authorization":                  schema_pkg_apis_numaflow_v1alpha1_Authorization(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.BasicAuth":                      schema_pkg_apis_numaflow_v1alpha1_BasicAuth(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Blackhole":                      schema_pkg_apis_numaflow_v1alpha1_Blackhole(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.BufferServiceConfig":            schema_pkg_apis_numaflow_v1alpha1_BufferServiceConfig(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.BurstBuffer":                    schema_pkg_apis_numaflow_v1alpha1_BurstBuffer(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Cache":                          schema_pkg_apis_numaflow_v1alpha1_Cache(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.CachePersistence":               schema_pkg_apis_numaflow_v1alpha1_CachePersistence(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ClaimCheck":                     schema_pkg_apis_numaflow_v1alpha1_ClaimCheck(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ClickHouseBatch":                schema_pkg_apis_numaflow_v1alpha1_ClickHouseBatch(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ClickHouseSink":                 schema_pkg_apis_numaflow_v1alpha1_ClickHouseSink(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.CombinedEdge":                   schema_pkg_apis_numaflow_v1alpha1_CombinedEdge(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Container":                      schema_pkg_apis_numaflow_v1alpha1_Container(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ContainerTemplate":              schema_pkg_apis_numaflow_v1alpha1_ContainerTemplate(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.CustomWindow":                   schema_pkg_apis_numaflow_v1alpha1_CustomWindow(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.DaemonTemplate":                 schema_pkg_apis_numaflow_v1alpha1_DaemonTemplate(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Degradation":                    schema_pkg_apis_numaflow_v1alpha1_Degradation(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.DegradationStatus":              schema_pkg_apis_numaflow_v1alpha1_DegradationStatus(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Edge":                           schema_pkg_apis_numaflow_v1alpha1_Edge(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.EdgeDegradation":                schema_pkg_apis_numaflow_v1alpha1_EdgeDegradation(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.EdgeMirror":                     schema_pkg_apis_numaflow_v1alpha1_EdgeMirror(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.EdgeSchema":                     schema_pkg_apis_numaflow_v1alpha1_EdgeSchema(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ElasticsearchSink":              schema_pkg_apis_numaflow_v1alpha1_ElasticsearchSink(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.EncryptionKMS":                  schema_pkg_apis_numaflow_v1alpha1_EncryptionKMS(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ExternalScalingMetric":          schema_pkg_apis_numaflow_v1alpha1_ExternalScalingMetric(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.FixedWindow":                    schema_pkg_apis_numaflow_v1alpha1_FixedWindow(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ForwardConditions":              schema_pkg_apis_numaflow_v1alpha1_ForwardConditions(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Function":                       schema_pkg_apis_numaflow_v1alpha1_Function(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.GCPKMS":                         schema_pkg_apis_numaflow_v1alpha1_GCPKMS(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.GRPCInterceptor":                schema_pkg_apis_numaflow_v1alpha1_GRPCInterceptor(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.GSSAPI":                         schema_pkg_apis_numaflow_v1alpha1_GSSAPI(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.GeneratorSource":                schema_pkg_apis_numaflow_v1alpha1_GeneratorSource(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.GetDaemonDeploymentReq":         schema_pkg_apis_numaflow_v1alpha1_GetDaemonDeploymentReq(ref),
@@ -59,17 +79,26 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.InterStepBufferServiceList":     schema_pkg_apis_numaflow_v1alpha1_InterStepBufferServiceList(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.InterStepBufferServiceSpec":     schema_pkg_apis_numaflow_v1alpha1_InterStepBufferServiceSpec(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.InterStepBufferServiceStatus":   schema_pkg_apis_numaflow_v1alpha1_InterStepBufferServiceStatus(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Interceptor":                    schema_pkg_apis_numaflow_v1alpha1_Interceptor(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.JetStreamBufferService":         schema_pkg_apis_numaflow_v1alpha1_JetStreamBufferService(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.JetStreamConfig":                schema_pkg_apis_numaflow_v1alpha1_JetStreamConfig(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.JobTemplate":                    schema_pkg_apis_numaflow_v1alpha1_JobTemplate(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Join":                           schema_pkg_apis_numaflow_v1alpha1_Join(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.KafkaSink":                      schema_pkg_apis_numaflow_v1alpha1_KafkaSink(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.KafkaSource":                    schema_pkg_apis_numaflow_v1alpha1_KafkaSource(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.LeafNode":                       schema_pkg_apis_numaflow_v1alpha1_LeafNode(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Lifecycle":                      schema_pkg_apis_numaflow_v1alpha1_Lifecycle(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Log":                            schema_pkg_apis_numaflow_v1alpha1_Log(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.MQTTSource":                     schema_pkg_apis_numaflow_v1alpha1_MQTTSource(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.MessageChecksum":                schema_pkg_apis_numaflow_v1alpha1_MessageChecksum(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.MessageEncryption":              schema_pkg_apis_numaflow_v1alpha1_MessageEncryption(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.MessageSigning":                 schema_pkg_apis_numaflow_v1alpha1_MessageSigning(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.MessageTTL":                     schema_pkg_apis_numaflow_v1alpha1_MessageTTL(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Metadata":                       schema_pkg_apis_numaflow_v1alpha1_Metadata(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.NativeRedis":                    schema_pkg_apis_numaflow_v1alpha1_NativeRedis(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.NatsAuth":                       schema_pkg_apis_numaflow_v1alpha1_NatsAuth(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.NatsSource":                     schema_pkg_apis_numaflow_v1alpha1_NatsSource(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.OTLPTraceExporter":              schema_pkg_apis_numaflow_v1alpha1_OTLPTraceExporter(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PBQStorage":                     schema_pkg_apis_numaflow_v1alpha1_PBQStorage(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PersistenceStrategy":            schema_pkg_apis_numaflow_v1alpha1_PersistenceStrategy(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Pipeline":                       schema_pkg_apis_numaflow_v1alpha1_Pipeline(ref),
@@ -77,24 +106,42 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PipelineList":                   schema_pkg_apis_numaflow_v1alpha1_PipelineList(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PipelineSpec":                   schema_pkg_apis_numaflow_v1alpha1_PipelineSpec(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PipelineStatus":                 schema_pkg_apis_numaflow_v1alpha1_PipelineStatus(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PipelineVertex":                 schema_pkg_apis_numaflow_v1alpha1_PipelineVertex(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PrometheusScalingMetric":        schema_pkg_apis_numaflow_v1alpha1_PrometheusScalingMetric(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PulsarAuth":                     schema_pkg_apis_numaflow_v1alpha1_PulsarAuth(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PulsarBatching":                 schema_pkg_apis_numaflow_v1alpha1_PulsarBatching(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PulsarSink":                     schema_pkg_apis_numaflow_v1alpha1_PulsarSink(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PulsarSource":                   schema_pkg_apis_numaflow_v1alpha1_PulsarSource(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Record":                         schema_pkg_apis_numaflow_v1alpha1_Record(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.RedisBufferService":             schema_pkg_apis_numaflow_v1alpha1_RedisBufferService(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.RedisConfig":                    schema_pkg_apis_numaflow_v1alpha1_RedisConfig(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.RedisSettings":                  schema_pkg_apis_numaflow_v1alpha1_RedisSettings(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.RedisStreamsSource":             schema_pkg_apis_numaflow_v1alpha1_RedisStreamsSource(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.RemoteUDF":                      schema_pkg_apis_numaflow_v1alpha1_RemoteUDF(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.RestartBudget":                  schema_pkg_apis_numaflow_v1alpha1_RestartBudget(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.S3Store":                        schema_pkg_apis_numaflow_v1alpha1_S3Store(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.SASL":                           schema_pkg_apis_numaflow_v1alpha1_SASL(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.SASLPlain":                      schema_pkg_apis_numaflow_v1alpha1_SASLPlain(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Scale":                          schema_pkg_apis_numaflow_v1alpha1_Scale(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ScalingMetric":                  schema_pkg_apis_numaflow_v1alpha1_ScalingMetric(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ScalingSchedule":                schema_pkg_apis_numaflow_v1alpha1_ScalingSchedule(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.SchemaRegistry":                 schema_pkg_apis_numaflow_v1alpha1_SchemaRegistry(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ShuffleStrategy":                schema_pkg_apis_numaflow_v1alpha1_ShuffleStrategy(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.SideInput":                      schema_pkg_apis_numaflow_v1alpha1_SideInput(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.SideInputTrigger":               schema_pkg_apis_numaflow_v1alpha1_SideInputTrigger(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.SideInputsManagerTemplate":      schema_pkg_apis_numaflow_v1alpha1_SideInputsManagerTemplate(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Sink":                           schema_pkg_apis_numaflow_v1alpha1_Sink(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.SlidingWindow":                  schema_pkg_apis_numaflow_v1alpha1_SlidingWindow(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Source":                         schema_pkg_apis_numaflow_v1alpha1_Source(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.StaleResources":                 schema_pkg_apis_numaflow_v1alpha1_StaleResources(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.StaticKMS":                      schema_pkg_apis_numaflow_v1alpha1_StaticKMS(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Status":                         schema_pkg_apis_numaflow_v1alpha1_Status(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.TLS":                            schema_pkg_apis_numaflow_v1alpha1_TLS(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.TagConditions":                  schema_pkg_apis_numaflow_v1alpha1_TagConditions(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Templates":                      schema_pkg_apis_numaflow_v1alpha1_Templates(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Tracing":                        schema_pkg_apis_numaflow_v1alpha1_Tracing(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Transformer":                    schema_pkg_apis_numaflow_v1alpha1_Transformer(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.TransformerStage":               schema_pkg_apis_numaflow_v1alpha1_TransformerStage(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.UDF":                            schema_pkg_apis_numaflow_v1alpha1_UDF(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.UDSink":                         schema_pkg_apis_numaflow_v1alpha1_UDSink(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.UDSource":                       schema_pkg_apis_numaflow_v1alpha1_UDSource(ref),
@@ -103,16 +150,70 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.VertexInstance":                 schema_pkg_apis_numaflow_v1alpha1_VertexInstance(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.VertexLimits":                   schema_pkg_apis_numaflow_v1alpha1_VertexLimits(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.VertexList":                     schema_pkg_apis_numaflow_v1alpha1_VertexList(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.VertexRestarts":                 schema_pkg_apis_numaflow_v1alpha1_VertexRestarts(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.VertexSpec":                     schema_pkg_apis_numaflow_v1alpha1_VertexSpec(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.VertexStatus":                   schema_pkg_apis_numaflow_v1alpha1_VertexStatus(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.VertexTemplate":                 schema_pkg_apis_numaflow_v1alpha1_VertexTemplate(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Watermark":                      schema_pkg_apis_numaflow_v1alpha1_Watermark(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.WatermarkGate":                  schema_pkg_apis_numaflow_v1alpha1_WatermarkGate(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.WebSocketSource":                schema_pkg_apis_numaflow_v1alpha1_WebSocketSource(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Window":                         schema_pkg_apis_numaflow_v1alpha1_Window(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.containerBuilder":               schema_pkg_apis_numaflow_v1alpha1_containerBuilder(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.getContainerReq":                schema_pkg_apis_numaflow_v1alpha1_getContainerReq(ref),
 	}
 }
 
+func schema_pkg_apis_numaflow_v1alpha1_AWSKMS(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AWSKMS wraps the data keys with a symmetric key of AWS KMS.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"keyId": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KeyID is the ID, the ARN, the alias name or the alias ARN of the KMS key, e.g. \"alias/numaflow\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"region": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Region of the KMS key, e.g. \"us-west-2\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"endpoint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Endpoint of AWS KMS, defaults to \"https://kms.<region>.amazonaws.com\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"accessKeyId": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AccessKeyID refers to the secret that contains the access key ID. If the access keys are not specified, the credentials are read from the environment variables \"AWS_ACCESS_KEY_ID\", \"AWS_SECRET_ACCESS_KEY\" and \"AWS_SESSION_TOKEN\", or obtained with the web identity token of IAM Roles for Service Accounts.",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"secretAccessKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretAccessKey refers to the secret that contains the secret access key.",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+				},
+				Required: []string{"keyId", "region"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.SecretKeySelector"},
+	}
+}
+
 func schema_pkg_apis_numaflow_v1alpha1_AbstractPodTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -488,12 +589,147 @@ func schema_pkg_apis_numaflow_v1alpha1_AbstractVertex(ref common.ReferenceCallba
 							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ContainerTemplate"),
 						},
 					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is the namespace where the pods and services of the vertex run, defaults to the namespace of the pipeline. The vertex still shares the ISB Service and the buffers of the pipeline. It requires the controller to be installed with the cluster scope, and the service account used by the pods to exist in the namespace.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"cache": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Cache is a local cache served by the numa container to the user defined container, it only applies to UDF vertices.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Cache"),
+						},
+					},
+					"restartBudget": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RestartBudget limits the container restarts of the pods of the vertex, the restarts are categorized by cause in the vertex status regardless of it.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.RestartBudget"),
+						},
+					},
+					"record": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Record records the input messages of the vertex to an object storage in the order they are read, so that they can be replayed through the same UDF to reproduce a problem. It only applies to UDF vertices.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Record"),
+						},
+					},
+					"leafNode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LeafNode connects the pods of the vertex to a NATS leaf node of the JetStream ISB Service instead of the ISB Service itself, e.g. a leaf node running at an edge site. The buffers and the buckets written by the vertex are kept in the JetStream domain of the leaf node, so that the vertex keeps running when the connection to the hub cluster is lost.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.LeafNode"),
+						},
+					},
+					"toPipeline": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ToPipeline connects the vertex to a vertex of another pipeline, the messages are written to the buffers of that vertex, together with the watermarks. It only applies to the non-sink vertices without outgoing edges, and the vertex of the other pipeline has to accept it in its fromPipelines.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PipelineVertex"),
+						},
+					},
+					"fromPipelines": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FromPipelines are the vertices of other pipelines allowed to write to the vertex through their toPipeline. It only applies to the non-source vertices without incoming edges.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PipelineVertex"),
+									},
+								},
+							},
+						},
+					},
+					"interceptors": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Interceptors are called in order by the forwarder of the vertex before reading, after the UDF and before writing the messages. They only apply to map UDF and sink vertices.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Interceptor"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"name"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ContainerTemplate", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Metadata", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Scale", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Sink", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Source", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.UDF", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.VertexLimits", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.Container", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.PodDNSConfig", "k8s.io/api/core/v1.PodSecurityContext", "k8s.io/api/core/v1.Toleration", "k8s.io/api/core/v1.Volume"},
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Cache", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ContainerTemplate", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Interceptor", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.LeafNode", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Metadata", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PipelineVertex", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Record", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.RestartBudget", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Scale", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Sink", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Source", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.UDF", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.VertexLimits", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.Container", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.PodDNSConfig", "k8s.io/api/core/v1.PodSecurityContext", "k8s.io/api/core/v1.Toleration", "k8s.io/api/core/v1.Volume"},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_AccumulatorWindow(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AccumulatorWindow describes a window of each key, which starts with the first message of the key and is emitted when any of the triggers fires, the next message of the key starts a new window.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"count": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Count triggers the emission when the given number of messages are accumulated for a key.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"timeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timeout triggers the emission when there's no new message for a key within the given duration, which is measured in event time and tracked by the watermark. Defaults to 1 minute.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"flush": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Flush enables the user container to trigger the emission of the keys explicitly, by serving the Trigger gRPC service along with the reduce service.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_AdaptiveReadBatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AdaptiveReadBatch makes the read batch size of a vertex adapt to the back pressure. The batch shrinks by half when writing to the to buffers fails, e.g. the buffers are full, or when processing a batch takes longer than the target latency; it grows additively when a full batch is processed in time. It applies to map UDF and sink vertices.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"min": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Min batch size, defaults to 1.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"max": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Max batch size, defaults to the read batch size.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"targetLatency": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetLatency is the target latency of processing a batch, including the UDF calls and the writes to the to buffers or the sink. Defaults to 1s.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
@@ -579,106 +815,419 @@ func schema_pkg_apis_numaflow_v1alpha1_BufferServiceConfig(ref common.ReferenceC
 	}
 }
 
-func schema_pkg_apis_numaflow_v1alpha1_CombinedEdge(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_numaflow_v1alpha1_BurstBuffer(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "CombinedEdge is a combination of Edge and some other properties such as vertex type, partitions, limits. It's used to decorate the fromEdges and toEdges of the generated Vertex objects, so that in the vertex pod, it knows the properties of the connected vertices, for example, how many partitioned buffers I should write to, what is the write buffer length, etc.",
+				Description: "BurstBuffer describes the temporary local disk buffering of a source vertex. When the writes to the inter-step buffers fail, e.g. the buffers are full or the ISB Service is under maintenance, the messages are spooled to a volume instead of blocking the source, and drained to the buffers in order once the writes succeed again.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"from": {
+					"volumeName": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "VolumeName is the name of a volume of the vertex, e.g. a PersistentVolumeClaim, which is mounted to the numa container to spool the messages. Each replica spools to a separate directory.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"to": {
+					"maxSize": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "MaxSize of the spooled messages of a replica, which is shared evenly by the partitions of the to edges. The source is blocked as before when it's reached. Defaults to 1Gi.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
 						},
 					},
-					"conditions": {
+				},
+				Required: []string{"volumeName"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_Cache(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Cache describes a local cache of a vertex, which is served by the numa container to the user defined container over a unix domain socket, so that the UDFs in any language share the same caching behavior. Each replica has its own cache, the entries are not shared across the replicas.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxEntries": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Conditional forwarding, only allowed when \"From\" is a Sink or UDF.",
-							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ForwardConditions"),
+							Description: "MaxEntries is the max number of the entries in the cache of a replica, the least recently used ones are evicted beyond it. Defaults to 10000.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"onFull": {
+					"ttl": {
 						SchemaProps: spec.SchemaProps{
-							Description: "OnFull specifies the behaviour for the write actions when the inter step buffer is full. There are currently two options, retryUntilSuccess and discardLatest. if not provided, the default value is set to \"retryUntilSuccess\"",
+							Description: "TTL of the entries, the entries never expire if it's not specified.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"persistence": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Persistence specifies a volume to save the entries, which are loaded when the container restarts.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.CachePersistence"),
+						},
+					},
+					"sideInput": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SideInput is the name of a side input of the vertex to warm the cache with, the value of which is a JSON object of the keys and the string values. The entries are reloaded when the side input is updated.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"fromVertexType": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.CachePersistence", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_CachePersistence(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CachePersistence describes how the cache entries are persisted.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"volumeName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "From vertex type.",
+							Description: "VolumeName is the name of a volume of the vertex, e.g. a PersistentVolumeClaim, which is mounted to the numa container to save the entries. Each replica saves its entries to a separate file.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"fromVertexPartitionCount": {
+					"interval": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The number of partitions of the from vertex, if not provided, the default value is set to \"1\".",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "Interval of saving the entries, defaults to 1m. The entries are also saved when the container exits.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
-					"fromVertexLimits": {
+				},
+				Required: []string{"volumeName"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_ClaimCheck(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClaimCheck describes the offloading of the large payloads.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"threshold": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.VertexLimits"),
+							Description: "Threshold of the payload size, the messages with larger payloads are offloaded to the object store. It needs to be smaller than the max payload size of the ISB Service. Defaults to 512Ki.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
 						},
 					},
-					"toVertexType": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_ClickHouseBatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClickHouseBatch describes how the messages are batched into inserts, a batch is inserted once any of the limits is reached. The messages are not acknowledged until they are inserted.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxRows": {
 						SchemaProps: spec.SchemaProps{
-							Description: "To vertex type.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "MaxRows is the max number of rows in an insert, defaults to 10000.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"toVertexPartitionCount": {
+					"maxBytes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The number of partitions of the to vertex, if not provided, the default value is set to \"1\".",
+							Description: "MaxBytes is the max total size of the payloads in an insert, defaults to 10MiB.",
 							Type:        []string{"integer"},
-							Format:      "int32",
+							Format:      "int64",
 						},
 					},
-					"toVertexLimits": {
+					"maxWait": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.VertexLimits"),
+							Description: "MaxWait is the max time to wait for more messages before inserting a batch not reaching the other limits, defaults to 1s. It should be less than the ack wait of the Inter-Step Buffer.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
 				},
-				Required: []string{"from", "to", "fromVertexType", "toVertexType"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ForwardConditions", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.VertexLimits"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
-func schema_pkg_apis_numaflow_v1alpha1_Container(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_numaflow_v1alpha1_ClickHouseSink(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Container is used to define the container properties for user defined functions, sinks, etc.",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"image": {
+					"url": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "URL of the HTTP interface of ClickHouse, e.g. \"http://clickhouse:8123\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"command": {
+					"database": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "Database of the table, defaults to \"default\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"table": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Table the JSON payloads of the messages are inserted into.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"columns": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Columns maps the columns of the table to the fields of the JSON payloads, keyed by the column names, e.g. {\"user_id\": \"user.id\"}, the nested fields are separated by dots. The columns missing from a payload get their default values. If not specified, the payloads are inserted as they are, the top level fields go to the columns with the same names.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"batch": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Batch specifies how the messages are batched into inserts.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ClickHouseBatch"),
+						},
+					},
+					"asyncInsert": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AsyncInsert enables the asynchronous inserts of ClickHouse, so that the small batches are buffered and merged by the server. The inserts still wait for the data to be flushed, and are deduplicated by the tokens derived from the message IDs if they are retried.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"tls": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TLS configuration for the client.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.TLS"),
+						},
+					},
+					"basicAuth": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BasicAuth used to authenticate the client.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.BasicAuth"),
+						},
+					},
+				},
+				Required: []string{"url", "table"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.BasicAuth", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ClickHouseBatch", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.TLS"},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_CombinedEdge(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CombinedEdge is a combination of Edge and some other properties such as vertex type, partitions, limits. It's used to decorate the fromEdges and toEdges of the generated Vertex objects, so that in the vertex pod, it knows the properties of the connected vertices, for example, how many partitioned buffers I should write to, what is the write buffer length, etc.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"from": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"to": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditional forwarding, only allowed when \"From\" is a Sink or UDF.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ForwardConditions"),
+						},
+					},
+					"onFull": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OnFull specifies the behaviour for the write actions when the inter step buffer is full. There are currently two options, retryUntilSuccess and discardLatest. if not provided, the default value is set to \"retryUntilSuccess\"",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"shuffle": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Shuffle specifies how the messages are distributed to the partitions of the to vertex, it only applies to the edges pointing to a keyed reduce vertex with multiple partitions. If not provided, the messages are distributed by hashing all the keys.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ShuffleStrategy"),
+						},
+					},
+					"messageTTL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MessageTTL specifies the max age of the messages read from the buffers of the edge, the older ones are dropped by the to vertex instead of being processed.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.MessageTTL"),
+						},
+					},
+					"weight": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Weight of the edge, when a message matches multiple edges with a weight from the same vertex, it's only forwarded to one of them, which is chosen in proportion to the weights, e.g. 90 and 10 for a canary split. The matching edges without a weight still get the message.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"priority": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Priority of the edge, when a message matches multiple edges from the same vertex, it's only forwarded to the matching edges with the highest priority. Defaults to 0.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"schema": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schema specifies the schema the payloads of the messages forwarded to the edge are validated against, the invalid messages are dropped or forwarded to an error edge.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.EdgeSchema"),
+						},
+					},
+					"mirror": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Mirror specifies a rate limited copy of the messages forwarded to the edge to be written to a vertex of another pipeline, e.g. a staging pipeline. The mirror never slows down the edge, the copies are sampled instead.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.EdgeMirror"),
+						},
+					},
+					"degradation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Degradation makes the edge a best-effort one, which is disabled or sampled when the pipeline is overloaded, according to the degradation policy of the pipeline.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.EdgeDegradation"),
+						},
+					},
+					"writeAckPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WriteAckPolicy specifies how the writes to the buffers of the edge are acknowledged, it only applies to the JetStream ISB Service. There are three options, sync, async and fireAndForget. \"sync\" waits for the acknowledgement of each message before returning, \"async\" publishes the messages of a batch with a bounded in-flight window and retries the failed ones, \"fireAndForget\" does not wait for the acknowledgements at all, the messages might be lost, so it should only be used for non-critical edges. If not provided, the default value is set to \"sync\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"strictFIFO": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StrictFIFO guarantees the messages of the edge are processed by the to vertex in the order they are written, by publishing them one after another, and by reading, processing, writing and acknowledging them in a single batch at a time with the UDF concurrency of 1. It trades the throughput for the global ordering, both the from and the to vertices need to have a single partition and at most one replica. It only applies to the JetStream ISB Service.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"fromVertexType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "From vertex type.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"fromVertexPartitionCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The number of partitions of the from vertex, if not provided, the default value is set to \"1\".",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"fromVertexLimits": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.VertexLimits"),
+						},
+					},
+					"toVertexType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "To vertex type.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"toVertexPartitionCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The number of partitions of the to vertex, if not provided, the default value is set to \"1\".",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"toVertexLimits": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.VertexLimits"),
+						},
+					},
+					"fromVertexJetStreamDomain": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The JetStream domain of the from vertex, where the buffers and the bucket of the edge are kept. Empty means the domain of the ISB Service.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"toPipeline": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ToPipeline is set for a cross-pipeline edge, whose to vertex is a vertex of another pipeline. The buffers and the bucket of the edge are kept in the ISB Service of that pipeline.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PipelineVertex"),
+						},
+					},
+					"toPipelineJetStream": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ToPipelineJetStream is the configuration of the JetStream ISB Service of the to pipeline of a cross-pipeline edge, it's only set if it's not the ISB Service of the from vertex.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.JetStreamConfig"),
+						},
+					},
+				},
+				Required: []string{"from", "to", "fromVertexType", "toVertexType"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.EdgeDegradation", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.EdgeMirror", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.EdgeSchema", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ForwardConditions", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.JetStreamConfig", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.MessageTTL", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PipelineVertex", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ShuffleStrategy", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.VertexLimits"},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_Container(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Container is used to define the container properties for user defined functions, sinks, etc.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"image": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"command": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
@@ -826,6 +1375,27 @@ func schema_pkg_apis_numaflow_v1alpha1_ContainerTemplate(ref common.ReferenceCal
 	}
 }
 
+func schema_pkg_apis_numaflow_v1alpha1_CustomWindow(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CustomWindow describes a window assigned and merged by the user container",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxLength": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxLength is the max length a window could be extended to by merging, the merges beyond it are ignored, so that a window always gets closed by the watermark. Defaults to 1 hour.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
 func schema_pkg_apis_numaflow_v1alpha1_DaemonTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -973,305 +1543,311 @@ func schema_pkg_apis_numaflow_v1alpha1_DaemonTemplate(ref common.ReferenceCallba
 	}
 }
 
-func schema_pkg_apis_numaflow_v1alpha1_Edge(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_numaflow_v1alpha1_Degradation(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "Degradation is the policy to degrade the best-effort edges of a pipeline when it's overloaded, i.e. any of the thresholds is breached, and restore them when it's healthy again. The edges to degrade, and how they are degraded, are specified by the \"degradation\" of the edges.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"from": {
-						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
-						},
-					},
-					"to": {
+					"maxPending": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "MaxPending is the max number of the pending messages of any edge, the pipeline is overloaded when it's exceeded.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"conditions": {
+					"maxLatency": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Conditional forwarding, only allowed when \"From\" is a Sink or UDF.",
-							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ForwardConditions"),
+							Description: "MaxLatency is the max watermark lag of any edge, the pipeline is overloaded when it's exceeded.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
-					"onFull": {
+					"recoveryPeriod": {
 						SchemaProps: spec.SchemaProps{
-							Description: "OnFull specifies the behaviour for the write actions when the inter step buffer is full. There are currently two options, retryUntilSuccess and discardLatest. if not provided, the default value is set to \"retryUntilSuccess\"",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "RecoveryPeriod is how long none of the thresholds is breached before the degraded edges are restored, defaults to 2m.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
 				},
-				Required: []string{"from", "to"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ForwardConditions"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
-func schema_pkg_apis_numaflow_v1alpha1_FixedWindow(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_numaflow_v1alpha1_DegradationStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "FixedWindow describes a fixed window",
+				Description: "DegradationStatus is the degradation state of a pipeline.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"length": {
+					"degraded": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+							Description: "Degraded tells if the best-effort edges of the pipeline are degraded.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason of the last transition.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastTransitionTime is the last time the pipeline was degraded or restored.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
+				Required: []string{"degraded"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_pkg_apis_numaflow_v1alpha1_ForwardConditions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_numaflow_v1alpha1_Edge(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"tags": {
+					"from": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Tags used to specify tags for conditional forwarding",
-							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.TagConditions"),
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-				},
-				Required: []string{"tags"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.TagConditions"},
-	}
-}
-
-func schema_pkg_apis_numaflow_v1alpha1_Function(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"name": {
+					"to": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"args": {
+					"conditions": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "Conditional forwarding, only allowed when \"From\" is a Sink or UDF.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ForwardConditions"),
 						},
 					},
-					"kwargs": {
+					"onFull": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "OnFull specifies the behaviour for the write actions when the inter step buffer is full. There are currently two options, retryUntilSuccess and discardLatest. if not provided, the default value is set to \"retryUntilSuccess\"",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-				},
-				Required: []string{"name"},
-			},
-		},
-	}
-}
-
-func schema_pkg_apis_numaflow_v1alpha1_GSSAPI(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "GSSAPI represents a SASL GSSAPI config",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"serviceName": {
+					"shuffle": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Shuffle specifies how the messages are distributed to the partitions of the to vertex, it only applies to the edges pointing to a keyed reduce vertex with multiple partitions. If not provided, the messages are distributed by hashing all the keys.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ShuffleStrategy"),
 						},
 					},
-					"realm": {
+					"messageTTL": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "MessageTTL specifies the max age of the messages read from the buffers of the edge, the older ones are dropped by the to vertex instead of being processed.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.MessageTTL"),
 						},
 					},
-					"usernameSecret": {
+					"weight": {
 						SchemaProps: spec.SchemaProps{
-							Description: "UsernameSecret refers to the secret that contains the username",
-							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+							Description: "Weight of the edge, when a message matches multiple edges with a weight from the same vertex, it's only forwarded to one of them, which is chosen in proportion to the weights, e.g. 90 and 10 for a canary split. The matching edges without a weight still get the message.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"authType": {
+					"priority": {
 						SchemaProps: spec.SchemaProps{
-							Description: "valid inputs - KRB5_USER_AUTH, KRB5_KEYTAB_AUTH",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Priority of the edge, when a message matches multiple edges from the same vertex, it's only forwarded to the matching edges with the highest priority. Defaults to 0.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"passwordSecret": {
+					"schema": {
 						SchemaProps: spec.SchemaProps{
-							Description: "PasswordSecret refers to the secret that contains the password",
-							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+							Description: "Schema specifies the schema the payloads of the messages forwarded to the edge are validated against, the invalid messages are dropped or forwarded to an error edge.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.EdgeSchema"),
 						},
 					},
-					"keytabSecret": {
+					"mirror": {
 						SchemaProps: spec.SchemaProps{
-							Description: "KeytabSecret refers to the secret that contains the keytab",
-							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+							Description: "Mirror specifies a rate limited copy of the messages forwarded to the edge to be written to a vertex of another pipeline, e.g. a staging pipeline. The mirror never slows down the edge, the copies are sampled instead.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.EdgeMirror"),
 						},
 					},
-					"kerberosConfigSecret": {
+					"degradation": {
 						SchemaProps: spec.SchemaProps{
-							Description: "KerberosConfigSecret refers to the secret that contains the kerberos config",
-							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+							Description: "Degradation makes the edge a best-effort one, which is disabled or sampled when the pipeline is overloaded, according to the degradation policy of the pipeline.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.EdgeDegradation"),
+						},
+					},
+					"writeAckPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WriteAckPolicy specifies how the writes to the buffers of the edge are acknowledged, it only applies to the JetStream ISB Service. There are three options, sync, async and fireAndForget. \"sync\" waits for the acknowledgement of each message before returning, \"async\" publishes the messages of a batch with a bounded in-flight window and retries the failed ones, \"fireAndForget\" does not wait for the acknowledgements at all, the messages might be lost, so it should only be used for non-critical edges. If not provided, the default value is set to \"sync\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"strictFIFO": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StrictFIFO guarantees the messages of the edge are processed by the to vertex in the order they are written, by publishing them one after another, and by reading, processing, writing and acknowledging them in a single batch at a time with the UDF concurrency of 1. It trades the throughput for the global ordering, both the from and the to vertices need to have a single partition and at most one replica. It only applies to the JetStream ISB Service.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"serviceName", "realm", "usernameSecret", "authType"},
+				Required: []string{"from", "to"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.SecretKeySelector"},
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.EdgeDegradation", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.EdgeMirror", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.EdgeSchema", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ForwardConditions", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.MessageTTL", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ShuffleStrategy"},
 	}
 }
 
-func schema_pkg_apis_numaflow_v1alpha1_GeneratorSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_numaflow_v1alpha1_EdgeDegradation(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "EdgeDegradation describes how a best-effort edge is degraded when the pipeline is overloaded.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"rpu": {
+					"action": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int64",
+							Description: "Action is how the edge is degraded, \"disable\" or \"sample\", defaults to \"disable\".",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"duration": {
+					"samplePercent": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+							Description: "SamplePercent is the percentage of the messages still forwarded to the edge with the \"sample\" action, defaults to 10.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"msgSize": {
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_EdgeMirror(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EdgeMirror describes a rate limited copy of the messages forwarded to an edge, which is written to the buffers of a vertex of another pipeline, e.g. a staging pipeline validating a new version with the production traffic.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"pipeline": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Size of each generated message",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "Pipeline is the name of the pipeline to mirror to, it needs to be in the same namespace and use the same Inter-Step Buffer Service.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"keyCount": {
+					"vertex": {
 						SchemaProps: spec.SchemaProps{
-							Description: "KeyCount is the number of unique keys in the payload",
+							Description: "Vertex is the name of the vertex of the pipeline to mirror to, the copies are written to its buffers.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"partitions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Partitions is the number of the partitions of the vertex to mirror to, defaults to 1.",
 							Type:        []string{"integer"},
 							Format:      "int32",
 						},
 					},
-					"value": {
+					"ratePerSecond": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Value is an optional uint64 value to be written in to the payload",
+							Description: "RatePerSecond is the max number of the messages mirrored per second by each pod, defaults to 100. The messages over the rate, or not accepted by the mirror target in time, are not mirrored, so that the edge is never slowed down by the mirror.",
 							Type:        []string{"integer"},
 							Format:      "int64",
 						},
 					},
 				},
+				Required: []string{"pipeline", "vertex"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
-func schema_pkg_apis_numaflow_v1alpha1_GetDaemonDeploymentReq(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_numaflow_v1alpha1_EdgeSchema(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "EdgeSchema describes the schema the payloads of the messages forwarded to an edge are validated against.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"ISBSvcType": {
+					"format": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Format of the schema, \"json\" for JSON Schema, \"avro\" or \"protobuf\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"Image": {
+					"definition": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Definition is the inline schema, for \"protobuf\" it is a base64 encoded serialized FileDescriptorSet, e.g. generated by \"protoc --include_imports --descriptor_set_out\". Either Definition or Registry is required.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"PullPolicy": {
+					"registry": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Registry specifies the schema registry to fetch the schema from when the vertex starts.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.SchemaRegistry"),
 						},
 					},
-					"Env": {
+					"messageType": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/api/core/v1.EnvVar"),
-									},
-								},
-							},
+							Description: "MessageType is the fully qualified name of the protobuf message type, it is required for \"protobuf\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"errorTo": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ErrorTo is the name of the to vertex of another edge from the same vertex, the invalid messages are forwarded to that edge instead, and the error edge only receives the invalid messages. If not provided, the invalid messages are dropped.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"ISBSvcType", "Image", "PullPolicy", "Env"},
+				Required: []string{"format"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.EnvVar"},
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.SchemaRegistry"},
 	}
 }
 
-func schema_pkg_apis_numaflow_v1alpha1_GetJetStreamServiceSpecReq(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_numaflow_v1alpha1_ElasticsearchSink(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"Labels": {
+					"urls": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "URLs of the Elasticsearch or OpenSearch nodes, e.g. \"https://elasticsearch:9200\".",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: "",
@@ -1282,167 +1858,201 @@ func schema_pkg_apis_numaflow_v1alpha1_GetJetStreamServiceSpecReq(ref common.Ref
 							},
 						},
 					},
-					"ClusterPort": {
+					"index": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int32",
+							Description: "Index is a Go template of the index name, executed with the message, e.g. \"logs-{{ index .Keys 0 }}\" or \"events-{{ .EventTime.Format \"2006.01.02\" }}\". The fields available are Keys, ID and EventTime.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"ClientPort": {
+					"bulkSize": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int32",
+							Description: "BulkSize is the max number of documents in a bulk request, defaults to 500.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"MonitorPort": {
+					"fallbackIndex": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int32",
+							Description: "FallbackIndex is the index the documents rejected with a non-retryable error (e.g. mapping errors) are routed to, the original payload is wrapped with the error. If not specified, the rejected documents are retried like the other failures.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"MetricsPort": {
+					"tls": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int32",
+							Description: "TLS configuration for the client.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.TLS"),
+						},
+					},
+					"basicAuth": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BasicAuth used to authenticate the client.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.BasicAuth"),
+						},
+					},
+					"apiKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIKey refers to the secret of a base64 encoded API key, it can not be used together with basicAuth.",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
 						},
 					},
 				},
-				Required: []string{"Labels", "ClusterPort", "ClientPort", "MonitorPort", "MetricsPort"},
+				Required: []string{"urls", "index"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.BasicAuth", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.TLS", "k8s.io/api/core/v1.SecretKeySelector"},
 	}
 }
 
-func schema_pkg_apis_numaflow_v1alpha1_GetJetStreamStatefulSetSpecReq(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_numaflow_v1alpha1_EncryptionKMS(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "EncryptionKMS is the KMS wrapping the data keys of the message encryption.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"ServiceName": {
+					"static": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Static wraps the data keys with the master keys in Secrets.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.StaticKMS"),
 						},
 					},
-					"Labels": {
+					"aws": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "AWS wraps the data keys with a key of AWS KMS.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.AWSKMS"),
 						},
 					},
-					"NatsImage": {
+					"gcp": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
-						},
-					},
-					"MetricsExporterImage": {
-						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
-						},
-					},
-					"ConfigReloaderImage": {
-						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
-						},
-					},
-					"ClusterPort": {
-						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int32",
-						},
-					},
-					"ClientPort": {
-						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int32",
-						},
-					},
-					"MonitorPort": {
-						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int32",
-						},
-					},
-					"MetricsPort": {
-						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int32",
+							Description: "GCP wraps the data keys with a key of Google Cloud KMS.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.GCPKMS"),
 						},
 					},
-					"ServerAuthSecretName": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.AWSKMS", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.GCPKMS", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.StaticKMS"},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_ExternalScalingMetric(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ExternalScalingMetric is a scaling metric read from the Kubernetes external metrics API (external.metrics.k8s.io) in the namespace of the vertex, the values of all the matching series are summed up.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"metricName": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "MetricName is the name of the external metric.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"ServerEncryptionSecretName": {
+					"selector": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Selector is the label selector of the metric series.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
 						},
 					},
-					"ConfigMapName": {
+				},
+				Required: []string{"metricName"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_FixedWindow(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "FixedWindow describes a fixed window",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"length": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
-					"PvcNameIfNeeded": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_ForwardConditions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"tags": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Tags used to specify tags for conditional forwarding",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.TagConditions"),
 						},
 					},
-					"StartCommand": {
+					"schemaVersions": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "SchemaVersions used to specify the schema versions of the messages to be forwarded, an empty string matches the messages without a schema version. If it's specified together with Tags, both of them need to be satisfied.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"ServiceName", "Labels", "NatsImage", "MetricsExporterImage", "ConfigReloaderImage", "ClusterPort", "ClientPort", "MonitorPort", "MetricsPort", "ServerAuthSecretName", "ServerEncryptionSecretName", "ConfigMapName", "PvcNameIfNeeded", "StartCommand"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.TagConditions"},
 	}
 }
 
-func schema_pkg_apis_numaflow_v1alpha1_GetRedisServiceSpecReq(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_numaflow_v1alpha1_Function(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"Labels": {
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"args": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"kwargs": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
@@ -1457,45 +2067,70 @@ func schema_pkg_apis_numaflow_v1alpha1_GetRedisServiceSpecReq(ref common.Referen
 							},
 						},
 					},
-					"RedisContainerPort": {
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_GCPKMS(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GCPKMS wraps the data keys with a symmetric key of Google Cloud KMS.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"keyName": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int32",
+							Description: "KeyName is the resource name of the KMS key, e.g. \"projects/my-project/locations/global/keyRings/my-ring/cryptoKeys/my-key\". The primary version of the key is used for wrapping.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"SentinelContainerPort": {
+					"endpoint": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int32",
+							Description: "Endpoint of Google Cloud KMS, defaults to \"https://cloudkms.googleapis.com\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"credentials": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Credentials refers to the secret that contains the JSON key of a service account. If not specified, the token of the service account of the pods is obtained from the metadata server, e.g. with GKE Workload Identity.",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
 						},
 					},
 				},
-				Required: []string{"Labels", "RedisContainerPort", "SentinelContainerPort"},
+				Required: []string{"keyName"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.SecretKeySelector"},
 	}
 }
 
-func schema_pkg_apis_numaflow_v1alpha1_GetRedisStatefulSetSpecReq(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_numaflow_v1alpha1_GRPCInterceptor(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "GRPCInterceptor is an external interceptor called over gRPC.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"ServiceName": {
+					"address": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Address of the interceptor service, e.g. \"localhost:9090\" for a sidecar.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"Labels": {
+					"hooks": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "Hooks to call, defaults to all of them. Each call adds a round trip, so only the ones implemented by the service should be listed.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: "",
@@ -1506,105 +2141,129 @@ func schema_pkg_apis_numaflow_v1alpha1_GetRedisStatefulSetSpecReq(ref common.Ref
 							},
 						},
 					},
-					"RedisImage": {
+					"timeout": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Timeout of each call, defaults to 5s.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
-					"SentinelImage": {
+				},
+				Required: []string{"address"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_GSSAPI(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GSSAPI represents a SASL GSSAPI config",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"serviceName": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"MetricsExporterImage": {
+					"realm": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"InitContainerImage": {
+					"usernameSecret": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "UsernameSecret refers to the secret that contains the username",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
 						},
 					},
-					"RedisContainerPort": {
+					"authType": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int32",
+							Description: "valid inputs - KRB5_USER_AUTH, KRB5_KEYTAB_AUTH",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"SentinelContainerPort": {
+					"passwordSecret": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int32",
+							Description: "PasswordSecret refers to the secret that contains the password",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
 						},
 					},
-					"RedisMetricsContainerPort": {
+					"keytabSecret": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int32",
+							Description: "KeytabSecret refers to the secret that contains the keytab",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
 						},
 					},
-					"CredentialSecretName": {
+					"kerberosConfigSecret": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "KerberosConfigSecret refers to the secret that contains the kerberos config",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
 						},
 					},
-					"TLSEnabled": {
+				},
+				Required: []string{"serviceName", "realm", "usernameSecret", "authType"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.SecretKeySelector"},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_GeneratorSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"rpu": {
 						SchemaProps: spec.SchemaProps{
-							Default: false,
-							Type:    []string{"boolean"},
-							Format:  "",
+							Type:   []string{"integer"},
+							Format: "int64",
 						},
 					},
-					"PvcNameIfNeeded": {
+					"duration": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
-					"ConfConfigMapName": {
+					"msgSize": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Size of each generated message",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"ScriptsConfigMapName": {
+					"keyCount": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "KeyCount is the number of unique keys in the payload",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"HealthConfigMapName": {
+					"value": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Value is an optional uint64 value to be written in to the payload",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
 				},
-				Required: []string{"ServiceName", "Labels", "RedisImage", "SentinelImage", "MetricsExporterImage", "InitContainerImage", "RedisContainerPort", "SentinelContainerPort", "RedisMetricsContainerPort", "CredentialSecretName", "TLSEnabled", "PvcNameIfNeeded", "ConfConfigMapName", "ScriptsConfigMapName", "HealthConfigMapName"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
-func schema_pkg_apis_numaflow_v1alpha1_GetSideInputDeploymentReq(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_numaflow_v1alpha1_GetDaemonDeploymentReq(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -1653,364 +2312,1440 @@ func schema_pkg_apis_numaflow_v1alpha1_GetSideInputDeploymentReq(ref common.Refe
 	}
 }
 
-func schema_pkg_apis_numaflow_v1alpha1_GetVertexPodSpecReq(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_numaflow_v1alpha1_GetJetStreamServiceSpecReq(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"ISBSvcType": {
-						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
-						},
-					},
-					"Image": {
-						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
-						},
-					},
-					"PullPolicy": {
-						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
-						},
-					},
-					"Env": {
+					"Labels": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/api/core/v1.EnvVar"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
-					"SideInputsStoreName": {
+					"ClusterPort": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
 						},
 					},
-				},
-				Required: []string{"ISBSvcType", "Image", "PullPolicy", "Env", "SideInputsStoreName"},
-			},
-		},
-		Dependencies: []string{
-			"k8s.io/api/core/v1.EnvVar"},
-	}
-}
-
-func schema_pkg_apis_numaflow_v1alpha1_GroupBy(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "GroupBy indicates it is a reducer UDF",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"window": {
+					"ClientPort": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Window describes the windowing strategy.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Window"),
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
 						},
 					},
-					"keyed": {
+					"MonitorPort": {
 						SchemaProps: spec.SchemaProps{
-							Default: false,
-							Type:    []string{"boolean"},
-							Format:  "",
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
 						},
 					},
-					"allowedLateness": {
+					"MetricsPort": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AllowedLateness allows late data to be included for the Reduce operation as long as the late data is not later than (Watermark - AllowedLateness).",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
 						},
 					},
-					"storage": {
+					"LeafNodePort": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Storage is used to define the PBQ storage for a reduce vertex.",
-							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PBQStorage"),
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
 						},
 					},
 				},
-				Required: []string{"window"},
+				Required: []string{"Labels", "ClusterPort", "ClientPort", "MonitorPort", "MetricsPort", "LeafNodePort"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PBQStorage", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Window", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
-func schema_pkg_apis_numaflow_v1alpha1_HTTPSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_numaflow_v1alpha1_GetJetStreamStatefulSetSpecReq(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"auth": {
+					"ServiceName": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Authorization"),
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"service": {
+					"Labels": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Whether to create a ClusterIP Service",
-							Default:     false,
-							Type:        []string{"boolean"},
-							Format:      "",
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Authorization"},
-	}
-}
-
-func schema_pkg_apis_numaflow_v1alpha1_InterStepBufferService(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"kind": {
+					"NatsImage": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"apiVersion": {
+					"MetricsExporterImage": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"metadata": {
+					"ConfigReloaderImage": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"spec": {
+					"ClusterPort": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.InterStepBufferServiceSpec"),
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
 						},
 					},
-					"status": {
+					"ClientPort": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.InterStepBufferServiceStatus"),
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
 						},
 					},
-				},
-				Required: []string{"spec"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.InterStepBufferServiceSpec", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.InterStepBufferServiceStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
-	}
-}
-
-func schema_pkg_apis_numaflow_v1alpha1_InterStepBufferServiceList(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "InterStepBufferServiceList is the list of InterStepBufferService resources",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"kind": {
+					"MonitorPort": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
 						},
 					},
-					"apiVersion": {
+					"MetricsPort": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
+						},
+					},
+					"ServerAuthSecretName": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"ServerEncryptionSecretName": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"ConfigMapName": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"PvcNameIfNeeded": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"StartCommand": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"LeafNodePort": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
+						},
+					},
+				},
+				Required: []string{"ServiceName", "Labels", "NatsImage", "MetricsExporterImage", "ConfigReloaderImage", "ClusterPort", "ClientPort", "MonitorPort", "MetricsPort", "ServerAuthSecretName", "ServerEncryptionSecretName", "ConfigMapName", "PvcNameIfNeeded", "StartCommand", "LeafNodePort"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_GetRedisServiceSpecReq(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"Labels": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"RedisContainerPort": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
+						},
+					},
+					"SentinelContainerPort": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
+						},
+					},
+				},
+				Required: []string{"Labels", "RedisContainerPort", "SentinelContainerPort"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_GetRedisStatefulSetSpecReq(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ServiceName": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"Labels": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"RedisImage": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"SentinelImage": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"MetricsExporterImage": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"InitContainerImage": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"RedisContainerPort": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
+						},
+					},
+					"SentinelContainerPort": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
+						},
+					},
+					"RedisMetricsContainerPort": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
+						},
+					},
+					"CredentialSecretName": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"TLSEnabled": {
+						SchemaProps: spec.SchemaProps{
+							Default: false,
+							Type:    []string{"boolean"},
+							Format:  "",
+						},
+					},
+					"PvcNameIfNeeded": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"ConfConfigMapName": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"ScriptsConfigMapName": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"HealthConfigMapName": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+				},
+				Required: []string{"ServiceName", "Labels", "RedisImage", "SentinelImage", "MetricsExporterImage", "InitContainerImage", "RedisContainerPort", "SentinelContainerPort", "RedisMetricsContainerPort", "CredentialSecretName", "TLSEnabled", "PvcNameIfNeeded", "ConfConfigMapName", "ScriptsConfigMapName", "HealthConfigMapName"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_GetSideInputDeploymentReq(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ISBSvcType": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"Image": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"PullPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"Env": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.EnvVar"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"ISBSvcType", "Image", "PullPolicy", "Env"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.EnvVar"},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_GetVertexPodSpecReq(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ISBSvcType": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"Image": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"PullPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"Env": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.EnvVar"),
+									},
+								},
+							},
+						},
+					},
+					"SideInputsStoreName": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"PackedVertices": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PackedVertices are the vertex objects of the vertices packed into the pods.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Vertex"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"ISBSvcType", "Image", "PullPolicy", "Env", "SideInputsStoreName", "PackedVertices"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Vertex", "k8s.io/api/core/v1.EnvVar"},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_GroupBy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GroupBy indicates it is a reducer UDF",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"window": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Window describes the windowing strategy.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Window"),
+						},
+					},
+					"keyed": {
+						SchemaProps: spec.SchemaProps{
+							Default: false,
+							Type:    []string{"boolean"},
+							Format:  "",
+						},
+					},
+					"allowedLateness": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AllowedLateness allows late data to be included for the Reduce operation as long as the late data is not later than (Watermark - AllowedLateness).",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"storage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Storage is used to define the PBQ storage for a reduce vertex.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PBQStorage"),
+						},
+					},
+					"join": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Join joins the messages from the two inbound edges of the vertex on their keys within each window, no user defined container is needed when it is specified.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Join"),
+						},
+					},
+				},
+				Required: []string{"window"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Join", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PBQStorage", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Window", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_HTTPSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"auth": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Authorization"),
+						},
+					},
+					"service": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Whether to create a ClusterIP Service",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Authorization"},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_InterStepBufferService(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.InterStepBufferServiceSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.InterStepBufferServiceStatus"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.InterStepBufferServiceSpec", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.InterStepBufferServiceStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_InterStepBufferServiceList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InterStepBufferServiceList is the list of InterStepBufferService resources",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.InterStepBufferService"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"metadata", "items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.InterStepBufferService", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_InterStepBufferServiceSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"redis": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.RedisBufferService"),
+						},
+					},
+					"jetstream": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.JetStreamBufferService"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.JetStreamBufferService", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.RedisBufferService"},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_InterStepBufferServiceStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "type",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions are the latest available observations of a resource's current state.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Condition"),
+									},
+								},
+							},
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"config": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.BufferServiceConfig"),
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.BufferServiceConfig", "k8s.io/apimachinery/pkg/apis/meta/v1.Condition"},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_Interceptor(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Interceptor intercepts the messages forwarded by a map or sink vertex, to add the concerns like auditing, tagging or encryption without changing the user-defined containers.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the interceptor. Without grpc, it's the name of an interceptor compiled into the numaflow image, which registers itself when the image is built with its build tag.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kwargs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KWArgs are passed to the compiled-in interceptor.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"grpc": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GRPC is an external interceptor implementing the Interceptor service defined in interceptor.proto, e.g. served by a sidecar of the vertex.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.GRPCInterceptor"),
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.GRPCInterceptor"},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_JetStreamBufferService(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JetStream version, such as \"2.7.1\"",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"replicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Redis StatefulSet size",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"containerTemplate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ContainerTemplate contains customized spec for NATS container",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ContainerTemplate"),
+						},
+					},
+					"reloaderContainerTemplate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReloaderContainerTemplate contains customized spec for config reloader container",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ContainerTemplate"),
+						},
+					},
+					"metricsContainerTemplate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MetricsContainerTemplate contains customized spec for metrics container",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ContainerTemplate"),
+						},
+					},
+					"persistence": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PersistenceStrategy"),
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Metadata sets the pods's metadata, i.e. annotations and labels",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Metadata"),
+						},
+					},
+					"nodeSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeSelector is a selector which must be true for the pod to fit on a node. Selector which must match a node's labels for the pod to be scheduled on that node. More info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"tolerations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If specified, the pod's tolerations.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.Toleration"),
+									},
+								},
+							},
+						},
+					},
+					"securityContext": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecurityContext holds pod-level security attributes and common container settings. Optional: Defaults to empty.  See type description for default values of each field.",
+							Ref:         ref("k8s.io/api/core/v1.PodSecurityContext"),
+						},
+					},
+					"imagePullSecrets": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "name",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "ImagePullSecrets is an optional list of references to secrets in the same namespace to use for pulling any of the images used by this PodSpec. If specified, these secrets will be passed to individual puller implementations for them to use. For example, in the case of docker, only DockerConfig type secrets are honored. More info: https://kubernetes.io/docs/concepts/containers/images#specifying-imagepullsecrets-on-a-pod",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.LocalObjectReference"),
+									},
+								},
+							},
+						},
+					},
+					"priorityClassName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If specified, indicates the Redis pod's priority. \"system-node-critical\" and \"system-cluster-critical\" are two special keywords which indicate the highest priorities with the former being the highest priority. Any other name must be defined by creating a PriorityClass object with that name. If not specified, the pod priority will be default or zero if there is no default. More info: https://kubernetes.io/docs/concepts/configuration/pod-priority-preemption/",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"priority": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The priority value. Various system components use this field to find the priority of the Redis pod. When Priority Admission Controller is enabled, it prevents users from setting this field. The admission controller populates this field from PriorityClassName. The higher the value, the higher the priority. More info: https://kubernetes.io/docs/concepts/configuration/pod-priority-preemption/",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"affinity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The pod's scheduling constraints More info: https://kubernetes.io/docs/concepts/scheduling-eviction/assign-pod-node/",
+							Ref:         ref("k8s.io/api/core/v1.Affinity"),
+						},
+					},
+					"serviceAccountName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServiceAccountName applied to the pod",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"runtimeClassName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RuntimeClassName refers to a RuntimeClass object in the node.k8s.io group, which should be used to run this pod.  If no RuntimeClass resource matches the named class, the pod will not be run. If unset or empty, the \"legacy\" RuntimeClass will be used, which is an implicit class with an empty definition that uses the default runtime handler. More info: https://git.k8s.io/enhancements/keps/sig-node/585-runtime-class",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"automountServiceAccountToken": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AutomountServiceAccountToken indicates whether a service account token should be automatically mounted.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"dnsPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Set DNS policy for the pod. Defaults to \"ClusterFirst\". Valid values are 'ClusterFirstWithHostNet', 'ClusterFirst', 'Default' or 'None'. DNS parameters given in DNSConfig will be merged with the policy selected with DNSPolicy. To have DNS options set along with hostNetwork, you have to specify DNS policy explicitly to 'ClusterFirstWithHostNet'.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"dnsConfig": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the DNS parameters of a pod. Parameters specified here will be merged to the generated DNS configuration based on DNSPolicy.",
+							Ref:         ref("k8s.io/api/core/v1.PodDNSConfig"),
+						},
+					},
+					"settings": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Nats/JetStream configuration, if not specified, global settings in numaflow-controller-config will be used. See https://docs.nats.io/running-a-nats-service/configuration#limits and https://docs.nats.io/running-a-nats-service/configuration#jetstream. For limits, only \"max_payload\" is supported for configuration, defaults to 1048576 (1MB), not recommended to use values over 8388608 (8MB) but max_payload can be set up to 67108864 (64MB). For jetstream, only \"max_memory_store\" and \"max_file_store\" are supported for configuration, do not set \"store_dir\" as it has been hardcoded.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"startArgs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Optional arguments to start nats-server. For example, \"-D\" to enable debugging output, \"-DV\" to enable debugging and tracing. Check https://docs.nats.io/ for all the available arguments.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"bufferConfig": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Optional configuration for the streams, consumers and buckets to be created in this JetStream service, if specified, it will be merged with the default configuration in numaflow-controller-config. It accepts a YAML format configuration, it may include 4 sections, \"stream\", \"consumer\", \"otBucket\" and \"procBucket\". Available fields under \"stream\" include \"retention\" (e.g. interest, limits, workerQueue), \"maxMsgs\", \"maxAge\" (e.g. 72h), \"replicas\" (1, 3, 5), \"duplicates\" (e.g. 5m). Available fields under \"consumer\" include \"ackWait\" (e.g. 60s) Available fields under \"otBucket\" include \"maxValueSize\", \"history\", \"ttl\" (e.g. 72h), \"maxBytes\", \"replicas\" (1, 3, 5). Available fields under \"procBucket\" include \"maxValueSize\", \"history\", \"ttl\" (e.g. 72h), \"maxBytes\", \"replicas\" (1, 3, 5).",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"encryption": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Whether encrypt the data at rest, defaults to false Enabling encryption might impact the performance, see https://docs.nats.io/running-a-nats-service/nats_admin/jetstream_admin/encryption_at_rest for the detail Toggling the value will impact encrypting/decrypting existing messages.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"tls": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Whether enable TLS, defaults to false Enabling TLS might impact the performance",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"domain": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JetStream domain of the service, required for the vertices connecting through leaf nodes, which run in their own domains. See https://docs.nats.io/running-a-nats-service/configuration/leafnodes/jetstream_leafnodes for the detail.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"leafNodes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Whether to accept the connections from NATS leaf nodes, defaults to false. The leaf nodes connect to port 7422 with the credentials of the service.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ContainerTemplate", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Metadata", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PersistenceStrategy", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.PodDNSConfig", "k8s.io/api/core/v1.PodSecurityContext", "k8s.io/api/core/v1.Toleration"},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_JetStreamConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JetStream (NATS) URL",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"auth": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.NatsAuth"),
+						},
+					},
+					"streamConfig": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"tlsEnabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TLS enabled or not",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"domain": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JetStream domain",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.NatsAuth"},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_JobTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
 					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Description: "Metadata sets the pods's metadata, i.e. annotations and labels",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Metadata"),
 						},
 					},
-					"items": {
+					"nodeSelector": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "NodeSelector is a selector which must be true for the pod to fit on a node. Selector which must match a node's labels for the pod to be scheduled on that node. More info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"tolerations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If specified, the pod's tolerations.",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.InterStepBufferService"),
+										Ref:     ref("k8s.io/api/core/v1.Toleration"),
+									},
+								},
+							},
+						},
+					},
+					"securityContext": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecurityContext holds pod-level security attributes and common container settings. Optional: Defaults to empty.  See type description for default values of each field.",
+							Ref:         ref("k8s.io/api/core/v1.PodSecurityContext"),
+						},
+					},
+					"imagePullSecrets": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "name",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "ImagePullSecrets is an optional list of references to secrets in the same namespace to use for pulling any of the images used by this PodSpec. If specified, these secrets will be passed to individual puller implementations for them to use. For example, in the case of docker, only DockerConfig type secrets are honored. More info: https://kubernetes.io/docs/concepts/containers/images#specifying-imagepullsecrets-on-a-pod",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.LocalObjectReference"),
 									},
 								},
 							},
 						},
 					},
+					"priorityClassName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If specified, indicates the Redis pod's priority. \"system-node-critical\" and \"system-cluster-critical\" are two special keywords which indicate the highest priorities with the former being the highest priority. Any other name must be defined by creating a PriorityClass object with that name. If not specified, the pod priority will be default or zero if there is no default. More info: https://kubernetes.io/docs/concepts/configuration/pod-priority-preemption/",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"priority": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The priority value. Various system components use this field to find the priority of the Redis pod. When Priority Admission Controller is enabled, it prevents users from setting this field. The admission controller populates this field from PriorityClassName. The higher the value, the higher the priority. More info: https://kubernetes.io/docs/concepts/configuration/pod-priority-preemption/",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"affinity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The pod's scheduling constraints More info: https://kubernetes.io/docs/concepts/scheduling-eviction/assign-pod-node/",
+							Ref:         ref("k8s.io/api/core/v1.Affinity"),
+						},
+					},
+					"serviceAccountName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServiceAccountName applied to the pod",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"runtimeClassName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RuntimeClassName refers to a RuntimeClass object in the node.k8s.io group, which should be used to run this pod.  If no RuntimeClass resource matches the named class, the pod will not be run. If unset or empty, the \"legacy\" RuntimeClass will be used, which is an implicit class with an empty definition that uses the default runtime handler. More info: https://git.k8s.io/enhancements/keps/sig-node/585-runtime-class",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"automountServiceAccountToken": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AutomountServiceAccountToken indicates whether a service account token should be automatically mounted.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"dnsPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Set DNS policy for the pod. Defaults to \"ClusterFirst\". Valid values are 'ClusterFirstWithHostNet', 'ClusterFirst', 'Default' or 'None'. DNS parameters given in DNSConfig will be merged with the policy selected with DNSPolicy. To have DNS options set along with hostNetwork, you have to specify DNS policy explicitly to 'ClusterFirstWithHostNet'.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"dnsConfig": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the DNS parameters of a pod. Parameters specified here will be merged to the generated DNS configuration based on DNSPolicy.",
+							Ref:         ref("k8s.io/api/core/v1.PodDNSConfig"),
+						},
+					},
+					"containerTemplate": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ContainerTemplate"),
+						},
+					},
+					"ttlSecondsAfterFinished": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ttlSecondsAfterFinished limits the lifetime of a Job that has finished execution (either Complete or Failed). If this field is set, ttlSecondsAfterFinished after the Job finishes, it is eligible to be automatically deleted. When the Job is being deleted, its lifecycle guarantees (e.g. finalizers) will be honored. If this field is unset, the Job won't be automatically deleted. If this field is set to zero, the Job becomes eligible to be deleted immediately after it finishes. Numaflow defaults to 30",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"backoffLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the number of retries before marking this job failed. More info: https://kubernetes.io/docs/concepts/workloads/controllers/job/#pod-backoff-failure-policy Numaflow defaults to 20",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 				},
-				Required: []string{"metadata", "items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.InterStepBufferService", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ContainerTemplate", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Metadata", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.PodDNSConfig", "k8s.io/api/core/v1.PodSecurityContext", "k8s.io/api/core/v1.Toleration"},
 	}
 }
 
-func schema_pkg_apis_numaflow_v1alpha1_InterStepBufferServiceSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_numaflow_v1alpha1_Join(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Join describes a keyed join of the messages from the inbound edges within a window. Each pair of the messages with the same keys from the two inbound edges is merged into one message, whose payload is a JSON object with the names of the from vertices as the fields and the original payloads as the values.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type of the join, \"inner\" or \"outer\", defaults to \"inner\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_KafkaSink(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"redis": {
+					"brokers": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.RedisBufferService"),
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"jetstream": {
+					"topic": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.JetStreamBufferService"),
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"tls": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TLS user to configure TLS connection for kafka broker TLS.enable=true default for TLS.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.TLS"),
+						},
+					},
+					"config": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"sasl": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SASL user to configure SASL connection for kafka broker SASL.enable=true default for SASL.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.SASL"),
+						},
+					},
+					"idempotent": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Idempotent enables the idempotent producer, so that the retries of the producer don't write duplicates.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"transactional": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Transactional enables the transactional producer, the messages of a batch are written in a transaction, which is committed only after the batch is acked from the inter-step buffer, so that the output is not duplicated when a sink pod restarts in the middle of a batch. It implies Idempotent.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"topic"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.JetStreamBufferService", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.RedisBufferService"},
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.SASL", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.TLS"},
 	}
 }
 
-func schema_pkg_apis_numaflow_v1alpha1_InterStepBufferServiceStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_numaflow_v1alpha1_KafkaSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"conditions": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-patch-merge-key": "type",
-								"x-kubernetes-patch-strategy":  "merge",
-							},
-						},
+					"brokers": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Conditions are the latest available observations of a resource's current state.",
-							Type:        []string{"array"},
+							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Condition"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
-					"phase": {
+					"topic": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"consumerGroup": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"message": {
+					"tls": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TLS user to configure TLS connection for kafka broker TLS.enable=true default for TLS.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.TLS"),
+						},
+					},
+					"config": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"config": {
+					"sasl": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SASL user to configure SASL connection for kafka broker SASL.enable=true default for SASL.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.SASL"),
+						},
+					},
+				},
+				Required: []string{"topic"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.SASL", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.TLS"},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_LeafNode(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LeafNode describes a NATS leaf node connected to a JetStream ISB Service.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.BufferServiceConfig"),
+							Description: "URL of the leaf node, e.g. \"nats://nats-leaf.edge.svc:4222\". The vertex pods use the credentials of the ISB Service to connect to it.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"type": {
+					"domain": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Domain is the JetStream domain of the leaf node, which must be different from the one of the ISB Service.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"url", "domain"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.BufferServiceConfig", "k8s.io/apimachinery/pkg/apis/meta/v1.Condition"},
 	}
 }
 
-func schema_pkg_apis_numaflow_v1alpha1_JetStreamBufferService(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_numaflow_v1alpha1_Lifecycle(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"version": {
-						SchemaProps: spec.SchemaProps{
-							Description: "JetStream version, such as \"2.7.1\"",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"replicas": {
+					"deleteGracePeriodSeconds": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Redis StatefulSet size",
+							Description: "DeleteGracePeriodSeconds used to delete pipeline gracefully",
 							Type:        []string{"integer"},
 							Format:      "int32",
 						},
 					},
-					"containerTemplate": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ContainerTemplate contains customized spec for NATS container",
-							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ContainerTemplate"),
-						},
-					},
-					"reloaderContainerTemplate": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ReloaderContainerTemplate contains customized spec for config reloader container",
-							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ContainerTemplate"),
-						},
-					},
-					"metricsContainerTemplate": {
+					"desiredPhase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MetricsContainerTemplate contains customized spec for metrics container",
-							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ContainerTemplate"),
+							Description: "DesiredPhase used to bring the pipeline from current phase to desired phase",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"persistence": {
+					"cleanUpDelaySeconds": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PersistenceStrategy"),
+							Description: "CleanUpDelaySeconds is the delay to clean up the ISB Service resources of the removed vertices, edges and side inputs, e.g. the buffers, the watermark buckets and the side input values, so that the pods still using them have time to terminate.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"metadata": {
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_Log(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_MQTTSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Metadata sets the pods's metadata, i.e. annotations and labels",
-							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Metadata"),
+							Description: "URL of the MQTT broker, e.g. \"tcp://mqtt-broker:1883\", or \"ssl://mqtt-broker:8883\" for TLS connections.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"nodeSelector": {
+					"topics": {
 						SchemaProps: spec.SchemaProps{
-							Description: "NodeSelector is a selector which must be true for the pod to fit on a node. Selector which must match a node's labels for the pod to be scheduled on that node. More info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "Topics to subscribe to, the wildcards \"+\" and \"#\" are supported.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: "",
@@ -2021,112 +3756,178 @@ func schema_pkg_apis_numaflow_v1alpha1_JetStreamBufferService(ref common.Referen
 							},
 						},
 					},
-					"tolerations": {
+					"protocolVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "If specified, the pod's tolerations.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/api/core/v1.Toleration"),
-									},
-								},
-							},
+							Description: "ProtocolVersion could be \"3.1.1\" or \"5\", defaults to \"3.1.1\".",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"securityContext": {
+					"qos": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SecurityContext holds pod-level security attributes and common container settings. Optional: Defaults to empty.  See type description for default values of each field.",
-							Ref:         ref("k8s.io/api/core/v1.PodSecurityContext"),
+							Description: "QoS of the subscriptions, 0 or 1, defaults to 1. With QoS 1, a message is acknowledged to the broker after it's written to the Inter-Step Buffers, the messages not acknowledged are redelivered by the broker after reconnecting.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"imagePullSecrets": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-patch-merge-key": "name",
-								"x-kubernetes-patch-strategy":  "merge",
-							},
-						},
+					"sharedSubscription": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ImagePullSecrets is an optional list of references to secrets in the same namespace to use for pulling any of the images used by this PodSpec. If specified, these secrets will be passed to individual puller implementations for them to use. For example, in the case of docker, only DockerConfig type secrets are honored. More info: https://kubernetes.io/docs/concepts/containers/images#specifying-imagepullsecrets-on-a-pod",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/api/core/v1.LocalObjectReference"),
-									},
-								},
-							},
+							Description: "SharedSubscription makes the pods of the vertex subscribe to the topics with a shared subscription, so that each message is only delivered to one of them, defaults to true. Shared subscriptions are part of MQTT v5, and supported by most of the MQTT v3.1.1 brokers as an extension. If it's disabled, the vertex can only run one pod.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"priorityClassName": {
+					"sharedSubscriptionGroup": {
 						SchemaProps: spec.SchemaProps{
-							Description: "If specified, indicates the Redis pod's priority. \"system-node-critical\" and \"system-cluster-critical\" are two special keywords which indicate the highest priorities with the former being the highest priority. Any other name must be defined by creating a PriorityClass object with that name. If not specified, the pod priority will be default or zero if there is no default. More info: https://kubernetes.io/docs/concepts/configuration/pod-priority-preemption/",
+							Description: "SharedSubscriptionGroup is the group name of the shared subscription, defaults to \"{namespace}-{pipeline}-{vertex}\".",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"priority": {
+					"tls": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The priority value. Various system components use this field to find the priority of the Redis pod. When Priority Admission Controller is enabled, it prevents users from setting this field. The admission controller populates this field from PriorityClassName. The higher the value, the higher the priority. More info: https://kubernetes.io/docs/concepts/configuration/pod-priority-preemption/",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "TLS configuration for the MQTT client.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.TLS"),
 						},
 					},
-					"affinity": {
+					"auth": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The pod's scheduling constraints More info: https://kubernetes.io/docs/concepts/scheduling-eviction/assign-pod-node/",
-							Ref:         ref("k8s.io/api/core/v1.Affinity"),
+							Description: "Auth is the user name and password to connect to the broker.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.BasicAuth"),
 						},
 					},
-					"serviceAccountName": {
+				},
+				Required: []string{"url", "topics"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.BasicAuth", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.TLS"},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_MessageChecksum(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MessageChecksum describes the checksums of the messages written to the Inter-Step Buffers. Each message is checksummed by the writer and verified by the reader, to detect the messages corrupted in the buffers, e.g. by a faulty storage of the Inter-Step Buffer Service.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"algorithm": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ServiceAccountName applied to the pod",
+							Description: "Algorithm is the checksum algorithm, \"crc32c\" or \"xxhash\", defaults to \"crc32c\".",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"runtimeClassName": {
+					"quarantine": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RuntimeClassName refers to a RuntimeClass object in the node.k8s.io group, which should be used to run this pod.  If no RuntimeClass resource matches the named class, the pod will not be run. If unset or empty, the \"legacy\" RuntimeClass will be used, which is an implicit class with an empty definition that uses the default runtime handler. More info: https://git.k8s.io/enhancements/keps/sig-node/585-runtime-class",
+							Description: "Quarantine is the policy of handling the corrupted messages, \"Drop\" or \"Redeliver\", defaults to \"Drop\".",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"automountServiceAccountToken": {
+					"maxDeliveries": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AutomountServiceAccountToken indicates whether a service account token should be automatically mounted.",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "MaxDeliveries is the max number of deliveries of a corrupted message with the \"Redeliver\" policy, before it's dropped, defaults to 3. A corrupted message is dropped at once if the Inter-Step Buffer doesn't track the deliveries.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"dnsPolicy": {
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_MessageEncryption(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MessageEncryption describes the envelope encryption of the payloads of the messages written to the Inter-Step Buffers, for the pipelines carrying sensitive data. The payload of each message is encrypted by the writer with a data key, which is wrapped by a KMS and carried in the message, and decrypted by the reader.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kms": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Set DNS policy for the pod. Defaults to \"ClusterFirst\". Valid values are 'ClusterFirstWithHostNet', 'ClusterFirst', 'Default' or 'None'. DNS parameters given in DNSConfig will be merged with the policy selected with DNSPolicy. To have DNS options set along with hostNetwork, you have to specify DNS policy explicitly to 'ClusterFirstWithHostNet'.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "KMS wraps the data keys, exactly one of the providers is required.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.EncryptionKMS"),
 						},
 					},
-					"dnsConfig": {
+					"dataKeyRotationInterval": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Specifies the DNS parameters of a pod. Parameters specified here will be merged to the generated DNS configuration based on DNSPolicy.",
-							Ref:         ref("k8s.io/api/core/v1.PodDNSConfig"),
+							Description: "DataKeyRotationInterval is the interval of generating a new data key by each writer, defaults to 1h.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
-					"settings": {
+				},
+				Required: []string{"kms"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.EncryptionKMS", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_MessageSigning(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MessageSigning describes the signing of the messages written to the Inter-Step Buffers. Each message is signed by the writer with a key of the pipeline and verified by the reader, the ones failing the verification are dropped, so that the messages not written by the vertices of the pipeline can't be injected into the middle of it. The keys are generated and rotated by the controller.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"rotationInterval": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Nats/JetStream configuration, if not specified, global settings in numaflow-controller-config will be used. See https://docs.nats.io/running-a-nats-service/configuration#limits and https://docs.nats.io/running-a-nats-service/configuration#jetstream. For limits, only \"max_payload\" is supported for configuration, defaults to 1048576 (1MB), not recommended to use values over 8388608 (8MB) but max_payload can be set up to 67108864 (64MB). For jetstream, only \"max_memory_store\" and \"max_file_store\" are supported for configuration, do not set \"store_dir\" as it has been hardcoded.",
+							Description: "RotationInterval is the interval of rotating the signing key, defaults to 24h. The rotation is disabled if it's 0. A message is rejected if it stays in a buffer for longer than two rotation intervals.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_MessageTTL(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"duration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Duration is the max age of the messages.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"basis": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Basis specifies how the age of a message is measured, could be \"eventTime\" or \"ingestTime\". if not provided, the default value is set to \"eventTime\"",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"startArgs": {
+				},
+				Required: []string{"duration"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_Metadata(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"annotations": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Optional arguments to start nats-server. For example, \"-D\" to enable debugging output, \"-DV\" to enable debugging and tracing. Check https://docs.nats.io/ for all the available arguments.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: "",
@@ -2137,80 +3938,75 @@ func schema_pkg_apis_numaflow_v1alpha1_JetStreamBufferService(ref common.Referen
 							},
 						},
 					},
-					"bufferConfig": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Optional configuration for the streams, consumers and buckets to be created in this JetStream service, if specified, it will be merged with the default configuration in numaflow-controller-config. It accepts a YAML format configuration, it may include 4 sections, \"stream\", \"consumer\", \"otBucket\" and \"procBucket\". Available fields under \"stream\" include \"retention\" (e.g. interest, limits, workerQueue), \"maxMsgs\", \"maxAge\" (e.g. 72h), \"replicas\" (1, 3, 5), \"duplicates\" (e.g. 5m). Available fields under \"consumer\" include \"ackWait\" (e.g. 60s) Available fields under \"otBucket\" include \"maxValueSize\", \"history\", \"ttl\" (e.g. 72h), \"maxBytes\", \"replicas\" (1, 3, 5). Available fields under \"procBucket\" include \"maxValueSize\", \"history\", \"ttl\" (e.g. 72h), \"maxBytes\", \"replicas\" (1, 3, 5).",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"encryption": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Whether encrypt the data at rest, defaults to false Enabling encryption might impact the performance, see https://docs.nats.io/running-a-nats-service/nats_admin/jetstream_admin/encryption_at_rest for the detail Toggling the value will impact encrypting/decrypting existing messages.",
-							Type:        []string{"boolean"},
-							Format:      "",
-						},
-					},
-					"tls": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Whether enable TLS, defaults to false Enabling TLS might impact the performance",
-							Type:        []string{"boolean"},
-							Format:      "",
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
 				},
 			},
 		},
-		Dependencies: []string{
-			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ContainerTemplate", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Metadata", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PersistenceStrategy", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.PodDNSConfig", "k8s.io/api/core/v1.PodSecurityContext", "k8s.io/api/core/v1.Toleration"},
 	}
 }
 
-func schema_pkg_apis_numaflow_v1alpha1_JetStreamConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_numaflow_v1alpha1_NativeRedis(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"url": {
+					"version": {
 						SchemaProps: spec.SchemaProps{
-							Description: "JetStream (NATS) URL",
+							Description: "Redis version, such as \"6.0.16\"",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"auth": {
+					"replicas": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.NatsAuth"),
+							Description: "Redis StatefulSet size",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"streamConfig": {
+					"redisContainerTemplate": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "RedisContainerTemplate contains customized spec for Redis container",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ContainerTemplate"),
 						},
 					},
-					"tlsEnabled": {
+					"sentinelContainerTemplate": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TLS enabled or not",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "SentinelContainerTemplate contains customized spec for Redis container",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ContainerTemplate"),
+						},
+					},
+					"metricsContainerTemplate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MetricsContainerTemplate contains customized spec for metrics container",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ContainerTemplate"),
+						},
+					},
+					"initContainerTemplate": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ContainerTemplate"),
+						},
+					},
+					"persistence": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PersistenceStrategy"),
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.NatsAuth"},
-	}
-}
-
-func schema_pkg_apis_numaflow_v1alpha1_JobTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
 					"metadata": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Metadata sets the pods's metadata, i.e. annotations and labels",
@@ -2327,43 +4123,132 @@ func schema_pkg_apis_numaflow_v1alpha1_JobTemplate(ref common.ReferenceCallback)
 							Ref:         ref("k8s.io/api/core/v1.PodDNSConfig"),
 						},
 					},
-					"containerTemplate": {
+					"settings": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ContainerTemplate"),
+							Description: "Redis configuration, if not specified, global settings in numaflow-controller-config will be used.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.RedisSettings"),
 						},
 					},
-					"ttlSecondsAfterFinished": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ContainerTemplate", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Metadata", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PersistenceStrategy", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.RedisSettings", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.PodDNSConfig", "k8s.io/api/core/v1.PodSecurityContext", "k8s.io/api/core/v1.Toleration"},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_NatsAuth(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NatsAuth defines how to authenticate the nats access",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"basic": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ttlSecondsAfterFinished limits the lifetime of a Job that has finished execution (either Complete or Failed). If this field is set, ttlSecondsAfterFinished after the Job finishes, it is eligible to be automatically deleted. When the Job is being deleted, its lifecycle guarantees (e.g. finalizers) will be honored. If this field is unset, the Job won't be automatically deleted. If this field is set to zero, the Job becomes eligible to be deleted immediately after it finishes. Numaflow defaults to 30",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "Basic auth which contains a user name and a password",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.BasicAuth"),
 						},
 					},
-					"backoffLimit": {
+					"token": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Specifies the number of retries before marking this job failed. More info: https://kubernetes.io/docs/concepts/workloads/controllers/job/#pod-backoff-failure-policy Numaflow defaults to 20",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "Token auth",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"nkey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NKey auth",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ContainerTemplate", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Metadata", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.PodDNSConfig", "k8s.io/api/core/v1.PodSecurityContext", "k8s.io/api/core/v1.Toleration"},
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.BasicAuth", "k8s.io/api/core/v1.SecretKeySelector"},
 	}
 }
 
-func schema_pkg_apis_numaflow_v1alpha1_KafkaSink(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_numaflow_v1alpha1_NatsSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"brokers": {
+					"url": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Description: "URL to connect to NATS cluster, multiple urls could be separated by comma.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"subject": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Subject holds the name of the subject onto which messages are published.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"queue": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Queue is used for queue subscription.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tls": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TLS configuration for the nats client.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.TLS"),
+						},
+					},
+					"auth": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Auth information",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.NatsAuth"),
+						},
+					},
+				},
+				Required: []string{"url", "subject", "queue"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.NatsAuth", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.TLS"},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_OTLPTraceExporter(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OTLPTraceExporter describes an OTLP/gRPC trace exporter.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"endpoint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Endpoint of the collector, in the format of host:port.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"insecure": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Insecure disables the client transport security.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"headers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Headers are sent with each export request, e.g. for authentication.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: "",
@@ -2374,832 +4259,866 @@ func schema_pkg_apis_numaflow_v1alpha1_KafkaSink(ref common.ReferenceCallback) c
 							},
 						},
 					},
-					"topic": {
+				},
+				Required: []string{"endpoint"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_PBQStorage(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PBQStorage defines the persistence configuration for a vertex.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"persistentVolumeClaim": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PersistenceStrategy"),
+						},
+					},
+					"emptyDir": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/api/core/v1.EmptyDirVolumeSource"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PersistenceStrategy", "k8s.io/api/core/v1.EmptyDirVolumeSource"},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_PersistenceStrategy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PersistenceStrategy defines the strategy of persistence",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"storageClassName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the StorageClass required by the claim. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#class-1",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"accessMode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Available access modes such as ReadWriteOnce, ReadWriteMany https://kubernetes.io/docs/concepts/storage/persistent-volumes/#access-modes",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"volumeSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Volume size, e.g. 50Gi",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_Pipeline(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"tls": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TLS user to configure TLS connection for kafka broker TLS.enable=true default for TLS.",
-							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.TLS"),
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"config": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PipelineSpec"),
 						},
 					},
-					"sasl": {
+					"status": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SASL user to configure SASL connection for kafka broker SASL.enable=true default for SASL.",
-							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.SASL"),
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PipelineStatus"),
 						},
 					},
 				},
-				Required: []string{"topic"},
+				Required: []string{"spec"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.SASL", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.TLS"},
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PipelineSpec", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PipelineStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_numaflow_v1alpha1_KafkaSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_numaflow_v1alpha1_PipelineLimits(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"brokers": {
+					"readBatchSize": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "Read batch size for all the vertices in the pipeline, can be overridden by the vertex's limit settings.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"topic": {
+					"bufferMaxLength": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "BufferMaxLength is used to define the max length of a buffer. Only applies to UDF and Source vertices as only they do buffer write. It can be overridden by the settings in vertex limits.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"consumerGroup": {
+					"bufferUsageLimit": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "BufferUsageLimit is used to define the percentage of the buffer usage limit, a valid value should be less than 100, for example, 85. Only applies to UDF and Source vertices as only they do buffer write. It will be overridden by the settings in vertex limits.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"tls": {
+					"readTimeout": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TLS user to configure TLS connection for kafka broker TLS.enable=true default for TLS.",
-							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.TLS"),
+							Description: "Read timeout for all the vertices in the pipeline, can be overridden by the vertex's limit settings",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
-					"config": {
+					"udfConcurrency": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "UDFConcurrency is the number of the messages processed concurrently by the map UDF vertices, defaults to the read batch size. It can be overridden by the vertex's limit settings.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"sasl": {
+					"retryInterval": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SASL user to configure SASL connection for kafka broker SASL.enable=true default for SASL.",
-							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.SASL"),
+							Description: "RetryInterval is the interval of retrying the failed writes to the Inter-Step Buffers or the sinks, defaults to 1ms. It can be overridden by the vertex's limit settings.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
 				},
-				Required: []string{"topic"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.SASL", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.TLS"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
-func schema_pkg_apis_numaflow_v1alpha1_Lifecycle(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_numaflow_v1alpha1_PipelineList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"deleteGracePeriodSeconds": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DeleteGracePeriodSeconds used to delete pipeline gracefully",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"desiredPhase": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DesiredPhase used to bring the pipeline from current phase to desired phase",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Pipeline"),
+									},
+								},
+							},
+						},
+					},
 				},
+				Required: []string{"items"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Pipeline", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_pkg_apis_numaflow_v1alpha1_Log(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-			},
-		},
-	}
-}
-
-func schema_pkg_apis_numaflow_v1alpha1_Metadata(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_numaflow_v1alpha1_PipelineSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"annotations": {
+					"interStepBufferServiceName": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"vertices": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "name",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.AbstractVertex"),
 									},
 								},
 							},
 						},
 					},
-					"labels": {
+					"edges": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "Edges define the relationships between vertices",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Edge"),
 									},
 								},
 							},
 						},
 					},
-				},
-			},
-		},
-	}
-}
-
-func schema_pkg_apis_numaflow_v1alpha1_NativeRedis(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"version": {
+					"lifecycle": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Redis version, such as \"6.0.16\"",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Lifecycle define the Lifecycle properties",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Lifecycle"),
 						},
 					},
-					"replicas": {
+					"limits": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Redis StatefulSet size",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "Limits define the limitations such as buffer read batch size for all the vertices of a pipeline, they could be overridden by each vertex's settings",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PipelineLimits"),
 						},
 					},
-					"redisContainerTemplate": {
+					"watermark": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RedisContainerTemplate contains customized spec for Redis container",
-							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ContainerTemplate"),
+							Description: "Watermark enables watermark progression across the entire pipeline.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Watermark"),
 						},
 					},
-					"sentinelContainerTemplate": {
+					"templates": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SentinelContainerTemplate contains customized spec for Redis container",
-							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ContainerTemplate"),
+							Description: "Templates is used to customize additional kubernetes resources required for the Pipeline",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Templates"),
 						},
 					},
-					"metricsContainerTemplate": {
+					"sideInputs": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MetricsContainerTemplate contains customized spec for metrics container",
-							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ContainerTemplate"),
+							Description: "SideInputs defines the Side Inputs of a pipeline.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.SideInput"),
+									},
+								},
+							},
 						},
 					},
-					"initContainerTemplate": {
+					"claimCheck": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ContainerTemplate"),
+							Description: "ClaimCheck offloads the payloads of the large messages to an object store of the ISB Service, and only the references are written to the buffers. Only supported with the JetStream ISB Service.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ClaimCheck"),
 						},
 					},
-					"persistence": {
+					"tracing": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PersistenceStrategy"),
+							Description: "Tracing enables the OpenTelemetry tracing of the messages flowing through the pipeline.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Tracing"),
 						},
 					},
-					"metadata": {
+					"messageSigning": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Metadata sets the pods's metadata, i.e. annotations and labels",
-							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Metadata"),
+							Description: "MessageSigning signs the messages written to the Inter-Step Buffers, and verifies them at the readers.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.MessageSigning"),
 						},
 					},
-					"nodeSelector": {
+					"podPacking": {
 						SchemaProps: spec.SchemaProps{
-							Description: "NodeSelector is a selector which must be true for the pod to fit on a node. Selector which must match a node's labels for the pod to be scheduled on that node. More info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "PodPacking runs a built-in sink in the pods of its upstream source, connected by an in-memory edge instead of an Inter-Step Buffer, to save the pods of the low-throughput pipelines. Only applies to a sink that reads from a single source, which writes to no other vertices. Neither of them may have user-defined containers. The messages in the in-memory edge are lost if the pod crashes.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"tolerations": {
-						SchemaProps: spec.SchemaProps{
-							Description: "If specified, the pod's tolerations.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/api/core/v1.Toleration"),
-									},
-								},
-							},
+					"runtimeImage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RuntimeImage overrides the Numaflow runtime image used by the vertices, the daemon and the jobs of the pipeline, which defaults to the image of the controller. It's recommended to pin it by digest, e.g. \"quay.io/numaproj/numaflow@sha256:...\", the images allowed are controlled by the runtime image policy of the cluster, which is enforced by the validating webhook.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"securityContext": {
+					"messageChecksum": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SecurityContext holds pod-level security attributes and common container settings. Optional: Defaults to empty.  See type description for default values of each field.",
-							Ref:         ref("k8s.io/api/core/v1.PodSecurityContext"),
+							Description: "MessageChecksum checksums the messages written to the Inter-Step Buffers, and verifies them at the readers.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.MessageChecksum"),
 						},
 					},
-					"imagePullSecrets": {
+					"degradation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Degradation is the policy to degrade the best-effort edges when the pipeline is overloaded, and restore them when it's healthy again.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Degradation"),
+						},
+					},
+					"messageEncryption": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MessageEncryption encrypts the payloads of the messages written to the Inter-Step Buffers, and decrypts them at the readers.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.MessageEncryption"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.AbstractVertex", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ClaimCheck", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Degradation", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Edge", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Lifecycle", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.MessageChecksum", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.MessageEncryption", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.MessageSigning", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PipelineLimits", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.SideInput", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Templates", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Tracing", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Watermark"},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_PipelineStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"conditions": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
-								"x-kubernetes-patch-merge-key": "name",
+								"x-kubernetes-patch-merge-key": "type",
 								"x-kubernetes-patch-strategy":  "merge",
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "ImagePullSecrets is an optional list of references to secrets in the same namespace to use for pulling any of the images used by this PodSpec. If specified, these secrets will be passed to individual puller implementations for them to use. For example, in the case of docker, only DockerConfig type secrets are honored. More info: https://kubernetes.io/docs/concepts/containers/images#specifying-imagepullsecrets-on-a-pod",
+							Description: "Conditions are the latest available observations of a resource's current state.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/api/core/v1.LocalObjectReference"),
+										Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Condition"),
 									},
 								},
 							},
 						},
 					},
-					"priorityClassName": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "If specified, indicates the Redis pod's priority. \"system-node-critical\" and \"system-cluster-critical\" are two special keywords which indicate the highest priorities with the former being the highest priority. Any other name must be defined by creating a PriorityClass object with that name. If not specified, the pod priority will be default or zero if there is no default. More info: https://kubernetes.io/docs/concepts/configuration/pod-priority-preemption/",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"priority": {
+					"message": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The priority value. Various system components use this field to find the priority of the Redis pod. When Priority Admission Controller is enabled, it prevents users from setting this field. The admission controller populates this field from PriorityClassName. The higher the value, the higher the priority. More info: https://kubernetes.io/docs/concepts/configuration/pod-priority-preemption/",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"affinity": {
+					"lastUpdated": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The pod's scheduling constraints More info: https://kubernetes.io/docs/concepts/scheduling-eviction/assign-pod-node/",
-							Ref:         ref("k8s.io/api/core/v1.Affinity"),
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"serviceAccountName": {
+					"vertexCount": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ServiceAccountName applied to the pod",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"integer"},
+							Format: "int64",
 						},
 					},
-					"runtimeClassName": {
+					"sourceCount": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RuntimeClassName refers to a RuntimeClass object in the node.k8s.io group, which should be used to run this pod.  If no RuntimeClass resource matches the named class, the pod will not be run. If unset or empty, the \"legacy\" RuntimeClass will be used, which is an implicit class with an empty definition that uses the default runtime handler. More info: https://git.k8s.io/enhancements/keps/sig-node/585-runtime-class",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"integer"},
+							Format: "int64",
 						},
 					},
-					"automountServiceAccountToken": {
+					"sinkCount": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AutomountServiceAccountToken indicates whether a service account token should be automatically mounted.",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Type:   []string{"integer"},
+							Format: "int64",
 						},
 					},
-					"dnsPolicy": {
+					"udfCount": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Set DNS policy for the pod. Defaults to \"ClusterFirst\". Valid values are 'ClusterFirstWithHostNet', 'ClusterFirst', 'Default' or 'None'. DNS parameters given in DNSConfig will be merged with the policy selected with DNSPolicy. To have DNS options set along with hostNetwork, you have to specify DNS policy explicitly to 'ClusterFirstWithHostNet'.",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"integer"},
+							Format: "int64",
 						},
 					},
-					"dnsConfig": {
+					"degradation": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Specifies the DNS parameters of a pod. Parameters specified here will be merged to the generated DNS configuration based on DNSPolicy.",
-							Ref:         ref("k8s.io/api/core/v1.PodDNSConfig"),
+							Description: "Degradation is the degradation state of the pipeline, only available with a degradation policy.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.DegradationStatus"),
 						},
 					},
-					"settings": {
+					"staleResources": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Redis configuration, if not specified, global settings in numaflow-controller-config will be used.",
-							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.RedisSettings"),
+							Description: "StaleResources are the ISB Service resources of the removed vertices, edges and side inputs, waiting to be cleaned up.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.StaleResources"),
+									},
+								},
+							},
+						},
+					},
+					"watermarkTimeUnit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WatermarkTimeUnit is the time unit of the watermarks the pipeline is deployed with, the timeUnit of the watermark spec can not be changed once it's recorded.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ContainerTemplate", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Metadata", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PersistenceStrategy", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.RedisSettings", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.PodDNSConfig", "k8s.io/api/core/v1.PodSecurityContext", "k8s.io/api/core/v1.Toleration"},
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.DegradationStatus", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.StaleResources", "k8s.io/apimachinery/pkg/apis/meta/v1.Condition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_pkg_apis_numaflow_v1alpha1_NatsAuth(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_numaflow_v1alpha1_PipelineVertex(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "NatsAuth defines how to authenticate the nats access",
+				Description: "PipelineVertex is a reference to a vertex of another pipeline, which is connected to a vertex of the pipeline through a cross-pipeline edge.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"basic": {
+					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Basic auth which contains a user name and a password",
-							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.BasicAuth"),
+							Description: "Namespace of the pipeline, defaults to the namespace of the pipeline referring to it.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"token": {
+					"pipeline": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Token auth",
-							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+							Description: "Pipeline is the name of the pipeline.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"nkey": {
+					"vertex": {
 						SchemaProps: spec.SchemaProps{
-							Description: "NKey auth",
-							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+							Description: "Vertex is the name of the vertex of the pipeline.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"pipeline", "vertex"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.BasicAuth", "k8s.io/api/core/v1.SecretKeySelector"},
 	}
 }
 
-func schema_pkg_apis_numaflow_v1alpha1_NatsSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_numaflow_v1alpha1_PrometheusScalingMetric(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "PrometheusScalingMetric is a scaling metric queried from Prometheus.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"url": {
-						SchemaProps: spec.SchemaProps{
-							Description: "URL to connect to NATS cluster, multiple urls could be separated by comma.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"subject": {
+					"serverURL": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Subject holds the name of the subject onto which messages are published.",
+							Description: "ServerURL is the address of the Prometheus server, e.g. http://prometheus.monitoring.svc:9090.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"queue": {
+					"query": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Queue is used for queue subscription.",
+							Description: "Query is the PromQL query, which must return a scalar or a vector with exactly one sample.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"tls": {
-						SchemaProps: spec.SchemaProps{
-							Description: "TLS configuration for the nats client.",
-							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.TLS"),
-						},
-					},
-					"auth": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Auth information",
-							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.NatsAuth"),
-						},
-					},
 				},
-				Required: []string{"url", "subject", "queue"},
+				Required: []string{"serverURL", "query"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.NatsAuth", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.TLS"},
 	}
 }
 
-func schema_pkg_apis_numaflow_v1alpha1_PBQStorage(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_numaflow_v1alpha1_PulsarAuth(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "PBQStorage defines the persistence configuration for a vertex.",
+				Description: "PulsarAuth defines how to authenticate with the Pulsar cluster",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"persistentVolumeClaim": {
-						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PersistenceStrategy"),
-						},
-					},
-					"emptyDir": {
+					"token": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/api/core/v1.EmptyDirVolumeSource"),
+							Description: "Token auth",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PersistenceStrategy", "k8s.io/api/core/v1.EmptyDirVolumeSource"},
+			"k8s.io/api/core/v1.SecretKeySelector"},
 	}
 }
 
-func schema_pkg_apis_numaflow_v1alpha1_PersistenceStrategy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_numaflow_v1alpha1_PulsarBatching(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "PersistenceStrategy defines the strategy of persistence",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"storageClassName": {
+					"disabled": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name of the StorageClass required by the claim. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#class-1",
-							Type:        []string{"string"},
+							Description: "Disabled is used to disable the batching of the producer.",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"accessMode": {
+					"maxMessages": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Available access modes such as ReadWriteOnce, ReadWriteMany https://kubernetes.io/docs/concepts/storage/persistent-volumes/#access-modes",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "MaxMessages is the max number of messages in a batch, defaults to 1000.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"volumeSize": {
+					"maxPublishDelay": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Volume size, e.g. 50Gi",
-							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+							Description: "MaxPublishDelay is the max time to wait before a batch is sent, defaults to 10ms.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
-func schema_pkg_apis_numaflow_v1alpha1_Pipeline(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_numaflow_v1alpha1_PulsarSink(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"serviceURL": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "ServiceURL of the Pulsar cluster, e.g. \"pulsar://pulsar-broker:6650\".",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"topic": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Topic to produce messages to. For partitioned topics, the messages with keys are routed to the partitions by the hash of the keys, and the messages without keys are distributed in a round-robin manner.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"tls": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "TLS configuration for the pulsar client.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.TLS"),
 						},
 					},
-					"spec": {
+					"auth": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PipelineSpec"),
+							Description: "Auth information",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PulsarAuth"),
 						},
 					},
-					"status": {
+					"batching": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PipelineStatus"),
+							Description: "Batching configuration of the producer, batching is enabled by default.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PulsarBatching"),
 						},
 					},
 				},
-				Required: []string{"spec"},
+				Required: []string{"serviceURL", "topic"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PipelineSpec", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PipelineStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PulsarAuth", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PulsarBatching", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.TLS"},
 	}
 }
 
-func schema_pkg_apis_numaflow_v1alpha1_PipelineLimits(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_numaflow_v1alpha1_PulsarSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"readBatchSize": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Read batch size for all the vertices in the pipeline, can be overridden by the vertex's limit settings.",
-							Type:        []string{"integer"},
-							Format:      "int64",
-						},
-					},
-					"bufferMaxLength": {
-						SchemaProps: spec.SchemaProps{
-							Description: "BufferMaxLength is used to define the max length of a buffer. Only applies to UDF and Source vertices as only they do buffer write. It can be overridden by the settings in vertex limits.",
-							Type:        []string{"integer"},
-							Format:      "int64",
-						},
-					},
-					"bufferUsageLimit": {
+					"serviceURL": {
 						SchemaProps: spec.SchemaProps{
-							Description: "BufferUsageLimit is used to define the percentage of the buffer usage limit, a valid value should be less than 100, for example, 85. Only applies to UDF and Source vertices as only they do buffer write. It will be overridden by the settings in vertex limits.",
-							Type:        []string{"integer"},
-							Format:      "int64",
+							Description: "ServiceURL of the Pulsar cluster, e.g. \"pulsar://pulsar-broker:6650\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"readTimeout": {
+					"topic": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Read timeout for all the vertices in the pipeline, can be overridden by the vertex's limit settings",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+							Description: "Topic to consume messages from, partitioned topics are supported.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
-	}
-}
-
-func schema_pkg_apis_numaflow_v1alpha1_PipelineList(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"kind": {
+					"subscriptionName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "SubscriptionName is the name of the subscription shared by all the pods of the vertex.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"subscriptionType": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "SubscriptionType could be \"shared\" or \"failover\". if not provided, the default value is set to \"shared\"",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"tls": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Description: "TLS configuration for the pulsar client.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.TLS"),
 						},
 					},
-					"items": {
+					"auth": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Pipeline"),
-									},
-								},
-							},
+							Description: "Auth information",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PulsarAuth"),
 						},
 					},
 				},
-				Required: []string{"items"},
+				Required: []string{"serviceURL", "topic", "subscriptionName"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Pipeline", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PulsarAuth", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.TLS"},
 	}
 }
 
-func schema_pkg_apis_numaflow_v1alpha1_PipelineSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_numaflow_v1alpha1_Record(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "Record describes a bounded recording of the input messages of a vertex. Each pod writes the batches it reads from each partition, in the exact order, to an object storage, until any of the limits is reached. The recordings can be fed back through the same UDF image with \"numaflow replay\".",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"interStepBufferServiceName": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"vertices": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-patch-merge-key": "name",
-								"x-kubernetes-patch-strategy":  "merge",
-							},
-						},
-						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.AbstractVertex"),
-									},
-								},
-							},
-						},
-					},
-					"edges": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Edges define the relationships between vertices",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Edge"),
-									},
-								},
-							},
-						},
-					},
-					"lifecycle": {
+					"s3": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Lifecycle define the Lifecycle properties",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Lifecycle"),
+							Description: "S3 is the S3 compatible object storage the recordings are written to.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.S3Store"),
 						},
 					},
-					"limits": {
+					"maxMessages": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Limits define the limitations such as buffer read batch size for all the vertices of a pipeline, they could be overridden by each vertex's settings",
-							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PipelineLimits"),
+							Description: "MaxMessages is the max number of the messages recorded by each pod, defaults to 10000.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"watermark": {
+					"duration": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Watermark enables watermark progression across the entire pipeline.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Watermark"),
+							Description: "Duration is the max time each pod records for since it starts, defaults to 10m.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
-					"templates": {
+				},
+				Required: []string{"s3"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.S3Store", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_RedisBufferService(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"native": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Templates is used to customize additional kubernetes resources required for the Pipeline",
-							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Templates"),
+							Description: "Native brings up a native Redis service",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.NativeRedis"),
 						},
 					},
-					"sideInputs": {
+					"external": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SideInputs defines the Side Inputs of a pipeline.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.SideInput"),
-									},
-								},
-							},
+							Description: "External holds an External Redis config",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.RedisConfig"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.AbstractVertex", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Edge", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Lifecycle", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PipelineLimits", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.SideInput", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Templates", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Watermark"},
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.NativeRedis", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.RedisConfig"},
 	}
 }
 
-func schema_pkg_apis_numaflow_v1alpha1_PipelineStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_numaflow_v1alpha1_RedisConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"conditions": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-patch-merge-key": "type",
-								"x-kubernetes-patch-strategy":  "merge",
-							},
-						},
+					"url": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Conditions are the latest available observations of a resource's current state.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Condition"),
-									},
-								},
-							},
+							Description: "Redis URL",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"phase": {
+					"sentinelUrl": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Sentinel URL, will be ignored if Redis URL is provided",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"message": {
+					"masterName": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Only required when Sentinel is used",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"lastUpdated": {
+					"user": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "Redis user",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"vertexCount": {
+					"password": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int64",
+							Description: "Redis password secret selector",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
 						},
 					},
-					"sourceCount": {
+					"sentinelPassword": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int64",
+							Description: "Sentinel password secret selector",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
 						},
 					},
-					"sinkCount": {
+					"tls": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int64",
+							Description: "TLS configuration for the connections to Redis and Sentinel",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.TLS"),
 						},
 					},
-					"udfCount": {
+					"sentinelUser": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int64",
+							Description: "Sentinel user, for Sentinel ACL authentication",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Condition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.TLS", "k8s.io/api/core/v1.SecretKeySelector"},
 	}
 }
 
-func schema_pkg_apis_numaflow_v1alpha1_RedisBufferService(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_numaflow_v1alpha1_RedisSettings(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"native": {
+					"redis": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Native brings up a native Redis service",
-							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.NativeRedis"),
+							Description: "Redis settings shared by both master and slaves, will override the global settings from controller config",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"external": {
+					"master": {
 						SchemaProps: spec.SchemaProps{
-							Description: "External holds an External Redis config",
-							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.RedisConfig"),
+							Description: "Special settings for Redis master node, will override the global settings from controller config",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"replica": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Special settings for Redis replica nodes, will override the global settings from controller config",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"sentinel": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Sentinel settings, will override the global settings from controller config",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
 			},
 		},
-		Dependencies: []string{
-			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.NativeRedis", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.RedisConfig"},
 	}
 }
 
-func schema_pkg_apis_numaflow_v1alpha1_RedisConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_numaflow_v1alpha1_RedisStreamsSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -3239,51 +5158,117 @@ func schema_pkg_apis_numaflow_v1alpha1_RedisConfig(ref common.ReferenceCallback)
 							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
 						},
 					},
-					"sentinelPassword": {
+					"sentinelPassword": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Sentinel password secret selector",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"tls": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TLS configuration for the connections to Redis and Sentinel",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.TLS"),
+						},
+					},
+					"sentinelUser": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Sentinel user, for Sentinel ACL authentication",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"stream": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"consumerGroup": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"readFromBeginning": {
+						SchemaProps: spec.SchemaProps{
+							Description: "if true, stream starts being read from the beginning; otherwise, the latest",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"eventTimeField": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EventTimeField is the field of the entries holding the event time, either in epoch milliseconds or in RFC3339 format. The time in the entry ID is used as the event time if it's not specified, or the field is missing or invalid.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"claimMinIdleTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Sentinel password secret selector",
-							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+							Description: "ClaimMinIdleTime is the min time that an entry is pending in the consumer group before it's claimed by another consumer, so that the entries delivered to a replica which is gone, e.g. after scaling down, are redelivered. Defaults to 5m, \"0s\" disables it.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
 				},
+				Required: []string{"stream", "consumerGroup", "readFromBeginning"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.SecretKeySelector"},
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.TLS", "k8s.io/api/core/v1.SecretKeySelector", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
-func schema_pkg_apis_numaflow_v1alpha1_RedisSettings(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_numaflow_v1alpha1_RemoteUDF(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "RemoteUDF describes a UDF served remotely over TCP.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"redis": {
+					"endpoint": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Redis settings shared by both master and slaves, will override the global settings from controller config",
+							Description: "Endpoint is the address of the remote gRPC server, in the format of \"host:port\".",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"master": {
+					"tls": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Special settings for Redis master node, will override the global settings from controller config",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "TLS configures the TLS connection to the remote server, the client cert and key enable the mutual TLS. The connection is not encrypted if it is not specified.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.TLS"),
 						},
 					},
-					"replica": {
+				},
+				Required: []string{"endpoint"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.TLS"},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_RestartBudget(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RestartBudget limits the container restarts of the pods of a vertex, so that a crash-looping vertex is reported, and optionally halted to preserve the failed pods for debugging.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxRestarts": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Special settings for Redis replica nodes, will override the global settings from controller config",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "MaxRestarts is the max number of the container restarts of all the pods of the vertex, defaults to 10.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"sentinel": {
+					"halt": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Sentinel settings, will override the global settings from controller config",
-							Type:        []string{"string"},
+							Description: "Halt the scaling and the pod recreation of the vertex once the budget is exceeded, so that the failed pods and the logs of their previous containers are kept. Edit the vertex spec, e.g. raise the budget or set it to false, to resume. If false, exceeding the budget is only surfaced in the status and as an event.",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
@@ -3293,81 +5278,62 @@ func schema_pkg_apis_numaflow_v1alpha1_RedisSettings(ref common.ReferenceCallbac
 	}
 }
 
-func schema_pkg_apis_numaflow_v1alpha1_RedisStreamsSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_numaflow_v1alpha1_S3Store(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "S3Store describes a bucket of an S3 compatible object storage, e.g. AWS S3 or MinIO. The objects are addressed in the path style.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"url": {
+					"endpoint": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Redis URL",
+							Description: "Endpoint of the object storage, e.g. \"https://s3.us-west-2.amazonaws.com\" or \"http://minio:9000\".",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"sentinelUrl": {
+					"bucket": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Sentinel URL, will be ignored if Redis URL is provided",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"masterName": {
+					"prefix": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Only required when Sentinel is used",
+							Description: "Prefix of the keys of the objects, defaults to \"<namespace>/<pipeline>/<vertex>\".",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"user": {
+					"region": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Redis user",
+							Description: "Region of the bucket, defaults to \"us-east-1\".",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"password": {
+					"accessKeyId": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Redis password secret selector",
+							Description: "AccessKeyID refers to the secret that contains the access key ID.",
 							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
 						},
 					},
-					"sentinelPassword": {
+					"secretAccessKey": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Sentinel password secret selector",
+							Description: "SecretAccessKey refers to the secret that contains the secret access key.",
 							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
 						},
 					},
-					"stream": {
-						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
-						},
-					},
-					"consumerGroup": {
-						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
-						},
-					},
-					"readFromBeginning": {
-						SchemaProps: spec.SchemaProps{
-							Description: "if true, stream starts being read from the beginning; otherwise, the latest",
-							Default:     false,
-							Type:        []string{"boolean"},
-							Format:      "",
-						},
-					},
 					"tls": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.TLS"),
+							Description: "TLS configuration for the client.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.TLS"),
 						},
 					},
 				},
-				Required: []string{"stream", "consumerGroup", "readFromBeginning"},
+				Required: []string{"endpoint", "bucket", "accessKeyId", "secretAccessKey"},
 			},
 		},
 		Dependencies: []string{
@@ -3492,25 +5458,265 @@ func schema_pkg_apis_numaflow_v1alpha1_Scale(ref common.ReferenceCallback) commo
 							Format:      "int64",
 						},
 					},
-					"targetProcessingSeconds": {
+					"targetProcessingSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetProcessingSeconds is used to tune the aggressiveness of autoscaling for source vertices, it measures how fast you want the vertex to process all the pending messages. Typically increasing the value, which leads to lower processing rate, thus less replicas. It's only effective for source vertices.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"targetBufferAvailability": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetBufferAvailability is used to define the target percentage of the buffer availability. A valid and meaningful value should be less than the BufferUsageLimit defined in the Edge spec (or Pipeline spec), for example, 50. It only applies to UDF and Sink vertices because only they have buffers to read.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"replicasPerScale": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReplicasPerScale defines maximum replicas can be scaled up or down at once. The is use to prevent too aggressive scaling operations",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"metrics": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Metrics are the additional signals to scale on, besides the pending messages and the processing rate.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ScalingMetric"),
+									},
+								},
+							},
+						},
+					},
+					"metricsPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MetricsPolicy is how the replicas desired by the builtin and the custom metrics are combined, \"max\" or \"weighted\", defaults to \"max\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"builtinMetricsWeight": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BuiltinMetricsWeight is the weight of the replicas desired by the builtin metrics, i.e. the pending messages and the processing rate, with the \"weighted\" metrics policy, defaults to 1.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"schedules": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schedules are the recurring time windows with raised minimum replicas, for example, to pre-scale the vertex before a known daily traffic peak.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ScalingSchedule"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ScalingMetric", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ScalingSchedule"},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_ScalingMetric(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ScalingMetric is an additional signal to autoscale a vertex on. The replicas desired by the metric is proportional to its value against the target value, i.e. ceil(currentReplicas * value / targetValue).",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the metric, used in the scaling decisions.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"prometheus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Prometheus gets the value of the metric with a PromQL query.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PrometheusScalingMetric"),
+						},
+					},
+					"external": {
+						SchemaProps: spec.SchemaProps{
+							Description: "External gets the value of the metric from the Kubernetes external metrics API.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ExternalScalingMetric"),
+						},
+					},
+					"targetValue": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetValue is the value of the metric expected to be handled by one replica.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"weight": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Weight of the metric with the \"weighted\" metrics policy, defaults to 1.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"name", "targetValue"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ExternalScalingMetric", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PrometheusScalingMetric", "k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_ScalingSchedule(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ScalingSchedule is a recurring time window during which the vertex is kept at no less than a given number of replicas, it's used to pre-scale a vertex before a known traffic peak. The reactive autoscaling still works above the floor.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the schedule, used in the scaling decisions.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"start": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Start is the standard cron expression of the start of the window, for example, \"30 8 * * 1-5\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"duration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Duration of the window.",
+							Default:     0,
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"timezone": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timezone of the cron expression, for example, \"America/New_York\", defaults to UTC.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"min": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Min is the minimum replicas during the window, it can not be greater than the max replicas of the vertex.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"name", "start", "duration", "min"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_SchemaRegistry(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SchemaRegistry describes a schema in a Confluent compatible schema registry.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL of the schema registry, e.g. http://schema-registry:8081.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"subject": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Subject of the schema.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version of the schema, defaults to the latest version.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"basicAuth": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BasicAuth for the schema registry.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.BasicAuth"),
+						},
+					},
+					"tls": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TLS configuration for the schema registry.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.TLS"),
+						},
+					},
+				},
+				Required: []string{"url", "subject"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.BasicAuth", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.TLS"},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_ShuffleStrategy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TargetProcessingSeconds is used to tune the aggressiveness of autoscaling for source vertices, it measures how fast you want the vertex to process all the pending messages. Typically increasing the value, which leads to lower processing rate, thus less replicas. It's only effective for source vertices.",
-							Type:        []string{"integer"},
-							Format:      "int64",
+							Description: "Type of the shuffle strategy, could be \"hash\", \"consistentHash\", \"roundRobin\" or \"header\". \"roundRobin\" and \"header\" do not keep the key affinity, they should only be used when the reduce function is able to tolerate the same key being processed in different partitions. if not provided, the default value is set to \"hash\"",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"targetBufferAvailability": {
+					"keyIndexes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TargetBufferAvailability is used to define the target percentage of the buffer availability. A valid and meaningful value should be less than the BufferUsageLimit defined in the Edge spec (or Pipeline spec), for example, 50. It only applies to UDF and Sink vertices because only they have buffers to read.",
-							Type:        []string{"integer"},
-							Format:      "int64",
+							Description: "KeyIndexes specifies the indexes of the message keys used to calculate the hash, all the keys are used if not provided. Indexes out of the range of the message keys are ignored.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: 0,
+										Type:    []string{"integer"},
+										Format:  "int32",
+									},
+								},
+							},
 						},
 					},
-					"replicasPerScale": {
+					"headerName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ReplicasPerScale defines maximum replicas can be scaled up or down at once. The is use to prevent too aggressive scaling operations",
-							Type:        []string{"integer"},
-							Format:      "int64",
+							Description: "HeaderName is the name of the message header used to calculate the hash, required by the \"header\" type. The messages without the header are all written to the same partition.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
@@ -3766,11 +5972,32 @@ func schema_pkg_apis_numaflow_v1alpha1_Sink(ref common.ReferenceCallback) common
 							Ref: ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.UDSink"),
 						},
 					},
+					"pulsar": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PulsarSink"),
+						},
+					},
+					"elasticsearch": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ElasticsearchSink"),
+						},
+					},
+					"watermarkGate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WatermarkGate holds the messages in the sink until the watermark passes their event times, so that the results of the windows of an upstream reduce vertex are only emitted once the windows are final.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.WatermarkGate"),
+						},
+					},
+					"clickhouse": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ClickHouseSink"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Blackhole", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.KafkaSink", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Log", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.UDSink"},
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Blackhole", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ClickHouseSink", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ElasticsearchSink", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.KafkaSink", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Log", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PulsarSink", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.UDSink", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.WatermarkGate"},
 	}
 }
 
@@ -3840,11 +6067,156 @@ func schema_pkg_apis_numaflow_v1alpha1_Source(ref common.ReferenceCallback) comm
 							Ref: ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.UDSource"),
 						},
 					},
+					"pulsar": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PulsarSource"),
+						},
+					},
+					"webSocket": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.WebSocketSource"),
+						},
+					},
+					"mqtt": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.MQTTSource"),
+						},
+					},
+					"transformers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Transformers is an ordered chain of source transformers, the output of a stage is the input of the next one, and the output of the last stage is assigned the watermark and forwarded. It can not be used together with the single \"transformer\".",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.TransformerStage"),
+									},
+								},
+							},
+						},
+					},
+					"burstBuffer": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BurstBuffer enables spooling the messages to a local volume when the writes to the inter-step buffers fail, so that a push source like HTTP keeps accepting the traffic during a short ISB Service maintenance window.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.BurstBuffer"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.BurstBuffer", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.GeneratorSource", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.HTTPSource", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.KafkaSource", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.MQTTSource", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.NatsSource", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PulsarSource", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.RedisStreamsSource", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.TransformerStage", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.UDSource", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.UDTransformer", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.WebSocketSource"},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_StaleResources(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "StaleResources describes the ISB Service resources no longer used by a pipeline, which are cleaned up after the clean up delay of the pipeline.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"buffers": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"buckets": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"sideInputs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SideInputs are the names of the removed side inputs, whose values are deleted from the side inputs store.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"jetStreamDomains": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JetStreamDomains are the JetStream domains of the buffers and buckets not in the domain of the ISB Service.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"removedAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RemovedAt is the time when the resources were found not used anymore.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"removedAt"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_StaticKMS(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "StaticKMS wraps the data keys with the AES-256 master keys in Secrets, each of them is 32 random bytes encoded in base64, e.g. generated by \"openssl rand -base64 32\".",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"key": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Key refers to the secret that contains the master key wrapping the data keys.",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"previousKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PreviousKey refers to the secret that contains the master key used before the last rotation, it's only used to unwrap the data keys of the messages written before the rotation.",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
 				},
+				Required: []string{"key"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.GeneratorSource", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.HTTPSource", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.KafkaSource", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.NatsSource", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.RedisStreamsSource", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.UDSource", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.UDTransformer"},
+			"k8s.io/api/core/v1.SecretKeySelector"},
 	}
 }
 
@@ -3994,6 +6366,34 @@ func schema_pkg_apis_numaflow_v1alpha1_Templates(ref common.ReferenceCallback) c
 	}
 }
 
+func schema_pkg_apis_numaflow_v1alpha1_Tracing(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Tracing describes the OpenTelemetry tracing of a pipeline.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"otlp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OTLP exports the spans to an OpenTelemetry collector, Jaeger or Tempo through OTLP/gRPC.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.OTLPTraceExporter"),
+						},
+					},
+					"samplingPercentage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SamplingPercentage is the percentage of the traces started at the sources to be sampled, the messages carrying a trace context follow the sampling decision of their parents. Defaults to 100.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.OTLPTraceExporter"},
+	}
+}
+
 func schema_pkg_apis_numaflow_v1alpha1_Transformer(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -4043,6 +6443,54 @@ func schema_pkg_apis_numaflow_v1alpha1_Transformer(ref common.ReferenceCallback)
 	}
 }
 
+func schema_pkg_apis_numaflow_v1alpha1_TransformerStage(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TransformerStage is a stage of a source transformer chain.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the stage, unique in the chain. The container of the stage is named \"transformer-{name}\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"container": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Container"),
+						},
+					},
+					"builtin": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Transformer"),
+						},
+					},
+					"onFailure": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OnFailure specifies what to do with a message when the stage fails to transform it. There are currently four options, retry, drop, skip and route. \"retry\" keeps retrying the message from the first stage of the chain, \"drop\" drops the message, \"skip\" passes the message to the next stage as it is, and \"route\" tags the message with the failure tag and forwards it as it is without going through the rest of the stages. If not provided, the default value is set to \"retry\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"failureTag": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailureTag is the tag of the messages routed on failures, required if OnFailure is \"route\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Container", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Transformer"},
+	}
+}
+
 func schema_pkg_apis_numaflow_v1alpha1_UDF(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -4064,11 +6512,17 @@ func schema_pkg_apis_numaflow_v1alpha1_UDF(ref common.ReferenceCallback) common.
 							Ref: ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.GroupBy"),
 						},
 					},
+					"remote": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Remote connects to a map UDF served remotely, e.g. by a shared inference service, instead of running it in a sidecar container. Not supported in reduce vertices.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.RemoteUDF"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Container", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Function", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.GroupBy"},
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Container", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Function", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.GroupBy", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.RemoteUDF"},
 	}
 }
 
@@ -4224,27 +6678,60 @@ func schema_pkg_apis_numaflow_v1alpha1_VertexLimits(ref common.ReferenceCallback
 				Properties: map[string]spec.Schema{
 					"readBatchSize": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Read batch size from the source or buffer. It overrides the settings from pipeline limits.",
+							Description: "Read batch size from the source or buffer. It overrides the settings from pipeline limits.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"readTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Read timeout duration from the source or buffer It overrides the settings from pipeline limits.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"bufferMaxLength": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BufferMaxLength is used to define the max length of a buffer. It overrides the settings from pipeline limits.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"bufferUsageLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BufferUsageLimit is used to define the percentage of the buffer usage limit, a valid value should be less than 100, for example, 85. It overrides the settings from pipeline limits.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"adaptiveReadBatch": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AdaptiveReadBatch makes the read batch size adapt to the back pressure, bounded by a min and a max, instead of being fixed to the read batch size.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.AdaptiveReadBatch"),
+						},
+					},
+					"udfConcurrency": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UDFConcurrency is the number of the messages processed concurrently by a map UDF vertex. It overrides the settings from pipeline limits.",
 							Type:        []string{"integer"},
 							Format:      "int64",
 						},
 					},
-					"readTimeout": {
+					"retryInterval": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Read timeout duration from the source or buffer It overrides the settings from pipeline limits.",
+							Description: "RetryInterval is the interval of retrying the failed writes to the Inter-Step Buffers or the sink. It overrides the settings from pipeline limits.",
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
-					"bufferMaxLength": {
+					"keyOrdered": {
 						SchemaProps: spec.SchemaProps{
-							Description: "BufferMaxLength is used to define the max length of a buffer. It overrides the settings from pipeline limits.",
-							Type:        []string{"integer"},
-							Format:      "int64",
+							Description: "KeyOrdered limits the concurrency of a map UDF vertex to across the keys, the messages of a batch with the same keys are processed one after another in the order they are read, so that the per key ordering is preserved. The messages without keys are still processed concurrently.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"bufferUsageLimit": {
+					"mapConnectionPoolSize": {
 						SchemaProps: spec.SchemaProps{
-							Description: "BufferUsageLimit is used to define the percentage of the buffer usage limit, a valid value should be less than 100, for example, 85. It overrides the settings from pipeline limits.",
+							Description: "MapConnectionPoolSize is the number of the gRPC connections from a map UDF vertex to its udf container, the map requests are dispatched to them in a round-robin manner. Defaults to 1.",
 							Type:        []string{"integer"},
 							Format:      "int64",
 						},
@@ -4253,7 +6740,7 @@ func schema_pkg_apis_numaflow_v1alpha1_VertexLimits(ref common.ReferenceCallback
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.AdaptiveReadBatch", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
@@ -4305,6 +6792,88 @@ func schema_pkg_apis_numaflow_v1alpha1_VertexList(ref common.ReferenceCallback)
 	}
 }
 
+func schema_pkg_apis_numaflow_v1alpha1_VertexRestarts(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VertexRestarts is the diagnosis of the container restarts of the pods of a vertex.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"total": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Total is the number of the container restarts of the existing pods of the vertex.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"causes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Causes is the number of the restarts by cause. As only the last termination of a container is known, all the restarts of a container are attributed to the cause of its last termination.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: 0,
+										Type:    []string{"integer"},
+										Format:  "int64",
+									},
+								},
+							},
+						},
+					},
+					"crashLooping": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CrashLooping is the number of the containers in the CrashLoopBackOff state.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"lastCause": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastCause is the cause of the last container termination.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastMessage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastMessage is the termination message of the last terminated container.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastContainer": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastContainer is the pod and the container name of the last terminated container, e.g. \"my-pl-cat-0-abcde/numa\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastTerminatedAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastTerminatedAt is the time of the last container termination.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"halted": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Halted is true if the restart budget is exceeded and the scaling and the pod recreation of the vertex are halted.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"total"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_numaflow_v1alpha1_VertexSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -4549,6 +7118,71 @@ func schema_pkg_apis_numaflow_v1alpha1_VertexSpec(ref common.ReferenceCallback)
 							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ContainerTemplate"),
 						},
 					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is the namespace where the pods and services of the vertex run, defaults to the namespace of the pipeline. The vertex still shares the ISB Service and the buffers of the pipeline. It requires the controller to be installed with the cluster scope, and the service account used by the pods to exist in the namespace.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"cache": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Cache is a local cache served by the numa container to the user defined container, it only applies to UDF vertices.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Cache"),
+						},
+					},
+					"restartBudget": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RestartBudget limits the container restarts of the pods of the vertex, the restarts are categorized by cause in the vertex status regardless of it.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.RestartBudget"),
+						},
+					},
+					"record": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Record records the input messages of the vertex to an object storage in the order they are read, so that they can be replayed through the same UDF to reproduce a problem. It only applies to UDF vertices.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Record"),
+						},
+					},
+					"leafNode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LeafNode connects the pods of the vertex to a NATS leaf node of the JetStream ISB Service instead of the ISB Service itself, e.g. a leaf node running at an edge site. The buffers and the buckets written by the vertex are kept in the JetStream domain of the leaf node, so that the vertex keeps running when the connection to the hub cluster is lost.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.LeafNode"),
+						},
+					},
+					"toPipeline": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ToPipeline connects the vertex to a vertex of another pipeline, the messages are written to the buffers of that vertex, together with the watermarks. It only applies to the non-sink vertices without outgoing edges, and the vertex of the other pipeline has to accept it in its fromPipelines.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PipelineVertex"),
+						},
+					},
+					"fromPipelines": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FromPipelines are the vertices of other pipelines allowed to write to the vertex through their toPipeline. It only applies to the non-source vertices without incoming edges.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PipelineVertex"),
+									},
+								},
+							},
+						},
+					},
+					"interceptors": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Interceptors are called in order by the forwarder of the vertex before reading, after the UDF and before writing the messages. They only apply to map UDF and sink vertices.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Interceptor"),
+									},
+								},
+							},
+						},
+					},
 					"pipelineName": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
@@ -4602,12 +7236,86 @@ func schema_pkg_apis_numaflow_v1alpha1_VertexSpec(ref common.ReferenceCallback)
 							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Watermark"),
 						},
 					},
+					"claimCheck": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClaimCheck is populated from the pipeline claim check settings.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ClaimCheck"),
+						},
+					},
+					"tracing": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Tracing is populated from the pipeline tracing settings.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Tracing"),
+						},
+					},
+					"messageSigning": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MessageSigning is populated from the pipeline message signing settings.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.MessageSigning"),
+						},
+					},
+					"packedVertices": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PackedVertices are the names of the vertices packed into the pods of this vertex, populated when pod packing is enabled. The pods get the specs of them from their vertex objects.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"packedInto": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PackedInto is the name of the vertex whose pods run this vertex, populated when pod packing is enabled. No pods are created for a packed vertex.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"runtimeImage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RuntimeImage is populated from the runtime image override of the pipeline.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"messageChecksum": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MessageChecksum is populated from the pipeline message checksum settings.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.MessageChecksum"),
+						},
+					},
+					"messageEncryption": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MessageEncryption is populated from the pipeline message encryption settings.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.MessageEncryption"),
+						},
+					},
+					"shuffleHeaderNames": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ShuffleHeaderNames are the names of the headers used by the header shuffle strategies of the downstream edges, populated for the source vertices, which only carry these headers from the source messages.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"name", "pipelineName"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.CombinedEdge", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ContainerTemplate", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Metadata", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Scale", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Sink", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Source", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.UDF", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.VertexLimits", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Watermark", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.Container", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.PodDNSConfig", "k8s.io/api/core/v1.PodSecurityContext", "k8s.io/api/core/v1.Toleration", "k8s.io/api/core/v1.Volume"},
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Cache", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ClaimCheck", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.CombinedEdge", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ContainerTemplate", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Interceptor", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.LeafNode", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.MessageChecksum", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.MessageEncryption", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.MessageSigning", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Metadata", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PipelineVertex", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Record", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.RestartBudget", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Scale", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Sink", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Source", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Tracing", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.UDF", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.VertexLimits", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Watermark", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.Container", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.PodDNSConfig", "k8s.io/api/core/v1.PodSecurityContext", "k8s.io/api/core/v1.Toleration", "k8s.io/api/core/v1.Volume"},
 	}
 }
 
@@ -4617,6 +7325,26 @@ func schema_pkg_apis_numaflow_v1alpha1_VertexStatus(ref common.ReferenceCallback
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "type",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions are the latest available observations of a resource's current state.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Condition"),
+									},
+								},
+							},
+						},
+					},
 					"phase": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
@@ -4655,12 +7383,18 @@ func schema_pkg_apis_numaflow_v1alpha1_VertexStatus(ref common.ReferenceCallback
 							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
+					"restarts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Restarts is the diagnosis of the container restarts of the pods, it's empty if there's no restart.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.VertexRestarts"),
+						},
+					},
 				},
 				Required: []string{"phase", "replicas"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.VertexRestarts", "k8s.io/apimachinery/pkg/apis/meta/v1.Condition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -4825,6 +7559,13 @@ func schema_pkg_apis_numaflow_v1alpha1_Watermark(ref common.ReferenceCallback) c
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
+					"timeUnit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TimeUnit is the precision of the event times, the watermarks and the window boundaries of the pipeline. There are currently three options, milliseconds, microseconds and nanoseconds. If not provided, the default value is set to \"milliseconds\". It can not be changed once the pipeline is deployed.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -4833,6 +7574,74 @@ func schema_pkg_apis_numaflow_v1alpha1_Watermark(ref common.ReferenceCallback) c
 	}
 }
 
+func schema_pkg_apis_numaflow_v1alpha1_WatermarkGate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WatermarkGate describes how the messages are held in the sink until the watermark passes their event times. The held messages are not acknowledged, they stay in the Inter-Step Buffer until they are emitted, and are read again if the pod restarts.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxHeldMessages": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxHeldMessages is the max number of the messages held by each partition of the sink, no more messages are read beyond it until the held ones are emitted. Defaults to 10000. It should be less than the max ack pending of the Inter-Step Buffer.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_WebSocketSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"auth": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Auth is the bearer token authorization of the clients, which need to add \"Authorization: Bearer <token>\" in the header of the handshake request.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Authorization"),
+						},
+					},
+					"service": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Whether to create a ClusterIP Service",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"eventTimeField": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EventTimeField is the field of a JSON payload holding the event time, either in epoch milliseconds or in RFC3339 format. The receive time is used as the event time if it's not specified, or the field is missing or invalid.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ackOnWrite": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AckOnWrite sends an acknowledgement back to the client once a message is written to the Inter-Step Buffers, e.g. {\"seq\":1}, where seq is the sequence number of the message in the connection, starting from 1.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"maxConnections": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxConnections is the max number of concurrent client connections of a pod, defaults to 100.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Authorization"},
+	}
+}
+
 func schema_pkg_apis_numaflow_v1alpha1_Window(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -4850,11 +7659,23 @@ func schema_pkg_apis_numaflow_v1alpha1_Window(ref common.ReferenceCallback) comm
 							Ref: ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.SlidingWindow"),
 						},
 					},
+					"custom": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Custom windows are assigned and merged by the user container, which implements the Windower gRPC service.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.CustomWindow"),
+						},
+					},
+					"accumulator": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Accumulator windows accumulate the messages of each key, and emit them on the triggers instead of the aligned window boundaries.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.AccumulatorWindow"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.FixedWindow", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.SlidingWindow"},
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.AccumulatorWindow", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.CustomWindow", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.FixedWindow", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.SlidingWindow"},
 	}
 }
 
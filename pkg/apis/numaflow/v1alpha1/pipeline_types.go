@@ -21,6 +21,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"sort"
 	"strings"
 	"time"
 
@@ -82,6 +83,14 @@ func (p Pipeline) GetVertex(vertexName string) *AbstractVertex {
 	return nil
 }
 
+// GetVertexPodNamespace returns the namespace where the pods of the vertex run.
+func (p Pipeline) GetVertexPodNamespace(vertexName string) string {
+	if v := p.GetVertex(vertexName); v != nil {
+		return v.GetPodNamespace(p.Namespace)
+	}
+	return p.Namespace
+}
+
 // ListAllEdges returns a copy of all the edges.
 func (p Pipeline) ListAllEdges() []Edge {
 	edges := []Edge{}
@@ -116,6 +125,36 @@ func (p Pipeline) FindVertexWithBuffer(buffer string) *AbstractVertex {
 	return nil
 }
 
+// GetPackedVertices returns the vertices packed into the pods of other vertices when pod packing is enabled. The keys
+// are the names of the packed vertices, and the values are the names of the vertices hosting them.
+func (p Pipeline) GetPackedVertices() map[string]string {
+	result := make(map[string]string)
+	if !p.Spec.PodPacking {
+		return result
+	}
+	for _, v := range p.Spec.Vertices {
+		if !v.IsASource() || v.IsUDSource() || v.HasUDTransformer() || len(v.SideInputs) > 0 || len(v.Sidecars) > 0 {
+			continue
+		}
+		toEdges := p.GetToEdges(v.Name)
+		if len(toEdges) != 1 || toEdges[0].StrictFIFO {
+			continue
+		}
+		to := p.GetVertex(toEdges[0].To)
+		if to == nil || !to.IsASink() || to.IsUDSink() || len(to.SideInputs) > 0 || len(to.Sidecars) > 0 {
+			continue
+		}
+		if to.GetPartitionCount() != 1 || len(p.GetFromEdges(to.Name)) != 1 || to.GetPodNamespace(p.Namespace) != v.GetPodNamespace(p.Namespace) {
+			continue
+		}
+		if to.GetJetStreamDomain() != v.GetJetStreamDomain() {
+			continue
+		}
+		result[to.Name] = v.Name
+	}
+	return result
+}
+
 func (p Pipeline) GetToEdges(vertexName string) []Edge {
 	edges := []Edge{}
 	for _, e := range p.ListAllEdges() {
@@ -150,6 +189,10 @@ func (p Pipeline) GetAllBuckets() []string {
 		r = append(r, GenerateEdgeBucketName(p.Namespace, p.Name, e.From, e.To))
 	}
 	for _, v := range p.Spec.Vertices {
+		// The buckets of the cross-pipeline edges are kept in the ISB Service of the to pipeline.
+		for _, from := range v.FromPipelines {
+			r = append(r, GenerateEdgeBucketName(p.Namespace, p.Name, CrossPipelineVertexName(from.GetNamespace(p.Namespace), from.Pipeline, from.Vertex), v.Name))
+		}
 		if v.Source != nil {
 			r = append(r, GenerateSourceBucketName(p.Namespace, p.Name, v.Name))
 		} else if v.Sink != nil {
@@ -159,6 +202,53 @@ func (p Pipeline) GetAllBuckets() []string {
 	return r
 }
 
+// GetJetStreamDomains returns the JetStream domains of the buffers and the buckets which are not in the domain of the
+// ISB Service, keyed by the buffer or bucket names. The buffers and the bucket of an edge are kept in the domain of the
+// from vertex.
+func (p Pipeline) GetJetStreamDomains() map[string]string {
+	r := map[string]string{}
+	for _, v := range p.Spec.Vertices {
+		domain := v.GetJetStreamDomain()
+		if domain == "" {
+			continue
+		}
+		if v.Source != nil {
+			r[GenerateSourceBucketName(p.Namespace, p.Name, v.Name)] = domain
+		} else if v.Sink != nil {
+			r[GenerateSinkBucketName(p.Namespace, p.Name, v.Name)] = domain
+		}
+		for _, e := range p.GetToEdges(v.Name) {
+			r[GenerateEdgeBucketName(p.Namespace, p.Name, e.From, e.To)] = domain
+			if to := p.GetVertex(e.To); to != nil {
+				for _, b := range to.OwnedBufferNames(p.Namespace, p.Name) {
+					r[b] = domain
+				}
+			}
+		}
+	}
+	return r
+}
+
+// GetJetStreamDomainsArgs returns the argument of the JetStream domains for the ISB Service commands, e.g.
+// "isbsvc-create", or nil if all the buffers and buckets are in the domain of the ISB Service.
+func (p Pipeline) GetJetStreamDomainsArgs() []string {
+	return JetStreamDomainsArgs(p.GetJetStreamDomains())
+}
+
+// JetStreamDomainsArgs returns the argument of the given JetStream domains for the ISB Service commands, or nil if there
+// are no domains.
+func JetStreamDomainsArgs(domains map[string]string) []string {
+	if len(domains) == 0 {
+		return nil
+	}
+	pairs := make([]string, 0, len(domains))
+	for k, v := range domains {
+		pairs = append(pairs, k+"="+v)
+	}
+	sort.Strings(pairs)
+	return []string{"--jetstream-domains=" + strings.Join(pairs, ",")}
+}
+
 // GetDownstreamEdges returns all the downstream edges of a vertex
 func (p Pipeline) GetDownstreamEdges(vertexName string) []Edge {
 	var f func(vertexName string, edges *[]Edge)
@@ -175,6 +265,23 @@ func (p Pipeline) GetDownstreamEdges(vertexName string) []Edge {
 	return result
 }
 
+// GetShuffleHeaderNames returns the sorted names of the headers used by the header shuffle strategies of the
+// downstream edges of a vertex.
+func (p Pipeline) GetShuffleHeaderNames(vertexName string) []string {
+	names := map[string]struct{}{}
+	for _, e := range p.GetDownstreamEdges(vertexName) {
+		if ss := e.Shuffle; ss != nil && ss.GetType() == ShuffleTypeHeader && ss.HeaderName != "" {
+			names[ss.HeaderName] = struct{}{}
+		}
+	}
+	var result []string
+	for n := range names {
+		result = append(result, n)
+	}
+	sort.Strings(result)
+	return result
+}
+
 // HasSideInputs returns if the pipeline has side inputs.
 func (p Pipeline) HasSideInputs() bool {
 	return len(p.Spec.SideInputs) > 0
@@ -334,6 +441,7 @@ func (p Pipeline) getDaemonPodInitContainer(req GetDaemonDeploymentReq) corev1.C
 	}
 	c.Args = append(c.Args, "--buffers="+strings.Join(p.GetAllBuffers(), ","))
 	c.Args = append(c.Args, "--buckets="+strings.Join(p.GetAllBuckets(), ","))
+	c.Args = append(c.Args, p.GetJetStreamDomainsArgs()...)
 	if p.Spec.Templates != nil && p.Spec.Templates.DaemonTemplate != nil && p.Spec.Templates.DaemonTemplate.InitContainerTemplate != nil {
 		p.Spec.Templates.DaemonTemplate.InitContainerTemplate.ApplyToContainer(&c)
 	}
@@ -390,6 +498,8 @@ func (p Pipeline) GetPipelineLimits() PipelineLimits {
 		if x.ReadTimeout != nil {
 			limits.ReadTimeout = x.ReadTimeout
 		}
+		limits.UDFConcurrency = x.UDFConcurrency
+		limits.RetryInterval = x.RetryInterval
 	}
 	return limits
 }
@@ -403,6 +513,12 @@ type Lifecycle struct {
 	// +kubebuilder:default=Running
 	// +optional
 	DesiredPhase PipelinePhase `json:"desiredPhase,omitempty" protobuf:"bytes,2,opt,name=desiredPhase"`
+	// CleanUpDelaySeconds is the delay to clean up the ISB Service resources of the removed vertices, edges and side
+	// inputs, e.g. the buffers, the watermark buckets and the side input values, so that the pods still using them
+	// have time to terminate.
+	// +kubebuilder:default=300
+	// +optional
+	CleanUpDelaySeconds *int32 `json:"cleanUpDelaySeconds,omitempty" protobuf:"varint,3,opt,name=cleanUpDelaySeconds"`
 }
 
 // GetDeleteGracePeriodSeconds returns the value DeleteGracePeriodSeconds.
@@ -413,6 +529,14 @@ func (lc Lifecycle) GetDeleteGracePeriodSeconds() int32 {
 	return 30
 }
 
+// GetCleanUpDelaySeconds returns the value CleanUpDelaySeconds.
+func (lc Lifecycle) GetCleanUpDelaySeconds() int32 {
+	if lc.CleanUpDelaySeconds != nil {
+		return *lc.CleanUpDelaySeconds
+	}
+	return 300
+}
+
 func (lc Lifecycle) GetDesiredPhase() PipelinePhase {
 	if string(lc.DesiredPhase) != "" {
 		return lc.DesiredPhase
@@ -446,6 +570,47 @@ type PipelineSpec struct {
 	// SideInputs defines the Side Inputs of a pipeline.
 	// +optional
 	SideInputs []SideInput `json:"sideInputs,omitempty" protobuf:"bytes,8,rep,name=sideInputs"`
+	// ClaimCheck offloads the payloads of the large messages to an object store of the ISB Service, and only the
+	// references are written to the buffers. Only supported with the JetStream ISB Service.
+	// +optional
+	ClaimCheck *ClaimCheck `json:"claimCheck,omitempty" protobuf:"bytes,9,opt,name=claimCheck"`
+	// Tracing enables the OpenTelemetry tracing of the messages flowing through the pipeline.
+	// +optional
+	Tracing *Tracing `json:"tracing,omitempty" protobuf:"bytes,10,opt,name=tracing"`
+	// MessageSigning signs the messages written to the Inter-Step Buffers, and verifies them at the readers.
+	// +optional
+	MessageSigning *MessageSigning `json:"messageSigning,omitempty" protobuf:"bytes,11,opt,name=messageSigning"`
+	// PodPacking runs a built-in sink in the pods of its upstream source, connected by an in-memory edge instead of
+	// an Inter-Step Buffer, to save the pods of the low-throughput pipelines.
+	// Only applies to a sink that reads from a single source, which writes to no other vertices. Neither of them may
+	// have user-defined containers. The messages in the in-memory edge are lost if the pod crashes.
+	// +optional
+	PodPacking bool `json:"podPacking,omitempty" protobuf:"varint,12,opt,name=podPacking"`
+	// RuntimeImage overrides the Numaflow runtime image used by the vertices, the daemon and the jobs of the pipeline,
+	// which defaults to the image of the controller. It's recommended to pin it by digest, e.g.
+	// "quay.io/numaproj/numaflow@sha256:...", the images allowed are controlled by the runtime image policy of the
+	// cluster, which is enforced by the validating webhook.
+	// +optional
+	RuntimeImage string `json:"runtimeImage,omitempty" protobuf:"bytes,13,opt,name=runtimeImage"`
+	// MessageChecksum checksums the messages written to the Inter-Step Buffers, and verifies them at the readers.
+	// +optional
+	MessageChecksum *MessageChecksum `json:"messageChecksum,omitempty" protobuf:"bytes,14,opt,name=messageChecksum"`
+	// Degradation is the policy to degrade the best-effort edges when the pipeline is overloaded, and restore them
+	// when it's healthy again.
+	// +optional
+	Degradation *Degradation `json:"degradation,omitempty" protobuf:"bytes,15,opt,name=degradation"`
+	// MessageEncryption encrypts the payloads of the messages written to the Inter-Step Buffers, and decrypts them at
+	// the readers.
+	// +optional
+	MessageEncryption *MessageEncryption `json:"messageEncryption,omitempty" protobuf:"bytes,16,opt,name=messageEncryption"`
+}
+
+// GetRuntimeImage returns the runtime image override of the pipeline, or the given default image if not specified.
+func (pipeline PipelineSpec) GetRuntimeImage(defaultImage string) string {
+	if pipeline.RuntimeImage != "" {
+		return pipeline.RuntimeImage
+	}
+	return defaultImage
 }
 
 func (pipeline PipelineSpec) GetMatchingVertices(f func(AbstractVertex) bool) map[string]*AbstractVertex {
@@ -486,6 +651,12 @@ type Watermark struct {
 	// +kubebuilder:default="0s"
 	// +optional
 	MaxDelay *metav1.Duration `json:"maxDelay,omitempty" protobuf:"bytes,2,opt,name=maxDelay"`
+	// TimeUnit is the precision of the event times, the watermarks and the window boundaries of the pipeline.
+	// There are currently three options, milliseconds, microseconds and nanoseconds.
+	// If not provided, the default value is set to "milliseconds". It can not be changed once the pipeline is deployed.
+	// +kubebuilder:validation:Enum=milliseconds;microseconds;nanoseconds
+	// +optional
+	TimeUnit *WatermarkTimeUnit `json:"timeUnit,omitempty" protobuf:"bytes,3,opt,name=timeUnit"`
 }
 
 // GetMaxDelay returns the configured max delay with a default value.
@@ -496,6 +667,41 @@ func (wm Watermark) GetMaxDelay() time.Duration {
 	return time.Duration(0)
 }
 
+// GetTimeUnit returns the precision of the event times and the watermarks, defaults to time.Millisecond.
+func (wm Watermark) GetTimeUnit() time.Duration {
+	if wm.TimeUnit == nil {
+		return time.Millisecond
+	}
+	switch *wm.TimeUnit {
+	case WatermarkTimeUnitMicroseconds:
+		return time.Microsecond
+	case WatermarkTimeUnitNanoseconds:
+		return time.Nanosecond
+	default:
+		return time.Millisecond
+	}
+}
+
+// GetTimeUnitType returns the time unit of the watermarks, defaults to milliseconds.
+func (wm Watermark) GetTimeUnitType() WatermarkTimeUnit {
+	switch wm.GetTimeUnit() {
+	case time.Microsecond:
+		return WatermarkTimeUnitMicroseconds
+	case time.Nanosecond:
+		return WatermarkTimeUnitNanoseconds
+	default:
+		return WatermarkTimeUnitMilliseconds
+	}
+}
+
+type WatermarkTimeUnit string
+
+const (
+	WatermarkTimeUnitMilliseconds WatermarkTimeUnit = "milliseconds"
+	WatermarkTimeUnitMicroseconds WatermarkTimeUnit = "microseconds"
+	WatermarkTimeUnitNanoseconds  WatermarkTimeUnit = "nanoseconds"
+)
+
 type Templates struct {
 	// DaemonTemplate is used to customize the Daemon Deployment.
 	// +optional
@@ -532,6 +738,14 @@ type PipelineLimits struct {
 	// +kubebuilder:default= "1s"
 	// +optional
 	ReadTimeout *metav1.Duration `json:"readTimeout,omitempty" protobuf:"bytes,4,opt,name=readTimeout"`
+	// UDFConcurrency is the number of the messages processed concurrently by the map UDF vertices, defaults to the
+	// read batch size. It can be overridden by the vertex's limit settings.
+	// +optional
+	UDFConcurrency *uint32 `json:"udfConcurrency,omitempty" protobuf:"varint,5,opt,name=udfConcurrency"`
+	// RetryInterval is the interval of retrying the failed writes to the Inter-Step Buffers or the sinks, defaults to
+	// 1ms. It can be overridden by the vertex's limit settings.
+	// +optional
+	RetryInterval *metav1.Duration `json:"retryInterval,omitempty" protobuf:"bytes,6,opt,name=retryInterval"`
 }
 
 type PipelineStatus struct {
@@ -543,6 +757,34 @@ type PipelineStatus struct {
 	SourceCount *uint32       `json:"sourceCount,omitempty" protobuf:"varint,6,opt,name=sourceCount"`
 	SinkCount   *uint32       `json:"sinkCount,omitempty" protobuf:"varint,7,opt,name=sinkCount"`
 	UDFCount    *uint32       `json:"udfCount,omitempty" protobuf:"varint,8,opt,name=udfCount"`
+	// Degradation is the degradation state of the pipeline, only available with a degradation policy.
+	// +optional
+	Degradation *DegradationStatus `json:"degradation,omitempty" protobuf:"bytes,9,opt,name=degradation"`
+	// StaleResources are the ISB Service resources of the removed vertices, edges and side inputs, waiting to be
+	// cleaned up.
+	// +optional
+	StaleResources []StaleResources `json:"staleResources,omitempty" protobuf:"bytes,10,rep,name=staleResources"`
+	// WatermarkTimeUnit is the time unit of the watermarks the pipeline is deployed with, the timeUnit of the
+	// watermark spec can not be changed once it's recorded.
+	// +optional
+	WatermarkTimeUnit WatermarkTimeUnit `json:"watermarkTimeUnit,omitempty" protobuf:"bytes,11,opt,name=watermarkTimeUnit,casttype=WatermarkTimeUnit"`
+}
+
+// StaleResources describes the ISB Service resources no longer used by a pipeline, which are cleaned up after the
+// clean up delay of the pipeline.
+type StaleResources struct {
+	// +optional
+	Buffers []string `json:"buffers,omitempty" protobuf:"bytes,1,rep,name=buffers"`
+	// +optional
+	Buckets []string `json:"buckets,omitempty" protobuf:"bytes,2,rep,name=buckets"`
+	// SideInputs are the names of the removed side inputs, whose values are deleted from the side inputs store.
+	// +optional
+	SideInputs []string `json:"sideInputs,omitempty" protobuf:"bytes,3,rep,name=sideInputs"`
+	// JetStreamDomains are the JetStream domains of the buffers and buckets not in the domain of the ISB Service.
+	// +optional
+	JetStreamDomains map[string]string `json:"jetStreamDomains,omitempty" protobuf:"bytes,4,rep,name=jetStreamDomains"`
+	// RemovedAt is the time when the resources were found not used anymore.
+	RemovedAt metav1.Time `json:"removedAt" protobuf:"bytes,5,opt,name=removedAt"`
 }
 
 // SetVertexCounts sets the counts of vertices.
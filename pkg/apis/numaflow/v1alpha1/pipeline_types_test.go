@@ -57,6 +57,35 @@ func Test_ListAllEdges(t *testing.T) {
 	assert.Equal(t, 2, len(es))
 }
 
+func Test_GetPackedVertices(t *testing.T) {
+	assert.Empty(t, testPipeline.GetPackedVertices())
+	pl := testPipeline.DeepCopy()
+	pl.Spec.PodPacking = true
+	// the sink reads from a udf
+	assert.Empty(t, pl.GetPackedVertices())
+	pl.Spec.Vertices = []AbstractVertex{
+		{Name: "input", Source: &Source{}},
+		{Name: "output", Sink: &Sink{}},
+	}
+	pl.Spec.Edges = []Edge{{From: "input", To: "output"}}
+	assert.Equal(t, map[string]string{"output": "input"}, pl.GetPackedVertices())
+	pl.Spec.Vertices[1].Sink.UDSink = &UDSink{}
+	assert.Empty(t, pl.GetPackedVertices())
+	pl.Spec.Vertices[1].Sink.UDSink = nil
+	pl.Spec.Vertices[0].Source.UDTransformer = &UDTransformer{}
+	assert.Empty(t, pl.GetPackedVertices())
+	pl.Spec.Vertices[0].Source.UDTransformer = nil
+	pl.Spec.Vertices[0].LeafNode = &LeafNode{URL: "nats://leaf:4222", Domain: "edge"}
+	assert.Empty(t, pl.GetPackedVertices())
+	pl.Spec.Vertices[1].LeafNode = &LeafNode{URL: "nats://leaf:4222", Domain: "edge"}
+	assert.Equal(t, map[string]string{"output": "input"}, pl.GetPackedVertices())
+	pl.Spec.Edges[0].StrictFIFO = true
+	assert.Empty(t, pl.GetPackedVertices())
+	pl.Spec.Edges[0].StrictFIFO = false
+	pl.Spec.PodPacking = false
+	assert.Empty(t, pl.GetPackedVertices())
+}
+
 func Test_GetToEdges(t *testing.T) {
 	es := testPipeline.GetToEdges("p1")
 	assert.Equal(t, 1, len(es))
@@ -321,6 +350,29 @@ func Test_GetDownstreamEdges(t *testing.T) {
 	assert.Equal(t, 0, len(edges))
 }
 
+func Test_GetShuffleHeaderNames(t *testing.T) {
+	header := ShuffleTypeHeader
+	hash := ShuffleTypeHash
+	pl := Pipeline{
+		Spec: PipelineSpec{
+			Vertices: []AbstractVertex{
+				{Name: "input"},
+				{Name: "p1"},
+				{Name: "p2"},
+				{Name: "output"},
+			},
+			Edges: []Edge{
+				{From: "input", To: "p1", Shuffle: &ShuffleStrategy{Type: &header, HeaderName: "tenant"}},
+				{From: "p1", To: "p2", Shuffle: &ShuffleStrategy{Type: &header, HeaderName: "region"}},
+				{From: "p2", To: "output", Shuffle: &ShuffleStrategy{Type: &hash, HeaderName: "ignored"}},
+			},
+		},
+	}
+	assert.Equal(t, []string{"region", "tenant"}, pl.GetShuffleHeaderNames("input"))
+	assert.Equal(t, []string{"region"}, pl.GetShuffleHeaderNames("p1"))
+	assert.Nil(t, pl.GetShuffleHeaderNames("p2"))
+}
+
 func Test_GetWatermarkMaxDelay(t *testing.T) {
 	wm := Watermark{}
 	assert.Equal(t, "0s", wm.GetMaxDelay().String())
@@ -328,6 +380,19 @@ func Test_GetWatermarkMaxDelay(t *testing.T) {
 	assert.Equal(t, "2s", wm.GetMaxDelay().String())
 }
 
+func Test_GetWatermarkTimeUnit(t *testing.T) {
+	wm := Watermark{}
+	assert.Equal(t, time.Millisecond, wm.GetTimeUnit())
+	assert.Equal(t, WatermarkTimeUnitMilliseconds, wm.GetTimeUnitType())
+	u := WatermarkTimeUnitMicroseconds
+	wm.TimeUnit = &u
+	assert.Equal(t, time.Microsecond, wm.GetTimeUnit())
+	assert.Equal(t, WatermarkTimeUnitMicroseconds, wm.GetTimeUnitType())
+	u = WatermarkTimeUnitNanoseconds
+	assert.Equal(t, time.Nanosecond, wm.GetTimeUnit())
+	assert.Equal(t, WatermarkTimeUnitNanoseconds, wm.GetTimeUnitType())
+}
+
 func Test_GetDeleteGracePeriodSeconds(t *testing.T) {
 	lc := Lifecycle{}
 	assert.Equal(t, int32(30), lc.GetDeleteGracePeriodSeconds())
@@ -335,6 +400,13 @@ func Test_GetDeleteGracePeriodSeconds(t *testing.T) {
 	assert.Equal(t, int32(50), lc.GetDeleteGracePeriodSeconds())
 }
 
+func Test_GetCleanUpDelaySeconds(t *testing.T) {
+	lc := Lifecycle{}
+	assert.Equal(t, int32(300), lc.GetCleanUpDelaySeconds())
+	lc.CleanUpDelaySeconds = pointer.Int32(0)
+	assert.Equal(t, int32(0), lc.GetCleanUpDelaySeconds())
+}
+
 func Test_GetDesiredPhase(t *testing.T) {
 	lc := Lifecycle{}
 	assert.Equal(t, PipelinePhaseRunning, lc.GetDesiredPhase())
@@ -351,6 +423,8 @@ func Test_GetPipelineLimits(t *testing.T) {
 	assert.Equal(t, float64(DefaultBufferUsageLimit), float64(*l.BufferUsageLimit)/100)
 	assert.Equal(t, int64(DefaultReadBatchSize), int64(*l.ReadBatchSize))
 	assert.Equal(t, "1s", l.ReadTimeout.Duration.String())
+	assert.Nil(t, l.UDFConcurrency)
+	assert.Nil(t, l.RetryInterval)
 
 	length := uint64(2000)
 	usuageLimit := uint32(40)
@@ -360,12 +434,16 @@ func Test_GetPipelineLimits(t *testing.T) {
 		BufferUsageLimit: &usuageLimit,
 		ReadBatchSize:    &readBatch,
 		ReadTimeout:      &metav1.Duration{Duration: time.Duration(5 * time.Second)},
+		UDFConcurrency:   pointer.Uint32(10),
+		RetryInterval:    &metav1.Duration{Duration: 100 * time.Millisecond},
 	}
 	l = pl.GetPipelineLimits()
 	assert.Equal(t, length, *l.BufferMaxLength)
 	assert.Equal(t, float64(40)/100, float64(*l.BufferUsageLimit)/100)
 	assert.Equal(t, readBatch, *l.ReadBatchSize)
 	assert.Equal(t, "5s", l.ReadTimeout.Duration.String())
+	assert.Equal(t, uint32(10), *l.UDFConcurrency)
+	assert.Equal(t, "100ms", l.RetryInterval.Duration.String())
 }
 
 func Test_GetAllBuckets(t *testing.T) {
@@ -390,6 +468,24 @@ func Test_GetAllBuckets(t *testing.T) {
 	assert.Equal(t, 6, len(buckets))
 }
 
+func Test_GetJetStreamDomains(t *testing.T) {
+	assert.Empty(t, testPipeline.GetJetStreamDomains())
+	assert.Nil(t, testPipeline.GetJetStreamDomainsArgs())
+	pl := testPipeline.DeepCopy()
+	pl.Spec.Vertices[0].LeafNode = &LeafNode{URL: "nats://leaf:4222", Domain: "edge"}
+	assert.Equal(t, map[string]string{
+		GenerateSourceBucketName(testNamespace, testPipelineName, "input"):     "edge",
+		GenerateEdgeBucketName(testNamespace, testPipelineName, "input", "p1"): "edge",
+		GenerateBufferName(testNamespace, testPipelineName, "p1", 0):           "edge",
+	}, pl.GetJetStreamDomains())
+	assert.Equal(t, []string{"--jetstream-domains=" +
+		testNamespace + "-" + testPipelineName + "-input-p1=edge," +
+		testNamespace + "-" + testPipelineName + "-input_SOURCE=edge," +
+		testNamespace + "-" + testPipelineName + "-p1-0=edge"}, pl.GetJetStreamDomainsArgs())
+	pl.Spec.Vertices[2].LeafNode = &LeafNode{URL: "nats://leaf:4222", Domain: "edge"}
+	assert.Equal(t, "edge", pl.GetJetStreamDomains()[GenerateSinkBucketName(testNamespace, testPipelineName, "output")])
+}
+
 func Test_FindVertexWithBuffer(t *testing.T) {
 	v := testPipeline.FindVertexWithBuffer(GenerateBufferName(testNamespace, testPipelineName, "p1", 0))
 	assert.NotNil(t, v)
@@ -0,0 +1,70 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type PulsarSink struct {
+	// ServiceURL of the Pulsar cluster, e.g. "pulsar://pulsar-broker:6650".
+	ServiceURL string `json:"serviceURL" protobuf:"bytes,1,opt,name=serviceURL"`
+	// Topic to produce messages to. For partitioned topics, the messages with keys are routed to the partitions
+	// by the hash of the keys, and the messages without keys are distributed in a round-robin manner.
+	Topic string `json:"topic" protobuf:"bytes,2,opt,name=topic"`
+	// TLS configuration for the pulsar client.
+	// +optional
+	TLS *TLS `json:"tls,omitempty" protobuf:"bytes,3,opt,name=tls"`
+	// Auth information
+	// +optional
+	Auth *PulsarAuth `json:"auth,omitempty" protobuf:"bytes,4,opt,name=auth"`
+	// Batching configuration of the producer, batching is enabled by default.
+	// +optional
+	Batching *PulsarBatching `json:"batching,omitempty" protobuf:"bytes,5,opt,name=batching"`
+}
+
+type PulsarBatching struct {
+	// Disabled is used to disable the batching of the producer.
+	// +optional
+	Disabled bool `json:"disabled,omitempty" protobuf:"varint,1,opt,name=disabled"`
+	// MaxMessages is the max number of messages in a batch, defaults to 1000.
+	// +optional
+	MaxMessages *uint32 `json:"maxMessages,omitempty" protobuf:"varint,2,opt,name=maxMessages"`
+	// MaxPublishDelay is the max time to wait before a batch is sent, defaults to 10ms.
+	// +optional
+	MaxPublishDelay *metav1.Duration `json:"maxPublishDelay,omitempty" protobuf:"bytes,3,opt,name=maxPublishDelay"`
+}
+
+func (pb *PulsarBatching) IsDisabled() bool {
+	return pb != nil && pb.Disabled
+}
+
+func (pb *PulsarBatching) GetMaxMessages() uint {
+	if pb == nil || pb.MaxMessages == nil {
+		return 1000
+	}
+	return uint(*pb.MaxMessages)
+}
+
+func (pb *PulsarBatching) GetMaxPublishDelay() time.Duration {
+	if pb == nil || pb.MaxPublishDelay == nil {
+		return 10 * time.Millisecond
+	}
+	return pb.MaxPublishDelay.Duration
+}
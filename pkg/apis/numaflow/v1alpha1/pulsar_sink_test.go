@@ -0,0 +1,45 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+)
+
+func TestPulsarBatching(t *testing.T) {
+	var pb *PulsarBatching
+	assert.False(t, pb.IsDisabled())
+	assert.Equal(t, uint(1000), pb.GetMaxMessages())
+	assert.Equal(t, 10*time.Millisecond, pb.GetMaxPublishDelay())
+	pb = &PulsarBatching{Disabled: true, MaxMessages: pointer.Uint32(10), MaxPublishDelay: &metav1.Duration{Duration: time.Second}}
+	assert.True(t, pb.IsDisabled())
+	assert.Equal(t, uint(10), pb.GetMaxMessages())
+	assert.Equal(t, time.Second, pb.GetMaxPublishDelay())
+}
+
+func TestPulsarSource_GetSubscriptionType(t *testing.T) {
+	ps := &PulsarSource{}
+	assert.Equal(t, PulsarSubscriptionTypeShared, ps.GetSubscriptionType())
+	failover := PulsarSubscriptionTypeFailover
+	ps.SubscriptionType = &failover
+	assert.Equal(t, PulsarSubscriptionTypeFailover, ps.GetSubscriptionType())
+}
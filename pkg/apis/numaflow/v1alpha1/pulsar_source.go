@@ -0,0 +1,65 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import corev1 "k8s.io/api/core/v1"
+
+type PulsarSubscriptionType string
+
+const (
+	PulsarSubscriptionTypeShared   PulsarSubscriptionType = "shared"
+	PulsarSubscriptionTypeFailover PulsarSubscriptionType = "failover"
+)
+
+type PulsarSource struct {
+	// ServiceURL of the Pulsar cluster, e.g. "pulsar://pulsar-broker:6650".
+	ServiceURL string `json:"serviceURL" protobuf:"bytes,1,opt,name=serviceURL"`
+	// Topic to consume messages from, partitioned topics are supported.
+	Topic string `json:"topic" protobuf:"bytes,2,opt,name=topic"`
+	// SubscriptionName is the name of the subscription shared by all the pods of the vertex.
+	SubscriptionName string `json:"subscriptionName" protobuf:"bytes,3,opt,name=subscriptionName"`
+	// SubscriptionType could be "shared" or "failover".
+	// if not provided, the default value is set to "shared"
+	// +kubebuilder:validation:Enum=shared;failover
+	// +optional
+	SubscriptionType *PulsarSubscriptionType `json:"subscriptionType,omitempty" protobuf:"bytes,4,opt,name=subscriptionType"`
+	// TLS configuration for the pulsar client.
+	// +optional
+	TLS *TLS `json:"tls,omitempty" protobuf:"bytes,5,opt,name=tls"`
+	// Auth information
+	// +optional
+	Auth *PulsarAuth `json:"auth,omitempty" protobuf:"bytes,6,opt,name=auth"`
+}
+
+func (ps PulsarSource) GetSubscriptionType() PulsarSubscriptionType {
+	if ps.SubscriptionType == nil {
+		return PulsarSubscriptionTypeShared
+	}
+	switch *ps.SubscriptionType {
+	case PulsarSubscriptionTypeShared, PulsarSubscriptionTypeFailover:
+		return *ps.SubscriptionType
+	default:
+		return PulsarSubscriptionTypeShared
+	}
+}
+
+// PulsarAuth defines how to authenticate with the Pulsar cluster
+type PulsarAuth struct {
+	// Token auth
+	// +optional
+	Token *corev1.SecretKeySelector `json:"token,omitempty" protobuf:"bytes,1,opt,name=token"`
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"path"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Record describes a bounded recording of the input messages of a vertex. Each pod writes the batches it reads from
+// each partition, in the exact order, to an object storage, until any of the limits is reached. The recordings can be
+// fed back through the same UDF image with "numaflow replay".
+type Record struct {
+	// S3 is the S3 compatible object storage the recordings are written to.
+	S3 *S3Store `json:"s3" protobuf:"bytes,1,opt,name=s3"`
+	// MaxMessages is the max number of the messages recorded by each pod, defaults to 10000.
+	// +optional
+	MaxMessages *uint32 `json:"maxMessages,omitempty" protobuf:"varint,2,opt,name=maxMessages"`
+	// Duration is the max time each pod records for since it starts, defaults to 10m.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty" protobuf:"bytes,3,opt,name=duration"`
+}
+
+func (r Record) GetMaxMessages() int {
+	if r.MaxMessages == nil || *r.MaxMessages == 0 {
+		return DefaultRecordMaxMessages
+	}
+	return int(*r.MaxMessages)
+}
+
+func (r Record) GetDuration() time.Duration {
+	if r.Duration == nil || r.Duration.Duration <= 0 {
+		return DefaultRecordDuration
+	}
+	return r.Duration.Duration
+}
+
+// S3Store describes a bucket of an S3 compatible object storage, e.g. AWS S3 or MinIO. The objects are addressed in
+// the path style.
+type S3Store struct {
+	// Endpoint of the object storage, e.g. "https://s3.us-west-2.amazonaws.com" or "http://minio:9000".
+	Endpoint string `json:"endpoint" protobuf:"bytes,1,opt,name=endpoint"`
+	Bucket   string `json:"bucket" protobuf:"bytes,2,opt,name=bucket"`
+	// Prefix of the keys of the objects, defaults to "<namespace>/<pipeline>/<vertex>".
+	// +optional
+	Prefix string `json:"prefix,omitempty" protobuf:"bytes,3,opt,name=prefix"`
+	// Region of the bucket, defaults to "us-east-1".
+	// +optional
+	Region string `json:"region,omitempty" protobuf:"bytes,4,opt,name=region"`
+	// AccessKeyID refers to the secret that contains the access key ID.
+	AccessKeyID *corev1.SecretKeySelector `json:"accessKeyId" protobuf:"bytes,5,opt,name=accessKeyId"`
+	// SecretAccessKey refers to the secret that contains the secret access key.
+	SecretAccessKey *corev1.SecretKeySelector `json:"secretAccessKey" protobuf:"bytes,6,opt,name=secretAccessKey"`
+	// TLS configuration for the client.
+	// +optional
+	TLS *TLS `json:"tls,omitempty" protobuf:"bytes,7,opt,name=tls"`
+}
+
+func (s S3Store) GetRegion() string {
+	if s.Region == "" {
+		return DefaultS3Region
+	}
+	return s.Region
+}
+
+// GetPrefix returns the prefix of the keys of the objects written by a vertex.
+func (s S3Store) GetPrefix(namespace, pipelineName, vertexName string) string {
+	if s.Prefix != "" {
+		return s.Prefix
+	}
+	return path.Join(namespace, pipelineName, vertexName)
+}
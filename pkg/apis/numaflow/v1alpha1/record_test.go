@@ -0,0 +1,46 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+)
+
+func TestRecord(t *testing.T) {
+	r := Record{}
+	assert.Equal(t, DefaultRecordMaxMessages, r.GetMaxMessages())
+	assert.Equal(t, DefaultRecordDuration, r.GetDuration())
+	r.MaxMessages = pointer.Uint32(100)
+	r.Duration = &metav1.Duration{Duration: time.Minute}
+	assert.Equal(t, 100, r.GetMaxMessages())
+	assert.Equal(t, time.Minute, r.GetDuration())
+}
+
+func TestS3Store(t *testing.T) {
+	s := S3Store{}
+	assert.Equal(t, DefaultS3Region, s.GetRegion())
+	assert.Equal(t, "ns/pl/v", s.GetPrefix("ns", "pl", "v"))
+	s.Region = "us-west-2"
+	s.Prefix = "recordings"
+	assert.Equal(t, "us-west-2", s.GetRegion())
+	assert.Equal(t, "recordings", s.GetPrefix("ns", "pl", "v"))
+}
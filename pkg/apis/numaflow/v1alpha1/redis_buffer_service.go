@@ -51,6 +51,12 @@ type RedisConfig struct {
 	// Sentinel password secret selector
 	// +optional
 	SentinelPassword *corev1.SecretKeySelector `json:"sentinelPassword,omitempty" protobuf:"bytes,6,opt,name=sentinelPassword"`
+	// TLS configuration for the connections to Redis and Sentinel
+	// +optional
+	TLS *TLS `json:"tls,omitempty" protobuf:"bytes,7,opt,name=tls"`
+	// Sentinel user, for Sentinel ACL authentication
+	// +optional
+	SentinelUser string `json:"sentinelUser,omitempty" protobuf:"bytes,8,opt,name=sentinelUser"`
 }
 
 type NativeRedis struct {
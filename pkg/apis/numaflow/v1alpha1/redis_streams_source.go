@@ -16,6 +16,12 @@ limitations under the License.
 
 package v1alpha1
 
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
 type RedisStreamsSource struct {
 	// RedisConfig contains connectivity info
 	RedisConfig   `json:",inline" protobuf:"bytes,1,opt,name=redisConfig"`
@@ -23,6 +29,22 @@ type RedisStreamsSource struct {
 	ConsumerGroup string `json:"consumerGroup" protobuf:"bytes,3,opt,name=consumerGroup"`
 	// if true, stream starts being read from the beginning; otherwise, the latest
 	ReadFromBeginning bool `json:"readFromBeginning" protobuf:"bytes,4,opt,name=readFromBeginning"`
+	// EventTimeField is the field of the entries holding the event time, either in epoch milliseconds or in RFC3339
+	// format. The time in the entry ID is used as the event time if it's not specified, or the field is missing or
+	// invalid.
+	// +optional
+	EventTimeField string `json:"eventTimeField,omitempty" protobuf:"bytes,5,opt,name=eventTimeField"`
+	// ClaimMinIdleTime is the min time that an entry is pending in the consumer group before it's claimed by another
+	// consumer, so that the entries delivered to a replica which is gone, e.g. after scaling down, are redelivered.
+	// Defaults to 5m, "0s" disables it.
 	// +optional
-	TLS *TLS `json:"tls" protobuf:"bytes,5,opt,name=tls"`
+	ClaimMinIdleTime *metav1.Duration `json:"claimMinIdleTime,omitempty" protobuf:"bytes,6,opt,name=claimMinIdleTime"`
+}
+
+// GetClaimMinIdleTime returns the min idle time of the pending entries to be claimed, 0 means not claiming them.
+func (rs *RedisStreamsSource) GetClaimMinIdleTime() time.Duration {
+	if rs == nil || rs.ClaimMinIdleTime == nil {
+		return DefaultRedisStreamsClaimMinIdleTime
+	}
+	return rs.ClaimMinIdleTime.Duration
 }
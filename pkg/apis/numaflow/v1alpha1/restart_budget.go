@@ -0,0 +1,84 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RestartCause is the category of the cause of a container termination.
+type RestartCause string
+
+const (
+	// RestartCauseOOMKilled means the container was killed for running out of memory.
+	RestartCauseOOMKilled RestartCause = "OOMKilled"
+	// RestartCauseUDSHandshakeFailed means the numa container failed to connect to the user-defined container
+	// over the unix domain socket, e.g. the user-defined server is not started or not compatible.
+	RestartCauseUDSHandshakeFailed RestartCause = "UDSHandshakeFailed"
+	// RestartCauseISBConnectFailed means the numa container failed to connect to the Inter-Step Buffer Service.
+	RestartCauseISBConnectFailed RestartCause = "ISBConnectFailed"
+	// RestartCauseError means the container exited with an error of any other cause.
+	RestartCauseError RestartCause = "Error"
+)
+
+// RestartBudget limits the container restarts of the pods of a vertex, so that a crash-looping vertex is reported,
+// and optionally halted to preserve the failed pods for debugging.
+type RestartBudget struct {
+	// MaxRestarts is the max number of the container restarts of all the pods of the vertex, defaults to 10.
+	// +optional
+	MaxRestarts *int32 `json:"maxRestarts,omitempty" protobuf:"varint,1,opt,name=maxRestarts"`
+	// Halt the scaling and the pod recreation of the vertex once the budget is exceeded, so that the failed pods
+	// and the logs of their previous containers are kept. Edit the vertex spec, e.g. raise the budget or set it to
+	// false, to resume. If false, exceeding the budget is only surfaced in the status and as an event.
+	// +optional
+	Halt bool `json:"halt,omitempty" protobuf:"varint,2,opt,name=halt"`
+}
+
+func (rb RestartBudget) GetMaxRestarts() int {
+	if rb.MaxRestarts == nil {
+		return DefaultRestartBudgetMaxRestarts
+	}
+	return int(*rb.MaxRestarts)
+}
+
+// VertexRestarts is the diagnosis of the container restarts of the pods of a vertex.
+type VertexRestarts struct {
+	// Total is the number of the container restarts of the existing pods of the vertex.
+	Total uint32 `json:"total" protobuf:"varint,1,opt,name=total"`
+	// Causes is the number of the restarts by cause. As only the last termination of a container is known,
+	// all the restarts of a container are attributed to the cause of its last termination.
+	// +optional
+	Causes map[string]uint32 `json:"causes,omitempty" protobuf:"bytes,2,rep,name=causes"`
+	// CrashLooping is the number of the containers in the CrashLoopBackOff state.
+	// +optional
+	CrashLooping uint32 `json:"crashLooping,omitempty" protobuf:"varint,3,opt,name=crashLooping"`
+	// LastCause is the cause of the last container termination.
+	// +optional
+	LastCause RestartCause `json:"lastCause,omitempty" protobuf:"bytes,4,opt,name=lastCause,casttype=RestartCause"`
+	// LastMessage is the termination message of the last terminated container.
+	// +optional
+	LastMessage string `json:"lastMessage,omitempty" protobuf:"bytes,5,opt,name=lastMessage"`
+	// LastContainer is the pod and the container name of the last terminated container, e.g. "my-pl-cat-0-abcde/numa".
+	// +optional
+	LastContainer string `json:"lastContainer,omitempty" protobuf:"bytes,6,opt,name=lastContainer"`
+	// LastTerminatedAt is the time of the last container termination.
+	// +optional
+	LastTerminatedAt metav1.Time `json:"lastTerminatedAt,omitempty" protobuf:"bytes,7,opt,name=lastTerminatedAt"`
+	// Halted is true if the restart budget is exceeded and the scaling and the pod recreation of the vertex are halted.
+	// +optional
+	Halted bool `json:"halted,omitempty" protobuf:"varint,8,opt,name=halted"`
+}
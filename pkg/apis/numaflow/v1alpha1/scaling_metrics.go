@@ -0,0 +1,75 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:validation:Enum=max;weighted
+type ScalingMetricsPolicy string
+
+const (
+	// ScalingMetricsPolicyMax uses the largest of the replicas desired by the builtin and the custom metrics.
+	ScalingMetricsPolicyMax ScalingMetricsPolicy = "max"
+	// ScalingMetricsPolicyWeighted uses the weighted average of the replicas desired by the builtin and the custom metrics.
+	ScalingMetricsPolicyWeighted ScalingMetricsPolicy = "weighted"
+)
+
+// ScalingMetric is an additional signal to autoscale a vertex on. The replicas desired by the metric is proportional
+// to its value against the target value, i.e. ceil(currentReplicas * value / targetValue).
+type ScalingMetric struct {
+	// Name of the metric, used in the scaling decisions.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Prometheus gets the value of the metric with a PromQL query.
+	// +optional
+	Prometheus *PrometheusScalingMetric `json:"prometheus,omitempty" protobuf:"bytes,2,opt,name=prometheus"`
+	// External gets the value of the metric from the Kubernetes external metrics API.
+	// +optional
+	External *ExternalScalingMetric `json:"external,omitempty" protobuf:"bytes,3,opt,name=external"`
+	// TargetValue is the value of the metric expected to be handled by one replica.
+	TargetValue resource.Quantity `json:"targetValue" protobuf:"bytes,4,opt,name=targetValue"`
+	// Weight of the metric with the "weighted" metrics policy, defaults to 1.
+	// +optional
+	Weight *uint32 `json:"weight,omitempty" protobuf:"varint,5,opt,name=weight"`
+}
+
+func (sm ScalingMetric) GetWeight() uint32 {
+	if sm.Weight == nil {
+		return DefaultScalingMetricWeight
+	}
+	return *sm.Weight
+}
+
+// PrometheusScalingMetric is a scaling metric queried from Prometheus.
+type PrometheusScalingMetric struct {
+	// ServerURL is the address of the Prometheus server, e.g. http://prometheus.monitoring.svc:9090.
+	ServerURL string `json:"serverURL" protobuf:"bytes,1,opt,name=serverURL"`
+	// Query is the PromQL query, which must return a scalar or a vector with exactly one sample.
+	Query string `json:"query" protobuf:"bytes,2,opt,name=query"`
+}
+
+// ExternalScalingMetric is a scaling metric read from the Kubernetes external metrics API (external.metrics.k8s.io)
+// in the namespace of the vertex, the values of all the matching series are summed up.
+type ExternalScalingMetric struct {
+	// MetricName is the name of the external metric.
+	MetricName string `json:"metricName" protobuf:"bytes,1,opt,name=metricName"`
+	// Selector is the label selector of the metric series.
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty" protobuf:"bytes,2,opt,name=selector"`
+}
@@ -0,0 +1,41 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/utils/pointer"
+)
+
+func TestScalingMetric_GetWeight(t *testing.T) {
+	sm := ScalingMetric{}
+	assert.Equal(t, uint32(DefaultScalingMetricWeight), sm.GetWeight())
+	sm.Weight = pointer.Uint32(3)
+	assert.Equal(t, uint32(3), sm.GetWeight())
+}
+
+func TestScale_GetMetricsPolicy(t *testing.T) {
+	s := Scale{}
+	assert.Equal(t, ScalingMetricsPolicyMax, s.GetMetricsPolicy())
+	assert.Equal(t, uint32(DefaultScalingMetricWeight), s.GetBuiltinMetricsWeight())
+	s.MetricsPolicy = ScalingMetricsPolicyWeighted
+	s.BuiltinMetricsWeight = pointer.Uint32(0)
+	assert.Equal(t, ScalingMetricsPolicyWeighted, s.GetMetricsPolicy())
+	assert.Equal(t, uint32(0), s.GetBuiltinMetricsWeight())
+}
@@ -0,0 +1,45 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ScalingSchedule is a recurring time window during which the vertex is kept at no less than a given number of
+// replicas, it's used to pre-scale a vertex before a known traffic peak. The reactive autoscaling still works
+// above the floor.
+type ScalingSchedule struct {
+	// Name of the schedule, used in the scaling decisions.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Start is the standard cron expression of the start of the window, for example, "30 8 * * 1-5".
+	Start string `json:"start" protobuf:"bytes,2,opt,name=start"`
+	// Duration of the window.
+	Duration metav1.Duration `json:"duration" protobuf:"bytes,3,opt,name=duration"`
+	// Timezone of the cron expression, for example, "America/New_York", defaults to UTC.
+	// +optional
+	Timezone *string `json:"timezone,omitempty" protobuf:"bytes,4,opt,name=timezone"`
+	// Min is the minimum replicas during the window, it can not be greater than the max replicas of the vertex.
+	Min int32 `json:"min" protobuf:"varint,5,opt,name=min"`
+}
+
+func (ss ScalingSchedule) GetTimezone() string {
+	if ss.Timezone == nil || *ss.Timezone == "" {
+		return "UTC"
+	}
+	return *ss.Timezone
+}
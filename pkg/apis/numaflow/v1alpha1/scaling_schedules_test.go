@@ -0,0 +1,33 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/utils/pointer"
+)
+
+func TestScalingSchedule_GetTimezone(t *testing.T) {
+	ss := ScalingSchedule{}
+	assert.Equal(t, "UTC", ss.GetTimezone())
+	ss.Timezone = pointer.String("")
+	assert.Equal(t, "UTC", ss.GetTimezone())
+	ss.Timezone = pointer.String("America/New_York")
+	assert.Equal(t, "America/New_York", ss.GetTimezone())
+}
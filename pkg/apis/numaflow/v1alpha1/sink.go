@@ -26,6 +26,34 @@ type Sink struct {
 	Kafka     *KafkaSink `json:"kafka,omitempty" protobuf:"bytes,2,opt,name=kafka"`
 	Blackhole *Blackhole `json:"blackhole,omitempty" protobuf:"bytes,3,opt,name=blackhole"`
 	UDSink    *UDSink    `json:"udsink,omitempty" protobuf:"bytes,4,opt,name=udsink"`
+	// +optional
+	Pulsar *PulsarSink `json:"pulsar,omitempty" protobuf:"bytes,5,opt,name=pulsar"`
+	// +optional
+	Elasticsearch *ElasticsearchSink `json:"elasticsearch,omitempty" protobuf:"bytes,6,opt,name=elasticsearch"`
+	// WatermarkGate holds the messages in the sink until the watermark passes their event times, so that the results
+	// of the windows of an upstream reduce vertex are only emitted once the windows are final.
+	// +optional
+	WatermarkGate *WatermarkGate `json:"watermarkGate,omitempty" protobuf:"bytes,7,opt,name=watermarkGate"`
+	// +optional
+	ClickHouse *ClickHouseSink `json:"clickhouse,omitempty" protobuf:"bytes,8,opt,name=clickhouse"`
+}
+
+// WatermarkGate describes how the messages are held in the sink until the watermark passes their event times.
+// The held messages are not acknowledged, they stay in the Inter-Step Buffer until they are emitted, and are read again
+// if the pod restarts.
+type WatermarkGate struct {
+	// MaxHeldMessages is the max number of the messages held by each partition of the sink, no more messages are read
+	// beyond it until the held ones are emitted. Defaults to 10000. It should be less than the max ack pending of the
+	// Inter-Step Buffer.
+	// +optional
+	MaxHeldMessages *uint32 `json:"maxHeldMessages,omitempty" protobuf:"varint,1,opt,name=maxHeldMessages"`
+}
+
+func (wg WatermarkGate) GetMaxHeldMessages() int {
+	if wg.MaxHeldMessages == nil {
+		return DefaultWatermarkGateMaxHeldMessages
+	}
+	return int(*wg.MaxHeldMessages)
 }
 
 func (s Sink) getContainers(req getContainerReq) ([]corev1.Container, error) {
@@ -22,6 +22,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	resource "k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/pointer"
 )
 
 func Test_Sink_getContainers(t *testing.T) {
@@ -71,3 +72,10 @@ func Test_Sink_getUDSinkContainer(t *testing.T) {
 	assert.Equal(t, testImagePullPolicy, c.ImagePullPolicy)
 	assert.True(t, c.LivenessProbe != nil)
 }
+
+func Test_WatermarkGate_GetMaxHeldMessages(t *testing.T) {
+	wg := WatermarkGate{}
+	assert.Equal(t, DefaultWatermarkGateMaxHeldMessages, wg.GetMaxHeldMessages())
+	wg.MaxHeldMessages = pointer.Uint32(100)
+	assert.Equal(t, 100, wg.GetMaxHeldMessages())
+}
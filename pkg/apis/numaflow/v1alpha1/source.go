@@ -41,6 +41,21 @@ type Source struct {
 	UDTransformer *UDTransformer `json:"transformer,omitempty" protobuf:"bytes,6,opt,name=transformer"`
 	// +optional
 	UDSource *UDSource `json:"udsource,omitempty" protobuf:"bytes,7,opt,name=udSource"`
+	// +optional
+	Pulsar *PulsarSource `json:"pulsar,omitempty" protobuf:"bytes,8,opt,name=pulsar"`
+	// +optional
+	WebSocket *WebSocketSource `json:"webSocket,omitempty" protobuf:"bytes,9,opt,name=webSocket"`
+	// +optional
+	MQTT *MQTTSource `json:"mqtt,omitempty" protobuf:"bytes,10,opt,name=mqtt"`
+	// Transformers is an ordered chain of source transformers, the output of a stage is the input of the next one,
+	// and the output of the last stage is assigned the watermark and forwarded. It can not be used together with
+	// the single "transformer".
+	// +optional
+	Transformers []TransformerStage `json:"transformers,omitempty" protobuf:"bytes,11,rep,name=transformers"`
+	// BurstBuffer enables spooling the messages to a local volume when the writes to the inter-step buffers fail,
+	// so that a push source like HTTP keeps accepting the traffic during a short ISB Service maintenance window.
+	// +optional
+	BurstBuffer *BurstBuffer `json:"burstBuffer,omitempty" protobuf:"bytes,12,opt,name=burstBuffer"`
 }
 
 func (s Source) getContainers(req getContainerReq) ([]corev1.Container, error) {
@@ -48,7 +63,15 @@ func (s Source) getContainers(req getContainerReq) ([]corev1.Container, error) {
 		s.getMainContainer(req),
 	}
 	if s.UDTransformer != nil {
-		containers = append(containers, s.getUDTransformerContainer(req))
+		containers = append(containers, s.getUDTransformerContainer(req, CtrUdtransformer, *s.UDTransformer))
+	}
+	for _, stage := range s.Transformers {
+		// Each stage has its own runtime directory, so that the stages don't share the socket and the server info file,
+		// the main container reaches them under PathTransformerStagesVarRun.
+		stageReq := req
+		stageReq.volumeMounts = []corev1.VolumeMount{{Name: stage.VolumeName(), MountPath: PathVarRun}}
+		containers = append(containers, s.getUDTransformerContainer(stageReq, stage.ContainerName(), stage.UDTransformer))
+		containers[0].VolumeMounts = append(containers[0].VolumeMounts, corev1.VolumeMount{Name: stage.VolumeName(), MountPath: stage.RuntimeDir()})
 	}
 	if s.UDSource != nil {
 		containers = append(containers, s.getUDSourceContainer(req))
@@ -60,12 +83,12 @@ func (s Source) getMainContainer(req getContainerReq) corev1.Container {
 	return containerBuilder{}.init(req).args("processor", "--type="+string(VertexTypeSource), "--isbsvc-type="+string(req.isbSvcType)).build()
 }
 
-func (s Source) getUDTransformerContainer(mainContainerReq getContainerReq) corev1.Container {
+func (s Source) getUDTransformerContainer(mainContainerReq getContainerReq, name string, transformer UDTransformer) corev1.Container {
 	c := containerBuilder{}.
-		name(CtrUdtransformer).
+		name(name).
 		imagePullPolicy(mainContainerReq.imagePullPolicy). // Use the same image pull policy as the main container
 		appendVolumeMounts(mainContainerReq.volumeMounts...)
-	if x := s.UDTransformer.Container; x != nil && x.Image != "" { // customized image
+	if x := transformer.Container; x != nil && x.Image != "" { // customized image
 		c = c.image(x.Image)
 		if len(x.Command) > 0 {
 			c = c.command(x.Command...)
@@ -74,12 +97,12 @@ func (s Source) getUDTransformerContainer(mainContainerReq getContainerReq) core
 			c = c.args(x.Args...)
 		}
 	} else { // built-in
-		args := []string{"builtin-transformer", "--name=" + s.UDTransformer.Builtin.Name}
-		for _, a := range s.UDTransformer.Builtin.Args {
+		args := []string{"builtin-transformer", "--name=" + transformer.Builtin.Name}
+		for _, a := range transformer.Builtin.Args {
 			args = append(args, "--args="+base64.StdEncoding.EncodeToString([]byte(a)))
 		}
 		var kwargs []string
-		for k, v := range s.UDTransformer.Builtin.KWArgs {
+		for k, v := range transformer.Builtin.KWArgs {
 			kwargs = append(kwargs, fmt.Sprintf("%s=%s", k, base64.StdEncoding.EncodeToString([]byte(v))))
 		}
 		if len(kwargs) > 0 {
@@ -92,7 +115,7 @@ func (s Source) getUDTransformerContainer(mainContainerReq getContainerReq) core
 
 		c = c.image(mainContainerReq.image).args(args...) // Use the same image as the main container
 	}
-	if x := s.UDTransformer.Container; x != nil {
+	if x := transformer.Container; x != nil {
 		c = c.appendEnv(x.Env...).appendVolumeMounts(x.VolumeMounts...).resources(x.Resources).securityContext(x.SecurityContext).appendEnvFrom(x.EnvFrom...)
 		if x.ImagePullPolicy != nil {
 			c = c.imagePullPolicy(*x.ImagePullPolicy)
@@ -69,6 +69,45 @@ func TestSource_getContainers(t *testing.T) {
 	assert.Equal(t, testImagePullPolicy, c[1].ImagePullPolicy)
 }
 
+func TestSource_getContainers_transformerChain(t *testing.T) {
+	x := Source{
+		HTTP: &HTTPSource{},
+		Transformers: []TransformerStage{
+			{Name: "decompress", UDTransformer: UDTransformer{Container: &Container{Image: "my-decompress"}}},
+			{Name: "parse", UDTransformer: UDTransformer{Builtin: &Transformer{Name: "filter"}}},
+		},
+	}
+	c, err := x.getContainers(getContainerReq{
+		image:        "main-image",
+		volumeMounts: []corev1.VolumeMount{{Name: "var-run-numaflow", MountPath: PathVarRun}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(c))
+	assert.Equal(t, []corev1.VolumeMount{
+		{Name: "var-run-numaflow", MountPath: PathVarRun},
+		{Name: "var-run-transformer-decompress", MountPath: "/var/run/numaflow/transformers/decompress"},
+		{Name: "var-run-transformer-parse", MountPath: "/var/run/numaflow/transformers/parse"},
+	}, c[0].VolumeMounts)
+	assert.Equal(t, "transformer-decompress", c[1].Name)
+	assert.Equal(t, "my-decompress", c[1].Image)
+	assert.Equal(t, []corev1.VolumeMount{{Name: "var-run-transformer-decompress", MountPath: PathVarRun}}, c[1].VolumeMounts)
+	assert.Equal(t, "transformer-parse", c[2].Name)
+	assert.Equal(t, "main-image", c[2].Image)
+	assert.Contains(t, c[2].Args, "--name=filter")
+	assert.Equal(t, []corev1.VolumeMount{{Name: "var-run-transformer-parse", MountPath: PathVarRun}}, c[2].VolumeMounts)
+}
+
+func TestTransformerStage_GetOnFailure(t *testing.T) {
+	ts := TransformerStage{Name: "parse"}
+	assert.Equal(t, TransformerFailureRetry, ts.GetOnFailure())
+	route := TransformerFailureRoute
+	ts.OnFailure = &route
+	assert.Equal(t, TransformerFailureRoute, ts.GetOnFailure())
+	unknown := TransformerFailureStrategy("unknown")
+	ts.OnFailure = &unknown
+	assert.Equal(t, TransformerFailureRetry, ts.GetOnFailure())
+}
+
 func Test_getTransformerContainer(t *testing.T) {
 	t.Run("with customized image", func(t *testing.T) {
 		x := Source{
@@ -88,7 +127,7 @@ func Test_getTransformerContainer(t *testing.T) {
 		c := x.getUDTransformerContainer(getContainerReq{
 			image:           "main-image",
 			imagePullPolicy: corev1.PullAlways,
-		})
+		}, CtrUdtransformer, *x.UDTransformer)
 		assert.Equal(t, "my-image", c.Image)
 		assert.Equal(t, corev1.PullNever, c.ImagePullPolicy)
 		assert.Contains(t, c.Args, "my-arg")
@@ -118,7 +157,7 @@ func Test_getTransformerContainer(t *testing.T) {
 			env: []corev1.EnvVar{
 				{Name: "a", Value: "b"},
 			},
-		})
+		}, CtrUdtransformer, *x.UDTransformer)
 		assert.NotNil(t, c.SecurityContext)
 		assert.Equal(t, "main-image", c.Image)
 		assert.Equal(t, corev1.PullNever, c.ImagePullPolicy)
@@ -151,10 +190,10 @@ func Test_getTransformerContainer(t *testing.T) {
 			}}
 		c1 := x.getUDTransformerContainer(getContainerReq{
 			image: "main-image",
-		})
+		}, CtrUdtransformer, *x.UDTransformer)
 		c2 := x.getUDTransformerContainer(getContainerReq{
 			image: "main-image",
-		})
+		}, CtrUdtransformer, *x.UDTransformer)
 		assert.Equal(t, getKWArgs(c1), getKWArgs(c2))
 	})
 }
@@ -0,0 +1,46 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+// Tracing describes the OpenTelemetry tracing of a pipeline.
+type Tracing struct {
+	// OTLP exports the spans to an OpenTelemetry collector, Jaeger or Tempo through OTLP/gRPC.
+	OTLP *OTLPTraceExporter `json:"otlp,omitempty" protobuf:"bytes,1,opt,name=otlp"`
+	// SamplingPercentage is the percentage of the traces started at the sources to be sampled, the messages carrying
+	// a trace context follow the sampling decision of their parents. Defaults to 100.
+	// +optional
+	SamplingPercentage *uint32 `json:"samplingPercentage,omitempty" protobuf:"varint,2,opt,name=samplingPercentage"`
+}
+
+// OTLPTraceExporter describes an OTLP/gRPC trace exporter.
+type OTLPTraceExporter struct {
+	// Endpoint of the collector, in the format of host:port.
+	Endpoint string `json:"endpoint" protobuf:"bytes,1,opt,name=endpoint"`
+	// Insecure disables the client transport security.
+	// +optional
+	Insecure bool `json:"insecure,omitempty" protobuf:"varint,2,opt,name=insecure"`
+	// Headers are sent with each export request, e.g. for authentication.
+	// +optional
+	Headers map[string]string `json:"headers,omitempty" protobuf:"bytes,3,rep,name=headers"`
+}
+
+func (t *Tracing) GetSamplingRatio() float64 {
+	if t == nil || t.SamplingPercentage == nil {
+		return 1
+	}
+	return float64(*t.SamplingPercentage) / 100
+}
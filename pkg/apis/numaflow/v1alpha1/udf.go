@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -28,7 +29,7 @@ import (
 )
 
 type Function struct {
-	// +kubebuilder:validation:Enum=cat;filter
+	// +kubebuilder:validation:Enum=cat;filter;quota;enrich
 	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
 	// +optional
 	Args []string `json:"args,omitempty" protobuf:"bytes,2,rep,name=args"`
@@ -43,12 +44,41 @@ type UDF struct {
 	Builtin *Function `json:"builtin" protobuf:"bytes,2,opt,name=builtin"`
 	// +optional
 	GroupBy *GroupBy `json:"groupBy" protobuf:"bytes,3,opt,name=groupBy"`
+	// Remote connects to a map UDF served remotely, e.g. by a shared inference service, instead of running it in a
+	// sidecar container. Not supported in reduce vertices.
+	// +optional
+	Remote *RemoteUDF `json:"remote,omitempty" protobuf:"bytes,4,opt,name=remote"`
+}
+
+// RemoteUDF describes a UDF served remotely over TCP.
+type RemoteUDF struct {
+	// Endpoint is the address of the remote gRPC server, in the format of "host:port".
+	Endpoint string `json:"endpoint" protobuf:"bytes,1,opt,name=endpoint"`
+	// TLS configures the TLS connection to the remote server, the client cert and key enable the mutual TLS.
+	// The connection is not encrypted if it is not specified.
+	// +optional
+	TLS *TLS `json:"tls,omitempty" protobuf:"bytes,2,opt,name=tls"`
 }
 
 func (in UDF) getContainers(req getContainerReq) ([]corev1.Container, error) {
+	if in.IsJoin() || in.IsRemote() {
+		// A join is processed by the main container, and a remote UDF is connected by the main container,
+		// there's no user defined container.
+		return []corev1.Container{in.getMainContainer(req)}, nil
+	}
 	return []corev1.Container{in.getMainContainer(req), in.getUDFContainer(req)}, nil
 }
 
+// IsRemote returns true if the UDF is served remotely.
+func (in UDF) IsRemote() bool {
+	return in.Remote != nil
+}
+
+// IsJoin returns true if the UDF joins the messages of the inbound edges.
+func (in UDF) IsJoin() bool {
+	return in.GroupBy != nil && in.GroupBy.Join != nil
+}
+
 func (in UDF) getMainContainer(req getContainerReq) corev1.Container {
 	if in.GroupBy == nil {
 		args := []string{"processor", "--type=" + string(VertexTypeMapUDF), "--isbsvc-type=" + string(req.isbSvcType)}
@@ -124,6 +154,36 @@ type GroupBy struct {
 	AllowedLateness *metav1.Duration `json:"allowedLateness,omitempty" protobuf:"bytes,3,opt,name=allowedLateness"`
 	// Storage is used to define the PBQ storage for a reduce vertex.
 	Storage *PBQStorage `json:"storage,omitempty" protobuf:"bytes,4,opt,name=storage"`
+	// Join joins the messages from the two inbound edges of the vertex on their keys within each window,
+	// no user defined container is needed when it is specified.
+	// +optional
+	Join *Join `json:"join,omitempty" protobuf:"bytes,5,opt,name=join"`
+}
+
+type JoinType string
+
+const (
+	// JoinTypeInner only emits the keys having messages from both of the inbound edges.
+	JoinTypeInner JoinType = "inner"
+	// JoinTypeOuter also emits the keys only having messages from one of the inbound edges.
+	JoinTypeOuter JoinType = "outer"
+)
+
+// Join describes a keyed join of the messages from the inbound edges within a window.
+// Each pair of the messages with the same keys from the two inbound edges is merged into one message, whose payload
+// is a JSON object with the names of the from vertices as the fields and the original payloads as the values.
+type Join struct {
+	// Type of the join, "inner" or "outer", defaults to "inner".
+	// +kubebuilder:validation:Enum="";inner;outer
+	// +optional
+	Type JoinType `json:"type,omitempty" protobuf:"bytes,1,opt,name=type,casttype=JoinType"`
+}
+
+func (j Join) GetType() JoinType {
+	if j.Type == "" {
+		return JoinTypeInner
+	}
+	return j.Type
 }
 
 // Window describes windowing strategy
@@ -132,6 +192,13 @@ type Window struct {
 	Fixed *FixedWindow `json:"fixed" protobuf:"bytes,1,opt,name=fixed"`
 	// +optional
 	Sliding *SlidingWindow `json:"sliding" protobuf:"bytes,2,opt,name=sliding"`
+	// Custom windows are assigned and merged by the user container, which implements the Windower gRPC service.
+	// +optional
+	Custom *CustomWindow `json:"custom,omitempty" protobuf:"bytes,3,opt,name=custom"`
+	// Accumulator windows accumulate the messages of each key, and emit them on the triggers instead of the
+	// aligned window boundaries.
+	// +optional
+	Accumulator *AccumulatorWindow `json:"accumulator,omitempty" protobuf:"bytes,4,opt,name=accumulator"`
 }
 
 // FixedWindow describes a fixed window
@@ -145,6 +212,52 @@ type SlidingWindow struct {
 	Slide  *metav1.Duration `json:"slide,omitempty" protobuf:"bytes,2,opt,name=slide"`
 }
 
+// CustomWindow describes a window assigned and merged by the user container
+type CustomWindow struct {
+	// MaxLength is the max length a window could be extended to by merging, the merges beyond it are ignored,
+	// so that a window always gets closed by the watermark. Defaults to 1 hour.
+	// +optional
+	MaxLength *metav1.Duration `json:"maxLength,omitempty" protobuf:"bytes,1,opt,name=maxLength"`
+}
+
+func (cw *CustomWindow) GetMaxLength() time.Duration {
+	if cw == nil || cw.MaxLength == nil {
+		return time.Hour
+	}
+	return cw.MaxLength.Duration
+}
+
+// AccumulatorWindow describes a window of each key, which starts with the first message of the key and is emitted when
+// any of the triggers fires, the next message of the key starts a new window.
+type AccumulatorWindow struct {
+	// Count triggers the emission when the given number of messages are accumulated for a key.
+	// +optional
+	Count *uint32 `json:"count,omitempty" protobuf:"varint,1,opt,name=count"`
+	// Timeout triggers the emission when there's no new message for a key within the given duration, which is measured
+	// in event time and tracked by the watermark. Defaults to 1 minute.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty" protobuf:"bytes,2,opt,name=timeout"`
+	// Flush enables the user container to trigger the emission of the keys explicitly, by serving the Trigger gRPC
+	// service along with the reduce service.
+	// +optional
+	Flush bool `json:"flush,omitempty" protobuf:"varint,3,opt,name=flush"`
+}
+
+// GetCount returns the count trigger, 0 means it's disabled.
+func (aw *AccumulatorWindow) GetCount() int {
+	if aw == nil || aw.Count == nil {
+		return 0
+	}
+	return int(*aw.Count)
+}
+
+func (aw *AccumulatorWindow) GetTimeout() time.Duration {
+	if aw == nil || aw.Timeout == nil {
+		return time.Minute
+	}
+	return aw.Timeout.Duration
+}
+
 // PBQStorage defines the persistence configuration for a vertex.
 type PBQStorage struct {
 	// +optional
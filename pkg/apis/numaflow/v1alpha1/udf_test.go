@@ -66,6 +66,42 @@ func TestUDF_getContainers(t *testing.T) {
 	assert.True(t, c[1].LivenessProbe != nil)
 }
 
+func TestUDF_getContainers_join(t *testing.T) {
+	x := UDF{
+		GroupBy: &GroupBy{
+			Keyed: true,
+			Join:  &Join{},
+		},
+	}
+	assert.True(t, x.IsJoin())
+	c, err := x.getContainers(getContainerReq{
+		image:           "main-image",
+		imagePullPolicy: corev1.PullAlways,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(c))
+	assert.Equal(t, "main-image", c[0].Image)
+	assert.Contains(t, c[0].Args, "--type="+string(VertexTypeReduceUDF))
+	assert.Equal(t, JoinTypeInner, x.GroupBy.Join.GetType())
+	x.GroupBy.Join.Type = JoinTypeOuter
+	assert.Equal(t, JoinTypeOuter, x.GroupBy.Join.GetType())
+}
+
+func TestUDF_getContainers_remote(t *testing.T) {
+	x := UDF{
+		Remote: &RemoteUDF{Endpoint: "inference:8443"},
+	}
+	assert.True(t, x.IsRemote())
+	c, err := x.getContainers(getContainerReq{
+		image:           "main-image",
+		imagePullPolicy: corev1.PullAlways,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(c))
+	assert.Equal(t, "main-image", c[0].Image)
+	assert.Contains(t, c[0].Args, "--type="+string(VertexTypeMapUDF))
+}
+
 func Test_getUDFContainer(t *testing.T) {
 	t.Run("with customized image", func(t *testing.T) {
 		x := UDF{
@@ -24,10 +24,66 @@ type UDTransformer struct {
 }
 
 type Transformer struct {
-	// +kubebuilder:validation:Enum=eventTimeExtractor;filter;timeExtractionFilter
+	// +kubebuilder:validation:Enum=eventTimeExtractor;filter;timeExtractionFilter;schemaUpconvert
 	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
 	// +optional
 	Args []string `json:"args,omitempty" protobuf:"bytes,2,rep,name=args"`
 	// +optional
 	KWArgs map[string]string `json:"kwargs,omitempty" protobuf:"bytes,3,rep,name=kwargs"`
 }
+
+// TransformerStage is a stage of a source transformer chain.
+type TransformerStage struct {
+	// Name of the stage, unique in the chain. The container of the stage is named "transformer-{name}".
+	Name          string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	UDTransformer `json:",inline" protobuf:"bytes,2,opt,name=uDTransformer"`
+	// OnFailure specifies what to do with a message when the stage fails to transform it.
+	// There are currently four options, retry, drop, skip and route.
+	// "retry" keeps retrying the message from the first stage of the chain, "drop" drops the message,
+	// "skip" passes the message to the next stage as it is, and "route" tags the message with the failure tag
+	// and forwards it as it is without going through the rest of the stages.
+	// If not provided, the default value is set to "retry".
+	// +kubebuilder:validation:Enum=retry;drop;skip;route
+	// +optional
+	OnFailure *TransformerFailureStrategy `json:"onFailure,omitempty" protobuf:"bytes,3,opt,name=onFailure"`
+	// FailureTag is the tag of the messages routed on failures, required if OnFailure is "route".
+	// +optional
+	FailureTag string `json:"failureTag,omitempty" protobuf:"bytes,4,opt,name=failureTag"`
+}
+
+// ContainerName returns the name of the container running the stage.
+func (ts TransformerStage) ContainerName() string {
+	return CtrUdtransformer + "-" + ts.Name
+}
+
+// VolumeName returns the name of the volume of the runtime directory of the stage.
+func (ts TransformerStage) VolumeName() string {
+	return "var-run-" + ts.ContainerName()
+}
+
+// RuntimeDir returns the path of the runtime directory of the stage in the main container.
+func (ts TransformerStage) RuntimeDir() string {
+	return PathTransformerStagesVarRun + "/" + ts.Name
+}
+
+// GetOnFailure returns the failure strategy of the stage, defaults to "retry".
+func (ts TransformerStage) GetOnFailure() TransformerFailureStrategy {
+	if ts.OnFailure == nil {
+		return TransformerFailureRetry
+	}
+	switch *ts.OnFailure {
+	case TransformerFailureRetry, TransformerFailureDrop, TransformerFailureSkip, TransformerFailureRoute:
+		return *ts.OnFailure
+	default:
+		return TransformerFailureRetry
+	}
+}
+
+type TransformerFailureStrategy string
+
+const (
+	TransformerFailureRetry TransformerFailureStrategy = "retry"
+	TransformerFailureDrop  TransformerFailureStrategy = "drop"
+	TransformerFailureSkip  TransformerFailureStrategy = "skip"
+	TransformerFailureRoute TransformerFailureStrategy = "route"
+)
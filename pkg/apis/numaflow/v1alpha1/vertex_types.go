@@ -37,6 +37,14 @@ const (
 	VertexPhaseRunning   VertexPhase = "Running"
 	VertexPhaseSucceeded VertexPhase = "Succeeded"
 	VertexPhaseFailed    VertexPhase = "Failed"
+
+	// VertexConditionBuffersHealthy has the status True when the buffers
+	// the Vertex reads from and writes to are available.
+	VertexConditionBuffersHealthy ConditionType = "BuffersHealthy"
+	// VertexConditionWithinRestartBudget has the status True when the container
+	// restarts of the Vertex pods are within the restart budget, it's only set
+	// with a restart budget.
+	VertexConditionWithinRestartBudget ConditionType = "WithinRestartBudget"
 )
 
 type VertexType string
@@ -106,7 +114,7 @@ func (v Vertex) GetVertexType() VertexType {
 }
 
 func (v Vertex) Scalable() bool {
-	if v.Spec.Scale.Disabled || v.IsReduceUDF() {
+	if v.Spec.Scale.Disabled || v.IsReduceUDF() || v.IsPacked() {
 		return false
 	}
 	if v.IsASink() || v.IsMapUDF() {
@@ -125,13 +133,48 @@ func (v Vertex) GetPartitionCount() int {
 	return v.Spec.GetPartitionCount()
 }
 
+// GetPodNamespace returns the namespace where the pods and services of the vertex are created.
+func (v Vertex) GetPodNamespace() string {
+	return v.Spec.GetPodNamespace(v.Namespace)
+}
+
+// IsCrossNamespace returns true if the pods of the vertex run in a namespace other than the one of the vertex object,
+// in which case they can not be owned by the vertex object.
+func (v Vertex) IsCrossNamespace() bool {
+	return v.GetPodNamespace() != v.Namespace
+}
+
+// PodLabels returns the labels used to identify the pods, services and PVCs of the vertex.
+func (v Vertex) PodLabels() map[string]string {
+	labels := map[string]string{
+		KeyPartOf:       Project,
+		KeyManagedBy:    ControllerVertex,
+		KeyComponent:    ComponentVertex,
+		KeyVertexName:   v.Spec.Name,
+		KeyPipelineName: v.Spec.PipelineName,
+	}
+	if v.IsCrossNamespace() {
+		labels[KeyVertexNamespace] = v.Namespace
+	}
+	return labels
+}
+
+// GetOwnerReferences returns the owner references of the objects created for the vertex, it's empty when the objects
+// are in another namespace, because cross namespace owner references are not allowed.
+func (v Vertex) GetOwnerReferences() []metav1.OwnerReference {
+	if v.IsCrossNamespace() {
+		return nil
+	}
+	return []metav1.OwnerReference{*metav1.NewControllerRef(v.GetObjectMeta(), VertexGroupVersionKind)}
+}
+
 func (v Vertex) GetHeadlessServiceName() string {
 	return v.Name + "-headless"
 }
 
 func (v Vertex) GetServiceObjs() []*corev1.Service {
 	svcs := []*corev1.Service{v.getServiceObj(v.GetHeadlessServiceName(), true, VertexMetricsPort, VertexMetricsPortName)}
-	if x := v.Spec.Source; x != nil && x.HTTP != nil && x.HTTP.Service {
+	if x := v.Spec.Source; x != nil && ((x.HTTP != nil && x.HTTP.Service) || (x.WebSocket != nil && x.WebSocket.Service)) {
 		svcs = append(svcs, v.getServiceObj(v.Name, false, VertexHTTPSPort, VertexHTTPSPortName))
 	}
 	return svcs
@@ -140,28 +183,16 @@ func (v Vertex) GetServiceObjs() []*corev1.Service {
 func (v Vertex) getServiceObj(name string, headless bool, port int, servicePortName string) *corev1.Service {
 	svc := &corev1.Service{
 		ObjectMeta: metav1.ObjectMeta{
-			Namespace:       v.Namespace,
+			Namespace:       v.GetPodNamespace(),
 			Name:            name,
-			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(v.GetObjectMeta(), VertexGroupVersionKind)},
-			Labels: map[string]string{
-				KeyPartOf:       Project,
-				KeyManagedBy:    ControllerVertex,
-				KeyComponent:    ComponentVertex,
-				KeyVertexName:   v.Spec.Name,
-				KeyPipelineName: v.Spec.PipelineName,
-			},
+			OwnerReferences: v.GetOwnerReferences(),
+			Labels:          v.PodLabels(),
 		},
 		Spec: corev1.ServiceSpec{
 			Ports: []corev1.ServicePort{
 				{Port: int32(port), TargetPort: intstr.FromInt(port), Name: servicePortName},
 			},
-			Selector: map[string]string{
-				KeyPartOf:       Project,
-				KeyManagedBy:    ControllerVertex,
-				KeyComponent:    ComponentVertex,
-				KeyVertexName:   v.Spec.Name,
-				KeyPipelineName: v.Spec.PipelineName,
-			},
+			Selector: v.PodLabels(),
 		},
 	}
 	if headless {
@@ -211,6 +242,20 @@ func (v Vertex) GetPodSpec(req GetVertexPodSpecReq) (*corev1.PodSpec, error) {
 	envVars := []corev1.EnvVar{
 		{Name: EnvVertexObject, Value: encodedVertexSpec},
 	}
+	if len(req.PackedVertices) > 0 {
+		var packedCopies []Vertex
+		for _, pv := range req.PackedVertices {
+			packedCopies = append(packedCopies, Vertex{
+				ObjectMeta: metav1.ObjectMeta{Namespace: pv.Namespace, Name: pv.Name},
+				Spec:       pv.Spec.WithOutReplicas(),
+			})
+		}
+		packedBytes, err := json.Marshal(packedCopies)
+		if err != nil {
+			return nil, errors.New("failed to marshal packed vertex specs")
+		}
+		envVars = append(envVars, corev1.EnvVar{Name: EnvPackedVertexObjects, Value: base64.StdEncoding.EncodeToString(packedBytes)})
+	}
 	envVars = append(envVars, v.commonEnvs()...)
 	envVars = append(envVars, req.Env...)
 
@@ -261,6 +306,39 @@ func (v Vertex) GetPodSpec(req GetVertexPodSpecReq) (*corev1.PodSpec, error) {
 		TimeoutSeconds:      30,
 	}
 
+	if v.Spec.MessageSigning != nil {
+		// Only the main container signs and verifies the messages
+		signingKeysVolName := "signing-keys"
+		volumes = append(volumes, corev1.Volume{
+			Name: signingKeysVolName,
+			VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{
+				SecretName: GenerateSigningKeysSecretName(v.Spec.PipelineName),
+			}},
+		})
+		containers[0].VolumeMounts = append(containers[0].VolumeMounts, corev1.VolumeMount{Name: signingKeysVolName, MountPath: PathSigningKeysMount, ReadOnly: true})
+	}
+
+	if x := v.Spec.Source; x != nil {
+		for _, stage := range x.Transformers {
+			volumes = append(volumes, corev1.Volume{
+				Name: stage.VolumeName(),
+				VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{
+					Medium: corev1.StorageMediumMemory,
+				}},
+			})
+		}
+	}
+
+	if x := v.Spec.Cache; x != nil && x.Persistence != nil {
+		// The numa container serves the cache, and saves the entries to the volume
+		containers[0].VolumeMounts = append(containers[0].VolumeMounts, corev1.VolumeMount{Name: x.Persistence.VolumeName, MountPath: PathCachePersistenceMount})
+	}
+
+	if x := v.Spec.Source; x != nil && x.BurstBuffer != nil {
+		// The numa container spools the messages to the volume
+		containers[0].VolumeMounts = append(containers[0].VolumeMounts, corev1.VolumeMount{Name: x.BurstBuffer.VolumeName, MountPath: PathBurstBufferMount})
+	}
+
 	if len(containers) > 1 { // udf, udsink, udsource, or source vertex specifies a udtransformer
 		for i := 1; i < len(containers); i++ {
 			containers[i].Env = append(containers[i].Env, v.commonEnvs()...)
@@ -298,6 +376,10 @@ func (v Vertex) GetPodSpec(req GetVertexPodSpecReq) (*corev1.PodSpec, error) {
 				containers[i].VolumeMounts = append(containers[i].VolumeMounts, corev1.VolumeMount{Name: sideInputsVolName, MountPath: PathSideInputsMount, ReadOnly: true})
 			}
 		}
+		if x := v.Spec.Cache; x != nil && x.SideInput != "" {
+			// The numa container warms the cache with the side input
+			containers[0].VolumeMounts = append(containers[0].VolumeMounts, corev1.VolumeMount{Name: sideInputsVolName, MountPath: PathSideInputsMount, ReadOnly: true})
+		}
 		// Side Inputs init container
 		initContainers[1].VolumeMounts = append(initContainers[1].VolumeMounts, corev1.VolumeMount{Name: sideInputsVolName, MountPath: PathSideInputsMount})
 	}
@@ -360,6 +442,15 @@ func (v Vertex) OwnedBuffers() []string {
 	return v.Spec.OwnedBufferNames(v.Namespace, v.Spec.PipelineName)
 }
 
+// GetFromJetStreamDomain returns the JetStream domain of the buffers that the vertex reads from, empty means the domain
+// of the ISB Service. All the vertices writing to the same buffers are in the same domain.
+func (v Vertex) GetFromJetStreamDomain() string {
+	if len(v.Spec.FromEdges) == 0 {
+		return ""
+	}
+	return v.Spec.FromEdges[0].FromVertexJetStreamDomain
+}
+
 // GetFromBuckets returns the buckets that the vertex reads from.
 // For a source vertex, it returns the source bucket name.
 func (v Vertex) GetFromBuckets() []string {
@@ -373,25 +464,77 @@ func (v Vertex) GetFromBuckets() []string {
 	return r
 }
 
-// GetToBuckets returns the buckets that the vertex writes to.
-// For a sink vertex, it returns the sink bucket name.
+// GetFromVertexNames returns the distinct names of the vertices that the vertex reads from, in the order of the edges.
+func (v Vertex) GetFromVertexNames() []string {
+	r := []string{}
+	seen := make(map[string]bool)
+	for _, vt := range v.Spec.FromEdges {
+		if !seen[vt.From] {
+			seen[vt.From] = true
+			r = append(r, vt.From)
+		}
+	}
+	return r
+}
+
+// GetToBuckets returns the buckets that the vertex writes to, excluding the ones of the cross-pipeline edges, which are
+// in the ISB Service of the to pipeline. For a sink vertex, it returns the sink bucket name.
 func (v Vertex) GetToBuckets() []string {
 	if v.IsASink() {
 		return []string{GenerateSinkBucketName(v.Namespace, v.Spec.PipelineName, v.Spec.Name)}
 	}
 	r := []string{}
 	for _, vt := range v.Spec.ToEdges {
+		if vt.ToPipeline != nil {
+			continue
+		}
 		r = append(r, GenerateEdgeBucketName(v.Namespace, v.Spec.PipelineName, vt.From, vt.To))
 	}
 	return r
 }
 
+// GetJetStreamDomains returns the JetStream domains of the owned buffers, the from buckets and the to buckets of the
+// vertex, which are not in the domain of the ISB Service.
+func (v Vertex) GetJetStreamDomains() map[string]string {
+	r := map[string]string{}
+	if domain := v.GetFromJetStreamDomain(); domain != "" {
+		for _, b := range v.OwnedBuffers() {
+			r[b] = domain
+		}
+	}
+	for _, e := range v.Spec.FromEdges {
+		if e.FromVertexJetStreamDomain != "" {
+			r[GenerateEdgeBucketName(v.Namespace, v.Spec.PipelineName, e.From, e.To)] = e.FromVertexJetStreamDomain
+		}
+	}
+	if domain := v.Spec.GetJetStreamDomain(); domain != "" {
+		if v.IsASource() {
+			r[GenerateSourceBucketName(v.Namespace, v.Spec.PipelineName, v.Spec.Name)] = domain
+		}
+		for _, b := range v.GetToBuckets() {
+			r[b] = domain
+		}
+	}
+	return r
+}
+
+// GetPipelineStoreName returns the name of the stores shared in the pipeline, e.g. the counters and claim check stores,
+// which is the same as the side inputs store name of the pipeline.
+func (v Vertex) GetPipelineStoreName() string {
+	return fmt.Sprintf("%s-%s", v.Namespace, v.Spec.PipelineName)
+}
+
+// GetToBuffers returns the buffers that the vertex writes to, excluding the ones of the cross-pipeline edges, which are
+// in the ISB Service of the to pipeline.
 func (v Vertex) GetToBuffers() []string {
 	r := []string{}
 	if v.IsASink() {
 		return r
 	}
 	for _, vt := range v.Spec.ToEdges {
+		if vt.ToPipeline != nil {
+			continue
+		}
 		for i := 0; i < vt.GetToVertexPartitionCount(); i++ {
 			r = append(r, GenerateBufferName(v.Namespace, v.Spec.PipelineName, vt.To, i))
 		}
@@ -400,6 +543,10 @@ func (v Vertex) GetToBuffers() []string {
 }
 
 func (v Vertex) GetReplicas() int {
+	if v.IsPacked() {
+		// A packed vertex runs in the pods of its host vertex.
+		return 0
+	}
 	if v.IsReduceUDF() {
 		// Replica of a reduce vertex is determined by the partitions.
 		return v.GetPartitionCount()
@@ -410,6 +557,21 @@ func (v Vertex) GetReplicas() int {
 	return int(*v.Spec.Replicas)
 }
 
+// IsStrictFIFO returns true if any of the edges the vertex reads from is a strict FIFO edge.
+func (v Vertex) IsStrictFIFO() bool {
+	for _, e := range v.Spec.FromEdges {
+		if e.StrictFIFO {
+			return true
+		}
+	}
+	return false
+}
+
+// IsPacked returns true if the vertex runs in the pods of another vertex.
+func (v Vertex) IsPacked() bool {
+	return v.Spec.PackedInto != ""
+}
+
 func (v Vertex) MapUdfStreamEnabled() (bool, error) {
 	if v.Spec.Metadata != nil && v.Spec.Metadata.Annotations != nil {
 		if mapUdfStream, existing := v.Spec.Metadata.Annotations[MapUdfStreamKey]; existing {
@@ -419,6 +581,16 @@ func (v Vertex) MapUdfStreamEnabled() (bool, error) {
 	return false, nil
 }
 
+// SourceTransformerBatchEnabled returns true if the source transformer is requested to run in the batch mode.
+func (v Vertex) SourceTransformerBatchEnabled() (bool, error) {
+	if v.Spec.Metadata != nil && v.Spec.Metadata.Annotations != nil {
+		if batch, existing := v.Spec.Metadata.Annotations[SourceTransformerBatchKey]; existing {
+			return strconv.ParseBool(batch)
+		}
+	}
+	return false, nil
+}
+
 type VertexSpec struct {
 	AbstractVertex `json:",inline" protobuf:"bytes,1,opt,name=abstractVertex"`
 	PipelineName   string `json:"pipelineName" protobuf:"bytes,2,opt,name=pipelineName"`
@@ -435,6 +607,36 @@ type VertexSpec struct {
 	// +kubebuilder:default={"disabled": false}
 	// +optional
 	Watermark Watermark `json:"watermark,omitempty" protobuf:"bytes,7,opt,name=watermark"`
+	// ClaimCheck is populated from the pipeline claim check settings.
+	// +optional
+	ClaimCheck *ClaimCheck `json:"claimCheck,omitempty" protobuf:"bytes,8,opt,name=claimCheck"`
+	// Tracing is populated from the pipeline tracing settings.
+	// +optional
+	Tracing *Tracing `json:"tracing,omitempty" protobuf:"bytes,9,opt,name=tracing"`
+	// MessageSigning is populated from the pipeline message signing settings.
+	// +optional
+	MessageSigning *MessageSigning `json:"messageSigning,omitempty" protobuf:"bytes,10,opt,name=messageSigning"`
+	// PackedVertices are the names of the vertices packed into the pods of this vertex, populated when pod packing is
+	// enabled. The pods get the specs of them from their vertex objects.
+	// +optional
+	PackedVertices []string `json:"packedVertices,omitempty" protobuf:"bytes,11,rep,name=packedVertices"`
+	// PackedInto is the name of the vertex whose pods run this vertex, populated when pod packing is enabled.
+	// No pods are created for a packed vertex.
+	// +optional
+	PackedInto string `json:"packedInto,omitempty" protobuf:"bytes,12,opt,name=packedInto"`
+	// RuntimeImage is populated from the runtime image override of the pipeline.
+	// +optional
+	RuntimeImage string `json:"runtimeImage,omitempty" protobuf:"bytes,13,opt,name=runtimeImage"`
+	// MessageChecksum is populated from the pipeline message checksum settings.
+	// +optional
+	MessageChecksum *MessageChecksum `json:"messageChecksum,omitempty" protobuf:"bytes,14,opt,name=messageChecksum"`
+	// MessageEncryption is populated from the pipeline message encryption settings.
+	// +optional
+	MessageEncryption *MessageEncryption `json:"messageEncryption,omitempty" protobuf:"bytes,15,opt,name=messageEncryption"`
+	// ShuffleHeaderNames are the names of the headers used by the header shuffle strategies of the downstream edges,
+	// populated for the source vertices, which only carry these headers from the source messages.
+	// +optional
+	ShuffleHeaderNames []string `json:"shuffleHeaderNames,omitempty" protobuf:"bytes,16,rep,name=shuffleHeaderNames"`
 }
 
 type AbstractVertex struct {
@@ -480,6 +682,67 @@ type AbstractVertex struct {
 	// Container template for the side inputs watcher container.
 	// +optional
 	SideInputsContainerTemplate *ContainerTemplate `json:"sideInputsContainerTemplate,omitempty" protobuf:"bytes,15,opt,name=sideInputsContainerTemplate"`
+	// Namespace is the namespace where the pods and services of the vertex run, defaults to the namespace of the pipeline.
+	// The vertex still shares the ISB Service and the buffers of the pipeline. It requires the controller to be
+	// installed with the cluster scope, and the service account used by the pods to exist in the namespace.
+	// +optional
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,16,opt,name=namespace"`
+	// Cache is a local cache served by the numa container to the user defined container, it only applies to UDF vertices.
+	// +optional
+	Cache *Cache `json:"cache,omitempty" protobuf:"bytes,17,opt,name=cache"`
+	// RestartBudget limits the container restarts of the pods of the vertex, the restarts are categorized by cause in
+	// the vertex status regardless of it.
+	// +optional
+	RestartBudget *RestartBudget `json:"restartBudget,omitempty" protobuf:"bytes,18,opt,name=restartBudget"`
+	// Record records the input messages of the vertex to an object storage in the order they are read, so that they
+	// can be replayed through the same UDF to reproduce a problem. It only applies to UDF vertices.
+	// +optional
+	Record *Record `json:"record,omitempty" protobuf:"bytes,19,opt,name=record"`
+	// LeafNode connects the pods of the vertex to a NATS leaf node of the JetStream ISB Service instead of the ISB
+	// Service itself, e.g. a leaf node running at an edge site. The buffers and the buckets written by the vertex are
+	// kept in the JetStream domain of the leaf node, so that the vertex keeps running when the connection to the hub
+	// cluster is lost.
+	// +optional
+	LeafNode *LeafNode `json:"leafNode,omitempty" protobuf:"bytes,20,opt,name=leafNode"`
+	// ToPipeline connects the vertex to a vertex of another pipeline, the messages are written to the buffers of that
+	// vertex, together with the watermarks. It only applies to the non-sink vertices without outgoing edges, and the
+	// vertex of the other pipeline has to accept it in its fromPipelines.
+	// +optional
+	ToPipeline *PipelineVertex `json:"toPipeline,omitempty" protobuf:"bytes,21,opt,name=toPipeline"`
+	// FromPipelines are the vertices of other pipelines allowed to write to the vertex through their toPipeline.
+	// It only applies to the non-source vertices without incoming edges.
+	// +optional
+	FromPipelines []PipelineVertex `json:"fromPipelines,omitempty" protobuf:"bytes,22,rep,name=fromPipelines"`
+	// Interceptors are called in order by the forwarder of the vertex before reading, after the UDF and before writing
+	// the messages. They only apply to map UDF and sink vertices.
+	// +optional
+	Interceptors []Interceptor `json:"interceptors,omitempty" protobuf:"bytes,23,rep,name=interceptors"`
+}
+
+// LeafNode describes a NATS leaf node connected to a JetStream ISB Service.
+type LeafNode struct {
+	// URL of the leaf node, e.g. "nats://nats-leaf.edge.svc:4222". The vertex pods use the credentials of the ISB
+	// Service to connect to it.
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+	// Domain is the JetStream domain of the leaf node, which must be different from the one of the ISB Service.
+	Domain string `json:"domain" protobuf:"bytes,2,opt,name=domain"`
+}
+
+// GetJetStreamDomain returns the JetStream domain of the buffers and the buckets written by the vertex, empty means the
+// domain of the ISB Service.
+func (av AbstractVertex) GetJetStreamDomain() string {
+	if av.LeafNode == nil {
+		return ""
+	}
+	return av.LeafNode.Domain
+}
+
+// GetPodNamespace returns the namespace where the pods of the vertex run, it defaults to the given pipeline namespace.
+func (av AbstractVertex) GetPodNamespace(pipelineNamespace string) string {
+	if av.Namespace != "" {
+		return av.Namespace
+	}
+	return pipelineNamespace
 }
 
 func (av AbstractVertex) GetVertexType() VertexType {
@@ -511,7 +774,7 @@ func (av AbstractVertex) IsASource() bool {
 }
 
 func (av AbstractVertex) HasUDTransformer() bool {
-	return av.Source != nil && av.Source.UDTransformer != nil
+	return av.Source != nil && (av.Source.UDTransformer != nil || len(av.Source.Transformers) > 0)
 }
 
 func (av AbstractVertex) IsUDSource() bool {
@@ -580,6 +843,21 @@ type Scale struct {
 	// The is use to prevent too aggressive scaling operations
 	// +optional
 	ReplicasPerScale *uint32 `json:"replicasPerScale,omitempty" protobuf:"varint,9,opt,name=replicasPerScale"`
+	// Metrics are the additional signals to scale on, besides the pending messages and the processing rate.
+	// +optional
+	Metrics []ScalingMetric `json:"metrics,omitempty" protobuf:"bytes,11,rep,name=metrics"`
+	// MetricsPolicy is how the replicas desired by the builtin and the custom metrics are combined,
+	// "max" or "weighted", defaults to "max".
+	// +optional
+	MetricsPolicy ScalingMetricsPolicy `json:"metricsPolicy,omitempty" protobuf:"bytes,12,opt,name=metricsPolicy,casttype=ScalingMetricsPolicy"`
+	// BuiltinMetricsWeight is the weight of the replicas desired by the builtin metrics, i.e. the pending messages
+	// and the processing rate, with the "weighted" metrics policy, defaults to 1.
+	// +optional
+	BuiltinMetricsWeight *uint32 `json:"builtinMetricsWeight,omitempty" protobuf:"varint,13,opt,name=builtinMetricsWeight"`
+	// Schedules are the recurring time windows with raised minimum replicas, for example, to pre-scale the vertex
+	// before a known daily traffic peak.
+	// +optional
+	Schedules []ScalingSchedule `json:"schedules,omitempty" protobuf:"bytes,14,rep,name=schedules"`
 }
 
 func (s Scale) GetLookbackSeconds() int {
@@ -624,6 +902,20 @@ func (s Scale) GetReplicasPerScale() int {
 	return DefaultReplicasPerScale
 }
 
+func (s Scale) GetMetricsPolicy() ScalingMetricsPolicy {
+	if s.MetricsPolicy == "" {
+		return ScalingMetricsPolicyMax
+	}
+	return s.MetricsPolicy
+}
+
+func (s Scale) GetBuiltinMetricsWeight() uint32 {
+	if s.BuiltinMetricsWeight == nil {
+		return DefaultScalingMetricWeight
+	}
+	return *s.BuiltinMetricsWeight
+}
+
 func (s Scale) GetMinReplicas() int32 {
 	if x := s.Min; x == nil || *x < 0 {
 		return 0
@@ -657,6 +949,27 @@ type VertexLimits struct {
 	// It overrides the settings from pipeline limits.
 	// +optional
 	BufferUsageLimit *uint32 `json:"bufferUsageLimit,omitempty" protobuf:"varint,4,opt,name=bufferUsageLimit"`
+	// AdaptiveReadBatch makes the read batch size adapt to the back pressure, bounded by a min and a max, instead of
+	// being fixed to the read batch size.
+	// +optional
+	AdaptiveReadBatch *AdaptiveReadBatch `json:"adaptiveReadBatch,omitempty" protobuf:"bytes,5,opt,name=adaptiveReadBatch"`
+	// UDFConcurrency is the number of the messages processed concurrently by a map UDF vertex.
+	// It overrides the settings from pipeline limits.
+	// +optional
+	UDFConcurrency *uint32 `json:"udfConcurrency,omitempty" protobuf:"varint,6,opt,name=udfConcurrency"`
+	// RetryInterval is the interval of retrying the failed writes to the Inter-Step Buffers or the sink.
+	// It overrides the settings from pipeline limits.
+	// +optional
+	RetryInterval *metav1.Duration `json:"retryInterval,omitempty" protobuf:"bytes,7,opt,name=retryInterval"`
+	// KeyOrdered limits the concurrency of a map UDF vertex to across the keys, the messages of a batch with the same
+	// keys are processed one after another in the order they are read, so that the per key ordering is preserved.
+	// The messages without keys are still processed concurrently.
+	// +optional
+	KeyOrdered bool `json:"keyOrdered,omitempty" protobuf:"varint,8,opt,name=keyOrdered"`
+	// MapConnectionPoolSize is the number of the gRPC connections from a map UDF vertex to its udf container, the map
+	// requests are dispatched to them in a round-robin manner. Defaults to 1.
+	// +optional
+	MapConnectionPoolSize *uint32 `json:"mapConnectionPoolSize,omitempty" protobuf:"varint,9,opt,name=mapConnectionPoolSize"`
 }
 
 func (v VertexSpec) getType() containerSupplier {
@@ -672,12 +985,16 @@ func (v VertexSpec) getType() containerSupplier {
 }
 
 type VertexStatus struct {
+	Status       `json:",inline" protobuf:"bytes,7,opt,name=status"`
 	Phase        VertexPhase `json:"phase" protobuf:"bytes,1,opt,name=phase,casttype=VertexPhase"`
 	Reason       string      `json:"reason,omitempty" protobuf:"bytes,6,opt,name=reason"`
 	Message      string      `json:"message,omitempty" protobuf:"bytes,2,opt,name=message"`
 	Replicas     uint32      `json:"replicas" protobuf:"varint,3,opt,name=replicas"`
 	Selector     string      `json:"selector,omitempty" protobuf:"bytes,5,opt,name=selector"`
 	LastScaledAt metav1.Time `json:"lastScaledAt,omitempty" protobuf:"bytes,4,opt,name=lastScaledAt"`
+	// Restarts is the diagnosis of the container restarts of the pods, it's empty if there's no restart.
+	// +optional
+	Restarts *VertexRestarts `json:"restarts,omitempty" protobuf:"bytes,8,opt,name=restarts"`
 }
 
 func (vs *VertexStatus) MarkPhase(phase VertexPhase, reason, message string) {
@@ -694,6 +1011,36 @@ func (vs *VertexStatus) MarkPhaseRunning() {
 	vs.MarkPhase(VertexPhaseRunning, "", "")
 }
 
+// MarkBuffersHealthy set the buffers of the vertex are available.
+func (vs *VertexStatus) MarkBuffersHealthy() {
+	vs.MarkTrue(VertexConditionBuffersHealthy)
+}
+
+// MarkBuffersUnhealthy set the buffers of the vertex are not available, e.g. deleted.
+func (vs *VertexStatus) MarkBuffersUnhealthy(reason, message string) {
+	vs.MarkFalse(VertexConditionBuffersHealthy, reason, message)
+}
+
+// MarkWithinRestartBudget set the container restarts of the vertex are within the restart budget.
+func (vs *VertexStatus) MarkWithinRestartBudget() {
+	vs.MarkTrue(VertexConditionWithinRestartBudget)
+}
+
+// MarkRestartBudgetExceeded set the container restarts of the vertex exceed the restart budget.
+func (vs *VertexStatus) MarkRestartBudgetExceeded(reason, message string) {
+	vs.MarkFalse(VertexConditionWithinRestartBudget, reason, message)
+}
+
+// IsHalted returns true if the vertex is halted for exceeding the restart budget.
+func (vs *VertexStatus) IsHalted() bool {
+	return vs.Restarts != nil && vs.Restarts.Halted
+}
+
+// MarkBuffersUnknown set the availability of the buffers of the vertex is unknown.
+func (vs *VertexStatus) MarkBuffersUnknown(reason, message string) {
+	vs.MarkUnknown(VertexConditionBuffersHealthy, reason, message)
+}
+
 // +kubebuilder:object:root=true
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 type VertexList struct {
@@ -17,6 +17,8 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"testing"
@@ -112,6 +114,29 @@ func TestGetToBuffers(t *testing.T) {
 	assert.Contains(t, f[0], fmt.Sprintf("%s-%s-%s-0", testVertex.Namespace, testVertex.Spec.PipelineName, "output"))
 }
 
+func TestGetJetStreamDomains(t *testing.T) {
+	assert.Empty(t, testVertex.GetJetStreamDomains())
+	assert.Equal(t, "", testVertex.GetFromJetStreamDomain())
+	v := testVertex.DeepCopy()
+	v.Spec.FromEdges[0].FromVertexJetStreamDomain = "edge"
+	assert.Equal(t, "edge", v.GetFromJetStreamDomain())
+	assert.Equal(t, map[string]string{
+		GenerateBufferName(testNamespace, testPipelineName, testVertexSpecName, 0):           "edge",
+		GenerateEdgeBucketName(testNamespace, testPipelineName, "input", testVertexSpecName): "edge",
+	}, v.GetJetStreamDomains())
+	src := testSrcVertex.DeepCopy()
+	src.Spec.LeafNode = &LeafNode{URL: "nats://leaf:4222", Domain: "edge"}
+	assert.Equal(t, map[string]string{
+		GenerateSourceBucketName(testNamespace, testPipelineName, testVertexSpecName):         "edge",
+		GenerateEdgeBucketName(testNamespace, testPipelineName, testVertexSpecName, "output"): "edge",
+	}, src.GetJetStreamDomains())
+}
+
+func TestGetPipelineStoreName(t *testing.T) {
+	pl := Pipeline{ObjectMeta: metav1.ObjectMeta{Namespace: testVertex.Namespace, Name: testVertex.Spec.PipelineName}}
+	assert.Equal(t, pl.GetSideInputsStoreName(), testVertex.GetPipelineStoreName())
+}
+
 func TestGetToBuffersSink(t *testing.T) {
 	f := testSinkVertex.GetToBuffers()
 	assert.Equal(t, 0, len(f))
@@ -148,6 +173,20 @@ func TestGetVertexReplicas(t *testing.T) {
 	assert.Equal(t, 1, v.GetReplicas())
 	v.Spec.UDF.GroupBy = nil
 	assert.Equal(t, 1000, v.GetReplicas())
+	v.Spec.PackedInto = "a"
+	assert.Equal(t, 0, v.GetReplicas())
+}
+
+func TestIsStrictFIFO(t *testing.T) {
+	v := Vertex{
+		Spec: VertexSpec{
+			AbstractVertex: AbstractVertex{Name: "out", Sink: &Sink{}},
+			FromEdges:      []CombinedEdge{{Edge: Edge{From: "in", To: "out"}}, {Edge: Edge{From: "cat", To: "out"}}},
+		},
+	}
+	assert.False(t, v.IsStrictFIFO())
+	v.Spec.FromEdges[1].StrictFIFO = true
+	assert.True(t, v.IsStrictFIFO())
 }
 
 func TestGetHeadlessSvcSpec(t *testing.T) {
@@ -181,6 +220,30 @@ func TestGetServiceObjs(t *testing.T) {
 	assert.Equal(t, s[1].Name, v.Name)
 	assert.Equal(t, 1, len(s[1].Spec.Ports))
 	assert.Equal(t, VertexHTTPSPort, int(s[1].Spec.Ports[0].Port))
+
+	v.Spec.Source = &Source{
+		WebSocket: &WebSocketSource{Service: true},
+	}
+	s = v.GetServiceObjs()
+	assert.Equal(t, 2, len(s))
+	assert.Equal(t, VertexHTTPSPort, int(s[1].Spec.Ports[0].Port))
+}
+
+func TestGetPodNamespace(t *testing.T) {
+	v := testVertex.DeepCopy()
+	assert.Equal(t, v.Namespace, v.GetPodNamespace())
+	assert.False(t, v.IsCrossNamespace())
+	assert.Equal(t, 1, len(v.GetOwnerReferences()))
+	assert.NotContains(t, v.PodLabels(), KeyVertexNamespace)
+
+	v.Spec.Namespace = "locked-ns"
+	assert.Equal(t, "locked-ns", v.GetPodNamespace())
+	assert.True(t, v.IsCrossNamespace())
+	assert.Empty(t, v.GetOwnerReferences())
+	assert.Equal(t, v.Namespace, v.PodLabels()[KeyVertexNamespace])
+	s := v.GetServiceObjs()
+	assert.Equal(t, "locked-ns", s[0].Namespace)
+	assert.Equal(t, v.Namespace, s[0].Spec.Selector[KeyVertexNamespace])
 }
 
 func TestGetHeadlessServiceName(t *testing.T) {
@@ -287,6 +350,47 @@ func TestGetPodSpec(t *testing.T) {
 		assert.Equal(t, CtrInit, s.InitContainers[0].Name)
 	})
 
+	t.Run("test packed vertices", func(t *testing.T) {
+		testObj := testVertex.DeepCopy()
+		testObj.Spec.Source = &Source{}
+		testObj.Spec.PackedVertices = []string{"out"}
+		packed := Vertex{
+			ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: testPipelineName + "-out"},
+			Spec: VertexSpec{
+				AbstractVertex: AbstractVertex{Name: "out", Sink: &Sink{}},
+				PipelineName:   testPipelineName,
+				Replicas:       pointer.Int32(3),
+				PackedInto:     testObj.Spec.Name,
+			},
+		}
+		packedReq := req
+		packedReq.PackedVertices = []Vertex{packed}
+		s, err := testObj.GetPodSpec(packedReq)
+		assert.NoError(t, err)
+		var encoded string
+		for _, e := range s.Containers[0].Env {
+			if e.Name == EnvPackedVertexObjects {
+				encoded = e.Value
+			}
+		}
+		b, err := base64.StdEncoding.DecodeString(encoded)
+		assert.NoError(t, err)
+		var vertices []Vertex
+		assert.NoError(t, json.Unmarshal(b, &vertices))
+		assert.Len(t, vertices, 1)
+		assert.Equal(t, packed.Name, vertices[0].Name)
+		assert.Equal(t, packed.Namespace, vertices[0].Namespace)
+		assert.True(t, vertices[0].IsPacked())
+		assert.Equal(t, int32(0), *vertices[0].Spec.Replicas)
+		assert.Equal(t, []string{testNamespace + "-" + testPipelineName + "-out-0"}, vertices[0].OwnedBuffers())
+
+		s, err = testObj.GetPodSpec(req)
+		assert.NoError(t, err)
+		for _, e := range s.Containers[0].Env {
+			assert.NotEqual(t, EnvPackedVertexObjects, e.Name)
+		}
+	})
+
 	t.Run("test user defined sink", func(t *testing.T) {
 		testObj := testVertex.DeepCopy()
 		testObj.Spec.Sink = &Sink{
@@ -412,6 +516,79 @@ func TestGetPodSpec(t *testing.T) {
 		assert.Equal(t, CtrInit, s.InitContainers[0].Name)
 		assert.Equal(t, CtrInitSideInputs, s.InitContainers[1].Name)
 	})
+
+	t.Run("test message signing", func(t *testing.T) {
+		testObj := testVertex.DeepCopy()
+		testObj.Spec.MessageSigning = &MessageSigning{}
+		testObj.Spec.UDF = &UDF{
+			Builtin: &Function{
+				Name: "cat",
+			},
+		}
+		s, err := testObj.GetPodSpec(req)
+		assert.NoError(t, err)
+		var secretName string
+		for _, v := range s.Volumes {
+			if v.Name == "signing-keys" {
+				secretName = v.Secret.SecretName
+			}
+		}
+		assert.Equal(t, GenerateSigningKeysSecretName(testObj.Spec.PipelineName), secretName)
+		var mountedAt string
+		for _, m := range s.Containers[0].VolumeMounts {
+			if m.Name == "signing-keys" {
+				mountedAt = m.MountPath
+				assert.True(t, m.ReadOnly)
+			}
+		}
+		assert.Equal(t, PathSigningKeysMount, mountedAt)
+		for _, m := range s.Containers[1].VolumeMounts {
+			assert.NotEqual(t, "signing-keys", m.Name)
+		}
+	})
+
+	t.Run("test cache", func(t *testing.T) {
+		testObj := testVertex.DeepCopy()
+		testObj.Spec.UDF = &UDF{
+			Builtin: &Function{
+				Name: "cat",
+			},
+		}
+		testObj.Spec.Volumes = []corev1.Volume{{Name: "cache-vol"}}
+		testObj.Spec.SideInputs = []string{"my-si"}
+		testObj.Spec.Cache = &Cache{
+			Persistence: &CachePersistence{VolumeName: "cache-vol"},
+			SideInput:   "my-si",
+		}
+		s, err := testObj.GetPodSpec(req)
+		assert.NoError(t, err)
+		mounts := map[string]corev1.VolumeMount{}
+		for _, m := range s.Containers[0].VolumeMounts {
+			mounts[m.Name] = m
+		}
+		assert.Equal(t, PathCachePersistenceMount, mounts["cache-vol"].MountPath)
+		assert.Equal(t, PathSideInputsMount, mounts["var-run-side-inputs"].MountPath)
+		assert.True(t, mounts["var-run-side-inputs"].ReadOnly)
+	})
+
+	t.Run("test burst buffer", func(t *testing.T) {
+		testObj := testVertex.DeepCopy()
+		testObj.Spec.Source = &Source{
+			HTTP:        &HTTPSource{},
+			BurstBuffer: &BurstBuffer{VolumeName: "burst-vol"},
+		}
+		testObj.Spec.Volumes = []corev1.Volume{{Name: "burst-vol"}}
+		s, err := testObj.GetPodSpec(req)
+		assert.NoError(t, err)
+		found := false
+		for _, m := range s.Containers[0].VolumeMounts {
+			if m.Name == "burst-vol" {
+				found = true
+				assert.Equal(t, PathBurstBufferMount, m.MountPath)
+			}
+		}
+		assert.True(t, found)
+	})
 }
 
 func Test_getType(t *testing.T) {
@@ -459,6 +636,20 @@ func TestVertexMarkPhaseFailed(t *testing.T) {
 	assert.Equal(t, "message", s.Message)
 }
 
+func TestVertexMarkBuffers(t *testing.T) {
+	s := VertexStatus{}
+	s.MarkBuffersUnhealthy("reason", "message")
+	assert.Len(t, s.Conditions, 1)
+	assert.Equal(t, string(VertexConditionBuffersHealthy), s.Conditions[0].Type)
+	assert.Equal(t, metav1.ConditionFalse, s.Conditions[0].Status)
+	assert.Equal(t, "message", s.Conditions[0].Message)
+	s.MarkBuffersUnknown("reason", "message")
+	assert.Equal(t, metav1.ConditionUnknown, s.Conditions[0].Status)
+	s.MarkBuffersHealthy()
+	assert.Len(t, s.Conditions, 1)
+	assert.Equal(t, metav1.ConditionTrue, s.Conditions[0].Status)
+}
+
 func Test_VertexIsSource(t *testing.T) {
 	o := testVertex.DeepCopy()
 	o.Spec.Source = &Source{}
@@ -535,6 +726,10 @@ func TestScalable(t *testing.T) {
 		Kafka: &KafkaSource{},
 	}
 	assert.True(t, v.Scalable())
+	v.Spec.Source = nil
+	v.Spec.Sink = &Sink{}
+	v.Spec.PackedInto = "in"
+	assert.False(t, v.Scalable())
 }
 
 func Test_Scale_Parameters(t *testing.T) {
@@ -574,3 +769,17 @@ func Test_Scale_Parameters(t *testing.T) {
 	s.Max = pointer.Int32(500)
 	assert.Equal(t, int32(500), s.GetMaxReplicas())
 }
+
+func TestSourceTransformerBatchEnabled(t *testing.T) {
+	v := Vertex{}
+	enabled, err := v.SourceTransformerBatchEnabled()
+	assert.NoError(t, err)
+	assert.False(t, enabled)
+	v.Spec.Metadata = &Metadata{Annotations: map[string]string{SourceTransformerBatchKey: "true"}}
+	enabled, err = v.SourceTransformerBatchEnabled()
+	assert.NoError(t, err)
+	assert.True(t, enabled)
+	v.Spec.Metadata.Annotations[SourceTransformerBatchKey] = "yes"
+	_, err = v.SourceTransformerBatchEnabled()
+	assert.Error(t, err)
+}
@@ -0,0 +1,46 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+type WebSocketSource struct {
+	// Auth is the bearer token authorization of the clients, which need to add "Authorization: Bearer <token>" in
+	// the header of the handshake request.
+	// +optional
+	Auth *Authorization `json:"auth,omitempty" protobuf:"bytes,1,opt,name=auth"`
+	// Whether to create a ClusterIP Service
+	// +optional
+	Service bool `json:"service,omitempty" protobuf:"varint,2,opt,name=service"`
+	// EventTimeField is the field of a JSON payload holding the event time, either in epoch milliseconds or in
+	// RFC3339 format. The receive time is used as the event time if it's not specified, or the field is missing or
+	// invalid.
+	// +optional
+	EventTimeField string `json:"eventTimeField,omitempty" protobuf:"bytes,3,opt,name=eventTimeField"`
+	// AckOnWrite sends an acknowledgement back to the client once a message is written to the Inter-Step Buffers,
+	// e.g. {"seq":1}, where seq is the sequence number of the message in the connection, starting from 1.
+	// +optional
+	AckOnWrite bool `json:"ackOnWrite,omitempty" protobuf:"varint,4,opt,name=ackOnWrite"`
+	// MaxConnections is the max number of concurrent client connections of a pod, defaults to 100.
+	// +optional
+	MaxConnections *uint32 `json:"maxConnections,omitempty" protobuf:"varint,5,opt,name=maxConnections"`
+}
+
+func (ws *WebSocketSource) GetMaxConnections() int {
+	if ws == nil || ws.MaxConnections == nil {
+		return DefaultWebSocketMaxConnections
+	}
+	return int(*ws.MaxConnections)
+}
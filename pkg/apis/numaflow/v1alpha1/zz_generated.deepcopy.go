@@ -27,6 +27,32 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSKMS) DeepCopyInto(out *AWSKMS) {
+	*out = *in
+	if in.AccessKeyID != nil {
+		in, out := &in.AccessKeyID, &out.AccessKeyID
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecretAccessKey != nil {
+		in, out := &in.SecretAccessKey, &out.SecretAccessKey
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSKMS.
+func (in *AWSKMS) DeepCopy() *AWSKMS {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSKMS)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AbstractPodTemplate) DeepCopyInto(out *AbstractPodTemplate) {
 	*out = *in
@@ -168,6 +194,43 @@ func (in *AbstractVertex) DeepCopyInto(out *AbstractVertex) {
 		*out = new(ContainerTemplate)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Cache != nil {
+		in, out := &in.Cache, &out.Cache
+		*out = new(Cache)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RestartBudget != nil {
+		in, out := &in.RestartBudget, &out.RestartBudget
+		*out = new(RestartBudget)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Record != nil {
+		in, out := &in.Record, &out.Record
+		*out = new(Record)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LeafNode != nil {
+		in, out := &in.LeafNode, &out.LeafNode
+		*out = new(LeafNode)
+		**out = **in
+	}
+	if in.ToPipeline != nil {
+		in, out := &in.ToPipeline, &out.ToPipeline
+		*out = new(PipelineVertex)
+		**out = **in
+	}
+	if in.FromPipelines != nil {
+		in, out := &in.FromPipelines, &out.FromPipelines
+		*out = make([]PipelineVertex, len(*in))
+		copy(*out, *in)
+	}
+	if in.Interceptors != nil {
+		in, out := &in.Interceptors, &out.Interceptors
+		*out = make([]Interceptor, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -181,6 +244,63 @@ func (in *AbstractVertex) DeepCopy() *AbstractVertex {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccumulatorWindow) DeepCopyInto(out *AccumulatorWindow) {
+	*out = *in
+	if in.Count != nil {
+		in, out := &in.Count, &out.Count
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccumulatorWindow.
+func (in *AccumulatorWindow) DeepCopy() *AccumulatorWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(AccumulatorWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdaptiveReadBatch) DeepCopyInto(out *AdaptiveReadBatch) {
+	*out = *in
+	if in.Min != nil {
+		in, out := &in.Min, &out.Min
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.Max != nil {
+		in, out := &in.Max, &out.Max
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.TargetLatency != nil {
+		in, out := &in.TargetLatency, &out.TargetLatency
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdaptiveReadBatch.
+func (in *AdaptiveReadBatch) DeepCopy() *AdaptiveReadBatch {
+	if in == nil {
+		return nil
+	}
+	out := new(AdaptiveReadBatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Authorization) DeepCopyInto(out *Authorization) {
 	*out = *in
@@ -270,6 +390,169 @@ func (in *BufferServiceConfig) DeepCopy() *BufferServiceConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BurstBuffer) DeepCopyInto(out *BurstBuffer) {
+	*out = *in
+	if in.MaxSize != nil {
+		in, out := &in.MaxSize, &out.MaxSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BurstBuffer.
+func (in *BurstBuffer) DeepCopy() *BurstBuffer {
+	if in == nil {
+		return nil
+	}
+	out := new(BurstBuffer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Cache) DeepCopyInto(out *Cache) {
+	*out = *in
+	if in.MaxEntries != nil {
+		in, out := &in.MaxEntries, &out.MaxEntries
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Persistence != nil {
+		in, out := &in.Persistence, &out.Persistence
+		*out = new(CachePersistence)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Cache.
+func (in *Cache) DeepCopy() *Cache {
+	if in == nil {
+		return nil
+	}
+	out := new(Cache)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CachePersistence) DeepCopyInto(out *CachePersistence) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CachePersistence.
+func (in *CachePersistence) DeepCopy() *CachePersistence {
+	if in == nil {
+		return nil
+	}
+	out := new(CachePersistence)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClaimCheck) DeepCopyInto(out *ClaimCheck) {
+	*out = *in
+	if in.Threshold != nil {
+		in, out := &in.Threshold, &out.Threshold
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClaimCheck.
+func (in *ClaimCheck) DeepCopy() *ClaimCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(ClaimCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClickHouseBatch) DeepCopyInto(out *ClickHouseBatch) {
+	*out = *in
+	if in.MaxRows != nil {
+		in, out := &in.MaxRows, &out.MaxRows
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.MaxBytes != nil {
+		in, out := &in.MaxBytes, &out.MaxBytes
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.MaxWait != nil {
+		in, out := &in.MaxWait, &out.MaxWait
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickHouseBatch.
+func (in *ClickHouseBatch) DeepCopy() *ClickHouseBatch {
+	if in == nil {
+		return nil
+	}
+	out := new(ClickHouseBatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClickHouseSink) DeepCopyInto(out *ClickHouseSink) {
+	*out = *in
+	if in.Columns != nil {
+		in, out := &in.Columns, &out.Columns
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Batch != nil {
+		in, out := &in.Batch, &out.Batch
+		*out = new(ClickHouseBatch)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(TLS)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BasicAuth != nil {
+		in, out := &in.BasicAuth, &out.BasicAuth
+		*out = new(BasicAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickHouseSink.
+func (in *ClickHouseSink) DeepCopy() *ClickHouseSink {
+	if in == nil {
+		return nil
+	}
+	out := new(ClickHouseSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CombinedEdge) DeepCopyInto(out *CombinedEdge) {
 	*out = *in
@@ -294,6 +577,16 @@ func (in *CombinedEdge) DeepCopyInto(out *CombinedEdge) {
 		*out = new(VertexLimits)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ToPipeline != nil {
+		in, out := &in.ToPipeline, &out.ToPipeline
+		*out = new(PipelineVertex)
+		**out = **in
+	}
+	if in.ToPipelineJetStream != nil {
+		in, out := &in.ToPipelineJetStream, &out.ToPipelineJetStream
+		*out = new(JetStreamConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -401,6 +694,27 @@ func (in *ContainerTemplate) DeepCopy() *ContainerTemplate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomWindow) DeepCopyInto(out *CustomWindow) {
+	*out = *in
+	if in.MaxLength != nil {
+		in, out := &in.MaxLength, &out.MaxLength
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomWindow.
+func (in *CustomWindow) DeepCopy() *CustomWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DaemonTemplate) DeepCopyInto(out *DaemonTemplate) {
 	*out = *in
@@ -434,77 +748,331 @@ func (in *DaemonTemplate) DeepCopy() *DaemonTemplate {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Edge) DeepCopyInto(out *Edge) {
+func (in *Degradation) DeepCopyInto(out *Degradation) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = new(ForwardConditions)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.OnFull != nil {
-		in, out := &in.OnFull, &out.OnFull
-		*out = new(BufferFullWritingStrategy)
+	if in.MaxPending != nil {
+		in, out := &in.MaxPending, &out.MaxPending
+		*out = new(int64)
 		**out = **in
 	}
-	return
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Edge.
-func (in *Edge) DeepCopy() *Edge {
-	if in == nil {
-		return nil
+	if in.MaxLatency != nil {
+		in, out := &in.MaxLatency, &out.MaxLatency
+		*out = new(metav1.Duration)
+		**out = **in
 	}
-	out := new(Edge)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FixedWindow) DeepCopyInto(out *FixedWindow) {
-	*out = *in
-	if in.Length != nil {
-		in, out := &in.Length, &out.Length
+	if in.RecoveryPeriod != nil {
+		in, out := &in.RecoveryPeriod, &out.RecoveryPeriod
 		*out = new(metav1.Duration)
 		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FixedWindow.
-func (in *FixedWindow) DeepCopy() *FixedWindow {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Degradation.
+func (in *Degradation) DeepCopy() *Degradation {
 	if in == nil {
 		return nil
 	}
-	out := new(FixedWindow)
+	out := new(Degradation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ForwardConditions) DeepCopyInto(out *ForwardConditions) {
+func (in *DegradationStatus) DeepCopyInto(out *DegradationStatus) {
 	*out = *in
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = new(TagConditions)
-		(*in).DeepCopyInto(*out)
-	}
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ForwardConditions.
-func (in *ForwardConditions) DeepCopy() *ForwardConditions {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DegradationStatus.
+func (in *DegradationStatus) DeepCopy() *DegradationStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ForwardConditions)
+	out := new(DegradationStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Function) DeepCopyInto(out *Function) {
+func (in *Edge) DeepCopyInto(out *Edge) {
 	*out = *in
-	if in.Args != nil {
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = new(ForwardConditions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OnFull != nil {
+		in, out := &in.OnFull, &out.OnFull
+		*out = new(BufferFullWritingStrategy)
+		**out = **in
+	}
+	if in.Shuffle != nil {
+		in, out := &in.Shuffle, &out.Shuffle
+		*out = new(ShuffleStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MessageTTL != nil {
+		in, out := &in.MessageTTL, &out.MessageTTL
+		*out = new(MessageTTL)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Weight != nil {
+		in, out := &in.Weight, &out.Weight
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = new(EdgeSchema)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Mirror != nil {
+		in, out := &in.Mirror, &out.Mirror
+		*out = new(EdgeMirror)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Degradation != nil {
+		in, out := &in.Degradation, &out.Degradation
+		*out = new(EdgeDegradation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WriteAckPolicy != nil {
+		in, out := &in.WriteAckPolicy, &out.WriteAckPolicy
+		*out = new(WriteAckPolicy)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Edge.
+func (in *Edge) DeepCopy() *Edge {
+	if in == nil {
+		return nil
+	}
+	out := new(Edge)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EdgeDegradation) DeepCopyInto(out *EdgeDegradation) {
+	*out = *in
+	if in.SamplePercent != nil {
+		in, out := &in.SamplePercent, &out.SamplePercent
+		*out = new(uint32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EdgeDegradation.
+func (in *EdgeDegradation) DeepCopy() *EdgeDegradation {
+	if in == nil {
+		return nil
+	}
+	out := new(EdgeDegradation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EdgeMirror) DeepCopyInto(out *EdgeMirror) {
+	*out = *in
+	if in.Partitions != nil {
+		in, out := &in.Partitions, &out.Partitions
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RatePerSecond != nil {
+		in, out := &in.RatePerSecond, &out.RatePerSecond
+		*out = new(uint32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EdgeMirror.
+func (in *EdgeMirror) DeepCopy() *EdgeMirror {
+	if in == nil {
+		return nil
+	}
+	out := new(EdgeMirror)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EdgeSchema) DeepCopyInto(out *EdgeSchema) {
+	*out = *in
+	if in.Registry != nil {
+		in, out := &in.Registry, &out.Registry
+		*out = new(SchemaRegistry)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EdgeSchema.
+func (in *EdgeSchema) DeepCopy() *EdgeSchema {
+	if in == nil {
+		return nil
+	}
+	out := new(EdgeSchema)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ElasticsearchSink) DeepCopyInto(out *ElasticsearchSink) {
+	*out = *in
+	if in.URLs != nil {
+		in, out := &in.URLs, &out.URLs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BulkSize != nil {
+		in, out := &in.BulkSize, &out.BulkSize
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(TLS)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BasicAuth != nil {
+		in, out := &in.BasicAuth, &out.BasicAuth
+		*out = new(BasicAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.APIKey != nil {
+		in, out := &in.APIKey, &out.APIKey
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticsearchSink.
+func (in *ElasticsearchSink) DeepCopy() *ElasticsearchSink {
+	if in == nil {
+		return nil
+	}
+	out := new(ElasticsearchSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EncryptionKMS) DeepCopyInto(out *EncryptionKMS) {
+	*out = *in
+	if in.Static != nil {
+		in, out := &in.Static, &out.Static
+		*out = new(StaticKMS)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AWS != nil {
+		in, out := &in.AWS, &out.AWS
+		*out = new(AWSKMS)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GCP != nil {
+		in, out := &in.GCP, &out.GCP
+		*out = new(GCPKMS)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EncryptionKMS.
+func (in *EncryptionKMS) DeepCopy() *EncryptionKMS {
+	if in == nil {
+		return nil
+	}
+	out := new(EncryptionKMS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalScalingMetric) DeepCopyInto(out *ExternalScalingMetric) {
+	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalScalingMetric.
+func (in *ExternalScalingMetric) DeepCopy() *ExternalScalingMetric {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalScalingMetric)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FixedWindow) DeepCopyInto(out *FixedWindow) {
+	*out = *in
+	if in.Length != nil {
+		in, out := &in.Length, &out.Length
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FixedWindow.
+func (in *FixedWindow) DeepCopy() *FixedWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(FixedWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ForwardConditions) DeepCopyInto(out *ForwardConditions) {
+	*out = *in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = new(TagConditions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SchemaVersions != nil {
+		in, out := &in.SchemaVersions, &out.SchemaVersions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ForwardConditions.
+func (in *ForwardConditions) DeepCopy() *ForwardConditions {
+	if in == nil {
+		return nil
+	}
+	out := new(ForwardConditions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Function) DeepCopyInto(out *Function) {
+	*out = *in
+	if in.Args != nil {
 		in, out := &in.Args, &out.Args
 		*out = make([]string, len(*in))
 		copy(*out, *in)
@@ -529,6 +1097,53 @@ func (in *Function) DeepCopy() *Function {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPKMS) DeepCopyInto(out *GCPKMS) {
+	*out = *in
+	if in.Credentials != nil {
+		in, out := &in.Credentials, &out.Credentials
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPKMS.
+func (in *GCPKMS) DeepCopy() *GCPKMS {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPKMS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPCInterceptor) DeepCopyInto(out *GRPCInterceptor) {
+	*out = *in
+	if in.Hooks != nil {
+		in, out := &in.Hooks, &out.Hooks
+		*out = make([]InterceptorHook, len(*in))
+		copy(*out, *in)
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCInterceptor.
+func (in *GRPCInterceptor) DeepCopy() *GRPCInterceptor {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPCInterceptor)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GSSAPI) DeepCopyInto(out *GSSAPI) {
 	*out = *in
@@ -759,6 +1374,13 @@ func (in *GetVertexPodSpecReq) DeepCopyInto(out *GetVertexPodSpecReq) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.PackedVertices != nil {
+		in, out := &in.PackedVertices, &out.PackedVertices
+		*out = make([]Vertex, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -786,6 +1408,11 @@ func (in *GroupBy) DeepCopyInto(out *GroupBy) {
 		*out = new(PBQStorage)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Join != nil {
+		in, out := &in.Join, &out.Join
+		*out = new(Join)
+		**out = **in
+	}
 	return
 }
 
@@ -926,19 +1553,47 @@ func (in *InterStepBufferServiceStatus) DeepCopy() *InterStepBufferServiceStatus
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *JetStreamBufferService) DeepCopyInto(out *JetStreamBufferService) {
+func (in *Interceptor) DeepCopyInto(out *Interceptor) {
 	*out = *in
-	if in.Replicas != nil {
-		in, out := &in.Replicas, &out.Replicas
-		*out = new(int32)
-		**out = **in
+	if in.KWArgs != nil {
+		in, out := &in.KWArgs, &out.KWArgs
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
 	}
-	if in.ContainerTemplate != nil {
-		in, out := &in.ContainerTemplate, &out.ContainerTemplate
-		*out = new(ContainerTemplate)
+	if in.GRPC != nil {
+		in, out := &in.GRPC, &out.GRPC
+		*out = new(GRPCInterceptor)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.ReloaderContainerTemplate != nil {
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Interceptor.
+func (in *Interceptor) DeepCopy() *Interceptor {
+	if in == nil {
+		return nil
+	}
+	out := new(Interceptor)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JetStreamBufferService) DeepCopyInto(out *JetStreamBufferService) {
+	*out = *in
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ContainerTemplate != nil {
+		in, out := &in.ContainerTemplate, &out.ContainerTemplate
+		*out = new(ContainerTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReloaderContainerTemplate != nil {
 		in, out := &in.ReloaderContainerTemplate, &out.ReloaderContainerTemplate
 		*out = new(ContainerTemplate)
 		(*in).DeepCopyInto(*out)
@@ -1035,6 +1690,22 @@ func (in *JobTemplate) DeepCopy() *JobTemplate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Join) DeepCopyInto(out *Join) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Join.
+func (in *Join) DeepCopy() *Join {
+	if in == nil {
+		return nil
+	}
+	out := new(Join)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KafkaSink) DeepCopyInto(out *KafkaSink) {
 	*out = *in
@@ -1097,6 +1768,22 @@ func (in *KafkaSource) DeepCopy() *KafkaSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LeafNode) DeepCopyInto(out *LeafNode) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LeafNode.
+func (in *LeafNode) DeepCopy() *LeafNode {
+	if in == nil {
+		return nil
+	}
+	out := new(LeafNode)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Lifecycle) DeepCopyInto(out *Lifecycle) {
 	*out = *in
@@ -1105,6 +1792,11 @@ func (in *Lifecycle) DeepCopyInto(out *Lifecycle) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.CleanUpDelaySeconds != nil {
+		in, out := &in.CleanUpDelaySeconds, &out.CleanUpDelaySeconds
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
@@ -1134,6 +1826,142 @@ func (in *Log) DeepCopy() *Log {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MQTTSource) DeepCopyInto(out *MQTTSource) {
+	*out = *in
+	if in.Topics != nil {
+		in, out := &in.Topics, &out.Topics
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ProtocolVersion != nil {
+		in, out := &in.ProtocolVersion, &out.ProtocolVersion
+		*out = new(MQTTProtocolVersion)
+		**out = **in
+	}
+	if in.QoS != nil {
+		in, out := &in.QoS, &out.QoS
+		*out = new(int32)
+		**out = **in
+	}
+	if in.SharedSubscription != nil {
+		in, out := &in.SharedSubscription, &out.SharedSubscription
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(TLS)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(BasicAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MQTTSource.
+func (in *MQTTSource) DeepCopy() *MQTTSource {
+	if in == nil {
+		return nil
+	}
+	out := new(MQTTSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MessageChecksum) DeepCopyInto(out *MessageChecksum) {
+	*out = *in
+	if in.MaxDeliveries != nil {
+		in, out := &in.MaxDeliveries, &out.MaxDeliveries
+		*out = new(uint32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MessageChecksum.
+func (in *MessageChecksum) DeepCopy() *MessageChecksum {
+	if in == nil {
+		return nil
+	}
+	out := new(MessageChecksum)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MessageEncryption) DeepCopyInto(out *MessageEncryption) {
+	*out = *in
+	in.KMS.DeepCopyInto(&out.KMS)
+	if in.DataKeyRotationInterval != nil {
+		in, out := &in.DataKeyRotationInterval, &out.DataKeyRotationInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MessageEncryption.
+func (in *MessageEncryption) DeepCopy() *MessageEncryption {
+	if in == nil {
+		return nil
+	}
+	out := new(MessageEncryption)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MessageSigning) DeepCopyInto(out *MessageSigning) {
+	*out = *in
+	if in.RotationInterval != nil {
+		in, out := &in.RotationInterval, &out.RotationInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MessageSigning.
+func (in *MessageSigning) DeepCopy() *MessageSigning {
+	if in == nil {
+		return nil
+	}
+	out := new(MessageSigning)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MessageTTL) DeepCopyInto(out *MessageTTL) {
+	*out = *in
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Basis != nil {
+		in, out := &in.Basis, &out.Basis
+		*out = new(MessageTTLBasis)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MessageTTL.
+func (in *MessageTTL) DeepCopy() *MessageTTL {
+	if in == nil {
+		return nil
+	}
+	out := new(MessageTTL)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Metadata) DeepCopyInto(out *Metadata) {
 	*out = *in
@@ -1273,6 +2101,29 @@ func (in *NatsSource) DeepCopy() *NatsSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OTLPTraceExporter) DeepCopyInto(out *OTLPTraceExporter) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OTLPTraceExporter.
+func (in *OTLPTraceExporter) DeepCopy() *OTLPTraceExporter {
+	if in == nil {
+		return nil
+	}
+	out := new(OTLPTraceExporter)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PBQStorage) DeepCopyInto(out *PBQStorage) {
 	*out = *in
@@ -1381,6 +2232,16 @@ func (in *PipelineLimits) DeepCopyInto(out *PipelineLimits) {
 		*out = new(metav1.Duration)
 		**out = **in
 	}
+	if in.UDFConcurrency != nil {
+		in, out := &in.UDFConcurrency, &out.UDFConcurrency
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.RetryInterval != nil {
+		in, out := &in.RetryInterval, &out.RetryInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -1463,6 +2324,36 @@ func (in *PipelineSpec) DeepCopyInto(out *PipelineSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ClaimCheck != nil {
+		in, out := &in.ClaimCheck, &out.ClaimCheck
+		*out = new(ClaimCheck)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tracing != nil {
+		in, out := &in.Tracing, &out.Tracing
+		*out = new(Tracing)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MessageSigning != nil {
+		in, out := &in.MessageSigning, &out.MessageSigning
+		*out = new(MessageSigning)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MessageChecksum != nil {
+		in, out := &in.MessageChecksum, &out.MessageChecksum
+		*out = new(MessageChecksum)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Degradation != nil {
+		in, out := &in.Degradation, &out.Degradation
+		*out = new(Degradation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MessageEncryption != nil {
+		in, out := &in.MessageEncryption, &out.MessageEncryption
+		*out = new(MessageEncryption)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1501,6 +2392,18 @@ func (in *PipelineStatus) DeepCopyInto(out *PipelineStatus) {
 		*out = new(uint32)
 		**out = **in
 	}
+	if in.Degradation != nil {
+		in, out := &in.Degradation, &out.Degradation
+		*out = new(DegradationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StaleResources != nil {
+		in, out := &in.StaleResources, &out.StaleResources
+		*out = make([]StaleResources, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1514,6 +2417,178 @@ func (in *PipelineStatus) DeepCopy() *PipelineStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineVertex) DeepCopyInto(out *PipelineVertex) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineVertex.
+func (in *PipelineVertex) DeepCopy() *PipelineVertex {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineVertex)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrometheusScalingMetric) DeepCopyInto(out *PrometheusScalingMetric) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusScalingMetric.
+func (in *PrometheusScalingMetric) DeepCopy() *PrometheusScalingMetric {
+	if in == nil {
+		return nil
+	}
+	out := new(PrometheusScalingMetric)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PulsarAuth) DeepCopyInto(out *PulsarAuth) {
+	*out = *in
+	if in.Token != nil {
+		in, out := &in.Token, &out.Token
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PulsarAuth.
+func (in *PulsarAuth) DeepCopy() *PulsarAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(PulsarAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PulsarBatching) DeepCopyInto(out *PulsarBatching) {
+	*out = *in
+	if in.MaxMessages != nil {
+		in, out := &in.MaxMessages, &out.MaxMessages
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.MaxPublishDelay != nil {
+		in, out := &in.MaxPublishDelay, &out.MaxPublishDelay
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PulsarBatching.
+func (in *PulsarBatching) DeepCopy() *PulsarBatching {
+	if in == nil {
+		return nil
+	}
+	out := new(PulsarBatching)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PulsarSink) DeepCopyInto(out *PulsarSink) {
+	*out = *in
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(TLS)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(PulsarAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Batching != nil {
+		in, out := &in.Batching, &out.Batching
+		*out = new(PulsarBatching)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PulsarSink.
+func (in *PulsarSink) DeepCopy() *PulsarSink {
+	if in == nil {
+		return nil
+	}
+	out := new(PulsarSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PulsarSource) DeepCopyInto(out *PulsarSource) {
+	*out = *in
+	if in.SubscriptionType != nil {
+		in, out := &in.SubscriptionType, &out.SubscriptionType
+		*out = new(PulsarSubscriptionType)
+		**out = **in
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(TLS)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(PulsarAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PulsarSource.
+func (in *PulsarSource) DeepCopy() *PulsarSource {
+	if in == nil {
+		return nil
+	}
+	out := new(PulsarSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Record) DeepCopyInto(out *Record) {
+	*out = *in
+	if in.S3 != nil {
+		in, out := &in.S3, &out.S3
+		*out = new(S3Store)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxMessages != nil {
+		in, out := &in.MaxMessages, &out.MaxMessages
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Record.
+func (in *Record) DeepCopy() *Record {
+	if in == nil {
+		return nil
+	}
+	out := new(Record)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RedisBufferService) DeepCopyInto(out *RedisBufferService) {
 	*out = *in
@@ -1553,6 +2628,11 @@ func (in *RedisConfig) DeepCopyInto(out *RedisConfig) {
 		*out = new(v1.SecretKeySelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(TLS)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1586,6 +2666,27 @@ func (in *RedisSettings) DeepCopy() *RedisSettings {
 func (in *RedisStreamsSource) DeepCopyInto(out *RedisStreamsSource) {
 	*out = *in
 	in.RedisConfig.DeepCopyInto(&out.RedisConfig)
+	if in.ClaimMinIdleTime != nil {
+		in, out := &in.ClaimMinIdleTime, &out.ClaimMinIdleTime
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisStreamsSource.
+func (in *RedisStreamsSource) DeepCopy() *RedisStreamsSource {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisStreamsSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoteUDF) DeepCopyInto(out *RemoteUDF) {
+	*out = *in
 	if in.TLS != nil {
 		in, out := &in.TLS, &out.TLS
 		*out = new(TLS)
@@ -1594,12 +2695,64 @@ func (in *RedisStreamsSource) DeepCopyInto(out *RedisStreamsSource) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisStreamsSource.
-func (in *RedisStreamsSource) DeepCopy() *RedisStreamsSource {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteUDF.
+func (in *RemoteUDF) DeepCopy() *RemoteUDF {
 	if in == nil {
 		return nil
 	}
-	out := new(RedisStreamsSource)
+	out := new(RemoteUDF)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestartBudget) DeepCopyInto(out *RestartBudget) {
+	*out = *in
+	if in.MaxRestarts != nil {
+		in, out := &in.MaxRestarts, &out.MaxRestarts
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestartBudget.
+func (in *RestartBudget) DeepCopy() *RestartBudget {
+	if in == nil {
+		return nil
+	}
+	out := new(RestartBudget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *S3Store) DeepCopyInto(out *S3Store) {
+	*out = *in
+	if in.AccessKeyID != nil {
+		in, out := &in.AccessKeyID, &out.AccessKeyID
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecretAccessKey != nil {
+		in, out := &in.SecretAccessKey, &out.SecretAccessKey
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(TLS)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3Store.
+func (in *S3Store) DeepCopy() *S3Store {
+	if in == nil {
+		return nil
+	}
+	out := new(S3Store)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1625,94 +2778,224 @@ func (in *SASL) DeepCopyInto(out *SASL) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SASL.
-func (in *SASL) DeepCopy() *SASL {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SASL.
+func (in *SASL) DeepCopy() *SASL {
+	if in == nil {
+		return nil
+	}
+	out := new(SASL)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SASLPlain) DeepCopyInto(out *SASLPlain) {
+	*out = *in
+	if in.UserSecret != nil {
+		in, out := &in.UserSecret, &out.UserSecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PasswordSecret != nil {
+		in, out := &in.PasswordSecret, &out.PasswordSecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SASLPlain.
+func (in *SASLPlain) DeepCopy() *SASLPlain {
+	if in == nil {
+		return nil
+	}
+	out := new(SASLPlain)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Scale) DeepCopyInto(out *Scale) {
+	*out = *in
+	if in.Min != nil {
+		in, out := &in.Min, &out.Min
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Max != nil {
+		in, out := &in.Max, &out.Max
+		*out = new(int32)
+		**out = **in
+	}
+	if in.LookbackSeconds != nil {
+		in, out := &in.LookbackSeconds, &out.LookbackSeconds
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.CooldownSeconds != nil {
+		in, out := &in.CooldownSeconds, &out.CooldownSeconds
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.ZeroReplicaSleepSeconds != nil {
+		in, out := &in.ZeroReplicaSleepSeconds, &out.ZeroReplicaSleepSeconds
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.TargetProcessingSeconds != nil {
+		in, out := &in.TargetProcessingSeconds, &out.TargetProcessingSeconds
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.TargetBufferAvailability != nil {
+		in, out := &in.TargetBufferAvailability, &out.TargetBufferAvailability
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.ReplicasPerScale != nil {
+		in, out := &in.ReplicasPerScale, &out.ReplicasPerScale
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = make([]ScalingMetric, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.BuiltinMetricsWeight != nil {
+		in, out := &in.BuiltinMetricsWeight, &out.BuiltinMetricsWeight
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Schedules != nil {
+		in, out := &in.Schedules, &out.Schedules
+		*out = make([]ScalingSchedule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Scale.
+func (in *Scale) DeepCopy() *Scale {
+	if in == nil {
+		return nil
+	}
+	out := new(Scale)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScalingMetric) DeepCopyInto(out *ScalingMetric) {
+	*out = *in
+	if in.Prometheus != nil {
+		in, out := &in.Prometheus, &out.Prometheus
+		*out = new(PrometheusScalingMetric)
+		**out = **in
+	}
+	if in.External != nil {
+		in, out := &in.External, &out.External
+		*out = new(ExternalScalingMetric)
+		(*in).DeepCopyInto(*out)
+	}
+	out.TargetValue = in.TargetValue.DeepCopy()
+	if in.Weight != nil {
+		in, out := &in.Weight, &out.Weight
+		*out = new(uint32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalingMetric.
+func (in *ScalingMetric) DeepCopy() *ScalingMetric {
+	if in == nil {
+		return nil
+	}
+	out := new(ScalingMetric)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScalingSchedule) DeepCopyInto(out *ScalingSchedule) {
+	*out = *in
+	out.Duration = in.Duration
+	if in.Timezone != nil {
+		in, out := &in.Timezone, &out.Timezone
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalingSchedule.
+func (in *ScalingSchedule) DeepCopy() *ScalingSchedule {
 	if in == nil {
 		return nil
 	}
-	out := new(SASL)
+	out := new(ScalingSchedule)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SASLPlain) DeepCopyInto(out *SASLPlain) {
+func (in *SchemaRegistry) DeepCopyInto(out *SchemaRegistry) {
 	*out = *in
-	if in.UserSecret != nil {
-		in, out := &in.UserSecret, &out.UserSecret
-		*out = new(v1.SecretKeySelector)
+	if in.Version != nil {
+		in, out := &in.Version, &out.Version
+		*out = new(int32)
+		**out = **in
+	}
+	if in.BasicAuth != nil {
+		in, out := &in.BasicAuth, &out.BasicAuth
+		*out = new(BasicAuth)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.PasswordSecret != nil {
-		in, out := &in.PasswordSecret, &out.PasswordSecret
-		*out = new(v1.SecretKeySelector)
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(TLS)
 		(*in).DeepCopyInto(*out)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SASLPlain.
-func (in *SASLPlain) DeepCopy() *SASLPlain {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchemaRegistry.
+func (in *SchemaRegistry) DeepCopy() *SchemaRegistry {
 	if in == nil {
 		return nil
 	}
-	out := new(SASLPlain)
+	out := new(SchemaRegistry)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Scale) DeepCopyInto(out *Scale) {
+func (in *ShuffleStrategy) DeepCopyInto(out *ShuffleStrategy) {
 	*out = *in
-	if in.Min != nil {
-		in, out := &in.Min, &out.Min
-		*out = new(int32)
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(ShuffleType)
 		**out = **in
 	}
-	if in.Max != nil {
-		in, out := &in.Max, &out.Max
-		*out = new(int32)
-		**out = **in
-	}
-	if in.LookbackSeconds != nil {
-		in, out := &in.LookbackSeconds, &out.LookbackSeconds
-		*out = new(uint32)
-		**out = **in
-	}
-	if in.CooldownSeconds != nil {
-		in, out := &in.CooldownSeconds, &out.CooldownSeconds
-		*out = new(uint32)
-		**out = **in
-	}
-	if in.ZeroReplicaSleepSeconds != nil {
-		in, out := &in.ZeroReplicaSleepSeconds, &out.ZeroReplicaSleepSeconds
-		*out = new(uint32)
-		**out = **in
-	}
-	if in.TargetProcessingSeconds != nil {
-		in, out := &in.TargetProcessingSeconds, &out.TargetProcessingSeconds
-		*out = new(uint32)
-		**out = **in
-	}
-	if in.TargetBufferAvailability != nil {
-		in, out := &in.TargetBufferAvailability, &out.TargetBufferAvailability
-		*out = new(uint32)
-		**out = **in
-	}
-	if in.ReplicasPerScale != nil {
-		in, out := &in.ReplicasPerScale, &out.ReplicasPerScale
-		*out = new(uint32)
-		**out = **in
+	if in.KeyIndexes != nil {
+		in, out := &in.KeyIndexes, &out.KeyIndexes
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Scale.
-func (in *Scale) DeepCopy() *Scale {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShuffleStrategy.
+func (in *ShuffleStrategy) DeepCopy() *ShuffleStrategy {
 	if in == nil {
 		return nil
 	}
-	out := new(Scale)
+	out := new(ShuffleStrategy)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1821,6 +3104,26 @@ func (in *Sink) DeepCopyInto(out *Sink) {
 		*out = new(UDSink)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Pulsar != nil {
+		in, out := &in.Pulsar, &out.Pulsar
+		*out = new(PulsarSink)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Elasticsearch != nil {
+		in, out := &in.Elasticsearch, &out.Elasticsearch
+		*out = new(ElasticsearchSink)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WatermarkGate != nil {
+		in, out := &in.WatermarkGate, &out.WatermarkGate
+		*out = new(WatermarkGate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClickHouse != nil {
+		in, out := &in.ClickHouse, &out.ClickHouse
+		*out = new(ClickHouseSink)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1898,6 +3201,33 @@ func (in *Source) DeepCopyInto(out *Source) {
 		*out = new(UDSource)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Pulsar != nil {
+		in, out := &in.Pulsar, &out.Pulsar
+		*out = new(PulsarSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WebSocket != nil {
+		in, out := &in.WebSocket, &out.WebSocket
+		*out = new(WebSocketSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MQTT != nil {
+		in, out := &in.MQTT, &out.MQTT
+		*out = new(MQTTSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Transformers != nil {
+		in, out := &in.Transformers, &out.Transformers
+		*out = make([]TransformerStage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.BurstBuffer != nil {
+		in, out := &in.BurstBuffer, &out.BurstBuffer
+		*out = new(BurstBuffer)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1911,6 +3241,71 @@ func (in *Source) DeepCopy() *Source {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StaleResources) DeepCopyInto(out *StaleResources) {
+	*out = *in
+	if in.Buffers != nil {
+		in, out := &in.Buffers, &out.Buffers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Buckets != nil {
+		in, out := &in.Buckets, &out.Buckets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SideInputs != nil {
+		in, out := &in.SideInputs, &out.SideInputs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.JetStreamDomains != nil {
+		in, out := &in.JetStreamDomains, &out.JetStreamDomains
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	in.RemovedAt.DeepCopyInto(&out.RemovedAt)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StaleResources.
+func (in *StaleResources) DeepCopy() *StaleResources {
+	if in == nil {
+		return nil
+	}
+	out := new(StaleResources)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StaticKMS) DeepCopyInto(out *StaticKMS) {
+	*out = *in
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PreviousKey != nil {
+		in, out := &in.PreviousKey, &out.PreviousKey
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StaticKMS.
+func (in *StaticKMS) DeepCopy() *StaticKMS {
+	if in == nil {
+		return nil
+	}
+	out := new(StaticKMS)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Status) DeepCopyInto(out *Status) {
 	*out = *in
@@ -2027,6 +3422,32 @@ func (in *Templates) DeepCopy() *Templates {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Tracing) DeepCopyInto(out *Tracing) {
+	*out = *in
+	if in.OTLP != nil {
+		in, out := &in.OTLP, &out.OTLP
+		*out = new(OTLPTraceExporter)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SamplingPercentage != nil {
+		in, out := &in.SamplingPercentage, &out.SamplingPercentage
+		*out = new(uint32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Tracing.
+func (in *Tracing) DeepCopy() *Tracing {
+	if in == nil {
+		return nil
+	}
+	out := new(Tracing)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Transformer) DeepCopyInto(out *Transformer) {
 	*out = *in
@@ -2055,6 +3476,28 @@ func (in *Transformer) DeepCopy() *Transformer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransformerStage) DeepCopyInto(out *TransformerStage) {
+	*out = *in
+	in.UDTransformer.DeepCopyInto(&out.UDTransformer)
+	if in.OnFailure != nil {
+		in, out := &in.OnFailure, &out.OnFailure
+		*out = new(TransformerFailureStrategy)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransformerStage.
+func (in *TransformerStage) DeepCopy() *TransformerStage {
+	if in == nil {
+		return nil
+	}
+	out := new(TransformerStage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UDF) DeepCopyInto(out *UDF) {
 	*out = *in
@@ -2073,6 +3516,11 @@ func (in *UDF) DeepCopyInto(out *UDF) {
 		*out = new(GroupBy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Remote != nil {
+		in, out := &in.Remote, &out.Remote
+		*out = new(RemoteUDF)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2222,6 +3670,26 @@ func (in *VertexLimits) DeepCopyInto(out *VertexLimits) {
 		*out = new(uint32)
 		**out = **in
 	}
+	if in.AdaptiveReadBatch != nil {
+		in, out := &in.AdaptiveReadBatch, &out.AdaptiveReadBatch
+		*out = new(AdaptiveReadBatch)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UDFConcurrency != nil {
+		in, out := &in.UDFConcurrency, &out.UDFConcurrency
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.RetryInterval != nil {
+		in, out := &in.RetryInterval, &out.RetryInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MapConnectionPoolSize != nil {
+		in, out := &in.MapConnectionPoolSize, &out.MapConnectionPoolSize
+		*out = new(uint32)
+		**out = **in
+	}
 	return
 }
 
@@ -2268,6 +3736,30 @@ func (in *VertexList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VertexRestarts) DeepCopyInto(out *VertexRestarts) {
+	*out = *in
+	if in.Causes != nil {
+		in, out := &in.Causes, &out.Causes
+		*out = make(map[string]uint32, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	in.LastTerminatedAt.DeepCopyInto(&out.LastTerminatedAt)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VertexRestarts.
+func (in *VertexRestarts) DeepCopy() *VertexRestarts {
+	if in == nil {
+		return nil
+	}
+	out := new(VertexRestarts)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VertexSpec) DeepCopyInto(out *VertexSpec) {
 	*out = *in
@@ -2292,6 +3784,41 @@ func (in *VertexSpec) DeepCopyInto(out *VertexSpec) {
 		}
 	}
 	in.Watermark.DeepCopyInto(&out.Watermark)
+	if in.ClaimCheck != nil {
+		in, out := &in.ClaimCheck, &out.ClaimCheck
+		*out = new(ClaimCheck)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tracing != nil {
+		in, out := &in.Tracing, &out.Tracing
+		*out = new(Tracing)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MessageSigning != nil {
+		in, out := &in.MessageSigning, &out.MessageSigning
+		*out = new(MessageSigning)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PackedVertices != nil {
+		in, out := &in.PackedVertices, &out.PackedVertices
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MessageChecksum != nil {
+		in, out := &in.MessageChecksum, &out.MessageChecksum
+		*out = new(MessageChecksum)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MessageEncryption != nil {
+		in, out := &in.MessageEncryption, &out.MessageEncryption
+		*out = new(MessageEncryption)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ShuffleHeaderNames != nil {
+		in, out := &in.ShuffleHeaderNames, &out.ShuffleHeaderNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -2308,7 +3835,13 @@ func (in *VertexSpec) DeepCopy() *VertexSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VertexStatus) DeepCopyInto(out *VertexStatus) {
 	*out = *in
+	in.Status.DeepCopyInto(&out.Status)
 	in.LastScaledAt.DeepCopyInto(&out.LastScaledAt)
+	if in.Restarts != nil {
+		in, out := &in.Restarts, &out.Restarts
+		*out = new(VertexRestarts)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2357,6 +3890,11 @@ func (in *Watermark) DeepCopyInto(out *Watermark) {
 		*out = new(metav1.Duration)
 		**out = **in
 	}
+	if in.TimeUnit != nil {
+		in, out := &in.TimeUnit, &out.TimeUnit
+		*out = new(WatermarkTimeUnit)
+		**out = **in
+	}
 	return
 }
 
@@ -2370,6 +3908,53 @@ func (in *Watermark) DeepCopy() *Watermark {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WatermarkGate) DeepCopyInto(out *WatermarkGate) {
+	*out = *in
+	if in.MaxHeldMessages != nil {
+		in, out := &in.MaxHeldMessages, &out.MaxHeldMessages
+		*out = new(uint32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WatermarkGate.
+func (in *WatermarkGate) DeepCopy() *WatermarkGate {
+	if in == nil {
+		return nil
+	}
+	out := new(WatermarkGate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebSocketSource) DeepCopyInto(out *WebSocketSource) {
+	*out = *in
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(Authorization)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxConnections != nil {
+		in, out := &in.MaxConnections, &out.MaxConnections
+		*out = new(uint32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebSocketSource.
+func (in *WebSocketSource) DeepCopy() *WebSocketSource {
+	if in == nil {
+		return nil
+	}
+	out := new(WebSocketSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Window) DeepCopyInto(out *Window) {
 	*out = *in
@@ -2383,6 +3968,16 @@ func (in *Window) DeepCopyInto(out *Window) {
 		*out = new(SlidingWindow)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Custom != nil {
+		in, out := &in.Custom, &out.Custom
+		*out = new(CustomWindow)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Accumulator != nil {
+		in, out := &in.Accumulator, &out.Accumulator
+		*out = new(AccumulatorWindow)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
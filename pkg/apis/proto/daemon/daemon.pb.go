@@ -831,1326 +831,13147 @@ func (m *GetPipelineWatermarksRequest) GetPipeline() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*BufferInfo)(nil), "daemon.BufferInfo")
-	proto.RegisterType((*VertexMetrics)(nil), "daemon.VertexMetrics")
-	proto.RegisterMapType((map[string]int64)(nil), "daemon.VertexMetrics.PendingsEntry")
-	proto.RegisterMapType((map[string]float64)(nil), "daemon.VertexMetrics.ProcessingRatesEntry")
-	proto.RegisterType((*PipelineStatus)(nil), "daemon.PipelineStatus")
-	proto.RegisterType((*ListBuffersRequest)(nil), "daemon.ListBuffersRequest")
-	proto.RegisterType((*ListBuffersResponse)(nil), "daemon.ListBuffersResponse")
-	proto.RegisterType((*GetBufferRequest)(nil), "daemon.GetBufferRequest")
-	proto.RegisterType((*GetBufferResponse)(nil), "daemon.GetBufferResponse")
-	proto.RegisterType((*GetPipelineStatusRequest)(nil), "daemon.GetPipelineStatusRequest")
-	proto.RegisterType((*GetPipelineStatusResponse)(nil), "daemon.GetPipelineStatusResponse")
-	proto.RegisterType((*GetVertexMetricsRequest)(nil), "daemon.GetVertexMetricsRequest")
-	proto.RegisterType((*GetVertexMetricsResponse)(nil), "daemon.GetVertexMetricsResponse")
-	proto.RegisterType((*EdgeWatermark)(nil), "daemon.EdgeWatermark")
-	proto.RegisterType((*GetPipelineWatermarksResponse)(nil), "daemon.GetPipelineWatermarksResponse")
-	proto.RegisterType((*GetPipelineWatermarksRequest)(nil), "daemon.GetPipelineWatermarksRequest")
+// EdgeMetrics has the watermark lag, buffer fill rate and processing latency of an edge.
+type EdgeMetrics struct {
+	Pipeline   *string `protobuf:"bytes,1,req,name=pipeline" json:"pipeline,omitempty"`
+	Edge       *string `protobuf:"bytes,2,req,name=edge" json:"edge,omitempty"`
+	FromVertex *string `protobuf:"bytes,3,req,name=fromVertex" json:"fromVertex,omitempty"`
+	ToVertex   *string `protobuf:"bytes,4,req,name=toVertex" json:"toVertex,omitempty"`
+	// Difference between now and the head watermark of the edge in milliseconds, -1 if not available.
+	WatermarkLag *int64 `protobuf:"varint,5,req,name=watermarkLag" json:"watermarkLag,omitempty"`
+	// Total pending and ack pending messages in the buffers of the edge.
+	Pending *int64 `protobuf:"varint,6,req,name=pending" json:"pending,omitempty"`
+	// Highest usage of the buffers of the edge.
+	BufferUsage *float64 `protobuf:"fixed64,7,req,name=bufferUsage" json:"bufferUsage,omitempty"`
+	// Changing rate of the pending messages per second, a positive value means the edge is falling behind.
+	BufferFillRate *float64 `protobuf:"fixed64,8,req,name=bufferFillRate" json:"bufferFillRate,omitempty"`
+	// Percentiles (p50, p90, p99) of the watermark lag in milliseconds, which is the processing latency from the source to the edge.
+	LatencyPercentiles   map[string]int64 `protobuf:"bytes,9,rep,name=latencyPercentiles" json:"latencyPercentiles,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func init() {
-	proto.RegisterFile("pkg/apis/proto/daemon/daemon.proto", fileDescriptor_93e327fd0d673221)
+func (m *EdgeMetrics) Reset()         { *m = EdgeMetrics{} }
+func (m *EdgeMetrics) String() string { return proto.CompactTextString(m) }
+func (*EdgeMetrics) ProtoMessage()    {}
+func (*EdgeMetrics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93e327fd0d673221, []int{14}
 }
-
-var fileDescriptor_93e327fd0d673221 = []byte{
-	// 905 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0x5f, 0x6f, 0xdb, 0x54,
-	0x14, 0x97, 0xed, 0xfe, 0x49, 0x4f, 0x09, 0x2b, 0x87, 0xad, 0x78, 0xde, 0x28, 0xc6, 0xeb, 0xc0,
-	0x94, 0x11, 0x43, 0x24, 0xa6, 0x69, 0x93, 0x18, 0xca, 0xc8, 0x2a, 0x44, 0x8b, 0x2a, 0x0f, 0x98,
-	0xc4, 0x9b, 0x93, 0xdc, 0x78, 0x26, 0xfe, 0x87, 0xef, 0x75, 0x4a, 0x35, 0xf5, 0x05, 0x89, 0x67,
-	0x1e, 0xd0, 0xbe, 0x0a, 0x1f, 0x01, 0xf1, 0x88, 0xc4, 0x23, 0x2f, 0xa8, 0xe2, 0x83, 0x20, 0xdf,
-	0x7b, 0x9d, 0xda, 0xa9, 0x93, 0xf6, 0x29, 0xf7, 0x9c, 0xf3, 0x3b, 0xe7, 0xfc, 0xee, 0xf9, 0x73,
-	0x1d, 0xb0, 0xd2, 0x89, 0xef, 0x78, 0x69, 0x40, 0x9d, 0x34, 0x4b, 0x58, 0xe2, 0x8c, 0x3c, 0x12,
-	0x25, 0xb1, 0xfc, 0xe9, 0x70, 0x1d, 0xae, 0x09, 0xc9, 0xb8, 0xed, 0x27, 0x89, 0x1f, 0x92, 0x02,
-	0xee, 0x78, 0x71, 0x9c, 0x30, 0x8f, 0x05, 0x49, 0x4c, 0x05, 0xca, 0xb8, 0x25, 0xad, 0x5c, 0x1a,
-	0xe4, 0x63, 0x87, 0x44, 0x29, 0x3b, 0x11, 0x46, 0xeb, 0x0f, 0x15, 0xa0, 0x97, 0x8f, 0xc7, 0x24,
-	0xfb, 0x32, 0x1e, 0x27, 0x68, 0x40, 0x2b, 0x0d, 0x52, 0x12, 0x06, 0x31, 0xd1, 0x15, 0x53, 0xb5,
-	0x37, 0xdc, 0x99, 0x8c, 0x3b, 0x00, 0x03, 0x8e, 0xfc, 0xda, 0x8b, 0x88, 0xae, 0x72, 0x6b, 0x45,
-	0x83, 0x16, 0xbc, 0x96, 0x92, 0x78, 0x14, 0xc4, 0xfe, 0x93, 0x24, 0x8f, 0x99, 0xae, 0x99, 0xaa,
-	0xad, 0xb9, 0x35, 0x1d, 0xda, 0x70, 0xcd, 0x1b, 0x4e, 0x8e, 0xaa, 0xb0, 0x15, 0x0e, 0x9b, 0x57,
-	0xe3, 0x2e, 0xb4, 0x59, 0xc2, 0xbc, 0xf0, 0x90, 0x50, 0xea, 0xf9, 0x84, 0xea, 0xab, 0x1c, 0x57,
-	0x57, 0x16, 0x39, 0x05, 0x83, 0x03, 0x12, 0xfb, 0xec, 0x85, 0xbe, 0x26, 0x72, 0x56, 0x75, 0xb8,
-	0x07, 0x5b, 0x42, 0xfe, 0xb6, 0xf0, 0x39, 0x08, 0xa2, 0x80, 0xe9, 0xeb, 0xa6, 0x6a, 0x2b, 0xee,
-	0x05, 0x3d, 0x9a, 0xb0, 0x59, 0xd1, 0xe9, 0x2d, 0x0e, 0xab, 0xaa, 0x70, 0x1b, 0xd6, 0x02, 0xfa,
-	0x34, 0x0f, 0x43, 0x7d, 0xc3, 0x54, 0xed, 0x96, 0x2b, 0x25, 0xeb, 0x1f, 0x15, 0xda, 0xdf, 0x91,
-	0x8c, 0x91, 0x9f, 0x0e, 0x09, 0xcb, 0x82, 0x21, 0x5d, 0x5a, 0xcb, 0x6d, 0x58, 0x9b, 0x72, 0xb0,
-	0xac, 0xa3, 0x94, 0xf0, 0x1b, 0xb8, 0x96, 0x66, 0xc9, 0x90, 0x50, 0x1a, 0xc4, 0xbe, 0xeb, 0x31,
-	0x42, 0x75, 0xcd, 0xd4, 0xec, 0xcd, 0xee, 0x5e, 0x47, 0x76, 0xbe, 0x96, 0xa3, 0x73, 0x54, 0x07,
-	0xf7, 0x63, 0x96, 0x9d, 0xb8, 0xf3, 0x21, 0xf0, 0x31, 0xb4, 0x64, 0x17, 0xa8, 0xbe, 0xc2, 0xc3,
-	0xdd, 0x59, 0x10, 0x4e, 0xa2, 0x44, 0x9c, 0x99, 0x93, 0xd1, 0x83, 0xeb, 0x4d, 0x99, 0x70, 0x0b,
-	0xb4, 0x09, 0x39, 0xd1, 0x15, 0x53, 0xb1, 0x37, 0xdc, 0xe2, 0x88, 0xd7, 0x61, 0x75, 0xea, 0x85,
-	0x79, 0x31, 0x1f, 0x8a, 0xad, 0xb8, 0x42, 0x78, 0xa8, 0x3e, 0x50, 0x8c, 0x47, 0xd0, 0xae, 0x85,
-	0xbf, 0xcc, 0x59, 0xab, 0x38, 0x5b, 0x3d, 0x78, 0xfd, 0x48, 0xd6, 0xee, 0x19, 0xf3, 0x58, 0x4e,
-	0x8b, 0x0a, 0x52, 0x7e, 0x92, 0xb5, 0x95, 0x12, 0xea, 0xb0, 0x1e, 0x89, 0xe9, 0x90, 0xa5, 0x2d,
-	0x45, 0xeb, 0x63, 0xc0, 0x83, 0x80, 0x32, 0x31, 0xed, 0xd4, 0x25, 0x3f, 0xe6, 0x84, 0xb2, 0x65,
-	0x5d, 0xb2, 0x9e, 0xc0, 0x9b, 0x35, 0x0f, 0x9a, 0x26, 0x31, 0x25, 0x78, 0x0f, 0xd6, 0xc5, 0x44,
-	0x14, 0xb9, 0x8b, 0x6a, 0x62, 0x59, 0xcd, 0xf3, 0x4d, 0x72, 0x4b, 0x88, 0xf5, 0x14, 0xb6, 0xf6,
-	0x89, 0x8c, 0x71, 0x85, 0xa4, 0xc5, 0xc5, 0x84, 0x6b, 0x39, 0x1a, 0x42, 0xb2, 0x1e, 0xc3, 0x1b,
-	0x95, 0x38, 0x92, 0xca, 0xde, 0x0c, 0x5c, 0x84, 0x69, 0x66, 0x52, 0x06, 0xb8, 0x0f, 0xfa, 0x3e,
-	0x61, 0xf5, 0x32, 0x5e, 0xa5, 0x0a, 0x5f, 0xc1, 0xcd, 0x06, 0x3f, 0x49, 0xa0, 0x53, 0x6b, 0xc3,
-	0x66, 0x77, 0xbb, 0x24, 0x30, 0x87, 0x97, 0x28, 0xeb, 0x10, 0xde, 0xda, 0x27, 0xac, 0x36, 0x75,
-	0x4d, 0x1c, 0xd4, 0x85, 0xfb, 0xa2, 0x55, 0xf7, 0xc5, 0x7a, 0xce, 0xef, 0x34, 0x17, 0x4e, 0x52,
-	0x7b, 0x04, 0xed, 0x69, 0xd5, 0x20, 0x9b, 0x75, 0xa3, 0x71, 0xf4, 0xdd, 0x3a, 0xd6, 0xfa, 0x55,
-	0x81, 0x76, 0x7f, 0xe4, 0x93, 0xe7, 0x1e, 0x23, 0x59, 0xe4, 0x65, 0x93, 0xa5, 0x3d, 0x43, 0x58,
-	0x21, 0xa3, 0xd9, 0xc4, 0xf1, 0x73, 0xf1, 0x5c, 0x1e, 0x97, 0xce, 0x62, 0x8b, 0x35, 0xb7, 0xa2,
-	0xc1, 0x0e, 0x60, 0x40, 0x67, 0xe1, 0xfb, 0xb1, 0x37, 0x08, 0xc9, 0x88, 0xbf, 0x86, 0x2d, 0xb7,
-	0xc1, 0x62, 0x8d, 0xe1, 0xed, 0x4a, 0x1b, 0x66, 0xe6, 0xf3, 0xfb, 0xf6, 0x01, 0xd3, 0x0b, 0xd6,
-	0xf9, 0x4b, 0xd7, 0xee, 0xe4, 0x36, 0x38, 0x58, 0x0f, 0xe1, 0xf6, 0x82, 0x3c, 0x97, 0x8e, 0x4a,
-	0xf7, 0xf7, 0x55, 0x68, 0x7f, 0xc1, 0x13, 0x3d, 0x23, 0xd9, 0x34, 0x18, 0x12, 0x64, 0xb0, 0x59,
-	0x59, 0x21, 0x34, 0x4a, 0x1e, 0x17, 0x37, 0xd1, 0xb8, 0xd5, 0x68, 0x13, 0x97, 0xb3, 0xee, 0xfd,
-	0xfc, 0xf7, 0x7f, 0xbf, 0xa9, 0xef, 0xe1, 0x2e, 0xff, 0xc8, 0x4d, 0x3f, 0x71, 0xca, 0x9c, 0xd4,
-	0x79, 0x59, 0x1e, 0x4f, 0x1d, 0xb9, 0x73, 0x78, 0x0c, 0x1b, 0xb3, 0x5d, 0x41, 0xbd, 0x8c, 0x3b,
-	0xbf, 0x86, 0xc6, 0xcd, 0x06, 0x8b, 0xcc, 0xf7, 0x29, 0xcf, 0xe7, 0xe0, 0x47, 0x57, 0xc9, 0xe7,
-	0xbc, 0x14, 0x87, 0x53, 0x7c, 0xa5, 0xf0, 0x6d, 0xaf, 0x7f, 0x08, 0xde, 0xa9, 0xa4, 0x69, 0x9a,
-	0x7c, 0xc3, 0x5c, 0x0c, 0x90, 0x74, 0x3e, 0xe3, 0x74, 0x1e, 0xe0, 0xfd, 0xa5, 0x74, 0x8a, 0x11,
-	0x0e, 0x86, 0x85, 0x4e, 0x0c, 0xf3, 0xa9, 0x13, 0x49, 0x0a, 0xaf, 0x14, 0xb8, 0xd1, 0xd8, 0x55,
-	0xdc, 0xad, 0xe4, 0x5e, 0xd8, 0x74, 0xe3, 0xee, 0x25, 0x28, 0x49, 0xd3, 0xe1, 0x34, 0x3f, 0xc0,
-	0xf7, 0x97, 0xd2, 0xac, 0x2c, 0xc1, 0x2f, 0x0a, 0x7f, 0xd5, 0xe6, 0xde, 0x76, 0xb3, 0x21, 0x5b,
-	0xed, 0xbd, 0x32, 0xde, 0x5d, 0x82, 0x90, 0x5c, 0x3e, 0xe4, 0x5c, 0xee, 0xe2, 0x9d, 0xa5, 0x5c,
-	0xc4, 0xb3, 0xd4, 0xfb, 0xfc, 0xcf, 0xb3, 0x1d, 0xe5, 0xaf, 0xb3, 0x1d, 0xe5, 0xdf, 0xb3, 0x1d,
-	0xe5, 0xfb, 0xae, 0x1f, 0xb0, 0x17, 0xf9, 0xa0, 0x33, 0x4c, 0x22, 0x27, 0xce, 0x23, 0x2f, 0xcd,
-	0x92, 0x1f, 0xf8, 0x61, 0x1c, 0x26, 0xc7, 0x4e, 0xe3, 0x1f, 0xb3, 0xff, 0x03, 0x00, 0x00, 0xff,
-	0xff, 0x90, 0x5c, 0xf8, 0xf5, 0xb0, 0x09, 0x00, 0x00,
+func (m *EdgeMetrics) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EdgeMetrics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EdgeMetrics.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EdgeMetrics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EdgeMetrics.Merge(m, src)
+}
+func (m *EdgeMetrics) XXX_Size() int {
+	return m.Size()
+}
+func (m *EdgeMetrics) XXX_DiscardUnknown() {
+	xxx_messageInfo_EdgeMetrics.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_EdgeMetrics proto.InternalMessageInfo
 
-// DaemonServiceClient is the client API for DaemonService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type DaemonServiceClient interface {
-	ListBuffers(ctx context.Context, in *ListBuffersRequest, opts ...grpc.CallOption) (*ListBuffersResponse, error)
-	GetBuffer(ctx context.Context, in *GetBufferRequest, opts ...grpc.CallOption) (*GetBufferResponse, error)
-	GetVertexMetrics(ctx context.Context, in *GetVertexMetricsRequest, opts ...grpc.CallOption) (*GetVertexMetricsResponse, error)
-	// GetPipelineWatermarks return the watermark of the given pipeline
-	GetPipelineWatermarks(ctx context.Context, in *GetPipelineWatermarksRequest, opts ...grpc.CallOption) (*GetPipelineWatermarksResponse, error)
-	GetPipelineStatus(ctx context.Context, in *GetPipelineStatusRequest, opts ...grpc.CallOption) (*GetPipelineStatusResponse, error)
+func (m *EdgeMetrics) GetPipeline() string {
+	if m != nil && m.Pipeline != nil {
+		return *m.Pipeline
+	}
+	return ""
 }
 
-type daemonServiceClient struct {
-	cc *grpc.ClientConn
+func (m *EdgeMetrics) GetEdge() string {
+	if m != nil && m.Edge != nil {
+		return *m.Edge
+	}
+	return ""
 }
 
-func NewDaemonServiceClient(cc *grpc.ClientConn) DaemonServiceClient {
-	return &daemonServiceClient{cc}
+func (m *EdgeMetrics) GetFromVertex() string {
+	if m != nil && m.FromVertex != nil {
+		return *m.FromVertex
+	}
+	return ""
 }
 
-func (c *daemonServiceClient) ListBuffers(ctx context.Context, in *ListBuffersRequest, opts ...grpc.CallOption) (*ListBuffersResponse, error) {
-	out := new(ListBuffersResponse)
-	err := c.cc.Invoke(ctx, "/daemon.DaemonService/ListBuffers", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *EdgeMetrics) GetToVertex() string {
+	if m != nil && m.ToVertex != nil {
+		return *m.ToVertex
 	}
-	return out, nil
+	return ""
 }
 
-func (c *daemonServiceClient) GetBuffer(ctx context.Context, in *GetBufferRequest, opts ...grpc.CallOption) (*GetBufferResponse, error) {
-	out := new(GetBufferResponse)
-	err := c.cc.Invoke(ctx, "/daemon.DaemonService/GetBuffer", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *EdgeMetrics) GetWatermarkLag() int64 {
+	if m != nil && m.WatermarkLag != nil {
+		return *m.WatermarkLag
 	}
-	return out, nil
+	return 0
 }
 
-func (c *daemonServiceClient) GetVertexMetrics(ctx context.Context, in *GetVertexMetricsRequest, opts ...grpc.CallOption) (*GetVertexMetricsResponse, error) {
-	out := new(GetVertexMetricsResponse)
-	err := c.cc.Invoke(ctx, "/daemon.DaemonService/GetVertexMetrics", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *EdgeMetrics) GetPending() int64 {
+	if m != nil && m.Pending != nil {
+		return *m.Pending
 	}
-	return out, nil
+	return 0
 }
 
-func (c *daemonServiceClient) GetPipelineWatermarks(ctx context.Context, in *GetPipelineWatermarksRequest, opts ...grpc.CallOption) (*GetPipelineWatermarksResponse, error) {
-	out := new(GetPipelineWatermarksResponse)
-	err := c.cc.Invoke(ctx, "/daemon.DaemonService/GetPipelineWatermarks", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *EdgeMetrics) GetBufferUsage() float64 {
+	if m != nil && m.BufferUsage != nil {
+		return *m.BufferUsage
 	}
-	return out, nil
+	return 0
 }
 
-func (c *daemonServiceClient) GetPipelineStatus(ctx context.Context, in *GetPipelineStatusRequest, opts ...grpc.CallOption) (*GetPipelineStatusResponse, error) {
-	out := new(GetPipelineStatusResponse)
-	err := c.cc.Invoke(ctx, "/daemon.DaemonService/GetPipelineStatus", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *EdgeMetrics) GetBufferFillRate() float64 {
+	if m != nil && m.BufferFillRate != nil {
+		return *m.BufferFillRate
 	}
-	return out, nil
+	return 0
 }
 
-// DaemonServiceServer is the server API for DaemonService service.
-type DaemonServiceServer interface {
-	ListBuffers(context.Context, *ListBuffersRequest) (*ListBuffersResponse, error)
-	GetBuffer(context.Context, *GetBufferRequest) (*GetBufferResponse, error)
-	GetVertexMetrics(context.Context, *GetVertexMetricsRequest) (*GetVertexMetricsResponse, error)
-	// GetPipelineWatermarks return the watermark of the given pipeline
-	GetPipelineWatermarks(context.Context, *GetPipelineWatermarksRequest) (*GetPipelineWatermarksResponse, error)
-	GetPipelineStatus(context.Context, *GetPipelineStatusRequest) (*GetPipelineStatusResponse, error)
+func (m *EdgeMetrics) GetLatencyPercentiles() map[string]int64 {
+	if m != nil {
+		return m.LatencyPercentiles
+	}
+	return nil
 }
 
-// UnimplementedDaemonServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedDaemonServiceServer struct {
+// GetPipelineEdgeMetricsRequest requests for the edge metrics of a pipeline.
+type GetPipelineEdgeMetricsRequest struct {
+	Pipeline             *string  `protobuf:"bytes,1,req,name=pipeline" json:"pipeline,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (*UnimplementedDaemonServiceServer) ListBuffers(ctx context.Context, req *ListBuffersRequest) (*ListBuffersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListBuffers not implemented")
+func (m *GetPipelineEdgeMetricsRequest) Reset()         { *m = GetPipelineEdgeMetricsRequest{} }
+func (m *GetPipelineEdgeMetricsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPipelineEdgeMetricsRequest) ProtoMessage()    {}
+func (*GetPipelineEdgeMetricsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93e327fd0d673221, []int{15}
 }
-func (*UnimplementedDaemonServiceServer) GetBuffer(ctx context.Context, req *GetBufferRequest) (*GetBufferResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetBuffer not implemented")
+func (m *GetPipelineEdgeMetricsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedDaemonServiceServer) GetVertexMetrics(ctx context.Context, req *GetVertexMetricsRequest) (*GetVertexMetricsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetVertexMetrics not implemented")
+func (m *GetPipelineEdgeMetricsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetPipelineEdgeMetricsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedDaemonServiceServer) GetPipelineWatermarks(ctx context.Context, req *GetPipelineWatermarksRequest) (*GetPipelineWatermarksResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPipelineWatermarks not implemented")
+func (m *GetPipelineEdgeMetricsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPipelineEdgeMetricsRequest.Merge(m, src)
 }
-func (*UnimplementedDaemonServiceServer) GetPipelineStatus(ctx context.Context, req *GetPipelineStatusRequest) (*GetPipelineStatusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPipelineStatus not implemented")
+func (m *GetPipelineEdgeMetricsRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterDaemonServiceServer(s *grpc.Server, srv DaemonServiceServer) {
-	s.RegisterService(&_DaemonService_serviceDesc, srv)
+func (m *GetPipelineEdgeMetricsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPipelineEdgeMetricsRequest.DiscardUnknown(m)
 }
 
-func _DaemonService_ListBuffers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListBuffersRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(DaemonServiceServer).ListBuffers(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/daemon.DaemonService/ListBuffers",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServiceServer).ListBuffers(ctx, req.(*ListBuffersRequest))
+var xxx_messageInfo_GetPipelineEdgeMetricsRequest proto.InternalMessageInfo
+
+func (m *GetPipelineEdgeMetricsRequest) GetPipeline() string {
+	if m != nil && m.Pipeline != nil {
+		return *m.Pipeline
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _DaemonService_GetBuffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetBufferRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(DaemonServiceServer).GetBuffer(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/daemon.DaemonService/GetBuffer",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServiceServer).GetBuffer(ctx, req.(*GetBufferRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type GetPipelineEdgeMetricsResponse struct {
+	EdgeMetrics []*EdgeMetrics `protobuf:"bytes,1,rep,name=edgeMetrics" json:"edgeMetrics,omitempty"`
+	// Percentiles (p50, p90, p99) of the end-to-end processing latency of the pipeline in milliseconds.
+	EndToEndLatencyPercentiles map[string]int64 `protobuf:"bytes,2,rep,name=endToEndLatencyPercentiles" json:"endToEndLatencyPercentiles,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	XXX_NoUnkeyedLiteral       struct{}         `json:"-"`
+	XXX_unrecognized           []byte           `json:"-"`
+	XXX_sizecache              int32            `json:"-"`
 }
 
-func _DaemonService_GetVertexMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetVertexMetricsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(DaemonServiceServer).GetVertexMetrics(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/daemon.DaemonService/GetVertexMetrics",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServiceServer).GetVertexMetrics(ctx, req.(*GetVertexMetricsRequest))
+func (m *GetPipelineEdgeMetricsResponse) Reset()         { *m = GetPipelineEdgeMetricsResponse{} }
+func (m *GetPipelineEdgeMetricsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPipelineEdgeMetricsResponse) ProtoMessage()    {}
+func (*GetPipelineEdgeMetricsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93e327fd0d673221, []int{16}
+}
+func (m *GetPipelineEdgeMetricsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetPipelineEdgeMetricsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetPipelineEdgeMetricsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *GetPipelineEdgeMetricsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPipelineEdgeMetricsResponse.Merge(m, src)
+}
+func (m *GetPipelineEdgeMetricsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetPipelineEdgeMetricsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPipelineEdgeMetricsResponse.DiscardUnknown(m)
 }
 
-func _DaemonService_GetPipelineWatermarks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPipelineWatermarksRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(DaemonServiceServer).GetPipelineWatermarks(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/daemon.DaemonService/GetPipelineWatermarks",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServiceServer).GetPipelineWatermarks(ctx, req.(*GetPipelineWatermarksRequest))
+var xxx_messageInfo_GetPipelineEdgeMetricsResponse proto.InternalMessageInfo
+
+func (m *GetPipelineEdgeMetricsResponse) GetEdgeMetrics() []*EdgeMetrics {
+	if m != nil {
+		return m.EdgeMetrics
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _DaemonService_GetPipelineStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPipelineStatusRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(DaemonServiceServer).GetPipelineStatus(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/daemon.DaemonService/GetPipelineStatus",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServiceServer).GetPipelineStatus(ctx, req.(*GetPipelineStatusRequest))
+func (m *GetPipelineEdgeMetricsResponse) GetEndToEndLatencyPercentiles() map[string]int64 {
+	if m != nil {
+		return m.EndToEndLatencyPercentiles
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _DaemonService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "daemon.DaemonService",
-	HandlerType: (*DaemonServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ListBuffers",
-			Handler:    _DaemonService_ListBuffers_Handler,
-		},
-		{
-			MethodName: "GetBuffer",
-			Handler:    _DaemonService_GetBuffer_Handler,
-		},
-		{
-			MethodName: "GetVertexMetrics",
-			Handler:    _DaemonService_GetVertexMetrics_Handler,
-		},
-		{
-			MethodName: "GetPipelineWatermarks",
-			Handler:    _DaemonService_GetPipelineWatermarks_Handler,
-		},
-		{
-			MethodName: "GetPipelineStatus",
-			Handler:    _DaemonService_GetPipelineStatus_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "pkg/apis/proto/daemon/daemon.proto",
+// MetricsHistoryPoint is a sample of a metric.
+type MetricsHistoryPoint struct {
+	// Timestamp in milliseconds.
+	Timestamp            *int64   `protobuf:"varint,1,req,name=timestamp" json:"timestamp,omitempty"`
+	Value                *float64 `protobuf:"fixed64,2,req,name=value" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *BufferInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MetricsHistoryPoint) Reset()         { *m = MetricsHistoryPoint{} }
+func (m *MetricsHistoryPoint) String() string { return proto.CompactTextString(m) }
+func (*MetricsHistoryPoint) ProtoMessage()    {}
+func (*MetricsHistoryPoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93e327fd0d673221, []int{17}
+}
+func (m *MetricsHistoryPoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MetricsHistoryPoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MetricsHistoryPoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MetricsHistoryPoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MetricsHistoryPoint.Merge(m, src)
+}
+func (m *MetricsHistoryPoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *MetricsHistoryPoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_MetricsHistoryPoint.DiscardUnknown(m)
 }
 
-func (m *BufferInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MetricsHistoryPoint proto.InternalMessageInfo
+
+func (m *MetricsHistoryPoint) GetTimestamp() int64 {
+	if m != nil && m.Timestamp != nil {
+		return *m.Timestamp
+	}
+	return 0
 }
 
-func (m *BufferInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *MetricsHistoryPoint) GetValue() float64 {
+	if m != nil && m.Value != nil {
+		return *m.Value
 	}
-	if m.IsFull == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("isFull")
+	return 0
+}
+
+// MetricsHistory has the samples of a metric of a vertex or an edge.
+type MetricsHistory struct {
+	Pipeline *string `protobuf:"bytes,1,req,name=pipeline" json:"pipeline,omitempty"`
+	// Name of the metric, "processingRate" of a vertex, "pending" or "watermarkLag" of an edge.
+	Metric               *string                `protobuf:"bytes,2,req,name=metric" json:"metric,omitempty"`
+	Vertex               *string                `protobuf:"bytes,3,opt,name=vertex" json:"vertex,omitempty"`
+	Edge                 *string                `protobuf:"bytes,4,opt,name=edge" json:"edge,omitempty"`
+	Points               []*MetricsHistoryPoint `protobuf:"bytes,5,rep,name=points" json:"points,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *MetricsHistory) Reset()         { *m = MetricsHistory{} }
+func (m *MetricsHistory) String() string { return proto.CompactTextString(m) }
+func (*MetricsHistory) ProtoMessage()    {}
+func (*MetricsHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93e327fd0d673221, []int{18}
+}
+func (m *MetricsHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MetricsHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MetricsHistory.Marshal(b, m, deterministic)
 	} else {
-		i--
-		if *m.IsFull {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x48
+		return b[:n], nil
 	}
-	if m.BufferUsage == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("bufferUsage")
-	} else {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*m.BufferUsage))))
-		i--
-		dAtA[i] = 0x41
+}
+func (m *MetricsHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MetricsHistory.Merge(m, src)
+}
+func (m *MetricsHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *MetricsHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_MetricsHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MetricsHistory proto.InternalMessageInfo
+
+func (m *MetricsHistory) GetPipeline() string {
+	if m != nil && m.Pipeline != nil {
+		return *m.Pipeline
 	}
-	if m.BufferUsageLimit == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("bufferUsageLimit")
-	} else {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*m.BufferUsageLimit))))
-		i--
-		dAtA[i] = 0x39
+	return ""
+}
+
+func (m *MetricsHistory) GetMetric() string {
+	if m != nil && m.Metric != nil {
+		return *m.Metric
 	}
-	if m.BufferLength == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("bufferLength")
-	} else {
-		i = encodeVarintDaemon(dAtA, i, uint64(*m.BufferLength))
-		i--
-		dAtA[i] = 0x30
+	return ""
+}
+
+func (m *MetricsHistory) GetVertex() string {
+	if m != nil && m.Vertex != nil {
+		return *m.Vertex
 	}
-	if m.TotalMessages == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("totalMessages")
-	} else {
-		i = encodeVarintDaemon(dAtA, i, uint64(*m.TotalMessages))
-		i--
-		dAtA[i] = 0x28
+	return ""
+}
+
+func (m *MetricsHistory) GetEdge() string {
+	if m != nil && m.Edge != nil {
+		return *m.Edge
 	}
-	if m.AckPendingCount == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("ackPendingCount")
-	} else {
-		i = encodeVarintDaemon(dAtA, i, uint64(*m.AckPendingCount))
-		i--
-		dAtA[i] = 0x20
+	return ""
+}
+
+func (m *MetricsHistory) GetPoints() []*MetricsHistoryPoint {
+	if m != nil {
+		return m.Points
 	}
-	if m.PendingCount == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("pendingCount")
+	return nil
+}
+
+// GetPipelineMetricsHistoryRequest requests for the metrics history of a pipeline.
+type GetPipelineMetricsHistoryRequest struct {
+	Pipeline *string `protobuf:"bytes,1,req,name=pipeline" json:"pipeline,omitempty"`
+	// Only the samples since the timestamp in milliseconds are returned, all the samples if not specified.
+	Since                *int64   `protobuf:"varint,2,opt,name=since" json:"since,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetPipelineMetricsHistoryRequest) Reset()         { *m = GetPipelineMetricsHistoryRequest{} }
+func (m *GetPipelineMetricsHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPipelineMetricsHistoryRequest) ProtoMessage()    {}
+func (*GetPipelineMetricsHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93e327fd0d673221, []int{19}
+}
+func (m *GetPipelineMetricsHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetPipelineMetricsHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetPipelineMetricsHistoryRequest.Marshal(b, m, deterministic)
 	} else {
-		i = encodeVarintDaemon(dAtA, i, uint64(*m.PendingCount))
-		i--
-		dAtA[i] = 0x18
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.BufferName == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("bufferName")
-	} else {
-		i -= len(*m.BufferName)
-		copy(dAtA[i:], *m.BufferName)
-		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.BufferName)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *GetPipelineMetricsHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPipelineMetricsHistoryRequest.Merge(m, src)
+}
+func (m *GetPipelineMetricsHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetPipelineMetricsHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPipelineMetricsHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPipelineMetricsHistoryRequest proto.InternalMessageInfo
+
+func (m *GetPipelineMetricsHistoryRequest) GetPipeline() string {
+	if m != nil && m.Pipeline != nil {
+		return *m.Pipeline
 	}
-	if m.Pipeline == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("pipeline")
+	return ""
+}
+
+func (m *GetPipelineMetricsHistoryRequest) GetSince() int64 {
+	if m != nil && m.Since != nil {
+		return *m.Since
+	}
+	return 0
+}
+
+type GetPipelineMetricsHistoryResponse struct {
+	History              []*MetricsHistory `protobuf:"bytes,1,rep,name=history" json:"history,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetPipelineMetricsHistoryResponse) Reset()         { *m = GetPipelineMetricsHistoryResponse{} }
+func (m *GetPipelineMetricsHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPipelineMetricsHistoryResponse) ProtoMessage()    {}
+func (*GetPipelineMetricsHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93e327fd0d673221, []int{20}
+}
+func (m *GetPipelineMetricsHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetPipelineMetricsHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetPipelineMetricsHistoryResponse.Marshal(b, m, deterministic)
 	} else {
-		i -= len(*m.Pipeline)
-		copy(dAtA[i:], *m.Pipeline)
-		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Pipeline)))
-		i--
-		dAtA[i] = 0xa
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *GetPipelineMetricsHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPipelineMetricsHistoryResponse.Merge(m, src)
+}
+func (m *GetPipelineMetricsHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetPipelineMetricsHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPipelineMetricsHistoryResponse.DiscardUnknown(m)
 }
 
-func (m *VertexMetrics) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetPipelineMetricsHistoryResponse proto.InternalMessageInfo
+
+func (m *GetPipelineMetricsHistoryResponse) GetHistory() []*MetricsHistory {
+	if m != nil {
+		return m.History
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *VertexMetrics) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// DrainBufferRequest requests to ack the pending messages of a buffer without processing them.
+type DrainBufferRequest struct {
+	Pipeline *string `protobuf:"bytes,1,req,name=pipeline" json:"pipeline,omitempty"`
+	Buffer   *string `protobuf:"bytes,2,req,name=buffer" json:"buffer,omitempty"`
+	// Reason of the operation, which is recorded in the audit log.
+	Reason               *string  `protobuf:"bytes,3,opt,name=reason" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *VertexMetrics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *DrainBufferRequest) Reset()         { *m = DrainBufferRequest{} }
+func (m *DrainBufferRequest) String() string { return proto.CompactTextString(m) }
+func (*DrainBufferRequest) ProtoMessage()    {}
+func (*DrainBufferRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93e327fd0d673221, []int{21}
+}
+func (m *DrainBufferRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DrainBufferRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DrainBufferRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Pendings) > 0 {
-		for k := range m.Pendings {
-			v := m.Pendings[k]
-			baseI := i
-			i = encodeVarintDaemon(dAtA, i, uint64(v))
-			i--
-			dAtA[i] = 0x10
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintDaemon(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintDaemon(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x22
-		}
+}
+func (m *DrainBufferRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DrainBufferRequest.Merge(m, src)
+}
+func (m *DrainBufferRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DrainBufferRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DrainBufferRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DrainBufferRequest proto.InternalMessageInfo
+
+func (m *DrainBufferRequest) GetPipeline() string {
+	if m != nil && m.Pipeline != nil {
+		return *m.Pipeline
 	}
-	if len(m.ProcessingRates) > 0 {
-		for k := range m.ProcessingRates {
-			v := m.ProcessingRates[k]
-			baseI := i
-			i -= 8
-			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(v))))
-			i--
-			dAtA[i] = 0x11
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintDaemon(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintDaemon(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x1a
-		}
+	return ""
+}
+
+func (m *DrainBufferRequest) GetBuffer() string {
+	if m != nil && m.Buffer != nil {
+		return *m.Buffer
 	}
-	if m.Vertex == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("vertex")
-	} else {
-		i -= len(*m.Vertex)
-		copy(dAtA[i:], *m.Vertex)
-		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Vertex)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *DrainBufferRequest) GetReason() string {
+	if m != nil && m.Reason != nil {
+		return *m.Reason
 	}
-	if m.Pipeline == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("pipeline")
+	return ""
+}
+
+type DrainBufferResponse struct {
+	// Number of the acked messages.
+	DrainedCount         *int64   `protobuf:"varint,1,req,name=drainedCount" json:"drainedCount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DrainBufferResponse) Reset()         { *m = DrainBufferResponse{} }
+func (m *DrainBufferResponse) String() string { return proto.CompactTextString(m) }
+func (*DrainBufferResponse) ProtoMessage()    {}
+func (*DrainBufferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93e327fd0d673221, []int{22}
+}
+func (m *DrainBufferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DrainBufferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DrainBufferResponse.Marshal(b, m, deterministic)
 	} else {
-		i -= len(*m.Pipeline)
-		copy(dAtA[i:], *m.Pipeline)
-		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Pipeline)))
-		i--
-		dAtA[i] = 0xa
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *DrainBufferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DrainBufferResponse.Merge(m, src)
+}
+func (m *DrainBufferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DrainBufferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DrainBufferResponse.DiscardUnknown(m)
 }
 
-func (m *PipelineStatus) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DrainBufferResponse proto.InternalMessageInfo
+
+func (m *DrainBufferResponse) GetDrainedCount() int64 {
+	if m != nil && m.DrainedCount != nil {
+		return *m.DrainedCount
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *PipelineStatus) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// SkipBufferRequest requests to move the reader of a buffer ahead to the latest offset.
+type SkipBufferRequest struct {
+	Pipeline *string `protobuf:"bytes,1,req,name=pipeline" json:"pipeline,omitempty"`
+	Buffer   *string `protobuf:"bytes,2,req,name=buffer" json:"buffer,omitempty"`
+	// Reason of the operation, which is recorded in the audit log.
+	Reason               *string  `protobuf:"bytes,3,opt,name=reason" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PipelineStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Message == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("message")
-	} else {
-		i -= len(*m.Message)
-		copy(dAtA[i:], *m.Message)
-		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Message)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Status == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("status")
+func (m *SkipBufferRequest) Reset()         { *m = SkipBufferRequest{} }
+func (m *SkipBufferRequest) String() string { return proto.CompactTextString(m) }
+func (*SkipBufferRequest) ProtoMessage()    {}
+func (*SkipBufferRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93e327fd0d673221, []int{23}
+}
+func (m *SkipBufferRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SkipBufferRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SkipBufferRequest.Marshal(b, m, deterministic)
 	} else {
-		i -= len(*m.Status)
-		copy(dAtA[i:], *m.Status)
-		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Status)))
-		i--
-		dAtA[i] = 0xa
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *SkipBufferRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SkipBufferRequest.Merge(m, src)
+}
+func (m *SkipBufferRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SkipBufferRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SkipBufferRequest.DiscardUnknown(m)
 }
 
-func (m *ListBuffersRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SkipBufferRequest proto.InternalMessageInfo
+
+func (m *SkipBufferRequest) GetPipeline() string {
+	if m != nil && m.Pipeline != nil {
+		return *m.Pipeline
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ListBuffersRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SkipBufferRequest) GetBuffer() string {
+	if m != nil && m.Buffer != nil {
+		return *m.Buffer
+	}
+	return ""
 }
 
-func (m *ListBuffersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *SkipBufferRequest) GetReason() string {
+	if m != nil && m.Reason != nil {
+		return *m.Reason
 	}
-	if m.Pipeline == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("pipeline")
+	return ""
+}
+
+type SkipBufferResponse struct {
+	// Number of the skipped messages.
+	SkippedCount         *int64   `protobuf:"varint,1,req,name=skippedCount" json:"skippedCount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SkipBufferResponse) Reset()         { *m = SkipBufferResponse{} }
+func (m *SkipBufferResponse) String() string { return proto.CompactTextString(m) }
+func (*SkipBufferResponse) ProtoMessage()    {}
+func (*SkipBufferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93e327fd0d673221, []int{24}
+}
+func (m *SkipBufferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SkipBufferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SkipBufferResponse.Marshal(b, m, deterministic)
 	} else {
-		i -= len(*m.Pipeline)
-		copy(dAtA[i:], *m.Pipeline)
-		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Pipeline)))
-		i--
-		dAtA[i] = 0xa
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *SkipBufferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SkipBufferResponse.Merge(m, src)
+}
+func (m *SkipBufferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SkipBufferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SkipBufferResponse.DiscardUnknown(m)
 }
 
-func (m *ListBuffersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SkipBufferResponse proto.InternalMessageInfo
+
+func (m *SkipBufferResponse) GetSkippedCount() int64 {
+	if m != nil && m.SkippedCount != nil {
+		return *m.SkippedCount
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ListBuffersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// VertexError is an error returned by a user-defined container of a vertex.
+type VertexError struct {
+	Pipeline *string `protobuf:"bytes,1,req,name=pipeline" json:"pipeline,omitempty"`
+	Vertex   *string `protobuf:"bytes,2,req,name=vertex" json:"vertex,omitempty"`
+	// Name of the pod where the error happened.
+	Pod *string `protobuf:"bytes,3,req,name=pod" json:"pod,omitempty"`
+	// Name of the container returning the error, e.g. "udf" or "udsink".
+	Container *string `protobuf:"bytes,4,req,name=container" json:"container,omitempty"`
+	// Timestamp in milliseconds.
+	Timestamp *int64 `protobuf:"varint,5,req,name=timestamp" json:"timestamp,omitempty"`
+	// gRPC status code of the error.
+	Code *string `protobuf:"bytes,6,req,name=code" json:"code,omitempty"`
+	// ID of the message being processed, or the window of a reduce vertex.
+	MessageID *string `protobuf:"bytes,7,opt,name=messageID" json:"messageID,omitempty"`
+	Message   *string `protobuf:"bytes,8,req,name=message" json:"message,omitempty"`
+	// Truncated SHA-256 hash of the payload of the message.
+	PayloadHash          *string  `protobuf:"bytes,9,opt,name=payloadHash" json:"payloadHash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListBuffersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Buffers) > 0 {
-		for iNdEx := len(m.Buffers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Buffers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDaemon(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *VertexError) Reset()         { *m = VertexError{} }
+func (m *VertexError) String() string { return proto.CompactTextString(m) }
+func (*VertexError) ProtoMessage()    {}
+func (*VertexError) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93e327fd0d673221, []int{25}
+}
+func (m *VertexError) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VertexError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VertexError.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *VertexError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VertexError.Merge(m, src)
+}
+func (m *VertexError) XXX_Size() int {
+	return m.Size()
+}
+func (m *VertexError) XXX_DiscardUnknown() {
+	xxx_messageInfo_VertexError.DiscardUnknown(m)
 }
 
-func (m *GetBufferRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_VertexError proto.InternalMessageInfo
+
+func (m *VertexError) GetPipeline() string {
+	if m != nil && m.Pipeline != nil {
+		return *m.Pipeline
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *GetBufferRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *VertexError) GetVertex() string {
+	if m != nil && m.Vertex != nil {
+		return *m.Vertex
+	}
+	return ""
 }
 
-func (m *GetBufferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *VertexError) GetPod() string {
+	if m != nil && m.Pod != nil {
+		return *m.Pod
 	}
-	if m.Buffer == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("buffer")
-	} else {
-		i -= len(*m.Buffer)
-		copy(dAtA[i:], *m.Buffer)
-		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Buffer)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *VertexError) GetContainer() string {
+	if m != nil && m.Container != nil {
+		return *m.Container
 	}
-	if m.Pipeline == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("pipeline")
-	} else {
-		i -= len(*m.Pipeline)
-		copy(dAtA[i:], *m.Pipeline)
-		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Pipeline)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *VertexError) GetTimestamp() int64 {
+	if m != nil && m.Timestamp != nil {
+		return *m.Timestamp
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *GetBufferResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *VertexError) GetCode() string {
+	if m != nil && m.Code != nil {
+		return *m.Code
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *GetBufferResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *VertexError) GetMessageID() string {
+	if m != nil && m.MessageID != nil {
+		return *m.MessageID
+	}
+	return ""
 }
 
-func (m *GetBufferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *VertexError) GetMessage() string {
+	if m != nil && m.Message != nil {
+		return *m.Message
 	}
-	if m.Buffer == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("buffer")
+	return ""
+}
+
+func (m *VertexError) GetPayloadHash() string {
+	if m != nil && m.PayloadHash != nil {
+		return *m.PayloadHash
+	}
+	return ""
+}
+
+// GetVertexErrorsRequest requests for the last errors of a vertex.
+type GetVertexErrorsRequest struct {
+	Pipeline             *string  `protobuf:"bytes,1,req,name=pipeline" json:"pipeline,omitempty"`
+	Vertex               *string  `protobuf:"bytes,2,req,name=vertex" json:"vertex,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetVertexErrorsRequest) Reset()         { *m = GetVertexErrorsRequest{} }
+func (m *GetVertexErrorsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetVertexErrorsRequest) ProtoMessage()    {}
+func (*GetVertexErrorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93e327fd0d673221, []int{26}
+}
+func (m *GetVertexErrorsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetVertexErrorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetVertexErrorsRequest.Marshal(b, m, deterministic)
 	} else {
-		{
-			size, err := m.Buffer.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDaemon(dAtA, i, uint64(size))
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *GetVertexErrorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetVertexErrorsRequest.Merge(m, src)
+}
+func (m *GetVertexErrorsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetVertexErrorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetVertexErrorsRequest.DiscardUnknown(m)
 }
 
-func (m *GetPipelineStatusRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetVertexErrorsRequest proto.InternalMessageInfo
+
+func (m *GetVertexErrorsRequest) GetPipeline() string {
+	if m != nil && m.Pipeline != nil {
+		return *m.Pipeline
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *GetPipelineStatusRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GetVertexErrorsRequest) GetVertex() string {
+	if m != nil && m.Vertex != nil {
+		return *m.Vertex
+	}
+	return ""
 }
 
-func (m *GetPipelineStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Pipeline == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("pipeline")
+type GetVertexErrorsResponse struct {
+	// The errors, the newest first.
+	Errors               []*VertexError `protobuf:"bytes,1,rep,name=errors" json:"errors,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *GetVertexErrorsResponse) Reset()         { *m = GetVertexErrorsResponse{} }
+func (m *GetVertexErrorsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetVertexErrorsResponse) ProtoMessage()    {}
+func (*GetVertexErrorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93e327fd0d673221, []int{27}
+}
+func (m *GetVertexErrorsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetVertexErrorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetVertexErrorsResponse.Marshal(b, m, deterministic)
 	} else {
-		i -= len(*m.Pipeline)
-		copy(dAtA[i:], *m.Pipeline)
-		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Pipeline)))
-		i--
-		dAtA[i] = 0xa
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *GetVertexErrorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetVertexErrorsResponse.Merge(m, src)
+}
+func (m *GetVertexErrorsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetVertexErrorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetVertexErrorsResponse.DiscardUnknown(m)
 }
 
-func (m *GetPipelineStatusResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetVertexErrorsResponse proto.InternalMessageInfo
+
+func (m *GetVertexErrorsResponse) GetErrors() []*VertexError {
+	if m != nil {
+		return m.Errors
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GetPipelineStatusResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// VertexLog is a log line of a user-defined container of a vertex, tagged with the ID of a message.
+type VertexLog struct {
+	Pipeline *string `protobuf:"bytes,1,req,name=pipeline" json:"pipeline,omitempty"`
+	Vertex   *string `protobuf:"bytes,2,req,name=vertex" json:"vertex,omitempty"`
+	// Name of the pod where the log line is written.
+	Pod *string `protobuf:"bytes,3,req,name=pod" json:"pod,omitempty"`
+	// Name of the message log, e.g. the name of the user-defined function.
+	Source *string `protobuf:"bytes,4,req,name=source" json:"source,omitempty"`
+	// Timestamp in milliseconds.
+	Timestamp *int64 `protobuf:"varint,5,req,name=timestamp" json:"timestamp,omitempty"`
+	// The log line in JSON.
+	Line                 *string  `protobuf:"bytes,6,req,name=line" json:"line,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetPipelineStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Status == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("status")
+func (m *VertexLog) Reset()         { *m = VertexLog{} }
+func (m *VertexLog) String() string { return proto.CompactTextString(m) }
+func (*VertexLog) ProtoMessage()    {}
+func (*VertexLog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93e327fd0d673221, []int{28}
+}
+func (m *VertexLog) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VertexLog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VertexLog.Marshal(b, m, deterministic)
 	} else {
-		{
-			size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDaemon(dAtA, i, uint64(size))
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *VertexLog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VertexLog.Merge(m, src)
+}
+func (m *VertexLog) XXX_Size() int {
+	return m.Size()
+}
+func (m *VertexLog) XXX_DiscardUnknown() {
+	xxx_messageInfo_VertexLog.DiscardUnknown(m)
 }
 
-func (m *GetVertexMetricsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_VertexLog proto.InternalMessageInfo
+
+func (m *VertexLog) GetPipeline() string {
+	if m != nil && m.Pipeline != nil {
+		return *m.Pipeline
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *GetVertexMetricsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *VertexLog) GetVertex() string {
+	if m != nil && m.Vertex != nil {
+		return *m.Vertex
+	}
+	return ""
 }
 
-func (m *GetVertexMetricsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Vertex == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("vertex")
-	} else {
-		i -= len(*m.Vertex)
-		copy(dAtA[i:], *m.Vertex)
-		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Vertex)))
-		i--
-		dAtA[i] = 0x1a
+func (m *VertexLog) GetPod() string {
+	if m != nil && m.Pod != nil {
+		return *m.Pod
 	}
-	if m.Pipeline == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("pipeline")
-	} else {
-		i -= len(*m.Pipeline)
-		copy(dAtA[i:], *m.Pipeline)
-		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Pipeline)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *VertexLog) GetSource() string {
+	if m != nil && m.Source != nil {
+		return *m.Source
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *GetVertexMetricsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *VertexLog) GetTimestamp() int64 {
+	if m != nil && m.Timestamp != nil {
+		return *m.Timestamp
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *GetVertexMetricsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *VertexLog) GetLine() string {
+	if m != nil && m.Line != nil {
+		return *m.Line
+	}
+	return ""
 }
 
-func (m *GetVertexMetricsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.VertexMetrics) > 0 {
-		for iNdEx := len(m.VertexMetrics) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.VertexMetrics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDaemon(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+// GetVertexLogsRequest requests for the recent logs of a vertex tagged with the ID of a message.
+type GetVertexLogsRequest struct {
+	Pipeline             *string  `protobuf:"bytes,1,req,name=pipeline" json:"pipeline,omitempty"`
+	Vertex               *string  `protobuf:"bytes,2,req,name=vertex" json:"vertex,omitempty"`
+	MessageID            *string  `protobuf:"bytes,3,req,name=messageID" json:"messageID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetVertexLogsRequest) Reset()         { *m = GetVertexLogsRequest{} }
+func (m *GetVertexLogsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetVertexLogsRequest) ProtoMessage()    {}
+func (*GetVertexLogsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93e327fd0d673221, []int{29}
+}
+func (m *GetVertexLogsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetVertexLogsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetVertexLogsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *GetVertexLogsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetVertexLogsRequest.Merge(m, src)
+}
+func (m *GetVertexLogsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetVertexLogsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetVertexLogsRequest.DiscardUnknown(m)
 }
 
-func (m *EdgeWatermark) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetVertexLogsRequest proto.InternalMessageInfo
+
+func (m *GetVertexLogsRequest) GetPipeline() string {
+	if m != nil && m.Pipeline != nil {
+		return *m.Pipeline
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EdgeWatermark) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GetVertexLogsRequest) GetVertex() string {
+	if m != nil && m.Vertex != nil {
+		return *m.Vertex
+	}
+	return ""
 }
 
-func (m *EdgeWatermark) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *GetVertexLogsRequest) GetMessageID() string {
+	if m != nil && m.MessageID != nil {
+		return *m.MessageID
 	}
-	if m.IsWatermarkEnabled == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("isWatermarkEnabled")
+	return ""
+}
+
+type GetVertexLogsResponse struct {
+	// The log lines, the newest first.
+	Logs                 []*VertexLog `protobuf:"bytes,1,rep,name=logs" json:"logs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *GetVertexLogsResponse) Reset()         { *m = GetVertexLogsResponse{} }
+func (m *GetVertexLogsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetVertexLogsResponse) ProtoMessage()    {}
+func (*GetVertexLogsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93e327fd0d673221, []int{30}
+}
+func (m *GetVertexLogsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetVertexLogsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetVertexLogsResponse.Marshal(b, m, deterministic)
 	} else {
-		i--
-		if *m.IsWatermarkEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Watermarks) > 0 {
-		for iNdEx := len(m.Watermarks) - 1; iNdEx >= 0; iNdEx-- {
-			i = encodeVarintDaemon(dAtA, i, uint64(m.Watermarks[iNdEx]))
-			i--
-			dAtA[i] = 0x18
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Edge == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("edge")
-	} else {
-		i -= len(*m.Edge)
-		copy(dAtA[i:], *m.Edge)
-		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Edge)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *GetVertexLogsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetVertexLogsResponse.Merge(m, src)
+}
+func (m *GetVertexLogsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetVertexLogsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetVertexLogsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetVertexLogsResponse proto.InternalMessageInfo
+
+func (m *GetVertexLogsResponse) GetLogs() []*VertexLog {
+	if m != nil {
+		return m.Logs
 	}
-	if m.Pipeline == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("pipeline")
+	return nil
+}
+
+// PipelineDegradation is the degradation state of a pipeline.
+type PipelineDegradation struct {
+	Pipeline *string `protobuf:"bytes,1,req,name=pipeline" json:"pipeline,omitempty"`
+	// Whether the best-effort edges of the pipeline are degraded.
+	Degraded *bool `protobuf:"varint,2,req,name=degraded" json:"degraded,omitempty"`
+	// Reason of the last transition.
+	Reason *string `protobuf:"bytes,3,req,name=reason" json:"reason,omitempty"`
+	// Time of the last transition in milliseconds, 0 if never transitioned.
+	LastTransitionTime *int64 `protobuf:"varint,4,req,name=lastTransitionTime" json:"lastTransitionTime,omitempty"`
+	// The recent transitions, the oldest first.
+	Transitions          []*DegradationTransition `protobuf:"bytes,5,rep,name=transitions" json:"transitions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *PipelineDegradation) Reset()         { *m = PipelineDegradation{} }
+func (m *PipelineDegradation) String() string { return proto.CompactTextString(m) }
+func (*PipelineDegradation) ProtoMessage()    {}
+func (*PipelineDegradation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93e327fd0d673221, []int{31}
+}
+func (m *PipelineDegradation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PipelineDegradation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PipelineDegradation.Marshal(b, m, deterministic)
 	} else {
-		i -= len(*m.Pipeline)
-		copy(dAtA[i:], *m.Pipeline)
-		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Pipeline)))
-		i--
-		dAtA[i] = 0xa
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *PipelineDegradation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PipelineDegradation.Merge(m, src)
+}
+func (m *PipelineDegradation) XXX_Size() int {
+	return m.Size()
+}
+func (m *PipelineDegradation) XXX_DiscardUnknown() {
+	xxx_messageInfo_PipelineDegradation.DiscardUnknown(m)
 }
 
-func (m *GetPipelineWatermarksResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PipelineDegradation proto.InternalMessageInfo
+
+func (m *PipelineDegradation) GetPipeline() string {
+	if m != nil && m.Pipeline != nil {
+		return *m.Pipeline
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *GetPipelineWatermarksResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PipelineDegradation) GetDegraded() bool {
+	if m != nil && m.Degraded != nil {
+		return *m.Degraded
+	}
+	return false
 }
 
-func (m *GetPipelineWatermarksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *PipelineDegradation) GetReason() string {
+	if m != nil && m.Reason != nil {
+		return *m.Reason
 	}
-	if len(m.PipelineWatermarks) > 0 {
-		for iNdEx := len(m.PipelineWatermarks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PipelineWatermarks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDaemon(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	return ""
+}
+
+func (m *PipelineDegradation) GetLastTransitionTime() int64 {
+	if m != nil && m.LastTransitionTime != nil {
+		return *m.LastTransitionTime
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *GetPipelineWatermarksRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PipelineDegradation) GetTransitions() []*DegradationTransition {
+	if m != nil {
+		return m.Transitions
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GetPipelineWatermarksRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// DegradationTransition is a transition of the degradation state of a pipeline.
+type DegradationTransition struct {
+	// Whether the best-effort edges are degraded after the transition.
+	Degraded *bool   `protobuf:"varint,1,req,name=degraded" json:"degraded,omitempty"`
+	Reason   *string `protobuf:"bytes,2,req,name=reason" json:"reason,omitempty"`
+	// Time of the transition in milliseconds.
+	Time                 *int64   `protobuf:"varint,3,req,name=time" json:"time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetPipelineWatermarksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Pipeline == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("pipeline")
+func (m *DegradationTransition) Reset()         { *m = DegradationTransition{} }
+func (m *DegradationTransition) String() string { return proto.CompactTextString(m) }
+func (*DegradationTransition) ProtoMessage()    {}
+func (*DegradationTransition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93e327fd0d673221, []int{32}
+}
+func (m *DegradationTransition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DegradationTransition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DegradationTransition.Marshal(b, m, deterministic)
 	} else {
-		i -= len(*m.Pipeline)
-		copy(dAtA[i:], *m.Pipeline)
-		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Pipeline)))
-		i--
-		dAtA[i] = 0xa
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *DegradationTransition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DegradationTransition.Merge(m, src)
+}
+func (m *DegradationTransition) XXX_Size() int {
+	return m.Size()
+}
+func (m *DegradationTransition) XXX_DiscardUnknown() {
+	xxx_messageInfo_DegradationTransition.DiscardUnknown(m)
 }
 
-func encodeVarintDaemon(dAtA []byte, offset int, v uint64) int {
-	offset -= sovDaemon(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_DegradationTransition proto.InternalMessageInfo
+
+func (m *DegradationTransition) GetDegraded() bool {
+	if m != nil && m.Degraded != nil {
+		return *m.Degraded
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return false
 }
-func (m *BufferInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pipeline != nil {
-		l = len(*m.Pipeline)
-		n += 1 + l + sovDaemon(uint64(l))
-	}
-	if m.BufferName != nil {
-		l = len(*m.BufferName)
-		n += 1 + l + sovDaemon(uint64(l))
+
+func (m *DegradationTransition) GetReason() string {
+	if m != nil && m.Reason != nil {
+		return *m.Reason
 	}
-	if m.PendingCount != nil {
-		n += 1 + sovDaemon(uint64(*m.PendingCount))
+	return ""
+}
+
+func (m *DegradationTransition) GetTime() int64 {
+	if m != nil && m.Time != nil {
+		return *m.Time
 	}
-	if m.AckPendingCount != nil {
-		n += 1 + sovDaemon(uint64(*m.AckPendingCount))
-	}
-	if m.TotalMessages != nil {
-		n += 1 + sovDaemon(uint64(*m.TotalMessages))
-	}
-	if m.BufferLength != nil {
-		n += 1 + sovDaemon(uint64(*m.BufferLength))
-	}
-	if m.BufferUsageLimit != nil {
-		n += 9
-	}
-	if m.BufferUsage != nil {
-		n += 9
-	}
-	if m.IsFull != nil {
-		n += 2
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return 0
 }
 
-func (m *VertexMetrics) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pipeline != nil {
-		l = len(*m.Pipeline)
-		n += 1 + l + sovDaemon(uint64(l))
-	}
-	if m.Vertex != nil {
-		l = len(*m.Vertex)
-		n += 1 + l + sovDaemon(uint64(l))
-	}
-	if len(m.ProcessingRates) > 0 {
-		for k, v := range m.ProcessingRates {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovDaemon(uint64(len(k))) + 1 + 8
-			n += mapEntrySize + 1 + sovDaemon(uint64(mapEntrySize))
+// GetPipelineDegradationRequest requests for the degradation state of a pipeline.
+type GetPipelineDegradationRequest struct {
+	Pipeline             *string  `protobuf:"bytes,1,req,name=pipeline" json:"pipeline,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetPipelineDegradationRequest) Reset()         { *m = GetPipelineDegradationRequest{} }
+func (m *GetPipelineDegradationRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPipelineDegradationRequest) ProtoMessage()    {}
+func (*GetPipelineDegradationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93e327fd0d673221, []int{33}
+}
+func (m *GetPipelineDegradationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetPipelineDegradationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetPipelineDegradationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Pendings) > 0 {
-		for k, v := range m.Pendings {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovDaemon(uint64(len(k))) + 1 + sovDaemon(uint64(v))
-			n += mapEntrySize + 1 + sovDaemon(uint64(mapEntrySize))
+}
+func (m *GetPipelineDegradationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPipelineDegradationRequest.Merge(m, src)
+}
+func (m *GetPipelineDegradationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetPipelineDegradationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPipelineDegradationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPipelineDegradationRequest proto.InternalMessageInfo
+
+func (m *GetPipelineDegradationRequest) GetPipeline() string {
+	if m != nil && m.Pipeline != nil {
+		return *m.Pipeline
+	}
+	return ""
+}
+
+type GetPipelineDegradationResponse struct {
+	Degradation          *PipelineDegradation `protobuf:"bytes,1,req,name=degradation" json:"degradation,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *GetPipelineDegradationResponse) Reset()         { *m = GetPipelineDegradationResponse{} }
+func (m *GetPipelineDegradationResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPipelineDegradationResponse) ProtoMessage()    {}
+func (*GetPipelineDegradationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93e327fd0d673221, []int{34}
+}
+func (m *GetPipelineDegradationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetPipelineDegradationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetPipelineDegradationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+}
+func (m *GetPipelineDegradationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPipelineDegradationResponse.Merge(m, src)
+}
+func (m *GetPipelineDegradationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetPipelineDegradationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPipelineDegradationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPipelineDegradationResponse proto.InternalMessageInfo
+
+func (m *GetPipelineDegradationResponse) GetDegradation() *PipelineDegradation {
+	if m != nil {
+		return m.Degradation
 	}
-	return n
+	return nil
 }
 
-func (m *PipelineStatus) Size() (n int) {
-	if m == nil {
-		return 0
+// EdgeBackpressure is how much an edge is backed up.
+type EdgeBackpressure struct {
+	Edge       *string `protobuf:"bytes,1,req,name=edge" json:"edge,omitempty"`
+	FromVertex *string `protobuf:"bytes,2,req,name=fromVertex" json:"fromVertex,omitempty"`
+	ToVertex   *string `protobuf:"bytes,3,req,name=toVertex" json:"toVertex,omitempty"`
+	// Backpressure score between 0 and 1, the higher the more the edge is backed up.
+	Score *float64 `protobuf:"fixed64,4,req,name=score" json:"score,omitempty"`
+	// Highest usage of the buffers of the edge.
+	BufferUsage *float64 `protobuf:"fixed64,5,req,name=bufferUsage" json:"bufferUsage,omitempty"`
+	// Failed writes to the buffers of the edge per second.
+	WriteErrorRate *float64 `protobuf:"fixed64,6,req,name=writeErrorRate" json:"writeErrorRate,omitempty"`
+	// Ratio of the failed writes to all the writes to the buffers of the edge.
+	WriteRetryRatio      *float64 `protobuf:"fixed64,7,req,name=writeRetryRatio" json:"writeRetryRatio,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EdgeBackpressure) Reset()         { *m = EdgeBackpressure{} }
+func (m *EdgeBackpressure) String() string { return proto.CompactTextString(m) }
+func (*EdgeBackpressure) ProtoMessage()    {}
+func (*EdgeBackpressure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93e327fd0d673221, []int{35}
+}
+func (m *EdgeBackpressure) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EdgeBackpressure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EdgeBackpressure.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.Status != nil {
-		l = len(*m.Status)
-		n += 1 + l + sovDaemon(uint64(l))
+}
+func (m *EdgeBackpressure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EdgeBackpressure.Merge(m, src)
+}
+func (m *EdgeBackpressure) XXX_Size() int {
+	return m.Size()
+}
+func (m *EdgeBackpressure) XXX_DiscardUnknown() {
+	xxx_messageInfo_EdgeBackpressure.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EdgeBackpressure proto.InternalMessageInfo
+
+func (m *EdgeBackpressure) GetEdge() string {
+	if m != nil && m.Edge != nil {
+		return *m.Edge
 	}
-	if m.Message != nil {
-		l = len(*m.Message)
-		n += 1 + l + sovDaemon(uint64(l))
+	return ""
+}
+
+func (m *EdgeBackpressure) GetFromVertex() string {
+	if m != nil && m.FromVertex != nil {
+		return *m.FromVertex
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return ""
+}
+
+func (m *EdgeBackpressure) GetToVertex() string {
+	if m != nil && m.ToVertex != nil {
+		return *m.ToVertex
 	}
-	return n
+	return ""
 }
 
-func (m *ListBuffersRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EdgeBackpressure) GetScore() float64 {
+	if m != nil && m.Score != nil {
+		return *m.Score
 	}
-	var l int
-	_ = l
-	if m.Pipeline != nil {
-		l = len(*m.Pipeline)
-		n += 1 + l + sovDaemon(uint64(l))
+	return 0
+}
+
+func (m *EdgeBackpressure) GetBufferUsage() float64 {
+	if m != nil && m.BufferUsage != nil {
+		return *m.BufferUsage
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return 0
+}
+
+func (m *EdgeBackpressure) GetWriteErrorRate() float64 {
+	if m != nil && m.WriteErrorRate != nil {
+		return *m.WriteErrorRate
 	}
-	return n
+	return 0
 }
 
-func (m *ListBuffersResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EdgeBackpressure) GetWriteRetryRatio() float64 {
+	if m != nil && m.WriteRetryRatio != nil {
+		return *m.WriteRetryRatio
 	}
-	var l int
-	_ = l
-	if len(m.Buffers) > 0 {
-		for _, e := range m.Buffers {
-			l = e.Size()
-			n += 1 + l + sovDaemon(uint64(l))
+	return 0
+}
+
+// VertexBottleneck is how likely a vertex is the bottleneck of a pipeline.
+type VertexBottleneck struct {
+	Vertex *string `protobuf:"bytes,1,req,name=vertex" json:"vertex,omitempty"`
+	// Bottleneck score between 0 and 1, which is the backpressure of the incoming edges not explained by the backpressure of the outgoing edges.
+	Score *float64 `protobuf:"fixed64,2,req,name=score" json:"score,omitempty"`
+	// Highest backpressure score of the incoming edges.
+	InboundBackpressure *float64 `protobuf:"fixed64,3,req,name=inboundBackpressure" json:"inboundBackpressure,omitempty"`
+	// Highest backpressure score of the outgoing edges.
+	OutboundBackpressure *float64 `protobuf:"fixed64,4,req,name=outboundBackpressure" json:"outboundBackpressure,omitempty"`
+	// Average processing time of a message by the user-defined container in milliseconds, -1 if not available.
+	ProcessingLatency    *float64 `protobuf:"fixed64,5,req,name=processingLatency" json:"processingLatency,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VertexBottleneck) Reset()         { *m = VertexBottleneck{} }
+func (m *VertexBottleneck) String() string { return proto.CompactTextString(m) }
+func (*VertexBottleneck) ProtoMessage()    {}
+func (*VertexBottleneck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93e327fd0d673221, []int{36}
+}
+func (m *VertexBottleneck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VertexBottleneck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VertexBottleneck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+}
+func (m *VertexBottleneck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VertexBottleneck.Merge(m, src)
+}
+func (m *VertexBottleneck) XXX_Size() int {
+	return m.Size()
+}
+func (m *VertexBottleneck) XXX_DiscardUnknown() {
+	xxx_messageInfo_VertexBottleneck.DiscardUnknown(m)
 }
 
-func (m *GetBufferRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pipeline != nil {
-		l = len(*m.Pipeline)
-		n += 1 + l + sovDaemon(uint64(l))
-	}
-	if m.Buffer != nil {
-		l = len(*m.Buffer)
-		n += 1 + l + sovDaemon(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+var xxx_messageInfo_VertexBottleneck proto.InternalMessageInfo
+
+func (m *VertexBottleneck) GetVertex() string {
+	if m != nil && m.Vertex != nil {
+		return *m.Vertex
 	}
-	return n
+	return ""
 }
 
-func (m *GetBufferResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Buffer != nil {
-		l = m.Buffer.Size()
-		n += 1 + l + sovDaemon(uint64(l))
+func (m *VertexBottleneck) GetScore() float64 {
+	if m != nil && m.Score != nil {
+		return *m.Score
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return 0
 }
 
-func (m *GetPipelineStatusRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *VertexBottleneck) GetInboundBackpressure() float64 {
+	if m != nil && m.InboundBackpressure != nil {
+		return *m.InboundBackpressure
 	}
-	var l int
-	_ = l
-	if m.Pipeline != nil {
-		l = len(*m.Pipeline)
-		n += 1 + l + sovDaemon(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return 0
+}
+
+func (m *VertexBottleneck) GetOutboundBackpressure() float64 {
+	if m != nil && m.OutboundBackpressure != nil {
+		return *m.OutboundBackpressure
 	}
-	return n
+	return 0
 }
 
-func (m *GetPipelineStatusResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *VertexBottleneck) GetProcessingLatency() float64 {
+	if m != nil && m.ProcessingLatency != nil {
+		return *m.ProcessingLatency
 	}
-	var l int
-	_ = l
-	if m.Status != nil {
-		l = m.Status.Size()
-		n += 1 + l + sovDaemon(uint64(l))
+	return 0
+}
+
+// GetPipelineBottlenecksRequest requests for the backpressure of the edges and the bottlenecks of a pipeline.
+type GetPipelineBottlenecksRequest struct {
+	Pipeline             *string  `protobuf:"bytes,1,req,name=pipeline" json:"pipeline,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetPipelineBottlenecksRequest) Reset()         { *m = GetPipelineBottlenecksRequest{} }
+func (m *GetPipelineBottlenecksRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPipelineBottlenecksRequest) ProtoMessage()    {}
+func (*GetPipelineBottlenecksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93e327fd0d673221, []int{37}
+}
+func (m *GetPipelineBottlenecksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetPipelineBottlenecksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetPipelineBottlenecksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+}
+func (m *GetPipelineBottlenecksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPipelineBottlenecksRequest.Merge(m, src)
+}
+func (m *GetPipelineBottlenecksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetPipelineBottlenecksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPipelineBottlenecksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPipelineBottlenecksRequest proto.InternalMessageInfo
+
+func (m *GetPipelineBottlenecksRequest) GetPipeline() string {
+	if m != nil && m.Pipeline != nil {
+		return *m.Pipeline
 	}
-	return n
+	return ""
 }
 
-func (m *GetVertexMetricsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+type GetPipelineBottlenecksResponse struct {
+	Edges []*EdgeBackpressure `protobuf:"bytes,1,rep,name=edges" json:"edges,omitempty"`
+	// The vertices ranked by the bottleneck score, the most likely bottleneck first.
+	Vertices             []*VertexBottleneck `protobuf:"bytes,2,rep,name=vertices" json:"vertices,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *GetPipelineBottlenecksResponse) Reset()         { *m = GetPipelineBottlenecksResponse{} }
+func (m *GetPipelineBottlenecksResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPipelineBottlenecksResponse) ProtoMessage()    {}
+func (*GetPipelineBottlenecksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93e327fd0d673221, []int{38}
+}
+func (m *GetPipelineBottlenecksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetPipelineBottlenecksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetPipelineBottlenecksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.Pipeline != nil {
-		l = len(*m.Pipeline)
-		n += 1 + l + sovDaemon(uint64(l))
+}
+func (m *GetPipelineBottlenecksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPipelineBottlenecksResponse.Merge(m, src)
+}
+func (m *GetPipelineBottlenecksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetPipelineBottlenecksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPipelineBottlenecksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPipelineBottlenecksResponse proto.InternalMessageInfo
+
+func (m *GetPipelineBottlenecksResponse) GetEdges() []*EdgeBackpressure {
+	if m != nil {
+		return m.Edges
 	}
-	if m.Vertex != nil {
-		l = len(*m.Vertex)
-		n += 1 + l + sovDaemon(uint64(l))
+	return nil
+}
+
+func (m *GetPipelineBottlenecksResponse) GetVertices() []*VertexBottleneck {
+	if m != nil {
+		return m.Vertices
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return nil
+}
+
+// ResetSourceOffsetsRequest requests to reset the committed offsets of a source vertex, to reprocess the historical data.
+// Exactly one of timestamp and offsets is required.
+type ResetSourceOffsetsRequest struct {
+	Pipeline *string `protobuf:"bytes,1,req,name=pipeline" json:"pipeline,omitempty"`
+	Vertex   *string `protobuf:"bytes,2,req,name=vertex" json:"vertex,omitempty"`
+	// Timestamp in milliseconds, the offsets are reset to the first messages at or after it.
+	Timestamp *int64 `protobuf:"varint,3,opt,name=timestamp" json:"timestamp,omitempty"`
+	// Offsets by the partitions, e.g. the offset numbers of Kafka, or the entry ID of Redis Streams for partition 0.
+	Offsets map[int32]string `protobuf:"bytes,4,rep,name=offsets" json:"offsets,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Reason of the operation, which is recorded in the audit log.
+	Reason               *string  `protobuf:"bytes,5,opt,name=reason" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResetSourceOffsetsRequest) Reset()         { *m = ResetSourceOffsetsRequest{} }
+func (m *ResetSourceOffsetsRequest) String() string { return proto.CompactTextString(m) }
+func (*ResetSourceOffsetsRequest) ProtoMessage()    {}
+func (*ResetSourceOffsetsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93e327fd0d673221, []int{39}
+}
+func (m *ResetSourceOffsetsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResetSourceOffsetsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResetSourceOffsetsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *ResetSourceOffsetsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResetSourceOffsetsRequest.Merge(m, src)
+}
+func (m *ResetSourceOffsetsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResetSourceOffsetsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResetSourceOffsetsRequest.DiscardUnknown(m)
 }
 
-func (m *GetVertexMetricsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_ResetSourceOffsetsRequest proto.InternalMessageInfo
+
+func (m *ResetSourceOffsetsRequest) GetPipeline() string {
+	if m != nil && m.Pipeline != nil {
+		return *m.Pipeline
 	}
-	var l int
-	_ = l
-	if len(m.VertexMetrics) > 0 {
-		for _, e := range m.VertexMetrics {
-			l = e.Size()
-			n += 1 + l + sovDaemon(uint64(l))
+	return ""
+}
+
+func (m *ResetSourceOffsetsRequest) GetVertex() string {
+	if m != nil && m.Vertex != nil {
+		return *m.Vertex
+	}
+	return ""
+}
+
+func (m *ResetSourceOffsetsRequest) GetTimestamp() int64 {
+	if m != nil && m.Timestamp != nil {
+		return *m.Timestamp
+	}
+	return 0
+}
+
+func (m *ResetSourceOffsetsRequest) GetOffsets() map[int32]string {
+	if m != nil {
+		return m.Offsets
+	}
+	return nil
+}
+
+func (m *ResetSourceOffsetsRequest) GetReason() string {
+	if m != nil && m.Reason != nil {
+		return *m.Reason
+	}
+	return ""
+}
+
+type ResetSourceOffsetsResponse struct {
+	// Offsets reset to by the partitions, empty if the source does not expose them, e.g. Pulsar.
+	Offsets              map[int32]string `protobuf:"bytes,1,rep,name=offsets" json:"offsets,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ResetSourceOffsetsResponse) Reset()         { *m = ResetSourceOffsetsResponse{} }
+func (m *ResetSourceOffsetsResponse) String() string { return proto.CompactTextString(m) }
+func (*ResetSourceOffsetsResponse) ProtoMessage()    {}
+func (*ResetSourceOffsetsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93e327fd0d673221, []int{40}
+}
+func (m *ResetSourceOffsetsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResetSourceOffsetsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResetSourceOffsetsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+}
+func (m *ResetSourceOffsetsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResetSourceOffsetsResponse.Merge(m, src)
+}
+func (m *ResetSourceOffsetsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResetSourceOffsetsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResetSourceOffsetsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResetSourceOffsetsResponse proto.InternalMessageInfo
+
+func (m *ResetSourceOffsetsResponse) GetOffsets() map[int32]string {
+	if m != nil {
+		return m.Offsets
 	}
-	return n
+	return nil
 }
 
-func (m *EdgeWatermark) Size() (n int) {
-	if m == nil {
-		return 0
+// BulkOperationResult is the result of a bulk operation on a vertex, or a side input.
+type BulkOperationResult struct {
+	// Name of the vertex, or the side input.
+	Name      *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Succeeded *bool   `protobuf:"varint,2,req,name=succeeded" json:"succeeded,omitempty"`
+	// Number of the pods the operation is applied to, 0 for a side input.
+	Pods *int32 `protobuf:"varint,3,req,name=pods" json:"pods,omitempty"`
+	// Error of the failed operation.
+	Error                *string  `protobuf:"bytes,4,opt,name=error" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BulkOperationResult) Reset()         { *m = BulkOperationResult{} }
+func (m *BulkOperationResult) String() string { return proto.CompactTextString(m) }
+func (*BulkOperationResult) ProtoMessage()    {}
+func (*BulkOperationResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93e327fd0d673221, []int{41}
+}
+func (m *BulkOperationResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BulkOperationResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BulkOperationResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.Pipeline != nil {
-		l = len(*m.Pipeline)
-		n += 1 + l + sovDaemon(uint64(l))
+}
+func (m *BulkOperationResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BulkOperationResult.Merge(m, src)
+}
+func (m *BulkOperationResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *BulkOperationResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_BulkOperationResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BulkOperationResult proto.InternalMessageInfo
+
+func (m *BulkOperationResult) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
 	}
-	if m.Edge != nil {
-		l = len(*m.Edge)
-		n += 1 + l + sovDaemon(uint64(l))
+	return ""
+}
+
+func (m *BulkOperationResult) GetSucceeded() bool {
+	if m != nil && m.Succeeded != nil {
+		return *m.Succeeded
 	}
-	if len(m.Watermarks) > 0 {
-		for _, e := range m.Watermarks {
-			n += 1 + sovDaemon(uint64(e))
+	return false
+}
+
+func (m *BulkOperationResult) GetPods() int32 {
+	if m != nil && m.Pods != nil {
+		return *m.Pods
+	}
+	return 0
+}
+
+func (m *BulkOperationResult) GetError() string {
+	if m != nil && m.Error != nil {
+		return *m.Error
+	}
+	return ""
+}
+
+// BulkOperationResponse is the results of a bulk operation, which is partially failed if some of the results are not
+// succeeded.
+type BulkOperationResponse struct {
+	Results []*BulkOperationResult `protobuf:"bytes,1,rep,name=results" json:"results,omitempty"`
+	// Number of the failed results.
+	Failed               *int32   `protobuf:"varint,2,req,name=failed" json:"failed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BulkOperationResponse) Reset()         { *m = BulkOperationResponse{} }
+func (m *BulkOperationResponse) String() string { return proto.CompactTextString(m) }
+func (*BulkOperationResponse) ProtoMessage()    {}
+func (*BulkOperationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93e327fd0d673221, []int{42}
+}
+func (m *BulkOperationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BulkOperationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BulkOperationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BulkOperationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BulkOperationResponse.Merge(m, src)
+}
+func (m *BulkOperationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BulkOperationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BulkOperationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BulkOperationResponse proto.InternalMessageInfo
+
+func (m *BulkOperationResponse) GetResults() []*BulkOperationResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *BulkOperationResponse) GetFailed() int32 {
+	if m != nil && m.Failed != nil {
+		return *m.Failed
+	}
+	return 0
+}
+
+// PauseSourcesRequest requests to pause, or resume, reading from all the source vertices of a pipeline.
+type PauseSourcesRequest struct {
+	Pipeline *string `protobuf:"bytes,1,req,name=pipeline" json:"pipeline,omitempty"`
+	// Reason of the operation, which is recorded in the audit log.
+	Reason               *string  `protobuf:"bytes,2,opt,name=reason" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PauseSourcesRequest) Reset()         { *m = PauseSourcesRequest{} }
+func (m *PauseSourcesRequest) String() string { return proto.CompactTextString(m) }
+func (*PauseSourcesRequest) ProtoMessage()    {}
+func (*PauseSourcesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93e327fd0d673221, []int{43}
+}
+func (m *PauseSourcesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PauseSourcesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PauseSourcesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PauseSourcesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseSourcesRequest.Merge(m, src)
+}
+func (m *PauseSourcesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PauseSourcesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseSourcesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PauseSourcesRequest proto.InternalMessageInfo
+
+func (m *PauseSourcesRequest) GetPipeline() string {
+	if m != nil && m.Pipeline != nil {
+		return *m.Pipeline
+	}
+	return ""
+}
+
+func (m *PauseSourcesRequest) GetReason() string {
+	if m != nil && m.Reason != nil {
+		return *m.Reason
+	}
+	return ""
+}
+
+// SetLogLevelRequest requests to change the log level of the vertices of a pipeline.
+type SetLogLevelRequest struct {
+	Pipeline *string `protobuf:"bytes,1,req,name=pipeline" json:"pipeline,omitempty"`
+	// Log level, one of "debug", "info", "warn" and "error".
+	Level *string `protobuf:"bytes,2,req,name=level" json:"level,omitempty"`
+	// Vertices to change, all the vertices if empty.
+	Vertices             []string `protobuf:"bytes,3,rep,name=vertices" json:"vertices,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetLogLevelRequest) Reset()         { *m = SetLogLevelRequest{} }
+func (m *SetLogLevelRequest) String() string { return proto.CompactTextString(m) }
+func (*SetLogLevelRequest) ProtoMessage()    {}
+func (*SetLogLevelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93e327fd0d673221, []int{44}
+}
+func (m *SetLogLevelRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetLogLevelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetLogLevelRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetLogLevelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetLogLevelRequest.Merge(m, src)
+}
+func (m *SetLogLevelRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetLogLevelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetLogLevelRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetLogLevelRequest proto.InternalMessageInfo
+
+func (m *SetLogLevelRequest) GetPipeline() string {
+	if m != nil && m.Pipeline != nil {
+		return *m.Pipeline
+	}
+	return ""
+}
+
+func (m *SetLogLevelRequest) GetLevel() string {
+	if m != nil && m.Level != nil {
+		return *m.Level
+	}
+	return ""
+}
+
+func (m *SetLogLevelRequest) GetVertices() []string {
+	if m != nil {
+		return m.Vertices
+	}
+	return nil
+}
+
+// RefreshSideInputsRequest requests to retrieve and broadcast the values of the side inputs of a pipeline immediately.
+type RefreshSideInputsRequest struct {
+	Pipeline *string `protobuf:"bytes,1,req,name=pipeline" json:"pipeline,omitempty"`
+	// Side inputs to refresh, all the side inputs if empty.
+	SideInputs           []string `protobuf:"bytes,2,rep,name=sideInputs" json:"sideInputs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RefreshSideInputsRequest) Reset()         { *m = RefreshSideInputsRequest{} }
+func (m *RefreshSideInputsRequest) String() string { return proto.CompactTextString(m) }
+func (*RefreshSideInputsRequest) ProtoMessage()    {}
+func (*RefreshSideInputsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93e327fd0d673221, []int{45}
+}
+func (m *RefreshSideInputsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RefreshSideInputsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RefreshSideInputsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RefreshSideInputsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefreshSideInputsRequest.Merge(m, src)
+}
+func (m *RefreshSideInputsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RefreshSideInputsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefreshSideInputsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RefreshSideInputsRequest proto.InternalMessageInfo
+
+func (m *RefreshSideInputsRequest) GetPipeline() string {
+	if m != nil && m.Pipeline != nil {
+		return *m.Pipeline
+	}
+	return ""
+}
+
+func (m *RefreshSideInputsRequest) GetSideInputs() []string {
+	if m != nil {
+		return m.SideInputs
+	}
+	return nil
+}
+
+// WatchPipelineStatusRequest requests to watch the status of a pipeline.
+type WatchPipelineStatusRequest struct {
+	Pipeline *string `protobuf:"bytes,1,req,name=pipeline" json:"pipeline,omitempty"`
+	// Cursor of the last response received, to resume the watch without receiving the unchanged status again.
+	Cursor               *string  `protobuf:"bytes,2,opt,name=cursor" json:"cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WatchPipelineStatusRequest) Reset()         { *m = WatchPipelineStatusRequest{} }
+func (m *WatchPipelineStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchPipelineStatusRequest) ProtoMessage()    {}
+func (*WatchPipelineStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93e327fd0d673221, []int{46}
+}
+func (m *WatchPipelineStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WatchPipelineStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WatchPipelineStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WatchPipelineStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchPipelineStatusRequest.Merge(m, src)
+}
+func (m *WatchPipelineStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *WatchPipelineStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchPipelineStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchPipelineStatusRequest proto.InternalMessageInfo
+
+func (m *WatchPipelineStatusRequest) GetPipeline() string {
+	if m != nil && m.Pipeline != nil {
+		return *m.Pipeline
+	}
+	return ""
+}
+
+func (m *WatchPipelineStatusRequest) GetCursor() string {
+	if m != nil && m.Cursor != nil {
+		return *m.Cursor
+	}
+	return ""
+}
+
+type WatchPipelineStatusResponse struct {
+	Status *PipelineStatus `protobuf:"bytes,1,req,name=status" json:"status,omitempty"`
+	// Cursor to resume the watch with.
+	Cursor               *string  `protobuf:"bytes,2,req,name=cursor" json:"cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WatchPipelineStatusResponse) Reset()         { *m = WatchPipelineStatusResponse{} }
+func (m *WatchPipelineStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*WatchPipelineStatusResponse) ProtoMessage()    {}
+func (*WatchPipelineStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93e327fd0d673221, []int{47}
+}
+func (m *WatchPipelineStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WatchPipelineStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WatchPipelineStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WatchPipelineStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchPipelineStatusResponse.Merge(m, src)
+}
+func (m *WatchPipelineStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *WatchPipelineStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchPipelineStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchPipelineStatusResponse proto.InternalMessageInfo
+
+func (m *WatchPipelineStatusResponse) GetStatus() *PipelineStatus {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *WatchPipelineStatusResponse) GetCursor() string {
+	if m != nil && m.Cursor != nil {
+		return *m.Cursor
+	}
+	return ""
+}
+
+// WatchBuffersRequest requests to watch the buffers of a pipeline.
+type WatchBuffersRequest struct {
+	Pipeline *string `protobuf:"bytes,1,req,name=pipeline" json:"pipeline,omitempty"`
+	// Cursor of the last response received, to resume the watch without receiving the unchanged buffers again.
+	Cursor               *string  `protobuf:"bytes,2,opt,name=cursor" json:"cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WatchBuffersRequest) Reset()         { *m = WatchBuffersRequest{} }
+func (m *WatchBuffersRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchBuffersRequest) ProtoMessage()    {}
+func (*WatchBuffersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93e327fd0d673221, []int{48}
+}
+func (m *WatchBuffersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WatchBuffersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WatchBuffersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WatchBuffersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchBuffersRequest.Merge(m, src)
+}
+func (m *WatchBuffersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *WatchBuffersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchBuffersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchBuffersRequest proto.InternalMessageInfo
+
+func (m *WatchBuffersRequest) GetPipeline() string {
+	if m != nil && m.Pipeline != nil {
+		return *m.Pipeline
+	}
+	return ""
+}
+
+func (m *WatchBuffersRequest) GetCursor() string {
+	if m != nil && m.Cursor != nil {
+		return *m.Cursor
+	}
+	return ""
+}
+
+type WatchBuffersResponse struct {
+	Buffers []*BufferInfo `protobuf:"bytes,1,rep,name=buffers" json:"buffers,omitempty"`
+	// Cursor to resume the watch with.
+	Cursor               *string  `protobuf:"bytes,2,req,name=cursor" json:"cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WatchBuffersResponse) Reset()         { *m = WatchBuffersResponse{} }
+func (m *WatchBuffersResponse) String() string { return proto.CompactTextString(m) }
+func (*WatchBuffersResponse) ProtoMessage()    {}
+func (*WatchBuffersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93e327fd0d673221, []int{49}
+}
+func (m *WatchBuffersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WatchBuffersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WatchBuffersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WatchBuffersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchBuffersResponse.Merge(m, src)
+}
+func (m *WatchBuffersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *WatchBuffersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchBuffersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchBuffersResponse proto.InternalMessageInfo
+
+func (m *WatchBuffersResponse) GetBuffers() []*BufferInfo {
+	if m != nil {
+		return m.Buffers
+	}
+	return nil
+}
+
+func (m *WatchBuffersResponse) GetCursor() string {
+	if m != nil && m.Cursor != nil {
+		return *m.Cursor
+	}
+	return ""
+}
+
+// WatchVertexMetricsRequest requests to watch the metrics of a vertex.
+type WatchVertexMetricsRequest struct {
+	Pipeline *string `protobuf:"bytes,1,req,name=pipeline" json:"pipeline,omitempty"`
+	Vertex   *string `protobuf:"bytes,2,req,name=vertex" json:"vertex,omitempty"`
+	// Cursor of the last response received, to resume the watch without receiving the unchanged metrics again.
+	Cursor               *string  `protobuf:"bytes,3,opt,name=cursor" json:"cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WatchVertexMetricsRequest) Reset()         { *m = WatchVertexMetricsRequest{} }
+func (m *WatchVertexMetricsRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchVertexMetricsRequest) ProtoMessage()    {}
+func (*WatchVertexMetricsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93e327fd0d673221, []int{50}
+}
+func (m *WatchVertexMetricsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WatchVertexMetricsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WatchVertexMetricsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WatchVertexMetricsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchVertexMetricsRequest.Merge(m, src)
+}
+func (m *WatchVertexMetricsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *WatchVertexMetricsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchVertexMetricsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchVertexMetricsRequest proto.InternalMessageInfo
+
+func (m *WatchVertexMetricsRequest) GetPipeline() string {
+	if m != nil && m.Pipeline != nil {
+		return *m.Pipeline
+	}
+	return ""
+}
+
+func (m *WatchVertexMetricsRequest) GetVertex() string {
+	if m != nil && m.Vertex != nil {
+		return *m.Vertex
+	}
+	return ""
+}
+
+func (m *WatchVertexMetricsRequest) GetCursor() string {
+	if m != nil && m.Cursor != nil {
+		return *m.Cursor
+	}
+	return ""
+}
+
+type WatchVertexMetricsResponse struct {
+	VertexMetrics []*VertexMetrics `protobuf:"bytes,1,rep,name=vertexMetrics" json:"vertexMetrics,omitempty"`
+	// Cursor to resume the watch with.
+	Cursor               *string  `protobuf:"bytes,2,req,name=cursor" json:"cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WatchVertexMetricsResponse) Reset()         { *m = WatchVertexMetricsResponse{} }
+func (m *WatchVertexMetricsResponse) String() string { return proto.CompactTextString(m) }
+func (*WatchVertexMetricsResponse) ProtoMessage()    {}
+func (*WatchVertexMetricsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93e327fd0d673221, []int{51}
+}
+func (m *WatchVertexMetricsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WatchVertexMetricsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WatchVertexMetricsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WatchVertexMetricsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchVertexMetricsResponse.Merge(m, src)
+}
+func (m *WatchVertexMetricsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *WatchVertexMetricsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchVertexMetricsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchVertexMetricsResponse proto.InternalMessageInfo
+
+func (m *WatchVertexMetricsResponse) GetVertexMetrics() []*VertexMetrics {
+	if m != nil {
+		return m.VertexMetrics
+	}
+	return nil
+}
+
+func (m *WatchVertexMetricsResponse) GetCursor() string {
+	if m != nil && m.Cursor != nil {
+		return *m.Cursor
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*BufferInfo)(nil), "daemon.BufferInfo")
+	proto.RegisterType((*VertexMetrics)(nil), "daemon.VertexMetrics")
+	proto.RegisterMapType((map[string]int64)(nil), "daemon.VertexMetrics.PendingsEntry")
+	proto.RegisterMapType((map[string]float64)(nil), "daemon.VertexMetrics.ProcessingRatesEntry")
+	proto.RegisterType((*PipelineStatus)(nil), "daemon.PipelineStatus")
+	proto.RegisterType((*ListBuffersRequest)(nil), "daemon.ListBuffersRequest")
+	proto.RegisterType((*ListBuffersResponse)(nil), "daemon.ListBuffersResponse")
+	proto.RegisterType((*GetBufferRequest)(nil), "daemon.GetBufferRequest")
+	proto.RegisterType((*GetBufferResponse)(nil), "daemon.GetBufferResponse")
+	proto.RegisterType((*GetPipelineStatusRequest)(nil), "daemon.GetPipelineStatusRequest")
+	proto.RegisterType((*GetPipelineStatusResponse)(nil), "daemon.GetPipelineStatusResponse")
+	proto.RegisterType((*GetVertexMetricsRequest)(nil), "daemon.GetVertexMetricsRequest")
+	proto.RegisterType((*GetVertexMetricsResponse)(nil), "daemon.GetVertexMetricsResponse")
+	proto.RegisterType((*EdgeWatermark)(nil), "daemon.EdgeWatermark")
+	proto.RegisterType((*GetPipelineWatermarksResponse)(nil), "daemon.GetPipelineWatermarksResponse")
+	proto.RegisterType((*GetPipelineWatermarksRequest)(nil), "daemon.GetPipelineWatermarksRequest")
+	proto.RegisterType((*EdgeMetrics)(nil), "daemon.EdgeMetrics")
+	proto.RegisterMapType((map[string]int64)(nil), "daemon.EdgeMetrics.LatencyPercentilesEntry")
+	proto.RegisterType((*GetPipelineEdgeMetricsRequest)(nil), "daemon.GetPipelineEdgeMetricsRequest")
+	proto.RegisterType((*GetPipelineEdgeMetricsResponse)(nil), "daemon.GetPipelineEdgeMetricsResponse")
+	proto.RegisterMapType((map[string]int64)(nil), "daemon.GetPipelineEdgeMetricsResponse.EndToEndLatencyPercentilesEntry")
+	proto.RegisterType((*MetricsHistoryPoint)(nil), "daemon.MetricsHistoryPoint")
+	proto.RegisterType((*MetricsHistory)(nil), "daemon.MetricsHistory")
+	proto.RegisterType((*GetPipelineMetricsHistoryRequest)(nil), "daemon.GetPipelineMetricsHistoryRequest")
+	proto.RegisterType((*GetPipelineMetricsHistoryResponse)(nil), "daemon.GetPipelineMetricsHistoryResponse")
+	proto.RegisterType((*DrainBufferRequest)(nil), "daemon.DrainBufferRequest")
+	proto.RegisterType((*DrainBufferResponse)(nil), "daemon.DrainBufferResponse")
+	proto.RegisterType((*SkipBufferRequest)(nil), "daemon.SkipBufferRequest")
+	proto.RegisterType((*SkipBufferResponse)(nil), "daemon.SkipBufferResponse")
+	proto.RegisterType((*VertexError)(nil), "daemon.VertexError")
+	proto.RegisterType((*GetVertexErrorsRequest)(nil), "daemon.GetVertexErrorsRequest")
+	proto.RegisterType((*GetVertexErrorsResponse)(nil), "daemon.GetVertexErrorsResponse")
+	proto.RegisterType((*VertexLog)(nil), "daemon.VertexLog")
+	proto.RegisterType((*GetVertexLogsRequest)(nil), "daemon.GetVertexLogsRequest")
+	proto.RegisterType((*GetVertexLogsResponse)(nil), "daemon.GetVertexLogsResponse")
+	proto.RegisterType((*PipelineDegradation)(nil), "daemon.PipelineDegradation")
+	proto.RegisterType((*DegradationTransition)(nil), "daemon.DegradationTransition")
+	proto.RegisterType((*GetPipelineDegradationRequest)(nil), "daemon.GetPipelineDegradationRequest")
+	proto.RegisterType((*GetPipelineDegradationResponse)(nil), "daemon.GetPipelineDegradationResponse")
+	proto.RegisterType((*EdgeBackpressure)(nil), "daemon.EdgeBackpressure")
+	proto.RegisterType((*VertexBottleneck)(nil), "daemon.VertexBottleneck")
+	proto.RegisterType((*GetPipelineBottlenecksRequest)(nil), "daemon.GetPipelineBottlenecksRequest")
+	proto.RegisterType((*GetPipelineBottlenecksResponse)(nil), "daemon.GetPipelineBottlenecksResponse")
+	proto.RegisterType((*ResetSourceOffsetsRequest)(nil), "daemon.ResetSourceOffsetsRequest")
+	proto.RegisterMapType((map[int32]string)(nil), "daemon.ResetSourceOffsetsRequest.OffsetsEntry")
+	proto.RegisterType((*ResetSourceOffsetsResponse)(nil), "daemon.ResetSourceOffsetsResponse")
+	proto.RegisterMapType((map[int32]string)(nil), "daemon.ResetSourceOffsetsResponse.OffsetsEntry")
+	proto.RegisterType((*BulkOperationResult)(nil), "daemon.BulkOperationResult")
+	proto.RegisterType((*BulkOperationResponse)(nil), "daemon.BulkOperationResponse")
+	proto.RegisterType((*PauseSourcesRequest)(nil), "daemon.PauseSourcesRequest")
+	proto.RegisterType((*SetLogLevelRequest)(nil), "daemon.SetLogLevelRequest")
+	proto.RegisterType((*RefreshSideInputsRequest)(nil), "daemon.RefreshSideInputsRequest")
+	proto.RegisterType((*WatchPipelineStatusRequest)(nil), "daemon.WatchPipelineStatusRequest")
+	proto.RegisterType((*WatchPipelineStatusResponse)(nil), "daemon.WatchPipelineStatusResponse")
+	proto.RegisterType((*WatchBuffersRequest)(nil), "daemon.WatchBuffersRequest")
+	proto.RegisterType((*WatchBuffersResponse)(nil), "daemon.WatchBuffersResponse")
+	proto.RegisterType((*WatchVertexMetricsRequest)(nil), "daemon.WatchVertexMetricsRequest")
+	proto.RegisterType((*WatchVertexMetricsResponse)(nil), "daemon.WatchVertexMetricsResponse")
+}
+
+func init() {
+	proto.RegisterFile("pkg/apis/proto/daemon/daemon.proto", fileDescriptor_93e327fd0d673221)
+}
+
+var fileDescriptor_93e327fd0d673221 = []byte{
+	// 2578 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5a, 0xdd, 0x6f, 0x1c, 0x49,
+	0x11, 0x57, 0xef, 0xfa, 0x6b, 0xcb, 0xf1, 0x9d, 0xdd, 0x76, 0x9c, 0xc9, 0x38, 0xf1, 0x39, 0x9d,
+	0xc4, 0x38, 0x4e, 0xe2, 0xf5, 0x39, 0x97, 0x10, 0x1c, 0x2e, 0x01, 0x5f, 0x9c, 0xc4, 0xc2, 0xe1,
+	0xac, 0x71, 0x2e, 0x91, 0x00, 0x29, 0x8c, 0x77, 0x7b, 0xd7, 0x83, 0x67, 0x67, 0x26, 0x33, 0xb3,
+	0x0e, 0x56, 0x14, 0x21, 0x0e, 0x71, 0x12, 0xe2, 0x01, 0x9d, 0x4e, 0xf7, 0x80, 0xee, 0xf1, 0x38,
+	0x5e, 0xf9, 0x2f, 0x10, 0x8f, 0x48, 0x20, 0x5e, 0x78, 0x41, 0x11, 0x8f, 0xfc, 0x0b, 0x48, 0xa8,
+	0x3f, 0x66, 0xa6, 0xe7, 0x6b, 0x77, 0x9d, 0x84, 0x27, 0x4f, 0x57, 0x57, 0x57, 0xfd, 0xba, 0xaa,
+	0xab, 0xba, 0xba, 0xd6, 0x40, 0xbc, 0x83, 0x76, 0xdd, 0xf4, 0xac, 0xa0, 0xee, 0xf9, 0x6e, 0xe8,
+	0xd6, 0x9b, 0x26, 0xed, 0xb8, 0x8e, 0xfc, 0xb3, 0xc2, 0x69, 0x78, 0x44, 0x8c, 0xf4, 0x33, 0x6d,
+	0xd7, 0x6d, 0xdb, 0x94, 0xb1, 0xd7, 0x4d, 0xc7, 0x71, 0x43, 0x33, 0xb4, 0x5c, 0x27, 0x10, 0x5c,
+	0xfa, 0x9c, 0x9c, 0xe5, 0xa3, 0xbd, 0x6e, 0xab, 0x4e, 0x3b, 0x5e, 0x78, 0x24, 0x26, 0xc9, 0x9f,
+	0x2b, 0x00, 0x1b, 0xdd, 0x56, 0x8b, 0xfa, 0x5b, 0x4e, 0xcb, 0xc5, 0x3a, 0x8c, 0x79, 0x96, 0x47,
+	0x6d, 0xcb, 0xa1, 0x1a, 0x5a, 0xa8, 0x2c, 0xd5, 0x8c, 0x78, 0x8c, 0xe7, 0x01, 0xf6, 0x38, 0xe7,
+	0x0f, 0xcd, 0x0e, 0xd5, 0x2a, 0x7c, 0x56, 0xa1, 0x60, 0x02, 0x27, 0x3c, 0xea, 0x34, 0x2d, 0xa7,
+	0xfd, 0x91, 0xdb, 0x75, 0x42, 0xad, 0xba, 0x50, 0x59, 0xaa, 0x1a, 0x29, 0x1a, 0x5e, 0x82, 0x77,
+	0xcd, 0xc6, 0xc1, 0x8e, 0xca, 0x36, 0xc4, 0xd9, 0xb2, 0x64, 0x7c, 0x01, 0x26, 0x42, 0x37, 0x34,
+	0xed, 0x87, 0x34, 0x08, 0xcc, 0x36, 0x0d, 0xb4, 0x61, 0xce, 0x97, 0x26, 0x32, 0x9d, 0x02, 0xc1,
+	0x36, 0x75, 0xda, 0xe1, 0xbe, 0x36, 0x22, 0x74, 0xaa, 0x34, 0xbc, 0x0c, 0x93, 0x62, 0xfc, 0x09,
+	0x5b, 0xb3, 0x6d, 0x75, 0xac, 0x50, 0x1b, 0x5d, 0xa8, 0x2c, 0x21, 0x23, 0x47, 0xc7, 0x0b, 0x30,
+	0xae, 0xd0, 0xb4, 0x31, 0xce, 0xa6, 0x92, 0xf0, 0x2c, 0x8c, 0x58, 0xc1, 0xbd, 0xae, 0x6d, 0x6b,
+	0xb5, 0x85, 0xca, 0xd2, 0x98, 0x21, 0x47, 0xe4, 0x9f, 0x15, 0x98, 0x78, 0x4c, 0xfd, 0x90, 0xfe,
+	0xfc, 0x21, 0x0d, 0x7d, 0xab, 0x11, 0xf4, 0xb4, 0xe5, 0x2c, 0x8c, 0x1c, 0x72, 0x66, 0x69, 0x47,
+	0x39, 0xc2, 0x8f, 0xe0, 0x5d, 0xcf, 0x77, 0x1b, 0x34, 0x08, 0x2c, 0xa7, 0x6d, 0x98, 0x21, 0x0d,
+	0xb4, 0xea, 0x42, 0x75, 0x69, 0x7c, 0x6d, 0x79, 0x45, 0x7a, 0x3e, 0xa5, 0x63, 0x65, 0x27, 0xcd,
+	0xbc, 0xe9, 0x84, 0xfe, 0x91, 0x91, 0x15, 0x81, 0xef, 0xc0, 0x98, 0xf4, 0x42, 0xa0, 0x0d, 0x71,
+	0x71, 0xe7, 0x4b, 0xc4, 0x49, 0x2e, 0x21, 0x27, 0x5e, 0xa4, 0x6f, 0xc0, 0x4c, 0x91, 0x26, 0x3c,
+	0x09, 0xd5, 0x03, 0x7a, 0xa4, 0xa1, 0x05, 0xb4, 0x54, 0x33, 0xd8, 0x27, 0x9e, 0x81, 0xe1, 0x43,
+	0xd3, 0xee, 0xb2, 0xf3, 0x81, 0x96, 0x90, 0x21, 0x06, 0xeb, 0x95, 0x9b, 0x48, 0xbf, 0x05, 0x13,
+	0x29, 0xf1, 0xfd, 0x16, 0x57, 0x95, 0xc5, 0x64, 0x03, 0xde, 0xd9, 0x91, 0xb6, 0xdb, 0x0d, 0xcd,
+	0xb0, 0x1b, 0x30, 0x0b, 0x06, 0xfc, 0x4b, 0xda, 0x56, 0x8e, 0xb0, 0x06, 0xa3, 0x1d, 0x71, 0x3a,
+	0xa4, 0x69, 0xa3, 0x21, 0x59, 0x05, 0xbc, 0x6d, 0x05, 0xa1, 0x38, 0xed, 0x81, 0x41, 0x9f, 0x75,
+	0x69, 0x10, 0xf6, 0xf2, 0x12, 0xf9, 0x08, 0xa6, 0x53, 0x2b, 0x02, 0xcf, 0x75, 0x02, 0x8a, 0xaf,
+	0xc0, 0xa8, 0x38, 0x11, 0x4c, 0x37, 0xb3, 0x26, 0x8e, 0xac, 0x99, 0x44, 0x92, 0x11, 0xb1, 0x90,
+	0x7b, 0x30, 0x79, 0x9f, 0x4a, 0x19, 0x03, 0x28, 0x65, 0x1b, 0x13, 0x4b, 0xa3, 0xa3, 0x21, 0x46,
+	0xe4, 0x0e, 0x4c, 0x29, 0x72, 0x24, 0x94, 0xe5, 0x98, 0x99, 0x89, 0x29, 0x46, 0x12, 0x09, 0xb8,
+	0x01, 0xda, 0x7d, 0x1a, 0xa6, 0xcd, 0x38, 0x88, 0x15, 0x7e, 0x00, 0xa7, 0x0b, 0xd6, 0x49, 0x00,
+	0x2b, 0x29, 0x37, 0x8c, 0xaf, 0xcd, 0x46, 0x00, 0x32, 0xfc, 0x92, 0x8b, 0x3c, 0x84, 0x53, 0xf7,
+	0x69, 0x98, 0x3a, 0x75, 0x45, 0x18, 0x2a, 0xa5, 0xf1, 0x52, 0x55, 0xe3, 0x85, 0x3c, 0x01, 0x2d,
+	0x2f, 0x4e, 0x42, 0xbb, 0x05, 0x13, 0x87, 0xea, 0x84, 0x74, 0xd6, 0xc9, 0xc2, 0xa3, 0x6f, 0xa4,
+	0x79, 0xc9, 0xef, 0x10, 0x4c, 0x6c, 0x36, 0xdb, 0xf4, 0x89, 0x19, 0x52, 0xbf, 0x63, 0xfa, 0x07,
+	0x3d, 0x7d, 0x86, 0x61, 0x88, 0x36, 0xe3, 0x13, 0xc7, 0xbf, 0x59, 0xba, 0x7c, 0x1e, 0x2d, 0x16,
+	0x51, 0x5c, 0x35, 0x14, 0x0a, 0x5e, 0x01, 0x6c, 0x05, 0xb1, 0xf8, 0x4d, 0xc7, 0xdc, 0xb3, 0x69,
+	0x93, 0x67, 0xc3, 0x31, 0xa3, 0x60, 0x86, 0xb4, 0xe0, 0xac, 0xe2, 0x86, 0x78, 0x3a, 0xd9, 0xef,
+	0x26, 0x60, 0x2f, 0x37, 0x9b, 0xdd, 0x74, 0x6a, 0x4f, 0x46, 0xc1, 0x02, 0xb2, 0x0e, 0x67, 0x4a,
+	0xf4, 0xf4, 0x3f, 0x2a, 0xdf, 0x54, 0x61, 0x9c, 0x69, 0x18, 0x24, 0x05, 0x96, 0xd8, 0xac, 0xe5,
+	0xbb, 0x9d, 0xc7, 0xaa, 0xab, 0x15, 0x0a, 0x93, 0x17, 0xba, 0x72, 0x76, 0x48, 0xc8, 0x8b, 0xc6,
+	0xec, 0x2a, 0x88, 0xad, 0xbb, 0x6d, 0xb6, 0xe5, 0x7d, 0x91, 0xa2, 0xb1, 0xe4, 0x20, 0x73, 0x9a,
+	0xbc, 0x29, 0xa2, 0x61, 0x36, 0xf1, 0x8f, 0xe6, 0x13, 0xff, 0x22, 0xbc, 0x23, 0x86, 0xf7, 0x2c,
+	0xdb, 0x66, 0x39, 0x50, 0xde, 0x0e, 0x19, 0x2a, 0xfe, 0x31, 0x60, 0xdb, 0x0c, 0xa9, 0xd3, 0x38,
+	0xda, 0xa1, 0x7e, 0x83, 0x3a, 0xa1, 0x65, 0xd3, 0x40, 0xab, 0x71, 0x37, 0x5c, 0x56, 0xdd, 0x10,
+	0x25, 0xdd, 0xed, 0x1c, 0xb7, 0x48, 0xbf, 0x05, 0x62, 0xf4, 0x4d, 0x38, 0x55, 0xc2, 0x7e, 0xac,
+	0x74, 0x7a, 0x2b, 0x75, 0x96, 0x14, 0x30, 0x83, 0x38, 0xf9, 0x4f, 0x15, 0x98, 0x2f, 0x5b, 0x2d,
+	0x8f, 0xe2, 0x75, 0x18, 0xa7, 0x09, 0x59, 0x9e, 0xc1, 0xe9, 0x82, 0xcd, 0x1b, 0x2a, 0x1f, 0xfe,
+	0x0c, 0x81, 0x4e, 0x9d, 0xe6, 0x23, 0x77, 0xd3, 0x69, 0xe6, 0xb7, 0xa9, 0x55, 0xb8, 0x98, 0x7b,
+	0x91, 0x98, 0xde, 0x18, 0x56, 0x36, 0x4b, 0x05, 0x09, 0xf3, 0xf6, 0xd0, 0xa4, 0x3f, 0x84, 0xf7,
+	0xfa, 0x2c, 0x3f, 0x96, 0xb9, 0xb7, 0x60, 0x5a, 0xa2, 0x7b, 0x60, 0x05, 0xa1, 0xeb, 0x1f, 0xed,
+	0xb8, 0x96, 0x13, 0xe2, 0x33, 0x50, 0x0b, 0xad, 0x0e, 0x0d, 0x42, 0xb3, 0xe3, 0x71, 0x2b, 0x57,
+	0x8d, 0x84, 0xa0, 0x8a, 0xab, 0xc4, 0x37, 0x29, 0xf9, 0x06, 0xc1, 0x3b, 0x69, 0x59, 0xfd, 0x2e,
+	0x93, 0x0e, 0xe7, 0x8e, 0x2e, 0x13, 0x31, 0x4a, 0xe5, 0x53, 0xa4, 0xd4, 0x1f, 0x51, 0x50, 0x0e,
+	0x71, 0x2a, 0xff, 0xc6, 0xd7, 0x60, 0xc4, 0x63, 0x78, 0x59, 0x09, 0xc6, 0x1c, 0x30, 0x17, 0x39,
+	0xa0, 0x60, 0x4f, 0x86, 0x64, 0x25, 0x8f, 0x60, 0x41, 0xf1, 0x4f, 0x9a, 0x73, 0x90, 0x5b, 0x70,
+	0x06, 0x86, 0x03, 0xcb, 0x69, 0xc4, 0xc6, 0xe4, 0x03, 0xf2, 0x09, 0x9c, 0xeb, 0x21, 0x55, 0x1e,
+	0xbe, 0x55, 0x18, 0xdd, 0x17, 0x24, 0x79, 0xf0, 0x66, 0x8b, 0x01, 0x1b, 0x11, 0x1b, 0xf9, 0x29,
+	0xe0, 0xbb, 0xbe, 0x69, 0x39, 0x6f, 0x7c, 0x49, 0x33, 0xba, 0x4f, 0xcd, 0xc0, 0x75, 0x22, 0xbb,
+	0x8a, 0x11, 0xf9, 0x0e, 0x4c, 0xa7, 0x34, 0x48, 0xa8, 0x04, 0x4e, 0x34, 0x19, 0x99, 0x36, 0x45,
+	0x2d, 0x2c, 0x0e, 0x41, 0x8a, 0x46, 0x9e, 0xc2, 0xd4, 0xee, 0x81, 0xe5, 0xfd, 0xff, 0xb0, 0xdd,
+	0x04, 0xac, 0x2a, 0x48, 0xa0, 0x05, 0x07, 0x96, 0xe7, 0x65, 0xa0, 0xa9, 0x34, 0xf2, 0x5f, 0x04,
+	0xe3, 0x22, 0xfb, 0x6e, 0xfa, 0xbe, 0xeb, 0xbf, 0x56, 0xc5, 0x3b, 0x09, 0x55, 0xcf, 0x6d, 0xca,
+	0x5c, 0xcf, 0x3e, 0x59, 0x58, 0x34, 0x5c, 0x27, 0x64, 0x26, 0xf0, 0x65, 0x96, 0x4f, 0x08, 0xe9,
+	0xa0, 0x19, 0xce, 0x06, 0x0d, 0x86, 0xa1, 0x86, 0xdb, 0xa4, 0x3c, 0xbb, 0xd7, 0x0c, 0xfe, 0xcd,
+	0x56, 0xc8, 0x12, 0x70, 0xeb, 0xae, 0x36, 0xca, 0xb7, 0x9e, 0x10, 0xd4, 0x7a, 0x71, 0x2c, 0x55,
+	0x2f, 0xb2, 0x2b, 0xc1, 0x33, 0x8f, 0x6c, 0xd7, 0x6c, 0x3e, 0x30, 0x83, 0x7d, 0xad, 0xc6, 0x57,
+	0xaa, 0x24, 0xb2, 0x0d, 0xb3, 0x71, 0xf5, 0xc1, 0x2d, 0x10, 0x0c, 0xe8, 0x9f, 0x22, 0x4b, 0x90,
+	0x7b, 0x70, 0x2a, 0x27, 0x4d, 0x3a, 0xe3, 0x32, 0x8c, 0x50, 0x4e, 0xc9, 0xa6, 0x52, 0x85, 0xdb,
+	0x90, 0x2c, 0xe4, 0x2b, 0x04, 0x35, 0x41, 0xdf, 0x76, 0xdb, 0x6f, 0xc9, 0x27, 0xac, 0xda, 0x76,
+	0xbb, 0x7e, 0x83, 0x4a, 0x87, 0xc8, 0x51, 0x7f, 0x6f, 0x70, 0xbd, 0xd2, 0x1b, 0xec, 0x9b, 0xec,
+	0xc3, 0x4c, 0xbc, 0xcb, 0x6d, 0xb7, 0xfd, 0x26, 0x16, 0x4b, 0x7b, 0x56, 0xa0, 0x4d, 0x08, 0xe4,
+	0x36, 0x9c, 0xcc, 0x68, 0x92, 0xd6, 0xbc, 0x08, 0x43, 0xb6, 0xdb, 0x8e, 0x6c, 0x39, 0x95, 0xb6,
+	0xe5, 0xb6, 0xdb, 0x36, 0xf8, 0x34, 0xf9, 0x07, 0x82, 0xe9, 0x28, 0xd5, 0xdc, 0xa5, 0x6d, 0xdf,
+	0x6c, 0xf2, 0x67, 0x75, 0x4f, 0xa4, 0x3a, 0x8c, 0x35, 0x39, 0x2b, 0x6d, 0x72, 0xac, 0x63, 0x46,
+	0x3c, 0x4e, 0xc5, 0x5f, 0x25, 0x89, 0x3f, 0x56, 0x08, 0xda, 0x66, 0x10, 0x3e, 0xf2, 0x4d, 0x27,
+	0xb0, 0x98, 0x86, 0x47, 0x56, 0x87, 0xca, 0x67, 0x71, 0xc1, 0x0c, 0xbe, 0x03, 0xe3, 0x61, 0x4c,
+	0x89, 0x92, 0xf2, 0xd9, 0x68, 0x17, 0x0a, 0xd2, 0x64, 0x9d, 0xa1, 0xae, 0x20, 0x4f, 0xe1, 0x64,
+	0x21, 0x57, 0x0a, 0x3d, 0x2a, 0x45, 0x5f, 0x49, 0xa1, 0xc7, 0x30, 0xc4, 0x1c, 0x2e, 0x5f, 0xfb,
+	0xfc, 0x3b, 0x53, 0x5e, 0x28, 0xba, 0x06, 0x29, 0x2f, 0x9e, 0xc2, 0x7c, 0xd9, 0x62, 0xe9, 0xbf,
+	0x0f, 0x61, 0xbc, 0x99, 0x90, 0xe5, 0xc3, 0x63, 0x2e, 0xfb, 0xf0, 0x50, 0x57, 0xaa, 0xfc, 0xe4,
+	0x3f, 0x08, 0x26, 0x59, 0xc1, 0xb0, 0x61, 0x36, 0x0e, 0x3c, 0x9f, 0x06, 0x41, 0xd7, 0x4f, 0xaa,
+	0x51, 0x54, 0x5a, 0x8d, 0x56, 0x7a, 0x56, 0xa3, 0xd5, 0x4c, 0x35, 0xca, 0xee, 0xaf, 0x86, 0xeb,
+	0x0b, 0x3f, 0x22, 0x43, 0x0c, 0xb2, 0x55, 0xe6, 0x70, 0x61, 0x95, 0xf9, 0xdc, 0xb7, 0x42, 0x2a,
+	0x42, 0xda, 0x0c, 0x45, 0xf0, 0x20, 0x23, 0x43, 0x65, 0x8d, 0x14, 0x4e, 0x31, 0x28, 0xab, 0x65,
+	0xd8, 0xc6, 0x64, 0xcd, 0x9a, 0x25, 0x93, 0xbf, 0x23, 0x98, 0x14, 0xa0, 0x36, 0xdc, 0x30, 0xb4,
+	0xa9, 0x43, 0x1b, 0x07, 0x4a, 0x44, 0xa1, 0x54, 0x44, 0xc5, 0xb0, 0x2b, 0x2a, 0xec, 0x55, 0x98,
+	0xb6, 0x9c, 0x3d, 0xb7, 0xeb, 0x34, 0x55, 0x9b, 0xf1, 0x3d, 0x23, 0xa3, 0x68, 0x0a, 0xaf, 0xc1,
+	0x8c, 0xdb, 0x0d, 0xf3, 0x4b, 0x84, 0x35, 0x0a, 0xe7, 0xf0, 0x15, 0x98, 0x4a, 0x1a, 0x17, 0xb2,
+	0xec, 0x92, 0x26, 0xca, 0x4f, 0x64, 0xce, 0x58, 0xb2, 0xb5, 0x81, 0x4a, 0xd8, 0xcf, 0x10, 0xcc,
+	0x97, 0xad, 0x8e, 0x1f, 0xb6, 0xc3, 0xec, 0x10, 0x44, 0x59, 0x42, 0x53, 0x8b, 0x57, 0x15, 0xb6,
+	0x21, 0xd8, 0xf0, 0x07, 0x30, 0xc6, 0x6c, 0x68, 0x35, 0xe2, 0x42, 0x55, 0x4b, 0x27, 0x96, 0x44,
+	0x89, 0x11, 0x73, 0x92, 0xdf, 0x56, 0xe0, 0xb4, 0x41, 0x03, 0x1a, 0xee, 0xf2, 0x7c, 0xfa, 0x71,
+	0xab, 0x15, 0xd0, 0xf0, 0x4d, 0x73, 0x62, 0x92, 0x91, 0xab, 0xbc, 0x78, 0x4a, 0x08, 0xf8, 0x01,
+	0x8c, 0xba, 0x42, 0x87, 0x6c, 0x04, 0xad, 0x44, 0x20, 0x4b, 0x51, 0xac, 0xc8, 0xa1, 0xa8, 0x9a,
+	0xa3, 0xe5, 0x4a, 0x3e, 0x18, 0x56, 0xab, 0x09, 0x7d, 0x1d, 0x4e, 0xa8, 0x0b, 0xd4, 0x3a, 0x79,
+	0xb8, 0xa0, 0x4e, 0xae, 0xa9, 0x75, 0xf2, 0xd7, 0x08, 0xf4, 0x22, 0x1c, 0xd2, 0x25, 0x5b, 0x09,
+	0x78, 0xe1, 0x94, 0x7a, 0x2f, 0xf0, 0x62, 0x51, 0x31, 0xfa, 0x37, 0x42, 0xf9, 0x0c, 0xa6, 0x37,
+	0xba, 0xf6, 0xc1, 0xc7, 0x1e, 0xf5, 0xa3, 0xbc, 0xd4, 0xb5, 0x43, 0x96, 0x41, 0x1c, 0xb3, 0x13,
+	0x39, 0x8a, 0x7f, 0x33, 0x67, 0x04, 0xdd, 0x46, 0x83, 0xd2, 0xe4, 0x3e, 0x48, 0x08, 0x6c, 0x85,
+	0xe7, 0x36, 0x03, 0x1e, 0x47, 0xc3, 0x06, 0xff, 0x66, 0x6a, 0xf9, 0x35, 0x2e, 0x2b, 0x70, 0x31,
+	0x20, 0x2d, 0x38, 0x99, 0x55, 0x19, 0x3d, 0xb4, 0x46, 0x7d, 0xae, 0x3e, 0x32, 0xc9, 0x5c, 0xd2,
+	0x00, 0xca, 0x41, 0x34, 0x22, 0x5e, 0xe6, 0xbc, 0x96, 0x69, 0xd9, 0x12, 0xd4, 0xb0, 0x21, 0x47,
+	0xec, 0xa1, 0xb2, 0x63, 0x76, 0x03, 0x2a, 0x4c, 0x39, 0xe8, 0x39, 0x8c, 0xef, 0x05, 0xb5, 0xaa,
+	0xdc, 0x03, 0xbc, 0x4b, 0xc3, 0x6d, 0xb7, 0xbd, 0x4d, 0x0f, 0xa9, 0x3d, 0x60, 0xc9, 0x6f, 0x33,
+	0x5e, 0x79, 0xa0, 0xc5, 0x80, 0xad, 0x88, 0xe3, 0x8a, 0x35, 0x51, 0x6a, 0x4a, 0xf4, 0x3c, 0x06,
+	0xcd, 0xa0, 0x2d, 0x9f, 0x06, 0xfb, 0xbb, 0x56, 0x93, 0x6e, 0x39, 0x5e, 0x77, 0xb0, 0xd8, 0x99,
+	0x07, 0x08, 0xe2, 0x05, 0x3c, 0x5a, 0x6b, 0x86, 0x42, 0x21, 0x3b, 0xa0, 0x3f, 0x31, 0xc3, 0xc6,
+	0xfe, 0xb1, 0x7b, 0x65, 0xcc, 0x1a, 0x8d, 0xae, 0x1f, 0xb8, 0x7e, 0x64, 0x0d, 0x31, 0x22, 0x14,
+	0xe6, 0x0a, 0x25, 0xbe, 0x5e, 0x17, 0x2d, 0xa5, 0xa6, 0xa2, 0xa8, 0xd9, 0x82, 0x69, 0xae, 0x66,
+	0xf0, 0x1e, 0x67, 0x29, 0xe2, 0x9f, 0xc0, 0x4c, 0x5a, 0xd4, 0xeb, 0x34, 0x3f, 0x4b, 0x81, 0xb6,
+	0xe1, 0x34, 0x97, 0xde, 0xb7, 0x11, 0x38, 0x68, 0xda, 0x4b, 0x14, 0x55, 0x53, 0xdb, 0x78, 0x06,
+	0x7a, 0x91, 0xa2, 0xb7, 0xd0, 0x22, 0x2c, 0xdb, 0xdb, 0xda, 0xab, 0x53, 0x30, 0x71, 0x97, 0xaf,
+	0xdf, 0xa5, 0xfe, 0xa1, 0xd5, 0xa0, 0x38, 0x84, 0x71, 0xa5, 0x8f, 0x8c, 0xf5, 0x48, 0x7c, 0xbe,
+	0x1d, 0xad, 0xcf, 0x15, 0xce, 0x09, 0xb8, 0xe4, 0xca, 0xa7, 0x7f, 0xfb, 0xf7, 0x17, 0x95, 0x45,
+	0x7c, 0x81, 0xff, 0xd2, 0x73, 0xf8, 0x7e, 0x3d, 0x32, 0x4b, 0x50, 0x7f, 0x11, 0x7d, 0xbe, 0xac,
+	0x47, 0xb6, 0x7f, 0x0e, 0xb5, 0xb8, 0x61, 0x8c, 0x35, 0xa5, 0x6b, 0x92, 0x7a, 0x4a, 0xea, 0xa7,
+	0x0b, 0x66, 0xa4, 0xbe, 0xeb, 0x5c, 0x5f, 0x1d, 0x5f, 0x1d, 0x44, 0x5f, 0xfd, 0x85, 0xf8, 0x78,
+	0x89, 0xbf, 0x44, 0xbc, 0xe5, 0x9d, 0xfe, 0x35, 0xe4, 0x3d, 0x45, 0x4d, 0x91, 0xd7, 0xf5, 0x85,
+	0x72, 0x06, 0x09, 0xe7, 0x36, 0x87, 0x73, 0x13, 0xdf, 0xe8, 0x09, 0x27, 0xca, 0x0e, 0xf5, 0x17,
+	0xc2, 0x5d, 0x2f, 0xeb, 0x1d, 0x09, 0xe1, 0x4b, 0xc4, 0x5f, 0x04, 0xf9, 0xd6, 0x26, 0xbe, 0x50,
+	0xd0, 0x53, 0xca, 0x75, 0x3e, 0xf5, 0x8b, 0x7d, 0xb8, 0x24, 0xcc, 0x3a, 0x87, 0x79, 0x09, 0x7f,
+	0xab, 0x27, 0x4c, 0xa5, 0x13, 0xfc, 0x6b, 0x04, 0x53, 0x8a, 0x48, 0xf9, 0x03, 0xc7, 0x42, 0x81,
+	0xb6, 0x54, 0x22, 0xd2, 0xcf, 0xf5, 0xe0, 0x90, 0x58, 0x2e, 0x73, 0x2c, 0x17, 0xf1, 0xf9, 0x9e,
+	0x58, 0x64, 0x56, 0xf9, 0x0a, 0xc1, 0xac, 0x22, 0x4a, 0x6d, 0xe4, 0x5e, 0xec, 0xd7, 0x74, 0x13,
+	0x88, 0x16, 0x07, 0xeb, 0xcd, 0x91, 0x35, 0x0e, 0xeb, 0x0a, 0x5e, 0xee, 0x09, 0x8b, 0x17, 0x56,
+	0xb1, 0xf7, 0xfe, 0x88, 0x52, 0xbf, 0x43, 0x64, 0x9a, 0x60, 0x4b, 0x05, 0x9a, 0x0b, 0xbb, 0x4e,
+	0xfa, 0xa5, 0x01, 0x38, 0x25, 0xcc, 0x0f, 0x38, 0xcc, 0x15, 0x7c, 0xa5, 0x27, 0x4c, 0x09, 0xb0,
+	0x2e, 0xbb, 0x49, 0xac, 0x8b, 0x39, 0xae, 0x34, 0x7b, 0x92, 0x70, 0xcf, 0xf7, 0x98, 0xf4, 0xb9,
+	0xc2, 0xb9, 0xf4, 0x79, 0x27, 0xd7, 0x8e, 0x15, 0x7e, 0x75, 0xde, 0x3d, 0x5a, 0x47, 0xcb, 0xf8,
+	0x53, 0x04, 0x90, 0x74, 0x76, 0x70, 0x1c, 0xe8, 0xb9, 0x76, 0x92, 0xae, 0x17, 0x4d, 0x49, 0x14,
+	0x1f, 0x72, 0x14, 0xdf, 0x5e, 0x47, 0xcb, 0x64, 0xed, 0x78, 0x40, 0x58, 0xaf, 0x08, 0x7f, 0x8e,
+	0xe0, 0xdd, 0x4c, 0x5b, 0x03, 0xcf, 0xe7, 0x42, 0x3d, 0xd5, 0x3d, 0xd1, 0xdf, 0x2b, 0x9d, 0x4f,
+	0x63, 0xc2, 0xd7, 0x8f, 0x99, 0x09, 0x44, 0x87, 0x04, 0xff, 0x06, 0xc1, 0x44, 0xaa, 0x35, 0x80,
+	0xcf, 0xe4, 0x34, 0x2a, 0xbd, 0x09, 0xfd, 0x6c, 0xc9, 0xac, 0x44, 0x73, 0x8b, 0xa3, 0xb9, 0x8e,
+	0xaf, 0x1d, 0x13, 0x0d, 0xeb, 0x32, 0xe0, 0xdf, 0xa7, 0x83, 0x4e, 0x6d, 0x34, 0x14, 0x05, 0x5d,
+	0xfe, 0x31, 0xad, 0x2f, 0xf6, 0x63, 0x93, 0x30, 0x57, 0x39, 0xcc, 0x65, 0xbc, 0xd4, 0x13, 0xa6,
+	0xf2, 0x52, 0xce, 0x62, 0x53, 0x9e, 0x49, 0x85, 0xd8, 0xf2, 0x8f, 0x30, 0x7d, 0xb1, 0x1f, 0xdb,
+	0xb1, 0xb0, 0xed, 0x29, 0x00, 0xfe, 0x80, 0x00, 0xe7, 0xcb, 0x7e, 0x7c, 0xae, 0xef, 0x7b, 0x46,
+	0x27, 0xfd, 0x5f, 0x0d, 0xe4, 0x3e, 0xc7, 0xf3, 0x7d, 0xf2, 0xdd, 0x63, 0xba, 0xd4, 0x67, 0x22,
+	0xaf, 0xca, 0x57, 0x06, 0x8b, 0xc1, 0x5f, 0x22, 0x38, 0xa1, 0x96, 0xd4, 0x38, 0xe9, 0x53, 0xe4,
+	0x0b, 0x6d, 0xfd, 0x6c, 0x59, 0xf5, 0x9e, 0xba, 0x8f, 0x59, 0x28, 0xf6, 0xce, 0x9c, 0xa2, 0x3b,
+	0x17, 0xd4, 0x3d, 0xa6, 0x03, 0xff, 0x0a, 0xc1, 0x84, 0x41, 0x83, 0x6e, 0xe7, 0xad, 0x80, 0xb8,
+	0xc1, 0x41, 0xac, 0x92, 0xcb, 0x03, 0x21, 0xf0, 0xb9, 0x5e, 0x66, 0x89, 0x17, 0x30, 0xae, 0x3c,
+	0x08, 0x92, 0xac, 0x98, 0x7f, 0x25, 0xf4, 0x43, 0xf0, 0x3e, 0x47, 0x70, 0x79, 0x1d, 0x2d, 0xeb,
+	0x8b, 0x3d, 0x41, 0xd8, 0x6e, 0xfb, 0xaa, 0x78, 0x45, 0x7c, 0x8e, 0x60, 0x2a, 0xf7, 0x54, 0x48,
+	0xae, 0xd8, 0xb2, 0x57, 0x44, 0x3f, 0x24, 0x32, 0xf2, 0xc9, 0x6a, 0x6f, 0x5b, 0x58, 0x4d, 0x7a,
+	0xd5, 0xe2, 0x72, 0xeb, 0xbe, 0xd0, 0xc4, 0x0c, 0xf2, 0x05, 0x92, 0xd5, 0x7a, 0xe6, 0xe2, 0x8f,
+	0xcf, 0x67, 0xf9, 0x1b, 0x44, 0x3f, 0xdf, 0x93, 0x27, 0x6d, 0x27, 0x7c, 0xa9, 0x5f, 0x21, 0xd2,
+	0xd8, 0x97, 0x25, 0xc0, 0x2a, 0xc2, 0xbf, 0x80, 0x13, 0x6a, 0xdd, 0x9f, 0x1c, 0x95, 0x82, 0x87,
+	0x85, 0x7e, 0xa6, 0x78, 0xf2, 0x58, 0xb7, 0xbc, 0xd0, 0x2f, 0x2f, 0x8f, 0x55, 0x84, 0xbf, 0x46,
+	0x80, 0xf3, 0x25, 0x7b, 0x12, 0xd8, 0xa5, 0xef, 0x06, 0x9d, 0xf4, 0x62, 0x91, 0x98, 0xee, 0x72,
+	0x4c, 0xb7, 0xf1, 0x71, 0x03, 0x5b, 0xa0, 0x94, 0x57, 0xfd, 0x2a, 0xda, 0xf8, 0xde, 0x5f, 0x5e,
+	0xcd, 0xa3, 0xbf, 0xbe, 0x9a, 0x47, 0xff, 0x7a, 0x35, 0x8f, 0x7e, 0xb4, 0xd6, 0xb6, 0xc2, 0xfd,
+	0xee, 0xde, 0x4a, 0xc3, 0xed, 0xd4, 0x9d, 0x6e, 0xc7, 0xf4, 0x7c, 0xf7, 0x67, 0xfc, 0xa3, 0x65,
+	0xbb, 0xcf, 0xeb, 0x85, 0xff, 0xc9, 0xf5, 0xbf, 0x01, 0x00, 0xe8, 0x15, 0xf5, 0x6c, 0xe1, 0x25,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// DaemonServiceClient is the client API for DaemonService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DaemonServiceClient interface {
+	ListBuffers(ctx context.Context, in *ListBuffersRequest, opts ...grpc.CallOption) (*ListBuffersResponse, error)
+	GetBuffer(ctx context.Context, in *GetBufferRequest, opts ...grpc.CallOption) (*GetBufferResponse, error)
+	GetVertexMetrics(ctx context.Context, in *GetVertexMetricsRequest, opts ...grpc.CallOption) (*GetVertexMetricsResponse, error)
+	// GetPipelineWatermarks return the watermark of the given pipeline
+	GetPipelineWatermarks(ctx context.Context, in *GetPipelineWatermarksRequest, opts ...grpc.CallOption) (*GetPipelineWatermarksResponse, error)
+	GetPipelineStatus(ctx context.Context, in *GetPipelineStatusRequest, opts ...grpc.CallOption) (*GetPipelineStatusResponse, error)
+	// GetPipelineEdgeMetrics returns the watermark lag, buffer fill rate and processing latency of each edge of the given pipeline
+	GetPipelineEdgeMetrics(ctx context.Context, in *GetPipelineEdgeMetricsRequest, opts ...grpc.CallOption) (*GetPipelineEdgeMetricsResponse, error)
+	// GetPipelineMetricsHistory returns the processing rates, pending messages and watermark lags of the given pipeline in the last 24 hours
+	GetPipelineMetricsHistory(ctx context.Context, in *GetPipelineMetricsHistoryRequest, opts ...grpc.CallOption) (*GetPipelineMetricsHistoryResponse, error)
+	// DrainBuffer acks the pending messages of a buffer without processing them
+	DrainBuffer(ctx context.Context, in *DrainBufferRequest, opts ...grpc.CallOption) (*DrainBufferResponse, error)
+	// SkipBuffer moves the reader of a buffer ahead to the latest offset, the skipped messages are not processed
+	SkipBuffer(ctx context.Context, in *SkipBufferRequest, opts ...grpc.CallOption) (*SkipBufferResponse, error)
+	// GetVertexErrors returns the last errors returned by the user-defined containers of a vertex
+	GetVertexErrors(ctx context.Context, in *GetVertexErrorsRequest, opts ...grpc.CallOption) (*GetVertexErrorsResponse, error)
+	// GetVertexLogs searches the recent logs of the user-defined containers of a vertex tagged with the ID of a message
+	GetVertexLogs(ctx context.Context, in *GetVertexLogsRequest, opts ...grpc.CallOption) (*GetVertexLogsResponse, error)
+	// GetPipelineDegradation returns if the best-effort edges of the pipeline are degraded
+	GetPipelineDegradation(ctx context.Context, in *GetPipelineDegradationRequest, opts ...grpc.CallOption) (*GetPipelineDegradationResponse, error)
+	// GetPipelineBottlenecks returns the backpressure score of each edge and the vertices ranked by how likely they are the bottleneck of the given pipeline
+	GetPipelineBottlenecks(ctx context.Context, in *GetPipelineBottlenecksRequest, opts ...grpc.CallOption) (*GetPipelineBottlenecksResponse, error)
+	// ResetSourceOffsets resets the committed offsets of a source vertex and the watermarks of the downstream edges
+	ResetSourceOffsets(ctx context.Context, in *ResetSourceOffsetsRequest, opts ...grpc.CallOption) (*ResetSourceOffsetsResponse, error)
+	// PauseSources stops reading from all the source vertices of a pipeline, the pods keep running
+	PauseSources(ctx context.Context, in *PauseSourcesRequest, opts ...grpc.CallOption) (*BulkOperationResponse, error)
+	// ResumeSources resumes reading from all the source vertices of a pipeline
+	ResumeSources(ctx context.Context, in *PauseSourcesRequest, opts ...grpc.CallOption) (*BulkOperationResponse, error)
+	// SetLogLevel changes the log level of the vertices of a pipeline
+	SetLogLevel(ctx context.Context, in *SetLogLevelRequest, opts ...grpc.CallOption) (*BulkOperationResponse, error)
+	// RefreshSideInputs retrieves and broadcasts the values of the side inputs of a pipeline immediately
+	RefreshSideInputs(ctx context.Context, in *RefreshSideInputsRequest, opts ...grpc.CallOption) (*BulkOperationResponse, error)
+	// WatchPipelineStatus streams the status of a pipeline whenever it changes
+	WatchPipelineStatus(ctx context.Context, in *WatchPipelineStatusRequest, opts ...grpc.CallOption) (DaemonService_WatchPipelineStatusClient, error)
+	// WatchBuffers streams the information of the buffers of a pipeline whenever it changes
+	WatchBuffers(ctx context.Context, in *WatchBuffersRequest, opts ...grpc.CallOption) (DaemonService_WatchBuffersClient, error)
+	// WatchVertexMetrics streams the processing rates and pending messages of a vertex whenever they change
+	WatchVertexMetrics(ctx context.Context, in *WatchVertexMetricsRequest, opts ...grpc.CallOption) (DaemonService_WatchVertexMetricsClient, error)
+}
+
+type daemonServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewDaemonServiceClient(cc *grpc.ClientConn) DaemonServiceClient {
+	return &daemonServiceClient{cc}
+}
+
+func (c *daemonServiceClient) ListBuffers(ctx context.Context, in *ListBuffersRequest, opts ...grpc.CallOption) (*ListBuffersResponse, error) {
+	out := new(ListBuffersResponse)
+	err := c.cc.Invoke(ctx, "/daemon.DaemonService/ListBuffers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonServiceClient) GetBuffer(ctx context.Context, in *GetBufferRequest, opts ...grpc.CallOption) (*GetBufferResponse, error) {
+	out := new(GetBufferResponse)
+	err := c.cc.Invoke(ctx, "/daemon.DaemonService/GetBuffer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonServiceClient) GetVertexMetrics(ctx context.Context, in *GetVertexMetricsRequest, opts ...grpc.CallOption) (*GetVertexMetricsResponse, error) {
+	out := new(GetVertexMetricsResponse)
+	err := c.cc.Invoke(ctx, "/daemon.DaemonService/GetVertexMetrics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonServiceClient) GetPipelineWatermarks(ctx context.Context, in *GetPipelineWatermarksRequest, opts ...grpc.CallOption) (*GetPipelineWatermarksResponse, error) {
+	out := new(GetPipelineWatermarksResponse)
+	err := c.cc.Invoke(ctx, "/daemon.DaemonService/GetPipelineWatermarks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonServiceClient) GetPipelineStatus(ctx context.Context, in *GetPipelineStatusRequest, opts ...grpc.CallOption) (*GetPipelineStatusResponse, error) {
+	out := new(GetPipelineStatusResponse)
+	err := c.cc.Invoke(ctx, "/daemon.DaemonService/GetPipelineStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonServiceClient) GetPipelineEdgeMetrics(ctx context.Context, in *GetPipelineEdgeMetricsRequest, opts ...grpc.CallOption) (*GetPipelineEdgeMetricsResponse, error) {
+	out := new(GetPipelineEdgeMetricsResponse)
+	err := c.cc.Invoke(ctx, "/daemon.DaemonService/GetPipelineEdgeMetrics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonServiceClient) GetPipelineMetricsHistory(ctx context.Context, in *GetPipelineMetricsHistoryRequest, opts ...grpc.CallOption) (*GetPipelineMetricsHistoryResponse, error) {
+	out := new(GetPipelineMetricsHistoryResponse)
+	err := c.cc.Invoke(ctx, "/daemon.DaemonService/GetPipelineMetricsHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonServiceClient) DrainBuffer(ctx context.Context, in *DrainBufferRequest, opts ...grpc.CallOption) (*DrainBufferResponse, error) {
+	out := new(DrainBufferResponse)
+	err := c.cc.Invoke(ctx, "/daemon.DaemonService/DrainBuffer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonServiceClient) SkipBuffer(ctx context.Context, in *SkipBufferRequest, opts ...grpc.CallOption) (*SkipBufferResponse, error) {
+	out := new(SkipBufferResponse)
+	err := c.cc.Invoke(ctx, "/daemon.DaemonService/SkipBuffer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonServiceClient) GetVertexErrors(ctx context.Context, in *GetVertexErrorsRequest, opts ...grpc.CallOption) (*GetVertexErrorsResponse, error) {
+	out := new(GetVertexErrorsResponse)
+	err := c.cc.Invoke(ctx, "/daemon.DaemonService/GetVertexErrors", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonServiceClient) GetVertexLogs(ctx context.Context, in *GetVertexLogsRequest, opts ...grpc.CallOption) (*GetVertexLogsResponse, error) {
+	out := new(GetVertexLogsResponse)
+	err := c.cc.Invoke(ctx, "/daemon.DaemonService/GetVertexLogs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonServiceClient) GetPipelineDegradation(ctx context.Context, in *GetPipelineDegradationRequest, opts ...grpc.CallOption) (*GetPipelineDegradationResponse, error) {
+	out := new(GetPipelineDegradationResponse)
+	err := c.cc.Invoke(ctx, "/daemon.DaemonService/GetPipelineDegradation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonServiceClient) GetPipelineBottlenecks(ctx context.Context, in *GetPipelineBottlenecksRequest, opts ...grpc.CallOption) (*GetPipelineBottlenecksResponse, error) {
+	out := new(GetPipelineBottlenecksResponse)
+	err := c.cc.Invoke(ctx, "/daemon.DaemonService/GetPipelineBottlenecks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonServiceClient) ResetSourceOffsets(ctx context.Context, in *ResetSourceOffsetsRequest, opts ...grpc.CallOption) (*ResetSourceOffsetsResponse, error) {
+	out := new(ResetSourceOffsetsResponse)
+	err := c.cc.Invoke(ctx, "/daemon.DaemonService/ResetSourceOffsets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonServiceClient) PauseSources(ctx context.Context, in *PauseSourcesRequest, opts ...grpc.CallOption) (*BulkOperationResponse, error) {
+	out := new(BulkOperationResponse)
+	err := c.cc.Invoke(ctx, "/daemon.DaemonService/PauseSources", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonServiceClient) ResumeSources(ctx context.Context, in *PauseSourcesRequest, opts ...grpc.CallOption) (*BulkOperationResponse, error) {
+	out := new(BulkOperationResponse)
+	err := c.cc.Invoke(ctx, "/daemon.DaemonService/ResumeSources", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonServiceClient) SetLogLevel(ctx context.Context, in *SetLogLevelRequest, opts ...grpc.CallOption) (*BulkOperationResponse, error) {
+	out := new(BulkOperationResponse)
+	err := c.cc.Invoke(ctx, "/daemon.DaemonService/SetLogLevel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonServiceClient) RefreshSideInputs(ctx context.Context, in *RefreshSideInputsRequest, opts ...grpc.CallOption) (*BulkOperationResponse, error) {
+	out := new(BulkOperationResponse)
+	err := c.cc.Invoke(ctx, "/daemon.DaemonService/RefreshSideInputs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonServiceClient) WatchPipelineStatus(ctx context.Context, in *WatchPipelineStatusRequest, opts ...grpc.CallOption) (DaemonService_WatchPipelineStatusClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_DaemonService_serviceDesc.Streams[0], "/daemon.DaemonService/WatchPipelineStatus", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &daemonServiceWatchPipelineStatusClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DaemonService_WatchPipelineStatusClient interface {
+	Recv() (*WatchPipelineStatusResponse, error)
+	grpc.ClientStream
+}
+
+type daemonServiceWatchPipelineStatusClient struct {
+	grpc.ClientStream
+}
+
+func (x *daemonServiceWatchPipelineStatusClient) Recv() (*WatchPipelineStatusResponse, error) {
+	m := new(WatchPipelineStatusResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *daemonServiceClient) WatchBuffers(ctx context.Context, in *WatchBuffersRequest, opts ...grpc.CallOption) (DaemonService_WatchBuffersClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_DaemonService_serviceDesc.Streams[1], "/daemon.DaemonService/WatchBuffers", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &daemonServiceWatchBuffersClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DaemonService_WatchBuffersClient interface {
+	Recv() (*WatchBuffersResponse, error)
+	grpc.ClientStream
+}
+
+type daemonServiceWatchBuffersClient struct {
+	grpc.ClientStream
+}
+
+func (x *daemonServiceWatchBuffersClient) Recv() (*WatchBuffersResponse, error) {
+	m := new(WatchBuffersResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *daemonServiceClient) WatchVertexMetrics(ctx context.Context, in *WatchVertexMetricsRequest, opts ...grpc.CallOption) (DaemonService_WatchVertexMetricsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_DaemonService_serviceDesc.Streams[2], "/daemon.DaemonService/WatchVertexMetrics", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &daemonServiceWatchVertexMetricsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DaemonService_WatchVertexMetricsClient interface {
+	Recv() (*WatchVertexMetricsResponse, error)
+	grpc.ClientStream
+}
+
+type daemonServiceWatchVertexMetricsClient struct {
+	grpc.ClientStream
+}
+
+func (x *daemonServiceWatchVertexMetricsClient) Recv() (*WatchVertexMetricsResponse, error) {
+	m := new(WatchVertexMetricsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// DaemonServiceServer is the server API for DaemonService service.
+type DaemonServiceServer interface {
+	ListBuffers(context.Context, *ListBuffersRequest) (*ListBuffersResponse, error)
+	GetBuffer(context.Context, *GetBufferRequest) (*GetBufferResponse, error)
+	GetVertexMetrics(context.Context, *GetVertexMetricsRequest) (*GetVertexMetricsResponse, error)
+	// GetPipelineWatermarks return the watermark of the given pipeline
+	GetPipelineWatermarks(context.Context, *GetPipelineWatermarksRequest) (*GetPipelineWatermarksResponse, error)
+	GetPipelineStatus(context.Context, *GetPipelineStatusRequest) (*GetPipelineStatusResponse, error)
+	// GetPipelineEdgeMetrics returns the watermark lag, buffer fill rate and processing latency of each edge of the given pipeline
+	GetPipelineEdgeMetrics(context.Context, *GetPipelineEdgeMetricsRequest) (*GetPipelineEdgeMetricsResponse, error)
+	// GetPipelineMetricsHistory returns the processing rates, pending messages and watermark lags of the given pipeline in the last 24 hours
+	GetPipelineMetricsHistory(context.Context, *GetPipelineMetricsHistoryRequest) (*GetPipelineMetricsHistoryResponse, error)
+	// DrainBuffer acks the pending messages of a buffer without processing them
+	DrainBuffer(context.Context, *DrainBufferRequest) (*DrainBufferResponse, error)
+	// SkipBuffer moves the reader of a buffer ahead to the latest offset, the skipped messages are not processed
+	SkipBuffer(context.Context, *SkipBufferRequest) (*SkipBufferResponse, error)
+	// GetVertexErrors returns the last errors returned by the user-defined containers of a vertex
+	GetVertexErrors(context.Context, *GetVertexErrorsRequest) (*GetVertexErrorsResponse, error)
+	// GetVertexLogs searches the recent logs of the user-defined containers of a vertex tagged with the ID of a message
+	GetVertexLogs(context.Context, *GetVertexLogsRequest) (*GetVertexLogsResponse, error)
+	// GetPipelineDegradation returns if the best-effort edges of the pipeline are degraded
+	GetPipelineDegradation(context.Context, *GetPipelineDegradationRequest) (*GetPipelineDegradationResponse, error)
+	// GetPipelineBottlenecks returns the backpressure score of each edge and the vertices ranked by how likely they are the bottleneck of the given pipeline
+	GetPipelineBottlenecks(context.Context, *GetPipelineBottlenecksRequest) (*GetPipelineBottlenecksResponse, error)
+	// ResetSourceOffsets resets the committed offsets of a source vertex and the watermarks of the downstream edges
+	ResetSourceOffsets(context.Context, *ResetSourceOffsetsRequest) (*ResetSourceOffsetsResponse, error)
+	// PauseSources stops reading from all the source vertices of a pipeline, the pods keep running
+	PauseSources(context.Context, *PauseSourcesRequest) (*BulkOperationResponse, error)
+	// ResumeSources resumes reading from all the source vertices of a pipeline
+	ResumeSources(context.Context, *PauseSourcesRequest) (*BulkOperationResponse, error)
+	// SetLogLevel changes the log level of the vertices of a pipeline
+	SetLogLevel(context.Context, *SetLogLevelRequest) (*BulkOperationResponse, error)
+	// RefreshSideInputs retrieves and broadcasts the values of the side inputs of a pipeline immediately
+	RefreshSideInputs(context.Context, *RefreshSideInputsRequest) (*BulkOperationResponse, error)
+	// WatchPipelineStatus streams the status of a pipeline whenever it changes
+	WatchPipelineStatus(*WatchPipelineStatusRequest, DaemonService_WatchPipelineStatusServer) error
+	// WatchBuffers streams the information of the buffers of a pipeline whenever it changes
+	WatchBuffers(*WatchBuffersRequest, DaemonService_WatchBuffersServer) error
+	// WatchVertexMetrics streams the processing rates and pending messages of a vertex whenever they change
+	WatchVertexMetrics(*WatchVertexMetricsRequest, DaemonService_WatchVertexMetricsServer) error
+}
+
+// UnimplementedDaemonServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedDaemonServiceServer struct {
+}
+
+func (*UnimplementedDaemonServiceServer) ListBuffers(ctx context.Context, req *ListBuffersRequest) (*ListBuffersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBuffers not implemented")
+}
+func (*UnimplementedDaemonServiceServer) GetBuffer(ctx context.Context, req *GetBufferRequest) (*GetBufferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBuffer not implemented")
+}
+func (*UnimplementedDaemonServiceServer) GetVertexMetrics(ctx context.Context, req *GetVertexMetricsRequest) (*GetVertexMetricsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVertexMetrics not implemented")
+}
+func (*UnimplementedDaemonServiceServer) GetPipelineWatermarks(ctx context.Context, req *GetPipelineWatermarksRequest) (*GetPipelineWatermarksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPipelineWatermarks not implemented")
+}
+func (*UnimplementedDaemonServiceServer) GetPipelineStatus(ctx context.Context, req *GetPipelineStatusRequest) (*GetPipelineStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPipelineStatus not implemented")
+}
+func (*UnimplementedDaemonServiceServer) GetPipelineEdgeMetrics(ctx context.Context, req *GetPipelineEdgeMetricsRequest) (*GetPipelineEdgeMetricsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPipelineEdgeMetrics not implemented")
+}
+func (*UnimplementedDaemonServiceServer) GetPipelineMetricsHistory(ctx context.Context, req *GetPipelineMetricsHistoryRequest) (*GetPipelineMetricsHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPipelineMetricsHistory not implemented")
+}
+func (*UnimplementedDaemonServiceServer) DrainBuffer(ctx context.Context, req *DrainBufferRequest) (*DrainBufferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DrainBuffer not implemented")
+}
+func (*UnimplementedDaemonServiceServer) SkipBuffer(ctx context.Context, req *SkipBufferRequest) (*SkipBufferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SkipBuffer not implemented")
+}
+func (*UnimplementedDaemonServiceServer) GetVertexErrors(ctx context.Context, req *GetVertexErrorsRequest) (*GetVertexErrorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVertexErrors not implemented")
+}
+func (*UnimplementedDaemonServiceServer) GetVertexLogs(ctx context.Context, req *GetVertexLogsRequest) (*GetVertexLogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVertexLogs not implemented")
+}
+func (*UnimplementedDaemonServiceServer) GetPipelineDegradation(ctx context.Context, req *GetPipelineDegradationRequest) (*GetPipelineDegradationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPipelineDegradation not implemented")
+}
+func (*UnimplementedDaemonServiceServer) GetPipelineBottlenecks(ctx context.Context, req *GetPipelineBottlenecksRequest) (*GetPipelineBottlenecksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPipelineBottlenecks not implemented")
+}
+func (*UnimplementedDaemonServiceServer) ResetSourceOffsets(ctx context.Context, req *ResetSourceOffsetsRequest) (*ResetSourceOffsetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetSourceOffsets not implemented")
+}
+func (*UnimplementedDaemonServiceServer) PauseSources(ctx context.Context, req *PauseSourcesRequest) (*BulkOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseSources not implemented")
+}
+func (*UnimplementedDaemonServiceServer) ResumeSources(ctx context.Context, req *PauseSourcesRequest) (*BulkOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeSources not implemented")
+}
+func (*UnimplementedDaemonServiceServer) SetLogLevel(ctx context.Context, req *SetLogLevelRequest) (*BulkOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLogLevel not implemented")
+}
+func (*UnimplementedDaemonServiceServer) RefreshSideInputs(ctx context.Context, req *RefreshSideInputsRequest) (*BulkOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshSideInputs not implemented")
+}
+func (*UnimplementedDaemonServiceServer) WatchPipelineStatus(req *WatchPipelineStatusRequest, srv DaemonService_WatchPipelineStatusServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchPipelineStatus not implemented")
+}
+func (*UnimplementedDaemonServiceServer) WatchBuffers(req *WatchBuffersRequest, srv DaemonService_WatchBuffersServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchBuffers not implemented")
+}
+func (*UnimplementedDaemonServiceServer) WatchVertexMetrics(req *WatchVertexMetricsRequest, srv DaemonService_WatchVertexMetricsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchVertexMetrics not implemented")
+}
+
+func RegisterDaemonServiceServer(s *grpc.Server, srv DaemonServiceServer) {
+	s.RegisterService(&_DaemonService_serviceDesc, srv)
+}
+
+func _DaemonService_ListBuffers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBuffersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).ListBuffers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/daemon.DaemonService/ListBuffers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).ListBuffers(ctx, req.(*ListBuffersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaemonService_GetBuffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBufferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).GetBuffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/daemon.DaemonService/GetBuffer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).GetBuffer(ctx, req.(*GetBufferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaemonService_GetVertexMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVertexMetricsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).GetVertexMetrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/daemon.DaemonService/GetVertexMetrics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).GetVertexMetrics(ctx, req.(*GetVertexMetricsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaemonService_GetPipelineWatermarks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPipelineWatermarksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).GetPipelineWatermarks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/daemon.DaemonService/GetPipelineWatermarks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).GetPipelineWatermarks(ctx, req.(*GetPipelineWatermarksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaemonService_GetPipelineStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPipelineStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).GetPipelineStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/daemon.DaemonService/GetPipelineStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).GetPipelineStatus(ctx, req.(*GetPipelineStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaemonService_GetPipelineEdgeMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPipelineEdgeMetricsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).GetPipelineEdgeMetrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/daemon.DaemonService/GetPipelineEdgeMetrics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).GetPipelineEdgeMetrics(ctx, req.(*GetPipelineEdgeMetricsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaemonService_GetPipelineMetricsHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPipelineMetricsHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).GetPipelineMetricsHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/daemon.DaemonService/GetPipelineMetricsHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).GetPipelineMetricsHistory(ctx, req.(*GetPipelineMetricsHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaemonService_DrainBuffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrainBufferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).DrainBuffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/daemon.DaemonService/DrainBuffer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).DrainBuffer(ctx, req.(*DrainBufferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaemonService_SkipBuffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SkipBufferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).SkipBuffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/daemon.DaemonService/SkipBuffer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).SkipBuffer(ctx, req.(*SkipBufferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaemonService_GetVertexErrors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVertexErrorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).GetVertexErrors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/daemon.DaemonService/GetVertexErrors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).GetVertexErrors(ctx, req.(*GetVertexErrorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaemonService_GetVertexLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVertexLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).GetVertexLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/daemon.DaemonService/GetVertexLogs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).GetVertexLogs(ctx, req.(*GetVertexLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaemonService_GetPipelineDegradation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPipelineDegradationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).GetPipelineDegradation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/daemon.DaemonService/GetPipelineDegradation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).GetPipelineDegradation(ctx, req.(*GetPipelineDegradationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaemonService_GetPipelineBottlenecks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPipelineBottlenecksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).GetPipelineBottlenecks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/daemon.DaemonService/GetPipelineBottlenecks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).GetPipelineBottlenecks(ctx, req.(*GetPipelineBottlenecksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaemonService_ResetSourceOffsets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetSourceOffsetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).ResetSourceOffsets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/daemon.DaemonService/ResetSourceOffsets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).ResetSourceOffsets(ctx, req.(*ResetSourceOffsetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaemonService_PauseSources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseSourcesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).PauseSources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/daemon.DaemonService/PauseSources",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).PauseSources(ctx, req.(*PauseSourcesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaemonService_ResumeSources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseSourcesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).ResumeSources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/daemon.DaemonService/ResumeSources",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).ResumeSources(ctx, req.(*PauseSourcesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaemonService_SetLogLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLogLevelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).SetLogLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/daemon.DaemonService/SetLogLevel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).SetLogLevel(ctx, req.(*SetLogLevelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaemonService_RefreshSideInputs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshSideInputsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).RefreshSideInputs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/daemon.DaemonService/RefreshSideInputs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).RefreshSideInputs(ctx, req.(*RefreshSideInputsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaemonService_WatchPipelineStatus_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchPipelineStatusRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DaemonServiceServer).WatchPipelineStatus(m, &daemonServiceWatchPipelineStatusServer{stream})
+}
+
+type DaemonService_WatchPipelineStatusServer interface {
+	Send(*WatchPipelineStatusResponse) error
+	grpc.ServerStream
+}
+
+type daemonServiceWatchPipelineStatusServer struct {
+	grpc.ServerStream
+}
+
+func (x *daemonServiceWatchPipelineStatusServer) Send(m *WatchPipelineStatusResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _DaemonService_WatchBuffers_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchBuffersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DaemonServiceServer).WatchBuffers(m, &daemonServiceWatchBuffersServer{stream})
+}
+
+type DaemonService_WatchBuffersServer interface {
+	Send(*WatchBuffersResponse) error
+	grpc.ServerStream
+}
+
+type daemonServiceWatchBuffersServer struct {
+	grpc.ServerStream
+}
+
+func (x *daemonServiceWatchBuffersServer) Send(m *WatchBuffersResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _DaemonService_WatchVertexMetrics_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchVertexMetricsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DaemonServiceServer).WatchVertexMetrics(m, &daemonServiceWatchVertexMetricsServer{stream})
+}
+
+type DaemonService_WatchVertexMetricsServer interface {
+	Send(*WatchVertexMetricsResponse) error
+	grpc.ServerStream
+}
+
+type daemonServiceWatchVertexMetricsServer struct {
+	grpc.ServerStream
+}
+
+func (x *daemonServiceWatchVertexMetricsServer) Send(m *WatchVertexMetricsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _DaemonService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "daemon.DaemonService",
+	HandlerType: (*DaemonServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListBuffers",
+			Handler:    _DaemonService_ListBuffers_Handler,
+		},
+		{
+			MethodName: "GetBuffer",
+			Handler:    _DaemonService_GetBuffer_Handler,
+		},
+		{
+			MethodName: "GetVertexMetrics",
+			Handler:    _DaemonService_GetVertexMetrics_Handler,
+		},
+		{
+			MethodName: "GetPipelineWatermarks",
+			Handler:    _DaemonService_GetPipelineWatermarks_Handler,
+		},
+		{
+			MethodName: "GetPipelineStatus",
+			Handler:    _DaemonService_GetPipelineStatus_Handler,
+		},
+		{
+			MethodName: "GetPipelineEdgeMetrics",
+			Handler:    _DaemonService_GetPipelineEdgeMetrics_Handler,
+		},
+		{
+			MethodName: "GetPipelineMetricsHistory",
+			Handler:    _DaemonService_GetPipelineMetricsHistory_Handler,
+		},
+		{
+			MethodName: "DrainBuffer",
+			Handler:    _DaemonService_DrainBuffer_Handler,
+		},
+		{
+			MethodName: "SkipBuffer",
+			Handler:    _DaemonService_SkipBuffer_Handler,
+		},
+		{
+			MethodName: "GetVertexErrors",
+			Handler:    _DaemonService_GetVertexErrors_Handler,
+		},
+		{
+			MethodName: "GetVertexLogs",
+			Handler:    _DaemonService_GetVertexLogs_Handler,
+		},
+		{
+			MethodName: "GetPipelineDegradation",
+			Handler:    _DaemonService_GetPipelineDegradation_Handler,
+		},
+		{
+			MethodName: "GetPipelineBottlenecks",
+			Handler:    _DaemonService_GetPipelineBottlenecks_Handler,
+		},
+		{
+			MethodName: "ResetSourceOffsets",
+			Handler:    _DaemonService_ResetSourceOffsets_Handler,
+		},
+		{
+			MethodName: "PauseSources",
+			Handler:    _DaemonService_PauseSources_Handler,
+		},
+		{
+			MethodName: "ResumeSources",
+			Handler:    _DaemonService_ResumeSources_Handler,
+		},
+		{
+			MethodName: "SetLogLevel",
+			Handler:    _DaemonService_SetLogLevel_Handler,
+		},
+		{
+			MethodName: "RefreshSideInputs",
+			Handler:    _DaemonService_RefreshSideInputs_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchPipelineStatus",
+			Handler:       _DaemonService_WatchPipelineStatus_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchBuffers",
+			Handler:       _DaemonService_WatchBuffers_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchVertexMetrics",
+			Handler:       _DaemonService_WatchVertexMetrics_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "pkg/apis/proto/daemon/daemon.proto",
+}
+
+func (m *BufferInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BufferInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BufferInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.IsFull == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("isFull")
+	} else {
+		i--
+		if *m.IsFull {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.BufferUsage == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("bufferUsage")
+	} else {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*m.BufferUsage))))
+		i--
+		dAtA[i] = 0x41
+	}
+	if m.BufferUsageLimit == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("bufferUsageLimit")
+	} else {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*m.BufferUsageLimit))))
+		i--
+		dAtA[i] = 0x39
+	}
+	if m.BufferLength == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("bufferLength")
+	} else {
+		i = encodeVarintDaemon(dAtA, i, uint64(*m.BufferLength))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.TotalMessages == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("totalMessages")
+	} else {
+		i = encodeVarintDaemon(dAtA, i, uint64(*m.TotalMessages))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AckPendingCount == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("ackPendingCount")
+	} else {
+		i = encodeVarintDaemon(dAtA, i, uint64(*m.AckPendingCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PendingCount == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("pendingCount")
+	} else {
+		i = encodeVarintDaemon(dAtA, i, uint64(*m.PendingCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BufferName == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("bufferName")
+	} else {
+		i -= len(*m.BufferName)
+		copy(dAtA[i:], *m.BufferName)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.BufferName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pipeline == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("pipeline")
+	} else {
+		i -= len(*m.Pipeline)
+		copy(dAtA[i:], *m.Pipeline)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Pipeline)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VertexMetrics) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VertexMetrics) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VertexMetrics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Pendings) > 0 {
+		for k := range m.Pendings {
+			v := m.Pendings[k]
+			baseI := i
+			i = encodeVarintDaemon(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintDaemon(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintDaemon(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ProcessingRates) > 0 {
+		for k := range m.ProcessingRates {
+			v := m.ProcessingRates[k]
+			baseI := i
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(v))))
+			i--
+			dAtA[i] = 0x11
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintDaemon(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintDaemon(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Vertex == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("vertex")
+	} else {
+		i -= len(*m.Vertex)
+		copy(dAtA[i:], *m.Vertex)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Vertex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pipeline == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("pipeline")
+	} else {
+		i -= len(*m.Pipeline)
+		copy(dAtA[i:], *m.Pipeline)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Pipeline)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PipelineStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PipelineStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PipelineStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Message == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("message")
+	} else {
+		i -= len(*m.Message)
+		copy(dAtA[i:], *m.Message)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Message)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("status")
+	} else {
+		i -= len(*m.Status)
+		copy(dAtA[i:], *m.Status)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Status)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListBuffersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListBuffersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListBuffersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Pipeline == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("pipeline")
+	} else {
+		i -= len(*m.Pipeline)
+		copy(dAtA[i:], *m.Pipeline)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Pipeline)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListBuffersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListBuffersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListBuffersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Buffers) > 0 {
+		for iNdEx := len(m.Buffers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Buffers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDaemon(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetBufferRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBufferRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetBufferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Buffer == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("buffer")
+	} else {
+		i -= len(*m.Buffer)
+		copy(dAtA[i:], *m.Buffer)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Buffer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pipeline == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("pipeline")
+	} else {
+		i -= len(*m.Pipeline)
+		copy(dAtA[i:], *m.Pipeline)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Pipeline)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetBufferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBufferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetBufferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Buffer == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("buffer")
+	} else {
+		{
+			size, err := m.Buffer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDaemon(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetPipelineStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetPipelineStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetPipelineStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Pipeline == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("pipeline")
+	} else {
+		i -= len(*m.Pipeline)
+		copy(dAtA[i:], *m.Pipeline)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Pipeline)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetPipelineStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetPipelineStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetPipelineStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Status == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("status")
+	} else {
+		{
+			size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDaemon(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetVertexMetricsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetVertexMetricsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetVertexMetricsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Vertex == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("vertex")
+	} else {
+		i -= len(*m.Vertex)
+		copy(dAtA[i:], *m.Vertex)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Vertex)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Pipeline == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("pipeline")
+	} else {
+		i -= len(*m.Pipeline)
+		copy(dAtA[i:], *m.Pipeline)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Pipeline)))
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetVertexMetricsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetVertexMetricsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetVertexMetricsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.VertexMetrics) > 0 {
+		for iNdEx := len(m.VertexMetrics) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VertexMetrics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDaemon(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EdgeWatermark) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EdgeWatermark) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EdgeWatermark) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.IsWatermarkEnabled == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("isWatermarkEnabled")
+	} else {
+		i--
+		if *m.IsWatermarkEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Watermarks) > 0 {
+		for iNdEx := len(m.Watermarks) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarintDaemon(dAtA, i, uint64(m.Watermarks[iNdEx]))
+			i--
+			dAtA[i] = 0x18
+		}
+	}
+	if m.Edge == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("edge")
+	} else {
+		i -= len(*m.Edge)
+		copy(dAtA[i:], *m.Edge)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Edge)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pipeline == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("pipeline")
+	} else {
+		i -= len(*m.Pipeline)
+		copy(dAtA[i:], *m.Pipeline)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Pipeline)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetPipelineWatermarksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetPipelineWatermarksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetPipelineWatermarksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PipelineWatermarks) > 0 {
+		for iNdEx := len(m.PipelineWatermarks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PipelineWatermarks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDaemon(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetPipelineWatermarksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetPipelineWatermarksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetPipelineWatermarksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Pipeline == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("pipeline")
+	} else {
+		i -= len(*m.Pipeline)
+		copy(dAtA[i:], *m.Pipeline)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Pipeline)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EdgeMetrics) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EdgeMetrics) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EdgeMetrics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.LatencyPercentiles) > 0 {
+		for k := range m.LatencyPercentiles {
+			v := m.LatencyPercentiles[k]
+			baseI := i
+			i = encodeVarintDaemon(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintDaemon(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintDaemon(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.BufferFillRate == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("bufferFillRate")
+	} else {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*m.BufferFillRate))))
+		i--
+		dAtA[i] = 0x41
+	}
+	if m.BufferUsage == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("bufferUsage")
+	} else {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*m.BufferUsage))))
+		i--
+		dAtA[i] = 0x39
+	}
+	if m.Pending == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("pending")
+	} else {
+		i = encodeVarintDaemon(dAtA, i, uint64(*m.Pending))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.WatermarkLag == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("watermarkLag")
+	} else {
+		i = encodeVarintDaemon(dAtA, i, uint64(*m.WatermarkLag))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ToVertex == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("toVertex")
+	} else {
+		i -= len(*m.ToVertex)
+		copy(dAtA[i:], *m.ToVertex)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.ToVertex)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.FromVertex == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("fromVertex")
+	} else {
+		i -= len(*m.FromVertex)
+		copy(dAtA[i:], *m.FromVertex)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.FromVertex)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Edge == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("edge")
+	} else {
+		i -= len(*m.Edge)
+		copy(dAtA[i:], *m.Edge)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Edge)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pipeline == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("pipeline")
+	} else {
+		i -= len(*m.Pipeline)
+		copy(dAtA[i:], *m.Pipeline)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Pipeline)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetPipelineEdgeMetricsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetPipelineEdgeMetricsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetPipelineEdgeMetricsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Pipeline == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("pipeline")
+	} else {
+		i -= len(*m.Pipeline)
+		copy(dAtA[i:], *m.Pipeline)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Pipeline)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetPipelineEdgeMetricsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetPipelineEdgeMetricsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetPipelineEdgeMetricsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.EndToEndLatencyPercentiles) > 0 {
+		for k := range m.EndToEndLatencyPercentiles {
+			v := m.EndToEndLatencyPercentiles[k]
+			baseI := i
+			i = encodeVarintDaemon(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintDaemon(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintDaemon(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.EdgeMetrics) > 0 {
+		for iNdEx := len(m.EdgeMetrics) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EdgeMetrics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDaemon(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MetricsHistoryPoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MetricsHistoryPoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MetricsHistoryPoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Value == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("value")
+	} else {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*m.Value))))
+		i--
+		dAtA[i] = 0x11
+	}
+	if m.Timestamp == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("timestamp")
+	} else {
+		i = encodeVarintDaemon(dAtA, i, uint64(*m.Timestamp))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MetricsHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MetricsHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MetricsHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Points) > 0 {
+		for iNdEx := len(m.Points) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Points[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDaemon(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Edge != nil {
+		i -= len(*m.Edge)
+		copy(dAtA[i:], *m.Edge)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Edge)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Vertex != nil {
+		i -= len(*m.Vertex)
+		copy(dAtA[i:], *m.Vertex)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Vertex)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Metric == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("metric")
+	} else {
+		i -= len(*m.Metric)
+		copy(dAtA[i:], *m.Metric)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Metric)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pipeline == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("pipeline")
+	} else {
+		i -= len(*m.Pipeline)
+		copy(dAtA[i:], *m.Pipeline)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Pipeline)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetPipelineMetricsHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetPipelineMetricsHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetPipelineMetricsHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Since != nil {
+		i = encodeVarintDaemon(dAtA, i, uint64(*m.Since))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Pipeline == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("pipeline")
+	} else {
+		i -= len(*m.Pipeline)
+		copy(dAtA[i:], *m.Pipeline)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Pipeline)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetPipelineMetricsHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetPipelineMetricsHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetPipelineMetricsHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDaemon(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DrainBufferRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DrainBufferRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DrainBufferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Reason != nil {
+		i -= len(*m.Reason)
+		copy(dAtA[i:], *m.Reason)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Buffer == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("buffer")
+	} else {
+		i -= len(*m.Buffer)
+		copy(dAtA[i:], *m.Buffer)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Buffer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pipeline == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("pipeline")
+	} else {
+		i -= len(*m.Pipeline)
+		copy(dAtA[i:], *m.Pipeline)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Pipeline)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DrainBufferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DrainBufferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DrainBufferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.DrainedCount == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("drainedCount")
+	} else {
+		i = encodeVarintDaemon(dAtA, i, uint64(*m.DrainedCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SkipBufferRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SkipBufferRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SkipBufferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Reason != nil {
+		i -= len(*m.Reason)
+		copy(dAtA[i:], *m.Reason)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Buffer == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("buffer")
+	} else {
+		i -= len(*m.Buffer)
+		copy(dAtA[i:], *m.Buffer)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Buffer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pipeline == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("pipeline")
+	} else {
+		i -= len(*m.Pipeline)
+		copy(dAtA[i:], *m.Pipeline)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Pipeline)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SkipBufferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SkipBufferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SkipBufferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.SkippedCount == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("skippedCount")
+	} else {
+		i = encodeVarintDaemon(dAtA, i, uint64(*m.SkippedCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VertexError) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VertexError) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VertexError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.PayloadHash != nil {
+		i -= len(*m.PayloadHash)
+		copy(dAtA[i:], *m.PayloadHash)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.PayloadHash)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Message == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("message")
+	} else {
+		i -= len(*m.Message)
+		copy(dAtA[i:], *m.Message)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Message)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.MessageID != nil {
+		i -= len(*m.MessageID)
+		copy(dAtA[i:], *m.MessageID)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.MessageID)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Code == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("code")
+	} else {
+		i -= len(*m.Code)
+		copy(dAtA[i:], *m.Code)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Code)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Timestamp == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("timestamp")
+	} else {
+		i = encodeVarintDaemon(dAtA, i, uint64(*m.Timestamp))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Container == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("container")
+	} else {
+		i -= len(*m.Container)
+		copy(dAtA[i:], *m.Container)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Container)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Pod == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("pod")
+	} else {
+		i -= len(*m.Pod)
+		copy(dAtA[i:], *m.Pod)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Pod)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Vertex == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("vertex")
+	} else {
+		i -= len(*m.Vertex)
+		copy(dAtA[i:], *m.Vertex)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Vertex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pipeline == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("pipeline")
+	} else {
+		i -= len(*m.Pipeline)
+		copy(dAtA[i:], *m.Pipeline)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Pipeline)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetVertexErrorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetVertexErrorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetVertexErrorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Vertex == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("vertex")
+	} else {
+		i -= len(*m.Vertex)
+		copy(dAtA[i:], *m.Vertex)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Vertex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pipeline == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("pipeline")
+	} else {
+		i -= len(*m.Pipeline)
+		copy(dAtA[i:], *m.Pipeline)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Pipeline)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetVertexErrorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetVertexErrorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetVertexErrorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Errors) > 0 {
+		for iNdEx := len(m.Errors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Errors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDaemon(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VertexLog) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VertexLog) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VertexLog) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Line == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("line")
+	} else {
+		i -= len(*m.Line)
+		copy(dAtA[i:], *m.Line)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Line)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Timestamp == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("timestamp")
+	} else {
+		i = encodeVarintDaemon(dAtA, i, uint64(*m.Timestamp))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Source == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("source")
+	} else {
+		i -= len(*m.Source)
+		copy(dAtA[i:], *m.Source)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Source)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Pod == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("pod")
+	} else {
+		i -= len(*m.Pod)
+		copy(dAtA[i:], *m.Pod)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Pod)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Vertex == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("vertex")
+	} else {
+		i -= len(*m.Vertex)
+		copy(dAtA[i:], *m.Vertex)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Vertex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pipeline == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("pipeline")
+	} else {
+		i -= len(*m.Pipeline)
+		copy(dAtA[i:], *m.Pipeline)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Pipeline)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetVertexLogsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetVertexLogsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetVertexLogsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.MessageID == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("messageID")
+	} else {
+		i -= len(*m.MessageID)
+		copy(dAtA[i:], *m.MessageID)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.MessageID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Vertex == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("vertex")
+	} else {
+		i -= len(*m.Vertex)
+		copy(dAtA[i:], *m.Vertex)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Vertex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pipeline == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("pipeline")
+	} else {
+		i -= len(*m.Pipeline)
+		copy(dAtA[i:], *m.Pipeline)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Pipeline)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetVertexLogsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetVertexLogsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetVertexLogsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Logs) > 0 {
+		for iNdEx := len(m.Logs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Logs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDaemon(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PipelineDegradation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PipelineDegradation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PipelineDegradation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Transitions) > 0 {
+		for iNdEx := len(m.Transitions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Transitions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDaemon(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.LastTransitionTime == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("lastTransitionTime")
+	} else {
+		i = encodeVarintDaemon(dAtA, i, uint64(*m.LastTransitionTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Reason == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("reason")
+	} else {
+		i -= len(*m.Reason)
+		copy(dAtA[i:], *m.Reason)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Degraded == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("degraded")
+	} else {
+		i--
+		if *m.Degraded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Pipeline == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("pipeline")
+	} else {
+		i -= len(*m.Pipeline)
+		copy(dAtA[i:], *m.Pipeline)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Pipeline)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DegradationTransition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DegradationTransition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DegradationTransition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Time == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("time")
+	} else {
+		i = encodeVarintDaemon(dAtA, i, uint64(*m.Time))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Reason == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("reason")
+	} else {
+		i -= len(*m.Reason)
+		copy(dAtA[i:], *m.Reason)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Degraded == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("degraded")
+	} else {
+		i--
+		if *m.Degraded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetPipelineDegradationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetPipelineDegradationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetPipelineDegradationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Pipeline == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("pipeline")
+	} else {
+		i -= len(*m.Pipeline)
+		copy(dAtA[i:], *m.Pipeline)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Pipeline)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetPipelineDegradationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetPipelineDegradationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetPipelineDegradationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Degradation == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("degradation")
+	} else {
+		{
+			size, err := m.Degradation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDaemon(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EdgeBackpressure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EdgeBackpressure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EdgeBackpressure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.WriteRetryRatio == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("writeRetryRatio")
+	} else {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*m.WriteRetryRatio))))
+		i--
+		dAtA[i] = 0x39
+	}
+	if m.WriteErrorRate == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("writeErrorRate")
+	} else {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*m.WriteErrorRate))))
+		i--
+		dAtA[i] = 0x31
+	}
+	if m.BufferUsage == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("bufferUsage")
+	} else {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*m.BufferUsage))))
+		i--
+		dAtA[i] = 0x29
+	}
+	if m.Score == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("score")
+	} else {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*m.Score))))
+		i--
+		dAtA[i] = 0x21
+	}
+	if m.ToVertex == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("toVertex")
+	} else {
+		i -= len(*m.ToVertex)
+		copy(dAtA[i:], *m.ToVertex)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.ToVertex)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.FromVertex == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("fromVertex")
+	} else {
+		i -= len(*m.FromVertex)
+		copy(dAtA[i:], *m.FromVertex)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.FromVertex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Edge == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("edge")
+	} else {
+		i -= len(*m.Edge)
+		copy(dAtA[i:], *m.Edge)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Edge)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VertexBottleneck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VertexBottleneck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VertexBottleneck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ProcessingLatency == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("processingLatency")
+	} else {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*m.ProcessingLatency))))
+		i--
+		dAtA[i] = 0x29
+	}
+	if m.OutboundBackpressure == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("outboundBackpressure")
+	} else {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*m.OutboundBackpressure))))
+		i--
+		dAtA[i] = 0x21
+	}
+	if m.InboundBackpressure == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("inboundBackpressure")
+	} else {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*m.InboundBackpressure))))
+		i--
+		dAtA[i] = 0x19
+	}
+	if m.Score == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("score")
+	} else {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*m.Score))))
+		i--
+		dAtA[i] = 0x11
+	}
+	if m.Vertex == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("vertex")
+	} else {
+		i -= len(*m.Vertex)
+		copy(dAtA[i:], *m.Vertex)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Vertex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetPipelineBottlenecksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetPipelineBottlenecksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetPipelineBottlenecksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Pipeline == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("pipeline")
+	} else {
+		i -= len(*m.Pipeline)
+		copy(dAtA[i:], *m.Pipeline)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Pipeline)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetPipelineBottlenecksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetPipelineBottlenecksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetPipelineBottlenecksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Vertices) > 0 {
+		for iNdEx := len(m.Vertices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Vertices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDaemon(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Edges) > 0 {
+		for iNdEx := len(m.Edges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Edges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDaemon(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResetSourceOffsetsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResetSourceOffsetsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResetSourceOffsetsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Reason != nil {
+		i -= len(*m.Reason)
+		copy(dAtA[i:], *m.Reason)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Offsets) > 0 {
+		for k := range m.Offsets {
+			v := m.Offsets[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintDaemon(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i = encodeVarintDaemon(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintDaemon(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Timestamp != nil {
+		i = encodeVarintDaemon(dAtA, i, uint64(*m.Timestamp))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Vertex == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("vertex")
+	} else {
+		i -= len(*m.Vertex)
+		copy(dAtA[i:], *m.Vertex)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Vertex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pipeline == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("pipeline")
+	} else {
+		i -= len(*m.Pipeline)
+		copy(dAtA[i:], *m.Pipeline)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Pipeline)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResetSourceOffsetsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResetSourceOffsetsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResetSourceOffsetsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Offsets) > 0 {
+		for k := range m.Offsets {
+			v := m.Offsets[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintDaemon(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i = encodeVarintDaemon(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintDaemon(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BulkOperationResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BulkOperationResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BulkOperationResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Error != nil {
+		i -= len(*m.Error)
+		copy(dAtA[i:], *m.Error)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Error)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Pods == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("pods")
+	} else {
+		i = encodeVarintDaemon(dAtA, i, uint64(*m.Pods))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Succeeded == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("succeeded")
+	} else {
+		i--
+		if *m.Succeeded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Name == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	} else {
+		i -= len(*m.Name)
+		copy(dAtA[i:], *m.Name)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BulkOperationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BulkOperationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BulkOperationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Failed == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("failed")
+	} else {
+		i = encodeVarintDaemon(dAtA, i, uint64(*m.Failed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDaemon(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PauseSourcesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PauseSourcesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PauseSourcesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Reason != nil {
+		i -= len(*m.Reason)
+		copy(dAtA[i:], *m.Reason)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pipeline == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("pipeline")
+	} else {
+		i -= len(*m.Pipeline)
+		copy(dAtA[i:], *m.Pipeline)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Pipeline)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetLogLevelRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetLogLevelRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetLogLevelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Vertices) > 0 {
+		for iNdEx := len(m.Vertices) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Vertices[iNdEx])
+			copy(dAtA[i:], m.Vertices[iNdEx])
+			i = encodeVarintDaemon(dAtA, i, uint64(len(m.Vertices[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Level == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("level")
+	} else {
+		i -= len(*m.Level)
+		copy(dAtA[i:], *m.Level)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Level)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pipeline == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("pipeline")
+	} else {
+		i -= len(*m.Pipeline)
+		copy(dAtA[i:], *m.Pipeline)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Pipeline)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RefreshSideInputsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RefreshSideInputsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RefreshSideInputsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.SideInputs) > 0 {
+		for iNdEx := len(m.SideInputs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SideInputs[iNdEx])
+			copy(dAtA[i:], m.SideInputs[iNdEx])
+			i = encodeVarintDaemon(dAtA, i, uint64(len(m.SideInputs[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Pipeline == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("pipeline")
+	} else {
+		i -= len(*m.Pipeline)
+		copy(dAtA[i:], *m.Pipeline)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Pipeline)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchPipelineStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchPipelineStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchPipelineStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Cursor != nil {
+		i -= len(*m.Cursor)
+		copy(dAtA[i:], *m.Cursor)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Cursor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pipeline == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("pipeline")
+	} else {
+		i -= len(*m.Pipeline)
+		copy(dAtA[i:], *m.Pipeline)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Pipeline)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchPipelineStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchPipelineStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchPipelineStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Cursor == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("cursor")
+	} else {
+		i -= len(*m.Cursor)
+		copy(dAtA[i:], *m.Cursor)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Cursor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("status")
+	} else {
+		{
+			size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDaemon(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchBuffersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchBuffersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchBuffersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Cursor != nil {
+		i -= len(*m.Cursor)
+		copy(dAtA[i:], *m.Cursor)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Cursor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pipeline == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("pipeline")
+	} else {
+		i -= len(*m.Pipeline)
+		copy(dAtA[i:], *m.Pipeline)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Pipeline)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchBuffersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchBuffersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchBuffersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Cursor == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("cursor")
+	} else {
+		i -= len(*m.Cursor)
+		copy(dAtA[i:], *m.Cursor)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Cursor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Buffers) > 0 {
+		for iNdEx := len(m.Buffers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Buffers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDaemon(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchVertexMetricsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchVertexMetricsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchVertexMetricsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Cursor != nil {
+		i -= len(*m.Cursor)
+		copy(dAtA[i:], *m.Cursor)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Cursor)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Vertex == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("vertex")
+	} else {
+		i -= len(*m.Vertex)
+		copy(dAtA[i:], *m.Vertex)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Vertex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pipeline == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("pipeline")
+	} else {
+		i -= len(*m.Pipeline)
+		copy(dAtA[i:], *m.Pipeline)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Pipeline)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchVertexMetricsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchVertexMetricsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchVertexMetricsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Cursor == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("cursor")
+	} else {
+		i -= len(*m.Cursor)
+		copy(dAtA[i:], *m.Cursor)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(*m.Cursor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.VertexMetrics) > 0 {
+		for iNdEx := len(m.VertexMetrics) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VertexMetrics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDaemon(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDaemon(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDaemon(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BufferInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = len(*m.Pipeline)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.BufferName != nil {
+		l = len(*m.BufferName)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.PendingCount != nil {
+		n += 1 + sovDaemon(uint64(*m.PendingCount))
+	}
+	if m.AckPendingCount != nil {
+		n += 1 + sovDaemon(uint64(*m.AckPendingCount))
+	}
+	if m.TotalMessages != nil {
+		n += 1 + sovDaemon(uint64(*m.TotalMessages))
+	}
+	if m.BufferLength != nil {
+		n += 1 + sovDaemon(uint64(*m.BufferLength))
+	}
+	if m.BufferUsageLimit != nil {
+		n += 9
+	}
+	if m.BufferUsage != nil {
+		n += 9
+	}
+	if m.IsFull != nil {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *VertexMetrics) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = len(*m.Pipeline)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.Vertex != nil {
+		l = len(*m.Vertex)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if len(m.ProcessingRates) > 0 {
+		for k, v := range m.ProcessingRates {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovDaemon(uint64(len(k))) + 1 + 8
+			n += mapEntrySize + 1 + sovDaemon(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Pendings) > 0 {
+		for k, v := range m.Pendings {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovDaemon(uint64(len(k))) + 1 + sovDaemon(uint64(v))
+			n += mapEntrySize + 1 + sovDaemon(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PipelineStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != nil {
+		l = len(*m.Status)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.Message != nil {
+		l = len(*m.Message)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListBuffersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = len(*m.Pipeline)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListBuffersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Buffers) > 0 {
+		for _, e := range m.Buffers {
+			l = e.Size()
+			n += 1 + l + sovDaemon(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetBufferRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = len(*m.Pipeline)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.Buffer != nil {
+		l = len(*m.Buffer)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetBufferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Buffer != nil {
+		l = m.Buffer.Size()
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetPipelineStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = len(*m.Pipeline)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetPipelineStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != nil {
+		l = m.Status.Size()
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetVertexMetricsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = len(*m.Pipeline)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.Vertex != nil {
+		l = len(*m.Vertex)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetVertexMetricsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.VertexMetrics) > 0 {
+		for _, e := range m.VertexMetrics {
+			l = e.Size()
+			n += 1 + l + sovDaemon(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EdgeWatermark) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = len(*m.Pipeline)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.Edge != nil {
+		l = len(*m.Edge)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if len(m.Watermarks) > 0 {
+		for _, e := range m.Watermarks {
+			n += 1 + sovDaemon(uint64(e))
+		}
+	}
+	if m.IsWatermarkEnabled != nil {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetPipelineWatermarksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PipelineWatermarks) > 0 {
+		for _, e := range m.PipelineWatermarks {
+			l = e.Size()
+			n += 1 + l + sovDaemon(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetPipelineWatermarksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = len(*m.Pipeline)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EdgeMetrics) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = len(*m.Pipeline)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.Edge != nil {
+		l = len(*m.Edge)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.FromVertex != nil {
+		l = len(*m.FromVertex)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.ToVertex != nil {
+		l = len(*m.ToVertex)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.WatermarkLag != nil {
+		n += 1 + sovDaemon(uint64(*m.WatermarkLag))
+	}
+	if m.Pending != nil {
+		n += 1 + sovDaemon(uint64(*m.Pending))
+	}
+	if m.BufferUsage != nil {
+		n += 9
+	}
+	if m.BufferFillRate != nil {
+		n += 9
+	}
+	if len(m.LatencyPercentiles) > 0 {
+		for k, v := range m.LatencyPercentiles {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovDaemon(uint64(len(k))) + 1 + sovDaemon(uint64(v))
+			n += mapEntrySize + 1 + sovDaemon(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetPipelineEdgeMetricsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = len(*m.Pipeline)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetPipelineEdgeMetricsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.EdgeMetrics) > 0 {
+		for _, e := range m.EdgeMetrics {
+			l = e.Size()
+			n += 1 + l + sovDaemon(uint64(l))
+		}
+	}
+	if len(m.EndToEndLatencyPercentiles) > 0 {
+		for k, v := range m.EndToEndLatencyPercentiles {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovDaemon(uint64(len(k))) + 1 + sovDaemon(uint64(v))
+			n += mapEntrySize + 1 + sovDaemon(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MetricsHistoryPoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Timestamp != nil {
+		n += 1 + sovDaemon(uint64(*m.Timestamp))
+	}
+	if m.Value != nil {
+		n += 9
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MetricsHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = len(*m.Pipeline)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.Metric != nil {
+		l = len(*m.Metric)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.Vertex != nil {
+		l = len(*m.Vertex)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.Edge != nil {
+		l = len(*m.Edge)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if len(m.Points) > 0 {
+		for _, e := range m.Points {
+			l = e.Size()
+			n += 1 + l + sovDaemon(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetPipelineMetricsHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = len(*m.Pipeline)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.Since != nil {
+		n += 1 + sovDaemon(uint64(*m.Since))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetPipelineMetricsHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovDaemon(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DrainBufferRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = len(*m.Pipeline)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.Buffer != nil {
+		l = len(*m.Buffer)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.Reason != nil {
+		l = len(*m.Reason)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DrainBufferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DrainedCount != nil {
+		n += 1 + sovDaemon(uint64(*m.DrainedCount))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SkipBufferRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = len(*m.Pipeline)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.Buffer != nil {
+		l = len(*m.Buffer)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.Reason != nil {
+		l = len(*m.Reason)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SkipBufferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SkippedCount != nil {
+		n += 1 + sovDaemon(uint64(*m.SkippedCount))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *VertexError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = len(*m.Pipeline)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.Vertex != nil {
+		l = len(*m.Vertex)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.Pod != nil {
+		l = len(*m.Pod)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.Container != nil {
+		l = len(*m.Container)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.Timestamp != nil {
+		n += 1 + sovDaemon(uint64(*m.Timestamp))
+	}
+	if m.Code != nil {
+		l = len(*m.Code)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.MessageID != nil {
+		l = len(*m.MessageID)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.Message != nil {
+		l = len(*m.Message)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.PayloadHash != nil {
+		l = len(*m.PayloadHash)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetVertexErrorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = len(*m.Pipeline)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.Vertex != nil {
+		l = len(*m.Vertex)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetVertexErrorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Errors) > 0 {
+		for _, e := range m.Errors {
+			l = e.Size()
+			n += 1 + l + sovDaemon(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *VertexLog) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = len(*m.Pipeline)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.Vertex != nil {
+		l = len(*m.Vertex)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.Pod != nil {
+		l = len(*m.Pod)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.Source != nil {
+		l = len(*m.Source)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.Timestamp != nil {
+		n += 1 + sovDaemon(uint64(*m.Timestamp))
+	}
+	if m.Line != nil {
+		l = len(*m.Line)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetVertexLogsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = len(*m.Pipeline)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.Vertex != nil {
+		l = len(*m.Vertex)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.MessageID != nil {
+		l = len(*m.MessageID)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetVertexLogsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Logs) > 0 {
+		for _, e := range m.Logs {
+			l = e.Size()
+			n += 1 + l + sovDaemon(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PipelineDegradation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = len(*m.Pipeline)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.Degraded != nil {
+		n += 2
+	}
+	if m.Reason != nil {
+		l = len(*m.Reason)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.LastTransitionTime != nil {
+		n += 1 + sovDaemon(uint64(*m.LastTransitionTime))
+	}
+	if len(m.Transitions) > 0 {
+		for _, e := range m.Transitions {
+			l = e.Size()
+			n += 1 + l + sovDaemon(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DegradationTransition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Degraded != nil {
+		n += 2
+	}
+	if m.Reason != nil {
+		l = len(*m.Reason)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.Time != nil {
+		n += 1 + sovDaemon(uint64(*m.Time))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetPipelineDegradationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = len(*m.Pipeline)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetPipelineDegradationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Degradation != nil {
+		l = m.Degradation.Size()
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EdgeBackpressure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Edge != nil {
+		l = len(*m.Edge)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.FromVertex != nil {
+		l = len(*m.FromVertex)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.ToVertex != nil {
+		l = len(*m.ToVertex)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.Score != nil {
+		n += 9
+	}
+	if m.BufferUsage != nil {
+		n += 9
+	}
+	if m.WriteErrorRate != nil {
+		n += 9
+	}
+	if m.WriteRetryRatio != nil {
+		n += 9
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *VertexBottleneck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Vertex != nil {
+		l = len(*m.Vertex)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.Score != nil {
+		n += 9
+	}
+	if m.InboundBackpressure != nil {
+		n += 9
+	}
+	if m.OutboundBackpressure != nil {
+		n += 9
+	}
+	if m.ProcessingLatency != nil {
+		n += 9
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetPipelineBottlenecksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = len(*m.Pipeline)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetPipelineBottlenecksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Edges) > 0 {
+		for _, e := range m.Edges {
+			l = e.Size()
+			n += 1 + l + sovDaemon(uint64(l))
+		}
+	}
+	if len(m.Vertices) > 0 {
+		for _, e := range m.Vertices {
+			l = e.Size()
+			n += 1 + l + sovDaemon(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResetSourceOffsetsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = len(*m.Pipeline)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.Vertex != nil {
+		l = len(*m.Vertex)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.Timestamp != nil {
+		n += 1 + sovDaemon(uint64(*m.Timestamp))
+	}
+	if len(m.Offsets) > 0 {
+		for k, v := range m.Offsets {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovDaemon(uint64(k)) + 1 + len(v) + sovDaemon(uint64(len(v)))
+			n += mapEntrySize + 1 + sovDaemon(uint64(mapEntrySize))
+		}
+	}
+	if m.Reason != nil {
+		l = len(*m.Reason)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResetSourceOffsetsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Offsets) > 0 {
+		for k, v := range m.Offsets {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovDaemon(uint64(k)) + 1 + len(v) + sovDaemon(uint64(len(v)))
+			n += mapEntrySize + 1 + sovDaemon(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BulkOperationResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.Succeeded != nil {
+		n += 2
+	}
+	if m.Pods != nil {
+		n += 1 + sovDaemon(uint64(*m.Pods))
+	}
+	if m.Error != nil {
+		l = len(*m.Error)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BulkOperationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovDaemon(uint64(l))
+		}
+	}
+	if m.Failed != nil {
+		n += 1 + sovDaemon(uint64(*m.Failed))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PauseSourcesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = len(*m.Pipeline)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.Reason != nil {
+		l = len(*m.Reason)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SetLogLevelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = len(*m.Pipeline)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.Level != nil {
+		l = len(*m.Level)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if len(m.Vertices) > 0 {
+		for _, s := range m.Vertices {
+			l = len(s)
+			n += 1 + l + sovDaemon(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RefreshSideInputsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = len(*m.Pipeline)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if len(m.SideInputs) > 0 {
+		for _, s := range m.SideInputs {
+			l = len(s)
+			n += 1 + l + sovDaemon(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WatchPipelineStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = len(*m.Pipeline)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.Cursor != nil {
+		l = len(*m.Cursor)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WatchPipelineStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != nil {
+		l = m.Status.Size()
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.Cursor != nil {
+		l = len(*m.Cursor)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WatchBuffersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = len(*m.Pipeline)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.Cursor != nil {
+		l = len(*m.Cursor)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WatchBuffersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Buffers) > 0 {
+		for _, e := range m.Buffers {
+			l = e.Size()
+			n += 1 + l + sovDaemon(uint64(l))
+		}
+	}
+	if m.Cursor != nil {
+		l = len(*m.Cursor)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WatchVertexMetricsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = len(*m.Pipeline)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.Vertex != nil {
+		l = len(*m.Vertex)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.Cursor != nil {
+		l = len(*m.Cursor)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WatchVertexMetricsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.VertexMetrics) > 0 {
+		for _, e := range m.VertexMetrics {
+			l = e.Size()
+			n += 1 + l + sovDaemon(uint64(l))
+		}
+	}
+	if m.Cursor != nil {
+		l = len(*m.Cursor)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovDaemon(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDaemon(x uint64) (n int) {
+	return sovDaemon(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BufferInfo) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDaemon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BufferInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BufferInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Pipeline = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BufferName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.BufferName = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000002)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingCount", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PendingCount = &v
+			hasFields[0] |= uint64(0x00000004)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckPendingCount", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AckPendingCount = &v
+			hasFields[0] |= uint64(0x00000008)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalMessages", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TotalMessages = &v
+			hasFields[0] |= uint64(0x00000010)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BufferLength", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BufferLength = &v
+			hasFields[0] |= uint64(0x00000020)
+		case 7:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BufferUsageLimit", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			v2 := float64(math.Float64frombits(v))
+			m.BufferUsageLimit = &v2
+			hasFields[0] |= uint64(0x00000040)
+		case 8:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BufferUsage", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			v2 := float64(math.Float64frombits(v))
+			m.BufferUsage = &v2
+			hasFields[0] |= uint64(0x00000080)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsFull", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.IsFull = &b
+			hasFields[0] |= uint64(0x00000100)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDaemon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("pipeline")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("bufferName")
+	}
+	if hasFields[0]&uint64(0x00000004) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("pendingCount")
+	}
+	if hasFields[0]&uint64(0x00000008) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("ackPendingCount")
+	}
+	if hasFields[0]&uint64(0x00000010) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("totalMessages")
+	}
+	if hasFields[0]&uint64(0x00000020) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("bufferLength")
+	}
+	if hasFields[0]&uint64(0x00000040) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("bufferUsageLimit")
+	}
+	if hasFields[0]&uint64(0x00000080) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("bufferUsage")
+	}
+	if hasFields[0]&uint64(0x00000100) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("isFull")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VertexMetrics) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDaemon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VertexMetrics: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VertexMetrics: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Pipeline = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vertex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Vertex = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000002)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessingRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProcessingRates == nil {
+				m.ProcessingRates = make(map[string]float64)
+			}
+			var mapkey string
+			var mapvalue float64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDaemon
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDaemon
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthDaemon
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthDaemon
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvaluetemp = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					mapvalue = math.Float64frombits(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipDaemon(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthDaemon
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ProcessingRates[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pendings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pendings == nil {
+				m.Pendings = make(map[string]int64)
+			}
+			var mapkey string
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDaemon
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDaemon
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthDaemon
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthDaemon
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDaemon
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipDaemon(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthDaemon
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Pendings[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDaemon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("pipeline")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("vertex")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PipelineStatus) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDaemon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PipelineStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PipelineStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Status = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Message = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000002)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDaemon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("status")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("message")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListBuffersRequest) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDaemon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListBuffersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListBuffersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Pipeline = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDaemon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("pipeline")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListBuffersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDaemon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListBuffersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListBuffersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buffers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buffers = append(m.Buffers, &BufferInfo{})
+			if err := m.Buffers[len(m.Buffers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDaemon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetBufferRequest) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDaemon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetBufferRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetBufferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Pipeline = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buffer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Buffer = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000002)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDaemon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("pipeline")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("buffer")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetBufferResponse) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDaemon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetBufferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetBufferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buffer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Buffer == nil {
+				m.Buffer = &BufferInfo{}
+			}
+			if err := m.Buffer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDaemon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("buffer")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetPipelineStatusRequest) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDaemon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetPipelineStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetPipelineStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Pipeline = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDaemon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("pipeline")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetPipelineStatusResponse) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDaemon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetPipelineStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetPipelineStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Status == nil {
+				m.Status = &PipelineStatus{}
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDaemon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("status")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetVertexMetricsRequest) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDaemon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetVertexMetricsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetVertexMetricsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Pipeline = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vertex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Vertex = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000002)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDaemon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("pipeline")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("vertex")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetVertexMetricsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDaemon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetVertexMetricsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetVertexMetricsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VertexMetrics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VertexMetrics = append(m.VertexMetrics, &VertexMetrics{})
+			if err := m.VertexMetrics[len(m.VertexMetrics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDaemon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EdgeWatermark) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDaemon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EdgeWatermark: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EdgeWatermark: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Pipeline = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Edge", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Edge = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000002)
+		case 3:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDaemon
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Watermarks = append(m.Watermarks, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDaemon
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthDaemon
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthDaemon
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Watermarks) == 0 {
+					m.Watermarks = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDaemon
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Watermarks = append(m.Watermarks, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Watermarks", wireType)
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsWatermarkEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.IsWatermarkEnabled = &b
+			hasFields[0] |= uint64(0x00000004)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDaemon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("pipeline")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("edge")
+	}
+	if hasFields[0]&uint64(0x00000004) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("isWatermarkEnabled")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetPipelineWatermarksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDaemon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetPipelineWatermarksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetPipelineWatermarksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PipelineWatermarks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PipelineWatermarks = append(m.PipelineWatermarks, &EdgeWatermark{})
+			if err := m.PipelineWatermarks[len(m.PipelineWatermarks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDaemon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetPipelineWatermarksRequest) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDaemon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetPipelineWatermarksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetPipelineWatermarksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Pipeline = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDaemon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("pipeline")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EdgeMetrics) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDaemon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EdgeMetrics: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EdgeMetrics: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Pipeline = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Edge", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Edge = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000002)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromVertex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.FromVertex = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000004)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToVertex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.ToVertex = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000008)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WatermarkLag", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WatermarkLag = &v
+			hasFields[0] |= uint64(0x00000010)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Pending = &v
+			hasFields[0] |= uint64(0x00000020)
+		case 7:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BufferUsage", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			v2 := float64(math.Float64frombits(v))
+			m.BufferUsage = &v2
+			hasFields[0] |= uint64(0x00000040)
+		case 8:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BufferFillRate", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			v2 := float64(math.Float64frombits(v))
+			m.BufferFillRate = &v2
+			hasFields[0] |= uint64(0x00000080)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatencyPercentiles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LatencyPercentiles == nil {
+				m.LatencyPercentiles = make(map[string]int64)
+			}
+			var mapkey string
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDaemon
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDaemon
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthDaemon
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthDaemon
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDaemon
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipDaemon(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthDaemon
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.LatencyPercentiles[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDaemon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("pipeline")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("edge")
+	}
+	if hasFields[0]&uint64(0x00000004) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("fromVertex")
+	}
+	if hasFields[0]&uint64(0x00000008) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("toVertex")
+	}
+	if hasFields[0]&uint64(0x00000010) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("watermarkLag")
+	}
+	if hasFields[0]&uint64(0x00000020) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("pending")
+	}
+	if hasFields[0]&uint64(0x00000040) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("bufferUsage")
+	}
+	if hasFields[0]&uint64(0x00000080) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("bufferFillRate")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetPipelineEdgeMetricsRequest) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDaemon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetPipelineEdgeMetricsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetPipelineEdgeMetricsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Pipeline = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDaemon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("pipeline")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetPipelineEdgeMetricsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDaemon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetPipelineEdgeMetricsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetPipelineEdgeMetricsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EdgeMetrics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EdgeMetrics = append(m.EdgeMetrics, &EdgeMetrics{})
+			if err := m.EdgeMetrics[len(m.EdgeMetrics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndToEndLatencyPercentiles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndToEndLatencyPercentiles == nil {
+				m.EndToEndLatencyPercentiles = make(map[string]int64)
+			}
+			var mapkey string
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDaemon
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDaemon
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthDaemon
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthDaemon
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDaemon
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipDaemon(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthDaemon
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.EndToEndLatencyPercentiles[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDaemon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MetricsHistoryPoint) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDaemon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MetricsHistoryPoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MetricsHistoryPoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Timestamp = &v
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			v2 := float64(math.Float64frombits(v))
+			m.Value = &v2
+			hasFields[0] |= uint64(0x00000002)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDaemon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("timestamp")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("value")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MetricsHistory) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDaemon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MetricsHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MetricsHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Pipeline = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metric", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Metric = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000002)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vertex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Vertex = &s
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Edge", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Edge = &s
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Points", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Points = append(m.Points, &MetricsHistoryPoint{})
+			if err := m.Points[len(m.Points)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDaemon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("pipeline")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("metric")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetPipelineMetricsHistoryRequest) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDaemon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetPipelineMetricsHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetPipelineMetricsHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Pipeline = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Since = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDaemon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("pipeline")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetPipelineMetricsHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDaemon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetPipelineMetricsHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetPipelineMetricsHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.History = append(m.History, &MetricsHistory{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDaemon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DrainBufferRequest) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDaemon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DrainBufferRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DrainBufferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Pipeline = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buffer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Buffer = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000002)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Reason = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDaemon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("pipeline")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("buffer")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DrainBufferResponse) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDaemon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DrainBufferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DrainBufferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrainedCount", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DrainedCount = &v
+			hasFields[0] |= uint64(0x00000001)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDaemon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("drainedCount")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SkipBufferRequest) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDaemon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SkipBufferRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SkipBufferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Pipeline = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buffer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Buffer = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000002)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Reason = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDaemon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("pipeline")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("buffer")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SkipBufferResponse) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDaemon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SkipBufferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SkipBufferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkippedCount", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SkippedCount = &v
+			hasFields[0] |= uint64(0x00000001)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDaemon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("skippedCount")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VertexError) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDaemon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VertexError: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VertexError: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Pipeline = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vertex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Vertex = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000002)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pod", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Pod = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000004)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Container = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000008)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Timestamp = &v
+			hasFields[0] |= uint64(0x00000010)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Code = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000020)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.MessageID = &s
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Message = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000040)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.PayloadHash = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDaemon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("pipeline")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("vertex")
+	}
+	if hasFields[0]&uint64(0x00000004) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("pod")
+	}
+	if hasFields[0]&uint64(0x00000008) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("container")
+	}
+	if hasFields[0]&uint64(0x00000010) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("timestamp")
+	}
+	if hasFields[0]&uint64(0x00000020) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("code")
+	}
+	if hasFields[0]&uint64(0x00000040) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("message")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetVertexErrorsRequest) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDaemon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetVertexErrorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetVertexErrorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Pipeline = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vertex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Vertex = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000002)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDaemon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("pipeline")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("vertex")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetVertexErrorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDaemon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetVertexErrorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetVertexErrorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Errors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Errors = append(m.Errors, &VertexError{})
+			if err := m.Errors[len(m.Errors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDaemon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VertexLog) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDaemon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VertexLog: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VertexLog: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Pipeline = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vertex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Vertex = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000002)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pod", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Pod = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000004)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Source = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000008)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Timestamp = &v
+			hasFields[0] |= uint64(0x00000010)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Line", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Line = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000020)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDaemon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("pipeline")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("vertex")
+	}
+	if hasFields[0]&uint64(0x00000004) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("pod")
+	}
+	if hasFields[0]&uint64(0x00000008) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("source")
+	}
+	if hasFields[0]&uint64(0x00000010) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("timestamp")
+	}
+	if hasFields[0]&uint64(0x00000020) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("line")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetVertexLogsRequest) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDaemon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetVertexLogsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetVertexLogsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Pipeline = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vertex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Vertex = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000002)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.MessageID = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000004)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDaemon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("pipeline")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("vertex")
+	}
+	if hasFields[0]&uint64(0x00000004) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("messageID")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetVertexLogsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDaemon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetVertexLogsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetVertexLogsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Logs = append(m.Logs, &VertexLog{})
+			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDaemon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PipelineDegradation) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDaemon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PipelineDegradation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PipelineDegradation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Pipeline = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Degraded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Degraded = &b
+			hasFields[0] |= uint64(0x00000002)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Reason = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000004)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LastTransitionTime = &v
+			hasFields[0] |= uint64(0x00000008)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transitions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transitions = append(m.Transitions, &DegradationTransition{})
+			if err := m.Transitions[len(m.Transitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDaemon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("pipeline")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("degraded")
+	}
+	if hasFields[0]&uint64(0x00000004) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("reason")
+	}
+	if hasFields[0]&uint64(0x00000008) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("lastTransitionTime")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DegradationTransition) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDaemon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DegradationTransition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DegradationTransition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Degraded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Degraded = &b
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Reason = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000002)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Time = &v
+			hasFields[0] |= uint64(0x00000004)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDaemon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("degraded")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("reason")
+	}
+	if hasFields[0]&uint64(0x00000004) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("time")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetPipelineDegradationRequest) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDaemon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetPipelineDegradationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetPipelineDegradationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Pipeline = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDaemon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("pipeline")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetPipelineDegradationResponse) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDaemon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetPipelineDegradationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetPipelineDegradationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Degradation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Degradation == nil {
+				m.Degradation = &PipelineDegradation{}
+			}
+			if err := m.Degradation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDaemon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("degradation")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EdgeBackpressure) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDaemon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EdgeBackpressure: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EdgeBackpressure: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Edge", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Edge = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromVertex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.FromVertex = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000002)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToVertex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.ToVertex = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000004)
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			v2 := float64(math.Float64frombits(v))
+			m.Score = &v2
+			hasFields[0] |= uint64(0x00000008)
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BufferUsage", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			v2 := float64(math.Float64frombits(v))
+			m.BufferUsage = &v2
+			hasFields[0] |= uint64(0x00000010)
+		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WriteErrorRate", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			v2 := float64(math.Float64frombits(v))
+			m.WriteErrorRate = &v2
+			hasFields[0] |= uint64(0x00000020)
+		case 7:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WriteRetryRatio", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			v2 := float64(math.Float64frombits(v))
+			m.WriteRetryRatio = &v2
+			hasFields[0] |= uint64(0x00000040)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDaemon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("edge")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("fromVertex")
+	}
+	if hasFields[0]&uint64(0x00000004) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("toVertex")
+	}
+	if hasFields[0]&uint64(0x00000008) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("score")
+	}
+	if hasFields[0]&uint64(0x00000010) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("bufferUsage")
+	}
+	if hasFields[0]&uint64(0x00000020) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("writeErrorRate")
+	}
+	if hasFields[0]&uint64(0x00000040) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("writeRetryRatio")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VertexBottleneck) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDaemon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VertexBottleneck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VertexBottleneck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vertex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Vertex = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			v2 := float64(math.Float64frombits(v))
+			m.Score = &v2
+			hasFields[0] |= uint64(0x00000002)
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundBackpressure", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			v2 := float64(math.Float64frombits(v))
+			m.InboundBackpressure = &v2
+			hasFields[0] |= uint64(0x00000004)
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundBackpressure", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			v2 := float64(math.Float64frombits(v))
+			m.OutboundBackpressure = &v2
+			hasFields[0] |= uint64(0x00000008)
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessingLatency", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			v2 := float64(math.Float64frombits(v))
+			m.ProcessingLatency = &v2
+			hasFields[0] |= uint64(0x00000010)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDaemon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
 		}
 	}
-	if m.IsWatermarkEnabled != nil {
-		n += 2
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("vertex")
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("score")
 	}
-	return n
-}
-
-func (m *GetPipelineWatermarksResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if hasFields[0]&uint64(0x00000004) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("inboundBackpressure")
 	}
-	var l int
-	_ = l
-	if len(m.PipelineWatermarks) > 0 {
-		for _, e := range m.PipelineWatermarks {
-			l = e.Size()
-			n += 1 + l + sovDaemon(uint64(l))
-		}
+	if hasFields[0]&uint64(0x00000008) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("outboundBackpressure")
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if hasFields[0]&uint64(0x00000010) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("processingLatency")
 	}
-	return n
-}
 
-func (m *GetPipelineWatermarksRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pipeline != nil {
-		l = len(*m.Pipeline)
-		n += 1 + l + sovDaemon(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovDaemon(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozDaemon(x uint64) (n int) {
-	return sovDaemon(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *BufferInfo) Unmarshal(dAtA []byte) error {
+func (m *GetPipelineBottlenecksRequest) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -2174,10 +13995,10 @@ func (m *BufferInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BufferInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetPipelineBottlenecksRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BufferInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetPipelineBottlenecksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2214,87 +14035,65 @@ func (m *BufferInfo) Unmarshal(dAtA []byte) error {
 			m.Pipeline = &s
 			iNdEx = postIndex
 			hasFields[0] |= uint64(0x00000001)
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BufferName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDaemon
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDaemon
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDaemon(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthDaemon
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.BufferName = &s
-			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000002)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingCount", wireType)
-			}
-			var v int64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDaemon
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("pipeline")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetPipelineBottlenecksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDaemon
 			}
-			m.PendingCount = &v
-			hasFields[0] |= uint64(0x00000004)
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AckPendingCount", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDaemon
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.AckPendingCount = &v
-			hasFields[0] |= uint64(0x00000008)
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalMessages", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetPipelineBottlenecksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetPipelineBottlenecksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Edges", wireType)
 			}
-			var v int64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDaemon
@@ -2304,65 +14103,31 @@ func (m *BufferInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.TotalMessages = &v
-			hasFields[0] |= uint64(0x00000010)
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BufferLength", wireType)
-			}
-			var v int64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDaemon
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if msglen < 0 {
+				return ErrInvalidLengthDaemon
 			}
-			m.BufferLength = &v
-			hasFields[0] |= uint64(0x00000020)
-		case 7:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BufferUsageLimit", wireType)
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			v2 := float64(math.Float64frombits(v))
-			m.BufferUsageLimit = &v2
-			hasFields[0] |= uint64(0x00000040)
-		case 8:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BufferUsage", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
+			m.Edges = append(m.Edges, &EdgeBackpressure{})
+			if err := m.Edges[len(m.Edges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			v2 := float64(math.Float64frombits(v))
-			m.BufferUsage = &v2
-			hasFields[0] |= uint64(0x00000080)
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsFull", wireType)
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vertices", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDaemon
@@ -2372,14 +14137,26 @@ func (m *BufferInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.IsFull = &b
-			hasFields[0] |= uint64(0x00000100)
+			if msglen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vertices = append(m.Vertices, &VertexBottleneck{})
+			if err := m.Vertices[len(m.Vertices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDaemon(dAtA[iNdEx:])
@@ -2396,40 +14173,13 @@ func (m *BufferInfo) Unmarshal(dAtA []byte) error {
 			iNdEx += skippy
 		}
 	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("pipeline")
-	}
-	if hasFields[0]&uint64(0x00000002) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("bufferName")
-	}
-	if hasFields[0]&uint64(0x00000004) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("pendingCount")
-	}
-	if hasFields[0]&uint64(0x00000008) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("ackPendingCount")
-	}
-	if hasFields[0]&uint64(0x00000010) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("totalMessages")
-	}
-	if hasFields[0]&uint64(0x00000020) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("bufferLength")
-	}
-	if hasFields[0]&uint64(0x00000040) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("bufferUsageLimit")
-	}
-	if hasFields[0]&uint64(0x00000080) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("bufferUsage")
-	}
-	if hasFields[0]&uint64(0x00000100) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("isFull")
-	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *VertexMetrics) Unmarshal(dAtA []byte) error {
+func (m *ResetSourceOffsetsRequest) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -2453,10 +14203,10 @@ func (m *VertexMetrics) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VertexMetrics: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResetSourceOffsetsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VertexMetrics: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResetSourceOffsetsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2528,8 +14278,28 @@ func (m *VertexMetrics) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 			hasFields[0] |= uint64(0x00000002)
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Timestamp = &v
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProcessingRates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Offsets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2556,11 +14326,11 @@ func (m *VertexMetrics) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProcessingRates == nil {
-				m.ProcessingRates = make(map[string]float64)
+			if m.Offsets == nil {
+				m.Offsets = make(map[int32]string)
 			}
-			var mapkey string
-			var mapvalue float64
+			var mapkey int32
+			var mapvalue string
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -2580,7 +14350,6 @@ func (m *VertexMetrics) Unmarshal(dAtA []byte) error {
 				}
 				fieldNum := int32(wire >> 3)
 				if fieldNum == 1 {
-					var stringLenmapkey uint64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowDaemon
@@ -2590,32 +14359,40 @@ func (m *VertexMetrics) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
+						mapkey |= int32(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthDaemon
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDaemon
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
 					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
 						return ErrInvalidLengthDaemon
 					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthDaemon
 					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapvaluetemp uint64
-					if (iNdEx + 8) > l {
+					if postStringIndexmapvalue > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvaluetemp = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-					iNdEx += 8
-					mapvalue = math.Float64frombits(mapvaluetemp)
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipDaemon(dAtA[iNdEx:])
@@ -2631,11 +14408,101 @@ func (m *VertexMetrics) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.ProcessingRates[mapkey] = mapvalue
+			m.Offsets[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pendings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Reason = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDaemon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("pipeline")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("vertex")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResetSourceOffsetsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDaemon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResetSourceOffsetsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResetSourceOffsetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offsets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2662,11 +14529,11 @@ func (m *VertexMetrics) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pendings == nil {
-				m.Pendings = make(map[string]int64)
+			if m.Offsets == nil {
+				m.Offsets = make(map[int32]string)
 			}
-			var mapkey string
-			var mapvalue int64
+			var mapkey int32
+			var mapvalue string
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -2686,7 +14553,6 @@ func (m *VertexMetrics) Unmarshal(dAtA []byte) error {
 				}
 				fieldNum := int32(wire >> 3)
 				if fieldNum == 1 {
-					var stringLenmapkey uint64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowDaemon
@@ -2696,25 +14562,13 @@ func (m *VertexMetrics) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
+						mapkey |= int32(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthDaemon
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthDaemon
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowDaemon
@@ -2724,11 +14578,24 @@ func (m *VertexMetrics) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapvalue |= int64(b&0x7F) << shift
+						stringLenmapvalue |= uint64(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthDaemon
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthDaemon
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipDaemon(dAtA[iNdEx:])
@@ -2744,7 +14611,7 @@ func (m *VertexMetrics) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Pendings[mapkey] = mapvalue
+			m.Offsets[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2762,19 +14629,13 @@ func (m *VertexMetrics) Unmarshal(dAtA []byte) error {
 			iNdEx += skippy
 		}
 	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("pipeline")
-	}
-	if hasFields[0]&uint64(0x00000002) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("vertex")
-	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *PipelineStatus) Unmarshal(dAtA []byte) error {
+func (m *BulkOperationResult) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -2798,15 +14659,15 @@ func (m *PipelineStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PipelineStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: BulkOperationResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PipelineStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BulkOperationResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2835,12 +14696,55 @@ func (m *PipelineStatus) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Status = &s
+			m.Name = &s
 			iNdEx = postIndex
 			hasFields[0] |= uint64(0x00000001)
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Succeeded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Succeeded = &b
+			hasFields[0] |= uint64(0x00000002)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pods", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Pods = &v
+			hasFields[0] |= uint64(0x00000004)
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2869,9 +14773,8 @@ func (m *PipelineStatus) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Message = &s
+			m.Error = &s
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000002)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDaemon(dAtA[iNdEx:])
@@ -2889,10 +14792,13 @@ func (m *PipelineStatus) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("status")
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
 	}
 	if hasFields[0]&uint64(0x00000002) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("message")
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("succeeded")
+	}
+	if hasFields[0]&uint64(0x00000004) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("pods")
 	}
 
 	if iNdEx > l {
@@ -2900,7 +14806,7 @@ func (m *PipelineStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListBuffersRequest) Unmarshal(dAtA []byte) error {
+func (m *BulkOperationResponse) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -2924,17 +14830,17 @@ func (m *ListBuffersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListBuffersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: BulkOperationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListBuffersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BulkOperationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDaemon
@@ -2944,25 +14850,46 @@ func (m *ListBuffersRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDaemon
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDaemon
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Pipeline = &s
+			m.Results = append(m.Results, &BulkOperationResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Failed = &v
 			hasFields[0] |= uint64(0x00000001)
 		default:
 			iNdEx = preIndex
@@ -2981,7 +14908,7 @@ func (m *ListBuffersRequest) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("pipeline")
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("failed")
 	}
 
 	if iNdEx > l {
@@ -2989,7 +14916,8 @@ func (m *ListBuffersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListBuffersResponse) Unmarshal(dAtA []byte) error {
+func (m *PauseSourcesRequest) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3012,17 +14940,17 @@ func (m *ListBuffersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListBuffersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PauseSourcesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListBuffersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PauseSourcesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Buffers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDaemon
@@ -3032,25 +14960,58 @@ func (m *ListBuffersResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDaemon
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDaemon
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Buffers = append(m.Buffers, &BufferInfo{})
-			if err := m.Buffers[len(m.Buffers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			s := string(dAtA[iNdEx:postIndex])
+			m.Pipeline = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Reason = &s
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3068,13 +15029,16 @@ func (m *ListBuffersResponse) Unmarshal(dAtA []byte) error {
 			iNdEx += skippy
 		}
 	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("pipeline")
+	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *GetBufferRequest) Unmarshal(dAtA []byte) error {
+func (m *SetLogLevelRequest) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -3098,10 +15062,10 @@ func (m *GetBufferRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetBufferRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetLogLevelRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetBufferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetLogLevelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3140,7 +15104,7 @@ func (m *GetBufferRequest) Unmarshal(dAtA []byte) error {
 			hasFields[0] |= uint64(0x00000001)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Buffer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3169,9 +15133,41 @@ func (m *GetBufferRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Buffer = &s
+			m.Level = &s
 			iNdEx = postIndex
 			hasFields[0] |= uint64(0x00000002)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vertices", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vertices = append(m.Vertices, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDaemon(dAtA[iNdEx:])
@@ -3192,7 +15188,7 @@ func (m *GetBufferRequest) Unmarshal(dAtA []byte) error {
 		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("pipeline")
 	}
 	if hasFields[0]&uint64(0x00000002) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("buffer")
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("level")
 	}
 
 	if iNdEx > l {
@@ -3200,7 +15196,7 @@ func (m *GetBufferRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetBufferResponse) Unmarshal(dAtA []byte) error {
+func (m *RefreshSideInputsRequest) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -3224,17 +15220,17 @@ func (m *GetBufferResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetBufferResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RefreshSideInputsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetBufferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RefreshSideInputsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Buffer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDaemon
@@ -3244,29 +15240,58 @@ func (m *GetBufferResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDaemon
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDaemon
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Buffer == nil {
-				m.Buffer = &BufferInfo{}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Pipeline = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SideInputs", wireType)
 			}
-			if err := m.Buffer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
 			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SideInputs = append(m.SideInputs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000001)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDaemon(dAtA[iNdEx:])
@@ -3284,7 +15309,7 @@ func (m *GetBufferResponse) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("buffer")
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("pipeline")
 	}
 
 	if iNdEx > l {
@@ -3292,7 +15317,7 @@ func (m *GetBufferResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetPipelineStatusRequest) Unmarshal(dAtA []byte) error {
+func (m *WatchPipelineStatusRequest) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -3316,10 +15341,10 @@ func (m *GetPipelineStatusRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetPipelineStatusRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: WatchPipelineStatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetPipelineStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WatchPipelineStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3356,6 +15381,39 @@ func (m *GetPipelineStatusRequest) Unmarshal(dAtA []byte) error {
 			m.Pipeline = &s
 			iNdEx = postIndex
 			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Cursor = &s
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDaemon(dAtA[iNdEx:])
@@ -3381,7 +15439,7 @@ func (m *GetPipelineStatusRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetPipelineStatusResponse) Unmarshal(dAtA []byte) error {
+func (m *WatchPipelineStatusResponse) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -3405,17 +15463,54 @@ func (m *GetPipelineStatusResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetPipelineStatusResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: WatchPipelineStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetPipelineStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WatchPipelineStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Status == nil {
+				m.Status = &PipelineStatus{}
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDaemon
@@ -3425,29 +15520,26 @@ func (m *GetPipelineStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDaemon
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDaemon
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Status == nil {
-				m.Status = &PipelineStatus{}
-			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Cursor = &s
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000001)
+			hasFields[0] |= uint64(0x00000002)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDaemon(dAtA[iNdEx:])
@@ -3467,13 +15559,16 @@ func (m *GetPipelineStatusResponse) Unmarshal(dAtA []byte) error {
 	if hasFields[0]&uint64(0x00000001) == 0 {
 		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("status")
 	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("cursor")
+	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *GetVertexMetricsRequest) Unmarshal(dAtA []byte) error {
+func (m *WatchBuffersRequest) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -3497,13 +15592,13 @@ func (m *GetVertexMetricsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetVertexMetricsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: WatchBuffersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetVertexMetricsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WatchBuffersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
@@ -3537,9 +15632,9 @@ func (m *GetVertexMetricsRequest) Unmarshal(dAtA []byte) error {
 			m.Pipeline = &s
 			iNdEx = postIndex
 			hasFields[0] |= uint64(0x00000001)
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vertex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3568,9 +15663,8 @@ func (m *GetVertexMetricsRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Vertex = &s
+			m.Cursor = &s
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000002)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDaemon(dAtA[iNdEx:])
@@ -3590,16 +15684,14 @@ func (m *GetVertexMetricsRequest) Unmarshal(dAtA []byte) error {
 	if hasFields[0]&uint64(0x00000001) == 0 {
 		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("pipeline")
 	}
-	if hasFields[0]&uint64(0x00000002) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("vertex")
-	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *GetVertexMetricsResponse) Unmarshal(dAtA []byte) error {
+func (m *WatchBuffersResponse) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3622,15 +15714,15 @@ func (m *GetVertexMetricsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetVertexMetricsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: WatchBuffersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetVertexMetricsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WatchBuffersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VertexMetrics", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Buffers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3657,11 +15749,45 @@ func (m *GetVertexMetricsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VertexMetrics = append(m.VertexMetrics, &VertexMetrics{})
-			if err := m.VertexMetrics[len(m.VertexMetrics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Buffers = append(m.Buffers, &BufferInfo{})
+			if err := m.Buffers[len(m.Buffers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Cursor = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDaemon(dAtA[iNdEx:])
@@ -3678,13 +15804,16 @@ func (m *GetVertexMetricsResponse) Unmarshal(dAtA []byte) error {
 			iNdEx += skippy
 		}
 	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("cursor")
+	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *EdgeWatermark) Unmarshal(dAtA []byte) error {
+func (m *WatchVertexMetricsRequest) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -3708,10 +15837,10 @@ func (m *EdgeWatermark) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EdgeWatermark: wiretype end group for non-group")
+			return fmt.Errorf("proto: WatchVertexMetricsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EdgeWatermark: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WatchVertexMetricsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3750,7 +15879,7 @@ func (m *EdgeWatermark) Unmarshal(dAtA []byte) error {
 			hasFields[0] |= uint64(0x00000001)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Edge", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Vertex", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3779,90 +15908,14 @@ func (m *EdgeWatermark) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Edge = &s
+			m.Vertex = &s
 			iNdEx = postIndex
 			hasFields[0] |= uint64(0x00000002)
 		case 3:
-			if wireType == 0 {
-				var v int64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowDaemon
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Watermarks = append(m.Watermarks, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowDaemon
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthDaemon
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthDaemon
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Watermarks) == 0 {
-					m.Watermarks = make([]int64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowDaemon
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Watermarks = append(m.Watermarks, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Watermarks", wireType)
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsWatermarkEnabled", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDaemon
@@ -3872,14 +15925,25 @@ func (m *EdgeWatermark) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.IsWatermarkEnabled = &b
-			hasFields[0] |= uint64(0x00000004)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Cursor = &s
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDaemon(dAtA[iNdEx:])
@@ -3900,10 +15964,7 @@ func (m *EdgeWatermark) Unmarshal(dAtA []byte) error {
 		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("pipeline")
 	}
 	if hasFields[0]&uint64(0x00000002) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("edge")
-	}
-	if hasFields[0]&uint64(0x00000004) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("isWatermarkEnabled")
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("vertex")
 	}
 
 	if iNdEx > l {
@@ -3911,7 +15972,8 @@ func (m *EdgeWatermark) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetPipelineWatermarksResponse) Unmarshal(dAtA []byte) error {
+func (m *WatchVertexMetricsResponse) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3934,15 +15996,15 @@ func (m *GetPipelineWatermarksResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetPipelineWatermarksResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: WatchVertexMetricsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetPipelineWatermarksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WatchVertexMetricsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PipelineWatermarks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VertexMetrics", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3969,66 +16031,14 @@ func (m *GetPipelineWatermarksResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PipelineWatermarks = append(m.PipelineWatermarks, &EdgeWatermark{})
-			if err := m.PipelineWatermarks[len(m.PipelineWatermarks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.VertexMetrics = append(m.VertexMetrics, &VertexMetrics{})
+			if err := m.VertexMetrics[len(m.VertexMetrics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDaemon(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthDaemon
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetPipelineWatermarksRequest) Unmarshal(dAtA []byte) error {
-	var hasFields [1]uint64
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDaemon
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetPipelineWatermarksRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetPipelineWatermarksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4057,7 +16067,7 @@ func (m *GetPipelineWatermarksRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Pipeline = &s
+			m.Cursor = &s
 			iNdEx = postIndex
 			hasFields[0] |= uint64(0x00000001)
 		default:
@@ -4077,7 +16087,7 @@ func (m *GetPipelineWatermarksRequest) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("pipeline")
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("cursor")
 	}
 
 	if iNdEx > l {
@@ -0,0 +1,663 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: pkg/apis/proto/trigger/trigger.proto
+
+package trigger
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// FlushRequest contains the keys to be flushed.
+type FlushRequest struct {
+	Keys                 []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FlushRequest) Reset()         { *m = FlushRequest{} }
+func (m *FlushRequest) String() string { return proto.CompactTextString(m) }
+func (*FlushRequest) ProtoMessage()    {}
+func (*FlushRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e69bca03343f398, []int{0}
+}
+func (m *FlushRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FlushRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FlushRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FlushRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FlushRequest.Merge(m, src)
+}
+func (m *FlushRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *FlushRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FlushRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FlushRequest proto.InternalMessageInfo
+
+func (m *FlushRequest) GetKeys() []string {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+// ReadyResponse is the health check result.
+type ReadyResponse struct {
+	Ready                bool     `protobuf:"varint,1,opt,name=ready,proto3" json:"ready,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReadyResponse) Reset()         { *m = ReadyResponse{} }
+func (m *ReadyResponse) String() string { return proto.CompactTextString(m) }
+func (*ReadyResponse) ProtoMessage()    {}
+func (*ReadyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e69bca03343f398, []int{1}
+}
+func (m *ReadyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReadyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReadyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReadyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadyResponse.Merge(m, src)
+}
+func (m *ReadyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReadyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadyResponse proto.InternalMessageInfo
+
+func (m *ReadyResponse) GetReady() bool {
+	if m != nil {
+		return m.Ready
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*FlushRequest)(nil), "trigger.FlushRequest")
+	proto.RegisterType((*ReadyResponse)(nil), "trigger.ReadyResponse")
+}
+
+func init() {
+	proto.RegisterFile("pkg/apis/proto/trigger/trigger.proto", fileDescriptor_2e69bca03343f398)
+}
+
+var fileDescriptor_2e69bca03343f398 = []byte{
+	// 249 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x29, 0xc8, 0x4e, 0xd7,
+	0x4f, 0x2c, 0xc8, 0x2c, 0xd6, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0xd7, 0x2f, 0x29, 0xca, 0x4c, 0x4f,
+	0x4f, 0x2d, 0x82, 0xd1, 0x7a, 0x60, 0x51, 0x21, 0x76, 0x28, 0x57, 0x4a, 0x3a, 0x3d, 0x3f, 0x3f,
+	0x3d, 0x27, 0x15, 0xa2, 0x38, 0xa9, 0x34, 0x4d, 0x3f, 0x35, 0xb7, 0xa0, 0xa4, 0x12, 0xa2, 0x4a,
+	0x49, 0x89, 0x8b, 0xc7, 0x2d, 0xa7, 0xb4, 0x38, 0x23, 0x28, 0xb5, 0xb0, 0x34, 0xb5, 0xb8, 0x44,
+	0x48, 0x88, 0x8b, 0x25, 0x3b, 0xb5, 0xb2, 0x58, 0x82, 0x51, 0x81, 0x59, 0x83, 0x33, 0x08, 0xcc,
+	0x56, 0x52, 0xe5, 0xe2, 0x0d, 0x4a, 0x4d, 0x4c, 0xa9, 0x0c, 0x4a, 0x2d, 0x2e, 0xc8, 0xcf, 0x2b,
+	0x4e, 0x15, 0x12, 0xe1, 0x62, 0x2d, 0x02, 0x09, 0x48, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x04, 0x41,
+	0x38, 0x46, 0xad, 0x8c, 0x5c, 0xec, 0x21, 0x10, 0x3b, 0x85, 0xec, 0xb9, 0x78, 0xc2, 0x13, 0x4b,
+	0x92, 0x33, 0xc0, 0x66, 0xa7, 0x16, 0x0b, 0x89, 0xe9, 0x41, 0x1c, 0xa1, 0x07, 0x73, 0x84, 0x9e,
+	0x2b, 0xc8, 0x11, 0x52, 0xa2, 0x7a, 0x30, 0x47, 0x23, 0xbb, 0xc2, 0x80, 0x51, 0xc8, 0x92, 0x8b,
+	0xdd, 0xb3, 0x18, 0x6c, 0x2b, 0x4e, 0xbd, 0x62, 0x70, 0xbd, 0x28, 0xae, 0x73, 0x72, 0x3c, 0xf1,
+	0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0xa3, 0x8c, 0xd3, 0x33, 0x4b,
+	0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0xf3, 0x4a, 0x73, 0x13, 0x0b, 0x8a, 0xf2, 0xb3,
+	0xc0, 0x8c, 0xb4, 0x9c, 0xfc, 0x72, 0x7d, 0xec, 0x21, 0x99, 0xc4, 0x06, 0xe6, 0x1a, 0x03, 0x06,
+	0x00, 0xec, 0xff, 0x94, 0x4c, 0x6a, 0x01, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// TriggerClient is the client API for Trigger service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type TriggerClient interface {
+	// WatchFlushes streams the flushes requested by the user container.
+	WatchFlushes(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (Trigger_WatchFlushesClient, error)
+	// IsReady is the heartbeat endpoint for gRPC.
+	IsReady(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ReadyResponse, error)
+}
+
+type triggerClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewTriggerClient(cc *grpc.ClientConn) TriggerClient {
+	return &triggerClient{cc}
+}
+
+func (c *triggerClient) WatchFlushes(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (Trigger_WatchFlushesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Trigger_serviceDesc.Streams[0], "/trigger.Trigger/WatchFlushes", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &triggerWatchFlushesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Trigger_WatchFlushesClient interface {
+	Recv() (*FlushRequest, error)
+	grpc.ClientStream
+}
+
+type triggerWatchFlushesClient struct {
+	grpc.ClientStream
+}
+
+func (x *triggerWatchFlushesClient) Recv() (*FlushRequest, error) {
+	m := new(FlushRequest)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *triggerClient) IsReady(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ReadyResponse, error) {
+	out := new(ReadyResponse)
+	err := c.cc.Invoke(ctx, "/trigger.Trigger/IsReady", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TriggerServer is the server API for Trigger service.
+type TriggerServer interface {
+	// WatchFlushes streams the flushes requested by the user container.
+	WatchFlushes(*emptypb.Empty, Trigger_WatchFlushesServer) error
+	// IsReady is the heartbeat endpoint for gRPC.
+	IsReady(context.Context, *emptypb.Empty) (*ReadyResponse, error)
+}
+
+// UnimplementedTriggerServer can be embedded to have forward compatible implementations.
+type UnimplementedTriggerServer struct {
+}
+
+func (*UnimplementedTriggerServer) WatchFlushes(req *emptypb.Empty, srv Trigger_WatchFlushesServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchFlushes not implemented")
+}
+func (*UnimplementedTriggerServer) IsReady(ctx context.Context, req *emptypb.Empty) (*ReadyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsReady not implemented")
+}
+
+func RegisterTriggerServer(s *grpc.Server, srv TriggerServer) {
+	s.RegisterService(&_Trigger_serviceDesc, srv)
+}
+
+func _Trigger_WatchFlushes_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TriggerServer).WatchFlushes(m, &triggerWatchFlushesServer{stream})
+}
+
+type Trigger_WatchFlushesServer interface {
+	Send(*FlushRequest) error
+	grpc.ServerStream
+}
+
+type triggerWatchFlushesServer struct {
+	grpc.ServerStream
+}
+
+func (x *triggerWatchFlushesServer) Send(m *FlushRequest) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Trigger_IsReady_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TriggerServer).IsReady(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/trigger.Trigger/IsReady",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TriggerServer).IsReady(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Trigger_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "trigger.Trigger",
+	HandlerType: (*TriggerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "IsReady",
+			Handler:    _Trigger_IsReady_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchFlushes",
+			Handler:       _Trigger_WatchFlushes_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "pkg/apis/proto/trigger/trigger.proto",
+}
+
+func (m *FlushRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FlushRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FlushRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Keys[iNdEx])
+			copy(dAtA[i:], m.Keys[iNdEx])
+			i = encodeVarintTrigger(dAtA, i, uint64(len(m.Keys[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReadyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReadyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReadyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Ready {
+		i--
+		if m.Ready {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTrigger(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTrigger(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *FlushRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Keys) > 0 {
+		for _, s := range m.Keys {
+			l = len(s)
+			n += 1 + l + sovTrigger(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReadyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Ready {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovTrigger(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTrigger(x uint64) (n int) {
+	return sovTrigger(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FlushRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrigger
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FlushRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FlushRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrigger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keys = append(m.Keys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrigger(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReadyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrigger
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReadyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReadyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ready", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrigger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Ready = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrigger(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTrigger(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTrigger
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTrigger
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTrigger
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTrigger
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTrigger
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTrigger
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTrigger        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTrigger          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTrigger = fmt.Errorf("proto: unexpected end of group")
+)
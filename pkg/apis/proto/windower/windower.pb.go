@@ -0,0 +1,1692 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: pkg/apis/proto/windower/windower.proto
+
+package windower
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Window is a time range, left inclusive and right exclusive, in milliseconds since epoch.
+type Window struct {
+	Start                int64    `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	End                  int64    `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Window) Reset()         { *m = Window{} }
+func (m *Window) String() string { return proto.CompactTextString(m) }
+func (*Window) ProtoMessage()    {}
+func (*Window) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e83331503d82f19, []int{0}
+}
+func (m *Window) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Window) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Window.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Window) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Window.Merge(m, src)
+}
+func (m *Window) XXX_Size() int {
+	return m.Size()
+}
+func (m *Window) XXX_DiscardUnknown() {
+	xxx_messageInfo_Window.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Window proto.InternalMessageInfo
+
+func (m *Window) GetStart() int64 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *Window) GetEnd() int64 {
+	if m != nil {
+		return m.End
+	}
+	return 0
+}
+
+// AssignWindowsRequest contains the keys and the event time of a message.
+type AssignWindowsRequest struct {
+	Keys                 []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	EventTime            int64    `protobuf:"varint,2,opt,name=event_time,json=eventTime,proto3" json:"event_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AssignWindowsRequest) Reset()         { *m = AssignWindowsRequest{} }
+func (m *AssignWindowsRequest) String() string { return proto.CompactTextString(m) }
+func (*AssignWindowsRequest) ProtoMessage()    {}
+func (*AssignWindowsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e83331503d82f19, []int{1}
+}
+func (m *AssignWindowsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AssignWindowsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AssignWindowsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AssignWindowsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssignWindowsRequest.Merge(m, src)
+}
+func (m *AssignWindowsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AssignWindowsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssignWindowsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AssignWindowsRequest proto.InternalMessageInfo
+
+func (m *AssignWindowsRequest) GetKeys() []string {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+func (m *AssignWindowsRequest) GetEventTime() int64 {
+	if m != nil {
+		return m.EventTime
+	}
+	return 0
+}
+
+// AssignWindowsResponse contains the windows a message belongs to.
+type AssignWindowsResponse struct {
+	Windows              []*Window `protobuf:"bytes,1,rep,name=windows,proto3" json:"windows,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *AssignWindowsResponse) Reset()         { *m = AssignWindowsResponse{} }
+func (m *AssignWindowsResponse) String() string { return proto.CompactTextString(m) }
+func (*AssignWindowsResponse) ProtoMessage()    {}
+func (*AssignWindowsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e83331503d82f19, []int{2}
+}
+func (m *AssignWindowsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AssignWindowsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AssignWindowsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AssignWindowsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssignWindowsResponse.Merge(m, src)
+}
+func (m *AssignWindowsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AssignWindowsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssignWindowsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AssignWindowsResponse proto.InternalMessageInfo
+
+func (m *AssignWindowsResponse) GetWindows() []*Window {
+	if m != nil {
+		return m.Windows
+	}
+	return nil
+}
+
+// MergeWindowsRequest contains a newly assigned window, and the active windows of the same keys overlapping with it
+// sorted by the start time.
+type MergeWindowsRequest struct {
+	Window               *Window   `protobuf:"bytes,1,opt,name=window,proto3" json:"window,omitempty"`
+	ActiveWindows        []*Window `protobuf:"bytes,2,rep,name=active_windows,json=activeWindows,proto3" json:"active_windows,omitempty"`
+	Keys                 []string  `protobuf:"bytes,3,rep,name=keys,proto3" json:"keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *MergeWindowsRequest) Reset()         { *m = MergeWindowsRequest{} }
+func (m *MergeWindowsRequest) String() string { return proto.CompactTextString(m) }
+func (*MergeWindowsRequest) ProtoMessage()    {}
+func (*MergeWindowsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e83331503d82f19, []int{3}
+}
+func (m *MergeWindowsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MergeWindowsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MergeWindowsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MergeWindowsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MergeWindowsRequest.Merge(m, src)
+}
+func (m *MergeWindowsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MergeWindowsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MergeWindowsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MergeWindowsRequest proto.InternalMessageInfo
+
+func (m *MergeWindowsRequest) GetWindow() *Window {
+	if m != nil {
+		return m.Window
+	}
+	return nil
+}
+
+func (m *MergeWindowsRequest) GetActiveWindows() []*Window {
+	if m != nil {
+		return m.ActiveWindows
+	}
+	return nil
+}
+
+func (m *MergeWindowsRequest) GetKeys() []string {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+// MergeWindowsResponse contains the result of the merge. If merged is false, the assigned window is used as it is.
+// Otherwise, the assigned window is merged into the active window at active_index, the active window keeps its start
+// time, and its end time is extended to the end of the merged window if it's later.
+type MergeWindowsResponse struct {
+	Merged               bool     `protobuf:"varint,1,opt,name=merged,proto3" json:"merged,omitempty"`
+	ActiveIndex          int32    `protobuf:"varint,2,opt,name=active_index,json=activeIndex,proto3" json:"active_index,omitempty"`
+	MergedWindow         *Window  `protobuf:"bytes,3,opt,name=merged_window,json=mergedWindow,proto3" json:"merged_window,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MergeWindowsResponse) Reset()         { *m = MergeWindowsResponse{} }
+func (m *MergeWindowsResponse) String() string { return proto.CompactTextString(m) }
+func (*MergeWindowsResponse) ProtoMessage()    {}
+func (*MergeWindowsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e83331503d82f19, []int{4}
+}
+func (m *MergeWindowsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MergeWindowsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MergeWindowsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MergeWindowsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MergeWindowsResponse.Merge(m, src)
+}
+func (m *MergeWindowsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MergeWindowsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MergeWindowsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MergeWindowsResponse proto.InternalMessageInfo
+
+func (m *MergeWindowsResponse) GetMerged() bool {
+	if m != nil {
+		return m.Merged
+	}
+	return false
+}
+
+func (m *MergeWindowsResponse) GetActiveIndex() int32 {
+	if m != nil {
+		return m.ActiveIndex
+	}
+	return 0
+}
+
+func (m *MergeWindowsResponse) GetMergedWindow() *Window {
+	if m != nil {
+		return m.MergedWindow
+	}
+	return nil
+}
+
+// ReadyResponse is the health check result.
+type ReadyResponse struct {
+	Ready                bool     `protobuf:"varint,1,opt,name=ready,proto3" json:"ready,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReadyResponse) Reset()         { *m = ReadyResponse{} }
+func (m *ReadyResponse) String() string { return proto.CompactTextString(m) }
+func (*ReadyResponse) ProtoMessage()    {}
+func (*ReadyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e83331503d82f19, []int{5}
+}
+func (m *ReadyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReadyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReadyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReadyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadyResponse.Merge(m, src)
+}
+func (m *ReadyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReadyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadyResponse proto.InternalMessageInfo
+
+func (m *ReadyResponse) GetReady() bool {
+	if m != nil {
+		return m.Ready
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*Window)(nil), "windower.Window")
+	proto.RegisterType((*AssignWindowsRequest)(nil), "windower.AssignWindowsRequest")
+	proto.RegisterType((*AssignWindowsResponse)(nil), "windower.AssignWindowsResponse")
+	proto.RegisterType((*MergeWindowsRequest)(nil), "windower.MergeWindowsRequest")
+	proto.RegisterType((*MergeWindowsResponse)(nil), "windower.MergeWindowsResponse")
+	proto.RegisterType((*ReadyResponse)(nil), "windower.ReadyResponse")
+}
+
+func init() {
+	proto.RegisterFile("pkg/apis/proto/windower/windower.proto", fileDescriptor_5e83331503d82f19)
+}
+
+var fileDescriptor_5e83331503d82f19 = []byte{
+	// 444 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0xd5, 0xd6, 0x24, 0x4d, 0xa7, 0x09, 0xaa, 0x16, 0x53, 0x2c, 0xa3, 0x9a, 0x62, 0x09, 0x14,
+	0x71, 0xb0, 0x51, 0x01, 0x21, 0x71, 0xa3, 0x88, 0x43, 0x0e, 0x95, 0x90, 0x85, 0x54, 0x89, 0x4b,
+	0xe5, 0xd4, 0x53, 0xb3, 0xb4, 0xf6, 0x1a, 0xef, 0xba, 0x21, 0x7f, 0xc0, 0x81, 0x0f, 0xe3, 0xc8,
+	0x27, 0xa0, 0x5c, 0xf8, 0x0d, 0xe4, 0xdd, 0xb5, 0xdd, 0x44, 0xf1, 0x6d, 0xe6, 0xed, 0xf3, 0x9b,
+	0xf7, 0x76, 0xc7, 0xf0, 0xbc, 0xb8, 0x4e, 0xc3, 0xb8, 0x60, 0x22, 0x2c, 0x4a, 0x2e, 0x79, 0xb8,
+	0x60, 0x79, 0xc2, 0x17, 0x58, 0xb6, 0x45, 0xa0, 0x70, 0x3a, 0x6a, 0x7a, 0xf7, 0x71, 0xca, 0x79,
+	0x7a, 0x83, 0x9a, 0x3f, 0xaf, 0xae, 0x42, 0xcc, 0x0a, 0xb9, 0xd4, 0x34, 0xff, 0x25, 0x0c, 0xcf,
+	0x15, 0x91, 0xda, 0x30, 0x10, 0x32, 0x2e, 0xa5, 0x43, 0x8e, 0xc9, 0xd4, 0x8a, 0x74, 0x43, 0x0f,
+	0xc0, 0xc2, 0x3c, 0x71, 0x76, 0x14, 0x56, 0x97, 0xfe, 0x0c, 0xec, 0xf7, 0x42, 0xb0, 0x34, 0xd7,
+	0xdf, 0x89, 0x08, 0xbf, 0x57, 0x28, 0x24, 0xa5, 0x70, 0xef, 0x1a, 0x97, 0xc2, 0x21, 0xc7, 0xd6,
+	0x74, 0x2f, 0x52, 0x35, 0x3d, 0x02, 0xc0, 0x5b, 0xcc, 0xe5, 0x85, 0x64, 0x19, 0x1a, 0x91, 0x3d,
+	0x85, 0x7c, 0x66, 0x19, 0xfa, 0x1f, 0xe0, 0xe1, 0x86, 0x94, 0x28, 0x78, 0x2e, 0x90, 0xbe, 0x80,
+	0x5d, 0x6d, 0x5f, 0xcb, 0xed, 0x9f, 0x1c, 0x04, 0x6d, 0x3c, 0xcd, 0x8d, 0x1a, 0x82, 0xff, 0x8b,
+	0xc0, 0x83, 0x33, 0x2c, 0x53, 0xdc, 0xf0, 0x33, 0x85, 0xa1, 0xa6, 0xa8, 0x40, 0xdb, 0x24, 0xcc,
+	0x39, 0x7d, 0x0b, 0xf7, 0xe3, 0x4b, 0xc9, 0x6e, 0xf1, 0xa2, 0x19, 0xba, 0xd3, 0x33, 0x74, 0xa2,
+	0x79, 0x66, 0x52, 0x1b, 0xd9, 0xea, 0x22, 0xfb, 0x3f, 0x09, 0xd8, 0xeb, 0x76, 0x4c, 0xa6, 0x43,
+	0x18, 0x66, 0x35, 0x9e, 0x28, 0x3f, 0xa3, 0xc8, 0x74, 0xf4, 0x29, 0x8c, 0xcd, 0x74, 0x96, 0x27,
+	0xf8, 0x43, 0xdd, 0xd2, 0x20, 0xda, 0xd7, 0xd8, 0xac, 0x86, 0xe8, 0x1b, 0x98, 0x68, 0xb2, 0x31,
+	0xe8, 0x58, 0x3d, 0x89, 0xc6, 0x9a, 0xa6, 0x3b, 0xff, 0x19, 0x4c, 0x22, 0x8c, 0x93, 0x65, 0x6b,
+	0xc1, 0x86, 0x41, 0x59, 0x03, 0xc6, 0x81, 0x6e, 0x4e, 0xfe, 0x11, 0x18, 0x9d, 0x1b, 0x21, 0xfa,
+	0x09, 0x26, 0x6b, 0x4f, 0x42, 0xbd, 0x6e, 0xc8, 0xb6, 0x67, 0x77, 0x9f, 0xf4, 0x9e, 0x9b, 0xa1,
+	0x67, 0x30, 0xbe, 0x7b, 0x1f, 0xf4, 0xa8, 0xfb, 0x60, 0xcb, 0xb3, 0xb9, 0x5e, 0xdf, 0xb1, 0x91,
+	0x7b, 0x07, 0xbb, 0x33, 0xa1, 0x62, 0xd1, 0xc3, 0x40, 0x6f, 0x76, 0xd0, 0x6c, 0x76, 0xf0, 0xb1,
+	0xde, 0x6c, 0xf7, 0x51, 0x27, 0xb1, 0x96, 0xff, 0xf4, 0xf4, 0xf7, 0xca, 0x23, 0x7f, 0x56, 0x1e,
+	0xf9, 0xbb, 0xf2, 0xc8, 0x97, 0xd7, 0x29, 0x93, 0x5f, 0xab, 0x79, 0x70, 0xc9, 0xb3, 0x30, 0xaf,
+	0xb2, 0xb8, 0x28, 0xf9, 0x37, 0x55, 0x5c, 0xdd, 0xf0, 0x45, 0xd8, 0xf3, 0x9b, 0xcd, 0x87, 0xaa,
+	0x7f, 0xf5, 0x7f, 0x00, 0xee, 0x75, 0x68, 0x45, 0x88, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// WindowerClient is the client API for Windower service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type WindowerClient interface {
+	// AssignWindows returns the windows a message belongs to.
+	AssignWindows(ctx context.Context, in *AssignWindowsRequest, opts ...grpc.CallOption) (*AssignWindowsResponse, error)
+	// MergeWindows merges the newly assigned windows with the active windows overlapping with them.
+	MergeWindows(ctx context.Context, in *MergeWindowsRequest, opts ...grpc.CallOption) (*MergeWindowsResponse, error)
+	// IsReady is the heartbeat endpoint for gRPC.
+	IsReady(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ReadyResponse, error)
+}
+
+type windowerClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewWindowerClient(cc *grpc.ClientConn) WindowerClient {
+	return &windowerClient{cc}
+}
+
+func (c *windowerClient) AssignWindows(ctx context.Context, in *AssignWindowsRequest, opts ...grpc.CallOption) (*AssignWindowsResponse, error) {
+	out := new(AssignWindowsResponse)
+	err := c.cc.Invoke(ctx, "/windower.Windower/AssignWindows", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *windowerClient) MergeWindows(ctx context.Context, in *MergeWindowsRequest, opts ...grpc.CallOption) (*MergeWindowsResponse, error) {
+	out := new(MergeWindowsResponse)
+	err := c.cc.Invoke(ctx, "/windower.Windower/MergeWindows", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *windowerClient) IsReady(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ReadyResponse, error) {
+	out := new(ReadyResponse)
+	err := c.cc.Invoke(ctx, "/windower.Windower/IsReady", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WindowerServer is the server API for Windower service.
+type WindowerServer interface {
+	// AssignWindows returns the windows a message belongs to.
+	AssignWindows(context.Context, *AssignWindowsRequest) (*AssignWindowsResponse, error)
+	// MergeWindows merges the newly assigned windows with the active windows overlapping with them.
+	MergeWindows(context.Context, *MergeWindowsRequest) (*MergeWindowsResponse, error)
+	// IsReady is the heartbeat endpoint for gRPC.
+	IsReady(context.Context, *emptypb.Empty) (*ReadyResponse, error)
+}
+
+// UnimplementedWindowerServer can be embedded to have forward compatible implementations.
+type UnimplementedWindowerServer struct {
+}
+
+func (*UnimplementedWindowerServer) AssignWindows(ctx context.Context, req *AssignWindowsRequest) (*AssignWindowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignWindows not implemented")
+}
+func (*UnimplementedWindowerServer) MergeWindows(ctx context.Context, req *MergeWindowsRequest) (*MergeWindowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeWindows not implemented")
+}
+func (*UnimplementedWindowerServer) IsReady(ctx context.Context, req *emptypb.Empty) (*ReadyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsReady not implemented")
+}
+
+func RegisterWindowerServer(s *grpc.Server, srv WindowerServer) {
+	s.RegisterService(&_Windower_serviceDesc, srv)
+}
+
+func _Windower_AssignWindows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignWindowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WindowerServer).AssignWindows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/windower.Windower/AssignWindows",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WindowerServer).AssignWindows(ctx, req.(*AssignWindowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Windower_MergeWindows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeWindowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WindowerServer).MergeWindows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/windower.Windower/MergeWindows",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WindowerServer).MergeWindows(ctx, req.(*MergeWindowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Windower_IsReady_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WindowerServer).IsReady(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/windower.Windower/IsReady",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WindowerServer).IsReady(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Windower_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "windower.Windower",
+	HandlerType: (*WindowerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AssignWindows",
+			Handler:    _Windower_AssignWindows_Handler,
+		},
+		{
+			MethodName: "MergeWindows",
+			Handler:    _Windower_MergeWindows_Handler,
+		},
+		{
+			MethodName: "IsReady",
+			Handler:    _Windower_IsReady_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/apis/proto/windower/windower.proto",
+}
+
+func (m *Window) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Window) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Window) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.End != 0 {
+		i = encodeVarintWindower(dAtA, i, uint64(m.End))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Start != 0 {
+		i = encodeVarintWindower(dAtA, i, uint64(m.Start))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AssignWindowsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AssignWindowsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssignWindowsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.EventTime != 0 {
+		i = encodeVarintWindower(dAtA, i, uint64(m.EventTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Keys[iNdEx])
+			copy(dAtA[i:], m.Keys[iNdEx])
+			i = encodeVarintWindower(dAtA, i, uint64(len(m.Keys[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AssignWindowsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AssignWindowsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssignWindowsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Windows) > 0 {
+		for iNdEx := len(m.Windows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Windows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintWindower(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MergeWindowsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MergeWindowsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MergeWindowsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Keys[iNdEx])
+			copy(dAtA[i:], m.Keys[iNdEx])
+			i = encodeVarintWindower(dAtA, i, uint64(len(m.Keys[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ActiveWindows) > 0 {
+		for iNdEx := len(m.ActiveWindows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ActiveWindows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintWindower(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Window != nil {
+		{
+			size, err := m.Window.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWindower(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MergeWindowsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MergeWindowsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MergeWindowsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.MergedWindow != nil {
+		{
+			size, err := m.MergedWindow.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWindower(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ActiveIndex != 0 {
+		i = encodeVarintWindower(dAtA, i, uint64(m.ActiveIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Merged {
+		i--
+		if m.Merged {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReadyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReadyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReadyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Ready {
+		i--
+		if m.Ready {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintWindower(dAtA []byte, offset int, v uint64) int {
+	offset -= sovWindower(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Window) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Start != 0 {
+		n += 1 + sovWindower(uint64(m.Start))
+	}
+	if m.End != 0 {
+		n += 1 + sovWindower(uint64(m.End))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AssignWindowsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Keys) > 0 {
+		for _, s := range m.Keys {
+			l = len(s)
+			n += 1 + l + sovWindower(uint64(l))
+		}
+	}
+	if m.EventTime != 0 {
+		n += 1 + sovWindower(uint64(m.EventTime))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AssignWindowsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Windows) > 0 {
+		for _, e := range m.Windows {
+			l = e.Size()
+			n += 1 + l + sovWindower(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MergeWindowsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Window != nil {
+		l = m.Window.Size()
+		n += 1 + l + sovWindower(uint64(l))
+	}
+	if len(m.ActiveWindows) > 0 {
+		for _, e := range m.ActiveWindows {
+			l = e.Size()
+			n += 1 + l + sovWindower(uint64(l))
+		}
+	}
+	if len(m.Keys) > 0 {
+		for _, s := range m.Keys {
+			l = len(s)
+			n += 1 + l + sovWindower(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MergeWindowsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Merged {
+		n += 2
+	}
+	if m.ActiveIndex != 0 {
+		n += 1 + sovWindower(uint64(m.ActiveIndex))
+	}
+	if m.MergedWindow != nil {
+		l = m.MergedWindow.Size()
+		n += 1 + l + sovWindower(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReadyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Ready {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovWindower(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozWindower(x uint64) (n int) {
+	return sovWindower(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Window) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWindower
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Window: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Window: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			m.Start = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWindower
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Start |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			m.End = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWindower
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.End |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWindower(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWindower
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AssignWindowsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWindower
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssignWindowsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssignWindowsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWindower
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWindower
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWindower
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keys = append(m.Keys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventTime", wireType)
+			}
+			m.EventTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWindower
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWindower(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWindower
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AssignWindowsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWindower
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssignWindowsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssignWindowsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Windows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWindower
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWindower
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWindower
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Windows = append(m.Windows, &Window{})
+			if err := m.Windows[len(m.Windows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWindower(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWindower
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MergeWindowsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWindower
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MergeWindowsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MergeWindowsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWindower
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWindower
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWindower
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Window == nil {
+				m.Window = &Window{}
+			}
+			if err := m.Window.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveWindows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWindower
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWindower
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWindower
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActiveWindows = append(m.ActiveWindows, &Window{})
+			if err := m.ActiveWindows[len(m.ActiveWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWindower
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWindower
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWindower
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keys = append(m.Keys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWindower(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWindower
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MergeWindowsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWindower
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MergeWindowsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MergeWindowsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Merged", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWindower
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Merged = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveIndex", wireType)
+			}
+			m.ActiveIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWindower
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActiveIndex |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MergedWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWindower
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWindower
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWindower
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MergedWindow == nil {
+				m.MergedWindow = &Window{}
+			}
+			if err := m.MergedWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWindower(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWindower
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReadyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWindower
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReadyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReadyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ready", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWindower
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Ready = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWindower(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWindower
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipWindower(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowWindower
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowWindower
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowWindower
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthWindower
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupWindower
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthWindower
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthWindower        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowWindower          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupWindower = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,31 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package builder provides a fluent API to construct Pipeline specs in Go, for the pipelines generated from code
+// rather than written in YAML.
+//
+// The vertices are added with typed handles, an edge can only be defined from a source, map or reduce vertex to a
+// map, reduce or sink vertex, and a reduce vertex can only be added with exactly one window and one storage, so that
+// those mistakes are caught by the compiler. The rest is checked by Build() with the same validation as the webhook.
+//
+//	b := builder.NewPipeline("my-pipeline", builder.WithNamespace("default"))
+//	in := b.Source("in", dfv1.Source{Generator: &dfv1.GeneratorSource{}})
+//	count := b.Reduce("count", dfv1.Container{Image: "my-counter:latest"}, builder.FixedWindow(time.Minute), builder.EmptyDirStorage(), builder.Keyed())
+//	out := b.Sink("out", dfv1.Sink{Log: &dfv1.Log{}})
+//	b.Edge(in, count)
+//	b.Edge(count, out)
+//	pl, err := b.Build()
+package builder
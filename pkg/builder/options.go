@@ -0,0 +1,118 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+// PipelineOption customizes the pipeline.
+type PipelineOption func(*dfv1.Pipeline)
+
+// WithNamespace sets the namespace of the pipeline.
+func WithNamespace(namespace string) PipelineOption {
+	return func(pl *dfv1.Pipeline) {
+		pl.Namespace = namespace
+	}
+}
+
+// WithInterStepBufferService sets the name of the ISB Service of the pipeline.
+func WithInterStepBufferService(name string) PipelineOption {
+	return func(pl *dfv1.Pipeline) {
+		pl.Spec.InterStepBufferServiceName = name
+	}
+}
+
+// WithPipelineLimits sets the limits of the pipeline.
+func WithPipelineLimits(limits dfv1.PipelineLimits) PipelineOption {
+	return func(pl *dfv1.Pipeline) {
+		pl.Spec.Limits = &limits
+	}
+}
+
+// VertexOption customizes a vertex.
+type VertexOption func(*dfv1.AbstractVertex)
+
+// WithScale sets the autoscaling parameters of the vertex.
+func WithScale(scale dfv1.Scale) VertexOption {
+	return func(v *dfv1.AbstractVertex) {
+		v.Scale = scale
+	}
+}
+
+// WithLimits sets the limits of the vertex.
+func WithLimits(limits dfv1.VertexLimits) VertexOption {
+	return func(v *dfv1.AbstractVertex) {
+		v.Limits = &limits
+	}
+}
+
+// WithPartitions sets the number of the partitions of the vertex.
+func WithPartitions(partitions int32) VertexOption {
+	return func(v *dfv1.AbstractVertex) {
+		v.Partitions = &partitions
+	}
+}
+
+// Keyed makes a reduce vertex keyed, it has no effect on the other vertices.
+func Keyed() VertexOption {
+	return func(v *dfv1.AbstractVertex) {
+		if v.IsReduceUDF() {
+			v.UDF.GroupBy.Keyed = true
+		}
+	}
+}
+
+// WithAllowedLateness sets the allowed lateness of a reduce vertex, it has no effect on the other vertices.
+func WithAllowedLateness(d time.Duration) VertexOption {
+	return func(v *dfv1.AbstractVertex) {
+		if v.IsReduceUDF() {
+			v.UDF.GroupBy.AllowedLateness = &metav1.Duration{Duration: d}
+		}
+	}
+}
+
+// EdgeOption customizes an edge.
+type EdgeOption func(*dfv1.Edge)
+
+// WithTags forwards the messages to the edge only if their tags match the values with the operator.
+func WithTags(operator dfv1.LogicOperator, values ...string) EdgeOption {
+	return func(e *dfv1.Edge) {
+		if e.Conditions == nil {
+			e.Conditions = &dfv1.ForwardConditions{}
+		}
+		e.Conditions.Tags = &dfv1.TagConditions{Operator: &operator, Values: values}
+	}
+}
+
+// WithOnFull sets the behaviour of writing to the edge when its buffers are full.
+func WithOnFull(strategy dfv1.BufferFullWritingStrategy) EdgeOption {
+	return func(e *dfv1.Edge) {
+		e.OnFull = &strategy
+	}
+}
+
+// WithStrictFIFO makes the edge a strict FIFO one.
+func WithStrictFIFO() EdgeOption {
+	return func(e *dfv1.Edge) {
+		e.StrictFIFO = true
+	}
+}
@@ -0,0 +1,177 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/reconciler/pipeline"
+)
+
+// PipelineBuilder builds a Pipeline spec, it's not safe for concurrent use.
+type PipelineBuilder struct {
+	pipeline *dfv1.Pipeline
+	// errs are the errors found when adding the vertices and the edges, they are returned by Build().
+	errs []error
+}
+
+// NewPipeline returns a builder of the pipeline with the given name.
+func NewPipeline(name string, opts ...PipelineOption) *PipelineBuilder {
+	pl := &dfv1.Pipeline{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: dfv1.SchemeGroupVersion.String(),
+			Kind:       dfv1.PipelineGroupVersionKind.Kind,
+		},
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+	}
+	for _, o := range opts {
+		o(pl)
+	}
+	return &PipelineBuilder{pipeline: pl}
+}
+
+// vertex is the handle of a vertex added to a builder.
+type vertex struct {
+	builder *PipelineBuilder
+	name    string
+}
+
+// Name returns the name of the vertex.
+func (v vertex) Name() string {
+	return v.name
+}
+
+// From is a vertex which messages are forwarded from, i.e. a source, map or reduce vertex.
+type From interface {
+	Name() string
+	from() vertex
+}
+
+// To is a vertex which messages are forwarded to, i.e. a map, reduce or sink vertex.
+type To interface {
+	Name() string
+	to() vertex
+}
+
+// SourceVertex is the handle of a source vertex.
+type SourceVertex struct{ vertex }
+
+func (v *SourceVertex) from() vertex { return v.vertex }
+
+// MapVertex is the handle of a map vertex.
+type MapVertex struct{ vertex }
+
+func (v *MapVertex) from() vertex { return v.vertex }
+func (v *MapVertex) to() vertex   { return v.vertex }
+
+// ReduceVertex is the handle of a reduce vertex.
+type ReduceVertex struct{ vertex }
+
+func (v *ReduceVertex) from() vertex { return v.vertex }
+func (v *ReduceVertex) to() vertex   { return v.vertex }
+
+// SinkVertex is the handle of a sink vertex.
+type SinkVertex struct{ vertex }
+
+func (v *SinkVertex) to() vertex { return v.vertex }
+
+// Function is the function of a map vertex.
+type Function interface {
+	udf() dfv1.UDF
+}
+
+type function dfv1.UDF
+
+func (f function) udf() dfv1.UDF {
+	return dfv1.UDF(f)
+}
+
+// UserDefined returns a function run in the given user defined container.
+func UserDefined(c dfv1.Container) Function {
+	return function{Container: &c}
+}
+
+// Builtin returns a builtin function.
+func Builtin(f dfv1.Function) Function {
+	return function{Builtin: &f}
+}
+
+func (b *PipelineBuilder) addVertex(v dfv1.AbstractVertex, opts []VertexOption) vertex {
+	for _, o := range opts {
+		o(&v)
+	}
+	b.pipeline.Spec.Vertices = append(b.pipeline.Spec.Vertices, v)
+	return vertex{builder: b, name: v.Name}
+}
+
+// Source adds a source vertex.
+func (b *PipelineBuilder) Source(name string, src dfv1.Source, opts ...VertexOption) *SourceVertex {
+	return &SourceVertex{b.addVertex(dfv1.AbstractVertex{Name: name, Source: &src}, opts)}
+}
+
+// Map adds a map vertex.
+func (b *PipelineBuilder) Map(name string, fn Function, opts ...VertexOption) *MapVertex {
+	udf := fn.udf()
+	return &MapVertex{b.addVertex(dfv1.AbstractVertex{Name: name, UDF: &udf}, opts)}
+}
+
+// Reduce adds a reduce vertex, which runs the user defined container with the given window and storage.
+func (b *PipelineBuilder) Reduce(name string, c dfv1.Container, w Window, s Storage, opts ...VertexOption) *ReduceVertex {
+	storage := s.storage()
+	udf := dfv1.UDF{
+		Container: &c,
+		GroupBy: &dfv1.GroupBy{
+			Window:  w.window(),
+			Storage: &storage,
+		},
+	}
+	return &ReduceVertex{b.addVertex(dfv1.AbstractVertex{Name: name, UDF: &udf}, opts)}
+}
+
+// Sink adds a sink vertex.
+func (b *PipelineBuilder) Sink(name string, sink dfv1.Sink, opts ...VertexOption) *SinkVertex {
+	return &SinkVertex{b.addVertex(dfv1.AbstractVertex{Name: name, Sink: &sink}, opts)}
+}
+
+// Edge adds an edge between two vertices of the builder.
+func (b *PipelineBuilder) Edge(from From, to To, opts ...EdgeOption) *PipelineBuilder {
+	if from.from().builder != b || to.to().builder != b {
+		b.errs = append(b.errs, fmt.Errorf("invalid edge from %q to %q: the vertices are not added to the pipeline %q", from.Name(), to.Name(), b.pipeline.Name))
+		return b
+	}
+	e := dfv1.Edge{From: from.Name(), To: to.Name()}
+	for _, o := range opts {
+		o(&e)
+	}
+	b.pipeline.Spec.Edges = append(b.pipeline.Spec.Edges, e)
+	return b
+}
+
+// Build returns the Pipeline spec, or an error if it's invalid. The builder can still be used after it.
+func (b *PipelineBuilder) Build() (*dfv1.Pipeline, error) {
+	if len(b.errs) > 0 {
+		return nil, b.errs[0]
+	}
+	pl := b.pipeline.DeepCopy()
+	if err := pipeline.ValidatePipeline(pl); err != nil {
+		return nil, err
+	}
+	return pl, nil
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/pointer"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+func TestPipelineBuilder(t *testing.T) {
+	b := NewPipeline("my-pipeline", WithNamespace("my-ns"), WithInterStepBufferService("my-isbsvc"))
+	in := b.Source("in", dfv1.Source{Generator: &dfv1.GeneratorSource{}})
+	cat := b.Map("cat", Builtin(dfv1.Function{Name: "cat"}), WithLimits(dfv1.VertexLimits{UDFConcurrency: pointer.Uint32(4)}))
+	count := b.Reduce("count", dfv1.Container{Image: "my-counter:latest"}, FixedWindow(time.Minute), EmptyDirStorage(), Keyed(), WithPartitions(2))
+	out := b.Sink("out", dfv1.Sink{Log: &dfv1.Log{}}, WithScale(dfv1.Scale{Max: pointer.Int32(1)}))
+	pl, err := b.Edge(in, cat).Edge(cat, count, WithTags(dfv1.LogicOperatorOr, "valid")).Edge(count, out).Build()
+	assert.NoError(t, err)
+
+	assert.Equal(t, "numaflow.numaproj.io/v1alpha1", pl.APIVersion)
+	assert.Equal(t, "Pipeline", pl.Kind)
+	assert.Equal(t, "my-pipeline", pl.Name)
+	assert.Equal(t, "my-ns", pl.Namespace)
+	assert.Equal(t, "my-isbsvc", pl.Spec.InterStepBufferServiceName)
+	assert.Len(t, pl.Spec.Vertices, 4)
+	assert.Equal(t, "cat", pl.Spec.Vertices[1].UDF.Builtin.Name)
+	assert.Equal(t, uint32(4), *pl.Spec.Vertices[1].Limits.UDFConcurrency)
+	groupBy := pl.Spec.Vertices[2].UDF.GroupBy
+	assert.True(t, groupBy.Keyed)
+	assert.Equal(t, time.Minute, groupBy.Window.Fixed.Length.Duration)
+	assert.NotNil(t, groupBy.Storage.EmptyDir)
+	assert.Equal(t, 2, pl.Spec.Vertices[2].GetPartitionCount())
+	assert.Equal(t, int32(1), pl.Spec.Vertices[3].Scale.GetMaxReplicas())
+	assert.Equal(t, []dfv1.Edge{
+		{From: "in", To: "cat"},
+		{From: "cat", To: "count", Conditions: &dfv1.ForwardConditions{Tags: &dfv1.TagConditions{Operator: pl.Spec.Edges[1].Conditions.Tags.Operator, Values: []string{"valid"}}}},
+		{From: "count", To: "out"},
+	}, pl.Spec.Edges)
+	assert.Equal(t, dfv1.LogicOperatorOr, *pl.Spec.Edges[1].Conditions.Tags.Operator)
+
+	// the built pipeline is not changed by the builder afterwards.
+	b.Sink("audit", dfv1.Sink{Log: &dfv1.Log{}})
+	assert.Len(t, pl.Spec.Vertices, 4)
+}
+
+func TestPipelineBuilder_Invalid(t *testing.T) {
+	b := NewPipeline("my-pipeline")
+	in := b.Source("in", dfv1.Source{Generator: &dfv1.GeneratorSource{}})
+	b.Sink("out", dfv1.Sink{Log: &dfv1.Log{}})
+	_, err := b.Build()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "no edges defined")
+
+	other := NewPipeline("other")
+	otherOut := other.Sink("out", dfv1.Sink{Log: &dfv1.Log{}})
+	_, err = b.Edge(in, otherOut).Build()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), `invalid edge from "in" to "out": the vertices are not added to the pipeline "my-pipeline"`)
+
+	b = NewPipeline("my-pipeline")
+	in = b.Source("in", dfv1.Source{Generator: &dfv1.GeneratorSource{}})
+	out := b.Sink("in", dfv1.Sink{Log: &dfv1.Log{}})
+	_, err = b.Edge(in, out).Build()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), `duplicate vertex name "in"`)
+}
+
+func TestWindows(t *testing.T) {
+	w := SlidingWindow(time.Minute, 10*time.Second).window()
+	assert.Equal(t, time.Minute, w.Sliding.Length.Duration)
+	assert.Equal(t, 10*time.Second, w.Sliding.Slide.Duration)
+	w = CustomWindow(time.Hour).window()
+	assert.Equal(t, time.Hour, w.Custom.GetMaxLength())
+	w = AccumulatorWindow(time.Minute, 0).window()
+	assert.Equal(t, time.Minute, w.Accumulator.GetTimeout())
+	assert.Nil(t, w.Accumulator.Count)
+	w = AccumulatorWindow(time.Minute, 100).window()
+	assert.Equal(t, 100, w.Accumulator.GetCount())
+}
+
+func TestStorages(t *testing.T) {
+	s := PersistentStorage(dfv1.PersistenceStrategy{VolumeSize: resource.NewQuantity(10, resource.BinarySI)}).storage()
+	assert.Nil(t, s.EmptyDir)
+	assert.Equal(t, int64(10), s.PersistentVolumeClaim.VolumeSize.Value())
+	s = EmptyDirStorage().storage()
+	assert.NotNil(t, s.EmptyDir)
+	assert.Nil(t, s.PersistentVolumeClaim)
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+// Window is the windowing strategy of a reduce vertex.
+type Window interface {
+	window() dfv1.Window
+}
+
+type window dfv1.Window
+
+func (w window) window() dfv1.Window {
+	return dfv1.Window(w)
+}
+
+// FixedWindow returns a fixed window of the given length.
+func FixedWindow(length time.Duration) Window {
+	return window{Fixed: &dfv1.FixedWindow{Length: &metav1.Duration{Duration: length}}}
+}
+
+// SlidingWindow returns a sliding window of the given length, which slides by the given duration.
+func SlidingWindow(length, slide time.Duration) Window {
+	return window{Sliding: &dfv1.SlidingWindow{Length: &metav1.Duration{Duration: length}, Slide: &metav1.Duration{Duration: slide}}}
+}
+
+// CustomWindow returns a custom window, whose boundaries are decided by the UDF, with the given max length.
+func CustomWindow(maxLength time.Duration) Window {
+	return window{Custom: &dfv1.CustomWindow{MaxLength: &metav1.Duration{Duration: maxLength}}}
+}
+
+// AccumulatorWindow returns an accumulator window, which is closed after the given timeout without new messages, or
+// once it has the given number of messages if count is greater than 0.
+func AccumulatorWindow(timeout time.Duration, count uint32) Window {
+	w := &dfv1.AccumulatorWindow{Timeout: &metav1.Duration{Duration: timeout}}
+	if count > 0 {
+		w.Count = &count
+	}
+	return window{Accumulator: w}
+}
+
+// Storage is the PBQ storage of a reduce vertex.
+type Storage interface {
+	storage() dfv1.PBQStorage
+}
+
+type storage dfv1.PBQStorage
+
+func (s storage) storage() dfv1.PBQStorage {
+	return dfv1.PBQStorage(s)
+}
+
+// EmptyDirStorage returns a storage on an emptyDir volume, which is lost when the pod is deleted.
+func EmptyDirStorage() Storage {
+	return storage{EmptyDir: &corev1.EmptyDirVolumeSource{}}
+}
+
+// PersistentStorage returns a storage on a persistent volume claim.
+func PersistentStorage(ps dfv1.PersistenceStrategy) Storage {
+	return storage{PersistentVolumeClaim: &ps}
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	cachepb "github.com/numaproj/numaflow/pkg/apis/proto/cache"
+)
+
+// Client is used by the user containers to access the cache served by the numa container.
+type Client struct {
+	conn   *grpc.ClientConn
+	client cachepb.CacheClient
+}
+
+// NewClient returns a new Client connecting to the socket.
+func NewClient(sockAddr string) (*Client, error) {
+	// wait for the server to be ready, the numa container might start later than the user container
+	conn, err := grpc.Dial("unix://"+sockAddr, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithDefaultCallOptions(grpc.WaitForReady(true)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to the cache server, %w", err)
+	}
+	return &Client{conn: conn, client: cachepb.NewCacheClient(conn)}, nil
+}
+
+// Get returns the value of a key, and whether the key is found.
+func (c *Client) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	resp, err := c.client.Get(ctx, &cachepb.GetRequest{Key: key})
+	if err != nil {
+		return nil, false, err
+	}
+	return resp.GetValue(), resp.GetFound(), nil
+}
+
+// Set sets the value of a key.
+func (c *Client) Set(ctx context.Context, key string, value []byte) error {
+	_, err := c.client.Set(ctx, &cachepb.SetRequest{Key: key, Value: value})
+	return err
+}
+
+// Delete deletes a key.
+func (c *Client) Delete(ctx context.Context, key string) error {
+	_, err := c.client.Delete(ctx, &cachepb.DeleteRequest{Key: key})
+	return err
+}
+
+// Close closes the connection.
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
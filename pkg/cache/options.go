@@ -0,0 +1,61 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import "time"
+
+type options struct {
+	// persistencePath is the file to save the entries, the entries are not saved if it's empty
+	persistencePath string
+	// persistenceInterval is the interval of saving the entries
+	persistenceInterval time.Duration
+	// sideInputPath is the file of the side input to warm the cache, the cache is not warmed if it's empty
+	sideInputPath string
+	// sideInputCheckInterval is the interval of checking the updates of the side input
+	sideInputCheckInterval time.Duration
+}
+
+func defaultOptions() *options {
+	return &options{
+		persistenceInterval:    time.Minute,
+		sideInputCheckInterval: 5 * time.Second,
+	}
+}
+
+type Option func(*options)
+
+// WithPersistence sets the file to save the entries to, and the interval of saving them.
+func WithPersistence(path string, interval time.Duration) Option {
+	return func(o *options) {
+		o.persistencePath = path
+		o.persistenceInterval = interval
+	}
+}
+
+// WithSideInput sets the file of the side input to warm the cache with.
+func WithSideInput(path string) Option {
+	return func(o *options) {
+		o.sideInputPath = path
+	}
+}
+
+// WithSideInputCheckInterval sets the interval of checking the updates of the side input.
+func WithSideInputCheckInterval(d time.Duration) Option {
+	return func(o *options) {
+		o.sideInputCheckInterval = d
+	}
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+	sideinpututils "github.com/numaproj/numaflow/pkg/sideinputs/utils"
+)
+
+// Start loads the saved entries and warms the cache with the side input, then keeps saving the entries and reloading
+// the side input until the context is done. The returned channel is closed once the entries are saved for the last
+// time.
+func (s *Store) Start(ctx context.Context) <-chan struct{} {
+	log := logging.FromContext(ctx)
+	done := make(chan struct{})
+	if s.opts.persistencePath != "" {
+		if err := s.Load(s.opts.persistencePath); err != nil {
+			// Start with an empty cache, the corrupted file is replaced by the next save.
+			log.Errorw("Failed to load the saved cache entries", zap.Error(err))
+		}
+	}
+	var sideInputValue []byte
+	warm := func() {
+		value, err := sideinpututils.FetchSideInputFileValue(s.opts.sideInputPath)
+		if err != nil {
+			log.Warnw("Failed to read the side input to warm the cache", zap.Error(err))
+			return
+		}
+		if sideInputValue != nil && bytes.Equal(value, sideInputValue) {
+			return
+		}
+		if err := s.Warm(value); err != nil {
+			log.Errorw("Failed to warm the cache with the side input", zap.Error(err))
+		}
+		// Not retried until the side input is updated.
+		sideInputValue = value
+	}
+	if s.opts.sideInputPath != "" {
+		warm()
+	}
+	go func() {
+		defer close(done)
+		var persistC, sideInputC <-chan time.Time
+		if s.opts.persistencePath != "" {
+			ticker := time.NewTicker(s.opts.persistenceInterval)
+			defer ticker.Stop()
+			persistC = ticker.C
+		}
+		if s.opts.sideInputPath != "" {
+			ticker := time.NewTicker(s.opts.sideInputCheckInterval)
+			defer ticker.Stop()
+			sideInputC = ticker.C
+		}
+		for {
+			select {
+			case <-ctx.Done():
+				if s.opts.persistencePath != "" {
+					if err := s.Save(s.opts.persistencePath); err != nil {
+						log.Errorw("Failed to save the cache entries", zap.Error(err))
+					}
+				}
+				return
+			case <-persistC:
+				if err := s.Save(s.opts.persistencePath); err != nil {
+					log.Errorw("Failed to save the cache entries", zap.Error(err))
+				}
+			case <-sideInputC:
+				warm()
+			}
+		}
+	}()
+	return done
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	cachepb "github.com/numaproj/numaflow/pkg/apis/proto/cache"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+// CacheAddr is the unix domain socket the cache server listens on, which is shared with the user containers.
+const CacheAddr = "/var/run/numaflow/cache.sock"
+
+// Server serves the entries of a Store.
+type Server struct {
+	cachepb.UnimplementedCacheServer
+	store *Store
+}
+
+// NewServer returns a new Server.
+func NewServer(store *Store) *Server {
+	return &Server{store: store}
+}
+
+// Get returns the value of a key.
+func (s *Server) Get(_ context.Context, req *cachepb.GetRequest) (*cachepb.GetResponse, error) {
+	if req.GetKey() == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty key")
+	}
+	value, found := s.store.Get(req.GetKey())
+	return &cachepb.GetResponse{Found: found, Value: value}, nil
+}
+
+// Set sets the value of a key.
+func (s *Server) Set(_ context.Context, req *cachepb.SetRequest) (*cachepb.SetResponse, error) {
+	if req.GetKey() == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty key")
+	}
+	s.store.Set(req.GetKey(), req.GetValue())
+	return &cachepb.SetResponse{}, nil
+}
+
+// Delete deletes a key.
+func (s *Server) Delete(_ context.Context, req *cachepb.DeleteRequest) (*cachepb.DeleteResponse, error) {
+	if req.GetKey() == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty key")
+	}
+	s.store.Delete(req.GetKey())
+	return &cachepb.DeleteResponse{}, nil
+}
+
+// Serve serves on the unix domain socket until the context is done.
+func (s *Server) Serve(ctx context.Context, sockAddr string) error {
+	log := logging.FromContext(ctx)
+	if err := os.RemoveAll(sockAddr); err != nil {
+		return fmt.Errorf("failed to clean up the socket %q, %w", sockAddr, err)
+	}
+	lis, err := net.Listen("unix", sockAddr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %q, %w", sockAddr, err)
+	}
+	grpcServer := grpc.NewServer()
+	cachepb.RegisterCacheServer(grpcServer, s)
+	go func() {
+		<-ctx.Done()
+		grpcServer.GracefulStop()
+	}()
+	log.Infow("Cache server started", zap.String("address", sockAddr))
+	if err := grpcServer.Serve(lis); err != nil {
+		return fmt.Errorf("cache server failed, %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestServer(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sockAddr := filepath.Join(t.TempDir(), "cache.sock")
+	store, err := NewStore(10, 0)
+	assert.NoError(t, err)
+	server := NewServer(store)
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- server.Serve(ctx, sockAddr)
+	}()
+
+	client, err := NewClient(sockAddr)
+	assert.NoError(t, err)
+	defer func() { _ = client.Close() }()
+	callCtx, callCancel := context.WithTimeout(ctx, 5*time.Second)
+	defer callCancel()
+
+	_, found, err := client.Get(callCtx, "k")
+	assert.NoError(t, err)
+	assert.False(t, found)
+	assert.NoError(t, client.Set(callCtx, "k", []byte("v")))
+	value, found, err := client.Get(callCtx, "k")
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, []byte("v"), value)
+	assert.NoError(t, client.Delete(callCtx, "k"))
+	_, found, err = client.Get(callCtx, "k")
+	assert.NoError(t, err)
+	assert.False(t, found)
+	err = client.Set(callCtx, "", []byte("v"))
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	cancel()
+	assert.NoError(t, <-errCh)
+}
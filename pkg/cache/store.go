@@ -0,0 +1,188 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cache provides a local cache of a vertex replica, which is served by the numa container to the user defined
+// container, so that the UDFs in all the languages get the same caching behavior.
+package cache
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/golang-lru/simplelru"
+)
+
+type entry struct {
+	Value []byte `json:"value"`
+	// ExpireAt is the expiry time of the entry, an entry never expires if it's zero
+	ExpireAt time.Time `json:"expireAt,omitempty"`
+}
+
+func (e entry) expired(now time.Time) bool {
+	return !e.ExpireAt.IsZero() && now.After(e.ExpireAt)
+}
+
+// Store is a concurrency safe cache bounded by the number of the entries and the TTL.
+type Store struct {
+	lock    sync.Mutex
+	entries *simplelru.LRU
+	ttl     time.Duration
+	opts    *options
+}
+
+// NewStore returns a Store keeping at most maxEntries entries, the least recently used ones are evicted beyond it.
+// The entries never expire if the ttl is 0.
+func NewStore(maxEntries int, ttl time.Duration, opts ...Option) (*Store, error) {
+	o := defaultOptions()
+	for _, opt := range opts {
+		opt(o)
+	}
+	entries, err := simplelru.NewLRU(maxEntries, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the cache, %w", err)
+	}
+	return &Store{entries: entries, ttl: ttl, opts: o}, nil
+}
+
+// Get returns the value of a key, it returns false if the key doesn't exist or has expired.
+func (s *Store) Get(key string) ([]byte, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	v, ok := s.entries.Get(key)
+	if !ok {
+		return nil, false
+	}
+	e := v.(entry)
+	if e.expired(time.Now()) {
+		s.entries.Remove(key)
+		return nil, false
+	}
+	return e.Value, true
+}
+
+// Set sets the value of a key, which expires after the TTL.
+func (s *Store) Set(key string, value []byte) {
+	e := entry{Value: value}
+	if s.ttl > 0 {
+		e.ExpireAt = time.Now().Add(s.ttl)
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.entries.Add(key, e)
+}
+
+// Delete deletes a key.
+func (s *Store) Delete(key string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.entries.Remove(key)
+}
+
+// Len returns the number of the entries, including the expired ones not yet removed.
+func (s *Store) Len() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.entries.Len()
+}
+
+// Warm sets the entries of a JSON object of the keys and the string values, the entries never expire. They're still
+// evicted if they're the least recently used ones.
+func (s *Store) Warm(data []byte) error {
+	values := make(map[string]string)
+	if err := json.Unmarshal(data, &values); err != nil {
+		return fmt.Errorf("failed to unmarshal the cache entries, %w", err)
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for k, v := range values {
+		s.entries.Add(k, entry{Value: []byte(v)})
+	}
+	return nil
+}
+
+// snapshot is the persisted format of the entries, which are ordered from the least recently used one.
+type snapshot struct {
+	Keys    []string `json:"keys"`
+	Entries []entry  `json:"entries"`
+}
+
+// Save writes the unexpired entries to a file, the file is replaced atomically.
+func (s *Store) Save(path string) error {
+	now := time.Now()
+	snap := snapshot{}
+	s.lock.Lock()
+	for _, k := range s.entries.Keys() {
+		v, ok := s.entries.Peek(k)
+		if !ok || v.(entry).expired(now) {
+			continue
+		}
+		snap.Keys = append(snap.Keys, k.(string))
+		snap.Entries = append(snap.Entries, v.(entry))
+	}
+	s.lock.Unlock()
+	data, err := json.Marshal(snap)
+	if err != nil {
+		return fmt.Errorf("failed to marshal the cache entries, %w", err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".cache-*")
+	if err != nil {
+		return fmt.Errorf("failed to create a temp file to save the cache entries, %w", err)
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("failed to write the cache entries, %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write the cache entries, %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to save the cache entries to %q, %w", path, err)
+	}
+	return nil
+}
+
+// Load reads the entries saved to a file, the expired ones are skipped. It's a no-op if the file doesn't exist.
+func (s *Store) Load(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to read the cache entries from %q, %w", path, err)
+	}
+	snap := snapshot{}
+	if err := json.Unmarshal(data, &snap); err != nil {
+		return fmt.Errorf("failed to unmarshal the cache entries from %q, %w", path, err)
+	}
+	if len(snap.Keys) != len(snap.Entries) {
+		return fmt.Errorf("invalid cache entries in %q", path)
+	}
+	now := time.Now()
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for i, k := range snap.Keys {
+		if snap.Entries[i].expired(now) {
+			continue
+		}
+		s.entries.Add(k, snap.Entries[i])
+	}
+	return nil
+}
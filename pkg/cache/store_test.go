@@ -0,0 +1,131 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStore(t *testing.T) {
+	s, err := NewStore(2, 0)
+	assert.NoError(t, err)
+	s.Set("a", []byte("1"))
+	s.Set("b", []byte("2"))
+	v, ok := s.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, []byte("1"), v)
+	// "b" is the least recently used one
+	s.Set("c", []byte("3"))
+	_, ok = s.Get("b")
+	assert.False(t, ok)
+	assert.Equal(t, 2, s.Len())
+	s.Delete("a")
+	_, ok = s.Get("a")
+	assert.False(t, ok)
+
+	_, err = NewStore(0, 0)
+	assert.Error(t, err)
+}
+
+func TestStore_TTL(t *testing.T) {
+	s, err := NewStore(10, 50*time.Millisecond)
+	assert.NoError(t, err)
+	s.Set("a", []byte("1"))
+	_, ok := s.Get("a")
+	assert.True(t, ok)
+	time.Sleep(100 * time.Millisecond)
+	_, ok = s.Get("a")
+	assert.False(t, ok)
+	assert.Equal(t, 0, s.Len())
+}
+
+func TestStore_Warm(t *testing.T) {
+	s, err := NewStore(10, time.Millisecond)
+	assert.NoError(t, err)
+	assert.Error(t, s.Warm([]byte("not json")))
+	assert.NoError(t, s.Warm([]byte(`{"a": "1", "b": "2"}`)))
+	time.Sleep(10 * time.Millisecond)
+	// the warmed entries never expire
+	v, ok := s.Get("b")
+	assert.True(t, ok)
+	assert.Equal(t, []byte("2"), v)
+}
+
+func TestStore_SaveAndLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cache.json")
+	s, err := NewStore(10, 0)
+	assert.NoError(t, err)
+	assert.NoError(t, s.Load(path))
+	s.Set("a", []byte("1"))
+	s.Set("b", []byte("2"))
+	_, _ = s.Get("a")
+	assert.NoError(t, s.Save(path))
+
+	// the recency is kept, "b" is evicted first
+	loaded, err := NewStore(2, 0)
+	assert.NoError(t, err)
+	assert.NoError(t, loaded.Load(path))
+	loaded.Set("c", []byte("3"))
+	_, ok := loaded.Get("b")
+	assert.False(t, ok)
+	v, ok := loaded.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, []byte("1"), v)
+
+	assert.NoError(t, os.WriteFile(path, []byte("corrupted"), 0o644))
+	assert.Error(t, loaded.Load(path))
+}
+
+func TestStore_Start(t *testing.T) {
+	dir := t.TempDir()
+	persistencePath := filepath.Join(dir, "cache.json")
+	sideInputPath := filepath.Join(dir, "side-input")
+	assert.NoError(t, os.WriteFile(sideInputPath, []byte(`{"a": "1"}`), 0o644))
+	ctx, cancel := context.WithCancel(context.Background())
+	s, err := NewStore(10, 0, WithPersistence(persistencePath, time.Hour), WithSideInput(sideInputPath), WithSideInputCheckInterval(10*time.Millisecond))
+	assert.NoError(t, err)
+	done := s.Start(ctx)
+	v, ok := s.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, []byte("1"), v)
+
+	assert.NoError(t, os.WriteFile(sideInputPath, []byte(`{"a": "2"}`), 0o644))
+	assert.Eventually(t, func() bool {
+		v, _ := s.Get("a")
+		return string(v) == "2"
+	}, 5*time.Second, 10*time.Millisecond)
+	s.Set("b", []byte("3"))
+
+	// the entries are saved on exit
+	cancel()
+	<-done
+	restored, err := NewStore(10, 0, WithPersistence(persistencePath, time.Hour))
+	assert.NoError(t, err)
+	ctx, cancel = context.WithCancel(context.Background())
+	done = restored.Start(ctx)
+	v, ok = restored.Get("b")
+	assert.True(t, ok)
+	assert.Equal(t, []byte("3"), v)
+	cancel()
+	<-done
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package counter
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	counterpb "github.com/numaproj/numaflow/pkg/apis/proto/counter"
+)
+
+// Client is used by the user containers to access the counters served by the numa container.
+type Client struct {
+	conn   *grpc.ClientConn
+	client counterpb.CounterClient
+}
+
+// NewClient returns a new Client connecting to the socket.
+func NewClient(sockAddr string) (*Client, error) {
+	// wait for the server to be ready, the numa container might start later than the user container
+	conn, err := grpc.Dial("unix://"+sockAddr, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithDefaultCallOptions(grpc.WaitForReady(true)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to the counter server, %w", err)
+	}
+	return &Client{conn: conn, client: counterpb.NewCounterClient(conn)}, nil
+}
+
+// Increment adds the delta to the counter, see Store.Increment for the meaning of sync.
+func (c *Client) Increment(ctx context.Context, name string, delta int64, sync bool) (int64, error) {
+	resp, err := c.client.Increment(ctx, &counterpb.IncrementRequest{Name: name, Delta: delta, Sync: sync})
+	if err != nil {
+		return 0, err
+	}
+	return resp.GetValue(), nil
+}
+
+// Get returns the value of the counter.
+func (c *Client) Get(ctx context.Context, name string) (int64, error) {
+	resp, err := c.client.Get(ctx, &counterpb.GetRequest{Name: name})
+	if err != nil {
+		return 0, err
+	}
+	return resp.GetValue(), nil
+}
+
+// Close closes the connection.
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
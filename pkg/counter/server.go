@@ -0,0 +1,97 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package counter
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"regexp"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	counterpb "github.com/numaproj/numaflow/pkg/apis/proto/counter"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+// CounterAddr is the unix domain socket the counter server listens on, which is shared with the user containers.
+const CounterAddr = "/var/run/numaflow/counter.sock"
+
+// validName is the valid name of a counter, which is used as the key in the KV store.
+var validName = regexp.MustCompile(`^[-/_=.a-zA-Z0-9]+$`)
+
+// Server serves the counters of a Store.
+type Server struct {
+	counterpb.UnimplementedCounterServer
+	store *Store
+}
+
+// NewServer returns a new Server.
+func NewServer(store *Store) *Server {
+	return &Server{store: store}
+}
+
+// Increment adds the delta to the counter.
+func (s *Server) Increment(ctx context.Context, req *counterpb.IncrementRequest) (*counterpb.CounterResponse, error) {
+	if !validName.MatchString(req.GetName()) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid counter name %q", req.GetName())
+	}
+	value, err := s.store.Increment(ctx, req.GetName(), req.GetDelta(), req.GetSync())
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to increment counter %q, %v", req.GetName(), err)
+	}
+	return &counterpb.CounterResponse{Name: req.GetName(), Value: value}, nil
+}
+
+// Get returns the value of the counter.
+func (s *Server) Get(ctx context.Context, req *counterpb.GetRequest) (*counterpb.CounterResponse, error) {
+	if !validName.MatchString(req.GetName()) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid counter name %q", req.GetName())
+	}
+	value, err := s.store.Get(ctx, req.GetName())
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to get counter %q, %v", req.GetName(), err)
+	}
+	return &counterpb.CounterResponse{Name: req.GetName(), Value: value}, nil
+}
+
+// Serve serves on the unix domain socket until the context is done.
+func (s *Server) Serve(ctx context.Context, sockAddr string) error {
+	log := logging.FromContext(ctx)
+	if err := os.RemoveAll(sockAddr); err != nil {
+		return fmt.Errorf("failed to clean up the socket %q, %w", sockAddr, err)
+	}
+	lis, err := net.Listen("unix", sockAddr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %q, %w", sockAddr, err)
+	}
+	grpcServer := grpc.NewServer()
+	counterpb.RegisterCounterServer(grpcServer, s)
+	go func() {
+		<-ctx.Done()
+		grpcServer.GracefulStop()
+	}()
+	log.Infow("Counter server started", zap.String("address", sockAddr))
+	if err := grpcServer.Serve(lis); err != nil {
+		return fmt.Errorf("counter server failed, %w", err)
+	}
+	return nil
+}
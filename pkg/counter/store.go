@@ -0,0 +1,209 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+Package counter implements the counters shared by all the replicas of all the vertices in a pipeline. The counters are
+kept in the KV store of the inter-step buffer service, and served to the user containers over a unix domain socket, so
+that the use cases like global quotas do not require an external store.
+*/
+package counter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/numaproj/numaflow/pkg/shared/kvs"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+// Store maintains the counters in a KV store. The increments are batched in memory and committed periodically with
+// compare-and-set, the commit is retried with the latest value if the counter has been updated by another replica.
+type Store struct {
+	kv            kvs.KVRevisionStorer
+	flushInterval time.Duration
+	maxRetries    int
+	lock          sync.Mutex
+	// pending is the increments not committed yet
+	pending map[string]int64
+	// committed is the last known committed value of the counters
+	committed map[string]int64
+	log       *zap.SugaredLogger
+}
+
+type Option func(*Store)
+
+// WithFlushInterval sets the interval of committing the batched increments
+func WithFlushInterval(interval time.Duration) Option {
+	return func(s *Store) {
+		s.flushInterval = interval
+	}
+}
+
+// WithMaxRetries sets the max number of retries of a commit on conflicts
+func WithMaxRetries(retries int) Option {
+	return func(s *Store) {
+		s.maxRetries = retries
+	}
+}
+
+// NewStore returns a new Store.
+func NewStore(ctx context.Context, kv kvs.KVRevisionStorer, opts ...Option) *Store {
+	s := &Store{
+		kv:            kv,
+		flushInterval: 100 * time.Millisecond,
+		maxRetries:    10,
+		pending:       make(map[string]int64),
+		committed:     make(map[string]int64),
+		log:           logging.FromContext(ctx).With("kvName", kv.GetStoreName()),
+	}
+	for _, o := range opts {
+		o(s)
+	}
+	return s
+}
+
+// Start commits the batched increments periodically until the context is done, the pending increments are committed
+// before the returned channel is closed.
+func (s *Store) Start(ctx context.Context) <-chan struct{} {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(s.flushInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				// the context is done, use a new one to commit the pending increments
+				flushCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				if err := s.Flush(flushCtx); err != nil {
+					s.log.Errorw("Failed to commit the pending increments on exit", zap.Error(err))
+				}
+				cancel()
+				return
+			case <-ticker.C:
+				if err := s.Flush(ctx); err != nil {
+					s.log.Warnw("Failed to commit the pending increments, will retry", zap.Error(err))
+				}
+			}
+		}
+	}()
+	return done
+}
+
+// Increment adds the delta to the counter. If sync is true, the increment is committed before returning, and the
+// committed value is returned. Otherwise, the increment is batched, and the value returned is the last known committed
+// value plus the pending increments of this replica.
+func (s *Store) Increment(ctx context.Context, name string, delta int64, sync bool) (int64, error) {
+	s.lock.Lock()
+	if !sync {
+		defer s.lock.Unlock()
+		s.pending[name] += delta
+		return s.committed[name] + s.pending[name], nil
+	}
+	// commit the pending increments of the counter together
+	delta += s.pending[name]
+	delete(s.pending, name)
+	s.lock.Unlock()
+	value, err := s.commit(ctx, name, delta)
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if err != nil {
+		s.pending[name] += delta
+		return 0, err
+	}
+	s.committed[name] = value
+	return value + s.pending[name], nil
+}
+
+// Get returns the committed value of the counter plus the pending increments of this replica.
+func (s *Store) Get(ctx context.Context, name string) (int64, error) {
+	value, _, err := s.getValue(ctx, name)
+	if err != nil {
+		return 0, err
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.committed[name] = value
+	return value + s.pending[name], nil
+}
+
+// Flush commits the pending increments, the ones failed to commit are kept pending.
+func (s *Store) Flush(ctx context.Context) error {
+	s.lock.Lock()
+	pending := s.pending
+	s.pending = make(map[string]int64)
+	s.lock.Unlock()
+
+	var errs []error
+	for name, delta := range pending {
+		value, err := s.commit(ctx, name, delta)
+		s.lock.Lock()
+		if err != nil {
+			s.pending[name] += delta
+			errs = append(errs, fmt.Errorf("failed to commit counter %q, %w", name, err))
+		} else {
+			s.committed[name] = value
+		}
+		s.lock.Unlock()
+	}
+	return errors.Join(errs...)
+}
+
+// commit adds the delta to the counter in the KV store, and returns the new value.
+func (s *Store) commit(ctx context.Context, name string, delta int64) (int64, error) {
+	for i := 0; i <= s.maxRetries; i++ {
+		value, revision, err := s.getValue(ctx, name)
+		if err != nil {
+			return 0, err
+		}
+		newValue := []byte(strconv.FormatInt(value+delta, 10))
+		if revision == 0 {
+			_, err = s.kv.CreateKV(ctx, name, newValue)
+		} else {
+			_, err = s.kv.UpdateKV(ctx, name, newValue, revision)
+		}
+		if err == nil {
+			return value + delta, nil
+		}
+		if !errors.Is(err, kvs.ErrRevisionMismatch) {
+			return 0, err
+		}
+		// updated by another replica, retry with the latest value
+	}
+	return 0, fmt.Errorf("too many conflicts")
+}
+
+// getValue returns the value and the revision of the counter, the revision is 0 if the counter does not exist.
+func (s *Store) getValue(ctx context.Context, name string) (int64, uint64, error) {
+	b, revision, err := s.kv.GetValueWithRevision(ctx, name)
+	if err != nil {
+		if errors.Is(err, kvs.ErrKeyNotFound) {
+			return 0, 0, nil
+		}
+		return 0, 0, err
+	}
+	value, err := strconv.ParseInt(string(b), 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid value of counter %q, %w", name, err)
+	}
+	return value, revision, nil
+}
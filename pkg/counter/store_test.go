@@ -0,0 +1,130 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package counter
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/numaproj/numaflow/pkg/shared/kvs"
+	"github.com/numaproj/numaflow/pkg/shared/kvs/inmem"
+)
+
+func newTestKV(t *testing.T, ctx context.Context) kvs.KVRevisionStorer {
+	kv, entries, err := inmem.NewKVInMemKVStore(ctx, "test_COUNTERS")
+	assert.NoError(t, err)
+	// drain the updates, otherwise the puts are blocked
+	go func() {
+		for range entries {
+		}
+	}()
+	t.Cleanup(kv.Close)
+	return kv.(kvs.KVRevisionStorer)
+}
+
+// conflictKV updates the key before the first update, to simulate a concurrent update by another replica.
+type conflictKV struct {
+	kvs.KVRevisionStorer
+	once sync.Once
+}
+
+func (c *conflictKV) UpdateKV(ctx context.Context, k string, v []byte, revision uint64) (uint64, error) {
+	c.once.Do(func() {
+		_ = c.KVRevisionStorer.PutKV(ctx, k, []byte("100"))
+	})
+	return c.KVRevisionStorer.UpdateKV(ctx, k, v, revision)
+}
+
+func TestStore_Increment(t *testing.T) {
+	ctx := context.Background()
+	s := NewStore(ctx, newTestKV(t, ctx))
+
+	value, err := s.Increment(ctx, "c1", 1, false)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), value)
+	value, err = s.Increment(ctx, "c1", 2, false)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), value)
+	// the pending increments are committed together with a sync increment
+	value, err = s.Increment(ctx, "c1", 3, true)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(6), value)
+	assert.Len(t, s.pending, 0)
+	b, err := s.kv.GetValue(ctx, "c1")
+	assert.NoError(t, err)
+	assert.Equal(t, "6", string(b))
+
+	value, err = s.Get(ctx, "c2")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), value)
+}
+
+func TestStore_Replicas(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	kv := newTestKV(t, ctx)
+	var dones []<-chan struct{}
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		s := NewStore(ctx, kv, WithFlushInterval(5*time.Millisecond))
+		dones = append(dones, s.Start(ctx))
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				_, err := s.Increment(ctx, "quota", 1, j%10 == 0)
+				assert.NoError(t, err)
+			}
+		}()
+	}
+	wg.Wait()
+	cancel()
+	for _, done := range dones {
+		<-done
+	}
+	s := NewStore(context.Background(), kv)
+	value, err := s.Get(context.Background(), "quota")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(300), value)
+}
+
+func TestStore_Conflict(t *testing.T) {
+	ctx := context.Background()
+	kv := &conflictKV{KVRevisionStorer: newTestKV(t, ctx)}
+	s := NewStore(ctx, kv)
+	_, err := s.Increment(ctx, "c1", 1, true)
+	assert.NoError(t, err)
+	// the first update conflicts with the put of 100
+	value, err := s.Increment(ctx, "c1", 1, true)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(101), value)
+
+	s = NewStore(ctx, kv, WithMaxRetries(0))
+	kv.once = sync.Once{}
+	_, err = s.Increment(ctx, "c1", 1, false)
+	assert.NoError(t, err)
+	assert.Error(t, s.Flush(ctx))
+	// kept pending
+	assert.Equal(t, int64(1), s.pending["c1"])
+	assert.NoError(t, s.Flush(ctx))
+	value, err = s.Get(ctx, "c1")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(101), value)
+}
@@ -118,3 +118,182 @@ func (dc *DaemonClient) GetPipelineStatus(ctx context.Context, pipeline string)
 		return rspn.Status, nil
 	}
 }
+
+// GetPipelineEdgeMetrics returns the GetPipelineEdgeMetricsResponse instance for GetPipelineEdgeMetricsRequest
+func (dc *DaemonClient) GetPipelineEdgeMetrics(ctx context.Context, pipeline string) (*daemon.GetPipelineEdgeMetricsResponse, error) {
+	return dc.client.GetPipelineEdgeMetrics(ctx, &daemon.GetPipelineEdgeMetricsRequest{
+		Pipeline: &pipeline,
+	})
+}
+
+// GetPipelineMetricsHistory returns the history of the metrics of a pipeline since the timestamp in milliseconds
+func (dc *DaemonClient) GetPipelineMetricsHistory(ctx context.Context, pipeline string, since int64) ([]*daemon.MetricsHistory, error) {
+	if rspn, err := dc.client.GetPipelineMetricsHistory(ctx, &daemon.GetPipelineMetricsHistoryRequest{
+		Pipeline: &pipeline,
+		Since:    &since,
+	}); err != nil {
+		return nil, err
+	} else {
+		return rspn.History, nil
+	}
+}
+
+// DrainPipelineBuffer acks the pending messages of a buffer without processing them, returns the number of the acked messages
+func (dc *DaemonClient) DrainPipelineBuffer(ctx context.Context, pipeline, buffer, reason string) (int64, error) {
+	if rspn, err := dc.client.DrainBuffer(ctx, &daemon.DrainBufferRequest{
+		Pipeline: &pipeline,
+		Buffer:   &buffer,
+		Reason:   &reason,
+	}); err != nil {
+		return 0, err
+	} else {
+		return rspn.GetDrainedCount(), nil
+	}
+}
+
+// SkipPipelineBuffer moves the reader of a buffer ahead to the latest offset, returns the number of the skipped messages
+func (dc *DaemonClient) SkipPipelineBuffer(ctx context.Context, pipeline, buffer, reason string) (int64, error) {
+	if rspn, err := dc.client.SkipBuffer(ctx, &daemon.SkipBufferRequest{
+		Pipeline: &pipeline,
+		Buffer:   &buffer,
+		Reason:   &reason,
+	}); err != nil {
+		return 0, err
+	} else {
+		return rspn.GetSkippedCount(), nil
+	}
+}
+
+// GetVertexErrors returns the last errors returned by the user-defined containers of a vertex, the newest first
+func (dc *DaemonClient) GetVertexErrors(ctx context.Context, pipeline, vertex string) ([]*daemon.VertexError, error) {
+	if rspn, err := dc.client.GetVertexErrors(ctx, &daemon.GetVertexErrorsRequest{
+		Pipeline: &pipeline,
+		Vertex:   &vertex,
+	}); err != nil {
+		return nil, err
+	} else {
+		return rspn.Errors, nil
+	}
+}
+
+// GetVertexLogs returns the recent logs of the user-defined containers of a vertex tagged with the ID of a message,
+// the newest first
+func (dc *DaemonClient) GetVertexLogs(ctx context.Context, pipeline, vertex, messageID string) ([]*daemon.VertexLog, error) {
+	if rspn, err := dc.client.GetVertexLogs(ctx, &daemon.GetVertexLogsRequest{
+		Pipeline:  &pipeline,
+		Vertex:    &vertex,
+		MessageID: &messageID,
+	}); err != nil {
+		return nil, err
+	} else {
+		return rspn.Logs, nil
+	}
+}
+
+// GetPipelineDegradation returns the degradation state of the pipeline
+func (dc *DaemonClient) GetPipelineDegradation(ctx context.Context, pipeline string) (*daemon.PipelineDegradation, error) {
+	if rspn, err := dc.client.GetPipelineDegradation(ctx, &daemon.GetPipelineDegradationRequest{
+		Pipeline: &pipeline,
+	}); err != nil {
+		return nil, err
+	} else {
+		return rspn.Degradation, nil
+	}
+}
+
+// GetPipelineBottlenecks returns the backpressure score of each edge, and the vertices ranked by how likely they are
+// the bottleneck of the pipeline
+func (dc *DaemonClient) GetPipelineBottlenecks(ctx context.Context, pipeline string) (*daemon.GetPipelineBottlenecksResponse, error) {
+	return dc.client.GetPipelineBottlenecks(ctx, &daemon.GetPipelineBottlenecksRequest{Pipeline: &pipeline})
+}
+
+// ResetSourceOffsets resets the committed offsets of a source vertex to a timestamp in milliseconds, or to the offsets
+// by the partitions if the timestamp is nil, returns the offsets reset to
+func (dc *DaemonClient) ResetSourceOffsets(ctx context.Context, pipeline, vertex string, timestamp *int64, offsets map[int32]string, reason string) (map[int32]string, error) {
+	if rspn, err := dc.client.ResetSourceOffsets(ctx, &daemon.ResetSourceOffsetsRequest{
+		Pipeline:  &pipeline,
+		Vertex:    &vertex,
+		Timestamp: timestamp,
+		Offsets:   offsets,
+		Reason:    &reason,
+	}); err != nil {
+		return nil, err
+	} else {
+		return rspn.Offsets, nil
+	}
+}
+
+// PauseSources stops reading from all the source vertices of the pipeline, it returns the results of the vertices.
+func (dc *DaemonClient) PauseSources(ctx context.Context, pipeline, reason string) (*daemon.BulkOperationResponse, error) {
+	return dc.client.PauseSources(ctx, &daemon.PauseSourcesRequest{Pipeline: &pipeline, Reason: &reason})
+}
+
+// ResumeSources resumes reading from all the source vertices of the pipeline, it returns the results of the vertices.
+func (dc *DaemonClient) ResumeSources(ctx context.Context, pipeline, reason string) (*daemon.BulkOperationResponse, error) {
+	return dc.client.ResumeSources(ctx, &daemon.PauseSourcesRequest{Pipeline: &pipeline, Reason: &reason})
+}
+
+// SetLogLevel changes the log level of the vertices of the pipeline, all the vertices if none is given.
+func (dc *DaemonClient) SetLogLevel(ctx context.Context, pipeline, level string, vertices ...string) (*daemon.BulkOperationResponse, error) {
+	return dc.client.SetLogLevel(ctx, &daemon.SetLogLevelRequest{Pipeline: &pipeline, Level: &level, Vertices: vertices})
+}
+
+// RefreshSideInputs retrieves and broadcasts the values of the side inputs of the pipeline immediately, all the side
+// inputs if none is given.
+func (dc *DaemonClient) RefreshSideInputs(ctx context.Context, pipeline string, sideInputs ...string) (*daemon.BulkOperationResponse, error) {
+	return dc.client.RefreshSideInputs(ctx, &daemon.RefreshSideInputsRequest{Pipeline: &pipeline, SideInputs: sideInputs})
+}
+
+// WatchPipelineStatus calls fn with the status of the pipeline and its cursor whenever it changes, until the context is
+// done, fn returns an error, or the watch fails. The watch is resumed from the cursor if it's not empty.
+func (dc *DaemonClient) WatchPipelineStatus(ctx context.Context, pipeline, cursor string, fn func(status *daemon.PipelineStatus, cursor string) error) error {
+	stream, err := dc.client.WatchPipelineStatus(ctx, &daemon.WatchPipelineStatusRequest{Pipeline: &pipeline, Cursor: &cursor})
+	if err != nil {
+		return err
+	}
+	for {
+		rspn, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		if err := fn(rspn.GetStatus(), rspn.GetCursor()); err != nil {
+			return err
+		}
+	}
+}
+
+// WatchPipelineBuffers calls fn with the buffers of the pipeline and the cursor whenever they change, until the context
+// is done, fn returns an error, or the watch fails. The watch is resumed from the cursor if it's not empty.
+func (dc *DaemonClient) WatchPipelineBuffers(ctx context.Context, pipeline, cursor string, fn func(buffers []*daemon.BufferInfo, cursor string) error) error {
+	stream, err := dc.client.WatchBuffers(ctx, &daemon.WatchBuffersRequest{Pipeline: &pipeline, Cursor: &cursor})
+	if err != nil {
+		return err
+	}
+	for {
+		rspn, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		if err := fn(rspn.GetBuffers(), rspn.GetCursor()); err != nil {
+			return err
+		}
+	}
+}
+
+// WatchVertexMetrics calls fn with the metrics of the vertex and the cursor whenever they change, until the context is
+// done, fn returns an error, or the watch fails. The watch is resumed from the cursor if it's not empty.
+func (dc *DaemonClient) WatchVertexMetrics(ctx context.Context, pipeline, vertex, cursor string, fn func(metrics []*daemon.VertexMetrics, cursor string) error) error {
+	stream, err := dc.client.WatchVertexMetrics(ctx, &daemon.WatchVertexMetricsRequest{Pipeline: &pipeline, Vertex: &vertex, Cursor: &cursor})
+	if err != nil {
+		return err
+	}
+	for {
+		rspn, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		if err := fn(rspn.GetVertexMetrics(), rspn.GetCursor()); err != nil {
+			return err
+		}
+	}
+}
@@ -42,6 +42,8 @@ import (
 	"github.com/numaproj/numaflow/pkg/isbsvc"
 	jsclient "github.com/numaproj/numaflow/pkg/shared/clients/nats"
 	redisclient "github.com/numaproj/numaflow/pkg/shared/clients/redis"
+	"github.com/numaproj/numaflow/pkg/shared/component"
+	jetstreamkv "github.com/numaproj/numaflow/pkg/shared/kvs/jetstream"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 	sharedtls "github.com/numaproj/numaflow/pkg/shared/tls"
 	"github.com/numaproj/numaflow/pkg/watermark/fetch"
@@ -76,9 +78,13 @@ func (ds *daemonServer) Run(ctx context.Context) error {
 	}
 	switch ds.isbSvcType {
 	case v1alpha1.ISBSvcTypeRedis:
-		isbSvcClient = isbsvc.NewISBRedisSvc(redisclient.NewInClusterRedisClient())
+		redisClient, err := redisclient.NewInClusterRedisClient()
+		if err != nil {
+			return fmt.Errorf("failed to create redis client, %w", err)
+		}
+		isbSvcClient = isbsvc.NewISBRedisSvc(redisClient)
 	case v1alpha1.ISBSvcTypeJetStream:
-		isbSvcClient, err = isbsvc.NewISBJetStreamSvc(ds.pipeline.Name, isbsvc.WithJetStreamClient(natsClientPool.NextAvailableClient()))
+		isbSvcClient, err = isbsvc.NewISBJetStreamSvc(ds.pipeline.Name, isbsvc.WithJetStreamClient(natsClientPool.NextAvailableClient()), isbsvc.WithJetStreamDomains(ds.pipeline.GetJetStreamDomains()))
 		if err != nil {
 			log.Errorw("Failed to get an ISB Service client.", zap.Error(err))
 			return err
@@ -106,6 +112,22 @@ func (ds *daemonServer) Run(ctx context.Context) error {
 
 	// rater is used to calculate the processing rate for each of the vertices
 	rater := server.NewRater(ctx, ds.pipeline)
+	// edgeMetrics is used to calculate the watermark lag, buffer fill rate and processing latency for each of the edges
+	edgeMetrics := service.NewEdgeMetricsCollector(ctx, ds.pipeline, isbSvcClient, wmFetchers)
+	// metricsHistory keeps the history of the processing rates, pending messages and watermark lags for the UI
+	var historyOpts []service.MetricsHistoryOption
+	if ds.isbSvcType == v1alpha1.ISBSvcTypeJetStream {
+		kvName := isbsvc.JetStreamMetricsHistoryKVName(ds.pipeline.GetSideInputsStoreName())
+		if store, err := jetstreamkv.NewKVJetStreamKVStore(ctx, kvName, natsClientPool.NextAvailableClient()); err != nil {
+			// e.g. the pipeline was created before the metrics history store was introduced
+			log.Warnw("Metrics history store is not available, the history is lost when the daemon server restarts", zap.String("kvName", kvName), zap.Error(err))
+		} else {
+			historyOpts = append(historyOpts, service.WithMetricsHistoryStore(store, 5*time.Minute))
+		}
+	}
+	metricsHistory := service.NewMetricsHistory(ctx, ds.pipeline, rater, edgeMetrics, historyOpts...)
+	// degradation evaluates the degradation policy of the pipeline against the edge metrics
+	degradation := service.NewDegradationEvaluator(ctx, ds.pipeline, edgeMetrics, 10*time.Second)
 
 	// Start listener
 	var conn net.Listener
@@ -122,7 +144,7 @@ func (ds *daemonServer) Run(ctx context.Context) error {
 	}
 
 	tlsConfig := &tls.Config{Certificates: []tls.Certificate{*cer}, MinVersion: tls.VersionTLS12}
-	grpcServer, err := ds.newGRPCServer(isbSvcClient, wmFetchers, rater)
+	grpcServer, err := ds.newGRPCServer(isbSvcClient, wmFetchers, rater, edgeMetrics, metricsHistory, degradation)
 	if err != nil {
 		return fmt.Errorf("failed to create grpc server: %w", err)
 	}
@@ -139,6 +161,21 @@ func (ds *daemonServer) Run(ctx context.Context) error {
 	go func() { _ = tcpm.Serve() }()
 
 	log.Infof("Daemon server started successfully on %s", address)
+	go func() {
+		if err := edgeMetrics.Start(ctx); err != nil {
+			log.Errorw("Failed to start the edge metrics collector", zap.Error(err))
+		}
+	}()
+	go func() {
+		if err := metricsHistory.Start(ctx); err != nil {
+			log.Errorw("Failed to start the metrics history", zap.Error(err))
+		}
+	}()
+	go func() {
+		if err := degradation.Start(ctx); err != nil {
+			log.Errorw("Failed to start the degradation evaluator", zap.Error(err))
+		}
+	}()
 	// Start the rater
 	if err := rater.Start(ctx); err != nil {
 		return fmt.Errorf("failed to start the rater: %w", err)
@@ -150,7 +187,10 @@ func (ds *daemonServer) Run(ctx context.Context) error {
 func (ds *daemonServer) newGRPCServer(
 	isbSvcClient isbsvc.ISBService,
 	wmFetchers map[v1alpha1.Edge][]fetch.UXFetcher,
-	rater server.Ratable) (*grpc.Server, error) {
+	rater server.Ratable,
+	edgeMetrics service.EdgeMetricsCollectable,
+	metricsHistory service.MetricsHistoryQueryable,
+	degradation service.DegradationEvaluable) (*grpc.Server, error) {
 	// "Prometheus histograms are a great way to measure latency distributions of your RPCs.
 	// However, since it is a bad practice to have metrics of high cardinality the latency monitoring metrics are disabled by default.
 	// To enable them please call the following in your server initialization code:"
@@ -164,11 +204,12 @@ func (ds *daemonServer) newGRPCServer(
 	}
 	grpcServer := grpc.NewServer(sOpts...)
 	grpc_prometheus.Register(grpcServer)
-	pipelineMetadataQuery, err := service.NewPipelineMetadataQuery(isbSvcClient, ds.pipeline, wmFetchers, rater)
+	pipelineMetadataQuery, err := service.NewPipelineMetadataQuery(isbSvcClient, ds.pipeline, wmFetchers, rater, edgeMetrics, metricsHistory, degradation)
 	if err != nil {
 		return nil, err
 	}
 	daemon.RegisterDaemonServiceServer(grpcServer, pipelineMetadataQuery)
+	component.NewServer(v1alpha1.ComponentDaemon, component.WithConfig(func() interface{} { return ds.pipeline })).Register(grpcServer)
 	return grpcServer, nil
 }
 
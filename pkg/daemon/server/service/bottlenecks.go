@@ -0,0 +1,122 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"math"
+	"sort"
+
+	"k8s.io/utils/pointer"
+
+	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/apis/proto/daemon"
+	server "github.com/numaproj/numaflow/pkg/daemon/server/service/rater"
+)
+
+// The weights of the components of the backpressure score of an edge, they add up to 1.
+const (
+	bufferUsageWeight     = 0.7
+	writeRetryRatioWeight = 0.3
+)
+
+// GetPipelineBottlenecks returns the backpressure score of each edge of the pipeline, and the vertices ranked by how
+// likely they are the bottleneck of the pipeline.
+func (ps *pipelineMetadataQuery) GetPipelineBottlenecks(ctx context.Context, req *daemon.GetPipelineBottlenecksRequest) (*daemon.GetPipelineBottlenecksResponse, error) {
+	var edges []*daemon.EdgeBackpressure
+	for _, edge := range ps.pipeline.ListAllEdges() {
+		edges = append(edges, ps.getEdgeBackpressure(edge))
+	}
+	return &daemon.GetPipelineBottlenecksResponse{
+		Edges:    edges,
+		Vertices: rankBottlenecks(ps.pipeline, edges, ps.getProcessingLatency),
+	}, nil
+}
+
+// getEdgeBackpressure computes the backpressure score of an edge from the buffer usage of the edge, and the ratio of
+// the failed writes to the buffers of the edge in the default lookback seconds of the from vertex.
+func (ps *pipelineMetadataQuery) getEdgeBackpressure(edge v1alpha1.Edge) *daemon.EdgeBackpressure {
+	var bufferUsage float64
+	if ps.edgeMetrics != nil {
+		if m := ps.edgeMetrics.GetEdgeMetrics(edge); m != nil {
+			bufferUsage = math.Min(m.GetBufferUsage(), 1)
+		}
+	}
+	var writeRate, writeErrorRate float64
+	if toVertex := ps.pipeline.GetVertex(edge.To); toVertex != nil && ps.rater != nil {
+		for _, buffer := range toVertex.OwnedBufferNames(ps.pipeline.Namespace, ps.pipeline.Name) {
+			writeRate += ps.rater.GetCounterRates(edge.From, server.CounterWriteTotal, buffer)["default"]
+			writeErrorRate += ps.rater.GetCounterRates(edge.From, server.CounterWriteErrorTotal, buffer)["default"]
+		}
+	}
+	var writeRetryRatio float64
+	if writeRate+writeErrorRate > 0 {
+		writeRetryRatio = writeErrorRate / (writeRate + writeErrorRate)
+	}
+	return &daemon.EdgeBackpressure{
+		Edge:            pointer.String(edge.GetEdgeName()),
+		FromVertex:      pointer.String(edge.From),
+		ToVertex:        pointer.String(edge.To),
+		Score:           pointer.Float64(bufferUsageWeight*bufferUsage + writeRetryRatioWeight*writeRetryRatio),
+		BufferUsage:     pointer.Float64(bufferUsage),
+		WriteErrorRate:  pointer.Float64(writeErrorRate),
+		WriteRetryRatio: pointer.Float64(writeRetryRatio),
+	}
+}
+
+// getProcessingLatency returns the average processing time of a message by the user-defined container of the vertex
+// in milliseconds in the default lookback seconds, -1 if not available.
+func (ps *pipelineMetadataQuery) getProcessingLatency(vertexName string) float64 {
+	if ps.rater == nil {
+		return -1
+	}
+	count := ps.rater.GetCounterRates(vertexName, server.CounterProcessingTimeCount, "")["default"]
+	if count <= 0 {
+		return -1
+	}
+	return ps.rater.GetCounterRates(vertexName, server.CounterProcessingTimeSum, "")["default"] / count
+}
+
+// rankBottlenecks ranks the vertices of the pipeline by the bottleneck score. A vertex that can't keep up has its
+// incoming edges backed up, while its outgoing edges are not, hence the score of a vertex is the backpressure of its
+// incoming edges not explained by the backpressure of its outgoing edges. The ties, e.g. when nothing is backed up,
+// are broken by the processing latency.
+func rankBottlenecks(pl *v1alpha1.Pipeline, edges []*daemon.EdgeBackpressure, processingLatency func(string) float64) []*daemon.VertexBottleneck {
+	inbound := make(map[string]float64)
+	outbound := make(map[string]float64)
+	for _, e := range edges {
+		inbound[e.GetToVertex()] = math.Max(inbound[e.GetToVertex()], e.GetScore())
+		outbound[e.GetFromVertex()] = math.Max(outbound[e.GetFromVertex()], e.GetScore())
+	}
+	vertices := make([]*daemon.VertexBottleneck, 0, len(pl.Spec.Vertices))
+	for _, v := range pl.Spec.Vertices {
+		vertices = append(vertices, &daemon.VertexBottleneck{
+			Vertex:               pointer.String(v.Name),
+			Score:                pointer.Float64(math.Max(inbound[v.Name]-outbound[v.Name], 0)),
+			InboundBackpressure:  pointer.Float64(inbound[v.Name]),
+			OutboundBackpressure: pointer.Float64(outbound[v.Name]),
+			ProcessingLatency:    pointer.Float64(processingLatency(v.Name)),
+		})
+	}
+	sort.SliceStable(vertices, func(i, j int) bool {
+		if vertices[i].GetScore() != vertices[j].GetScore() {
+			return vertices[i].GetScore() > vertices[j].GetScore()
+		}
+		return vertices[i].GetProcessingLatency() > vertices[j].GetProcessingLatency()
+	})
+	return vertices
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/apis/proto/daemon"
+	server "github.com/numaproj/numaflow/pkg/daemon/server/service/rater"
+)
+
+type mockBottleneckEdgeMetrics struct {
+	// bufferUsages is a map between edge name and the buffer usage of that edge
+	bufferUsages map[string]float64
+}
+
+func (m *mockBottleneckEdgeMetrics) Start(ctx context.Context) error {
+	return nil
+}
+
+func (m *mockBottleneckEdgeMetrics) GetEdgeMetrics(edge v1alpha1.Edge) *daemon.EdgeMetrics {
+	return &daemon.EdgeMetrics{BufferUsage: pointer.Float64(m.bufferUsages[edge.GetEdgeName()])}
+}
+
+func (m *mockBottleneckEdgeMetrics) GetEndToEndLatencyPercentiles() map[string]int64 {
+	return nil
+}
+
+type mockCounterRater struct {
+	// rates is a map between vertex name, counter name and partition name and the default rate
+	rates map[string]map[string]map[string]float64
+}
+
+func (m *mockCounterRater) Start(ctx context.Context) error {
+	return nil
+}
+
+func (m *mockCounterRater) GetRates(vertexName, partitionName string) map[string]float64 {
+	return map[string]float64{}
+}
+
+func (m *mockCounterRater) GetCounterRates(vertexName, counterName, partitionName string) map[string]float64 {
+	return map[string]float64{"default": m.rates[vertexName][counterName][partitionName]}
+}
+
+func TestGetPipelineBottlenecks(t *testing.T) {
+	pipelineName := "simple-pipeline"
+	pipeline := &v1alpha1.Pipeline{
+		ObjectMeta: metav1.ObjectMeta{Name: pipelineName, Namespace: "default"},
+		Spec: v1alpha1.PipelineSpec{
+			Vertices: []v1alpha1.AbstractVertex{
+				{Name: "in", Source: &v1alpha1.Source{}},
+				{Name: "cat", UDF: &v1alpha1.UDF{Builtin: &v1alpha1.Function{Name: "cat"}}},
+				{Name: "out", Sink: &v1alpha1.Sink{}},
+			},
+			Edges: []v1alpha1.Edge{{From: "in", To: "cat"}, {From: "cat", To: "out"}},
+		},
+	}
+	edgeMetrics := &mockBottleneckEdgeMetrics{bufferUsages: map[string]float64{"in-cat": 0.9, "cat-out": 0.1}}
+	rater := &mockCounterRater{rates: map[string]map[string]map[string]float64{
+		"in": {
+			server.CounterWriteTotal:      {"default-simple-pipeline-cat-0": 80},
+			server.CounterWriteErrorTotal: {"default-simple-pipeline-cat-0": 20},
+		},
+		"cat": {
+			server.CounterWriteTotal:          {"default-simple-pipeline-out-0": 80},
+			server.CounterProcessingTimeSum:   {"": 500},
+			server.CounterProcessingTimeCount: {"": 100},
+		},
+	}}
+	ps, err := NewPipelineMetadataQuery(&mockIsbSvcClient{}, pipeline, nil, rater, edgeMetrics, nil, nil)
+	assert.NoError(t, err)
+	resp, err := ps.GetPipelineBottlenecks(context.Background(), &daemon.GetPipelineBottlenecksRequest{Pipeline: &pipelineName})
+	assert.NoError(t, err)
+
+	assert.Len(t, resp.GetEdges(), 2)
+	inCat := resp.GetEdges()[0]
+	assert.Equal(t, "in-cat", inCat.GetEdge())
+	assert.InDelta(t, 0.9, inCat.GetBufferUsage(), 1e-9)
+	assert.InDelta(t, 20, inCat.GetWriteErrorRate(), 1e-9)
+	assert.InDelta(t, 0.2, inCat.GetWriteRetryRatio(), 1e-9)
+	assert.InDelta(t, 0.7*0.9+0.3*0.2, inCat.GetScore(), 1e-9)
+	catOut := resp.GetEdges()[1]
+	assert.Equal(t, "cat-out", catOut.GetEdge())
+	assert.InDelta(t, 0, catOut.GetWriteRetryRatio(), 1e-9)
+	assert.InDelta(t, 0.07, catOut.GetScore(), 1e-9)
+
+	assert.Len(t, resp.GetVertices(), 3)
+	cat := resp.GetVertices()[0]
+	assert.Equal(t, "cat", cat.GetVertex())
+	assert.InDelta(t, inCat.GetScore()-catOut.GetScore(), cat.GetScore(), 1e-9)
+	assert.InDelta(t, inCat.GetScore(), cat.GetInboundBackpressure(), 1e-9)
+	assert.InDelta(t, catOut.GetScore(), cat.GetOutboundBackpressure(), 1e-9)
+	assert.InDelta(t, 5, cat.GetProcessingLatency(), 1e-9)
+	assert.Equal(t, "out", resp.GetVertices()[1].GetVertex())
+	assert.InDelta(t, catOut.GetScore(), resp.GetVertices()[1].GetScore(), 1e-9)
+	assert.Equal(t, "in", resp.GetVertices()[2].GetVertex())
+	assert.Equal(t, float64(0), resp.GetVertices()[2].GetScore())
+	assert.Equal(t, float64(-1), resp.GetVertices()[2].GetProcessingLatency())
+}
+
+func TestRankBottlenecks_NoBackpressure(t *testing.T) {
+	pipeline := &v1alpha1.Pipeline{
+		Spec: v1alpha1.PipelineSpec{
+			Vertices: []v1alpha1.AbstractVertex{{Name: "in"}, {Name: "slow"}, {Name: "out"}},
+		},
+	}
+	latencies := map[string]float64{"in": -1, "slow": 30, "out": 2}
+	vertices := rankBottlenecks(pipeline, nil, func(v string) float64 { return latencies[v] })
+	assert.Equal(t, "slow", vertices[0].GetVertex())
+	assert.Equal(t, "out", vertices[1].GetVertex())
+	assert.Equal(t, "in", vertices[2].GetVertex())
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"k8s.io/utils/pointer"
+
+	"github.com/numaproj/numaflow/pkg/apis/proto/daemon"
+	"github.com/numaproj/numaflow/pkg/metrics"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+const (
+	bufferOperationDrain = "drain"
+	bufferOperationSkip  = "skip"
+)
+
+// DrainBuffer acks the pending messages of a buffer of the pipeline without processing them, to recover from a poison
+// backlog without deleting the buffer.
+func (ps *pipelineMetadataQuery) DrainBuffer(ctx context.Context, req *daemon.DrainBufferRequest) (*daemon.DrainBufferResponse, error) {
+	if ps.pipeline.FindVertexWithBuffer(req.GetBuffer()) == nil {
+		return nil, status.Errorf(codes.NotFound, "buffer %q not found from the pipeline", req.GetBuffer())
+	}
+	count, err := ps.isbSvcClient.DrainBuffer(ctx, req.GetBuffer())
+	auditBufferOperation(ctx, ps.pipeline.Name, req.GetBuffer(), bufferOperationDrain, req.GetReason(), count, err)
+	if err != nil {
+		return nil, fmt.Errorf("failed to drain buffer %q after %d messages, %w", req.GetBuffer(), count, err)
+	}
+	return &daemon.DrainBufferResponse{DrainedCount: pointer.Int64(count)}, nil
+}
+
+// SkipBuffer moves the reader of a buffer of the pipeline ahead to the latest offset, the skipped messages are never
+// processed.
+func (ps *pipelineMetadataQuery) SkipBuffer(ctx context.Context, req *daemon.SkipBufferRequest) (*daemon.SkipBufferResponse, error) {
+	if ps.pipeline.FindVertexWithBuffer(req.GetBuffer()) == nil {
+		return nil, status.Errorf(codes.NotFound, "buffer %q not found from the pipeline", req.GetBuffer())
+	}
+	count, err := ps.isbSvcClient.SkipBuffer(ctx, req.GetBuffer())
+	auditBufferOperation(ctx, ps.pipeline.Name, req.GetBuffer(), bufferOperationSkip, req.GetReason(), count, err)
+	if err != nil {
+		return nil, fmt.Errorf("failed to skip buffer %q, %w", req.GetBuffer(), err)
+	}
+	return &daemon.SkipBufferResponse{SkippedCount: pointer.Int64(count)}, nil
+}
+
+// auditBufferOperation records an operation discarding the messages of a buffer in the audit log and the metrics.
+func auditBufferOperation(ctx context.Context, pipeline, buffer, operation, reason string, count int64, err error) {
+	log := logging.FromContext(ctx).Named("audit").With(
+		zap.String("operation", operation),
+		zap.String("pipeline", pipeline),
+		zap.String("buffer", buffer),
+		zap.String("client", clientAddr(ctx)),
+		zap.String("reason", reason),
+		zap.Int64("messages", count),
+	)
+	result := "success"
+	if err != nil {
+		result = "failure"
+		log.Errorw("Buffer operation failed", zap.Error(err))
+	} else {
+		log.Infow("Buffer operation succeeded")
+	}
+	bufferOperations.With(map[string]string{metrics.LabelPipeline: pipeline, LabelBuffer: buffer, LabelOperation: operation, LabelResult: result}).Inc()
+	bufferOperationMessages.With(map[string]string{metrics.LabelPipeline: pipeline, LabelBuffer: buffer, LabelOperation: operation}).Add(float64(count))
+}
+
+// clientAddr returns the address of the client calling the API, which is recorded in the audit log.
+func clientAddr(ctx context.Context) string {
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		return p.Addr.String()
+	}
+	return "unknown"
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/apis/proto/daemon"
+)
+
+func TestBufferOperations(t *testing.T) {
+	pipelineName := "simple-pipeline"
+	pipeline := &v1alpha1.Pipeline{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      pipelineName,
+			Namespace: "numaflow-system",
+		},
+		Spec: v1alpha1.PipelineSpec{
+			Vertices: []v1alpha1.AbstractVertex{
+				{Name: "in"},
+				{Name: "cat"},
+			},
+			Edges: []v1alpha1.Edge{{From: "in", To: "cat"}},
+		},
+	}
+	ps, err := NewPipelineMetadataQuery(&mockIsbSvcClient{}, pipeline, nil, nil, nil, nil, nil)
+	assert.NoError(t, err)
+	bufferName := "numaflow-system-simple-pipeline-cat-0"
+
+	drainResp, err := ps.DrainBuffer(context.Background(), &daemon.DrainBufferRequest{Pipeline: &pipelineName, Buffer: &bufferName, Reason: pointer.String("poison messages")})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10), drainResp.GetDrainedCount())
+
+	skipResp, err := ps.SkipBuffer(context.Background(), &daemon.SkipBufferRequest{Pipeline: &pipelineName, Buffer: &bufferName})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(25), skipResp.GetSkippedCount())
+
+	_, err = ps.SkipBuffer(context.Background(), &daemon.SkipBufferRequest{Pipeline: &pipelineName, Buffer: pointer.String("not-existing")})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,247 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/utils/pointer"
+
+	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/apis/proto/daemon"
+	"github.com/numaproj/numaflow/pkg/metrics"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/shared/pause"
+)
+
+const (
+	bulkOperationPauseSources      = "pause-sources"
+	bulkOperationResumeSources     = "resume-sources"
+	bulkOperationSetLogLevel       = "set-log-level"
+	bulkOperationRefreshSideInputs = "refresh-side-inputs"
+
+	// sideInputRefreshTimeout is how long to wait for the side inputs manager to refresh a side input.
+	sideInputRefreshTimeout = 30 * time.Second
+)
+
+// PauseSources stops reading from all the source vertices of the pipeline, the pods keep running. The paused state
+// is kept in the memory of the running pods, the restarted or newly scaled up pods are not paused.
+func (ps *pipelineMetadataQuery) PauseSources(ctx context.Context, req *daemon.PauseSourcesRequest) (*daemon.BulkOperationResponse, error) {
+	return ps.setSourcesPaused(ctx, true, req.GetReason())
+}
+
+// ResumeSources resumes reading from all the source vertices of the pipeline.
+func (ps *pipelineMetadataQuery) ResumeSources(ctx context.Context, req *daemon.PauseSourcesRequest) (*daemon.BulkOperationResponse, error) {
+	return ps.setSourcesPaused(ctx, false, req.GetReason())
+}
+
+func (ps *pipelineMetadataQuery) setSourcesPaused(ctx context.Context, paused bool, reason string) (*daemon.BulkOperationResponse, error) {
+	var sources []v1alpha1.AbstractVertex
+	for _, v := range ps.pipeline.Spec.Vertices {
+		if v.IsASource() {
+			sources = append(sources, v)
+		}
+	}
+	body, err := json.Marshal(pause.State{Paused: paused})
+	if err != nil {
+		return nil, err
+	}
+	operation := bulkOperationPauseSources
+	if !paused {
+		operation = bulkOperationResumeSources
+	}
+	resp := ps.applyToVertices(ctx, sources, pause.Path, body)
+	auditBulkOperation(ctx, ps.pipeline.Name, operation, resp, zap.String("reason", reason))
+	return resp, nil
+}
+
+// SetLogLevel changes the log level of the vertices of the pipeline, all the vertices if none is specified. Same as
+// pausing the sources, the log level of the restarted or newly scaled up pods is not changed.
+func (ps *pipelineMetadataQuery) SetLogLevel(ctx context.Context, req *daemon.SetLogLevelRequest) (*daemon.BulkOperationResponse, error) {
+	var level zapcore.Level
+	if err := level.UnmarshalText([]byte(req.GetLevel())); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid log level %q", req.GetLevel())
+	}
+	vertices := ps.pipeline.Spec.Vertices
+	if len(req.GetVertices()) > 0 {
+		vertices = nil
+		for _, name := range req.GetVertices() {
+			v := ps.pipeline.GetVertex(name)
+			if v == nil {
+				return nil, status.Errorf(codes.NotFound, "vertex %q not found from the pipeline", name)
+			}
+			vertices = append(vertices, *v)
+		}
+	}
+	body, err := json.Marshal(map[string]string{"level": level.String()})
+	if err != nil {
+		return nil, err
+	}
+	resp := ps.applyToVertices(ctx, vertices, logging.LevelPath, body)
+	auditBulkOperation(ctx, ps.pipeline.Name, bulkOperationSetLogLevel, resp, zap.String("level", level.String()))
+	return resp, nil
+}
+
+// RefreshSideInputs asks the side inputs managers to retrieve and broadcast the values of the side inputs of the
+// pipeline immediately, all the side inputs if none is specified.
+func (ps *pipelineMetadataQuery) RefreshSideInputs(ctx context.Context, req *daemon.RefreshSideInputsRequest) (*daemon.BulkOperationResponse, error) {
+	names := req.GetSideInputs()
+	if len(names) == 0 {
+		for _, si := range ps.pipeline.Spec.SideInputs {
+			names = append(names, si.Name)
+		}
+	}
+	for _, name := range names {
+		found := false
+		for _, si := range ps.pipeline.Spec.SideInputs {
+			if si.Name == name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, status.Errorf(codes.NotFound, "side input %q not found from the pipeline", name)
+		}
+	}
+	results := make([]*daemon.BulkOperationResult, len(names))
+	var wg sync.WaitGroup
+	for i, name := range names {
+		wg.Add(1)
+		go func(i int, name string) {
+			defer wg.Done()
+			cctx, cancel := context.WithTimeout(ctx, sideInputRefreshTimeout)
+			defer cancel()
+			results[i] = &daemon.BulkOperationResult{Name: pointer.String(name), Succeeded: pointer.Bool(true), Pods: pointer.Int32(0)}
+			if err := ps.isbSvcClient.RefreshSideInput(cctx, ps.pipeline.GetSideInputsStoreName(), name); err != nil {
+				results[i].Succeeded = pointer.Bool(false)
+				results[i].Error = pointer.String(err.Error())
+			}
+		}(i, name)
+	}
+	wg.Wait()
+	resp := newBulkOperationResponse(results)
+	auditBulkOperation(ctx, ps.pipeline.Name, bulkOperationRefreshSideInputs, resp)
+	return resp, nil
+}
+
+// applyToVertices sends the PUT request to the endpoint of all the pods of the vertices, the vertices are processed
+// concurrently.
+func (ps *pipelineMetadataQuery) applyToVertices(ctx context.Context, vertices []v1alpha1.AbstractVertex, path string, body []byte) *daemon.BulkOperationResponse {
+	results := make([]*daemon.BulkOperationResult, len(vertices))
+	var wg sync.WaitGroup
+	for i, v := range vertices {
+		wg.Add(1)
+		go func(i int, v v1alpha1.AbstractVertex) {
+			defer wg.Done()
+			results[i] = ps.applyToVertex(ctx, v, path, body)
+		}(i, v)
+	}
+	wg.Wait()
+	return newBulkOperationResponse(results)
+}
+
+// applyToVertex sends the PUT request to the endpoint of the pods of a vertex one by one. The pods are indexed from 0,
+// there are no more pods after an unreachable one. The vertex fails if any of the pods replies an error.
+func (ps *pipelineMetadataQuery) applyToVertex(ctx context.Context, v v1alpha1.AbstractVertex, path string, body []byte) *daemon.BulkOperationResult {
+	log := logging.FromContext(ctx)
+	vertexName := fmt.Sprintf("%s-%s", ps.pipeline.Name, v.Name)
+	vertex := &v1alpha1.Vertex{}
+	vertex.Name = vertexName
+	headlessServiceName := vertex.GetHeadlessServiceName()
+	pods := int32(0)
+	var errs []string
+	for idx := 0; idx < int(v.Scale.GetMaxReplicas()); idx++ {
+		podName := fmt.Sprintf("%s-%d", vertexName, idx)
+		url := fmt.Sprintf("https://%s.%s.%s.svc:%v%s", podName, headlessServiceName, v.GetPodNamespace(ps.pipeline.Namespace), v1alpha1.VertexMetricsPort, path)
+		req, err := http.NewRequestWithContext(ctx, http.MethodPut, url, bytes.NewReader(body))
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", podName, err))
+			break
+		}
+		req.Header.Set("Content-Type", "application/json")
+		res, err := ps.httpClient.Do(req)
+		if err != nil {
+			log.Debugw("Failed to reach the pod, it might be because of vertex scaling down", zap.String("pod", podName), zap.Error(err))
+			break
+		}
+		msg, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		_ = res.Body.Close()
+		if res.StatusCode != http.StatusOK {
+			errs = append(errs, fmt.Sprintf("%s: status code %d, %s", podName, res.StatusCode, strings.TrimSpace(string(msg))))
+			continue
+		}
+		pods++
+	}
+	result := &daemon.BulkOperationResult{Name: pointer.String(v.Name), Succeeded: pointer.Bool(len(errs) == 0), Pods: pointer.Int32(pods)}
+	if len(errs) > 0 {
+		result.Error = pointer.String(strings.Join(errs, "; "))
+	}
+	return result
+}
+
+func newBulkOperationResponse(results []*daemon.BulkOperationResult) *daemon.BulkOperationResponse {
+	failed := int32(0)
+	for _, r := range results {
+		if !r.GetSucceeded() {
+			failed++
+		}
+	}
+	return &daemon.BulkOperationResponse{Results: results, Failed: pointer.Int32(failed)}
+}
+
+// auditBulkOperation records a bulk operation in the audit log and the metrics.
+func auditBulkOperation(ctx context.Context, pipeline, operation string, resp *daemon.BulkOperationResponse, fields ...zap.Field) {
+	var succeeded, failed []string
+	for _, r := range resp.GetResults() {
+		if r.GetSucceeded() {
+			succeeded = append(succeeded, r.GetName())
+		} else {
+			failed = append(failed, r.GetName())
+		}
+	}
+	log := logging.FromContext(ctx).Named("audit").Desugar().With(
+		zap.String("operation", operation),
+		zap.String("pipeline", pipeline),
+		zap.String("client", clientAddr(ctx)),
+		zap.Strings("succeeded", succeeded),
+		zap.Strings("failed", failed),
+	).With(fields...).Sugar()
+	result := "success"
+	switch {
+	case len(failed) == 0:
+		log.Infow("Bulk operation succeeded")
+	case len(succeeded) == 0:
+		result = "failure"
+		log.Errorw("Bulk operation failed")
+	default:
+		result = "partial"
+		log.Warnw("Bulk operation partially failed")
+	}
+	bulkOperations.With(map[string]string{metrics.LabelPipeline: pipeline, LabelOperation: operation, LabelResult: result}).Inc()
+}
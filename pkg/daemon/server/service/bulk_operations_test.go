@@ -0,0 +1,188 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/apis/proto/daemon"
+)
+
+type refreshFailingIsbSvcClient struct {
+	mockIsbSvcClient
+	failing string
+}
+
+func (ms *refreshFailingIsbSvcClient) RefreshSideInput(ctx context.Context, sideInputsStore, sideInput string) error {
+	if sideInput == ms.failing {
+		return fmt.Errorf("failed to retrieve side input")
+	}
+	return nil
+}
+
+func newBulkTestPipeline() *v1alpha1.Pipeline {
+	return &v1alpha1.Pipeline{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "simple-pipeline",
+			Namespace: "numaflow-system",
+		},
+		Spec: v1alpha1.PipelineSpec{
+			Vertices: []v1alpha1.AbstractVertex{
+				{Name: "in", Source: &v1alpha1.Source{Kafka: &v1alpha1.KafkaSource{}}, Scale: v1alpha1.Scale{Max: pointer.Int32(3)}},
+				{Name: "in2", Source: &v1alpha1.Source{Kafka: &v1alpha1.KafkaSource{}}, Scale: v1alpha1.Scale{Max: pointer.Int32(3)}},
+				{Name: "cat", UDF: &v1alpha1.UDF{Builtin: &v1alpha1.Function{Name: "cat"}}, Scale: v1alpha1.Scale{Max: pointer.Int32(3)}},
+				{Name: "out", Sink: &v1alpha1.Sink{Log: &v1alpha1.Log{}}, Scale: v1alpha1.Scale{Max: pointer.Int32(3)}},
+			},
+			Edges: []v1alpha1.Edge{{From: "in", To: "cat"}, {From: "in2", To: "cat"}, {From: "cat", To: "out"}},
+			SideInputs: []v1alpha1.SideInput{
+				{Name: "s1", Trigger: &v1alpha1.SideInputTrigger{Schedule: "@every 1m"}},
+				{Name: "s2", Trigger: &v1alpha1.SideInputTrigger{Schedule: "@every 1m"}},
+			},
+		},
+	}
+}
+
+// mockPods returns a mock http client of the pods, a pod replies with the status code, and the missing ones are
+// not reachable.
+func mockPods(pods map[string]int, requested *[]string, bodies *[]string) *mockHttpClient {
+	lock := new(sync.Mutex)
+	return &mockHttpClient{
+		MockDo: func(req *http.Request) (*http.Response, error) {
+			pod := strings.SplitN(req.URL.Host, ".", 2)[0]
+			body, _ := io.ReadAll(req.Body)
+			lock.Lock()
+			*requested = append(*requested, req.Method+" "+req.URL.String())
+			*bodies = append(*bodies, string(body))
+			lock.Unlock()
+			code, ok := pods[pod]
+			if !ok {
+				return nil, fmt.Errorf("no such host")
+			}
+			return &http.Response{StatusCode: code, Body: io.NopCloser(bytes.NewReader([]byte("{}")))}, nil
+		},
+	}
+}
+
+func TestPauseSources(t *testing.T) {
+	ps, err := NewPipelineMetadataQuery(&mockIsbSvcClient{}, newBulkTestPipeline(), nil, nil, nil, nil, nil)
+	assert.NoError(t, err)
+	var requested, bodies []string
+	ps.httpClient = mockPods(map[string]int{
+		"simple-pipeline-in-0":  http.StatusOK,
+		"simple-pipeline-in-1":  http.StatusOK,
+		"simple-pipeline-in2-0": http.StatusOK,
+		"simple-pipeline-in2-1": http.StatusInternalServerError,
+		"simple-pipeline-in2-2": http.StatusOK,
+		"simple-pipeline-cat-0": http.StatusOK,
+	}, &requested, &bodies)
+
+	resp, err := ps.PauseSources(context.Background(), &daemon.PauseSourcesRequest{Pipeline: pointer.String("simple-pipeline"), Reason: pointer.String("maintenance")})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), resp.GetFailed())
+	assert.Len(t, resp.GetResults(), 2)
+	assert.Equal(t, "in", resp.GetResults()[0].GetName())
+	assert.True(t, resp.GetResults()[0].GetSucceeded())
+	assert.Equal(t, int32(2), resp.GetResults()[0].GetPods())
+	assert.Equal(t, "in2", resp.GetResults()[1].GetName())
+	assert.False(t, resp.GetResults()[1].GetSucceeded())
+	assert.Equal(t, int32(2), resp.GetResults()[1].GetPods())
+	assert.Contains(t, resp.GetResults()[1].GetError(), "simple-pipeline-in2-1: status code 500")
+	assert.Contains(t, requested, "PUT https://simple-pipeline-in-0.simple-pipeline-in-headless.numaflow-system.svc:2469/pause")
+	for _, r := range requested {
+		assert.NotContains(t, r, "cat")
+	}
+	for _, b := range bodies {
+		assert.Equal(t, `{"paused":true}`, b)
+	}
+
+	requested, bodies = nil, nil
+	resp, err = ps.ResumeSources(context.Background(), &daemon.PauseSourcesRequest{Pipeline: pointer.String("simple-pipeline")})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), resp.GetFailed())
+	for _, b := range bodies {
+		assert.Equal(t, `{"paused":false}`, b)
+	}
+}
+
+func TestSetLogLevel(t *testing.T) {
+	ps, err := NewPipelineMetadataQuery(&mockIsbSvcClient{}, newBulkTestPipeline(), nil, nil, nil, nil, nil)
+	assert.NoError(t, err)
+	var requested, bodies []string
+	ps.httpClient = mockPods(map[string]int{
+		"simple-pipeline-in-0":  http.StatusOK,
+		"simple-pipeline-cat-0": http.StatusOK,
+		"simple-pipeline-cat-1": http.StatusOK,
+		"simple-pipeline-out-0": http.StatusOK,
+	}, &requested, &bodies)
+
+	resp, err := ps.SetLogLevel(context.Background(), &daemon.SetLogLevelRequest{Pipeline: pointer.String("simple-pipeline"), Level: pointer.String("DEBUG")})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(0), resp.GetFailed())
+	assert.Len(t, resp.GetResults(), 4)
+	assert.Equal(t, int32(0), resp.GetResults()[1].GetPods())
+	assert.Equal(t, int32(2), resp.GetResults()[2].GetPods())
+	assert.Contains(t, requested, "PUT https://simple-pipeline-cat-1.simple-pipeline-cat-headless.numaflow-system.svc:2469/log-level")
+	for _, b := range bodies {
+		assert.Equal(t, `{"level":"debug"}`, b)
+	}
+
+	resp, err = ps.SetLogLevel(context.Background(), &daemon.SetLogLevelRequest{Pipeline: pointer.String("simple-pipeline"), Level: pointer.String("info"), Vertices: []string{"out"}})
+	assert.NoError(t, err)
+	assert.Len(t, resp.GetResults(), 1)
+	assert.Equal(t, "out", resp.GetResults()[0].GetName())
+
+	_, err = ps.SetLogLevel(context.Background(), &daemon.SetLogLevelRequest{Pipeline: pointer.String("simple-pipeline"), Level: pointer.String("verbose")})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = ps.SetLogLevel(context.Background(), &daemon.SetLogLevelRequest{Pipeline: pointer.String("simple-pipeline"), Level: pointer.String("info"), Vertices: []string{"not-existing"}})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestRefreshSideInputs(t *testing.T) {
+	ps, err := NewPipelineMetadataQuery(&refreshFailingIsbSvcClient{failing: "s2"}, newBulkTestPipeline(), nil, nil, nil, nil, nil)
+	assert.NoError(t, err)
+
+	resp, err := ps.RefreshSideInputs(context.Background(), &daemon.RefreshSideInputsRequest{Pipeline: pointer.String("simple-pipeline")})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), resp.GetFailed())
+	assert.Len(t, resp.GetResults(), 2)
+	assert.True(t, resp.GetResults()[0].GetSucceeded())
+	assert.Equal(t, "s2", resp.GetResults()[1].GetName())
+	assert.False(t, resp.GetResults()[1].GetSucceeded())
+	assert.Equal(t, "failed to retrieve side input", resp.GetResults()[1].GetError())
+
+	resp, err = ps.RefreshSideInputs(context.Background(), &daemon.RefreshSideInputsRequest{Pipeline: pointer.String("simple-pipeline"), SideInputs: []string{"s1"}})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(0), resp.GetFailed())
+	assert.Len(t, resp.GetResults(), 1)
+
+	_, err = ps.RefreshSideInputs(context.Background(), &daemon.RefreshSideInputsRequest{Pipeline: pointer.String("simple-pipeline"), SideInputs: []string{"s3"}})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
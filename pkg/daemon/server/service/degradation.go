@@ -0,0 +1,181 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"k8s.io/utils/pointer"
+
+	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/apis/proto/daemon"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+// maxDegradationTransitions is the number of recent transitions kept by the degradation evaluator.
+const maxDegradationTransitions = 16
+
+// DegradationEvaluable evaluates the degradation policy of a pipeline against the edge metrics.
+type DegradationEvaluable interface {
+	Start(ctx context.Context) error
+	GetDegradation() *daemon.PipelineDegradation
+}
+
+// degradationEvaluator periodically checks the latest edge metrics against the thresholds of the degradation policy.
+// The pipeline is degraded as soon as any threshold is breached, and restored after none of them is breached for the
+// recovery period.
+type degradationEvaluator struct {
+	pipeline    *v1alpha1.Pipeline
+	edgeMetrics EdgeMetricsCollectable
+	interval    time.Duration
+	log         *zap.SugaredLogger
+	lock        sync.RWMutex
+	degraded    bool
+	reason      string
+	// lastTransition is the time of the last transition, zero if never transitioned.
+	lastTransition time.Time
+	// healthySince is the first time none of the thresholds is breached since the last breach.
+	healthySince time.Time
+	// transitions are the recent transitions, the oldest first, so that a degradation restored between two syncs
+	// of the pipeline controller is not missed.
+	transitions []*daemon.DegradationTransition
+}
+
+// NewDegradationEvaluator returns a degradation evaluator of the pipeline, which evaluates at the given interval.
+func NewDegradationEvaluator(ctx context.Context, pl *v1alpha1.Pipeline, edgeMetrics EdgeMetricsCollectable, interval time.Duration) *degradationEvaluator {
+	return &degradationEvaluator{
+		pipeline:    pl,
+		edgeMetrics: edgeMetrics,
+		interval:    interval,
+		log:         logging.FromContext(ctx).Named("DegradationEvaluator"),
+	}
+}
+
+// Start evaluates the degradation policy until the context is done, it returns immediately if the pipeline does not
+// have a degradation policy.
+func (e *degradationEvaluator) Start(ctx context.Context) error {
+	if e.pipeline.Spec.Degradation == nil {
+		return nil
+	}
+	e.log.Info("Starting degradation evaluator...")
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			e.log.Info("Shutting down degradation evaluator")
+			return nil
+		case <-ticker.C:
+			e.evaluate(time.Now())
+		}
+	}
+}
+
+// evaluate checks the latest edge metrics, and transitions the degradation state if needed.
+func (e *degradationEvaluator) evaluate(now time.Time) {
+	breach := e.breach()
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	if breach != "" {
+		e.healthySince = time.Time{}
+		if !e.degraded {
+			e.transition(true, breach, now)
+			e.log.Infow("Degrading the best-effort edges", zap.String("reason", breach))
+		}
+	} else if e.degraded {
+		if e.healthySince.IsZero() {
+			e.healthySince = now
+		}
+		if recovery := e.pipeline.Spec.Degradation.GetRecoveryPeriod(); now.Sub(e.healthySince) >= recovery {
+			e.transition(false, fmt.Sprintf("no threshold breached in the last %v", recovery), now)
+			e.healthySince = time.Time{}
+			e.log.Infow("Restoring the best-effort edges", zap.String("reason", e.reason))
+		}
+	}
+	v := float64(0)
+	if e.degraded {
+		v = 1
+	}
+	pipelineDegraded.WithLabelValues(e.pipeline.Name).Set(v)
+}
+
+// transition transitions the degradation state, and records the transition.
+func (e *degradationEvaluator) transition(degraded bool, reason string, now time.Time) {
+	e.degraded = degraded
+	e.reason = reason
+	e.lastTransition = now
+	e.transitions = append(e.transitions, &daemon.DegradationTransition{
+		Degraded: pointer.Bool(degraded),
+		Reason:   pointer.String(reason),
+		Time:     pointer.Int64(now.UnixMilli()),
+	})
+	if len(e.transitions) > maxDegradationTransitions {
+		e.transitions = e.transitions[len(e.transitions)-maxDegradationTransitions:]
+	}
+}
+
+// breach returns the description of the first breached threshold, or empty if none of them is breached.
+func (e *degradationEvaluator) breach() string {
+	policy := e.pipeline.Spec.Degradation
+	for _, edge := range e.pipeline.ListAllEdges() {
+		m := e.edgeMetrics.GetEdgeMetrics(edge)
+		if m == nil {
+			continue
+		}
+		if policy.MaxPending != nil && m.GetPending() > *policy.MaxPending {
+			return fmt.Sprintf("pending messages of edge %q is %d, exceeding %d", m.GetEdge(), m.GetPending(), *policy.MaxPending)
+		}
+		if policy.MaxLatency != nil && m.GetWatermarkLag() > policy.MaxLatency.Milliseconds() {
+			return fmt.Sprintf("watermark lag of edge %q is %dms, exceeding %v", m.GetEdge(), m.GetWatermarkLag(), policy.MaxLatency.Duration)
+		}
+	}
+	return ""
+}
+
+// GetDegradation returns the current degradation state of the pipeline.
+func (e *degradationEvaluator) GetDegradation() *daemon.PipelineDegradation {
+	e.lock.RLock()
+	defer e.lock.RUnlock()
+	var lastTransition int64
+	if !e.lastTransition.IsZero() {
+		lastTransition = e.lastTransition.UnixMilli()
+	}
+	return &daemon.PipelineDegradation{
+		Pipeline:           pointer.String(e.pipeline.Name),
+		Degraded:           pointer.Bool(e.degraded),
+		Reason:             pointer.String(e.reason),
+		LastTransitionTime: pointer.Int64(lastTransition),
+		Transitions:        append([]*daemon.DegradationTransition(nil), e.transitions...),
+	}
+}
+
+// GetPipelineDegradation returns if the best-effort edges of the pipeline are degraded.
+func (ps *pipelineMetadataQuery) GetPipelineDegradation(ctx context.Context, req *daemon.GetPipelineDegradationRequest) (*daemon.GetPipelineDegradationResponse, error) {
+	if ps.degradation == nil {
+		return &daemon.GetPipelineDegradationResponse{Degradation: &daemon.PipelineDegradation{
+			Pipeline:           pointer.String(ps.pipeline.Name),
+			Degraded:           pointer.Bool(false),
+			Reason:             pointer.String(""),
+			LastTransitionTime: pointer.Int64(0),
+		}}, nil
+	}
+	return &daemon.GetPipelineDegradationResponse{Degradation: ps.degradation.GetDegradation()}, nil
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/apis/proto/daemon"
+)
+
+func TestDegradationEvaluator(t *testing.T) {
+	pipelineName := "simple-pipeline"
+	pipeline := &v1alpha1.Pipeline{
+		ObjectMeta: metav1.ObjectMeta{Name: pipelineName, Namespace: "default"},
+		Spec: v1alpha1.PipelineSpec{
+			Vertices: []v1alpha1.AbstractVertex{
+				{Name: "in", Source: &v1alpha1.Source{}},
+				{Name: "out", Sink: &v1alpha1.Sink{}},
+			},
+			Edges: []v1alpha1.Edge{{From: "in", To: "out"}},
+			Degradation: &v1alpha1.Degradation{
+				MaxPending:     pointer.Int64(1000),
+				MaxLatency:     &metav1.Duration{Duration: time.Second},
+				RecoveryPeriod: &metav1.Duration{Duration: time.Minute},
+			},
+		},
+	}
+	edgeMetrics := &mockEdgeMetrics{watermarkLag: 500}
+	e := NewDegradationEvaluator(context.Background(), pipeline, edgeMetrics, time.Second)
+	ps, err := NewPipelineMetadataQuery(&mockIsbSvcClient{}, pipeline, nil, nil, edgeMetrics, nil, e)
+	assert.NoError(t, err)
+	getDegradation := func() *daemon.PipelineDegradation {
+		resp, err := ps.GetPipelineDegradation(context.Background(), &daemon.GetPipelineDegradationRequest{Pipeline: &pipelineName})
+		assert.NoError(t, err)
+		return resp.GetDegradation()
+	}
+
+	now := time.Now()
+	e.evaluate(now)
+	d := getDegradation()
+	assert.False(t, d.GetDegraded())
+	assert.Equal(t, int64(0), d.GetLastTransitionTime())
+
+	edgeMetrics.watermarkLag = 5000
+	e.evaluate(now.Add(10 * time.Second))
+	d = getDegradation()
+	assert.True(t, d.GetDegraded())
+	assert.Contains(t, d.GetReason(), "watermark lag of edge")
+	assert.Equal(t, now.Add(10*time.Second).UnixMilli(), d.GetLastTransitionTime())
+
+	// not restored until it's healthy for the recovery period
+	edgeMetrics.watermarkLag = 500
+	e.evaluate(now.Add(20 * time.Second))
+	assert.True(t, getDegradation().GetDegraded())
+	edgeMetrics.watermarkLag = 5000
+	e.evaluate(now.Add(30 * time.Second))
+	edgeMetrics.watermarkLag = 500
+	e.evaluate(now.Add(40 * time.Second))
+	e.evaluate(now.Add(90 * time.Second))
+	assert.True(t, getDegradation().GetDegraded())
+	e.evaluate(now.Add(100 * time.Second))
+	d = getDegradation()
+	assert.False(t, d.GetDegraded())
+	assert.Equal(t, now.Add(100*time.Second).UnixMilli(), d.GetLastTransitionTime())
+
+	pipeline.Spec.Degradation.MaxPending = pointer.Int64(10)
+	e.evaluate(now.Add(110 * time.Second))
+	d = getDegradation()
+	assert.True(t, d.GetDegraded())
+	assert.Contains(t, d.GetReason(), "pending messages of edge")
+	transitions := d.GetTransitions()
+	assert.Equal(t, 3, len(transitions))
+	assert.True(t, transitions[0].GetDegraded())
+	assert.Equal(t, now.Add(10*time.Second).UnixMilli(), transitions[0].GetTime())
+	assert.False(t, transitions[1].GetDegraded())
+	assert.Equal(t, now.Add(100*time.Second).UnixMilli(), transitions[1].GetTime())
+	assert.True(t, transitions[2].GetDegraded())
+	assert.Equal(t, d.GetReason(), transitions[2].GetReason())
+
+	// only the recent transitions are kept
+	for i := 0; i < maxDegradationTransitions; i++ {
+		e.transition(i%2 == 0, "", now.Add(time.Duration(120+i)*time.Second))
+	}
+	transitions = getDegradation().GetTransitions()
+	assert.Equal(t, maxDegradationTransitions, len(transitions))
+	assert.Equal(t, now.Add(120*time.Second).UnixMilli(), transitions[0].GetTime())
+}
+
+func TestGetPipelineDegradation_NoPolicy(t *testing.T) {
+	pipelineName := "simple-pipeline"
+	pipeline := &v1alpha1.Pipeline{ObjectMeta: metav1.ObjectMeta{Name: pipelineName, Namespace: "default"}}
+	ps, err := NewPipelineMetadataQuery(&mockIsbSvcClient{}, pipeline, nil, nil, nil, nil, nil)
+	assert.NoError(t, err)
+	resp, err := ps.GetPipelineDegradation(context.Background(), &daemon.GetPipelineDegradationRequest{Pipeline: &pipelineName})
+	assert.NoError(t, err)
+	assert.False(t, resp.GetDegradation().GetDegraded())
+	assert.NoError(t, NewDegradationEvaluator(context.Background(), pipeline, nil, time.Second).Start(context.Background()))
+}
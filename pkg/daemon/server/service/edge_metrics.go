@@ -0,0 +1,276 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"k8s.io/utils/pointer"
+
+	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/apis/proto/daemon"
+	"github.com/numaproj/numaflow/pkg/isbsvc"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+	sharedqueue "github.com/numaproj/numaflow/pkg/shared/queue"
+	"github.com/numaproj/numaflow/pkg/watermark/fetch"
+)
+
+// latencyPercentiles are the percentiles of the processing latencies exposed by the edge metrics collector.
+var latencyPercentiles = map[string]float64{"p50": 0.5, "p90": 0.9, "p99": 0.99}
+
+// EdgeMetricsCollectable collects the watermark lag, buffer fill rate and processing latency of the edges of a pipeline.
+type EdgeMetricsCollectable interface {
+	Start(ctx context.Context) error
+	GetEdgeMetrics(edge v1alpha1.Edge) *daemon.EdgeMetrics
+	GetEndToEndLatencyPercentiles() map[string]int64
+}
+
+// edgeSample is a snapshot of an edge at a given point in time.
+type edgeSample struct {
+	// timestamp in milliseconds
+	timestamp int64
+	// watermarkLag in milliseconds, -1 means not available
+	watermarkLag int64
+	// pending is the sum of pending and ack pending messages of all the partitions
+	pending int64
+	// bufferUsage is the highest usage of the partitions
+	bufferUsage float64
+}
+
+type edgeMetricsOptions struct {
+	// interval to take a sample of the edges
+	interval time.Duration
+	// window is the time window the fill rates and latency percentiles are calculated over
+	window time.Duration
+}
+
+type EdgeMetricsOption func(*edgeMetricsOptions)
+
+// WithEdgeMetricsInterval sets the interval of taking samples of the edges
+func WithEdgeMetricsInterval(d time.Duration) EdgeMetricsOption {
+	return func(o *edgeMetricsOptions) {
+		o.interval = d
+	}
+}
+
+// WithEdgeMetricsWindow sets the time window the fill rates and latency percentiles are calculated over
+func WithEdgeMetricsWindow(d time.Duration) EdgeMetricsOption {
+	return func(o *edgeMetricsOptions) {
+		o.window = d
+	}
+}
+
+// edgeMetricsCollector periodically samples the head watermarks and the buffer information of each edge,
+// and computes the metrics from the samples in the time window.
+type edgeMetricsCollector struct {
+	pipeline          *v1alpha1.Pipeline
+	isbSvcClient      isbsvc.ISBService
+	watermarkFetchers map[v1alpha1.Edge][]fetch.UXFetcher
+	log               *zap.SugaredLogger
+	options           *edgeMetricsOptions
+	// samples is a map between edge name and a queue of the samples of that edge
+	samples map[string]*sharedqueue.OverflowQueue[*edgeSample]
+	// endToEndLatencies is a queue of the end-to-end latencies in milliseconds
+	endToEndLatencies *sharedqueue.OverflowQueue[int64]
+	lock              sync.RWMutex
+	// latest is a map between edge name and the latest metrics of that edge
+	latest map[string]*daemon.EdgeMetrics
+}
+
+// NewEdgeMetricsCollector returns a new edge metrics collector of the pipeline
+func NewEdgeMetricsCollector(ctx context.Context, pl *v1alpha1.Pipeline, isbSvcClient isbsvc.ISBService, wmFetchers map[v1alpha1.Edge][]fetch.UXFetcher, opts ...EdgeMetricsOption) *edgeMetricsCollector {
+	o := &edgeMetricsOptions{
+		interval: 10 * time.Second,
+		window:   5 * time.Minute,
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(o)
+		}
+	}
+	size := int(o.window/o.interval) + 1
+	c := &edgeMetricsCollector{
+		pipeline:          pl,
+		isbSvcClient:      isbSvcClient,
+		watermarkFetchers: wmFetchers,
+		log:               logging.FromContext(ctx).Named("EdgeMetricsCollector"),
+		options:           o,
+		samples:           make(map[string]*sharedqueue.OverflowQueue[*edgeSample]),
+		endToEndLatencies: sharedqueue.New[int64](size),
+		latest:            make(map[string]*daemon.EdgeMetrics),
+	}
+	for _, e := range pl.ListAllEdges() {
+		c.samples[e.GetEdgeName()] = sharedqueue.New[*edgeSample](size)
+	}
+	return c
+}
+
+// Start starts sampling the edges until the context is done
+func (c *edgeMetricsCollector) Start(ctx context.Context) error {
+	c.log.Info("Starting edge metrics collector...")
+	ticker := time.NewTicker(c.options.interval)
+	defer ticker.Stop()
+	for {
+		c.collect(ctx)
+		select {
+		case <-ctx.Done():
+			c.log.Info("Shutting down edge metrics collector")
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// collect takes a sample of each edge, and refreshes the metrics.
+func (c *edgeMetricsCollector) collect(ctx context.Context) {
+	now := time.Now().UnixMilli()
+	latest := make(map[string]*daemon.EdgeMetrics)
+	endToEndLatency := int64(-1)
+	for _, e := range c.pipeline.ListAllEdges() {
+		edgeName := e.GetEdgeName()
+		sample := c.sample(ctx, e, now)
+		q := c.samples[edgeName]
+		q.Append(sample)
+		m := &daemon.EdgeMetrics{
+			Pipeline:           pointer.String(c.pipeline.Name),
+			Edge:               pointer.String(edgeName),
+			FromVertex:         pointer.String(e.From),
+			ToVertex:           pointer.String(e.To),
+			WatermarkLag:       pointer.Int64(sample.watermarkLag),
+			Pending:            pointer.Int64(sample.pending),
+			BufferUsage:        pointer.Float64(sample.bufferUsage),
+			BufferFillRate:     pointer.Float64(fillRate(q.Items())),
+			LatencyPercentiles: percentiles(watermarkLags(q.Items())),
+		}
+		latest[edgeName] = m
+		if c.pipeline.GetVertex(e.To).IsASink() && sample.watermarkLag > endToEndLatency {
+			endToEndLatency = sample.watermarkLag
+		}
+		if sample.watermarkLag >= 0 {
+			edgeWatermarkLag.WithLabelValues(c.pipeline.Name, edgeName).Set(float64(sample.watermarkLag))
+		}
+		edgeBufferFillRate.WithLabelValues(c.pipeline.Name, edgeName).Set(m.GetBufferFillRate())
+		for quantile, v := range m.LatencyPercentiles {
+			edgeProcessingLatency.WithLabelValues(c.pipeline.Name, edgeName, quantile).Set(float64(v))
+		}
+	}
+	if endToEndLatency >= 0 {
+		c.endToEndLatencies.Append(endToEndLatency)
+	}
+	for quantile, v := range percentiles(c.endToEndLatencies.Items()) {
+		pipelineProcessingLatency.WithLabelValues(c.pipeline.Name, quantile).Set(float64(v))
+	}
+	c.lock.Lock()
+	c.latest = latest
+	c.lock.Unlock()
+}
+
+// sample takes a snapshot of the edge.
+func (c *edgeMetricsCollector) sample(ctx context.Context, e v1alpha1.Edge, now int64) *edgeSample {
+	s := &edgeSample{timestamp: now, watermarkLag: -1}
+	if fetchers, ok := c.watermarkFetchers[e]; ok && !c.pipeline.Spec.Watermark.Disabled {
+		minWatermark := int64(math.MaxInt64)
+		for _, wm := range headWatermarks(c.pipeline, e, fetchers) {
+			if wm < minWatermark {
+				minWatermark = wm
+			}
+		}
+		// A negative watermark means it is not available yet.
+		if minWatermark >= 0 && minWatermark != math.MaxInt64 {
+			s.watermarkLag = now - minWatermark
+			if s.watermarkLag < 0 {
+				s.watermarkLag = 0
+			}
+		}
+	}
+	toVertex := c.pipeline.GetVertex(e.To)
+	bufferLength, _ := getBufferLimits(c.pipeline, *toVertex)
+	for _, buffer := range toVertex.OwnedBufferNames(c.pipeline.Namespace, c.pipeline.Name) {
+		bufferInfo, err := c.isbSvcClient.GetBufferInfo(ctx, buffer)
+		if err != nil {
+			c.log.Warnw("Failed to get buffer information", zap.String("buffer", buffer), zap.Error(err))
+			continue
+		}
+		pending := bufferInfo.PendingCount + bufferInfo.AckPendingCount
+		s.pending += pending
+		if usage := float64(pending) / float64(bufferLength); usage > s.bufferUsage {
+			s.bufferUsage = usage
+		}
+	}
+	return s
+}
+
+// GetEdgeMetrics returns the latest metrics of the edge, nil if it has not been collected yet.
+func (c *edgeMetricsCollector) GetEdgeMetrics(edge v1alpha1.Edge) *daemon.EdgeMetrics {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.latest[edge.GetEdgeName()]
+}
+
+// GetEndToEndLatencyPercentiles returns the percentiles of the end-to-end processing latency in milliseconds,
+// which is the highest watermark lag of the edges to the sinks.
+func (c *edgeMetricsCollector) GetEndToEndLatencyPercentiles() map[string]int64 {
+	return percentiles(c.endToEndLatencies.Items())
+}
+
+// fillRate returns the changing rate of the pending messages per second over the samples.
+func fillRate(samples []*edgeSample) float64 {
+	if len(samples) < 2 {
+		return 0
+	}
+	first, last := samples[0], samples[len(samples)-1]
+	seconds := float64(last.timestamp-first.timestamp) / 1000
+	if seconds <= 0 {
+		return 0
+	}
+	return float64(last.pending-first.pending) / seconds
+}
+
+// watermarkLags returns the available watermark lags of the samples.
+func watermarkLags(samples []*edgeSample) []int64 {
+	var r []int64
+	for _, s := range samples {
+		if s.watermarkLag >= 0 {
+			r = append(r, s.watermarkLag)
+		}
+	}
+	return r
+}
+
+// percentiles returns the nearest-rank percentiles of the values, nil if there is no value.
+func percentiles(values []int64) map[string]int64 {
+	if len(values) == 0 {
+		return nil
+	}
+	sorted := make([]int64, len(values))
+	copy(sorted, values)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	r := make(map[string]int64, len(latencyPercentiles))
+	for name, p := range latencyPercentiles {
+		idx := int(math.Ceil(p*float64(len(sorted)))) - 1
+		if idx < 0 {
+			idx = 0
+		}
+		r[name] = sorted[idx]
+	}
+	return r
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/apis/proto/daemon"
+	"github.com/numaproj/numaflow/pkg/watermark/fetch"
+	"github.com/numaproj/numaflow/pkg/watermark/wmb"
+)
+
+type mockUXFetcher struct {
+	watermark time.Time
+}
+
+func (f *mockUXFetcher) ComputeHeadWatermark(fromPartitionIdx int32) wmb.Watermark {
+	return wmb.Watermark(f.watermark)
+}
+
+func TestPercentiles(t *testing.T) {
+	assert.Nil(t, percentiles(nil))
+	var values []int64
+	for i := 100; i > 0; i-- {
+		values = append(values, int64(i))
+	}
+	r := percentiles(values)
+	assert.Equal(t, int64(50), r["p50"])
+	assert.Equal(t, int64(90), r["p90"])
+	assert.Equal(t, int64(99), r["p99"])
+	// The input should not be sorted in place.
+	assert.Equal(t, int64(100), values[0])
+	assert.Equal(t, map[string]int64{"p50": 7, "p90": 7, "p99": 7}, percentiles([]int64{7}))
+}
+
+func TestFillRate(t *testing.T) {
+	assert.Equal(t, float64(0), fillRate(nil))
+	assert.Equal(t, float64(0), fillRate([]*edgeSample{{timestamp: 1000, pending: 10}}))
+	assert.Equal(t, float64(5), fillRate([]*edgeSample{{timestamp: 1000, pending: 10}, {timestamp: 5000, pending: 20}, {timestamp: 11000, pending: 60}}))
+	assert.Equal(t, float64(-2), fillRate([]*edgeSample{{timestamp: 1000, pending: 30}, {timestamp: 11000, pending: 10}}))
+}
+
+func TestEdgeMetricsCollector(t *testing.T) {
+	pipelineName := "simple-pipeline"
+	pipeline := &v1alpha1.Pipeline{
+		ObjectMeta: metav1.ObjectMeta{Name: pipelineName, Namespace: "default"},
+		Spec: v1alpha1.PipelineSpec{
+			Vertices: []v1alpha1.AbstractVertex{
+				{Name: "in", Source: &v1alpha1.Source{}},
+				{Name: "cat", UDF: &v1alpha1.UDF{}},
+				{Name: "out", Sink: &v1alpha1.Sink{}},
+			},
+			Edges: []v1alpha1.Edge{
+				{From: "in", To: "cat"},
+				{From: "cat", To: "out"},
+			},
+		},
+	}
+	now := time.Now()
+	wmFetchers := map[v1alpha1.Edge][]fetch.UXFetcher{
+		{From: "in", To: "cat"}:  {&mockUXFetcher{watermark: now.Add(-2 * time.Second)}},
+		{From: "cat", To: "out"}: {&mockUXFetcher{watermark: now.Add(-5 * time.Second)}},
+	}
+	c := NewEdgeMetricsCollector(context.Background(), pipeline, &mockIsbSvcClient{}, wmFetchers)
+	assert.Nil(t, c.GetEdgeMetrics(v1alpha1.Edge{From: "in", To: "cat"}))
+	c.collect(context.Background())
+
+	m := c.GetEdgeMetrics(v1alpha1.Edge{From: "in", To: "cat"})
+	assert.NotNil(t, m)
+	assert.Equal(t, "in-cat", m.GetEdge())
+	assert.Equal(t, "in", m.GetFromVertex())
+	assert.Equal(t, "cat", m.GetToVertex())
+	assert.GreaterOrEqual(t, m.GetWatermarkLag(), int64(2000))
+	assert.Less(t, m.GetWatermarkLag(), int64(5000))
+	assert.Equal(t, int64(25), m.GetPending())
+	assert.Equal(t, float64(25)/float64(*pipeline.GetPipelineLimits().BufferMaxLength), m.GetBufferUsage())
+	assert.Equal(t, float64(0), m.GetBufferFillRate())
+	assert.Equal(t, m.GetWatermarkLag(), m.LatencyPercentiles["p99"])
+
+	m = c.GetEdgeMetrics(v1alpha1.Edge{From: "cat", To: "out"})
+	assert.NotNil(t, m)
+	assert.GreaterOrEqual(t, m.GetWatermarkLag(), int64(5000))
+	assert.Equal(t, m.GetWatermarkLag(), c.GetEndToEndLatencyPercentiles()["p50"])
+
+	ps, err := NewPipelineMetadataQuery(&mockIsbSvcClient{}, pipeline, wmFetchers, nil, c, nil, nil)
+	assert.NoError(t, err)
+	resp, err := ps.GetPipelineEdgeMetrics(context.Background(), &daemon.GetPipelineEdgeMetricsRequest{Pipeline: &pipelineName})
+	assert.NoError(t, err)
+	assert.Len(t, resp.EdgeMetrics, 2)
+	assert.Len(t, resp.EndToEndLatencyPercentiles, 3)
+}
+
+func TestEdgeMetricsCollector_WatermarkNotAvailable(t *testing.T) {
+	pipeline := &v1alpha1.Pipeline{
+		ObjectMeta: metav1.ObjectMeta{Name: "simple-pipeline", Namespace: "default"},
+		Spec: v1alpha1.PipelineSpec{
+			Vertices: []v1alpha1.AbstractVertex{
+				{Name: "in", Source: &v1alpha1.Source{}},
+				{Name: "out", Sink: &v1alpha1.Sink{}},
+			},
+			Edges: []v1alpha1.Edge{{From: "in", To: "out"}},
+		},
+	}
+	wmFetchers := map[v1alpha1.Edge][]fetch.UXFetcher{
+		{From: "in", To: "out"}: {&mockUXFetcher{watermark: time.UnixMilli(-1)}},
+	}
+	c := NewEdgeMetricsCollector(context.Background(), pipeline, &mockIsbSvcClient{}, wmFetchers)
+	c.collect(context.Background())
+	m := c.GetEdgeMetrics(v1alpha1.Edge{From: "in", To: "out"})
+	assert.NotNil(t, m)
+	assert.Equal(t, int64(-1), m.GetWatermarkLag())
+	assert.Nil(t, m.LatencyPercentiles)
+	assert.Nil(t, c.GetEndToEndLatencyPercentiles())
+}
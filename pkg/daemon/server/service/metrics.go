@@ -0,0 +1,95 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/numaproj/numaflow/pkg/metrics"
+)
+
+const (
+	LabelEdge      = "edge"
+	LabelQuantile  = "quantile"
+	LabelBuffer    = "buffer"
+	LabelOperation = "operation"
+	LabelResult    = "result"
+)
+
+// edgeWatermarkLag indicates the watermark lag of an edge in milliseconds
+var edgeWatermarkLag = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Subsystem: "pipeline_edge",
+	Name:      "watermark_lag_milliseconds",
+	Help:      "The difference between current time and the head watermark of an edge in milliseconds",
+}, []string{metrics.LabelPipeline, LabelEdge})
+
+// edgeBufferFillRate indicates the changing rate of the pending messages in the buffers of an edge
+var edgeBufferFillRate = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Subsystem: "pipeline_edge",
+	Name:      "buffer_fill_rate",
+	Help:      "Changing rate of the pending messages per second in the buffers of an edge, a positive value means it is falling behind",
+}, []string{metrics.LabelPipeline, LabelEdge})
+
+// edgeProcessingLatency indicates the percentiles of the processing latency from the source to an edge
+var edgeProcessingLatency = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Subsystem: "pipeline_edge",
+	Name:      "processing_latency_milliseconds",
+	Help:      "Percentiles of the processing latency from the source to an edge in milliseconds",
+}, []string{metrics.LabelPipeline, LabelEdge, LabelQuantile})
+
+// pipelineProcessingLatency indicates the percentiles of the end-to-end processing latency of a pipeline
+var pipelineProcessingLatency = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Subsystem: "pipeline",
+	Name:      "processing_latency_milliseconds",
+	Help:      "Percentiles of the end-to-end processing latency of a pipeline in milliseconds",
+}, []string{metrics.LabelPipeline, LabelQuantile})
+
+// bufferOperations indicates the number of the drain and skip operations on the buffers
+var bufferOperations = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "pipeline_buffer",
+	Name:      "operations_total",
+	Help:      "Total number of the drain and skip operations on the buffers of a pipeline",
+}, []string{metrics.LabelPipeline, LabelBuffer, LabelOperation, LabelResult})
+
+// bufferOperationMessages indicates the number of the messages discarded by the drain and skip operations
+var bufferOperationMessages = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "pipeline_buffer",
+	Name:      "discarded_messages_total",
+	Help:      "Total number of the messages discarded by the drain and skip operations on the buffers of a pipeline",
+}, []string{metrics.LabelPipeline, LabelBuffer, LabelOperation})
+
+// sourceOffsetsResets indicates the number of the offsets resets of the source vertices
+var sourceOffsetsResets = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "pipeline_source",
+	Name:      "offsets_resets_total",
+	Help:      "Total number of the offsets resets of the source vertices of a pipeline",
+}, []string{metrics.LabelPipeline, metrics.LabelVertex, LabelResult})
+
+// pipelineDegraded indicates if the best-effort edges of a pipeline are degraded
+var pipelineDegraded = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Subsystem: "pipeline",
+	Name:      "degraded",
+	Help:      "Whether the best-effort edges of a pipeline are degraded by the degradation policy, 1 means degraded",
+}, []string{metrics.LabelPipeline})
+
+// bulkOperations indicates the number of the bulk operations across the vertices or the side inputs
+var bulkOperations = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "pipeline",
+	Name:      "bulk_operations_total",
+	Help:      "Total number of the bulk operations across the vertices or the side inputs of a pipeline, the result is success, partial or failure",
+}, []string{metrics.LabelPipeline, LabelOperation, LabelResult})
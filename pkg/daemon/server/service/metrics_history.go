@@ -0,0 +1,318 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"time"
+
+	"go.uber.org/zap"
+	"k8s.io/utils/pointer"
+
+	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/apis/proto/daemon"
+	server "github.com/numaproj/numaflow/pkg/daemon/server/service/rater"
+	"github.com/numaproj/numaflow/pkg/shared/kvs"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+	sharedqueue "github.com/numaproj/numaflow/pkg/shared/queue"
+)
+
+const (
+	HistoryMetricProcessingRate = "processingRate"
+	HistoryMetricPending        = "pending"
+	HistoryMetricWatermarkLag   = "watermarkLag"
+)
+
+// historyPointSize is the size of an encoded point, the timestamp in seconds as an uint32 and the value as a float32.
+const historyPointSize = 8
+
+// MetricsHistoryQueryable keeps the history of the processing rates, pending messages and watermark lags of a pipeline.
+type MetricsHistoryQueryable interface {
+	Start(ctx context.Context) error
+	GetHistory(since int64) []*daemon.MetricsHistory
+}
+
+type historyPoint struct {
+	// timestamp in seconds
+	timestamp uint32
+	value     float32
+}
+
+// historySeries is the history of a metric of a vertex or an edge.
+type historySeries struct {
+	metric string
+	vertex string
+	edge   string
+	points *sharedqueue.OverflowQueue[historyPoint]
+}
+
+// key returns the key of the series in the store.
+func (s *historySeries) key() string {
+	if s.vertex != "" {
+		return fmt.Sprintf("vertex.%s.%s", s.vertex, s.metric)
+	}
+	return fmt.Sprintf("edge.%s.%s", s.edge, s.metric)
+}
+
+type metricsHistoryOptions struct {
+	// interval to take a sample of the metrics
+	interval time.Duration
+	// retention of the samples
+	retention time.Duration
+	// persistInterval is the interval of saving the samples to the store
+	persistInterval time.Duration
+	// store to save the samples, so that they are kept when the daemon server restarts
+	store kvs.KVStorer
+}
+
+type MetricsHistoryOption func(*metricsHistoryOptions)
+
+// WithMetricsHistoryInterval sets the interval of taking samples of the metrics
+func WithMetricsHistoryInterval(d time.Duration) MetricsHistoryOption {
+	return func(o *metricsHistoryOptions) {
+		o.interval = d
+	}
+}
+
+// WithMetricsHistoryRetention sets how long the samples are kept
+func WithMetricsHistoryRetention(d time.Duration) MetricsHistoryOption {
+	return func(o *metricsHistoryOptions) {
+		o.retention = d
+	}
+}
+
+// WithMetricsHistoryStore sets the store to save the samples, and the interval of saving them
+func WithMetricsHistoryStore(store kvs.KVStorer, persistInterval time.Duration) MetricsHistoryOption {
+	return func(o *metricsHistoryOptions) {
+		o.store = store
+		o.persistInterval = persistInterval
+	}
+}
+
+// metricsHistory periodically samples the processing rates of the vertices from the rater, and the pending messages
+// and watermark lags of the edges from the edge metrics collector, the samples are kept in a ring of each metric.
+type metricsHistory struct {
+	pipeline    *v1alpha1.Pipeline
+	rater       server.Ratable
+	edgeMetrics EdgeMetricsCollectable
+	log         *zap.SugaredLogger
+	options     *metricsHistoryOptions
+	series      []*historySeries
+}
+
+// NewMetricsHistory returns a new metrics history of the pipeline
+func NewMetricsHistory(ctx context.Context, pl *v1alpha1.Pipeline, rater server.Ratable, edgeMetrics EdgeMetricsCollectable, opts ...MetricsHistoryOption) *metricsHistory {
+	o := &metricsHistoryOptions{
+		interval:        time.Minute,
+		retention:       24 * time.Hour,
+		persistInterval: 5 * time.Minute,
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(o)
+		}
+	}
+	size := int(o.retention / o.interval)
+	h := &metricsHistory{
+		pipeline:    pl,
+		rater:       rater,
+		edgeMetrics: edgeMetrics,
+		log:         logging.FromContext(ctx).Named("MetricsHistory"),
+		options:     o,
+	}
+	for _, v := range pl.Spec.Vertices {
+		h.series = append(h.series, &historySeries{metric: HistoryMetricProcessingRate, vertex: v.Name, points: sharedqueue.New[historyPoint](size)})
+	}
+	for _, e := range pl.ListAllEdges() {
+		for _, metric := range []string{HistoryMetricPending, HistoryMetricWatermarkLag} {
+			h.series = append(h.series, &historySeries{metric: metric, edge: e.GetEdgeName(), points: sharedqueue.New[historyPoint](size)})
+		}
+	}
+	return h
+}
+
+// Start loads the saved samples, then keeps sampling the metrics until the context is done.
+func (h *metricsHistory) Start(ctx context.Context) error {
+	h.log.Info("Starting metrics history...")
+	if h.options.store != nil {
+		h.load(ctx)
+	}
+	ticker := time.NewTicker(h.options.interval)
+	defer ticker.Stop()
+	lastSaved := time.Now()
+	for {
+		select {
+		case <-ctx.Done():
+			if h.options.store != nil {
+				// the context is done, use a new one to save for the last time
+				h.save(context.Background())
+			}
+			h.log.Info("Shutting down metrics history")
+			return nil
+		case t := <-ticker.C:
+			h.collect(t)
+			if h.options.store != nil && t.Sub(lastSaved) >= h.options.persistInterval {
+				h.save(ctx)
+				lastSaved = t
+			}
+		}
+	}
+}
+
+// collect takes a sample of each metric, the metrics not available at the time are skipped.
+func (h *metricsHistory) collect(t time.Time) {
+	ts := uint32(t.Unix())
+	for _, s := range h.series {
+		var value float64
+		if s.vertex != "" {
+			rate, ok := h.processingRate(s.vertex)
+			if !ok {
+				continue
+			}
+			value = rate
+		} else {
+			m := h.edgeMetrics.GetEdgeMetrics(h.getEdge(s.edge))
+			if m == nil {
+				continue
+			}
+			switch s.metric {
+			case HistoryMetricPending:
+				value = float64(m.GetPending())
+			case HistoryMetricWatermarkLag:
+				if m.GetWatermarkLag() < 0 {
+					continue
+				}
+				value = float64(m.GetWatermarkLag())
+			}
+		}
+		s.points.Append(historyPoint{timestamp: ts, value: float32(value)})
+	}
+}
+
+// processingRate returns the processing rate of the vertex, which is the sum of the rates of all the partitions.
+func (h *metricsHistory) processingRate(vertexName string) (float64, bool) {
+	v := h.pipeline.GetVertex(vertexName)
+	partitions := v.OwnedBufferNames(h.pipeline.Namespace, h.pipeline.Name)
+	if v.IsASource() {
+		// source vertex has a single partition, which is the vertex name itself
+		partitions = append(partitions, vertexName)
+	}
+	var total float64
+	found := false
+	for _, p := range partitions {
+		if rate, ok := h.rater.GetRates(vertexName, p)["default"]; ok && rate >= 0 {
+			total += rate
+			found = true
+		}
+	}
+	return total, found
+}
+
+func (h *metricsHistory) getEdge(edgeName string) v1alpha1.Edge {
+	for _, e := range h.pipeline.ListAllEdges() {
+		if e.GetEdgeName() == edgeName {
+			return e
+		}
+	}
+	return v1alpha1.Edge{}
+}
+
+// GetHistory returns the samples since the timestamp in milliseconds.
+func (h *metricsHistory) GetHistory(since int64) []*daemon.MetricsHistory {
+	var result []*daemon.MetricsHistory
+	for _, s := range h.series {
+		mh := &daemon.MetricsHistory{
+			Pipeline: pointer.String(h.pipeline.Name),
+			Metric:   pointer.String(s.metric),
+		}
+		if s.vertex != "" {
+			mh.Vertex = pointer.String(s.vertex)
+		} else {
+			mh.Edge = pointer.String(s.edge)
+		}
+		for _, p := range s.points.Items() {
+			ts := int64(p.timestamp) * 1000
+			if ts < since {
+				continue
+			}
+			mh.Points = append(mh.Points, &daemon.MetricsHistoryPoint{Timestamp: pointer.Int64(ts), Value: pointer.Float64(float64(p.value))})
+		}
+		result = append(result, mh)
+	}
+	return result
+}
+
+// save saves the samples of each metric to the store.
+func (h *metricsHistory) save(ctx context.Context) {
+	for _, s := range h.series {
+		if err := h.options.store.PutKV(ctx, s.key(), encodeHistoryPoints(s.points.Items())); err != nil {
+			h.log.Warnw("Failed to save the metrics history", zap.String("key", s.key()), zap.Error(err))
+		}
+	}
+}
+
+// load loads the saved samples within the retention, the ones of the deleted vertices and edges are ignored.
+func (h *metricsHistory) load(ctx context.Context) {
+	keys, err := h.options.store.GetAllKeys(ctx)
+	if err != nil {
+		h.log.Warnw("Failed to list the saved metrics history", zap.Error(err))
+		return
+	}
+	saved := make(map[string]bool, len(keys))
+	for _, k := range keys {
+		saved[k] = true
+	}
+	oldest := uint32(time.Now().Add(-h.options.retention).Unix())
+	for _, s := range h.series {
+		if !saved[s.key()] {
+			continue
+		}
+		value, err := h.options.store.GetValue(ctx, s.key())
+		if err != nil {
+			h.log.Warnw("Failed to load the metrics history", zap.String("key", s.key()), zap.Error(err))
+			continue
+		}
+		for _, p := range decodeHistoryPoints(value) {
+			if p.timestamp >= oldest {
+				s.points.Append(p)
+			}
+		}
+	}
+}
+
+func encodeHistoryPoints(points []historyPoint) []byte {
+	b := make([]byte, len(points)*historyPointSize)
+	for i, p := range points {
+		binary.BigEndian.PutUint32(b[i*historyPointSize:], p.timestamp)
+		binary.BigEndian.PutUint32(b[i*historyPointSize+4:], math.Float32bits(p.value))
+	}
+	return b
+}
+
+func decodeHistoryPoints(b []byte) []historyPoint {
+	points := make([]historyPoint, 0, len(b)/historyPointSize)
+	for i := 0; i+historyPointSize <= len(b); i += historyPointSize {
+		points = append(points, historyPoint{
+			timestamp: binary.BigEndian.Uint32(b[i:]),
+			value:     math.Float32frombits(binary.BigEndian.Uint32(b[i+4:])),
+		})
+	}
+	return points
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/apis/proto/daemon"
+	"github.com/numaproj/numaflow/pkg/shared/kvs/inmem"
+)
+
+type mockEdgeMetrics struct {
+	watermarkLag int64
+}
+
+func (m *mockEdgeMetrics) Start(ctx context.Context) error {
+	return nil
+}
+
+func (m *mockEdgeMetrics) GetEdgeMetrics(edge v1alpha1.Edge) *daemon.EdgeMetrics {
+	return &daemon.EdgeMetrics{Pending: pointer.Int64(100), WatermarkLag: pointer.Int64(m.watermarkLag)}
+}
+
+func (m *mockEdgeMetrics) GetEndToEndLatencyPercentiles() map[string]int64 {
+	return nil
+}
+
+func TestMetricsHistory(t *testing.T) {
+	ctx := context.Background()
+	pipeline := &v1alpha1.Pipeline{
+		ObjectMeta: metav1.ObjectMeta{Name: "simple-pipeline", Namespace: "numaflow-system"},
+		Spec: v1alpha1.PipelineSpec{
+			Vertices: []v1alpha1.AbstractVertex{
+				{Name: "in", Source: &v1alpha1.Source{}},
+				{Name: "cat", UDF: &v1alpha1.UDF{}},
+			},
+			Edges: []v1alpha1.Edge{{From: "in", To: "cat"}},
+		},
+	}
+	store, entries, _ := inmem.NewKVInMemKVStore(ctx, "metrics-history")
+	go func() {
+		for range entries {
+		}
+	}()
+	edgeMetrics := &mockEdgeMetrics{watermarkLag: -1}
+	h := NewMetricsHistory(ctx, pipeline, &mockRater_TestGetVertexMetrics{}, edgeMetrics, WithMetricsHistoryStore(store, time.Minute))
+	now := time.Now().Truncate(time.Second)
+	h.collect(now.Add(-time.Minute))
+	edgeMetrics.watermarkLag = 2000
+	h.collect(now)
+
+	history := map[string][]*daemon.MetricsHistoryPoint{}
+	for _, mh := range h.GetHistory(0) {
+		history[mh.GetVertex()+mh.GetEdge()+"/"+mh.GetMetric()] = mh.Points
+	}
+	assert.Len(t, history["in/processingRate"], 2)
+	assert.InDelta(t, 4.894736842105263, history["cat/processingRate"][1].GetValue(), 0.0001)
+	assert.Len(t, history["in-cat/pending"], 2)
+	// the watermark lag is not available in the first sample
+	assert.Len(t, history["in-cat/watermarkLag"], 1)
+	assert.Equal(t, now.UnixMilli(), history["in-cat/watermarkLag"][0].GetTimestamp())
+	assert.Equal(t, float64(2000), history["in-cat/watermarkLag"][0].GetValue())
+	for _, mh := range h.GetHistory(now.UnixMilli()) {
+		assert.Len(t, mh.Points, 1)
+	}
+
+	// the samples are restored by a new history from the store
+	h.save(ctx)
+	restored := NewMetricsHistory(ctx, pipeline, &mockRater_TestGetVertexMetrics{}, edgeMetrics, WithMetricsHistoryStore(store, time.Minute))
+	restored.load(ctx)
+	assert.Equal(t, h.GetHistory(0), restored.GetHistory(0))
+}
@@ -38,10 +38,11 @@ import (
 	"github.com/numaproj/numaflow/pkg/watermark/fetch"
 )
 
-// metricsHttpClient interface for the GET call to metrics endpoint.
+// metricsHttpClient interface for the calls to the metrics endpoint.
 // Had to add this an interface for testing
 type metricsHttpClient interface {
 	Get(url string) (*http.Response, error)
+	Do(req *http.Request) (*http.Response, error)
 }
 
 // pipelineMetadataQuery has the metadata required for the pipeline queries
@@ -51,6 +52,10 @@ type pipelineMetadataQuery struct {
 	httpClient        metricsHttpClient
 	watermarkFetchers map[v1alpha1.Edge][]fetch.UXFetcher
 	rater             server.Ratable
+	edgeMetrics       EdgeMetricsCollectable
+	metricsHistory    MetricsHistoryQueryable
+	degradation       DegradationEvaluable
+	offsetsResetter   sourceOffsetsResetter
 }
 
 const (
@@ -64,7 +69,10 @@ func NewPipelineMetadataQuery(
 	isbSvcClient isbsvc.ISBService,
 	pipeline *v1alpha1.Pipeline,
 	wmFetchers map[v1alpha1.Edge][]fetch.UXFetcher,
-	rater server.Ratable) (*pipelineMetadataQuery, error) {
+	rater server.Ratable,
+	edgeMetrics EdgeMetricsCollectable,
+	metricsHistory MetricsHistoryQueryable,
+	degradation DegradationEvaluable) (*pipelineMetadataQuery, error) {
 	var err error
 	ps := pipelineMetadataQuery{
 		isbSvcClient: isbSvcClient,
@@ -77,6 +85,10 @@ func NewPipelineMetadataQuery(
 		},
 		watermarkFetchers: wmFetchers,
 		rater:             rater,
+		edgeMetrics:       edgeMetrics,
+		metricsHistory:    metricsHistory,
+		degradation:       degradation,
+		offsetsResetter:   resetSourceOffsets,
 	}
 	if err != nil {
 		return nil, err
@@ -207,7 +219,7 @@ func (ps *pipelineMetadataQuery) getPending(ctx context.Context, req *daemon.Get
 		// Get the headless service name
 		// We can query the metrics endpoint of the (i)th pod to obtain this value.
 		// example for 0th pod : https://simple-pipeline-in-0.simple-pipeline-in-headless.default.svc:2469/metrics
-		url := fmt.Sprintf("https://%s-%v.%s.%s.svc:%v/metrics", vertexName, idx, headlessServiceName, ps.pipeline.Namespace, v1alpha1.VertexMetricsPort)
+		url := fmt.Sprintf("https://%s-%v.%s.%s.svc:%v/metrics", vertexName, idx, headlessServiceName, abstractVertex.GetPodNamespace(ps.pipeline.Namespace), v1alpha1.VertexMetricsPort)
 		if res, err := ps.httpClient.Get(url); err != nil {
 			log.Debugf("Error reading the metrics endpoint, it might be because of vertex scaling down to 0: %f", err.Error())
 			return nil
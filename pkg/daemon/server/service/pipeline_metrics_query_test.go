@@ -37,12 +37,17 @@ type mockGetType func(url string) (*http.Response, error)
 
 type mockHttpClient struct {
 	MockGet mockGetType
+	MockDo  func(req *http.Request) (*http.Response, error)
 }
 
 func (m *mockHttpClient) Get(url string) (*http.Response, error) {
 	return m.MockGet(url)
 }
 
+func (m *mockHttpClient) Do(req *http.Request) (*http.Response, error) {
+	return m.MockDo(req)
+}
+
 type mockIsbSvcClient struct {
 }
 
@@ -71,6 +76,26 @@ func (ms *mockIsbSvcClient) CreateProcessorManagers(ctx context.Context, bucketN
 	return nil, nil
 }
 
+func (ms *mockIsbSvcClient) DrainBuffer(ctx context.Context, buffer string) (int64, error) {
+	return 10, nil
+}
+
+func (ms *mockIsbSvcClient) SkipBuffer(ctx context.Context, buffer string) (int64, error) {
+	return 25, nil
+}
+
+func (ms *mockIsbSvcClient) ResetWatermarks(ctx context.Context, buckets []string) error {
+	return nil
+}
+
+func (ms *mockIsbSvcClient) DeleteSideInputs(ctx context.Context, sideInputsStore string, sideInputs []string) error {
+	return nil
+}
+
+func (ms *mockIsbSvcClient) RefreshSideInput(ctx context.Context, sideInputsStore, sideInput string) error {
+	return nil
+}
+
 // mock rater
 type mockRater_TestGetVertexMetrics struct {
 }
@@ -79,6 +104,10 @@ func (mr *mockRater_TestGetVertexMetrics) Start(ctx context.Context) error {
 	return nil
 }
 
+func (mr *mockRater_TestGetVertexMetrics) GetCounterRates(vertexName, counterName, partitionName string) map[string]float64 {
+	return map[string]float64{}
+}
+
 func (mr *mockRater_TestGetVertexMetrics) GetRates(vertexName string, partitionName string) map[string]float64 {
 	res := make(map[string]float64)
 	res["default"] = 4.894736842105263
@@ -97,7 +126,7 @@ func TestGetVertexMetrics(t *testing.T) {
 		Spec:       v1alpha1.PipelineSpec{Vertices: []v1alpha1.AbstractVertex{{Name: vertexName, Partitions: &vertexPartition}}},
 	}
 	client, _ := isbsvc.NewISBJetStreamSvc(pipelineName)
-	pipelineMetricsQueryService, err := NewPipelineMetadataQuery(client, pipeline, nil, &mockRater_TestGetVertexMetrics{})
+	pipelineMetricsQueryService, err := NewPipelineMetadataQuery(client, pipeline, nil, &mockRater_TestGetVertexMetrics{}, nil, nil, nil)
 	assert.NoError(t, err)
 
 	metricsResponse := `# HELP vertex_pending_messages Average pending messages in the last period of seconds. It is the pending messages of a vertex, not a pod.
@@ -165,7 +194,7 @@ func TestGetBuffer(t *testing.T) {
 	}
 
 	ms := &mockIsbSvcClient{}
-	pipelineMetricsQueryService, err := NewPipelineMetadataQuery(ms, pipeline, nil, nil)
+	pipelineMetricsQueryService, err := NewPipelineMetadataQuery(ms, pipeline, nil, nil, nil, nil, nil)
 	assert.NoError(t, err)
 
 	bufferName := "numaflow-system-simple-pipeline-cat-0"
@@ -206,7 +235,7 @@ func TestListBuffers(t *testing.T) {
 	}
 
 	ms := &mockIsbSvcClient{}
-	pipelineMetricsQueryService, err := NewPipelineMetadataQuery(ms, pipeline, nil, nil)
+	pipelineMetricsQueryService, err := NewPipelineMetadataQuery(ms, pipeline, nil, nil, nil, nil, nil)
 	assert.NoError(t, err)
 
 	req := &daemon.ListBuffersRequest{Pipeline: &pipelineName}
@@ -225,6 +254,10 @@ func (mr *mockRater_TestGetPipelineStatus) Start(ctx context.Context) error {
 	return nil
 }
 
+func (mr *mockRater_TestGetPipelineStatus) GetCounterRates(vertexName, counterName, partitionName string) map[string]float64 {
+	return map[string]float64{}
+}
+
 func (mr *mockRater_TestGetPipelineStatus) GetRates(vertexName string, partitionName string) map[string]float64 {
 	res := make(map[string]float64)
 	if mr.isActivelyProcessing {
@@ -265,7 +298,7 @@ vertex_pending_messages{period="default",partition_name="-simple-pipeline-cat-0"
 
 	// test when rater is actively processing
 	activeRater := &mockRater_TestGetPipelineStatus{isActivelyProcessing: true}
-	pipelineMetricsQueryService, err := NewPipelineMetadataQuery(client, pipeline, nil, activeRater)
+	pipelineMetricsQueryService, err := NewPipelineMetadataQuery(client, pipeline, nil, activeRater, nil, nil, nil)
 	assert.NoError(t, err)
 	ioReader := io.NopCloser(bytes.NewReader([]byte(metricsResponse)))
 	pipelineMetricsQueryService.httpClient = &mockHttpClient{
@@ -283,7 +316,7 @@ vertex_pending_messages{period="default",partition_name="-simple-pipeline-cat-0"
 
 	// test when rater is not actively processing
 	idleRater := &mockRater_TestGetPipelineStatus{isActivelyProcessing: false}
-	pipelineMetricsQueryService, err = NewPipelineMetadataQuery(client, pipeline, nil, idleRater)
+	pipelineMetricsQueryService, err = NewPipelineMetadataQuery(client, pipeline, nil, idleRater, nil, nil, nil)
 	assert.NoError(t, err)
 	ioReader = io.NopCloser(bytes.NewReader([]byte(metricsResponse)))
 	pipelineMetricsQueryService.httpClient = &mockHttpClient{
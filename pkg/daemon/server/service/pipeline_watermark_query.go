@@ -100,19 +100,7 @@ func (ps *pipelineMetadataQuery) GetPipelineWatermarks(ctx context.Context, requ
 	watermarkArr := make([]*daemon.EdgeWatermark, len(ps.watermarkFetchers))
 	i := 0
 	for k, edgeFetchers := range ps.watermarkFetchers {
-		var latestWatermarks []int64
-		for _, fetcher := range edgeFetchers {
-			if ps.pipeline.GetVertex(k.To).IsReduceUDF() {
-				watermark := fetcher.ComputeHeadWatermark(0).UnixMilli()
-				latestWatermarks = append(latestWatermarks, watermark)
-			} else {
-				for idx := 0; idx < ps.pipeline.GetVertex(k.To).GetPartitionCount(); idx++ {
-					watermark := fetcher.ComputeHeadWatermark(int32(idx)).UnixMilli()
-					latestWatermarks = append(latestWatermarks, watermark)
-				}
-			}
-		}
-
+		latestWatermarks := headWatermarks(ps.pipeline, k, edgeFetchers)
 		edgeName := k.GetEdgeName()
 		watermarkArr[i] = &daemon.EdgeWatermark{
 			Pipeline:           &ps.pipeline.Name,
@@ -125,3 +113,43 @@ func (ps *pipelineMetadataQuery) GetPipelineWatermarks(ctx context.Context, requ
 	resp.PipelineWatermarks = watermarkArr
 	return resp, nil
 }
+
+// headWatermarks returns the head watermarks in milliseconds of all the partitions of the edge.
+func headWatermarks(pipeline *v1alpha1.Pipeline, edge v1alpha1.Edge, edgeFetchers []fetch.UXFetcher) []int64 {
+	var latestWatermarks []int64
+	for _, fetcher := range edgeFetchers {
+		if pipeline.GetVertex(edge.To).IsReduceUDF() {
+			watermark := fetcher.ComputeHeadWatermark(0).UnixMilli()
+			latestWatermarks = append(latestWatermarks, watermark)
+		} else {
+			for idx := 0; idx < pipeline.GetVertex(edge.To).GetPartitionCount(); idx++ {
+				watermark := fetcher.ComputeHeadWatermark(int32(idx)).UnixMilli()
+				latestWatermarks = append(latestWatermarks, watermark)
+			}
+		}
+	}
+	return latestWatermarks
+}
+
+// GetPipelineEdgeMetrics is used to return the watermark lag, buffer fill rate and processing latency of each edge of a given pipeline.
+func (ps *pipelineMetadataQuery) GetPipelineEdgeMetrics(ctx context.Context, request *daemon.GetPipelineEdgeMetricsRequest) (*daemon.GetPipelineEdgeMetricsResponse, error) {
+	resp := new(daemon.GetPipelineEdgeMetricsResponse)
+	if ps.edgeMetrics == nil {
+		return nil, fmt.Errorf("edge metrics are not available")
+	}
+	for _, edge := range ps.pipeline.ListAllEdges() {
+		if m := ps.edgeMetrics.GetEdgeMetrics(edge); m != nil {
+			resp.EdgeMetrics = append(resp.EdgeMetrics, m)
+		}
+	}
+	resp.EndToEndLatencyPercentiles = ps.edgeMetrics.GetEndToEndLatencyPercentiles()
+	return resp, nil
+}
+
+// GetPipelineMetricsHistory returns the history of the processing rates of the vertices, and the pending messages and watermark lags of the edges
+func (ps *pipelineMetadataQuery) GetPipelineMetricsHistory(ctx context.Context, request *daemon.GetPipelineMetricsHistoryRequest) (*daemon.GetPipelineMetricsHistoryResponse, error) {
+	if ps.metricsHistory == nil {
+		return nil, fmt.Errorf("metrics history is not available")
+	}
+	return &daemon.GetPipelineMetricsHistoryResponse{History: ps.metricsHistory.GetHistory(request.GetSince())}, nil
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	dto "github.com/prometheus/client_model/go"
+)
+
+// The counters of a pod tracked by the rater besides the read counts, the rates of which can be retrieved by GetCounterRates.
+const (
+	// CounterWriteTotal is the number of messages written to each partition of the to buffers.
+	CounterWriteTotal = "write_total"
+	// CounterWriteErrorTotal is the number of failed writes to each partition of the to buffers.
+	CounterWriteErrorTotal = "write_error_total"
+	// CounterProcessingTimeSum is the total time in milliseconds spent by the user-defined container, it's tracked
+	// with an empty partition name since it's summed up across the partitions.
+	CounterProcessingTimeSum = "processing_time_sum"
+	// CounterProcessingTimeCount is the number of the processing time observations, it's tracked with an empty
+	// partition name since it's summed up across the partitions.
+	CounterProcessingTimeCount = "processing_time_count"
+)
+
+// counterMetric is the metric a counter is scraped from.
+type counterMetric struct {
+	writeTotal      string
+	writeErrorTotal string
+	// processingTime is the histogram of the processing time of the user-defined container
+	processingTime string
+	// processingTimeUnit is the number of the processing time units in a millisecond
+	processingTimeUnit float64
+}
+
+// counterMetrics are the metrics of each vertex type to scrape the counters from.
+var counterMetrics = map[string]counterMetric{
+	"reduce": {
+		writeTotal:         "reduce_isb_writer_write_total",
+		writeErrorTotal:    "reduce_isb_writer_write_error_total",
+		processingTime:     "reduce_pnf_process_time",
+		processingTimeUnit: 1,
+	},
+	"source": {
+		writeTotal:         "source_forwarder_write_total",
+		writeErrorTotal:    "source_forwarder_write_error_total",
+		processingTime:     "source_forwarder_transformer_processing_time",
+		processingTimeUnit: 1000,
+	},
+	"other": {
+		writeTotal:         "forwarder_write_total",
+		writeErrorTotal:    "forwarder_write_error_total",
+		processingTime:     "forwarder_udf_processing_time",
+		processingTimeUnit: 1000,
+	},
+}
+
+// getPodCounters returns the counters of a pod from the scraped metric families, keyed by the counter names.
+// A metric not exported yet is treated as zero, because the counters of the pod only start being exported at the first
+// observation.
+func getPodCounters(vertexType, podName string, families map[string]*dto.MetricFamily) map[string]*PodReadCount {
+	m, ok := counterMetrics[vertexType]
+	if !ok {
+		m = counterMetrics["other"]
+	}
+	result := map[string]*PodReadCount{
+		CounterWriteTotal:      {podName, sumByPartition(families[m.writeTotal])},
+		CounterWriteErrorTotal: {podName, sumByPartition(families[m.writeErrorTotal])},
+	}
+	var timeSum, timeCount float64
+	if family, ok := families[m.processingTime]; ok && family != nil {
+		for _, metric := range family.GetMetric() {
+			timeSum += metric.GetHistogram().GetSampleSum()
+			timeCount += float64(metric.GetHistogram().GetSampleCount())
+		}
+	}
+	result[CounterProcessingTimeSum] = &PodReadCount{podName, map[string]float64{"": timeSum / m.processingTimeUnit}}
+	result[CounterProcessingTimeCount] = &PodReadCount{podName, map[string]float64{"": timeCount}}
+	return result
+}
+
+// sumByPartition sums up the values of a counter metric family by the partition name label.
+func sumByPartition(family *dto.MetricFamily) map[string]float64 {
+	counts := make(map[string]float64)
+	if family == nil {
+		return counts
+	}
+	for _, metric := range family.GetMetric() {
+		partitionName := ""
+		for _, label := range metric.GetLabel() {
+			if label.GetName() == "partition_name" {
+				partitionName = label.GetValue()
+			}
+		}
+		counts[partitionName] += metric.GetCounter().GetValue()
+	}
+	return counts
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/prometheus/common/expfmt"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetPodCounters(t *testing.T) {
+	metrics := `
+# HELP forwarder_write_total Total number of Messages Written
+# TYPE forwarder_write_total counter
+forwarder_write_total{partition_name="p-out-0",pipeline="p",vertex="v"} 100
+forwarder_write_total{partition_name="p-out-1",pipeline="p",vertex="v"} 50
+# HELP forwarder_write_error_total Total number of Write Errors
+# TYPE forwarder_write_error_total counter
+forwarder_write_error_total{partition_name="p-out-0",pipeline="p",vertex="v"} 5
+# HELP forwarder_udf_processing_time Processing times of UDF (100 microseconds to 15 minutes)
+# TYPE forwarder_udf_processing_time histogram
+forwarder_udf_processing_time_bucket{partition_name="p-v-0",pipeline="p",vertex="v",le="+Inf"} 10
+forwarder_udf_processing_time_sum{partition_name="p-v-0",pipeline="p",vertex="v"} 20000
+forwarder_udf_processing_time_count{partition_name="p-v-0",pipeline="p",vertex="v"} 10
+forwarder_udf_processing_time_bucket{partition_name="p-v-1",pipeline="p",vertex="v",le="+Inf"} 30
+forwarder_udf_processing_time_sum{partition_name="p-v-1",pipeline="p",vertex="v"} 40000
+forwarder_udf_processing_time_count{partition_name="p-v-1",pipeline="p",vertex="v"} 30
+`
+	families, err := new(expfmt.TextParser).TextToMetricFamilies(strings.NewReader(metrics))
+	assert.NoError(t, err)
+
+	counters := getPodCounters("other", "p-v-0", families)
+	assert.Equal(t, map[string]float64{"p-out-0": 100, "p-out-1": 50}, counters[CounterWriteTotal].PartitionReadCounts())
+	assert.Equal(t, map[string]float64{"p-out-0": 5}, counters[CounterWriteErrorTotal].PartitionReadCounts())
+	assert.Equal(t, map[string]float64{"": 60}, counters[CounterProcessingTimeSum].PartitionReadCounts())
+	assert.Equal(t, map[string]float64{"": 40}, counters[CounterProcessingTimeCount].PartitionReadCounts())
+	assert.Equal(t, "p-v-0", counters[CounterWriteTotal].Name())
+
+	// the metrics of a source vertex are not exported yet
+	counters = getPodCounters("source", "p-in-0", families)
+	assert.Empty(t, counters[CounterWriteTotal].PartitionReadCounts())
+	assert.Empty(t, counters[CounterWriteErrorTotal].PartitionReadCounts())
+	assert.Equal(t, map[string]float64{"": 0}, counters[CounterProcessingTimeCount].PartitionReadCounts())
+}
@@ -35,6 +35,7 @@ import (
 type Ratable interface {
 	Start(ctx context.Context) error
 	GetRates(vertexName, partitionName string) map[string]float64
+	GetCounterRates(vertexName, counterName, partitionName string) map[string]float64
 }
 
 // CountWindow is the time window for which we maintain the timestamped counts, currently 10 seconds
@@ -60,7 +61,9 @@ type Rater struct {
 	podTracker *PodTracker
 	// timestampedPodCounts is a map between vertex name and a queue of timestamped counts for that vertex
 	timestampedPodCounts map[string]*sharedqueue.OverflowQueue[*TimestampedCounts]
-	options              *options
+	// timestampedPodCounters is a map between vertex name and counter name and a queue of timestamped counts of that counter
+	timestampedPodCounters map[string]map[string]*sharedqueue.OverflowQueue[*TimestampedCounts]
+	options                *options
 }
 
 // PodReadCount is a struct to maintain count of messages read from each partition by a pod
@@ -88,15 +91,20 @@ func NewRater(ctx context.Context, p *v1alpha1.Pipeline, opts ...Option) *Rater
 			},
 			Timeout: time.Second * 1,
 		},
-		log:                  logging.FromContext(ctx).Named("Rater"),
-		timestampedPodCounts: make(map[string]*sharedqueue.OverflowQueue[*TimestampedCounts]),
-		options:              defaultOptions(),
+		log:                    logging.FromContext(ctx).Named("Rater"),
+		timestampedPodCounts:   make(map[string]*sharedqueue.OverflowQueue[*TimestampedCounts]),
+		timestampedPodCounters: make(map[string]map[string]*sharedqueue.OverflowQueue[*TimestampedCounts]),
+		options:                defaultOptions(),
 	}
 
 	rater.podTracker = NewPodTracker(ctx, p)
 	for _, v := range p.Spec.Vertices {
 		// maintain the total counts of the last 30 minutes(1800 seconds) since we support 1m, 5m, 15m lookback seconds.
 		rater.timestampedPodCounts[v.Name] = sharedqueue.New[*TimestampedCounts](int(1800 / CountWindow.Seconds()))
+		rater.timestampedPodCounters[v.Name] = make(map[string]*sharedqueue.OverflowQueue[*TimestampedCounts])
+		for _, c := range []string{CounterWriteTotal, CounterWriteErrorTotal, CounterProcessingTimeSum, CounterProcessingTimeCount} {
+			rater.timestampedPodCounters[v.Name][c] = sharedqueue.New[*TimestampedCounts](int(1800 / CountWindow.Seconds()))
+		}
 	}
 
 	for _, opt := range opts {
@@ -135,8 +143,9 @@ func (r *Rater) monitorOnePod(ctx context.Context, key string, worker int) error
 	vertexType := podInfo[3]
 	podName := strings.Join([]string{podInfo[0], podInfo[1], podInfo[2]}, "-")
 	var podReadCount *PodReadCount
+	var podCounters map[string]*PodReadCount
 	if r.podTracker.IsActive(key) {
-		podReadCount = r.getPodReadCounts(vertexName, vertexType, podName)
+		podReadCount, podCounters = r.getPodReadCounts(vertexName, vertexType, podName)
 		if podReadCount == nil {
 			log.Debugf("Failed retrieving total podReadCount for pod %s", podName)
 		}
@@ -146,6 +155,9 @@ func (r *Rater) monitorOnePod(ctx context.Context, key string, worker int) error
 	}
 	now := time.Now().Add(CountWindow).Truncate(CountWindow).Unix()
 	UpdateCount(r.timestampedPodCounts[vertexName], now, podReadCount)
+	for c, q := range r.timestampedPodCounters[vertexName] {
+		UpdateCount(q, now, podCounters[c])
+	}
 	return nil
 }
 
@@ -211,13 +223,14 @@ func sleep(ctx context.Context, duration time.Duration) {
 
 // getPodReadCounts returns the total number of messages read by the pod
 // since a pod can read from multiple partitions, we will return a map of partition to read count.
-func (r *Rater) getPodReadCounts(vertexName, vertexType, podName string) *PodReadCount {
+// the other counters of the pod scraped from the same metrics are returned as well, keyed by the counter names.
+func (r *Rater) getPodReadCounts(vertexName, vertexType, podName string) (*PodReadCount, map[string]*PodReadCount) {
 	// scrape the read total metric from pod metric port
-	url := fmt.Sprintf("https://%s.%s.%s.svc:%v/metrics", podName, r.pipeline.Name+"-"+vertexName+"-headless", r.pipeline.Namespace, v1alpha1.VertexMetricsPort)
+	url := fmt.Sprintf("https://%s.%s.%s.svc:%v/metrics", podName, r.pipeline.Name+"-"+vertexName+"-headless", r.pipeline.GetVertexPodNamespace(vertexName), v1alpha1.VertexMetricsPort)
 	resp, err := r.httpClient.Get(url)
 	if err != nil {
 		r.log.Errorf("failed reading the metrics endpoint, %v", err.Error())
-		return nil
+		return nil, nil
 	}
 	defer resp.Body.Close()
 
@@ -225,8 +238,9 @@ func (r *Rater) getPodReadCounts(vertexName, vertexType, podName string) *PodRea
 	result, err := textParser.TextToMetricFamilies(resp.Body)
 	if err != nil {
 		r.log.Errorf("failed parsing to prometheus metric families, %v", err.Error())
-		return nil
+		return nil, nil
 	}
+	podCounters := getPodCounters(vertexType, podName, result)
 	var readTotalMetricName string
 	if vertexType == "reduce" {
 		readTotalMetricName = "reduce_isb_reader_read_total"
@@ -248,10 +262,10 @@ func (r *Rater) getPodReadCounts(vertexName, vertexType, podName string) *PodRea
 			partitionReadCount[partitionName] = ele.Counter.GetValue()
 		}
 		podReadCount := &PodReadCount{podName, partitionReadCount}
-		return podReadCount
+		return podReadCount, podCounters
 	} else {
 		r.log.Errorf("failed getting the read total metric, the metric is not available.")
-		return nil
+		return nil, podCounters
 	}
 }
 
@@ -266,6 +280,20 @@ func (r *Rater) GetRates(vertexName, partitionName string) map[string]float64 {
 	return result
 }
 
+// GetCounterRates returns the changing rates per second of a counter of the vertex partition in the format of lookback
+// second to rate mappings, the counter names are defined as the Counter constants.
+func (r *Rater) GetCounterRates(vertexName, counterName, partitionName string) map[string]float64 {
+	var result = make(map[string]float64)
+	q, ok := r.timestampedPodCounters[vertexName][counterName]
+	if !ok {
+		return result
+	}
+	for n, i := range r.buildLookbackSecondsMap(vertexName) {
+		result[n] = CalculateRate(q, i, partitionName)
+	}
+	return result
+}
+
 func (r *Rater) buildLookbackSecondsMap(vertexName string) map[string]int64 {
 	// get the user-specified lookback seconds from the pipeline spec
 	var userSpecifiedLookBackSeconds int64
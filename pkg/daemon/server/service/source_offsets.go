@@ -0,0 +1,194 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/apis/proto/daemon"
+	"github.com/numaproj/numaflow/pkg/metrics"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/sources/kafka"
+	"github.com/numaproj/numaflow/pkg/sources/pulsar"
+	"github.com/numaproj/numaflow/pkg/sources/redisstreams"
+)
+
+// sourceOffsetsResetter resets the committed offsets of a source, to a timestamp, or to the offsets by the partitions
+// if the timestamp is zero. It returns the offsets reset to.
+type sourceOffsetsResetter func(ctx context.Context, source *v1alpha1.Source, timestamp time.Time, offsets map[int32]string) (map[int32]string, error)
+
+// ResetSourceOffsets resets the committed offsets of a source vertex, and the watermarks of the edges downstream of it,
+// so that the historical data is reprocessed. It requires the pods of the source vertex and the downstream vertices
+// not running, e.g. the pipeline is paused.
+func (ps *pipelineMetadataQuery) ResetSourceOffsets(ctx context.Context, req *daemon.ResetSourceOffsetsRequest) (*daemon.ResetSourceOffsetsResponse, error) {
+	abstractVertex := ps.pipeline.GetVertex(req.GetVertex())
+	if abstractVertex == nil {
+		return nil, status.Errorf(codes.NotFound, "vertex %q not found from the pipeline", req.GetVertex())
+	}
+	if !abstractVertex.IsASource() {
+		return nil, status.Errorf(codes.InvalidArgument, "vertex %q is not a source", req.GetVertex())
+	}
+	if (req.Timestamp == nil) == (len(req.GetOffsets()) == 0) {
+		return nil, status.Error(codes.InvalidArgument, "exactly one of timestamp and offsets is required")
+	}
+	var timestamp time.Time
+	if req.Timestamp != nil {
+		timestamp = time.UnixMilli(req.GetTimestamp())
+	}
+	vertices := []string{req.GetVertex()}
+	for _, e := range ps.pipeline.GetDownstreamEdges(req.GetVertex()) {
+		vertices = append(vertices, e.To)
+	}
+	for _, v := range vertices {
+		if ps.isVertexRunning(v) {
+			return nil, status.Errorf(codes.FailedPrecondition, "pods of vertex %q are still running, pause the pipeline first", v)
+		}
+	}
+	offsets, err := ps.offsetsResetter(ctx, abstractVertex.Source, timestamp, req.GetOffsets())
+	if err == nil {
+		err = ps.isbSvcClient.ResetWatermarks(ctx, ps.downstreamBuckets(req.GetVertex()))
+		if err != nil {
+			err = fmt.Errorf("failed to reset the watermarks, %w", err)
+		}
+	}
+	auditSourceOffsetsReset(ctx, ps.pipeline.Name, req, offsets, err)
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to reset the offsets of source vertex %q, %w", req.GetVertex(), err)
+	}
+	return &daemon.ResetSourceOffsetsResponse{Offsets: offsets}, nil
+}
+
+// isVertexRunning returns if the first pod of a vertex is reachable, the pods are indexed from 0.
+func (ps *pipelineMetadataQuery) isVertexRunning(vertexName string) bool {
+	abstractVertex := ps.pipeline.GetVertex(vertexName)
+	vertex := &v1alpha1.Vertex{}
+	vertex.Name = fmt.Sprintf("%s-%s", ps.pipeline.Name, vertexName)
+	// example: https://simple-pipeline-in-0.simple-pipeline-in-headless.default.svc:2469/readyz
+	url := fmt.Sprintf("https://%s-0.%s.%s.svc:%v/readyz", vertex.Name, vertex.GetHeadlessServiceName(), abstractVertex.GetPodNamespace(ps.pipeline.Namespace), v1alpha1.VertexMetricsPort)
+	res, err := ps.httpClient.Get(url)
+	if err != nil {
+		return false
+	}
+	_ = res.Body.Close()
+	return res.StatusCode == http.StatusOK
+}
+
+// downstreamBuckets returns the watermark buckets of a source vertex and the edges downstream of it, including the ones
+// of the sinks.
+func (ps *pipelineMetadataQuery) downstreamBuckets(sourceVertex string) []string {
+	namespace, pipeline := ps.pipeline.Namespace, ps.pipeline.Name
+	seen := map[string]bool{}
+	var buckets []string
+	add := func(b string) {
+		if !seen[b] {
+			seen[b] = true
+			buckets = append(buckets, b)
+		}
+	}
+	add(v1alpha1.GenerateSourceBucketName(namespace, pipeline, sourceVertex))
+	for _, e := range ps.pipeline.GetDownstreamEdges(sourceVertex) {
+		add(v1alpha1.GenerateEdgeBucketName(namespace, pipeline, e.From, e.To))
+		if ps.pipeline.GetVertex(e.To).IsASink() {
+			add(v1alpha1.GenerateSinkBucketName(namespace, pipeline, e.To))
+		}
+	}
+	return buckets
+}
+
+// resetSourceOffsets resets the committed offsets of the sources supporting it, i.e. Kafka, Pulsar and Redis Streams.
+func resetSourceOffsets(ctx context.Context, source *v1alpha1.Source, timestamp time.Time, offsets map[int32]string) (map[int32]string, error) {
+	switch {
+	case source.Kafka != nil:
+		kafkaOffsets := make(map[int32]int64)
+		for p, o := range offsets {
+			n, err := strconv.ParseInt(o, 10, 64)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid offset %q of partition %d", o, p)
+			}
+			kafkaOffsets[p] = n
+		}
+		committed, err := kafka.ResetOffsets(source.Kafka, timestamp, kafkaOffsets)
+		if err != nil {
+			return nil, err
+		}
+		result := make(map[int32]string)
+		for p, o := range committed {
+			result[p] = strconv.FormatInt(o, 10)
+		}
+		return result, nil
+	case source.Pulsar != nil:
+		if timestamp.IsZero() {
+			return nil, status.Error(codes.InvalidArgument, "offsets are not supported by pulsar sources, use a timestamp instead")
+		}
+		return nil, pulsar.ResetCursor(source.Pulsar, timestamp)
+	case source.RedisStreams != nil:
+		id, ok := offsets[0]
+		if timestamp.IsZero() && (!ok || len(offsets) != 1) {
+			return nil, status.Error(codes.InvalidArgument, "redis streams sources only have partition 0")
+		}
+		id, err := redisstreams.ResetOffsets(ctx, source.RedisStreams, timestamp, id)
+		if err != nil {
+			return nil, err
+		}
+		return map[int32]string{0: id}, nil
+	default:
+		return nil, status.Error(codes.InvalidArgument, "resetting offsets is only supported by kafka, pulsar and redis streams sources")
+	}
+}
+
+// auditSourceOffsetsReset records a source offsets reset in the audit log and the metrics.
+func auditSourceOffsetsReset(ctx context.Context, pipeline string, req *daemon.ResetSourceOffsetsRequest, offsets map[int32]string, err error) {
+	partitions := make([]int32, 0, len(offsets))
+	for p := range offsets {
+		partitions = append(partitions, p)
+	}
+	sort.Slice(partitions, func(i, j int) bool { return partitions[i] < partitions[j] })
+	var resetTo []string
+	for _, p := range partitions {
+		resetTo = append(resetTo, fmt.Sprintf("%d:%s", p, offsets[p]))
+	}
+	log := logging.FromContext(ctx).Named("audit").With(
+		zap.String("operation", "reset-offsets"),
+		zap.String("pipeline", pipeline),
+		zap.String("vertex", req.GetVertex()),
+		zap.String("client", clientAddr(ctx)),
+		zap.String("reason", req.GetReason()),
+		zap.Int64p("timestamp", req.Timestamp),
+		zap.Strings("offsets", resetTo),
+	)
+	result := "success"
+	if err != nil {
+		result = "failure"
+		log.Errorw("Source offsets reset failed", zap.Error(err))
+	} else {
+		log.Infow("Source offsets reset succeeded")
+	}
+	sourceOffsetsResets.With(map[string]string{metrics.LabelPipeline: pipeline, metrics.LabelVertex: req.GetVertex(), LabelResult: result}).Inc()
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/apis/proto/daemon"
+)
+
+func TestResetSourceOffsets(t *testing.T) {
+	pipelineName := "simple-pipeline"
+	pipeline := &v1alpha1.Pipeline{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      pipelineName,
+			Namespace: "numaflow-system",
+		},
+		Spec: v1alpha1.PipelineSpec{
+			Vertices: []v1alpha1.AbstractVertex{
+				{Name: "in", Source: &v1alpha1.Source{Kafka: &v1alpha1.KafkaSource{Topic: "topic"}}},
+				{Name: "cat", UDF: &v1alpha1.UDF{}},
+				{Name: "out", Sink: &v1alpha1.Sink{}},
+			},
+			Edges: []v1alpha1.Edge{{From: "in", To: "cat"}, {From: "cat", To: "out"}},
+		},
+	}
+	ps, err := NewPipelineMetadataQuery(&mockIsbSvcClient{}, pipeline, nil, nil, nil, nil, nil)
+	assert.NoError(t, err)
+	runningPods := map[string]bool{}
+	ps.httpClient = &mockHttpClient{
+		MockGet: func(url string) (*http.Response, error) {
+			pod := strings.SplitN(strings.TrimPrefix(url, "https://"), ".", 2)[0]
+			if !runningPods[pod] {
+				return nil, fmt.Errorf("no such host")
+			}
+			return &http.Response{StatusCode: 200, Body: io.NopCloser(bytes.NewReader(nil))}, nil
+		},
+	}
+	var resetTimestamp time.Time
+	ps.offsetsResetter = func(_ context.Context, source *v1alpha1.Source, timestamp time.Time, offsets map[int32]string) (map[int32]string, error) {
+		resetTimestamp = timestamp
+		if timestamp.IsZero() {
+			return offsets, nil
+		}
+		return map[int32]string{0: "10", 1: "25"}, nil
+	}
+
+	t.Run("reset to a timestamp", func(t *testing.T) {
+		resp, err := ps.ResetSourceOffsets(context.Background(), &daemon.ResetSourceOffsetsRequest{Pipeline: &pipelineName, Vertex: pointer.String("in"), Timestamp: pointer.Int64(1696118400000)})
+		assert.NoError(t, err)
+		assert.Equal(t, map[int32]string{0: "10", 1: "25"}, resp.GetOffsets())
+		assert.Equal(t, int64(1696118400000), resetTimestamp.UnixMilli())
+	})
+
+	t.Run("reset to offsets", func(t *testing.T) {
+		resp, err := ps.ResetSourceOffsets(context.Background(), &daemon.ResetSourceOffsetsRequest{Pipeline: &pipelineName, Vertex: pointer.String("in"), Offsets: map[int32]string{1: "100"}})
+		assert.NoError(t, err)
+		assert.Equal(t, map[int32]string{1: "100"}, resp.GetOffsets())
+		assert.True(t, resetTimestamp.IsZero())
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		_, err := ps.ResetSourceOffsets(context.Background(), &daemon.ResetSourceOffsetsRequest{Pipeline: &pipelineName, Vertex: pointer.String("not-existing"), Timestamp: pointer.Int64(1)})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		_, err = ps.ResetSourceOffsets(context.Background(), &daemon.ResetSourceOffsetsRequest{Pipeline: &pipelineName, Vertex: pointer.String("cat"), Timestamp: pointer.Int64(1)})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = ps.ResetSourceOffsets(context.Background(), &daemon.ResetSourceOffsetsRequest{Pipeline: &pipelineName, Vertex: pointer.String("in")})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = ps.ResetSourceOffsets(context.Background(), &daemon.ResetSourceOffsetsRequest{Pipeline: &pipelineName, Vertex: pointer.String("in"), Timestamp: pointer.Int64(1), Offsets: map[int32]string{0: "1"}})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("downstream pods running", func(t *testing.T) {
+		runningPods["simple-pipeline-out-0"] = true
+		defer delete(runningPods, "simple-pipeline-out-0")
+		_, err := ps.ResetSourceOffsets(context.Background(), &daemon.ResetSourceOffsetsRequest{Pipeline: &pipelineName, Vertex: pointer.String("in"), Timestamp: pointer.Int64(1)})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("downstream buckets", func(t *testing.T) {
+		assert.Equal(t, []string{
+			"numaflow-system-simple-pipeline-in_SOURCE",
+			"numaflow-system-simple-pipeline-in-cat",
+			"numaflow-system-simple-pipeline-cat-out",
+			"numaflow-system-simple-pipeline-out_SINK",
+		}, ps.downstreamBuckets("in"))
+	})
+}
+
+func TestResetSourceOffsets_UnsupportedSources(t *testing.T) {
+	_, err := resetSourceOffsets(context.Background(), &v1alpha1.Source{Generator: &v1alpha1.GeneratorSource{}}, time.Now(), nil)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = resetSourceOffsets(context.Background(), &v1alpha1.Source{Pulsar: &v1alpha1.PulsarSource{}}, time.Time{}, map[int32]string{0: "1"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = resetSourceOffsets(context.Background(), &v1alpha1.Source{RedisStreams: &v1alpha1.RedisStreamsSource{}}, time.Time{}, map[int32]string{1: "1-0"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = resetSourceOffsets(context.Background(), &v1alpha1.Source{Kafka: &v1alpha1.KafkaSource{}}, time.Time{}, map[int32]string{0: "abc"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/utils/pointer"
+
+	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/apis/proto/daemon"
+	"github.com/numaproj/numaflow/pkg/shared/errorlog"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+// GetVertexErrors returns the last errors returned by the user-defined containers of a vertex, collected from the
+// error logs of its pods. Only the last errorlog.DefaultSize errors of all the pods are returned, the newest first.
+func (ps *pipelineMetadataQuery) GetVertexErrors(ctx context.Context, req *daemon.GetVertexErrorsRequest) (*daemon.GetVertexErrorsResponse, error) {
+	log := logging.FromContext(ctx)
+	abstractVertex := ps.pipeline.GetVertex(req.GetVertex())
+	if abstractVertex == nil {
+		return nil, status.Errorf(codes.NotFound, "vertex %q not found from the pipeline", req.GetVertex())
+	}
+	vertexName := fmt.Sprintf("%s-%s", ps.pipeline.Name, req.GetVertex())
+	vertex := &v1alpha1.Vertex{}
+	vertex.Name = vertexName
+	headlessServiceName := vertex.GetHeadlessServiceName()
+
+	var errs []*daemon.VertexError
+	for idx := 0; idx < int(abstractVertex.Scale.GetMaxReplicas()); idx++ {
+		podName := fmt.Sprintf("%s-%d", vertexName, idx)
+		// example for 0th pod : https://simple-pipeline-in-0.simple-pipeline-in-headless.default.svc:2469/errors
+		url := fmt.Sprintf("https://%s.%s.%s.svc:%v%s", podName, headlessServiceName, abstractVertex.GetPodNamespace(ps.pipeline.Namespace), v1alpha1.VertexMetricsPort, errorlog.Path)
+		entries, err := ps.getPodErrors(url)
+		if err != nil {
+			// the pods are indexed from 0, there are no more pods after a missing one
+			log.Debugw("Failed to read the error log of the pod, it might be because of vertex scaling down", zap.String("pod", podName), zap.Error(err))
+			break
+		}
+		for _, e := range entries {
+			errs = append(errs, &daemon.VertexError{
+				Pipeline:    &ps.pipeline.Name,
+				Vertex:      req.Vertex,
+				Pod:         pointer.String(podName),
+				Container:   pointer.String(e.Container),
+				Timestamp:   pointer.Int64(e.Timestamp.UnixMilli()),
+				Code:        pointer.String(e.Code),
+				MessageID:   pointer.String(e.MessageID),
+				Message:     pointer.String(e.Message),
+				PayloadHash: pointer.String(e.PayloadHash),
+			})
+		}
+	}
+	sort.SliceStable(errs, func(i, j int) bool {
+		return errs[i].GetTimestamp() > errs[j].GetTimestamp()
+	})
+	if len(errs) > errorlog.DefaultSize {
+		errs = errs[:errorlog.DefaultSize]
+	}
+	return &daemon.GetVertexErrorsResponse{Errors: errs}, nil
+}
+
+// getPodErrors reads the error log of a pod.
+func (ps *pipelineMetadataQuery) getPodErrors(url string) ([]errorlog.Entry, error) {
+	res, err := ps.httpClient.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = res.Body.Close() }()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d", res.StatusCode)
+	}
+	var entries []errorlog.Entry
+	if err := json.NewDecoder(res.Body).Decode(&entries); err != nil {
+		return nil, fmt.Errorf("failed to decode the error log, %w", err)
+	}
+	return entries, nil
+}
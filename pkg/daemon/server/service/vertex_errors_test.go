@@ -0,0 +1,95 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/apis/proto/daemon"
+)
+
+func TestGetVertexErrors(t *testing.T) {
+	pipelineName := "simple-pipeline"
+	pipeline := &v1alpha1.Pipeline{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      pipelineName,
+			Namespace: "numaflow-system",
+		},
+		Spec: v1alpha1.PipelineSpec{
+			Vertices: []v1alpha1.AbstractVertex{
+				{Name: "in"},
+				{Name: "cat", Scale: v1alpha1.Scale{Max: pointer.Int32(5)}},
+			},
+			Edges: []v1alpha1.Edge{{From: "in", To: "cat"}},
+		},
+	}
+	ps, err := NewPipelineMetadataQuery(&mockIsbSvcClient{}, pipeline, nil, nil, nil, nil, nil)
+	assert.NoError(t, err)
+	podErrors := map[string]string{
+		"simple-pipeline-cat-0": `[{"timestamp":"2023-10-01T00:00:01Z","container":"udf","code":"Unknown","messageId":"msg-1","message":"failed","payloadHash":"2cf24dba5fb0a30e"}]`,
+		"simple-pipeline-cat-1": `[{"timestamp":"2023-10-01T00:00:02Z","container":"udf","code":"InvalidArgument","messageId":"msg-2","message":"bad input"}]`,
+	}
+	var requested []string
+	ps.httpClient = &mockHttpClient{
+		MockGet: func(url string) (*http.Response, error) {
+			requested = append(requested, url)
+			pod := strings.SplitN(strings.TrimPrefix(url, "https://"), ".", 2)[0]
+			body, ok := podErrors[pod]
+			if !ok {
+				return nil, fmt.Errorf("no such host")
+			}
+			return &http.Response{
+				StatusCode: 200,
+				Body:       io.NopCloser(bytes.NewReader([]byte(body))),
+			}, nil
+		},
+	}
+
+	resp, err := ps.GetVertexErrors(context.Background(), &daemon.GetVertexErrorsRequest{Pipeline: &pipelineName, Vertex: pointer.String("cat")})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"https://simple-pipeline-cat-0.simple-pipeline-cat-headless.numaflow-system.svc:2469/errors",
+		"https://simple-pipeline-cat-1.simple-pipeline-cat-headless.numaflow-system.svc:2469/errors",
+		"https://simple-pipeline-cat-2.simple-pipeline-cat-headless.numaflow-system.svc:2469/errors",
+	}, requested)
+	errs := resp.GetErrors()
+	assert.Len(t, errs, 2)
+	assert.Equal(t, "simple-pipeline-cat-1", errs[0].GetPod())
+	assert.Equal(t, "InvalidArgument", errs[0].GetCode())
+	assert.Equal(t, "msg-2", errs[0].GetMessageID())
+	assert.Equal(t, "simple-pipeline-cat-0", errs[1].GetPod())
+	assert.Equal(t, "udf", errs[1].GetContainer())
+	assert.Equal(t, "failed", errs[1].GetMessage())
+	assert.Equal(t, "2cf24dba5fb0a30e", errs[1].GetPayloadHash())
+	assert.Equal(t, int64(1696118401000), errs[1].GetTimestamp())
+
+	_, err = ps.GetVertexErrors(context.Background(), &daemon.GetVertexErrorsRequest{Pipeline: &pipelineName, Vertex: pointer.String("not-existing")})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/utils/pointer"
+
+	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/apis/proto/daemon"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/shared/msglog"
+)
+
+// GetVertexLogs searches the recent logs of the user-defined containers of a vertex tagged with the ID of a message,
+// collected from the message logs of its pods. Only the last msglog.DefaultSearchLimit log lines of all the pods are
+// returned, the newest first.
+func (ps *pipelineMetadataQuery) GetVertexLogs(ctx context.Context, req *daemon.GetVertexLogsRequest) (*daemon.GetVertexLogsResponse, error) {
+	log := logging.FromContext(ctx)
+	abstractVertex := ps.pipeline.GetVertex(req.GetVertex())
+	if abstractVertex == nil {
+		return nil, status.Errorf(codes.NotFound, "vertex %q not found from the pipeline", req.GetVertex())
+	}
+	if req.GetMessageID() == "" {
+		return nil, status.Error(codes.InvalidArgument, "message ID is required")
+	}
+	vertexName := fmt.Sprintf("%s-%s", ps.pipeline.Name, req.GetVertex())
+	vertex := &v1alpha1.Vertex{}
+	vertex.Name = vertexName
+	headlessServiceName := vertex.GetHeadlessServiceName()
+	query := url.Values{msglog.QueryMessageID: []string{req.GetMessageID()}}.Encode()
+
+	var logs []*daemon.VertexLog
+	for idx := 0; idx < int(abstractVertex.Scale.GetMaxReplicas()); idx++ {
+		podName := fmt.Sprintf("%s-%d", vertexName, idx)
+		// example for 0th pod : https://simple-pipeline-in-0.simple-pipeline-in-headless.default.svc:2469/message-logs?messageId=xxx
+		u := fmt.Sprintf("https://%s.%s.%s.svc:%v%s?%s", podName, headlessServiceName, abstractVertex.GetPodNamespace(ps.pipeline.Namespace), v1alpha1.VertexMetricsPort, msglog.Path, query)
+		entries, err := ps.getPodMessageLogs(u)
+		if err != nil {
+			// the pods are indexed from 0, there are no more pods after a missing one
+			log.Debugw("Failed to search the message log of the pod, it might be because of vertex scaling down", zap.String("pod", podName), zap.Error(err))
+			break
+		}
+		for _, e := range entries {
+			logs = append(logs, &daemon.VertexLog{
+				Pipeline:  &ps.pipeline.Name,
+				Vertex:    req.Vertex,
+				Pod:       pointer.String(podName),
+				Source:    pointer.String(e.Source),
+				Timestamp: pointer.Int64(e.Timestamp.UnixMilli()),
+				Line:      pointer.String(e.Line),
+			})
+		}
+	}
+	sort.SliceStable(logs, func(i, j int) bool {
+		return logs[i].GetTimestamp() > logs[j].GetTimestamp()
+	})
+	if len(logs) > msglog.DefaultSearchLimit {
+		logs = logs[:msglog.DefaultSearchLimit]
+	}
+	return &daemon.GetVertexLogsResponse{Logs: logs}, nil
+}
+
+// getPodMessageLogs searches the message log of a pod.
+func (ps *pipelineMetadataQuery) getPodMessageLogs(url string) ([]msglog.Entry, error) {
+	res, err := ps.httpClient.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = res.Body.Close() }()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d", res.StatusCode)
+	}
+	var entries []msglog.Entry
+	if err := json.NewDecoder(res.Body).Decode(&entries); err != nil {
+		return nil, fmt.Errorf("failed to decode the message log, %w", err)
+	}
+	return entries, nil
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/apis/proto/daemon"
+)
+
+func TestGetVertexLogs(t *testing.T) {
+	pipelineName := "simple-pipeline"
+	pipeline := &v1alpha1.Pipeline{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      pipelineName,
+			Namespace: "numaflow-system",
+		},
+		Spec: v1alpha1.PipelineSpec{
+			Vertices: []v1alpha1.AbstractVertex{
+				{Name: "in"},
+				{Name: "cat", Scale: v1alpha1.Scale{Max: pointer.Int32(5)}},
+			},
+			Edges: []v1alpha1.Edge{{From: "in", To: "cat"}},
+		},
+	}
+	ps, err := NewPipelineMetadataQuery(&mockIsbSvcClient{}, pipeline, nil, nil, nil, nil, nil)
+	assert.NoError(t, err)
+	podLogs := map[string]string{
+		"simple-pipeline-cat-0": `[{"timestamp":"2023-10-01T00:00:01Z","source":"my-udf","line":"{\"msg\":\"first\"}"}]`,
+		"simple-pipeline-cat-1": `[{"timestamp":"2023-10-01T00:00:02Z","source":"my-udf","line":"{\"msg\":\"second\"}"}]`,
+	}
+	var requested []string
+	ps.httpClient = &mockHttpClient{
+		MockGet: func(url string) (*http.Response, error) {
+			requested = append(requested, url)
+			pod := strings.SplitN(strings.TrimPrefix(url, "https://"), ".", 2)[0]
+			body, ok := podLogs[pod]
+			if !ok {
+				return nil, fmt.Errorf("no such host")
+			}
+			return &http.Response{
+				StatusCode: 200,
+				Body:       io.NopCloser(bytes.NewReader([]byte(body))),
+			}, nil
+		},
+	}
+
+	resp, err := ps.GetVertexLogs(context.Background(), &daemon.GetVertexLogsRequest{Pipeline: &pipelineName, Vertex: pointer.String("cat"), MessageID: pointer.String("msg 1")})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"https://simple-pipeline-cat-0.simple-pipeline-cat-headless.numaflow-system.svc:2469/message-logs?messageId=msg+1",
+		"https://simple-pipeline-cat-1.simple-pipeline-cat-headless.numaflow-system.svc:2469/message-logs?messageId=msg+1",
+		"https://simple-pipeline-cat-2.simple-pipeline-cat-headless.numaflow-system.svc:2469/message-logs?messageId=msg+1",
+	}, requested)
+	logs := resp.GetLogs()
+	assert.Len(t, logs, 2)
+	assert.Equal(t, "simple-pipeline-cat-1", logs[0].GetPod())
+	assert.Equal(t, `{"msg":"second"}`, logs[0].GetLine())
+	assert.Equal(t, "simple-pipeline-cat-0", logs[1].GetPod())
+	assert.Equal(t, "my-udf", logs[1].GetSource())
+	assert.Equal(t, int64(1696118401000), logs[1].GetTimestamp())
+
+	_, err = ps.GetVertexLogs(context.Background(), &daemon.GetVertexLogsRequest{Pipeline: &pipelineName, Vertex: pointer.String("cat"), MessageID: pointer.String("")})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = ps.GetVertexLogs(context.Background(), &daemon.GetVertexLogsRequest{Pipeline: &pipelineName, Vertex: pointer.String("not-existing"), MessageID: pointer.String("msg-1")})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"hash/fnv"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/numaproj/numaflow/pkg/apis/proto/daemon"
+)
+
+// watchInterval is the interval of checking the changes of the watched data.
+var watchInterval = 5 * time.Second
+
+// WatchPipelineStatus streams the status of a pipeline whenever it changes.
+func (ps *pipelineMetadataQuery) WatchPipelineStatus(req *daemon.WatchPipelineStatusRequest, stream daemon.DaemonService_WatchPipelineStatusServer) error {
+	return watch(stream.Context(), req.GetCursor(), func(ctx context.Context) (*daemon.PipelineStatus, error) {
+		resp, err := ps.GetPipelineStatus(ctx, &daemon.GetPipelineStatusRequest{Pipeline: req.Pipeline})
+		if err != nil {
+			return nil, err
+		}
+		return resp.GetStatus(), nil
+	}, func(status *daemon.PipelineStatus, cursor string) error {
+		return stream.Send(&daemon.WatchPipelineStatusResponse{Status: status, Cursor: &cursor})
+	})
+}
+
+// WatchBuffers streams the information of the buffers of a pipeline whenever it changes.
+func (ps *pipelineMetadataQuery) WatchBuffers(req *daemon.WatchBuffersRequest, stream daemon.DaemonService_WatchBuffersServer) error {
+	return watch(stream.Context(), req.GetCursor(), func(ctx context.Context) ([]*daemon.BufferInfo, error) {
+		resp, err := ps.ListBuffers(ctx, &daemon.ListBuffersRequest{Pipeline: req.Pipeline})
+		if err != nil {
+			return nil, err
+		}
+		return resp.GetBuffers(), nil
+	}, func(buffers []*daemon.BufferInfo, cursor string) error {
+		return stream.Send(&daemon.WatchBuffersResponse{Buffers: buffers, Cursor: &cursor})
+	})
+}
+
+// WatchVertexMetrics streams the processing rates and pending messages of a vertex whenever they change.
+func (ps *pipelineMetadataQuery) WatchVertexMetrics(req *daemon.WatchVertexMetricsRequest, stream daemon.DaemonService_WatchVertexMetricsServer) error {
+	if ps.pipeline.GetVertex(req.GetVertex()) == nil {
+		return status.Errorf(codes.NotFound, "vertex %q not found from the pipeline", req.GetVertex())
+	}
+	return watch(stream.Context(), req.GetCursor(), func(ctx context.Context) ([]*daemon.VertexMetrics, error) {
+		resp, err := ps.GetVertexMetrics(ctx, &daemon.GetVertexMetricsRequest{Pipeline: req.Pipeline, Vertex: req.Vertex})
+		if err != nil {
+			return nil, err
+		}
+		return resp.GetVertexMetrics(), nil
+	}, func(metrics []*daemon.VertexMetrics, cursor string) error {
+		return stream.Send(&daemon.WatchVertexMetricsResponse{VertexMetrics: metrics, Cursor: &cursor})
+	})
+}
+
+// watch gets the data every watchInterval until the context is done, and sends it with its cursor when it's changed.
+// The cursor is the hash of the data, so that a watch resumed with the cursor of the last received data does not
+// receive the same data again.
+func watch[T any](ctx context.Context, cursor string, get func(context.Context) (T, error), send func(T, string) error) error {
+	ticker := time.NewTicker(watchInterval)
+	defer ticker.Stop()
+	for {
+		data, err := get(ctx)
+		if err != nil {
+			return err
+		}
+		c, err := watchCursor(data)
+		if err != nil {
+			return err
+		}
+		if c != cursor {
+			if err := send(data, c); err != nil {
+				return err
+			}
+			cursor = c
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// watchCursor returns the FNV-1a hash of the data in JSON, in which the map keys are sorted.
+func watchCursor(data interface{}) (string, error) {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return "", err
+	}
+	h := fnv.New64a()
+	_, _ = h.Write(b)
+	return strconv.FormatUint(h.Sum64(), 16), nil
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/apis/proto/daemon"
+)
+
+type mockWatchBuffersServer struct {
+	grpc.ServerStream
+	ctx       context.Context
+	responses []*daemon.WatchBuffersResponse
+}
+
+func (m *mockWatchBuffersServer) Context() context.Context {
+	return m.ctx
+}
+
+func (m *mockWatchBuffersServer) Send(resp *daemon.WatchBuffersResponse) error {
+	m.responses = append(m.responses, resp)
+	return nil
+}
+
+func TestWatch(t *testing.T) {
+	defer func(d time.Duration) { watchInterval = d }(watchInterval)
+	watchInterval = 10 * time.Millisecond
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	values := []int{1, 1, 2, 2, 3}
+	i := 0
+	var sent []int
+	var cursors []string
+	err := watch(ctx, "", func(context.Context) (int, error) {
+		v := values[i]
+		i++
+		if i == len(values) {
+			cancel()
+		}
+		return v, nil
+	}, func(v int, cursor string) error {
+		sent = append(sent, v)
+		cursors = append(cursors, cursor)
+		return nil
+	})
+	assert.NoError(t, err)
+	// only the changes are sent
+	assert.Equal(t, []int{1, 2, 3}, sent)
+	assert.Len(t, cursors, 3)
+	assert.NotEqual(t, cursors[0], cursors[1])
+
+	// resumed with a cursor, the unchanged data is not sent again
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+	sent = nil
+	err = watch(ctx, cursors[2], func(context.Context) (int, error) {
+		cancel()
+		return 3, nil
+	}, func(v int, cursor string) error {
+		sent = append(sent, v)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, sent)
+
+	// the errors end the watch
+	err = watch(context.Background(), "", func(context.Context) (int, error) {
+		return 0, fmt.Errorf("failed")
+	}, func(v int, cursor string) error {
+		return nil
+	})
+	assert.Error(t, err)
+}
+
+func TestWatchBuffers(t *testing.T) {
+	pipelineName := "simple-pipeline"
+	pipeline := &v1alpha1.Pipeline{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      pipelineName,
+			Namespace: "numaflow-system",
+		},
+		Spec: v1alpha1.PipelineSpec{
+			Vertices: []v1alpha1.AbstractVertex{
+				{Name: "in", Source: &v1alpha1.Source{}},
+				{Name: "out", Sink: &v1alpha1.Sink{}},
+			},
+			Edges: []v1alpha1.Edge{{From: "in", To: "out"}},
+		},
+	}
+	pipelineMetricsQueryService, err := NewPipelineMetadataQuery(&mockIsbSvcClient{}, pipeline, nil, nil, nil, nil, nil)
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	stream := &mockWatchBuffersServer{ctx: ctx}
+	err = pipelineMetricsQueryService.WatchBuffers(&daemon.WatchBuffersRequest{Pipeline: &pipelineName}, stream)
+	assert.NoError(t, err)
+	assert.Len(t, stream.responses, 1)
+	assert.Len(t, stream.responses[0].GetBuffers(), 1)
+	assert.Equal(t, int64(10), stream.responses[0].GetBuffers()[0].GetPendingCount())
+	assert.NotEmpty(t, stream.responses[0].GetCursor())
+}
+
+func TestWatchVertexMetrics_NotFound(t *testing.T) {
+	pipeline := &v1alpha1.Pipeline{ObjectMeta: metav1.ObjectMeta{Name: "simple-pipeline"}}
+	pipelineMetricsQueryService, err := NewPipelineMetadataQuery(&mockIsbSvcClient{}, pipeline, nil, nil, nil, nil, nil)
+	assert.NoError(t, err)
+	err = pipelineMetricsQueryService.WatchVertexMetrics(&daemon.WatchVertexMetricsRequest{Pipeline: &pipeline.Name, Vertex: &pipeline.Name}, nil)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "not found")
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package forward
+
+import (
+	"time"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+// batchSizer adapts the read batch size to the back pressure. The batch shrinks by half when writing to the to buffers
+// fails or a batch takes longer than the target latency to process, and grows by a tenth of the range when a full
+// batch is processed in time. It's only used by the forwarding goroutine, so it's not safe for concurrent use.
+type batchSizer struct {
+	min           int64
+	max           int64
+	step          int64
+	targetLatency time.Duration
+	size          int64
+	// writeFailed is set when writing to the to buffers fails while processing the current batch.
+	writeFailed bool
+}
+
+func newBatchSizer(arb dfv1.AdaptiveReadBatch, readBatchSize int64) *batchSizer {
+	max := arb.GetMax(readBatchSize)
+	min := arb.GetMin()
+	if min > max {
+		min = max
+	}
+	step := (max - min) / 10
+	if step < 1 {
+		step = 1
+	}
+	// start with the max, which is the batch size without back pressure
+	return &batchSizer{min: min, max: max, step: step, targetLatency: arb.GetTargetLatency(), size: max}
+}
+
+// current returns the batch size to read the next batch with.
+func (b *batchSizer) current() int64 {
+	return b.size
+}
+
+// recordWriteFailure records a failed write to the to buffers, e.g. because of a full buffer.
+func (b *batchSizer) recordWriteFailure() {
+	b.writeFailed = true
+}
+
+// update adjusts the batch size with the number of the messages read in the current batch and the latency of
+// processing them, it returns the new batch size.
+func (b *batchSizer) update(read int, latency time.Duration) int64 {
+	switch {
+	case b.writeFailed || latency > b.targetLatency:
+		b.size /= 2
+		if b.size < b.min {
+			b.size = b.min
+		}
+	case int64(read) >= b.size:
+		// only grow when the batch is full, otherwise there are not enough messages to read a larger batch
+		b.size += b.step
+		if b.size > b.max {
+			b.size = b.max
+		}
+	}
+	b.writeFailed = false
+	return b.size
+}
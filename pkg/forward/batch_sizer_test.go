@@ -0,0 +1,70 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package forward
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+func TestBatchSizer(t *testing.T) {
+	min, max := uint64(10), uint64(110)
+	b := newBatchSizer(dfv1.AdaptiveReadBatch{Min: &min, Max: &max, TargetLatency: &metav1.Duration{Duration: 100 * time.Millisecond}}, 500)
+	assert.Equal(t, int64(110), b.current())
+
+	// shrink on a slow batch
+	assert.Equal(t, int64(55), b.update(110, 200*time.Millisecond))
+	// shrink on a write failure
+	b.recordWriteFailure()
+	assert.Equal(t, int64(27), b.update(55, 10*time.Millisecond))
+	// bounded by min
+	b.recordWriteFailure()
+	assert.Equal(t, int64(13), b.update(27, 10*time.Millisecond))
+	b.recordWriteFailure()
+	assert.Equal(t, int64(10), b.update(13, 10*time.Millisecond))
+	// the failure is reset after an update
+	assert.Equal(t, int64(20), b.update(10, 10*time.Millisecond))
+	// no growth if the batch is not full
+	assert.Equal(t, int64(20), b.update(5, 10*time.Millisecond))
+	// bounded by max
+	for i := 0; i < 20; i++ {
+		b.update(int(b.current()), 10*time.Millisecond)
+	}
+	assert.Equal(t, int64(110), b.current())
+}
+
+func TestBatchSizerDefaults(t *testing.T) {
+	b := newBatchSizer(dfv1.AdaptiveReadBatch{}, 5)
+	assert.Equal(t, int64(5), b.current())
+	assert.Equal(t, int64(1), b.step)
+	assert.Equal(t, time.Second, b.targetLatency)
+	b.recordWriteFailure()
+	b.update(5, 0)
+	b.recordWriteFailure()
+	b.update(2, 0)
+	b.recordWriteFailure()
+	assert.Equal(t, int64(1), b.update(1, 0))
+
+	min := uint64(10)
+	b = newBatchSizer(dfv1.AdaptiveReadBatch{Min: &min}, 5)
+	assert.Equal(t, int64(5), b.min)
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package forward
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	daemonclient "github.com/numaproj/numaflow/pkg/daemon/client"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+// degradationPollInterval is the interval of polling the degradation state from the daemon service.
+const degradationPollInterval = 10 * time.Second
+
+// DegradationWatcher polls the degradation state of the pipeline from the daemon service, for the EdgeRouter to
+// degrade the best-effort edges of a vertex.
+type DegradationWatcher struct {
+	pipeline string
+	address  string
+	degraded atomic.Bool
+}
+
+// NewDegradationWatcher returns a DegradationWatcher of the vertex, or nil if none of its to edges is a best-effort
+// one.
+func NewDegradationWatcher(vertex *dfv1.Vertex) *DegradationWatcher {
+	for _, edge := range vertex.Spec.ToEdges {
+		if edge.Degradation != nil {
+			pl := dfv1.Pipeline{ObjectMeta: metav1.ObjectMeta{Name: vertex.Spec.PipelineName, Namespace: vertex.Namespace}}
+			return &DegradationWatcher{pipeline: pl.Name, address: pl.GetDaemonServiceURL()}
+		}
+	}
+	return nil
+}
+
+// Start polls the degradation state until the context is done. The last known state is kept when the daemon service
+// is not available.
+func (w *DegradationWatcher) Start(ctx context.Context) {
+	log := logging.FromContext(ctx).Named("DegradationWatcher")
+	client, err := daemonclient.NewDaemonServiceClient(w.address)
+	if err != nil {
+		log.Errorw("Failed to create a daemon service client, the best-effort edges are never degraded", zap.Error(err))
+		return
+	}
+	defer func() { _ = client.Close() }()
+	ticker := time.NewTicker(degradationPollInterval)
+	defer ticker.Stop()
+	for {
+		if d, err := client.GetPipelineDegradation(ctx, w.pipeline); err != nil {
+			log.Warnw("Failed to get the degradation state of the pipeline", zap.Error(err))
+		} else if degraded := d.GetDegraded(); w.degraded.Swap(degraded) != degraded {
+			log.Infow("Degradation state of the pipeline changed", zap.Bool("degraded", degraded), zap.String("reason", d.GetReason()))
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// IsDegraded tells if the best-effort edges are degraded, it's always false for a nil watcher.
+func (w *DegradationWatcher) IsDegraded() bool {
+	if w == nil {
+		return false
+	}
+	return w.degraded.Load()
+}
@@ -24,6 +24,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"strings"
 	"sync"
 	"time"
 
@@ -34,15 +35,22 @@ import (
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/forward/applier"
+	"github.com/numaproj/numaflow/pkg/forward/interceptor"
+	"github.com/numaproj/numaflow/pkg/forward/schema"
 	"github.com/numaproj/numaflow/pkg/isb"
 	"github.com/numaproj/numaflow/pkg/metrics"
 	"github.com/numaproj/numaflow/pkg/shared/idlehandler"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/shared/msglog"
+	"github.com/numaproj/numaflow/pkg/shared/tracing"
 	"github.com/numaproj/numaflow/pkg/watermark/fetch"
 	"github.com/numaproj/numaflow/pkg/watermark/publish"
 	"github.com/numaproj/numaflow/pkg/watermark/wmb"
 )
 
+// keysDelimiter is used to combine the keys of a message to a string
+const keysDelimiter = "\x00"
+
 // InterStepDataForward forwards the data from previous step to the current step via inter-step buffer.
 type InterStepDataForward struct {
 	// I have my reasons for overriding the default principle https://github.com/golang/go/issues/22602
@@ -66,9 +74,21 @@ type InterStepDataForward struct {
 	idleManager *wmb.IdleManager
 	// wmbChecker checks if the idle watermark is valid.
 	wmbChecker wmb.WMBChecker
+	// originRequired stores the names of the downstream vertices which need to know the origin of the messages.
+	originRequired map[string]bool
+	// schemaValidator validates the payloads against the schemas of the to edges, it's nil if there's no schema.
+	schemaValidator *schema.EdgeValidator
+	// gate holds the messages of a sink until the watermark passes their event times, it's nil if not enabled.
+	gate *emissionGate
+	// batchSizer adapts the read batch size to the back pressure, it's nil if not enabled.
+	batchSizer *batchSizer
+	// interceptors are called before reading, after the map UDF and before writing the messages.
+	interceptors interceptor.Chain
 	Shutdown
 }
 
+var _ StarterStopper = (*InterStepDataForward)(nil)
+
 // NewInterStepDataForward creates an inter-step forwarder.
 func NewInterStepDataForward(
 	vertex *dfv1.Vertex,
@@ -82,11 +102,25 @@ func NewInterStepDataForward(
 	opts ...Option) (*InterStepDataForward, error) {
 
 	options := DefaultOptions()
+	if x := vertex.Spec.Limits; x != nil {
+		if x.RetryInterval != nil {
+			options.retryInterval = x.RetryInterval.Duration
+		}
+		options.keyOrdered = x.KeyOrdered
+	}
 	for _, o := range opts {
 		if err := o(options); err != nil {
 			return nil, err
 		}
 	}
+	if vertex.IsStrictFIFO() {
+		// The messages of a strict FIFO edge are processed one after another.
+		options.udfConcurrency = 1
+	}
+	schemaValidator, err := schema.NewEdgeValidator(vertex)
+	if err != nil {
+		return nil, err
+	}
 	// creating a context here which is managed by the forwarder's lifecycle
 	ctx, cancel := context.WithCancel(context.Background())
 
@@ -101,10 +135,12 @@ func NewInterStepDataForward(
 		wmFetcher:           fetchWatermark,
 		wmPublishers:        publishWatermark,
 		// should we do a check here for the values not being null?
-		vertexName:   vertex.Spec.Name,
-		pipelineName: vertex.Spec.PipelineName,
-		idleManager:  wmb.NewIdleManager(len(toSteps)),
-		wmbChecker:   wmb.NewWMBChecker(2), // TODO: make configurable
+		vertexName:      vertex.Spec.Name,
+		pipelineName:    vertex.Spec.PipelineName,
+		idleManager:     wmb.NewIdleManager(len(toSteps)),
+		wmbChecker:      wmb.NewWMBChecker(2), // TODO: make configurable
+		originRequired:  OriginRequiredVertices(vertex),
+		schemaValidator: schemaValidator,
 		Shutdown: Shutdown{
 			rwlock: new(sync.RWMutex),
 		},
@@ -122,6 +158,24 @@ func NewInterStepDataForward(
 		return nil, fmt.Errorf("source vertex is not supported by inter-step forwarder, please use source forwarder instead")
 	}
 
+	if vertex.IsASink() && vertex.Spec.Sink.WatermarkGate != nil {
+		if vertex.IsStrictFIFO() {
+			return nil, fmt.Errorf("watermark gate is not supported with strict FIFO edges")
+		}
+		isdf.gate = newEmissionGate(vertex.Spec.Sink.WatermarkGate.GetMaxHeldMessages())
+	}
+
+	if x := vertex.Spec.Limits; x != nil && x.AdaptiveReadBatch != nil {
+		if isdf.opts.enableMapUdfStream {
+			return nil, fmt.Errorf("adaptive read batch is not supported with map UDF streaming")
+		}
+		isdf.batchSizer = newBatchSizer(*x.AdaptiveReadBatch, isdf.opts.readBatchSize)
+	}
+
+	if isdf.interceptors, err = interceptor.New(vertex); err != nil {
+		return nil, err
+	}
+
 	return &isdf, nil
 }
 
@@ -173,6 +227,9 @@ func (isdf *InterStepDataForward) Start() <-chan struct{} {
 				}
 			}
 		}
+		if err := isdf.interceptors.Close(); err != nil {
+			log.Errorw("Failed to close interceptors, shutdown anyways...", zap.Error(err))
+		}
 
 		close(stopped)
 	}()
@@ -185,6 +242,8 @@ type readWriteMessagePair struct {
 	readMessage   *isb.ReadMessage
 	writeMessages []*isb.WriteMessage
 	udfError      error
+	// span is the tracing span of the read message, nil if it's not traced.
+	span *tracing.Span
 }
 
 // forwardAChunk forwards a chunk of message from the fromBufferPartition to the toBuffers. It does the Read -> Process -> Forward -> Ack chain
@@ -193,10 +252,34 @@ type readWriteMessagePair struct {
 // buffer-not-reachable, etc., but does not include errors due to user code UDFs, WhereTo, etc.
 func (isdf *InterStepDataForward) forwardAChunk(ctx context.Context) {
 	start := time.Now()
+	if isdf.gate != nil && isdf.gate.isFull() {
+		// no more messages are read until some of the held ones are emitted
+		if !isdf.emitHeldMessages(ctx, isdf.gateWatermark(wmb.InitialWatermark)) {
+			select {
+			case <-ctx.Done():
+			case <-time.After(gateFullBackoff):
+			}
+		}
+		return
+	}
 	// There is a chance that we have read the message and the container got forcefully terminated before processing. To provide
 	// at-least-once semantics for reading, during restart we will have to reprocess all unacknowledged messages. It is the
 	// responsibility of the Read function to do that.
-	readMessages, err := isdf.fromBufferPartition.Read(ctx, isdf.opts.readBatchSize)
+	if err := isdf.interceptors.PreRead(ctx, isdf.fromBufferPartition.GetName()); err != nil {
+		isdf.opts.logger.Warnw("Interceptor failed before reading, skipping the read", zap.Error(err))
+		select {
+		case <-ctx.Done():
+		case <-time.After(isdf.opts.retryInterval):
+		}
+		return
+	}
+	batchSize := isdf.opts.readBatchSize
+	if isdf.batchSizer != nil {
+		batchSize = isdf.batchSizer.current()
+	}
+	readBatchSize.With(map[string]string{metrics.LabelVertex: isdf.vertexName, metrics.LabelPipeline: isdf.pipelineName, metrics.LabelPartitionName: isdf.fromBufferPartition.GetName()}).Set(float64(batchSize))
+	readMessages, err := isdf.fromBufferPartition.Read(ctx, batchSize)
+	readEnd := time.Now()
 	isdf.opts.logger.Debugw("Read from buffer", zap.String("bufferFrom", isdf.fromBufferPartition.GetName()), zap.Int64("length", int64(len(readMessages))))
 	if err != nil {
 		isdf.opts.logger.Warnw("failed to read fromBufferPartition", zap.Error(err))
@@ -207,6 +290,9 @@ func (isdf *InterStepDataForward) forwardAChunk(ctx context.Context) {
 	// process only if we have any read messages. There is a natural looping here if there is an internal error while
 	// reading, and we are not able to proceed.
 	if len(readMessages) == 0 {
+		if isdf.gate != nil {
+			isdf.emitHeldMessages(ctx, isdf.gateWatermark(wmb.InitialWatermark))
+		}
 		// When the read length is zero, the write length is definitely zero too,
 		// meaning there's no data to be published to the next vertex, and we consider this
 		// situation as idling.
@@ -228,7 +314,7 @@ func (isdf *InterStepDataForward) forwardAChunk(ctx context.Context) {
 		for toVertexName, toVertexBuffer := range isdf.toBuffers {
 			for _, partition := range toVertexBuffer {
 				if p, ok := isdf.wmPublishers[toVertexName]; ok {
-					idlehandler.PublishIdleWatermark(ctx, partition, p, isdf.idleManager, isdf.opts.logger, isdf.opts.vertexType, wmb.Watermark(time.UnixMilli(processorWMB.Watermark)))
+					idlehandler.PublishIdleWatermark(ctx, partition, p, isdf.idleManager, isdf.opts.logger, isdf.opts.vertexType, wmb.Watermark(wmb.FromEpoch(processorWMB.Watermark)))
 				}
 			}
 		}
@@ -246,6 +332,17 @@ func (isdf *InterStepDataForward) forwardAChunk(ctx context.Context) {
 		}
 	}
 
+	// start the spans of the data messages carrying a trace context, spanErr is recorded when the chunk fails.
+	spans := tracing.StartSpans(ctx, isdf.vertexName, dataMessages, start, false)
+	spans.Phase("read", start, readEnd, nil)
+	var spanErr error
+	defer func() { spans.End(spanErr) }()
+
+	// the transactions of the transactional writers are committed only once the read offsets are acked, and are aborted
+	// if the chunk fails, so that the messages written for a failed chunk are not duplicated when it's read again.
+	var acked bool
+	defer func() { isdf.endTransactions(ctx, acked) }()
+
 	// fetch watermark if available
 	// TODO: make it async (concurrent and wait later)
 	// let's track only the first element's watermark. This is important because we reassign the watermark we fetch
@@ -254,6 +351,8 @@ func (isdf *InterStepDataForward) forwardAChunk(ctx context.Context) {
 	processorWM := isdf.wmFetcher.ComputeWatermark(readMessages[0].ReadOffset, isdf.fromBufferPartition.GetPartitionIdx())
 
 	var writeOffsets map[string][][]isb.Offset
+	// ackOffsets are the offsets to ack once the messages are written, the held messages are acked once emitted.
+	var ackOffsets = readOffsets
 	if !isdf.opts.enableMapUdfStream {
 		// create space for writeMessages specific to each step as we could forward to all the steps too.
 		var messageToStep = make(map[string][][]isb.Message)
@@ -262,8 +361,8 @@ func (isdf *InterStepDataForward) forwardAChunk(ctx context.Context) {
 			messageToStep[toVertex] = make([][]isb.Message, len(isdf.toBuffers[toVertex]))
 		}
 
-		// udf concurrent processing request channel
-		udfCh := make(chan *readWriteMessagePair)
+		// udf concurrent processing request channel, the messages of a group are processed serially
+		udfCh := make(chan []*readWriteMessagePair)
 		// udfResults stores the results after map UDF processing for all read messages. It indexes
 		// a read message to the corresponding write message
 		udfResults := make([]readWriteMessagePair, len(dataMessages))
@@ -286,9 +385,12 @@ func (isdf *InterStepDataForward) forwardAChunk(ctx context.Context) {
 			readBytesCount.With(map[string]string{metrics.LabelVertex: isdf.vertexName, metrics.LabelPipeline: isdf.pipelineName, metrics.LabelPartitionName: isdf.fromBufferPartition.GetName()}).Add(float64(len(m.Payload)))
 			// assign watermark to the message
 			m.Watermark = time.Time(processorWM)
-			// send map UDF processing work to the channel
 			udfResults[idx].readMessage = m
-			udfCh <- &udfResults[idx]
+			udfResults[idx].span = spans.Get(idx)
+		}
+		// send map UDF processing work to the channel
+		for _, group := range groupByKeys(udfResults, isdf.opts.keyOrdered) {
+			udfCh <- group
 		}
 		// let the go routines know that there is no more work
 		close(udfCh)
@@ -299,6 +401,27 @@ func (isdf *InterStepDataForward) forwardAChunk(ctx context.Context) {
 		concurrentUDFProcessingTime.With(map[string]string{metrics.LabelVertex: isdf.vertexName, metrics.LabelPipeline: isdf.pipelineName, metrics.LabelPartitionName: isdf.fromBufferPartition.GetName()}).Observe(float64(time.Since(concurrentUDFProcessingStart).Microseconds()))
 		// map UDF processing is done.
 
+		// the held messages which are ready to be emitted are written ahead of the ones read in this chunk.
+		var (
+			gateWM   wmb.Watermark
+			released []heldMessage
+			newHeld  []heldMessage
+		)
+		if isdf.gate != nil {
+			gateWM = isdf.gateWatermark(processorWM)
+			released = isdf.gate.ready(gateWM)
+			for _, h := range released {
+				for _, message := range h.writeMessages {
+					if err := isdf.whereToStep(message, messageToStep, h.readMessage); err != nil {
+						isdf.opts.logger.Errorw("failed in whereToStep", zap.Error(err))
+						isdf.fromBufferPartition.NoAck(ctx, readOffsets)
+						spanErr = err
+						return
+					}
+				}
+			}
+		}
+
 		// let's figure out which vertex to send the results to.
 		// update the toBuffer(s) with writeMessages.
 		for _, m := range udfResults {
@@ -309,32 +432,59 @@ func (isdf *InterStepDataForward) forwardAChunk(ctx context.Context) {
 				isdf.opts.logger.Errorw("failed to applyUDF", zap.Error(m.udfError))
 				// As there's no partial failure, non-ack all the readOffsets
 				isdf.fromBufferPartition.NoAck(ctx, readOffsets)
+				spanErr = m.udfError
 				return
 			}
+			// the written messages carry the trace context of the span in this vertex
+			traceContext := m.span.TraceContext()
+			// hold the message if the watermark hasn't passed its event time yet
+			held := isdf.gate != nil && !isReady(m.readMessage, gateWM)
+			if held {
+				newHeld = append(newHeld, heldMessage{readMessage: m.readMessage, writeMessages: m.writeMessages})
+			}
 			// update toBuffers
 			for _, message := range m.writeMessages {
+				if traceContext != "" {
+					message.TraceContext = traceContext
+				}
+				if held {
+					continue
+				}
 				if err := isdf.whereToStep(message, messageToStep, m.readMessage); err != nil {
 					isdf.opts.logger.Errorw("failed in whereToStep", zap.Error(err))
 					isdf.fromBufferPartition.NoAck(ctx, readOffsets)
+					spanErr = err
 					return
 				}
 			}
 		}
 
 		// forward the message to the edge buffer (could be multiple edges)
+		writeStart := time.Now()
 		writeOffsets, err = isdf.writeToBuffers(ctx, messageToStep)
+		spans.Phase("write", writeStart, time.Now(), err)
 		if err != nil {
 			isdf.opts.logger.Errorw("failed to write to toBuffers", zap.Error(err))
 			isdf.fromBufferPartition.NoAck(ctx, readOffsets)
+			spanErr = err
 			return
 		}
 		isdf.opts.logger.Debugw("writeToBuffers completed")
+		if isdf.batchSizer != nil {
+			isdf.batchSizer.update(len(readMessages), time.Since(readEnd))
+		}
+		if isdf.gate != nil {
+			isdf.gate.update(gateWM, newHeld)
+			ackOffsets = gatedAckOffsets(readOffsets, released, newHeld)
+			heldMessagesCount.With(map[string]string{metrics.LabelVertex: isdf.vertexName, metrics.LabelPipeline: isdf.pipelineName, metrics.LabelPartitionName: isdf.fromBufferPartition.GetName()}).Set(float64(len(isdf.gate.held)))
+		}
 	} else {
-		writeOffsets, err = isdf.streamMessage(ctx, dataMessages, processorWM)
+		writeOffsets, err = isdf.streamMessage(ctx, dataMessages, processorWM, spans.Get(0))
 		if err != nil {
 			isdf.opts.logger.Errorw("failed to streamMessage", zap.Error(err))
 			// As there's no partial failure, non-ack all the readOffsets
 			isdf.fromBufferPartition.NoAck(ctx, readOffsets)
+			spanErr = err
 			return
 		}
 	}
@@ -392,24 +542,79 @@ func (isdf *InterStepDataForward) forwardAChunk(ctx context.Context) {
 
 	// when we apply udf, we don't handle partial errors (it's either non or all, non will return early),
 	// so we should be able to ack all the readOffsets including data messages and control messages
-	err = isdf.ackFromBuffer(ctx, readOffsets)
+	ackStart := time.Now()
+	err = isdf.ackFromBuffer(ctx, ackOffsets)
+	spans.Phase("ack", ackStart, time.Now(), err)
 	// implicit return for posterity :-)
 	if err != nil {
 		isdf.opts.logger.Errorw("failed to ack from buffer", zap.Error(err))
-		ackMessageError.With(map[string]string{metrics.LabelVertex: isdf.vertexName, metrics.LabelPipeline: isdf.pipelineName, metrics.LabelPartitionName: isdf.fromBufferPartition.GetName()}).Add(float64(len(readOffsets)))
+		spanErr = err
+		ackMessageError.With(map[string]string{metrics.LabelVertex: isdf.vertexName, metrics.LabelPipeline: isdf.pipelineName, metrics.LabelPartitionName: isdf.fromBufferPartition.GetName()}).Add(float64(len(ackOffsets)))
 		return
 	}
-	ackMessagesCount.With(map[string]string{metrics.LabelVertex: isdf.vertexName, metrics.LabelPipeline: isdf.pipelineName, metrics.LabelPartitionName: isdf.fromBufferPartition.GetName()}).Add(float64(len(readOffsets)))
+	ackMessagesCount.With(map[string]string{metrics.LabelVertex: isdf.vertexName, metrics.LabelPipeline: isdf.pipelineName, metrics.LabelPartitionName: isdf.fromBufferPartition.GetName()}).Add(float64(len(ackOffsets)))
+	acked = true
 
 	// ProcessingTimes of the entire forwardAChunk
 	forwardAChunkProcessingTime.With(map[string]string{metrics.LabelVertex: isdf.vertexName, metrics.LabelPipeline: isdf.pipelineName, metrics.LabelPartitionName: isdf.fromBufferPartition.GetName()}).Observe(float64(time.Since(start).Microseconds()))
 }
 
+// gateWatermark returns the watermark to emit the held messages with, which is the later one of the given watermark and
+// the head watermark of the from buffer partition.
+func (isdf *InterStepDataForward) gateWatermark(wm wmb.Watermark) wmb.Watermark {
+	if f, ok := isdf.wmFetcher.(fetch.UXFetcher); ok {
+		if headWM := f.ComputeHeadWatermark(isdf.fromBufferPartition.GetPartitionIdx()); headWM.AfterWatermark(wm) {
+			return headWM
+		}
+	}
+	return wm
+}
+
+// emitHeldMessages writes the held messages which are ready to be emitted with the given watermark, and acks them.
+// It returns whether any message is emitted.
+func (isdf *InterStepDataForward) emitHeldMessages(ctx context.Context, wm wmb.Watermark) bool {
+	released := isdf.gate.ready(wm)
+	if len(released) == 0 {
+		return false
+	}
+	var acked bool
+	defer func() { isdf.endTransactions(ctx, acked) }()
+	var messageToStep = make(map[string][][]isb.Message)
+	for toVertex := range isdf.toBuffers {
+		messageToStep[toVertex] = make([][]isb.Message, len(isdf.toBuffers[toVertex]))
+	}
+	for _, h := range released {
+		for _, message := range h.writeMessages {
+			if err := isdf.whereToStep(message, messageToStep, h.readMessage); err != nil {
+				isdf.opts.logger.Errorw("failed in whereToStep", zap.Error(err))
+				return false
+			}
+		}
+	}
+	if _, err := isdf.writeToBuffers(ctx, messageToStep); err != nil {
+		isdf.opts.logger.Errorw("failed to write the held messages to toBuffers", zap.Error(err))
+		return false
+	}
+	isdf.gate.update(wm, nil)
+	labels := map[string]string{metrics.LabelVertex: isdf.vertexName, metrics.LabelPipeline: isdf.pipelineName, metrics.LabelPartitionName: isdf.fromBufferPartition.GetName()}
+	heldMessagesCount.With(labels).Set(float64(len(isdf.gate.held)))
+	offsets := gatedAckOffsets(nil, released, nil)
+	if err := isdf.ackFromBuffer(ctx, offsets); err != nil {
+		isdf.opts.logger.Errorw("failed to ack the held messages from buffer", zap.Error(err))
+		ackMessageError.With(labels).Add(float64(len(offsets)))
+		return true
+	}
+	ackMessagesCount.With(labels).Add(float64(len(offsets)))
+	acked = true
+	return true
+}
+
 // streamMessage streams the data messages to the next step.
 func (isdf *InterStepDataForward) streamMessage(
 	ctx context.Context,
 	dataMessages []*isb.ReadMessage,
 	processorWM wmb.Watermark,
+	span *tracing.Span,
 ) (map[string][][]isb.Offset, error) {
 	// create space for writeMessages specific to each step as we could forward to all the steps too.
 	// these messages are for per partition (due to round-robin writes) for load balancing
@@ -439,10 +644,15 @@ func (isdf *InterStepDataForward) streamMessage(
 		start := time.Now()
 		udfReadMessagesCount.With(map[string]string{metrics.LabelVertex: isdf.vertexName, metrics.LabelPipeline: isdf.pipelineName, metrics.LabelPartitionName: isdf.fromBufferPartition.GetName()}).Inc()
 
+		traceContext := span.TraceContext()
 		writeMessageCh := make(chan isb.WriteMessage)
 		errs, ctx := errgroup.WithContext(ctx)
 		errs.Go(func() error {
-			return isdf.mapStreamUDF.ApplyMapStream(ctx, dataMessages[0], writeMessageCh)
+			// the apply phase of a streamed message includes writing its results to the buffers
+			udfCtx, endPhase := span.StartPhase(ctx, "apply")
+			err := isdf.mapStreamUDF.ApplyMapStream(msglog.OutgoingContext(tracing.OutgoingContext(udfCtx), dataMessages[0].ID), dataMessages[0], writeMessageCh)
+			endPhase(err)
+			return err
 		})
 
 		// Stream the message to the next vertex. First figure out which vertex
@@ -451,12 +661,21 @@ func (isdf *InterStepDataForward) streamMessage(
 		for writeMessage := range writeMessageCh {
 			// add vertex name to the ID, since multiple vertices can publish to the same vertex and we need uniqueness across them
 			writeMessage.ID = fmt.Sprintf("%s-%s-%d", dataMessages[0].ReadOffset.String(), isdf.vertexName, msgIndex)
+			if traceContext != "" {
+				writeMessage.TraceContext = traceContext
+			}
 			msgIndex += 1
 			udfWriteMessagesCount.With(map[string]string{metrics.LabelVertex: isdf.vertexName, metrics.LabelPipeline: isdf.pipelineName, metrics.LabelPartitionName: isdf.fromBufferPartition.GetName()}).Add(float64(1))
 
+			intercepted, err := isdf.interceptors.PostUDF(ctx, dataMessages[0], []*isb.WriteMessage{&writeMessage})
+			if err != nil {
+				return nil, fmt.Errorf("interceptor failed after the map UDF, error: %w", err)
+			}
 			// update toBuffers
-			if err := isdf.whereToStep(&writeMessage, messageToStep, dataMessages[0]); err != nil {
-				return nil, fmt.Errorf("failed at whereToStep, error: %w", err)
+			for _, m := range intercepted {
+				if err := isdf.whereToStep(m, messageToStep, dataMessages[0]); err != nil {
+					return nil, fmt.Errorf("failed at whereToStep, error: %w", err)
+				}
 			}
 
 			// Forward the message to the edge buffer (could be multiple edges)
@@ -548,6 +767,26 @@ func (isdf *InterStepDataForward) ackFromBuffer(ctx context.Context, offsets []i
 	return ctxClosedErr
 }
 
+// endTransactions commits the transactions of the transactional toBuffers if the chunk has been acked, or aborts them
+// otherwise.
+func (isdf *InterStepDataForward) endTransactions(ctx context.Context, acked bool) {
+	for _, buffer := range isdf.toBuffers {
+		for _, partition := range buffer {
+			writer, ok := partition.(isb.TransactionalBufferWriter)
+			if !ok {
+				continue
+			}
+			if acked {
+				if err := writer.CommitTransaction(ctx); err != nil {
+					isdf.opts.logger.Errorw("Failed to commit the transaction", zap.String("bufferTo", writer.GetName()), zap.Error(err))
+				}
+			} else if err := writer.AbortTransaction(ctx); err != nil {
+				isdf.opts.logger.Errorw("Failed to abort the transaction", zap.String("bufferTo", writer.GetName()), zap.Error(err))
+			}
+		}
+	}
+}
+
 // writeToBuffers is a blocking call until all the messages have be forwarded to all the toBuffers, or a shutdown
 // has been initiated while we are stuck looping on an InternalError.
 func (isdf *InterStepDataForward) writeToBuffers(
@@ -561,7 +800,13 @@ func (isdf *InterStepDataForward) writeToBuffers(
 	}
 	for toVertexName, toVertexBuffer := range isdf.toBuffers {
 		for index, partition := range toVertexBuffer {
-			writeOffsets[toVertexName][index], err = isdf.writeToBuffer(ctx, partition, messageToStep[toVertexName][index])
+			messages := messageToStep[toVertexName][index]
+			if len(messages) > 0 {
+				if messages, err = isdf.interceptors.PreWrite(ctx, partition.GetName(), messages); err != nil {
+					return nil, fmt.Errorf("interceptor failed before writing to %s, error: %w", partition.GetName(), err)
+				}
+			}
+			writeOffsets[toVertexName][index], err = isdf.writeToBuffer(ctx, partition, messages)
 			if err != nil {
 				return nil, err
 			}
@@ -580,8 +825,15 @@ func (isdf *InterStepDataForward) writeToBuffer(ctx context.Context, toBufferPar
 	)
 	totalCount = len(messages)
 	writeOffsets = make([]isb.Offset, 0, totalCount)
+	labels := map[string]string{metrics.LabelVertex: isdf.vertexName, metrics.LabelPipeline: isdf.pipelineName, metrics.LabelPartitionName: toBufferPartition.GetName()}
+	consecutiveFailures := 0
+	defer func() {
+		writeInFlight.With(labels).Set(0)
+		writeBackoffSeconds.With(labels).Set(0)
+	}()
 
 	for {
+		writeInFlight.With(labels).Set(float64(len(messages)))
 		_writeOffsets, errs := toBufferPartition.Write(ctx, messages)
 		// Note: this is an unwanted memory allocation during a happy path. We want only minimal allocation since using failedMessages is an unlikely path.
 		var failedMessages []isb.Message
@@ -596,7 +848,7 @@ func (isdf *InterStepDataForward) writeToBuffer(ctx context.Context, toBufferPar
 					needRetry = true
 					// we retry only failed messages
 					failedMessages = append(failedMessages, msg)
-					writeMessagesError.With(map[string]string{metrics.LabelVertex: isdf.vertexName, metrics.LabelPipeline: isdf.pipelineName, metrics.LabelPartitionName: toBufferPartition.GetName()}).Inc()
+					writeMessagesError.With(labels).Inc()
 					// a shutdown can break the blocking loop caused due to InternalErr
 					if ok, _ := isdf.IsShuttingDown(); ok {
 						platformError.With(map[string]string{metrics.LabelVertex: isdf.vertexName, metrics.LabelPipeline: isdf.pipelineName}).Inc()
@@ -622,31 +874,83 @@ func (isdf *InterStepDataForward) writeToBuffer(ctx context.Context, toBufferPar
 			)
 			// set messages to failed for the retry
 			messages = failedMessages
+			if isdf.batchSizer != nil {
+				isdf.batchSizer.recordWriteFailure()
+			}
+			consecutiveFailures++
+			writeConsecutiveFailures.With(labels).Set(float64(consecutiveFailures))
+			writeBackoffSeconds.With(labels).Set(isdf.opts.retryInterval.Seconds())
 			// TODO: implement retry with backoff etc.
 			time.Sleep(isdf.opts.retryInterval)
 		} else {
+			writeConsecutiveFailures.With(labels).Set(0)
 			break
 		}
 	}
 
-	dropMessagesCount.With(map[string]string{metrics.LabelVertex: isdf.vertexName, metrics.LabelPipeline: isdf.pipelineName, metrics.LabelPartitionName: toBufferPartition.GetName()}).Add(float64(totalCount - writeCount))
-	dropBytesCount.With(map[string]string{metrics.LabelVertex: isdf.vertexName, metrics.LabelPipeline: isdf.pipelineName, metrics.LabelPartitionName: toBufferPartition.GetName()}).Add(dropBytes)
-	writeMessagesCount.With(map[string]string{metrics.LabelVertex: isdf.vertexName, metrics.LabelPipeline: isdf.pipelineName, metrics.LabelPartitionName: toBufferPartition.GetName()}).Add(float64(writeCount))
-	writeBytesCount.With(map[string]string{metrics.LabelVertex: isdf.vertexName, metrics.LabelPipeline: isdf.pipelineName, metrics.LabelPartitionName: toBufferPartition.GetName()}).Add(writeBytes)
+	dropMessagesCount.With(labels).Add(float64(totalCount - writeCount))
+	dropBytesCount.With(labels).Add(dropBytes)
+	writeMessagesCount.With(labels).Add(float64(writeCount))
+	writeBytesCount.With(labels).Add(writeBytes)
 	return writeOffsets, nil
 }
 
-// concurrentApplyUDF applies the map UDF based on the request from the channel
-func (isdf *InterStepDataForward) concurrentApplyUDF(ctx context.Context, readMessagePair <-chan *readWriteMessagePair) {
-	for message := range readMessagePair {
-		start := time.Now()
-		udfReadMessagesCount.With(map[string]string{metrics.LabelVertex: isdf.vertexName, metrics.LabelPipeline: isdf.pipelineName, metrics.LabelPartitionName: isdf.fromBufferPartition.GetName()}).Inc()
-		writeMessages, err := isdf.applyUDF(ctx, message.readMessage)
-		udfWriteMessagesCount.With(map[string]string{metrics.LabelVertex: isdf.vertexName, metrics.LabelPipeline: isdf.pipelineName, metrics.LabelPartitionName: isdf.fromBufferPartition.GetName()}).Add(float64(len(writeMessages)))
-		message.writeMessages = append(message.writeMessages, writeMessages...)
-		message.udfError = err
-		udfProcessingTime.With(map[string]string{metrics.LabelVertex: isdf.vertexName, metrics.LabelPipeline: isdf.pipelineName, metrics.LabelPartitionName: isdf.fromBufferPartition.GetName()}).Observe(float64(time.Since(start).Microseconds()))
+// groupByKeys groups the messages to be sent to the map UDF. If keyOrdered is true, the messages with the same keys
+// are in the same group in the order they are read, otherwise, or if a message has no keys, each message is a group.
+func groupByKeys(pairs []readWriteMessagePair, keyOrdered bool) [][]*readWriteMessagePair {
+	groups := make([][]*readWriteMessagePair, 0, len(pairs))
+	groupIdx := make(map[string]int)
+	for i := range pairs {
+		p := &pairs[i]
+		if !keyOrdered || len(p.readMessage.Keys) == 0 {
+			groups = append(groups, []*readWriteMessagePair{p})
+			continue
+		}
+		key := strings.Join(p.readMessage.Keys, keysDelimiter)
+		if idx, ok := groupIdx[key]; ok {
+			groups[idx] = append(groups[idx], p)
+			continue
+		}
+		groupIdx[key] = len(groups)
+		groups = append(groups, []*readWriteMessagePair{p})
 	}
+	return groups
+}
+
+// concurrentApplyUDF applies the map UDF based on the request from the channel, the messages of a group are applied
+// one after another.
+func (isdf *InterStepDataForward) concurrentApplyUDF(ctx context.Context, readMessagePairs <-chan []*readWriteMessagePair) {
+	for group := range readMessagePairs {
+		for _, message := range group {
+			isdf.applyUDFToPair(ctx, message)
+			// the batch fails as a whole on an error, the rest of the group are not applied ahead of the failed one
+			if message.udfError != nil {
+				break
+			}
+		}
+	}
+}
+
+// applyUDFToPair applies the map UDF to the read message of the pair, and sets the results to the pair.
+func (isdf *InterStepDataForward) applyUDFToPair(ctx context.Context, message *readWriteMessagePair) {
+	start := time.Now()
+	udfReadMessagesCount.With(map[string]string{metrics.LabelVertex: isdf.vertexName, metrics.LabelPipeline: isdf.pipelineName, metrics.LabelPartitionName: isdf.fromBufferPartition.GetName()}).Inc()
+	// the apply phase is not traced for sinks, which apply a terminal function
+	var udfCtx, endPhase = ctx, func(error) {}
+	if isdf.opts.vertexType != dfv1.VertexTypeSink {
+		udfCtx, endPhase = message.span.StartPhase(ctx, "apply")
+	}
+	writeMessages, err := isdf.applyUDF(msglog.OutgoingContext(tracing.OutgoingContext(udfCtx), message.readMessage.ID), message.readMessage)
+	endPhase(err)
+	udfWriteMessagesCount.With(map[string]string{metrics.LabelVertex: isdf.vertexName, metrics.LabelPipeline: isdf.pipelineName, metrics.LabelPartitionName: isdf.fromBufferPartition.GetName()}).Add(float64(len(writeMessages)))
+	if err == nil {
+		if writeMessages, err = isdf.interceptors.PostUDF(ctx, message.readMessage, writeMessages); err != nil {
+			err = fmt.Errorf("interceptor failed after the map UDF, %w", err)
+		}
+	}
+	message.writeMessages = append(message.writeMessages, writeMessages...)
+	message.udfError = err
+	udfProcessingTime.With(map[string]string{metrics.LabelVertex: isdf.vertexName, metrics.LabelPipeline: isdf.pipelineName, metrics.LabelPartitionName: isdf.fromBufferPartition.GetName()}).Observe(float64(time.Since(start).Microseconds()))
 }
 
 // applyUDF applies the map UDF and will block if there is any InternalErr. On the other hand, if this is a UserError
@@ -685,7 +989,7 @@ func (isdf *InterStepDataForward) applyUDF(ctx context.Context, readMessage *isb
 // whereToStep executes the WhereTo interfaces and then updates the to step's writeToBuffers buffer.
 func (isdf *InterStepDataForward) whereToStep(writeMessage *isb.WriteMessage, messageToStep map[string][][]isb.Message, readMessage *isb.ReadMessage) error {
 	// call WhereTo and drop it on errors
-	to, err := isdf.FSD.WhereTo(writeMessage.Keys, writeMessage.Tags)
+	to, err := isdf.FSD.WhereTo(writeMessage.Keys, writeMessage.Tags, writeMessage.SchemaVersion, writeMessage.Headers)
 	if err != nil {
 		isdf.opts.logger.Errorw("failed in whereToStep", zap.Error(isb.MessageWriteErr{Name: isdf.fromBufferPartition.GetName(), Header: readMessage.Header, Body: readMessage.Body, Message: fmt.Sprintf("WhereTo failed, %s", err)}))
 		// a shutdown can break the blocking loop caused due to InternalErr
@@ -696,16 +1000,36 @@ func (isdf *InterStepDataForward) whereToStep(writeMessage *isb.WriteMessage, me
 		}
 		return err
 	}
+	to = ApplySchemas(isdf.schemaValidator, to, writeMessage.Payload, func(toVertex string) int { return len(messageToStep[toVertex]) }, isdf.opts.logger)
 
 	for _, t := range to {
 		if _, ok := messageToStep[t.ToVertexName]; !ok {
 			isdf.opts.logger.Errorw("failed in whereToStep", zap.Error(isb.MessageWriteErr{Name: isdf.fromBufferPartition.GetName(), Header: readMessage.Header, Body: readMessage.Body, Message: fmt.Sprintf("no such destination (%s)", t.ToVertexName)}))
 		}
-		messageToStep[t.ToVertexName][t.ToVertexPartitionIdx] = append(messageToStep[t.ToVertexName][t.ToVertexPartitionIdx], writeMessage.Message)
+		message := writeMessage.Message
+		// the origin of the read message is not carried over, so that it's not mistaken for this vertex's
+		message.Origin = ""
+		if isdf.originRequired[t.ToVertexName] {
+			message.Origin = isdf.vertexName
+		}
+		messageToStep[t.ToVertexName][t.ToVertexPartitionIdx] = append(messageToStep[t.ToVertexName][t.ToVertexPartitionIdx], message)
 	}
 	return nil
 }
 
+// OriginRequiredVertices returns the names of the downstream vertices which need to know the origin of the messages,
+// they are the reduce vertices, which could join the messages from multiple inbound edges, and the ones reading from
+// an edge with a message TTL.
+func OriginRequiredVertices(vertex *dfv1.Vertex) map[string]bool {
+	result := make(map[string]bool)
+	for _, edge := range vertex.Spec.ToEdges {
+		if edge.ToVertexType == dfv1.VertexTypeReduceUDF || edge.MessageTTL != nil {
+			result[edge.To] = true
+		}
+	}
+	return result
+}
+
 // errorArrayToMap summarizes an error array to map
 func errorArrayToMap(errs []error) map[string]int64 {
 	result := make(map[string]int64)
@@ -28,11 +28,14 @@ import (
 
 	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/forward/interceptor"
 	"github.com/numaproj/numaflow/pkg/isb"
 	"github.com/numaproj/numaflow/pkg/isb/stores/simplebuffer"
 	"github.com/numaproj/numaflow/pkg/isb/testutils"
+	"github.com/numaproj/numaflow/pkg/metrics"
 	"github.com/numaproj/numaflow/pkg/shared/kvs"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 	udfapplier "github.com/numaproj/numaflow/pkg/udf/rpc"
@@ -81,7 +84,7 @@ func (t *testForwardFetcher) ComputeHeadIdleWMB(int32) wmb.WMB {
 type myForwardTest struct {
 }
 
-func (f myForwardTest) WhereTo(_ []string, _ []string) ([]VertexBuffer, error) {
+func (f myForwardTest) WhereTo(_ []string, _ []string, _ string, _ map[string]string) ([]VertexBuffer, error) {
 	return []VertexBuffer{{
 		ToVertexName:         "to1",
 		ToVertexPartitionIdx: 0,
@@ -1144,7 +1147,7 @@ func TestNewInterStepDataForwardIdleWatermark_Reset(t *testing.T) {
 type mySourceForwardTest struct {
 }
 
-func (f mySourceForwardTest) WhereTo(_ []string, _ []string) ([]VertexBuffer, error) {
+func (f mySourceForwardTest) WhereTo(_ []string, _ []string, _ string, _ map[string]string) ([]VertexBuffer, error) {
 	return []VertexBuffer{{
 		ToVertexName:         "to1",
 		ToVertexPartitionIdx: 0,
@@ -1155,7 +1158,7 @@ type mySourceForwardTestRoundRobin struct {
 	count int
 }
 
-func (f *mySourceForwardTestRoundRobin) WhereTo(_ []string, _ []string) ([]VertexBuffer, error) {
+func (f *mySourceForwardTestRoundRobin) WhereTo(_ []string, _ []string, _ string, _ map[string]string) ([]VertexBuffer, error) {
 	var output = []VertexBuffer{{
 		ToVertexName:         "to1",
 		ToVertexPartitionIdx: int32(f.count % 2),
@@ -1431,7 +1434,7 @@ func TestWriteToBuffer(t *testing.T) {
 type myForwardDropTest struct {
 }
 
-func (f myForwardDropTest) WhereTo(_ []string, _ []string) ([]VertexBuffer, error) {
+func (f myForwardDropTest) WhereTo(_ []string, _ []string, _ string, _ map[string]string) ([]VertexBuffer, error) {
 	return []VertexBuffer{}, nil
 }
 
@@ -1447,7 +1450,7 @@ type myForwardToAllTest struct {
 	count int
 }
 
-func (f *myForwardToAllTest) WhereTo(_ []string, _ []string) ([]VertexBuffer, error) {
+func (f *myForwardToAllTest) WhereTo(_ []string, _ []string, _ string, _ map[string]string) ([]VertexBuffer, error) {
 	var output = []VertexBuffer{{
 		ToVertexName:         "to1",
 		ToVertexPartitionIdx: int32(f.count % 2),
@@ -1471,7 +1474,7 @@ func (f myForwardToAllTest) ApplyMapStream(ctx context.Context, message *isb.Rea
 type myForwardInternalErrTest struct {
 }
 
-func (f myForwardInternalErrTest) WhereTo(_ []string, _ []string) ([]VertexBuffer, error) {
+func (f myForwardInternalErrTest) WhereTo(_ []string, _ []string, _ string, _ map[string]string) ([]VertexBuffer, error) {
 	return []VertexBuffer{{
 		ToVertexName:         "to1",
 		ToVertexPartitionIdx: 0,
@@ -1504,7 +1507,7 @@ func (f myForwardInternalErrTest) ApplyMapStream(_ context.Context, _ *isb.ReadM
 type myForwardApplyWhereToErrTest struct {
 }
 
-func (f myForwardApplyWhereToErrTest) WhereTo(_ []string, _ []string) ([]VertexBuffer, error) {
+func (f myForwardApplyWhereToErrTest) WhereTo(_ []string, _ []string, _ string, _ map[string]string) ([]VertexBuffer, error) {
 	return []VertexBuffer{{
 		ToVertexName:         "to1",
 		ToVertexPartitionIdx: 0,
@@ -1522,7 +1525,7 @@ func (f myForwardApplyWhereToErrTest) ApplyMapStream(ctx context.Context, messag
 type myForwardApplyUDFErrTest struct {
 }
 
-func (f myForwardApplyUDFErrTest) WhereTo(_ []string, _ []string) ([]VertexBuffer, error) {
+func (f myForwardApplyUDFErrTest) WhereTo(_ []string, _ []string, _ string, _ map[string]string) ([]VertexBuffer, error) {
 	return []VertexBuffer{{
 		ToVertexName:         "to1",
 		ToVertexPartitionIdx: 0,
@@ -1608,3 +1611,311 @@ func buildPublisherMapAndOTStore(toBuffers map[string][]isb.BufferWriter) (map[s
 	}
 	return publishers, otStores
 }
+
+// flakyBufferWriter fails the writes for the first few attempts.
+type flakyBufferWriter struct {
+	failures  int
+	attempts  int
+	inFlights []float64
+	// failureGauges are the consecutive failures gauge values observed by the attempts
+	failureGauges []float64
+}
+
+func (f *flakyBufferWriter) GetName() string {
+	return "flaky"
+}
+
+func (f *flakyBufferWriter) GetPartitionIdx() int32 {
+	return 0
+}
+
+func (f *flakyBufferWriter) Close() error {
+	return nil
+}
+
+func (f *flakyBufferWriter) Write(_ context.Context, messages []isb.Message) ([]isb.Offset, []error) {
+	labels := map[string]string{metrics.LabelVertex: "testVertex", metrics.LabelPipeline: "testPipeline", metrics.LabelPartitionName: f.GetName()}
+	f.inFlights = append(f.inFlights, testutil.ToFloat64(writeInFlight.With(labels)))
+	f.failureGauges = append(f.failureGauges, testutil.ToFloat64(writeConsecutiveFailures.With(labels)))
+	f.attempts++
+	errs := make([]error, len(messages))
+	if f.attempts <= f.failures {
+		for i := range errs {
+			errs[i] = fmt.Errorf("failed to write")
+		}
+	}
+	return nil, errs
+}
+
+func TestWriteToBuffer_Gauges(t *testing.T) {
+	isdf := &InterStepDataForward{
+		vertexName:   "testVertex",
+		pipelineName: "testPipeline",
+		opts:         *DefaultOptions(),
+		Shutdown:     Shutdown{rwlock: new(sync.RWMutex)},
+	}
+	labels := map[string]string{metrics.LabelVertex: "testVertex", metrics.LabelPipeline: "testPipeline", metrics.LabelPartitionName: "flaky"}
+	writer := &flakyBufferWriter{failures: 2}
+	messages := testutils.BuildTestWriteMessages(3, time.Unix(1636470000, 0))
+	_, err := isdf.writeToBuffer(context.Background(), writer, messages)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, writer.attempts)
+	assert.Equal(t, []float64{3, 3, 3}, writer.inFlights)
+	assert.Equal(t, []float64{0, 1, 2}, writer.failureGauges)
+	assert.Equal(t, float64(0), testutil.ToFloat64(writeInFlight.With(labels)))
+	assert.Equal(t, float64(0), testutil.ToFloat64(writeConsecutiveFailures.With(labels)))
+	assert.Equal(t, float64(0), testutil.ToFloat64(writeBackoffSeconds.With(labels)))
+}
+
+func TestWhereToStep_Origin(t *testing.T) {
+	vertex := &dfv1.Vertex{Spec: dfv1.VertexSpec{
+		PipelineName: "testPipeline",
+		AbstractVertex: dfv1.AbstractVertex{
+			Name: "testVertex",
+		},
+		ToEdges: []dfv1.CombinedEdge{
+			{Edge: dfv1.Edge{From: "testVertex", To: "map"}, ToVertexType: dfv1.VertexTypeMapUDF},
+			{Edge: dfv1.Edge{From: "testVertex", To: "join"}, ToVertexType: dfv1.VertexTypeReduceUDF},
+			{Edge: dfv1.Edge{From: "testVertex", To: "sink", MessageTTL: &dfv1.MessageTTL{}}, ToVertexType: dfv1.VertexTypeSink},
+		},
+	}}
+	assert.Equal(t, map[string]bool{"join": true, "sink": true}, OriginRequiredVertices(vertex))
+	vertex.Spec.ToEdges = vertex.Spec.ToEdges[:2]
+	assert.Equal(t, map[string]bool{"join": true}, OriginRequiredVertices(vertex))
+
+	isdf := &InterStepDataForward{
+		vertexName:     vertex.Spec.Name,
+		originRequired: OriginRequiredVertices(vertex),
+		FSD: GoWhere(func(keys []string, tags []string, sv string, _ map[string]string) ([]VertexBuffer, error) {
+			return []VertexBuffer{{ToVertexName: "map"}, {ToVertexName: "join"}}, nil
+		}),
+	}
+	messageToStep := map[string][][]isb.Message{"map": make([][]isb.Message, 1), "join": make([][]isb.Message, 1)}
+	// the origin of the read message is not carried over
+	writeMessage := &isb.WriteMessage{Message: isb.Message{Header: isb.Header{Keys: []string{"k"}, MessageInfo: isb.MessageInfo{Origin: "in"}}}}
+	err := isdf.whereToStep(writeMessage, messageToStep, &isb.ReadMessage{})
+	assert.NoError(t, err)
+	assert.Equal(t, "", messageToStep["map"][0][0].Origin)
+	assert.Equal(t, "testVertex", messageToStep["join"][0][0].Origin)
+	assert.Equal(t, "in", writeMessage.Origin)
+}
+
+func TestNewInterStepDataForward_RetryInterval(t *testing.T) {
+	fromStep := simplebuffer.NewInMemoryBuffer("from", 10, 0)
+	to1 := simplebuffer.NewInMemoryBuffer("to1", 10, 0)
+	toSteps := map[string][]isb.BufferWriter{
+		"to1": {to1},
+	}
+	vertex := &dfv1.Vertex{Spec: dfv1.VertexSpec{
+		PipelineName: "testPipeline",
+		AbstractVertex: dfv1.AbstractVertex{
+			Name:   "testVertex",
+			Limits: &dfv1.VertexLimits{RetryInterval: &metav1.Duration{Duration: 50 * time.Millisecond}},
+		},
+	}}
+	fetchWatermark, publishWatermark := generic.BuildNoOpWatermarkProgressorsFromBufferMap(toSteps)
+	f, err := NewInterStepDataForward(vertex, fromStep, toSteps, &mySourceForwardTestRoundRobin{}, myForwardTest{}, myForwardTest{}, fetchWatermark, publishWatermark)
+	assert.NoError(t, err)
+	assert.Equal(t, 50*time.Millisecond, f.opts.retryInterval)
+
+	// the option takes precedence over the vertex limits
+	f, err = NewInterStepDataForward(vertex, fromStep, toSteps, &mySourceForwardTestRoundRobin{}, myForwardTest{}, myForwardTest{}, fetchWatermark, publishWatermark, WithRetryInterval(time.Second))
+	assert.NoError(t, err)
+	assert.Equal(t, time.Second, f.opts.retryInterval)
+}
+
+func TestNewInterStepDataForward_StrictFIFO(t *testing.T) {
+	fromStep := simplebuffer.NewInMemoryBuffer("from", 10, 0)
+	to1 := simplebuffer.NewInMemoryBuffer("to1", 10, 0)
+	toSteps := map[string][]isb.BufferWriter{
+		"to1": {to1},
+	}
+	vertex := &dfv1.Vertex{Spec: dfv1.VertexSpec{
+		PipelineName: "testPipeline",
+		AbstractVertex: dfv1.AbstractVertex{
+			Name: "testVertex",
+		},
+		FromEdges: []dfv1.CombinedEdge{{Edge: dfv1.Edge{From: "in", To: "testVertex", StrictFIFO: true}}},
+	}}
+	fetchWatermark, publishWatermark := generic.BuildNoOpWatermarkProgressorsFromBufferMap(toSteps)
+	f, err := NewInterStepDataForward(vertex, fromStep, toSteps, &mySourceForwardTestRoundRobin{}, myForwardTest{}, myForwardTest{}, fetchWatermark, publishWatermark, WithUDFConcurrency(10))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, f.opts.udfConcurrency)
+
+	vertex.Spec.Sink = &dfv1.Sink{WatermarkGate: &dfv1.WatermarkGate{}}
+	_, err = NewInterStepDataForward(vertex, fromStep, toSteps, &mySourceForwardTestRoundRobin{}, myForwardTest{}, myForwardTest{}, fetchWatermark, publishWatermark)
+	assert.Error(t, err)
+}
+
+func TestGroupByKeys(t *testing.T) {
+	keys := [][]string{{"a"}, {"b"}, {"a"}, {}, {"a", "b"}, {"b"}, {}}
+	pairs := make([]readWriteMessagePair, len(keys))
+	for i, k := range keys {
+		pairs[i].readMessage = &isb.ReadMessage{Message: isb.Message{Header: isb.Header{ID: fmt.Sprintf("%d", i), Keys: k}}}
+	}
+	ids := func(groups [][]*readWriteMessagePair) [][]string {
+		var result [][]string
+		for _, g := range groups {
+			var group []string
+			for _, p := range g {
+				group = append(group, p.readMessage.ID)
+			}
+			result = append(result, group)
+		}
+		return result
+	}
+	assert.Equal(t, [][]string{{"0"}, {"1"}, {"2"}, {"3"}, {"4"}, {"5"}, {"6"}}, ids(groupByKeys(pairs, false)))
+	assert.Equal(t, [][]string{{"0", "2"}, {"1", "5"}, {"3"}, {"4"}, {"6"}}, ids(groupByKeys(pairs, true)))
+}
+
+// keyOrderTracker is a map UDF tracking the order the messages of each key are applied, and if any of them are
+// applied concurrently.
+type keyOrderTracker struct {
+	lock       sync.Mutex
+	inflight   map[string]bool
+	applied    map[string][]string
+	concurrent bool
+}
+
+func (k *keyOrderTracker) ApplyMap(ctx context.Context, message *isb.ReadMessage) ([]*isb.WriteMessage, error) {
+	key := strings.Join(message.Keys, ",")
+	k.lock.Lock()
+	if k.inflight[key] {
+		k.concurrent = true
+	}
+	k.inflight[key] = true
+	k.lock.Unlock()
+	time.Sleep(time.Millisecond)
+	k.lock.Lock()
+	k.inflight[key] = false
+	k.applied[key] = append(k.applied[key], message.ID)
+	k.lock.Unlock()
+	return testutils.CopyUDFTestApply(ctx, message)
+}
+
+func TestInterStepDataForward_KeyOrdered(t *testing.T) {
+	fromStep := simplebuffer.NewInMemoryBuffer("from", 25, 0)
+	to1 := simplebuffer.NewInMemoryBuffer("to1", 25, 0, simplebuffer.WithReadTimeOut(time.Second*10))
+	toSteps := map[string][]isb.BufferWriter{
+		"to1": {to1},
+	}
+	vertex := &dfv1.Vertex{Spec: dfv1.VertexSpec{
+		PipelineName: "testPipeline",
+		AbstractVertex: dfv1.AbstractVertex{
+			Name:   "receivingVertex",
+			Limits: &dfv1.VertexLimits{KeyOrdered: true},
+		},
+	}}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	writeMessages := testutils.BuildTestWriteMessages(int64(20), testStartTime)
+	for i := range writeMessages {
+		writeMessages[i].Keys = []string{fmt.Sprintf("key-%d", i%2)}
+	}
+	fetchWatermark := &testForwardFetcher{}
+	_, publishWatermark := generic.BuildNoOpWatermarkProgressorsFromBufferMap(toSteps)
+
+	tracker := &keyOrderTracker{inflight: map[string]bool{}, applied: map[string][]string{}}
+	f, err := NewInterStepDataForward(vertex, fromStep, toSteps, myForwardTest{}, tracker, myForwardTest{}, fetchWatermark, publishWatermark, WithReadBatchSize(20), WithUDFConcurrency(10), WithVertexType(dfv1.VertexTypeMapUDF))
+	assert.NoError(t, err)
+	assert.True(t, f.opts.keyOrdered)
+
+	stopped := f.Start()
+	_, errs := fromStep.Write(ctx, writeMessages)
+	assert.Equal(t, make([]error, len(writeMessages)), errs)
+	readMessages, err := to1.Read(ctx, int64(len(writeMessages)))
+	assert.NoError(t, err)
+	assert.Len(t, readMessages, len(writeMessages))
+
+	tracker.lock.Lock()
+	assert.False(t, tracker.concurrent)
+	for i, key := range []string{"key-0", "key-1"} {
+		var expected []string
+		for j := i; j < len(writeMessages); j += 2 {
+			expected = append(expected, writeMessages[j].ID)
+		}
+		assert.Equal(t, expected, tracker.applied[key])
+	}
+	tracker.lock.Unlock()
+
+	f.Stop()
+	<-stopped
+}
+
+// suffixInterceptor appends "-udf" to the payloads of the results of the UDF, and "-write" to the ones to be written.
+type suffixInterceptor struct {
+	interceptor.Noop
+	lock       sync.Mutex
+	partitions map[string]bool
+}
+
+func (s *suffixInterceptor) PreRead(_ context.Context, partition string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.partitions[partition] = true
+	return nil
+}
+
+func (s *suffixInterceptor) PostUDF(_ context.Context, _ *isb.ReadMessage, results []*isb.WriteMessage) ([]*isb.WriteMessage, error) {
+	for _, r := range results {
+		r.Payload = append(r.Payload, "-udf"...)
+	}
+	return results, nil
+}
+
+func (s *suffixInterceptor) PreWrite(_ context.Context, partition string, messages []isb.Message) ([]isb.Message, error) {
+	for i := range messages {
+		messages[i].Payload = append(messages[i].Payload, ("-write:" + partition)...)
+	}
+	return messages, nil
+}
+
+func TestInterStepDataForward_Interceptors(t *testing.T) {
+	suffix := &suffixInterceptor{partitions: map[string]bool{}}
+	interceptor.Register("test-suffix", func(map[string]string) (interceptor.Interceptor, error) {
+		return suffix, nil
+	})
+	fromStep := simplebuffer.NewInMemoryBuffer("from", 25, 0)
+	to1 := simplebuffer.NewInMemoryBuffer("to1", 25, 0, simplebuffer.WithReadTimeOut(time.Second*10))
+	toSteps := map[string][]isb.BufferWriter{
+		"to1": {to1},
+	}
+	vertex := &dfv1.Vertex{Spec: dfv1.VertexSpec{
+		PipelineName: "testPipeline",
+		AbstractVertex: dfv1.AbstractVertex{
+			Name:         "receivingVertex",
+			Interceptors: []dfv1.Interceptor{{Name: "test-suffix"}},
+		},
+	}}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	writeMessages := testutils.BuildTestWriteMessages(int64(10), testStartTime)
+	fetchWatermark := &testForwardFetcher{}
+	_, publishWatermark := generic.BuildNoOpWatermarkProgressorsFromBufferMap(toSteps)
+
+	f, err := NewInterStepDataForward(vertex, fromStep, toSteps, myForwardTest{}, myForwardTest{}, myForwardTest{}, fetchWatermark, publishWatermark, WithReadBatchSize(10), WithVertexType(dfv1.VertexTypeMapUDF))
+	assert.NoError(t, err)
+	assert.Len(t, f.interceptors, 1)
+
+	stopped := f.Start()
+	_, errs := fromStep.Write(ctx, writeMessages)
+	assert.Equal(t, make([]error, len(writeMessages)), errs)
+	readMessages, err := to1.Read(ctx, int64(len(writeMessages)))
+	assert.NoError(t, err)
+	assert.Len(t, readMessages, len(writeMessages))
+	for i, m := range readMessages {
+		assert.Equal(t, string(writeMessages[i].Payload)+"-udf-write:to1", string(m.Payload))
+	}
+	suffix.lock.Lock()
+	assert.True(t, suffix.partitions["from"])
+	suffix.lock.Unlock()
+
+	f.Stop()
+	<-stopped
+
+	vertex.Spec.Interceptors = []dfv1.Interceptor{{Name: "not-registered"}}
+	_, err = NewInterStepDataForward(vertex, fromStep, toSteps, myForwardTest{}, myForwardTest{}, myForwardTest{}, fetchWatermark, publishWatermark)
+	assert.Error(t, err)
+}
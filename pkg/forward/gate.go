@@ -0,0 +1,101 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package forward
+
+import (
+	"time"
+
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/watermark/wmb"
+)
+
+// gateFullBackoff is how long to wait before retrying to emit the held messages when the gate is full.
+const gateFullBackoff = 100 * time.Millisecond
+
+// heldMessage is a message held by the emission gate, along with the messages to write for it.
+type heldMessage struct {
+	readMessage   *isb.ReadMessage
+	writeMessages []*isb.WriteMessage
+}
+
+// emissionGate holds the messages of a sink until the watermark passes their event times, so that the results of a
+// window are never emitted before the window is final. The held messages are not acked, so they stay in the
+// Inter-Step Buffer and are read again if the pod restarts.
+type emissionGate struct {
+	maxHeld int
+	held    []heldMessage
+}
+
+func newEmissionGate(maxHeld int) *emissionGate {
+	return &emissionGate{maxHeld: maxHeld}
+}
+
+// isFull returns whether the gate can't hold more messages.
+func (g *emissionGate) isFull() bool {
+	return len(g.held) >= g.maxHeld
+}
+
+// isReady returns whether a message can be emitted with the given watermark.
+func isReady(m *isb.ReadMessage, wm wmb.Watermark) bool {
+	return !m.EventTime.After(time.Time(wm))
+}
+
+// ready returns the held messages which can be emitted with the given watermark, in the order they were read.
+// They are still held until update is called.
+func (g *emissionGate) ready(wm wmb.Watermark) []heldMessage {
+	var result []heldMessage
+	for _, h := range g.held {
+		if isReady(h.readMessage, wm) {
+			result = append(result, h)
+		}
+	}
+	return result
+}
+
+// update releases the held messages which can be emitted with the given watermark, and holds the new ones. It's called
+// once the ready messages are written.
+func (g *emissionGate) update(wm wmb.Watermark, newHeld []heldMessage) {
+	remaining := g.held[:0]
+	for _, h := range g.held {
+		if !isReady(h.readMessage, wm) {
+			remaining = append(remaining, h)
+		}
+	}
+	for i := len(remaining); i < len(g.held); i++ {
+		g.held[i] = heldMessage{}
+	}
+	g.held = append(remaining, newHeld...)
+}
+
+// gatedAckOffsets returns the offsets to ack after the messages are written, which are the read ones except the held
+// ones, plus the ones of the released messages.
+func gatedAckOffsets(readOffsets []isb.Offset, released []heldMessage, newHeld []heldMessage) []isb.Offset {
+	heldOffsets := make(map[string]struct{}, len(newHeld))
+	for _, h := range newHeld {
+		heldOffsets[h.readMessage.ReadOffset.String()] = struct{}{}
+	}
+	result := make([]isb.Offset, 0, len(readOffsets)+len(released))
+	for _, h := range released {
+		result = append(result, h.readMessage.ReadOffset)
+	}
+	for _, o := range readOffsets {
+		if _, ok := heldOffsets[o.String()]; !ok {
+			result = append(result, o)
+		}
+	}
+	return result
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package forward
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/isb/stores/simplebuffer"
+	"github.com/numaproj/numaflow/pkg/isb/testutils"
+	"github.com/numaproj/numaflow/pkg/watermark/generic"
+	"github.com/numaproj/numaflow/pkg/watermark/wmb"
+)
+
+// testGateFetcher returns the same watermark for the read messages and the head of the partition.
+type testGateFetcher struct {
+	wm atomic.Int64
+}
+
+func (t *testGateFetcher) ComputeWatermark(isb.Offset, int32) wmb.Watermark {
+	return wmb.Watermark(time.UnixMilli(t.wm.Load()))
+}
+
+func (t *testGateFetcher) ComputeHeadIdleWMB(int32) wmb.WMB {
+	return wmb.WMB{}
+}
+
+func (t *testGateFetcher) ComputeHeadWatermark(int32) wmb.Watermark {
+	return wmb.Watermark(time.UnixMilli(t.wm.Load()))
+}
+
+func testHeldMessage(offset int64, eventTime time.Time) heldMessage {
+	return heldMessage{readMessage: &isb.ReadMessage{
+		Message:    isb.Message{Header: isb.Header{MessageInfo: isb.MessageInfo{EventTime: eventTime}}},
+		ReadOffset: isb.NewSimpleIntPartitionOffset(offset, 0),
+	}}
+}
+
+func TestEmissionGate(t *testing.T) {
+	g := newEmissionGate(3)
+	g.update(wmb.InitialWatermark, []heldMessage{
+		testHeldMessage(0, testStartTime.Add(2*time.Minute)),
+		testHeldMessage(1, testStartTime),
+	})
+	assert.False(t, g.isFull())
+	assert.Empty(t, g.ready(wmb.Watermark(testStartTime.Add(-time.Millisecond))))
+
+	wm := wmb.Watermark(testStartTime)
+	released := g.ready(wm)
+	assert.Len(t, released, 1)
+	assert.Equal(t, "1-0", released[0].readMessage.ReadOffset.String())
+	newHeld := []heldMessage{testHeldMessage(2, testStartTime.Add(time.Minute)), testHeldMessage(3, testStartTime.Add(time.Minute))}
+	readOffsets := []isb.Offset{isb.NewSimpleIntPartitionOffset(2, 0), isb.NewSimpleIntPartitionOffset(3, 0), isb.NewSimpleIntPartitionOffset(4, 0)}
+	assert.Equal(t, []isb.Offset{isb.NewSimpleIntPartitionOffset(1, 0), isb.NewSimpleIntPartitionOffset(4, 0)}, gatedAckOffsets(readOffsets, released, newHeld))
+
+	g.update(wm, newHeld)
+	assert.True(t, g.isFull())
+	released = g.ready(wmb.Watermark(testStartTime.Add(time.Minute)))
+	assert.Len(t, released, 2)
+	assert.Equal(t, "2-0", released[0].readMessage.ReadOffset.String())
+	assert.Equal(t, "3-0", released[1].readMessage.ReadOffset.String())
+	g.update(wmb.Watermark(testStartTime.Add(time.Minute)), nil)
+	assert.Len(t, g.held, 1)
+	assert.Equal(t, "0-0", g.held[0].readMessage.ReadOffset.String())
+}
+
+func TestInterStepDataForward_WatermarkGate(t *testing.T) {
+	fromStep := simplebuffer.NewInMemoryBuffer("from", 25, 0, simplebuffer.WithReadTimeOut(100*time.Millisecond))
+	to1 := simplebuffer.NewInMemoryBuffer("to1", 10, 0, simplebuffer.WithReadTimeOut(time.Second))
+	toSteps := map[string][]isb.BufferWriter{
+		"to1": {to1},
+	}
+	vertex := &dfv1.Vertex{Spec: dfv1.VertexSpec{
+		PipelineName: "testPipeline",
+		AbstractVertex: dfv1.AbstractVertex{
+			Name: "receivingVertex",
+			Sink: &dfv1.Sink{Log: &dfv1.Log{}, WatermarkGate: &dfv1.WatermarkGate{}},
+		},
+	}}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	writeMessages := testutils.BuildTestWriteMessages(int64(5), testStartTime)
+	fetchWatermark := &testGateFetcher{}
+	fetchWatermark.wm.Store(testStartTime.Add(time.Minute).UnixMilli())
+	_, publishWatermark := generic.BuildNoOpWatermarkProgressorsFromBufferMap(toSteps)
+
+	f, err := NewInterStepDataForward(vertex, fromStep, toSteps, myForwardTest{}, myForwardTest{}, myForwardTest{}, fetchWatermark, publishWatermark, WithReadBatchSize(5), WithVertexType(dfv1.VertexTypeSink))
+	assert.NoError(t, err)
+	assert.NotNil(t, f.gate)
+
+	stopped := f.Start()
+	_, errs := fromStep.Write(ctx, writeMessages)
+	assert.Equal(t, make([]error, 5), errs)
+
+	// only the messages with the event times not after the watermark are emitted
+	readMessages, err := to1.Read(ctx, 5)
+	assert.NoError(t, err)
+	assert.Len(t, readMessages, 2)
+	assert.Equal(t, writeMessages[0].EventTime, readMessages[0].EventTime)
+	assert.Equal(t, writeMessages[1].EventTime, readMessages[1].EventTime)
+	readMessages, err = to1.Read(ctx, 5)
+	assert.NoError(t, err)
+	assert.Len(t, readMessages, 0)
+
+	// the held messages are emitted when the watermark progresses, without new messages read
+	fetchWatermark.wm.Store(testStartTime.Add(3 * time.Minute).UnixMilli())
+	readMessages, err = to1.Read(ctx, 5)
+	assert.NoError(t, err)
+	assert.Len(t, readMessages, 2)
+	assert.Equal(t, writeMessages[2].EventTime, readMessages[0].EventTime)
+	assert.Equal(t, writeMessages[3].EventTime, readMessages[1].EventTime)
+
+	f.Stop()
+	time.Sleep(1 * time.Millisecond)
+	// the last message is still held, so the from buffer is not empty
+	f.ForceStop()
+	<-stopped
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package interceptor
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	interceptorpb "github.com/numaproj/numaflow/pkg/apis/proto/interceptor"
+	"github.com/numaproj/numaflow/pkg/isb"
+)
+
+// grpcInterceptor calls the Interceptor service defined in interceptor.proto for the configured hooks.
+type grpcInterceptor struct {
+	vertexName string
+	spec       dfv1.GRPCInterceptor
+	conn       *grpc.ClientConn
+	client     interceptorpb.InterceptorClient
+}
+
+func newGRPCInterceptor(vertexName string, spec dfv1.GRPCInterceptor) (*grpcInterceptor, error) {
+	conn, err := grpc.Dial(spec.Address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to the interceptor service, %w", err)
+	}
+	return &grpcInterceptor{vertexName: vertexName, spec: spec, conn: conn, client: interceptorpb.NewInterceptorClient(conn)}, nil
+}
+
+func (g *grpcInterceptor) PreRead(ctx context.Context, partition string) error {
+	if !g.spec.HasHook(dfv1.InterceptorHookPreRead) {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, g.spec.GetTimeout())
+	defer cancel()
+	_, err := g.client.PreRead(ctx, &interceptorpb.PreReadRequest{Vertex: g.vertexName, Partition: partition})
+	return err
+}
+
+func (g *grpcInterceptor) PostUDF(ctx context.Context, readMessage *isb.ReadMessage, results []*isb.WriteMessage) ([]*isb.WriteMessage, error) {
+	if !g.spec.HasHook(dfv1.InterceptorHookPostUDF) || len(results) == 0 {
+		return results, nil
+	}
+	req := &interceptorpb.PostUDFRequest{Vertex: g.vertexName, ReadMessage: toProto(readMessage.Message, nil)}
+	byID := make(map[string]*isb.WriteMessage, len(results))
+	for _, r := range results {
+		req.Results = append(req.Results, toProto(r.Message, r.Tags))
+		byID[r.ID] = r
+	}
+	ctx, cancel := context.WithTimeout(ctx, g.spec.GetTimeout())
+	defer cancel()
+	resp, err := g.client.PostUDF(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	intercepted := make([]*isb.WriteMessage, 0, len(resp.GetResults()))
+	for _, r := range resp.GetResults() {
+		original, ok := byID[r.GetId()]
+		if !ok {
+			return nil, fmt.Errorf("unknown result id %q returned by the interceptor service", r.GetId())
+		}
+		m := &isb.WriteMessage{Message: fromProto(original.Message, r), Tags: r.GetTags()}
+		intercepted = append(intercepted, m)
+	}
+	return intercepted, nil
+}
+
+func (g *grpcInterceptor) PreWrite(ctx context.Context, partition string, messages []isb.Message) ([]isb.Message, error) {
+	if !g.spec.HasHook(dfv1.InterceptorHookPreWrite) || len(messages) == 0 {
+		return messages, nil
+	}
+	req := &interceptorpb.PreWriteRequest{Vertex: g.vertexName, Partition: partition}
+	byID := make(map[string]isb.Message, len(messages))
+	for _, m := range messages {
+		req.Messages = append(req.Messages, toProto(m, nil))
+		byID[m.ID] = m
+	}
+	ctx, cancel := context.WithTimeout(ctx, g.spec.GetTimeout())
+	defer cancel()
+	resp, err := g.client.PreWrite(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	intercepted := make([]isb.Message, 0, len(resp.GetMessages()))
+	for _, r := range resp.GetMessages() {
+		original, ok := byID[r.GetId()]
+		if !ok {
+			return nil, fmt.Errorf("unknown message id %q returned by the interceptor service", r.GetId())
+		}
+		intercepted = append(intercepted, fromProto(original, r))
+	}
+	return intercepted, nil
+}
+
+func (g *grpcInterceptor) Close() error {
+	return g.conn.Close()
+}
+
+func toProto(m isb.Message, tags []string) *interceptorpb.Message {
+	return &interceptorpb.Message{
+		Id:        m.ID,
+		Keys:      m.Keys,
+		Value:     m.Payload,
+		EventTime: m.EventTime.UnixMilli(),
+		Tags:      tags,
+	}
+}
+
+// fromProto returns a copy of the original message updated with the intercepted one, the event time is kept if the
+// intercepted one is 0.
+func fromProto(original isb.Message, intercepted *interceptorpb.Message) isb.Message {
+	m := original
+	m.Keys = intercepted.GetKeys()
+	m.Payload = intercepted.GetValue()
+	if t := intercepted.GetEventTime(); t != 0 {
+		m.EventTime = time.UnixMilli(t)
+	}
+	return m
+}
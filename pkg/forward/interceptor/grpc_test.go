@@ -0,0 +1,132 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package interceptor
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	interceptorpb "github.com/numaproj/numaflow/pkg/apis/proto/interceptor"
+	"github.com/numaproj/numaflow/pkg/isb"
+)
+
+// upperServer denies reading from the partition "denied", tags the results with "audited", drops the results with
+// the key "drop", and upper cases the payloads before writing.
+type upperServer struct {
+	interceptorpb.UnimplementedInterceptorServer
+	preReads int
+}
+
+func (s *upperServer) PreRead(_ context.Context, req *interceptorpb.PreReadRequest) (*interceptorpb.PreReadResponse, error) {
+	s.preReads++
+	if req.GetPartition() == "denied" {
+		return nil, fmt.Errorf("reading %s is denied", req.GetPartition())
+	}
+	return &interceptorpb.PreReadResponse{}, nil
+}
+
+func (s *upperServer) PostUDF(_ context.Context, req *interceptorpb.PostUDFRequest) (*interceptorpb.PostUDFResponse, error) {
+	resp := &interceptorpb.PostUDFResponse{}
+	for _, r := range req.GetResults() {
+		if len(r.GetKeys()) > 0 && r.GetKeys()[0] == "drop" {
+			continue
+		}
+		r.Tags = append(r.Tags, "audited")
+		resp.Results = append(resp.Results, r)
+	}
+	return resp, nil
+}
+
+func (s *upperServer) PreWrite(_ context.Context, req *interceptorpb.PreWriteRequest) (*interceptorpb.PreWriteResponse, error) {
+	for _, m := range req.GetMessages() {
+		m.Value = bytes.ToUpper(m.GetValue())
+		if m.GetId() == "unknown" {
+			m.Id = "forged"
+		}
+	}
+	return &interceptorpb.PreWriteResponse{Messages: req.GetMessages()}, nil
+}
+
+func startServer(t *testing.T, srv interceptorpb.InterceptorServer) string {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	server := grpc.NewServer()
+	interceptorpb.RegisterInterceptorServer(server, srv)
+	go func() { _ = server.Serve(lis) }()
+	t.Cleanup(server.Stop)
+	return lis.Addr().String()
+}
+
+func TestGRPCInterceptor(t *testing.T) {
+	ctx := context.Background()
+	srv := &upperServer{}
+	g, err := newGRPCInterceptor("v", dfv1.GRPCInterceptor{Address: startServer(t, srv)})
+	assert.NoError(t, err)
+	defer func() { _ = g.Close() }()
+
+	assert.NoError(t, g.PreRead(ctx, "p-v-0"))
+	assert.Error(t, g.PreRead(ctx, "denied"))
+
+	eventTime := time.UnixMilli(1700000000000)
+	readMessage := &isb.ReadMessage{Message: isb.Message{Header: isb.Header{ID: "0-0"}}}
+	results, err := g.PostUDF(ctx, readMessage, []*isb.WriteMessage{
+		{Message: isb.Message{Header: isb.Header{MessageInfo: isb.MessageInfo{EventTime: eventTime, IsLate: true}, ID: "0-v-0", Keys: []string{"k"}}, Body: isb.Body{Payload: []byte("hello")}}, Tags: []string{"t"}},
+		{Message: isb.Message{Header: isb.Header{ID: "0-v-1", Keys: []string{"drop"}}}},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, results, 1)
+	assert.Equal(t, "0-v-0", results[0].ID)
+	assert.Equal(t, []string{"t", "audited"}, results[0].Tags)
+	assert.Equal(t, []string{"k"}, results[0].Keys)
+	assert.Equal(t, "hello", string(results[0].Payload))
+	// the fields not carried by the interceptor service are kept
+	assert.True(t, results[0].EventTime.Equal(eventTime))
+	assert.True(t, results[0].IsLate)
+
+	messages, err := g.PreWrite(ctx, "p-out-0", []isb.Message{{Header: isb.Header{ID: "0-v-0"}, Body: isb.Body{Payload: []byte("hello")}}})
+	assert.NoError(t, err)
+	assert.Equal(t, "HELLO", string(messages[0].Payload))
+	_, err = g.PreWrite(ctx, "p-out-0", []isb.Message{{Header: isb.Header{ID: "unknown"}}})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), `unknown message id "forged"`)
+}
+
+func TestGRPCInterceptor_Hooks(t *testing.T) {
+	ctx := context.Background()
+	srv := &upperServer{}
+	g, err := newGRPCInterceptor("v", dfv1.GRPCInterceptor{Address: startServer(t, srv), Hooks: []dfv1.InterceptorHook{dfv1.InterceptorHookPreWrite}})
+	assert.NoError(t, err)
+	defer func() { _ = g.Close() }()
+
+	// the hooks not configured are not called
+	assert.NoError(t, g.PreRead(ctx, "denied"))
+	assert.Equal(t, 0, srv.preReads)
+	results, err := g.PostUDF(ctx, &isb.ReadMessage{}, []*isb.WriteMessage{{Message: isb.Message{Header: isb.Header{ID: "0-v-0", Keys: []string{"drop"}}}}})
+	assert.NoError(t, err)
+	assert.Len(t, results, 1)
+	messages, err := g.PreWrite(ctx, "p-out-0", []isb.Message{{Header: isb.Header{ID: "0-v-0"}, Body: isb.Body{Payload: []byte("hello")}}})
+	assert.NoError(t, err)
+	assert.Equal(t, "HELLO", string(messages[0].Payload))
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package interceptor defines the interceptors called by the forwarder of a map or sink vertex before reading, after
+// the UDF and before writing the messages, so that the concerns like auditing, tagging or encryption can be added
+// without forking the forwarder. An interceptor is either compiled into the numaflow image with a build tag, see
+// Register, or called over gRPC, e.g. served by a sidecar of the vertex.
+package interceptor
+
+import (
+	"context"
+	"errors"
+
+	"github.com/numaproj/numaflow/pkg/isb"
+)
+
+// Interceptor intercepts the messages forwarded by the forwarder. The implementations can embed Noop to only implement
+// the hooks they need.
+type Interceptor interface {
+	// PreRead is called before a batch of messages is read from the partition, the batch is not read if it returns an
+	// error.
+	PreRead(ctx context.Context, partition string) error
+	// PostUDF is called with the results returned by the UDF for a read message, before they are routed to the edges.
+	// The returned results replace the given ones. An error fails the batch, which is read again.
+	PostUDF(ctx context.Context, readMessage *isb.ReadMessage, results []*isb.WriteMessage) ([]*isb.WriteMessage, error)
+	// PreWrite is called with the messages about to be written to a partition of the to buffers. The returned messages
+	// replace the given ones. An error fails the batch, which is read again.
+	PreWrite(ctx context.Context, partition string, messages []isb.Message) ([]isb.Message, error)
+	// Close releases the resources of the interceptor.
+	Close() error
+}
+
+// Noop is an interceptor doing nothing.
+type Noop struct{}
+
+func (Noop) PreRead(context.Context, string) error {
+	return nil
+}
+
+func (Noop) PostUDF(_ context.Context, _ *isb.ReadMessage, results []*isb.WriteMessage) ([]*isb.WriteMessage, error) {
+	return results, nil
+}
+
+func (Noop) PreWrite(_ context.Context, _ string, messages []isb.Message) ([]isb.Message, error) {
+	return messages, nil
+}
+
+func (Noop) Close() error {
+	return nil
+}
+
+// Chain calls the interceptors in order, the output of one interceptor is the input of the next one. An empty chain
+// does nothing.
+type Chain []Interceptor
+
+var _ Interceptor = Chain(nil)
+
+func (c Chain) PreRead(ctx context.Context, partition string) error {
+	for _, i := range c {
+		if err := i.PreRead(ctx, partition); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c Chain) PostUDF(ctx context.Context, readMessage *isb.ReadMessage, results []*isb.WriteMessage) ([]*isb.WriteMessage, error) {
+	var err error
+	for _, i := range c {
+		if results, err = i.PostUDF(ctx, readMessage, results); err != nil {
+			return nil, err
+		}
+	}
+	return results, nil
+}
+
+func (c Chain) PreWrite(ctx context.Context, partition string, messages []isb.Message) ([]isb.Message, error) {
+	var err error
+	for _, i := range c {
+		if messages, err = i.PreWrite(ctx, partition, messages); err != nil {
+			return nil, err
+		}
+	}
+	return messages, nil
+}
+
+// Close closes all the interceptors of the chain.
+func (c Chain) Close() error {
+	var errs []error
+	for _, i := range c {
+		if err := i.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package interceptor
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+)
+
+// appender appends its suffix to the payloads, and records the calls in the shared list.
+type appender struct {
+	Noop
+	suffix string
+	calls  *[]string
+	err    error
+}
+
+func (a *appender) PreRead(_ context.Context, partition string) error {
+	*a.calls = append(*a.calls, a.suffix+":"+partition)
+	return a.err
+}
+
+func (a *appender) PostUDF(_ context.Context, _ *isb.ReadMessage, results []*isb.WriteMessage) ([]*isb.WriteMessage, error) {
+	if a.err != nil {
+		return nil, a.err
+	}
+	for _, r := range results {
+		r.Payload = append(r.Payload, a.suffix...)
+	}
+	return results, nil
+}
+
+func (a *appender) PreWrite(_ context.Context, _ string, messages []isb.Message) ([]isb.Message, error) {
+	if a.err != nil {
+		return nil, a.err
+	}
+	for i := range messages {
+		messages[i].Payload = append(messages[i].Payload, a.suffix...)
+	}
+	return messages, nil
+}
+
+func (a *appender) Close() error {
+	*a.calls = append(*a.calls, "close:"+a.suffix)
+	return a.err
+}
+
+func TestChain(t *testing.T) {
+	ctx := context.Background()
+	var calls []string
+	chain := Chain{&appender{suffix: "a", calls: &calls}, &appender{suffix: "b", calls: &calls}}
+
+	assert.NoError(t, chain.PreRead(ctx, "p-0"))
+	assert.Equal(t, []string{"a:p-0", "b:p-0"}, calls)
+
+	results, err := chain.PostUDF(ctx, &isb.ReadMessage{}, []*isb.WriteMessage{{Message: isb.Message{Body: isb.Body{Payload: []byte("x")}}}})
+	assert.NoError(t, err)
+	assert.Equal(t, "xab", string(results[0].Payload))
+
+	messages, err := chain.PreWrite(ctx, "p-0", []isb.Message{{Body: isb.Body{Payload: []byte("y")}}})
+	assert.NoError(t, err)
+	assert.Equal(t, "yab", string(messages[0].Payload))
+
+	assert.NoError(t, chain.Close())
+	assert.Equal(t, []string{"close:a", "close:b"}, calls[2:])
+
+	// an empty chain passes the messages through
+	messages, err = Chain(nil).PreWrite(ctx, "p-0", []isb.Message{{Body: isb.Body{Payload: []byte("z")}}})
+	assert.NoError(t, err)
+	assert.Equal(t, "z", string(messages[0].Payload))
+	assert.NoError(t, Chain(nil).PreRead(ctx, "p-0"))
+	assert.NoError(t, Chain(nil).Close())
+}
+
+func TestChain_Error(t *testing.T) {
+	ctx := context.Background()
+	var calls []string
+	chain := Chain{&appender{suffix: "a", calls: &calls, err: fmt.Errorf("denied")}, &appender{suffix: "b", calls: &calls}}
+
+	assert.EqualError(t, chain.PreRead(ctx, "p-0"), "denied")
+	// the interceptors after the failed one are not called
+	assert.Equal(t, []string{"a:p-0"}, calls)
+	_, err := chain.PostUDF(ctx, &isb.ReadMessage{}, []*isb.WriteMessage{{}})
+	assert.EqualError(t, err, "denied")
+	_, err = chain.PreWrite(ctx, "p-0", []isb.Message{{}})
+	assert.EqualError(t, err, "denied")
+	// all the interceptors are closed regardless of the errors
+	assert.EqualError(t, chain.Close(), "denied")
+	assert.Equal(t, []string{"close:a", "close:b"}, calls[1:])
+}
+
+func TestNew(t *testing.T) {
+	var calls []string
+	Register("test-appender", func(kwargs map[string]string) (Interceptor, error) {
+		if kwargs["suffix"] == "" {
+			return nil, fmt.Errorf("suffix is required")
+		}
+		return &appender{suffix: kwargs["suffix"], calls: &calls}, nil
+	})
+	assert.Panics(t, func() { Register("test-appender", func(map[string]string) (Interceptor, error) { return Noop{}, nil }) })
+
+	vertex := &dfv1.Vertex{Spec: dfv1.VertexSpec{AbstractVertex: dfv1.AbstractVertex{Name: "v"}}}
+	chain, err := New(vertex)
+	assert.NoError(t, err)
+	assert.Empty(t, chain)
+
+	vertex.Spec.Interceptors = []dfv1.Interceptor{
+		{Name: "test-appender", KWArgs: map[string]string{"suffix": "a"}},
+		{Name: "remote", GRPC: &dfv1.GRPCInterceptor{Address: "localhost:9090"}},
+	}
+	chain, err = New(vertex)
+	assert.NoError(t, err)
+	assert.Len(t, chain, 2)
+	assert.IsType(t, &grpcInterceptor{}, chain[1])
+	assert.NoError(t, chain.Close())
+
+	vertex.Spec.Interceptors = []dfv1.Interceptor{{Name: "test-appender"}}
+	_, err = New(vertex)
+	assert.EqualError(t, err, `failed to create interceptor "test-appender", suffix is required`)
+
+	vertex.Spec.Interceptors = []dfv1.Interceptor{{Name: "test-appender", KWArgs: map[string]string{"suffix": "a"}}, {Name: "unknown"}}
+	_, err = New(vertex)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "not compiled into the image")
+	// the created interceptors are closed
+	assert.Equal(t, "close:a", calls[len(calls)-1])
+}
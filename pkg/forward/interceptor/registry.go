@@ -0,0 +1,82 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package interceptor
+
+import (
+	"fmt"
+	"sync"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+// Factory creates a compiled-in interceptor with the kwargs in the vertex spec.
+type Factory func(kwargs map[string]string) (Interceptor, error)
+
+var (
+	factoriesLock sync.RWMutex
+	factories     = make(map[string]Factory)
+)
+
+// Register makes a compiled-in interceptor available by the name. It's called in the init function of the package of
+// the interceptor, which is imported by a file of the main package with the build tag of the interceptor, so that the
+// interceptor is only compiled into the image built with the tag, e.g.
+//
+//	//go:build interceptor_audit
+//
+//	package main
+//
+//	import _ "example.com/platform/audit"
+//
+// If Register is called twice with the same name, it panics.
+func Register(name string, factory Factory) {
+	factoriesLock.Lock()
+	defer factoriesLock.Unlock()
+	if factory == nil {
+		panic("interceptor: Register factory is nil")
+	}
+	if _, dup := factories[name]; dup {
+		panic("interceptor: Register called twice for " + name)
+	}
+	factories[name] = factory
+}
+
+// New returns the chain of the interceptors of the vertex, which is empty if the vertex has no interceptors.
+func New(vertex *dfv1.Vertex) (Chain, error) {
+	var chain Chain
+	for _, spec := range vertex.Spec.Interceptors {
+		i, err := newInterceptor(vertex.Spec.Name, spec)
+		if err != nil {
+			_ = chain.Close()
+			return nil, fmt.Errorf("failed to create interceptor %q, %w", spec.Name, err)
+		}
+		chain = append(chain, i)
+	}
+	return chain, nil
+}
+
+func newInterceptor(vertexName string, spec dfv1.Interceptor) (Interceptor, error) {
+	if spec.GRPC != nil {
+		return newGRPCInterceptor(vertexName, *spec.GRPC)
+	}
+	factoriesLock.RLock()
+	factory, ok := factories[spec.Name]
+	factoriesLock.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("not compiled into the image, which needs to be built with the build tag of the interceptor")
+	}
+	return factory(spec.KWArgs)
+}
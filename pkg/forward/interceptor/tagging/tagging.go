@@ -0,0 +1,75 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package tagging is a compiled-in interceptor adding tags to the results of the UDF, so that they can be routed by the
+// conditional forwarding regardless of the UDF. It's compiled into the numaflow image built with the
+// "interceptor_tagging" build tag, and serves as an example of the compiled-in interceptors.
+package tagging
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/numaproj/numaflow/pkg/forward/interceptor"
+	"github.com/numaproj/numaflow/pkg/isb"
+)
+
+// Name is the name the interceptor is registered with.
+const Name = "tagging"
+
+func init() {
+	interceptor.Register(Name, New)
+}
+
+type tagging struct {
+	interceptor.Noop
+	tags []string
+}
+
+// New returns a tagging interceptor, the kwarg "tags" is the comma separated tags to add.
+func New(kwargs map[string]string) (interceptor.Interceptor, error) {
+	var tags []string
+	for _, t := range strings.Split(kwargs["tags"], ",") {
+		if t = strings.TrimSpace(t); t != "" {
+			tags = append(tags, t)
+		}
+	}
+	if len(tags) == 0 {
+		return nil, fmt.Errorf("kwarg \"tags\" is required")
+	}
+	return &tagging{tags: tags}, nil
+}
+
+func (t *tagging) PostUDF(_ context.Context, _ *isb.ReadMessage, results []*isb.WriteMessage) ([]*isb.WriteMessage, error) {
+	for _, r := range results {
+		for _, tag := range t.tags {
+			if !contains(r.Tags, tag) {
+				r.Tags = append(r.Tags, tag)
+			}
+		}
+	}
+	return results, nil
+}
+
+func contains(tags []string, tag string) bool {
+	for _, t := range tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
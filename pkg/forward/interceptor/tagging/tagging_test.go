@@ -0,0 +1,50 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tagging
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/forward/interceptor"
+	"github.com/numaproj/numaflow/pkg/isb"
+)
+
+func TestTagging(t *testing.T) {
+	_, err := New(map[string]string{"tags": " , "})
+	assert.Error(t, err)
+
+	i, err := New(map[string]string{"tags": "audited, eu"})
+	assert.NoError(t, err)
+	results, err := i.PostUDF(context.Background(), &isb.ReadMessage{}, []*isb.WriteMessage{{Tags: []string{"eu"}}, {}})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"eu", "audited"}, results[0].Tags)
+	assert.Equal(t, []string{"audited", "eu"}, results[1].Tags)
+}
+
+func TestTagging_Registered(t *testing.T) {
+	vertex := &dfv1.Vertex{Spec: dfv1.VertexSpec{AbstractVertex: dfv1.AbstractVertex{
+		Name:         "v",
+		Interceptors: []dfv1.Interceptor{{Name: Name, KWArgs: map[string]string{"tags": "audited"}}},
+	}}}
+	chain, err := interceptor.New(vertex)
+	assert.NoError(t, err)
+	assert.Len(t, chain, 1)
+}
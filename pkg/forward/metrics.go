@@ -144,3 +144,38 @@ var udfWriteMessagesCount = promauto.NewCounterVec(prometheus.CounterOpts{
 	Name:      "udf_write_total",
 	Help:      "Total number of Messages Written by UDF",
 }, []string{metrics.LabelVertex, metrics.LabelPipeline, metrics.LabelPartitionName})
+
+// writeInFlight is used to indicate the number of messages being written to a to buffer partition
+var writeInFlight = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Subsystem: "forwarder",
+	Name:      "write_in_flight",
+	Help:      "Number of messages being written to a to buffer partition",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline, metrics.LabelPartitionName})
+
+// writeConsecutiveFailures is used to indicate the number of consecutive failed write attempts to a to buffer partition
+var writeConsecutiveFailures = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Subsystem: "forwarder",
+	Name:      "write_consecutive_failures",
+	Help:      "Number of consecutive failed write attempts to a to buffer partition, reset on a successful write",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline, metrics.LabelPartitionName})
+
+// writeBackoffSeconds is used to indicate the current backoff before retrying the failed writes to a to buffer partition
+var writeBackoffSeconds = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Subsystem: "forwarder",
+	Name:      "write_backoff_seconds",
+	Help:      "Current backoff in seconds before retrying the failed writes to a to buffer partition, 0 if not retrying",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline, metrics.LabelPartitionName})
+
+// heldMessagesCount is used to indicate the number of messages held by a sink until the watermark passes their event times
+var heldMessagesCount = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Subsystem: "forwarder",
+	Name:      "held_messages",
+	Help:      "Number of messages held until the watermark passes their event times",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline, metrics.LabelPartitionName})
+
+// readBatchSize is used to indicate the current batch size of reading a from buffer partition
+var readBatchSize = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Subsystem: "forwarder",
+	Name:      "read_batch_size",
+	Help:      "Current batch size of reading a from buffer partition, it changes with the back pressure if adaptive read batch is enabled",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline, metrics.LabelPartitionName})
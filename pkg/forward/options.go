@@ -39,6 +39,8 @@ type options struct {
 	logger *zap.SugaredLogger
 	// enableMapUdfStream indicates whether the message streaming is enabled or not for map UDF processing
 	enableMapUdfStream bool
+	// keyOrdered indicates whether the messages of a batch with the same keys are processed serially
+	keyOrdered bool
 }
 
 type Option func(*options) error
@@ -77,6 +79,14 @@ func WithUDFConcurrency(f int) Option {
 	}
 }
 
+// WithKeyOrdered sets whether the messages of a batch with the same keys are processed serially by the map UDF
+func WithKeyOrdered(f bool) Option {
+	return func(o *options) error {
+		o.keyOrdered = f
+		return nil
+	}
+}
+
 // WithLogger is used to return logger information
 func WithLogger(l *zap.SugaredLogger) Option {
 	return func(o *options) error {
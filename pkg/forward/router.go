@@ -0,0 +1,217 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package forward
+
+import (
+	"strings"
+	"sync"
+
+	"go.uber.org/zap"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/forward/schema"
+)
+
+// EdgeRouter applies the priorities and the weights of the to edges of a vertex to the buffers returned by a
+// GoWhere. Of the matching edges, only the ones with the highest priority are kept, then if more than one of the
+// kept edges have a weight, only one of these weighted edges is picked, with smooth weighted round-robin.
+// When the pipeline is degraded, the best-effort edges of the picked ones are then disabled or sampled.
+type EdgeRouter struct {
+	priorities map[string]int32
+	weights    map[string]int32
+	// enabled is false when none of the edges has a priority or a weight, so the buffers are returned as is.
+	enabled bool
+	lock    sync.Mutex
+	// currentWeights are the current weights of the smooth weighted round-robin, by the combination of the matching
+	// weighted edges, since the combination could vary with the conditions of the edges.
+	currentWeights map[string]map[string]int64
+	degradation    *DegradationWatcher
+	// samplePercents are the percentages of the messages forwarded to the best-effort edges when degraded.
+	samplePercents map[string]uint32
+	// sampleCounts are the numbers of the messages routed to the best-effort edges when degraded.
+	sampleCounts map[string]uint64
+}
+
+// NewEdgeRouter returns an EdgeRouter for the to edges of a vertex, the best-effort edges are degraded when the
+// degradation watcher tells so, it can be nil if there's no best-effort edge.
+func NewEdgeRouter(edges []dfv1.CombinedEdge, degradation *DegradationWatcher) *EdgeRouter {
+	r := &EdgeRouter{
+		priorities:     make(map[string]int32),
+		weights:        make(map[string]int32),
+		currentWeights: make(map[string]map[string]int64),
+		degradation:    degradation,
+		samplePercents: make(map[string]uint32),
+		sampleCounts:   make(map[string]uint64),
+	}
+	for _, edge := range edges {
+		r.priorities[edge.To] = edge.GetPriority()
+		if edge.Priority != nil {
+			r.enabled = true
+		}
+		if edge.Weight != nil {
+			r.weights[edge.To] = *edge.Weight
+			r.enabled = true
+		}
+		if edge.Degradation != nil {
+			r.samplePercents[edge.To] = edge.Degradation.GetSamplePercent()
+		}
+	}
+	return r
+}
+
+// Route returns the buffers the message should be forwarded to, out of the ones matching the conditions.
+func (r *EdgeRouter) Route(buffers []VertexBuffer) []VertexBuffer {
+	return r.degrade(r.route(buffers))
+}
+
+// route applies the priorities and the weights of the edges.
+func (r *EdgeRouter) route(buffers []VertexBuffer) []VertexBuffer {
+	if !r.enabled || len(buffers) < 2 {
+		return buffers
+	}
+	highest := r.priorities[buffers[0].ToVertexName]
+	for _, b := range buffers[1:] {
+		if p := r.priorities[b.ToVertexName]; p > highest {
+			highest = p
+		}
+	}
+	var result, weighted []VertexBuffer
+	for _, b := range buffers {
+		if r.priorities[b.ToVertexName] != highest {
+			continue
+		}
+		if _, ok := r.weights[b.ToVertexName]; ok {
+			weighted = append(weighted, b)
+		} else {
+			result = append(result, b)
+		}
+	}
+	switch len(weighted) {
+	case 0:
+	case 1:
+		// the only matching weighted edge is not shared with any other edge, unless its weight is 0
+		if r.weights[weighted[0].ToVertexName] > 0 {
+			result = append(result, weighted[0])
+		}
+	default:
+		if picked, ok := r.pick(weighted); ok {
+			result = append(result, picked)
+		}
+	}
+	return result
+}
+
+// degrade removes the buffers of the best-effort edges if the pipeline is degraded, except the sampled ones. The
+// messages are not redirected to the other edges.
+func (r *EdgeRouter) degrade(buffers []VertexBuffer) []VertexBuffer {
+	if len(r.samplePercents) == 0 || !r.degradation.IsDegraded() {
+		return buffers
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	result := make([]VertexBuffer, 0, len(buffers))
+	for _, b := range buffers {
+		percent, ok := r.samplePercents[b.ToVertexName]
+		if !ok {
+			result = append(result, b)
+			continue
+		}
+		// n*percent/100 increases by one every 100/percent messages, so the sampled ones are spread evenly
+		n := r.sampleCounts[b.ToVertexName]
+		r.sampleCounts[b.ToVertexName] = n + 1
+		if n*uint64(percent)%100 < uint64(percent) {
+			result = append(result, b)
+		}
+	}
+	return result
+}
+
+// pick picks one of the weighted buffers with smooth weighted round-robin, so that the picks of an edge are spread
+// evenly instead of coming in bursts. It returns false if all the weights are 0.
+func (r *EdgeRouter) pick(weighted []VertexBuffer) (VertexBuffer, bool) {
+	names := make([]string, len(weighted))
+	for i, b := range weighted {
+		names[i] = b.ToVertexName
+	}
+	key := strings.Join(names, ",")
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	current, ok := r.currentWeights[key]
+	if !ok {
+		current = make(map[string]int64, len(weighted))
+		r.currentWeights[key] = current
+	}
+	var total int64
+	best := -1
+	for i, b := range weighted {
+		w := int64(r.weights[b.ToVertexName])
+		if w <= 0 {
+			continue
+		}
+		total += w
+		current[b.ToVertexName] += w
+		if best < 0 || current[b.ToVertexName] > current[weighted[best].ToVertexName] {
+			best = i
+		}
+	}
+	if best < 0 {
+		return VertexBuffer{}, false
+	}
+	current[weighted[best].ToVertexName] -= total
+	return weighted[best], true
+}
+
+// ApplySchemas validates the payload against the schemas of the edges of the buffers. The buffer of an edge whose
+// schema is not satisfied is replaced by the buffer of its error edge, or removed if there's no error edge. The error
+// edges only receive the invalid messages.
+// The partition of the error edge is picked by the partition of the original edge, modulo the number of the partitions
+// of the error edge returned by partitions.
+func ApplySchemas(ev *schema.EdgeValidator, buffers []VertexBuffer, payload []byte, partitions func(toVertex string) int, logger *zap.SugaredLogger) []VertexBuffer {
+	if ev == nil {
+		return buffers
+	}
+	result := make([]VertexBuffer, 0, len(buffers))
+	add := func(b VertexBuffer) {
+		for _, r := range result {
+			if r == b {
+				return
+			}
+		}
+		result = append(result, b)
+	}
+	for _, b := range buffers {
+		if ev.IsErrorVertex(b.ToVertexName) {
+			continue
+		}
+		valid, errorTo, err := ev.Validate(b.ToVertexName, payload)
+		if valid {
+			add(b)
+			continue
+		}
+		n := 0
+		if errorTo != "" {
+			n = partitions(errorTo)
+		}
+		if n == 0 {
+			logger.Debugw("Dropped a message not satisfying the schema", zap.String("toVertex", b.ToVertexName), zap.Error(err))
+			continue
+		}
+		logger.Debugw("Forwarding a message not satisfying the schema to the error edge", zap.String("toVertex", b.ToVertexName), zap.String("errorTo", errorTo), zap.Error(err))
+		add(VertexBuffer{ToVertexName: errorTo, ToVertexPartitionIdx: b.ToVertexPartitionIdx % int32(n)})
+	}
+	return result
+}
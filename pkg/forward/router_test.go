@@ -0,0 +1,146 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package forward
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/forward/schema"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+func buffersTo(names ...string) []VertexBuffer {
+	var result []VertexBuffer
+	for _, n := range names {
+		result = append(result, VertexBuffer{ToVertexName: n})
+	}
+	return result
+}
+
+func TestEdgeRouter_Route(t *testing.T) {
+	t.Run("no weights or priorities", func(t *testing.T) {
+		r := NewEdgeRouter([]dfv1.CombinedEdge{{Edge: dfv1.Edge{From: "in", To: "a"}}, {Edge: dfv1.Edge{From: "in", To: "b"}}}, nil)
+		assert.Equal(t, buffersTo("a", "b"), r.Route(buffersTo("a", "b")))
+	})
+
+	t.Run("weights", func(t *testing.T) {
+		r := NewEdgeRouter([]dfv1.CombinedEdge{
+			{Edge: dfv1.Edge{From: "in", To: "a", Weight: pointer.Int32(9)}},
+			{Edge: dfv1.Edge{From: "in", To: "b", Weight: pointer.Int32(1)}},
+			{Edge: dfv1.Edge{From: "in", To: "c"}},
+		}, nil)
+		counts := map[string]int{}
+		for i := 0; i < 100; i++ {
+			result := r.Route(buffersTo("a", "b", "c"))
+			assert.Len(t, result, 2)
+			for _, b := range result {
+				counts[b.ToVertexName]++
+			}
+		}
+		assert.Equal(t, map[string]int{"a": 90, "b": 10, "c": 100}, counts)
+		// the only matching weighted edge gets all the messages
+		assert.Equal(t, buffersTo("b"), r.Route(buffersTo("b")))
+	})
+
+	t.Run("zero weights", func(t *testing.T) {
+		r := NewEdgeRouter([]dfv1.CombinedEdge{
+			{Edge: dfv1.Edge{From: "in", To: "a", Weight: pointer.Int32(0)}},
+			{Edge: dfv1.Edge{From: "in", To: "b", Weight: pointer.Int32(0)}},
+			{Edge: dfv1.Edge{From: "in", To: "c"}},
+		}, nil)
+		assert.Equal(t, buffersTo("c"), r.Route(buffersTo("a", "b", "c")))
+		assert.Equal(t, buffersTo("c"), r.Route(buffersTo("a", "c")))
+	})
+
+	t.Run("priorities", func(t *testing.T) {
+		r := NewEdgeRouter([]dfv1.CombinedEdge{
+			{Edge: dfv1.Edge{From: "in", To: "a", Priority: pointer.Int32(2)}},
+			{Edge: dfv1.Edge{From: "in", To: "b", Priority: pointer.Int32(1)}},
+			{Edge: dfv1.Edge{From: "in", To: "c"}},
+		}, nil)
+		assert.Equal(t, buffersTo("a"), r.Route(buffersTo("a", "b", "c")))
+		assert.Equal(t, buffersTo("b"), r.Route(buffersTo("b", "c")))
+		assert.Equal(t, buffersTo("c"), r.Route(buffersTo("c")))
+	})
+
+	t.Run("degradation", func(t *testing.T) {
+		degradation := &DegradationWatcher{}
+		r := NewEdgeRouter([]dfv1.CombinedEdge{
+			{Edge: dfv1.Edge{From: "in", To: "a"}},
+			{Edge: dfv1.Edge{From: "in", To: "b", Degradation: &dfv1.EdgeDegradation{}}},
+			{Edge: dfv1.Edge{From: "in", To: "c", Degradation: &dfv1.EdgeDegradation{Action: dfv1.EdgeDegradationActionSample, SamplePercent: pointer.Uint32(25)}}},
+		}, degradation)
+		assert.Equal(t, buffersTo("a", "b", "c"), r.Route(buffersTo("a", "b", "c")))
+		degradation.degraded.Store(true)
+		counts := map[string]int{}
+		for i := 0; i < 100; i++ {
+			for _, b := range r.Route(buffersTo("a", "b", "c")) {
+				counts[b.ToVertexName]++
+			}
+		}
+		assert.Equal(t, map[string]int{"a": 100, "c": 25}, counts)
+		degradation.degraded.Store(false)
+		assert.Equal(t, buffersTo("b"), r.Route(buffersTo("b")))
+		// a nil watcher never degrades
+		r = NewEdgeRouter([]dfv1.CombinedEdge{{Edge: dfv1.Edge{From: "in", To: "b", Degradation: &dfv1.EdgeDegradation{}}}}, nil)
+		assert.Equal(t, buffersTo("b"), r.Route(buffersTo("b")))
+	})
+}
+
+func TestNewDegradationWatcher(t *testing.T) {
+	vertex := &dfv1.Vertex{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-pl-in", Namespace: "my-ns"},
+		Spec: dfv1.VertexSpec{
+			PipelineName:   "my-pl",
+			AbstractVertex: dfv1.AbstractVertex{Name: "in"},
+			ToEdges:        []dfv1.CombinedEdge{{Edge: dfv1.Edge{From: "in", To: "a"}}},
+		},
+	}
+	assert.Nil(t, NewDegradationWatcher(vertex))
+	vertex.Spec.ToEdges = append(vertex.Spec.ToEdges, dfv1.CombinedEdge{Edge: dfv1.Edge{From: "in", To: "b", Degradation: &dfv1.EdgeDegradation{}}})
+	w := NewDegradationWatcher(vertex)
+	assert.NotNil(t, w)
+	assert.Equal(t, "my-pl", w.pipeline)
+	assert.Equal(t, "my-pl-daemon-svc.my-ns.svc:4327", w.address)
+	assert.False(t, w.IsDegraded())
+}
+
+func TestApplySchemas(t *testing.T) {
+	objectSchema := `{"type": "object"}`
+	ev, err := schema.NewEdgeValidator(&dfv1.Vertex{Spec: dfv1.VertexSpec{
+		AbstractVertex: dfv1.AbstractVertex{Name: "in"},
+		ToEdges: []dfv1.CombinedEdge{
+			{Edge: dfv1.Edge{From: "in", To: "a", Schema: &dfv1.EdgeSchema{Format: dfv1.SchemaFormatJSON, Definition: objectSchema, ErrorTo: "errors"}}},
+			{Edge: dfv1.Edge{From: "in", To: "b", Schema: &dfv1.EdgeSchema{Format: dfv1.SchemaFormatJSON, Definition: objectSchema}}},
+			{Edge: dfv1.Edge{From: "in", To: "c", Schema: &dfv1.EdgeSchema{Format: dfv1.SchemaFormatJSON, Definition: objectSchema, ErrorTo: "errors"}}},
+			{Edge: dfv1.Edge{From: "in", To: "errors"}},
+		},
+	}})
+	assert.NoError(t, err)
+	partitions := func(toVertex string) int { return 2 }
+	log := logging.NewLogger()
+	buffers := []VertexBuffer{{ToVertexName: "a", ToVertexPartitionIdx: 3}, {ToVertexName: "b"}, {ToVertexName: "c", ToVertexPartitionIdx: 1}, {ToVertexName: "errors", ToVertexPartitionIdx: 0}}
+	assert.Equal(t, buffers[:3], ApplySchemas(ev, buffers, []byte(`{}`), partitions, log))
+	assert.Equal(t, []VertexBuffer{{ToVertexName: "errors", ToVertexPartitionIdx: 1}}, ApplySchemas(ev, buffers, []byte(`[]`), partitions, log))
+	assert.Equal(t, buffersTo("errors"), ApplySchemas(ev, buffersTo("a", "c"), []byte(`[]`), partitions, log))
+	assert.Equal(t, buffersTo("a", "b"), ApplySchemas(nil, buffersTo("a", "b"), []byte(`[]`), partitions, log))
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"fmt"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+// EdgeValidator validates the payloads against the schemas of the to edges of a vertex.
+type EdgeValidator struct {
+	pipelineName string
+	vertexName   string
+	// validators of the edges with a schema, by the names of the to vertices.
+	validators map[string]Validator
+	errorTo    map[string]string
+	// errorVertices are the to vertices of the error edges, which only receive the invalid messages.
+	errorVertices map[string]bool
+}
+
+// NewEdgeValidator returns an EdgeValidator of the to edges of a vertex, it returns nil if none of the edges
+// has a schema.
+func NewEdgeValidator(vertex *dfv1.Vertex) (*EdgeValidator, error) {
+	ev := &EdgeValidator{
+		pipelineName:  vertex.Spec.PipelineName,
+		vertexName:    vertex.Spec.Name,
+		validators:    make(map[string]Validator),
+		errorTo:       make(map[string]string),
+		errorVertices: make(map[string]bool),
+	}
+	for _, edge := range vertex.Spec.ToEdges {
+		if edge.Schema == nil {
+			continue
+		}
+		v, err := NewValidator(edge.Schema)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create the schema validator of edge %s, %w", edge.GetEdgeName(), err)
+		}
+		ev.validators[edge.To] = v
+		ev.errorTo[edge.To] = edge.Schema.ErrorTo
+		if edge.Schema.ErrorTo != "" {
+			ev.errorVertices[edge.Schema.ErrorTo] = true
+		}
+	}
+	if len(ev.validators) == 0 {
+		return nil, nil
+	}
+	return ev, nil
+}
+
+// Validate validates the payload against the schema of the edge to the toVertex. If the payload is invalid, it
+// returns the to vertex of the error edge, which is empty if the message should be dropped, and the reason.
+func (ev *EdgeValidator) Validate(toVertex string, payload []byte) (bool, string, error) {
+	if ev == nil {
+		return true, "", nil
+	}
+	v, ok := ev.validators[toVertex]
+	if !ok {
+		return true, "", nil
+	}
+	if err := v.Validate(payload); err != nil {
+		errorTo := ev.errorTo[toVertex]
+		invalidMessages.WithLabelValues(ev.pipelineName, ev.vertexName, toVertex, errorTo).Inc()
+		return false, errorTo, err
+	}
+	return true, "", nil
+}
+
+// IsErrorVertex returns true if the vertex is the to vertex of an error edge.
+func (ev *EdgeValidator) IsErrorVertex(vertex string) bool {
+	return ev != nil && ev.errorVertices[vertex]
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/shared/util"
+)
+
+// registrySchema is the response of getting a version of a subject from a Confluent compatible schema registry.
+type registrySchema struct {
+	Subject    string `json:"subject"`
+	Version    int32  `json:"version"`
+	ID         int32  `json:"id"`
+	SchemaType string `json:"schemaType"`
+	Schema     string `json:"schema"`
+}
+
+// fetchSchema fetches the schema definition from the registry. The protobuf schemas are fetched in the serialized
+// format, which is a base64 encoded FileDescriptorProto.
+func fetchSchema(registry *dfv1.SchemaRegistry, format dfv1.SchemaFormat) (string, error) {
+	version := "latest"
+	if registry.Version != nil {
+		version = strconv.Itoa(int(*registry.Version))
+	}
+	u := fmt.Sprintf("%s/subjects/%s/versions/%s", registry.URL, url.PathEscape(registry.Subject), version)
+	if format == dfv1.SchemaFormatProtobuf {
+		u += "?format=serialized"
+	}
+	req, err := http.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to create the schema registry request, %w", err)
+	}
+	req.Header.Set("Accept", "application/vnd.schemaregistry.v1+json")
+	if x := registry.BasicAuth; x != nil {
+		var user, password string
+		if x.User != nil {
+			if user, err = util.GetSecretFromVolume(x.User); err != nil {
+				return "", fmt.Errorf("failed to get the schema registry user, %w", err)
+			}
+		}
+		if x.Password != nil {
+			if password, err = util.GetSecretFromVolume(x.Password); err != nil {
+				return "", fmt.Errorf("failed to get the schema registry password, %w", err)
+			}
+		}
+		req.SetBasicAuth(user, password)
+	}
+	tlsConfig, err := util.GetTLSConfig(registry.TLS)
+	if err != nil {
+		return "", fmt.Errorf("failed to get the schema registry tls config, %w", err)
+	}
+	client := &http.Client{Timeout: 30 * time.Second, Transport: &http.Transport{TLSClientConfig: tlsConfig}}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch schema %s version %s from the registry, %w", registry.Subject, version, err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read the schema registry response, %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to fetch schema %s version %s from the registry, status code %d, %s", registry.Subject, version, resp.StatusCode, string(body))
+	}
+	result := &registrySchema{}
+	if err := json.Unmarshal(body, result); err != nil {
+		return "", fmt.Errorf("failed to unmarshal the schema registry response, %w", err)
+	}
+	return result.Schema, nil
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"encoding/base64"
+	"fmt"
+
+	"github.com/linkedin/goavro/v2"
+	"github.com/xeipuuv/gojsonschema"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+// Validator validates a payload against a schema.
+type Validator interface {
+	// Validate returns an error describing why the payload doesn't satisfy the schema.
+	Validate(payload []byte) error
+}
+
+type jsonValidator struct {
+	schema *gojsonschema.Schema
+}
+
+func newJSONValidator(definition string) (*jsonValidator, error) {
+	schema, err := gojsonschema.NewSchema(gojsonschema.NewStringLoader(definition))
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile the JSON schema, %w", err)
+	}
+	return &jsonValidator{schema: schema}, nil
+}
+
+func (v *jsonValidator) Validate(payload []byte) error {
+	result, err := v.schema.Validate(gojsonschema.NewBytesLoader(payload))
+	if err != nil {
+		return fmt.Errorf("invalid JSON, %w", err)
+	}
+	if !result.Valid() {
+		return fmt.Errorf("the payload doesn't satisfy the JSON schema, %v", result.Errors())
+	}
+	return nil
+}
+
+type avroValidator struct {
+	codec *goavro.Codec
+}
+
+func newAvroValidator(definition string) (*avroValidator, error) {
+	codec, err := goavro.NewCodec(definition)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile the Avro schema, %w", err)
+	}
+	return &avroValidator{codec: codec}, nil
+}
+
+func (v *avroValidator) Validate(payload []byte) error {
+	_, remaining, err := v.codec.NativeFromBinary(payload)
+	if err != nil {
+		return fmt.Errorf("the payload doesn't satisfy the Avro schema, %w", err)
+	}
+	if len(remaining) > 0 {
+		return fmt.Errorf("the payload doesn't satisfy the Avro schema, %d trailing bytes", len(remaining))
+	}
+	return nil
+}
+
+type protobufValidator struct {
+	descriptor protoreflect.MessageDescriptor
+}
+
+// newProtobufValidator returns a validator of the messageType, the definition is a base64 encoded serialized
+// FileDescriptorSet, or FileDescriptorProto whose imports could only be the well-known types.
+func newProtobufValidator(definition string, messageType string) (*protobufValidator, error) {
+	data, err := base64.StdEncoding.DecodeString(definition)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode the protobuf schema, %w", err)
+	}
+	var files *protoregistry.Files
+	fdSet := &descriptorpb.FileDescriptorSet{}
+	if err := proto.Unmarshal(data, fdSet); err == nil && len(fdSet.GetFile()) > 0 && fdSet.GetFile()[0].GetName() != "" {
+		if files, err = protodesc.NewFiles(fdSet); err != nil {
+			return nil, fmt.Errorf("invalid protobuf file descriptor set, %w", err)
+		}
+	} else {
+		fd := &descriptorpb.FileDescriptorProto{}
+		if err := proto.Unmarshal(data, fd); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal the protobuf schema, %w", err)
+		}
+		file, err := protodesc.NewFile(fd, protoregistry.GlobalFiles)
+		if err != nil {
+			return nil, fmt.Errorf("invalid protobuf file descriptor, %w", err)
+		}
+		files = new(protoregistry.Files)
+		if err := files.RegisterFile(file); err != nil {
+			return nil, err
+		}
+	}
+	d, err := files.FindDescriptorByName(protoreflect.FullName(messageType))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find protobuf message type %q, %w", messageType, err)
+	}
+	md, ok := d.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%q is not a protobuf message type", messageType)
+	}
+	return &protobufValidator{descriptor: md}, nil
+}
+
+func (v *protobufValidator) Validate(payload []byte) error {
+	msg := dynamicpb.NewMessage(v.descriptor)
+	if err := proto.Unmarshal(payload, msg); err != nil {
+		return fmt.Errorf("the payload is not a valid %s, %w", v.descriptor.FullName(), err)
+	}
+	// The fields unknown to the schema are not considered valid, since the message could be of another type.
+	if len(msg.GetUnknown()) > 0 {
+		return fmt.Errorf("the payload is not a valid %s, it has unknown fields", v.descriptor.FullName())
+	}
+	return nil
+}
+
+// NewValidator returns a Validator of the schema, the schema is fetched from the registry if it's not inline.
+func NewValidator(s *dfv1.EdgeSchema) (Validator, error) {
+	definition := s.Definition
+	if s.Registry != nil {
+		var err error
+		if definition, err = fetchSchema(s.Registry, s.Format); err != nil {
+			return nil, err
+		}
+	}
+	switch s.Format {
+	case dfv1.SchemaFormatJSON:
+		return newJSONValidator(definition)
+	case dfv1.SchemaFormatAvro:
+		return newAvroValidator(definition)
+	case dfv1.SchemaFormatProtobuf:
+		return newProtobufValidator(definition, s.MessageType)
+	default:
+		return nil, fmt.Errorf("unsupported schema format %q", s.Format)
+	}
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"k8s.io/utils/pointer"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+const testJSONSchema = `{"type": "object", "properties": {"id": {"type": "string"}, "amount": {"type": "integer"}}, "required": ["id"]}`
+
+func TestJSONValidator(t *testing.T) {
+	v, err := NewValidator(&dfv1.EdgeSchema{Format: dfv1.SchemaFormatJSON, Definition: testJSONSchema})
+	assert.NoError(t, err)
+	assert.NoError(t, v.Validate([]byte(`{"id": "a", "amount": 10}`)))
+	assert.Error(t, v.Validate([]byte(`{"amount": 10}`)))
+	assert.Error(t, v.Validate([]byte(`{"id": "a", "amount": "10"}`)))
+	assert.Error(t, v.Validate([]byte(`not a json`)))
+
+	_, err = NewValidator(&dfv1.EdgeSchema{Format: dfv1.SchemaFormatJSON, Definition: `{"type": 1}`})
+	assert.Error(t, err)
+}
+
+func TestAvroValidator(t *testing.T) {
+	v, err := NewValidator(&dfv1.EdgeSchema{Format: dfv1.SchemaFormatAvro, Definition: `{"type": "record", "name": "Order", "fields": [{"name": "id", "type": "string"}, {"name": "amount", "type": "long"}]}`})
+	assert.NoError(t, err)
+	codec := v.(*avroValidator).codec
+	payload, err := codec.BinaryFromNative(nil, map[string]interface{}{"id": "a", "amount": int64(10)})
+	assert.NoError(t, err)
+	assert.NoError(t, v.Validate(payload))
+	assert.Error(t, v.Validate(payload[:len(payload)-1]))
+	assert.Error(t, v.Validate(append(payload, 0x02)))
+}
+
+func TestProtobufValidator(t *testing.T) {
+	fd := protodesc.ToFileDescriptorProto(wrapperspb.File_google_protobuf_wrappers_proto)
+	data, err := proto.Marshal(&descriptorpb.FileDescriptorSet{File: []*descriptorpb.FileDescriptorProto{fd}})
+	assert.NoError(t, err)
+	v, err := NewValidator(&dfv1.EdgeSchema{Format: dfv1.SchemaFormatProtobuf, Definition: base64.StdEncoding.EncodeToString(data), MessageType: "google.protobuf.StringValue"})
+	assert.NoError(t, err)
+	payload, _ := proto.Marshal(wrapperspb.String("a"))
+	assert.NoError(t, v.Validate(payload))
+	// the field of an Int64Value has a different wire type
+	payload, _ = proto.Marshal(wrapperspb.Int64(10))
+	assert.Error(t, v.Validate(payload))
+	assert.Error(t, v.Validate([]byte{0xff, 0xff}))
+
+	// a serialized FileDescriptorProto, as the one returned by a schema registry
+	data, err = proto.Marshal(fd)
+	assert.NoError(t, err)
+	_, err = NewValidator(&dfv1.EdgeSchema{Format: dfv1.SchemaFormatProtobuf, Definition: base64.StdEncoding.EncodeToString(data), MessageType: "google.protobuf.BoolValue"})
+	assert.NoError(t, err)
+	_, err = NewValidator(&dfv1.EdgeSchema{Format: dfv1.SchemaFormatProtobuf, Definition: base64.StdEncoding.EncodeToString(data), MessageType: "google.protobuf.NotExisting"})
+	assert.Error(t, err)
+}
+
+func TestRegistry(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/subjects/orders-value/versions/latest", "/subjects/orders-value/versions/2":
+			_ = json.NewEncoder(w).Encode(registrySchema{Subject: "orders-value", Version: 2, ID: 1, SchemaType: "JSON", Schema: testJSONSchema})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error_code": 40401, "message": "Subject not found"}`))
+		}
+	}))
+	defer server.Close()
+
+	v, err := NewValidator(&dfv1.EdgeSchema{Format: dfv1.SchemaFormatJSON, Registry: &dfv1.SchemaRegistry{URL: server.URL, Subject: "orders-value"}})
+	assert.NoError(t, err)
+	assert.NoError(t, v.Validate([]byte(`{"id": "a"}`)))
+	assert.Error(t, v.Validate([]byte(`{}`)))
+	_, err = NewValidator(&dfv1.EdgeSchema{Format: dfv1.SchemaFormatJSON, Registry: &dfv1.SchemaRegistry{URL: server.URL, Subject: "orders-value", Version: pointer.Int32(2)}})
+	assert.NoError(t, err)
+	_, err = NewValidator(&dfv1.EdgeSchema{Format: dfv1.SchemaFormatJSON, Registry: &dfv1.SchemaRegistry{URL: server.URL, Subject: "unknown"}})
+	assert.ErrorContains(t, err, "status code 404")
+}
+
+func TestEdgeValidator(t *testing.T) {
+	vertex := &dfv1.Vertex{Spec: dfv1.VertexSpec{
+		PipelineName:   "pl",
+		AbstractVertex: dfv1.AbstractVertex{Name: "in"},
+		ToEdges: []dfv1.CombinedEdge{
+			{Edge: dfv1.Edge{From: "in", To: "a", Schema: &dfv1.EdgeSchema{Format: dfv1.SchemaFormatJSON, Definition: testJSONSchema, ErrorTo: "errors"}}},
+			{Edge: dfv1.Edge{From: "in", To: "b", Schema: &dfv1.EdgeSchema{Format: dfv1.SchemaFormatJSON, Definition: testJSONSchema}}},
+			{Edge: dfv1.Edge{From: "in", To: "errors"}},
+		},
+	}}
+	ev, err := NewEdgeValidator(vertex)
+	assert.NoError(t, err)
+	valid, _, err := ev.Validate("a", []byte(`{"id": "a"}`))
+	assert.True(t, valid)
+	assert.NoError(t, err)
+	valid, errorTo, err := ev.Validate("a", []byte(`{}`))
+	assert.False(t, valid)
+	assert.Equal(t, "errors", errorTo)
+	assert.Error(t, err)
+	valid, errorTo, _ = ev.Validate("b", []byte(`{}`))
+	assert.False(t, valid)
+	assert.Equal(t, "", errorTo)
+	valid, _, _ = ev.Validate("errors", []byte(`{}`))
+	assert.True(t, valid)
+	assert.True(t, ev.IsErrorVertex("errors"))
+	assert.False(t, ev.IsErrorVertex("a"))
+
+	vertex.Spec.ToEdges = vertex.Spec.ToEdges[2:]
+	ev, err = NewEdgeValidator(vertex)
+	assert.NoError(t, err)
+	assert.Nil(t, ev)
+	valid, _, _ = ev.Validate("errors", []byte(`{}`))
+	assert.True(t, valid)
+}
@@ -33,7 +33,7 @@ import (
 type myShutdownTest struct {
 }
 
-func (s myShutdownTest) WhereTo(_ []string, _ []string) ([]VertexBuffer, error) {
+func (s myShutdownTest) WhereTo(_ []string, _ []string, _ string, _ map[string]string) ([]VertexBuffer, error) {
 	return []VertexBuffer{}, nil
 }
 
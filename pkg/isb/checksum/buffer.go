@@ -0,0 +1,164 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package checksum
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+// checksummingWriter checksums the messages before writing them to the buffer.
+type checksummingWriter struct {
+	isb.BufferWriter
+	algorithm dfv1.ChecksumAlgorithm
+}
+
+// NewChecksummingWriter returns a BufferWriter checksumming the messages with the algorithm.
+func NewChecksummingWriter(w isb.BufferWriter, algorithm dfv1.ChecksumAlgorithm) isb.BufferWriter {
+	return &checksummingWriter{BufferWriter: w, algorithm: algorithm}
+}
+
+func (w *checksummingWriter) Write(ctx context.Context, messages []isb.Message) ([]isb.Offset, []error) {
+	checksummed := make([]isb.Message, len(messages))
+	for i, m := range messages {
+		sum, err := Sum(w.algorithm, m)
+		if err != nil {
+			sumErrors.WithLabelValues(w.GetName()).Inc()
+			errs := make([]error, len(messages))
+			for j := range errs {
+				errs[j] = err
+			}
+			return make([]isb.Offset, len(messages)), errs
+		}
+		m.Checksum = sum
+		checksummed[i] = m
+	}
+	return w.BufferWriter.Write(ctx, checksummed)
+}
+
+// verifyingReader verifies the checksums of the read messages, the corrupted ones are handled by the quarantine
+// policy and not returned.
+type verifyingReader struct {
+	isb.BufferReader
+	policy        dfv1.ChecksumQuarantinePolicy
+	maxDeliveries uint64
+	log           *zap.SugaredLogger
+}
+
+// NewVerifyingReader returns a BufferReader verifying the checksums of the messages with the settings.
+// The messages without a checksum, e.g. the ones written before enabling the checksums, are returned unverified.
+func NewVerifyingReader(ctx context.Context, r isb.BufferReader, settings *dfv1.MessageChecksum) isb.BufferReader {
+	return &verifyingReader{
+		BufferReader:  r,
+		policy:        settings.GetQuarantine(),
+		maxDeliveries: uint64(settings.GetMaxDeliveries()),
+		log:           logging.FromContext(ctx).With("buffer", r.GetName()),
+	}
+}
+
+func (r *verifyingReader) Read(ctx context.Context, count int64) ([]*isb.ReadMessage, error) {
+	messages, err := r.BufferReader.Read(ctx, count)
+	valid := make([]*isb.ReadMessage, 0, len(messages))
+	var dropped, redelivered []isb.Offset
+	for _, m := range messages {
+		verifyErr := Verify(&m.Message)
+		if verifyErr == nil {
+			valid = append(valid, m)
+			continue
+		}
+		if errors.Is(verifyErr, ErrMissing) {
+			unverified.WithLabelValues(r.GetName()).Inc()
+			valid = append(valid, m)
+			continue
+		}
+		verificationFailures.WithLabelValues(r.GetName(), failureReason(verifyErr)).Inc()
+		if r.redeliver(m) {
+			r.log.Warnw("Leaving a message failing the checksum verification for redelivery", zap.String("offset", m.ReadOffset.String()), zap.Uint64("deliveries", m.Metadata.NumDelivered), zap.Error(verifyErr))
+			redelivered = append(redelivered, m.ReadOffset)
+			continue
+		}
+		r.log.Errorw("Dropping a message failing the checksum verification", zap.String("offset", m.ReadOffset.String()), zap.Uint64("deliveries", m.Metadata.NumDelivered), zap.Error(verifyErr))
+		dropped = append(dropped, m.ReadOffset)
+	}
+	if len(redelivered) > 0 {
+		quarantined.WithLabelValues(r.GetName(), "redeliver").Add(float64(len(redelivered)))
+		r.BufferReader.NoAck(ctx, redelivered)
+	}
+	if len(dropped) > 0 {
+		quarantined.WithLabelValues(r.GetName(), "drop").Add(float64(len(dropped)))
+		for _, ackErr := range r.BufferReader.Ack(ctx, dropped) {
+			if ackErr != nil {
+				r.log.Errorw("Failed to ack a message failing the checksum verification", zap.Error(ackErr))
+			}
+		}
+	}
+	return valid, err
+}
+
+// redeliver tells if a corrupted message should be left for redelivery. The ones read from the buffers not tracking
+// the deliveries are always dropped.
+func (r *verifyingReader) redeliver(m *isb.ReadMessage) bool {
+	if r.policy != dfv1.ChecksumQuarantineRedeliver {
+		return false
+	}
+	return m.Metadata.NumDelivered > 0 && m.Metadata.NumDelivered < r.maxDeliveries
+}
+
+// Pending returns the pending messages number of the underlying reader.
+func (r *verifyingReader) Pending(ctx context.Context) (int64, error) {
+	if x, ok := r.BufferReader.(isb.LagReader); ok {
+		return x.Pending(ctx)
+	}
+	return isb.PendingNotAvailable, nil
+}
+
+func failureReason(err error) string {
+	switch {
+	case errors.Is(err, ErrUnknownAlgorithm):
+		return "unknown_algorithm"
+	case errors.Is(err, ErrMismatch):
+		return "mismatch"
+	default:
+		return "error"
+	}
+}
+
+// NewVerifyingReaders wraps the readers with NewVerifyingReader.
+func NewVerifyingReaders(ctx context.Context, readers []isb.BufferReader, settings *dfv1.MessageChecksum) []isb.BufferReader {
+	result := make([]isb.BufferReader, len(readers))
+	for i, r := range readers {
+		result[i] = NewVerifyingReader(ctx, r, settings)
+	}
+	return result
+}
+
+// NewChecksummingWriters wraps the writers of each to vertex with NewChecksummingWriter.
+func NewChecksummingWriters(writers map[string][]isb.BufferWriter, algorithm dfv1.ChecksumAlgorithm) map[string][]isb.BufferWriter {
+	result := make(map[string][]isb.BufferWriter, len(writers))
+	for toVertex, ws := range writers {
+		for _, w := range ws {
+			result[toVertex] = append(result[toVertex], NewChecksummingWriter(w, algorithm))
+		}
+	}
+	return result
+}
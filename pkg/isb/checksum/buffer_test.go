@@ -0,0 +1,127 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package checksum
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/utils/pointer"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/isb/stores/simplebuffer"
+	"github.com/numaproj/numaflow/pkg/isb/testutils"
+)
+
+// deliveryTracker sets the number of deliveries of the read messages, and records the acked and the not acked ones.
+type deliveryTracker struct {
+	*simplebuffer.InMemoryBuffer
+	numDelivered uint64
+	acked        []isb.Offset
+	noAcked      []isb.Offset
+}
+
+func (r *deliveryTracker) Read(ctx context.Context, count int64) ([]*isb.ReadMessage, error) {
+	messages, err := r.InMemoryBuffer.Read(ctx, count)
+	for _, m := range messages {
+		m.Metadata.NumDelivered = r.numDelivered
+	}
+	return messages, err
+}
+
+func (r *deliveryTracker) Ack(ctx context.Context, offsets []isb.Offset) []error {
+	r.acked = append(r.acked, offsets...)
+	return r.InMemoryBuffer.Ack(ctx, offsets)
+}
+
+func (r *deliveryTracker) NoAck(_ context.Context, offsets []isb.Offset) {
+	r.noAcked = append(r.noAcked, offsets...)
+}
+
+// writeMessages writes 2 checksummed messages, 1 corrupted message and 1 message without a checksum.
+func writeMessages(t *testing.T, ctx context.Context, buffer isb.BufferWriter) []isb.Message {
+	writer := NewChecksummingWriter(buffer, dfv1.ChecksumAlgorithmXXHash)
+	messages := testutils.BuildTestWriteMessages(2, time.Now())
+	_, errs := writer.Write(ctx, messages)
+	for _, e := range errs {
+		assert.NoError(t, e)
+	}
+	injected := testutils.BuildTestWriteMessages(2, time.Now())
+	injected[0].Checksum = "xxhash:0000000000000000"
+	_, errs = buffer.Write(ctx, injected)
+	for _, e := range errs {
+		assert.NoError(t, e)
+	}
+	return messages
+}
+
+func TestChecksummingWriterAndVerifyingReader(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	buffer := &deliveryTracker{InMemoryBuffer: simplebuffer.NewInMemoryBuffer("test", 10, 0), numDelivered: 1}
+	messages := writeMessages(t, ctx, buffer)
+	reader := NewVerifyingReader(ctx, buffer, &dfv1.MessageChecksum{})
+	readMessages, err := reader.Read(ctx, 4)
+	assert.NoError(t, err)
+	assert.Len(t, readMessages, 3)
+	for i, m := range readMessages[:2] {
+		assert.Equal(t, messages[i].Payload, m.Payload)
+		assert.Empty(t, m.Checksum)
+	}
+	// The corrupted message is acknowledged and dropped.
+	assert.Len(t, buffer.acked, 1)
+	assert.Equal(t, "2-0", buffer.acked[0].String())
+	assert.Empty(t, buffer.noAcked)
+	pending, err := reader.(isb.LagReader).Pending(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, isb.PendingNotAvailable, pending)
+}
+
+func TestVerifyingReader_Redeliver(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	settings := &dfv1.MessageChecksum{Quarantine: dfv1.ChecksumQuarantineRedeliver, MaxDeliveries: pointer.Uint32(2)}
+
+	buffer := &deliveryTracker{InMemoryBuffer: simplebuffer.NewInMemoryBuffer("test", 10, 0), numDelivered: 1}
+	writeMessages(t, ctx, buffer)
+	readMessages, err := NewVerifyingReader(ctx, buffer, settings).Read(ctx, 4)
+	assert.NoError(t, err)
+	assert.Len(t, readMessages, 3)
+	assert.Empty(t, buffer.acked)
+	assert.Len(t, buffer.noAcked, 1)
+	assert.Equal(t, "2-0", buffer.noAcked[0].String())
+
+	// Dropped once delivered for max deliveries times.
+	buffer = &deliveryTracker{InMemoryBuffer: simplebuffer.NewInMemoryBuffer("test", 10, 0), numDelivered: 2}
+	writeMessages(t, ctx, buffer)
+	_, err = NewVerifyingReader(ctx, buffer, settings).Read(ctx, 4)
+	assert.NoError(t, err)
+	assert.Len(t, buffer.acked, 1)
+	assert.Empty(t, buffer.noAcked)
+
+	// Dropped at once if the deliveries are not tracked.
+	buffer = &deliveryTracker{InMemoryBuffer: simplebuffer.NewInMemoryBuffer("test", 10, 0)}
+	writeMessages(t, ctx, buffer)
+	_, err = NewVerifyingReader(ctx, buffer, settings).Read(ctx, 4)
+	assert.NoError(t, err)
+	assert.Len(t, buffer.acked, 1)
+	assert.Empty(t, buffer.noAcked)
+}
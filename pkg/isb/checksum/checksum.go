@@ -0,0 +1,86 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package checksum checksums the messages written to the inter-step buffers, and verifies them at the readers, so
+// that the messages corrupted in the buffers, e.g. by a faulty storage, are detected before being processed.
+package checksum
+
+import (
+	"errors"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"strings"
+
+	"github.com/cespare/xxhash/v2"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+)
+
+var (
+	ErrMissing          = errors.New("message has no checksum")
+	ErrUnknownAlgorithm = errors.New("unknown checksum algorithm")
+	ErrMismatch         = errors.New("checksum mismatch")
+)
+
+var crc32cTable = crc32.MakeTable(crc32.Castagnoli)
+
+func newHash(algorithm dfv1.ChecksumAlgorithm) (hash.Hash, error) {
+	switch algorithm {
+	case dfv1.ChecksumAlgorithmCRC32C:
+		return crc32.New(crc32cTable), nil
+	case dfv1.ChecksumAlgorithmXXHash:
+		return xxhash.New(), nil
+	default:
+		return nil, fmt.Errorf("%w %q", ErrUnknownAlgorithm, algorithm)
+	}
+}
+
+// Sum returns the checksum of a message with the algorithm, it's calculated on the header and the payload of the
+// message, excluding the checksum.
+func Sum(algorithm dfv1.ChecksumAlgorithm, m isb.Message) (string, error) {
+	h, err := newHash(algorithm)
+	if err != nil {
+		return "", err
+	}
+	m.Checksum = ""
+	header, err := m.Header.MarshalBinary()
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal the message header, %w", err)
+	}
+	h.Write(header)
+	h.Write(m.Payload)
+	return fmt.Sprintf("%s:%x", algorithm, h.Sum(nil)), nil
+}
+
+// Verify verifies the checksum of a message with the algorithm it was checksummed with, the checksum is cleared if
+// it's valid.
+func Verify(m *isb.Message) error {
+	if m.Checksum == "" {
+		return ErrMissing
+	}
+	algorithm, _, _ := strings.Cut(m.Checksum, ":")
+	expected, err := Sum(dfv1.ChecksumAlgorithm(algorithm), *m)
+	if err != nil {
+		return err
+	}
+	if expected != m.Checksum {
+		return ErrMismatch
+	}
+	m.Checksum = ""
+	return nil
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package checksum
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb/testutils"
+)
+
+func TestSumAndVerify(t *testing.T) {
+	for _, algorithm := range []dfv1.ChecksumAlgorithm{dfv1.ChecksumAlgorithmCRC32C, dfv1.ChecksumAlgorithmXXHash} {
+		t.Run(string(algorithm), func(t *testing.T) {
+			m := testutils.BuildTestWriteMessages(1, time.Now())[0]
+			m.Signature = "key-1:c2lnbmF0dXJl"
+			sum, err := Sum(algorithm, m)
+			assert.NoError(t, err)
+			assert.True(t, strings.HasPrefix(sum, string(algorithm)+":"))
+			m.Checksum = sum
+			// The checksum itself is excluded.
+			again, err := Sum(algorithm, m)
+			assert.NoError(t, err)
+			assert.Equal(t, sum, again)
+
+			valid := m
+			assert.NoError(t, Verify(&valid))
+			assert.Empty(t, valid.Checksum)
+
+			corrupted := m
+			corrupted.Payload = append([]byte{}, m.Payload...)
+			corrupted.Payload[0] ^= 0x01
+			assert.ErrorIs(t, Verify(&corrupted), ErrMismatch)
+			assert.Equal(t, sum, corrupted.Checksum)
+
+			corrupted = m
+			corrupted.Keys = []string{"k"}
+			assert.ErrorIs(t, Verify(&corrupted), ErrMismatch)
+		})
+	}
+
+	m := testutils.BuildTestWriteMessages(1, time.Now())[0]
+	assert.ErrorIs(t, Verify(&m), ErrMissing)
+	m.Checksum = "md5:abc"
+	assert.ErrorIs(t, Verify(&m), ErrUnknownAlgorithm)
+	_, err := Sum("md5", m)
+	assert.ErrorIs(t, err, ErrUnknownAlgorithm)
+}
@@ -0,0 +1,50 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package checksum
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// verificationFailures is used to indicate the number of the messages failing the checksum verification
+var verificationFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "isb_checksum",
+	Name:      "verification_failures_total",
+	Help:      "Total number of the messages failing the checksum verification",
+}, []string{"buffer", "reason"})
+
+// quarantined is used to indicate the number of the corrupted messages handled by the quarantine policy
+var quarantined = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "isb_checksum",
+	Name:      "quarantined_total",
+	Help:      "Total number of the corrupted messages dropped or left for redelivery",
+}, []string{"buffer", "action"})
+
+// unverified is used to indicate the number of the messages without a checksum, which are processed unverified
+var unverified = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "isb_checksum",
+	Name:      "unverified_total",
+	Help:      "Total number of the messages without a checksum, which are processed unverified",
+}, []string{"buffer"})
+
+// sumErrors is used to indicate the number of errors checksumming the messages
+var sumErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "isb_checksum",
+	Name:      "sum_error_total",
+	Help:      "Total number of errors checksumming the messages",
+}, []string{"buffer"})
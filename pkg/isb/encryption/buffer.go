@@ -0,0 +1,140 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encryption
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+// encryptingWriter encrypts the payloads of the messages before writing them to the buffer.
+type encryptingWriter struct {
+	isb.BufferWriter
+	encryptor *Encryptor
+}
+
+// NewEncryptingWriter returns a BufferWriter which encrypts the messages before writing them with the given writer.
+func NewEncryptingWriter(w isb.BufferWriter, encryptor *Encryptor) isb.BufferWriter {
+	return &encryptingWriter{BufferWriter: w, encryptor: encryptor}
+}
+
+// Write encrypts the messages and writes them. The messages are not written if any of them fails to be encrypted.
+func (w *encryptingWriter) Write(ctx context.Context, messages []isb.Message) ([]isb.Offset, []error) {
+	encrypted := make([]isb.Message, len(messages))
+	for i, m := range messages {
+		if err := w.encryptor.Encrypt(ctx, &m); err != nil {
+			encryptErrors.WithLabelValues(w.GetName()).Inc()
+			errs := make([]error, len(messages))
+			for j := range errs {
+				errs[j] = err
+			}
+			return make([]isb.Offset, len(messages)), errs
+		}
+		encrypted[i] = m
+	}
+	return w.BufferWriter.Write(ctx, encrypted)
+}
+
+// decryptingReader decrypts the payloads of the messages read from the buffer. The corrupted messages are acknowledged
+// and dropped, and the ones with the data keys failing to be unwrapped, e.g. because the KMS is unavailable, are left
+// for redelivery.
+type decryptingReader struct {
+	isb.BufferReader
+	encryptor *Encryptor
+	log       *zap.SugaredLogger
+}
+
+// NewDecryptingReader returns a BufferReader which decrypts the messages read with the given reader. The messages not
+// encrypted, e.g. the ones written before enabling the encryption, are returned as they are.
+func NewDecryptingReader(ctx context.Context, r isb.BufferReader, encryptor *Encryptor) isb.BufferReader {
+	return &decryptingReader{BufferReader: r, encryptor: encryptor, log: logging.FromContext(ctx).With("buffer", r.GetName())}
+}
+
+func (r *decryptingReader) Read(ctx context.Context, count int64) ([]*isb.ReadMessage, error) {
+	messages, err := r.BufferReader.Read(ctx, count)
+	decrypted := make([]*isb.ReadMessage, 0, len(messages))
+	var dropped, redelivered []isb.Offset
+	// the data keys failing to be unwrapped are not retried in the same batch, not to flood the KMS
+	failedKeys := make(map[string]error)
+	for _, m := range messages {
+		decryptErr, failed := failedKeys[m.DataKey]
+		if !failed {
+			decryptErr = r.encryptor.Decrypt(ctx, &m.Message)
+		}
+		var unwrapErr *UnwrapError
+		switch {
+		case decryptErr == nil:
+			decrypted = append(decrypted, m)
+		case errors.Is(decryptErr, ErrUnencrypted):
+			unencrypted.WithLabelValues(r.GetName()).Inc()
+			decrypted = append(decrypted, m)
+		case errors.As(decryptErr, &unwrapErr):
+			failedKeys[m.DataKey] = decryptErr
+			decryptionFailures.WithLabelValues(r.GetName(), "unwrap").Inc()
+			r.log.Errorw("Leaving a message failing to be decrypted for redelivery", zap.String("offset", m.ReadOffset.String()), zap.Error(decryptErr))
+			redelivered = append(redelivered, m.ReadOffset)
+		default:
+			decryptionFailures.WithLabelValues(r.GetName(), "corrupted").Inc()
+			r.log.Errorw("Dropping a message failing to be decrypted", zap.String("offset", m.ReadOffset.String()), zap.Error(decryptErr))
+			dropped = append(dropped, m.ReadOffset)
+		}
+	}
+	if len(redelivered) > 0 {
+		r.BufferReader.NoAck(ctx, redelivered)
+	}
+	if len(dropped) > 0 {
+		for _, ackErr := range r.BufferReader.Ack(ctx, dropped) {
+			if ackErr != nil {
+				r.log.Errorw("Failed to ack a message failing to be decrypted", zap.Error(ackErr))
+			}
+		}
+	}
+	return decrypted, err
+}
+
+// Pending returns the pending messages number of the underlying reader.
+func (r *decryptingReader) Pending(ctx context.Context) (int64, error) {
+	if x, ok := r.BufferReader.(isb.LagReader); ok {
+		return x.Pending(ctx)
+	}
+	return isb.PendingNotAvailable, nil
+}
+
+// NewDecryptingReaders wraps the readers with NewDecryptingReader.
+func NewDecryptingReaders(ctx context.Context, readers []isb.BufferReader, encryptor *Encryptor) []isb.BufferReader {
+	result := make([]isb.BufferReader, len(readers))
+	for i, r := range readers {
+		result[i] = NewDecryptingReader(ctx, r, encryptor)
+	}
+	return result
+}
+
+// NewEncryptingWriters wraps the writers of each to vertex with NewEncryptingWriter.
+func NewEncryptingWriters(writers map[string][]isb.BufferWriter, encryptor *Encryptor) map[string][]isb.BufferWriter {
+	result := make(map[string][]isb.BufferWriter, len(writers))
+	for toVertex, ws := range writers {
+		for _, w := range ws {
+			result[toVertex] = append(result[toVertex], NewEncryptingWriter(w, encryptor))
+		}
+	}
+	return result
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encryption
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/isb/stores/simplebuffer"
+	"github.com/numaproj/numaflow/pkg/isb/testutils"
+)
+
+// ackRecorder records the acknowledged and the not acknowledged offsets.
+type ackRecorder struct {
+	*simplebuffer.InMemoryBuffer
+	acked, noAcked []isb.Offset
+}
+
+func (r *ackRecorder) Ack(ctx context.Context, offsets []isb.Offset) []error {
+	r.acked = append(r.acked, offsets...)
+	return r.InMemoryBuffer.Ack(ctx, offsets)
+}
+
+func (r *ackRecorder) NoAck(ctx context.Context, offsets []isb.Offset) {
+	r.noAcked = append(r.noAcked, offsets...)
+	r.InMemoryBuffer.NoAck(ctx, offsets)
+}
+
+func TestEncryptingWriterAndDecryptingReader(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	kms := newTestKMS(t)
+	buffer := &ackRecorder{InMemoryBuffer: simplebuffer.NewInMemoryBuffer("test", 10, 0)}
+	writer := NewEncryptingWriter(buffer, newEncryptor(kms, time.Hour))
+	reader := NewDecryptingReader(ctx, buffer, newEncryptor(kms, time.Hour))
+
+	messages := testutils.BuildTestWriteMessages(2, time.Now())
+	_, errs := writer.Write(ctx, messages)
+	for _, e := range errs {
+		assert.NoError(t, e)
+	}
+	// A message written before enabling the encryption is returned as it is.
+	plain := testutils.BuildTestWriteMessages(1, time.Now())
+	_, errs = buffer.Write(ctx, plain)
+	assert.NoError(t, errs[0])
+	// A corrupted message is dropped.
+	corrupted := testutils.BuildTestWriteMessages(1, time.Now())
+	corrupted[0].DataKey = buffer.GetMessages(1)[0].DataKey
+	_, errs = buffer.Write(ctx, corrupted)
+	assert.NoError(t, errs[0])
+
+	readMessages, err := reader.Read(ctx, 4)
+	assert.NoError(t, err)
+	assert.Len(t, readMessages, 3)
+	for i, m := range readMessages[:2] {
+		assert.Equal(t, messages[i].Payload, m.Payload)
+		assert.Empty(t, m.DataKey)
+	}
+	assert.Equal(t, plain[0].Payload, readMessages[2].Payload)
+	assert.Len(t, buffer.acked, 1)
+	assert.Equal(t, "3-0", buffer.acked[0].String())
+
+	pending, err := reader.(isb.LagReader).Pending(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, isb.PendingNotAvailable, pending)
+}
+
+func TestDecryptingReader_UnwrapFailure(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	kms := newTestKMS(t)
+	buffer := &ackRecorder{InMemoryBuffer: simplebuffer.NewInMemoryBuffer("test", 10, 0)}
+	writer := NewEncryptingWriter(buffer, newEncryptor(kms, time.Hour))
+	_, errs := writer.Write(ctx, testutils.BuildTestWriteMessages(3, time.Now()))
+	for _, e := range errs {
+		assert.NoError(t, e)
+	}
+
+	kms.unwrapErr = errors.New("kms unavailable")
+	kms.unwraps = 0
+	reader := NewDecryptingReader(ctx, buffer, newEncryptor(kms, time.Hour))
+	readMessages, err := reader.Read(ctx, 3)
+	assert.NoError(t, err)
+	assert.Empty(t, readMessages)
+	// The messages are left for redelivery, and the data key is only tried once in the batch.
+	assert.Len(t, buffer.noAcked, 3)
+	assert.Empty(t, buffer.acked)
+	assert.Equal(t, 1, kms.unwraps)
+}
@@ -0,0 +1,225 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package encryption encrypts the payloads of the messages written to the Inter-Step Buffers with the envelope
+// encryption. Each writer encrypts the payloads with a random AES-256-GCM data key, which is wrapped by a KMS and
+// carried in the message header, and rotated periodically. The readers unwrap the data keys with the KMS, and cache
+// them, to decrypt the payloads.
+package encryption
+
+import (
+	"context"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+)
+
+const (
+	// dataKeySize is the size of a data key, for AES-256.
+	dataKeySize = 32
+	// maxCachedDataKeys is the max number of the unwrapped data keys cached by an Encryptor, the cache is cleared
+	// once it's full.
+	maxCachedDataKeys = 1024
+)
+
+var (
+	// ErrUnencrypted is returned when decrypting a message not encrypted, e.g. written before enabling the encryption.
+	ErrUnencrypted = errors.New("message is not encrypted")
+	// ErrCorrupted is returned when the encrypted payload of a message fails the authentication.
+	ErrCorrupted = errors.New("encrypted payload is corrupted")
+)
+
+// UnwrapError is returned when the data key of a message can't be unwrapped by the KMS.
+type UnwrapError struct {
+	err error
+}
+
+func (e *UnwrapError) Error() string {
+	return fmt.Sprintf("failed to unwrap the data key, %v", e.err)
+}
+
+func (e *UnwrapError) Unwrap() error {
+	return e.err
+}
+
+// dataKey is a data key of the writer with its wrapped form.
+type dataKey struct {
+	aead    cipher.AEAD
+	wrapped string
+	created time.Time
+}
+
+// Encryptor encrypts and decrypts the payloads of the messages with the data keys wrapped by a KMS.
+type Encryptor struct {
+	kms              KMS
+	rotationInterval time.Duration
+	lock             sync.Mutex
+	current          *dataKey
+	// unwrapped caches the data keys by their wrapped forms
+	unwrapped map[string]cipher.AEAD
+	// now returns the current time, it's replaced in the tests
+	now func() time.Time
+}
+
+// NewEncryptor returns an Encryptor with the KMS in the settings.
+func NewEncryptor(settings *dfv1.MessageEncryption) (*Encryptor, error) {
+	kms, err := NewKMS(settings.KMS)
+	if err != nil {
+		return nil, err
+	}
+	return newEncryptor(kms, settings.GetDataKeyRotationInterval()), nil
+}
+
+func newEncryptor(kms KMS, rotationInterval time.Duration) *Encryptor {
+	return &Encryptor{
+		kms:              kms,
+		rotationInterval: rotationInterval,
+		unwrapped:        make(map[string]cipher.AEAD),
+		now:              time.Now,
+	}
+}
+
+// Encrypt encrypts the payload of a data message, and sets the wrapped data key to the header. The encrypted payload
+// is the nonce followed by the ciphertext.
+func (e *Encryptor) Encrypt(ctx context.Context, m *isb.Message) error {
+	if m.Kind != isb.Data {
+		return nil
+	}
+	key, err := e.currentKey(ctx)
+	if err != nil {
+		return err
+	}
+	nonce := make([]byte, key.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return err
+	}
+	m.Payload = key.aead.Seal(nonce, nonce, m.Payload, nil)
+	m.DataKey = key.wrapped
+	return nil
+}
+
+// Decrypt decrypts the payload of a message, and clears the data key. It returns ErrUnencrypted if the message is not
+// encrypted, ErrCorrupted if the payload fails the authentication, or an UnwrapError if the data key can't be
+// unwrapped.
+func (e *Encryptor) Decrypt(ctx context.Context, m *isb.Message) error {
+	if m.DataKey == "" {
+		if m.Kind != isb.Data {
+			return nil
+		}
+		return ErrUnencrypted
+	}
+	aead, err := e.unwrap(ctx, m.DataKey)
+	if err != nil {
+		return &UnwrapError{err: err}
+	}
+	if len(m.Payload) < aead.NonceSize() {
+		return ErrCorrupted
+	}
+	nonce := m.Payload[:aead.NonceSize()]
+	payload, err := aead.Open(nil, nonce, m.Payload[aead.NonceSize():], nil)
+	if err != nil {
+		return ErrCorrupted
+	}
+	m.Payload = payload
+	m.DataKey = ""
+	return nil
+}
+
+// currentKey returns the current data key, a new one is generated and wrapped if it's due to rotate.
+func (e *Encryptor) currentKey(ctx context.Context) (*dataKey, error) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	if e.current != nil && e.now().Sub(e.current.created) < e.rotationInterval {
+		return e.current, nil
+	}
+	key := make([]byte, dataKeySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	wrapped, err := e.kms.Wrap(ctx, key)
+	kmsRequests.WithLabelValues(e.kms.Name(), "wrap", requestResult(err)).Inc()
+	if err != nil {
+		return nil, fmt.Errorf("failed to wrap the data key, %w", err)
+	}
+	e.current = &dataKey{
+		aead:    aead,
+		wrapped: e.kms.Name() + ":" + base64.StdEncoding.EncodeToString(wrapped),
+		created: e.now(),
+	}
+	e.cache(e.current.wrapped, aead)
+	dataKeyRotations.WithLabelValues(e.kms.Name()).Inc()
+	return e.current, nil
+}
+
+// unwrap returns the cipher of a wrapped data key, the data key is unwrapped by the KMS if it's not cached.
+func (e *Encryptor) unwrap(ctx context.Context, wrapped string) (cipher.AEAD, error) {
+	e.lock.Lock()
+	aead, ok := e.unwrapped[wrapped]
+	e.lock.Unlock()
+	if ok {
+		return aead, nil
+	}
+	provider, encoded, found := strings.Cut(wrapped, ":")
+	if !found {
+		return nil, fmt.Errorf("invalid data key format")
+	}
+	if provider != e.kms.Name() {
+		return nil, fmt.Errorf("the data key is wrapped by kms %q, but %q is configured", provider, e.kms.Name())
+	}
+	decoded, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("invalid data key encoding, %w", err)
+	}
+	key, err := e.kms.Unwrap(ctx, decoded)
+	kmsRequests.WithLabelValues(e.kms.Name(), "unwrap", requestResult(err)).Inc()
+	if err != nil {
+		return nil, err
+	}
+	if aead, err = newAEAD(key); err != nil {
+		return nil, err
+	}
+	e.lock.Lock()
+	e.cache(wrapped, aead)
+	e.lock.Unlock()
+	return aead, nil
+}
+
+// cache caches an unwrapped data key, it's called with the lock held.
+func (e *Encryptor) cache(wrapped string, aead cipher.AEAD) {
+	if len(e.unwrapped) >= maxCachedDataKeys {
+		e.unwrapped = make(map[string]cipher.AEAD)
+	}
+	e.unwrapped[wrapped] = aead
+}
+
+func requestResult(err error) string {
+	if err != nil {
+		return "failure"
+	}
+	return "success"
+}
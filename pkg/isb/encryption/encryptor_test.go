@@ -0,0 +1,136 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encryption
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/numaproj/numaflow/pkg/isb"
+)
+
+// countingKMS counts the calls to the wrapped KMS.
+type countingKMS struct {
+	KMS
+	wraps, unwraps int
+	unwrapErr      error
+}
+
+func (k *countingKMS) Wrap(ctx context.Context, dataKey []byte) ([]byte, error) {
+	k.wraps++
+	return k.KMS.Wrap(ctx, dataKey)
+}
+
+func (k *countingKMS) Unwrap(ctx context.Context, wrapped []byte) ([]byte, error) {
+	k.unwraps++
+	if k.unwrapErr != nil {
+		return nil, k.unwrapErr
+	}
+	return k.KMS.Unwrap(ctx, wrapped)
+}
+
+func newTestKMS(t *testing.T) *countingKMS {
+	k, err := newStaticKMSWithKeys(bytes.Repeat([]byte{1}, masterKeySize))
+	assert.NoError(t, err)
+	return &countingKMS{KMS: k}
+}
+
+func TestEncryptor_EncryptAndDecrypt(t *testing.T) {
+	ctx := context.Background()
+	kms := newTestKMS(t)
+	writer := newEncryptor(kms, time.Hour)
+	reader := newEncryptor(kms, time.Hour)
+
+	m := isb.Message{Header: isb.Header{ID: "1"}, Body: isb.Body{Payload: []byte("sensitive")}}
+	assert.NoError(t, writer.Encrypt(ctx, &m))
+	assert.NotEqual(t, []byte("sensitive"), m.Payload)
+	assert.NotContains(t, string(m.Payload), "sensitive")
+	assert.True(t, len(m.DataKey) > len("static:"))
+	assert.Equal(t, "static:", m.DataKey[:len("static:")])
+
+	assert.NoError(t, reader.Decrypt(ctx, &m))
+	assert.Equal(t, []byte("sensitive"), m.Payload)
+	assert.Empty(t, m.DataKey)
+
+	// The data key is wrapped once, and unwrapped once by the reader.
+	m2 := isb.Message{Body: isb.Body{Payload: []byte("more")}}
+	assert.NoError(t, writer.Encrypt(ctx, &m2))
+	assert.NoError(t, reader.Decrypt(ctx, &m2))
+	assert.Equal(t, []byte("more"), m2.Payload)
+	assert.Equal(t, 1, kms.wraps)
+	assert.Equal(t, 1, kms.unwraps)
+
+	// The control messages are not encrypted.
+	wmb := isb.Message{Header: isb.Header{Kind: isb.WMB}}
+	assert.NoError(t, writer.Encrypt(ctx, &wmb))
+	assert.Empty(t, wmb.DataKey)
+	assert.NoError(t, reader.Decrypt(ctx, &wmb))
+}
+
+func TestEncryptor_Rotation(t *testing.T) {
+	ctx := context.Background()
+	kms := newTestKMS(t)
+	e := newEncryptor(kms, time.Hour)
+	now := time.Now()
+	e.now = func() time.Time { return now }
+
+	m1 := isb.Message{Body: isb.Body{Payload: []byte("a")}}
+	assert.NoError(t, e.Encrypt(ctx, &m1))
+	now = now.Add(time.Hour)
+	m2 := isb.Message{Body: isb.Body{Payload: []byte("b")}}
+	assert.NoError(t, e.Encrypt(ctx, &m2))
+	assert.Equal(t, 2, kms.wraps)
+	assert.NotEqual(t, m1.DataKey, m2.DataKey)
+
+	// The messages encrypted with the rotated data key are still decrypted.
+	reader := newEncryptor(kms, time.Hour)
+	assert.NoError(t, reader.Decrypt(ctx, &m1))
+	assert.Equal(t, []byte("a"), m1.Payload)
+	assert.NoError(t, reader.Decrypt(ctx, &m2))
+	assert.Equal(t, []byte("b"), m2.Payload)
+}
+
+func TestEncryptor_DecryptErrors(t *testing.T) {
+	ctx := context.Background()
+	kms := newTestKMS(t)
+	e := newEncryptor(kms, time.Hour)
+
+	m := isb.Message{Body: isb.Body{Payload: []byte("plain")}}
+	assert.ErrorIs(t, e.Decrypt(ctx, &m), ErrUnencrypted)
+
+	assert.NoError(t, e.Encrypt(ctx, &m))
+	corrupted := m
+	corrupted.Payload = append([]byte{}, m.Payload...)
+	corrupted.Payload[len(corrupted.Payload)-1] ^= 0xff
+	assert.ErrorIs(t, e.Decrypt(ctx, &corrupted), ErrCorrupted)
+
+	var unwrapErr *UnwrapError
+	wrongProvider := m
+	wrongProvider.DataKey = "aws:" + m.DataKey[len("static:"):]
+	assert.True(t, errors.As(e.Decrypt(ctx, &wrongProvider), &unwrapErr))
+
+	kms.unwrapErr = errors.New("kms unavailable")
+	reader := newEncryptor(kms, time.Hour)
+	err := reader.Decrypt(ctx, &m)
+	assert.True(t, errors.As(err, &unwrapErr))
+	assert.Contains(t, err.Error(), "kms unavailable")
+}
@@ -0,0 +1,58 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encryption
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+// kmsTimeout is the timeout of a request to a KMS.
+const kmsTimeout = 10 * time.Second
+
+// KMS wraps and unwraps the data keys encrypting the payloads.
+type KMS interface {
+	// Name returns the name of the KMS provider, which is carried in the wrapped data keys.
+	Name() string
+	// Wrap encrypts a data key.
+	Wrap(ctx context.Context, dataKey []byte) ([]byte, error)
+	// Unwrap decrypts a data key wrapped by Wrap.
+	Unwrap(ctx context.Context, wrapped []byte) ([]byte, error)
+}
+
+// NewKMS returns the KMS of the provider in the settings.
+func NewKMS(settings dfv1.EncryptionKMS) (KMS, error) {
+	switch {
+	case settings.Static != nil:
+		return newStaticKMS(*settings.Static)
+	case settings.AWS != nil:
+		return newAWSKMS(*settings.AWS)
+	case settings.GCP != nil:
+		return newGCPKMS(*settings.GCP)
+	default:
+		return nil, fmt.Errorf("no kms provider is specified")
+	}
+}
+
+// kmsHTTPClient returns the http client for the requests to a KMS.
+func kmsHTTPClient(transport http.RoundTripper) *http.Client {
+	return &http.Client{Transport: transport, Timeout: kmsTimeout}
+}
@@ -0,0 +1,274 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encryption
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	sharedutil "github.com/numaproj/numaflow/pkg/shared/util"
+)
+
+const (
+	// awsAlgorithm is the signing algorithm of the requests, AWS Signature Version 4.
+	awsAlgorithm = "AWS4-HMAC-SHA256"
+	// awsCredentialsExpiryWindow is the time before the expiration of the temporary credentials to refresh them.
+	awsCredentialsExpiryWindow = 5 * time.Minute
+)
+
+// awsCredentials are the credentials to sign the requests to AWS.
+type awsCredentials struct {
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string
+	// Expiration is the expiration time of the temporary credentials, zero for the long term ones.
+	Expiration time.Time
+}
+
+// awsKMS wraps the data keys with a key of AWS KMS, the requests are sent to the JSON API of AWS KMS signed with AWS
+// Signature Version 4.
+type awsKMS struct {
+	keyID    string
+	region   string
+	endpoint string
+	// stsEndpoint is the endpoint of AWS STS to obtain the credentials with a web identity token.
+	stsEndpoint string
+	client      *http.Client
+	lock        sync.Mutex
+	credentials *awsCredentials
+	// now returns the current time, it's replaced in the tests
+	now func() time.Time
+}
+
+func newAWSKMS(settings dfv1.AWSKMS) (KMS, error) {
+	k := &awsKMS{
+		keyID:       settings.KeyID,
+		region:      settings.Region,
+		endpoint:    strings.TrimSuffix(settings.GetEndpoint(), "/"),
+		stsEndpoint: fmt.Sprintf("https://sts.%s.amazonaws.com", settings.Region),
+		client:      kmsHTTPClient(http.DefaultTransport),
+		now:         time.Now,
+	}
+	if settings.AccessKeyID != nil && settings.SecretAccessKey != nil {
+		accessKeyID, err := sharedutil.GetSecretFromVolume(settings.AccessKeyID)
+		if err != nil {
+			return nil, err
+		}
+		secretAccessKey, err := sharedutil.GetSecretFromVolume(settings.SecretAccessKey)
+		if err != nil {
+			return nil, err
+		}
+		k.credentials = &awsCredentials{AccessKeyID: accessKeyID, SecretAccessKey: secretAccessKey}
+	} else if accessKeyID := os.Getenv("AWS_ACCESS_KEY_ID"); accessKeyID != "" {
+		k.credentials = &awsCredentials{AccessKeyID: accessKeyID, SecretAccessKey: os.Getenv("AWS_SECRET_ACCESS_KEY"), SessionToken: os.Getenv("AWS_SESSION_TOKEN")}
+	} else if os.Getenv("AWS_ROLE_ARN") == "" || os.Getenv("AWS_WEB_IDENTITY_TOKEN_FILE") == "" {
+		return nil, fmt.Errorf("no aws credentials, neither the access keys nor the web identity token are provided")
+	}
+	return k, nil
+}
+
+func (k *awsKMS) Name() string {
+	return "aws"
+}
+
+func (k *awsKMS) Wrap(ctx context.Context, dataKey []byte) ([]byte, error) {
+	var res struct {
+		CiphertextBlob []byte `json:"CiphertextBlob"`
+	}
+	if err := k.call(ctx, "Encrypt", map[string]interface{}{"KeyId": k.keyID, "Plaintext": dataKey}, &res); err != nil {
+		return nil, err
+	}
+	return res.CiphertextBlob, nil
+}
+
+// Unwrap decrypts the data key, the KMS key is told by the metadata in the wrapped data key, so that the data keys
+// wrapped before changing the key ID could still be unwrapped.
+func (k *awsKMS) Unwrap(ctx context.Context, wrapped []byte) ([]byte, error) {
+	var res struct {
+		Plaintext []byte `json:"Plaintext"`
+	}
+	if err := k.call(ctx, "Decrypt", map[string]interface{}{"CiphertextBlob": wrapped}, &res); err != nil {
+		return nil, err
+	}
+	return res.Plaintext, nil
+}
+
+// call calls an action of the AWS KMS API.
+func (k *awsKMS) call(ctx context.Context, action string, input interface{}, output interface{}) error {
+	creds, err := k.getCredentials(ctx)
+	if err != nil {
+		return err
+	}
+	body, err := json.Marshal(input)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, k.endpoint+"/", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-amz-json-1.1")
+	req.Header.Set("X-Amz-Target", "TrentService."+action)
+	signAWSRequest(req, body, creds, k.region, "kms", k.now())
+	res, err := k.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to call aws kms %s, %w", action, err)
+	}
+	defer func() { _ = res.Body.Close() }()
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to call aws kms %s, %w", action, responseError(res))
+	}
+	return json.NewDecoder(res.Body).Decode(output)
+}
+
+// getCredentials returns the credentials, the temporary ones obtained with the web identity token are refreshed
+// before they expire.
+func (k *awsKMS) getCredentials(ctx context.Context) (awsCredentials, error) {
+	k.lock.Lock()
+	defer k.lock.Unlock()
+	if k.credentials != nil && (k.credentials.Expiration.IsZero() || k.now().Add(awsCredentialsExpiryWindow).Before(k.credentials.Expiration)) {
+		return *k.credentials, nil
+	}
+	creds, err := k.assumeRoleWithWebIdentity(ctx)
+	if err != nil {
+		return awsCredentials{}, err
+	}
+	k.credentials = creds
+	return *creds, nil
+}
+
+// assumeRoleWithWebIdentityResponse is the response of the AssumeRoleWithWebIdentity action of AWS STS.
+type assumeRoleWithWebIdentityResponse struct {
+	Credentials struct {
+		AccessKeyID     string    `xml:"AccessKeyId"`
+		SecretAccessKey string    `xml:"SecretAccessKey"`
+		SessionToken    string    `xml:"SessionToken"`
+		Expiration      time.Time `xml:"Expiration"`
+	} `xml:"AssumeRoleWithWebIdentityResult>Credentials"`
+}
+
+// assumeRoleWithWebIdentity obtains the temporary credentials of the role of IAM Roles for Service Accounts, with the
+// projected service account token. The request doesn't need to be signed.
+func (k *awsKMS) assumeRoleWithWebIdentity(ctx context.Context) (*awsCredentials, error) {
+	token, err := os.ReadFile(os.Getenv("AWS_WEB_IDENTITY_TOKEN_FILE"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the web identity token, %w", err)
+	}
+	form := url.Values{
+		"Action":           {"AssumeRoleWithWebIdentity"},
+		"Version":          {"2011-06-15"},
+		"RoleArn":          {os.Getenv("AWS_ROLE_ARN")},
+		"RoleSessionName":  {fmt.Sprintf("numaflow-%d", k.now().UnixNano())},
+		"WebIdentityToken": {strings.TrimSpace(string(token))},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, k.stsEndpoint+"/", strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	res, err := k.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to assume role with web identity, %w", err)
+	}
+	defer func() { _ = res.Body.Close() }()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to assume role with web identity, %w", responseError(res))
+	}
+	var result assumeRoleWithWebIdentityResponse
+	if err := xml.NewDecoder(res.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode the response of assuming role with web identity, %w", err)
+	}
+	c := result.Credentials
+	return &awsCredentials{AccessKeyID: c.AccessKeyID, SecretAccessKey: c.SecretAccessKey, SessionToken: c.SessionToken, Expiration: c.Expiration}, nil
+}
+
+// signAWSRequest signs the request with AWS Signature Version 4, all the headers set to the request are signed.
+func signAWSRequest(req *http.Request, body []byte, creds awsCredentials, region, service string, now time.Time) {
+	now = now.UTC()
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	req.Header.Set("X-Amz-Date", amzDate)
+	if creds.SessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", creds.SessionToken)
+	}
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		headers[strings.ToLower(name)] = strings.TrimSpace(strings.Join(values, ","))
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+	path := req.URL.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		path,
+		req.URL.RawQuery,
+		canonicalHeaders.String(),
+		signedHeaders,
+		hashHex(body),
+	}, "\n")
+	scope := strings.Join([]string{date, region, service, "aws4_request"}, "/")
+	stringToSign := strings.Join([]string{awsAlgorithm, amzDate, scope, hashHex([]byte(canonicalRequest))}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+creds.SecretAccessKey), date)
+	key = hmacSHA256(key, region)
+	key = hmacSHA256(key, service)
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s", awsAlgorithm, creds.AccessKeyID, scope, signedHeaders, signature))
+}
+
+func hashHex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	_, _ = h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+func responseError(res *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+	return fmt.Errorf("unexpected status code %d, %s", res.StatusCode, strings.TrimSpace(string(body)))
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encryption
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/jwt"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	sharedutil "github.com/numaproj/numaflow/pkg/shared/util"
+)
+
+const (
+	// gcpKMSScope is the OAuth 2.0 scope of Google Cloud KMS.
+	gcpKMSScope = "https://www.googleapis.com/auth/cloudkms"
+	// gcpTokenURL is the default token URL of the service account keys.
+	gcpTokenURL = "https://oauth2.googleapis.com/token"
+	// gcpMetadataTokenURL is the URL of the metadata server to obtain the token of the service account of the pods.
+	gcpMetadataTokenURL = "http://metadata.google.internal/computeMetadata/v1/instance/service-accounts/default/token"
+)
+
+// gcpKMS wraps the data keys with a key of Google Cloud KMS, the requests are sent to the REST API of Google Cloud KMS.
+type gcpKMS struct {
+	keyName  string
+	endpoint string
+	client   *http.Client
+}
+
+func newGCPKMS(settings dfv1.GCPKMS) (KMS, error) {
+	var tokenSource oauth2.TokenSource
+	if settings.Credentials != nil {
+		credentials, err := sharedutil.GetSecretFromVolume(settings.Credentials)
+		if err != nil {
+			return nil, err
+		}
+		var key struct {
+			ClientEmail  string `json:"client_email"`
+			PrivateKey   string `json:"private_key"`
+			PrivateKeyID string `json:"private_key_id"`
+			TokenURI     string `json:"token_uri"`
+		}
+		if err := json.Unmarshal([]byte(credentials), &key); err != nil {
+			return nil, fmt.Errorf("failed to parse the service account key in secret %q, %w", settings.Credentials.Name, err)
+		}
+		if key.TokenURI == "" {
+			key.TokenURI = gcpTokenURL
+		}
+		config := &jwt.Config{
+			Email:        key.ClientEmail,
+			PrivateKey:   []byte(key.PrivateKey),
+			PrivateKeyID: key.PrivateKeyID,
+			Scopes:       []string{gcpKMSScope},
+			TokenURL:     key.TokenURI,
+		}
+		tokenSource = config.TokenSource(context.Background())
+	} else {
+		tokenSource = oauth2.ReuseTokenSource(nil, &metadataTokenSource{client: kmsHTTPClient(http.DefaultTransport), url: gcpMetadataTokenURL})
+	}
+	return &gcpKMS{
+		keyName:  settings.KeyName,
+		endpoint: strings.TrimSuffix(settings.GetEndpoint(), "/"),
+		client:   kmsHTTPClient(&oauth2.Transport{Source: tokenSource, Base: http.DefaultTransport}),
+	}, nil
+}
+
+func (k *gcpKMS) Name() string {
+	return "gcp"
+}
+
+func (k *gcpKMS) Wrap(ctx context.Context, dataKey []byte) ([]byte, error) {
+	var res struct {
+		Ciphertext []byte `json:"ciphertext"`
+	}
+	if err := k.call(ctx, "encrypt", map[string]interface{}{"plaintext": dataKey}, &res); err != nil {
+		return nil, err
+	}
+	return res.Ciphertext, nil
+}
+
+// Unwrap decrypts the data key, the version of the KMS key is told by the wrapped data key, so that the data keys
+// wrapped by the versions before the rotation could still be unwrapped.
+func (k *gcpKMS) Unwrap(ctx context.Context, wrapped []byte) ([]byte, error) {
+	var res struct {
+		Plaintext []byte `json:"plaintext"`
+	}
+	if err := k.call(ctx, "decrypt", map[string]interface{}{"ciphertext": wrapped}, &res); err != nil {
+		return nil, err
+	}
+	return res.Plaintext, nil
+}
+
+// call calls a method of the crypto key in the Google Cloud KMS API.
+func (k *gcpKMS) call(ctx context.Context, method string, input interface{}, output interface{}) error {
+	body, err := json.Marshal(input)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/v1/%s:%s", k.endpoint, k.keyName, method), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	res, err := k.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to call gcp kms %s, %w", method, err)
+	}
+	defer func() { _ = res.Body.Close() }()
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to call gcp kms %s, %w", method, responseError(res))
+	}
+	return json.NewDecoder(res.Body).Decode(output)
+}
+
+// metadataTokenSource obtains the token of the service account of the pods from the metadata server.
+type metadataTokenSource struct {
+	client *http.Client
+	url    string
+}
+
+func (s *metadataTokenSource) Token() (*oauth2.Token, error) {
+	req, err := http.NewRequest(http.MethodGet, s.url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Metadata-Flavor", "Google")
+	res, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the token from the metadata server, %w", err)
+	}
+	defer func() { _ = res.Body.Close() }()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get the token from the metadata server, %w", responseError(res))
+	}
+	var token struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+		TokenType   string `json:"token_type"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&token); err != nil {
+		return nil, fmt.Errorf("failed to decode the token from the metadata server, %w", err)
+	}
+	return &oauth2.Token{
+		AccessToken: token.AccessToken,
+		TokenType:   token.TokenType,
+		Expiry:      time.Now().Add(time.Duration(token.ExpiresIn) * time.Second),
+	}, nil
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encryption
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	sharedutil "github.com/numaproj/numaflow/pkg/shared/util"
+)
+
+const (
+	// masterKeySize is the size of a static master key, for AES-256.
+	masterKeySize = 32
+	// fingerprintSize is the size of the fingerprint of a master key, which is carried in the wrapped data keys to
+	// tell which master key to unwrap them with.
+	fingerprintSize = 8
+)
+
+// staticKMS wraps the data keys with AES-256-GCM master keys.
+type staticKMS struct {
+	current     cipher.AEAD
+	fingerprint string
+	// keys are the master keys by their fingerprints, including the current one.
+	keys map[string]cipher.AEAD
+}
+
+func newStaticKMS(settings dfv1.StaticKMS) (KMS, error) {
+	current, err := readMasterKey(settings.Key)
+	if err != nil {
+		return nil, err
+	}
+	var previous [][]byte
+	if settings.PreviousKey != nil {
+		key, err := readMasterKey(settings.PreviousKey)
+		if err != nil {
+			return nil, err
+		}
+		previous = append(previous, key)
+	}
+	return newStaticKMSWithKeys(current, previous...)
+}
+
+// readMasterKey reads a base64 encoded master key from the mounted secret.
+func readMasterKey(selector *corev1.SecretKeySelector) ([]byte, error) {
+	value, err := sharedutil.GetSecretFromVolume(selector)
+	if err != nil {
+		return nil, err
+	}
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(value))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode the master key in secret %q, %w", selector.Name, err)
+	}
+	return key, nil
+}
+
+// newStaticKMSWithKeys returns a static KMS wrapping the data keys with the current master key, and unwrapping them
+// with any of the keys.
+func newStaticKMSWithKeys(current []byte, previous ...[]byte) (*staticKMS, error) {
+	k := &staticKMS{keys: make(map[string]cipher.AEAD)}
+	for i, key := range append([][]byte{current}, previous...) {
+		if len(key) != masterKeySize {
+			return nil, fmt.Errorf("invalid master key size %d, it should be %d bytes", len(key), masterKeySize)
+		}
+		aead, err := newAEAD(key)
+		if err != nil {
+			return nil, err
+		}
+		fingerprint := masterKeyFingerprint(key)
+		k.keys[fingerprint] = aead
+		if i == 0 {
+			k.current, k.fingerprint = aead, fingerprint
+		}
+	}
+	return k, nil
+}
+
+func masterKeyFingerprint(key []byte) string {
+	sum := sha256.Sum256(key)
+	return string(sum[:fingerprintSize])
+}
+
+func (k *staticKMS) Name() string {
+	return "static"
+}
+
+// Wrap encrypts the data key with the current master key, the result is the fingerprint of the master key, followed
+// by the nonce and the encrypted data key.
+func (k *staticKMS) Wrap(_ context.Context, dataKey []byte) ([]byte, error) {
+	nonce := make([]byte, k.current.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	result := append([]byte(k.fingerprint), nonce...)
+	return k.current.Seal(result, nonce, dataKey, []byte(k.fingerprint)), nil
+}
+
+func (k *staticKMS) Unwrap(_ context.Context, wrapped []byte) ([]byte, error) {
+	if len(wrapped) < fingerprintSize+k.current.NonceSize() {
+		return nil, fmt.Errorf("invalid wrapped data key size %d", len(wrapped))
+	}
+	fingerprint := wrapped[:fingerprintSize]
+	aead, ok := k.keys[string(fingerprint)]
+	if !ok {
+		return nil, fmt.Errorf("the data key is wrapped by an unknown master key %x", fingerprint)
+	}
+	nonce := wrapped[fingerprintSize : fingerprintSize+aead.NonceSize()]
+	return aead.Open(nil, nonce, wrapped[fingerprintSize+aead.NonceSize():], fingerprint)
+}
+
+// newAEAD returns an AES-GCM cipher with the key.
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
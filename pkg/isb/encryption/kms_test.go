@@ -0,0 +1,190 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encryption
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStaticKMS(t *testing.T) {
+	ctx := context.Background()
+	oldKey := bytes.Repeat([]byte{1}, masterKeySize)
+	newKey := bytes.Repeat([]byte{2}, masterKeySize)
+	dataKey := bytes.Repeat([]byte{3}, dataKeySize)
+
+	_, err := newStaticKMSWithKeys([]byte("short"))
+	assert.Error(t, err)
+
+	oldKMS, err := newStaticKMSWithKeys(oldKey)
+	assert.NoError(t, err)
+	wrappedByOld, err := oldKMS.Wrap(ctx, dataKey)
+	assert.NoError(t, err)
+
+	// After the rotation, the data keys wrapped by the previous master key are still unwrapped.
+	rotated, err := newStaticKMSWithKeys(newKey, oldKey)
+	assert.NoError(t, err)
+	unwrapped, err := rotated.Unwrap(ctx, wrappedByOld)
+	assert.NoError(t, err)
+	assert.Equal(t, dataKey, unwrapped)
+	wrappedByNew, err := rotated.Wrap(ctx, dataKey)
+	assert.NoError(t, err)
+	_, err = oldKMS.Unwrap(ctx, wrappedByNew)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown master key")
+
+	wrappedByNew[len(wrappedByNew)-1] ^= 0xff
+	_, err = rotated.Unwrap(ctx, wrappedByNew)
+	assert.Error(t, err)
+}
+
+func TestAWSKMS(t *testing.T) {
+	ctx := context.Background()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth := r.Header.Get("Authorization")
+		assert.True(t, strings.HasPrefix(auth, "AWS4-HMAC-SHA256 Credential=AKID/20231001/us-west-2/kms/aws4_request, SignedHeaders=content-type;host;x-amz-date;x-amz-security-token;x-amz-target, Signature="))
+		assert.Equal(t, "session", r.Header.Get("X-Amz-Security-Token"))
+		body, _ := io.ReadAll(r.Body)
+		var req map[string][]byte
+		switch r.Header.Get("X-Amz-Target") {
+		case "TrentService.Encrypt":
+			var input struct {
+				KeyId     string
+				Plaintext []byte
+			}
+			assert.NoError(t, json.Unmarshal(body, &input))
+			assert.Equal(t, "alias/numaflow", input.KeyId)
+			req = map[string][]byte{"CiphertextBlob": append([]byte("wrapped-"), input.Plaintext...)}
+		case "TrentService.Decrypt":
+			var input struct {
+				CiphertextBlob []byte
+			}
+			assert.NoError(t, json.Unmarshal(body, &input))
+			req = map[string][]byte{"Plaintext": bytes.TrimPrefix(input.CiphertextBlob, []byte("wrapped-"))}
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(req)
+	}))
+	defer server.Close()
+
+	k := &awsKMS{
+		keyID:       "alias/numaflow",
+		region:      "us-west-2",
+		endpoint:    server.URL,
+		client:      server.Client(),
+		credentials: &awsCredentials{AccessKeyID: "AKID", SecretAccessKey: "SECRET", SessionToken: "session"},
+		now:         func() time.Time { return time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC) },
+	}
+	wrapped, err := k.Wrap(ctx, []byte("data-key"))
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("wrapped-data-key"), wrapped)
+	unwrapped, err := k.Unwrap(ctx, wrapped)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("data-key"), unwrapped)
+}
+
+func TestAWSKMS_WebIdentity(t *testing.T) {
+	ctx := context.Background()
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	assert.NoError(t, os.WriteFile(tokenFile, []byte("web-identity-token\n"), 0600))
+	t.Setenv("AWS_ROLE_ARN", "arn:aws:iam::123456789012:role/numaflow")
+	t.Setenv("AWS_WEB_IDENTITY_TOKEN_FILE", tokenFile)
+	now := time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)
+	var assumed int
+	sts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assumed++
+		assert.NoError(t, r.ParseForm())
+		assert.Equal(t, "AssumeRoleWithWebIdentity", r.Form.Get("Action"))
+		assert.Equal(t, "arn:aws:iam::123456789012:role/numaflow", r.Form.Get("RoleArn"))
+		assert.Equal(t, "web-identity-token", r.Form.Get("WebIdentityToken"))
+		_, _ = w.Write([]byte(`<AssumeRoleWithWebIdentityResponse><AssumeRoleWithWebIdentityResult><Credentials>
+<AccessKeyId>ASIA</AccessKeyId><SecretAccessKey>SECRET</SecretAccessKey><SessionToken>session</SessionToken>
+<Expiration>2023-10-01T01:00:00Z</Expiration></Credentials></AssumeRoleWithWebIdentityResult></AssumeRoleWithWebIdentityResponse>`))
+	}))
+	defer sts.Close()
+	k := &awsKMS{stsEndpoint: sts.URL, client: sts.Client(), now: func() time.Time { return now }}
+
+	creds, err := k.getCredentials(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "ASIA", creds.AccessKeyID)
+	assert.Equal(t, "session", creds.SessionToken)
+	_, err = k.getCredentials(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, assumed)
+	// The credentials are refreshed before they expire.
+	now = now.Add(56 * time.Minute)
+	_, err = k.getCredentials(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, assumed)
+}
+
+func TestGCPKMS(t *testing.T) {
+	ctx := context.Background()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var input map[string][]byte
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&input))
+		switch r.URL.Path {
+		case "/v1/projects/p/locations/global/keyRings/r/cryptoKeys/k:encrypt":
+			_ = json.NewEncoder(w).Encode(map[string][]byte{"ciphertext": append([]byte("wrapped-"), input["plaintext"]...)})
+		case "/v1/projects/p/locations/global/keyRings/r/cryptoKeys/k:decrypt":
+			_ = json.NewEncoder(w).Encode(map[string][]byte{"plaintext": bytes.TrimPrefix(input["ciphertext"], []byte("wrapped-"))})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	k := &gcpKMS{keyName: "projects/p/locations/global/keyRings/r/cryptoKeys/k", endpoint: server.URL, client: server.Client()}
+	wrapped, err := k.Wrap(ctx, []byte("data-key"))
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("wrapped-data-key"), wrapped)
+	unwrapped, err := k.Unwrap(ctx, wrapped)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("data-key"), unwrapped)
+
+	k.keyName = "projects/p/locations/global/keyRings/r/cryptoKeys/unknown"
+	_, err = k.Wrap(ctx, []byte("data-key"))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "unexpected status code 404")
+}
+
+func TestMetadataTokenSource(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Google", r.Header.Get("Metadata-Flavor"))
+		_, _ = w.Write([]byte(`{"access_token":"token","expires_in":3600,"token_type":"Bearer"}`))
+	}))
+	defer server.Close()
+	s := &metadataTokenSource{client: server.Client(), url: server.URL}
+	token, err := s.Token()
+	assert.NoError(t, err)
+	assert.Equal(t, "token", token.AccessToken)
+	assert.Equal(t, "Bearer", token.TokenType)
+	assert.True(t, token.Expiry.After(time.Now().Add(59*time.Minute)))
+}
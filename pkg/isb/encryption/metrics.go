@@ -0,0 +1,57 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encryption
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// decryptionFailures is used to indicate the number of the messages failing to be decrypted
+var decryptionFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "isb_encryption",
+	Name:      "decryption_failures_total",
+	Help:      "Total number of the messages failing to be decrypted, the corrupted ones are dropped, and the ones with the data keys failing to be unwrapped are left for redelivery",
+}, []string{"buffer", "reason"})
+
+// unencrypted is used to indicate the number of the messages not encrypted, which are processed as they are
+var unencrypted = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "isb_encryption",
+	Name:      "unencrypted_total",
+	Help:      "Total number of the messages not encrypted, which are processed as they are",
+}, []string{"buffer"})
+
+// encryptErrors is used to indicate the number of errors encrypting the messages
+var encryptErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "isb_encryption",
+	Name:      "encrypt_error_total",
+	Help:      "Total number of errors encrypting the messages",
+}, []string{"buffer"})
+
+// kmsRequests is used to indicate the number of the requests to the KMS wrapping or unwrapping the data keys
+var kmsRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "isb_encryption",
+	Name:      "kms_requests_total",
+	Help:      "Total number of the requests to the KMS wrapping or unwrapping the data keys",
+}, []string{"provider", "operation", "result"})
+
+// dataKeyRotations is used to indicate the number of the data keys generated by the writers
+var dataKeyRotations = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "isb_encryption",
+	Name:      "data_key_rotations_total",
+	Help:      "Total number of the data keys generated by the writers",
+}, []string{"provider"})
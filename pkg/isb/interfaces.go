@@ -43,6 +43,16 @@ type BufferWriter interface {
 	Write(context.Context, []Message) ([]Offset, []error)
 }
 
+// TransactionalBufferWriter is a BufferWriter writing the messages in transactions. The messages written for a batch
+// are committed only after the batch is acked from the buffer it's read from, and are discarded if the batch fails.
+type TransactionalBufferWriter interface {
+	BufferWriter
+	// CommitTransaction commits the messages written since the last commit or abort.
+	CommitTransaction(context.Context) error
+	// AbortTransaction discards the messages written since the last commit or abort.
+	AbortTransaction(context.Context) error
+}
+
 // BufferReader is the buffer from which we are reading.
 type BufferReader interface {
 	BufferReaderInformation
@@ -50,6 +50,43 @@ type MessageInfo struct {
 	// MessageKind == Data, IsLate is used to indicate if the message is a late data (assignment happens at source)
 	// MessageKind == WMB, value is ignored
 	IsLate bool
+	// SchemaVersion when
+	// MessageKind == Data represents the schema version of the payload, which is carried from the source to the sink,
+	// and could be changed by the transformers
+	// MessageKind == WMB, value is ignored
+	SchemaVersion string
+	// TraceContext when
+	// MessageKind == Data represents the W3C trace context (traceparent) of the message, it's only set when tracing
+	// is enabled for the pipeline
+	// MessageKind == WMB, value is ignored
+	TraceContext string
+	// Origin when
+	// MessageKind == Data represents the name of the vertex which wrote the message to the buffer, it's used to tell
+	// which inbound edge a message comes from, e.g. by a join
+	// MessageKind == WMB, value is ignored
+	Origin string
+	// Signature is the signature of the message with the key ID, for both of the MessageKinds. It's only set when
+	// message signing is enabled for the pipeline, and it's cleared once the message is verified by the reader.
+	Signature string
+	// IngestTime when
+	// MessageKind == Data represents the time the message was read by the source vertex, which is carried to the
+	// sink and used by the message TTL of the edges
+	// MessageKind == WMB, value is ignored
+	IngestTime time.Time
+	// Checksum is the checksum of the message with the algorithm, for both of the MessageKinds. It's only set when
+	// message checksums are enabled for the pipeline, and it's cleared once the message is verified by the reader.
+	Checksum string
+	// DataKey when
+	// MessageKind == Data represents the data key encrypting the payload, wrapped by the KMS of the pipeline. It's only
+	// set when message encryption is enabled for the pipeline, and it's cleared once the payload is decrypted by the
+	// reader.
+	// MessageKind == WMB, value is ignored
+	DataKey string
+	// Headers when
+	// MessageKind == Data represents the user headers of the message, e.g. the record headers read by a source, which
+	// are carried to the sink
+	// MessageKind == WMB, value is ignored
+	Headers map[string]string
 }
 
 // MessageMetadata is the metadata of the message
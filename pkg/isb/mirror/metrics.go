@@ -0,0 +1,36 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mirror
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// mirrored is used to indicate the number of the messages mirrored to the mirror target of an edge
+var mirrored = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "isb",
+	Name:      "mirrored_messages_total",
+	Help:      "Total number of the messages mirrored to the mirror target of the edge",
+}, []string{"edge"})
+
+// dropped is used to indicate the number of the messages not mirrored, by the reason
+var dropped = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "isb",
+	Name:      "mirror_dropped_messages_total",
+	Help:      "Total number of the messages not mirrored to the mirror target of the edge, by the reason: rate, backpressure or write",
+}, []string{"edge", "reason"})
@@ -0,0 +1,184 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package mirror writes rate limited copies of the messages forwarded to the edges with a mirror to the buffers of a
+// vertex of another pipeline, e.g. a staging pipeline. The copies over the rate, or not accepted by the mirror target
+// in time, are dropped, so that a slow or missing mirror target never slows down the production traffic.
+package mirror
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+const (
+	// queueSize is the max number of the copies waiting to be written to the mirror target.
+	queueSize = 1000
+	// batchSize is the max number of the copies written to the mirror target at once.
+	batchSize = 100
+	// writeTimeout is the timeout of writing a batch of copies to the mirror target.
+	writeTimeout = 5 * time.Second
+)
+
+// WriterFunc creates a writer for a partition of the buffers of the mirror target.
+type WriterFunc func(bufferName string, partitionIdx int32) (isb.BufferWriter, error)
+
+// mirror samples the messages written to an edge and writes them to the buffers of the mirror target.
+type mirror struct {
+	edgeName string
+	writers  []isb.BufferWriter
+	limiter  *rate.Limiter
+	queue    chan isb.Message
+	// next is the index of the writer to write the next batch to.
+	next int
+	log  *zap.SugaredLogger
+}
+
+func newMirror(ctx context.Context, edgeName string, writers []isb.BufferWriter, ratePerSecond int) *mirror {
+	return &mirror{
+		edgeName: edgeName,
+		writers:  writers,
+		limiter:  rate.NewLimiter(rate.Limit(ratePerSecond), ratePerSecond),
+		queue:    make(chan isb.Message, queueSize),
+		log:      logging.FromContext(ctx).With("edge", edgeName),
+	}
+}
+
+// offer queues the copies of the messages within the rate without blocking, the rest are dropped.
+func (m *mirror) offer(messages []isb.Message) {
+	for _, msg := range messages {
+		if !m.limiter.Allow() {
+			dropped.WithLabelValues(m.edgeName, "rate").Inc()
+			continue
+		}
+		select {
+		case m.queue <- msg:
+		default:
+			dropped.WithLabelValues(m.edgeName, "backpressure").Inc()
+		}
+	}
+}
+
+// run writes the queued copies to the mirror target until the context is done, the writers are closed on exit.
+func (m *mirror) run(ctx context.Context) {
+	defer func() {
+		for _, w := range m.writers {
+			if err := w.Close(); err != nil {
+				m.log.Errorw("Failed to close the mirror writer", zap.String("buffer", w.GetName()), zap.Error(err))
+			}
+		}
+	}()
+	batch := make([]isb.Message, 0, batchSize)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg := <-m.queue:
+			batch = append(batch[:0], msg)
+		drain:
+			for len(batch) < batchSize {
+				select {
+				case msg = <-m.queue:
+					batch = append(batch, msg)
+				default:
+					break drain
+				}
+			}
+			m.write(ctx, batch)
+		}
+	}
+}
+
+// write writes a batch of copies to the partitions of the mirror target in a round-robin manner, the failed ones
+// are dropped.
+func (m *mirror) write(ctx context.Context, batch []isb.Message) {
+	w := m.writers[m.next]
+	m.next = (m.next + 1) % len(m.writers)
+	writeCtx, cancel := context.WithTimeout(ctx, writeTimeout)
+	defer cancel()
+	_, errs := w.Write(writeCtx, batch)
+	var failed int
+	var lastErr error
+	for _, err := range errs {
+		if err != nil {
+			failed++
+			lastErr = err
+		}
+	}
+	mirrored.WithLabelValues(m.edgeName).Add(float64(len(batch) - failed))
+	if failed > 0 {
+		dropped.WithLabelValues(m.edgeName, "write").Add(float64(failed))
+		m.log.Debugw("Failed to write the copies to the mirror target", zap.String("buffer", w.GetName()), zap.Int("count", failed), zap.Error(lastErr))
+	}
+}
+
+// mirroringWriter offers the copies of the data messages written to the buffer to the mirror of the edge.
+type mirroringWriter struct {
+	isb.BufferWriter
+	mirror *mirror
+}
+
+func (w *mirroringWriter) Write(ctx context.Context, messages []isb.Message) ([]isb.Offset, []error) {
+	offsets, errs := w.BufferWriter.Write(ctx, messages)
+	written := make([]isb.Message, 0, len(messages))
+	for i, msg := range messages {
+		if msg.Kind != isb.Data || (i < len(errs) && errs[i] != nil) {
+			continue
+		}
+		written = append(written, msg)
+	}
+	w.mirror.offer(written)
+	return offsets, errs
+}
+
+// NewMirroringWriters wraps the writers of the edges with a mirror, keyed by the to vertices, to mirror the messages
+// written to them. The writers of the mirror targets are created with newWriter, and the writers are returned as they
+// are if none of the edges has a mirror.
+func NewMirroringWriters(ctx context.Context, writers map[string][]isb.BufferWriter, vertex *dfv1.Vertex, newWriter WriterFunc) (map[string][]isb.BufferWriter, error) {
+	result := make(map[string][]isb.BufferWriter, len(writers))
+	for to, edgeWriters := range writers {
+		result[to] = edgeWriters
+	}
+	for _, e := range vertex.Spec.ToEdges {
+		if e.Mirror == nil {
+			continue
+		}
+		var mirrorWriters []isb.BufferWriter
+		for partitionIdx, buffer := range dfv1.GenerateBufferNames(vertex.Namespace, e.Mirror.Pipeline, e.Mirror.Vertex, e.Mirror.GetPartitions()) {
+			w, err := newWriter(buffer, int32(partitionIdx))
+			if err != nil {
+				return nil, err
+			}
+			mirrorWriters = append(mirrorWriters, w)
+		}
+		m := newMirror(ctx, e.GetEdgeName(), mirrorWriters, e.Mirror.GetRatePerSecond())
+		go m.run(ctx)
+		wrapped := make([]isb.BufferWriter, len(writers[e.To]))
+		for i, w := range writers[e.To] {
+			wrapped[i] = &mirroringWriter{BufferWriter: w, mirror: m}
+		}
+		result[e.To] = wrapped
+		logging.FromContext(ctx).Infow("Mirroring the edge", zap.String("edge", e.GetEdgeName()), zap.String("pipeline", e.Mirror.Pipeline), zap.String("vertex", e.Mirror.Vertex), zap.Int("ratePerSecond", e.Mirror.GetRatePerSecond()))
+	}
+	return result, nil
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mirror
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/atomic"
+	"k8s.io/utils/pointer"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/isb/stores/simplebuffer"
+	"github.com/numaproj/numaflow/pkg/isb/testutils"
+)
+
+// countingWriter counts the messages written.
+type countingWriter struct {
+	*simplebuffer.InMemoryBuffer
+	written *atomic.Int64
+}
+
+func (w countingWriter) Write(ctx context.Context, messages []isb.Message) ([]isb.Offset, []error) {
+	w.written.Add(int64(len(messages)))
+	return w.InMemoryBuffer.Write(ctx, messages)
+}
+
+func TestNewMirroringWriters(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	vertex := &dfv1.Vertex{Spec: dfv1.VertexSpec{
+		PipelineName: "prod",
+		ToEdges: []dfv1.CombinedEdge{
+			{Edge: dfv1.Edge{From: "in", To: "cat", Mirror: &dfv1.EdgeMirror{Pipeline: "staging", Vertex: "cat", Partitions: pointer.Int32(2), RatePerSecond: pointer.Uint32(3)}}},
+			{Edge: dfv1.Edge{From: "in", To: "out"}},
+		},
+	}}
+	vertex.Namespace = "test-ns"
+	cat := simplebuffer.NewInMemoryBuffer("cat", 100, 0)
+	out := simplebuffer.NewInMemoryBuffer("out", 100, 0)
+	staging := make(map[string]isb.BufferWriter)
+	written := atomic.NewInt64(0)
+	writers, err := NewMirroringWriters(ctx, map[string][]isb.BufferWriter{"cat": {cat}, "out": {out}}, vertex, func(bufferName string, partitionIdx int32) (isb.BufferWriter, error) {
+		w := countingWriter{InMemoryBuffer: simplebuffer.NewInMemoryBuffer(bufferName, 100, partitionIdx), written: written}
+		staging[bufferName] = w
+		return w, nil
+	})
+	assert.NoError(t, err)
+	assert.Len(t, staging, 2)
+	assert.Contains(t, staging, "test-ns-staging-cat-0")
+	assert.Contains(t, staging, "test-ns-staging-cat-1")
+	assert.Same(t, out, writers["out"][0])
+
+	messages := testutils.BuildTestWriteMessages(10, time.Now())
+	_, errs := writers["cat"][0].Write(ctx, messages)
+	for _, e := range errs {
+		assert.NoError(t, e)
+	}
+	// Only the messages within the burst of the rate are mirrored.
+	assert.Eventually(t, func() bool {
+		return written.Load() == 3
+	}, 5*time.Second, 10*time.Millisecond)
+
+	unchanged, err := NewMirroringWriters(ctx, map[string][]isb.BufferWriter{"out": {out}}, &dfv1.Vertex{}, nil)
+	assert.NoError(t, err)
+	assert.Same(t, out, unchanged["out"][0])
+}
+
+func TestMirrorDropsOnBackpressure(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	target := simplebuffer.NewInMemoryBuffer("target", 2, 0)
+	m := newMirror(ctx, "in-cat", []isb.BufferWriter{target}, 10*queueSize)
+	// The queue is full without a running mirror, so the copies over the queue size are dropped instead of blocking.
+	m.offer(testutils.BuildTestWriteMessages(queueSize+5, time.Now()))
+	assert.Len(t, m.queue, queueSize)
+	// The copies not accepted by the target are dropped.
+	m.write(ctx, testutils.BuildTestWriteMessages(5, time.Now()))
+	assert.True(t, target.IsFull())
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package record
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/numaproj/numaflow/pkg/isb"
+)
+
+// header is the first bytes of a recording object, the last byte is the version of the format.
+var header = []byte{'N', 'F', 'R', 'E', 'C', 1}
+
+// Batch is a batch of messages returned by a read of a buffer partition.
+type Batch struct {
+	ReadAt   time.Time
+	Messages []*isb.ReadMessage
+}
+
+type batchPreamble struct {
+	ReadAt int64
+	Count  int32
+}
+
+type entryPreamble struct {
+	OffsetLen    int32
+	Watermark    int64
+	NumDelivered uint64
+	MessageLen   int32
+}
+
+// zeroTime is the encoded zero time, which has no Unix nanoseconds.
+const zeroTime = math.MinInt64
+
+func encodeTime(t time.Time) int64 {
+	if t.IsZero() {
+		return zeroTime
+	}
+	return t.UnixNano()
+}
+
+func decodeTime(n int64) time.Time {
+	if n == zeroTime {
+		return time.Time{}
+	}
+	return time.Unix(0, n)
+}
+
+// encodeBatch appends a batch to a recording object.
+func encodeBatch(buf *bytes.Buffer, batch Batch) error {
+	if err := binary.Write(buf, binary.LittleEndian, batchPreamble{ReadAt: encodeTime(batch.ReadAt), Count: int32(len(batch.Messages))}); err != nil {
+		return err
+	}
+	for _, m := range batch.Messages {
+		message, err := m.Message.MarshalBinary()
+		if err != nil {
+			return err
+		}
+		var offset string
+		if m.ReadOffset != nil {
+			offset = m.ReadOffset.String()
+		}
+		preamble := entryPreamble{
+			OffsetLen:    int32(len(offset)),
+			Watermark:    encodeTime(m.Watermark),
+			NumDelivered: m.Metadata.NumDelivered,
+			MessageLen:   int32(len(message)),
+		}
+		if err := binary.Write(buf, binary.LittleEndian, preamble); err != nil {
+			return err
+		}
+		buf.WriteString(offset)
+		buf.Write(message)
+	}
+	return nil
+}
+
+// Encode encodes the batches as a recording object.
+func Encode(batches ...Batch) ([]byte, error) {
+	buf := bytes.NewBuffer(append([]byte(nil), header...))
+	for _, b := range batches {
+		if err := encodeBatch(buf, b); err != nil {
+			return nil, err
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// Decode decodes the batches of a recording object. The offsets of the messages are decoded as isb.SimpleStringOffset.
+func Decode(data []byte) ([]Batch, error) {
+	if !bytes.HasPrefix(data, header) {
+		return nil, fmt.Errorf("not a recording of a supported version")
+	}
+	r := bytes.NewReader(data[len(header):])
+	var batches []Batch
+	for r.Len() > 0 {
+		var bp batchPreamble
+		if err := binary.Read(r, binary.LittleEndian, &bp); err != nil {
+			return nil, fmt.Errorf("failed to read the batch, %w", err)
+		}
+		batch := Batch{ReadAt: decodeTime(bp.ReadAt), Messages: make([]*isb.ReadMessage, 0, bp.Count)}
+		for i := 0; i < int(bp.Count); i++ {
+			m, err := decodeEntry(r)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read the message %d of the batch %d, %w", i, len(batches), err)
+			}
+			batch.Messages = append(batch.Messages, m)
+		}
+		batches = append(batches, batch)
+	}
+	return batches, nil
+}
+
+func decodeEntry(r *bytes.Reader) (*isb.ReadMessage, error) {
+	var ep entryPreamble
+	if err := binary.Read(r, binary.LittleEndian, &ep); err != nil {
+		return nil, err
+	}
+	if ep.OffsetLen < 0 || ep.MessageLen < 0 || int(ep.OffsetLen)+int(ep.MessageLen) > r.Len() {
+		return nil, fmt.Errorf("unexpected end of the recording")
+	}
+	offset := make([]byte, ep.OffsetLen)
+	_, _ = r.Read(offset)
+	message := make([]byte, ep.MessageLen)
+	_, _ = r.Read(message)
+	m := &isb.ReadMessage{
+		ReadOffset: isb.SimpleStringOffset(func() string { return string(offset) }),
+		Watermark:  decodeTime(ep.Watermark),
+		Metadata:   isb.MessageMetadata{NumDelivered: ep.NumDelivered},
+	}
+	if err := m.Message.UnmarshalBinary(message); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package record
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/isb/testutils"
+)
+
+func TestEncode(t *testing.T) {
+	messages := testutils.BuildTestReadMessages(3, time.Unix(1636470000, 123456789))
+	messages[1].Watermark = time.Unix(1636470000, 1000)
+	messages[1].Metadata.NumDelivered = 2
+	batches := []Batch{
+		{ReadAt: time.Unix(1700000000, 1), Messages: []*isb.ReadMessage{&messages[0], &messages[1]}},
+		{ReadAt: time.Unix(1700000001, 1), Messages: []*isb.ReadMessage{&messages[2]}},
+	}
+	data, err := Encode(batches...)
+	assert.NoError(t, err)
+	decoded, err := Decode(data)
+	assert.NoError(t, err)
+	assert.Len(t, decoded, 2)
+	for i, b := range batches {
+		assert.True(t, b.ReadAt.Equal(decoded[i].ReadAt))
+		assert.Len(t, decoded[i].Messages, len(b.Messages))
+		for j, m := range b.Messages {
+			d := decoded[i].Messages[j]
+			assert.Equal(t, m.ID, d.ID)
+			assert.Equal(t, m.Keys, d.Keys)
+			assert.Equal(t, m.Payload, d.Payload)
+			assert.Equal(t, m.ReadOffset.String(), d.ReadOffset.String())
+			assert.True(t, m.Watermark.Equal(d.Watermark))
+			assert.Equal(t, m.Metadata.NumDelivered, d.Metadata.NumDelivered)
+		}
+	}
+}
+
+func TestDecode(t *testing.T) {
+	_, err := Decode([]byte("not a recording"))
+	assert.Error(t, err)
+	_, err = Decode(append(append([]byte(nil), header...), 1, 2, 3))
+	assert.Error(t, err)
+}
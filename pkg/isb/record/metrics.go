@@ -0,0 +1,36 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package record
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// recordedMessages is used to indicate the number of the messages recorded for replaying
+var recordedMessages = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "isb",
+	Name:      "recorded_messages_total",
+	Help:      "Total number of the input messages recorded for replaying",
+}, []string{"buffer"})
+
+// recordingWriteErrors is used to indicate the number of the recording objects failed to be written
+var recordingWriteErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "isb",
+	Name:      "recording_write_errors_total",
+	Help:      "Total number of the recording objects failed to be written to the object storage",
+}, []string{"buffer"})
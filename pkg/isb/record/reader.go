@@ -0,0 +1,284 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package record records the input messages of a vertex to an object storage, the batches read from each buffer
+// partition are written in the exact order they are read, so that they can be replayed through the same UDF to
+// reproduce a problem. The recording is bounded by the number of the messages and the time, and it stops early
+// instead of slowing down the vertex if the object storage can't keep up.
+package record
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/shared/objectstore"
+	sharedutil "github.com/numaproj/numaflow/pkg/shared/util"
+)
+
+const (
+	// chunkSize is the size of the recorded batches buffered before they are written as an object.
+	chunkSize = 1024 * 1024
+	// flushInterval is the max time the recorded batches are buffered before they are written.
+	flushInterval = 10 * time.Second
+	// queueSize is the max number of the objects waiting to be written, the recording stops if it's exceeded.
+	queueSize = 8
+	// writeTimeout is the timeout of writing an object.
+	writeTimeout = 30 * time.Second
+	// closeTimeout is the max time to wait for the pending objects to be written when the reader is closed.
+	closeTimeout = time.Minute
+)
+
+// ObjectKeyPrefix returns the prefix of the keys of the objects recorded from a buffer partition by a replica, the
+// objects are numbered from 0 in the order they are recorded.
+func ObjectKeyPrefix(prefix string, replica int32, startTime time.Time, bufferName string) string {
+	return path.Join(prefix, fmt.Sprintf("%d", replica), startTime.UTC().Format("20060102T150405Z"), bufferName)
+}
+
+type object struct {
+	key  string
+	data []byte
+}
+
+// recorder encodes the batches read from a buffer partition and writes them to the object storage.
+type recorder struct {
+	store       objectstore.Store
+	bufferName  string
+	keyPrefix   string
+	maxMessages int
+	deadline    time.Time
+
+	lock      sync.Mutex
+	buf       *bytes.Buffer
+	recorded  int
+	seq       int
+	lastFlush time.Time
+	stopped   bool
+
+	queue chan object
+	done  chan struct{}
+	log   *zap.SugaredLogger
+}
+
+func newRecorder(ctx context.Context, store objectstore.Store, bufferName, keyPrefix string, maxMessages int, deadline time.Time) *recorder {
+	r := &recorder{
+		store:       store,
+		bufferName:  bufferName,
+		keyPrefix:   keyPrefix,
+		maxMessages: maxMessages,
+		deadline:    deadline,
+		buf:         bytes.NewBuffer(append([]byte(nil), header...)),
+		lastFlush:   time.Now(),
+		queue:       make(chan object, queueSize),
+		done:        make(chan struct{}),
+		log:         logging.FromContext(ctx).With("buffer", bufferName),
+	}
+	go r.run(ctx)
+	return r
+}
+
+// record encodes a batch, only the messages within the limits are recorded.
+func (r *recorder) record(messages []*isb.ReadMessage, now time.Time) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.stopped || len(messages) == 0 {
+		return
+	}
+	if now.After(r.deadline) {
+		r.stop()
+		return
+	}
+	if remaining := r.maxMessages - r.recorded; len(messages) > remaining {
+		messages = messages[:remaining]
+	}
+	if err := encodeBatch(r.buf, Batch{ReadAt: now, Messages: messages}); err != nil {
+		r.log.Errorw("Failed to encode the batch, stopping the recording", zap.Error(err))
+		r.stop()
+		return
+	}
+	r.recorded += len(messages)
+	recordedMessages.WithLabelValues(r.bufferName).Add(float64(len(messages)))
+	switch {
+	case r.recorded >= r.maxMessages:
+		r.stop()
+	case r.buf.Len() >= chunkSize || now.Sub(r.lastFlush) >= flushInterval:
+		r.flush(now)
+	}
+}
+
+// flush queues the buffered batches to be written as an object, the recording stops if the queue is full, so that the
+// recorded sequence is always a prefix of the read one. It should be called with the lock held.
+func (r *recorder) flush(now time.Time) {
+	r.lastFlush = now
+	if r.buf.Len() == len(header) {
+		return
+	}
+	o := object{key: fmt.Sprintf("%s/%06d.rec", r.keyPrefix, r.seq), data: r.buf.Bytes()}
+	r.buf = bytes.NewBuffer(append([]byte(nil), header...))
+	select {
+	case r.queue <- o:
+		r.seq++
+	default:
+		r.log.Warnw("The object storage can't keep up with the recording, stopping it", zap.Int("recorded", r.recorded))
+		r.stopped = true
+		close(r.queue)
+	}
+}
+
+// stop flushes the buffered batches and stops the recording. It should be called with the lock held.
+func (r *recorder) stop() {
+	if r.stopped {
+		return
+	}
+	r.flush(time.Now())
+	if r.stopped {
+		return
+	}
+	r.stopped = true
+	close(r.queue)
+	r.log.Infow("Stopped recording the input messages", zap.Int("recorded", r.recorded), zap.String("keyPrefix", r.keyPrefix))
+}
+
+// run writes the queued objects, and flushes the buffered batches periodically, until the recording stops and all
+// the objects are written.
+func (r *recorder) run(ctx context.Context) {
+	defer close(r.done)
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+	ctxDone := ctx.Done()
+	for {
+		select {
+		case o, ok := <-r.queue:
+			if !ok {
+				return
+			}
+			r.write(o)
+		case now := <-ticker.C:
+			r.lock.Lock()
+			if !r.stopped {
+				if now.After(r.deadline) {
+					r.stop()
+				} else if now.Sub(r.lastFlush) >= flushInterval {
+					r.flush(now)
+				}
+			}
+			r.lock.Unlock()
+		case <-ctxDone:
+			ctxDone = nil
+			r.lock.Lock()
+			r.stop()
+			r.lock.Unlock()
+		}
+	}
+}
+
+// write writes an object, it's not retried if it fails, so that the following ones are not delayed.
+func (r *recorder) write(o object) {
+	// not using the context of the vertex, so that the last objects are written when it's shutting down
+	ctx, cancel := context.WithTimeout(context.Background(), writeTimeout)
+	defer cancel()
+	if err := r.store.Put(ctx, o.key, o.data); err != nil {
+		recordingWriteErrors.WithLabelValues(r.bufferName).Inc()
+		r.log.Errorw("Failed to write the recording object", zap.String("key", o.key), zap.Error(err))
+	}
+}
+
+// close stops the recording and waits for the pending objects to be written.
+func (r *recorder) close() {
+	r.lock.Lock()
+	r.stop()
+	r.lock.Unlock()
+	select {
+	case <-r.done:
+	case <-time.After(closeTimeout):
+		r.log.Warn("Timed out waiting for the recording objects to be written")
+	}
+}
+
+// recordingReader records the batches read from the buffer partition.
+type recordingReader struct {
+	isb.BufferReader
+	recorder *recorder
+}
+
+func (r *recordingReader) Read(ctx context.Context, count int64) ([]*isb.ReadMessage, error) {
+	messages, err := r.BufferReader.Read(ctx, count)
+	r.recorder.record(messages, time.Now())
+	return messages, err
+}
+
+// Pending returns the pending messages number of the underlying reader.
+func (r *recordingReader) Pending(ctx context.Context) (int64, error) {
+	if x, ok := r.BufferReader.(isb.LagReader); ok {
+		return x.Pending(ctx)
+	}
+	return isb.PendingNotAvailable, nil
+}
+
+func (r *recordingReader) Close() error {
+	r.recorder.close()
+	return r.BufferReader.Close()
+}
+
+// NewRecordingReaders wraps the readers of the vertex to record the messages read from them, the readers are returned
+// as they are if the vertex doesn't record its input.
+func NewRecordingReaders(ctx context.Context, readers []isb.BufferReader, vertexInstance *dfv1.VertexInstance) ([]isb.BufferReader, error) {
+	vertex := vertexInstance.Vertex
+	x := vertex.Spec.Record
+	if x == nil || x.S3 == nil {
+		return readers, nil
+	}
+	store, err := NewS3Store(x.S3)
+	if err != nil {
+		return nil, err
+	}
+	startTime := time.Now()
+	prefix := x.S3.GetPrefix(vertex.Namespace, vertex.Spec.PipelineName, vertex.Spec.Name)
+	result := make([]isb.BufferReader, len(readers))
+	for i, r := range readers {
+		keyPrefix := ObjectKeyPrefix(prefix, vertexInstance.Replica, startTime, r.GetName())
+		result[i] = &recordingReader{
+			BufferReader: r,
+			recorder:     newRecorder(ctx, store, r.GetName(), keyPrefix, x.GetMaxMessages(), startTime.Add(x.GetDuration())),
+		}
+	}
+	logging.FromContext(ctx).Infow("Recording the input messages", zap.String("prefix", prefix), zap.Int("maxMessages", x.GetMaxMessages()), zap.Duration("duration", x.GetDuration()))
+	return result, nil
+}
+
+// NewS3Store creates a Store of the S3 compatible object storage, the credentials are read from the mounted secrets.
+func NewS3Store(s *dfv1.S3Store) (objectstore.Store, error) {
+	config := objectstore.S3Config{Endpoint: s.Endpoint, Bucket: s.Bucket, Region: s.GetRegion()}
+	var err error
+	if config.AccessKeyID, err = sharedutil.GetSecretFromVolume(s.AccessKeyID); err != nil {
+		return nil, fmt.Errorf("failed to get the access key ID, %w", err)
+	}
+	if config.SecretAccessKey, err = sharedutil.GetSecretFromVolume(s.SecretAccessKey); err != nil {
+		return nil, fmt.Errorf("failed to get the secret access key, %w", err)
+	}
+	if config.TLSConfig, err = sharedutil.GetTLSConfig(s.TLS); err != nil {
+		return nil, fmt.Errorf("failed to get the TLS config, %w", err)
+	}
+	return objectstore.NewS3Store(config)
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package record
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/isb/stores/simplebuffer"
+	"github.com/numaproj/numaflow/pkg/isb/testutils"
+	"github.com/numaproj/numaflow/pkg/shared/objectstore"
+)
+
+func TestNewRecordingReaders(t *testing.T) {
+	buffer := simplebuffer.NewInMemoryBuffer("test", 10, 0)
+	readers, err := NewRecordingReaders(context.Background(), []isb.BufferReader{buffer}, &dfv1.VertexInstance{Vertex: &dfv1.Vertex{}})
+	assert.NoError(t, err)
+	assert.Same(t, buffer, readers[0])
+}
+
+func TestObjectKeyPrefix(t *testing.T) {
+	startTime := time.Date(2023, 10, 1, 1, 2, 3, 0, time.UTC)
+	assert.Equal(t, "ns/pl/v/1/20231001T010203Z/ns-pl-v-0", ObjectKeyPrefix("ns/pl/v", 1, startTime, "ns-pl-v-0"))
+}
+
+func TestRecordingReader(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	buffer := simplebuffer.NewInMemoryBuffer("test-record", 10, 0)
+	store := objectstore.NewFileStore(t.TempDir())
+	reader := &recordingReader{
+		BufferReader: buffer,
+		recorder:     newRecorder(ctx, store, "test-record", "prefix/test-record", 5, time.Now().Add(time.Hour)),
+	}
+	startTime := time.Unix(1636470000, 0)
+	_, errs := buffer.Write(ctx, testutils.BuildTestWriteMessages(10, startTime))
+	for _, err := range errs {
+		assert.NoError(t, err)
+	}
+	// read 3 batches, only the first 5 messages are recorded
+	for _, count := range []int64{2, 2, 2} {
+		messages, err := reader.Read(ctx, count)
+		assert.NoError(t, err)
+		assert.Len(t, messages, int(count))
+	}
+	assert.NoError(t, reader.Close())
+	assert.Equal(t, float64(5), testutil.ToFloat64(recordedMessages.WithLabelValues("test-record")))
+
+	keys, err := store.List(ctx, "prefix/")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"prefix/test-record/000000.rec"}, keys)
+	data, err := store.Get(ctx, keys[0])
+	assert.NoError(t, err)
+	batches, err := Decode(data)
+	assert.NoError(t, err)
+	assert.Len(t, batches, 3)
+	var ids []string
+	for _, b := range batches {
+		for _, m := range b.Messages {
+			ids = append(ids, m.ReadOffset.String())
+		}
+	}
+	assert.Equal(t, 2, len(batches[0].Messages))
+	assert.Equal(t, 1, len(batches[2].Messages))
+	assert.Equal(t, []string{"0-0", "1-0", "2-0", "3-0", "4-0"}, ids)
+	assert.Equal(t, "3-testVertex-0-0", batches[1].Messages[1].ID)
+	assert.Equal(t, startTime.Add(4*time.Minute).UnixMilli(), batches[2].Messages[0].EventTime.UnixMilli())
+
+	// the reads after the recording stops are not recorded
+	_, err = reader.Read(ctx, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, float64(5), testutil.ToFloat64(recordedMessages.WithLabelValues("test-record")))
+}
+
+func TestRecorder_deadline(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	store := objectstore.NewFileStore(t.TempDir())
+	r := newRecorder(ctx, store, "test-deadline", "prefix", 100, time.Now().Add(-time.Second))
+	messages := testutils.BuildTestReadMessages(2, time.Unix(1636470000, 0))
+	r.record([]*isb.ReadMessage{&messages[0], &messages[1]}, time.Now())
+	r.close()
+	keys, err := store.List(ctx, "")
+	assert.NoError(t, err)
+	assert.Empty(t, keys)
+}
@@ -20,6 +20,9 @@ import (
 	"bytes"
 	"encoding/binary"
 	"fmt"
+	"io"
+	"math"
+	"sort"
 	"time"
 )
 
@@ -38,9 +41,64 @@ func (p MessageInfo) MarshalBinary() (data []byte, err error) {
 	if err = binary.Write(buf, binary.LittleEndian, preamble); err != nil {
 		return nil, err
 	}
+	// SchemaVersion, TraceContext, Origin, Signature, IngestTime, Checksum, the sub-millisecond part of EventTime,
+	// DataKey and Headers are written after the preamble in order, so that the MessageInfo written by the older
+	// versions, which doesn't have them, could still be decoded. A field is written if any of the later ones is set,
+	// a zero IngestTime is written as 0.
+	eventSubMilli := subMilli(p.EventTime)
+	withDataKey := p.DataKey != "" || len(p.Headers) > 0
+	withSubMilli := eventSubMilli != 0 || withDataKey
+	withChecksum := p.Checksum != "" || withSubMilli
+	withIngestTime := !p.IngestTime.IsZero() || withChecksum
+	fields := []string{p.SchemaVersion, p.TraceContext, p.Origin, p.Signature}
+	last := len(fields) - 1
+	if !withIngestTime {
+		for last >= 0 && fields[last] == "" {
+			last--
+		}
+	}
+	for _, f := range fields[:last+1] {
+		if err = writeString(buf, f); err != nil {
+			return nil, err
+		}
+	}
+	if withIngestTime {
+		var ingestEpoch int64
+		if !p.IngestTime.IsZero() {
+			ingestEpoch = p.IngestTime.UnixMilli()
+		}
+		if err = binary.Write(buf, binary.LittleEndian, ingestEpoch); err != nil {
+			return nil, err
+		}
+	}
+	if withChecksum {
+		if err = writeString(buf, p.Checksum); err != nil {
+			return nil, err
+		}
+	}
+	if withSubMilli {
+		if err = binary.Write(buf, binary.LittleEndian, eventSubMilli); err != nil {
+			return nil, err
+		}
+	}
+	if withDataKey {
+		if err = writeString(buf, p.DataKey); err != nil {
+			return nil, err
+		}
+	}
+	if len(p.Headers) > 0 {
+		if err = writeHeaders(buf, p.Headers); err != nil {
+			return nil, err
+		}
+	}
 	return buf.Bytes(), nil
 }
 
+// subMilli returns the sub-millisecond part of the time in nanoseconds, which is dropped by UnixMilli.
+func subMilli(t time.Time) int32 {
+	return int32(t.Nanosecond() % int(time.Millisecond))
+}
+
 // UnmarshalBinary decodes MessageInfo from the binary format
 func (p *MessageInfo) UnmarshalBinary(data []byte) (err error) {
 	var r = bytes.NewReader(data)
@@ -51,9 +109,151 @@ func (p *MessageInfo) UnmarshalBinary(data []byte) (err error) {
 	}
 	p.EventTime = time.UnixMilli(preamble.EventEpoch).UTC()
 	p.IsLate = preamble.IsLate
+	for _, f := range []*string{&p.SchemaVersion, &p.TraceContext, &p.Origin, &p.Signature} {
+		if r.Len() == 0 {
+			break
+		}
+		if *f, err = readString(r); err != nil {
+			return err
+		}
+	}
+	if r.Len() > 0 {
+		var ingestEpoch int64
+		if err = binary.Read(r, binary.LittleEndian, &ingestEpoch); err != nil {
+			return err
+		}
+		if ingestEpoch != 0 {
+			p.IngestTime = time.UnixMilli(ingestEpoch).UTC()
+		}
+	}
+	if r.Len() > 0 {
+		if p.Checksum, err = readString(r); err != nil {
+			return err
+		}
+	}
+	if r.Len() > 0 {
+		var eventSubMilli int32
+		if err = binary.Read(r, binary.LittleEndian, &eventSubMilli); err != nil {
+			return err
+		}
+		p.EventTime = p.EventTime.Add(time.Duration(eventSubMilli))
+	}
+	if r.Len() > 0 {
+		if p.DataKey, err = readString(r); err != nil {
+			return err
+		}
+	}
+	if r.Len() > 0 {
+		if p.Headers, err = readHeaders(r); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+// writeString writes a length prefixed string.
+func writeString(buf *bytes.Buffer, s string) error {
+	if len(s) > math.MaxInt16 {
+		return fmt.Errorf("string length %d exceeds the max length %d", len(s), math.MaxInt16)
+	}
+	if err := binary.Write(buf, binary.LittleEndian, int16(len(s))); err != nil {
+		return err
+	}
+	return binary.Write(buf, binary.LittleEndian, []byte(s))
+}
+
+// readString reads a length prefixed string.
+func readString(r *bytes.Reader) (string, error) {
+	var l int16
+	if err := binary.Read(r, binary.LittleEndian, &l); err != nil {
+		return "", err
+	}
+	if l < 0 || int(l) > r.Len() {
+		return "", fmt.Errorf("invalid string length %d, %d bytes remaining", l, r.Len())
+	}
+	var s = make([]byte, l)
+	if err := binary.Read(r, binary.LittleEndian, s); err != nil {
+		return "", err
+	}
+	return string(s), nil
+}
+
+// writeHeaders writes the number of the headers followed by the names and values prefixed by their 32-bit lengths,
+// sorted by the names to get the same bytes for the same headers.
+func writeHeaders(buf *bytes.Buffer, headers map[string]string) error {
+	if uint64(len(headers)) > math.MaxUint32 {
+		return fmt.Errorf("number of headers %d exceeds the max number %d", len(headers), uint32(math.MaxUint32))
+	}
+	names := make([]string, 0, len(headers))
+	for k := range headers {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	if err := binary.Write(buf, binary.LittleEndian, uint32(len(names))); err != nil {
+		return err
+	}
+	for _, k := range names {
+		if err := writeLongString(buf, k); err != nil {
+			return err
+		}
+		if err := writeLongString(buf, headers[k]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readHeaders reads the headers written by writeHeaders.
+func readHeaders(r *bytes.Reader) (map[string]string, error) {
+	var n uint32
+	if err := binary.Read(r, binary.LittleEndian, &n); err != nil {
+		return nil, err
+	}
+	// each header takes at least the two lengths
+	if uint64(n)*8 > uint64(r.Len()) {
+		return nil, fmt.Errorf("invalid number of headers %d, %d bytes remaining", n, r.Len())
+	}
+	headers := make(map[string]string, n)
+	for i := uint32(0); i < n; i++ {
+		k, err := readLongString(r)
+		if err != nil {
+			return nil, err
+		}
+		if headers[k], err = readLongString(r); err != nil {
+			return nil, err
+		}
+	}
+	return headers, nil
+}
+
+// writeLongString writes a string prefixed by its 32-bit length.
+func writeLongString(buf *bytes.Buffer, s string) error {
+	if uint64(len(s)) > math.MaxUint32 {
+		return fmt.Errorf("string length %d exceeds the max length %d", len(s), uint32(math.MaxUint32))
+	}
+	if err := binary.Write(buf, binary.LittleEndian, uint32(len(s))); err != nil {
+		return err
+	}
+	_, err := buf.WriteString(s)
+	return err
+}
+
+// readLongString reads a string written by writeLongString.
+func readLongString(r *bytes.Reader) (string, error) {
+	var l uint32
+	if err := binary.Read(r, binary.LittleEndian, &l); err != nil {
+		return "", err
+	}
+	if uint64(l) > uint64(r.Len()) {
+		return "", fmt.Errorf("invalid string length %d, %d bytes remaining", l, r.Len())
+	}
+	var s = make([]byte, l)
+	if _, err := io.ReadFull(r, s); err != nil {
+		return "", err
+	}
+	return string(s), nil
+}
+
 type headerPreamble struct {
 	MLen    int32
 	MsgKind MessageKind
@@ -293,6 +493,12 @@ func (rm ReadMessage) MarshalBinary() (data []byte, err error) {
 	} else if n != int(preamble.MLen) {
 		return nil, fmt.Errorf("expected to write message size of %d but got %d", preamble.MLen, n)
 	}
+	// the sub-millisecond part of the watermark is written after the message only if it's set, for the compatibility
+	if wmSubMilli := subMilli(rm.Watermark); wmSubMilli != 0 {
+		if err = binary.Write(buf, binary.LittleEndian, wmSubMilli); err != nil {
+			return nil, err
+		}
+	}
 	return buf.Bytes(), nil
 }
 
@@ -320,6 +526,13 @@ func (rm *ReadMessage) UnmarshalBinary(data []byte) (err error) {
 		return preamble.SimpleIntOffset
 	})
 	rm.Watermark = time.UnixMilli(preamble.WMEpoch).UTC()
+	if r.Len() > 0 {
+		var wmSubMilli int32
+		if err = binary.Read(r, binary.LittleEndian, &wmSubMilli); err != nil {
+			return err
+		}
+		rm.Watermark = rm.Watermark.Add(time.Duration(wmSubMilli))
+	}
 	rm.Metadata = MessageMetadata{
 		NumDelivered: preamble.NumDelivered,
 	}
@@ -17,17 +17,29 @@ limitations under the License.
 package isb
 
 import (
+	"bytes"
+	"encoding/binary"
+	"math"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 )
 
 func TestPaneInfo(t *testing.T) {
 	type fields struct {
-		EventTime time.Time
-		StartTime time.Time
-		EndTime   time.Time
-		IsLate    bool
+		EventTime     time.Time
+		StartTime     time.Time
+		EndTime       time.Time
+		IsLate        bool
+		SchemaVersion string
+		TraceContext  string
+		Origin        string
+		Signature     string
+		IngestTime    time.Time
+		Checksum      string
+		DataKey       string
+		Headers       map[string]string
 	}
 	tests := []struct {
 		name               string
@@ -62,12 +74,172 @@ func TestPaneInfo(t *testing.T) {
 			wantMarshalError:   false,
 			wantUnmarshalError: false,
 		},
+		{
+			name: "good_schema_version",
+			fields: fields{
+				EventTime:     time.UnixMilli(1676617200000),
+				SchemaVersion: "v2",
+			},
+			wantData: MessageInfo{
+				EventTime:     time.UnixMilli(1676617200000).UTC(),
+				SchemaVersion: "v2",
+			},
+			wantMarshalError:   false,
+			wantUnmarshalError: false,
+		},
+		{
+			name: "good_trace_context",
+			fields: fields{
+				EventTime:    time.UnixMilli(1676617200000),
+				TraceContext: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+			},
+			wantData: MessageInfo{
+				EventTime:    time.UnixMilli(1676617200000).UTC(),
+				TraceContext: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+			},
+			wantMarshalError:   false,
+			wantUnmarshalError: false,
+		},
+		{
+			name: "good_origin",
+			fields: fields{
+				EventTime: time.UnixMilli(1676617200000),
+				Origin:    "in",
+			},
+			wantData: MessageInfo{
+				EventTime: time.UnixMilli(1676617200000).UTC(),
+				Origin:    "in",
+			},
+			wantMarshalError:   false,
+			wantUnmarshalError: false,
+		},
+		{
+			name: "good_signature",
+			fields: fields{
+				EventTime: time.UnixMilli(1676617200000),
+				Signature: "key-1676617200:c2lnbmF0dXJl",
+			},
+			wantData: MessageInfo{
+				EventTime: time.UnixMilli(1676617200000).UTC(),
+				Signature: "key-1676617200:c2lnbmF0dXJl",
+			},
+			wantMarshalError:   false,
+			wantUnmarshalError: false,
+		},
+		{
+			name: "good_ingest_time",
+			fields: fields{
+				EventTime:  time.UnixMilli(1676617200000),
+				Origin:     "in",
+				IngestTime: time.UnixMilli(1676617201000),
+			},
+			wantData: MessageInfo{
+				EventTime:  time.UnixMilli(1676617200000).UTC(),
+				Origin:     "in",
+				IngestTime: time.UnixMilli(1676617201000).UTC(),
+			},
+			wantMarshalError:   false,
+			wantUnmarshalError: false,
+		},
+		{
+			name: "good_checksum",
+			fields: fields{
+				EventTime: time.UnixMilli(1676617200000),
+				Checksum:  "crc32c:1a2b3c4d",
+			},
+			wantData: MessageInfo{
+				EventTime: time.UnixMilli(1676617200000).UTC(),
+				Checksum:  "crc32c:1a2b3c4d",
+			},
+			wantMarshalError:   false,
+			wantUnmarshalError: false,
+		},
+		{
+			name: "good_data_key",
+			fields: fields{
+				EventTime: time.UnixMilli(1676617200000),
+				DataKey:   "static:0123456789abcdef:d3JhcHBlZA==",
+			},
+			wantData: MessageInfo{
+				EventTime: time.UnixMilli(1676617200000).UTC(),
+				DataKey:   "static:0123456789abcdef:d3JhcHBlZA==",
+			},
+			wantMarshalError:   false,
+			wantUnmarshalError: false,
+		},
+		{
+			name: "good_headers",
+			fields: fields{
+				EventTime: time.UnixMilli(1676617200000),
+				Headers:   map[string]string{"tenant": "t1", "region": "eu"},
+			},
+			wantData: MessageInfo{
+				EventTime: time.UnixMilli(1676617200000).UTC(),
+				Headers:   map[string]string{"tenant": "t1", "region": "eu"},
+			},
+			wantMarshalError:   false,
+			wantUnmarshalError: false,
+		},
+		{
+			name: "good_long_header_value",
+			fields: fields{
+				EventTime: time.UnixMilli(1676617200000),
+				Headers:   map[string]string{"tenant": strings.Repeat("t", math.MaxInt16+1)},
+			},
+			wantData: MessageInfo{
+				EventTime: time.UnixMilli(1676617200000).UTC(),
+				Headers:   map[string]string{"tenant": strings.Repeat("t", math.MaxInt16+1)},
+			},
+			wantMarshalError:   false,
+			wantUnmarshalError: false,
+		},
+		{
+			name: "bad_long_schema_version",
+			fields: fields{
+				EventTime:     time.UnixMilli(1676617200000),
+				SchemaVersion: strings.Repeat("v", math.MaxInt16+1),
+			},
+			wantMarshalError:   true,
+			wantUnmarshalError: true,
+		},
+		{
+			name: "good_sub_millisecond_event_time",
+			fields: fields{
+				EventTime: time.Unix(1676617200, 123456789),
+				Origin:    "in",
+			},
+			wantData: MessageInfo{
+				EventTime: time.Unix(1676617200, 123456789).UTC(),
+				Origin:    "in",
+			},
+			wantMarshalError:   false,
+			wantUnmarshalError: false,
+		},
+		{
+			name: "good_sub_millisecond_event_time_before_epoch",
+			fields: fields{
+				EventTime: time.Unix(-1, 999999),
+			},
+			wantData: MessageInfo{
+				EventTime: time.Unix(-1, 999999).UTC(),
+			},
+			wantMarshalError:   false,
+			wantUnmarshalError: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			p := MessageInfo{
-				EventTime: tt.fields.EventTime,
-				IsLate:    tt.fields.IsLate,
+				EventTime:     tt.fields.EventTime,
+				IsLate:        tt.fields.IsLate,
+				SchemaVersion: tt.fields.SchemaVersion,
+				TraceContext:  tt.fields.TraceContext,
+				Origin:        tt.fields.Origin,
+				Signature:     tt.fields.Signature,
+				IngestTime:    tt.fields.IngestTime,
+				Checksum:      tt.fields.Checksum,
+				DataKey:       tt.fields.DataKey,
+				Headers:       tt.fields.Headers,
 			}
 			gotData, err := p.MarshalBinary()
 			if (err != nil) != tt.wantMarshalError {
@@ -87,6 +259,40 @@ func TestPaneInfo(t *testing.T) {
 	}
 }
 
+func TestMessageInfo_InvalidLengths(t *testing.T) {
+	data, err := MessageInfo{EventTime: time.UnixMilli(1676617200000), SchemaVersion: "v1"}.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the length of the schema version follows the preamble
+	for _, l := range []int16{-1, math.MaxInt16} {
+		corrupted := append([]byte{}, data...)
+		binary.LittleEndian.PutUint16(corrupted[9:], uint16(l))
+		if err := new(MessageInfo).UnmarshalBinary(corrupted); err == nil {
+			t.Errorf("UnmarshalBinary() with string length %d, expected an error", l)
+		}
+	}
+
+	for name, lengths := range map[string][]uint32{
+		"too_many_headers":  {math.MaxUint32},
+		"too_long_name":     {1, math.MaxUint32},
+		"too_long_value":    {1, 1, 'k', math.MaxUint32},
+		"truncated_headers": {2, 1, 'k', 1, 'v'},
+	} {
+		buf := new(bytes.Buffer)
+		for _, l := range lengths {
+			if l == 'k' || l == 'v' {
+				buf.WriteByte(byte(l))
+				continue
+			}
+			_ = binary.Write(buf, binary.LittleEndian, l)
+		}
+		if _, err := readHeaders(bytes.NewReader(buf.Bytes())); err == nil {
+			t.Errorf("readHeaders() %s, expected an error", name)
+		}
+	}
+}
+
 func TestHeader(t *testing.T) {
 	type fields struct {
 		MessageInfo MessageInfo
@@ -351,6 +557,40 @@ func TestReadMessage(t *testing.T) {
 			wantMarshalError:   false,
 			wantUnmarshalError: false,
 		},
+		{
+			name: "good_sub_millisecond",
+			fields: fields{
+				Message: Message{
+					Header: Header{
+						MessageInfo: MessageInfo{EventTime: time.Unix(1676617200, 123456)},
+						Kind:        Data,
+						ID:          "TestID",
+					},
+					Body: Body{Payload: []byte("TestBODY")},
+				},
+				ReadOffset: SimpleIntOffset(func() int64 {
+					return 123
+				}),
+				Watermark: time.Unix(1676613600, 654321),
+			},
+			wantData: ReadMessage{
+				Message: Message{
+					Header: Header{
+						MessageInfo: MessageInfo{EventTime: time.Unix(1676617200, 123456).UTC()},
+						Kind:        Data,
+						ID:          "TestID",
+						Keys:        []string{},
+					},
+					Body: Body{Payload: []byte("TestBODY")},
+				},
+				ReadOffset: SimpleIntOffset(func() int64 {
+					return 123
+				}),
+				Watermark: time.Unix(1676613600, 654321).UTC(),
+			},
+			wantMarshalError:   false,
+			wantUnmarshalError: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
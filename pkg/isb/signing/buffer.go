@@ -0,0 +1,133 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signing
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+// signingWriter signs the messages before writing them to the buffer.
+type signingWriter struct {
+	isb.BufferWriter
+	keyring *Keyring
+}
+
+// NewSigningWriter returns a BufferWriter which signs the messages before writing them with the given writer.
+func NewSigningWriter(w isb.BufferWriter, keyring *Keyring) isb.BufferWriter {
+	return &signingWriter{BufferWriter: w, keyring: keyring}
+}
+
+// Write signs the messages and writes them. The messages are not written if any of them fails to be signed.
+func (w *signingWriter) Write(ctx context.Context, messages []isb.Message) ([]isb.Offset, []error) {
+	signed := make([]isb.Message, len(messages))
+	for i, m := range messages {
+		if err := w.keyring.Sign(&m); err != nil {
+			signErrors.WithLabelValues(w.GetName()).Inc()
+			errs := make([]error, len(messages))
+			for j := range errs {
+				errs[j] = err
+			}
+			return make([]isb.Offset, len(messages)), errs
+		}
+		signed[i] = m
+	}
+	return w.BufferWriter.Write(ctx, signed)
+}
+
+// verifyingReader verifies the messages read from the buffer, the invalid ones are acknowledged and dropped.
+type verifyingReader struct {
+	isb.BufferReader
+	keyring *Keyring
+	log     *zap.SugaredLogger
+}
+
+// NewVerifyingReader returns a BufferReader which verifies the messages read with the given reader.
+func NewVerifyingReader(ctx context.Context, r isb.BufferReader, keyring *Keyring) isb.BufferReader {
+	return &verifyingReader{BufferReader: r, keyring: keyring, log: logging.FromContext(ctx).With("buffer", r.GetName())}
+}
+
+// Read reads the messages and returns the valid ones. The invalid messages are acknowledged, so that they are not
+// redelivered.
+func (r *verifyingReader) Read(ctx context.Context, count int64) ([]*isb.ReadMessage, error) {
+	messages, err := r.BufferReader.Read(ctx, count)
+	valid := make([]*isb.ReadMessage, 0, len(messages))
+	var rejected []isb.Offset
+	for _, m := range messages {
+		if verifyErr := r.keyring.Verify(&m.Message); verifyErr != nil {
+			verificationFailures.WithLabelValues(r.GetName(), failureReason(verifyErr)).Inc()
+			r.log.Warnw("Dropping a message failing the signature verification", zap.String("offset", m.ReadOffset.String()), zap.Error(verifyErr))
+			rejected = append(rejected, m.ReadOffset)
+			continue
+		}
+		valid = append(valid, m)
+	}
+	if len(rejected) > 0 {
+		for _, ackErr := range r.BufferReader.Ack(ctx, rejected) {
+			if ackErr != nil {
+				r.log.Errorw("Failed to ack a message failing the signature verification", zap.Error(ackErr))
+			}
+		}
+	}
+	return valid, err
+}
+
+// Pending returns the pending messages number of the underlying reader.
+func (r *verifyingReader) Pending(ctx context.Context) (int64, error) {
+	if x, ok := r.BufferReader.(isb.LagReader); ok {
+		return x.Pending(ctx)
+	}
+	return isb.PendingNotAvailable, nil
+}
+
+func failureReason(err error) string {
+	switch {
+	case errors.Is(err, ErrUnsigned):
+		return "unsigned"
+	case errors.Is(err, ErrUnknownKey):
+		return "unknown_key"
+	case errors.Is(err, ErrInvalidSignature):
+		return "invalid_signature"
+	default:
+		return "error"
+	}
+}
+
+// NewVerifyingReaders wraps the readers with NewVerifyingReader.
+func NewVerifyingReaders(ctx context.Context, readers []isb.BufferReader, keyring *Keyring) []isb.BufferReader {
+	result := make([]isb.BufferReader, len(readers))
+	for i, r := range readers {
+		result[i] = NewVerifyingReader(ctx, r, keyring)
+	}
+	return result
+}
+
+// NewSigningWriters wraps the writers of each to vertex with NewSigningWriter.
+func NewSigningWriters(writers map[string][]isb.BufferWriter, keyring *Keyring) map[string][]isb.BufferWriter {
+	result := make(map[string][]isb.BufferWriter, len(writers))
+	for toVertex, ws := range writers {
+		for _, w := range ws {
+			result[toVertex] = append(result[toVertex], NewSigningWriter(w, keyring))
+		}
+	}
+	return result
+}
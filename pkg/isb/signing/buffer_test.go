@@ -0,0 +1,80 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signing
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/isb/stores/simplebuffer"
+	"github.com/numaproj/numaflow/pkg/isb/testutils"
+)
+
+// ackRecorder records the acknowledged offsets.
+type ackRecorder struct {
+	*simplebuffer.InMemoryBuffer
+	acked []isb.Offset
+}
+
+func (r *ackRecorder) Ack(ctx context.Context, offsets []isb.Offset) []error {
+	r.acked = append(r.acked, offsets...)
+	return r.InMemoryBuffer.Ack(ctx, offsets)
+}
+
+func TestSigningWriterAndVerifyingReader(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	dir := t.TempDir()
+	keys, _, _, err := RotateKeys(nil, time.Now().Add(-time.Hour), time.Hour)
+	assert.NoError(t, err)
+	writeKeys(t, dir, keys)
+	k, err := NewKeyring(ctx, dir)
+	assert.NoError(t, err)
+
+	buffer := &ackRecorder{InMemoryBuffer: simplebuffer.NewInMemoryBuffer("test", 10, 0)}
+	writer := NewSigningWriter(buffer, k)
+	reader := NewVerifyingReader(ctx, buffer, k)
+
+	messages := testutils.BuildTestWriteMessages(2, time.Now())
+	_, errs := writer.Write(ctx, messages)
+	for _, e := range errs {
+		assert.NoError(t, e)
+	}
+	// A message written without the signing writer is rejected.
+	injected := testutils.BuildTestWriteMessages(1, time.Now())
+	_, errs = buffer.Write(ctx, injected)
+	assert.NoError(t, errs[0])
+
+	readMessages, err := reader.Read(ctx, 3)
+	assert.NoError(t, err)
+	assert.Len(t, readMessages, 2)
+	for i, m := range readMessages {
+		assert.Equal(t, messages[i].Payload, m.Payload)
+		assert.Empty(t, m.Signature)
+	}
+	// The rejected message is acknowledged.
+	assert.Len(t, buffer.acked, 1)
+	assert.Equal(t, "2-0", buffer.acked[0].String())
+
+	pending, err := reader.(isb.LagReader).Pending(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, isb.PendingNotAvailable, pending)
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signing
+
+import (
+	"context"
+	"crypto/hmac"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+// Keyring holds the signing keys of a pipeline. The keys are loaded from the files in a directory, i.e. the mounted
+// secret of the keys, and reloaded periodically to pick up the rotated ones.
+type Keyring struct {
+	dir  string
+	opts *options
+	lock sync.RWMutex
+	keys map[string][]byte
+	// ids are the key IDs sorted by the creation time, the oldest first
+	ids        []string
+	lastLoaded time.Time
+	log        *zap.SugaredLogger
+}
+
+// NewKeyring loads the keys from the directory and returns a Keyring, it returns an error if there's no key.
+// The keys are reloaded until the context is done.
+func NewKeyring(ctx context.Context, dir string, opts ...Option) (*Keyring, error) {
+	o := defaultOptions()
+	for _, opt := range opts {
+		opt(o)
+	}
+	k := &Keyring{dir: dir, opts: o, log: logging.FromContext(ctx).With("signingKeysDir", dir)}
+	if err := k.load(); err != nil {
+		return nil, err
+	}
+	if len(k.ids) == 0 {
+		return nil, fmt.Errorf("no signing key found in %q", dir)
+	}
+	go k.reloadLoop(ctx)
+	return k, nil
+}
+
+func (k *Keyring) reloadLoop(ctx context.Context) {
+	ticker := time.NewTicker(k.opts.reloadInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := k.load(); err != nil {
+				k.log.Errorw("Failed to reload the signing keys", zap.Error(err))
+			}
+		}
+	}
+}
+
+// load reads the keys from the files in the directory, the hidden files created for the mounted secret are ignored.
+func (k *Keyring) load() error {
+	entries, err := os.ReadDir(k.dir)
+	if err != nil {
+		return fmt.Errorf("failed to read the signing keys from %q, %w", k.dir, err)
+	}
+	keys := make(map[string][]byte)
+	for _, e := range entries {
+		if strings.HasPrefix(e.Name(), ".") {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(k.dir, e.Name()))
+		if err != nil {
+			return fmt.Errorf("failed to read the signing key %q, %w", e.Name(), err)
+		}
+		keys[e.Name()] = data
+	}
+	ids := sortedKeyIDs(keys)
+	if len(ids) == 0 {
+		// Keep the loaded keys, the directory might be in the middle of an update.
+		return fmt.Errorf("no signing key found in %q", k.dir)
+	}
+	k.lock.Lock()
+	defer k.lock.Unlock()
+	k.keys = keys
+	k.ids = ids
+	k.lastLoaded = time.Now()
+	return nil
+}
+
+// currentKey returns the newest key which has been created for longer than the activation delay, so that the readers
+// have loaded it. The oldest key is returned if none of them is old enough.
+func (k *Keyring) currentKey() (string, []byte) {
+	k.lock.RLock()
+	defer k.lock.RUnlock()
+	now := time.Now()
+	for i := len(k.ids) - 1; i >= 0; i-- {
+		created, _ := KeyCreationTime(k.ids[i])
+		if !created.Add(k.opts.activationDelay).After(now) {
+			return k.ids[i], k.keys[k.ids[i]]
+		}
+	}
+	return k.ids[0], k.keys[k.ids[0]]
+}
+
+func (k *Keyring) getKey(id string) ([]byte, bool) {
+	k.lock.RLock()
+	defer k.lock.RUnlock()
+	secret, ok := k.keys[id]
+	return secret, ok
+}
+
+// Sign sets the signature of the message with the current key.
+func (k *Keyring) Sign(m *isb.Message) error {
+	id, secret := k.currentKey()
+	signature, err := sign(id, secret, *m)
+	if err != nil {
+		return err
+	}
+	m.Signature = signature
+	return nil
+}
+
+// Verify verifies the signature of the message, and clears it if the message is valid. The keys are reloaded if the
+// message is signed with an unknown key, in case it's just rotated.
+func (k *Keyring) Verify(m *isb.Message) error {
+	id, mac, err := parseSignature(m.Signature)
+	if err != nil {
+		return err
+	}
+	secret, ok := k.getKey(id)
+	if !ok {
+		k.lock.RLock()
+		lastLoaded := k.lastLoaded
+		k.lock.RUnlock()
+		if time.Since(lastLoaded) < k.opts.minReloadInterval {
+			return ErrUnknownKey
+		}
+		if err := k.load(); err != nil {
+			k.log.Errorw("Failed to reload the signing keys", zap.Error(err))
+		}
+		if secret, ok = k.getKey(id); !ok {
+			return ErrUnknownKey
+		}
+	}
+	expected, err := digest(secret, *m)
+	if err != nil {
+		return err
+	}
+	if !hmac.Equal(mac, expected) {
+		return ErrInvalidSignature
+	}
+	m.Signature = ""
+	return nil
+}
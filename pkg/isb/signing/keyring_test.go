@@ -0,0 +1,143 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signing
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/isb/testutils"
+)
+
+func writeKeys(t *testing.T, dir string, keys map[string][]byte) {
+	t.Helper()
+	for id, secret := range keys {
+		assert.NoError(t, os.WriteFile(filepath.Join(dir, id), secret, 0o600))
+	}
+}
+
+func TestNewKeyring(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	dir := t.TempDir()
+	_, err := NewKeyring(ctx, dir)
+	assert.Error(t, err)
+	_, err = NewKeyring(ctx, filepath.Join(dir, "nonexistent"))
+	assert.Error(t, err)
+
+	// Hidden files and invalid key IDs are ignored.
+	assert.NoError(t, os.MkdirAll(filepath.Join(dir, "..data"), 0o700))
+	writeKeys(t, dir, map[string][]byte{"foo": []byte("bar")})
+	_, err = NewKeyring(ctx, dir)
+	assert.Error(t, err)
+
+	keys, _, _, err := RotateKeys(nil, time.Now(), time.Hour)
+	assert.NoError(t, err)
+	writeKeys(t, dir, keys)
+	k, err := NewKeyring(ctx, dir)
+	assert.NoError(t, err)
+	assert.Len(t, k.ids, 1)
+}
+
+func TestKeyring_SignAndVerify(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	dir := t.TempDir()
+	keys, _, _, err := RotateKeys(nil, time.Now().Add(-time.Hour), time.Hour)
+	assert.NoError(t, err)
+	writeKeys(t, dir, keys)
+	k, err := NewKeyring(ctx, dir)
+	assert.NoError(t, err)
+
+	m := testutils.BuildTestWriteMessages(1, time.Now())[0]
+	assert.ErrorIs(t, k.Verify(&m), ErrUnsigned)
+	assert.NoError(t, k.Sign(&m))
+	assert.NotEmpty(t, m.Signature)
+
+	t.Run("valid", func(t *testing.T) {
+		msg := m
+		assert.NoError(t, k.Verify(&msg))
+		assert.Empty(t, msg.Signature)
+	})
+
+	t.Run("tampered payload", func(t *testing.T) {
+		msg := m
+		msg.Payload = []byte("tampered")
+		assert.ErrorIs(t, k.Verify(&msg), ErrInvalidSignature)
+	})
+
+	t.Run("tampered header", func(t *testing.T) {
+		msg := m
+		msg.Keys = []string{"tampered"}
+		assert.ErrorIs(t, k.Verify(&msg), ErrInvalidSignature)
+	})
+
+	t.Run("unknown key", func(t *testing.T) {
+		msg := m
+		other, _, _ := GenerateKey(time.Now())
+		msg.Signature = other + msg.Signature[len(k.ids[0]):]
+		assert.ErrorIs(t, k.Verify(&msg), ErrUnknownKey)
+	})
+}
+
+func TestKeyring_Rotation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	dir := t.TempDir()
+	now := time.Now()
+	keys, _, _, err := RotateKeys(nil, now.Add(-time.Hour), time.Hour)
+	assert.NoError(t, err)
+	writeKeys(t, dir, keys)
+	k, err := NewKeyring(ctx, dir, WithActivationDelay(time.Minute))
+	assert.NoError(t, err)
+	oldID := k.ids[0]
+
+	// The new key is loaded when verifying a message signed with it, but not used for signing before activated.
+	k2Dir := t.TempDir()
+	rotated, changed, _, err := RotateKeys(keys, now, time.Hour)
+	assert.NoError(t, err)
+	assert.True(t, changed)
+	writeKeys(t, k2Dir, rotated)
+	k2, err := NewKeyring(ctx, k2Dir, WithActivationDelay(0))
+	assert.NoError(t, err)
+	m := testutils.BuildTestWriteMessages(1, now)[0]
+	assert.NoError(t, k2.Sign(&m))
+	assert.NotContains(t, m.Signature, oldID)
+
+	k.lastLoaded = time.Time{}
+	writeKeys(t, dir, rotated)
+	assert.NoError(t, k.Verify(&m))
+	assert.Len(t, k.ids, 2)
+
+	m = testutils.BuildTestWriteMessages(1, now)[0]
+	assert.NoError(t, k.Sign(&m))
+	assert.Contains(t, m.Signature, oldID+":")
+	assert.NoError(t, k2.Verify(&m))
+}
+
+func TestFailureReason(t *testing.T) {
+	assert.Equal(t, "unsigned", failureReason(ErrUnsigned))
+	assert.Equal(t, "unknown_key", failureReason(ErrUnknownKey))
+	assert.Equal(t, "invalid_signature", failureReason(ErrInvalidSignature))
+	assert.Equal(t, "error", failureReason(isb.BufferWriteErr{}))
+}
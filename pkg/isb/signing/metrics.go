@@ -0,0 +1,36 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signing
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// verificationFailures is used to indicate the number of the messages failing the signature verification
+var verificationFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "isb_signing",
+	Name:      "verification_failures_total",
+	Help:      "Total number of the messages failing the signature verification, which are dropped",
+}, []string{"buffer", "reason"})
+
+// signErrors is used to indicate the number of errors signing the messages
+var signErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "isb_signing",
+	Name:      "sign_error_total",
+	Help:      "Total number of errors signing the messages",
+}, []string{"buffer"})
@@ -0,0 +1,53 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signing
+
+import "time"
+
+type options struct {
+	// activationDelay is the time after the creation of a key before it's used for signing
+	activationDelay time.Duration
+	// reloadInterval is the interval of reloading the keys
+	reloadInterval time.Duration
+	// minReloadInterval is the minimal interval of reloading the keys for an unknown key
+	minReloadInterval time.Duration
+}
+
+func defaultOptions() *options {
+	return &options{
+		activationDelay:   5 * time.Minute,
+		reloadInterval:    30 * time.Second,
+		minReloadInterval: 5 * time.Second,
+	}
+}
+
+type Option func(*options)
+
+// WithActivationDelay sets the time after the creation of a key before it's used for signing, it needs to be longer
+// than the time for the rotated keys to be propagated to all the pods.
+func WithActivationDelay(d time.Duration) Option {
+	return func(o *options) {
+		o.activationDelay = d
+	}
+}
+
+// WithReloadInterval sets the interval of reloading the keys
+func WithReloadInterval(d time.Duration) Option {
+	return func(o *options) {
+		o.reloadInterval = d
+	}
+}
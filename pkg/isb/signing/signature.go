@@ -0,0 +1,151 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package signing signs the messages written to the inter-step buffers with the keys of a pipeline, and verifies
+// them at the readers, so that the messages not written by the vertices of the pipeline are rejected.
+package signing
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/numaproj/numaflow/pkg/isb"
+)
+
+const (
+	keyIDPrefix = "key-"
+	keySize     = 32
+)
+
+var (
+	ErrUnsigned         = errors.New("message is not signed")
+	ErrUnknownKey       = errors.New("unknown signing key")
+	ErrInvalidSignature = errors.New("invalid signature")
+)
+
+// GenerateKey generates a signing key created at the given time, it returns the ID and the secret of the key.
+func GenerateKey(now time.Time) (string, []byte, error) {
+	secret := make([]byte, keySize)
+	if _, err := rand.Read(secret); err != nil {
+		return "", nil, fmt.Errorf("failed to generate a signing key, %w", err)
+	}
+	return keyIDPrefix + strconv.FormatInt(now.Unix(), 10), secret, nil
+}
+
+// KeyCreationTime returns the creation time of a key by its ID.
+func KeyCreationTime(id string) (time.Time, error) {
+	if !strings.HasPrefix(id, keyIDPrefix) {
+		return time.Time{}, fmt.Errorf("invalid signing key ID %q", id)
+	}
+	sec, err := strconv.ParseInt(strings.TrimPrefix(id, keyIDPrefix), 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid signing key ID %q, %w", id, err)
+	}
+	return time.Unix(sec, 0), nil
+}
+
+// sortedKeyIDs returns the valid key IDs sorted by the creation time, the oldest first.
+func sortedKeyIDs(keys map[string][]byte) []string {
+	var ids []string
+	for id := range keys {
+		if _, err := KeyCreationTime(id); err == nil {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		ti, _ := KeyCreationTime(ids[i])
+		tj, _ := KeyCreationTime(ids[j])
+		return ti.Before(tj)
+	})
+	return ids
+}
+
+// RotateKeys adds a new key if there's no key, or the newest one is older than the rotation interval, only the
+// previous key is kept along with the newest one, so that the messages signed with it could still be verified.
+// It returns the keys, whether they're changed, and the time of the next rotation. No key is added after the first
+// one if the rotation interval is 0.
+func RotateKeys(keys map[string][]byte, now time.Time, interval time.Duration) (map[string][]byte, bool, time.Time, error) {
+	ids := sortedKeyIDs(keys)
+	if len(ids) > 0 {
+		newest, _ := KeyCreationTime(ids[len(ids)-1])
+		if interval <= 0 {
+			return keys, false, time.Time{}, nil
+		}
+		if next := newest.Add(interval); now.Before(next) {
+			return keys, false, next, nil
+		}
+	}
+	id, secret, err := GenerateKey(now)
+	if err != nil {
+		return keys, false, time.Time{}, err
+	}
+	result := map[string][]byte{id: secret}
+	if len(ids) > 0 {
+		// Keep the newest one, which is the previous key after the rotation.
+		result[ids[len(ids)-1]] = keys[ids[len(ids)-1]]
+	}
+	var next time.Time
+	if interval > 0 {
+		next = now.Add(interval)
+	}
+	return result, true, next, nil
+}
+
+// sign returns the signature of a message with a key, it's calculated on the header and the payload of the message,
+// excluding the signature.
+func sign(id string, secret []byte, m isb.Message) (string, error) {
+	mac, err := digest(secret, m)
+	if err != nil {
+		return "", err
+	}
+	return id + ":" + base64.RawStdEncoding.EncodeToString(mac), nil
+}
+
+func digest(secret []byte, m isb.Message) ([]byte, error) {
+	m.Signature = ""
+	header, err := m.Header.MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal the message header, %w", err)
+	}
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(header)
+	mac.Write(m.Payload)
+	return mac.Sum(nil), nil
+}
+
+// parseSignature returns the key ID and the MAC of a signature.
+func parseSignature(signature string) (string, []byte, error) {
+	if signature == "" {
+		return "", nil, ErrUnsigned
+	}
+	id, encoded, ok := strings.Cut(signature, ":")
+	if !ok {
+		return "", nil, ErrInvalidSignature
+	}
+	mac, err := base64.RawStdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", nil, ErrInvalidSignature
+	}
+	return id, mac, nil
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeyCreationTime(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	id, secret, err := GenerateKey(now)
+	assert.NoError(t, err)
+	assert.Equal(t, "key-1700000000", id)
+	assert.Len(t, secret, keySize)
+	created, err := KeyCreationTime(id)
+	assert.NoError(t, err)
+	assert.True(t, now.Equal(created))
+	_, err = KeyCreationTime("foo")
+	assert.Error(t, err)
+	_, err = KeyCreationTime("key-abc")
+	assert.Error(t, err)
+}
+
+func TestRotateKeys(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	keys, changed, next, err := RotateKeys(nil, now, time.Hour)
+	assert.NoError(t, err)
+	assert.True(t, changed)
+	assert.Len(t, keys, 1)
+	assert.True(t, now.Add(time.Hour).Equal(next))
+	first := sortedKeyIDs(keys)[0]
+
+	keys, changed, next, err = RotateKeys(keys, now.Add(30*time.Minute), time.Hour)
+	assert.NoError(t, err)
+	assert.False(t, changed)
+	assert.Len(t, keys, 1)
+	assert.True(t, now.Add(time.Hour).Equal(next))
+
+	keys, changed, _, err = RotateKeys(keys, now.Add(time.Hour), time.Hour)
+	assert.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, []string{first, "key-1700003600"}, sortedKeyIDs(keys))
+
+	keys, changed, _, err = RotateKeys(keys, now.Add(2*time.Hour), time.Hour)
+	assert.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, []string{"key-1700003600", "key-1700007200"}, sortedKeyIDs(keys))
+
+	t.Run("rotation disabled", func(t *testing.T) {
+		keys, changed, next, err := RotateKeys(keys, now.Add(100*time.Hour), 0)
+		assert.NoError(t, err)
+		assert.False(t, changed)
+		assert.True(t, next.IsZero())
+		assert.Len(t, keys, 2)
+	})
+}
+
+func TestParseSignature(t *testing.T) {
+	_, _, err := parseSignature("")
+	assert.ErrorIs(t, err, ErrUnsigned)
+	_, _, err = parseSignature("key-1")
+	assert.ErrorIs(t, err, ErrInvalidSignature)
+	_, _, err = parseSignature("key-1:%%%")
+	assert.ErrorIs(t, err, ErrInvalidSignature)
+	id, mac, err := parseSignature("key-1:YWJj")
+	assert.NoError(t, err)
+	assert.Equal(t, "key-1", id)
+	assert.Equal(t, []byte("abc"), mac)
+}
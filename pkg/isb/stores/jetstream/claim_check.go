@@ -0,0 +1,103 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jetstream
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/nats-io/nats.go"
+
+	"github.com/numaproj/numaflow/pkg/isb"
+)
+
+const (
+	// claimCheckBucketHeader and claimCheckObjectHeader are the headers of a message whose payload is offloaded, they
+	// refer to the object in the claim check object store that keeps the payload.
+	claimCheckBucketHeader = "Numaflow-Claim-Check-Bucket"
+	claimCheckObjectHeader = "Numaflow-Claim-Check-Object"
+)
+
+// claimCheckObjectName returns the name of the object keeping the payload of a message written to a buffer. The name
+// is deterministic with the message ID, so that retrying a write overwrites the same object instead of leaving an
+// orphaned one.
+func claimCheckObjectName(buffer string, msgID string) string {
+	if msgID == "" {
+		msgID = uuid.NewString()
+	}
+	return buffer + "/" + msgID
+}
+
+// offloadPayload puts the payload of the message to the object store, and returns the message with the payload removed
+// and the headers referring to the object.
+func offloadPayload(objectStore nats.ObjectStore, bucket string, buffer string, message isb.Message) (isb.Message, nats.Header, error) {
+	name := claimCheckObjectName(buffer, message.ID)
+	if _, err := objectStore.PutBytes(name, message.Payload); err != nil {
+		return message, nil, fmt.Errorf("failed to offload the payload to the claim check object store %q, %w", bucket, err)
+	}
+	header := nats.Header{}
+	header.Set(claimCheckBucketHeader, bucket)
+	header.Set(claimCheckObjectHeader, name)
+	message.Payload = nil
+	return message, header, nil
+}
+
+// objectStores caches the bound object stores by bucket.
+type objectStores struct {
+	js     nats.JetStreamContext
+	lock   sync.Mutex
+	stores map[string]nats.ObjectStore
+}
+
+func newObjectStores(js nats.JetStreamContext) *objectStores {
+	return &objectStores{js: js, stores: make(map[string]nats.ObjectStore)}
+}
+
+func (o *objectStores) get(bucket string) (nats.ObjectStore, error) {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	if store, ok := o.stores[bucket]; ok {
+		return store, nil
+	}
+	store, err := o.js.ObjectStore(bucket)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind the claim check object store %q, %w", bucket, err)
+	}
+	o.stores[bucket] = store
+	return store, nil
+}
+
+// rehydratePayload gets the offloaded payload of the message if the headers refer to an object, and returns the
+// object store and the object name, which are used to delete the object once the message is acknowledged.
+func (o *objectStores) rehydratePayload(msg *nats.Msg, message *isb.Message) (nats.ObjectStore, string, error) {
+	name := msg.Header.Get(claimCheckObjectHeader)
+	if name == "" {
+		return nil, "", nil
+	}
+	bucket := msg.Header.Get(claimCheckBucketHeader)
+	store, err := o.get(bucket)
+	if err != nil {
+		return nil, "", err
+	}
+	payload, err := store.GetBytes(name)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get the offloaded payload %q from the claim check object store %q, %w", name, bucket, err)
+	}
+	message.Payload = payload
+	return store, name, nil
+}
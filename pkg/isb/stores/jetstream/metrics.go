@@ -83,3 +83,52 @@ var isbWriteTimeout = promauto.NewCounterVec(prometheus.CounterOpts{
 	Name:      "write_timeout_total",
 	Help:      "Total number of jetstream write timeouts",
 }, []string{"buffer"})
+
+// isbClaimCheckOffloaded is used to indicate the number of messages whose payloads are offloaded to the claim check object store
+var isbClaimCheckOffloaded = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "isb_jetstream",
+	Name:      "claim_check_offloaded_total",
+	Help:      "Total number of messages whose payloads are offloaded to the claim check object store",
+}, []string{"buffer"})
+
+// isbClaimCheckMissing is used to indicate the number of messages dropped because their claim check objects are missing
+var isbClaimCheckMissing = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "isb_jetstream",
+	Name:      "claim_check_missing_total",
+	Help:      "Total number of messages dropped because their claim check objects are missing",
+}, []string{"buffer"})
+
+// isbBufferMissing is used to indicate if the stream or the consumer of the buffer is not found, 1 means missing
+var isbBufferMissing = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Subsystem: "isb_jetstream",
+	Name:      "buffer_missing",
+	Help:      "Whether the stream or the consumer of the buffer is not found, 1 means missing",
+}, []string{"buffer"})
+
+// isbBufferRecreated records how many times the stream or the consumer of the buffer is detected as recreated
+var isbBufferRecreated = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "isb_jetstream",
+	Name:      "buffer_recreated_total",
+	Help:      "Total number of times the stream or the consumer of the buffer is detected as recreated",
+}, []string{"buffer"})
+
+// isbWriteRetries records how many messages are republished in the async write mode
+var isbWriteRetries = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "isb_jetstream",
+	Name:      "write_retry_total",
+	Help:      "Total number of messages republished in the async write mode",
+}, []string{"buffer"})
+
+// isbAsyncWritePending is the number of asynchronously published messages waiting for the acknowledgements
+var isbAsyncWritePending = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Subsystem: "isb_jetstream",
+	Name:      "async_write_pending",
+	Help:      "Number of asynchronously published messages waiting for the acknowledgements",
+}, []string{"buffer"})
+
+// isbFireAndForgetLost records how many messages written in the fire-and-forget mode are not acknowledged
+var isbFireAndForgetLost = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "isb_jetstream",
+	Name:      "fire_and_forget_lost_total",
+	Help:      "Total number of messages written in the fire-and-forget mode failed to be acknowledged",
+}, []string{"buffer"})
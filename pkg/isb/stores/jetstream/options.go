@@ -17,6 +17,7 @@ limitations under the License.
 package jetstream
 
 import (
+	"fmt"
 	"time"
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
@@ -32,6 +33,20 @@ type writeOptions struct {
 	refreshInterval time.Duration
 	// bufferFullWritingStrategy is the writing strategy when buffer is full
 	bufferFullWritingStrategy dfv1.BufferFullWritingStrategy
+	// claimCheckStore is the object store the large payloads are offloaded to, claim check is disabled if it's empty
+	claimCheckStore string
+	// claimCheckThreshold is the payload size above which the payload is offloaded
+	claimCheckThreshold int64
+	// writeAckPolicy is how the writes are acknowledged
+	writeAckPolicy dfv1.WriteAckPolicy
+	// strictFIFO publishes the messages one after another
+	strictFIFO bool
+	// maxAsyncInFlight is the max number of the asynchronously published messages waiting for the acknowledgements
+	maxAsyncInFlight int
+	// asyncWriteRetries is the number of times the failed messages of a batch are republished in the async mode
+	asyncWriteRetries int
+	// asyncAckTimeout is the timeout of waiting for the acknowledgements of a batch in the async mode
+	asyncAckTimeout time.Duration
 }
 
 func defaultWriteOptions() *writeOptions {
@@ -40,6 +55,10 @@ func defaultWriteOptions() *writeOptions {
 		bufferUsageLimit:          dfv1.DefaultBufferUsageLimit,
 		refreshInterval:           1 * time.Second,
 		bufferFullWritingStrategy: dfv1.RetryUntilSuccess,
+		writeAckPolicy:            dfv1.WriteAckPolicySync,
+		maxAsyncInFlight:          1024,
+		asyncWriteRetries:         3,
+		asyncAckTimeout:           5 * time.Second,
 	}
 }
 
@@ -77,6 +96,61 @@ func WithBufferFullWritingStrategy(s dfv1.BufferFullWritingStrategy) WriteOption
 	}
 }
 
+// WithClaimCheck enables offloading the payloads larger than the threshold to the object store
+func WithClaimCheck(objectStore string, threshold int64) WriteOption {
+	return func(o *writeOptions) error {
+		o.claimCheckStore = objectStore
+		o.claimCheckThreshold = threshold
+		return nil
+	}
+}
+
+// WithWriteAckPolicy sets how the writes are acknowledged
+func WithWriteAckPolicy(p dfv1.WriteAckPolicy) WriteOption {
+	return func(o *writeOptions) error {
+		o.writeAckPolicy = p
+		return nil
+	}
+}
+
+// WithStrictFIFO sets whether the messages are published one after another, in the order they are given
+func WithStrictFIFO(f bool) WriteOption {
+	return func(o *writeOptions) error {
+		o.strictFIFO = f
+		return nil
+	}
+}
+
+// WithMaxAsyncInFlight sets the max number of the asynchronously published messages waiting for the acknowledgements
+func WithMaxAsyncInFlight(n int) WriteOption {
+	return func(o *writeOptions) error {
+		if n <= 0 {
+			return fmt.Errorf("max async in-flight messages should be positive, got %d", n)
+		}
+		o.maxAsyncInFlight = n
+		return nil
+	}
+}
+
+// WithAsyncWriteRetries sets the number of times the failed messages of a batch are republished in the async mode
+func WithAsyncWriteRetries(retries int) WriteOption {
+	return func(o *writeOptions) error {
+		if retries < 0 {
+			return fmt.Errorf("async write retries should not be negative, got %d", retries)
+		}
+		o.asyncWriteRetries = retries
+		return nil
+	}
+}
+
+// WithAsyncAckTimeout sets the timeout of waiting for the acknowledgements of a batch in the async mode
+func WithAsyncAckTimeout(timeout time.Duration) WriteOption {
+	return func(o *writeOptions) error {
+		o.asyncAckTimeout = timeout
+		return nil
+	}
+}
+
 // options for reading from JetStream
 type readOptions struct {
 	// readTimeOut is the timeout needed for read timeout
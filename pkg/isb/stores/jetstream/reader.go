@@ -32,16 +32,31 @@ import (
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 )
 
+const (
+	// consumerCheckInterval is the interval of checking the consumer when the reads get no messages.
+	consumerCheckInterval = 10 * time.Second
+	// maxConsumerResolveBackoff is the max interval of checking the consumer after it's found missing.
+	maxConsumerResolveBackoff = 30 * time.Second
+)
+
 type jetStreamReader struct {
 	name                   string
 	stream                 string
 	subject                string
 	client                 *jsclient.NATSClient
+	js                     nats.JetStreamContext
 	sub                    *nats.Subscription
 	opts                   *readOptions
+	objectStores           *objectStores
 	inProgressTickDuration time.Duration
 	partitionIdx           int32
-	log                    *zap.SugaredLogger
+	// consumerCreated is the creation time of the consumer subscribed to, which is used to detect the recreation.
+	consumerCreated time.Time
+	// nextConsumerCheck and consumerBackoff are used to check the consumer when the reads get no messages, since
+	// fetching from a deleted consumer only times out.
+	nextConsumerCheck time.Time
+	consumerBackoff   time.Duration
+	log               *zap.SugaredLogger
 }
 
 // NewJetStreamBufferReader is used to provide a new JetStream buffer reader connection
@@ -56,13 +71,15 @@ func NewJetStreamBufferReader(ctx context.Context, client *jsclient.NATSClient,
 		}
 	}
 	reader := &jetStreamReader{
-		name:         name,
-		stream:       stream,
-		subject:      subject,
-		client:       client,
-		partitionIdx: partitionIdx,
-		opts:         o,
-		log:          log,
+		name:              name,
+		stream:            stream,
+		subject:           subject,
+		client:            client,
+		partitionIdx:      partitionIdx,
+		opts:              o,
+		nextConsumerCheck: time.Now().Add(consumerCheckInterval),
+		consumerBackoff:   o.readTimeOut,
+		log:               log,
 	}
 
 	jsContext, err := reader.client.JetStreamContext()
@@ -70,6 +87,9 @@ func NewJetStreamBufferReader(ctx context.Context, client *jsclient.NATSClient,
 		return nil, fmt.Errorf("failed to get JetStream context, %w", err)
 	}
 
+	reader.js = jsContext
+	reader.objectStores = newObjectStores(jsContext)
+
 	consumer, err := jsContext.ConsumerInfo(stream, stream)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get consumer info, %w", err)
@@ -87,6 +107,7 @@ func NewJetStreamBufferReader(ctx context.Context, client *jsclient.NATSClient,
 	}
 
 	reader.sub = sub
+	reader.consumerCreated = consumer.Created
 	reader.inProgressTickDuration = time.Duration(inProgressTickSeconds * int64(time.Second))
 	return reader, nil
 }
@@ -120,6 +141,12 @@ func (jr *jetStreamReader) Read(_ context.Context, count int64) ([]*isb.ReadMess
 		isbReadErrors.With(map[string]string{"buffer": jr.GetName()}).Inc()
 		return nil, fmt.Errorf("failed to fetch messages from jet stream subject %q, %w", jr.subject, err)
 	}
+	if len(msgs) == 0 && time.Now().After(jr.nextConsumerCheck) {
+		if err := jr.checkConsumer(); err != nil {
+			isbReadErrors.With(map[string]string{"buffer": jr.GetName()}).Inc()
+			return nil, err
+		}
+	}
 	for _, msg := range msgs {
 		var m = new(isb.Message)
 		// err should be nil as we have our own marshaller/unmarshaller
@@ -127,12 +154,29 @@ func (jr *jetStreamReader) Read(_ context.Context, count int64) ([]*isb.ReadMess
 		if err != nil {
 			return nil, fmt.Errorf("failed to unmarshal the message into isb.Message, %w", err)
 		}
+		objectStore, objectName, err := jr.objectStores.rehydratePayload(msg, m)
+		if err != nil {
+			if errors.Is(err, nats.ErrObjectNotFound) {
+				// The object is expired by the TTL of the object store, or deleted by the ack of a previous delivery.
+				// Redelivering the message doesn't bring the payload back, so it's dropped instead of blocking the buffer.
+				jr.log.Errorw("Dropping the message whose claim check object is missing", zap.String("msgID", m.ID), zap.Error(err))
+				isbClaimCheckMissing.With(map[string]string{"buffer": jr.GetName()}).Inc()
+				if err := msg.AckSync(); err != nil && !errors.Is(err, nats.ErrMsgAlreadyAckd) && !errors.Is(err, nats.ErrMsgNotFound) {
+					jr.log.Warnw("Failed to ack the message whose claim check object is missing", zap.String("msgID", m.ID), zap.Error(err))
+				}
+				continue
+			}
+			isbReadErrors.With(map[string]string{"buffer": jr.GetName()}).Inc()
+			return nil, err
+		}
 		msgMetadata, err := msg.Metadata()
 		if err != nil {
 			return nil, fmt.Errorf("failed to get jetstream message metadata, %w", err)
 		}
+		readOffset := newOffset(msg, jr.inProgressTickDuration, jr.partitionIdx, jr.log)
+		readOffset.objectStore, readOffset.objectName = objectStore, objectName
 		rm := &isb.ReadMessage{
-			ReadOffset: newOffset(msg, jr.inProgressTickDuration, jr.partitionIdx, jr.log),
+			ReadOffset: readOffset,
 			Message:    *m,
 			Metadata: isb.MessageMetadata{
 				NumDelivered: msgMetadata.NumDelivered,
@@ -143,6 +187,44 @@ func (jr *jetStreamReader) Read(_ context.Context, count int64) ([]*isb.ReadMess
 	return result, nil
 }
 
+// checkConsumer checks if the consumer has been deleted or recreated, it resubscribes to the consumer once it's
+// recreated. The consumer is re-resolved with an exponential backoff when it's missing.
+func (jr *jetStreamReader) checkConsumer() error {
+	labels := map[string]string{"buffer": jr.GetName()}
+	consumer, err := jr.js.ConsumerInfo(jr.stream, jr.stream)
+	if err != nil {
+		if !errors.Is(err, nats.ErrConsumerNotFound) && !errors.Is(err, nats.ErrStreamNotFound) {
+			jr.log.Warnw("Failed to get consumer info in the reader", zap.Error(err))
+			jr.nextConsumerCheck = time.Now().Add(consumerCheckInterval)
+			return nil
+		}
+		isbBufferMissing.With(labels).Set(1)
+		if jr.consumerBackoff *= 2; jr.consumerBackoff > maxConsumerResolveBackoff {
+			jr.consumerBackoff = maxConsumerResolveBackoff
+		}
+		jr.nextConsumerCheck = time.Now().Add(jr.consumerBackoff)
+		return fmt.Errorf("consumer of buffer %q not found, %w", jr.name, err)
+	}
+	isbBufferMissing.With(labels).Set(0)
+	jr.consumerBackoff = jr.opts.readTimeOut
+	jr.nextConsumerCheck = time.Now().Add(consumerCheckInterval)
+	if consumer.Created.Equal(jr.consumerCreated) {
+		return nil
+	}
+	jr.log.Warnw("Consumer was recreated, resubscribing", zap.Time("previousCreated", jr.consumerCreated), zap.Time("created", consumer.Created))
+	isbBufferRecreated.With(labels).Inc()
+	sub, err := jr.client.Subscribe(jr.subject, jr.stream, nats.Bind(jr.stream, jr.stream))
+	if err != nil {
+		return fmt.Errorf("failed to resubscribe to subject %q, %w", jr.subject, err)
+	}
+	if err := jr.sub.Unsubscribe(); err != nil {
+		jr.log.Warnw("Failed to unsubscribe the previous subscription", zap.Error(err))
+	}
+	jr.sub = sub
+	jr.consumerCreated = consumer.Created
+	return nil
+}
+
 func (jr *jetStreamReader) Ack(_ context.Context, offsets []isb.Offset) []error {
 	errs := make([]error, len(offsets))
 	done := make(chan struct{})
@@ -159,6 +241,13 @@ func (jr *jetStreamReader) Ack(_ context.Context, offsets []isb.Offset) []error
 				if !strings.HasPrefix(err.Error(), "nats:") {
 					errs[index] = err
 				}
+				return
+			}
+			if jo, ok := o.(*offset); ok && jo.objectStore != nil {
+				// Best effort, the ones failed to be deleted get expired by the TTL of the object store.
+				if err := jo.objectStore.Delete(jo.objectName); err != nil && !errors.Is(err, nats.ErrObjectNotFound) {
+					jr.log.Warnw("Failed to delete the claim check object", zap.String("object", jo.objectName), zap.Error(err))
+				}
 			}
 		}(idx, o)
 	}
@@ -192,6 +281,9 @@ type offset struct {
 	msg          *nats.Msg
 	partitionIdx int32
 	cancelFunc   context.CancelFunc
+	// objectStore and objectName refer to the offloaded payload of the message, if any
+	objectStore nats.ObjectStore
+	objectName  string
 }
 
 func newOffset(msg *nats.Msg, tickDuration time.Duration, partitionIdx int32, log *zap.SugaredLogger) *offset {
@@ -24,6 +24,7 @@ import (
 	"go.uber.org/goleak"
 
 	"github.com/nats-io/nats.go"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/numaproj/numaflow/pkg/isb"
@@ -130,7 +131,194 @@ func TestJetStreamBufferRead(t *testing.T) {
 
 }
 
+// TestJetStreamBufferReadClaimCheck is used to test reading the messages whose payloads are offloaded
+func TestJetStreamBufferReadClaimCheck(t *testing.T) {
+	s := natstest.RunJetStreamServer(t)
+	defer natstest.ShutdownJetStreamServer(t, s)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Minute)
+	defer cancel()
+
+	defaultJetStreamClient := natstest.JetStreamClient(t, s)
+	defer defaultJetStreamClient.Close()
+	js, err := defaultJetStreamClient.JetStreamContext()
+	assert.NoError(t, err)
+
+	streamName := "testJetStreamBufferReadClaimCheck"
+	addStream(t, js, streamName)
+	defer deleteStream(js, streamName)
+	bucket := streamName + "_CLAIM_CHECK"
+	objectStore, err := js.CreateObjectStore(&nats.ObjectStoreConfig{Bucket: bucket})
+	assert.NoError(t, err)
+	defer func() { _ = js.DeleteObjectStore(bucket) }()
+
+	startTime := time.Unix(1636470000, 0)
+	messages := testutils.BuildTestWriteMessages(int64(2), startTime)
+	threshold := int64(len(messages[0].Payload))
+	messages[1].Payload = append(messages[1].Payload, []byte("-oversized")...)
+
+	bw, err := NewJetStreamBufferWriter(ctx, defaultJetStreamClient, streamName, streamName, streamName, defaultPartitionIdx, WithClaimCheck(bucket, threshold))
+	assert.NoError(t, err)
+	jw, _ := bw.(*jetStreamWriter)
+	defer jw.Close()
+	for jw.isFull.Load() {
+		select {
+		case <-ctx.Done():
+			t.Fatalf("expected not to be full, %s", ctx.Err())
+		default:
+			time.Sleep(1 * time.Millisecond)
+		}
+	}
+	_, errs := jw.Write(ctx, messages)
+	for _, e := range errs {
+		assert.NoError(t, e)
+	}
+	objects, err := objectStore.List()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(objects))
+	assert.Equal(t, claimCheckObjectName(streamName, messages[1].ID), objects[0].Name)
+
+	bufferReader, err := NewJetStreamBufferReader(ctx, defaultJetStreamClient, streamName, streamName, streamName, defaultPartitionIdx)
+	assert.NoError(t, err)
+	fromStep := bufferReader.(*jetStreamReader)
+	defer fromStep.Close()
+
+	readMessages, err := fromStep.Read(ctx, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(readMessages))
+	offsets := make([]isb.Offset, len(readMessages))
+	for idx, m := range readMessages {
+		assert.Equal(t, messages[idx].Payload, m.Payload)
+		offsets[idx] = m.ReadOffset
+	}
+	errs = fromStep.Ack(ctx, offsets)
+	for _, e := range errs {
+		assert.NoError(t, e)
+	}
+	// The offloaded payload should be deleted after acking
+	_, err = objectStore.List()
+	assert.ErrorIs(t, err, nats.ErrNoObjectsFound)
+}
+
+// TestJetStreamBufferReadClaimCheckMissing is used to test reading the messages whose claim check objects are missing
+func TestJetStreamBufferReadClaimCheckMissing(t *testing.T) {
+	s := natstest.RunJetStreamServer(t)
+	defer natstest.ShutdownJetStreamServer(t, s)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Minute)
+	defer cancel()
+
+	defaultJetStreamClient := natstest.JetStreamClient(t, s)
+	defer defaultJetStreamClient.Close()
+	js, err := defaultJetStreamClient.JetStreamContext()
+	assert.NoError(t, err)
+
+	streamName := "testJetStreamBufferReadClaimCheckMissing"
+	addStream(t, js, streamName)
+	defer deleteStream(js, streamName)
+	bucket := streamName + "_CLAIM_CHECK"
+	objectStore, err := js.CreateObjectStore(&nats.ObjectStoreConfig{Bucket: bucket})
+	assert.NoError(t, err)
+	defer func() { _ = js.DeleteObjectStore(bucket) }()
+
+	startTime := time.Unix(1636470000, 0)
+	messages := testutils.BuildTestWriteMessages(int64(3), startTime)
+	threshold := int64(len(messages[0].Payload))
+	messages[1].Payload = append(messages[1].Payload, []byte("-oversized")...)
+
+	bw, err := NewJetStreamBufferWriter(ctx, defaultJetStreamClient, streamName, streamName, streamName, defaultPartitionIdx, WithClaimCheck(bucket, threshold))
+	assert.NoError(t, err)
+	jw, _ := bw.(*jetStreamWriter)
+	defer jw.Close()
+	for jw.isFull.Load() {
+		select {
+		case <-ctx.Done():
+			t.Fatalf("expected not to be full, %s", ctx.Err())
+		default:
+			time.Sleep(1 * time.Millisecond)
+		}
+	}
+	_, errs := jw.Write(ctx, messages)
+	for _, e := range errs {
+		assert.NoError(t, e)
+	}
+	// e.g. expired by the TTL of the object store
+	assert.NoError(t, objectStore.Delete(claimCheckObjectName(streamName, messages[1].ID)))
+
+	bufferReader, err := NewJetStreamBufferReader(ctx, defaultJetStreamClient, streamName, streamName, streamName, defaultPartitionIdx)
+	assert.NoError(t, err)
+	fromStep := bufferReader.(*jetStreamReader)
+	defer fromStep.Close()
+
+	// The message whose object is missing is dropped, the others are read
+	readMessages, err := fromStep.Read(ctx, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(readMessages))
+	assert.ElementsMatch(t, []string{messages[0].ID, messages[2].ID}, []string{readMessages[0].ID, readMessages[1].ID})
+	assert.Equal(t, float64(1), testutil.ToFloat64(isbClaimCheckMissing.With(map[string]string{"buffer": fromStep.GetName()})))
+
+	// The dropped message is acked, so it's not redelivered
+	offsets := []isb.Offset{readMessages[0].ReadOffset, readMessages[1].ReadOffset}
+	for _, e := range fromStep.Ack(ctx, offsets) {
+		assert.NoError(t, e)
+	}
+	consumer, err := js.ConsumerInfo(streamName, streamName)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, consumer.NumAckPending)
+	assert.Equal(t, uint64(0), consumer.NumPending)
+}
+
 // TestGetName is used to test the GetName function
+func TestJetStreamBufferReadConsumerRecreated(t *testing.T) {
+	s := natstest.RunJetStreamServer(t)
+	defer natstest.ShutdownJetStreamServer(t, s)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Minute)
+	defer cancel()
+
+	defaultJetStreamClient := natstest.JetStreamClient(t, s)
+	defer defaultJetStreamClient.Close()
+	js, err := defaultJetStreamClient.JetStreamContext()
+	assert.NoError(t, err)
+
+	streamName := "testJetStreamBufferReadConsumerRecreated"
+	addStream(t, js, streamName)
+	defer deleteStream(js, streamName)
+
+	bufferReader, err := NewJetStreamBufferReader(ctx, defaultJetStreamClient, streamName, streamName, streamName, defaultPartitionIdx, WithReadTimeOut(100*time.Millisecond))
+	assert.NoError(t, err)
+	fromStep := bufferReader.(*jetStreamReader)
+	defer fromStep.Close()
+
+	deleteStream(js, streamName)
+	fromStep.nextConsumerCheck = time.Now()
+	_, err = fromStep.Read(ctx, 1)
+	assert.ErrorContains(t, err, "not found")
+	// backoff before checking again
+	assert.True(t, fromStep.nextConsumerCheck.After(time.Now()))
+
+	addStream(t, js, streamName)
+	previousSub := fromStep.sub
+	fromStep.nextConsumerCheck = time.Now()
+	_, err = fromStep.Read(ctx, 1)
+	assert.NoError(t, err)
+	// resubscribed to the recreated consumer
+	assert.NotEqual(t, previousSub, fromStep.sub)
+
+	_, err = js.Publish(streamName, buildTestPayload(t))
+	assert.NoError(t, err)
+	readMessages, err := fromStep.Read(ctx, 1)
+	assert.NoError(t, err)
+	assert.Len(t, readMessages, 1)
+}
+
+func buildTestPayload(t *testing.T) []byte {
+	msg := testutils.BuildTestWriteMessages(int64(1), time.Unix(1636470000, 0))[0]
+	b, err := msg.MarshalBinary()
+	assert.NoError(t, err)
+	return b
+}
+
 func TestGetName(t *testing.T) {
 	s := natstest.RunJetStreamServer(t)
 	defer natstest.ShutdownJetStreamServer(t, s)
@@ -18,6 +18,7 @@ package jetstream
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sync"
 	"time"
@@ -30,9 +31,11 @@ import (
 	"github.com/numaproj/numaflow/pkg/isb"
 	jsclient "github.com/numaproj/numaflow/pkg/shared/clients/nats"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
-	sharedutil "github.com/numaproj/numaflow/pkg/shared/util"
 )
 
+// maxStreamResolveBackoff is the max interval of checking the stream after it's found missing.
+const maxStreamResolveBackoff = 30 * time.Second
+
 type jetStreamWriter struct {
 	name         string
 	partitionIdx int32
@@ -40,8 +43,15 @@ type jetStreamWriter struct {
 	subject      string
 	client       *jsclient.NATSClient
 	js           nats.JetStreamContext
+	objectStore  nats.ObjectStore
 	opts         *writeOptions
 	isFull       *atomic.Bool
+	// streamMissing is set when the stream is found deleted, the writes fail fast until it's recreated.
+	streamMissing *atomic.Bool
+	// recheck triggers a status check immediately, e.g. when a publishing gets no response from the stream.
+	recheck chan struct{}
+	// lastAckedSeq is the sequence of the latest acknowledged message in the fire-and-forget mode.
+	lastAckedSeq *atomic.Uint64
 	log          *zap.SugaredLogger
 }
 
@@ -56,22 +66,31 @@ func NewJetStreamBufferWriter(ctx context.Context, client *jsclient.NATSClient,
 		}
 	}
 
-	js, err := client.JetStreamContext(nats.PublishAsyncMaxPending(1024))
+	js, err := client.JetStreamContext(nats.PublishAsyncMaxPending(o.maxAsyncInFlight))
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to get JetStream context for writer")
 	}
 
 	result := &jetStreamWriter{
-		name:         name,
-		partitionIdx: partitionIdx,
-		stream:       stream,
-		subject:      subject,
-		client:       client,
-		js:           js,
-		opts:         o,
-		isFull:       atomic.NewBool(true),
-		log:          logging.FromContext(ctx).With("bufferWriter", name).With("stream", stream).With("subject", subject).With("partitionIdx", partitionIdx),
+		name:          name,
+		partitionIdx:  partitionIdx,
+		stream:        stream,
+		subject:       subject,
+		client:        client,
+		js:            js,
+		opts:          o,
+		isFull:        atomic.NewBool(true),
+		streamMissing: atomic.NewBool(false),
+		recheck:       make(chan struct{}, 1),
+		lastAckedSeq:  atomic.NewUint64(0),
+		log:           logging.FromContext(ctx).With("bufferWriter", name).With("stream", stream).With("subject", subject).With("partitionIdx", partitionIdx),
+	}
+
+	if o.claimCheckStore != "" {
+		if result.objectStore, err = js.ObjectStore(o.claimCheckStore); err != nil {
+			return nil, fmt.Errorf("failed to bind the claim check object store %q, %w", o.claimCheckStore, err)
+		}
 	}
 
 	go result.runStatusChecker(ctx)
@@ -86,18 +105,36 @@ func (jw *jetStreamWriter) runStatusChecker(ctx context.Context) {
 		// Let it exit if it fails to start the status checker
 		jw.log.Fatal("Failed to get Jet Stream context, %w", err)
 	}
-	checkStatus := func() {
+	// streamCreated is used to detect the recreation of the stream.
+	var streamCreated time.Time
+	checkStatus := func() error {
 		s, err := js.StreamInfo(jw.stream)
 		if err != nil {
 			isbFullErrors.With(labels).Inc()
+			if errors.Is(err, nats.ErrStreamNotFound) {
+				if !jw.streamMissing.Swap(true) {
+					jw.log.Errorw("Stream not found, writing is paused until it's recreated", zap.Error(err))
+				}
+				isbBufferMissing.With(labels).Set(1)
+				return err
+			}
 			jw.log.Errorw("Failed to get stream info in the writer", zap.Error(err))
-			return
+			return nil
 		}
+		if !streamCreated.IsZero() && !s.Created.Equal(streamCreated) {
+			jw.log.Warnw("Stream was recreated", zap.Time("previousCreated", streamCreated), zap.Time("created", s.Created))
+			isbBufferRecreated.With(labels).Inc()
+		}
+		streamCreated = s.Created
+		if jw.streamMissing.Swap(false) {
+			jw.log.Infow("Stream is found, resuming writing")
+		}
+		isbBufferMissing.With(labels).Set(0)
 		c, err := js.ConsumerInfo(jw.stream, jw.stream)
 		if err != nil {
 			isbFullErrors.With(labels).Inc()
 			jw.log.Errorw("Failed to get consumer info in the writer", zap.Error(err))
-			return
+			return nil
 		}
 		var solidUsage, softUsage float64
 		softUsage = (float64(c.NumPending) + float64(c.NumAckPending)) / float64(jw.opts.maxLength)
@@ -123,21 +160,42 @@ func (jw *jetStreamWriter) runStatusChecker(ctx context.Context) {
 			zap.Any("ackPending", c.NumAckPending), zap.Any("waiting", c.NumWaiting),
 			zap.Any("ackFloorStreamId", c.AckFloor.Stream), zap.Any("deliveredStreamId", c.Delivered.Stream),
 			zap.Float64("solidUsage", solidUsage), zap.Float64("softUsage", softUsage))
+		return nil
 	}
-	checkStatus()
 
-	ticker := time.NewTicker(jw.opts.refreshInterval)
-	defer ticker.Stop()
+	// The stream is re-resolved with an exponential backoff when it's missing.
+	interval := jw.opts.refreshInterval
 	for {
+		if err := checkStatus(); err != nil {
+			if interval *= 2; interval > maxStreamResolveBackoff {
+				interval = maxStreamResolveBackoff
+			}
+		} else {
+			interval = jw.opts.refreshInterval
+		}
+		timer := time.NewTimer(interval)
 		select {
-		case <-ticker.C:
-			checkStatus()
+		case <-timer.C:
+		case <-jw.recheck:
+			timer.Stop()
 		case <-ctx.Done():
+			timer.Stop()
 			return
 		}
 	}
 }
 
+// triggerStatusCheck triggers the status checker if the publishing error indicates the stream might be missing.
+func (jw *jetStreamWriter) triggerStatusCheck(err error) {
+	if !errors.Is(err, nats.ErrNoStreamResponse) && !errors.Is(err, nats.ErrStreamNotFound) {
+		return
+	}
+	select {
+	case jw.recheck <- struct{}{}:
+	default:
+	}
+}
+
 func (jw *jetStreamWriter) GetName() string {
 	return jw.name
 }
@@ -157,6 +215,14 @@ func (jw *jetStreamWriter) Write(ctx context.Context, messages []isb.Message) ([
 	for i := 0; i < len(errs); i++ {
 		errs[i] = fmt.Errorf("unknown error")
 	}
+	if jw.streamMissing.Load() {
+		// fail fast instead of waiting for the publishing acks, the caller retries until the stream is recreated.
+		for i := 0; i < len(errs); i++ {
+			errs[i] = isb.BufferWriteErr{Name: jw.name, Message: fmt.Sprintf("stream %q not found", jw.stream)}
+		}
+		isbWriteErrors.With(labels).Inc()
+		return nil, errs
+	}
 	if jw.isFull.Load() {
 		jw.log.Debugw("Is full")
 		isbFull.With(map[string]string{"buffer": jw.GetName()}).Inc()
@@ -177,70 +243,181 @@ func (jw *jetStreamWriter) Write(ctx context.Context, messages []isb.Message) ([
 		isbWriteErrors.With(labels).Inc()
 		return nil, errs
 	}
-	// TODO: temp env flag for sync/async writing, revisit this later.
-	if sharedutil.LookupEnvStringOr("ISB_ASYNC_WRITE", "false") == "true" {
+	if jw.opts.strictFIFO {
+		return jw.serialWrite(ctx, messages, errs, labels)
+	}
+	switch jw.opts.writeAckPolicy {
+	case v1alpha1.WriteAckPolicyAsync:
 		return jw.asyncWrite(ctx, messages, errs, labels)
+	case v1alpha1.WriteAckPolicyFireAndForget:
+		return jw.fireAndForgetWrite(ctx, messages, errs, labels)
+	default:
+		return jw.syncWrite(ctx, messages, errs, labels)
 	}
-	return jw.syncWrite(ctx, messages, errs, labels)
 }
 
+// asyncWrite publishes the messages asynchronously and waits for the acknowledgements, the messages failed to be
+// published or acknowledged are republished up to asyncWriteRetries times, unless the stream is missing.
 func (jw *jetStreamWriter) asyncWrite(_ context.Context, messages []isb.Message, errs []error, metricsLabels map[string]string) ([]isb.Offset, []error) {
 	var writeOffsets = make([]isb.Offset, len(messages))
-	var futures = make([]nats.PubAckFuture, len(messages))
+	var msgs = make([]*nats.Msg, len(messages))
+	var pending []int
 	for index, message := range messages {
-		payload, err := message.MarshalBinary()
+		m, err := jw.buildMsg(message, metricsLabels)
 		if err != nil {
 			errs[index] = err
 			continue
 		}
-		m := &nats.Msg{
-			Subject: jw.subject,
-			Data:    payload,
+		msgs[index] = m
+		pending = append(pending, index)
+	}
+	for attempt := 0; len(pending) > 0; attempt++ {
+		if attempt > 0 {
+			if attempt > jw.opts.asyncWriteRetries || jw.streamMissing.Load() {
+				break
+			}
+			isbWriteRetries.With(metricsLabels).Add(float64(len(pending)))
+			jw.log.Debugw("Republishing failed messages", zap.Int("count", len(pending)), zap.Int("attempt", attempt))
 		}
-		if future, err := jw.js.PublishMsgAsync(m, nats.MsgId(message.Header.ID)); err != nil { // nats.MsgId() is for exactly-once writing
-			errs[index] = err
+		pending = jw.publishAsync(messages, msgs, pending, writeOffsets, errs, metricsLabels)
+	}
+	isbAsyncWritePending.With(metricsLabels).Set(float64(jw.js.PublishAsyncPending()))
+	return writeOffsets, errs
+}
+
+// publishAsync publishes the messages of the given indexes asynchronously, and waits for their acknowledgements until
+// the timeout. It returns the indexes of the messages worth retrying.
+func (jw *jetStreamWriter) publishAsync(messages []isb.Message, msgs []*nats.Msg, indexes []int, writeOffsets []isb.Offset, errs []error, metricsLabels map[string]string) []int {
+	var retries []int
+	var published []int
+	var futures []nats.PubAckFuture
+	for _, idx := range indexes {
+		if future, err := jw.js.PublishMsgAsync(msgs[idx], nats.MsgId(messages[idx].Header.ID)); err != nil { // nats.MsgId() is for exactly-once writing
+			errs[idx] = err
+			isbWriteErrors.With(metricsLabels).Inc()
+			jw.triggerStatusCheck(err)
+			if isRetryablePublishErr(err) {
+				retries = append(retries, idx)
+			}
 		} else {
-			futures[index] = future
+			published = append(published, idx)
+			futures = append(futures, future)
 		}
 	}
-	futureCheckDone := make(chan struct{})
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-	wg := new(sync.WaitGroup)
-	for index, f := range futures {
-		if f == nil {
+	timeout := time.NewTimer(jw.opts.asyncAckTimeout)
+	defer timeout.Stop()
+	for i, idx := range published {
+		select {
+		case pubAck := <-futures[i].Ok():
+			writeOffsets[idx] = &writeOffset{seq: pubAck.Sequence, partitionIdx: jw.partitionIdx}
+			errs[idx] = nil
+			jw.log.Debugw("Succeeded to publish a message", zap.String("stream", pubAck.Stream), zap.Any("seq", pubAck.Sequence), zap.Bool("duplicate", pubAck.Duplicate), zap.String("msgID", messages[idx].Header.ID), zap.String("domain", pubAck.Domain))
+		case err := <-futures[i].Err():
+			errs[idx] = err
+			isbWriteErrors.With(metricsLabels).Inc()
+			jw.triggerStatusCheck(err)
+			if isRetryablePublishErr(err) {
+				retries = append(retries, idx)
+			}
+		case <-timeout.C:
+			// TODO: Maybe need to reconnect.
+			isbWriteTimeout.With(metricsLabels).Inc()
+			for _, j := range published[i:] {
+				errs[j] = isb.BufferWriteErr{Name: jw.name, Message: "timed out waiting for the publishing acknowledgement"}
+			}
+			return retries
+		}
+	}
+	return retries
+}
+
+// isRetryablePublishErr tells if a message failed to be published is worth republishing right away, the ones failed
+// because of the missing stream are left to the caller.
+func isRetryablePublishErr(err error) bool {
+	return !errors.Is(err, nats.ErrNoStreamResponse) && !errors.Is(err, nats.ErrStreamNotFound)
+}
+
+// fireAndForgetWrite publishes the messages asynchronously without waiting for the acknowledgements, the messages
+// are considered written once they are published. Since the sequences of the messages are unknown, the offsets
+// returned all point to the latest acknowledged message, or nil if there's none yet, which makes the watermarks of
+// the buffer best-effort.
+func (jw *jetStreamWriter) fireAndForgetWrite(_ context.Context, messages []isb.Message, errs []error, metricsLabels map[string]string) ([]isb.Offset, []error) {
+	var futures []nats.PubAckFuture
+	for index, message := range messages {
+		m, err := jw.buildMsg(message, metricsLabels)
+		if err != nil {
+			errs[index] = err
 			continue
 		}
-		wg.Add(1)
-		go func(idx int, fu nats.PubAckFuture) {
-			defer wg.Done()
-			select {
-			case pubAck := <-fu.Ok():
-				writeOffsets[idx] = &writeOffset{seq: pubAck.Sequence, partitionIdx: jw.partitionIdx}
-				errs[idx] = nil
-				jw.log.Debugw("Succeeded to publish a message", zap.String("stream", pubAck.Stream), zap.Any("seq", pubAck.Sequence), zap.Bool("duplicate", pubAck.Duplicate), zap.String("domain", pubAck.Domain))
-			case err := <-fu.Err():
-				errs[idx] = err
-				isbWriteErrors.With(metricsLabels).Inc()
-			case <-ctx.Done():
+		if future, err := jw.js.PublishMsgAsync(m, nats.MsgId(message.Header.ID)); err != nil { // nats.MsgId() is for exactly-once writing
+			errs[index] = err
+			isbWriteErrors.With(metricsLabels).Inc()
+			jw.triggerStatusCheck(err)
+		} else {
+			futures = append(futures, future)
+			errs[index] = nil
+		}
+	}
+	if len(futures) > 0 {
+		go jw.trackAcks(futures, metricsLabels)
+	}
+	isbAsyncWritePending.With(metricsLabels).Set(float64(jw.js.PublishAsyncPending()))
+	seq := jw.lastAckedSeq.Load()
+	if seq == 0 {
+		return nil, errs
+	}
+	var writeOffsets = make([]isb.Offset, len(messages))
+	for i := range writeOffsets {
+		writeOffsets[i] = &writeOffset{seq: seq, partitionIdx: jw.partitionIdx}
+	}
+	return writeOffsets, errs
+}
+
+// trackAcks waits for the acknowledgements of the messages published in the fire-and-forget mode, to keep track of
+// the latest acknowledged sequence and count the lost messages.
+func (jw *jetStreamWriter) trackAcks(futures []nats.PubAckFuture, metricsLabels map[string]string) {
+	timeout := time.NewTimer(jw.opts.asyncAckTimeout)
+	defer timeout.Stop()
+	for i, f := range futures {
+		select {
+		case pubAck := <-f.Ok():
+			for {
+				seq := jw.lastAckedSeq.Load()
+				if pubAck.Sequence <= seq || jw.lastAckedSeq.CAS(seq, pubAck.Sequence) {
+					break
+				}
 			}
-		}(index, f)
+		case err := <-f.Err():
+			isbFireAndForgetLost.With(metricsLabels).Inc()
+			jw.triggerStatusCheck(err)
+			jw.log.Debugw("Failed to publish a message in fire-and-forget mode", zap.Error(err))
+		case <-timeout.C:
+			isbFireAndForgetLost.With(metricsLabels).Add(float64(len(futures) - i))
+			return
+		}
 	}
+}
 
-	go func() {
-		wg.Wait()
-		close(futureCheckDone)
-	}()
-	timeout := time.After(5 * time.Second)
-	select {
-	case <-futureCheckDone:
-	case <-timeout:
-		cancel()
-		<-futureCheckDone
-		// TODO: Maybe need to reconnect.
-		isbWriteTimeout.With(metricsLabels).Inc()
+// buildMsg builds the NATS message to be published, the payload is offloaded to the claim check object store if it
+// exceeds the threshold.
+func (jw *jetStreamWriter) buildMsg(message isb.Message, metricsLabels map[string]string) (*nats.Msg, error) {
+	var header nats.Header
+	if jw.objectStore != nil && int64(len(message.Payload)) > jw.opts.claimCheckThreshold {
+		var err error
+		if message, header, err = offloadPayload(jw.objectStore, jw.opts.claimCheckStore, jw.name, message); err != nil {
+			return nil, err
+		}
+		isbClaimCheckOffloaded.With(metricsLabels).Inc()
 	}
-	return writeOffsets, errs
+	payload, err := message.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return &nats.Msg{
+		Subject: jw.subject,
+		Header:  header,
+		Data:    payload,
+	}, nil
 }
 
 func (jw *jetStreamWriter) syncWrite(_ context.Context, messages []isb.Message, errs []error, metricsLabels map[string]string) ([]isb.Offset, []error) {
@@ -250,18 +427,15 @@ func (jw *jetStreamWriter) syncWrite(_ context.Context, messages []isb.Message,
 		wg.Add(1)
 		go func(message isb.Message, idx int) {
 			defer wg.Done()
-			payload, err := message.MarshalBinary()
+			m, err := jw.buildMsg(message, metricsLabels)
 			if err != nil {
 				errs[idx] = err
 				return
 			}
-			m := &nats.Msg{
-				Subject: jw.subject,
-				Data:    payload,
-			}
 			if pubAck, err := jw.js.PublishMsg(m, nats.MsgId(message.Header.ID), nats.AckWait(2*time.Second)); err != nil { // nats.MsgId() is for exactly-once writing
 				errs[idx] = err
 				isbWriteErrors.With(metricsLabels).Inc()
+				jw.triggerStatusCheck(err)
 			} else {
 				writeOffsets[idx] = &writeOffset{seq: pubAck.Sequence, partitionIdx: jw.partitionIdx}
 				errs[idx] = nil
@@ -273,6 +447,37 @@ func (jw *jetStreamWriter) syncWrite(_ context.Context, messages []isb.Message,
 	return writeOffsets, errs
 }
 
+// serialWrite publishes the messages one after another and waits for the acknowledgement of each of them. The messages
+// after a failed one are not published, so that they can't overtake the failed one when it's retried.
+func (jw *jetStreamWriter) serialWrite(_ context.Context, messages []isb.Message, errs []error, metricsLabels map[string]string) ([]isb.Offset, []error) {
+	var writeOffsets = make([]isb.Offset, len(messages))
+	failed := false
+	for idx, message := range messages {
+		if failed {
+			errs[idx] = isb.BufferWriteErr{Name: jw.name, Message: "not published after a failed message"}
+			continue
+		}
+		m, err := jw.buildMsg(message, metricsLabels)
+		if err != nil {
+			errs[idx] = err
+			failed = true
+			continue
+		}
+		pubAck, err := jw.js.PublishMsg(m, nats.MsgId(message.Header.ID), nats.AckWait(2*time.Second))
+		if err != nil {
+			errs[idx] = err
+			failed = true
+			isbWriteErrors.With(metricsLabels).Inc()
+			jw.triggerStatusCheck(err)
+			continue
+		}
+		writeOffsets[idx] = &writeOffset{seq: pubAck.Sequence, partitionIdx: jw.partitionIdx}
+		errs[idx] = nil
+		jw.log.Debugw("Succeeded to publish a message", zap.String("stream", pubAck.Stream), zap.Any("seq", pubAck.Sequence), zap.Bool("duplicate", pubAck.Duplicate), zap.String("msgID", message.Header.ID), zap.String("domain", pubAck.Domain))
+	}
+	return writeOffsets, errs
+}
+
 // writeOffset is the offset of the location in the JS stream we wrote to.
 type writeOffset struct {
 	seq          uint64
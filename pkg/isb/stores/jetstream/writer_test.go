@@ -35,7 +35,7 @@ import (
 type myForwardJetStreamTest struct {
 }
 
-func (f myForwardJetStreamTest) WhereTo(_ []string, _ []string) ([]forward.VertexBuffer, error) {
+func (f myForwardJetStreamTest) WhereTo(_ []string, _ []string, _ string, _ map[string]string) ([]forward.VertexBuffer, error) {
 	return []forward.VertexBuffer{{
 		ToVertexName:         "to1",
 		ToVertexPartitionIdx: 0,
@@ -240,6 +240,218 @@ func TestJetStreamBufferWriterBufferFull(t *testing.T) {
 	}
 }
 
+func TestJetStreamBufferWriterWriteAckPolicy(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy dfv1.WriteAckPolicy
+	}{
+		{name: "sync", policy: dfv1.WriteAckPolicySync},
+		{name: "async", policy: dfv1.WriteAckPolicyAsync},
+		{name: "fireAndForget", policy: dfv1.WriteAckPolicyFireAndForget},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := natstest.RunJetStreamServer(t)
+			defer natstest.ShutdownJetStreamServer(t, s)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 1*time.Minute)
+			defer cancel()
+
+			defaultJetStreamClient := natstest.JetStreamClient(t, s)
+			defer defaultJetStreamClient.Close()
+			js, err := defaultJetStreamClient.JetStreamContext()
+			assert.NoError(t, err)
+
+			streamName := "TestJetStreamBufferWriterWriteAckPolicy"
+			addStream(t, js, streamName)
+			defer deleteStream(js, streamName)
+
+			bw, err := NewJetStreamBufferWriter(ctx, defaultJetStreamClient, streamName, streamName, streamName, defaultPartitionIdx, WithWriteAckPolicy(tt.policy), WithMaxAsyncInFlight(4))
+			assert.NoError(t, err)
+			jw, _ := bw.(*jetStreamWriter)
+			defer jw.Close()
+			for jw.isFull.Load() {
+				select {
+				case <-ctx.Done():
+					t.Fatalf("expected not to be full, %s", ctx.Err())
+				default:
+					time.Sleep(1 * time.Millisecond)
+				}
+			}
+
+			messages := testutils.BuildTestWriteMessages(int64(10), time.Unix(1636470000, 0))
+			offsets, errs := jw.Write(ctx, messages)
+			assert.Len(t, errs, 10)
+			for _, err := range errs {
+				assert.NoError(t, err)
+			}
+			if tt.policy == dfv1.WriteAckPolicyFireAndForget {
+				// no message is acknowledged before the first batch returns.
+				assert.Nil(t, offsets)
+			} else {
+				var seqs []int64
+				for _, o := range offsets {
+					seq, err := o.Sequence()
+					assert.NoError(t, err)
+					seqs = append(seqs, seq)
+				}
+				assert.ElementsMatch(t, []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, seqs)
+			}
+
+			for {
+				info, err := js.StreamInfo(streamName)
+				assert.NoError(t, err)
+				if info.State.Msgs == 10 {
+					break
+				}
+				select {
+				case <-ctx.Done():
+					t.Fatalf("expected 10 msgs, %s", ctx.Err())
+				default:
+					time.Sleep(10 * time.Millisecond)
+				}
+			}
+
+			if tt.policy == dfv1.WriteAckPolicyFireAndForget {
+				for jw.lastAckedSeq.Load() != 10 {
+					select {
+					case <-ctx.Done():
+						t.Fatalf("expected the acknowledgements to be tracked, %s", ctx.Err())
+					default:
+						time.Sleep(10 * time.Millisecond)
+					}
+				}
+				offsets, errs = jw.Write(ctx, testutils.BuildTestWriteMessages(int64(2), time.Unix(1636470001, 0)))
+				assert.Len(t, offsets, 2)
+				for _, o := range offsets {
+					seq, err := o.Sequence()
+					assert.NoError(t, err)
+					assert.Equal(t, int64(10), seq)
+				}
+				for _, err := range errs {
+					assert.NoError(t, err)
+				}
+			}
+		})
+	}
+}
+
+func TestJetStreamBufferWriterStrictFIFO(t *testing.T) {
+	s := natstest.RunJetStreamServer(t)
+	defer natstest.ShutdownJetStreamServer(t, s)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Minute)
+	defer cancel()
+
+	defaultJetStreamClient := natstest.JetStreamClient(t, s)
+	defer defaultJetStreamClient.Close()
+	js, err := defaultJetStreamClient.JetStreamContext()
+	assert.NoError(t, err)
+
+	streamName := "TestJetStreamBufferWriterStrictFIFO"
+	addStream(t, js, streamName)
+	defer deleteStream(js, streamName)
+
+	bw, err := NewJetStreamBufferWriter(ctx, defaultJetStreamClient, streamName, streamName, streamName, defaultPartitionIdx, WithStrictFIFO(true))
+	assert.NoError(t, err)
+	jw, _ := bw.(*jetStreamWriter)
+	defer jw.Close()
+	for jw.isFull.Load() {
+		select {
+		case <-ctx.Done():
+			t.Fatalf("expected not to be full, %s", ctx.Err())
+		default:
+			time.Sleep(1 * time.Millisecond)
+		}
+	}
+
+	messages := testutils.BuildTestWriteMessages(int64(10), time.Unix(1636470000, 0))
+	offsets, errs := jw.Write(ctx, messages)
+	var seqs []int64
+	for i, o := range offsets {
+		assert.NoError(t, errs[i])
+		seq, err := o.Sequence()
+		assert.NoError(t, err)
+		seqs = append(seqs, seq)
+	}
+	// the messages are published in order.
+	assert.Equal(t, []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, seqs)
+
+	// the messages after a failed one are not published.
+	_ = js.DeleteStream(streamName)
+	messages = testutils.BuildTestWriteMessages(int64(3), time.Unix(1636470001, 0))
+	offsets, errs = jw.serialWrite(ctx, messages, make([]error, 3), map[string]string{"buffer": jw.GetName()})
+	assert.Len(t, offsets, 3)
+	assert.Error(t, errs[0])
+	for _, err := range errs[1:] {
+		assert.Equal(t, isb.BufferWriteErr{Name: streamName, Message: "not published after a failed message"}, err)
+	}
+	for _, o := range offsets {
+		assert.Nil(t, o)
+	}
+}
+
+func TestJetStreamBufferWriterStreamRecreated(t *testing.T) {
+	s := natstest.RunJetStreamServer(t)
+	defer natstest.ShutdownJetStreamServer(t, s)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Minute)
+	defer cancel()
+
+	defaultJetStreamClient := natstest.JetStreamClient(t, s)
+	defer defaultJetStreamClient.Close()
+	js, err := defaultJetStreamClient.JetStreamContext()
+	assert.NoError(t, err)
+
+	streamName := "TestJetStreamBufferWriterStreamRecreated"
+	addStream(t, js, streamName)
+	defer deleteStream(js, streamName)
+
+	bw, err := NewJetStreamBufferWriter(ctx, defaultJetStreamClient, streamName, streamName, streamName, defaultPartitionIdx, WithRefreshInterval(100*time.Millisecond))
+	assert.NoError(t, err)
+	jw, _ := bw.(*jetStreamWriter)
+	defer jw.Close()
+	for jw.isFull.Load() {
+		select {
+		case <-ctx.Done():
+			t.Fatalf("expected not to be full, %s", ctx.Err())
+		default:
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+
+	deleteStream(js, streamName)
+	// the first write gets no response from the stream, which triggers the status check
+	_, errs := jw.Write(ctx, testutils.BuildTestWriteMessages(int64(1), time.Unix(1636470000, 0)))
+	assert.Error(t, errs[0])
+	for !jw.streamMissing.Load() {
+		select {
+		case <-ctx.Done():
+			t.Fatalf("expected the stream to be missing, %s", ctx.Err())
+		default:
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+	_, errs = jw.Write(ctx, testutils.BuildTestWriteMessages(int64(2), time.Unix(1636470001, 0)))
+	for _, e := range errs {
+		assert.ErrorContains(t, e, "not found")
+	}
+
+	addStream(t, js, streamName)
+	for jw.streamMissing.Load() {
+		select {
+		case <-ctx.Done():
+			t.Fatalf("expected the stream to be found, %s", ctx.Err())
+		default:
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+	_, errs = jw.Write(ctx, testutils.BuildTestWriteMessages(int64(2), time.Unix(1636470002, 0)))
+	for _, e := range errs {
+		assert.NoError(t, e)
+	}
+}
+
 // TestJetStreamBufferWrite on buffer full, with writing strategy being DiscardLatest
 func TestJetStreamBufferWriterBufferFull_DiscardLatest(t *testing.T) {
 	s := natstest.RunJetStreamServer(t)
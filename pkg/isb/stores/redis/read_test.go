@@ -294,7 +294,7 @@ type ReadWritePerformance struct {
 type forwardReadWritePerformance struct {
 }
 
-func (f forwardReadWritePerformance) WhereTo(_ []string, _ []string) ([]forward.VertexBuffer, error) {
+func (f forwardReadWritePerformance) WhereTo(_ []string, _ []string, _ string, _ map[string]string) ([]forward.VertexBuffer, error) {
 	return []forward.VertexBuffer{{
 		ToVertexName:         "to1",
 		ToVertexPartitionIdx: 0,
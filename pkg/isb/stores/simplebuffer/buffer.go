@@ -40,6 +40,8 @@ type InMemoryBuffer struct {
 	buffer       []elem
 	writeIdx     int64
 	readIdx      int64
+	writeSeq     int64
+	readSeq      int64
 	partitionIdx int32
 	options      *options
 	rwlock       *sync.RWMutex
@@ -146,7 +148,8 @@ func (b *InMemoryBuffer) Write(_ context.Context, messages []isb.Message) ([]isb
 			errs[idx] = nil
 			b.buffer[currentIdx].dirty = true
 			b.writeIdx = (currentIdx + 1) % b.size
-			writeOffsets[idx] = isb.NewSimpleIntPartitionOffset(currentIdx, b.partitionIdx)
+			writeOffsets[idx] = isb.NewSimpleIntPartitionOffset(b.offsetOf(currentIdx, b.writeSeq), b.partitionIdx)
+			b.writeSeq++
 			// access buffer via lock
 			b.rwlock.Unlock()
 		} else {
@@ -202,6 +205,8 @@ func (b *InMemoryBuffer) Read(ctx context.Context, count int64) ([]*isb.ReadMess
 		// mark it as pending
 		b.buffer[currentIdx].pending = true
 		b.readIdx = (currentIdx + 1) % b.size
+		readOffset := b.offsetOf(currentIdx, b.readSeq)
+		b.readSeq++
 		// get header and body
 		header := b.buffer[currentIdx].header
 		body := b.buffer[currentIdx].body
@@ -218,7 +223,7 @@ func (b *InMemoryBuffer) Read(ctx context.Context, count int64) ([]*isb.ReadMess
 			}
 		}
 
-		readMessage := isb.ReadMessage{Message: msg, ReadOffset: isb.NewSimpleIntPartitionOffset(currentIdx, b.partitionIdx)}
+		readMessage := isb.ReadMessage{Message: msg, ReadOffset: isb.NewSimpleIntPartitionOffset(readOffset, b.partitionIdx)}
 
 		readMessages = append(readMessages, &readMessage)
 	}
@@ -226,6 +231,15 @@ func (b *InMemoryBuffer) Read(ctx context.Context, count int64) ([]*isb.ReadMess
 	return readMessages, nil
 }
 
+// offsetOf returns the offset of the message at the given index of the buffer, which is either the index itself, or the
+// sequence number of the message if the sequence offsets are enabled.
+func (b *InMemoryBuffer) offsetOf(idx int64, seq int64) int64 {
+	if b.options.sequenceOffsets {
+		return seq
+	}
+	return idx
+}
+
 func buildMessage(header []byte, body []byte) (msg isb.Message, err error) {
 	err = msg.Header.UnmarshalBinary(header)
 	if err != nil {
@@ -248,6 +262,9 @@ func (b *InMemoryBuffer) Ack(_ context.Context, offsets []isb.Offset) []error {
 			errs[index] = isb.MessageAckErr{Name: b.name, Message: err.Error(), Offset: isb.Offset(offset)}
 			continue
 		}
+		if b.options.sequenceOffsets {
+			intOffset = intOffset % int(b.size)
+		}
 		if int64(intOffset) >= b.size {
 			errs[index] = isb.MessageAckErr{
 				Name:    b.name,
@@ -100,3 +100,34 @@ func TestNewSimpleBuffer_BufferFullWritingStrategyIsDiscard(t *testing.T) {
 	// still full as we did not ack
 	assert.Equal(t, true, sb.IsFull())
 }
+
+func TestNewSimpleBuffer_SequenceOffsets(t *testing.T) {
+	count := int64(4)
+	sb := NewInMemoryBuffer("test", count, 0, WithSequenceOffsets())
+	ctx := context.Background()
+
+	startTime := time.Unix(1636470000, 0)
+	writeMessages := testutils.BuildTestWriteMessages(2*count, startTime)
+	for i := int64(0); i < 2; i++ {
+		offsets, errs := sb.Write(ctx, writeMessages[i*count:(i+1)*count])
+		for j := range errs {
+			assert.NoError(t, errs[j])
+			// the offsets keep increasing after the buffer wraps around
+			seq, err := offsets[j].Sequence()
+			assert.NoError(t, err)
+			assert.Equal(t, i*count+int64(j), seq)
+		}
+		readMessages, err := sb.Read(ctx, count)
+		assert.NoError(t, err)
+		assert.Len(t, readMessages, int(count))
+		assert.Equal(t, offsets[0].String(), readMessages[0].ReadOffset.String())
+		var readOffsets []isb.Offset
+		for _, m := range readMessages {
+			readOffsets = append(readOffsets, m.ReadOffset)
+		}
+		for _, err := range sb.Ack(ctx, readOffsets) {
+			assert.NoError(t, err)
+		}
+		assert.False(t, sb.IsFull())
+	}
+}
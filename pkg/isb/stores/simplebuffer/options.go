@@ -28,6 +28,8 @@ type options struct {
 	readTimeOut time.Duration
 	// bufferFullWritingStrategy is the writing strategy when buffer is full
 	bufferFullWritingStrategy dfv1.BufferFullWritingStrategy
+	// sequenceOffsets uses the monotonically increasing sequence numbers of the messages as the offsets
+	sequenceOffsets bool
 }
 
 type Option func(options *options) error
@@ -47,3 +49,12 @@ func WithBufferFullWritingStrategy(s dfv1.BufferFullWritingStrategy) Option {
 		return nil
 	}
 }
+
+// WithSequenceOffsets uses the sequence numbers of the messages as the offsets instead of their indexes in the buffer,
+// so that the offsets keep increasing after the buffer wraps around, as the watermark offset timelines require.
+func WithSequenceOffsets() Option {
+	return func(o *options) error {
+		o.sequenceOffsets = true
+		return nil
+	}
+}
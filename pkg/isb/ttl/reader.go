@@ -0,0 +1,136 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package ttl drops the messages read from the inter-step buffers which are older than the message TTL of the
+// inbound edges, so that a vertex doesn't spend time on a stale backlog.
+package ttl
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+type policy struct {
+	edgeName string
+	ttl      time.Duration
+	basis    dfv1.MessageTTLBasis
+}
+
+// expired returns true if the message is older than the TTL, a message without an ingest time never expires by it.
+func (p policy) expired(m *isb.ReadMessage, now time.Time) bool {
+	if m.Kind != isb.Data {
+		return false
+	}
+	t := m.EventTime
+	if p.basis == dfv1.MessageTTLBasisIngestTime {
+		if m.IngestTime.IsZero() {
+			return false
+		}
+		t = m.IngestTime
+	}
+	return now.Sub(t) > p.ttl
+}
+
+// expiringReader drops the messages older than the TTL of the edges they come from, the dropped ones are
+// acknowledged.
+type expiringReader struct {
+	isb.BufferReader
+	// policies are the TTL policies indexed by the from vertices
+	policies map[string]policy
+	// defaultPolicy applies to the messages without an origin, it's only set if there's one inbound edge
+	defaultPolicy *policy
+	log           *zap.SugaredLogger
+}
+
+// NewExpiringReaders wraps the readers of the vertex to drop the messages older than the TTL of its inbound edges,
+// the readers are returned as they are if none of the edges has a TTL.
+func NewExpiringReaders(ctx context.Context, readers []isb.BufferReader, vertex *dfv1.Vertex) []isb.BufferReader {
+	policies := make(map[string]policy)
+	for _, e := range vertex.Spec.FromEdges {
+		if e.MessageTTL == nil || e.MessageTTL.GetDuration() <= 0 {
+			continue
+		}
+		policies[e.From] = policy{edgeName: e.GetEdgeName(), ttl: e.MessageTTL.GetDuration(), basis: e.MessageTTL.GetBasis()}
+	}
+	if len(policies) == 0 {
+		return readers
+	}
+	var defaultPolicy *policy
+	if len(vertex.Spec.FromEdges) == 1 {
+		p := policies[vertex.Spec.FromEdges[0].From]
+		defaultPolicy = &p
+	}
+	result := make([]isb.BufferReader, len(readers))
+	for i, r := range readers {
+		result[i] = &expiringReader{
+			BufferReader:  r,
+			policies:      policies,
+			defaultPolicy: defaultPolicy,
+			log:           logging.FromContext(ctx).With("buffer", r.GetName()),
+		}
+	}
+	return result
+}
+
+func (r *expiringReader) policyOf(m *isb.ReadMessage) (policy, bool) {
+	if p, ok := r.policies[m.Origin]; ok {
+		return p, true
+	}
+	if m.Origin == "" && r.defaultPolicy != nil {
+		return *r.defaultPolicy, true
+	}
+	return policy{}, false
+}
+
+// Read reads the messages and returns the unexpired ones. The expired messages are acknowledged, so that they are not
+// redelivered.
+func (r *expiringReader) Read(ctx context.Context, count int64) ([]*isb.ReadMessage, error) {
+	messages, err := r.BufferReader.Read(ctx, count)
+	now := time.Now()
+	valid := make([]*isb.ReadMessage, 0, len(messages))
+	var expired []isb.Offset
+	for _, m := range messages {
+		if p, ok := r.policyOf(m); ok && p.expired(m, now) {
+			expiredMessages.WithLabelValues(r.GetName(), p.edgeName).Inc()
+			expired = append(expired, m.ReadOffset)
+			continue
+		}
+		valid = append(valid, m)
+	}
+	if len(expired) > 0 {
+		r.log.Debugw("Dropping the expired messages", zap.Int("count", len(expired)))
+		for _, ackErr := range r.BufferReader.Ack(ctx, expired) {
+			if ackErr != nil {
+				r.log.Errorw("Failed to ack an expired message", zap.Error(ackErr))
+			}
+		}
+	}
+	return valid, err
+}
+
+// Pending returns the pending messages number of the underlying reader.
+func (r *expiringReader) Pending(ctx context.Context) (int64, error) {
+	if x, ok := r.BufferReader.(isb.LagReader); ok {
+		return x.Pending(ctx)
+	}
+	return isb.PendingNotAvailable, nil
+}
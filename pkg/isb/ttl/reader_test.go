@@ -0,0 +1,106 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ttl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/isb/stores/simplebuffer"
+)
+
+func testVertex(edges ...dfv1.CombinedEdge) *dfv1.Vertex {
+	return &dfv1.Vertex{Spec: dfv1.VertexSpec{
+		AbstractVertex: dfv1.AbstractVertex{Name: "out"},
+		FromEdges:      edges,
+	}}
+}
+
+func testEdge(from string, ttl *dfv1.MessageTTL) dfv1.CombinedEdge {
+	return dfv1.CombinedEdge{Edge: dfv1.Edge{From: from, To: "out", MessageTTL: ttl}}
+}
+
+func TestNewExpiringReaders(t *testing.T) {
+	buffer := simplebuffer.NewInMemoryBuffer("test", 10, 0)
+	readers := NewExpiringReaders(context.Background(), []isb.BufferReader{buffer}, testVertex(testEdge("in", nil)))
+	assert.Same(t, buffer, readers[0])
+	readers = NewExpiringReaders(context.Background(), []isb.BufferReader{buffer}, testVertex(testEdge("in", &dfv1.MessageTTL{})))
+	assert.Same(t, buffer, readers[0])
+}
+
+func TestExpiringReader_Read(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	ingestTime := dfv1.MessageTTLBasisIngestTime
+	now := time.Now()
+
+	t.Run("event time with one inbound edge", func(t *testing.T) {
+		buffer := simplebuffer.NewInMemoryBuffer("test-event-time", 10, 0)
+		readers := NewExpiringReaders(ctx, []isb.BufferReader{buffer},
+			testVertex(testEdge("in", &dfv1.MessageTTL{Duration: &metav1.Duration{Duration: time.Minute}})))
+		messages := []isb.Message{
+			{Header: isb.Header{Kind: isb.Data, ID: "0", MessageInfo: isb.MessageInfo{EventTime: now.Add(-time.Hour)}}},
+			{Header: isb.Header{Kind: isb.Data, ID: "1", MessageInfo: isb.MessageInfo{EventTime: now}}},
+			{Header: isb.Header{Kind: isb.WMB, ID: "2", MessageInfo: isb.MessageInfo{EventTime: now.Add(-time.Hour)}}},
+		}
+		_, errs := buffer.Write(ctx, messages)
+		for _, e := range errs {
+			assert.NoError(t, e)
+		}
+		readMessages, err := readers[0].Read(ctx, 3)
+		assert.NoError(t, err)
+		assert.Len(t, readMessages, 2)
+		assert.Equal(t, "1", readMessages[0].ID)
+		assert.Equal(t, "2", readMessages[1].ID)
+		assert.Equal(t, float64(1), testutil.ToFloat64(expiredMessages.WithLabelValues("test-event-time", "in-out")))
+	})
+
+	t.Run("ingest time with multiple inbound edges", func(t *testing.T) {
+		buffer := simplebuffer.NewInMemoryBuffer("test-ingest-time", 10, 0)
+		readers := NewExpiringReaders(ctx, []isb.BufferReader{buffer}, testVertex(
+			testEdge("in", &dfv1.MessageTTL{Duration: &metav1.Duration{Duration: time.Minute}, Basis: &ingestTime}),
+			testEdge("other", nil),
+		))
+		old := now.Add(-time.Hour)
+		messages := []isb.Message{
+			{Header: isb.Header{Kind: isb.Data, ID: "0", MessageInfo: isb.MessageInfo{EventTime: now, IngestTime: old, Origin: "in"}}},
+			{Header: isb.Header{Kind: isb.Data, ID: "1", MessageInfo: isb.MessageInfo{EventTime: old, IngestTime: now, Origin: "in"}}},
+			{Header: isb.Header{Kind: isb.Data, ID: "2", MessageInfo: isb.MessageInfo{EventTime: old, IngestTime: old, Origin: "other"}}},
+			{Header: isb.Header{Kind: isb.Data, ID: "3", MessageInfo: isb.MessageInfo{EventTime: old, IngestTime: old}}},
+			{Header: isb.Header{Kind: isb.Data, ID: "4", MessageInfo: isb.MessageInfo{EventTime: old, Origin: "in"}}},
+		}
+		_, errs := buffer.Write(ctx, messages)
+		for _, e := range errs {
+			assert.NoError(t, e)
+		}
+		readMessages, err := readers[0].Read(ctx, 5)
+		assert.NoError(t, err)
+		var ids []string
+		for _, m := range readMessages {
+			ids = append(ids, m.ID)
+		}
+		assert.Equal(t, []string{"1", "2", "3", "4"}, ids)
+		assert.Equal(t, float64(1), testutil.ToFloat64(expiredMessages.WithLabelValues("test-ingest-time", "in-out")))
+	})
+}
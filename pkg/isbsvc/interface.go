@@ -26,9 +26,21 @@ import (
 type ISBService interface {
 	CreateBuffersAndBuckets(ctx context.Context, buffers, buckets []string, sideInputsStore string, opts ...CreateOption) error
 	DeleteBuffersAndBuckets(ctx context.Context, buffers, buckets []string, sideInputsStore string) error
+	// DeleteSideInputs deletes the values of the side inputs from the side inputs store, e.g. the removed ones, the
+	// store itself is kept.
+	DeleteSideInputs(ctx context.Context, sideInputsStore string, sideInputs []string) error
 	ValidateBuffersAndBuckets(ctx context.Context, buffers, buckets []string, sideInputsStore string) error
 	GetBufferInfo(ctx context.Context, buffer string) (*BufferInfo, error)
 	CreateProcessorManagers(ctx context.Context, bucketName string, partitions int, isReduce bool) ([]*processor.ProcessorManager, error)
+	// DrainBuffer acks the pending messages of a buffer without processing them, it returns the number of the acked messages.
+	DrainBuffer(ctx context.Context, buffer string) (int64, error)
+	// SkipBuffer moves the reader of a buffer ahead to the latest offset, it returns the number of the skipped messages.
+	SkipBuffer(ctx context.Context, buffer string) (int64, error)
+	// ResetWatermarks deletes the offset timelines and the processor heartbeats in the buckets, so that the watermarks
+	// are rebuilt from scratch.
+	ResetWatermarks(ctx context.Context, buckets []string) error
+	// RefreshSideInput asks the side inputs manager of a side input to retrieve and broadcast the value immediately.
+	RefreshSideInput(ctx context.Context, sideInputsStore, sideInput string) error
 }
 
 // createOptions describes the options for creating buffers and buckets
@@ -38,6 +38,8 @@ type jetStreamSvc struct {
 
 	jsClient *jsclient.NATSClient
 	js       nats.JetStreamContext
+	// domains are the JetStream domains of the buffers and buckets which are not in the domain of the ISB Service.
+	domains map[string]string
 }
 
 func NewISBJetStreamSvc(pipelineName string, opts ...JSServiceOption) (ISBService, error) {
@@ -59,6 +61,29 @@ func WithJetStreamClient(jsClient *jsclient.NATSClient) JSServiceOption {
 	}
 }
 
+// WithJetStreamDomains sets the JetStream domains of the buffers and buckets which are not in the domain of the ISB
+// Service, keyed by the buffer or bucket names.
+func WithJetStreamDomains(domains map[string]string) JSServiceOption {
+	return func(j *jetStreamSvc) error {
+		j.domains = domains
+		return nil
+	}
+}
+
+// jetStreamContextOf returns the JetStream context in the domain of the given buffer or bucket, js is the one in the
+// domain of the ISB Service.
+func (jss *jetStreamSvc) jetStreamContextOf(nc *jsclient.NATSClient, js nats.JetStreamContext, name string) (nats.JetStreamContext, error) {
+	domain := jss.domains[name]
+	if domain == "" {
+		return js, nil
+	}
+	domainJS, err := nc.JetStreamContext(nats.Domain(domain))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get a js context of domain %q from nats connection, %w", domain, err)
+	}
+	return domainJS, nil
+}
+
 func (jss *jetStreamSvc) CreateBuffersAndBuckets(ctx context.Context, buffers, buckets []string, sideInputsStore string, opts ...CreateOption) error {
 	if len(buffers) == 0 && len(buckets) == 0 {
 		return nil
@@ -104,10 +129,68 @@ func (jss *jetStreamSvc) CreateBuffersAndBuckets(ctx context.Context, buffers, b
 			}
 			log.Infow("Succeeded to create a side inputs KV", zap.String("kvName", kvName))
 		}
+		// the counters store shares the same name with the side inputs store, which is unique to a pipeline
+		countersKVName := JetStreamCountersKVName(sideInputsStore)
+		if _, err := js.KeyValue(countersKVName); err != nil {
+			if !errors.Is(err, nats.ErrBucketNotFound) && !errors.Is(err, nats.ErrStreamNotFound) {
+				return fmt.Errorf("failed to query information of KV %q, %w", countersKVName, err)
+			}
+			if _, err := js.CreateKeyValue(&nats.KeyValueConfig{
+				Bucket:       countersKVName,
+				MaxValueSize: 0,
+				History:      1, // No history
+				TTL:          0, // Never expire
+				MaxBytes:     0,
+				Storage:      nats.FileStorage,
+				Replicas:     3,
+			}); err != nil {
+				return fmt.Errorf("failed to create counters KV %q, %w", countersKVName, err)
+			}
+			log.Infow("Succeeded to create a counters KV", zap.String("kvName", countersKVName))
+		}
+		metricsHistoryKVName := JetStreamMetricsHistoryKVName(sideInputsStore)
+		if _, err := js.KeyValue(metricsHistoryKVName); err != nil {
+			if !errors.Is(err, nats.ErrBucketNotFound) && !errors.Is(err, nats.ErrStreamNotFound) {
+				return fmt.Errorf("failed to query information of KV %q, %w", metricsHistoryKVName, err)
+			}
+			if _, err := js.CreateKeyValue(&nats.KeyValueConfig{
+				Bucket:       metricsHistoryKVName,
+				MaxValueSize: 0,
+				History:      1,              // No history
+				TTL:          time.Hour * 24, // The same as the retention of the metrics history
+				MaxBytes:     0,
+				Storage:      nats.FileStorage,
+				Replicas:     3,
+			}); err != nil {
+				return fmt.Errorf("failed to create metrics history KV %q, %w", metricsHistoryKVName, err)
+			}
+			log.Infow("Succeeded to create a metrics history KV", zap.String("kvName", metricsHistoryKVName))
+		}
+		claimCheckStoreName := JetStreamClaimCheckStoreName(sideInputsStore)
+		if _, err := js.ObjectStore(claimCheckStoreName); err != nil {
+			if !errors.Is(err, nats.ErrBucketNotFound) && !errors.Is(err, nats.ErrStreamNotFound) {
+				return fmt.Errorf("failed to query information of object store %q, %w", claimCheckStoreName, err)
+			}
+			if _, err := js.CreateObjectStore(&nats.ObjectStoreConfig{
+				Bucket: claimCheckStoreName,
+				// the objects are deleted once the messages are acknowledged, the orphaned ones (e.g. the messages are
+				// discarded, or the write of the message fails) expire together with the messages in the buffers.
+				TTL:      v.GetDuration("stream.maxAge"),
+				Storage:  nats.FileStorage,
+				Replicas: v.GetInt("stream.replicas"),
+			}); err != nil {
+				return fmt.Errorf("failed to create claim check object store %q, %w", claimCheckStoreName, err)
+			}
+			log.Infow("Succeeded to create a claim check object store", zap.String("objectStore", claimCheckStoreName))
+		}
 	}
 	for _, buffer := range buffers {
+		js, err := jss.jetStreamContextOf(nc, js, buffer)
+		if err != nil {
+			return err
+		}
 		streamName := JetStreamName(buffer)
-		_, err := js.StreamInfo(streamName)
+		_, err = js.StreamInfo(streamName)
 		if err != nil {
 			if !errors.Is(err, nats.ErrStreamNotFound) {
 				return fmt.Errorf("failed to query information of stream %q during buffer creating, %w", streamName, err)
@@ -144,6 +227,10 @@ func (jss *jetStreamSvc) CreateBuffersAndBuckets(ctx context.Context, buffers, b
 	}
 
 	for _, bucket := range buckets {
+		js, err := jss.jetStreamContextOf(nc, js, bucket)
+		if err != nil {
+			return err
+		}
 		// Create offset-timeline KV
 		otKVName := wmstore.JetStreamOTKVName(bucket)
 		if _, err := js.KeyValue(otKVName); err != nil {
@@ -201,6 +288,10 @@ func (jss *jetStreamSvc) DeleteBuffersAndBuckets(ctx context.Context, buffers, b
 		return fmt.Errorf("failed to get a js context from nats connection, %w", err)
 	}
 	for _, buffer := range buffers {
+		js, err := jss.jetStreamContextOf(nc, js, buffer)
+		if err != nil {
+			return err
+		}
 		streamName := JetStreamName(buffer)
 		if err := js.DeleteStream(streamName); err != nil && !errors.Is(err, nats.ErrStreamNotFound) {
 			return fmt.Errorf("failed to delete stream %q, %w", streamName, err)
@@ -208,6 +299,10 @@ func (jss *jetStreamSvc) DeleteBuffersAndBuckets(ctx context.Context, buffers, b
 		log.Infow("Succeeded to delete a stream", zap.String("stream", streamName))
 	}
 	for _, bucket := range buckets {
+		js, err := jss.jetStreamContextOf(nc, js, bucket)
+		if err != nil {
+			return err
+		}
 		otKVName := wmstore.JetStreamOTKVName(bucket)
 		if err := js.DeleteKeyValue(otKVName); err != nil && !errors.Is(err, nats.ErrBucketNotFound) && !errors.Is(err, nats.ErrStreamNotFound) {
 			return fmt.Errorf("failed to delete offset timeline KV %q, %w", otKVName, err)
@@ -226,6 +321,53 @@ func (jss *jetStreamSvc) DeleteBuffersAndBuckets(ctx context.Context, buffers, b
 			return fmt.Errorf("failed to delete side inputs KV %q, %w", sideInputsKVName, err)
 		}
 		log.Infow("Succeeded to delete a side inputs KV", zap.String("kvName", sideInputsKVName))
+		countersKVName := JetStreamCountersKVName(sideInputsStore)
+		if err := js.DeleteKeyValue(countersKVName); err != nil && !errors.Is(err, nats.ErrBucketNotFound) && !errors.Is(err, nats.ErrStreamNotFound) {
+			return fmt.Errorf("failed to delete counters KV %q, %w", countersKVName, err)
+		}
+		log.Infow("Succeeded to delete a counters KV", zap.String("kvName", countersKVName))
+		metricsHistoryKVName := JetStreamMetricsHistoryKVName(sideInputsStore)
+		if err := js.DeleteKeyValue(metricsHistoryKVName); err != nil && !errors.Is(err, nats.ErrBucketNotFound) && !errors.Is(err, nats.ErrStreamNotFound) {
+			return fmt.Errorf("failed to delete metrics history KV %q, %w", metricsHistoryKVName, err)
+		}
+		log.Infow("Succeeded to delete a metrics history KV", zap.String("kvName", metricsHistoryKVName))
+		claimCheckStoreName := JetStreamClaimCheckStoreName(sideInputsStore)
+		if err := js.DeleteObjectStore(claimCheckStoreName); err != nil && !errors.Is(err, nats.ErrBucketNotFound) && !errors.Is(err, nats.ErrStreamNotFound) {
+			return fmt.Errorf("failed to delete claim check object store %q, %w", claimCheckStoreName, err)
+		}
+		log.Infow("Succeeded to delete a claim check object store", zap.String("objectStore", claimCheckStoreName))
+	}
+	return nil
+}
+
+func (jss *jetStreamSvc) DeleteSideInputs(ctx context.Context, sideInputsStore string, sideInputs []string) error {
+	if len(sideInputs) == 0 {
+		return nil
+	}
+	log := logging.FromContext(ctx)
+	nc, err := jsclient.NewNATSClient(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get an in-cluster nats connection, %w", err)
+	}
+	defer nc.Close()
+	js, err := nc.JetStreamContext()
+	if err != nil {
+		return fmt.Errorf("failed to get a js context from nats connection, %w", err)
+	}
+	sideInputsKVName := JetStreamSideInputsStoreKVName(sideInputsStore)
+	kv, err := js.KeyValue(sideInputsKVName)
+	if err != nil {
+		if errors.Is(err, nats.ErrBucketNotFound) || errors.Is(err, nats.ErrStreamNotFound) {
+			return nil
+		}
+		return fmt.Errorf("failed to query side inputs store KV %q, %w", sideInputsKVName, err)
+	}
+	for _, sideInput := range sideInputs {
+		// Purge instead of Delete to drop the history of the values as well
+		if err := kv.Purge(sideInput); err != nil && !errors.Is(err, nats.ErrKeyNotFound) {
+			return fmt.Errorf("failed to delete side input %q from KV %q, %w", sideInput, sideInputsKVName, err)
+		}
+		log.Infow("Succeeded to delete a side input", zap.String("kvName", sideInputsKVName), zap.String("sideInput", sideInput))
 	}
 	return nil
 }
@@ -244,12 +386,20 @@ func (jss *jetStreamSvc) ValidateBuffersAndBuckets(ctx context.Context, buffers,
 		return fmt.Errorf("failed to get a js context from nats connection, %w", err)
 	}
 	for _, buffer := range buffers {
+		js, err := jss.jetStreamContextOf(nc, js, buffer)
+		if err != nil {
+			return err
+		}
 		streamName := JetStreamName(buffer)
 		if _, err := js.StreamInfo(streamName); err != nil {
 			return fmt.Errorf("failed to query information of stream %q, %w", streamName, err)
 		}
 	}
 	for _, bucket := range buckets {
+		js, err := jss.jetStreamContextOf(nc, js, bucket)
+		if err != nil {
+			return err
+		}
 		otKVName := wmstore.JetStreamOTKVName(bucket)
 		if _, err := js.KeyValue(otKVName); err != nil {
 			return fmt.Errorf("failed to query OT KV %q, %w", otKVName, err)
@@ -269,17 +419,142 @@ func (jss *jetStreamSvc) ValidateBuffersAndBuckets(ctx context.Context, buffers,
 	return nil
 }
 
+// drainBatchSize is the max number of the messages fetched at a time when draining a buffer.
+const drainBatchSize = 500
+
+// DrainBuffer acks the pending messages of a buffer, the ones delivered but not acked yet are left to the readers.
+// It stops at the pending count when it starts, so that it's not going forever when the writers keep writing.
+func (jss *jetStreamSvc) DrainBuffer(ctx context.Context, buffer string) (int64, error) {
+	js, err := jss.jetStreamContext(buffer)
+	if err != nil {
+		return 0, err
+	}
+	streamName := JetStreamName(buffer)
+	consumer, err := js.ConsumerInfo(streamName, streamName)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get consumer information of stream %q, %w", streamName, err)
+	}
+	// Binding to the existing consumer, which is not deleted when unsubscribing.
+	sub, err := js.PullSubscribe("", streamName, nats.Bind(streamName, streamName))
+	if err != nil {
+		return 0, fmt.Errorf("failed to subscribe to stream %q, %w", streamName, err)
+	}
+	defer func() { _ = sub.Unsubscribe() }()
+	target := int64(consumer.NumPending)
+	var drained int64
+	for drained < target {
+		batch := drainBatchSize
+		if left := target - drained; left < int64(batch) {
+			batch = int(left)
+		}
+		msgs, err := sub.Fetch(batch, nats.MaxWait(time.Second), nats.Context(ctx))
+		if err != nil {
+			if errors.Is(err, nats.ErrTimeout) {
+				// The rest are taken by the readers.
+				break
+			}
+			return drained, fmt.Errorf("failed to fetch messages from stream %q, %w", streamName, err)
+		}
+		for _, m := range msgs {
+			if err := m.AckSync(); err != nil {
+				return drained, fmt.Errorf("failed to ack a message of stream %q, %w", streamName, err)
+			}
+			drained++
+		}
+	}
+	return drained, nil
+}
+
+// SkipBuffer purges the stream of a buffer, the consumer then starts from the next message to be written.
+func (jss *jetStreamSvc) SkipBuffer(ctx context.Context, buffer string) (int64, error) {
+	js, err := jss.jetStreamContext(buffer)
+	if err != nil {
+		return 0, err
+	}
+	streamName := JetStreamName(buffer)
+	consumer, err := js.ConsumerInfo(streamName, streamName)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get consumer information of stream %q, %w", streamName, err)
+	}
+	if err := js.PurgeStream(streamName, nats.Context(ctx)); err != nil {
+		return 0, fmt.Errorf("failed to purge stream %q, %w", streamName, err)
+	}
+	return int64(consumer.NumPending) + int64(consumer.NumAckPending), nil
+}
+
+// ResetWatermarks deletes all the keys of the offset timeline and processor KVs of the buckets. The keys are deleted
+// instead of purging the KVs, so that the watchers are notified.
+func (jss *jetStreamSvc) ResetWatermarks(ctx context.Context, buckets []string) error {
+	for _, bucket := range buckets {
+		js, err := jss.jetStreamContext(bucket)
+		if err != nil {
+			return err
+		}
+		for _, kvName := range []string{wmstore.JetStreamOTKVName(bucket), wmstore.JetStreamProcessorKVName(bucket)} {
+			kv, err := js.KeyValue(kvName)
+			if err != nil {
+				return fmt.Errorf("failed to get KV %q, %w", kvName, err)
+			}
+			keys, err := kv.Keys(nats.Context(ctx))
+			if err != nil {
+				if errors.Is(err, nats.ErrNoKeysFound) {
+					continue
+				}
+				return fmt.Errorf("failed to list the keys of KV %q, %w", kvName, err)
+			}
+			for _, k := range keys {
+				if err := kv.Delete(k); err != nil {
+					return fmt.Errorf("failed to delete key %q of KV %q, %w", k, kvName, err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// RefreshSideInput sends a refresh request to the side inputs manager of the side input, the manager replies an empty
+// message when the value is retrieved and broadcast, or the error message otherwise.
+func (jss *jetStreamSvc) RefreshSideInput(ctx context.Context, sideInputsStore, sideInput string) error {
+	if jss.jsClient == nil {
+		return fmt.Errorf("no JetStream client is available")
+	}
+	reply, err := jss.jsClient.Request(ctx, JetStreamSideInputRefreshSubject(sideInputsStore, sideInput), nil)
+	if err != nil {
+		if errors.Is(err, nats.ErrNoResponders) {
+			return fmt.Errorf("side inputs manager of %q is not running", sideInput)
+		}
+		return fmt.Errorf("failed to send the refresh request, %w", err)
+	}
+	if len(reply.Data) > 0 {
+		return fmt.Errorf("%s", reply.Data)
+	}
+	return nil
+}
+
+// jetStreamContext returns the JetStream context of the long-running client, like the one of the daemon server, in the
+// domain of the given buffer or bucket.
+func (jss *jetStreamSvc) jetStreamContext(name string) (nats.JetStreamContext, error) {
+	if jss.jsClient == nil {
+		return nil, fmt.Errorf("no JetStream client is available")
+	}
+	if jss.js == nil {
+		js, err := jss.jsClient.JetStreamContext()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get a JetStream context from nats connection, %w", err)
+		}
+		jss.js = js
+	}
+	return jss.jetStreamContextOf(jss.jsClient, jss.js, name)
+}
+
 func (jss *jetStreamSvc) GetBufferInfo(ctx context.Context, buffer string) (*BufferInfo, error) {
 	var js nats.JetStreamContext
 	var err error
-	if jss.js != nil { // Daemon server use case
-		js = jss.js
-	} else if jss.jsClient != nil { // Daemon server first time access use case
-		js, err = jss.jsClient.JetStreamContext()
+	if jss.jsClient != nil { // Daemon server use case
+		js, err = jss.jetStreamContext(buffer)
 		if err != nil {
-			return nil, fmt.Errorf("failed to get a JetStream context from nats connection, %w", err)
+			return nil, err
 		}
-		jss.js = js
 	} else { // Short running use case
 		nc, err := jsclient.NewNATSClient(ctx)
 		if err != nil {
@@ -290,16 +565,18 @@ func (jss *jetStreamSvc) GetBufferInfo(ctx context.Context, buffer string) (*Buf
 		if err != nil {
 			return nil, fmt.Errorf("failed to get a JetStream context from nats connection, %w", err)
 		}
-		jss.js = js
+		if js, err = jss.jetStreamContextOf(nc, js, buffer); err != nil {
+			return nil, err
+		}
 	}
 	streamName := JetStreamName(buffer)
 	stream, err := js.StreamInfo(streamName)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get information of stream %q", streamName)
+		return nil, fmt.Errorf("failed to get information of stream %q, %w", streamName, err)
 	}
 	consumer, err := js.ConsumerInfo(streamName, streamName)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get consumer information of stream %q", streamName)
+		return nil, fmt.Errorf("failed to get consumer information of stream %q, %w", streamName, err)
 	}
 	totalMessages := int64(stream.State.Msgs)
 	if stream.Config.Retention == nats.LimitsPolicy {
@@ -325,7 +602,7 @@ func (jss *jetStreamSvc) CreateProcessorManagers(ctx context.Context, bucketName
 	}
 	// if it's not a reduce vertex, we don't need multiple watermark fetchers. We use common fetcher among all partitions.
 	for i := 0; i < fetchers; i++ {
-		storeWatcher, err := wmstore.BuildJetStreamWatermarkStoreWatcher(ctx, bucketName, jss.jsClient)
+		storeWatcher, err := wmstore.BuildJetStreamWatermarkStoreWatcher(ctx, bucketName, jss.jsClient.WithDomain(jss.domains[bucketName]))
 		if err != nil {
 			return nil, fmt.Errorf("failed at new JetStream watermark store watcher, %w", err)
 		}
@@ -347,3 +624,21 @@ func JetStreamName(bufferName string) string {
 func JetStreamSideInputsStoreKVName(sideInputStoreName string) string {
 	return fmt.Sprintf("%s_SIDE_INPUTS", sideInputStoreName)
 }
+
+// JetStreamSideInputRefreshSubject is the subject the side inputs manager of a side input listens on for the refresh
+// requests.
+func JetStreamSideInputRefreshSubject(sideInputStoreName, sideInput string) string {
+	return fmt.Sprintf("%s_SIDE_INPUTS.refresh.%s", sideInputStoreName, sideInput)
+}
+
+func JetStreamCountersKVName(storeName string) string {
+	return fmt.Sprintf("%s_COUNTERS", storeName)
+}
+
+func JetStreamMetricsHistoryKVName(storeName string) string {
+	return fmt.Sprintf("%s_METRICS_HISTORY", storeName)
+}
+
+func JetStreamClaimCheckStoreName(storeName string) string {
+	return fmt.Sprintf("%s_CLAIM_CHECK", storeName)
+}
@@ -18,8 +18,10 @@ package isbsvc
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"github.com/redis/go-redis/v9"
 	"go.uber.org/multierr"
 	"go.uber.org/zap"
 
@@ -124,7 +126,7 @@ func (r *isbsRedisSvc) ValidateBuffersAndBuckets(ctx context.Context, buffers, b
 // GetBufferInfo is used to provide buffer information like pending count, buffer length, has unprocessed data etc.
 func (r *isbsRedisSvc) GetBufferInfo(ctx context.Context, buffer string) (*BufferInfo, error) {
 	group := fmt.Sprintf("%s-group", buffer)
-	rqw := redis2.NewBufferWrite(ctx, redisclient.NewInClusterRedisClient(), buffer, group, 0, redisclient.WithRefreshBufferWriteInfo(false))
+	rqw := redis2.NewBufferWrite(ctx, r.client, buffer, group, 0, redisclient.WithRefreshBufferWriteInfo(false))
 	var bufferWrite = rqw.(*redis2.BufferWrite)
 
 	bufferInfo := &BufferInfo{
@@ -137,6 +139,98 @@ func (r *isbsRedisSvc) GetBufferInfo(ctx context.Context, buffer string) (*Buffe
 	return bufferInfo, nil
 }
 
+// drainConsumer is the consumer of the stream group used to read the messages when draining a buffer.
+const drainConsumer = "numaflow-drain"
+
+// DrainBuffer reads and acks the messages of a buffer which are not delivered to any consumer yet, it stops at the lag
+// of the stream group when it starts.
+func (r *isbsRedisSvc) DrainBuffer(ctx context.Context, buffer string) (int64, error) {
+	stream := redisclient.GetRedisStreamName(buffer)
+	group := fmt.Sprintf("%s-group", buffer)
+	target, err := r.groupLag(ctx, stream, group)
+	if err != nil {
+		return 0, err
+	}
+	var drained int64
+	defer func() {
+		// The consumer created by the reads has nothing pending after the acks.
+		_ = r.client.Client.XGroupDelConsumer(ctx, stream, group, drainConsumer).Err()
+	}()
+	for drained < target {
+		result, err := r.client.Client.XReadGroup(ctx, &redis.XReadGroupArgs{
+			Group:    group,
+			Consumer: drainConsumer,
+			Streams:  []string{stream, ">"},
+			Count:    target - drained,
+			Block:    -1,
+		}).Result()
+		if err != nil {
+			if errors.Is(err, redis.Nil) {
+				break
+			}
+			return drained, fmt.Errorf("failed to read the stream %q, %w", stream, err)
+		}
+		var ids []string
+		for _, s := range result {
+			for _, m := range s.Messages {
+				ids = append(ids, m.ID)
+			}
+		}
+		if len(ids) == 0 {
+			break
+		}
+		if err := r.client.Client.XAck(ctx, stream, group, ids...).Err(); err != nil {
+			return drained, fmt.Errorf("failed to ack the messages of stream %q, %w", stream, err)
+		}
+		drained += int64(len(ids))
+	}
+	return drained, nil
+}
+
+// SkipBuffer sets the last delivered ID of the stream group to the latest, the messages delivered but not acked yet
+// are left to the readers.
+func (r *isbsRedisSvc) SkipBuffer(ctx context.Context, buffer string) (int64, error) {
+	stream := redisclient.GetRedisStreamName(buffer)
+	group := fmt.Sprintf("%s-group", buffer)
+	lag, err := r.groupLag(ctx, stream, group)
+	if err != nil {
+		return 0, err
+	}
+	if err := r.client.Client.XGroupSetID(ctx, stream, group, redisclient.ReadFromLatest).Err(); err != nil {
+		return 0, fmt.Errorf("failed to set the ID of group %q of stream %q, %w", group, stream, err)
+	}
+	return lag, nil
+}
+
+// groupLag returns the number of the messages not delivered to the stream group yet, it requires Redis >= 7.0.
+func (r *isbsRedisSvc) groupLag(ctx context.Context, stream, group string) (int64, error) {
+	groups, err := r.client.StreamGroupInfo(ctx, stream)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get the groups of stream %q, %w", stream, err)
+	}
+	for _, g := range groups {
+		if g.Name == group {
+			return g.Lag, nil
+		}
+	}
+	return 0, fmt.Errorf("group %q of stream %q not found", group, stream)
+}
+
+// ResetWatermarks is a no-op, watermarks are not supported for Redis ATM.
+func (r *isbsRedisSvc) ResetWatermarks(ctx context.Context, buckets []string) error {
+	return nil
+}
+
+// DeleteSideInputs is not needed, side inputs are not supported for Redis ATM.
+func (r *isbsRedisSvc) DeleteSideInputs(ctx context.Context, sideInputsStore string, sideInputs []string) error {
+	return nil
+}
+
+// RefreshSideInput is not supported, side inputs are not supported for Redis ATM.
+func (r *isbsRedisSvc) RefreshSideInput(ctx context.Context, sideInputsStore, sideInput string) error {
+	return fmt.Errorf("side inputs are not supported for redis isbsvc")
+}
+
 // CreateProcessorManagers is used to create the processor managers for the given bucket.
 func (r *isbsRedisSvc) CreateProcessorManagers(ctx context.Context, bucketName string, fromBufferPartitionCount int, isReduce bool) ([]*processor.ProcessorManager, error) {
 	log := logging.FromContext(ctx).With("bucket", bucketName)
@@ -0,0 +1,188 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"google.golang.org/protobuf/proto"
+)
+
+// OverflowLabelValue is the label value of the series folded together because of the label cardinality cap.
+const OverflowLabelValue = "other"
+
+// reservedMetrics are the metrics scraped by the daemon server for pending and rate calculation,
+// they are never pruned to keep the autoscaling working.
+var reservedMetrics = map[string]struct{}{
+	VertexPendingMessages:          {},
+	"forwarder_read_total":         {},
+	"source_forwarder_read_total":  {},
+	"reduce_isb_reader_read_total": {},
+}
+
+// guardrails defines how the metrics are pruned before being exposed, to protect Prometheus from high cardinality metrics.
+type guardrails struct {
+	// disabledHistograms are the names of the histograms not to be exposed.
+	disabledHistograms map[string]struct{}
+	// prunedLabels are the labels removed from all the metrics, the series only differing by them are merged.
+	prunedLabels map[string]struct{}
+	// maxLabelValues is the max number of distinct values of a label in a metric, values beyond it are folded into OverflowLabelValue.
+	// 0 means unlimited.
+	maxLabelValues int
+}
+
+func (g *guardrails) enabled() bool {
+	return g != nil && (len(g.disabledHistograms) > 0 || len(g.prunedLabels) > 0 || g.maxLabelValues > 0)
+}
+
+// guardedGatherer is a prometheus.Gatherer applying the guardrails to the gathered metrics.
+type guardedGatherer struct {
+	gatherer   prometheus.Gatherer
+	guardrails *guardrails
+	lock       sync.Mutex
+	// admittedValues records the first seen values of each metric and label, so that the folding is stable across the scrapes.
+	admittedValues map[string]map[string]map[string]struct{}
+}
+
+func newGuardedGatherer(gatherer prometheus.Gatherer, g *guardrails) *guardedGatherer {
+	return &guardedGatherer{
+		gatherer:       gatherer,
+		guardrails:     g,
+		admittedValues: make(map[string]map[string]map[string]struct{}),
+	}
+}
+
+// Gather implements prometheus.Gatherer.
+func (gg *guardedGatherer) Gather() ([]*dto.MetricFamily, error) {
+	mfs, err := gg.gatherer.Gather()
+	result := make([]*dto.MetricFamily, 0, len(mfs))
+	for _, mf := range mfs {
+		if _, ok := reservedMetrics[mf.GetName()]; ok {
+			result = append(result, mf)
+			continue
+		}
+		if _, ok := gg.guardrails.disabledHistograms[mf.GetName()]; ok && mf.GetType() == dto.MetricType_HISTOGRAM {
+			continue
+		}
+		result = append(result, gg.prune(mf))
+	}
+	return result, err
+}
+
+// prune removes the pruned labels, folds the label values beyond the cap, and merges the series with the same labels.
+func (gg *guardedGatherer) prune(mf *dto.MetricFamily) *dto.MetricFamily {
+	if len(gg.guardrails.prunedLabels) == 0 && gg.guardrails.maxLabelValues <= 0 {
+		return mf
+	}
+	gg.lock.Lock()
+	defer gg.lock.Unlock()
+	changed := false
+	for _, m := range mf.GetMetric() {
+		labels := make([]*dto.LabelPair, 0, len(m.GetLabel()))
+		for _, l := range m.GetLabel() {
+			if _, ok := gg.guardrails.prunedLabels[l.GetName()]; ok {
+				changed = true
+				continue
+			}
+			if !gg.admit(mf.GetName(), l.GetName(), l.GetValue()) {
+				l = &dto.LabelPair{Name: proto.String(l.GetName()), Value: proto.String(OverflowLabelValue)}
+				changed = true
+			}
+			labels = append(labels, l)
+		}
+		m.Label = labels
+	}
+	if !changed {
+		return mf
+	}
+	var merged []*dto.Metric
+	index := make(map[string]int)
+	for _, m := range mf.GetMetric() {
+		sig := labelSignature(m.GetLabel())
+		if i, ok := index[sig]; ok {
+			mergeMetric(merged[i], m)
+			continue
+		}
+		index[sig] = len(merged)
+		merged = append(merged, m)
+	}
+	mf.Metric = merged
+	return mf
+}
+
+// admit returns true if the label value is within the cap of the distinct values.
+func (gg *guardedGatherer) admit(metric, label, value string) bool {
+	if gg.guardrails.maxLabelValues <= 0 {
+		return true
+	}
+	labels, ok := gg.admittedValues[metric]
+	if !ok {
+		labels = make(map[string]map[string]struct{})
+		gg.admittedValues[metric] = labels
+	}
+	values, ok := labels[label]
+	if !ok {
+		values = make(map[string]struct{})
+		labels[label] = values
+	}
+	if _, ok := values[value]; ok {
+		return true
+	}
+	if len(values) >= gg.guardrails.maxLabelValues {
+		return false
+	}
+	values[value] = struct{}{}
+	return true
+}
+
+func labelSignature(labels []*dto.LabelPair) string {
+	pairs := make([]string, 0, len(labels))
+	for _, l := range labels {
+		pairs = append(pairs, l.GetName()+"="+l.GetValue())
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, "\xff")
+}
+
+// mergeMetric adds the values of src to dst. The quantiles of the summaries can't be merged, so they are removed.
+func mergeMetric(dst, src *dto.Metric) {
+	switch {
+	case dst.Counter != nil && src.Counter != nil:
+		dst.Counter.Value = proto.Float64(dst.Counter.GetValue() + src.Counter.GetValue())
+	case dst.Gauge != nil && src.Gauge != nil:
+		dst.Gauge.Value = proto.Float64(dst.Gauge.GetValue() + src.Gauge.GetValue())
+	case dst.Untyped != nil && src.Untyped != nil:
+		dst.Untyped.Value = proto.Float64(dst.Untyped.GetValue() + src.Untyped.GetValue())
+	case dst.Histogram != nil && src.Histogram != nil:
+		dst.Histogram.SampleCount = proto.Uint64(dst.Histogram.GetSampleCount() + src.Histogram.GetSampleCount())
+		dst.Histogram.SampleSum = proto.Float64(dst.Histogram.GetSampleSum() + src.Histogram.GetSampleSum())
+		// the series of the same metric share the same buckets
+		for i, b := range dst.Histogram.GetBucket() {
+			if i < len(src.Histogram.GetBucket()) {
+				b.CumulativeCount = proto.Uint64(b.GetCumulativeCount() + src.Histogram.GetBucket()[i].GetCumulativeCount())
+			}
+		}
+	case dst.Summary != nil && src.Summary != nil:
+		dst.Summary.SampleCount = proto.Uint64(dst.Summary.GetSampleCount() + src.Summary.GetSampleCount())
+		dst.Summary.SampleSum = proto.Float64(dst.Summary.GetSampleSum() + src.Summary.GetSampleSum())
+		dst.Summary.Quantile = nil
+	}
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestRegistry() (*prometheus.Registry, *prometheus.CounterVec, *prometheus.HistogramVec) {
+	reg := prometheus.NewRegistry()
+	counter := prometheus.NewCounterVec(prometheus.CounterOpts{Name: "test_write_total"}, []string{LabelVertex, LabelPartitionName})
+	histogram := prometheus.NewHistogramVec(prometheus.HistogramOpts{Name: "test_processing_time", Buckets: []float64{1, 10}}, []string{LabelVertex, LabelPartitionName})
+	pendingGauge := prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: VertexPendingMessages}, []string{LabelVertex, LabelPartitionName})
+	reg.MustRegister(counter, histogram, pendingGauge)
+	for i := 0; i < 5; i++ {
+		counter.WithLabelValues("v", fmt.Sprintf("p-%d", i)).Add(float64(i + 1))
+		histogram.WithLabelValues("v", fmt.Sprintf("p-%d", i)).Observe(float64(i))
+		pendingGauge.WithLabelValues("v", fmt.Sprintf("p-%d", i)).Set(float64(i))
+	}
+	return reg, counter, histogram
+}
+
+func getFamily(mfs []*dto.MetricFamily, name string) *dto.MetricFamily {
+	for _, mf := range mfs {
+		if mf.GetName() == name {
+			return mf
+		}
+	}
+	return nil
+}
+
+func TestGuardedGatherer_DisabledHistograms(t *testing.T) {
+	reg, _, _ := newTestRegistry()
+	g := newGuardedGatherer(reg, &guardrails{disabledHistograms: toSet([]string{"test_processing_time", "test_write_total"})})
+	mfs, err := g.Gather()
+	assert.NoError(t, err)
+	assert.Nil(t, getFamily(mfs, "test_processing_time"))
+	// not a histogram
+	assert.NotNil(t, getFamily(mfs, "test_write_total"))
+}
+
+func TestGuardedGatherer_PrunedLabels(t *testing.T) {
+	reg, _, _ := newTestRegistry()
+	g := newGuardedGatherer(reg, &guardrails{prunedLabels: toSet([]string{LabelPartitionName})})
+	mfs, err := g.Gather()
+	assert.NoError(t, err)
+	counter := getFamily(mfs, "test_write_total")
+	assert.Len(t, counter.GetMetric(), 1)
+	assert.Equal(t, float64(15), counter.GetMetric()[0].GetCounter().GetValue())
+	assert.Len(t, counter.GetMetric()[0].GetLabel(), 1)
+	histogram := getFamily(mfs, "test_processing_time")
+	assert.Len(t, histogram.GetMetric(), 1)
+	assert.Equal(t, uint64(5), histogram.GetMetric()[0].GetHistogram().GetSampleCount())
+	assert.Equal(t, uint64(2), histogram.GetMetric()[0].GetHistogram().GetBucket()[0].GetCumulativeCount())
+	// reserved metrics are untouched
+	assert.Len(t, getFamily(mfs, VertexPendingMessages).GetMetric(), 5)
+}
+
+func TestGuardedGatherer_MaxLabelValues(t *testing.T) {
+	reg, counter, _ := newTestRegistry()
+	g := newGuardedGatherer(reg, &guardrails{maxLabelValues: 2})
+	mfs, err := g.Gather()
+	assert.NoError(t, err)
+	mf := getFamily(mfs, "test_write_total")
+	assert.Len(t, mf.GetMetric(), 3)
+	values := make(map[string]float64)
+	for _, m := range mf.GetMetric() {
+		for _, l := range m.GetLabel() {
+			if l.GetName() == LabelPartitionName {
+				values[l.GetValue()] = m.GetCounter().GetValue()
+			}
+		}
+	}
+	assert.Equal(t, map[string]float64{"p-0": 1, "p-1": 2, OverflowLabelValue: 12}, values)
+
+	// the admitted values are stable across the scrapes
+	counter.WithLabelValues("v", "a-new-partition").Add(1)
+	mfs, err = g.Gather()
+	assert.NoError(t, err)
+	mf = getFamily(mfs, "test_write_total")
+	assert.Len(t, mf.GetMetric(), 3)
+	for _, m := range mf.GetMetric() {
+		for _, l := range m.GetLabel() {
+			if l.GetName() == LabelPartitionName && l.GetValue() == OverflowLabelValue {
+				assert.Equal(t, float64(13), m.GetCounter().GetValue())
+			}
+		}
+	}
+	assert.Len(t, getFamily(mfs, VertexPendingMessages).GetMetric(), 5)
+}
+
+func TestGuardrails_Enabled(t *testing.T) {
+	var g *guardrails
+	assert.False(t, g.enabled())
+	assert.False(t, (&guardrails{}).enabled())
+	assert.True(t, (&guardrails{maxLabelValues: 1}).enabled())
+	ms := NewMetricsServer(nil, WithPrunedLabels([]string{" ", LabelPartitionName}))
+	assert.True(t, ms.guardrails.enabled())
+	assert.Len(t, ms.guardrails.prunedLabels, 1)
+}
@@ -23,16 +23,22 @@ import (
 	"net/http"
 	"net/http/pprof"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/shared/component"
+	"github.com/numaproj/numaflow/pkg/shared/errorlog"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/shared/msglog"
+	"github.com/numaproj/numaflow/pkg/shared/pause"
 	sharedqueue "github.com/numaproj/numaflow/pkg/shared/queue"
 	sharedtls "github.com/numaproj/numaflow/pkg/shared/tls"
 	"github.com/numaproj/numaflow/pkg/shared/util"
@@ -44,6 +50,7 @@ const (
 	LabelPeriod             = "period"
 	LabelVertexReplicaIndex = "replica"
 	LabelPartitionName      = "partition_name"
+	LabelService            = "service"
 
 	VertexPendingMessages = "vertex_pending_messages"
 )
@@ -79,6 +86,8 @@ type metricsServer struct {
 	partitionPendingInfo map[string]*sharedqueue.OverflowQueue[timestampedPending]
 	// Functions that health check executes
 	healthCheckExecutors []func() error
+	// guardrails to prune the exposed metrics
+	guardrails *guardrails
 }
 
 type Option func(*metricsServer)
@@ -111,6 +120,36 @@ func WithHealthCheckExecutor(f func() error) Option {
 	}
 }
 
+// WithDisabledHistograms sets the names of the histograms not to be exposed
+func WithDisabledHistograms(names []string) Option {
+	return func(m *metricsServer) {
+		if m.guardrails == nil {
+			m.guardrails = &guardrails{}
+		}
+		m.guardrails.disabledHistograms = toSet(names)
+	}
+}
+
+// WithPrunedLabels sets the labels to be removed from the exposed metrics
+func WithPrunedLabels(labels []string) Option {
+	return func(m *metricsServer) {
+		if m.guardrails == nil {
+			m.guardrails = &guardrails{}
+		}
+		m.guardrails.prunedLabels = toSet(labels)
+	}
+}
+
+// WithMaxLabelValues sets the max number of distinct values of a label in an exposed metric
+func WithMaxLabelValues(n int) Option {
+	return func(m *metricsServer) {
+		if m.guardrails == nil {
+			m.guardrails = &guardrails{}
+		}
+		m.guardrails.maxLabelValues = n
+	}
+}
+
 // NewMetricsOptions returns a metrics option list.
 func NewMetricsOptions(ctx context.Context, vertex *dfv1.Vertex, healthCheckers []HealthChecker, readers []isb.BufferReader) []Option {
 	metricsOpts := []Option{
@@ -136,6 +175,15 @@ func NewMetricsOptions(ctx context.Context, vertex *dfv1.Vertex, healthCheckers
 	if len(lagReaders) > 0 {
 		metricsOpts = append(metricsOpts, WithLagReaders(lagReaders))
 	}
+	if x := util.LookupEnvStringOr(dfv1.EnvMetricsDisabledHistograms, ""); x != "" {
+		metricsOpts = append(metricsOpts, WithDisabledHistograms(strings.Split(x, ",")))
+	}
+	if x := util.LookupEnvStringOr(dfv1.EnvMetricsPrunedLabels, ""); x != "" {
+		metricsOpts = append(metricsOpts, WithPrunedLabels(strings.Split(x, ",")))
+	}
+	if x := util.LookupEnvIntOr(dfv1.EnvMetricsMaxLabelValues, 0); x > 0 {
+		metricsOpts = append(metricsOpts, WithMaxLabelValues(x))
+	}
 	return metricsOpts
 }
 
@@ -247,7 +295,13 @@ func (ms *metricsServer) Start(ctx context.Context) (func(ctx context.Context) e
 		return nil, fmt.Errorf("failed to generate cert: %w", err)
 	}
 	mux := http.NewServeMux()
-	mux.Handle("/metrics", promhttp.Handler())
+	if ms.guardrails.enabled() {
+		log.Infow("Enabling metrics guardrails", zap.Int("maxLabelValues", ms.guardrails.maxLabelValues))
+		mux.Handle("/metrics", promhttp.InstrumentMetricHandler(prometheus.DefaultRegisterer,
+			promhttp.HandlerFor(newGuardedGatherer(prometheus.DefaultGatherer, ms.guardrails), promhttp.HandlerOpts{})))
+	} else {
+		mux.Handle("/metrics", promhttp.Handler())
+	}
 	mux.HandleFunc("/readyz", func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusNoContent)
 	})
@@ -255,16 +309,18 @@ func (ms *metricsServer) Start(ctx context.Context) (func(ctx context.Context) e
 		w.WriteHeader(http.StatusNoContent)
 	})
 	mux.HandleFunc("/sidecar-livez", func(w http.ResponseWriter, r *http.Request) {
-		for _, ex := range ms.healthCheckExecutors {
-			if err := ex(); err != nil {
-				log.Errorw("Failed to execute sidecar health check", zap.Error(err))
-				w.WriteHeader(http.StatusInternalServerError)
-				_, _ = w.Write([]byte(err.Error()))
-				return
-			}
+		if err := ms.checkSidecarHealth(); err != nil {
+			log.Errorw("Failed to execute sidecar health check", zap.Error(err))
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(err.Error()))
+			return
 		}
 		w.WriteHeader(http.StatusNoContent)
 	})
+	mux.HandleFunc(errorlog.Path, errorlog.Handler)
+	mux.HandleFunc(msglog.Path, msglog.Handler)
+	mux.Handle(logging.LevelPath, logging.LevelHandler())
+	mux.HandleFunc(pause.Path, pause.Handler)
 	pprofEnabled := os.Getenv(dfv1.EnvDebug) == "true" || os.Getenv(dfv1.EnvPPROF) == "true"
 	if pprofEnabled {
 		mux.HandleFunc("/debug/pprof/", pprof.Index)
@@ -276,9 +332,21 @@ func (ms *metricsServer) Start(ctx context.Context) (func(ctx context.Context) e
 		log.Info("Not enabling pprof debug endpoints")
 	}
 
+	// The component gRPC service is served on the same port, over HTTP/2
+	grpcServer := grpc.NewServer()
+	component.NewServer(dfv1.ComponentVertex,
+		component.WithConfig(func() interface{} { return ms.vertex }),
+		component.WithHealthCheck(ms.checkSidecarHealth),
+	).Register(grpcServer)
 	httpServer := &http.Server{
-		Addr:      fmt.Sprintf(":%d", dfv1.VertexMetricsPort),
-		Handler:   mux,
+		Addr: fmt.Sprintf(":%d", dfv1.VertexMetricsPort),
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.ProtoMajor == 2 && strings.HasPrefix(r.Header.Get("Content-Type"), "application/grpc") {
+				grpcServer.ServeHTTP(w, r)
+				return
+			}
+			mux.ServeHTTP(w, r)
+		}),
 		TLSConfig: &tls.Config{Certificates: []tls.Certificate{*cer}, MinVersion: tls.VersionTLS12},
 	}
 	// Buildup pending information
@@ -294,3 +362,23 @@ func (ms *metricsServer) Start(ctx context.Context) (func(ctx context.Context) e
 	}()
 	return httpServer.Shutdown, nil
 }
+
+// checkSidecarHealth executes the health checks of the sidecars, it returns the first error.
+func (ms *metricsServer) checkSidecarHealth() error {
+	for _, ex := range ms.healthCheckExecutors {
+		if err := ex(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func toSet(items []string) map[string]struct{} {
+	s := make(map[string]struct{})
+	for _, item := range items {
+		if item = strings.TrimSpace(item); item != "" {
+			s[item] = struct{}{}
+		}
+	}
+	return s
+}
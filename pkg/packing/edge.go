@@ -0,0 +1,103 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package packing
+
+import (
+	"context"
+	"sync"
+
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/isb/stores/simplebuffer"
+)
+
+// ackedEdge is the in-memory edge from the source to a packed sink. A write to it returns only after the sink acks the
+// messages written, i.e. after they are written to the sink, so that the source acks the messages only when they can't
+// be lost by a crash of the pod.
+type ackedEdge struct {
+	*simplebuffer.InMemoryBuffer
+	// done is closed when the sink stops, no more messages will be acked then.
+	done <-chan struct{}
+	lock sync.Mutex
+	// waiting are the channels closed when the messages at the offsets are acked, keyed by the offsets.
+	waiting map[string]chan struct{}
+}
+
+var _ isb.BufferReader = (*ackedEdge)(nil)
+var _ isb.BufferWriter = (*ackedEdge)(nil)
+
+func newAckedEdge(buffer *simplebuffer.InMemoryBuffer, done <-chan struct{}) *ackedEdge {
+	return &ackedEdge{
+		InMemoryBuffer: buffer,
+		done:           done,
+		waiting:        make(map[string]chan struct{}),
+	}
+}
+
+// Write writes the messages to the buffer and waits until the sink acks the ones written. The messages not acked before
+// the context is canceled or the sink stops are returned with errors, so that they are not acked at the source.
+func (e *ackedEdge) Write(ctx context.Context, messages []isb.Message) ([]isb.Offset, []error) {
+	// Hold the lock until the channels are registered, so that the sink can't ack the messages before.
+	e.lock.Lock()
+	offsets, errs := e.InMemoryBuffer.Write(ctx, messages)
+	acked := make([]chan struct{}, len(messages))
+	for i := range messages {
+		if errs[i] == nil && offsets[i] != nil {
+			acked[i] = make(chan struct{})
+			e.waiting[offsets[i].String()] = acked[i]
+		}
+	}
+	e.lock.Unlock()
+
+	for i, ch := range acked {
+		if ch == nil {
+			continue
+		}
+		select {
+		case <-ch:
+		case <-ctx.Done():
+			errs[i] = isb.BufferWriteErr{Name: e.GetName(), Message: "context canceled before the message is acked by the sink"}
+		case <-e.done:
+			errs[i] = isb.BufferWriteErr{Name: e.GetName(), Message: "sink stopped before the message is acked"}
+		}
+	}
+
+	e.lock.Lock()
+	for i, ch := range acked {
+		if ch != nil {
+			delete(e.waiting, offsets[i].String())
+		}
+	}
+	e.lock.Unlock()
+	return offsets, errs
+}
+
+// Ack acks the messages in the buffer, and releases the writes waiting for them.
+func (e *ackedEdge) Ack(ctx context.Context, offsets []isb.Offset) []error {
+	errs := e.InMemoryBuffer.Ack(ctx, offsets)
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	for i, o := range offsets {
+		if errs[i] != nil {
+			continue
+		}
+		if ch, ok := e.waiting[o.String()]; ok {
+			close(ch)
+			delete(e.waiting, o.String())
+		}
+	}
+	return errs
+}
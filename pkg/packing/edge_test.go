@@ -0,0 +1,75 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package packing
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/isb/stores/simplebuffer"
+	"github.com/numaproj/numaflow/pkg/isb/testutils"
+)
+
+func TestAckedEdge_WriteWaitsForAck(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	e := newAckedEdge(simplebuffer.NewInMemoryBuffer("test", 10, 0, simplebuffer.WithSequenceOffsets()), make(chan struct{}))
+
+	written := make(chan []error)
+	go func() {
+		_, errs := e.Write(ctx, testutils.BuildTestWriteMessages(3, time.Unix(1636470000, 0)))
+		written <- errs
+	}()
+
+	var readOffsets []isb.Offset
+	for len(readOffsets) < 3 {
+		msgs, err := e.Read(ctx, 3)
+		assert.NoError(t, err)
+		for _, m := range msgs {
+			readOffsets = append(readOffsets, m.ReadOffset)
+		}
+	}
+	e.Ack(ctx, readOffsets[:2])
+	select {
+	case <-written:
+		t.Fatal("the write should wait until all the messages are acked")
+	case <-time.After(100 * time.Millisecond):
+	}
+	e.Ack(ctx, readOffsets[2:])
+	assert.Equal(t, []error{nil, nil, nil}, <-written)
+	assert.Empty(t, e.waiting)
+}
+
+func TestAckedEdge_SinkStopped(t *testing.T) {
+	ctx := context.Background()
+	done := make(chan struct{})
+	e := newAckedEdge(simplebuffer.NewInMemoryBuffer("test", 2, 0, simplebuffer.WithSequenceOffsets()), done)
+	close(done)
+
+	_, errs := e.Write(ctx, testutils.BuildTestWriteMessages(3, time.Unix(1636470000, 0)))
+	assert.Len(t, errs, 3)
+	for _, err := range errs {
+		assert.ErrorAs(t, err, &isb.BufferWriteErr{})
+	}
+	// the message not written because the buffer is full is reported as well
+	assert.True(t, errs[2].(isb.BufferWriteErr).Full)
+	assert.Empty(t, e.waiting)
+}
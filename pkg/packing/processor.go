@@ -0,0 +1,139 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package packing runs a source vertex together with the sink vertices packed into its pods. The packed sinks read
+// from in-memory edges written by the source, instead of from the Inter-Step Buffers.
+package packing
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/isb/stores/simplebuffer"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/sinks"
+	"github.com/numaproj/numaflow/pkg/sources"
+)
+
+// drainTimeout is how long the packed sinks are given to drain the in-memory edges after the source stops.
+const drainTimeout = 10 * time.Second
+
+type Processor struct {
+	ISBSvcType     dfv1.ISBSvcType
+	VertexInstance *dfv1.VertexInstance
+	// PackedVertices are the vertex objects of the sinks packed into the pod.
+	PackedVertices []dfv1.Vertex
+}
+
+func (p *Processor) Start(ctx context.Context) error {
+	log := logging.FromContext(ctx)
+	// The sinks keep running after the source stops, to drain the in-memory edges.
+	sinkCtx, cancelSinks := context.WithCancel(logging.WithLogger(context.Background(), log))
+	defer cancelSinks()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		buffers       []*simplebuffer.InMemoryBuffer
+		packedWriters = make(map[string][]isb.BufferWriter)
+		wg            sync.WaitGroup
+		sinkErr       error
+		errOnce       sync.Once
+	)
+	for _, v := range p.PackedVertices {
+		vertex := v
+		if len(vertex.Spec.FromEdges) != 1 {
+			return fmt.Errorf("packed vertex %q should have exactly one from edge", vertex.Spec.Name)
+		}
+		edge := vertex.Spec.FromEdges[0]
+		opts := []simplebuffer.Option{
+			simplebuffer.WithSequenceOffsets(),
+			simplebuffer.WithBufferFullWritingStrategy(edge.BufferFullWritingStrategy()),
+		}
+		if x := vertex.Spec.Limits; x != nil && x.ReadTimeout != nil {
+			opts = append(opts, simplebuffer.WithReadTimeOut(x.ReadTimeout.Duration))
+		}
+		size := int64(dfv1.DefaultBufferLength)
+		if x := vertex.Spec.Limits; x != nil && x.BufferMaxLength != nil {
+			size = int64(*x.BufferMaxLength)
+		}
+		buffer := simplebuffer.NewInMemoryBuffer(vertex.OwnedBuffers()[0], size, 0, opts...)
+		buffers = append(buffers, buffer)
+		sinkDone := make(chan struct{})
+		memEdge := newAckedEdge(buffer, sinkDone)
+		packedWriters[vertex.Spec.Name] = []isb.BufferWriter{memEdge}
+
+		sinkProcessor := &sinks.SinkProcessor{
+			ISBSvcType: p.ISBSvcType,
+			VertexInstance: &dfv1.VertexInstance{
+				Vertex:   &vertex,
+				Hostname: p.VertexInstance.Hostname,
+				Replica:  p.VertexInstance.Replica,
+			},
+			PackedReaders: []isb.BufferReader{memEdge},
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer close(sinkDone)
+			sinkLog := log.With("packedVertex", vertex.Spec.Name)
+			if err := sinkProcessor.Start(logging.WithLogger(sinkCtx, sinkLog)); err != nil {
+				sinkLog.Errorw("Packed sink exited with an error", zap.Error(err))
+				errOnce.Do(func() { sinkErr = err })
+			}
+			// stop the source, there is nothing to consume its messages
+			cancel()
+		}()
+	}
+
+	sourceProcessor := &sources.SourceProcessor{
+		ISBSvcType:     p.ISBSvcType,
+		VertexInstance: p.VertexInstance,
+		PackedWriters:  packedWriters,
+	}
+	err := sourceProcessor.Start(ctx)
+	waitUntilDrained(buffers, drainTimeout)
+	cancelSinks()
+	wg.Wait()
+	if err != nil {
+		return err
+	}
+	return sinkErr
+}
+
+// waitUntilDrained waits until all the messages in the buffers are read, or the timeout is reached.
+func waitUntilDrained(buffers []*simplebuffer.InMemoryBuffer, timeout time.Duration) {
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) {
+		drained := true
+		for _, b := range buffers {
+			if !b.IsEmpty() {
+				drained = false
+				break
+			}
+		}
+		if drained {
+			return
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+}
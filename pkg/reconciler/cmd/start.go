@@ -23,14 +23,18 @@ import (
 	"go.uber.org/zap"
 	appv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/metrics/pkg/client/external_metrics"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	numaflow "github.com/numaproj/numaflow"
@@ -40,6 +44,7 @@ import (
 	plctrl "github.com/numaproj/numaflow/pkg/reconciler/pipeline"
 	vertexctrl "github.com/numaproj/numaflow/pkg/reconciler/vertex"
 	"github.com/numaproj/numaflow/pkg/reconciler/vertex/scaling"
+	"github.com/numaproj/numaflow/pkg/shared/component"
 	logging "github.com/numaproj/numaflow/pkg/shared/logging"
 	sharedutil "github.com/numaproj/numaflow/pkg/shared/util"
 )
@@ -124,7 +129,7 @@ func Start(namespaced bool, managedNamespace string) {
 
 	// Pipeline controller
 	pipelineController, err := controller.New(dfv1.ControllerPipeline, mgr, controller.Options{
-		Reconciler: plctrl.NewReconciler(mgr.GetClient(), mgr.GetScheme(), config, image, logger, mgr.GetEventRecorderFor(dfv1.ControllerPipeline)),
+		Reconciler: plctrl.NewReconciler(mgr.GetClient(), kubeClient, mgr.GetScheme(), config, image, logger, mgr.GetEventRecorderFor(dfv1.ControllerPipeline)),
 	})
 	if err != nil {
 		logger.Fatalw("Unable to set up Pipeline controller", zap.Error(err))
@@ -165,9 +170,9 @@ func Start(namespaced bool, managedNamespace string) {
 	}
 
 	// Vertex controller
-	autoscaler := scaling.NewScaler(mgr.GetClient(), scaling.WithWorkers(20))
+	autoscaler := scaling.NewScaler(mgr.GetClient(), scaling.WithWorkers(20), scaling.WithExternalMetricsClient(external_metrics.NewForConfigOrDie(restConfig)))
 	vertexController, err := controller.New(dfv1.ControllerVertex, mgr, controller.Options{
-		Reconciler: vertexctrl.NewReconciler(mgr.GetClient(), mgr.GetScheme(), config, image, autoscaler, logger, mgr.GetEventRecorderFor(dfv1.ControllerVertex)),
+		Reconciler: vertexctrl.NewReconciler(mgr.GetClient(), kubeClient, mgr.GetScheme(), config, image, autoscaler, logger, mgr.GetEventRecorderFor(dfv1.ControllerVertex)),
 	})
 	if err != nil {
 		logger.Fatalw("Unable to set up Vertex controller", zap.Error(err))
@@ -181,6 +186,12 @@ func Start(namespaced bool, managedNamespace string) {
 		logger.Fatalw("Unable to watch Vertices", zap.Error(err))
 	}
 
+	// Watch the packed Vertices, their specs are passed to the pods of the Vertices they are packed into
+	if err := vertexController.Watch(&source.Kind{Type: &dfv1.Vertex{}}, handler.EnqueueRequestsFromMapFunc(enqueuePackingVertex),
+		predicate.GenerationChangedPredicate{}); err != nil {
+		logger.Fatalw("Unable to watch packed Vertices", zap.Error(err))
+	}
+
 	// Watch Pods
 	if err := vertexController.Watch(&source.Kind{Type: &corev1.Pod{}}, &handler.EnqueueRequestForOwner{OwnerType: &dfv1.Vertex{}, IsController: true},
 		predicate.Funcs{
@@ -194,6 +205,28 @@ func Start(namespaced bool, managedNamespace string) {
 		logger.Fatalw("Unable to watch Services", zap.Error(err))
 	}
 
+	// Watch Pods and Services created in other namespaces, which are not owned by the vertices
+	for _, obj := range []client.Object{&corev1.Pod{}, &corev1.Service{}} {
+		if err := vertexController.Watch(&source.Kind{Type: obj}, handler.EnqueueRequestsFromMapFunc(enqueueCrossNamespaceVertex), predicate.Funcs{
+			CreateFunc: func(event.CreateEvent) bool { return false },
+		}); err != nil {
+			logger.Fatalw("Unable to watch objects in other namespaces", zap.Error(err))
+		}
+	}
+
+	// Serve the most recent autoscaling decisions on the metrics server
+	if err := mgr.AddMetricsExtraHandler("/autoscaling/decisions", autoscaler.DecisionsHandler()); err != nil {
+		logger.Fatalw("Unable to add autoscaling decisions handler", zap.Error(err))
+	}
+
+	// Serve the component service on all the replicas
+	componentServer := component.NewServer(dfv1.ComponentController, component.WithConfig(func() interface{} { return config }))
+	if err := mgr.Add(Runner(func(ctx context.Context) error {
+		return componentServer.Serve(logging.WithLogger(ctx, logger), dfv1.ControllerComponentPort)
+	})); err != nil {
+		logger.Fatalw("Unable to add component server runner", zap.Error(err))
+	}
+
 	// Add autoscaling runner
 	if err := mgr.Add(LeaderElectionRunner(autoscaler.Start)); err != nil {
 		logger.Fatalw("Unable to add autoscaling runner", zap.Error(err))
@@ -218,3 +251,36 @@ func (ler LeaderElectionRunner) NeedLeaderElection() bool {
 
 var _ manager.Runnable = (*LeaderElectionRunner)(nil)
 var _ manager.LeaderElectionRunnable = (*LeaderElectionRunner)(nil)
+
+// Runner is used to convert a function to be able to run on all the replicas, regardless of the leader election.
+type Runner func(ctx context.Context) error
+
+func (r Runner) Start(ctx context.Context) error {
+	return r(ctx)
+}
+
+func (r Runner) NeedLeaderElection() bool {
+	return false
+}
+
+var _ manager.Runnable = (*Runner)(nil)
+var _ manager.LeaderElectionRunnable = (*Runner)(nil)
+
+// enqueuePackingVertex maps a packed vertex to the vertex whose pods run it.
+func enqueuePackingVertex(obj client.Object) []reconcile.Request {
+	v, ok := obj.(*dfv1.Vertex)
+	if !ok || !v.IsPacked() {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: v.Namespace, Name: v.Spec.PipelineName + "-" + v.Spec.PackedInto}}}
+}
+
+// enqueueCrossNamespaceVertex maps an object created for a vertex in another namespace to the vertex.
+func enqueueCrossNamespaceVertex(obj client.Object) []reconcile.Request {
+	l := obj.GetLabels()
+	ns, pipelineName, vertexName := l[dfv1.KeyVertexNamespace], l[dfv1.KeyPipelineName], l[dfv1.KeyVertexName]
+	if ns == "" || pipelineName == "" || vertexName == "" || l[dfv1.KeyManagedBy] != dfv1.ControllerVertex {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: ns, Name: pipelineName + "-" + vertexName}}}
+}
@@ -17,7 +17,11 @@ limitations under the License.
 package reconciler
 
 import (
+	"bytes"
 	"fmt"
+	"path"
+	"strings"
+	"time"
 
 	"github.com/fsnotify/fsnotify"
 	"github.com/spf13/viper"
@@ -28,6 +32,48 @@ import (
 // controller manager.
 type GlobalConfig struct {
 	ISBSvc *ISBSvcConfig `json:"isbsvc"`
+	// RuntimeImagePolicy controls the runtime images that pipelines are allowed to pin, it's enforced by the webhook.
+	RuntimeImagePolicy *RuntimeImagePolicy `json:"runtimeImagePolicy"`
+	// Pipeline is the configuration applied to all the pipelines.
+	Pipeline *PipelineConfig `json:"pipeline"`
+}
+
+type PipelineConfig struct {
+	// DefaultLimits are the default limits of the vertices, they are overridden by the limits in the pipeline and
+	// vertex specs.
+	DefaultLimits *PipelineDefaultLimits `json:"defaultLimits"`
+	// CapacityCheck enables checking if the Inter-Step Buffer Service has enough storage for the buffers of a pipeline
+	// before creating them.
+	CapacityCheck *CapacityCheckConfig `json:"capacityCheck"`
+}
+
+// CapacityCheckConfig is the configuration of checking the storage capacity of the Inter-Step Buffer Services.
+type CapacityCheckConfig struct {
+	// AverageMessageSize is the estimated average size of the messages, e.g. "4Ki", which is used to estimate the
+	// storage of a buffer without a "maxBytes" limit, defaults to 1Ki.
+	AverageMessageSize string `json:"averageMessageSize"`
+	// UsageLimit is the percentage of the storage of an Inter-Step Buffer Service that the buffers are allowed to
+	// take, defaults to 100.
+	UsageLimit *uint32 `json:"usageLimit"`
+}
+
+// PipelineDefaultLimits are the cluster level defaults of the pipeline limits.
+type PipelineDefaultLimits struct {
+	ReadBatchSize    *uint64        `json:"readBatchSize"`
+	BufferMaxLength  *uint64        `json:"bufferMaxLength"`
+	BufferUsageLimit *uint32        `json:"bufferUsageLimit"`
+	ReadTimeout      *time.Duration `json:"readTimeout"`
+	UDFConcurrency   *uint32        `json:"udfConcurrency"`
+	RetryInterval    *time.Duration `json:"retryInterval"`
+}
+
+// RuntimeImagePolicy controls which Numaflow runtime images can be specified in the pipeline spec.
+type RuntimeImagePolicy struct {
+	// RequireDigest requires the runtime images to be pinned by digest, e.g. "quay.io/numaproj/numaflow@sha256:...".
+	RequireDigest bool `json:"requireDigest"`
+	// AllowedImages is a list of patterns (in the syntax of path.Match) of the runtime images allowed,
+	// e.g. "quay.io/numaproj/numaflow:v1.*". Any image is allowed if it's empty.
+	AllowedImages []string `json:"allowedImages"`
 }
 
 type ISBSvcConfig struct {
@@ -93,6 +139,59 @@ func (g *GlobalConfig) GetJetStreamVersion(version string) (*JetStreamVersion, e
 	return nil, fmt.Errorf("no jetstream configuration found for %q", version)
 }
 
+// GetPipelineDefaultLimits returns the default limits of the pipelines, or nil if they are not configured.
+func (g *GlobalConfig) GetPipelineDefaultLimits() *PipelineDefaultLimits {
+	if g == nil || g.Pipeline == nil {
+		return nil
+	}
+	return g.Pipeline.DefaultLimits
+}
+
+// GetCapacityCheck returns the configuration of the capacity check of the Inter-Step Buffer Services, or nil if it's
+// not enabled.
+func (g *GlobalConfig) GetCapacityCheck() *CapacityCheckConfig {
+	if g == nil || g.Pipeline == nil {
+		return nil
+	}
+	return g.Pipeline.CapacityCheck
+}
+
+// ValidateRuntimeImage validates the runtime image against the policy, an empty image means using the default one,
+// which is always allowed.
+func (p *RuntimeImagePolicy) ValidateRuntimeImage(image string) error {
+	if p == nil || image == "" {
+		return nil
+	}
+	if p.RequireDigest && !strings.Contains(image, "@sha256:") {
+		return fmt.Errorf("runtime image %q is not pinned by digest", image)
+	}
+	if len(p.AllowedImages) == 0 {
+		return nil
+	}
+	for _, pattern := range p.AllowedImages {
+		if matched, err := path.Match(pattern, image); err != nil {
+			return fmt.Errorf("invalid allowed runtime image pattern %q, %w", pattern, err)
+		} else if matched {
+			return nil
+		}
+	}
+	return fmt.Errorf("runtime image %q is not allowed by the runtime image policy", image)
+}
+
+// ParseConfig parses the controller configuration from its yaml content.
+func ParseConfig(data []byte) (*GlobalConfig, error) {
+	v := viper.New()
+	v.SetConfigType("yaml")
+	if err := v.ReadConfig(bytes.NewReader(data)); err != nil {
+		return nil, fmt.Errorf("failed to parse configuration. %w", err)
+	}
+	r := &GlobalConfig{}
+	if err := v.Unmarshal(r); err != nil {
+		return nil, fmt.Errorf("failed unmarshal configuration. %w", err)
+	}
+	return r, nil
+}
+
 func LoadConfig(onErrorReloading func(error)) (*GlobalConfig, error) {
 	v := viper.New()
 	v.SetConfigName("controller-config")
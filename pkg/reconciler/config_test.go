@@ -0,0 +1,94 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseConfig(t *testing.T) {
+	config, err := ParseConfig([]byte(`
+runtimeImagePolicy:
+  requireDigest: true
+  allowedImages:
+    - quay.io/numaproj/numaflow@sha256:*
+`))
+	assert.NoError(t, err)
+	assert.NotNil(t, config.RuntimeImagePolicy)
+	assert.True(t, config.RuntimeImagePolicy.RequireDigest)
+	assert.Equal(t, []string{"quay.io/numaproj/numaflow@sha256:*"}, config.RuntimeImagePolicy.AllowedImages)
+
+	config, err = ParseConfig([]byte(`isbsvc: {}`))
+	assert.NoError(t, err)
+	assert.Nil(t, config.RuntimeImagePolicy)
+	assert.Nil(t, config.GetPipelineDefaultLimits())
+	assert.Nil(t, config.GetCapacityCheck())
+
+	config, err = ParseConfig([]byte(`
+pipeline:
+  defaultLimits:
+    readBatchSize: 100
+    bufferMaxLength: 50000
+    readTimeout: 2s
+    udfConcurrency: 20
+    retryInterval: 100ms
+`))
+	assert.NoError(t, err)
+	limits := config.GetPipelineDefaultLimits()
+	assert.NotNil(t, limits)
+	assert.Equal(t, uint64(100), *limits.ReadBatchSize)
+	assert.Equal(t, uint64(50000), *limits.BufferMaxLength)
+	assert.Nil(t, limits.BufferUsageLimit)
+	assert.Equal(t, 2*time.Second, *limits.ReadTimeout)
+	assert.Equal(t, uint32(20), *limits.UDFConcurrency)
+	assert.Equal(t, 100*time.Millisecond, *limits.RetryInterval)
+
+	config, err = ParseConfig([]byte(`
+pipeline:
+  capacityCheck:
+    averageMessageSize: 4Ki
+    usageLimit: 80
+`))
+	assert.NoError(t, err)
+	assert.Nil(t, config.GetPipelineDefaultLimits())
+	cc := config.GetCapacityCheck()
+	assert.NotNil(t, cc)
+	assert.Equal(t, "4Ki", cc.AverageMessageSize)
+	assert.Equal(t, uint32(80), *cc.UsageLimit)
+}
+
+func TestValidateRuntimeImage(t *testing.T) {
+	var p *RuntimeImagePolicy
+	assert.NoError(t, p.ValidateRuntimeImage("my-image:latest"))
+
+	p = &RuntimeImagePolicy{RequireDigest: true}
+	assert.NoError(t, p.ValidateRuntimeImage(""))
+	assert.NoError(t, p.ValidateRuntimeImage("my-image@sha256:abcd"))
+	assert.Error(t, p.ValidateRuntimeImage("my-image:latest"))
+
+	p = &RuntimeImagePolicy{AllowedImages: []string{"quay.io/numaproj/numaflow:v1.*", "quay.io/numaproj/numaflow@sha256:*"}}
+	assert.NoError(t, p.ValidateRuntimeImage("quay.io/numaproj/numaflow:v1.0.0"))
+	assert.NoError(t, p.ValidateRuntimeImage("quay.io/numaproj/numaflow@sha256:abcd"))
+	assert.Error(t, p.ValidateRuntimeImage("quay.io/numaproj/numaflow:v0.9.0"))
+	assert.Error(t, p.ValidateRuntimeImage("my-registry/numaflow:v1.0.0"))
+
+	p = &RuntimeImagePolicy{AllowedImages: []string{"["}}
+	assert.Error(t, p.ValidateRuntimeImage("my-image:latest"))
+}
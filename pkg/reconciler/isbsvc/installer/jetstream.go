@@ -43,10 +43,11 @@ import (
 )
 
 const (
-	clientPort  = int32(4222)
-	clusterPort = int32(6222)
-	monitorPort = int32(8222)
-	metricsPort = int32(7777)
+	clientPort   = int32(4222)
+	clusterPort  = int32(6222)
+	monitorPort  = int32(8222)
+	metricsPort  = int32(7777)
+	leafNodePort = int32(7422)
 )
 
 var (
@@ -137,17 +138,19 @@ func (r *jetStreamInstaller) Install(ctx context.Context) (*dfv1.BufferServiceCo
 			},
 			StreamConfig: string(b),
 			TLSEnabled:   r.isbs.Spec.JetStream.TLS,
+			Domain:       r.isbs.Spec.JetStream.Domain,
 		},
 	}, nil
 }
 
 func (r *jetStreamInstaller) createService(ctx context.Context) error {
 	spec := r.isbs.Spec.JetStream.GetServiceSpec(dfv1.GetJetStreamServiceSpecReq{
-		Labels:      r.labels,
-		MetricsPort: metricsPort,
-		ClusterPort: clusterPort,
-		ClientPort:  clientPort,
-		MonitorPort: monitorPort,
+		Labels:       r.labels,
+		MetricsPort:  metricsPort,
+		ClusterPort:  clusterPort,
+		ClientPort:   clientPort,
+		MonitorPort:  monitorPort,
+		LeafNodePort: leafNodePort,
 	})
 	hash := sharedutil.MustHash(spec)
 	obj := &corev1.Service{
@@ -207,6 +210,7 @@ func (r *jetStreamInstaller) createStatefulSet(ctx context.Context) error {
 		ConfigMapName:              generateJetStreamConfigMapName(r.isbs),
 		PvcNameIfNeeded:            generateJetStreamPVCName(r.isbs),
 		StartCommand:               jsVersion.StartCommand,
+		LeafNodePort:               leafNodePort,
 	})
 	hash := sharedutil.MustHash(spec)
 	obj := &appv1.StatefulSet{
@@ -411,6 +415,26 @@ func (r *jetStreamInstaller) createConfigMap(ctx context.Context) error {
   }
 `
 	}
+	domainSettings := ""
+	if r.isbs.Spec.JetStream.Domain != "" {
+		domainSettings = fmt.Sprintf("domain: %s", r.isbs.Spec.JetStream.Domain)
+	}
+	leafNodesSettings := ""
+	if r.isbs.Spec.JetStream.LeafNodes {
+		// The leaf nodes are authenticated with the users of the server.
+		leafNodesSettings = fmt.Sprintf(`
+leafnodes {
+  port: %d
+`, leafNodePort)
+		if r.isbs.Spec.JetStream.TLS {
+			leafNodesSettings += `  tls {
+    cert_file: "/etc/nats-config/server-cert.pem"
+    key_file: "/etc/nats-config/server-key.pem"
+  }
+`
+		}
+		leafNodesSettings += "}\n"
+	}
 	// Merge Nats settings
 	v := viper.New()
 	v.SetConfigType("yaml")
@@ -435,6 +459,8 @@ func (r *jetStreamInstaller) createConfigMap(ctx context.Context) error {
 		MaxFileStore       string
 		EncryptionSettings string
 		TLSConfig          string
+		DomainSettings     string
+		LeafNodesSettings  string
 	}{
 		ClusterName:        r.isbs.Name,
 		MonitorPort:        strconv.Itoa(int(monitorPort)),
@@ -446,6 +472,8 @@ func (r *jetStreamInstaller) createConfigMap(ctx context.Context) error {
 		MaxMemoryStore:     v.GetString("max_memory_store"),
 		EncryptionSettings: encryptionSettings,
 		TLSConfig:          clusterTLSConfig,
+		DomainSettings:     domainSettings,
+		LeafNodesSettings:  leafNodesSettings,
 	}); err != nil {
 		return fmt.Errorf("failed to parse nats config template, error: %w", err)
 	}
@@ -143,6 +143,25 @@ func TestJetStreamCreateObjects(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, 1, len(c.Data))
 		assert.Contains(t, c.Annotations, dfv1.KeyHash)
+		assert.NotContains(t, c.Data[dfv1.JetStreamConfigMapKey], "leafnodes")
+	})
+
+	t.Run("test create objects with leaf nodes", func(t *testing.T) {
+		testObj := testJetStreamIsbSvc.DeepCopy()
+		testObj.Name = "leaf"
+		testObj.Spec.JetStream.Domain = "hub"
+		testObj.Spec.JetStream.LeafNodes = true
+		i.isbs = testObj
+		assert.NoError(t, i.createConfigMap(ctx))
+		c := &corev1.ConfigMap{}
+		assert.NoError(t, cl.Get(ctx, types.NamespacedName{Namespace: testObj.Namespace, Name: generateJetStreamConfigMapName(testObj)}, c))
+		assert.Contains(t, c.Data[dfv1.JetStreamConfigMapKey], "domain: hub")
+		assert.Contains(t, c.Data[dfv1.JetStreamConfigMapKey], "leafnodes {\n  port: 7422")
+		assert.NoError(t, i.createService(ctx))
+		svc := &corev1.Service{}
+		assert.NoError(t, cl.Get(ctx, types.NamespacedName{Namespace: testObj.Namespace, Name: generateJetStreamServiceName(testObj)}, svc))
+		assert.Equal(t, 5, len(svc.Spec.Ports))
+		assert.Equal(t, leafNodePort, svc.Spec.Ports[4].Port)
 	})
 }
 
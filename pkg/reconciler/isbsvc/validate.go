@@ -18,6 +18,7 @@ package isbsvc
 
 import (
 	"fmt"
+	"strings"
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 )
@@ -42,11 +43,28 @@ func ValidateInterStepBufferService(isbs *dfv1.InterStepBufferService) error {
 				return fmt.Errorf(`invalid spec: "spec.redis.native.version" is not defined`)
 			}
 		}
+		if external := isbs.Spec.Redis.External; external != nil {
+			if external.URL == "" && external.SentinelURL == "" {
+				return fmt.Errorf(`invalid spec: either "spec.redis.external.url" or "spec.redis.external.sentinelUrl" needs to be specified`)
+			}
+			if external.URL == "" && external.MasterName == "" {
+				return fmt.Errorf(`invalid spec: "spec.redis.external.masterName" is required when Sentinel is used`)
+			}
+			if x := external.TLS; x != nil && (x.CertSecret == nil) != (x.KeySecret == nil) {
+				return fmt.Errorf(`invalid spec: both "clientCertSecret" and "clientKeySecret" of "spec.redis.external.tls" need to be specified`)
+			}
+		}
 	}
 	if x := isbs.Spec.JetStream; x != nil {
 		if x.Version == "" {
 			return fmt.Errorf(`invalid spec: "spec.jetstream.version" is not defined`)
 		}
+		if strings.ContainsAny(x.Domain, ". *>") {
+			return fmt.Errorf(`invalid spec: invalid "spec.jetstream.domain" %q`, x.Domain)
+		}
+		if x.LeafNodes && x.Domain == "" {
+			return fmt.Errorf(`invalid spec: "spec.jetstream.domain" is required when leaf nodes are enabled`)
+		}
 	}
 	return nil
 }
@@ -21,6 +21,7 @@ import (
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -98,6 +99,29 @@ func TestValidateInterStepBuffer(t *testing.T) {
 		assert.Contains(t, err.Error(), "must be defined")
 	})
 
+	t.Run("test external redis", func(t *testing.T) {
+		isbs := testRedisIsbs.DeepCopy()
+		isbs.Spec.Redis.Native = nil
+		isbs.Spec.Redis.External = &dfv1.RedisConfig{}
+		err := ValidateInterStepBufferService(isbs)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `either "spec.redis.external.url" or "spec.redis.external.sentinelUrl" needs to be specified`)
+
+		isbs.Spec.Redis.External.SentinelURL = "sentinel:26379"
+		err = ValidateInterStepBufferService(isbs)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `"spec.redis.external.masterName" is required`)
+
+		isbs.Spec.Redis.External.MasterName = "mymaster"
+		isbs.Spec.Redis.External.TLS = &dfv1.TLS{CertSecret: &corev1.SecretKeySelector{Key: "tls.crt"}}
+		err = ValidateInterStepBufferService(isbs)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `both "clientCertSecret" and "clientKeySecret"`)
+
+		isbs.Spec.Redis.External.TLS.KeySecret = &corev1.SecretKeySelector{Key: "tls.key"}
+		assert.NoError(t, ValidateInterStepBufferService(isbs))
+	})
+
 	t.Run("test missing jetstream version", func(t *testing.T) {
 		isbs := testJetStreamIsbs.DeepCopy()
 		isbs.Spec.JetStream.Version = ""
@@ -105,4 +129,20 @@ func TestValidateInterStepBuffer(t *testing.T) {
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "is not defined")
 	})
+
+	t.Run("test jetstream domain and leaf nodes", func(t *testing.T) {
+		isbs := testJetStreamIsbs.DeepCopy()
+		isbs.Spec.JetStream.LeafNodes = true
+		err := ValidateInterStepBufferService(isbs)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `"spec.jetstream.domain" is required`)
+
+		isbs.Spec.JetStream.Domain = "hub.1"
+		err = ValidateInterStepBufferService(isbs)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid "spec.jetstream.domain"`)
+
+		isbs.Spec.JetStream.Domain = "hub"
+		assert.NoError(t, ValidateInterStepBufferService(isbs))
+	})
 }
@@ -0,0 +1,244 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pipeline
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/nats-io/nats.go"
+	"github.com/spf13/viper"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/reconciler"
+)
+
+const defaultAverageMessageSize = 1024
+
+// checkISBSvcCapacity checks if the Inter-Step Buffer Service has enough storage for the buffers of all the pipelines
+// using it, including the ones to be created for this pipeline. It returns a message describing the shortage if the
+// storage is insufficient, or an empty string otherwise.
+//
+// The check only runs if it's enabled in the controller configuration and the pipeline has buffers to be created. It's
+// skipped for the ISB Services whose storage is unknown, e.g. Redis, or JetStream without a storage limit.
+func (r *pipelineReconciler) checkISBSvcCapacity(ctx context.Context, pl *dfv1.Pipeline, isbSvc *dfv1.InterStepBufferService) (string, error) {
+	cc := r.config.GetCapacityCheck()
+	if cc == nil || isbSvc.Spec.JetStream == nil || isbSvc.Status.Config.JetStream == nil {
+		return "", nil
+	}
+	avgMsgSize := int64(defaultAverageMessageSize)
+	if cc.AverageMessageSize != "" {
+		q, err := resource.ParseQuantity(cc.AverageMessageSize)
+		if err != nil {
+			return "", fmt.Errorf("invalid average message size %q in the capacity check configuration, %w", cc.AverageMessageSize, err)
+		}
+		avgMsgSize = q.Value()
+	}
+	usageLimit := int64(100)
+	if cc.UsageLimit != nil {
+		usageLimit = int64(*cc.UsageLimit)
+	}
+	streamConfig := viper.New()
+	streamConfig.SetConfigType("yaml")
+	if err := streamConfig.ReadConfig(bytes.NewBufferString(isbSvc.Status.Config.JetStream.StreamConfig)); err != nil {
+		return "", fmt.Errorf("failed to parse the stream config of the ISB Service, %w", err)
+	}
+	storage, err := jetStreamStorage(isbSvc, r.config, streamConfig.GetInt("stream.storage") == int(nats.MemoryStorage))
+	if err != nil {
+		return "", err
+	}
+	if storage <= 0 {
+		return "", nil
+	}
+
+	vertices := &dfv1.VertexList{}
+	if err := r.client.List(ctx, vertices, &client.ListOptions{Namespace: pl.Namespace}); err != nil {
+		return "", fmt.Errorf("failed to list the vertices, %w", err)
+	}
+	existingBuffers := make(map[string]bool)
+	var required int64
+	for _, v := range vertices.Items {
+		if v.Spec.PipelineName == pl.Name {
+			for _, b := range v.OwnedBuffers() {
+				existingBuffers[b] = true
+			}
+			continue
+		}
+		if isbSvcNameOf(v.Spec.InterStepBufferServiceName) != isbSvc.Name {
+			continue
+		}
+		required += bufferStorage(v, streamConfig, avgMsgSize) * int64(len(v.OwnedBuffers()))
+	}
+	hasNewBuffers := false
+	for _, v := range buildVertices(withDefaultLimits(pl, r.config)) {
+		buffers := v.OwnedBuffers()
+		for _, b := range buffers {
+			if !existingBuffers[b] {
+				hasNewBuffers = true
+			}
+		}
+		required += bufferStorage(v, streamConfig, avgMsgSize) * int64(len(buffers))
+	}
+	if !hasNewBuffers {
+		return "", nil
+	}
+	replicas := int64(streamConfig.GetInt("stream.replicas"))
+	if replicas < 1 {
+		replicas = 1
+	}
+	if servers := int64(isbSvc.Spec.JetStream.GetReplicas()); replicas > servers {
+		replicas = servers
+	}
+	required *= replicas
+	if required*100 <= storage*usageLimit {
+		return "", nil
+	}
+	return fmt.Sprintf("The buffers of the pipelines using ISB Service %q need about %s of storage, which exceeds %d%% of its storage %s.",
+		isbSvc.Name, formatStorage(required), usageLimit, formatStorage(storage)), nil
+}
+
+// jetStreamStorage returns the total storage in bytes of the servers of a JetStream ISB Service, for the streams using
+// the file storage or the memory storage. It returns 0 if the storage is unknown or unlimited.
+func jetStreamStorage(isbSvc *dfv1.InterStepBufferService, config *reconciler.GlobalConfig, memory bool) (int64, error) {
+	settings := viper.New()
+	settings.SetConfigType("yaml")
+	if config != nil && config.ISBSvc != nil && config.ISBSvc.JetStream != nil {
+		if err := settings.ReadConfig(bytes.NewBufferString(config.ISBSvc.JetStream.Settings)); err != nil {
+			return 0, fmt.Errorf("invalid jetstream settings in global configuration, %w", err)
+		}
+	}
+	js := isbSvc.Spec.JetStream
+	if js.Settings != nil {
+		if err := settings.MergeConfig(bytes.NewBufferString(*js.Settings)); err != nil {
+			return 0, fmt.Errorf("failed to merge customized jetstream settings, %w", err)
+		}
+	}
+	var perServer int64
+	if memory {
+		limit, err := parseNatsSize(settings.GetString("max_memory_store"))
+		if err != nil {
+			return 0, fmt.Errorf("invalid max_memory_store in the jetstream settings, %w", err)
+		}
+		perServer = limit
+	} else {
+		limit, err := parseNatsSize(settings.GetString("max_file_store"))
+		if err != nil {
+			return 0, fmt.Errorf("invalid max_file_store in the jetstream settings, %w", err)
+		}
+		perServer = limit
+		// Without persistence, the size of the emptyDir volume is unknown.
+		if js.Persistence != nil {
+			volumeSize := dfv1.DefaultVolumeSize.Value()
+			if js.Persistence.VolumeSize != nil {
+				volumeSize = js.Persistence.VolumeSize.Value()
+			}
+			if perServer <= 0 || volumeSize < perServer {
+				perServer = volumeSize
+			}
+		}
+	}
+	if perServer <= 0 {
+		return 0, nil
+	}
+	return perServer * int64(js.GetReplicas()), nil
+}
+
+// bufferStorage estimates the storage in bytes of a buffer (a stream) owned by the vertex, in one server.
+func bufferStorage(v dfv1.Vertex, streamConfig *viper.Viper, avgMsgSize int64) int64 {
+	if maxBytes := streamConfig.GetInt64("stream.maxBytes"); maxBytes > 0 {
+		return maxBytes
+	}
+	msgs := int64(dfv1.DefaultBufferLength)
+	if l := v.Spec.Limits; l != nil && l.BufferMaxLength != nil {
+		msgs = int64(*l.BufferMaxLength)
+	}
+	maxMsgs := streamConfig.GetInt64("stream.maxMsgs")
+	if streamConfig.GetInt("stream.retention") == int(nats.LimitsPolicy) {
+		// With the limits retention, the acknowledged messages are kept until the stream reaches its limits.
+		if maxMsgs > 0 {
+			msgs = maxMsgs
+		}
+	} else if maxMsgs > 0 && maxMsgs < msgs {
+		msgs = maxMsgs
+	}
+	return msgs * avgMsgSize
+}
+
+// isbSvcNameOf returns the name of the ISB Service, an empty name means the default one.
+func isbSvcNameOf(name string) string {
+	if name == "" {
+		return dfv1.DefaultISBSvcName
+	}
+	return name
+}
+
+// parseNatsSize parses a size in the NATS configuration, e.g. "1TB", "512M" or "1048576". It returns -1 for an empty
+// value, which means no limit.
+func parseNatsSize(s string) (int64, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return -1, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return n, nil
+	}
+	i := 0
+	for i < len(s) && (s[i] >= '0' && s[i] <= '9' || s[i] == '.') {
+		i++
+	}
+	n, err := strconv.ParseFloat(s[:i], 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid size %q", s)
+	}
+	var multiplier float64
+	switch strings.ToLower(strings.TrimSpace(s[i:])) {
+	case "k":
+		multiplier = 1e3
+	case "kb", "ki", "kib":
+		multiplier = 1 << 10
+	case "m":
+		multiplier = 1e6
+	case "mb", "mi", "mib":
+		multiplier = 1 << 20
+	case "g":
+		multiplier = 1e9
+	case "gb", "gi", "gib":
+		multiplier = 1 << 30
+	case "t":
+		multiplier = 1e12
+	case "tb", "ti", "tib":
+		multiplier = 1 << 40
+	case "p":
+		multiplier = 1e15
+	case "pb", "pi", "pib":
+		multiplier = 1 << 50
+	default:
+		return 0, fmt.Errorf("invalid size %q", s)
+	}
+	return int64(n * multiplier), nil
+}
+
+// formatStorage formats a storage size in bytes, rounded up to Mi.
+func formatStorage(size int64) string {
+	const mi = 1 << 20
+	return resource.NewQuantity((size+mi-1)/mi*mi, resource.BinarySI).String()
+}
@@ -33,6 +33,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/pointer"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -53,8 +54,9 @@ const (
 
 // pipelineReconciler reconciles a pipeline object.
 type pipelineReconciler struct {
-	client client.Client
-	scheme *runtime.Scheme
+	client     client.Client
+	kubeClient kubernetes.Interface
+	scheme     *runtime.Scheme
 
 	config   *reconciler.GlobalConfig
 	image    string
@@ -62,8 +64,8 @@ type pipelineReconciler struct {
 	recorder record.EventRecorder
 }
 
-func NewReconciler(client client.Client, scheme *runtime.Scheme, config *reconciler.GlobalConfig, image string, logger *zap.SugaredLogger, recorder record.EventRecorder) reconcile.Reconciler {
-	return &pipelineReconciler{client: client, scheme: scheme, config: config, image: image, logger: logger, recorder: recorder}
+func NewReconciler(client client.Client, kubeClient kubernetes.Interface, scheme *runtime.Scheme, config *reconciler.GlobalConfig, image string, logger *zap.SugaredLogger, recorder record.EventRecorder) reconcile.Reconciler {
+	return &pipelineReconciler{client: client, kubeClient: kubeClient, scheme: scheme, config: config, image: image, logger: logger, recorder: recorder}
 }
 
 func (r *pipelineReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -138,6 +140,17 @@ func (r *pipelineReconciler) reconcile(ctx context.Context, pl *dfv1.Pipeline) (
 		return result, err
 	}
 
+	if _, ok := pl.Annotations[dfv1.KeyResetSourceOffsets]; ok && pl.Status.Phase == pl.Spec.Lifecycle.GetDesiredPhase() {
+		requeue, err := r.resetSourceOffsets(ctx, pl)
+		if err != nil {
+			r.recorder.Eventf(pl, corev1.EventTypeWarning, "ReconcilePipelineFailed", "Failed to reset the source offsets: %v", err.Error())
+			return ctrl.Result{}, err
+		}
+		if requeue {
+			return ctrl.Result{RequeueAfter: dfv1.DefaultRequeueAfter}, nil
+		}
+	}
+
 	if oldPhase := pl.Status.Phase; oldPhase != pl.Spec.Lifecycle.GetDesiredPhase() {
 		requeue, err := r.updateDesiredState(ctx, pl)
 		if err != nil {
@@ -201,13 +214,54 @@ func (r *pipelineReconciler) reconcileNonLifecycleChanges(ctx context.Context, p
 		return ctrl.Result{}, fmt.Errorf("isbsvc not ready")
 	}
 
+	// The vertices connecting through leaf nodes access the ISB Service in its JetStream domain
+	if len(pl.GetJetStreamDomains()) > 0 && (isbSvc.Status.Config.JetStream == nil || isbSvc.Status.Config.JetStream.Domain == "") {
+		pl.Status.MarkDeployFailed("ISBSvcDomainNotFound", "Leaf nodes require a JetStream ISB Service with a domain.")
+		log.Errorw("Leaf nodes require a JetStream ISB Service with a domain", zap.String("isbsvc", isbSvcName))
+		return ctrl.Result{}, fmt.Errorf("isbsvc %s does not have a jetstream domain", isbSvcName)
+	}
+
+	// Fail fast if the ISB Service does not have enough storage for the buffers to be created
+	shortage, err := r.checkISBSvcCapacity(ctx, pl, isbSvc)
+	if err != nil {
+		log.Errorw("Failed to check the capacity of the ISB Service", zap.String("isbsvc", isbSvcName), zap.Error(err))
+		pl.Status.MarkDeployFailed("CheckISBSvcCapacityFailed", err.Error())
+		return ctrl.Result{}, err
+	}
+	if shortage != "" {
+		log.Errorw("Insufficient ISB Service capacity", zap.String("isbsvc", isbSvcName), zap.String("reason", shortage))
+		pl.Status.MarkDeployFailed("InsufficientISBSvcCapacity", shortage)
+		return ctrl.Result{}, fmt.Errorf("insufficient capacity of isbsvc %s", isbSvcName)
+	}
+
+	// Create or rotate the message signing keys before the vertices, whose pods mount them
+	nextKeyRotation, err := r.createOrRotateSigningKeys(ctx, pl)
+	if err != nil {
+		log.Errorw("Failed to create or rotate the message signing keys", zap.Error(err))
+		pl.Status.MarkDeployFailed("CreateOrRotateSigningKeysFailed", err.Error())
+		return ctrl.Result{}, err
+	}
+
 	// Create or update the Side Inputs Manager deployments
-	if err := r.createOrUpdateSIMDeployments(ctx, pl, isbSvc.Status.Config); err != nil {
+	staleSideInputs, err := r.createOrUpdateSIMDeployments(ctx, pl, isbSvc.Status.Config)
+	if err != nil {
 		log.Errorw("Failed to create or update Side Inputs Manager deployments", zap.Error(err))
 		pl.Status.MarkDeployFailed("CreateOrUpdateSIMDeploymentsFailed", err.Error())
 		return ctrl.Result{}, err
 	}
 
+	// Resolve the to pipelines of the cross-pipeline edges, which are not deployed until the to pipelines accept them
+	crossPipelineEdges, err := r.buildCrossPipelineEdges(ctx, pl, isbSvc)
+	if err != nil {
+		log.Errorw("Failed to build the cross-pipeline edges", zap.Error(err))
+		pl.Status.MarkDeployFailed("BuildCrossPipelineEdgesFailed", err.Error())
+		return ctrl.Result{}, err
+	}
+
+	// the watermarks and the reduce WAL written by the vertices can't be read in another time unit, so it's recorded
+	// before the vertices are deployed, and any change afterwards fails the validation
+	pl.Status.WatermarkTimeUnit = pl.Spec.Watermark.GetTimeUnitType()
+
 	existingObjs, err := r.findExistingVertices(ctx, pl)
 	if err != nil {
 		log.Errorw("Failed to find existing vertices", zap.Error(err))
@@ -218,7 +272,11 @@ func (r *pipelineReconciler) reconcileNonLifecycleChanges(ctx context.Context, p
 	newBuffers := make(map[string]string)
 	oldBuckets := make(map[string]string)
 	newBuckets := make(map[string]string)
+	oldDomains := make(map[string]string)
 	for _, v := range existingObjs {
+		for k, d := range v.GetJetStreamDomains() {
+			oldDomains[k] = d
+		}
 		for _, b := range v.OwnedBuffers() {
 			oldBuffers[b] = b
 		}
@@ -243,7 +301,10 @@ func (r *pipelineReconciler) reconcileNonLifecycleChanges(ctx context.Context, p
 			newBuckets[b] = b
 		}
 	}
-	newObjs := buildVertices(pl)
+	// Record the stale resources before deleting the stale vertices, which are the only place to find them
+	addStaleResources(pl, oldBuffers, oldBuckets, staleSideInputs, oldDomains)
+	newObjs := buildVertices(withDefaultLimits(pl, r.config))
+	withCrossPipelineEdges(pl, newObjs, crossPipelineEdges)
 	for vertexName, newObj := range newObjs {
 		if oldObj, existing := existingObjs[vertexName]; !existing {
 			if err := r.client.Create(ctx, &newObj); err != nil {
@@ -288,7 +349,8 @@ func (r *pipelineReconciler) reconcileNonLifecycleChanges(ctx context.Context, p
 		}
 		args := []string{fmt.Sprintf("--buffers=%s", strings.Join(bfs, ",")), fmt.Sprintf("--buckets=%s", strings.Join(bks, ","))}
 		args = append(args, fmt.Sprintf("--side-inputs-store=%s", pl.GetSideInputsStoreName()))
-		batchJob := buildISBBatchJob(pl, r.image, isbSvc.Status.Config, "isbsvc-create", args, "create")
+		args = append(args, pl.GetJetStreamDomainsArgs()...)
+		batchJob := buildISBBatchJob(pl, pl.Spec.GetRuntimeImage(r.image), isbSvc.Status.Config, "isbsvc-create", args, "create")
 		if err := r.client.Create(ctx, batchJob); err != nil && !apierrors.IsAlreadyExists(err) {
 			pl.Status.MarkDeployFailed("CreateISBSvcCreatingJobFailed", err.Error())
 			return ctrl.Result{}, fmt.Errorf("failed to create ISB Svc creating job, err: %w", err)
@@ -296,22 +358,10 @@ func (r *pipelineReconciler) reconcileNonLifecycleChanges(ctx context.Context, p
 		log.Infow("Created ISB Svc creating job successfully", zap.Any("buffers", bfs), zap.Any("buckets", bks))
 	}
 
-	if len(oldBuffers) > 0 || len(oldBuckets) > 0 {
-		bfs := []string{}
-		for k := range oldBuffers {
-			bfs = append(bfs, k)
-		}
-		bks := []string{}
-		for k := range oldBuckets {
-			bks = append(bks, k)
-		}
-		args := []string{fmt.Sprintf("--buffers=%s", strings.Join(bfs, ",")), fmt.Sprintf("--buckets=%s", strings.Join(bks, ","))}
-		batchJob := buildISBBatchJob(pl, r.image, isbSvc.Status.Config, "isbsvc-delete", args, "delete")
-		if err := r.client.Create(ctx, batchJob); err != nil && !apierrors.IsAlreadyExists(err) {
-			pl.Status.MarkDeployFailed("CreateISBSvcDeletingJobFailed", err.Error())
-			return ctrl.Result{}, fmt.Errorf("failed to create ISB Svc deleting job, err: %w", err)
-		}
-		log.Infow("Created ISB Svc deleting job successfully", zap.Any("buffers", bfs), zap.Any("buckets", bks))
+	nextCleanUp, err := r.cleanUpStaleResources(ctx, pl, isbSvc.Status.Config)
+	if err != nil {
+		pl.Status.MarkDeployFailed("CreateISBSvcDeletingJobFailed", err.Error())
+		return ctrl.Result{}, err
 	}
 
 	// Daemon service
@@ -325,7 +375,26 @@ func (r *pipelineReconciler) reconcileNonLifecycleChanges(ctx context.Context, p
 
 	pl.Status.MarkDeployed()
 	pl.Status.SetPhase(pl.Spec.Lifecycle.GetDesiredPhase(), "")
-	return ctrl.Result{}, nil
+	var requeueAfter time.Duration
+	if !nextKeyRotation.IsZero() {
+		// Requeue to rotate the signing keys
+		requeueAfter = time.Until(nextKeyRotation) + time.Second
+	}
+	if nextCleanUp > 0 && (requeueAfter == 0 || requeueAfter > nextCleanUp) {
+		// Requeue to clean up the stale resources
+		requeueAfter = nextCleanUp
+	}
+	if len(crossPipelineEdges) > 0 && (requeueAfter == 0 || requeueAfter > dfv1.DefaultCrossPipelineSyncInterval) {
+		// Requeue to resolve the to pipelines again, in case they are updated
+		requeueAfter = dfv1.DefaultCrossPipelineSyncInterval
+	}
+	if pl.Status.Phase == dfv1.PipelinePhaseRunning && r.syncDegradation(ctx, pl) {
+		// Requeue to sync the degradation state again
+		if requeueAfter == 0 || requeueAfter > dfv1.DefaultDegradationSyncInterval {
+			requeueAfter = dfv1.DefaultDegradationSyncInterval
+		}
+	}
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
 }
 
 func (r *pipelineReconciler) createOrUpdateDaemonService(ctx context.Context, pl *dfv1.Pipeline) error {
@@ -368,15 +437,27 @@ func (r *pipelineReconciler) createOrUpdateDaemonDeployment(ctx context.Context,
 	envs = append(envs, corev1.EnvVar{Name: dfv1.EnvPipelineName, Value: pl.Name})
 	req := dfv1.GetDaemonDeploymentReq{
 		ISBSvcType: isbSvcType,
-		Image:      r.image,
+		Image:      pl.Spec.GetRuntimeImage(r.image),
 		PullPolicy: corev1.PullPolicy(sharedutil.LookupEnvStringOr(dfv1.EnvImagePullPolicy, "")),
 		Env:        envs,
 	}
-	deploy, err := pl.GetDaemonDeploymentObj(req)
+	deploy, err := withDefaultLimits(pl, r.config).GetDaemonDeploymentObj(req)
 	if err != nil {
 		pl.Status.MarkDeployFailed("BuildDaemonDeployFailed", err.Error())
 		return fmt.Errorf("failed to build daemon deployment spec, %w", err)
 	}
+	// Attach the secret or configmap volumes of the sources, which are used to reset the source offsets
+	var sources []*dfv1.Source
+	for _, v := range pl.Spec.Vertices {
+		if v.Source != nil && (v.Source.Kafka != nil || v.Source.Pulsar != nil || v.Source.RedisStreams != nil) {
+			sources = append(sources, v.Source)
+		}
+	}
+	if len(sources) > 0 {
+		vols, volMounts := sharedutil.VolumesFromSecretsAndConfigMaps(sources)
+		deploy.Spec.Template.Spec.Volumes = append(deploy.Spec.Template.Spec.Volumes, vols...)
+		deploy.Spec.Template.Spec.Containers[0].VolumeMounts = append(deploy.Spec.Template.Spec.Containers[0].VolumeMounts, volMounts...)
+	}
 	deployHash := sharedutil.MustHash(deploy.Spec)
 	deploy.Annotations = map[string]string{dfv1.KeyHash: deployHash}
 	existingDeploy := &appv1.Deployment{}
@@ -420,13 +501,13 @@ func (r *pipelineReconciler) findExistingVertices(ctx context.Context, pl *dfv1.
 }
 
 // Create or update Side Inputs Mapager deployments
-func (r *pipelineReconciler) createOrUpdateSIMDeployments(ctx context.Context, pl *dfv1.Pipeline, isbSvcConfig dfv1.BufferServiceConfig) error {
+func (r *pipelineReconciler) createOrUpdateSIMDeployments(ctx context.Context, pl *dfv1.Pipeline, isbSvcConfig dfv1.BufferServiceConfig) ([]string, error) {
 	log := logging.FromContext(ctx)
 	isbSvcType, envs := sharedutil.GetIsbSvcEnvVars(isbSvcConfig)
 	envs = append(envs, corev1.EnvVar{Name: dfv1.EnvPipelineName, Value: pl.Name})
 	req := dfv1.GetSideInputDeploymentReq{
 		ISBSvcType: isbSvcType,
-		Image:      r.image,
+		Image:      pl.Spec.GetRuntimeImage(r.image),
 		PullPolicy: corev1.PullPolicy(sharedutil.LookupEnvStringOr(dfv1.EnvImagePullPolicy, "")),
 		Env:        envs,
 	}
@@ -434,12 +515,12 @@ func (r *pipelineReconciler) createOrUpdateSIMDeployments(ctx context.Context, p
 	newObjs, err := pl.GetSideInputsManagerDeployments(req)
 	if err != nil {
 		pl.Status.MarkDeployFailed("BuildSIMObjsFailed", err.Error())
-		return fmt.Errorf("failed to build Side Inputs Manager Deployments, %w", err)
+		return nil, fmt.Errorf("failed to build Side Inputs Manager Deployments, %w", err)
 	}
 	existingObjs, err := r.findExistingSIMDeploys(ctx, pl)
 	if err != nil {
 		pl.Status.MarkDeployFailed("FindExistingSIMFailed", err.Error())
-		return fmt.Errorf("failed to find existing Side Inputs Manager Deployments, %w", err)
+		return nil, fmt.Errorf("failed to find existing Side Inputs Manager Deployments, %w", err)
 	}
 	for _, newObj := range newObjs {
 		deployHash := sharedutil.MustHash(newObj.Spec)
@@ -453,7 +534,7 @@ func (r *pipelineReconciler) createOrUpdateSIMDeployments(ctx context.Context, p
 					continue
 				} else {
 					pl.Status.MarkDeployFailed("CreateSIMDeploymentFailed", err.Error())
-					return fmt.Errorf("failed to create Side Inputs Manager Deployment %q, %w", newObj.Name, err)
+					return nil, fmt.Errorf("failed to create Side Inputs Manager Deployment %q, %w", newObj.Name, err)
 				}
 			}
 			log.Infow("Created Side Inputs Manager Deployment successfully", zap.String("deployment", newObj.Name))
@@ -463,21 +544,25 @@ func (r *pipelineReconciler) createOrUpdateSIMDeployments(ctx context.Context, p
 				oldObj.Annotations[dfv1.KeyHash] = newObj.GetAnnotations()[dfv1.KeyHash]
 				if err := r.client.Update(ctx, &oldObj); err != nil {
 					pl.Status.MarkDeployFailed("UpdateSIMDeploymentFailed", err.Error())
-					return fmt.Errorf("failed to update Side Inputs Manager Deployment %q, %w", oldObj.Name, err)
+					return nil, fmt.Errorf("failed to update Side Inputs Manager Deployment %q, %w", oldObj.Name, err)
 				}
 				log.Infow("Updated Side Inputs Manager Deployment successfully", zap.String("deployment", oldObj.Name))
 			}
 			delete(existingObjs, oldObj.Name)
 		}
 	}
+	var staleSideInputs []string
 	for _, v := range existingObjs {
 		if err := r.client.Delete(ctx, &v); err != nil {
 			pl.Status.MarkDeployFailed("DeleteStaleSIMDeploymentFailed", err.Error())
-			return fmt.Errorf("failed to delete stale Side Inputs Manager Deployment %q, %w", v.Name, err)
+			return nil, fmt.Errorf("failed to delete stale Side Inputs Manager Deployment %q, %w", v.Name, err)
 		}
 		log.Infow("Deleted stale Side Inputs Manager Deployment successfully", zap.String("deployment", v.Name))
+		if name := v.GetLabels()[dfv1.KeySideInputName]; name != "" {
+			staleSideInputs = append(staleSideInputs, name)
+		}
 	}
-	return nil
+	return staleSideInputs, nil
 }
 
 // Find existing Side Inputs Manager Deployment objects.
@@ -497,6 +582,15 @@ func (r *pipelineReconciler) findExistingSIMDeploys(ctx context.Context, pl *dfv
 func (r *pipelineReconciler) cleanUpBuffers(ctx context.Context, pl *dfv1.Pipeline, log *zap.SugaredLogger) error {
 	allBuffers := pl.GetAllBuffers()
 	allBuckets := pl.GetAllBuckets()
+	domains := pl.GetJetStreamDomains()
+	// The stale resources waiting for the clean up delay are deleted together
+	for _, sr := range pl.Status.StaleResources {
+		allBuffers = append(allBuffers, sr.Buffers...)
+		allBuckets = append(allBuckets, sr.Buckets...)
+		for k, d := range sr.JetStreamDomains {
+			domains[k] = d
+		}
+	}
 	if len(allBuffers) > 0 || len(allBuckets) > 0 {
 		isbSvc := &dfv1.InterStepBufferService{}
 		isbSvcName := dfv1.DefaultISBSvcName
@@ -516,8 +610,9 @@ func (r *pipelineReconciler) cleanUpBuffers(ctx context.Context, pl *dfv1.Pipeli
 		args = append(args, fmt.Sprintf("--buffers=%s", strings.Join(allBuffers, ",")))
 		args = append(args, fmt.Sprintf("--buckets=%s", strings.Join(allBuckets, ",")))
 		args = append(args, fmt.Sprintf("--side-inputs-store=%s", pl.GetSideInputsStoreName()))
+		args = append(args, dfv1.JetStreamDomainsArgs(domains)...)
 
-		batchJob := buildISBBatchJob(pl, r.image, isbSvc.Status.Config, "isbsvc-delete", args, "cleanup")
+		batchJob := buildISBBatchJob(pl, pl.Spec.GetRuntimeImage(r.image), isbSvc.Status.Config, "isbsvc-delete", args, "cleanup")
 		batchJob.OwnerReferences = []metav1.OwnerReference{}
 		if err := r.client.Create(ctx, batchJob); err != nil && !apierrors.IsAlreadyExists(err) {
 			return fmt.Errorf("failed to create buffer clean up job, err: %w", err)
@@ -534,11 +629,15 @@ func needsUpdate(old, new *dfv1.Pipeline) bool {
 	if !equality.Semantic.DeepEqual(old.Finalizers, new.Finalizers) {
 		return true
 	}
+	if !equality.Semantic.DeepEqual(old.Annotations, new.Annotations) {
+		return true
+	}
 	return false
 }
 
 func buildVertices(pl *dfv1.Pipeline) map[string]dfv1.Vertex {
 	result := make(map[string]dfv1.Vertex)
+	packedVertices := pl.GetPackedVertices()
 	for _, v := range pl.Spec.Vertices {
 		vertexFullName := pl.Name + "-" + v.Name
 		matchLabels := map[string]string{
@@ -548,7 +647,7 @@ func buildVertices(pl *dfv1.Pipeline) map[string]dfv1.Vertex {
 			dfv1.KeyPipelineName: pl.Name,
 			dfv1.KeyVertexName:   v.Name,
 		}
-		fromEdges := copyEdges(pl, pl.GetFromEdges(v.Name))
+		fromEdges := append(copyEdges(pl, pl.GetFromEdges(v.Name)), crossPipelineFromEdges(pl, v)...)
 		toEdges := copyEdges(pl, pl.GetToEdges(v.Name))
 		vCopy := v.DeepCopy()
 		copyVertexLimits(pl, vCopy)
@@ -575,7 +674,22 @@ func buildVertices(pl *dfv1.Pipeline) map[string]dfv1.Vertex {
 			FromEdges:                  fromEdges,
 			ToEdges:                    toEdges,
 			Watermark:                  pl.Spec.Watermark,
+			ClaimCheck:                 pl.Spec.ClaimCheck,
+			Tracing:                    pl.Spec.Tracing,
+			MessageSigning:             pl.Spec.MessageSigning,
+			MessageChecksum:            pl.Spec.MessageChecksum,
+			MessageEncryption:          pl.Spec.MessageEncryption,
 			Replicas:                   &replicas,
+			PackedInto:                 packedVertices[v.Name],
+			RuntimeImage:               pl.Spec.RuntimeImage,
+		}
+		if v.IsASource() {
+			spec.ShuffleHeaderNames = pl.GetShuffleHeaderNames(v.Name)
+		}
+		for _, pv := range pl.Spec.Vertices {
+			if packedVertices[pv.Name] == v.Name {
+				spec.PackedVertices = append(spec.PackedVertices, pv.Name)
+			}
 		}
 		hash := sharedutil.MustHash(spec.WithOutReplicas())
 		obj := dfv1.Vertex{
@@ -597,6 +711,39 @@ func buildVertices(pl *dfv1.Pipeline) map[string]dfv1.Vertex {
 	return result
 }
 
+// withDefaultLimits returns a copy of the pipeline whose limits not specified are filled with the default limits in the
+// controller configuration, or the pipeline itself if no default limits are configured.
+func withDefaultLimits(pl *dfv1.Pipeline, config *reconciler.GlobalConfig) *dfv1.Pipeline {
+	d := config.GetPipelineDefaultLimits()
+	if d == nil {
+		return pl
+	}
+	plCopy := pl.DeepCopy()
+	if plCopy.Spec.Limits == nil {
+		plCopy.Spec.Limits = &dfv1.PipelineLimits{}
+	}
+	x := plCopy.Spec.Limits
+	if x.ReadBatchSize == nil {
+		x.ReadBatchSize = d.ReadBatchSize
+	}
+	if x.BufferMaxLength == nil {
+		x.BufferMaxLength = d.BufferMaxLength
+	}
+	if x.BufferUsageLimit == nil {
+		x.BufferUsageLimit = d.BufferUsageLimit
+	}
+	if x.ReadTimeout == nil && d.ReadTimeout != nil {
+		x.ReadTimeout = &metav1.Duration{Duration: *d.ReadTimeout}
+	}
+	if x.UDFConcurrency == nil {
+		x.UDFConcurrency = d.UDFConcurrency
+	}
+	if x.RetryInterval == nil && d.RetryInterval != nil {
+		x.RetryInterval = &metav1.Duration{Duration: *d.RetryInterval}
+	}
+	return plCopy
+}
+
 func copyVertexLimits(pl *dfv1.Pipeline, v *dfv1.AbstractVertex) {
 	mergedLimits := mergeLimits(pl.GetPipelineLimits(), v.Limits)
 	v.Limits = &mergedLimits
@@ -609,6 +756,11 @@ func mergeLimits(plLimits dfv1.PipelineLimits, vLimits *dfv1.VertexLimits) dfv1.
 		result.BufferUsageLimit = vLimits.BufferUsageLimit
 		result.ReadBatchSize = vLimits.ReadBatchSize
 		result.ReadTimeout = vLimits.ReadTimeout
+		result.AdaptiveReadBatch = vLimits.AdaptiveReadBatch
+		result.UDFConcurrency = vLimits.UDFConcurrency
+		result.RetryInterval = vLimits.RetryInterval
+		result.KeyOrdered = vLimits.KeyOrdered
+		result.MapConnectionPoolSize = vLimits.MapConnectionPoolSize
 	}
 	if result.ReadBatchSize == nil {
 		result.ReadBatchSize = plLimits.ReadBatchSize
@@ -622,6 +774,12 @@ func mergeLimits(plLimits dfv1.PipelineLimits, vLimits *dfv1.VertexLimits) dfv1.
 	if result.BufferUsageLimit == nil {
 		result.BufferUsageLimit = plLimits.BufferUsageLimit
 	}
+	if result.UDFConcurrency == nil {
+		result.UDFConcurrency = plLimits.UDFConcurrency
+	}
+	if result.RetryInterval == nil {
+		result.RetryInterval = plLimits.RetryInterval
+	}
 	return result
 }
 
@@ -633,13 +791,14 @@ func copyEdges(pl *dfv1.Pipeline, edges []dfv1.Edge) []dfv1.CombinedEdge {
 		fromVertexLimits := mergeLimits(pl.GetPipelineLimits(), vFrom.Limits)
 		toVertexLimits := mergeLimits(pl.GetPipelineLimits(), vTo.Limits)
 		combinedEdge := dfv1.CombinedEdge{
-			Edge:                     e,
-			FromVertexType:           vFrom.GetVertexType(),
-			FromVertexPartitionCount: pointer.Int32(int32(vFrom.GetPartitionCount())),
-			FromVertexLimits:         &fromVertexLimits,
-			ToVertexLimits:           &toVertexLimits,
-			ToVertexType:             vTo.GetVertexType(),
-			ToVertexPartitionCount:   pointer.Int32(int32(vTo.GetPartitionCount())),
+			Edge:                      e,
+			FromVertexType:            vFrom.GetVertexType(),
+			FromVertexPartitionCount:  pointer.Int32(int32(vFrom.GetPartitionCount())),
+			FromVertexLimits:          &fromVertexLimits,
+			ToVertexLimits:            &toVertexLimits,
+			ToVertexType:              vTo.GetVertexType(),
+			ToVertexPartitionCount:    pointer.Int32(int32(vTo.GetPartitionCount())),
+			FromVertexJetStreamDomain: vFrom.GetJetStreamDomain(),
 		}
 		result = append(result, combinedEdge)
 	}
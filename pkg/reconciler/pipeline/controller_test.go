@@ -19,9 +19,11 @@ package pipeline
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/goccy/go-json"
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/apis/proto/daemon"
 	"github.com/numaproj/numaflow/pkg/reconciler"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap/zaptest"
@@ -31,6 +33,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/pointer"
@@ -109,7 +112,7 @@ func init() {
 
 func Test_NewReconciler(t *testing.T) {
 	cl := fake.NewClientBuilder().Build()
-	r := NewReconciler(cl, scheme.Scheme, fakeConfig, testFlowImage, zaptest.NewLogger(t).Sugar(), record.NewFakeRecorder(64))
+	r := NewReconciler(cl, k8sfake.NewSimpleClientset(), scheme.Scheme, fakeConfig, testFlowImage, zaptest.NewLogger(t).Sugar(), record.NewFakeRecorder(64))
 	_, ok := r.(*pipelineReconciler)
 	assert.True(t, ok)
 }
@@ -144,6 +147,118 @@ func Test_reconcile(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, 1, len(jobs.Items))
 	})
+
+	t.Run("test reconcile with a changed watermark time unit", func(t *testing.T) {
+		cl := fake.NewClientBuilder().Build()
+		ctx := context.TODO()
+		testIsbSvc := testNativeRedisIsbSvc.DeepCopy()
+		testIsbSvc.Status.MarkConfigured()
+		testIsbSvc.Status.MarkDeployed()
+		err := cl.Create(ctx, testIsbSvc)
+		assert.Nil(t, err)
+		r := &pipelineReconciler{
+			client:   cl,
+			scheme:   scheme.Scheme,
+			config:   fakeConfig,
+			image:    testFlowImage,
+			logger:   zaptest.NewLogger(t).Sugar(),
+			recorder: record.NewFakeRecorder(64),
+		}
+		testObj := testPipeline.DeepCopy()
+		_, err = r.reconcile(ctx, testObj)
+		assert.NoError(t, err)
+		assert.Equal(t, dfv1.WatermarkTimeUnitMilliseconds, testObj.Status.WatermarkTimeUnit)
+		u := dfv1.WatermarkTimeUnitNanoseconds
+		testObj.Spec.Watermark.TimeUnit = &u
+		_, err = r.reconcile(ctx, testObj)
+		assert.Error(t, err)
+		assert.False(t, testObj.Status.IsReady())
+		assert.Equal(t, dfv1.WatermarkTimeUnitMilliseconds, testObj.Status.WatermarkTimeUnit)
+	})
+
+	t.Run("test reconcile with a removed vertex", func(t *testing.T) {
+		cl := fake.NewClientBuilder().Build()
+		ctx := context.TODO()
+		testIsbSvc := testNativeRedisIsbSvc.DeepCopy()
+		testIsbSvc.Status.MarkConfigured()
+		testIsbSvc.Status.MarkDeployed()
+		err := cl.Create(ctx, testIsbSvc)
+		assert.Nil(t, err)
+		r := &pipelineReconciler{
+			client:   cl,
+			scheme:   scheme.Scheme,
+			config:   fakeConfig,
+			image:    testFlowImage,
+			logger:   zaptest.NewLogger(t).Sugar(),
+			recorder: record.NewFakeRecorder(64),
+		}
+		testObj := testPipeline.DeepCopy()
+		_, err = r.reconcile(ctx, testObj)
+		assert.NoError(t, err)
+		testObj.Spec.Vertices = []dfv1.AbstractVertex{testObj.Spec.Vertices[0], testObj.Spec.Vertices[2]}
+		testObj.Spec.Edges = []dfv1.Edge{{From: "input", To: "output"}}
+		result, err := r.reconcile(ctx, testObj)
+		assert.NoError(t, err)
+		assert.True(t, result.RequeueAfter > 299*time.Second && result.RequeueAfter <= 300*time.Second)
+		vertices := &dfv1.VertexList{}
+		selector, _ := labels.Parse(dfv1.KeyPipelineName + "=" + testObj.Name)
+		err = r.client.List(ctx, vertices, &client.ListOptions{Namespace: testNamespace, LabelSelector: selector})
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(vertices.Items))
+		// The buffers of the removed vertex are not deleted before the clean up delay
+		assert.Len(t, testObj.Status.StaleResources, 1)
+		assert.Equal(t, []string{"test-ns-test-pl-p1-0"}, testObj.Status.StaleResources[0].Buffers)
+		assert.Equal(t, []string{"test-ns-test-pl-input-p1", "test-ns-test-pl-p1-output"}, testObj.Status.StaleResources[0].Buckets)
+		jobs := &batchv1.JobList{}
+		err = r.client.List(ctx, jobs, &client.ListOptions{Namespace: testNamespace, LabelSelector: selector})
+		assert.NoError(t, err)
+		for _, j := range jobs.Items {
+			assert.NotContains(t, j.Name, "delete")
+		}
+	})
+
+	t.Run("test reconcile with message signing", func(t *testing.T) {
+		cl := fake.NewClientBuilder().Build()
+		kubeClient := k8sfake.NewSimpleClientset()
+		ctx := context.TODO()
+		testIsbSvc := testNativeRedisIsbSvc.DeepCopy()
+		testIsbSvc.Status.MarkConfigured()
+		testIsbSvc.Status.MarkDeployed()
+		err := cl.Create(ctx, testIsbSvc)
+		assert.Nil(t, err)
+		r := &pipelineReconciler{
+			client:     cl,
+			kubeClient: kubeClient,
+			scheme:     scheme.Scheme,
+			config:     fakeConfig,
+			image:      testFlowImage,
+			logger:     zaptest.NewLogger(t).Sugar(),
+			recorder:   record.NewFakeRecorder(64),
+		}
+		testObj := testPipeline.DeepCopy()
+		testObj.Spec.MessageSigning = &dfv1.MessageSigning{RotationInterval: &metav1.Duration{Duration: time.Hour}}
+		result, err := r.reconcile(ctx, testObj)
+		assert.NoError(t, err)
+		assert.True(t, result.RequeueAfter > 59*time.Minute && result.RequeueAfter <= time.Hour+time.Second)
+		secret, err := kubeClient.CoreV1().Secrets(testNamespace).Get(ctx, dfv1.GenerateSigningKeysSecretName(testObj.Name), metav1.GetOptions{})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(secret.Data))
+		assert.Equal(t, testObj.Name, secret.OwnerReferences[0].Name)
+		vertices := &dfv1.VertexList{}
+		selector, _ := labels.Parse(dfv1.KeyPipelineName + "=" + testObj.Name)
+		err = r.client.List(ctx, vertices, &client.ListOptions{Namespace: testNamespace, LabelSelector: selector})
+		assert.NoError(t, err)
+		for _, v := range vertices.Items {
+			assert.NotNil(t, v.Spec.MessageSigning)
+		}
+
+		// The keys are not rotated before the interval.
+		_, err = r.reconcile(ctx, testObj)
+		assert.NoError(t, err)
+		secret, err = kubeClient.CoreV1().Secrets(testNamespace).Get(ctx, dfv1.GenerateSigningKeysSecretName(testObj.Name), metav1.GetOptions{})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(secret.Data))
+	})
 }
 
 func Test_reconcileEvents(t *testing.T) {
@@ -202,12 +317,90 @@ func Test_reconcileEvents(t *testing.T) {
 	})
 }
 
+func Test_updateDegradationStatus(t *testing.T) {
+	r := &pipelineReconciler{
+		logger:   zaptest.NewLogger(t).Sugar(),
+		recorder: record.NewFakeRecorder(64),
+	}
+	testObj := testPipeline.DeepCopy()
+	r.updateDegradationStatus(testObj, &daemon.PipelineDegradation{Degraded: pointer.Bool(false)})
+	assert.NotNil(t, testObj.Status.Degradation)
+	assert.False(t, testObj.Status.Degradation.Degraded)
+	transitionTime := time.Now().Add(-time.Minute).Truncate(time.Millisecond)
+	r.updateDegradationStatus(testObj, &daemon.PipelineDegradation{Degraded: pointer.Bool(true), Reason: pointer.String("too many pending"), LastTransitionTime: pointer.Int64(transitionTime.UnixMilli())})
+	assert.True(t, testObj.Status.Degradation.Degraded)
+	assert.Equal(t, "too many pending", testObj.Status.Degradation.Reason)
+	assert.True(t, transitionTime.Equal(testObj.Status.Degradation.LastTransitionTime.Time))
+	// no event without a transition
+	r.updateDegradationStatus(testObj, &daemon.PipelineDegradation{Degraded: pointer.Bool(true), Reason: pointer.String("too many pending")})
+	r.updateDegradationStatus(testObj, &daemon.PipelineDegradation{Degraded: pointer.Bool(false), Reason: pointer.String("recovered")})
+	assert.False(t, testObj.Status.Degradation.Degraded)
+	events := getEvents(r, 2)
+	assert.Equal(t, "Warning PipelineDegraded Degraded the best-effort edges, too many pending", events[0])
+	assert.Equal(t, "Normal PipelineRestored Restored the best-effort edges, recovered", events[1])
+
+	// degraded and restored between two updates
+	degradedTime := time.Now().Add(-30 * time.Second).Truncate(time.Millisecond)
+	restoredTime := time.Now().Add(-10 * time.Second).Truncate(time.Millisecond)
+	testObj.Status.Degradation.LastTransitionTime = metav1.NewTime(transitionTime.Truncate(time.Second))
+	d := &daemon.PipelineDegradation{
+		Degraded:           pointer.Bool(false),
+		Reason:             pointer.String("recovered again"),
+		LastTransitionTime: pointer.Int64(restoredTime.UnixMilli()),
+		Transitions: []*daemon.DegradationTransition{
+			{Degraded: pointer.Bool(true), Reason: pointer.String("too many pending"), Time: pointer.Int64(transitionTime.UnixMilli())},
+			{Degraded: pointer.Bool(true), Reason: pointer.String("too slow"), Time: pointer.Int64(degradedTime.UnixMilli())},
+			{Degraded: pointer.Bool(false), Reason: pointer.String("recovered again"), Time: pointer.Int64(restoredTime.UnixMilli())},
+		},
+	}
+	r.updateDegradationStatus(testObj, d)
+	assert.False(t, testObj.Status.Degradation.Degraded)
+	assert.True(t, restoredTime.Equal(testObj.Status.Degradation.LastTransitionTime.Time))
+	events = getEvents(r, 2)
+	assert.Equal(t, "Warning PipelineDegraded Degraded the best-effort edges, too slow", events[0])
+	assert.Equal(t, "Normal PipelineRestored Restored the best-effort edges, recovered again", events[1])
+	// no event for the transitions already replayed
+	testObj.Status.Degradation.LastTransitionTime = metav1.NewTime(restoredTime.Truncate(time.Second))
+	r.updateDegradationStatus(testObj, d)
+	assert.Equal(t, 0, len(r.recorder.(*record.FakeRecorder).Events))
+
+	testObj.Spec.Degradation = nil
+	assert.False(t, r.syncDegradation(context.TODO(), testObj))
+	assert.Nil(t, testObj.Status.Degradation)
+}
+
 func Test_buildVertices(t *testing.T) {
 	r := buildVertices(testPipeline)
 	assert.Equal(t, 3, len(r))
 	_, existing := r[testPipeline.Name+"-"+testPipeline.Spec.Vertices[0].Name]
 	assert.True(t, existing)
 	assert.Equal(t, testPipeline.Spec.Watermark.MaxDelay, r[testPipeline.Name+"-"+testPipeline.Spec.Vertices[0].Name].Spec.Watermark.MaxDelay)
+
+	pl := testPipeline.DeepCopy()
+	pl.Spec.RuntimeImage = "test-runtime-image@sha256:abcd"
+	for _, v := range buildVertices(pl) {
+		assert.Equal(t, pl.Spec.RuntimeImage, v.Spec.RuntimeImage)
+	}
+}
+
+func Test_buildPackedVertices(t *testing.T) {
+	pl := testPipeline.DeepCopy()
+	pl.Spec.PodPacking = true
+	pl.Spec.Vertices = []dfv1.AbstractVertex{pl.Spec.Vertices[0], pl.Spec.Vertices[2]}
+	pl.Spec.Edges = []dfv1.Edge{{From: pl.Spec.Vertices[0].Name, To: pl.Spec.Vertices[1].Name}}
+	r := buildVertices(pl)
+	// the transformer of the source runs in a sidecar
+	assert.Empty(t, r[pl.Name+"-"+pl.Spec.Vertices[0].Name].Spec.PackedVertices)
+	pl.Spec.Vertices[0].Source.UDTransformer = nil
+	r = buildVertices(pl)
+	assert.Equal(t, 2, len(r))
+	host := r[pl.Name+"-"+pl.Spec.Vertices[0].Name]
+	assert.Equal(t, []string{pl.Spec.Vertices[1].Name}, host.Spec.PackedVertices)
+	packed := r[pl.Name+"-"+pl.Spec.Vertices[1].Name]
+	assert.Equal(t, pl.Spec.Vertices[0].Name, packed.Spec.PackedInto)
+	assert.NotNil(t, packed.Spec.Limits)
+	assert.Equal(t, host.Spec.ToEdges, packed.Spec.FromEdges)
+	assert.Equal(t, 0, packed.GetReplicas())
 }
 
 func Test_buildReducesVertices(t *testing.T) {
@@ -249,6 +442,57 @@ func Test_copyVertexLimits(t *testing.T) {
 	copyVertexLimits(pl, v)
 	assert.Equal(t, two, *v.Limits.ReadBatchSize)
 	assert.Equal(t, "3s", v.Limits.ReadTimeout.Duration.String())
+	assert.Nil(t, v.Limits.AdaptiveReadBatch)
+	v.Limits.AdaptiveReadBatch = &dfv1.AdaptiveReadBatch{Max: &two}
+	copyVertexLimits(pl, v)
+	assert.NotNil(t, v.Limits.AdaptiveReadBatch)
+	assert.Equal(t, two, *v.Limits.AdaptiveReadBatch.Max)
+	assert.False(t, v.Limits.KeyOrdered)
+	v.Limits.KeyOrdered = true
+	copyVertexLimits(pl, v)
+	assert.True(t, v.Limits.KeyOrdered)
+	v.Limits.MapConnectionPoolSize = pointer.Uint32(4)
+	copyVertexLimits(pl, v)
+	assert.Equal(t, uint32(4), *v.Limits.MapConnectionPoolSize)
+}
+
+func Test_withDefaultLimits(t *testing.T) {
+	pl := testPipeline.DeepCopy()
+	assert.Same(t, pl, withDefaultLimits(pl, nil))
+	assert.Same(t, pl, withDefaultLimits(pl, &reconciler.GlobalConfig{}))
+
+	readBatchSize := uint64(100)
+	bufferMaxLength := uint64(50000)
+	retryInterval := 10 * time.Millisecond
+	config := &reconciler.GlobalConfig{Pipeline: &reconciler.PipelineConfig{DefaultLimits: &reconciler.PipelineDefaultLimits{
+		ReadBatchSize:   &readBatchSize,
+		BufferMaxLength: &bufferMaxLength,
+		UDFConcurrency:  pointer.Uint32(20),
+		RetryInterval:   &retryInterval,
+	}}}
+	pl.Spec.Limits = &dfv1.PipelineLimits{BufferMaxLength: pointer.Uint64(1000)}
+	plWithDefaults := withDefaultLimits(pl, config)
+	assert.NotSame(t, pl, plWithDefaults)
+	assert.Nil(t, pl.Spec.Limits.ReadBatchSize)
+	l := plWithDefaults.GetPipelineLimits()
+	assert.Equal(t, uint64(100), *l.ReadBatchSize)
+	// the pipeline limits take precedence over the defaults
+	assert.Equal(t, uint64(1000), *l.BufferMaxLength)
+	assert.Equal(t, uint32(20), *l.UDFConcurrency)
+	assert.Equal(t, "10ms", l.RetryInterval.Duration.String())
+	assert.Equal(t, "1s", l.ReadTimeout.Duration.String())
+
+	// the vertex limits take precedence over the pipeline ones
+	plWithDefaults.Spec.Vertices[1].Limits = &dfv1.VertexLimits{UDFConcurrency: pointer.Uint32(5)}
+	for _, v := range buildVertices(plWithDefaults) {
+		assert.Equal(t, uint64(100), *v.Spec.Limits.ReadBatchSize)
+		assert.Equal(t, "10ms", v.Spec.Limits.RetryInterval.Duration.String())
+		if v.Spec.Name == plWithDefaults.Spec.Vertices[1].Name {
+			assert.Equal(t, uint32(5), *v.Spec.Limits.UDFConcurrency)
+		} else {
+			assert.Equal(t, uint32(20), *v.Spec.Limits.UDFConcurrency)
+		}
+	}
 }
 
 func Test_copyEdges(t *testing.T) {
@@ -397,6 +641,8 @@ func Test_needsUpdate(t *testing.T) {
 	assert.True(t, needsUpdate(testPipeline, testObj))
 	testobj1 := testObj.DeepCopy()
 	assert.False(t, needsUpdate(testObj, testobj1))
+	testobj1.Annotations = map[string]string{dfv1.KeyResetSourceOffsets: `{"vertex":"input"}`}
+	assert.True(t, needsUpdate(testObj, testobj1))
 }
 
 func Test_cleanupBuffers(t *testing.T) {
@@ -441,6 +687,76 @@ func Test_cleanupBuffers(t *testing.T) {
 	})
 }
 
+func Test_addStaleResources(t *testing.T) {
+	testObj := testPipeline.DeepCopy()
+	addStaleResources(testObj, map[string]string{"b1": "b1"}, map[string]string{"k1": "k1"}, []string{"s1"}, map[string]string{"b1": "edge", "b3": "edge"})
+	assert.Len(t, testObj.Status.StaleResources, 1)
+	assert.Equal(t, []string{"b1"}, testObj.Status.StaleResources[0].Buffers)
+	assert.Equal(t, []string{"k1"}, testObj.Status.StaleResources[0].Buckets)
+	assert.Equal(t, []string{"s1"}, testObj.Status.StaleResources[0].SideInputs)
+	assert.Equal(t, map[string]string{"b1": "edge"}, testObj.Status.StaleResources[0].JetStreamDomains)
+	assert.False(t, testObj.Status.StaleResources[0].RemovedAt.IsZero())
+
+	// The recorded ones are skipped
+	addStaleResources(testObj, map[string]string{"b1": "b1", "b2": "b2"}, map[string]string{"k1": "k1"}, []string{"s1"}, nil)
+	assert.Len(t, testObj.Status.StaleResources, 2)
+	assert.Equal(t, []string{"b2"}, testObj.Status.StaleResources[1].Buffers)
+	assert.Nil(t, testObj.Status.StaleResources[1].Buckets)
+	assert.Nil(t, testObj.Status.StaleResources[1].SideInputs)
+	addStaleResources(testObj, map[string]string{"b2": "b2"}, nil, nil, nil)
+	assert.Len(t, testObj.Status.StaleResources, 2)
+}
+
+func Test_cleanUpStaleResources(t *testing.T) {
+	cl := fake.NewClientBuilder().Build()
+	ctx := context.TODO()
+	r := &pipelineReconciler{
+		client: cl,
+		scheme: scheme.Scheme,
+		config: fakeConfig,
+		image:  testFlowImage,
+		logger: zaptest.NewLogger(t).Sugar(),
+	}
+	selector, _ := labels.Parse(dfv1.KeyPipelineName + "=" + testPipeline.Name)
+
+	t.Run("test no stale resources", func(t *testing.T) {
+		testObj := testPipeline.DeepCopy()
+		next, err := r.cleanUpStaleResources(ctx, testObj, fakeIsbSvcConfig)
+		assert.NoError(t, err)
+		assert.Equal(t, time.Duration(0), next)
+		jobs := &batchv1.JobList{}
+		err = r.client.List(ctx, jobs, &client.ListOptions{Namespace: testNamespace, LabelSelector: selector})
+		assert.NoError(t, err)
+		assert.Equal(t, 0, len(jobs.Items))
+	})
+
+	t.Run("test clean up after the delay", func(t *testing.T) {
+		testObj := testPipeline.DeepCopy()
+		testObj.Spec.Lifecycle.CleanUpDelaySeconds = pointer.Int32(60)
+		testObj.Status.StaleResources = []dfv1.StaleResources{
+			{Buffers: []string{"b1"}, SideInputs: []string{"s1"}, JetStreamDomains: map[string]string{"b1": "edge"}, RemovedAt: metav1.NewTime(time.Now().Add(-2 * time.Minute))},
+			{Buckets: []string{"k2"}, RemovedAt: metav1.NewTime(time.Now().Add(-30 * time.Second))},
+			// Used by the pipeline again
+			{Buffers: testObj.GetAllBuffers(), RemovedAt: metav1.NewTime(time.Now().Add(-time.Second))},
+		}
+		next, err := r.cleanUpStaleResources(ctx, testObj, fakeIsbSvcConfig)
+		assert.NoError(t, err)
+		assert.True(t, next > 0 && next <= 30*time.Second)
+		assert.Len(t, testObj.Status.StaleResources, 1)
+		assert.Equal(t, []string{"k2"}, testObj.Status.StaleResources[0].Buckets)
+		jobs := &batchv1.JobList{}
+		err = r.client.List(ctx, jobs, &client.ListOptions{Namespace: testNamespace, LabelSelector: selector})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(jobs.Items))
+		assert.Contains(t, jobs.Items[0].Name, "delete")
+		args := jobs.Items[0].Spec.Template.Spec.Containers[0].Args
+		assert.Contains(t, args, "--buffers=b1")
+		assert.Contains(t, args, "--buckets=")
+		assert.Contains(t, args, "--side-inputs=s1")
+		assert.Contains(t, args, "--jetstream-domains=b1=edge")
+	})
+}
+
 func TestCreateOrUpdateDaemon(t *testing.T) {
 	cl := fake.NewClientBuilder().Build()
 	ctx := context.TODO()
@@ -472,6 +788,92 @@ func TestCreateOrUpdateDaemon(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Len(t, deployList.Items, 1)
 		assert.Equal(t, "test-pl-daemon", deployList.Items[0].Name)
+		assert.Equal(t, testFlowImage, deployList.Items[0].Spec.Template.Spec.Containers[0].Image)
+	})
+
+	t.Run("test create or update deployment with runtime image", func(t *testing.T) {
+		testObj := testPipeline.DeepCopy()
+		testObj.Spec.RuntimeImage = "test-runtime-image@sha256:abcd"
+		err := r.createOrUpdateDaemonDeployment(ctx, testObj, fakeIsbSvcConfig)
+		assert.NoError(t, err)
+		deployList := appv1.DeploymentList{}
+		err = cl.List(context.Background(), &deployList)
+		assert.NoError(t, err)
+		assert.Len(t, deployList.Items, 1)
+		assert.Equal(t, "test-runtime-image@sha256:abcd", deployList.Items[0].Spec.Template.Spec.Containers[0].Image)
+	})
+
+	t.Run("test create or update deployment with source secrets", func(t *testing.T) {
+		testObj := testPipeline.DeepCopy()
+		testObj.Spec.Vertices[0].Source = &dfv1.Source{Kafka: &dfv1.KafkaSource{
+			SASL: &dfv1.SASL{Plain: &dfv1.SASLPlain{UserSecret: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "kafka-auth"},
+				Key:                  "user",
+			}}},
+		}}
+		err := r.createOrUpdateDaemonDeployment(ctx, testObj, fakeIsbSvcConfig)
+		assert.NoError(t, err)
+		deployList := appv1.DeploymentList{}
+		err = cl.List(context.Background(), &deployList)
+		assert.NoError(t, err)
+		assert.Len(t, deployList.Items, 1)
+		found := false
+		for _, v := range deployList.Items[0].Spec.Template.Spec.Volumes {
+			if v.Secret != nil && v.Secret.SecretName == "kafka-auth" {
+				found = true
+			}
+		}
+		assert.True(t, found)
+	})
+}
+
+func Test_resetSourceOffsets(t *testing.T) {
+	cl := fake.NewClientBuilder().Build()
+	ctx := context.TODO()
+	testIsbSvc := testNativeRedisIsbSvc.DeepCopy()
+	testIsbSvc.Status.MarkConfigured()
+	testIsbSvc.Status.MarkDeployed()
+	err := cl.Create(ctx, testIsbSvc)
+	assert.Nil(t, err)
+	r := &pipelineReconciler{
+		client:   cl,
+		scheme:   scheme.Scheme,
+		config:   fakeConfig,
+		image:    testFlowImage,
+		logger:   zaptest.NewLogger(t).Sugar(),
+		recorder: record.NewFakeRecorder(64),
+	}
+	testObj := testPipeline.DeepCopy()
+	_, err = r.reconcile(ctx, testObj)
+	assert.NoError(t, err)
+
+	t.Run("scale down the affected vertices", func(t *testing.T) {
+		testObj.Annotations = map[string]string{dfv1.KeyResetSourceOffsets: `{"vertex":"input","timestamp":"2023-10-01T00:00:00Z"}`}
+		requeue, err := r.resetSourceOffsets(ctx, testObj)
+		assert.NoError(t, err)
+		assert.True(t, requeue)
+		vertices, err := r.findExistingVertices(ctx, testObj)
+		assert.NoError(t, err)
+		for _, v := range vertices {
+			assert.Equal(t, int32(0), *v.Spec.Replicas)
+		}
+		assert.Contains(t, testObj.Annotations, dfv1.KeyResetSourceOffsets)
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		testObj.Annotations = map[string]string{dfv1.KeyResetSourceOffsets: `{"vertex":"p1"}`}
+		requeue, err := r.resetSourceOffsets(ctx, testObj)
+		assert.NoError(t, err)
+		assert.False(t, requeue)
+		assert.NotContains(t, testObj.Annotations, dfv1.KeyResetSourceOffsets)
+		testObj.Annotations = map[string]string{dfv1.KeyResetSourceOffsets: `invalid`}
+		requeue, err = r.resetSourceOffsets(ctx, testObj)
+		assert.NoError(t, err)
+		assert.False(t, requeue)
+		assert.NotContains(t, testObj.Annotations, dfv1.KeyResetSourceOffsets)
+		events := getEvents(r, 5)
+		assert.Equal(t, "Warning ResetSourceOffsetsFailed Source vertex \"p1\" not found", events[3])
+		assert.Contains(t, events[4], "Warning ResetSourceOffsetsFailed Invalid annotation numaflow.numaproj.io/reset-source-offsets")
 	})
 }
 
@@ -487,7 +889,7 @@ func Test_createOrUpdateSIMDeployments(t *testing.T) {
 	}
 
 	t.Run("no side inputs", func(t *testing.T) {
-		err := r.createOrUpdateSIMDeployments(ctx, testPipeline, fakeIsbSvcConfig)
+		_, err := r.createOrUpdateSIMDeployments(ctx, testPipeline, fakeIsbSvcConfig)
 		assert.NoError(t, err)
 		deployList := appv1.DeploymentList{}
 		err = cl.List(context.Background(), &deployList, &client.ListOptions{Namespace: testNamespace, LabelSelector: labels.SelectorFromSet(labels.Set{dfv1.KeyComponent: dfv1.ComponentSideInputManager})})
@@ -508,7 +910,7 @@ func Test_createOrUpdateSIMDeployments(t *testing.T) {
 				},
 			},
 		}
-		err := r.createOrUpdateSIMDeployments(ctx, testObj, fakeIsbSvcConfig)
+		_, err := r.createOrUpdateSIMDeployments(ctx, testObj, fakeIsbSvcConfig)
 		assert.NoError(t, err)
 		deployList := appv1.DeploymentList{}
 		err = cl.List(context.Background(), &deployList, &client.ListOptions{Namespace: testNamespace, LabelSelector: labels.SelectorFromSet(labels.Set{dfv1.KeyComponent: dfv1.ComponentSideInputManager})})
@@ -539,7 +941,7 @@ func Test_createOrUpdateSIMDeployments(t *testing.T) {
 				},
 			},
 		}
-		err := r.createOrUpdateSIMDeployments(ctx, testObj, fakeIsbSvcConfig)
+		_, err := r.createOrUpdateSIMDeployments(ctx, testObj, fakeIsbSvcConfig)
 		assert.NoError(t, err)
 		deployList := appv1.DeploymentList{}
 		err = cl.List(context.Background(), &deployList, &client.ListOptions{Namespace: testNamespace, LabelSelector: labels.SelectorFromSet(labels.Set{dfv1.KeyComponent: dfv1.ComponentSideInputManager})})
@@ -562,11 +964,12 @@ func Test_createOrUpdateSIMDeployments(t *testing.T) {
 				},
 			},
 		}
-		err := r.createOrUpdateSIMDeployments(ctx, testObj, fakeIsbSvcConfig)
+		_, err := r.createOrUpdateSIMDeployments(ctx, testObj, fakeIsbSvcConfig)
 		assert.NoError(t, err)
 		testObj.Spec.SideInputs[0].Name = "s2"
-		err = r.createOrUpdateSIMDeployments(ctx, testObj, fakeIsbSvcConfig)
+		staleSideInputs, err := r.createOrUpdateSIMDeployments(ctx, testObj, fakeIsbSvcConfig)
 		assert.NoError(t, err)
+		assert.Equal(t, []string{"s1"}, staleSideInputs)
 		deployList := appv1.DeploymentList{}
 		err = cl.List(context.Background(), &deployList, &client.ListOptions{Namespace: testNamespace, LabelSelector: labels.SelectorFromSet(labels.Set{dfv1.KeyComponent: dfv1.ComponentSideInputManager})})
 		assert.NoError(t, err)
@@ -575,6 +978,275 @@ func Test_createOrUpdateSIMDeployments(t *testing.T) {
 	})
 }
 
+func Test_checkISBSvcCapacity(t *testing.T) {
+	ctx := context.TODO()
+	newIsbSvc := func() *dfv1.InterStepBufferService {
+		return &dfv1.InterStepBufferService{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: testNamespace,
+				Name:      dfv1.DefaultISBSvcName,
+			},
+			Spec: dfv1.InterStepBufferServiceSpec{
+				JetStream: &dfv1.JetStreamBufferService{
+					Version:     "2.9.15",
+					Persistence: &dfv1.PersistenceStrategy{VolumeSize: resource.NewQuantity(10<<30, resource.BinarySI)},
+				},
+			},
+			Status: dfv1.InterStepBufferServiceStatus{
+				Config: dfv1.BufferServiceConfig{
+					JetStream: &dfv1.JetStreamConfig{
+						StreamConfig: "stream:\n  retention: 0\n  maxMsgs: 100000\n  maxBytes: -1\n  storage: 0\n  replicas: 3\n",
+					},
+				},
+			},
+		}
+	}
+	newReconciler := func(cl client.Client, cc *reconciler.CapacityCheckConfig) *pipelineReconciler {
+		return &pipelineReconciler{
+			client:   cl,
+			scheme:   scheme.Scheme,
+			config:   &reconciler.GlobalConfig{Pipeline: &reconciler.PipelineConfig{CapacityCheck: cc}},
+			image:    testFlowImage,
+			logger:   zaptest.NewLogger(t).Sugar(),
+			recorder: record.NewFakeRecorder(64),
+		}
+	}
+
+	t.Run("not enabled", func(t *testing.T) {
+		r := newReconciler(fake.NewClientBuilder().Build(), nil)
+		r.config = fakeConfig
+		shortage, err := r.checkISBSvcCapacity(ctx, testPipeline, newIsbSvc())
+		assert.NoError(t, err)
+		assert.Empty(t, shortage)
+	})
+
+	t.Run("enough storage", func(t *testing.T) {
+		r := newReconciler(fake.NewClientBuilder().Build(), &reconciler.CapacityCheckConfig{})
+		shortage, err := r.checkISBSvcCapacity(ctx, testPipeline, newIsbSvc())
+		assert.NoError(t, err)
+		assert.Empty(t, shortage)
+	})
+
+	t.Run("insufficient storage", func(t *testing.T) {
+		r := newReconciler(fake.NewClientBuilder().Build(), &reconciler.CapacityCheckConfig{AverageMessageSize: "64Ki"})
+		shortage, err := r.checkISBSvcCapacity(ctx, testPipeline, newIsbSvc())
+		assert.NoError(t, err)
+		// 2 buffers * 100000 messages * 64Ki * 3 replicas > 3 * 10Gi
+		assert.Equal(t, "The buffers of the pipelines using ISB Service \"default\" need about 37500Mi of storage, which exceeds 100% of its storage 30Gi.", shortage)
+
+		// The storage is limited by max_file_store
+		isbSvc := newIsbSvc()
+		isbSvc.Spec.JetStream.Persistence.VolumeSize = resource.NewQuantity(100<<30, resource.BinarySI)
+		shortage, err = r.checkISBSvcCapacity(ctx, testPipeline, isbSvc)
+		assert.NoError(t, err)
+		assert.Empty(t, shortage)
+		isbSvc.Spec.JetStream.Settings = pointer.String("max_file_store: 10GB")
+		shortage, err = r.checkISBSvcCapacity(ctx, testPipeline, isbSvc)
+		assert.NoError(t, err)
+		assert.Contains(t, shortage, "of its storage 30Gi")
+	})
+
+	t.Run("unknown storage", func(t *testing.T) {
+		r := newReconciler(fake.NewClientBuilder().Build(), &reconciler.CapacityCheckConfig{AverageMessageSize: "64Ki"})
+		isbSvc := newIsbSvc()
+		isbSvc.Spec.JetStream.Persistence = nil
+		shortage, err := r.checkISBSvcCapacity(ctx, testPipeline, isbSvc)
+		assert.NoError(t, err)
+		assert.Empty(t, shortage)
+		shortage, err = r.checkISBSvcCapacity(ctx, testPipeline, testNativeRedisIsbSvc)
+		assert.NoError(t, err)
+		assert.Empty(t, shortage)
+	})
+
+	t.Run("buffers of other pipelines", func(t *testing.T) {
+		cl := fake.NewClientBuilder().Build()
+		r := newReconciler(cl, &reconciler.CapacityCheckConfig{AverageMessageSize: "32Ki", UsageLimit: pointer.Uint32(80)})
+		shortage, err := r.checkISBSvcCapacity(ctx, testPipeline, newIsbSvc())
+		assert.NoError(t, err)
+		assert.Empty(t, shortage)
+		other := testPipeline.DeepCopy()
+		other.Name = "other-pl"
+		for _, v := range buildVertices(other) {
+			vertex := v
+			assert.NoError(t, cl.Create(ctx, &vertex))
+		}
+		shortage, err = r.checkISBSvcCapacity(ctx, testPipeline, newIsbSvc())
+		assert.NoError(t, err)
+		assert.Equal(t, "The buffers of the pipelines using ISB Service \"default\" need about 37500Mi of storage, which exceeds 80% of its storage 30Gi.", shortage)
+		// The buffers of the pipeline already exist
+		for _, v := range buildVertices(testPipeline) {
+			vertex := v
+			assert.NoError(t, cl.Create(ctx, &vertex))
+		}
+		shortage, err = r.checkISBSvcCapacity(ctx, testPipeline, newIsbSvc())
+		assert.NoError(t, err)
+		assert.Empty(t, shortage)
+	})
+
+	t.Run("reconcile", func(t *testing.T) {
+		cl := fake.NewClientBuilder().Build()
+		isbSvc := newIsbSvc()
+		isbSvc.Status.MarkConfigured()
+		isbSvc.Status.MarkDeployed()
+		assert.NoError(t, cl.Create(ctx, isbSvc))
+		r := newReconciler(cl, &reconciler.CapacityCheckConfig{AverageMessageSize: "64Ki"})
+		testObj := testPipeline.DeepCopy()
+		_, err := r.reconcile(ctx, testObj)
+		assert.Error(t, err)
+		assert.Equal(t, dfv1.PipelinePhaseFailed, testObj.Status.Phase)
+		c := testObj.Status.GetCondition(dfv1.PipelineConditionDeployed)
+		assert.NotNil(t, c)
+		assert.Equal(t, "InsufficientISBSvcCapacity", c.Reason)
+		vertices := &dfv1.VertexList{}
+		assert.NoError(t, cl.List(ctx, vertices, &client.ListOptions{Namespace: testNamespace}))
+		assert.Len(t, vertices.Items, 0)
+	})
+}
+
+func Test_buildCrossPipelineEdges(t *testing.T) {
+	ctx := context.TODO()
+	newIsbSvc := func(namespace string) *dfv1.InterStepBufferService {
+		isbSvc := &dfv1.InterStepBufferService{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: dfv1.DefaultISBSvcName},
+			Spec:       dfv1.InterStepBufferServiceSpec{JetStream: &dfv1.JetStreamBufferService{Version: "2.9.15"}},
+			Status: dfv1.InterStepBufferServiceStatus{
+				Config: dfv1.BufferServiceConfig{
+					JetStream: &dfv1.JetStreamConfig{
+						URL: "nats://isbsvc-default-js-svc." + namespace + ".svc:4222",
+						Auth: &dfv1.NatsAuth{Basic: &dfv1.BasicAuth{
+							User:     &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "isbsvc-default-js-client-auth"}, Key: "client-auth-user"},
+							Password: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "isbsvc-default-js-client-auth"}, Key: "client-auth-password"},
+						}},
+					},
+				},
+			},
+		}
+		isbSvc.Status.MarkConfigured()
+		isbSvc.Status.MarkDeployed()
+		return isbSvc
+	}
+	// the downstream pipeline accepts the p1 vertex of the test pipeline at its first vertex
+	newDownstream := func(namespace string) *dfv1.Pipeline {
+		pl := testPipeline.DeepCopy()
+		pl.Namespace = namespace
+		pl.Name = "downstream"
+		pl.Spec.Vertices = pl.Spec.Vertices[1:]
+		pl.Spec.Vertices[0].Partitions = pointer.Int32(2)
+		pl.Spec.Vertices[0].FromPipelines = []dfv1.PipelineVertex{{Namespace: testNamespace, Pipeline: testPipeline.Name, Vertex: "p1"}}
+		pl.Spec.Edges = pl.Spec.Edges[1:]
+		return pl
+	}
+	upstream := testPipeline.DeepCopy()
+	upstream.Spec.Vertices = upstream.Spec.Vertices[:2]
+	upstream.Spec.Edges = upstream.Spec.Edges[:1]
+	upstream.Spec.Vertices[1].ToPipeline = &dfv1.PipelineVertex{Namespace: "other-ns", Pipeline: "downstream", Vertex: "p1"}
+	newReconciler := func(cl client.Client) *pipelineReconciler {
+		kubeClient := k8sfake.NewSimpleClientset(&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "other-ns", Name: "isbsvc-default-js-client-auth"},
+			Data:       map[string][]byte{"client-auth-user": []byte("user"), "client-auth-password": []byte("password")},
+		})
+		return &pipelineReconciler{
+			client:     cl,
+			kubeClient: kubeClient,
+			scheme:     scheme.Scheme,
+			config:     fakeConfig,
+			image:      testFlowImage,
+			logger:     zaptest.NewLogger(t).Sugar(),
+			recorder:   record.NewFakeRecorder(64),
+		}
+	}
+
+	t.Run("to pipeline not found", func(t *testing.T) {
+		r := newReconciler(fake.NewClientBuilder().Build())
+		_, err := r.buildCrossPipelineEdges(ctx, upstream, newIsbSvc(testNamespace))
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to get the to pipeline other-ns/downstream")
+	})
+
+	t.Run("not accepted by the to pipeline", func(t *testing.T) {
+		downstream := newDownstream("other-ns")
+		downstream.Spec.Vertices[0].FromPipelines[0].Namespace = ""
+		r := newReconciler(fake.NewClientBuilder().WithObjects(downstream, newIsbSvc("other-ns")).Build())
+		_, err := r.buildCrossPipelineEdges(ctx, upstream, newIsbSvc(testNamespace))
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "does not have vertex \"p1\" in its fromPipelines")
+	})
+
+	t.Run("to pipeline in another ISB Service", func(t *testing.T) {
+		r := newReconciler(fake.NewClientBuilder().WithObjects(newDownstream("other-ns"), newIsbSvc("other-ns")).Build())
+		edges, err := r.buildCrossPipelineEdges(ctx, upstream, newIsbSvc(testNamespace))
+		assert.NoError(t, err)
+		assert.Len(t, edges, 1)
+		e := edges["p1"]
+		assert.Equal(t, "p1", e.To)
+		assert.Equal(t, 2, e.GetToVertexPartitionCount())
+		assert.Equal(t, []string{"other-ns-downstream-p1-0", "other-ns-downstream-p1-1"}, e.GetToBufferNames(testNamespace, testPipeline.Name))
+		assert.Equal(t, "other-ns-downstream-test-ns_test-pl_p1-p1", e.GetBucketName(testNamespace, testPipeline.Name))
+		assert.Equal(t, "nats://isbsvc-default-js-svc.other-ns.svc:4222", e.ToPipelineJetStream.URL)
+		secretName := dfv1.GenerateToPipelineSecretName(testPipeline.Name, "p1")
+		assert.Equal(t, secretName, e.ToPipelineJetStream.Auth.Basic.User.Name)
+		secret, err := r.kubeClient.CoreV1().Secrets(testNamespace).Get(ctx, secretName, metav1.GetOptions{})
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("user"), secret.Data[e.ToPipelineJetStream.Auth.Basic.User.Key])
+		assert.Equal(t, []byte("password"), secret.Data[e.ToPipelineJetStream.Auth.Basic.Password.Key])
+
+		// the edge is added to the to edges of the vertex
+		vertices := buildVertices(upstream)
+		oldHash := vertices[upstream.Name+"-p1"].Annotations[dfv1.KeyHash]
+		withCrossPipelineEdges(upstream, vertices, edges)
+		v := vertices[upstream.Name+"-p1"]
+		assert.Len(t, v.Spec.ToEdges, 1)
+		assert.NotEqual(t, oldHash, v.Annotations[dfv1.KeyHash])
+		// the buffers and the bucket of the edge are not in the ISB Service of the vertex
+		assert.Empty(t, v.GetToBuffers())
+		assert.Empty(t, v.GetToBuckets())
+	})
+
+	t.Run("to pipeline in the same ISB Service", func(t *testing.T) {
+		pl := upstream.DeepCopy()
+		pl.Spec.Vertices[1].ToPipeline.Namespace = ""
+		r := newReconciler(fake.NewClientBuilder().WithObjects(newDownstream(testNamespace), newIsbSvc(testNamespace)).Build())
+		edges, err := r.buildCrossPipelineEdges(ctx, pl, newIsbSvc(testNamespace))
+		assert.NoError(t, err)
+		assert.Equal(t, testNamespace, edges["p1"].ToPipeline.Namespace)
+		assert.Nil(t, edges["p1"].ToPipelineJetStream)
+	})
+}
+
+func Test_crossPipelineFromEdges(t *testing.T) {
+	pl := testPipeline.DeepCopy()
+	pl.Name = "downstream"
+	pl.Spec.Vertices = pl.Spec.Vertices[1:]
+	pl.Spec.Vertices[0].FromPipelines = []dfv1.PipelineVertex{{Pipeline: "a", Vertex: "p1"}, {Namespace: "other-ns", Pipeline: "b", Vertex: "p1"}}
+	pl.Spec.Edges = pl.Spec.Edges[1:]
+	v := buildVertices(pl)[pl.Name+"-p1"]
+	assert.Equal(t, []string{"test-ns_a_p1", "other-ns_b_p1"}, v.GetFromVertexNames())
+	assert.Equal(t, []string{"test-ns-downstream-test-ns_a_p1-p1", "test-ns-downstream-other-ns_b_p1-p1"}, v.GetFromBuckets())
+	assert.Subset(t, pl.GetAllBuckets(), v.GetFromBuckets())
+}
+
+func Test_parseNatsSize(t *testing.T) {
+	for s, expected := range map[string]int64{
+		"":        -1,
+		"-1":      -1,
+		"1048576": 1048576,
+		"1k":      1000,
+		"1KB":     1024,
+		"512M":    512e6,
+		"512Mi":   512 << 20,
+		"20G":     20e9,
+		"1TB":     1 << 40,
+	} {
+		n, err := parseNatsSize(s)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, n, s)
+	}
+	_, err := parseNatsSize("1X")
+	assert.Error(t, err)
+	_, err = parseNatsSize("abc")
+	assert.Error(t, err)
+}
+
 func getEvents(reconciler *pipelineReconciler, num int) []string {
 	c := reconciler.recorder.(*record.FakeRecorder).Events
 	events := make([]string, num)
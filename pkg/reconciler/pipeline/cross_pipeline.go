@@ -0,0 +1,203 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pipeline
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+	sharedutil "github.com/numaproj/numaflow/pkg/shared/util"
+)
+
+const (
+	toPipelineSecretUserKey     = "user"
+	toPipelineSecretPasswordKey = "password"
+)
+
+// crossPipelineFromEdges builds the from edges of a vertex for the vertices of other pipelines in its fromPipelines.
+// The from vertices are named by dfv1.CrossPipelineVertexName, so that the buckets of the edges are distinct.
+func crossPipelineFromEdges(pl *dfv1.Pipeline, v dfv1.AbstractVertex) []dfv1.CombinedEdge {
+	var result []dfv1.CombinedEdge
+	toVertexLimits := mergeLimits(pl.GetPipelineLimits(), v.Limits)
+	for _, from := range v.FromPipelines {
+		result = append(result, dfv1.CombinedEdge{
+			Edge: dfv1.Edge{
+				From: dfv1.CrossPipelineVertexName(from.GetNamespace(pl.Namespace), from.Pipeline, from.Vertex),
+				To:   v.Name,
+			},
+			ToVertexLimits:         &toVertexLimits,
+			ToVertexType:           v.GetVertexType(),
+			ToVertexPartitionCount: pointer.Int32(int32(v.GetPartitionCount())),
+		})
+	}
+	return result
+}
+
+// buildCrossPipelineEdges builds the cross-pipeline edges of the vertices with a toPipeline, keyed by the vertex names.
+// The to pipelines are resolved for the properties of the to vertices and the ISB Services they use. If a to pipeline
+// uses another ISB Service, its credentials are copied to a secret in the namespace of the pipeline.
+func (r *pipelineReconciler) buildCrossPipelineEdges(ctx context.Context, pl *dfv1.Pipeline, isbSvc *dfv1.InterStepBufferService) (map[string]dfv1.CombinedEdge, error) {
+	result := make(map[string]dfv1.CombinedEdge)
+	plWithLimits := withDefaultLimits(pl, r.config)
+	for _, v := range plWithLimits.Spec.Vertices {
+		if v.ToPipeline == nil {
+			continue
+		}
+		if isbSvc.Status.Config.JetStream == nil {
+			return nil, fmt.Errorf("cross-pipeline edges require a JetStream ISB Service")
+		}
+		to := dfv1.PipelineVertex{Namespace: v.ToPipeline.GetNamespace(pl.Namespace), Pipeline: v.ToPipeline.Pipeline, Vertex: v.ToPipeline.Vertex}
+		toPl := &dfv1.Pipeline{}
+		if err := r.client.Get(ctx, types.NamespacedName{Namespace: to.Namespace, Name: to.Pipeline}, toPl); err != nil {
+			return nil, fmt.Errorf("failed to get the to pipeline %s/%s of vertex %q, %w", to.Namespace, to.Pipeline, v.Name, err)
+		}
+		toVertex := toPl.GetVertex(to.Vertex)
+		if toVertex == nil {
+			return nil, fmt.Errorf("vertex %q not found in the to pipeline %s/%s", to.Vertex, to.Namespace, to.Pipeline)
+		}
+		if !acceptsFromPipeline(*toVertex, to.Namespace, pl.Namespace, pl.Name, v.Name) {
+			return nil, fmt.Errorf("vertex %q of the to pipeline %s/%s does not have vertex %q in its fromPipelines", to.Vertex, to.Namespace, to.Pipeline, v.Name)
+		}
+		toISBSvcName := dfv1.DefaultISBSvcName
+		if len(toPl.Spec.InterStepBufferServiceName) > 0 {
+			toISBSvcName = toPl.Spec.InterStepBufferServiceName
+		}
+		toISBSvc := &dfv1.InterStepBufferService{}
+		if err := r.client.Get(ctx, types.NamespacedName{Namespace: to.Namespace, Name: toISBSvcName}, toISBSvc); err != nil {
+			return nil, fmt.Errorf("failed to get the ISB Service %s/%s of the to pipeline, %w", to.Namespace, toISBSvcName, err)
+		}
+		if !toISBSvc.Status.IsReady() || toISBSvc.Status.Config.JetStream == nil {
+			return nil, fmt.Errorf("the ISB Service %s/%s of the to pipeline is not a ready JetStream ISB Service", to.Namespace, toISBSvcName)
+		}
+		fromVertexLimits := mergeLimits(plWithLimits.GetPipelineLimits(), v.Limits)
+		toVertexLimits := mergeLimits(withDefaultLimits(toPl, r.config).GetPipelineLimits(), toVertex.Limits)
+		edge := dfv1.CombinedEdge{
+			Edge:                     dfv1.Edge{From: v.Name, To: to.Vertex},
+			FromVertexType:           v.GetVertexType(),
+			FromVertexPartitionCount: pointer.Int32(int32(v.GetPartitionCount())),
+			FromVertexLimits:         &fromVertexLimits,
+			ToVertexType:             toVertex.GetVertexType(),
+			ToVertexPartitionCount:   pointer.Int32(int32(toVertex.GetPartitionCount())),
+			ToVertexLimits:           &toVertexLimits,
+			ToPipeline:               &to,
+		}
+		if toISBSvc.Namespace != isbSvc.Namespace || toISBSvc.Name != isbSvc.Name {
+			js, err := r.syncToPipelineSecret(ctx, pl, v.Name, toISBSvc)
+			if err != nil {
+				return nil, err
+			}
+			edge.ToPipelineJetStream = js
+		}
+		result[v.Name] = edge
+	}
+	return result, nil
+}
+
+// acceptsFromPipeline returns true if the vertex, in the given namespace, has the vertex of the given pipeline in its
+// fromPipelines.
+func acceptsFromPipeline(v dfv1.AbstractVertex, namespace, fromNamespace, fromPipeline, fromVertex string) bool {
+	for _, from := range v.FromPipelines {
+		if from.GetNamespace(namespace) == fromNamespace && from.Pipeline == fromPipeline && from.Vertex == fromVertex {
+			return true
+		}
+	}
+	return false
+}
+
+// syncToPipelineSecret copies the credentials of the JetStream ISB Service of the to pipeline of a vertex to a secret in
+// the namespace of the pipeline, and returns the configuration of the ISB Service referring to the secret.
+func (r *pipelineReconciler) syncToPipelineSecret(ctx context.Context, pl *dfv1.Pipeline, vertexName string, toISBSvc *dfv1.InterStepBufferService) (*dfv1.JetStreamConfig, error) {
+	log := logging.FromContext(ctx)
+	src := toISBSvc.Status.Config.JetStream
+	js := &dfv1.JetStreamConfig{URL: src.URL, TLSEnabled: src.TLSEnabled, Domain: src.Domain}
+	if src.Auth == nil || src.Auth.Basic == nil || src.Auth.Basic.User == nil || src.Auth.Basic.Password == nil {
+		return js, nil
+	}
+	data := make(map[string][]byte)
+	for key, selector := range map[string]*corev1.SecretKeySelector{
+		toPipelineSecretUserKey:     src.Auth.Basic.User,
+		toPipelineSecretPasswordKey: src.Auth.Basic.Password,
+	} {
+		s, err := r.kubeClient.CoreV1().Secrets(toISBSvc.Namespace).Get(ctx, selector.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get secret %q in namespace %q, %w", selector.Name, toISBSvc.Namespace, err)
+		}
+		data[key] = s.Data[selector.Key]
+	}
+	name := dfv1.GenerateToPipelineSecretName(pl.Name, vertexName)
+	existing, err := r.kubeClient.CoreV1().Secrets(pl.Namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("failed to get the to pipeline secret, %w", err)
+		}
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: pl.Namespace,
+				Name:      name,
+				Labels: map[string]string{
+					dfv1.KeyPartOf:       dfv1.Project,
+					dfv1.KeyManagedBy:    dfv1.ControllerPipeline,
+					dfv1.KeyComponent:    dfv1.ComponentVertex,
+					dfv1.KeyPipelineName: pl.Name,
+					dfv1.KeyVertexName:   vertexName,
+				},
+				OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(pl.GetObjectMeta(), dfv1.PipelineGroupVersionKind)},
+			},
+			Type: corev1.SecretTypeOpaque,
+			Data: data,
+		}
+		if _, err := r.kubeClient.CoreV1().Secrets(pl.Namespace).Create(ctx, secret, metav1.CreateOptions{}); err != nil && !apierrors.IsAlreadyExists(err) {
+			return nil, fmt.Errorf("failed to create the to pipeline secret, %w", err)
+		}
+		log.Infow("Created the to pipeline secret", zap.String("secret", name))
+	} else if !reflect.DeepEqual(existing.Data, data) {
+		existing.Data = data
+		if _, err := r.kubeClient.CoreV1().Secrets(pl.Namespace).Update(ctx, existing, metav1.UpdateOptions{}); err != nil {
+			return nil, fmt.Errorf("failed to update the to pipeline secret, %w", err)
+		}
+		log.Infow("Updated the to pipeline secret", zap.String("secret", name))
+	}
+	js.Auth = &dfv1.NatsAuth{Basic: &dfv1.BasicAuth{
+		User:     &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: name}, Key: toPipelineSecretUserKey},
+		Password: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: name}, Key: toPipelineSecretPasswordKey},
+	}}
+	return js, nil
+}
+
+// withCrossPipelineEdges adds the cross-pipeline edges to the to edges of the vertices, and updates their hashes.
+func withCrossPipelineEdges(pl *dfv1.Pipeline, vertices map[string]dfv1.Vertex, edges map[string]dfv1.CombinedEdge) {
+	for vertexName, e := range edges {
+		name := pl.Name + "-" + vertexName
+		obj, existing := vertices[name]
+		if !existing {
+			continue
+		}
+		obj.Spec.ToEdges = append(obj.Spec.ToEdges, e)
+		obj.Annotations[dfv1.KeyHash] = sharedutil.MustHash(obj.Spec.WithOutReplicas())
+		vertices[name] = obj
+	}
+}
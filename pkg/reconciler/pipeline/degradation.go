@@ -0,0 +1,102 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pipeline
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/apis/proto/daemon"
+	daemonclient "github.com/numaproj/numaflow/pkg/daemon/client"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+// syncDegradation syncs the degradation state of the pipeline from the daemon service, which evaluates the
+// degradation policy. It returns false if the pipeline does not have a degradation policy, so that it doesn't need to
+// be synced again.
+func (r *pipelineReconciler) syncDegradation(ctx context.Context, pl *dfv1.Pipeline) bool {
+	if pl.Spec.Degradation == nil {
+		pl.Status.Degradation = nil
+		return false
+	}
+	log := logging.FromContext(ctx)
+	daemonClient, err := daemonclient.NewDaemonServiceClient(pl.GetDaemonServiceURL())
+	if err != nil {
+		log.Warnw("Failed to create a daemon service client", zap.Error(err))
+		return true
+	}
+	defer func() { _ = daemonClient.Close() }()
+	cctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	d, err := daemonClient.GetPipelineDegradation(cctx, pl.Name)
+	if err != nil {
+		log.Warnw("Failed to get the degradation state of the pipeline", zap.Error(err))
+		return true
+	}
+	r.updateDegradationStatus(pl, d)
+	return true
+}
+
+// updateDegradationStatus updates the degradation status of the pipeline, and emits an event for each time it's
+// degraded or restored since the last update, so that a degradation restored between two syncs is not missed.
+func (r *pipelineReconciler) updateDegradationStatus(pl *dfv1.Pipeline, d *daemon.PipelineDegradation) {
+	var since metav1.Time
+	if s := pl.Status.Degradation; s != nil {
+		since = s.LastTransitionTime
+	}
+	// Compare at the precision of seconds, which is what the status keeps after it's persisted.
+	replayed := false
+	for _, t := range d.GetTransitions() {
+		if !since.IsZero() && t.GetTime()/1000 <= since.Unix() {
+			continue
+		}
+		r.recordDegradationEvent(pl, t.GetDegraded(), t.GetReason())
+		replayed = true
+	}
+	if s := pl.Status.Degradation; !replayed && s != nil && s.Degraded == d.GetDegraded() {
+		return
+	}
+	if !replayed && pl.Status.Degradation == nil && !d.GetDegraded() {
+		pl.Status.Degradation = &dfv1.DegradationStatus{}
+		return
+	}
+	if !replayed {
+		r.recordDegradationEvent(pl, d.GetDegraded(), d.GetReason())
+	}
+	transitionTime := metav1.Now()
+	if d.GetLastTransitionTime() > 0 {
+		transitionTime = metav1.NewTime(time.UnixMilli(d.GetLastTransitionTime()))
+	}
+	pl.Status.Degradation = &dfv1.DegradationStatus{
+		Degraded:           d.GetDegraded(),
+		Reason:             d.GetReason(),
+		LastTransitionTime: transitionTime,
+	}
+}
+
+func (r *pipelineReconciler) recordDegradationEvent(pl *dfv1.Pipeline, degraded bool, reason string) {
+	if degraded {
+		r.recorder.Eventf(pl, corev1.EventTypeWarning, "PipelineDegraded", "Degraded the best-effort edges, %s", reason)
+	} else {
+		r.recorder.Eventf(pl, corev1.EventTypeNormal, "PipelineRestored", "Restored the best-effort edges, %s", reason)
+	}
+}
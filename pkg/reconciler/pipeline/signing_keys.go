@@ -0,0 +1,87 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pipeline
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb/signing"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+// createOrRotateSigningKeys creates the secret of the message signing keys if it doesn't exist, and rotates the keys
+// once the newest one is older than the rotation interval. It returns the time of the next rotation, which is zero if
+// there's no rotation.
+func (r *pipelineReconciler) createOrRotateSigningKeys(ctx context.Context, pl *dfv1.Pipeline) (time.Time, error) {
+	if pl.Spec.MessageSigning == nil {
+		return time.Time{}, nil
+	}
+	log := logging.FromContext(ctx)
+	name := dfv1.GenerateSigningKeysSecretName(pl.Name)
+	interval := pl.Spec.MessageSigning.GetRotationInterval()
+	existing, err := r.kubeClient.CoreV1().Secrets(pl.Namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return time.Time{}, fmt.Errorf("failed to get the signing keys secret, %w", err)
+		}
+		keys, _, next, err := signing.RotateKeys(nil, time.Now(), interval)
+		if err != nil {
+			return time.Time{}, err
+		}
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: pl.Namespace,
+				Name:      name,
+				Labels: map[string]string{
+					dfv1.KeyPartOf:       dfv1.Project,
+					dfv1.KeyManagedBy:    dfv1.ControllerPipeline,
+					dfv1.KeyComponent:    dfv1.ComponentVertex,
+					dfv1.KeyPipelineName: pl.Name,
+				},
+				OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(pl.GetObjectMeta(), dfv1.PipelineGroupVersionKind)},
+			},
+			Type: corev1.SecretTypeOpaque,
+			Data: keys,
+		}
+		if _, err := r.kubeClient.CoreV1().Secrets(pl.Namespace).Create(ctx, secret, metav1.CreateOptions{}); err != nil {
+			return time.Time{}, fmt.Errorf("failed to create the signing keys secret, %w", err)
+		}
+		log.Infow("Created the signing keys secret", zap.String("secret", name))
+		return next, nil
+	}
+	keys, changed, next, err := signing.RotateKeys(existing.Data, time.Now(), interval)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if changed {
+		existing.Data = keys
+		if _, err := r.kubeClient.CoreV1().Secrets(pl.Namespace).Update(ctx, existing, metav1.UpdateOptions{}); err != nil {
+			return time.Time{}, fmt.Errorf("failed to rotate the signing keys, %w", err)
+		}
+		log.Infow("Rotated the signing keys", zap.String("secret", name))
+		r.recorder.Event(pl, corev1.EventTypeNormal, "RotatedSigningKeys", "Rotated the message signing keys")
+	}
+	return next, nil
+}
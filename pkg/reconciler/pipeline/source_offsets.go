@@ -0,0 +1,116 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pipeline
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	daemonclient "github.com/numaproj/numaflow/pkg/daemon/client"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+// sourceOffsetsReset is the request in the annotation numaflow.numaproj.io/reset-source-offsets of a pipeline, to reset
+// the committed offsets of a source vertex, either to a timestamp or to the offsets by the partitions.
+type sourceOffsetsReset struct {
+	Vertex    string           `json:"vertex"`
+	Timestamp *metav1.Time     `json:"timestamp,omitempty"`
+	Offsets   map[int32]string `json:"offsets,omitempty"`
+	Reason    string           `json:"reason,omitempty"`
+}
+
+// resetSourceOffsets coordinates the offsets reset requested by the annotation of the pipeline. It scales down the
+// source vertex and the vertices downstream, resets the offsets and the watermarks through the daemon service once the
+// pods are gone, then removes the annotation and scales the vertices back up if the pipeline is running. It returns
+// true if it needs to be requeued.
+func (r *pipelineReconciler) resetSourceOffsets(ctx context.Context, pl *dfv1.Pipeline) (bool, error) {
+	log := logging.FromContext(ctx)
+	req := &sourceOffsetsReset{}
+	if err := json.Unmarshal([]byte(pl.Annotations[dfv1.KeyResetSourceOffsets]), req); err != nil {
+		r.recorder.Eventf(pl, corev1.EventTypeWarning, "ResetSourceOffsetsFailed", "Invalid annotation %s, %v", dfv1.KeyResetSourceOffsets, err)
+		return false, r.finishSourceOffsetsReset(ctx, pl, nil)
+	}
+	if pl.GetVertex(req.Vertex) == nil || !pl.GetVertex(req.Vertex).IsASource() {
+		r.recorder.Eventf(pl, corev1.EventTypeWarning, "ResetSourceOffsetsFailed", "Source vertex %q not found", req.Vertex)
+		return false, r.finishSourceOffsetsReset(ctx, pl, nil)
+	}
+	affected := map[string]bool{req.Vertex: true}
+	for _, e := range pl.GetDownstreamEdges(req.Vertex) {
+		affected[e.To] = true
+	}
+	filter := func(v dfv1.Vertex) bool { return affected[v.Spec.Name] }
+	if scaled, err := r.scaleVertex(ctx, pl, filter, 0); err != nil || scaled {
+		// Requeue to wait for the pods to be gone
+		return scaled, err
+	}
+
+	daemonClient, err := daemonclient.NewDaemonServiceClient(pl.GetDaemonServiceURL())
+	if err != nil {
+		return true, err
+	}
+	defer func() { _ = daemonClient.Close() }()
+	var timestamp *int64
+	if req.Timestamp != nil {
+		timestamp = pointer.Int64(req.Timestamp.UnixMilli())
+	}
+	cctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+	offsets, err := daemonClient.ResetSourceOffsets(cctx, pl.Name, req.Vertex, timestamp, req.Offsets, req.Reason)
+	if err != nil {
+		switch status.Code(err) {
+		case codes.InvalidArgument, codes.NotFound, codes.Unimplemented:
+			r.recorder.Eventf(pl, corev1.EventTypeWarning, "ResetSourceOffsetsFailed", "Failed to reset the offsets of source vertex %s, %s", req.Vertex, status.Convert(err).Message())
+			return false, r.finishSourceOffsetsReset(ctx, pl, filter)
+		default:
+			// e.g. the pods are still terminating, or the daemon service is not available
+			log.Infow("Failed to reset the offsets of the source vertex, will retry", zap.String("vertex", req.Vertex), zap.Error(err))
+			return true, nil
+		}
+	}
+	log.Infow("Reset the offsets of the source vertex", zap.String("vertex", req.Vertex), zap.Any("offsets", offsets))
+	r.recorder.Eventf(pl, corev1.EventTypeNormal, "ResetSourceOffsets", "Reset the offsets of source vertex %s to %s", req.Vertex, describeResetTarget(req, offsets))
+	return false, r.finishSourceOffsetsReset(ctx, pl, filter)
+}
+
+// finishSourceOffsetsReset removes the annotation, and scales up the vertices matching the filter if the pipeline is
+// running.
+func (r *pipelineReconciler) finishSourceOffsetsReset(ctx context.Context, pl *dfv1.Pipeline, filter vertexFilterFunc) error {
+	delete(pl.Annotations, dfv1.KeyResetSourceOffsets)
+	if filter == nil || pl.Spec.Lifecycle.GetDesiredPhase() != dfv1.PipelinePhaseRunning {
+		return nil
+	}
+	_, err := r.scaleVertex(ctx, pl, filter, 1)
+	return err
+}
+
+// describeResetTarget describes the timestamp or the offsets that a source is reset to, for the events.
+func describeResetTarget(req *sourceOffsetsReset, offsets map[int32]string) string {
+	if len(offsets) == 0 && req.Timestamp != nil {
+		return req.Timestamp.UTC().Format(time.RFC3339)
+	}
+	return fmt.Sprintf("offsets %v", offsets)
+}
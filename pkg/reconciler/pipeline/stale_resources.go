@@ -0,0 +1,165 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pipeline
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+	sharedutil "github.com/numaproj/numaflow/pkg/shared/util"
+)
+
+// addStaleResources records the ISB Service resources no longer used by the pipeline in its status, they are cleaned
+// up after the clean up delay. The ones already recorded are skipped, e.g. the ones of the stale vertices failed to be
+// deleted in the last reconciliation.
+func addStaleResources(pl *dfv1.Pipeline, buffers, buckets map[string]string, sideInputs []string, domains map[string]string) {
+	recordedBuffers := make(map[string]bool)
+	recordedBuckets := make(map[string]bool)
+	recordedSideInputs := make(map[string]bool)
+	for _, sr := range pl.Status.StaleResources {
+		for _, b := range sr.Buffers {
+			recordedBuffers[b] = true
+		}
+		for _, b := range sr.Buckets {
+			recordedBuckets[b] = true
+		}
+		for _, si := range sr.SideInputs {
+			recordedSideInputs[si] = true
+		}
+	}
+	sr := dfv1.StaleResources{RemovedAt: metav1.Now()}
+	addDomain := func(name string) {
+		if d, ok := domains[name]; ok {
+			if sr.JetStreamDomains == nil {
+				sr.JetStreamDomains = make(map[string]string)
+			}
+			sr.JetStreamDomains[name] = d
+		}
+	}
+	for b := range buffers {
+		if !recordedBuffers[b] {
+			sr.Buffers = append(sr.Buffers, b)
+			addDomain(b)
+		}
+	}
+	for b := range buckets {
+		if !recordedBuckets[b] {
+			sr.Buckets = append(sr.Buckets, b)
+			addDomain(b)
+		}
+	}
+	for _, si := range sideInputs {
+		if !recordedSideInputs[si] {
+			sr.SideInputs = append(sr.SideInputs, si)
+		}
+	}
+	if len(sr.Buffers) == 0 && len(sr.Buckets) == 0 && len(sr.SideInputs) == 0 {
+		return
+	}
+	sort.Strings(sr.Buffers)
+	sort.Strings(sr.Buckets)
+	sort.Strings(sr.SideInputs)
+	pl.Status.StaleResources = append(pl.Status.StaleResources, sr)
+}
+
+// cleanUpStaleResources creates a job to delete the stale ISB Service resources of the pipeline, whose clean up delay
+// has passed. The ones used by the pipeline again, e.g. a removed vertex added back, are dropped without deletion. It
+// returns the time to wait for the next stale resources to be cleaned up, or 0 if there are no more.
+func (r *pipelineReconciler) cleanUpStaleResources(ctx context.Context, pl *dfv1.Pipeline, isbSvcConfig dfv1.BufferServiceConfig) (time.Duration, error) {
+	if len(pl.Status.StaleResources) == 0 {
+		return 0, nil
+	}
+	log := logging.FromContext(ctx)
+	inUseBuffers := make(map[string]bool)
+	for _, b := range pl.GetAllBuffers() {
+		inUseBuffers[b] = true
+	}
+	inUseBuckets := make(map[string]bool)
+	for _, b := range pl.GetAllBuckets() {
+		inUseBuckets[b] = true
+	}
+	inUseSideInputs := make(map[string]bool)
+	for _, si := range pl.Spec.SideInputs {
+		inUseSideInputs[si.Name] = true
+	}
+	notInUse := func(names []string, inUse map[string]bool) []string {
+		var result []string
+		for _, n := range names {
+			if !inUse[n] {
+				result = append(result, n)
+			}
+		}
+		return result
+	}
+
+	delay := time.Duration(pl.Spec.Lifecycle.GetCleanUpDelaySeconds()) * time.Second
+	due := dfv1.StaleResources{JetStreamDomains: make(map[string]string)}
+	var pending []dfv1.StaleResources
+	var nextCleanUp time.Duration
+	for _, sr := range pl.Status.StaleResources {
+		sr.Buffers = notInUse(sr.Buffers, inUseBuffers)
+		sr.Buckets = notInUse(sr.Buckets, inUseBuckets)
+		sr.SideInputs = notInUse(sr.SideInputs, inUseSideInputs)
+		if len(sr.Buffers) == 0 && len(sr.Buckets) == 0 && len(sr.SideInputs) == 0 {
+			continue
+		}
+		if wait := time.Until(sr.RemovedAt.Add(delay)); wait > 0 {
+			pending = append(pending, sr)
+			if nextCleanUp == 0 || nextCleanUp > wait {
+				nextCleanUp = wait
+			}
+			continue
+		}
+		due.Buffers = append(due.Buffers, sr.Buffers...)
+		due.Buckets = append(due.Buckets, sr.Buckets...)
+		due.SideInputs = append(due.SideInputs, sr.SideInputs...)
+		for k, d := range sr.JetStreamDomains {
+			due.JetStreamDomains[k] = d
+		}
+	}
+
+	if len(due.Buffers) > 0 || len(due.Buckets) > 0 || len(due.SideInputs) > 0 {
+		sort.Strings(due.Buffers)
+		sort.Strings(due.Buckets)
+		sort.Strings(due.SideInputs)
+		args := []string{fmt.Sprintf("--buffers=%s", strings.Join(due.Buffers, ",")), fmt.Sprintf("--buckets=%s", strings.Join(due.Buckets, ","))}
+		if len(due.SideInputs) > 0 {
+			args = append(args, fmt.Sprintf("--side-inputs-store=%s", pl.GetSideInputsStoreName()), fmt.Sprintf("--side-inputs=%s", strings.Join(due.SideInputs, ",")))
+		}
+		args = append(args, dfv1.JetStreamDomainsArgs(due.JetStreamDomains)...)
+		batchJob := buildISBBatchJob(pl, pl.Spec.GetRuntimeImage(r.image), isbSvcConfig, "isbsvc-delete", args, "delete")
+		// Name the job after the resources rather than the spec, which might be the same for the resources removed at
+		// different times
+		batchJob.Name = fmt.Sprintf("%s-buffer-bucket-delete-%s", pl.Name, strings.ToLower(sharedutil.MustHash(strings.Join(args, " "))[:6]))
+		if err := r.client.Create(ctx, batchJob); err != nil && !apierrors.IsAlreadyExists(err) {
+			// Keep the stale resources in the status to retry in the next reconciliation
+			return 0, fmt.Errorf("failed to create ISB Svc deleting job, err: %w", err)
+		}
+		log.Infow("Created ISB Svc deleting job successfully", zap.Strings("buffers", due.Buffers), zap.Strings("buckets", due.Buckets), zap.Strings("sideInputs", due.SideInputs))
+	}
+	pl.Status.StaleResources = pending
+	return nextCleanUp, nil
+}
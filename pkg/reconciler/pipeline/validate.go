@@ -18,7 +18,12 @@ package pipeline
 
 import (
 	"fmt"
+	"strings"
+	"text/template"
+	"time"
 
+	cronlib "github.com/robfig/cron/v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
@@ -36,9 +41,55 @@ func ValidatePipeline(pl *dfv1.Pipeline) error {
 	if len(pl.Spec.Vertices) == 0 {
 		return fmt.Errorf("empty vertices")
 	}
-	if len(pl.Spec.Edges) == 0 {
+	// The vertices of the cross-pipeline edges stand for the missing sources, sinks and edges of a decomposed pipeline
+	toPipelines := make(map[string]bool)
+	fromPipelines := make(map[string]bool)
+	for _, v := range pl.Spec.Vertices {
+		if v.ToPipeline != nil {
+			toPipelines[v.Name] = true
+		}
+		if len(v.FromPipelines) > 0 {
+			fromPipelines[v.Name] = true
+		}
+	}
+	if len(pl.Spec.Edges) == 0 && len(toPipelines) == 0 && len(fromPipelines) == 0 {
 		return fmt.Errorf("no edges defined")
 	}
+	if deployed, desired := pl.Status.WatermarkTimeUnit, pl.Spec.Watermark.GetTimeUnitType(); deployed != "" && deployed != desired {
+		return fmt.Errorf("watermark timeUnit can not be changed from %q to %q after the pipeline is deployed, delete and recreate the pipeline to change it", deployed, desired)
+	}
+	if x := pl.Spec.ClaimCheck; x != nil && x.GetThreshold() <= 0 {
+		return fmt.Errorf("invalid claim check threshold %q, it should be positive", x.Threshold.String())
+	}
+	if x := pl.Spec.Tracing; x != nil {
+		if x.OTLP == nil || x.OTLP.Endpoint == "" {
+			return fmt.Errorf("invalid tracing settings, an otlp endpoint is required")
+		}
+		if x.SamplingPercentage != nil && *x.SamplingPercentage > 100 {
+			return fmt.Errorf("invalid tracing sampling percentage %d, it should not be greater than 100", *x.SamplingPercentage)
+		}
+	}
+	if x := pl.Spec.MessageSigning; x != nil {
+		if d := x.GetRotationInterval(); d < 0 || (d > 0 && d < 10*time.Minute) {
+			return fmt.Errorf("invalid message signing rotation interval %q, it should be 0 or no less than 10m", d.String())
+		}
+	}
+	if x := pl.Spec.MessageChecksum; x != nil {
+		if a := x.GetAlgorithm(); a != dfv1.ChecksumAlgorithmCRC32C && a != dfv1.ChecksumAlgorithmXXHash {
+			return fmt.Errorf("invalid message checksum algorithm %q, it should be %q or %q", a, dfv1.ChecksumAlgorithmCRC32C, dfv1.ChecksumAlgorithmXXHash)
+		}
+		if q := x.GetQuarantine(); q != dfv1.ChecksumQuarantineDrop && q != dfv1.ChecksumQuarantineRedeliver {
+			return fmt.Errorf("invalid message checksum quarantine policy %q, it should be %q or %q", q, dfv1.ChecksumQuarantineDrop, dfv1.ChecksumQuarantineRedeliver)
+		}
+		if x.MaxDeliveries != nil && *x.MaxDeliveries == 0 {
+			return fmt.Errorf("invalid message checksum max deliveries 0, it should be greater than 0")
+		}
+	}
+	if x := pl.Spec.MessageEncryption; x != nil {
+		if err := validateMessageEncryption(*x); err != nil {
+			return fmt.Errorf("invalid message encryption, %w", err)
+		}
+	}
 	names := make(map[string]bool)
 	sources := make(map[string]dfv1.AbstractVertex)
 	udTransformers := make(map[string]dfv1.AbstractVertex)
@@ -59,7 +110,7 @@ func ValidatePipeline(pl *dfv1.Pipeline) error {
 				return fmt.Errorf("invalid vertex %q, only one of 'source', 'sink' and 'udf' can be specified", v.Name)
 			}
 			sources[v.Name] = v
-			if v.Source.UDTransformer != nil {
+			if v.HasUDTransformer() {
 				udTransformers[v.Name] = v
 			}
 		}
@@ -81,40 +132,77 @@ func ValidatePipeline(pl *dfv1.Pipeline) error {
 		}
 	}
 
-	if len(sources) == 0 {
+	if len(sources) == 0 && len(fromPipelines) == 0 {
 		return fmt.Errorf("pipeline has no source, at least one vertex with 'source' defined is required")
 	}
 
-	if len(sinks) == 0 {
+	if len(sinks) == 0 && len(toPipelines) == 0 {
 		return fmt.Errorf("pipeline has no sink, at least one vertex with 'sink' defined is required")
 	}
 
+	for k, s := range sinks {
+		if x := s.Sink.WatermarkGate; x != nil {
+			if pl.Spec.Watermark.Disabled {
+				return fmt.Errorf("invalid sink vertex %q, watermark gate can not be used when watermark is disabled", k)
+			}
+			if x.MaxHeldMessages != nil && *x.MaxHeldMessages == 0 {
+				return fmt.Errorf("invalid sink vertex %q, max held messages of the watermark gate should be positive", k)
+			}
+		}
+	}
+
 	for k, s := range sources {
 		if s.IsUDSource() {
 			if s.Source.UDSource.Container == nil || s.Source.UDSource.Container.Image == "" {
 				return fmt.Errorf("invalid user-defined source vertex %q, a customized image is required", k)
 			}
-			if s.Source.HTTP != nil || s.Source.Kafka != nil || s.Source.Nats != nil || s.Source.RedisStreams != nil || s.Source.Generator != nil {
-				return fmt.Errorf("invalid user-defined source vertex %q, only one of 'http', 'kafka', 'nats', 'redisStreams', 'generator' and 'udSource' can be specified", k)
+			if s.Source.HTTP != nil || s.Source.Kafka != nil || s.Source.Nats != nil || s.Source.RedisStreams != nil || s.Source.Generator != nil || s.Source.Pulsar != nil || s.Source.WebSocket != nil || s.Source.MQTT != nil {
+				return fmt.Errorf("invalid user-defined source vertex %q, only one of 'http', 'kafka', 'nats', 'redisStreams', 'generator', 'pulsar', 'webSocket', 'mqtt' and 'udSource' can be specified", k)
 			}
 		}
 	}
 
 	for k, t := range udTransformers {
-		transformer := t.Source.UDTransformer
-		if transformer.Container != nil {
-			if transformer.Container.Image == "" && transformer.Builtin == nil {
-				return fmt.Errorf("invalid source vertex %q, either specify a builtin transformer, or a customized image", k)
+		if t.Source.UDTransformer != nil {
+			if len(t.Source.Transformers) > 0 {
+				return fmt.Errorf("invalid source vertex %q, can not specify both 'transformer' and 'transformers'", k)
 			}
-			if transformer.Container.Image != "" && transformer.Builtin != nil {
-				return fmt.Errorf("invalid source vertex %q, can not specify both builtin transformer, and a customized image", k)
+			if err := validateUDTransformer(*t.Source.UDTransformer); err != nil {
+				return fmt.Errorf("invalid source vertex %q, %w", k, err)
+			}
+			continue
+		}
+		stageNames := make(map[string]bool)
+		for _, stage := range t.Source.Transformers {
+			if errs := k8svalidation.IsDNS1123Label(stage.VolumeName()); len(errs) > 0 {
+				return fmt.Errorf("invalid source vertex %q, invalid transformer stage name %q, %v", k, stage.Name, errs)
+			}
+			if stageNames[stage.Name] {
+				return fmt.Errorf("invalid source vertex %q, duplicate transformer stage name %q", k, stage.Name)
+			}
+			stageNames[stage.Name] = true
+			if err := validateUDTransformer(stage.UDTransformer); err != nil {
+				return fmt.Errorf("invalid source vertex %q, transformer stage %q: %w", k, stage.Name, err)
+			}
+			if stage.GetOnFailure() == dfv1.TransformerFailureRoute && stage.FailureTag == "" {
+				return fmt.Errorf("invalid source vertex %q, transformer stage %q: a failure tag is required to route the failed messages", k, stage.Name)
 			}
-		} else if transformer.Builtin == nil {
-			return fmt.Errorf("invalid source vertex %q, either specify a builtin transformer, or a customized image", k)
 		}
 	}
 
 	for k, u := range mapUdfs {
+		if r := u.UDF.Remote; r != nil {
+			if u.UDF.Builtin != nil || (u.UDF.Container != nil && u.UDF.Container.Image != "") {
+				return fmt.Errorf("invalid vertex %q, can not specify a remote UDF with a builtin function, or a customized image", k)
+			}
+			if r.Endpoint == "" {
+				return fmt.Errorf("invalid vertex %q, endpoint of the remote UDF is missing", k)
+			}
+			if len(u.SideInputs) > 0 {
+				return fmt.Errorf("invalid vertex %q, side inputs are not supported with a remote UDF", k)
+			}
+			continue
+		}
 		if u.UDF.Container != nil {
 			if u.UDF.Container.Image == "" && u.UDF.Builtin == nil {
 				return fmt.Errorf("invalid vertex %q, either specify a builtin function, or a customized image", k)
@@ -132,6 +220,31 @@ func ValidatePipeline(pl *dfv1.Pipeline) error {
 			// No builtin function supported for reduce vertices.
 			return fmt.Errorf("invalid vertex %q, there's no buildin function support in reduce vertices", k)
 		}
+		if u.UDF.Remote != nil {
+			return fmt.Errorf("invalid vertex %q, remote UDF is not supported in reduce vertices", k)
+		}
+		if j := u.UDF.GroupBy.Join; j != nil {
+			if u.UDF.Container != nil {
+				return fmt.Errorf("invalid vertex %q, can not specify both join, and a customized image", k)
+			}
+			if !u.UDF.GroupBy.Keyed {
+				return fmt.Errorf("invalid vertex %q, a join has to be keyed", k)
+			}
+			if a := u.UDF.GroupBy.Window.Accumulator; a != nil && a.Flush {
+				return fmt.Errorf("invalid vertex %q, the flush of the accumulator windows requires a user defined container, which is not available in a join", k)
+			}
+			if t := j.GetType(); t != dfv1.JoinTypeInner && t != dfv1.JoinTypeOuter {
+				return fmt.Errorf("invalid vertex %q, unsupported join type %q", k, t)
+			}
+			fromVertices := make(map[string]bool)
+			for _, e := range pl.GetFromEdges(k) {
+				fromVertices[e.From] = true
+			}
+			if len(fromVertices) != 2 {
+				return fmt.Errorf("invalid vertex %q, a join requires exactly 2 inbound vertices, got %d", k, len(fromVertices))
+			}
+			continue
+		}
 		if u.UDF.Container != nil {
 			if u.UDF.Container.Image == "" {
 				return fmt.Errorf("invalid vertex %q, a customized image is required", k)
@@ -156,9 +269,75 @@ func ValidatePipeline(pl *dfv1.Pipeline) error {
 		if _, existing := sinks[e.From]; existing {
 			return fmt.Errorf("sink vertex %q can not be define as 'from'", e.To)
 		}
+		if e.Shuffle != nil {
+			if _, existing := reduceUdfs[e.To]; !existing {
+				return fmt.Errorf("invalid edge from %q to %q: shuffle strategy is only supported for edges pointing to reduce vertices", e.From, e.To)
+			}
+			for _, idx := range e.Shuffle.KeyIndexes {
+				if idx < 0 {
+					return fmt.Errorf("invalid edge from %q to %q: shuffle key index should not be smaller than 0", e.From, e.To)
+				}
+			}
+			if e.Shuffle.GetType() == dfv1.ShuffleTypeHeader {
+				if e.Shuffle.HeaderName == "" {
+					return fmt.Errorf("invalid edge from %q to %q: header name is required by the header shuffle strategy", e.From, e.To)
+				}
+			} else if e.Shuffle.HeaderName != "" {
+				return fmt.Errorf("invalid edge from %q to %q: header name is only supported by the header shuffle strategy", e.From, e.To)
+			}
+		}
+		if e.MessageTTL != nil && e.MessageTTL.GetDuration() <= 0 {
+			return fmt.Errorf("invalid edge from %q to %q: message TTL duration should be positive", e.From, e.To)
+		}
+		if e.Weight != nil && *e.Weight < 0 {
+			return fmt.Errorf("invalid edge from %q to %q: weight should not be smaller than 0", e.From, e.To)
+		}
+		if e.GetWriteAckPolicy() == dfv1.WriteAckPolicyFireAndForget {
+			if _, existing := reduceUdfs[e.To]; existing {
+				return fmt.Errorf("invalid edge from %q to %q: fire-and-forget write ack policy is not supported for edges pointing to reduce vertices", e.From, e.To)
+			}
+		}
+		if e.Schema != nil {
+			if _, existing := reduceUdfs[e.From]; existing {
+				return fmt.Errorf("invalid edge from %q to %q: schema is not supported for edges from reduce vertices", e.From, e.To)
+			}
+			if err := validateEdgeSchema(*pl, e); err != nil {
+				return fmt.Errorf("invalid edge from %q to %q: %w", e.From, e.To, err)
+			}
+		}
+		if m := e.Mirror; m != nil {
+			if m.Pipeline == "" || m.Vertex == "" {
+				return fmt.Errorf("invalid edge from %q to %q: both pipeline and vertex are required for mirror", e.From, e.To)
+			}
+			if m.Pipeline == pl.Name {
+				return fmt.Errorf("invalid edge from %q to %q: mirror pipeline should not be the pipeline itself", e.From, e.To)
+			}
+		}
+		if d := e.Degradation; d != nil {
+			if pl.Spec.Degradation == nil {
+				return fmt.Errorf("invalid edge from %q to %q: degradation requires the degradation policy of the pipeline", e.From, e.To)
+			}
+			if d.GetAction() != dfv1.EdgeDegradationActionDisable && d.GetAction() != dfv1.EdgeDegradationActionSample {
+				return fmt.Errorf("invalid edge from %q to %q: unsupported degradation action %q", e.From, e.To, d.Action)
+			}
+			if d.SamplePercent != nil && *d.SamplePercent > 100 {
+				return fmt.Errorf("invalid edge from %q to %q: degradation sample percent should not be greater than 100", e.From, e.To)
+			}
+		}
+		if e.StrictFIFO {
+			if err := validateStrictFIFOEdge(*pl, e); err != nil {
+				return fmt.Errorf("invalid edge from %q to %q: %w", e.From, e.To, err)
+			}
+		}
 		namesInEdges[e.From] = true
 		namesInEdges[e.To] = true
 	}
+	for name := range toPipelines {
+		namesInEdges[name] = true
+	}
+	for name := range fromPipelines {
+		namesInEdges[name] = true
+	}
 	if len(namesInEdges) != len(names) {
 		return fmt.Errorf("not all the vertex names are defined in edges")
 	}
@@ -170,6 +349,21 @@ func ValidatePipeline(pl *dfv1.Pipeline) error {
 		return err
 	}
 
+	if x := pl.Spec.Limits; x != nil {
+		if err := validateLimits(x.UDFConcurrency, x.RetryInterval); err != nil {
+			return fmt.Errorf("invalid pipeline limits, %w", err)
+		}
+	}
+
+	if d := pl.Spec.Degradation; d != nil {
+		if d.MaxPending == nil && d.MaxLatency == nil {
+			return fmt.Errorf("invalid degradation policy, at least one of maxPending and maxLatency is required")
+		}
+		if (d.MaxPending != nil && *d.MaxPending <= 0) || (d.MaxLatency != nil && d.MaxLatency.Duration <= 0) {
+			return fmt.Errorf("invalid degradation policy, maxPending and maxLatency should be positive")
+		}
+	}
+
 	for _, v := range pl.Spec.Vertices {
 		if err := validateVertex(v); err != nil {
 			return err
@@ -184,6 +378,137 @@ func ValidatePipeline(pl *dfv1.Pipeline) error {
 		return err
 	}
 
+	if err := validateLeafNodes(*pl); err != nil {
+		return err
+	}
+
+	if err := validateCrossPipelineEdges(*pl); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateCrossPipelineEdges validates the vertices connected to the vertices of other pipelines. A vertex with a
+// toPipeline is the last one of its pipeline before the edge, and a vertex with fromPipelines is the first one after it.
+func validateCrossPipelineEdges(pl dfv1.Pipeline) error {
+	for _, v := range pl.Spec.Vertices {
+		if v.ToPipeline == nil && len(v.FromPipelines) == 0 {
+			continue
+		}
+		if pl.Spec.MessageSigning != nil || pl.Spec.ClaimCheck != nil {
+			return fmt.Errorf("invalid vertex %q, cross-pipeline edges do not support message signing or claim check", v.Name)
+		}
+		if x := v.ToPipeline; x != nil {
+			if v.IsASink() {
+				return fmt.Errorf("invalid vertex %q, a sink vertex can not have a toPipeline", v.Name)
+			}
+			if len(pl.GetToEdges(v.Name)) > 0 {
+				return fmt.Errorf("invalid vertex %q, a vertex with a toPipeline can not have outgoing edges", v.Name)
+			}
+			if x.Pipeline == "" || x.Vertex == "" {
+				return fmt.Errorf("invalid vertex %q, both pipeline and vertex are required for toPipeline", v.Name)
+			}
+			if x.GetNamespace(pl.Namespace) == pl.Namespace && x.Pipeline == pl.Name {
+				return fmt.Errorf("invalid vertex %q, toPipeline should not be the pipeline itself", v.Name)
+			}
+		}
+		if len(v.FromPipelines) > 0 {
+			if v.IsASource() {
+				return fmt.Errorf("invalid vertex %q, a source vertex can not have fromPipelines", v.Name)
+			}
+			if len(pl.GetFromEdges(v.Name)) > 0 {
+				return fmt.Errorf("invalid vertex %q, a vertex with fromPipelines can not have incoming edges", v.Name)
+			}
+			seen := make(map[string]bool)
+			for _, from := range v.FromPipelines {
+				if from.Pipeline == "" || from.Vertex == "" {
+					return fmt.Errorf("invalid vertex %q, both pipeline and vertex are required for fromPipelines", v.Name)
+				}
+				name := dfv1.CrossPipelineVertexName(from.GetNamespace(pl.Namespace), from.Pipeline, from.Vertex)
+				if seen[name] {
+					return fmt.Errorf("invalid vertex %q, duplicate vertex %q of pipeline %q in fromPipelines", v.Name, from.Vertex, from.Pipeline)
+				}
+				seen[name] = true
+			}
+		}
+	}
+	return nil
+}
+
+// validateLeafNodes validates the vertices connecting through leaf nodes. The buffers of a vertex are kept in the
+// JetStream domain of the vertices writing to them, so all of these vertices have to be in the same domain.
+func validateLeafNodes(pl dfv1.Pipeline) error {
+	leafNodes := false
+	for _, v := range pl.Spec.Vertices {
+		x := v.LeafNode
+		if x == nil {
+			continue
+		}
+		leafNodes = true
+		if x.URL == "" || x.Domain == "" {
+			return fmt.Errorf("invalid vertex %q, both url and domain of the leaf node are required", v.Name)
+		}
+		if strings.ContainsAny(x.Domain, ". *>") {
+			return fmt.Errorf("invalid vertex %q, invalid leaf node domain %q", v.Name, x.Domain)
+		}
+	}
+	if !leafNodes {
+		return nil
+	}
+	if pl.Spec.ClaimCheck != nil {
+		// the claim check object store is only available in the domain of the ISB Service
+		return fmt.Errorf("claim check is not supported with leaf nodes")
+	}
+	for _, v := range pl.Spec.Vertices {
+		domains := make(map[string]bool)
+		for _, e := range pl.GetFromEdges(v.Name) {
+			if from := pl.GetVertex(e.From); from != nil {
+				domains[from.GetJetStreamDomain()] = true
+			}
+		}
+		if len(domains) > 1 {
+			return fmt.Errorf("invalid vertex %q, the vertices writing to it are in different JetStream domains", v.Name)
+		}
+	}
+	return nil
+}
+
+func validateEdgeSchema(pl dfv1.Pipeline, e dfv1.Edge) error {
+	s := e.Schema
+	switch s.Format {
+	case dfv1.SchemaFormatJSON, dfv1.SchemaFormatAvro:
+	case dfv1.SchemaFormatProtobuf:
+		if s.MessageType == "" {
+			return fmt.Errorf("message type is required for protobuf schema")
+		}
+	default:
+		return fmt.Errorf("unsupported schema format %q", s.Format)
+	}
+	if (s.Definition == "") == (s.Registry == nil) {
+		return fmt.Errorf("exactly one of schema definition and registry is required")
+	}
+	if r := s.Registry; r != nil && (r.URL == "" || r.Subject == "") {
+		return fmt.Errorf("both url and subject are required for schema registry")
+	}
+	if s.ErrorTo != "" {
+		if s.ErrorTo == e.To {
+			return fmt.Errorf("schema error edge should not be the edge itself")
+		}
+		found := false
+		for _, edge := range pl.GetToEdges(e.From) {
+			if edge.To == s.ErrorTo {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("no edge from %q to the schema error vertex %q", e.From, s.ErrorTo)
+		}
+		if v := pl.GetVertex(s.ErrorTo); v != nil && v.IsReduceUDF() {
+			return fmt.Errorf("schema error vertex %q should not be a reduce vertex", s.ErrorTo)
+		}
+	}
 	return nil
 }
 
@@ -191,6 +516,11 @@ func validateVertex(v dfv1.AbstractVertex) error {
 	if errs := k8svalidation.IsDNS1035Label(v.Name); len(errs) > 0 {
 		return fmt.Errorf("invalid vertex name %q, %v", v.Name, errs)
 	}
+	if v.Namespace != "" {
+		if errs := k8svalidation.IsDNS1123Label(v.Namespace); len(errs) > 0 {
+			return fmt.Errorf("vertex %q: invalid namespace %q, %v", v.Name, v.Namespace, errs)
+		}
+	}
 	min, max := int32(0), int32(dfv1.DefaultMaxReplicas)
 	if v.Scale.Min != nil {
 		min = *v.Scale.Min
@@ -228,23 +558,387 @@ func validateVertex(v dfv1.AbstractVertex) error {
 			return fmt.Errorf("vertex %q: sidecar container name %q is reserved for containers created by numaflow", v.Name, sc.Name)
 		}
 	}
+	if v.Source != nil && v.Source.Pulsar != nil {
+		if x := v.Source.Pulsar; x.ServiceURL == "" || x.Topic == "" || x.SubscriptionName == "" {
+			return fmt.Errorf("vertex %q: 'serviceURL', 'topic' and 'subscriptionName' are required for pulsar source", v.Name)
+		}
+	}
+	if v.Source != nil && v.Source.RedisStreams != nil {
+		x := v.Source.RedisStreams
+		if x.Stream == "" || x.ConsumerGroup == "" {
+			return fmt.Errorf("vertex %q: 'stream' and 'consumerGroup' are required for redis streams source", v.Name)
+		}
+		if x.GetClaimMinIdleTime() < 0 {
+			return fmt.Errorf("vertex %q: 'claimMinIdleTime' of redis streams source can not be negative", v.Name)
+		}
+	}
+	if v.Source != nil && v.Source.WebSocket != nil {
+		if x := v.Source.WebSocket; x.MaxConnections != nil && *x.MaxConnections == 0 {
+			return fmt.Errorf("vertex %q: 'maxConnections' of websocket source should be greater than 0", v.Name)
+		}
+	}
+	if v.Source != nil && v.Source.MQTT != nil {
+		x := v.Source.MQTT
+		if x.URL == "" || len(x.Topics) == 0 {
+			return fmt.Errorf("vertex %q: 'url' and 'topics' are required for mqtt source", v.Name)
+		}
+		if strings.ContainsAny(x.SharedSubscriptionGroup, "/+#") {
+			return fmt.Errorf("vertex %q: 'sharedSubscriptionGroup' of mqtt source can not contain '/', '+' or '#'", v.Name)
+		}
+		if !x.IsSharedSubscription() && v.Scale.GetMaxReplicas() > 1 {
+			return fmt.Errorf("vertex %q: mqtt source without shared subscription can only run one replica, set 'scale.max' to 1", v.Name)
+		}
+	}
+	if v.Source != nil && v.Source.BurstBuffer != nil {
+		x := v.Source.BurstBuffer
+		found := false
+		for _, vol := range v.Volumes {
+			if vol.Name == x.VolumeName {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("vertex %q: burst buffer volume %q is not found in the volumes", v.Name, x.VolumeName)
+		}
+		if x.GetMaxSize() <= 0 {
+			return fmt.Errorf("vertex %q: 'maxSize' of burst buffer should be greater than 0", v.Name)
+		}
+	}
+	if v.Sink != nil && v.Sink.Pulsar != nil {
+		if x := v.Sink.Pulsar; x.ServiceURL == "" || x.Topic == "" {
+			return fmt.Errorf("vertex %q: 'serviceURL' and 'topic' are required for pulsar sink", v.Name)
+		}
+	}
+	if v.Sink != nil && v.Sink.Elasticsearch != nil {
+		x := v.Sink.Elasticsearch
+		if len(x.URLs) == 0 || x.Index == "" {
+			return fmt.Errorf("vertex %q: 'urls' and 'index' are required for elasticsearch sink", v.Name)
+		}
+		if _, err := template.New("index").Parse(x.Index); err != nil {
+			return fmt.Errorf("vertex %q: invalid elasticsearch index template, %w", v.Name, err)
+		}
+		if x.BasicAuth != nil && x.APIKey != nil {
+			return fmt.Errorf("vertex %q: only one of 'basicAuth' and 'apiKey' can be specified for elasticsearch sink", v.Name)
+		}
+	}
+	if v.Sink != nil && v.Sink.ClickHouse != nil {
+		x := v.Sink.ClickHouse
+		if x.URL == "" || x.Table == "" {
+			return fmt.Errorf("vertex %q: 'url' and 'table' are required for clickhouse sink", v.Name)
+		}
+		for column, path := range x.Columns {
+			if column == "" || path == "" {
+				return fmt.Errorf("vertex %q: invalid column mapping of clickhouse sink, empty column name or field path", v.Name)
+			}
+		}
+		if b := x.Batch; b != nil && b.MaxWait != nil && b.MaxWait.Duration <= 0 {
+			return fmt.Errorf("vertex %q: 'batch.maxWait' of clickhouse sink should be positive", v.Name)
+		}
+	}
+	if x := v.Limits; x != nil {
+		if err := validateLimits(x.UDFConcurrency, x.RetryInterval); err != nil {
+			return fmt.Errorf("vertex %q: invalid limits, %w", v.Name, err)
+		}
+		if x.KeyOrdered && !v.IsMapUDF() {
+			return fmt.Errorf("vertex %q: keyOrdered is only supported for map UDF vertices", v.Name)
+		}
+	}
+	if x := v.Limits; x != nil && x.MapConnectionPoolSize != nil {
+		if *x.MapConnectionPoolSize < 1 {
+			return fmt.Errorf("vertex %q: mapConnectionPoolSize should be greater than 0", v.Name)
+		}
+		if !v.IsMapUDF() {
+			return fmt.Errorf("vertex %q: mapConnectionPoolSize is only supported for map UDF vertices", v.Name)
+		}
+	}
+	if v.Limits != nil && v.Limits.AdaptiveReadBatch != nil {
+		if err := validateAdaptiveReadBatch(v); err != nil {
+			return fmt.Errorf("vertex %q: %w", v.Name, err)
+		}
+	}
+	if v.Cache != nil {
+		if err := validateCache(v); err != nil {
+			return fmt.Errorf("vertex %q: %w", v.Name, err)
+		}
+	}
+	if v.Record != nil {
+		if err := validateRecord(v); err != nil {
+			return fmt.Errorf("vertex %q: %w", v.Name, err)
+		}
+	}
+	if err := validateScalingMetrics(v.Scale); err != nil {
+		return fmt.Errorf("vertex %q: %w", v.Name, err)
+	}
+	if err := validateScalingSchedules(v.Scale); err != nil {
+		return fmt.Errorf("vertex %q: %w", v.Name, err)
+	}
+	if len(v.Interceptors) > 0 {
+		if err := validateInterceptors(v); err != nil {
+			return fmt.Errorf("vertex %q: %w", v.Name, err)
+		}
+	}
 	if v.UDF != nil {
 		return validateUDF(*v.UDF)
 	}
 	return nil
 }
 
+func validateRecord(v dfv1.AbstractVertex) error {
+	r := v.Record
+	if v.UDF == nil {
+		return fmt.Errorf("record is only supported for UDF vertices")
+	}
+	if r.Duration != nil && r.Duration.Duration <= 0 {
+		return fmt.Errorf("record duration should be greater than 0")
+	}
+	s := r.S3
+	if s == nil {
+		return fmt.Errorf("record s3 is required")
+	}
+	if s.Endpoint == "" || s.Bucket == "" {
+		return fmt.Errorf("record s3 endpoint and bucket are required")
+	}
+	if s.AccessKeyID == nil || s.SecretAccessKey == nil {
+		return fmt.Errorf("record s3 accessKeyId and secretAccessKey are required")
+	}
+	return nil
+}
+
+func validateInterceptors(v dfv1.AbstractVertex) error {
+	if !v.IsMapUDF() && !v.IsASink() {
+		return fmt.Errorf("interceptors are only supported for map UDF and sink vertices")
+	}
+	names := make(map[string]bool)
+	for _, i := range v.Interceptors {
+		if i.Name == "" {
+			return fmt.Errorf("interceptor name is required")
+		}
+		if names[i.Name] {
+			return fmt.Errorf("duplicate interceptor name %q", i.Name)
+		}
+		names[i.Name] = true
+		if i.GRPC == nil {
+			continue
+		}
+		if i.GRPC.Address == "" {
+			return fmt.Errorf("interceptor %q: grpc address is required", i.Name)
+		}
+		if i.GRPC.Timeout != nil && i.GRPC.Timeout.Duration <= 0 {
+			return fmt.Errorf("interceptor %q: grpc timeout should be greater than 0", i.Name)
+		}
+		for _, h := range i.GRPC.Hooks {
+			if h != dfv1.InterceptorHookPreRead && h != dfv1.InterceptorHookPostUDF && h != dfv1.InterceptorHookPreWrite {
+				return fmt.Errorf("interceptor %q: unsupported hook %q", i.Name, h)
+			}
+		}
+	}
+	return nil
+}
+
+func validateMessageEncryption(me dfv1.MessageEncryption) error {
+	if me.DataKeyRotationInterval != nil && me.DataKeyRotationInterval.Duration < time.Minute {
+		return fmt.Errorf("data key rotation interval %q should be no less than 1m", me.DataKeyRotationInterval.Duration.String())
+	}
+	if providers := me.KMS.GetProviders(); len(providers) != 1 {
+		return fmt.Errorf("exactly one kms provider is required, got %v", providers)
+	}
+	kms := me.KMS
+	switch {
+	case kms.Static != nil:
+		if kms.Static.Key == nil {
+			return fmt.Errorf("static kms key is required")
+		}
+	case kms.AWS != nil:
+		if kms.AWS.KeyID == "" || kms.AWS.Region == "" {
+			return fmt.Errorf("aws kms keyId and region are required")
+		}
+		if (kms.AWS.AccessKeyID == nil) != (kms.AWS.SecretAccessKey == nil) {
+			return fmt.Errorf("aws kms accessKeyId and secretAccessKey should be specified together")
+		}
+	case kms.GCP != nil:
+		if !strings.HasPrefix(kms.GCP.KeyName, "projects/") || !strings.Contains(kms.GCP.KeyName, "/cryptoKeys/") {
+			return fmt.Errorf("invalid gcp kms keyName %q, it should be like \"projects/<project>/locations/<location>/keyRings/<keyRing>/cryptoKeys/<key>\"", kms.GCP.KeyName)
+		}
+	}
+	return nil
+}
+
+func validateScalingMetrics(scale dfv1.Scale) error {
+	switch scale.GetMetricsPolicy() {
+	case dfv1.ScalingMetricsPolicyMax, dfv1.ScalingMetricsPolicyWeighted:
+	default:
+		return fmt.Errorf("invalid scaling metrics policy %q, should be %q or %q", scale.MetricsPolicy, dfv1.ScalingMetricsPolicyMax, dfv1.ScalingMetricsPolicyWeighted)
+	}
+	names := make(map[string]bool)
+	for _, m := range scale.Metrics {
+		if m.Name == "" {
+			return fmt.Errorf("scaling metric name is required")
+		}
+		if names[m.Name] {
+			return fmt.Errorf("duplicate scaling metric name %q", m.Name)
+		}
+		names[m.Name] = true
+		if (m.Prometheus == nil) == (m.External == nil) {
+			return fmt.Errorf("scaling metric %q: exactly one of prometheus and external should be specified", m.Name)
+		}
+		if x := m.Prometheus; x != nil && (x.ServerURL == "" || x.Query == "") {
+			return fmt.Errorf("scaling metric %q: prometheus serverURL and query are required", m.Name)
+		}
+		if x := m.External; x != nil && x.MetricName == "" {
+			return fmt.Errorf("scaling metric %q: external metricName is required", m.Name)
+		}
+		if m.TargetValue.Sign() <= 0 {
+			return fmt.Errorf("scaling metric %q: targetValue should be greater than 0", m.Name)
+		}
+	}
+	return nil
+}
+
+func validateStrictFIFOEdge(pl dfv1.Pipeline, e dfv1.Edge) error {
+	if p := e.GetWriteAckPolicy(); p != dfv1.WriteAckPolicySync {
+		return fmt.Errorf("strict FIFO requires the %q write ack policy, got %q", dfv1.WriteAckPolicySync, p)
+	}
+	from := pl.GetVertex(e.From)
+	if from.GetPartitionCount() != 1 {
+		return fmt.Errorf("strict FIFO requires the from vertex to have a single partition")
+	}
+	// the replicas of a reduce vertex are its partitions
+	if !from.IsReduceUDF() && from.Scale.GetMaxReplicas() > 1 {
+		return fmt.Errorf("strict FIFO requires the from vertex to have at most 1 replica, set its scale.max to 1")
+	}
+	to := pl.GetVertex(e.To)
+	if to.IsReduceUDF() {
+		return fmt.Errorf("strict FIFO is not supported for edges pointing to reduce vertices")
+	}
+	if to.GetPartitionCount() != 1 {
+		return fmt.Errorf("strict FIFO requires the to vertex to have a single partition")
+	}
+	if to.Scale.GetMaxReplicas() > 1 {
+		return fmt.Errorf("strict FIFO requires the to vertex to have at most 1 replica, set its scale.max to 1")
+	}
+	if x := to.Limits; x != nil && x.UDFConcurrency != nil && *x.UDFConcurrency != 1 {
+		return fmt.Errorf("strict FIFO requires the udfConcurrency of the to vertex to be 1")
+	}
+	if to.Sink != nil && to.Sink.WatermarkGate != nil {
+		return fmt.Errorf("strict FIFO is not supported for edges pointing to sinks with a watermark gate")
+	}
+	return nil
+}
+
+func validateScalingSchedules(scale dfv1.Scale) error {
+	names := make(map[string]bool)
+	for _, ss := range scale.Schedules {
+		if ss.Name == "" {
+			return fmt.Errorf("scaling schedule name is required")
+		}
+		if names[ss.Name] {
+			return fmt.Errorf("duplicate scaling schedule name %q", ss.Name)
+		}
+		names[ss.Name] = true
+		if _, err := cronlib.ParseStandard(ss.Start); err != nil {
+			return fmt.Errorf("scaling schedule %q: invalid start %q, %w", ss.Name, ss.Start, err)
+		}
+		if _, err := time.LoadLocation(ss.GetTimezone()); err != nil {
+			return fmt.Errorf("scaling schedule %q: invalid timezone %q, %w", ss.Name, ss.GetTimezone(), err)
+		}
+		if ss.Duration.Duration <= 0 {
+			return fmt.Errorf("scaling schedule %q: duration should be greater than 0", ss.Name)
+		}
+		if ss.Min < 0 || ss.Min > scale.GetMaxReplicas() {
+			return fmt.Errorf("scaling schedule %q: min should be between 0 and the max replicas %d", ss.Name, scale.GetMaxReplicas())
+		}
+	}
+	return nil
+}
+
+func validateLimits(udfConcurrency *uint32, retryInterval *metav1.Duration) error {
+	if udfConcurrency != nil && *udfConcurrency == 0 {
+		return fmt.Errorf("udfConcurrency should be greater than 0")
+	}
+	if retryInterval != nil && retryInterval.Duration <= 0 {
+		return fmt.Errorf("retryInterval should be greater than 0")
+	}
+	return nil
+}
+
+func validateAdaptiveReadBatch(v dfv1.AbstractVertex) error {
+	arb := v.Limits.AdaptiveReadBatch
+	if !v.IsMapUDF() && !v.IsASink() {
+		return fmt.Errorf("adaptive read batch is only supported for map UDF and sink vertices")
+	}
+	if arb.GetMin() <= 0 {
+		return fmt.Errorf("adaptive read batch min should be greater than 0")
+	}
+	if arb.Max != nil && int64(*arb.Max) < arb.GetMin() {
+		return fmt.Errorf("adaptive read batch max should be greater than or equal to min")
+	}
+	if arb.GetTargetLatency() <= 0 {
+		return fmt.Errorf("adaptive read batch targetLatency should be greater than 0")
+	}
+	return nil
+}
+
+func validateCache(v dfv1.AbstractVertex) error {
+	c := v.Cache
+	if v.UDF == nil || v.UDF.IsJoin() || v.UDF.IsRemote() {
+		return fmt.Errorf("cache is only supported for vertices with a user defined container")
+	}
+	if c.MaxEntries != nil && *c.MaxEntries <= 0 {
+		return fmt.Errorf("cache maxEntries should be greater than 0")
+	}
+	if c.GetTTL() < 0 {
+		return fmt.Errorf("cache ttl should not be negative")
+	}
+	if p := c.Persistence; p != nil {
+		found := false
+		for _, vol := range v.Volumes {
+			if vol.Name == p.VolumeName {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("cache persistence volume %q is not found in the volumes", p.VolumeName)
+		}
+		if p.GetInterval() <= 0 {
+			return fmt.Errorf("cache persistence interval should be greater than 0")
+		}
+	}
+	if c.SideInput != "" {
+		found := false
+		for _, si := range v.SideInputs {
+			if si == c.SideInput {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("cache side input %q is not a side input of the vertex", c.SideInput)
+		}
+	}
+	return nil
+}
+
 func validateUDF(udf dfv1.UDF) error {
 	if udf.GroupBy != nil {
 		f := udf.GroupBy.Window.Fixed
 		s := udf.GroupBy.Window.Sliding
+		c := udf.GroupBy.Window.Custom
+		a := udf.GroupBy.Window.Accumulator
 		storage := udf.GroupBy.Storage
-		if f == nil && s == nil {
+		strategies := 0
+		for _, specified := range []bool{f != nil, s != nil, c != nil, a != nil} {
+			if specified {
+				strategies++
+			}
+		}
+		if strategies == 0 {
 			return fmt.Errorf(`invalid "groupBy.window", no windowing strategy specified`)
 		}
 
-		if f != nil && s != nil {
-			return fmt.Errorf(`invalid "groupBy.window", either fixed or sliding is allowed, not both`)
+		if strategies > 1 {
+			return fmt.Errorf(`invalid "groupBy.window", only one of fixed, sliding, custom and accumulator is allowed`)
 		}
 
 		if f != nil && f.Length == nil {
@@ -257,6 +951,15 @@ func validateUDF(udf dfv1.UDF) error {
 		if s != nil && (s.Slide == nil) {
 			return fmt.Errorf(`invalid "groupBy.window.sliding", "slide" is missing`)
 		}
+		if c != nil && c.GetMaxLength() <= 0 {
+			return fmt.Errorf(`invalid "groupBy.window.custom", "maxLength" should be positive`)
+		}
+		if a != nil && a.GetTimeout() <= 0 {
+			return fmt.Errorf(`invalid "groupBy.window.accumulator", "timeout" should be positive`)
+		}
+		if a != nil && a.Count != nil && *a.Count == 0 {
+			return fmt.Errorf(`invalid "groupBy.window.accumulator", "count" should be positive`)
+		}
 		if storage == nil {
 			return fmt.Errorf(`invalid "groupBy", "storage" is missing`)
 		}
@@ -308,12 +1011,27 @@ func validateSideInputs(pl dfv1.Pipeline) error {
 	return nil
 }
 
+func validateUDTransformer(transformer dfv1.UDTransformer) error {
+	if transformer.Container != nil {
+		if transformer.Container.Image == "" && transformer.Builtin == nil {
+			return fmt.Errorf("either specify a builtin transformer, or a customized image")
+		}
+		if transformer.Container.Image != "" && transformer.Builtin != nil {
+			return fmt.Errorf("can not specify both builtin transformer, and a customized image")
+		}
+	} else if transformer.Builtin == nil {
+		return fmt.Errorf("either specify a builtin transformer, or a customized image")
+	}
+	return nil
+}
+
 func isReservedContainerName(name string) bool {
 	return name == dfv1.CtrInit ||
 		name == dfv1.CtrMain ||
 		name == dfv1.CtrUdf ||
 		name == dfv1.CtrUdsink ||
 		name == dfv1.CtrUdtransformer ||
+		strings.HasPrefix(name, dfv1.CtrUdtransformer+"-") ||
 		name == dfv1.CtrUdsource ||
 		name == dfv1.CtrUdSideInput ||
 		name == dfv1.CtrInitSideInputs ||
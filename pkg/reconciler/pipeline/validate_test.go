@@ -23,6 +23,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 
 	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/pointer"
 
@@ -192,6 +193,109 @@ func TestValidatePipeline(t *testing.T) {
 		assert.Contains(t, err.Error(), "over the max limit")
 	})
 
+	t.Run("test invalid claim check threshold", func(t *testing.T) {
+		testObj := testPipeline.DeepCopy()
+		threshold := resource.MustParse("0")
+		testObj.Spec.ClaimCheck = &dfv1.ClaimCheck{Threshold: &threshold}
+		err := ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid claim check threshold")
+		threshold = resource.MustParse("256Ki")
+		testObj.Spec.ClaimCheck.Threshold = &threshold
+		assert.NoError(t, ValidatePipeline(testObj))
+	})
+
+	t.Run("test changing watermark time unit", func(t *testing.T) {
+		testObj := testPipeline.DeepCopy()
+		testObj.Status.WatermarkTimeUnit = dfv1.WatermarkTimeUnitMilliseconds
+		assert.NoError(t, ValidatePipeline(testObj))
+		u := dfv1.WatermarkTimeUnitMicroseconds
+		testObj.Spec.Watermark.TimeUnit = &u
+		err := ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "watermark timeUnit can not be changed")
+		testObj.Status.WatermarkTimeUnit = ""
+		assert.NoError(t, ValidatePipeline(testObj))
+	})
+
+	t.Run("test invalid tracing", func(t *testing.T) {
+		testObj := testPipeline.DeepCopy()
+		testObj.Spec.Tracing = &dfv1.Tracing{}
+		err := ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "an otlp endpoint is required")
+		testObj.Spec.Tracing.OTLP = &dfv1.OTLPTraceExporter{Endpoint: "otel-collector:4317"}
+		percentage := uint32(101)
+		testObj.Spec.Tracing.SamplingPercentage = &percentage
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid tracing sampling percentage")
+		percentage = 10
+		assert.NoError(t, ValidatePipeline(testObj))
+	})
+
+	t.Run("test invalid message signing", func(t *testing.T) {
+		testObj := testPipeline.DeepCopy()
+		testObj.Spec.MessageSigning = &dfv1.MessageSigning{RotationInterval: &metav1.Duration{Duration: time.Minute}}
+		err := ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid message signing rotation interval")
+		testObj.Spec.MessageSigning.RotationInterval.Duration = 0
+		assert.NoError(t, ValidatePipeline(testObj))
+		testObj.Spec.MessageSigning.RotationInterval = nil
+		assert.NoError(t, ValidatePipeline(testObj))
+	})
+
+	t.Run("test invalid message checksum", func(t *testing.T) {
+		testObj := testPipeline.DeepCopy()
+		testObj.Spec.MessageChecksum = &dfv1.MessageChecksum{Algorithm: "md5"}
+		err := ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid message checksum algorithm")
+		testObj.Spec.MessageChecksum = &dfv1.MessageChecksum{Quarantine: "Halt"}
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid message checksum quarantine policy")
+		testObj.Spec.MessageChecksum = &dfv1.MessageChecksum{Quarantine: dfv1.ChecksumQuarantineRedeliver, MaxDeliveries: pointer.Uint32(0)}
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid message checksum max deliveries")
+		testObj.Spec.MessageChecksum.MaxDeliveries = pointer.Uint32(5)
+		assert.NoError(t, ValidatePipeline(testObj))
+		testObj.Spec.MessageChecksum = &dfv1.MessageChecksum{Algorithm: dfv1.ChecksumAlgorithmXXHash}
+		assert.NoError(t, ValidatePipeline(testObj))
+	})
+
+	t.Run("test invalid message encryption", func(t *testing.T) {
+		testObj := testPipeline.DeepCopy()
+		testObj.Spec.MessageEncryption = &dfv1.MessageEncryption{}
+		err := ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "exactly one kms provider is required")
+		key := &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "keys"}, Key: "master"}
+		testObj.Spec.MessageEncryption.KMS = dfv1.EncryptionKMS{Static: &dfv1.StaticKMS{Key: key}, AWS: &dfv1.AWSKMS{KeyID: "alias/numaflow", Region: "us-west-2"}}
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "exactly one kms provider is required")
+		testObj.Spec.MessageEncryption.KMS = dfv1.EncryptionKMS{AWS: &dfv1.AWSKMS{KeyID: "alias/numaflow", Region: "us-west-2", AccessKeyID: key}}
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "accessKeyId and secretAccessKey should be specified together")
+		testObj.Spec.MessageEncryption.KMS = dfv1.EncryptionKMS{GCP: &dfv1.GCPKMS{KeyName: "my-key"}}
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid gcp kms keyName")
+		testObj.Spec.MessageEncryption.KMS = dfv1.EncryptionKMS{Static: &dfv1.StaticKMS{Key: key}}
+		testObj.Spec.MessageEncryption.DataKeyRotationInterval = &metav1.Duration{Duration: time.Second}
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "data key rotation interval")
+		testObj.Spec.MessageEncryption.DataKeyRotationInterval = nil
+		assert.NoError(t, ValidatePipeline(testObj))
+		testObj.Spec.MessageEncryption.KMS = dfv1.EncryptionKMS{GCP: &dfv1.GCPKMS{KeyName: "projects/p/locations/global/keyRings/r/cryptoKeys/k"}}
+		assert.NoError(t, ValidatePipeline(testObj))
+	})
+
 	t.Run("no type", func(t *testing.T) {
 		testObj := testPipeline.DeepCopy()
 		testObj.Spec.Vertices = append(testObj.Spec.Vertices, dfv1.AbstractVertex{Name: "abc"})
@@ -232,6 +336,49 @@ func TestValidatePipeline(t *testing.T) {
 		assert.Contains(t, err.Error(), "can not specify both builtin transformer, and a customized image")
 	})
 
+	t.Run("transformer chain", func(t *testing.T) {
+		testObj := testPipeline.DeepCopy()
+		testObj.Spec.Vertices[0].Source.UDTransformer = nil
+		testObj.Spec.Vertices[0].Source.Transformers = []dfv1.TransformerStage{
+			{Name: "decompress", UDTransformer: dfv1.UDTransformer{Container: &dfv1.Container{Image: "my-decompress"}}},
+			{Name: "parse", UDTransformer: dfv1.UDTransformer{Builtin: &dfv1.Transformer{Name: "filter"}}},
+		}
+		assert.NoError(t, ValidatePipeline(testObj))
+
+		failed := testObj.DeepCopy()
+		failed.Spec.Vertices[0].Source.UDTransformer = testPipeline.Spec.Vertices[0].Source.UDTransformer
+		err := ValidatePipeline(failed)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "can not specify both 'transformer' and 'transformers'")
+
+		failed = testObj.DeepCopy()
+		failed.Spec.Vertices[0].Source.Transformers[1].Name = "decompress"
+		err = ValidatePipeline(failed)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "duplicate transformer stage name")
+
+		failed = testObj.DeepCopy()
+		failed.Spec.Vertices[0].Source.Transformers[1].Name = "Parse"
+		err = ValidatePipeline(failed)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid transformer stage name")
+
+		failed = testObj.DeepCopy()
+		failed.Spec.Vertices[0].Source.Transformers[1].Builtin = nil
+		err = ValidatePipeline(failed)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `transformer stage "parse": either specify a builtin transformer, or a customized image`)
+
+		failed = testObj.DeepCopy()
+		route := dfv1.TransformerFailureRoute
+		failed.Spec.Vertices[0].Source.Transformers[0].OnFailure = &route
+		err = ValidatePipeline(failed)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "a failure tag is required")
+		failed.Spec.Vertices[0].Source.Transformers[0].FailureTag = "undecompressable"
+		assert.NoError(t, ValidatePipeline(failed))
+	})
+
 	t.Run("udsource no image specified", func(t *testing.T) {
 		testObj := testPipeline.DeepCopy()
 		testObj.Spec.Vertices[0].Source.UDSource = &dfv1.UDSource{}
@@ -273,6 +420,21 @@ func TestValidatePipeline(t *testing.T) {
 		assert.Contains(t, err.Error(), "can not specify both builtin function, and a customized image")
 	})
 
+	t.Run("remote udf", func(t *testing.T) {
+		testObj := testPipeline.DeepCopy()
+		testObj.Spec.Vertices[1].UDF.Remote = &dfv1.RemoteUDF{Endpoint: "inference.default.svc:8443"}
+		err := ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "can not specify a remote UDF with a builtin function, or a customized image")
+		testObj.Spec.Vertices[1].UDF.Builtin = nil
+		err = ValidatePipeline(testObj)
+		assert.NoError(t, err)
+		testObj.Spec.Vertices[1].UDF.Remote.Endpoint = ""
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "endpoint of the remote UDF is missing")
+	})
+
 	t.Run("edge - invalid vertex name", func(t *testing.T) {
 		testObj := testPipeline.DeepCopy()
 		testObj.Spec.Edges = append(testObj.Spec.Edges, dfv1.Edge{From: "a", To: "b"})
@@ -378,6 +540,89 @@ func TestValidatePipeline(t *testing.T) {
 		err := ValidatePipeline(testObj)
 		assert.NoError(t, err)
 	})
+
+	t.Run("watermark gate", func(t *testing.T) {
+		testObj := testPipeline.DeepCopy()
+		testObj.Spec.Vertices[2].Sink.WatermarkGate = &dfv1.WatermarkGate{}
+		err := ValidatePipeline(testObj)
+		assert.NoError(t, err)
+		testObj.Spec.Vertices[2].Sink.WatermarkGate.MaxHeldMessages = pointer.Uint32(0)
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "should be positive")
+		testObj.Spec.Vertices[2].Sink.WatermarkGate.MaxHeldMessages = nil
+		testObj.Spec.Watermark.Disabled = true
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "watermark is disabled")
+	})
+
+	t.Run("leaf nodes", func(t *testing.T) {
+		testObj := testPipeline.DeepCopy()
+		testObj.Spec.Vertices[0].LeafNode = &dfv1.LeafNode{URL: "nats://leaf:4222"}
+		err := ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "both url and domain of the leaf node are required")
+		testObj.Spec.Vertices[0].LeafNode.Domain = "edge.1"
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid leaf node domain")
+		testObj.Spec.Vertices[0].LeafNode.Domain = "edge"
+		assert.NoError(t, ValidatePipeline(testObj))
+		testObj.Spec.ClaimCheck = &dfv1.ClaimCheck{}
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "claim check is not supported with leaf nodes")
+		testObj.Spec.ClaimCheck = nil
+		// the sink is written by the source at the edge and the udf in the hub
+		testObj.Spec.Edges = append(testObj.Spec.Edges, dfv1.Edge{From: "input", To: "output"})
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "in different JetStream domains")
+	})
+
+	t.Run("cross-pipeline edges", func(t *testing.T) {
+		// the upstream pipeline ends at p1 without a sink
+		upstream := testPipeline.DeepCopy()
+		upstream.Spec.Vertices = upstream.Spec.Vertices[:2]
+		upstream.Spec.Edges = upstream.Spec.Edges[:1]
+		err := ValidatePipeline(upstream)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "pipeline has no sink")
+		upstream.Spec.Vertices[1].ToPipeline = &dfv1.PipelineVertex{Pipeline: "test-pl"}
+		err = ValidatePipeline(upstream)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "both pipeline and vertex are required for toPipeline")
+		upstream.Spec.Vertices[1].ToPipeline.Vertex = "p1"
+		err = ValidatePipeline(upstream)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "toPipeline should not be the pipeline itself")
+		upstream.Spec.Vertices[1].ToPipeline.Pipeline = "downstream"
+		assert.NoError(t, ValidatePipeline(upstream))
+		upstream.Spec.MessageSigning = &dfv1.MessageSigning{}
+		err = ValidatePipeline(upstream)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "do not support message signing or claim check")
+
+		// the downstream pipeline starts at p1 without a source
+		downstream := testPipeline.DeepCopy()
+		downstream.Name = "downstream"
+		downstream.Spec.Vertices = downstream.Spec.Vertices[1:]
+		downstream.Spec.Edges = downstream.Spec.Edges[1:]
+		err = ValidatePipeline(downstream)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "pipeline has no source")
+		downstream.Spec.Vertices[0].FromPipelines = []dfv1.PipelineVertex{{Pipeline: "test-pl", Vertex: "p1"}, {Namespace: testNamespace, Pipeline: "test-pl", Vertex: "p1"}}
+		err = ValidatePipeline(downstream)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "duplicate vertex")
+		downstream.Spec.Vertices[0].FromPipelines = downstream.Spec.Vertices[0].FromPipelines[:1]
+		assert.NoError(t, ValidatePipeline(downstream))
+		downstream.Spec.Vertices[1].FromPipelines = downstream.Spec.Vertices[0].FromPipelines
+		err = ValidatePipeline(downstream)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "a vertex with fromPipelines can not have incoming edges")
+	})
 }
 
 func TestValidateReducePipeline(t *testing.T) {
@@ -444,6 +689,219 @@ func TestValidateReducePipeline(t *testing.T) {
 		assert.Contains(t, err.Error(), `either emptyDir or persistentVolumeClaim is allowed, not both`)
 	})
 
+	t.Run("test shuffle strategy", func(t *testing.T) {
+		testObj := testReducePipeline.DeepCopy()
+		consistentHash := dfv1.ShuffleTypeConsistentHash
+		testObj.Spec.Edges[1].Shuffle = &dfv1.ShuffleStrategy{Type: &consistentHash, KeyIndexes: []int32{0}}
+		err := ValidatePipeline(testObj)
+		assert.NoError(t, err)
+		testObj.Spec.Edges[1].Shuffle.KeyIndexes = []int32{-1}
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `shuffle key index should not be smaller than 0`)
+		testObj.Spec.Edges[1].Shuffle = &dfv1.ShuffleStrategy{HeaderName: "tenant"}
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `header name is only supported by the header shuffle strategy`)
+		header := dfv1.ShuffleTypeHeader
+		testObj.Spec.Edges[1].Shuffle.Type = &header
+		assert.NoError(t, ValidatePipeline(testObj))
+		testObj.Spec.Edges[1].Shuffle.HeaderName = ""
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `header name is required by the header shuffle strategy`)
+		testObj.Spec.Edges[1].Shuffle = nil
+		testObj.Spec.Edges[3].Shuffle = &dfv1.ShuffleStrategy{}
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `shuffle strategy is only supported for edges pointing to reduce vertices`)
+	})
+
+	t.Run("test message TTL", func(t *testing.T) {
+		testObj := testPipeline.DeepCopy()
+		testObj.Spec.Edges[0].MessageTTL = &dfv1.MessageTTL{}
+		err := ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `message TTL duration should be positive`)
+		testObj.Spec.Edges[0].MessageTTL.Duration = &metav1.Duration{Duration: time.Minute}
+		assert.NoError(t, ValidatePipeline(testObj))
+	})
+
+	t.Run("test edge weight", func(t *testing.T) {
+		testObj := testPipeline.DeepCopy()
+		testObj.Spec.Edges[0].Weight = pointer.Int32(-1)
+		err := ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `weight should not be smaller than 0`)
+		testObj.Spec.Edges[0].Weight = pointer.Int32(0)
+		assert.NoError(t, ValidatePipeline(testObj))
+	})
+
+	t.Run("test write ack policy", func(t *testing.T) {
+		testObj := testReducePipeline.DeepCopy()
+		async, fireAndForget := dfv1.WriteAckPolicyAsync, dfv1.WriteAckPolicyFireAndForget
+		testObj.Spec.Edges[1].WriteAckPolicy = &async
+		testObj.Spec.Edges[3].WriteAckPolicy = &fireAndForget
+		assert.NoError(t, ValidatePipeline(testObj))
+		testObj.Spec.Edges[1].WriteAckPolicy = &fireAndForget
+		err := ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `fire-and-forget write ack policy is not supported for edges pointing to reduce vertices`)
+	})
+
+	t.Run("test edge schema", func(t *testing.T) {
+		testObj := testPipeline.DeepCopy()
+		testObj.Spec.Edges[0].Schema = &dfv1.EdgeSchema{Format: "xml", Definition: "{}"}
+		err := ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `unsupported schema format`)
+		testObj.Spec.Edges[0].Schema = &dfv1.EdgeSchema{Format: dfv1.SchemaFormatJSON}
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `exactly one of schema definition and registry is required`)
+		testObj.Spec.Edges[0].Schema = &dfv1.EdgeSchema{Format: dfv1.SchemaFormatProtobuf, Registry: &dfv1.SchemaRegistry{URL: "http://schema-registry:8081", Subject: "input-value"}}
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `message type is required`)
+		testObj.Spec.Edges[0].Schema.MessageType = "test.Order"
+		assert.NoError(t, ValidatePipeline(testObj))
+		testObj.Spec.Edges[0].Schema.ErrorTo = "output"
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `no edge from "input" to the schema error vertex "output"`)
+		testObj.Spec.Edges = append(testObj.Spec.Edges, dfv1.Edge{From: "input", To: "output"})
+		assert.NoError(t, ValidatePipeline(testObj))
+	})
+
+	t.Run("test edge mirror", func(t *testing.T) {
+		testObj := testPipeline.DeepCopy()
+		testObj.Spec.Edges[0].Mirror = &dfv1.EdgeMirror{Pipeline: "staging"}
+		err := ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `both pipeline and vertex are required for mirror`)
+		testObj.Spec.Edges[0].Mirror = &dfv1.EdgeMirror{Pipeline: testObj.Name, Vertex: "p1"}
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `mirror pipeline should not be the pipeline itself`)
+		testObj.Spec.Edges[0].Mirror.Pipeline = "staging"
+		assert.NoError(t, ValidatePipeline(testObj))
+	})
+
+	t.Run("test degradation", func(t *testing.T) {
+		testObj := testPipeline.DeepCopy()
+		testObj.Spec.Edges[0].Degradation = &dfv1.EdgeDegradation{}
+		err := ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `degradation requires the degradation policy of the pipeline`)
+		testObj.Spec.Degradation = &dfv1.Degradation{}
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `at least one of maxPending and maxLatency is required`)
+		testObj.Spec.Degradation.MaxPending = pointer.Int64(0)
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `maxPending and maxLatency should be positive`)
+		testObj.Spec.Degradation.MaxPending = pointer.Int64(10000)
+		testObj.Spec.Edges[0].Degradation = &dfv1.EdgeDegradation{Action: "drop"}
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `unsupported degradation action "drop"`)
+		testObj.Spec.Edges[0].Degradation = &dfv1.EdgeDegradation{Action: dfv1.EdgeDegradationActionSample, SamplePercent: pointer.Uint32(101)}
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `sample percent should not be greater than 100`)
+		testObj.Spec.Edges[0].Degradation.SamplePercent = pointer.Uint32(20)
+		assert.NoError(t, ValidatePipeline(testObj))
+	})
+
+	t.Run("test strict FIFO", func(t *testing.T) {
+		testObj := testPipeline.DeepCopy()
+		testObj.Spec.Edges[0].StrictFIFO = true
+		err := ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `strict FIFO requires the from vertex to have at most 1 replica, set its scale.max to 1`)
+		testObj.Spec.Vertices[0].Scale.Max = pointer.Int32(1)
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `strict FIFO requires the to vertex to have at most 1 replica, set its scale.max to 1`)
+		testObj.Spec.Vertices[1].Scale.Max = pointer.Int32(1)
+		assert.NoError(t, ValidatePipeline(testObj))
+		testObj.Spec.Vertices[1].Partitions = pointer.Int32(2)
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `strict FIFO requires the to vertex to have a single partition`)
+		testObj.Spec.Vertices[1].Partitions = nil
+		testObj.Spec.Vertices[1].Limits = &dfv1.VertexLimits{UDFConcurrency: pointer.Uint32(4)}
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `strict FIFO requires the udfConcurrency of the to vertex to be 1`)
+		testObj.Spec.Vertices[1].Limits = nil
+		policy := dfv1.WriteAckPolicyAsync
+		testObj.Spec.Edges[0].WriteAckPolicy = &policy
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `strict FIFO requires the "sync" write ack policy, got "async"`)
+		testObj.Spec.Edges[0].WriteAckPolicy = nil
+
+		// an edge from a keyed reduce vertex with multiple partitions
+		testObj = testReducePipeline.DeepCopy()
+		for i, v := range testObj.Spec.Vertices {
+			if v.IsReduceUDF() && v.UDF.GroupBy.Keyed {
+				testObj.Spec.Vertices[i].Partitions = pointer.Int32(2)
+				for j, e := range testObj.Spec.Edges {
+					if e.From == v.Name {
+						testObj.Spec.Edges[j].StrictFIFO = true
+					}
+				}
+			}
+		}
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `strict FIFO requires the from vertex to have a single partition`)
+
+		testObj = testReducePipeline.DeepCopy()
+		for i := range testObj.Spec.Vertices {
+			testObj.Spec.Vertices[i].Scale.Max = pointer.Int32(1)
+		}
+		for i, e := range testObj.Spec.Edges {
+			if testObj.GetVertex(e.To).IsReduceUDF() {
+				testObj.Spec.Edges[i].StrictFIFO = true
+			}
+		}
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `strict FIFO is not supported for edges pointing to reduce vertices`)
+	})
+
+	t.Run("test join", func(t *testing.T) {
+		testObj := testReducePipeline.DeepCopy()
+		testObj.Spec.Vertices[3].UDF.Container = nil
+		testObj.Spec.Vertices[3].UDF.GroupBy.Keyed = true
+		testObj.Spec.Vertices[3].UDF.GroupBy.Join = &dfv1.Join{}
+		err := ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `a join requires exactly 2 inbound vertices, got 1`)
+		testObj.Spec.Edges = append(testObj.Spec.Edges, dfv1.Edge{From: "input", To: "p3"})
+		err = ValidatePipeline(testObj)
+		assert.NoError(t, err)
+
+		testObj.Spec.Vertices[3].UDF.GroupBy.Join.Type = "cross"
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `unsupported join type "cross"`)
+		testObj.Spec.Vertices[3].UDF.GroupBy.Join.Type = dfv1.JoinTypeOuter
+
+		testObj.Spec.Vertices[3].UDF.GroupBy.Keyed = false
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `a join has to be keyed`)
+		testObj.Spec.Vertices[3].UDF.GroupBy.Keyed = true
+
+		testObj.Spec.Vertices[3].UDF.Container = &dfv1.Container{Image: "my-image"}
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `can not specify both join, and a customized image`)
+	})
 }
 
 func TestValidateVertex(t *testing.T) {
@@ -456,9 +914,153 @@ func TestValidateVertex(t *testing.T) {
 		assert.Contains(t, err.Error(), "invalid vertex name")
 	})
 
+	t.Run("test invalid vertex namespace", func(t *testing.T) {
+		v := dfv1.AbstractVertex{
+			Name:      "my-vertex",
+			Namespace: "Invalid_NS",
+		}
+		err := validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid namespace")
+	})
+
 	goodContainers := []corev1.Container{{Name: "my-test-image", Image: "my-image:latest"}}
 	badContainers := []corev1.Container{{Name: dfv1.CtrInit, Image: "my-image:latest"}}
 
+	t.Run("test pulsar source and sink", func(t *testing.T) {
+		v := dfv1.AbstractVertex{
+			Name:   "my-vertex",
+			Source: &dfv1.Source{Pulsar: &dfv1.PulsarSource{ServiceURL: "pulsar://pulsar:6650", Topic: "my-topic"}},
+		}
+		err := validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "are required for pulsar source")
+		v.Source.Pulsar.SubscriptionName = "my-sub"
+		assert.NoError(t, validateVertex(v))
+
+		v = dfv1.AbstractVertex{
+			Name: "my-vertex",
+			Sink: &dfv1.Sink{Pulsar: &dfv1.PulsarSink{ServiceURL: "pulsar://pulsar:6650"}},
+		}
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "are required for pulsar sink")
+		v.Sink.Pulsar.Topic = "my-topic"
+		assert.NoError(t, validateVertex(v))
+	})
+
+	t.Run("test redis streams source", func(t *testing.T) {
+		v := dfv1.AbstractVertex{
+			Name:   "my-vertex",
+			Source: &dfv1.Source{RedisStreams: &dfv1.RedisStreamsSource{Stream: "my-stream"}},
+		}
+		err := validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "are required for redis streams source")
+		v.Source.RedisStreams.ConsumerGroup = "my-group"
+		assert.NoError(t, validateVertex(v))
+		v.Source.RedisStreams.ClaimMinIdleTime = &metav1.Duration{Duration: -time.Second}
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "'claimMinIdleTime' of redis streams source can not be negative")
+	})
+
+	t.Run("test websocket source", func(t *testing.T) {
+		v := dfv1.AbstractVertex{
+			Name:   "my-vertex",
+			Source: &dfv1.Source{WebSocket: &dfv1.WebSocketSource{MaxConnections: pointer.Uint32(0)}},
+		}
+		err := validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "'maxConnections' of websocket source should be greater than 0")
+		v.Source.WebSocket.MaxConnections = nil
+		assert.NoError(t, validateVertex(v))
+	})
+
+	t.Run("test mqtt source", func(t *testing.T) {
+		v := dfv1.AbstractVertex{
+			Name:   "my-vertex",
+			Source: &dfv1.Source{MQTT: &dfv1.MQTTSource{URL: "tcp://mqtt:1883"}},
+		}
+		err := validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "are required for mqtt source")
+		v.Source.MQTT.Topics = []string{"sensors/+/temperature"}
+		assert.NoError(t, validateVertex(v))
+		v.Source.MQTT.SharedSubscriptionGroup = "a/b"
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "'sharedSubscriptionGroup' of mqtt source can not contain")
+		v.Source.MQTT.SharedSubscriptionGroup = ""
+		v.Source.MQTT.SharedSubscription = pointer.Bool(false)
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "can only run one replica")
+		v.Scale.Max = pointer.Int32(1)
+		assert.NoError(t, validateVertex(v))
+	})
+
+	t.Run("test burst buffer", func(t *testing.T) {
+		v := dfv1.AbstractVertex{
+			Name:   "my-vertex",
+			Source: &dfv1.Source{HTTP: &dfv1.HTTPSource{}, BurstBuffer: &dfv1.BurstBuffer{VolumeName: "my-vol"}},
+		}
+		err := validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `burst buffer volume "my-vol" is not found`)
+		v.Volumes = []corev1.Volume{{Name: "my-vol"}}
+		assert.NoError(t, validateVertex(v))
+		q := resource.MustParse("0")
+		v.Source.BurstBuffer.MaxSize = &q
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "'maxSize' of burst buffer should be greater than 0")
+	})
+
+	t.Run("test elasticsearch sink", func(t *testing.T) {
+		v := dfv1.AbstractVertex{
+			Name: "my-vertex",
+			Sink: &dfv1.Sink{Elasticsearch: &dfv1.ElasticsearchSink{URLs: []string{"http://elasticsearch:9200"}}},
+		}
+		err := validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "are required for elasticsearch sink")
+		v.Sink.Elasticsearch.Index = "logs-{{ index .Keys 0 }"
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid elasticsearch index template")
+		v.Sink.Elasticsearch.Index = "logs-{{ index .Keys 0 }}"
+		assert.NoError(t, validateVertex(v))
+		v.Sink.Elasticsearch.BasicAuth = &dfv1.BasicAuth{}
+		v.Sink.Elasticsearch.APIKey = &corev1.SecretKeySelector{}
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "only one of 'basicAuth' and 'apiKey'")
+	})
+
+	t.Run("test clickhouse sink", func(t *testing.T) {
+		v := dfv1.AbstractVertex{
+			Name: "my-vertex",
+			Sink: &dfv1.Sink{ClickHouse: &dfv1.ClickHouseSink{URL: "http://clickhouse:8123"}},
+		}
+		err := validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "are required for clickhouse sink")
+		v.Sink.ClickHouse.Table = "events"
+		assert.NoError(t, validateVertex(v))
+		v.Sink.ClickHouse.Columns = map[string]string{"user_id": ""}
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid column mapping")
+		v.Sink.ClickHouse.Columns = map[string]string{"user_id": "user.id"}
+		v.Sink.ClickHouse.Batch = &dfv1.ClickHouseBatch{MaxWait: &metav1.Duration{}}
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "'batch.maxWait' of clickhouse sink should be positive")
+		v.Sink.ClickHouse.Batch.MaxWait.Duration = time.Second
+		assert.NoError(t, validateVertex(v))
+	})
+
 	t.Run("bad min", func(t *testing.T) {
 		v := dfv1.AbstractVertex{
 			Name: "my-vertex",
@@ -523,6 +1125,299 @@ func TestValidateVertex(t *testing.T) {
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), `"sidecars" are not supported for source vertices`)
 	})
+
+	t.Run("test cache", func(t *testing.T) {
+		v := dfv1.AbstractVertex{
+			Name:  "my-vertex",
+			Sink:  &dfv1.Sink{Log: &dfv1.Log{}},
+			Cache: &dfv1.Cache{},
+		}
+		err := validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "only supported for vertices with a user defined container")
+		v = dfv1.AbstractVertex{
+			Name:  "my-vertex",
+			UDF:   &dfv1.UDF{Builtin: &dfv1.Function{Name: "cat"}},
+			Cache: &dfv1.Cache{MaxEntries: pointer.Int32(0)},
+		}
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "maxEntries should be greater than 0")
+		v.Cache.MaxEntries = nil
+		v.Cache.Persistence = &dfv1.CachePersistence{VolumeName: "my-vol"}
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `volume "my-vol" is not found`)
+		v.Volumes = []corev1.Volume{{Name: "my-vol"}}
+		assert.NoError(t, validateVertex(v))
+		v.Cache.SideInput = "my-si"
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `side input "my-si" is not a side input of the vertex`)
+		v.SideInputs = []string{"my-si"}
+		assert.NoError(t, validateVertex(v))
+	})
+
+	t.Run("test record", func(t *testing.T) {
+		v := dfv1.AbstractVertex{
+			Name:   "my-vertex",
+			Sink:   &dfv1.Sink{Log: &dfv1.Log{}},
+			Record: &dfv1.Record{},
+		}
+		err := validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "record is only supported for UDF vertices")
+		v = dfv1.AbstractVertex{
+			Name:   "my-vertex",
+			UDF:    &dfv1.UDF{Builtin: &dfv1.Function{Name: "cat"}},
+			Record: &dfv1.Record{},
+		}
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "record s3 is required")
+		v.Record.S3 = &dfv1.S3Store{Endpoint: "http://minio:9000"}
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "endpoint and bucket are required")
+		v.Record.S3.Bucket = "recordings"
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "accessKeyId and secretAccessKey are required")
+		v.Record.S3.AccessKeyID = &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "s3"}, Key: "accesskey"}
+		v.Record.S3.SecretAccessKey = &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "s3"}, Key: "secretkey"}
+		assert.NoError(t, validateVertex(v))
+		v.Record.Duration = &metav1.Duration{}
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "record duration should be greater than 0")
+	})
+
+	t.Run("test adaptive read batch", func(t *testing.T) {
+		v := dfv1.AbstractVertex{
+			Name:   "my-vertex",
+			Source: &dfv1.Source{Generator: &dfv1.GeneratorSource{}},
+			Limits: &dfv1.VertexLimits{AdaptiveReadBatch: &dfv1.AdaptiveReadBatch{}},
+		}
+		err := validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "only supported for map UDF and sink vertices")
+		v = dfv1.AbstractVertex{
+			Name:   "my-vertex",
+			Sink:   &dfv1.Sink{Log: &dfv1.Log{}},
+			Limits: &dfv1.VertexLimits{AdaptiveReadBatch: &dfv1.AdaptiveReadBatch{Min: pointer.Uint64(0)}},
+		}
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "min should be greater than 0")
+		v.Limits.AdaptiveReadBatch = &dfv1.AdaptiveReadBatch{Min: pointer.Uint64(10), Max: pointer.Uint64(5)}
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "max should be greater than or equal to min")
+		v.Limits.AdaptiveReadBatch = &dfv1.AdaptiveReadBatch{TargetLatency: &metav1.Duration{}}
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "targetLatency should be greater than 0")
+		v.Limits.AdaptiveReadBatch = &dfv1.AdaptiveReadBatch{Min: pointer.Uint64(10), Max: pointer.Uint64(100)}
+		assert.NoError(t, validateVertex(v))
+	})
+
+	t.Run("test key ordered", func(t *testing.T) {
+		v := dfv1.AbstractVertex{
+			Name:   "my-vertex",
+			Sink:   &dfv1.Sink{Log: &dfv1.Log{}},
+			Limits: &dfv1.VertexLimits{KeyOrdered: true},
+		}
+		err := validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "keyOrdered is only supported for map UDF vertices")
+		v.Sink = nil
+		v.UDF = &dfv1.UDF{Builtin: &dfv1.Function{Name: "cat"}}
+		assert.NoError(t, validateVertex(v))
+	})
+
+	t.Run("test map connection pool size", func(t *testing.T) {
+		v := dfv1.AbstractVertex{
+			Name:   "my-vertex",
+			UDF:    &dfv1.UDF{Builtin: &dfv1.Function{Name: "cat"}},
+			Limits: &dfv1.VertexLimits{MapConnectionPoolSize: pointer.Uint32(0)},
+		}
+		err := validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "mapConnectionPoolSize should be greater than 0")
+		v.Limits.MapConnectionPoolSize = pointer.Uint32(4)
+		assert.NoError(t, validateVertex(v))
+		v.UDF = nil
+		v.Sink = &dfv1.Sink{Log: &dfv1.Log{}}
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "mapConnectionPoolSize is only supported for map UDF vertices")
+	})
+
+	t.Run("test limits", func(t *testing.T) {
+		v := dfv1.AbstractVertex{
+			Name:   "my-vertex",
+			UDF:    &dfv1.UDF{Builtin: &dfv1.Function{Name: "cat"}},
+			Limits: &dfv1.VertexLimits{UDFConcurrency: pointer.Uint32(0)},
+		}
+		err := validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "udfConcurrency should be greater than 0")
+		v.Limits = &dfv1.VertexLimits{RetryInterval: &metav1.Duration{}}
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "retryInterval should be greater than 0")
+		v.Limits = &dfv1.VertexLimits{UDFConcurrency: pointer.Uint32(10), RetryInterval: &metav1.Duration{Duration: time.Millisecond}}
+		assert.NoError(t, validateVertex(v))
+
+		pl := testPipeline.DeepCopy()
+		pl.Spec.Limits = &dfv1.PipelineLimits{UDFConcurrency: pointer.Uint32(0)}
+		err = ValidatePipeline(pl)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid pipeline limits")
+	})
+
+	t.Run("test scaling metrics", func(t *testing.T) {
+		v := dfv1.AbstractVertex{
+			Name: "my-vertex",
+			Sink: &dfv1.Sink{Log: &dfv1.Log{}},
+			Scale: dfv1.Scale{Metrics: []dfv1.ScalingMetric{
+				{Name: "queue", Prometheus: &dfv1.PrometheusScalingMetric{ServerURL: "http://prometheus:9090", Query: "sum(queue_depth)"}, TargetValue: resource.MustParse("100")},
+				{Name: "lag", External: &dfv1.ExternalScalingMetric{MetricName: "api_lag"}, TargetValue: resource.MustParse("500m")},
+			}},
+		}
+		assert.NoError(t, validateVertex(v))
+		v.Scale.MetricsPolicy = dfv1.ScalingMetricsPolicyWeighted
+		assert.NoError(t, validateVertex(v))
+		v.Scale.MetricsPolicy = "min"
+		err := validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid scaling metrics policy")
+		v.Scale.MetricsPolicy = ""
+
+		v.Scale.Metrics[1].Name = "queue"
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "duplicate scaling metric name")
+		v.Scale.Metrics[1].Name = ""
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "scaling metric name is required")
+		v.Scale.Metrics[1].Name = "lag"
+
+		v.Scale.Metrics[1].Prometheus = v.Scale.Metrics[0].Prometheus
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "exactly one of prometheus and external")
+		v.Scale.Metrics[1].Prometheus = nil
+
+		v.Scale.Metrics[1].External.MetricName = ""
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "external metricName is required")
+		v.Scale.Metrics[1].External.MetricName = "api_lag"
+
+		v.Scale.Metrics[0].Prometheus.Query = ""
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "prometheus serverURL and query are required")
+		v.Scale.Metrics[0].Prometheus.Query = "sum(queue_depth)"
+
+		v.Scale.Metrics[0].TargetValue = resource.MustParse("0")
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "targetValue should be greater than 0")
+	})
+
+	t.Run("test scaling schedules", func(t *testing.T) {
+		v := dfv1.AbstractVertex{
+			Name: "my-vertex",
+			Sink: &dfv1.Sink{Log: &dfv1.Log{}},
+			Scale: dfv1.Scale{Max: pointer.Int32(10), Schedules: []dfv1.ScalingSchedule{
+				{Name: "morning", Start: "30 8 * * 1-5", Duration: metav1.Duration{Duration: 2 * time.Hour}, Min: 5},
+				{Name: "evening", Start: "0 18 * * *", Duration: metav1.Duration{Duration: time.Hour}, Timezone: pointer.String("Asia/Tokyo"), Min: 10},
+			}},
+		}
+		assert.NoError(t, validateVertex(v))
+
+		v.Scale.Schedules[1].Name = "morning"
+		err := validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "duplicate scaling schedule name")
+		v.Scale.Schedules[1].Name = ""
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "scaling schedule name is required")
+		v.Scale.Schedules[1].Name = "evening"
+
+		v.Scale.Schedules[1].Start = "0 0 18 * * *"
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid start")
+		v.Scale.Schedules[1].Start = "0 18 * * *"
+
+		v.Scale.Schedules[1].Timezone = pointer.String("Nowhere/Nowhere")
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid timezone")
+		v.Scale.Schedules[1].Timezone = nil
+
+		v.Scale.Schedules[1].Duration = metav1.Duration{}
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "duration should be greater than 0")
+		v.Scale.Schedules[1].Duration = metav1.Duration{Duration: time.Hour}
+
+		v.Scale.Schedules[1].Min = 11
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "min should be between 0 and the max replicas 10")
+	})
+
+	t.Run("test interceptors", func(t *testing.T) {
+		v := dfv1.AbstractVertex{
+			Name: "my-vertex",
+			Sink: &dfv1.Sink{Log: &dfv1.Log{}},
+			Interceptors: []dfv1.Interceptor{
+				{Name: "tagging", KWArgs: map[string]string{"tags": "audited"}},
+				{Name: "encrypt", GRPC: &dfv1.GRPCInterceptor{Address: "localhost:9090", Hooks: []dfv1.InterceptorHook{dfv1.InterceptorHookPreWrite}}},
+			},
+		}
+		assert.NoError(t, validateVertex(v))
+
+		v.Interceptors[1].Name = "tagging"
+		err := validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "duplicate interceptor name")
+		v.Interceptors[1].Name = ""
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "interceptor name is required")
+		v.Interceptors[1].Name = "encrypt"
+
+		v.Interceptors[1].GRPC.Address = ""
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "grpc address is required")
+		v.Interceptors[1].GRPC.Address = "localhost:9090"
+
+		v.Interceptors[1].GRPC.Timeout = &metav1.Duration{}
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "grpc timeout should be greater than 0")
+		v.Interceptors[1].GRPC.Timeout = nil
+
+		v.Interceptors[1].GRPC.Hooks = []dfv1.InterceptorHook{"postWrite"}
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "unsupported hook")
+		v.Interceptors[1].GRPC.Hooks = nil
+
+		v.Sink = nil
+		v.Source = &dfv1.Source{}
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "only supported for map UDF and sink vertices")
+	})
 }
 
 func TestValidateUDF(t *testing.T) {
@@ -549,6 +1444,55 @@ func TestValidateUDF(t *testing.T) {
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), `"length" is missing`)
 	})
+
+	t.Run("custom window", func(t *testing.T) {
+		udf := dfv1.UDF{
+			GroupBy: &dfv1.GroupBy{
+				Window: dfv1.Window{
+					Fixed:  &dfv1.FixedWindow{Length: &metav1.Duration{Duration: time.Minute}},
+					Custom: &dfv1.CustomWindow{},
+				},
+			},
+		}
+		err := validateUDF(udf)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "only one of fixed, sliding, custom and accumulator is allowed")
+		udf.GroupBy.Window.Fixed = nil
+		udf.GroupBy.Window.Custom.MaxLength = &metav1.Duration{Duration: -time.Second}
+		err = validateUDF(udf)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `"maxLength" should be positive`)
+		udf.GroupBy.Window.Custom.MaxLength = nil
+		udf.GroupBy.Storage = &dfv1.PBQStorage{EmptyDir: &corev1.EmptyDirVolumeSource{}}
+		assert.NoError(t, validateUDF(udf))
+	})
+	t.Run("accumulator window", func(t *testing.T) {
+		udf := dfv1.UDF{
+			GroupBy: &dfv1.GroupBy{
+				Window: dfv1.Window{
+					Custom:      &dfv1.CustomWindow{},
+					Accumulator: &dfv1.AccumulatorWindow{},
+				},
+				Storage: &dfv1.PBQStorage{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+			},
+		}
+		err := validateUDF(udf)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "only one of fixed, sliding, custom and accumulator is allowed")
+		udf.GroupBy.Window.Custom = nil
+		udf.GroupBy.Window.Accumulator.Timeout = &metav1.Duration{Duration: 0}
+		err = validateUDF(udf)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `"timeout" should be positive`)
+		udf.GroupBy.Window.Accumulator.Timeout = nil
+		udf.GroupBy.Window.Accumulator.Count = pointer.Uint32(0)
+		err = validateUDF(udf)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `"count" should be positive`)
+		udf.GroupBy.Window.Accumulator.Count = pointer.Uint32(100)
+		udf.GroupBy.Window.Accumulator.Flush = true
+		assert.NoError(t, validateUDF(udf))
+	})
 }
 
 func Test_validateSideInputs(t *testing.T) {
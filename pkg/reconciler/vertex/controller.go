@@ -24,6 +24,8 @@ import (
 	"time"
 
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -31,22 +33,27 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	daemonclient "github.com/numaproj/numaflow/pkg/daemon/client"
 	"github.com/numaproj/numaflow/pkg/reconciler"
 	"github.com/numaproj/numaflow/pkg/reconciler/vertex/scaling"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/shared/termination"
 	sharedutil "github.com/numaproj/numaflow/pkg/shared/util"
 )
 
 // vertexReconciler reconciles a vertex object.
 type vertexReconciler struct {
-	client client.Client
-	scheme *runtime.Scheme
+	client     client.Client
+	kubeClient kubernetes.Interface
+	scheme     *runtime.Scheme
 
 	config *reconciler.GlobalConfig
 	image  string
@@ -54,10 +61,18 @@ type vertexReconciler struct {
 
 	scaler   *scaling.Scaler
 	recorder record.EventRecorder
+	// checkBuffers checks the availability of the buffers of a vertex, the check is skipped if it's nil.
+	checkBuffers bufferChecker
 }
 
-func NewReconciler(client client.Client, scheme *runtime.Scheme, config *reconciler.GlobalConfig, image string, scaler *scaling.Scaler, logger *zap.SugaredLogger, recorder record.EventRecorder) reconcile.Reconciler {
-	return &vertexReconciler{client: client, scheme: scheme, config: config, image: image, scaler: scaler, logger: logger, recorder: recorder}
+// bufferChecker checks the availability of the buffers of a pipeline, it returns an error if any of them is not available.
+type bufferChecker func(ctx context.Context, pl *dfv1.Pipeline, buffers []string) error
+
+// bufferHealthCheckInterval is the interval of requeueing a running vertex to check the health of its buffers.
+const bufferHealthCheckInterval = time.Minute
+
+func NewReconciler(client client.Client, kubeClient kubernetes.Interface, scheme *runtime.Scheme, config *reconciler.GlobalConfig, image string, scaler *scaling.Scaler, logger *zap.SugaredLogger, recorder record.EventRecorder) reconcile.Reconciler {
+	return &vertexReconciler{client: client, kubeClient: kubeClient, scheme: scheme, config: config, image: image, scaler: scaler, logger: logger, recorder: recorder, checkBuffers: checkBuffersWithDaemon}
 }
 
 func (r *vertexReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -77,6 +92,17 @@ func (r *vertexReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 		log.Errorw("Reconcile error", zap.Error(err))
 	}
 
+	if !equality.Semantic.DeepEqual(vertex.Finalizers, vertexCopy.Finalizers) {
+		// The status is not updated with the object, keep it for the status update.
+		vertexStatus := vertexCopy.Status.DeepCopy()
+		if err := r.client.Update(ctx, vertexCopy); err != nil {
+			return reconcile.Result{}, err
+		}
+		if !vertexCopy.DeletionTimestamp.IsZero() && len(vertexCopy.Finalizers) == 0 {
+			return result, err
+		}
+		vertexCopy.Status = *vertexStatus
+	}
 	if !equality.Semantic.DeepEqual(vertex.Status, vertexCopy.Status) {
 		if err := r.client.Status().Update(ctx, vertexCopy); err != nil {
 			return reconcile.Result{}, err
@@ -92,8 +118,33 @@ func (r *vertexReconciler) reconcile(ctx context.Context, vertex *dfv1.Vertex) (
 	if !vertex.DeletionTimestamp.IsZero() {
 		log.Info("Deleting vertex")
 		r.scaler.StopWatching(vertexKey)
+		if controllerutil.ContainsFinalizer(vertex, finalizerName) {
+			if err := r.cleanUpCrossNamespaceObjects(ctx, vertex, ""); err != nil {
+				log.Errorw("Failed to clean up the objects in other namespaces", zap.Error(err))
+				return ctrl.Result{}, err
+			}
+			controllerutil.RemoveFinalizer(vertex, finalizerName)
+		}
 		return ctrl.Result{}, nil
 	}
+	if vertex.IsCrossNamespace() {
+		// Objects in other namespaces are not garbage collected, they are cleaned up by the finalizer.
+		controllerutil.AddFinalizer(vertex, finalizerName)
+	}
+	if controllerutil.ContainsFinalizer(vertex, finalizerName) {
+		keepNamespace := ""
+		if vertex.IsCrossNamespace() {
+			keepNamespace = vertex.GetPodNamespace()
+		}
+		// Clean up the objects left in the namespace the vertex used to run in.
+		if err := r.cleanUpCrossNamespaceObjects(ctx, vertex, keepNamespace); err != nil {
+			r.markPhaseLogEvent(vertex, log, "CleanUpFailed", err.Error(), "Failed to clean up the objects in other namespaces", zap.Error(err))
+			return ctrl.Result{}, err
+		}
+		if !vertex.IsCrossNamespace() {
+			controllerutil.RemoveFinalizer(vertex, finalizerName)
+		}
+	}
 
 	isbSvc := &dfv1.InterStepBufferService{}
 	isbSvcName := dfv1.DefaultISBSvcName
@@ -116,6 +167,13 @@ func (r *vertexReconciler) reconcile(ctx context.Context, vertex *dfv1.Vertex) (
 		return ctrl.Result{}, fmt.Errorf("isbsvc not ready")
 	}
 
+	if vertex.IsCrossNamespace() {
+		if err := r.syncSecrets(ctx, vertex, isbSvc.Status.Config); err != nil {
+			r.markPhaseLogEvent(vertex, log, "SyncSecretsFailed", err.Error(), "Failed to sync secrets", zap.String("namespace", vertex.GetPodNamespace()), zap.Error(err))
+			return ctrl.Result{}, err
+		}
+	}
+
 	if vertex.Scalable() { // Add to autoscaling watcher
 		r.scaler.StartWatching(vertexKey)
 	}
@@ -133,7 +191,7 @@ func (r *vertexReconciler) reconcile(ctx context.Context, vertex *dfv1.Vertex) (
 				hash := sharedutil.MustHash(newPvc.Spec)
 				newPvc.SetAnnotations(map[string]string{dfv1.KeyHash: hash})
 				existingPvc := &corev1.PersistentVolumeClaim{}
-				if err := r.client.Get(ctx, types.NamespacedName{Namespace: vertex.Namespace, Name: newPvc.Name}, existingPvc); err != nil {
+				if err := r.client.Get(ctx, types.NamespacedName{Namespace: newPvc.Namespace, Name: newPvc.Name}, existingPvc); err != nil {
 					if !apierrors.IsNotFound(err) {
 						r.markPhaseLogEvent(vertex, log, "FindExistingPVCFailed", err.Error(), "Error finding existing PVC", zap.Error(err))
 						return ctrl.Result{}, err
@@ -165,8 +223,18 @@ func (r *vertexReconciler) reconcile(ctx context.Context, vertex *dfv1.Vertex) (
 		r.markPhaseLogEvent(vertex, log, "FindExistingPodFailed", err.Error(), "Failed to find existing pods", zap.Error(err))
 		return ctrl.Result{}, err
 	}
+	if r.checkRestarts(vertex, existingPods, log) {
+		// Keep the existing pods as they are for debugging, until the budget is raised or the pods are deleted.
+		vertex.Status.MarkPhaseRunning()
+		return ctrl.Result{}, nil
+	}
+	packedVertices, err := r.findPackedVertices(ctx, vertex)
+	if err != nil {
+		r.markPhaseLogEvent(vertex, log, "FindPackedVerticesFailed", err.Error(), "Failed to find the packed vertices", zap.Error(err))
+		return ctrl.Result{}, err
+	}
 	for replica := 0; replica < desiredReplicas; replica++ {
-		podSpec, err := r.buildPodSpec(vertex, pipeline, isbSvc.Status.Config, replica)
+		podSpec, err := r.buildPodSpec(vertex, pipeline, isbSvc.Status.Config, replica, packedVertices)
 		if err != nil {
 			r.markPhaseLogEvent(vertex, log, "PodSpecGenFailed", err.Error(), "Failed to generate pod spec", zap.Error(err))
 			return ctrl.Result{}, err
@@ -208,11 +276,9 @@ func (r *vertexReconciler) reconcile(ctx context.Context, vertex *dfv1.Vertex) (
 					labels[k] = v
 				}
 			}
-			labels[dfv1.KeyPartOf] = dfv1.Project
-			labels[dfv1.KeyManagedBy] = dfv1.ControllerVertex
-			labels[dfv1.KeyComponent] = dfv1.ComponentVertex
-			labels[dfv1.KeyPipelineName] = vertex.Spec.PipelineName
-			labels[dfv1.KeyVertexName] = vertex.Spec.Name
+			for k, v := range vertex.PodLabels() {
+				labels[k] = v
+			}
 			annotations[dfv1.KeyHash] = hash
 			annotations[dfv1.KeyReplica] = strconv.Itoa(replica)
 			if vertex.IsMapUDF() || vertex.IsReduceUDF() {
@@ -222,15 +288,19 @@ func (r *vertexReconciler) reconcile(ctx context.Context, vertex *dfv1.Vertex) (
 			} else if vertex.IsUDSource() {
 				annotations[dfv1.KeyDefaultContainer] = dfv1.CtrUdsource
 			} else if vertex.HasUDTransformer() {
-				annotations[dfv1.KeyDefaultContainer] = dfv1.CtrUdtransformer
+				if stages := vertex.Spec.Source.Transformers; len(stages) > 0 {
+					annotations[dfv1.KeyDefaultContainer] = stages[0].ContainerName()
+				} else {
+					annotations[dfv1.KeyDefaultContainer] = dfv1.CtrUdtransformer
+				}
 			}
 			pod := &corev1.Pod{
 				ObjectMeta: metav1.ObjectMeta{
-					Namespace:       vertex.Namespace,
+					Namespace:       vertex.GetPodNamespace(),
 					Name:            podNamePrefix + sharedutil.RandomLowerCaseString(5),
 					Labels:          labels,
 					Annotations:     annotations,
-					OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(vertex.GetObjectMeta(), dfv1.VertexGroupVersionKind)},
+					OwnerReferences: vertex.GetOwnerReferences(),
 				},
 				Spec: *podSpec,
 			}
@@ -308,7 +378,113 @@ func (r *vertexReconciler) reconcile(ctx context.Context, vertex *dfv1.Vertex) (
 	}
 
 	vertex.Status.MarkPhaseRunning()
-	return ctrl.Result{}, nil
+	return r.checkBufferHealth(ctx, vertex, pipeline), nil
+}
+
+// checkBufferHealth checks the availability of the buffers the vertex reads from and writes to, so that a deleted
+// buffer is reflected by the BuffersHealthy condition of the vertex. It returns the result to requeue the vertex
+// for the next check.
+func (r *vertexReconciler) checkBufferHealth(ctx context.Context, vertex *dfv1.Vertex, pl *dfv1.Pipeline) ctrl.Result {
+	if r.checkBuffers == nil || pl.Spec.Lifecycle.GetDesiredPhase() != dfv1.PipelinePhaseRunning {
+		return ctrl.Result{}
+	}
+	buffers := append(vertex.OwnedBuffers(), vertex.GetToBuffers()...)
+	if len(buffers) == 0 {
+		return ctrl.Result{}
+	}
+	checkCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	if err := r.checkBuffers(checkCtx, pl, buffers); err != nil {
+		switch status.Code(err) {
+		case codes.Unavailable, codes.DeadlineExceeded:
+			vertex.Status.MarkBuffersUnknown("DaemonUnavailable", err.Error())
+		default:
+			if c := vertex.Status.GetCondition(dfv1.VertexConditionBuffersHealthy); c == nil || c.Status != metav1.ConditionFalse {
+				r.recorder.Event(vertex, corev1.EventTypeWarning, "BufferUnavailable", err.Error())
+			}
+			vertex.Status.MarkBuffersUnhealthy("BufferUnavailable", err.Error())
+		}
+	} else {
+		vertex.Status.MarkBuffersHealthy()
+	}
+	return ctrl.Result{RequeueAfter: bufferHealthCheckInterval}
+}
+
+// checkRestarts diagnoses the container restarts of the existing pods of the vertex, and checks them against the
+// restart budget. It returns true if the vertex is halted for exceeding the budget.
+func (r *vertexReconciler) checkRestarts(vertex *dfv1.Vertex, pods map[string]corev1.Pod, log *zap.SugaredLogger) bool {
+	restarts := diagnoseRestarts(pods)
+	vertex.Status.Restarts = restarts
+	budget := vertex.Spec.RestartBudget
+	if budget == nil {
+		return false
+	}
+	if restarts == nil || int(restarts.Total) <= budget.GetMaxRestarts() {
+		vertex.Status.MarkWithinRestartBudget()
+		return false
+	}
+	restarts.Halted = budget.Halt
+	msg := fmt.Sprintf("%d container restarts exceed the restart budget %d, the last cause is %q", restarts.Total, budget.GetMaxRestarts(), restarts.LastCause)
+	if restarts.Halted {
+		msg += ", scaling and pod recreation are halted"
+	}
+	if c := vertex.Status.GetCondition(dfv1.VertexConditionWithinRestartBudget); c == nil || c.Status != metav1.ConditionFalse {
+		log.Warnw("Restart budget exceeded", zap.Uint32("restarts", restarts.Total), zap.String("lastCause", string(restarts.LastCause)), zap.String("lastContainer", restarts.LastContainer))
+		r.recorder.Event(vertex, corev1.EventTypeWarning, "RestartBudgetExceeded", msg)
+	}
+	vertex.Status.MarkRestartBudgetExceeded("RestartBudgetExceeded", msg)
+	return restarts.Halted
+}
+
+// diagnoseRestarts categorizes the container restarts of the pods by the cause of the last termination of each
+// container. It returns nil if there's no restart.
+func diagnoseRestarts(pods map[string]corev1.Pod) *dfv1.VertexRestarts {
+	restarts := &dfv1.VertexRestarts{}
+	for _, pod := range pods {
+		statuses := append(append([]corev1.ContainerStatus{}, pod.Status.InitContainerStatuses...), pod.Status.ContainerStatuses...)
+		for _, cs := range statuses {
+			if cs.RestartCount == 0 {
+				continue
+			}
+			restarts.Total += uint32(cs.RestartCount)
+			if w := cs.State.Waiting; w != nil && w.Reason == "CrashLoopBackOff" {
+				restarts.CrashLooping++
+			}
+			cause, msg := dfv1.RestartCauseError, ""
+			if t := cs.LastTerminationState.Terminated; t != nil {
+				cause, msg = termination.Diagnose(t)
+				if t.FinishedAt.After(restarts.LastTerminatedAt.Time) {
+					restarts.LastCause = cause
+					restarts.LastMessage = msg
+					restarts.LastContainer = pod.Name + "/" + cs.Name
+					restarts.LastTerminatedAt = t.FinishedAt
+				}
+			}
+			if restarts.Causes == nil {
+				restarts.Causes = map[string]uint32{}
+			}
+			restarts.Causes[string(cause)] += uint32(cs.RestartCount)
+		}
+	}
+	if restarts.Total == 0 {
+		return nil
+	}
+	return restarts
+}
+
+// checkBuffersWithDaemon checks the availability of the buffers through the daemon service of the pipeline.
+func checkBuffersWithDaemon(ctx context.Context, pl *dfv1.Pipeline, buffers []string) error {
+	daemonClient, err := daemonclient.NewDaemonServiceClient(pl.GetDaemonServiceURL())
+	if err != nil {
+		return fmt.Errorf("failed to get daemon service client for pipeline %s, %w", pl.Name, err)
+	}
+	defer func() { _ = daemonClient.Close() }()
+	for _, buffer := range buffers {
+		if _, err := daemonClient.GetPipelineBuffer(ctx, pl.Name, buffer); err != nil {
+			return fmt.Errorf("buffer %q is not available, %w", buffer, err)
+		}
+	}
+	return nil
 }
 
 func (r *vertexReconciler) buildReduceVertexPVCSpec(vertex *dfv1.Vertex, replicaIndex int) (*corev1.PersistentVolumeClaim, error) {
@@ -318,26 +494,50 @@ func (r *vertexReconciler) buildReduceVertexPVCSpec(vertex *dfv1.Vertex, replica
 
 	pvcName := dfv1.GeneratePBQStoragePVCName(vertex.Spec.PipelineName, vertex.Spec.Name, replicaIndex)
 	newPvc := vertex.Spec.UDF.GroupBy.Storage.PersistentVolumeClaim.GetPVCSpec(pvcName)
-	newPvc.SetNamespace(vertex.Namespace)
-	newPvc.SetOwnerReferences([]metav1.OwnerReference{*metav1.NewControllerRef(vertex.GetObjectMeta(), dfv1.VertexGroupVersionKind)})
-	newPvc.SetLabels(map[string]string{
-		dfv1.KeyPartOf:       dfv1.Project,
-		dfv1.KeyManagedBy:    dfv1.ControllerVertex,
-		dfv1.KeyComponent:    dfv1.ComponentVertex,
-		dfv1.KeyVertexName:   vertex.Spec.Name,
-		dfv1.KeyPipelineName: vertex.Spec.PipelineName,
-	})
+	newPvc.SetNamespace(vertex.GetPodNamespace())
+	newPvc.SetOwnerReferences(vertex.GetOwnerReferences())
+	newPvc.SetLabels(vertex.PodLabels())
 	return &newPvc, nil
 }
 
-func (r *vertexReconciler) buildPodSpec(vertex *dfv1.Vertex, pl *dfv1.Pipeline, isbSvcConfig dfv1.BufferServiceConfig, replicaIndex int) (*corev1.PodSpec, error) {
+// findPackedVertices returns the vertex objects of the vertices packed into the pods of the vertex.
+func (r *vertexReconciler) findPackedVertices(ctx context.Context, vertex *dfv1.Vertex) ([]dfv1.Vertex, error) {
+	var result []dfv1.Vertex
+	for _, name := range vertex.Spec.PackedVertices {
+		pv := dfv1.Vertex{}
+		if err := r.client.Get(ctx, types.NamespacedName{Namespace: vertex.Namespace, Name: vertex.Spec.PipelineName + "-" + name}, &pv); err != nil {
+			return nil, fmt.Errorf("failed to get packed vertex %q, %w", name, err)
+		}
+		if pv.Spec.PackedInto != vertex.Spec.Name {
+			return nil, fmt.Errorf("vertex %q is not packed into vertex %q yet", name, vertex.Spec.Name)
+		}
+		result = append(result, pv)
+	}
+	return result, nil
+}
+
+func (r *vertexReconciler) buildPodSpec(vertex *dfv1.Vertex, pl *dfv1.Pipeline, isbSvcConfig dfv1.BufferServiceConfig, replicaIndex int, packedVertices []dfv1.Vertex) (*corev1.PodSpec, error) {
 	isbSvcType, envs := sharedutil.GetIsbSvcEnvVars(isbSvcConfig)
+	if x := vertex.Spec.LeafNode; x != nil {
+		// Connect to the leaf node instead of the ISB Service
+		for i := range envs {
+			if envs[i].Name == dfv1.EnvISBSvcJetStreamURL {
+				envs[i].Value = x.URL
+			}
+		}
+	}
+	envs = append(envs, sharedutil.GetToPipelineEnvVars(vertex.Spec.ToEdges)...)
+	image := r.image
+	if vertex.Spec.RuntimeImage != "" {
+		image = vertex.Spec.RuntimeImage
+	}
 	podSpec, err := vertex.GetPodSpec(dfv1.GetVertexPodSpecReq{
 		ISBSvcType:          isbSvcType,
-		Image:               r.image,
+		Image:               image,
 		PullPolicy:          corev1.PullPolicy(sharedutil.LookupEnvStringOr(dfv1.EnvImagePullPolicy, "")),
 		Env:                 envs,
 		SideInputsStoreName: pl.GetSideInputsStoreName(),
+		PackedVertices:      packedVertices,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate pod spec, error: %w", err)
@@ -393,13 +593,14 @@ func (r *vertexReconciler) buildPodSpec(vertex *dfv1.Vertex, pl *dfv1.Pipeline,
 		bks = append(bks, vertex.GetToBuckets()...)
 	}
 	podSpec.InitContainers[0].Args = append(podSpec.InitContainers[0].Args, "--buffers="+strings.Join(bfs, ","), "--buckets="+strings.Join(bks, ","))
+	podSpec.InitContainers[0].Args = append(podSpec.InitContainers[0].Args, pl.GetJetStreamDomainsArgs()...)
 	return podSpec, nil
 }
 
 func (r *vertexReconciler) findExistingPods(ctx context.Context, vertex *dfv1.Vertex) (map[string]corev1.Pod, error) {
 	pods := &corev1.PodList{}
-	selector, _ := labels.Parse(dfv1.KeyPipelineName + "=" + vertex.Spec.PipelineName + "," + dfv1.KeyVertexName + "=" + vertex.Spec.Name)
-	if err := r.client.List(ctx, pods, &client.ListOptions{Namespace: vertex.Namespace, LabelSelector: selector}); err != nil {
+	selector := labels.SelectorFromSet(vertex.PodLabels())
+	if err := r.client.List(ctx, pods, &client.ListOptions{Namespace: vertex.GetPodNamespace(), LabelSelector: selector}); err != nil {
 		return nil, fmt.Errorf("failed to list pods: %w", err)
 	}
 	result := make(map[string]corev1.Pod)
@@ -415,8 +616,8 @@ func (r *vertexReconciler) findExistingPods(ctx context.Context, vertex *dfv1.Ve
 
 func (r *vertexReconciler) findExistingServices(ctx context.Context, vertex *dfv1.Vertex) (map[string]corev1.Service, error) {
 	svcs := &corev1.ServiceList{}
-	selector, _ := labels.Parse(dfv1.KeyPipelineName + "=" + vertex.Spec.PipelineName + "," + dfv1.KeyVertexName + "=" + vertex.Spec.Name)
-	if err := r.client.List(ctx, svcs, &client.ListOptions{Namespace: vertex.Namespace, LabelSelector: selector}); err != nil {
+	selector := labels.SelectorFromSet(vertex.PodLabels())
+	if err := r.client.List(ctx, svcs, &client.ListOptions{Namespace: vertex.GetPodNamespace(), LabelSelector: selector}); err != nil {
 		return nil, fmt.Errorf("failed to list services: %w", err)
 	}
 	result := make(map[string]corev1.Service)
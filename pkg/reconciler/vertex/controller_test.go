@@ -18,18 +18,25 @@ package vertex
 
 import (
 	"context"
+	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	appv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
@@ -170,7 +177,7 @@ func init() {
 
 func Test_NewReconciler(t *testing.T) {
 	cl := fake.NewClientBuilder().Build()
-	r := NewReconciler(cl, scheme.Scheme, fakeConfig, testFlowImage, scaling.NewScaler(cl), zaptest.NewLogger(t).Sugar(), record.NewFakeRecorder(64))
+	r := NewReconciler(cl, k8sfake.NewSimpleClientset(), scheme.Scheme, fakeConfig, testFlowImage, scaling.NewScaler(cl), zaptest.NewLogger(t).Sugar(), record.NewFakeRecorder(64))
 	_, ok := r.(*vertexReconciler)
 	assert.True(t, ok)
 }
@@ -186,7 +193,7 @@ func Test_BuildPodSpec(t *testing.T) {
 			logger: zaptest.NewLogger(t).Sugar(),
 		}
 		testObj := testSrcVertex.DeepCopy()
-		spec, err := r.buildPodSpec(testObj, testPipeline, fakeIsbSvcConfig, 0)
+		spec, err := r.buildPodSpec(testObj, testPipeline, fakeIsbSvcConfig, 0, nil)
 		assert.NoError(t, err)
 		assert.Equal(t, 1, len(spec.InitContainers))
 		assert.Equal(t, 1, len(spec.Containers))
@@ -226,7 +233,7 @@ func Test_BuildPodSpec(t *testing.T) {
 				},
 			},
 		}
-		spec, err := r.buildPodSpec(testObj, testPipeline, fakeIsbSvcConfig, 2)
+		spec, err := r.buildPodSpec(testObj, testPipeline, fakeIsbSvcConfig, 2, nil)
 		assert.NoError(t, err)
 		assert.Equal(t, 1, len(spec.InitContainers))
 		assert.Equal(t, 2, len(spec.Containers))
@@ -254,7 +261,7 @@ func Test_BuildPodSpec(t *testing.T) {
 				},
 			},
 		}
-		spec, err := r.buildPodSpec(testObj, testPipeline, fakeIsbSvcConfig, 2)
+		spec, err := r.buildPodSpec(testObj, testPipeline, fakeIsbSvcConfig, 2, nil)
 		assert.NoError(t, err)
 		assert.Equal(t, 1, len(spec.InitContainers))
 		assert.Equal(t, 3, len(spec.Containers))
@@ -275,7 +282,7 @@ func Test_BuildPodSpec(t *testing.T) {
 		testObj.Spec.Sink = &dfv1.Sink{}
 		testObj.Spec.FromEdges = []dfv1.CombinedEdge{{Edge: dfv1.Edge{From: "p1", To: "output"}}}
 		testObj.Spec.ToEdges = []dfv1.CombinedEdge{}
-		spec, err := r.buildPodSpec(testObj, testPipeline, fakeIsbSvcConfig, 0)
+		spec, err := r.buildPodSpec(testObj, testPipeline, fakeIsbSvcConfig, 0, nil)
 		assert.NoError(t, err)
 		assert.Equal(t, 1, len(spec.InitContainers))
 		assert.Equal(t, 1, len(spec.Containers))
@@ -320,7 +327,7 @@ func Test_BuildPodSpec(t *testing.T) {
 		}
 		testObj.Spec.FromEdges = []dfv1.CombinedEdge{{Edge: dfv1.Edge{From: "p1", To: "output"}}}
 		testObj.Spec.ToEdges = []dfv1.CombinedEdge{}
-		spec, err := r.buildPodSpec(testObj, testPipeline, fakeIsbSvcConfig, 0)
+		spec, err := r.buildPodSpec(testObj, testPipeline, fakeIsbSvcConfig, 0, nil)
 		assert.NoError(t, err)
 		assert.Equal(t, 1, len(spec.InitContainers))
 		assert.Equal(t, 2, len(spec.Containers))
@@ -346,7 +353,7 @@ func Test_BuildPodSpec(t *testing.T) {
 				Name: "cat",
 			},
 		}
-		spec, err := r.buildPodSpec(testObj, testPipeline, fakeIsbSvcConfig, 0)
+		spec, err := r.buildPodSpec(testObj, testPipeline, fakeIsbSvcConfig, 0, nil)
 		assert.NoError(t, err)
 		assert.Equal(t, 1, len(spec.InitContainers))
 		assert.Equal(t, 2, len(spec.Containers))
@@ -391,7 +398,7 @@ func Test_BuildPodSpec(t *testing.T) {
 				},
 			},
 		}
-		spec, err := r.buildPodSpec(testObj, testPipeline, fakeIsbSvcConfig, 2)
+		spec, err := r.buildPodSpec(testObj, testPipeline, fakeIsbSvcConfig, 2, nil)
 		assert.NoError(t, err)
 		assert.Equal(t, 1, len(spec.InitContainers))
 		assert.Equal(t, 2, len(spec.Containers))
@@ -412,6 +419,44 @@ func Test_BuildPodSpec(t *testing.T) {
 	})
 }
 
+func Test_findPackedVertices(t *testing.T) {
+	ctx := context.TODO()
+	cl := fake.NewClientBuilder().Build()
+	r := &vertexReconciler{
+		client: cl,
+		scheme: scheme.Scheme,
+		config: fakeConfig,
+		image:  testFlowImage,
+		logger: zaptest.NewLogger(t).Sugar(),
+	}
+	testObj := testSrcVertex.DeepCopy()
+	pvs, err := r.findPackedVertices(ctx, testObj)
+	assert.NoError(t, err)
+	assert.Empty(t, pvs)
+
+	testObj.Spec.PackedVertices = []string{"out"}
+	_, err = r.findPackedVertices(ctx, testObj)
+	assert.Error(t, err)
+
+	packed := &dfv1.Vertex{
+		ObjectMeta: metav1.ObjectMeta{Namespace: testObj.Namespace, Name: testObj.Spec.PipelineName + "-out"},
+		Spec: dfv1.VertexSpec{
+			AbstractVertex: dfv1.AbstractVertex{Name: "out", Sink: &dfv1.Sink{}},
+			PipelineName:   testObj.Spec.PipelineName,
+		},
+	}
+	assert.NoError(t, cl.Create(ctx, packed))
+	_, err = r.findPackedVertices(ctx, testObj)
+	assert.ErrorContains(t, err, "not packed into")
+
+	packed.Spec.PackedInto = testObj.Spec.Name
+	assert.NoError(t, cl.Update(ctx, packed))
+	pvs, err = r.findPackedVertices(ctx, testObj)
+	assert.NoError(t, err)
+	assert.Len(t, pvs, 1)
+	assert.Equal(t, packed.Name, pvs[0].Name)
+}
+
 func Test_reconcile(t *testing.T) {
 	t.Run("test reconcile source", func(t *testing.T) {
 		cl := fake.NewClientBuilder().Build()
@@ -624,6 +669,76 @@ func Test_reconcile(t *testing.T) {
 		assert.Equal(t, 3, len(pods.Items[0].Spec.Containers))
 		assert.Equal(t, 2, len(pods.Items[0].Spec.InitContainers))
 	})
+
+	t.Run("test reconcile vertex in another namespace", func(t *testing.T) {
+		cl := fake.NewClientBuilder().Build()
+		kubeClient := k8sfake.NewSimpleClientset()
+		ctx := context.TODO()
+		testIsbSvc := testNativeRedisIsbSvc.DeepCopy()
+		testIsbSvc.Status.MarkConfigured()
+		testIsbSvc.Status.MarkDeployed()
+		testIsbSvc.Status.Config = fakeIsbSvcConfig
+		err := cl.Create(ctx, testIsbSvc)
+		assert.Nil(t, err)
+		_, err = kubeClient.CoreV1().Secrets(testNamespace).Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "test-name"},
+			Data:       map[string][]byte{"test-key": []byte("password")},
+		}, metav1.CreateOptions{})
+		assert.NoError(t, err)
+		testPl := testPipeline.DeepCopy()
+		err = cl.Create(ctx, testPl)
+		assert.Nil(t, err)
+		r := &vertexReconciler{
+			client:     cl,
+			kubeClient: kubeClient,
+			scheme:     scheme.Scheme,
+			config:     fakeConfig,
+			image:      testFlowImage,
+			scaler:     scaling.NewScaler(cl),
+			logger:     zaptest.NewLogger(t).Sugar(),
+		}
+		testObj := testVertex.DeepCopy()
+		testObj.Spec.UDF = &dfv1.UDF{
+			Builtin: &dfv1.Function{
+				Name: "cat",
+			},
+		}
+		testObj.Spec.Namespace = "locked-ns"
+		err = cl.Create(ctx, testObj)
+		assert.NoError(t, err)
+		_, err = r.reconcile(ctx, testObj)
+		assert.NoError(t, err)
+		assert.Contains(t, testObj.Finalizers, finalizerName)
+		selector, _ := labels.Parse(dfv1.KeyPipelineName + "=" + testPipelineName + "," + dfv1.KeyVertexName + "=" + testVertexSpecName)
+		pods := &corev1.PodList{}
+		err = r.client.List(ctx, pods, &client.ListOptions{Namespace: "locked-ns", LabelSelector: selector})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(pods.Items))
+		assert.Empty(t, pods.Items[0].OwnerReferences)
+		assert.Equal(t, testNamespace, pods.Items[0].Labels[dfv1.KeyVertexNamespace])
+		svcs := &corev1.ServiceList{}
+		err = r.client.List(ctx, svcs, &client.ListOptions{Namespace: "locked-ns", LabelSelector: selector})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(svcs.Items))
+		assert.Equal(t, testNamespace, svcs.Items[0].Spec.Selector[dfv1.KeyVertexNamespace])
+		secret, err := kubeClient.CoreV1().Secrets("locked-ns").Get(ctx, "test-name", metav1.GetOptions{})
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("password"), secret.Data["test-key"])
+
+		// Move the vertex back to the namespace of the pipeline.
+		testObj.Spec.Namespace = ""
+		_, err = r.reconcile(ctx, testObj)
+		assert.NoError(t, err)
+		assert.NotContains(t, testObj.Finalizers, finalizerName)
+		err = r.client.List(ctx, pods, &client.ListOptions{Namespace: "locked-ns", LabelSelector: selector})
+		assert.NoError(t, err)
+		assert.Equal(t, 0, len(pods.Items))
+		err = r.client.List(ctx, pods, &client.ListOptions{Namespace: testNamespace, LabelSelector: selector})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(pods.Items))
+		_, err = kubeClient.CoreV1().Secrets("locked-ns").Get(ctx, "test-name", metav1.GetOptions{})
+		assert.True(t, apierrors.IsNotFound(err))
+	})
 }
 
 func Test_reconcileEvents(t *testing.T) {
@@ -656,3 +771,166 @@ func getEvents(reconciler *vertexReconciler, num int) []string {
 	}
 	return events
 }
+
+func Test_checkBufferHealth(t *testing.T) {
+	newReconciler := func(checker bufferChecker) *vertexReconciler {
+		return &vertexReconciler{
+			scheme:       scheme.Scheme,
+			config:       fakeConfig,
+			image:        testFlowImage,
+			logger:       zaptest.NewLogger(t).Sugar(),
+			recorder:     record.NewFakeRecorder(64),
+			checkBuffers: checker,
+		}
+	}
+
+	t.Run("test no checker", func(t *testing.T) {
+		r := newReconciler(nil)
+		testObj := testVertex.DeepCopy()
+		result := r.checkBufferHealth(context.TODO(), testObj, testPipeline.DeepCopy())
+		assert.Equal(t, time.Duration(0), result.RequeueAfter)
+		assert.Nil(t, testObj.Status.GetCondition(dfv1.VertexConditionBuffersHealthy))
+	})
+
+	t.Run("test healthy", func(t *testing.T) {
+		var checked []string
+		r := newReconciler(func(ctx context.Context, pl *dfv1.Pipeline, buffers []string) error {
+			checked = buffers
+			return nil
+		})
+		testObj := testVertex.DeepCopy()
+		result := r.checkBufferHealth(context.TODO(), testObj, testPipeline.DeepCopy())
+		assert.Equal(t, bufferHealthCheckInterval, result.RequeueAfter)
+		assert.ElementsMatch(t, append(testObj.OwnedBuffers(), testObj.GetToBuffers()...), checked)
+		c := testObj.Status.GetCondition(dfv1.VertexConditionBuffersHealthy)
+		assert.NotNil(t, c)
+		assert.Equal(t, metav1.ConditionTrue, c.Status)
+	})
+
+	t.Run("test buffer unavailable", func(t *testing.T) {
+		r := newReconciler(func(ctx context.Context, pl *dfv1.Pipeline, buffers []string) error {
+			return fmt.Errorf("buffer %q is not available, %w", buffers[0], status.Error(codes.NotFound, "stream not found"))
+		})
+		testObj := testVertex.DeepCopy()
+		result := r.checkBufferHealth(context.TODO(), testObj, testPipeline.DeepCopy())
+		assert.Equal(t, bufferHealthCheckInterval, result.RequeueAfter)
+		c := testObj.Status.GetCondition(dfv1.VertexConditionBuffersHealthy)
+		assert.NotNil(t, c)
+		assert.Equal(t, metav1.ConditionFalse, c.Status)
+		assert.Equal(t, "BufferUnavailable", c.Reason)
+		events := getEvents(r, 1)
+		assert.True(t, strings.HasPrefix(events[0], "Warning BufferUnavailable"))
+		// No more event when it stays unavailable.
+		_ = r.checkBufferHealth(context.TODO(), testObj, testPipeline.DeepCopy())
+		assert.Len(t, r.recorder.(*record.FakeRecorder).Events, 0)
+	})
+
+	t.Run("test daemon unavailable", func(t *testing.T) {
+		r := newReconciler(func(ctx context.Context, pl *dfv1.Pipeline, buffers []string) error {
+			return status.Error(codes.Unavailable, "connection refused")
+		})
+		testObj := testVertex.DeepCopy()
+		_ = r.checkBufferHealth(context.TODO(), testObj, testPipeline.DeepCopy())
+		c := testObj.Status.GetCondition(dfv1.VertexConditionBuffersHealthy)
+		assert.NotNil(t, c)
+		assert.Equal(t, metav1.ConditionUnknown, c.Status)
+		assert.Equal(t, "DaemonUnavailable", c.Reason)
+	})
+
+	t.Run("test pipeline paused", func(t *testing.T) {
+		r := newReconciler(func(ctx context.Context, pl *dfv1.Pipeline, buffers []string) error {
+			return fmt.Errorf("should not be called")
+		})
+		testObj := testVertex.DeepCopy()
+		pl := testPipeline.DeepCopy()
+		pl.Spec.Lifecycle.DesiredPhase = dfv1.PipelinePhasePaused
+		result := r.checkBufferHealth(context.TODO(), testObj, pl)
+		assert.Equal(t, time.Duration(0), result.RequeueAfter)
+		assert.Nil(t, testObj.Status.GetCondition(dfv1.VertexConditionBuffersHealthy))
+	})
+}
+
+func Test_checkRestarts(t *testing.T) {
+	r := &vertexReconciler{
+		scheme:   scheme.Scheme,
+		config:   fakeConfig,
+		image:    testFlowImage,
+		logger:   zaptest.NewLogger(t).Sugar(),
+		recorder: record.NewFakeRecorder(64),
+	}
+	now := time.Now()
+	pods := map[string]corev1.Pod{
+		"test-pl-p1-0-abcde": {
+			ObjectMeta: metav1.ObjectMeta{Name: "test-pl-p1-0-abcde"},
+			Status: corev1.PodStatus{
+				ContainerStatuses: []corev1.ContainerStatus{
+					{
+						Name:                 dfv1.CtrMain,
+						RestartCount:         4,
+						State:                corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}},
+						LastTerminationState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Reason: "Error", Message: "UDSHandshakeFailed: failed on map UDF readiness check, context deadline exceeded", FinishedAt: metav1.NewTime(now)}},
+					},
+					{
+						Name:                 dfv1.CtrUdf,
+						RestartCount:         2,
+						LastTerminationState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Reason: "OOMKilled", FinishedAt: metav1.NewTime(now.Add(-time.Minute))}},
+					},
+				},
+			},
+		},
+		"test-pl-p1-1-fghij": {
+			ObjectMeta: metav1.ObjectMeta{Name: "test-pl-p1-1-fghij"},
+			Status: corev1.PodStatus{
+				ContainerStatuses: []corev1.ContainerStatus{{Name: dfv1.CtrMain}},
+			},
+		},
+	}
+
+	t.Run("test no restarts", func(t *testing.T) {
+		testObj := testVertex.DeepCopy()
+		testObj.Spec.RestartBudget = &dfv1.RestartBudget{Halt: true}
+		assert.False(t, r.checkRestarts(testObj, map[string]corev1.Pod{}, r.logger))
+		assert.Nil(t, testObj.Status.Restarts)
+		c := testObj.Status.GetCondition(dfv1.VertexConditionWithinRestartBudget)
+		assert.NotNil(t, c)
+		assert.Equal(t, metav1.ConditionTrue, c.Status)
+	})
+
+	t.Run("test diagnosis without budget", func(t *testing.T) {
+		testObj := testVertex.DeepCopy()
+		assert.False(t, r.checkRestarts(testObj, pods, r.logger))
+		restarts := testObj.Status.Restarts
+		assert.NotNil(t, restarts)
+		assert.Equal(t, uint32(6), restarts.Total)
+		assert.Equal(t, uint32(1), restarts.CrashLooping)
+		assert.Equal(t, map[string]uint32{"UDSHandshakeFailed": 4, "OOMKilled": 2}, restarts.Causes)
+		assert.Equal(t, dfv1.RestartCauseUDSHandshakeFailed, restarts.LastCause)
+		assert.Equal(t, "failed on map UDF readiness check, context deadline exceeded", restarts.LastMessage)
+		assert.Equal(t, "test-pl-p1-0-abcde/numa", restarts.LastContainer)
+		assert.False(t, restarts.Halted)
+		assert.Nil(t, testObj.Status.GetCondition(dfv1.VertexConditionWithinRestartBudget))
+	})
+
+	t.Run("test budget exceeded", func(t *testing.T) {
+		testObj := testVertex.DeepCopy()
+		testObj.Spec.RestartBudget = &dfv1.RestartBudget{MaxRestarts: pointer.Int32(5)}
+		assert.False(t, r.checkRestarts(testObj, pods, r.logger))
+		assert.False(t, testObj.Status.IsHalted())
+		c := testObj.Status.GetCondition(dfv1.VertexConditionWithinRestartBudget)
+		assert.NotNil(t, c)
+		assert.Equal(t, metav1.ConditionFalse, c.Status)
+		assert.Equal(t, "RestartBudgetExceeded", c.Reason)
+		events := getEvents(r, 1)
+		assert.True(t, strings.HasPrefix(events[0], "Warning RestartBudgetExceeded"))
+
+		testObj.Spec.RestartBudget.Halt = true
+		assert.True(t, r.checkRestarts(testObj, pods, r.logger))
+		assert.True(t, testObj.Status.IsHalted())
+		// No more event when it stays exceeded.
+		assert.Len(t, r.recorder.(*record.FakeRecorder).Events, 0)
+
+		testObj.Spec.RestartBudget.MaxRestarts = pointer.Int32(10)
+		assert.False(t, r.checkRestarts(testObj, pods, r.logger))
+		assert.False(t, testObj.Status.IsHalted())
+	})
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vertex
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	sharedutil "github.com/numaproj/numaflow/pkg/shared/util"
+)
+
+const (
+	// finalizerName is added to the vertices running in other namespaces, to clean up the objects there.
+	finalizerName = dfv1.ControllerVertex
+)
+
+// syncSecrets copies the secrets referenced by the ISB Service configuration, including the one of the to pipeline, and
+// the message signing keys if enabled, to the namespace where the pods of the vertex run, so that the pods are able to
+// access them.
+func (r *vertexReconciler) syncSecrets(ctx context.Context, vertex *dfv1.Vertex, isbSvcConfig dfv1.BufferServiceConfig) error {
+	_, envs := sharedutil.GetIsbSvcEnvVars(isbSvcConfig)
+	envs = append(envs, sharedutil.GetToPipelineEnvVars(vertex.Spec.ToEdges)...)
+	secretNames := make(map[string]bool)
+	for _, e := range envs {
+		if e.ValueFrom != nil && e.ValueFrom.SecretKeyRef != nil {
+			secretNames[e.ValueFrom.SecretKeyRef.Name] = true
+		}
+	}
+	if vertex.Spec.MessageSigning != nil {
+		secretNames[dfv1.GenerateSigningKeysSecretName(vertex.Spec.PipelineName)] = true
+	}
+	podNamespace := vertex.GetPodNamespace()
+	for name := range secretNames {
+		src, err := r.kubeClient.CoreV1().Secrets(vertex.Namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to get secret %q, %w", name, err)
+		}
+		existing, err := r.kubeClient.CoreV1().Secrets(podNamespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			if !apierrors.IsNotFound(err) {
+				return fmt.Errorf("failed to get secret %q in namespace %q, %w", name, podNamespace, err)
+			}
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: podNamespace,
+					Name:      name,
+					Labels: map[string]string{
+						dfv1.KeyPartOf:          dfv1.Project,
+						dfv1.KeyManagedBy:       dfv1.ControllerVertex,
+						dfv1.KeyVertexNamespace: vertex.Namespace,
+					},
+				},
+				Type: src.Type,
+				Data: src.Data,
+			}
+			if _, err := r.kubeClient.CoreV1().Secrets(podNamespace).Create(ctx, secret, metav1.CreateOptions{}); err != nil && !apierrors.IsAlreadyExists(err) {
+				return fmt.Errorf("failed to create secret %q in namespace %q, %w", name, podNamespace, err)
+			}
+			continue
+		}
+		if existing.Labels[dfv1.KeyManagedBy] != dfv1.ControllerVertex || existing.Labels[dfv1.KeyVertexNamespace] != vertex.Namespace {
+			return fmt.Errorf("secret %q in namespace %q is not managed for the vertices in namespace %q", name, podNamespace, vertex.Namespace)
+		}
+		if !reflect.DeepEqual(existing.Data, src.Data) {
+			existing.Data = src.Data
+			if _, err := r.kubeClient.CoreV1().Secrets(podNamespace).Update(ctx, existing, metav1.UpdateOptions{}); err != nil {
+				return fmt.Errorf("failed to update secret %q in namespace %q, %w", name, podNamespace, err)
+			}
+		}
+	}
+	return nil
+}
+
+// cleanUpCrossNamespaceObjects deletes the pods, services and PVCs the vertex created in the namespaces other than
+// keepNamespace, which are not garbage collected because they have no owner references. The copied secrets are deleted once none of the vertices in the namespace of the vertex run in their namespaces.
+func (r *vertexReconciler) cleanUpCrossNamespaceObjects(ctx context.Context, vertex *dfv1.Vertex, keepNamespace string) error {
+	selector := labels.SelectorFromSet(map[string]string{
+		dfv1.KeyPipelineName:    vertex.Spec.PipelineName,
+		dfv1.KeyVertexName:      vertex.Spec.Name,
+		dfv1.KeyVertexNamespace: vertex.Namespace,
+	})
+	listOpts := &client.ListOptions{LabelSelector: selector}
+	pods := &corev1.PodList{}
+	if err := r.client.List(ctx, pods, listOpts); err != nil {
+		return fmt.Errorf("failed to list pods, %w", err)
+	}
+	for i := range pods.Items {
+		if err := r.deleteIfNotIn(ctx, &pods.Items[i], keepNamespace); err != nil {
+			return err
+		}
+	}
+	svcs := &corev1.ServiceList{}
+	if err := r.client.List(ctx, svcs, listOpts); err != nil {
+		return fmt.Errorf("failed to list services, %w", err)
+	}
+	for i := range svcs.Items {
+		if err := r.deleteIfNotIn(ctx, &svcs.Items[i], keepNamespace); err != nil {
+			return err
+		}
+	}
+	pvcs := &corev1.PersistentVolumeClaimList{}
+	if err := r.client.List(ctx, pvcs, listOpts); err != nil {
+		return fmt.Errorf("failed to list PVCs, %w", err)
+	}
+	for i := range pvcs.Items {
+		if err := r.deleteIfNotIn(ctx, &pvcs.Items[i], keepNamespace); err != nil {
+			return err
+		}
+	}
+
+	inUse := make(map[string]bool)
+	if keepNamespace != "" {
+		inUse[keepNamespace] = true
+	}
+	vertices := &dfv1.VertexList{}
+	if err := r.client.List(ctx, vertices, &client.ListOptions{Namespace: vertex.Namespace}); err != nil {
+		return fmt.Errorf("failed to list vertices, %w", err)
+	}
+	for _, v := range vertices.Items {
+		if v.Name == vertex.Name || !v.DeletionTimestamp.IsZero() || !v.IsCrossNamespace() {
+			continue
+		}
+		inUse[v.GetPodNamespace()] = true
+	}
+	secrets, err := r.kubeClient.CoreV1().Secrets(metav1.NamespaceAll).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(map[string]string{
+			dfv1.KeyManagedBy:       dfv1.ControllerVertex,
+			dfv1.KeyVertexNamespace: vertex.Namespace,
+		}).String(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list secrets, %w", err)
+	}
+	for _, s := range secrets.Items {
+		if inUse[s.Namespace] {
+			continue
+		}
+		if err := r.kubeClient.CoreV1().Secrets(s.Namespace).Delete(ctx, s.Name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete secret %q in namespace %q, %w", s.Name, s.Namespace, err)
+		}
+	}
+	return nil
+}
+
+func (r *vertexReconciler) deleteIfNotIn(ctx context.Context, obj client.Object, namespace string) error {
+	if obj.GetNamespace() == namespace {
+		return nil
+	}
+	if err := r.client.Delete(ctx, obj); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete %s/%s, %w", obj.GetNamespace(), obj.GetName(), err)
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaling
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	promapi "github.com/prometheus/client_golang/api"
+	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/metrics/pkg/client/external_metrics"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+// CustomMetric records the value of a custom scaling metric and the replicas desired by it.
+type CustomMetric struct {
+	Name        string  `json:"name"`
+	Value       float64 `json:"value"`
+	TargetValue float64 `json:"targetValue"`
+	Replicas    int32   `json:"replicas"`
+}
+
+// customMetricsQuerier gets the current value of a custom scaling metric.
+type customMetricsQuerier interface {
+	query(ctx context.Context, namespace string, metric dfv1.ScalingMetric) (float64, error)
+}
+
+type defaultCustomMetricsQuerier struct {
+	externalMetricsClient external_metrics.ExternalMetricsClient
+}
+
+func (q *defaultCustomMetricsQuerier) query(ctx context.Context, namespace string, metric dfv1.ScalingMetric) (float64, error) {
+	switch {
+	case metric.Prometheus != nil:
+		return queryPrometheus(ctx, metric.Prometheus)
+	case metric.External != nil:
+		if q.externalMetricsClient == nil {
+			return 0, fmt.Errorf("external metrics client not configured")
+		}
+		return queryExternalMetric(q.externalMetricsClient, namespace, metric.External)
+	default:
+		return 0, fmt.Errorf("neither prometheus nor external is specified")
+	}
+}
+
+// queryPrometheus runs the PromQL query, which is expected to return a scalar or a vector with exactly one sample.
+func queryPrometheus(ctx context.Context, m *dfv1.PrometheusScalingMetric) (float64, error) {
+	c, err := promapi.NewClient(promapi.Config{Address: m.ServerURL})
+	if err != nil {
+		return 0, fmt.Errorf("failed to create prometheus client, %w", err)
+	}
+	result, _, err := promv1.NewAPI(c).Query(ctx, m.Query, time.Now())
+	if err != nil {
+		return 0, fmt.Errorf("failed to run the prometheus query, %w", err)
+	}
+	var value float64
+	switch r := result.(type) {
+	case *model.Scalar:
+		value = float64(r.Value)
+	case model.Vector:
+		if len(r) != 1 {
+			return 0, fmt.Errorf("the prometheus query returned %d samples, expecting exactly 1", len(r))
+		}
+		value = float64(r[0].Value)
+	default:
+		return 0, fmt.Errorf("unsupported prometheus query result type %q", result.Type())
+	}
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return 0, fmt.Errorf("invalid prometheus query result %v", value)
+	}
+	return value, nil
+}
+
+// queryExternalMetric reads the external metric, and sums up the values of all the matching series.
+func queryExternalMetric(c external_metrics.ExternalMetricsClient, namespace string, m *dfv1.ExternalScalingMetric) (float64, error) {
+	selector := labels.Everything()
+	if m.Selector != nil {
+		s, err := metav1.LabelSelectorAsSelector(m.Selector)
+		if err != nil {
+			return 0, fmt.Errorf("invalid selector of external metric %q, %w", m.MetricName, err)
+		}
+		selector = s
+	}
+	list, err := c.NamespacedMetrics(namespace).List(m.MetricName, selector)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get external metric %q, %w", m.MetricName, err)
+	}
+	if len(list.Items) == 0 {
+		return 0, fmt.Errorf("no value found for external metric %q", m.MetricName)
+	}
+	var sum float64
+	for _, item := range list.Items {
+		sum += item.Value.AsApproximateFloat64()
+	}
+	return sum, nil
+}
+
+// desiredReplicasByMetric returns the replicas desired by a custom metric, which is proportional to the value of the
+// metric against its target value.
+func desiredReplicasByMetric(current int32, value, target float64) int32 {
+	if current < 1 {
+		current = 1
+	}
+	return int32(math.Ceil(float64(current) * value / target))
+}
+
+// combineDesiredReplicas combines the replicas desired by the builtin metrics and the custom metrics with the
+// metrics policy of the vertex.
+func combineDesiredReplicas(scale dfv1.Scale, builtin int32, customs []CustomMetric) int32 {
+	switch scale.GetMetricsPolicy() {
+	case dfv1.ScalingMetricsPolicyWeighted:
+		weights := float64(scale.GetBuiltinMetricsWeight())
+		sum := weights * float64(builtin)
+		for i, c := range customs {
+			w := float64(scale.Metrics[i].GetWeight())
+			weights += w
+			sum += w * float64(c.Replicas)
+		}
+		if weights == 0 {
+			return builtin
+		}
+		return int32(math.Round(sum / weights))
+	default:
+		desired := builtin
+		for _, c := range customs {
+			if c.Replicas > desired {
+				desired = c.Replicas
+			}
+		}
+		return desired
+	}
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaling
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/metrics/pkg/apis/external_metrics/v1beta1"
+	"k8s.io/metrics/pkg/client/external_metrics/fake"
+	"k8s.io/utils/pointer"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+func Test_desiredReplicasByMetric(t *testing.T) {
+	assert.Equal(t, int32(4), desiredReplicasByMetric(2, 150, 80))
+	assert.Equal(t, int32(1), desiredReplicasByMetric(2, 40, 80))
+	assert.Equal(t, int32(0), desiredReplicasByMetric(2, 0, 80))
+	assert.Equal(t, int32(3), desiredReplicasByMetric(0, 250, 100))
+}
+
+func Test_combineDesiredReplicas(t *testing.T) {
+	customs := []CustomMetric{{Name: "a", Replicas: 6}, {Name: "b", Replicas: 2}}
+	scale := dfv1.Scale{Metrics: []dfv1.ScalingMetric{{Name: "a"}, {Name: "b"}}}
+	assert.Equal(t, int32(6), combineDesiredReplicas(scale, 3, customs))
+	assert.Equal(t, int32(8), combineDesiredReplicas(scale, 8, customs))
+	assert.Equal(t, int32(3), combineDesiredReplicas(scale, 3, nil))
+
+	scale.MetricsPolicy = dfv1.ScalingMetricsPolicyWeighted
+	// (1*4 + 1*6 + 1*2) / 3
+	assert.Equal(t, int32(4), combineDesiredReplicas(scale, 4, customs))
+	scale.BuiltinMetricsWeight = pointer.Uint32(0)
+	scale.Metrics[0].Weight = pointer.Uint32(3)
+	// (3*6 + 1*2) / 4
+	assert.Equal(t, int32(5), combineDesiredReplicas(scale, 4, customs))
+	scale.Metrics[0].Weight = pointer.Uint32(0)
+	scale.Metrics[1].Weight = pointer.Uint32(0)
+	assert.Equal(t, int32(4), combineDesiredReplicas(scale, 4, customs))
+}
+
+func Test_queryPrometheus(t *testing.T) {
+	var response string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/query", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(response))
+	}))
+	defer server.Close()
+	m := &dfv1.PrometheusScalingMetric{ServerURL: server.URL, Query: "sum(api_queue_depth)"}
+
+	response = `{"status":"success","data":{"resultType":"vector","result":[{"metric":{},"value":[1696118400,"120.5"]}]}}`
+	v, err := queryPrometheus(context.Background(), m)
+	assert.NoError(t, err)
+	assert.Equal(t, 120.5, v)
+
+	response = `{"status":"success","data":{"resultType":"scalar","result":[1696118400,"7"]}}`
+	v, err = queryPrometheus(context.Background(), m)
+	assert.NoError(t, err)
+	assert.Equal(t, float64(7), v)
+
+	response = `{"status":"success","data":{"resultType":"vector","result":[]}}`
+	_, err = queryPrometheus(context.Background(), m)
+	assert.Error(t, err)
+
+	response = `{"status":"success","data":{"resultType":"vector","result":[{"metric":{"a":"1"},"value":[1696118400,"1"]},{"metric":{"a":"2"},"value":[1696118400,"2"]}]}}`
+	_, err = queryPrometheus(context.Background(), m)
+	assert.Error(t, err)
+}
+
+func Test_queryExternalMetric(t *testing.T) {
+	c := &fake.FakeExternalMetricsClient{}
+	c.AddReactor("list", "*", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		la := action.(k8stesting.ListAction)
+		assert.Equal(t, "test-ns", la.GetNamespace())
+		assert.Equal(t, "queue_depth", la.GetResource().Resource)
+		assert.Equal(t, "queue=orders", la.GetListRestrictions().Labels.String())
+		return true, &v1beta1.ExternalMetricValueList{Items: []v1beta1.ExternalMetricValue{
+			{MetricName: "queue_depth", Value: resource.MustParse("30")},
+			{MetricName: "queue_depth", Value: resource.MustParse("1500m")},
+		}}, nil
+	})
+	v, err := queryExternalMetric(c, "test-ns", &dfv1.ExternalScalingMetric{
+		MetricName: "queue_depth",
+		Selector:   &metav1.LabelSelector{MatchLabels: map[string]string{"queue": "orders"}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 31.5, v)
+
+	q := &defaultCustomMetricsQuerier{}
+	_, err = q.query(context.Background(), "test-ns", dfv1.ScalingMetric{Name: "m", External: &dfv1.ExternalScalingMetric{MetricName: "queue_depth"}})
+	assert.Error(t, err)
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaling
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	ClampMinReplicas            = "minReplicas"
+	ClampMaxReplicas            = "maxReplicas"
+	ClampReplicasPerScale       = "replicasPerScale"
+	ClampDirectBackPressure     = "directBackPressure"
+	ClampDownstreamBackPressure = "downstreamBackPressure"
+)
+
+// Decision records the inputs and the results of a scaling calculation of a vertex.
+type Decision struct {
+	Time            time.Time `json:"time"`
+	Namespace       string    `json:"namespace"`
+	Pipeline        string    `json:"pipeline"`
+	Vertex          string    `json:"vertex"`
+	CurrentReplicas int32     `json:"currentReplicas"`
+	MinReplicas     int32     `json:"minReplicas"`
+	MaxReplicas     int32     `json:"maxReplicas"`
+	// Names of the active scaling schedules, MinReplicas is raised to the largest floor of them.
+	Schedules []string `json:"schedules,omitempty"`
+	// Aggregated and per partition processing rates and pending messages.
+	TotalRate        float64   `json:"totalRate"`
+	TotalPending     int64     `json:"totalPending"`
+	PartitionRates   []float64 `json:"partitionRates,omitempty"`
+	PartitionPending []int64   `json:"partitionPending,omitempty"`
+	// Usable and target available buffer lengths of the partitions, only available for non-source vertices.
+	PartitionBufferLengths          []int64 `json:"partitionBufferLengths,omitempty"`
+	PartitionAvailableBufferLengths []int64 `json:"partitionAvailableBufferLengths,omitempty"`
+	// Values of the custom scaling metrics and the replicas desired by them.
+	CustomMetrics []CustomMetric `json:"customMetrics,omitempty"`
+	// CalculatedReplicas is the result of the scaling formula, combined with the custom metrics if any, before any
+	// clamp is applied.
+	CalculatedReplicas int32 `json:"calculatedReplicas"`
+	// Clamps applied to the calculated replicas, such as min/max replicas, replicas per scale, and back pressure.
+	Clamps []string `json:"clamps,omitempty"`
+	// DesiredReplicas is the replica number the vertex is scaled to, it equals to the current replicas if not scaled.
+	DesiredReplicas int32  `json:"desiredReplicas"`
+	Message         string `json:"message,omitempty"`
+}
+
+// recordDecision keeps the decision as the most recent one of the vertex key.
+func (s *Scaler) recordDecision(key string, d *Decision) {
+	s.decisionsLock.Lock()
+	defer s.decisionsLock.Unlock()
+	decisions := append(s.decisions[key], *d)
+	if len(decisions) > s.options.decisionsHistorySize {
+		decisions = decisions[len(decisions)-s.options.decisionsHistorySize:]
+	}
+	s.decisions[key] = decisions
+}
+
+// GetDecisions returns the most recent scaling decisions of the vertex key (namespace/name).
+func (s *Scaler) GetDecisions(key string) []Decision {
+	s.decisionsLock.RLock()
+	defer s.decisionsLock.RUnlock()
+	return append([]Decision(nil), s.decisions[key]...)
+}
+
+// DecisionsHandler returns an http handler to serve the most recent scaling decisions of the vertices as JSON.
+// The decisions can be filtered by the "namespace", "pipeline" and "vertex" query parameters.
+func (s *Scaler) DecisionsHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		namespace, pipeline, vertex := query.Get("namespace"), query.Get("pipeline"), query.Get("vertex")
+		result := make(map[string][]Decision)
+		s.decisionsLock.RLock()
+		for key, decisions := range s.decisions {
+			if namespace != "" && !strings.HasPrefix(key, namespace+"/") {
+				continue
+			}
+			last := decisions[len(decisions)-1]
+			if (pipeline != "" && last.Pipeline != pipeline) || (vertex != "" && last.Vertex != vertex) {
+				continue
+			}
+			result[key] = append([]Decision(nil), decisions...)
+		}
+		s.decisionsLock.RUnlock()
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(result); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	})
+}
@@ -16,6 +16,10 @@ limitations under the License.
 
 package scaling
 
+import (
+	"k8s.io/metrics/pkg/client/external_metrics"
+)
+
 type options struct {
 	// Number of workers working on autoscaling.
 	workers int
@@ -25,6 +29,10 @@ type options struct {
 	backPressureThreshold float64
 	// size of the daemon clients cache.
 	clientsCacheSize int
+	// Number of the most recent scaling decisions kept for each vertex.
+	decisionsHistorySize int
+	// Client of the Kubernetes external metrics API, for the external scaling metrics.
+	externalMetricsClient external_metrics.ExternalMetricsClient
 }
 
 type Option func(*options)
@@ -35,6 +43,7 @@ func defaultOptions() *options {
 		taskInterval:          30000,
 		backPressureThreshold: 0.9,
 		clientsCacheSize:      100,
+		decisionsHistorySize:  10,
 	}
 }
 
@@ -65,3 +74,18 @@ func WithClientsCacheSize(n int) Option {
 		o.clientsCacheSize = n
 	}
 }
+
+// WithDecisionsHistorySize sets the number of the most recent scaling decisions kept for each vertex.
+func WithDecisionsHistorySize(n int) Option {
+	return func(o *options) {
+		o.decisionsHistorySize = n
+	}
+}
+
+// WithExternalMetricsClient sets the client of the Kubernetes external metrics API, which is required to scale on
+// the external scaling metrics.
+func WithExternalMetricsClient(c external_metrics.ExternalMetricsClient) Option {
+	return func(o *options) {
+		o.externalMetricsClient = c
+	}
+}
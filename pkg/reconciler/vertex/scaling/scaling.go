@@ -49,6 +49,11 @@ type Scaler struct {
 	// Cache to store the vertex metrics such as pending message number
 	vertexMetricsCache *lru.Cache
 	daemonClientsCache *lru.Cache
+	// The most recent scaling decisions of the vertices, keyed by the vertex key.
+	decisions     map[string][]Decision
+	decisionsLock *sync.RWMutex
+	// Querier of the custom scaling metrics.
+	metricsQuerier customMetricsQuerier
 }
 
 // NewScaler returns a Scaler instance.
@@ -60,12 +65,15 @@ func NewScaler(client client.Client, opts ...Option) *Scaler {
 		}
 	}
 	s := &Scaler{
-		client:     client,
-		options:    scalerOpts,
-		vertexMap:  make(map[string]*list.Element),
-		vertexList: list.New(),
-		lock:       new(sync.RWMutex),
-	}
+		client:        client,
+		options:       scalerOpts,
+		vertexMap:     make(map[string]*list.Element),
+		vertexList:    list.New(),
+		lock:          new(sync.RWMutex),
+		decisions:     make(map[string][]Decision),
+		decisionsLock: new(sync.RWMutex),
+	}
+	s.metricsQuerier = &defaultCustomMetricsQuerier{externalMetricsClient: scalerOpts.externalMetricsClient}
 	// cache top 100 daemon clients
 	s.daemonClientsCache, _ = lru.NewWithEvict(s.options.clientsCacheSize, func(key, value interface{}) {
 		_ = value.(*daemonclient.DaemonClient).Close()
@@ -107,6 +115,9 @@ func (s *Scaler) StopWatching(key string) {
 		_ = s.vertexList.Remove(e)
 		delete(s.vertexMap, key)
 	}
+	s.decisionsLock.Lock()
+	defer s.decisionsLock.Unlock()
+	delete(s.decisions, key)
 }
 
 // Function scale() defines each of the worker's job.
@@ -178,6 +189,10 @@ func (s *Scaler) scaleOneVertex(ctx context.Context, key string, worker int) err
 		log.Debug("Vertex not in Running phase.")
 		return nil
 	}
+	if vertex.Status.IsHalted() {
+		log.Debug("Vertex halted for exceeding the restart budget.")
+		return nil
+	}
 	pl := &dfv1.Pipeline{}
 	if err := s.client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: vertex.Spec.PipelineName}, pl); err != nil {
 		if apierrors.IsNotFound(err) {
@@ -196,11 +211,26 @@ func (s *Scaler) scaleOneVertex(ctx context.Context, key string, worker int) err
 		log.Debug("Corresponding Pipeline not in Running state")
 		return nil
 	}
+	if _, ok := pl.Annotations[dfv1.KeyResetSourceOffsets]; ok {
+		log.Debug("Corresponding Pipeline resetting source offsets")
+		return nil
+	}
 	if int(vertex.Status.Replicas) != vertex.GetReplicas() {
 		log.Debugf("Vertex %s might be under processing, replicas mismatch", vertex.Name)
 		return nil
 	}
+	scheduledMin, activeSchedules, err := scheduledMinReplicas(vertex.Spec.Scale.Schedules, time.Now())
+	if err != nil {
+		log.Errorw("Failed to evaluate scaling schedules", zap.Error(err))
+	}
+	if max := vertex.Spec.Scale.GetMaxReplicas(); scheduledMin > max {
+		scheduledMin = max
+	}
 	if vertex.Status.Replicas == 0 { // Was scaled to 0
+		if scheduledMin > 0 {
+			log.Debugf("Vertex %s is in scaling schedules %v, scaling up to %d", vertex.Name, activeSchedules, scheduledMin)
+			return s.patchVertexReplicas(ctx, vertex, scheduledMin)
+		}
 		if seconds := time.Since(vertex.Status.LastScaledAt.Time).Seconds(); seconds >= float64(vertex.Spec.Scale.GetZeroReplicaSleepSeconds()) {
 			log.Debugf("Vertex %s has slept %v seconds, scaling up to peek", vertex.Name, seconds)
 			return s.patchVertexReplicas(ctx, vertex, 1)
@@ -209,7 +239,6 @@ func (s *Scaler) scaleOneVertex(ctx context.Context, key string, worker int) err
 			return nil
 		}
 	}
-	var err error
 	dClient, _ := s.daemonClientsCache.Get(pl.GetDaemonServiceURL())
 	if dClient == nil {
 		dClient, err = daemonclient.NewDaemonServiceClient(pl.GetDaemonServiceURL())
@@ -231,6 +260,24 @@ func (s *Scaler) scaleOneVertex(ctx context.Context, key string, worker int) err
 	// we need both aggregated and partition level metrics for scaling, because we use aggregated metrics to
 	// determine whether we can scale down to 0 and for calculating back pressure, and partition level metrics to determine
 	// the max desired replicas among all the partitions.
+	current := int32(vertex.GetReplicas())
+	max := vertex.Spec.Scale.GetMaxReplicas()
+	min := vertex.Spec.Scale.GetMinReplicas()
+	if scheduledMin > min { // Pre-scale the vertex with the floor of the active schedules.
+		min = scheduledMin
+	}
+	decision := &Decision{
+		Time:            time.Now(),
+		Namespace:       namespace,
+		Pipeline:        pl.Name,
+		Vertex:          vertex.Spec.Name,
+		CurrentReplicas: current,
+		MinReplicas:     min,
+		MaxReplicas:     max,
+		Schedules:       activeSchedules,
+		DesiredReplicas: current,
+	}
+	defer s.recordDecision(key, decision)
 	partitionRates := make([]float64, 0)
 	partitionPending := make([]int64, 0)
 	totalRate := float64(0)
@@ -240,6 +287,7 @@ func (s *Scaler) scaleOneVertex(ctx context.Context, key string, worker int) err
 		// If rate is not available, we skip scaling.
 		if !existing || rate < 0 { // Rate not available
 			log.Debugf("Vertex %s has no rate information, skip scaling", vertex.Name)
+			decision.Message = "Processing rate not available, skip scaling"
 			return nil
 		}
 		partitionRates = append(partitionRates, rate)
@@ -249,11 +297,14 @@ func (s *Scaler) scaleOneVertex(ctx context.Context, key string, worker int) err
 		if !existing || pending < 0 || pending == isb.PendingNotAvailable {
 			// Pending not available, we don't do anything
 			log.Debugf("Vertex %s has no pending messages information, skip scaling", vertex.Name)
+			decision.Message = "Pending messages not available, skip scaling"
 			return nil
 		}
 		totalPending += pending
 		partitionPending = append(partitionPending, pending)
 	}
+	decision.TotalRate, decision.TotalPending = totalRate, totalPending
+	decision.PartitionRates, decision.PartitionPending = partitionRates, partitionPending
 
 	// Add pending information to cache for back pressure calculation, if there is a backpressure it will impact all the partitions.
 	// So we only need to add the total pending to the cache.
@@ -265,9 +316,11 @@ func (s *Scaler) scaleOneVertex(ctx context.Context, key string, worker int) err
 	if !vertex.IsASource() { // Only non-source vertex has buffer to read
 		for _, bufferName := range vertex.OwnedBuffers() {
 			if bInfo, err := daemonClient.GetPipelineBuffer(ctx, pl.Name, bufferName); err != nil {
+				decision.Message = "Failed to get the read buffer information"
 				return fmt.Errorf("failed to get the read buffer information of vertex %q, %w", vertex.Name, err)
 			} else {
 				if bInfo.BufferLength == nil || bInfo.BufferUsageLimit == nil {
+					decision.Message = "Invalid read buffer information"
 					return fmt.Errorf("invalid read buffer information of vertex %q, length or usage limit is missing", vertex.Name)
 				}
 				partitionBufferLengths = append(partitionBufferLengths, int64(float64(bInfo.GetBufferLength())*bInfo.GetBufferUsageLimit()))
@@ -279,9 +332,9 @@ func (s *Scaler) scaleOneVertex(ctx context.Context, key string, worker int) err
 		}
 		// Add processing rate information to cache for back pressure calculation
 		_ = s.vertexMetricsCache.Add(key+"/length", totalBufferLength)
+		decision.PartitionBufferLengths, decision.PartitionAvailableBufferLengths = partitionBufferLengths, partitionAvailableBufferLengths
 	}
 	var desired int32
-	current := int32(vertex.GetReplicas())
 	// if both totalRate and totalPending are 0, we scale down to 0
 	// since pending contains the pending acks, we can scale down to 0.
 	if totalPending == 0 && totalRate == 0 {
@@ -289,18 +342,41 @@ func (s *Scaler) scaleOneVertex(ctx context.Context, key string, worker int) err
 	} else {
 		desired = s.desiredReplicas(ctx, vertex, partitionRates, partitionPending, partitionBufferLengths, partitionAvailableBufferLengths)
 	}
+	if len(vertex.Spec.Scale.Metrics) > 0 {
+		customMetrics := make([]CustomMetric, 0, len(vertex.Spec.Scale.Metrics))
+		for _, m := range vertex.Spec.Scale.Metrics {
+			value, err := s.metricsQuerier.query(ctx, namespace, m)
+			if err != nil {
+				decision.Message = fmt.Sprintf("Failed to get the value of scaling metric %q, skip scaling", m.Name)
+				return fmt.Errorf("failed to get the value of scaling metric %q of vertex %q, %w", m.Name, vertex.Name, err)
+			}
+			target := m.TargetValue.AsApproximateFloat64()
+			customMetrics = append(customMetrics, CustomMetric{
+				Name:        m.Name,
+				Value:       value,
+				TargetValue: target,
+				Replicas:    desiredReplicasByMetric(current, value, target),
+			})
+		}
+		decision.CustomMetrics = customMetrics
+		log.Debugf("Desired replica number of vertex %q by the builtin metrics is: %d, by the custom metrics: %v", vertex.Name, desired, customMetrics)
+		desired = combineDesiredReplicas(vertex.Spec.Scale, desired, customMetrics)
+	}
 	log.Debugf("Calculated desired replica number of vertex %q is: %d", vertex.Name, desired)
-	max := vertex.Spec.Scale.GetMaxReplicas()
-	min := vertex.Spec.Scale.GetMinReplicas()
+	decision.CalculatedReplicas = desired
 	if desired > max {
 		desired = max
+		decision.Clamps = append(decision.Clamps, ClampMaxReplicas)
 		log.Debugf("Calculated desired replica number %d of vertex %q is greater than max, using max %d", vertex.Name, desired, max)
 	}
 	if desired < min {
 		desired = min
+		decision.Clamps = append(decision.Clamps, ClampMinReplicas)
 		log.Debugf("Calculated desired replica number %d of vertex %q is smaller than min, using min %d", vertex.Name, desired, min)
 	}
 	if current > max || current < min { // Someone might have manually scaled up/down the vertex
+		decision.DesiredReplicas = desired
+		decision.Message = "Current replicas out of the min/max range"
 		return s.patchVertexReplicas(ctx, vertex, desired)
 	}
 	maxAllowed := int32(vertex.Spec.Scale.GetReplicasPerScale())
@@ -308,30 +384,43 @@ func (s *Scaler) scaleOneVertex(ctx context.Context, key string, worker int) err
 		diff := current - desired
 		if diff > maxAllowed {
 			diff = maxAllowed
+			decision.Clamps = append(decision.Clamps, ClampReplicasPerScale)
 		}
+		decision.DesiredReplicas = current - diff
+		decision.Message = "Scaling down"
 		return s.patchVertexReplicas(ctx, vertex, current-diff) // We scale down gradually
 	}
 	if desired > current {
 		// When scaling up, need to check back pressure
 		directPressure, downstreamPressure := s.hasBackPressure(*pl, *vertex)
 		if directPressure {
+			decision.Clamps = append(decision.Clamps, ClampDirectBackPressure)
 			if current > 1 {
 				log.Debugf("Vertex %s has direct back pressure from connected vertices, decreasing one replica", key)
+				decision.DesiredReplicas = current - 1
+				decision.Message = "Direct back pressure from connected vertices, decreasing one replica"
 				return s.patchVertexReplicas(ctx, vertex, current-1)
 			} else {
 				log.Debugf("Vertex %s has direct back pressure from connected vertices, skip scaling", key)
+				decision.Message = "Direct back pressure from connected vertices, skip scaling"
 				return nil
 			}
 		} else if downstreamPressure {
 			log.Debugf("Vertex %s has back pressure in downstream vertices, skip scaling", key)
+			decision.Clamps = append(decision.Clamps, ClampDownstreamBackPressure)
+			decision.Message = "Back pressure in downstream vertices, skip scaling"
 			return nil
 		}
 		diff := desired - current
 		if diff > maxAllowed {
 			diff = maxAllowed
+			decision.Clamps = append(decision.Clamps, ClampReplicasPerScale)
 		}
+		decision.DesiredReplicas = current + diff
+		decision.Message = "Scaling up"
 		return s.patchVertexReplicas(ctx, vertex, current+diff) // We scale up gradually
 	}
+	decision.Message = "No scaling needed"
 	return nil
 }
 
@@ -18,6 +18,8 @@ package scaling
 
 import (
 	"context"
+	"encoding/json"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -109,3 +111,27 @@ func Test_desiredReplicasMultiplePartitions(t *testing.T) {
 	assert.Equal(t, int32(4), s.desiredReplicas(context.TODO(), udf, []float64{5000, 3000, 5000}, []int64{0, 30000, 1}, []int64{24000, 24000, 24000}, []int64{15000, 15000, 15000}))
 	assert.Equal(t, int32(4), s.desiredReplicas(context.TODO(), udf, []float64{1000, 3000, 1000}, []int64{0, 27000, 3000}, []int64{24000, 24000, 24000}, []int64{15000, 15000, 15000}))
 }
+
+func Test_Decisions(t *testing.T) {
+	cl := fake.NewClientBuilder().Build()
+	s := NewScaler(cl, WithDecisionsHistorySize(2))
+	for i := int32(1); i <= 3; i++ {
+		s.recordDecision("ns/pl-v1", &Decision{Namespace: "ns", Pipeline: "pl", Vertex: "v1", CurrentReplicas: i, DesiredReplicas: i + 1})
+	}
+	s.recordDecision("ns/pl-v2", &Decision{Namespace: "ns", Pipeline: "pl", Vertex: "v2", CalculatedReplicas: 10, DesiredReplicas: 5, Clamps: []string{ClampMaxReplicas}})
+	decisions := s.GetDecisions("ns/pl-v1")
+	assert.Len(t, decisions, 2)
+	assert.Equal(t, int32(2), decisions[0].CurrentReplicas)
+	assert.Equal(t, int32(3), decisions[1].CurrentReplicas)
+
+	w := httptest.NewRecorder()
+	s.DecisionsHandler().ServeHTTP(w, httptest.NewRequest("GET", "/autoscaling/decisions?pipeline=pl&vertex=v2", nil))
+	result := make(map[string][]Decision)
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &result))
+	assert.Len(t, result, 1)
+	assert.Equal(t, []string{ClampMaxReplicas}, result["ns/pl-v2"][0].Clamps)
+
+	s.StartWatching("ns/pl-v1")
+	s.StopWatching("ns/pl-v1")
+	assert.Empty(t, s.GetDecisions("ns/pl-v1"))
+}
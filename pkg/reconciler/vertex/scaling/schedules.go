@@ -0,0 +1,67 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaling
+
+import (
+	"fmt"
+	"time"
+
+	cronlib "github.com/robfig/cron/v3"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+// scheduledMinReplicas returns the largest min replicas of the scaling schedules active at the given time, and the
+// names of those schedules. A schedule is active from its start time, for its duration. Invalid schedules are
+// skipped, the first error is returned along with the result of the valid ones.
+func scheduledMinReplicas(schedules []dfv1.ScalingSchedule, now time.Time) (int32, []string, error) {
+	var min int32
+	var active []string
+	var firstErr error
+	for _, ss := range schedules {
+		ok, err := isScheduleActive(ss, now)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = fmt.Errorf("invalid scaling schedule %q, %w", ss.Name, err)
+			}
+			continue
+		}
+		if !ok {
+			continue
+		}
+		active = append(active, ss.Name)
+		if ss.Min > min {
+			min = ss.Min
+		}
+	}
+	return min, active, firstErr
+}
+
+// isScheduleActive returns true if the most recent start time of the schedule is within its duration before now.
+func isScheduleActive(ss dfv1.ScalingSchedule, now time.Time) (bool, error) {
+	loc, err := time.LoadLocation(ss.GetTimezone())
+	if err != nil {
+		return false, err
+	}
+	sched, err := cronlib.ParseStandard(ss.Start)
+	if err != nil {
+		return false, err
+	}
+	now = now.In(loc)
+	// Next returns the first start time strictly after the given time.
+	return !sched.Next(now.Add(-ss.Duration.Duration)).After(now), nil
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaling
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+func Test_isScheduleActive(t *testing.T) {
+	ss := dfv1.ScalingSchedule{
+		Name:     "morning",
+		Start:    "30 8 * * *",
+		Duration: metav1.Duration{Duration: 2 * time.Hour},
+		Min:      5,
+	}
+	for _, tc := range []struct {
+		now    time.Time
+		active bool
+	}{
+		{time.Date(2023, 6, 1, 8, 29, 59, 0, time.UTC), false},
+		{time.Date(2023, 6, 1, 8, 30, 0, 0, time.UTC), true},
+		{time.Date(2023, 6, 1, 10, 29, 59, 0, time.UTC), true},
+		{time.Date(2023, 6, 1, 10, 30, 0, 0, time.UTC), false},
+		{time.Date(2023, 6, 1, 23, 0, 0, 0, time.UTC), false},
+	} {
+		active, err := isScheduleActive(ss, tc.now)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.active, active, tc.now.String())
+	}
+
+	// Windows across midnight are active on both days.
+	ss.Start = "0 23 * * *"
+	active, err := isScheduleActive(ss, time.Date(2023, 6, 2, 0, 30, 0, 0, time.UTC))
+	assert.NoError(t, err)
+	assert.True(t, active)
+
+	ss.Start = "0 9 * * *"
+	ss.Timezone = pointer.String("America/New_York")
+	active, err = isScheduleActive(ss, time.Date(2023, 6, 1, 13, 30, 0, 0, time.UTC)) // 09:30 EDT
+	assert.NoError(t, err)
+	assert.True(t, active)
+	active, err = isScheduleActive(ss, time.Date(2023, 6, 1, 9, 30, 0, 0, time.UTC))
+	assert.NoError(t, err)
+	assert.False(t, active)
+
+	ss.Timezone = pointer.String("Nowhere/Nowhere")
+	_, err = isScheduleActive(ss, time.Now())
+	assert.Error(t, err)
+	ss.Timezone = nil
+	ss.Start = "invalid"
+	_, err = isScheduleActive(ss, time.Now())
+	assert.Error(t, err)
+}
+
+func Test_scheduledMinReplicas(t *testing.T) {
+	now := time.Date(2023, 6, 1, 9, 0, 0, 0, time.UTC)
+	min, active, err := scheduledMinReplicas(nil, now)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(0), min)
+	assert.Nil(t, active)
+
+	schedules := []dfv1.ScalingSchedule{
+		{Name: "morning", Start: "30 8 * * *", Duration: metav1.Duration{Duration: time.Hour}, Min: 3},
+		{Name: "peak", Start: "45 8 * * *", Duration: metav1.Duration{Duration: time.Hour}, Min: 8},
+		{Name: "evening", Start: "0 18 * * *", Duration: metav1.Duration{Duration: time.Hour}, Min: 10},
+		{Name: "broken", Start: "invalid", Duration: metav1.Duration{Duration: time.Hour}, Min: 20},
+	}
+	min, active, err = scheduledMinReplicas(schedules, now)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "broken")
+	assert.Equal(t, int32(8), min)
+	assert.Equal(t, []string{"morning", "peak"}, active)
+}
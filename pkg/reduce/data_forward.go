@@ -29,6 +29,7 @@ package reduce
 
 import (
 	"context"
+	"fmt"
 	"math"
 	"strconv"
 	"sync"
@@ -160,6 +161,9 @@ func (df *DataForward) ReplayPersistedMessages(ctx context.Context) error {
 		// crosses the window.
 
 		alignedKeyedWindow := keyed.NewKeyedWindow(p.Start, p.End)
+		// the slot is added before the insertion too, because the windowers keyed by the slots (e.g., accumulator
+		// windows) need it to rebuild the window
+		alignedKeyedWindow.AddSlot(p.Slot)
 
 		// insert the window to the list of active windows, since the active window list is in-memory
 		keyedWindow, _ := df.windower.InsertIfNotPresent(alignedKeyedWindow)
@@ -168,6 +172,18 @@ func (df *DataForward) ReplayPersistedMessages(ctx context.Context) error {
 		// the window end time comes, slots will not be lost and the windows will be closed as expected
 		keyedWindow.AddSlot(p.Slot)
 
+		// restore the end time extended before the restart (e.g., by merging the custom windows), so that the window
+		// is not closed at the end time of the partition
+		if ew, ok := keyedWindow.(window.ExtendableWindower); ok {
+			end, err := df.pbqManager.GetWindowEnd(p)
+			if err != nil {
+				return fmt.Errorf("failed to get the window end of partition %s, %w", p.String(), err)
+			}
+			if !end.IsZero() {
+				ew.Extend(end)
+			}
+		}
+
 		// create and invoke process and forward for the partition
 		df.associatePBQAndPnF(ctx, p, keyedWindow)
 	}
@@ -214,7 +230,7 @@ func (df *DataForward) forwardAChunk(ctx context.Context) {
 			for toVertexName, toVertexBuffer := range df.toBuffers {
 				if publisher, ok := df.wmPublishers[toVertexName]; ok {
 					for _, bufferPartition := range toVertexBuffer {
-						idlehandler.PublishIdleWatermark(ctx, bufferPartition, publisher, df.idleManager, df.log, dfv1.VertexTypeReduceUDF, wmb.Watermark(time.UnixMilli(processorWMB.Watermark)))
+						idlehandler.PublishIdleWatermark(ctx, bufferPartition, publisher, df.idleManager, df.log, dfv1.VertexTypeReduceUDF, wmb.Watermark(wmb.FromEpoch(processorWMB.Watermark)))
 					}
 				}
 			}
@@ -222,12 +238,19 @@ func (df *DataForward) forwardAChunk(ctx context.Context) {
 			// if toBeClosed window exists, and the watermark we fetch has already passed the endTime of this window
 			// then it means the overall dataflow of the pipeline has already reached a later time point
 			// so we can close the window and process the data in this window
-			if watermark := time.UnixMilli(processorWMB.Watermark).Add(-1 * time.Millisecond); nextWinAsSeenByReader.EndTime().Before(watermark) {
+			if watermark := wmb.FromEpoch(processorWMB.Watermark).Add(-wmb.Precision()); nextWinAsSeenByReader.EndTime().Before(watermark) {
 				closedWindows := df.windower.RemoveWindows(watermark)
 				for _, win := range closedWindows {
 					df.ClosePartitions(win.Partitions())
 				}
 			} else {
+				// the windows closed by the triggers other than the watermark (e.g., flushed accumulator windows) are
+				// closed even if the watermark doesn't progress
+				if tw, ok := df.windower.(window.TriggeredWindower); ok && tw.HasTriggered() {
+					for _, win := range tw.RemoveWindows(watermark) {
+						df.ClosePartitions(win.Partitions())
+					}
+				}
 				// if toBeClosed window exists, but the watermark we fetch is still within the endTime of the window
 				// then we can't close the window because there could still be data after the idling situation ends
 				// so in this case, we publish an idle watermark
@@ -300,7 +323,7 @@ func (df *DataForward) associatePBQAndPnF(ctx context.Context, partitionID parti
 		// since we created a brand new PBQ it means there is no PnF listening on this PBQ.
 		// we should create and attach the read side of the loop (PnF) to the partition and then
 		// start process-and-forward (pnf) loop
-		t := df.of.SchedulePnF(ctx, partitionID)
+		t := df.of.SchedulePnF(ctx, partitionID, kw)
 		df.udfInvocationTracking[partitionID] = t
 		df.log.Debugw("Successfully Created/Found pbq and started PnF", zap.String("partitionID", partitionID.String()))
 	}
@@ -357,8 +380,8 @@ func (df *DataForward) Process(ctx context.Context, messages []*isb.ReadMessage)
 
 	// solve Reduce withholding of watermark where we do not send WM until the window is closed.
 	if nextWinAsSeenByReader := df.pbqManager.NextWindowToBeMaterialized(); nextWinAsSeenByReader != nil {
-		// minus 1 ms because if it's the same as the end time the window would have already been closed
-		if watermark := time.Time(wm).Add(-1 * time.Millisecond); nextWinAsSeenByReader.EndTime().After(watermark) {
+		// minus 1 unit of the precision because if it's the same as the end time the window would have already been closed
+		if watermark := time.Time(wm).Add(-wmb.Precision()); nextWinAsSeenByReader.EndTime().After(watermark) {
 			// publish idle watermark so that the next vertex doesn't need to wait for the window to close to
 			// start processing data whose watermark is smaller than the endTime of the toBeClosed window
 
@@ -429,7 +452,11 @@ messagesLoop:
 		}
 
 		// identify and add window for the message
-		windows := df.upsertWindowsAndKeys(message)
+		windows, err := df.upsertWindowsAndKeys(ctx, message)
+		if err != nil {
+			df.log.Errorw("Failed to assign windows, asked to stop trying", zap.Any("msgOffSet", message.ReadOffset.String()), zap.Error(err))
+			break messagesLoop
+		}
 
 		// for each window we will have a PBQ. A message could belong to multiple windows (e.g., sliding).
 		// We need to write the messages to these PBQs
@@ -457,11 +484,13 @@ messagesLoop:
 // error loop, and we have received ctx.Done() via SIGTERM.
 func (df *DataForward) writeToPBQ(ctx context.Context, m *isb.ReadMessage, p partition.ID, kw window.AlignedKeyedWindower) error {
 	startTime := time.Now()
-	defer pbqWriteTime.With(map[string]string{
-		metrics.LabelVertex:             df.vertexName,
-		metrics.LabelPipeline:           df.pipelineName,
-		metrics.LabelVertexReplicaIndex: strconv.Itoa(int(df.vertexReplica)),
-	}).Observe(float64(time.Since(startTime).Milliseconds()))
+	defer func() {
+		pbqWriteTime.With(map[string]string{
+			metrics.LabelVertex:             df.vertexName,
+			metrics.LabelPipeline:           df.pipelineName,
+			metrics.LabelVertexReplicaIndex: strconv.Itoa(int(df.vertexReplica)),
+		}).Observe(float64(time.Since(startTime).Milliseconds()))
+	}()
 
 	var pbqWriteBackoff = wait.Backoff{
 		Steps:    math.MaxInt,
@@ -581,7 +610,32 @@ func (df *DataForward) ShutDown(ctx context.Context) {
 
 // upsertWindowsAndKeys will create or assigns (if already present) a window to the message. It is an upsert operation
 // because windows are created out of order, but they will be closed in-order.
-func (df *DataForward) upsertWindowsAndKeys(m *isb.ReadMessage) []window.AlignedKeyedWindower {
+// The keyed windowers (e.g., custom windows) insert or merge the windows themselves while assigning.
+func (df *DataForward) upsertWindowsAndKeys(ctx context.Context, m *isb.ReadMessage) ([]window.AlignedKeyedWindower, error) {
+
+	if kw, ok := df.windower.(window.KeyedWindower); ok {
+		kWindows, err := kw.AssignWindowWithKeys(ctx, m.Keys, m.EventTime)
+		if err != nil {
+			return nil, err
+		}
+		// unlike the aligned windows, the keyed windows always come with their slots, which are the keys of the
+		// message joined by the windower, so no slot needs to be added here.
+		for _, w := range kWindows {
+			// persist the end time extended by merging before the message is written, so that the window is closed
+			// at the same time if the partitions are replayed
+			if _, ok := w.(window.ExtendableWindower); !ok {
+				continue
+			}
+			for _, p := range w.Partitions() {
+				if w.EndTime().After(p.End) {
+					if err := df.pbqManager.SaveWindowEnd(p, w.EndTime()); err != nil {
+						return nil, fmt.Errorf("failed to save the window end of partition %s, %w", p.String(), err)
+					}
+				}
+			}
+		}
+		return kWindows, nil
+	}
 
 	processingWindows := df.windower.AssignWindow(m.EventTime)
 	var kWindows []window.AlignedKeyedWindower
@@ -597,7 +651,7 @@ func (df *DataForward) upsertWindowsAndKeys(m *isb.ReadMessage) []window.Aligned
 		w.AddSlot("slot-0")
 		kWindows = append(kWindows, w)
 	}
-	return kWindows
+	return kWindows, nil
 }
 
 // ClosePartitions closes the partitions by invoking close-of-book (COB).
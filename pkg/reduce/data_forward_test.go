@@ -27,8 +27,10 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/emptypb"
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	windowerpb "github.com/numaproj/numaflow/pkg/apis/proto/windower"
 	"github.com/numaproj/numaflow/pkg/forward"
 	"github.com/numaproj/numaflow/pkg/isb"
 	"github.com/numaproj/numaflow/pkg/isb/stores/simplebuffer"
@@ -42,6 +44,7 @@ import (
 	"github.com/numaproj/numaflow/pkg/watermark/publish"
 	wmstore "github.com/numaproj/numaflow/pkg/watermark/store"
 	"github.com/numaproj/numaflow/pkg/watermark/wmb"
+	"github.com/numaproj/numaflow/pkg/window/strategy/custom"
 	"github.com/numaproj/numaflow/pkg/window/strategy/fixed"
 )
 
@@ -124,7 +127,7 @@ type myForwardTestRoundRobin struct {
 	count int
 }
 
-func (f *myForwardTestRoundRobin) WhereTo(_ []string, _ []string) ([]forward.VertexBuffer, error) {
+func (f *myForwardTestRoundRobin) WhereTo(_ []string, _ []string, _ string, _ map[string]string) ([]forward.VertexBuffer, error) {
 	var output = []forward.VertexBuffer{{
 		ToVertexName:         "reduce-to-vertex",
 		ToVertexPartitionIdx: int32(f.count % 2),
@@ -163,7 +166,7 @@ func (f CounterReduceTest) ApplyReduce(_ context.Context, partitionID *partition
 	}, nil
 }
 
-func (f CounterReduceTest) WhereTo(_ []string, _ []string) ([]forward.VertexBuffer, error) {
+func (f CounterReduceTest) WhereTo(_ []string, _ []string, _ string, _ map[string]string) ([]forward.VertexBuffer, error) {
 	return []forward.VertexBuffer{{
 		ToVertexName:         "reduce-to-vertex",
 		ToVertexPartitionIdx: 0,
@@ -1395,6 +1398,95 @@ func writeMessages(ctx context.Context, count int, key string, fromBuffer *simpl
 	}
 }
 
+// sessionWindowerClient assigns a session window [eventTime, eventTime+gap) and merges it into the first overlapping
+// active window.
+type sessionWindowerClient struct {
+	gap int64
+}
+
+func (s *sessionWindowerClient) CloseConn(context.Context) error {
+	return nil
+}
+
+func (s *sessionWindowerClient) IsReady(context.Context, *emptypb.Empty) (bool, error) {
+	return true, nil
+}
+
+func (s *sessionWindowerClient) AssignWindows(_ context.Context, req *windowerpb.AssignWindowsRequest) (*windowerpb.AssignWindowsResponse, error) {
+	return &windowerpb.AssignWindowsResponse{Windows: []*windowerpb.Window{{Start: req.EventTime, End: req.EventTime + s.gap}}}, nil
+}
+
+func (s *sessionWindowerClient) MergeWindows(_ context.Context, req *windowerpb.MergeWindowsRequest) (*windowerpb.MergeWindowsResponse, error) {
+	active := req.ActiveWindows[0]
+	end := active.End
+	if req.Window.End > end {
+		end = req.Window.End
+	}
+	return &windowerpb.MergeWindowsResponse{Merged: true, ActiveIndex: 0, MergedWindow: &windowerpb.Window{Start: active.Start, End: end}}, nil
+}
+
+// TestReduceDataForward_CustomWindowRestart tests that a custom window extended by merging is closed at the merged end
+// time after the partitions are replayed.
+func TestReduceDataForward_CustomWindowRestart(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	fromBuffer := simplebuffer.NewInMemoryBuffer("source-reduce-buffer", 100, 0)
+	buffer := simplebuffer.NewInMemoryBuffer("reduce-to-vertex", 10, 0)
+	toBuffer := map[string][]isb.BufferWriter{
+		"reduce-to-vertex": {buffer},
+	}
+	// the store provider outlives the forwarders, like the persisted volume
+	storeProvider := memory.NewMemoryStores(memory.WithStoreSize(100))
+	f, _ := fetcherAndPublisher(ctx, fromBuffer, t.Name())
+	publisherMap, _ := buildPublisherMapAndOTStore(ctx, toBuffer, pipelineName)
+	defer func() {
+		for _, p := range publisherMap {
+			_ = p.Close()
+		}
+	}()
+
+	newForwarder := func() (*DataForward, *pbq.Manager, *custom.Windower) {
+		pbqManager, err := pbq.NewManager(ctx, "reduce", pipelineName, 0, storeProvider,
+			pbq.WithReadTimeout(1*time.Second), pbq.WithChannelBufferSize(10))
+		assert.NoError(t, err)
+		windower := custom.NewWindower(ctx, &sessionWindowerClient{gap: 10}, time.Hour)
+		idleManager := wmb.NewIdleManager(len(toBuffer))
+		op := pnf.NewOrderedProcessor(ctx, keyedVertex, CounterReduceTest{}, toBuffer, pbqManager, CounterReduceTest{}, publisherMap, idleManager)
+		df, err := NewDataForward(ctx, keyedVertex, fromBuffer, toBuffer, pbqManager, CounterReduceTest{}, f, publisherMap,
+			windower, idleManager, op, WithReadBatchSize(10))
+		assert.NoError(t, err)
+		return df, pbqManager, windower
+	}
+
+	df, pbqManager, _ := newForwarder()
+	var messages []*isb.ReadMessage
+	for i, et := range []int64{100, 105} {
+		messages = append(messages, &isb.ReadMessage{
+			Message:    buildIsbMessage(1, time.UnixMilli(et)),
+			ReadOffset: isb.SimpleStringOffset(func() string { return strconv.Itoa(i) }),
+		})
+	}
+	written, err := df.writeMessagesToWindows(ctx, messages)
+	assert.NoError(t, err)
+	assert.Len(t, written, 2)
+
+	// the merged window is persisted with the partition it's created with
+	id := partition.ID{Start: time.UnixMilli(100), End: time.UnixMilli(110), Slot: "odd"}
+	end, err := pbqManager.GetWindowEnd(id)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(115), end.UnixMilli())
+
+	// restart
+	df, _, windower := newForwarder()
+	assert.NoError(t, df.ReplayPersistedMessages(ctx))
+	next := windower.NextWindowToBeClosed()
+	assert.Equal(t, []partition.ID{id}, next.Partitions())
+	assert.Equal(t, int64(115), next.EndTime().UnixMilli())
+	assert.Len(t, windower.RemoveWindows(time.UnixMilli(110)), 0)
+	assert.Len(t, windower.RemoveWindows(time.UnixMilli(115)), 1)
+}
+
 func publishMessages(ctx context.Context, startTime int, messages []int, testDuration int, batchSize int, publish publish.Publisher, fromBuffer *simplebuffer.InMemoryBuffer) {
 	eventTime := startTime
 
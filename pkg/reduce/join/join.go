@@ -0,0 +1,159 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package join joins the messages from the two inbound edges of a reduce vertex on their keys within each window.
+// The inbound edge a message comes from is told by the origin of the message, which is set by the upstream vertices.
+// The watermark of the join vertex is the minimum of the watermarks of the inbound edges, which is guaranteed by the
+// edge fetcher set, so a window is only closed once both of the inbound edges have passed the end of it.
+package join
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/reduce/pbq/partition"
+	"github.com/numaproj/numaflow/pkg/watermark/wmb"
+)
+
+// keysDelimiter is used to combine the keys of a message to a string
+const keysDelimiter = "\x00"
+
+// Joiner joins the messages from the two inbound edges of a window on their keys, it implements applier.ReduceApplier.
+type Joiner struct {
+	joinType dfv1.JoinType
+	// sides are the names of the from vertices of the two inbound edges
+	sides [2]string
+}
+
+// group is the messages of a key from each side
+type group struct {
+	keys     []string
+	messages [2][][]byte
+}
+
+// NewJoiner returns a Joiner of the messages from the given two from vertices.
+func NewJoiner(join dfv1.Join, left, right string) *Joiner {
+	return &Joiner{
+		joinType: join.GetType(),
+		sides:    [2]string{left, right},
+	}
+}
+
+// ApplyReduce reads all the messages of the window, and returns the merged messages of each key. For an inner join,
+// the keys only having messages from one side are dropped, while for an outer join, they are emitted with a null
+// value of the missing side.
+func (j *Joiner) ApplyReduce(ctx context.Context, partitionID *partition.ID, messageStream <-chan *isb.ReadMessage) ([]*isb.WriteMessage, error) {
+	groups := make(map[string]*group)
+	// order is used to emit the results in the order of the keys first seen
+	var order []string
+readLoop:
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case msg, ok := <-messageStream:
+			if !ok || msg == nil {
+				break readLoop
+			}
+			side := j.sideOf(msg.Origin)
+			if side < 0 {
+				// not from any of the inbound edges, e.g. written by an older version without origin
+				continue
+			}
+			k := strings.Join(msg.Keys, keysDelimiter)
+			g, ok := groups[k]
+			if !ok {
+				g = &group{keys: msg.Keys}
+				groups[k] = g
+				order = append(order, k)
+			}
+			g.messages[side] = append(g.messages[side], msg.Payload)
+		}
+	}
+
+	eventTime := partitionID.End.Add(-wmb.Precision())
+	var results []*isb.WriteMessage
+	for _, k := range order {
+		g := groups[k]
+		left, right := g.messages[0], g.messages[1]
+		if len(left) == 0 || len(right) == 0 {
+			if j.joinType != dfv1.JoinTypeOuter {
+				continue
+			}
+			// a nil payload stands for the missing side
+			if len(left) == 0 {
+				left = [][]byte{nil}
+			} else {
+				right = [][]byte{nil}
+			}
+		}
+		for _, l := range left {
+			for _, r := range right {
+				payload, err := j.merge(l, r)
+				if err != nil {
+					return nil, err
+				}
+				results = append(results, &isb.WriteMessage{
+					Message: isb.Message{
+						Header: isb.Header{
+							MessageInfo: isb.MessageInfo{
+								EventTime: eventTime,
+							},
+							Keys: g.keys,
+						},
+						Body: isb.Body{
+							Payload: payload,
+						},
+					},
+				})
+			}
+		}
+	}
+	return results, nil
+}
+
+// sideOf returns the index of the side of the origin, -1 if it is not any of the sides.
+func (j *Joiner) sideOf(origin string) int {
+	for i, s := range j.sides {
+		if s == origin {
+			return i
+		}
+	}
+	return -1
+}
+
+// merge merges the payloads of the two sides to a JSON object, with the names of the from vertices as the fields.
+// A payload which is a valid JSON is embedded as is, otherwise it is embedded as a string.
+func (j *Joiner) merge(left, right []byte) ([]byte, error) {
+	return json.Marshal(map[string]json.RawMessage{
+		j.sides[0]: toJSONValue(left),
+		j.sides[1]: toJSONValue(right),
+	})
+}
+
+func toJSONValue(payload []byte) json.RawMessage {
+	if payload == nil {
+		return json.RawMessage("null")
+	}
+	if json.Valid(payload) {
+		return payload
+	}
+	b, _ := json.Marshal(string(payload))
+	return b
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package join
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/reduce/pbq/partition"
+)
+
+func buildMessage(origin string, keys []string, payload string) *isb.ReadMessage {
+	return &isb.ReadMessage{
+		Message: isb.Message{
+			Header: isb.Header{
+				MessageInfo: isb.MessageInfo{
+					EventTime: time.UnixMilli(60000),
+					Origin:    origin,
+				},
+				Keys: keys,
+			},
+			Body: isb.Body{Payload: []byte(payload)},
+		},
+	}
+}
+
+func apply(t *testing.T, j *Joiner, messages ...*isb.ReadMessage) []*isb.WriteMessage {
+	ch := make(chan *isb.ReadMessage, len(messages))
+	for _, m := range messages {
+		ch <- m
+	}
+	close(ch)
+	partitionID := &partition.ID{Start: time.UnixMilli(60000), End: time.UnixMilli(120000), Slot: "slot-0"}
+	results, err := j.ApplyReduce(context.Background(), partitionID, ch)
+	assert.NoError(t, err)
+	for _, r := range results {
+		assert.Equal(t, time.UnixMilli(119999), r.EventTime)
+	}
+	return results
+}
+
+func payloads(messages []*isb.WriteMessage) []string {
+	var r []string
+	for _, m := range messages {
+		r = append(r, string(m.Payload))
+	}
+	return r
+}
+
+func TestJoiner_Inner(t *testing.T) {
+	j := NewJoiner(dfv1.Join{}, "orders", "payments")
+	results := apply(t, j,
+		buildMessage("orders", []string{"o1"}, `{"amount":10}`),
+		buildMessage("payments", []string{"o2"}, `{"paid":true}`),
+		buildMessage("payments", []string{"o1"}, `{"paid":true}`),
+		buildMessage("orders", []string{"o3"}, `{"amount":30}`),
+		buildMessage("unknown", []string{"o3"}, `{"paid":false}`),
+	)
+	assert.Len(t, results, 1)
+	assert.Equal(t, []string{"o1"}, results[0].Keys)
+	assert.Equal(t, `{"orders":{"amount":10},"payments":{"paid":true}}`, string(results[0].Payload))
+}
+
+func TestJoiner_InnerCrossProduct(t *testing.T) {
+	j := NewJoiner(dfv1.Join{Type: dfv1.JoinTypeInner}, "a", "b")
+	results := apply(t, j,
+		buildMessage("a", []string{"k"}, "a1"),
+		buildMessage("a", []string{"k"}, "a2"),
+		buildMessage("b", []string{"k"}, "b1"),
+	)
+	assert.Equal(t, []string{`{"a":"a1","b":"b1"}`, `{"a":"a2","b":"b1"}`}, payloads(results))
+}
+
+func TestJoiner_Outer(t *testing.T) {
+	j := NewJoiner(dfv1.Join{Type: dfv1.JoinTypeOuter}, "a", "b")
+	results := apply(t, j,
+		buildMessage("a", []string{"k1"}, "1"),
+		buildMessage("b", []string{"k2"}, "2"),
+		buildMessage("b", []string{"k1"}, "3"),
+	)
+	assert.Equal(t, []string{`{"a":1,"b":3}`, `{"a":null,"b":2}`}, payloads(results))
+	assert.Equal(t, []string{"k1"}, results[0].Keys)
+	assert.Equal(t, []string{"k2"}, results[1].Keys)
+}
+
+func TestJoiner_ContextCanceled(t *testing.T) {
+	j := NewJoiner(dfv1.Join{}, "a", "b")
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := j.ApplyReduce(ctx, &partition.ID{}, make(chan *isb.ReadMessage))
+	assert.ErrorIs(t, err, context.Canceled)
+}
@@ -34,6 +34,10 @@ type Reader interface {
 	ReadCh() <-chan *isb.ReadMessage
 	// GC does garbage collection, it deletes all the persisted data from the store
 	GC() error
+	// MarkEmitted persists that the results have been written to the buffer
+	MarkEmitted(buffer string) error
+	// IsEmitted returns true if the results have been written to the buffer, e.g. before a restart
+	IsEmitted(buffer string) (bool, error)
 }
 
 // WriteCloser provides methods to write data to the PQB and close the PBQ.
@@ -19,6 +19,8 @@ package partition
 import (
 	"fmt"
 	"time"
+
+	"github.com/numaproj/numaflow/pkg/watermark/wmb"
 )
 
 // ID uniquely identifies a partition
@@ -30,5 +32,5 @@ type ID struct {
 }
 
 func (p ID) String() string {
-	return fmt.Sprintf("%v-%v-%s", p.Start.UnixMilli(), p.End.UnixMilli(), p.Slot)
+	return fmt.Sprintf("%v-%v-%s", wmb.Epoch(p.Start), wmb.Epoch(p.End), p.Slot)
 }
@@ -116,6 +116,18 @@ func (p *PBQ) GC() error {
 	return p.manager.deregister(p.PartitionID)
 }
 
+// MarkEmitted persists that the results of the partition have been written to the buffer, the marker is deleted
+// together with the store in GC.
+func (p *PBQ) MarkEmitted(buffer string) error {
+	return p.manager.storeProvider.MarkEmitted(p.PartitionID, buffer)
+}
+
+// IsEmitted returns true if the results of the partition have been written to the buffer, which means the partition
+// is replayed after a crash between the writing and GC.
+func (p *PBQ) IsEmitted(buffer string) (bool, error) {
+	return p.manager.storeProvider.IsEmitted(p.PartitionID, buffer)
+}
+
 // replayRecordsFromStore replays store messages when replay flag is set during start up time. It replays by reading from
 // the store and writing to the PBQ channel.
 func (p *PBQ) replayRecordsFromStore(ctx context.Context) {
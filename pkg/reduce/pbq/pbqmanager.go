@@ -260,6 +260,16 @@ func (m *Manager) deregister(partitionID partition.ID) error {
 	return m.storeProvider.DeleteStore(partitionID)
 }
 
+// SaveWindowEnd persists the extended end time of the window of the partition.
+func (m *Manager) SaveWindowEnd(partitionID partition.ID, end time.Time) error {
+	return m.storeProvider.SaveWindowEnd(partitionID, end)
+}
+
+// GetWindowEnd returns the persisted end time of the window of the partition, or zero if it's never extended.
+func (m *Manager) GetWindowEnd(partitionID partition.ID) (time.Time, error) {
+	return m.storeProvider.GetWindowEnd(partitionID)
+}
+
 func (m *Manager) getPBQs() []*PBQ {
 	m.RLock()
 	defer m.RUnlock()
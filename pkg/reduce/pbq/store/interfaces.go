@@ -18,6 +18,7 @@ package store
 
 import (
 	"context"
+	"time"
 
 	"github.com/numaproj/numaflow/pkg/isb"
 	"github.com/numaproj/numaflow/pkg/reduce/pbq/partition"
@@ -40,6 +41,16 @@ type StoreProvider interface {
 	CreateStore(context.Context, partition.ID) (Store, error)
 	// DiscoverPartitions discovers all the managed partitions.
 	DiscoverPartitions(context.Context) ([]partition.ID, error)
-	// DeleteStore deletes the store
+	// DeleteStore deletes the store, together with the emission markers and the window end of the partition.
 	DeleteStore(partition.ID) error
+	// MarkEmitted persists a marker that the results of the partition have been written to the buffer, so that the
+	// results are not written again if the partition is replayed after a crash before the store is deleted.
+	MarkEmitted(partitionID partition.ID, buffer string) error
+	// IsEmitted returns true if the results of the partition have been written to the buffer.
+	IsEmitted(partitionID partition.ID, buffer string) (bool, error)
+	// SaveWindowEnd persists the end time of the window of the partition when it's extended, e.g. by merging the
+	// custom windows, so that the window is closed at the extended end time if the partition is replayed.
+	SaveWindowEnd(partitionID partition.ID, end time.Time) error
+	// GetWindowEnd returns the persisted end time of the window of the partition, or zero if it's never extended.
+	GetWindowEnd(partitionID partition.ID) (time.Time, error)
 }
@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"sync"
+	"time"
 
 	"github.com/numaproj/numaflow/pkg/isb"
 	"github.com/numaproj/numaflow/pkg/reduce/pbq/partition"
@@ -31,6 +32,8 @@ type memoryStores struct {
 	storeSize    int64
 	discoverFunc func(ctx context.Context) ([]partition.ID, error)
 	partitions   map[partition.ID]*memoryStore
+	emitted      map[partition.ID]map[string]bool
+	windowEnds   map[partition.ID]time.Time
 	sync.RWMutex
 }
 
@@ -38,6 +41,8 @@ func NewMemoryStores(opts ...Option) store.StoreProvider {
 	s := &memoryStores{
 		storeSize:  100000,
 		partitions: make(map[partition.ID]*memoryStore),
+		emitted:    make(map[partition.ID]map[string]bool),
+		windowEnds: make(map[partition.ID]time.Time),
 	}
 
 	for _, o := range opts {
@@ -89,5 +94,36 @@ func (ms *memoryStores) DeleteStore(partitionID partition.ID) error {
 	memStore.storage = nil
 	memStore.writePos = -1
 	delete(ms.partitions, partitionID)
+	delete(ms.emitted, partitionID)
+	delete(ms.windowEnds, partitionID)
 	return nil
 }
+
+func (ms *memoryStores) MarkEmitted(partitionID partition.ID, buffer string) error {
+	ms.Lock()
+	defer ms.Unlock()
+	if _, ok := ms.emitted[partitionID]; !ok {
+		ms.emitted[partitionID] = make(map[string]bool)
+	}
+	ms.emitted[partitionID][buffer] = true
+	return nil
+}
+
+func (ms *memoryStores) IsEmitted(partitionID partition.ID, buffer string) (bool, error) {
+	ms.RLock()
+	defer ms.RUnlock()
+	return ms.emitted[partitionID][buffer], nil
+}
+
+func (ms *memoryStores) SaveWindowEnd(partitionID partition.ID, end time.Time) error {
+	ms.Lock()
+	defer ms.Unlock()
+	ms.windowEnds[partitionID] = end
+	return nil
+}
+
+func (ms *memoryStores) GetWindowEnd(partitionID partition.ID) (time.Time, error) {
+	ms.RLock()
+	defer ms.RUnlock()
+	return ms.windowEnds[partitionID], nil
+}
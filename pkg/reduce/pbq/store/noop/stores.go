@@ -18,6 +18,7 @@ package noop
 
 import (
 	"context"
+	"time"
 
 	"github.com/numaproj/numaflow/pkg/reduce/pbq/partition"
 	"github.com/numaproj/numaflow/pkg/reduce/pbq/store"
@@ -41,3 +42,19 @@ func (ns *noopStores) DiscoverPartitions(ctx context.Context) ([]partition.ID, e
 func (ns *noopStores) DeleteStore(partitionID partition.ID) error {
 	return nil
 }
+
+func (ns *noopStores) MarkEmitted(partitionID partition.ID, buffer string) error {
+	return nil
+}
+
+func (ns *noopStores) IsEmitted(partitionID partition.ID, buffer string) (bool, error) {
+	return false, nil
+}
+
+func (ns *noopStores) SaveWindowEnd(partitionID partition.ID, end time.Time) error {
+	return nil
+}
+
+func (ns *noopStores) GetWindowEnd(partitionID partition.ID) (time.Time, error) {
+	return time.Time{}, nil
+}
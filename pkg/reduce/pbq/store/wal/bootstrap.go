@@ -26,6 +26,7 @@ import (
 
 	"github.com/numaproj/numaflow/pkg/isb"
 	"github.com/numaproj/numaflow/pkg/reduce/pbq/partition"
+	"github.com/numaproj/numaflow/pkg/watermark/wmb"
 )
 
 var location *time.Location
@@ -83,15 +84,25 @@ func decodeWALHeader(buf io.Reader) (*partition.ID, error) {
 		return nil, err
 	}
 	// read the variadic key
-	var key = make([]rune, hp.SLen)
+	var key = make([]rune, hp.SLen&^headerTimeUnitFlag)
 	err = binary.Read(buf, binary.LittleEndian, key)
 	if err != nil {
 		return nil, err
 	}
+	// the epochs can't be read in another time unit, the segments without the time unit are in milliseconds
+	timeUnit := int64(time.Millisecond)
+	if hp.SLen&headerTimeUnitFlag != 0 {
+		if err = binary.Read(buf, binary.LittleEndian, &timeUnit); err != nil {
+			return nil, err
+		}
+	}
+	if err = wmb.CheckTimeUnit(time.Duration(timeUnit)); err != nil {
+		return nil, fmt.Errorf("failed to decode the WAL header of slot %q, %w", string(key), err)
+	}
 
 	return &partition.ID{
-		Start: time.UnixMilli(hp.S).In(location),
-		End:   time.UnixMilli(hp.E).In(location),
+		Start: wmb.FromEpoch(hp.S).In(location),
+		End:   wmb.FromEpoch(hp.E).In(location),
 		Slot:  string(key),
 	}, nil
 }
@@ -147,7 +158,7 @@ func decodeReadMessage(buf io.Reader) (*isb.ReadMessage, int64, error) {
 
 	return &isb.ReadMessage{
 		Message:    *entryBody,
-		Watermark:  time.UnixMilli(entryHeader.WaterMark).In(location),
+		Watermark:  wmb.FromEpoch(entryHeader.WaterMark).In(location),
 		ReadOffset: isb.SimpleIntOffset(func() int64 { return entryHeader.Offset }),
 	}, size, nil
 }
@@ -18,25 +18,41 @@ package wal
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"encoding/binary"
+	"encoding/hex"
 	"fmt"
 	"hash/crc32"
 	"os"
 	"path/filepath"
+	"regexp"
 	"strconv"
 	"time"
 
 	"github.com/numaproj/numaflow/pkg/isb"
 	"github.com/numaproj/numaflow/pkg/metrics"
 	"github.com/numaproj/numaflow/pkg/reduce/pbq/partition"
+	"github.com/numaproj/numaflow/pkg/watermark/wmb"
 )
 
 const (
 	IEEE            = 0xedb88320
 	EntryHeaderSize = 28
 	SegmentPrefix   = "segment"
+	// emittedMarkersDir is the directory of the emission markers of the partitions, under the store path.
+	emittedMarkersDir = "emitted"
+	// windowEndsDir is the directory of the extended window ends of the partitions, under the store path.
+	windowEndsDir = "window-ends"
 )
 
+// headerTimeUnitFlag is set in the slot length of the header if the time unit of the epochs is written after the slot,
+// which is only written if it's not milliseconds, so that the segments in milliseconds are still compatible with the
+// older versions.
+const headerTimeUnitFlag = int16(-1 << 15)
+
+// safeSlotName matches the slots which could be used in the segment file names as they are.
+var safeSlotName = regexp.MustCompile(`^[a-zA-Z0-9._-]{1,64}$`)
+
 // Various errors contained in DNSError.
 var (
 	errChecksumMismatch = fmt.Errorf("data checksum not match")
@@ -134,10 +150,15 @@ func (w *WAL) encodeWALHeader(id *partition.ID) (buf *bytes.Buffer, err error) {
 		}
 	}()
 	buf = new(bytes.Buffer)
+	// the slot is written as runes, so the length is the number of runes rather than bytes
 	hp := walHeaderPreamble{
-		S:    id.Start.UnixMilli(),
-		E:    id.End.UnixMilli(),
-		SLen: int16(len(id.Slot)),
+		S:    wmb.Epoch(id.Start),
+		E:    wmb.Epoch(id.End),
+		SLen: int16(len([]rune(id.Slot))),
+	}
+	timeUnit := wmb.Precision()
+	if timeUnit != time.Millisecond {
+		hp.SLen |= headerTimeUnitFlag
 	}
 
 	// write the fixed values
@@ -148,6 +169,13 @@ func (w *WAL) encodeWALHeader(id *partition.ID) (buf *bytes.Buffer, err error) {
 
 	// write the variadic values
 	err = binary.Write(buf, binary.LittleEndian, []rune(id.Slot))
+	if err != nil {
+		return nil, err
+	}
+
+	if timeUnit != time.Millisecond {
+		err = binary.Write(buf, binary.LittleEndian, int64(timeUnit))
+	}
 
 	return buf, err
 }
@@ -198,7 +226,7 @@ func (w *WAL) encodeWALMessage(message *isb.ReadMessage) (buf *bytes.Buffer, err
 // encodeWALMessageHeader creates the header of the WAL message. The header is mainly for checksum, verifying the
 // correctness of the WALMessage.
 func (w *WAL) encodeWALMessageHeader(message *isb.ReadMessage, messageLen int64, checksum uint32) (*bytes.Buffer, error) {
-	watermark := message.Watermark.UnixMilli()
+	watermark := wmb.Epoch(message.Watermark)
 
 	offset, err := message.ReadOffset.Sequence()
 	if err != nil {
@@ -356,7 +384,23 @@ func (w *WAL) Close() (err error) {
 	return nil
 }
 
+// getSegmentFilePath returns the path of the segment file of the partition. The start and end time are in milliseconds,
+// because the windows closed by triggers (e.g., accumulator windows) are not aligned to seconds. The slots which can't be
+// used in a file name, e.g. the keys of the accumulator windows, are replaced by their hash, the partition is always
+// read from the WAL header rather than the file name.
 func getSegmentFilePath(id *partition.ID, dir string) string {
+	slot := id.Slot
+	if !safeSlotName.MatchString(slot) {
+		h := sha256.Sum256([]byte(slot))
+		slot = "h" + hex.EncodeToString(h[:16])
+	}
+	filename := fmt.Sprintf("%s_%d.%d.%s", SegmentPrefix, wmb.Epoch(id.Start), wmb.Epoch(id.End), slot)
+	return filepath.Join(dir, filename)
+}
+
+// getLegacySegmentFilePath returns the path of the segment file of the partition created by the older versions, which
+// use the start and end time in seconds.
+func getLegacySegmentFilePath(id *partition.ID, dir string) string {
 	filename := fmt.Sprintf("%s_%d.%d.%s", SegmentPrefix, id.Start.Unix(), id.End.Unix(), id.Slot)
 	return filepath.Join(dir, filename)
 }
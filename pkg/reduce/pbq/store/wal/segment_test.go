@@ -29,6 +29,7 @@ import (
 	"github.com/numaproj/numaflow/pkg/isb"
 	"github.com/numaproj/numaflow/pkg/isb/testutils"
 	"github.com/numaproj/numaflow/pkg/reduce/pbq/partition"
+	"github.com/numaproj/numaflow/pkg/watermark/wmb"
 )
 
 var vi = &dfv1.VertexInstance{
@@ -92,6 +93,15 @@ func Test_encodeDecodeHeader(t *testing.T) {
 				Slot:  "test1,test2",
 			},
 		},
+		{
+			name:    "enc_dec_multibyte_slot",
+			wantErr: assert.NoError,
+			id: &partition.ID{
+				Start: time.UnixMilli(1665109020123).In(location),
+				End:   time.UnixMilli(1665109080123).In(location),
+				Slot:  "größe\x00key/2",
+			},
+		},
 		{
 			name:    "enc_dec_nodata",
 			wantErr: assert.NoError,
@@ -122,6 +132,49 @@ func Test_encodeDecodeHeader(t *testing.T) {
 	}
 }
 
+func Test_headerTimeUnit(t *testing.T) {
+	defer wmb.SetPrecision(time.Millisecond)
+	id := partition.ID{
+		Start: time.Unix(1665109020, 123456000).In(location),
+		End:   time.Unix(1665109020, 123456000).Add(time.Minute).In(location),
+		Slot:  "test1",
+	}
+
+	tmp := t.TempDir()
+	stores := NewWALStores(vi, WithStorePath(tmp))
+	wmb.SetPrecision(time.Microsecond)
+	wal, err := stores.CreateStore(context.Background(), id)
+	assert.NoError(t, err)
+	message := testutils.BuildTestReadMessagesIntOffset(1, id.Start)[0]
+	assert.NoError(t, wal.Write(&message))
+	assert.NoError(t, wal.Close())
+
+	// the segment is replayed in the same time unit
+	partitions, err := stores.DiscoverPartitions(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, []partition.ID{id}, partitions)
+	store, err := stores.CreateStore(context.Background(), id)
+	assert.NoError(t, err)
+	actualMessages, finished, err := store.Read(10000)
+	assert.NoError(t, err)
+	assert.True(t, finished)
+	assert.Len(t, actualMessages, 1)
+	assert.Equal(t, message.Message, actualMessages[0].Message)
+	assert.NoError(t, store.Close())
+
+	// the segment in microseconds is not read as milliseconds
+	wmb.SetPrecision(time.Millisecond)
+	_, err = stores.DiscoverPartitions(context.Background())
+	assert.ErrorIs(t, err, wmb.ErrTimeUnitMismatch)
+
+	// the header without the time unit is in milliseconds
+	buf, err := wal.(*WAL).encodeWALHeader(&id)
+	assert.NoError(t, err)
+	wmb.SetPrecision(time.Nanosecond)
+	_, err = decodeWALHeader(buf)
+	assert.ErrorIs(t, err, wmb.ErrTimeUnitMismatch)
+}
+
 func Test_writeReadEntry(t *testing.T) {
 	id := partition.ID{
 		Start: time.Unix(1665109020, 0).In(location),
@@ -23,6 +23,7 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
@@ -40,6 +41,9 @@ type walStores struct {
 	pipelineName string
 	vertexName   string
 	replicaIndex int32
+	// windowEnds caches the persisted window ends by the segment file names, so that an unchanged end isn't rewritten.
+	windowEnds map[string]time.Time
+	lock       sync.Mutex
 }
 
 func NewWALStores(vertexInstance *dfv1.VertexInstance, opts ...Option) store.StoreProvider {
@@ -50,6 +54,7 @@ func NewWALStores(vertexInstance *dfv1.VertexInstance, opts ...Option) store.Sto
 		pipelineName: vertexInstance.Vertex.Spec.PipelineName,
 		vertexName:   vertexInstance.Vertex.Spec.AbstractVertex.Name,
 		replicaIndex: vertexInstance.Replica,
+		windowEnds:   make(map[string]time.Time),
 	}
 	for _, o := range opts {
 		o(s)
@@ -88,7 +93,7 @@ func (ws *walStores) openOrCreateWAL(id *partition.ID) (*WAL, error) {
 		}
 	}()
 
-	filePath := getSegmentFilePath(id, ws.storePath)
+	filePath := ws.segmentFilePath(id)
 	stat, err := os.Stat(filePath)
 
 	var fp *os.File
@@ -166,6 +171,7 @@ func (ws *walStores) DiscoverPartitions(_ context.Context) ([]partition.ID, erro
 		return nil, err
 	}
 	partitions := make([]partition.ID, 0)
+	segments := make(map[string]bool)
 
 	for _, f := range files {
 		if strings.HasPrefix(f.Name(), SegmentPrefix) && !f.IsDir() {
@@ -175,12 +181,125 @@ func (ws *walStores) DiscoverPartitions(_ context.Context) ([]partition.ID, erro
 				return nil, err
 			}
 			partitions = append(partitions, *wal.partitionID)
+			segments[f.Name()] = true
+		}
+	}
+
+	// the markers and the window ends are orphaned if the pod crashed after deleting a segment but before deleting them
+	for _, dir := range []string{emittedMarkersDir, windowEndsDir} {
+		entries, err := os.ReadDir(filepath.Join(ws.storePath, dir))
+		if err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+		for _, e := range entries {
+			if !segments[e.Name()] {
+				if err := os.RemoveAll(filepath.Join(ws.storePath, dir, e.Name())); err != nil {
+					return nil, err
+				}
+			}
 		}
 	}
 
 	return partitions, nil
 }
 
+// MarkEmitted creates an empty marker file named after the buffer in the markers directory of the partition.
+func (ws *walStores) MarkEmitted(partitionID partition.ID, buffer string) error {
+	dir := ws.emittedMarkersPath(&partitionID)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	fp, err := os.OpenFile(filepath.Join(dir, buffer), os.O_WRONLY|os.O_CREATE, 0644)
+	if err != nil {
+		return err
+	}
+	if err = fp.Sync(); err != nil {
+		_ = fp.Close()
+		return err
+	}
+	return fp.Close()
+}
+
+// IsEmitted returns true if the marker file of the buffer exists.
+func (ws *walStores) IsEmitted(partitionID partition.ID, buffer string) (bool, error) {
+	_, err := os.Stat(filepath.Join(ws.emittedMarkersPath(&partitionID), buffer))
+	if err == nil {
+		return true, nil
+	}
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	return false, err
+}
+
+// SaveWindowEnd writes the end time in milliseconds to the window end file of the partition, the file is replaced
+// atomically so that a crash while writing doesn't leave a partial end time.
+func (ws *walStores) SaveWindowEnd(partitionID partition.ID, end time.Time) error {
+	name := filepath.Base(ws.segmentFilePath(&partitionID))
+	ws.lock.Lock()
+	defer ws.lock.Unlock()
+	if saved, ok := ws.windowEnds[name]; ok && saved.Equal(end) {
+		return nil
+	}
+	dir := filepath.Join(ws.storePath, windowEndsDir)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	tmpPath := filepath.Join(dir, name+".tmp")
+	fp, err := os.OpenFile(tmpPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	if _, err = fp.WriteString(strconv.FormatInt(end.UnixMilli(), 10)); err == nil {
+		err = fp.Sync()
+	}
+	if closeErr := fp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	if err = os.Rename(tmpPath, filepath.Join(dir, name)); err != nil {
+		return err
+	}
+	ws.windowEnds[name] = end
+	return nil
+}
+
+// GetWindowEnd reads the end time from the window end file of the partition, it returns zero if there's no such file.
+func (ws *walStores) GetWindowEnd(partitionID partition.ID) (time.Time, error) {
+	name := filepath.Base(ws.segmentFilePath(&partitionID))
+	data, err := os.ReadFile(filepath.Join(ws.storePath, windowEndsDir, name))
+	if os.IsNotExist(err) {
+		return time.Time{}, nil
+	} else if err != nil {
+		return time.Time{}, err
+	}
+	end, err := strconv.ParseInt(string(data), 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid window end %q of partition %s, %w", string(data), partitionID.String(), err)
+	}
+	return time.UnixMilli(end), nil
+}
+
+// emittedMarkersPath returns the directory of the emission markers of the partition, which is named after its segment.
+func (ws *walStores) emittedMarkersPath(id *partition.ID) string {
+	return filepath.Join(ws.storePath, emittedMarkersDir, filepath.Base(ws.segmentFilePath(id)))
+}
+
+// segmentFilePath returns the path of the segment file of the partition, it's the legacy path if the segment was
+// created by an older version and is still there, so that it could be replayed and deleted after upgrading.
+func (ws *walStores) segmentFilePath(id *partition.ID) string {
+	filePath := getSegmentFilePath(id, ws.storePath)
+	if _, err := os.Stat(filePath); os.IsNotExist(err) {
+		legacyPath := getLegacySegmentFilePath(id, ws.storePath)
+		if _, err := os.Stat(legacyPath); err == nil {
+			return legacyPath
+		}
+	}
+	return filePath
+}
+
 // openWAL returns a WAL if present
 func (ws *walStores) openWAL(filePath string) (*WAL, error) {
 	stat, err := os.Stat(filePath)
@@ -222,7 +341,7 @@ func (ws *walStores) DeleteStore(partitionID partition.ID) error {
 		}
 	}()
 
-	filePath := getSegmentFilePath(&partitionID, ws.storePath)
+	filePath := ws.segmentFilePath(&partitionID)
 	_, err = os.Stat(filePath)
 
 	if err != nil {
@@ -230,8 +349,22 @@ func (ws *walStores) DeleteStore(partitionID partition.ID) error {
 	}
 
 	start := time.Now()
+	// the markers and the window end are deleted after the segment, so that the results are not emitted again if the
+	// pod crashes in between, the orphaned ones are deleted when the partitions are discovered.
+	markersPath := ws.emittedMarkersPath(&partitionID)
+	name := filepath.Base(filePath)
+	windowEndPath := filepath.Join(ws.storePath, windowEndsDir, name)
 	// an open file can also be deleted
 	err = os.Remove(filePath)
+	if err == nil {
+		err = os.RemoveAll(markersPath)
+	}
+	if err == nil {
+		err = os.RemoveAll(windowEndPath)
+		ws.lock.Lock()
+		delete(ws.windowEnds, name)
+		ws.lock.Unlock()
+	}
 
 	if err == nil {
 		garbageCollectingTime.With(map[string]string{
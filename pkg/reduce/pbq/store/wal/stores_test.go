@@ -18,6 +18,7 @@ package wal
 
 import (
 	"context"
+	"os"
 	"testing"
 	"time"
 
@@ -83,3 +84,165 @@ func TestWalStores(t *testing.T) {
 
 	assert.Len(t, discoveredPartitions, 0)
 }
+
+func TestWalStores_UnalignedPartitions(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	// the partitions of the windows closed by triggers could start within the same second, and have the keys as slots
+	partitionIds := []partition.ID{
+		{
+			Start: time.UnixMilli(60001),
+			End:   time.UnixMilli(120001),
+			Slot:  "key/1\x00a",
+		},
+		{
+			Start: time.UnixMilli(60002),
+			End:   time.UnixMilli(120002),
+			Slot:  "key/1\x00a",
+		},
+	}
+
+	tmp := t.TempDir()
+	storeProvider := NewWALStores(vi, WithStorePath(tmp))
+	for _, partitionID := range partitionIds {
+		_, err := storeProvider.CreateStore(ctx, partitionID)
+		assert.NoError(t, err)
+	}
+
+	discoveredPartitions, err := storeProvider.DiscoverPartitions(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, discoveredPartitions, len(partitionIds))
+	for _, p := range discoveredPartitions {
+		assert.Equal(t, "key/1\x00a", p.Slot)
+	}
+
+	for _, partitionID := range partitionIds {
+		assert.NoError(t, storeProvider.DeleteStore(partitionID))
+	}
+	discoveredPartitions, err = storeProvider.DiscoverPartitions(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, discoveredPartitions, 0)
+}
+
+func TestWalStores_LegacySegment(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	id := partition.ID{
+		Start: time.Unix(60, 0),
+		End:   time.Unix(120, 0),
+		Slot:  "slot-0",
+	}
+
+	tmp := t.TempDir()
+	storeProvider := NewWALStores(vi, WithStorePath(tmp))
+	s, err := storeProvider.CreateStore(ctx, id)
+	assert.NoError(t, err)
+	assert.NoError(t, s.Close())
+	// rename the segment to the name used by the older versions
+	assert.NoError(t, os.Rename(getSegmentFilePath(&id, tmp), getLegacySegmentFilePath(&id, tmp)))
+
+	s, err = storeProvider.CreateStore(ctx, id)
+	assert.NoError(t, err)
+	assert.Equal(t, getLegacySegmentFilePath(&id, tmp), s.(*WAL).fp.Name())
+	assert.NoError(t, s.Close())
+	assert.NoError(t, storeProvider.DeleteStore(id))
+	_, err = os.Stat(getLegacySegmentFilePath(&id, tmp))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestWalStores_EmittedMarkers(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	id := partition.ID{
+		Start: time.Unix(60, 0),
+		End:   time.Unix(120, 0),
+		Slot:  "slot-0",
+	}
+
+	tmp := t.TempDir()
+	storeProvider := NewWALStores(vi, WithStorePath(tmp))
+	_, err := storeProvider.CreateStore(ctx, id)
+	assert.NoError(t, err)
+
+	emitted, err := storeProvider.IsEmitted(id, "buffer-0")
+	assert.NoError(t, err)
+	assert.False(t, emitted)
+	assert.NoError(t, storeProvider.MarkEmitted(id, "buffer-0"))
+	emitted, err = storeProvider.IsEmitted(id, "buffer-0")
+	assert.NoError(t, err)
+	assert.True(t, emitted)
+	emitted, err = storeProvider.IsEmitted(id, "buffer-1")
+	assert.NoError(t, err)
+	assert.False(t, emitted)
+
+	// the markers are kept for the replayed partitions
+	discoveredPartitions, err := storeProvider.DiscoverPartitions(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, discoveredPartitions, 1)
+	emitted, err = storeProvider.IsEmitted(id, "buffer-0")
+	assert.NoError(t, err)
+	assert.True(t, emitted)
+
+	// the markers are deleted together with the segment
+	assert.NoError(t, storeProvider.DeleteStore(id))
+	emitted, err = storeProvider.IsEmitted(id, "buffer-0")
+	assert.NoError(t, err)
+	assert.False(t, emitted)
+
+	// the orphaned markers are deleted when the partitions are discovered
+	assert.NoError(t, storeProvider.MarkEmitted(id, "buffer-0"))
+	discoveredPartitions, err = storeProvider.DiscoverPartitions(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, discoveredPartitions, 0)
+	emitted, err = storeProvider.IsEmitted(id, "buffer-0")
+	assert.NoError(t, err)
+	assert.False(t, emitted)
+}
+
+func TestWalStores_WindowEnd(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	id := partition.ID{
+		Start: time.Unix(60, 0),
+		End:   time.Unix(120, 0),
+		Slot:  "k1\x00k2",
+	}
+
+	tmp := t.TempDir()
+	storeProvider := NewWALStores(vi, WithStorePath(tmp))
+	_, err := storeProvider.CreateStore(ctx, id)
+	assert.NoError(t, err)
+
+	end, err := storeProvider.GetWindowEnd(id)
+	assert.NoError(t, err)
+	assert.True(t, end.IsZero())
+	assert.NoError(t, storeProvider.SaveWindowEnd(id, time.Unix(150, 0)))
+	assert.NoError(t, storeProvider.SaveWindowEnd(id, time.Unix(180, 0)))
+
+	// the window end is kept for the replayed partitions after a restart
+	storeProvider = NewWALStores(vi, WithStorePath(tmp))
+	discoveredPartitions, err := storeProvider.DiscoverPartitions(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, discoveredPartitions, 1)
+	end, err = storeProvider.GetWindowEnd(id)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(180), end.Unix())
+
+	// the window end is deleted together with the segment
+	assert.NoError(t, storeProvider.DeleteStore(id))
+	end, err = storeProvider.GetWindowEnd(id)
+	assert.NoError(t, err)
+	assert.True(t, end.IsZero())
+
+	// the orphaned window end is deleted when the partitions are discovered
+	assert.NoError(t, storeProvider.SaveWindowEnd(id, time.Unix(180, 0)))
+	end, err = storeProvider.GetWindowEnd(id)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(180), end.Unix())
+	discoveredPartitions, err = storeProvider.DiscoverPartitions(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, discoveredPartitions, 0)
+	end, err = storeProvider.GetWindowEnd(id)
+	assert.NoError(t, err)
+	assert.True(t, end.IsZero())
+}
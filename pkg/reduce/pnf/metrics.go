@@ -58,6 +58,14 @@ var dropBytesCount = promauto.NewCounterVec(prometheus.CounterOpts{
 	Help:      "Total number of Bytes Dropped",
 }, []string{metrics.LabelVertex, metrics.LabelPipeline, metrics.LabelVertexReplicaIndex, metrics.LabelPartitionName})
 
+// duplicateEmissionsSuppressed is used to indicate the number of result messages not written again, since they had
+// been written before the vertex restarted
+var duplicateEmissionsSuppressed = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "reduce_isb_writer",
+	Name:      "duplicate_emissions_suppressed_total",
+	Help:      "Total number of result messages suppressed since they had been written before a restart",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline, metrics.LabelVertexReplicaIndex, metrics.LabelPartitionName})
+
 // platformError is used to indicate the number of Internal/Platform errors
 var platformError = promauto.NewCounterVec(prometheus.CounterOpts{
 	Subsystem: "reduce_pnf",
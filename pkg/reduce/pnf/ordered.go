@@ -37,6 +37,7 @@ import (
 	"github.com/numaproj/numaflow/pkg/reduce/pbq/partition"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 	"github.com/numaproj/numaflow/pkg/watermark/publish"
+	"github.com/numaproj/numaflow/pkg/window"
 )
 
 var retryDelay = 1 * time.Second
@@ -63,7 +64,9 @@ type OrderedProcessor struct {
 	whereToDecider      forward.ToWhichStepDecider
 	watermarkPublishers map[string]publish.Publisher
 	idleManager         *wmb.IdleManager
-	log                 *zap.SugaredLogger
+	// originRequired stores the names of the downstream vertices which need to know the origin of the messages.
+	originRequired map[string]bool
+	log            *zap.SugaredLogger
 }
 
 // NewOrderedProcessor returns an OrderedProcessor.
@@ -88,6 +91,7 @@ func NewOrderedProcessor(ctx context.Context,
 		whereToDecider:      whereToDecider,
 		watermarkPublishers: watermarkPublishers,
 		idleManager:         idleManager,
+		originRequired:      forward.OriginRequiredVertices(vertexInstance.Vertex),
 		log:                 logging.FromContext(ctx),
 	}
 
@@ -105,11 +109,13 @@ func (op *OrderedProcessor) InsertTask(t *ForwardTask) {
 // SchedulePnF creates and schedules the PnF routine.
 func (op *OrderedProcessor) SchedulePnF(
 	ctx context.Context,
-	partitionID partition.ID) *ForwardTask {
+	partitionID partition.ID,
+	kw window.AlignedWindower) *ForwardTask {
 
 	pbq := op.pbqManager.GetPBQ(partitionID)
 
-	pf := newProcessAndForward(ctx, op.vertexName, op.pipelineName, op.vertexReplica, partitionID, op.udf, pbq, op.toBuffers, op.whereToDecider, op.watermarkPublishers, op.idleManager)
+	pf := newProcessAndForward(ctx, op.vertexName, op.pipelineName, op.vertexReplica, partitionID, kw, op.udf, pbq, op.toBuffers, op.whereToDecider, op.watermarkPublishers, op.idleManager)
+	pf.originRequired = op.originRequired
 
 	doneCh := make(chan struct{})
 	t := &ForwardTask{
@@ -41,7 +41,7 @@ import (
 type myForwardTest struct {
 }
 
-func (f myForwardTest) WhereTo(_ []string, _ []string) ([]forward.VertexBuffer, error) {
+func (f myForwardTest) WhereTo(_ []string, _ []string, _ string, _ map[string]string) ([]forward.VertexBuffer, error) {
 	return []forward.VertexBuffer{}, nil
 }
 
@@ -132,7 +132,7 @@ func TestOrderedProcessing(t *testing.T) {
 				kw.AddSlot(_partition.Slot)
 
 				_, _ = pbqManager.CreateNewPBQ(ctx, _partition, kw)
-				t := op.SchedulePnF(cCtx, _partition)
+				t := op.SchedulePnF(cCtx, _partition, kw)
 				op.InsertTask(t)
 			}
 			assert.Equal(t, op.taskQueue.Len(), tt.expectedBefore)
@@ -42,6 +42,7 @@ import (
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 	"github.com/numaproj/numaflow/pkg/watermark/publish"
 	"github.com/numaproj/numaflow/pkg/watermark/wmb"
+	"github.com/numaproj/numaflow/pkg/window"
 )
 
 // processAndForward reads messages from pbq, invokes udf using grpc, forwards the results to ISB, and then publishes
@@ -51,6 +52,7 @@ type processAndForward struct {
 	pipelineName   string
 	vertexReplica  int32
 	PartitionID    partition.ID
+	window         window.AlignedWindower
 	UDF            applier.ReduceApplier
 	writeMessages  []*isb.WriteMessage
 	pbqReader      pbq.Reader
@@ -59,6 +61,8 @@ type processAndForward struct {
 	whereToDecider forward.ToWhichStepDecider
 	wmPublishers   map[string]publish.Publisher
 	idleManager    *wmb.IdleManager
+	// originRequired stores the names of the downstream vertices which need to know the origin of the messages.
+	originRequired map[string]bool
 }
 
 // newProcessAndForward will return a new processAndForward instance
@@ -67,6 +71,7 @@ func newProcessAndForward(ctx context.Context,
 	pipelineName string,
 	vr int32,
 	partitionID partition.ID,
+	kw window.AlignedWindower,
 	udf applier.ReduceApplier,
 	pbqReader pbq.Reader,
 	toBuffers map[string][]isb.BufferWriter,
@@ -79,6 +84,7 @@ func newProcessAndForward(ctx context.Context,
 		pipelineName:   pipelineName,
 		vertexReplica:  vr,
 		PartitionID:    partitionID,
+		window:         kw,
 		UDF:            udf,
 		pbqReader:      pbqReader,
 		log:            logging.FromContext(ctx),
@@ -93,11 +99,13 @@ func newProcessAndForward(ctx context.Context,
 func (p *processAndForward) Process(ctx context.Context) error {
 	var err error
 	startTime := time.Now()
-	defer reduceProcessTime.With(map[string]string{
-		metrics.LabelVertex:             p.vertexName,
-		metrics.LabelPipeline:           p.pipelineName,
-		metrics.LabelVertexReplicaIndex: strconv.Itoa(int(p.vertexReplica)),
-	}).Observe(float64(time.Since(startTime).Milliseconds()))
+	defer func() {
+		reduceProcessTime.With(map[string]string{
+			metrics.LabelVertex:             p.vertexName,
+			metrics.LabelPipeline:           p.pipelineName,
+			metrics.LabelVertexReplicaIndex: strconv.Itoa(int(p.vertexReplica)),
+		}).Observe(float64(time.Since(startTime).Milliseconds()))
+	}()
 
 	// blocking call, only returns the writeMessages after it has read all the messages from pbq
 	p.writeMessages, err = p.UDF.ApplyReduce(ctx, &p.PartitionID, p.pbqReader.ReadCh())
@@ -108,14 +116,27 @@ func (p *processAndForward) Process(ctx context.Context) error {
 func (p *processAndForward) Forward(ctx context.Context) error {
 	// extract window end time from the partitionID, which will be used for watermark
 	startTime := time.Now()
-	defer reduceForwardTime.With(map[string]string{
-		metrics.LabelVertex:             p.vertexName,
-		metrics.LabelPipeline:           p.pipelineName,
-		metrics.LabelVertexReplicaIndex: strconv.Itoa(int(p.vertexReplica)),
-	}).Observe(float64(time.Since(startTime).Microseconds()))
+	defer func() {
+		reduceForwardTime.With(map[string]string{
+			metrics.LabelVertex:             p.vertexName,
+			metrics.LabelPipeline:           p.pipelineName,
+			metrics.LabelVertexReplicaIndex: strconv.Itoa(int(p.vertexReplica)),
+		}).Observe(float64(time.Since(startTime).Microseconds()))
+	}()
+
+	// the end time of a window could be extended by merging after its partitions are created (e.g., custom windows),
+	// or be the trigger point of a window closed before its end (e.g., accumulator windows), the results and the
+	// watermark should follow the actual end time.
+	windowEnd := p.PartitionID.End
+	if p.window != nil && !p.window.EndTime().Equal(windowEnd) {
+		windowEnd = p.window.EndTime()
+		for _, msg := range p.writeMessages {
+			msg.EventTime = windowEnd.Add(-wmb.Precision())
+		}
+	}
 
-	// millisecond is the lowest granularity currently supported.
-	processorWM := wmb.Watermark(p.PartitionID.End.Add(-1 * time.Millisecond))
+	// the precision of the watermarks is the lowest granularity supported.
+	processorWM := wmb.Watermark(windowEnd.Add(-wmb.Precision()))
 
 	messagesToStep := p.whereToStep()
 
@@ -137,12 +158,16 @@ func (p *processAndForward) Forward(ctx context.Context) error {
 			wg.Add(1)
 			go func(toVertexName string, toVertexPartitionIdx int32, resultMessages []isb.Message) {
 				defer wg.Done()
+				if p.isEmitted(toVertexName, toVertexPartitionIdx, resultMessages) {
+					return
+				}
 				offsets, ctxClosedErr := p.writeToBuffer(ctx, toVertexName, toVertexPartitionIdx, resultMessages)
 				if ctxClosedErr != nil {
 					success = false
 					p.log.Errorw("Context closed while waiting to write the message to ISB", zap.Error(ctxClosedErr), zap.Any("partitionID", p.PartitionID))
 					return
 				}
+				p.markEmitted(toVertexName, toVertexPartitionIdx)
 				mu.Lock()
 				// TODO: do we need lock? isn't each buffer isolated since we do sequential per ISB?
 				writeOffsets[toVertexName][toVertexPartitionIdx] = offsets
@@ -167,6 +192,36 @@ func (p *processAndForward) Forward(ctx context.Context) error {
 	return nil
 }
 
+// isEmitted returns true if the results have been written to the buffer partition before the vertex restarted, in
+// which case they are not written again.
+func (p *processAndForward) isEmitted(toVertexName string, toVertexPartitionIdx int32, resultMessages []isb.Message) bool {
+	bufferName := p.toBuffers[toVertexName][toVertexPartitionIdx].GetName()
+	emitted, err := p.pbqReader.IsEmitted(bufferName)
+	if err != nil {
+		// the results could be emitted twice, but never lost
+		p.log.Errorw("Failed to check the emission marker, writing the results", zap.String("bufferName", bufferName), zap.Error(err), zap.Any("partitionID", p.PartitionID))
+		return false
+	}
+	if emitted {
+		p.log.Infow("Results have been written before, skipping", zap.String("bufferName", bufferName), zap.Any("partitionID", p.PartitionID))
+		duplicateEmissionsSuppressed.With(map[string]string{
+			metrics.LabelVertex:             p.vertexName,
+			metrics.LabelPipeline:           p.pipelineName,
+			metrics.LabelVertexReplicaIndex: strconv.Itoa(int(p.vertexReplica)),
+			metrics.LabelPartitionName:      bufferName,
+		}).Add(float64(len(resultMessages)))
+	}
+	return emitted
+}
+
+// markEmitted persists that the results have been written to the buffer partition.
+func (p *processAndForward) markEmitted(toVertexName string, toVertexPartitionIdx int32) {
+	bufferName := p.toBuffers[toVertexName][toVertexPartitionIdx].GetName()
+	if err := p.pbqReader.MarkEmitted(bufferName); err != nil {
+		p.log.Errorw("Failed to mark the results emitted", zap.String("bufferName", bufferName), zap.Error(err), zap.Any("partitionID", p.PartitionID))
+	}
+}
+
 // whereToStep assigns a message to the ISBs based on the Message.Keys.
 func (p *processAndForward) whereToStep() map[string][][]isb.Message {
 	// writer doesn't accept array of pointers
@@ -175,7 +230,7 @@ func (p *processAndForward) whereToStep() map[string][][]isb.Message {
 	var to []forward.VertexBuffer
 	var err error
 	for _, msg := range p.writeMessages {
-		to, err = p.whereToDecider.WhereTo(msg.Keys, msg.Tags)
+		to, err = p.whereToDecider.WhereTo(msg.Keys, msg.Tags, msg.SchemaVersion, msg.Headers)
 		if err != nil {
 			platformError.With(map[string]string{
 				metrics.LabelVertex:             p.vertexName,
@@ -194,7 +249,11 @@ func (p *processAndForward) whereToStep() map[string][][]isb.Message {
 			if _, ok := messagesToStep[step.ToVertexName]; !ok {
 				messagesToStep[step.ToVertexName] = make([][]isb.Message, len(p.toBuffers[step.ToVertexName]))
 			}
-			messagesToStep[step.ToVertexName][step.ToVertexPartitionIdx] = append(messagesToStep[step.ToVertexName][step.ToVertexPartitionIdx], msg.Message)
+			message := msg.Message
+			if p.originRequired[step.ToVertexName] {
+				message.Origin = p.vertexName
+			}
+			messagesToStep[step.ToVertexName][step.ToVertexPartitionIdx] = append(messagesToStep[step.ToVertexName][step.ToVertexPartitionIdx], message)
 		}
 
 	}
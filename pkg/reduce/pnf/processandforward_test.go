@@ -63,7 +63,7 @@ type forwardTest struct {
 	buffers []string
 }
 
-func (f *forwardTest) WhereTo(keys []string, _ []string) ([]forward.VertexBuffer, error) {
+func (f *forwardTest) WhereTo(keys []string, _ []string, _ string, _ map[string]string) ([]forward.VertexBuffer, error) {
 	if strings.Compare(keys[len(keys)-1], "test-forward-one") == 0 {
 		return []forward.VertexBuffer{{
 			ToVertexName:         "buffer1",
@@ -173,7 +173,7 @@ func TestProcessAndForward_Process(t *testing.T) {
 	_, publishWatermark := generic.BuildNoOpWatermarkProgressorsFromBufferMap(make(map[string][]isb.BufferWriter))
 
 	// create pf using key and reducer
-	pf := newProcessAndForward(ctx, "reduce", "test-pipeline", 0, testPartition, client, simplePbq, make(map[string][]isb.BufferWriter, 1), &forwardTest{}, publishWatermark, wmb.NewIdleManager(1))
+	pf := newProcessAndForward(ctx, "reduce", "test-pipeline", 0, testPartition, keyed.NewKeyedWindow(testPartition.Start, testPartition.End), client, simplePbq, make(map[string][]isb.BufferWriter, 1), &forwardTest{}, publishWatermark, wmb.NewIdleManager(1))
 
 	err = pf.Process(ctx)
 	assert.NoError(t, err)
@@ -348,6 +348,71 @@ func TestProcessAndForward_Forward(t *testing.T) {
 }
 
 // TestWriteToBuffer tests two BufferFullWritingStrategies: 1. discarding the latest message and 2. retrying writing until context is cancelled.
+func TestProcessAndForward_ForwardExtendedWindow(t *testing.T) {
+	ctx := context.Background()
+	pbqManager, _ := pbq.NewManager(ctx, "reduce", "test-pipeline", 0, memory.NewMemoryStores())
+	buffer := simplebuffer.NewInMemoryBuffer("buffer1-1", 10, 0)
+	toBuffers := map[string][]isb.BufferWriter{
+		"buffer1": {buffer},
+	}
+	pf, _ := createProcessAndForwardAndOTStore(ctx, "test-forward-one", pbqManager, toBuffers)
+	// the window is extended by merging after the partition is created
+	pf.window = keyed.NewKeyedWindow(time.UnixMilli(60000), time.UnixMilli(180000))
+
+	err := pf.Forward(ctx)
+	assert.NoError(t, err)
+	msgs, err := buffer.Read(ctx, 1)
+	assert.NoError(t, err)
+	assert.Len(t, msgs, 1)
+	assert.Equal(t, int64(179999), msgs[0].EventTime.UnixMilli())
+}
+
+func TestProcessAndForward_ForwardEmitted(t *testing.T) {
+	ctx := context.Background()
+	pbqManager, _ := pbq.NewManager(ctx, "reduce", "test-pipeline", 0, memory.NewMemoryStores())
+	buffer1 := simplebuffer.NewInMemoryBuffer("buffer1-1", 10, 0)
+	buffer2 := simplebuffer.NewInMemoryBuffer("buffer2-1", 10, 0)
+	toBuffers := map[string][]isb.BufferWriter{
+		"buffer1": {buffer1},
+		"buffer2": {buffer2},
+	}
+	pf, _ := createProcessAndForwardAndOTStore(ctx, "test-forward-all", pbqManager, toBuffers)
+	// the results were written to buffer1 before a crash
+	assert.NoError(t, pf.pbqReader.MarkEmitted("buffer1-1"))
+
+	err := pf.Forward(ctx)
+	assert.NoError(t, err)
+	msgs, err := buffer1.Read(ctx, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, isb.WMB, msgs[0].Header.Kind)
+	msgs, err = buffer2.Read(ctx, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, isb.Data, msgs[0].Header.Kind)
+	// the markers are deleted together with the pbq
+	emitted, err := pf.pbqReader.IsEmitted("buffer1-1")
+	assert.NoError(t, err)
+	assert.False(t, emitted)
+}
+
+func TestProcessAndForward_ForwardTriggeredWindow(t *testing.T) {
+	ctx := context.Background()
+	pbqManager, _ := pbq.NewManager(ctx, "reduce", "test-pipeline", 0, memory.NewMemoryStores())
+	buffer := simplebuffer.NewInMemoryBuffer("buffer1-1", 10, 0)
+	toBuffers := map[string][]isb.BufferWriter{
+		"buffer1": {buffer},
+	}
+	pf, _ := createProcessAndForwardAndOTStore(ctx, "test-forward-one", pbqManager, toBuffers)
+	// the window is closed by a trigger before the end of its partition
+	pf.window = keyed.NewKeyedWindow(time.UnixMilli(60000), time.UnixMilli(90000))
+
+	err := pf.Forward(ctx)
+	assert.NoError(t, err)
+	msgs, err := buffer.Read(ctx, 1)
+	assert.NoError(t, err)
+	assert.Len(t, msgs, 1)
+	assert.Equal(t, int64(89999), msgs[0].EventTime.UnixMilli())
+}
+
 func TestWriteToBuffer(t *testing.T) {
 	tests := []struct {
 		name       string
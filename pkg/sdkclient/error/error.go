@@ -18,6 +18,8 @@ package error
 
 import (
 	"fmt"
+
+	"google.golang.org/grpc/codes"
 )
 
 // ErrKind represents if the error is retryable
@@ -45,12 +47,19 @@ func (ek ErrKind) String() string {
 // UDFError is returned to the main numaflow indicates the status of the error
 type UDFError struct {
 	errKind    ErrKind
+	errCode    codes.Code
 	errMessage string
 }
 
 func New(kind ErrKind, msg string) *UDFError {
+	return NewWithCode(kind, codes.Unknown, msg)
+}
+
+// NewWithCode returns a UDFError with the gRPC status code returned by the UDF.
+func NewWithCode(kind ErrKind, code codes.Code, msg string) *UDFError {
 	return &UDFError{
 		errKind:    kind,
+		errCode:    code,
 		errMessage: msg,
 	}
 }
@@ -67,6 +76,11 @@ func (e *UDFError) ErrorMessage() string {
 	return e.errMessage
 }
 
+// Code returns the gRPC status code returned by the UDF.
+func (e *UDFError) Code() codes.Code {
+	return e.errCode
+}
+
 // FromError gets error information from the UDFError
 func FromError(err error) (udfErr *UDFError, ok bool) {
 	if err == nil {
@@ -76,7 +90,11 @@ func FromError(err error) (udfErr *UDFError, ok bool) {
 		ErrorKind() ErrKind
 		ErrorMessage() string
 	}); ok {
-		return &UDFError{se.ErrorKind(), se.ErrorMessage()}, true
+		code := codes.Unknown
+		if c, ok := err.(interface{ Code() codes.Code }); ok {
+			code = c.Code()
+		}
+		return &UDFError{se.ErrorKind(), code, se.ErrorMessage()}, true
 	}
-	return &UDFError{Unknown, err.Error()}, false
+	return &UDFError{Unknown, codes.Unknown, err.Error()}, false
 }
@@ -20,6 +20,8 @@ import (
 	"fmt"
 	"reflect"
 	"testing"
+
+	"google.golang.org/grpc/codes"
 )
 
 func TestErrKind_String(t *testing.T) {
@@ -76,7 +78,7 @@ func TestFromError(t *testing.T) {
 					errMessage: "Retryable Error",
 				},
 			},
-			want:   &UDFError{Retryable, "Retryable Error"},
+			want:   &UDFError{Retryable, codes.OK, "Retryable Error"},
 			wantOk: true,
 		},
 		{
@@ -87,7 +89,7 @@ func TestFromError(t *testing.T) {
 					errMessage: "NonRetryable Error",
 				},
 			},
-			want:   &UDFError{NonRetryable, "NonRetryable Error"},
+			want:   &UDFError{NonRetryable, codes.OK, "NonRetryable Error"},
 			wantOk: true,
 		},
 		{
@@ -98,7 +100,15 @@ func TestFromError(t *testing.T) {
 					errMessage: "Unknown Error",
 				},
 			},
-			want:   &UDFError{Unknown, "Unknown Error"},
+			want:   &UDFError{Unknown, codes.OK, "Unknown Error"},
+			wantOk: true,
+		},
+		{
+			name: "good_with_code",
+			args: args{
+				err: NewWithCode(Retryable, codes.Unavailable, "Unavailable Error"),
+			},
+			want:   &UDFError{Retryable, codes.Unavailable, "Unavailable Error"},
 			wantOk: true,
 		},
 		{
@@ -106,7 +116,7 @@ func TestFromError(t *testing.T) {
 			args: args{
 				err: fmt.Errorf("not a standard error"),
 			},
-			want:   &UDFError{Unknown, "not a standard error"},
+			want:   &UDFError{Unknown, codes.Unknown, "not a standard error"},
 			wantOk: false,
 		},
 	}
@@ -18,7 +18,9 @@ package mapper
 
 import (
 	"context"
+	"errors"
 	"log"
+	"sync/atomic"
 	"time"
 
 	mappb "github.com/numaproj/numaflow-go/pkg/apis/proto/map/v1"
@@ -28,13 +30,16 @@ import (
 
 	"github.com/numaproj/numaflow-go/pkg/info"
 
+	"github.com/numaproj/numaflow/pkg/sdkclient"
 	"github.com/numaproj/numaflow/pkg/shared/util"
 )
 
-// client contains the grpc connection and the grpc client.
+// client contains a pool of grpc connections and the grpc clients.
 type client struct {
-	conn    *grpc.ClientConn
-	grpcClt mappb.MapClient
+	conns       []*grpc.ClientConn
+	grpcClts    []mappb.MapClient
+	next        atomic.Uint32
+	sizeChecker *sdkclient.MessageSizeChecker
 }
 
 // New creates a new client object.
@@ -45,64 +50,106 @@ func New(inputOptions ...Option) (Client, error) {
 		tcpSockAddr:                shared.TcpAddr,
 		udsSockAddr:                shared.MapAddr,
 		serverInfoReadinessTimeout: 120 * time.Second, // Default timeout is 120 seconds
+		connectionPoolSize:         1,
 	}
 
 	for _, inputOption := range inputOptions {
 		inputOption(opts)
 	}
 
-	// Wait for server info to be ready
-	serverInfo, err := util.WaitForServerInfo(opts.serverInfoReadinessTimeout, opts.serverInfoFilePath)
-	if err != nil {
-		return nil, err
+	var serverInfo *info.ServerInfo
+	if opts.remoteEndpoint == "" {
+		// Wait for server info to be ready
+		var err error
+		serverInfo, err = util.WaitForServerInfo(opts.serverInfoReadinessTimeout, opts.serverInfoFilePath)
+		if err != nil {
+			return nil, err
+		}
+
+		if serverInfo != nil {
+			log.Printf("ServerInfo: %v\n", serverInfo)
+		}
+		opts.maxMessageSize = util.NegotiateMaxMessageSize(serverInfo, opts.maxMessageSize)
 	}
 
-	if serverInfo != nil {
-		log.Printf("ServerInfo: %v\n", serverInfo)
+	if opts.connectionPoolSize < 1 {
+		opts.connectionPoolSize = 1
 	}
 
 	// Connect to the server
-	conn, err := util.ConnectToServer(opts.udsSockAddr, opts.tcpSockAddr, serverInfo, opts.maxMessageSize)
-	if err != nil {
-		return nil, err
-	}
-
 	c := new(client)
-	c.conn = conn
-	c.grpcClt = mappb.NewMapClient(conn)
+	for i := 0; i < opts.connectionPoolSize; i++ {
+		conn, err := connect(opts, serverInfo,
+			util.GRPCMethod{Service: mappb.Map_ServiceDesc.ServiceName, Method: "MapFn", Idempotent: true},
+			util.GRPCMethod{Service: mappb.Map_ServiceDesc.ServiceName, Method: "IsReady", Idempotent: true})
+		if err != nil {
+			_ = c.CloseConn(context.Background())
+			return nil, err
+		}
+		c.conns = append(c.conns, conn)
+		c.grpcClts = append(c.grpcClts, mappb.NewMapClient(conn))
+	}
+	c.sizeChecker = sdkclient.NewMessageSizeChecker(mappb.Map_ServiceDesc.ServiceName, opts.maxMessageSize)
 	return c, nil
 }
 
+// connect connects to the remote server if the remote endpoint is specified, otherwise to the sidecar container.
+func connect(opts *options, serverInfo *info.ServerInfo, methods ...util.GRPCMethod) (*grpc.ClientConn, error) {
+	if opts.remoteEndpoint != "" {
+		return util.ConnectToRemoteServer(opts.remoteEndpoint, opts.remoteTLSConfig, opts.maxMessageSize, opts.callPolicy, methods...)
+	}
+	return util.ConnectToServer(opts.udsSockAddr, opts.tcpSockAddr, serverInfo, opts.maxMessageSize, opts.callPolicy, methods...)
+}
+
 // NewFromClient creates a new client object from a grpc client. This is used for testing.
 func NewFromClient(c mappb.MapClient) (Client, error) {
 	return &client{
-		grpcClt: c,
+		grpcClts: []mappb.MapClient{c},
 	}, nil
 }
 
-// CloseConn closes the grpc client connection.
+// CloseConn closes all the grpc client connections.
 func (c *client) CloseConn(ctx context.Context) error {
-	if c.conn == nil {
-		return nil
+	var errs []error
+	for _, conn := range c.conns {
+		if err := conn.Close(); err != nil {
+			errs = append(errs, err)
+		}
 	}
-	return c.conn.Close()
+	return errors.Join(errs...)
 }
 
-// IsReady returns true if the grpc connection is ready to use.
+// IsReady returns true if all the grpc connections are ready to use.
 func (c *client) IsReady(ctx context.Context, in *emptypb.Empty) (bool, error) {
-	resp, err := c.grpcClt.IsReady(ctx, in)
-	if err != nil {
-		return false, err
+	for _, grpcClt := range c.grpcClts {
+		resp, err := grpcClt.IsReady(ctx, in)
+		if err != nil {
+			return false, err
+		}
+		if !resp.GetReady() {
+			return false, nil
+		}
 	}
-	return resp.GetReady(), nil
+	return true, nil
 }
 
 // MapFn applies a function to each datum element.
 func (c *client) MapFn(ctx context.Context, request *mappb.MapRequest) (*mappb.MapResponse, error) {
-	mapResponse, err := c.grpcClt.MapFn(ctx, request)
+	if err := c.sizeChecker.Check(request); err != nil {
+		return nil, err
+	}
+	mapResponse, err := c.nextClient().MapFn(ctx, request)
 	err = util.ToUDFErr("c.grpcClt.SourceTransformFn", err)
 	if err != nil {
 		return nil, err
 	}
 	return mapResponse, nil
 }
+
+// nextClient returns the grpc clients in a round-robin manner.
+func (c *client) nextClient() mappb.MapClient {
+	if len(c.grpcClts) == 1 {
+		return c.grpcClts[0]
+	}
+	return c.grpcClts[int(c.next.Add(1)-1)%len(c.grpcClts)]
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mapper
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	mappb "github.com/numaproj/numaflow-go/pkg/apis/proto/map/v1"
+	"github.com/numaproj/numaflow-go/pkg/apis/proto/map/v1/mapmock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type readyMapServer struct {
+	mappb.UnimplementedMapServer
+}
+
+func (readyMapServer) IsReady(context.Context, *emptypb.Empty) (*mappb.ReadyResponse, error) {
+	return &mappb.ReadyResponse{Ready: true}, nil
+}
+
+func TestNew_RemoteEndpoint(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	server := grpc.NewServer()
+	mappb.RegisterMapServer(server, readyMapServer{})
+	go func() { _ = server.Serve(lis) }()
+	defer server.Stop()
+
+	// no server info file is waited for a remote udf
+	c, err := New(WithRemoteEndpoint(lis.Addr().String(), nil), WithServerInfoFilePath("/not/existing"), WithServerInfoReadinessTimeout(time.Second))
+	assert.NoError(t, err)
+	defer func() { _ = c.CloseConn(context.Background()) }()
+	ready, err := c.IsReady(context.Background(), &emptypb.Empty{})
+	assert.NoError(t, err)
+	assert.True(t, ready)
+}
+
+func TestIsReady_Pool(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient1 := mapmock.NewMockMapClient(ctrl)
+	mockClient2 := mapmock.NewMockMapClient(ctrl)
+	mockClient1.EXPECT().IsReady(gomock.Any(), gomock.Any()).Return(&mappb.ReadyResponse{Ready: true}, nil).Times(2)
+	mockClient2.EXPECT().IsReady(gomock.Any(), gomock.Any()).Return(&mappb.ReadyResponse{Ready: true}, nil)
+	mockClient2.EXPECT().IsReady(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("mock connection refused"))
+
+	testClient := &client{grpcClts: []mappb.MapClient{mockClient1, mockClient2}}
+	ready, err := testClient.IsReady(ctx, &emptypb.Empty{})
+	assert.NoError(t, err)
+	assert.True(t, ready)
+
+	ready, err = testClient.IsReady(ctx, &emptypb.Empty{})
+	assert.EqualError(t, err, "mock connection refused")
+	assert.False(t, ready)
+}
+
+func TestMapFn_RoundRobin(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient1 := mapmock.NewMockMapClient(ctrl)
+	mockClient2 := mapmock.NewMockMapClient(ctrl)
+	mockClient1.EXPECT().MapFn(gomock.Any(), gomock.Any()).Return(&mappb.MapResponse{Results: []*mappb.MapResponse_Result{{Value: []byte("1")}}}, nil).Times(2)
+	mockClient2.EXPECT().MapFn(gomock.Any(), gomock.Any()).Return(&mappb.MapResponse{Results: []*mappb.MapResponse_Result{{Value: []byte("2")}}}, nil).Times(2)
+
+	testClient := &client{grpcClts: []mappb.MapClient{mockClient1, mockClient2}}
+	var got []string
+	for i := 0; i < 4; i++ {
+		resp, err := testClient.MapFn(ctx, &mappb.MapRequest{Value: []byte("test")})
+		assert.NoError(t, err)
+		got = append(got, string(resp.GetResults()[0].GetValue()))
+	}
+	assert.Equal(t, []string{"1", "2", "1", "2"}, got)
+}
@@ -16,7 +16,16 @@ limitations under the License.
 
 package mapper
 
-import "time"
+import (
+	"crypto/tls"
+	"path"
+	"time"
+
+	"github.com/numaproj/numaflow-go/pkg/info"
+	"github.com/numaproj/numaflow-go/pkg/shared"
+
+	"github.com/numaproj/numaflow/pkg/shared/util"
+)
 
 type options struct {
 	tcpSockAddr                string
@@ -24,6 +33,10 @@ type options struct {
 	maxMessageSize             int
 	serverInfoFilePath         string
 	serverInfoReadinessTimeout time.Duration
+	callPolicy                 *util.GRPCCallPolicy
+	remoteEndpoint             string
+	remoteTLSConfig            *tls.Config
+	connectionPoolSize         int
 }
 
 // Option is the interface to apply options.
@@ -57,9 +70,41 @@ func WithServerInfoFilePath(f string) Option {
 	}
 }
 
+// WithRuntimeDir connects to the map UDF serving the socket and the server info file in the given directory instead
+// of the default one, e.g. a UDF container run locally with the directory mounted.
+func WithRuntimeDir(dir string) Option {
+	return func(o *options) {
+		o.udsSockAddr = path.Join(dir, path.Base(shared.MapAddr))
+		o.serverInfoFilePath = path.Join(dir, path.Base(info.ServerInfoFilePath))
+	}
+}
+
 // WithServerInfoReadinessTimeout sets the server info readiness timeout to the given timeout.
 func WithServerInfoReadinessTimeout(t time.Duration) Option {
 	return func(o *options) {
 		o.serverInfoReadinessTimeout = t
 	}
 }
+
+// WithCallPolicy sets the keepalive, retry and hedging policies of the gRPC connection.
+func WithCallPolicy(p *util.GRPCCallPolicy) Option {
+	return func(o *options) {
+		o.callPolicy = p
+	}
+}
+
+// WithConnectionPoolSize sets the number of gRPC connections to the server, the map requests are dispatched to the connections in a round-robin manner.
+func WithConnectionPoolSize(size int) Option {
+	return func(o *options) {
+		o.connectionPoolSize = size
+	}
+}
+
+// WithRemoteEndpoint connects the client to a UDF served remotely at the given "host:port" endpoint instead of the
+// sidecar container, using TLS if the TLS config is not nil. The server info file is not waited for in this case.
+func WithRemoteEndpoint(endpoint string, tlsConfig *tls.Config) Option {
+	return func(o *options) {
+		o.remoteEndpoint = endpoint
+		o.remoteTLSConfig = tlsConfig
+	}
+}
@@ -30,13 +30,15 @@ import (
 
 	"github.com/numaproj/numaflow-go/pkg/info"
 
+	"github.com/numaproj/numaflow/pkg/sdkclient"
 	"github.com/numaproj/numaflow/pkg/shared/util"
 )
 
 // client contains the grpc connection and the grpc client.
 type client struct {
-	conn    *grpc.ClientConn
-	grpcClt mapstreampb.MapStreamClient
+	conn        *grpc.ClientConn
+	grpcClt     mapstreampb.MapStreamClient
+	sizeChecker *sdkclient.MessageSizeChecker
 }
 
 // New creates a new client object.
@@ -53,25 +55,41 @@ func New(inputOptions ...Option) (Client, error) {
 		inputOption(opts)
 	}
 
-	// Wait for server info to be ready
-	serverInfo, err := util.WaitForServerInfo(opts.serverInfoReadinessTimeout, opts.serverInfoFilePath)
-	if err != nil {
-		return nil, err
+	methods := []util.GRPCMethod{
+		{Service: mapstreampb.MapStream_ServiceDesc.ServiceName, Method: "MapStreamFn"},
+		{Service: mapstreampb.MapStream_ServiceDesc.ServiceName, Method: "IsReady", Idempotent: true},
 	}
+	var conn *grpc.ClientConn
+	var err error
+	if opts.remoteEndpoint != "" {
+		// Connect to the remote server, there's no server info file to wait for
+		conn, err = util.ConnectToRemoteServer(opts.remoteEndpoint, opts.remoteTLSConfig, opts.maxMessageSize, opts.callPolicy, methods...)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		// Wait for server info to be ready
+		serverInfo, err := util.WaitForServerInfo(opts.serverInfoReadinessTimeout, opts.serverInfoFilePath)
+		if err != nil {
+			return nil, err
+		}
 
-	if serverInfo != nil {
-		log.Printf("ServerInfo: %v\n", serverInfo)
-	}
+		if serverInfo != nil {
+			log.Printf("ServerInfo: %v\n", serverInfo)
+		}
+		opts.maxMessageSize = util.NegotiateMaxMessageSize(serverInfo, opts.maxMessageSize)
 
-	// Connect to the server
-	conn, err := util.ConnectToServer(opts.udsSockAddr, opts.tcpSockAddr, serverInfo, opts.maxMessageSize)
-	if err != nil {
-		return nil, err
+		// Connect to the server
+		conn, err = util.ConnectToServer(opts.udsSockAddr, opts.tcpSockAddr, serverInfo, opts.maxMessageSize, opts.callPolicy, methods...)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	c := new(client)
 	c.conn = conn
 	c.grpcClt = mapstreampb.NewMapStreamClient(conn)
+	c.sizeChecker = sdkclient.NewMessageSizeChecker(mapstreampb.MapStream_ServiceDesc.ServiceName, opts.maxMessageSize)
 	return c, nil
 }
 
@@ -98,6 +116,9 @@ func (c *client) IsReady(ctx context.Context, in *emptypb.Empty) (bool, error) {
 // MapStreamFn applies a function to each datum element and returns a stream.
 func (c *client) MapStreamFn(ctx context.Context, request *mapstreampb.MapStreamRequest, responseCh chan<- *mapstreampb.MapStreamResponse) error {
 	defer close(responseCh)
+	if err := c.sizeChecker.Check(request); err != nil {
+		return err
+	}
 	stream, err := c.grpcClt.MapStreamFn(ctx, request)
 	if err != nil {
 		return fmt.Errorf("failed to execute c.grpcClt.MapStreamFn(): %w", err)
@@ -16,7 +16,12 @@ limitations under the License.
 
 package mapstreamer
 
-import "time"
+import (
+	"crypto/tls"
+	"time"
+
+	"github.com/numaproj/numaflow/pkg/shared/util"
+)
 
 type options struct {
 	tcpSockAddr                string
@@ -24,6 +29,9 @@ type options struct {
 	maxMessageSize             int
 	serverInfoFilePath         string
 	serverInfoReadinessTimeout time.Duration
+	callPolicy                 *util.GRPCCallPolicy
+	remoteEndpoint             string
+	remoteTLSConfig            *tls.Config
 }
 
 // Option is the interface to apply options.
@@ -63,3 +71,19 @@ func WithServerInfoReadinessTimeout(t time.Duration) Option {
 		o.serverInfoReadinessTimeout = t
 	}
 }
+
+// WithCallPolicy sets the keepalive, retry and hedging policies of the gRPC connection.
+func WithCallPolicy(p *util.GRPCCallPolicy) Option {
+	return func(o *options) {
+		o.callPolicy = p
+	}
+}
+
+// WithRemoteEndpoint connects the client to a UDF served remotely at the given "host:port" endpoint instead of the
+// sidecar container, using TLS if the TLS config is not nil. The server info file is not waited for in this case.
+func WithRemoteEndpoint(endpoint string, tlsConfig *tls.Config) Option {
+	return func(o *options) {
+		o.remoteEndpoint = endpoint
+		o.remoteTLSConfig = tlsConfig
+	}
+}
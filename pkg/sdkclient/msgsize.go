@@ -0,0 +1,63 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sdkclient
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/metrics"
+	sdkerr "github.com/numaproj/numaflow/pkg/sdkclient/error"
+)
+
+// nearLimitRatio is the ratio of the max message size, above which a message is counted as near-limit.
+const nearLimitRatio = 0.8
+
+// MessageSizeChecker checks the size of the messages sent to the user container against the negotiated max message size.
+type MessageSizeChecker struct {
+	service        string
+	maxMessageSize int
+	nearLimitSize  int
+}
+
+// NewMessageSizeChecker returns a MessageSizeChecker of the given gRPC service.
+func NewMessageSizeChecker(service string, maxMessageSize int) *MessageSizeChecker {
+	return &MessageSizeChecker{
+		service:        service,
+		maxMessageSize: maxMessageSize,
+		nearLimitSize:  int(float64(maxMessageSize) * nearLimitRatio),
+	}
+}
+
+// Check returns a non-retryable UDF error if the size of the message exceeds the max message size,
+// instead of letting the gRPC call fail with a vague error. A nil checker allows all the messages.
+func (c *MessageSizeChecker) Check(m proto.Message) error {
+	if c == nil {
+		return nil
+	}
+	size := proto.Size(m)
+	if size < c.nearLimitSize {
+		return nil
+	}
+	nearLimitMessagesCount.With(map[string]string{metrics.LabelService: c.service}).Inc()
+	if size > c.maxMessageSize {
+		return sdkerr.New(sdkerr.NonRetryable, fmt.Sprintf("message size %d bytes exceeds the max message size %d bytes of %s, consider increasing %q on both the numa and the user containers", size, c.maxMessageSize, c.service, dfv1.EnvGRPCMaxMessageSize))
+	}
+	return nil
+}
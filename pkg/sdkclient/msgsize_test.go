@@ -0,0 +1,48 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sdkclient
+
+import (
+	"bytes"
+	"testing"
+
+	mappb "github.com/numaproj/numaflow-go/pkg/apis/proto/map/v1"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+
+	sdkerr "github.com/numaproj/numaflow/pkg/sdkclient/error"
+)
+
+func TestMessageSizeChecker_Check(t *testing.T) {
+	var nilChecker *MessageSizeChecker
+	assert.NoError(t, nilChecker.Check(&mappb.MapRequest{Value: bytes.Repeat([]byte("a"), 100)}))
+
+	c := NewMessageSizeChecker("test.v1.Test", 100)
+	assert.NoError(t, c.Check(&mappb.MapRequest{Value: bytes.Repeat([]byte("a"), 10)}))
+	assert.Equal(t, float64(0), testutil.ToFloat64(nearLimitMessagesCount.WithLabelValues("test.v1.Test")))
+
+	assert.NoError(t, c.Check(&mappb.MapRequest{Value: bytes.Repeat([]byte("a"), 90)}))
+	assert.Equal(t, float64(1), testutil.ToFloat64(nearLimitMessagesCount.WithLabelValues("test.v1.Test")))
+
+	err := c.Check(&mappb.MapRequest{Value: bytes.Repeat([]byte("a"), 100)})
+	assert.Error(t, err)
+	udfErr, ok := sdkerr.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, sdkerr.NonRetryable, udfErr.ErrorKind())
+	assert.Contains(t, udfErr.ErrorMessage(), "exceeds the max message size 100 bytes of test.v1.Test")
+	assert.Equal(t, float64(2), testutil.ToFloat64(nearLimitMessagesCount.WithLabelValues("test.v1.Test")))
+}
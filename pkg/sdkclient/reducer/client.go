@@ -18,6 +18,7 @@ package reducer
 
 import (
 	"context"
+	"errors"
 	"io"
 	"log"
 	"time"
@@ -31,13 +32,16 @@ import (
 
 	"github.com/numaproj/numaflow-go/pkg/info"
 
+	"github.com/numaproj/numaflow/pkg/sdkclient"
+	sdkerr "github.com/numaproj/numaflow/pkg/sdkclient/error"
 	"github.com/numaproj/numaflow/pkg/shared/util"
 )
 
 // client contains the grpc connection and the grpc client.
 type client struct {
-	conn    *grpc.ClientConn
-	grpcClt reducepb.ReduceClient
+	conn        *grpc.ClientConn
+	grpcClt     reducepb.ReduceClient
+	sizeChecker *sdkclient.MessageSizeChecker
 }
 
 // New creates a new client object.
@@ -63,9 +67,12 @@ func New(inputOptions ...Option) (Client, error) {
 	if serverInfo != nil {
 		log.Printf("ServerInfo: %v\n", serverInfo)
 	}
+	opts.maxMessageSize = util.NegotiateMaxMessageSize(serverInfo, opts.maxMessageSize)
 
 	// Connect to the server
-	conn, err := util.ConnectToServer(opts.udsSockAddr, opts.tcpSockAddr, serverInfo, opts.maxMessageSize)
+	// ReduceFn is a long-running bidirectional stream, the call policy only applies to the readiness check.
+	conn, err := util.ConnectToServer(opts.udsSockAddr, opts.tcpSockAddr, serverInfo, opts.maxMessageSize, opts.callPolicy,
+		util.GRPCMethod{Service: reducepb.Reduce_ServiceDesc.ServiceName, Method: "IsReady", Idempotent: true})
 	if err != nil {
 		return nil, err
 	}
@@ -73,6 +80,7 @@ func New(inputOptions ...Option) (Client, error) {
 	c := new(client)
 	c.conn = conn
 	c.grpcClt = reducepb.NewReduceClient(conn)
+	c.sizeChecker = sdkclient.NewMessageSizeChecker(reducepb.Reduce_ServiceDesc.ServiceName, opts.maxMessageSize)
 	return c, nil
 }
 
@@ -101,6 +109,10 @@ func (c *client) ReduceFn(ctx context.Context, datumStreamCh <-chan *reducepb.Re
 	var g errgroup.Group
 	var finalResponse = &reducepb.ReduceResponse{}
 
+	// the stream is cancelled with the size check error as the cause if a datum exceeds the max message size.
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
 	stream, err := c.grpcClt.ReduceFn(ctx)
 	err = util.ToUDFErr("c.grpcClt.ReduceFn", err)
 	if err != nil {
@@ -114,6 +126,10 @@ func (c *client) ReduceFn(ctx context.Context, datumStreamCh <-chan *reducepb.Re
 			case <-ctx.Done():
 				return status.FromContextError(ctx.Err()).Err()
 			default:
+				if sendErr = c.sizeChecker.Check(datum); sendErr != nil {
+					cancel(sendErr)
+					return sendErr
+				}
 				if sendErr = stream.Send(datum); sendErr != nil {
 					// we don't need to invoke close on the stream
 					// if there is an error gRPC will close the stream.
@@ -129,6 +145,9 @@ outputLoop:
 	for {
 		select {
 		case <-ctx.Done():
+			if udfErr := sizeCheckErr(ctx); udfErr != nil {
+				return nil, udfErr
+			}
 			return nil, util.ToUDFErr("ReduceFn OutputLoop", status.FromContextError(ctx.Err()).Err())
 		default:
 			var resp *reducepb.ReduceResponse
@@ -136,6 +155,9 @@ outputLoop:
 			if err == io.EOF {
 				break outputLoop
 			}
+			if udfErr := sizeCheckErr(ctx); udfErr != nil {
+				return nil, udfErr
+			}
 			err = util.ToUDFErr("ReduceFn stream.Recv()", err)
 			if err != nil {
 				return nil, err
@@ -152,3 +174,12 @@ outputLoop:
 
 	return finalResponse, nil
 }
+
+// sizeCheckErr returns the size check error if the context is cancelled because of it.
+func sizeCheckErr(ctx context.Context) error {
+	var udfErr *sdkerr.UDFError
+	if errors.As(context.Cause(ctx), &udfErr) {
+		return udfErr
+	}
+	return nil
+}
@@ -29,3 +29,10 @@ type Client interface {
 	IsReady(ctx context.Context, in *emptypb.Empty) (bool, error)
 	ReduceFn(ctx context.Context, datumStreamCh <-chan *reducepb.ReduceRequest) (*reducepb.ReduceResponse, error)
 }
+
+// TriggerClient contains methods to call the Trigger gRPC service, which requests the flushes of the accumulator windows.
+type TriggerClient interface {
+	CloseConn(ctx context.Context) error
+	IsReady(ctx context.Context, in *emptypb.Empty) (bool, error)
+	WatchFlushes(ctx context.Context, flush func(keys []string)) error
+}
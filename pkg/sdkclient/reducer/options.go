@@ -16,7 +16,11 @@ limitations under the License.
 
 package reducer
 
-import "time"
+import (
+	"time"
+
+	"github.com/numaproj/numaflow/pkg/shared/util"
+)
 
 type options struct {
 	tcpSockAddr                string
@@ -24,6 +28,7 @@ type options struct {
 	maxMessageSize             int
 	serverInfoFilePath         string
 	serverInfoReadinessTimeout time.Duration
+	callPolicy                 *util.GRPCCallPolicy
 }
 
 // Option is the interface to apply options.
@@ -63,3 +68,10 @@ func WithServerInfoReadinessTimeout(t time.Duration) Option {
 		o.serverInfoReadinessTimeout = t
 	}
 }
+
+// WithCallPolicy sets the keepalive, retry and hedging policies of the gRPC connection.
+func WithCallPolicy(p *util.GRPCCallPolicy) Option {
+	return func(o *options) {
+		o.callPolicy = p
+	}
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reducer
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/numaproj/numaflow-go/pkg/info"
+	"github.com/numaproj/numaflow-go/pkg/shared"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	triggerpb "github.com/numaproj/numaflow/pkg/apis/proto/trigger"
+	"github.com/numaproj/numaflow/pkg/shared/util"
+)
+
+const (
+	// TriggerAddr is the UDS address of the Trigger service, served by the reduce user container along with the
+	// reduce service when the accumulator windows are flushed explicitly.
+	TriggerAddr        = "/var/run/numaflow/trigger.sock"
+	triggerServiceName = "trigger.Trigger"
+)
+
+// triggerClient contains the grpc connection and the grpc client of the Trigger service.
+type triggerClient struct {
+	conn    *grpc.ClientConn
+	grpcClt triggerpb.TriggerClient
+}
+
+// NewTriggerClient creates a new client of the Trigger service.
+func NewTriggerClient(inputOptions ...Option) (TriggerClient, error) {
+	var opts = &options{
+		maxMessageSize:             1024 * 1024 * 64, // 64 MB
+		serverInfoFilePath:         info.ServerInfoFilePath,
+		tcpSockAddr:                shared.TcpAddr,
+		udsSockAddr:                TriggerAddr,
+		serverInfoReadinessTimeout: 120 * time.Second, // Default timeout is 120 seconds
+	}
+
+	for _, inputOption := range inputOptions {
+		inputOption(opts)
+	}
+
+	// Wait for server info to be ready
+	serverInfo, err := util.WaitForServerInfo(opts.serverInfoReadinessTimeout, opts.serverInfoFilePath)
+	if err != nil {
+		return nil, err
+	}
+	opts.maxMessageSize = util.NegotiateMaxMessageSize(serverInfo, opts.maxMessageSize)
+
+	// Connect to the server
+	// WatchFlushes is a long-running server stream, the call policy only applies to the readiness check.
+	conn, err := util.ConnectToServer(opts.udsSockAddr, opts.tcpSockAddr, serverInfo, opts.maxMessageSize, opts.callPolicy,
+		util.GRPCMethod{Service: triggerServiceName, Method: "IsReady", Idempotent: true})
+	if err != nil {
+		return nil, err
+	}
+
+	c := new(triggerClient)
+	c.conn = conn
+	c.grpcClt = triggerpb.NewTriggerClient(conn)
+	return c, nil
+}
+
+// NewTriggerClientFromClient creates a new client object from a grpc client. This is used for testing.
+func NewTriggerClientFromClient(c triggerpb.TriggerClient) (TriggerClient, error) {
+	return &triggerClient{
+		grpcClt: c,
+	}, nil
+}
+
+// CloseConn closes the grpc client connection.
+func (c *triggerClient) CloseConn(ctx context.Context) error {
+	if c.conn == nil {
+		return nil
+	}
+	return c.conn.Close()
+}
+
+// IsReady returns true if the grpc connection is ready to use.
+func (c *triggerClient) IsReady(ctx context.Context, in *emptypb.Empty) (bool, error) {
+	resp, err := c.grpcClt.IsReady(ctx, in)
+	if err != nil {
+		return false, err
+	}
+	return resp.GetReady(), nil
+}
+
+// WatchFlushes invokes flush with the keys of each flush requested by the user container. It blocks until the stream
+// ends or the context is done, the stream ending with io.EOF returns nil.
+func (c *triggerClient) WatchFlushes(ctx context.Context, flush func(keys []string)) error {
+	stream, err := c.grpcClt.WatchFlushes(ctx, &emptypb.Empty{})
+	err = util.ToUDFErr("c.grpcClt.WatchFlushes", err)
+	if err != nil {
+		return err
+	}
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		err = util.ToUDFErr("WatchFlushes stream.Recv()", err)
+		if err != nil {
+			return err
+		}
+		flush(req.GetKeys())
+	}
+}
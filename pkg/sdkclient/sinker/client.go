@@ -29,13 +29,15 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/protobuf/types/known/emptypb"
 
+	"github.com/numaproj/numaflow/pkg/sdkclient"
 	"github.com/numaproj/numaflow/pkg/shared/util"
 )
 
 // client contains the grpc connection and the grpc client.
 type client struct {
-	conn    *grpc.ClientConn
-	grpcClt sinkpb.SinkClient
+	conn        *grpc.ClientConn
+	grpcClt     sinkpb.SinkClient
+	sizeChecker *sdkclient.MessageSizeChecker
 }
 
 var _ Client = (*client)(nil)
@@ -62,18 +64,25 @@ func New(inputOptions ...Option) (Client, error) {
 	if serverInfo != nil {
 		log.Printf("ServerInfo: %v\n", serverInfo)
 	}
+	opts.maxMessageSize = util.NegotiateMaxMessageSize(serverInfo, opts.maxMessageSize)
 
 	// connect to the server
 	c := new(client)
 	sockAddr := fmt.Sprintf("%s:%s", shared.UDS, opts.sockAddr)
-	conn, err := grpc.Dial(sockAddr, grpc.WithTransportCredentials(insecure.NewCredentials()),
-		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(opts.maxMessageSize), grpc.MaxCallSendMsgSize(opts.maxMessageSize)))
+	// SinkFn writes to the external systems, it's not retried to avoid duplicate writes.
+	dialOpts, err := util.GRPCDialOptions(opts.maxMessageSize, "", opts.callPolicy,
+		util.GRPCMethod{Service: sinkpb.Sink_ServiceDesc.ServiceName, Method: "IsReady", Idempotent: true})
+	if err != nil {
+		return nil, err
+	}
+	conn, err := grpc.Dial(sockAddr, append(dialOpts, grpc.WithTransportCredentials(insecure.NewCredentials()))...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute grpc.Dial(%q): %w", sockAddr, err)
 	}
 
 	c.conn = conn
 	c.grpcClt = sinkpb.NewSinkClient(conn)
+	c.sizeChecker = sdkclient.NewMessageSizeChecker(sinkpb.Sink_ServiceDesc.ServiceName, opts.maxMessageSize)
 	return c, nil
 }
 
@@ -101,6 +110,12 @@ func (c *client) IsReady(ctx context.Context, in *emptypb.Empty) (bool, error) {
 
 // SinkFn applies a function to a list of requests.
 func (c *client) SinkFn(ctx context.Context, requests []*sinkpb.SinkRequest) (*sinkpb.SinkResponse, error) {
+	// check the sizes before opening the stream, so that the batch is either entirely sent or not sent at all.
+	for _, datum := range requests {
+		if err := c.sizeChecker.Check(datum); err != nil {
+			return nil, err
+		}
+	}
 	stream, err := c.grpcClt.SinkFn(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute c.grpcClt.SinkFn(): %w", err)
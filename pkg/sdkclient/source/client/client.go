@@ -63,14 +63,20 @@ func New(inputOptions ...Option) (Client, error) {
 	if serverInfo != nil {
 		log.Printf("ServerInfo: %v\n", serverInfo)
 	}
+	opts.maxMessageSize = util.NegotiateMaxMessageSize(serverInfo, opts.maxMessageSize)
 
 	// connect to the grpc server
 	c := new(client)
 	sockAddr := fmt.Sprintf("%s:%s", shared.UDS, opts.sockAddr)
-	conn, err := grpc.Dial(sockAddr,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(opts.maxMessageSize),
-			grpc.MaxCallSendMsgSize(opts.maxMessageSize)))
+	// ReadFn moves the read offset forward, it's not retried.
+	dialOpts, err := util.GRPCDialOptions(opts.maxMessageSize, "", opts.callPolicy,
+		util.GRPCMethod{Service: sourcepb.Source_ServiceDesc.ServiceName, Method: "IsReady", Idempotent: true},
+		util.GRPCMethod{Service: sourcepb.Source_ServiceDesc.ServiceName, Method: "PendingFn", Idempotent: true},
+		util.GRPCMethod{Service: sourcepb.Source_ServiceDesc.ServiceName, Method: "AckFn"})
+	if err != nil {
+		return nil, err
+	}
+	conn, err := grpc.Dial(sockAddr, append(dialOpts, grpc.WithTransportCredentials(insecure.NewCredentials()))...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute grpc.Dial(%q): %w", sockAddr, err)
 	}
@@ -16,13 +16,18 @@ limitations under the License.
 
 package client
 
-import "time"
+import (
+	"time"
+
+	"github.com/numaproj/numaflow/pkg/shared/util"
+)
 
 type options struct {
 	sockAddr                   string
 	serverInfoFilePath         string
 	serverInfoReadinessTimeout time.Duration
 	maxMessageSize             int
+	callPolicy                 *util.GRPCCallPolicy
 }
 
 // Option is the interface to apply options.
@@ -55,3 +60,10 @@ func WithMaxMessageSize(size int) Option {
 		o.maxMessageSize = size
 	}
 }
+
+// WithCallPolicy sets the keepalive, retry and hedging policies of the gRPC connection.
+func WithCallPolicy(p *util.GRPCCallPolicy) Option {
+	return func(o *options) {
+		o.callPolicy = p
+	}
+}
@@ -18,22 +18,41 @@ package sourcetransformer
 
 import (
 	"context"
+	"errors"
 	"log"
+	"sync/atomic"
 	"time"
 
 	transformpb "github.com/numaproj/numaflow-go/pkg/apis/proto/sourcetransform/v1"
 	"github.com/numaproj/numaflow-go/pkg/info"
 	"github.com/numaproj/numaflow-go/pkg/shared"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 
+	batchpb "github.com/numaproj/numaflow/pkg/apis/proto/sourcetransformbatch"
+	"github.com/numaproj/numaflow/pkg/sdkclient"
 	"github.com/numaproj/numaflow/pkg/shared/util"
 )
 
+const (
+	batchServiceName = "sourcetransformbatch.SourceTransformBatch"
+	// ServerInfoBatchKey is the key of the server info metadata, which is set to "true" by the transformer container
+	// serving the SourceTransformBatch service.
+	ServerInfoBatchKey = "sourceTransformBatch"
+)
+
+// ErrBatchNotSupported is returned when the transformer container doesn't serve the SourceTransformBatch service.
+var ErrBatchNotSupported = errors.New("SourceTransformBatch is not supported by the transformer")
+
 // client contains the grpc connection and the grpc client.
 type client struct {
-	conn    *grpc.ClientConn
-	grpcClt transformpb.SourceTransformClient
+	conn           *grpc.ClientConn
+	grpcClt        transformpb.SourceTransformClient
+	batchClt       batchpb.SourceTransformBatchClient
+	batchSupported atomic.Bool
+	sizeChecker    *sdkclient.MessageSizeChecker
 }
 
 // New creates a new client object.
@@ -59,9 +78,13 @@ func New(inputOptions ...Option) (Client, error) {
 	if serverInfo != nil {
 		log.Printf("ServerInfo: %v\n", serverInfo)
 	}
+	opts.maxMessageSize = util.NegotiateMaxMessageSize(serverInfo, opts.maxMessageSize)
 
 	// Connect to the server
-	conn, err := util.ConnectToServer(opts.udsSockAddr, opts.tcpSockAddr, serverInfo, opts.maxMessageSize)
+	conn, err := util.ConnectToServer(opts.udsSockAddr, opts.tcpSockAddr, serverInfo, opts.maxMessageSize, opts.callPolicy,
+		util.GRPCMethod{Service: transformpb.SourceTransform_ServiceDesc.ServiceName, Method: "SourceTransformFn", Idempotent: true},
+		util.GRPCMethod{Service: transformpb.SourceTransform_ServiceDesc.ServiceName, Method: "IsReady", Idempotent: true},
+		util.GRPCMethod{Service: batchServiceName, Method: "SourceTransformBatchFn", Idempotent: true})
 	if err != nil {
 		return nil, err
 	}
@@ -69,6 +92,9 @@ func New(inputOptions ...Option) (Client, error) {
 	c := new(client)
 	c.conn = conn
 	c.grpcClt = transformpb.NewSourceTransformClient(conn)
+	c.batchClt = batchpb.NewSourceTransformBatchClient(conn)
+	c.batchSupported.Store(serverInfo != nil && serverInfo.Metadata[ServerInfoBatchKey] == "true")
+	c.sizeChecker = sdkclient.NewMessageSizeChecker(transformpb.SourceTransform_ServiceDesc.ServiceName, opts.maxMessageSize)
 	return c, nil
 }
 
@@ -79,6 +105,16 @@ func NewFromClient(c transformpb.SourceTransformClient) (Client, error) {
 	}, nil
 }
 
+// NewFromBatchClient creates a new client object supporting the batch mode from the grpc clients. This is used for testing.
+func NewFromBatchClient(c transformpb.SourceTransformClient, bc batchpb.SourceTransformBatchClient) (Client, error) {
+	clt := &client{
+		grpcClt:  c,
+		batchClt: bc,
+	}
+	clt.batchSupported.Store(true)
+	return clt, nil
+}
+
 // CloseConn closes the grpc client connection.
 func (c *client) CloseConn(ctx context.Context) error {
 	if c.conn == nil {
@@ -97,11 +133,37 @@ func (c *client) IsReady(ctx context.Context, in *emptypb.Empty) (bool, error) {
 }
 
 // SourceTransformFn SourceTransformerFn applies a function to each request element.
-func (c *client) SourceTransformFn(ctx context.Context, request *transformpb.SourceTransformRequest) (*transformpb.SourceTransformResponse, error) {
-	transformResponse, err := c.grpcClt.SourceTransformFn(ctx, request)
+func (c *client) SourceTransformFn(ctx context.Context, request *transformpb.SourceTransformRequest, opts ...grpc.CallOption) (*transformpb.SourceTransformResponse, error) {
+	if err := c.sizeChecker.Check(request); err != nil {
+		return nil, err
+	}
+	transformResponse, err := c.grpcClt.SourceTransformFn(ctx, request, opts...)
 	err = util.ToUDFErr("c.grpcClt.SourceTransformFn", err)
 	if err != nil {
 		return nil, err
 	}
 	return transformResponse, nil
 }
+
+// IsBatchSupported returns true if the transformer container serves the SourceTransformBatch service.
+func (c *client) IsBatchSupported() bool {
+	return c.batchSupported.Load()
+}
+
+// SourceTransformBatchFn applies the transformer to a batch of messages. It returns ErrBatchNotSupported if the
+// transformer container doesn't serve the SourceTransformBatch service, and the batch mode is not supported since then.
+func (c *client) SourceTransformBatchFn(ctx context.Context, request *batchpb.SourceTransformBatchRequest, opts ...grpc.CallOption) (*batchpb.SourceTransformBatchResponse, error) {
+	if !c.batchSupported.Load() {
+		return nil, ErrBatchNotSupported
+	}
+	response, err := c.batchClt.SourceTransformBatchFn(ctx, request, opts...)
+	if status.Code(err) == codes.Unimplemented {
+		c.batchSupported.Store(false)
+		return nil, ErrBatchNotSupported
+	}
+	err = util.ToUDFErr("c.batchClt.SourceTransformBatchFn", err)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
@@ -20,12 +20,17 @@ import (
 	"context"
 
 	transformpb "github.com/numaproj/numaflow-go/pkg/apis/proto/sourcetransform/v1"
+	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/emptypb"
+
+	batchpb "github.com/numaproj/numaflow/pkg/apis/proto/sourcetransformbatch"
 )
 
 // Client contains methods to call a gRPC client.
 type Client interface {
 	CloseConn(ctx context.Context) error
 	IsReady(ctx context.Context, in *emptypb.Empty) (bool, error)
-	SourceTransformFn(ctx context.Context, request *transformpb.SourceTransformRequest) (*transformpb.SourceTransformResponse, error)
+	SourceTransformFn(ctx context.Context, request *transformpb.SourceTransformRequest, opts ...grpc.CallOption) (*transformpb.SourceTransformResponse, error)
+	IsBatchSupported() bool
+	SourceTransformBatchFn(ctx context.Context, request *batchpb.SourceTransformBatchRequest, opts ...grpc.CallOption) (*batchpb.SourceTransformBatchResponse, error)
 }
@@ -16,7 +16,15 @@ limitations under the License.
 
 package sourcetransformer
 
-import "time"
+import (
+	"path"
+	"time"
+
+	"github.com/numaproj/numaflow-go/pkg/info"
+	"github.com/numaproj/numaflow-go/pkg/shared"
+
+	"github.com/numaproj/numaflow/pkg/shared/util"
+)
 
 type options struct {
 	tcpSockAddr                string
@@ -24,6 +32,7 @@ type options struct {
 	maxMessageSize             int
 	serverInfoFilePath         string
 	serverInfoReadinessTimeout time.Duration
+	callPolicy                 *util.GRPCCallPolicy
 }
 
 // Option is the interface to apply options.
@@ -57,9 +66,25 @@ func WithServerInfoFilePath(f string) Option {
 	}
 }
 
+// WithRuntimeDir connects to the transformer serving the socket and the server info file in the given directory instead
+// of the default one, e.g. a stage of a source transformer chain.
+func WithRuntimeDir(dir string) Option {
+	return func(o *options) {
+		o.udsSockAddr = path.Join(dir, path.Base(shared.SourceTransformerAddr))
+		o.serverInfoFilePath = path.Join(dir, path.Base(info.ServerInfoFilePath))
+	}
+}
+
 // WithServerInfoReadinessTimeout sets the server info readiness timeout to the given timeout.
 func WithServerInfoReadinessTimeout(t time.Duration) Option {
 	return func(o *options) {
 		o.serverInfoReadinessTimeout = t
 	}
 }
+
+// WithCallPolicy sets the keepalive, retry and hedging policies of the gRPC connection.
+func WithCallPolicy(p *util.GRPCCallPolicy) Option {
+	return func(o *options) {
+		o.callPolicy = p
+	}
+}
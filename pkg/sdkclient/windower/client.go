@@ -0,0 +1,128 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package windower
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/numaproj/numaflow-go/pkg/info"
+	"github.com/numaproj/numaflow-go/pkg/shared"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	windowerpb "github.com/numaproj/numaflow/pkg/apis/proto/windower"
+	"github.com/numaproj/numaflow/pkg/shared/util"
+)
+
+const (
+	// WindowerAddr is the UDS address of the Windower service, served by the reduce user container
+	// along with the reduce service.
+	WindowerAddr = "/var/run/numaflow/windower.sock"
+	serviceName  = "windower.Windower"
+)
+
+// client contains the grpc connection and the grpc client.
+type client struct {
+	conn    *grpc.ClientConn
+	grpcClt windowerpb.WindowerClient
+}
+
+// New creates a new client object.
+func New(inputOptions ...Option) (Client, error) {
+	var opts = &options{
+		maxMessageSize:             1024 * 1024 * 64, // 64 MB
+		serverInfoFilePath:         info.ServerInfoFilePath,
+		tcpSockAddr:                shared.TcpAddr,
+		udsSockAddr:                WindowerAddr,
+		serverInfoReadinessTimeout: 120 * time.Second, // Default timeout is 120 seconds
+	}
+
+	for _, inputOption := range inputOptions {
+		inputOption(opts)
+	}
+
+	// Wait for server info to be ready
+	serverInfo, err := util.WaitForServerInfo(opts.serverInfoReadinessTimeout, opts.serverInfoFilePath)
+	if err != nil {
+		return nil, err
+	}
+
+	if serverInfo != nil {
+		log.Printf("ServerInfo: %v\n", serverInfo)
+	}
+	opts.maxMessageSize = util.NegotiateMaxMessageSize(serverInfo, opts.maxMessageSize)
+
+	// Connect to the server
+	conn, err := util.ConnectToServer(opts.udsSockAddr, opts.tcpSockAddr, serverInfo, opts.maxMessageSize, opts.callPolicy,
+		util.GRPCMethod{Service: serviceName, Method: "AssignWindows", Idempotent: true},
+		util.GRPCMethod{Service: serviceName, Method: "MergeWindows", Idempotent: true},
+		util.GRPCMethod{Service: serviceName, Method: "IsReady", Idempotent: true})
+	if err != nil {
+		return nil, err
+	}
+
+	c := new(client)
+	c.conn = conn
+	c.grpcClt = windowerpb.NewWindowerClient(conn)
+	return c, nil
+}
+
+// NewFromClient creates a new client object from a grpc client. This is used for testing.
+func NewFromClient(c windowerpb.WindowerClient) (Client, error) {
+	return &client{
+		grpcClt: c,
+	}, nil
+}
+
+// CloseConn closes the grpc client connection.
+func (c *client) CloseConn(ctx context.Context) error {
+	if c.conn == nil {
+		return nil
+	}
+	return c.conn.Close()
+}
+
+// IsReady returns true if the grpc connection is ready to use.
+func (c *client) IsReady(ctx context.Context, in *emptypb.Empty) (bool, error) {
+	resp, err := c.grpcClt.IsReady(ctx, in)
+	if err != nil {
+		return false, err
+	}
+	return resp.GetReady(), nil
+}
+
+// AssignWindows returns the windows a message belongs to.
+func (c *client) AssignWindows(ctx context.Context, request *windowerpb.AssignWindowsRequest) (*windowerpb.AssignWindowsResponse, error) {
+	resp, err := c.grpcClt.AssignWindows(ctx, request)
+	err = util.ToUDFErr("c.grpcClt.AssignWindows", err)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// MergeWindows merges a newly assigned window with the overlapping active windows.
+func (c *client) MergeWindows(ctx context.Context, request *windowerpb.MergeWindowsRequest) (*windowerpb.MergeWindowsResponse, error) {
+	resp, err := c.grpcClt.MergeWindows(ctx, request)
+	err = util.ToUDFErr("c.grpcClt.MergeWindows", err)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
@@ -0,0 +1,33 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package windower
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	windowerpb "github.com/numaproj/numaflow/pkg/apis/proto/windower"
+)
+
+// Client contains methods to call a gRPC client.
+type Client interface {
+	CloseConn(ctx context.Context) error
+	IsReady(ctx context.Context, in *emptypb.Empty) (bool, error)
+	AssignWindows(ctx context.Context, request *windowerpb.AssignWindowsRequest) (*windowerpb.AssignWindowsResponse, error)
+	MergeWindows(ctx context.Context, request *windowerpb.MergeWindowsRequest) (*windowerpb.MergeWindowsResponse, error)
+}
@@ -24,8 +24,9 @@ import (
 
 // ClientPool is a pool of NATS clients used on at the initial create connection phase.
 type ClientPool struct {
-	clients *list.List
-	mutex   sync.Mutex
+	clients    *list.List
+	toPipeline *NATSClient
+	mutex      sync.Mutex
 }
 
 // NewClientPool returns a new pool of NATS clients of the given size
@@ -37,15 +38,21 @@ func NewClientPool(ctx context.Context, opts ...Option) (*ClientPool, error) {
 		o(options)
 	}
 
+	// The connection to the ISB Service of the to pipeline is shared by the clients in the pool
+	toPipeline, err := NewToPipelineNATSClient(ctx)
+	if err != nil {
+		return nil, err
+	}
 	for i := 0; i < options.clientPoolSize; i++ {
 		client, err := NewNATSClient(ctx)
 		if err != nil {
 			return nil, err
 		}
+		client.toPipeline = toPipeline
 		clients.PushBack(client)
 
 	}
-	return &ClientPool{clients: clients}, nil
+	return &ClientPool{clients: clients, toPipeline: toPipeline}, nil
 }
 
 // NextAvailableClient returns the next available NATS client. This code need not be optimized because this is
@@ -72,4 +79,7 @@ func (p *ClientPool) CloseAll() {
 	for e := p.clients.Front(); e != nil; e = e.Next() {
 		e.Value.(*NATSClient).Close()
 	}
+	if p.toPipeline != nil {
+		p.toPipeline.Close()
+	}
 }
@@ -39,11 +39,55 @@ type NATSClient struct {
 	sync.Mutex
 	nc    *nats.Conn
 	jsCtx nats.JetStreamContext
-	log   *zap.SugaredLogger
+	// jsOpts are the options of the JetStream contexts, e.g. the JetStream domain.
+	jsOpts []nats.JSOpt
+	// toPipeline is the client of the ISB Service of the to pipeline of the cross-pipeline edges, if it's not the
+	// same ISB Service.
+	toPipeline *NATSClient
+	log        *zap.SugaredLogger
 }
 
+// connEnvs are the names of the environment variables of the connection to a JetStream ISB Service.
+type connEnvs struct {
+	url        string
+	user       string
+	password   string
+	tlsEnabled string
+	domain     string
+}
+
+var (
+	isbSvcEnvs = connEnvs{
+		url:        dfv1.EnvISBSvcJetStreamURL,
+		user:       dfv1.EnvISBSvcJetStreamUser,
+		password:   dfv1.EnvISBSvcJetStreamPassword,
+		tlsEnabled: dfv1.EnvISBSvcJetStreamTLSEnabled,
+		domain:     dfv1.EnvISBSvcJetStreamDomain,
+	}
+	toPipelineEnvs = connEnvs{
+		url:        dfv1.EnvToPipelineJetStreamURL,
+		user:       dfv1.EnvToPipelineJetStreamUser,
+		password:   dfv1.EnvToPipelineJetStreamPassword,
+		tlsEnabled: dfv1.EnvToPipelineJetStreamTLSEnabled,
+		domain:     dfv1.EnvToPipelineJetStreamDomain,
+	}
+)
+
 // NewNATSClient Create a new NATS client
 func NewNATSClient(ctx context.Context, natsOptions ...nats.Option) (*NATSClient, error) {
+	return newNATSClient(ctx, isbSvcEnvs, natsOptions...)
+}
+
+// NewToPipelineNATSClient creates a new NATS client of the ISB Service of the to pipeline of the cross-pipeline edges,
+// it returns nil if the to pipeline uses the same ISB Service.
+func NewToPipelineNATSClient(ctx context.Context, natsOptions ...nats.Option) (*NATSClient, error) {
+	if _, existing := os.LookupEnv(dfv1.EnvToPipelineJetStreamURL); !existing {
+		return nil, nil
+	}
+	return newNATSClient(ctx, toPipelineEnvs, natsOptions...)
+}
+
+func newNATSClient(ctx context.Context, envs connEnvs, natsOptions ...nats.Option) (*NATSClient, error) {
 	log := logging.FromContext(ctx)
 	var jsCtx nats.JetStreamContext
 	opts := []nats.Option{
@@ -75,36 +119,69 @@ func NewNATSClient(ctx context.Context, natsOptions ...nats.Option) (*NATSClient
 		nats.FlusherTimeout(10 * time.Second),
 	}
 
-	url, existing := os.LookupEnv(dfv1.EnvISBSvcJetStreamURL)
+	url, existing := os.LookupEnv(envs.url)
 	if !existing {
-		return nil, fmt.Errorf("environment variable %q not found", dfv1.EnvISBSvcJetStreamURL)
+		return nil, fmt.Errorf("environment variable %q not found", envs.url)
 	}
-	user, existing := os.LookupEnv(dfv1.EnvISBSvcJetStreamUser)
+	user, existing := os.LookupEnv(envs.user)
 	if !existing {
-		return nil, fmt.Errorf("environment variable %q not found", dfv1.EnvISBSvcJetStreamUser)
+		return nil, fmt.Errorf("environment variable %q not found", envs.user)
 	}
-	password, existing := os.LookupEnv(dfv1.EnvISBSvcJetStreamPassword)
+	password, existing := os.LookupEnv(envs.password)
 	if !existing {
-		return nil, fmt.Errorf("environment variable %q not found", dfv1.EnvISBSvcJetStreamPassword)
+		return nil, fmt.Errorf("environment variable %q not found", envs.password)
 	}
 	// Pass nats options for username password
 	opts = append(opts, nats.UserInfo(user, password))
-	if sharedutil.LookupEnvStringOr(dfv1.EnvISBSvcJetStreamTLSEnabled, "false") == "true" {
+	if sharedutil.LookupEnvStringOr(envs.tlsEnabled, "false") == "true" {
 		opts = append(opts, nats.Secure(&tls.Config{
 			InsecureSkipVerify: true,
 		}))
 	}
 
+	// The vertices connecting through leaf nodes access the ISB Service in its domain, the leaf nodes' own domains are
+	// used through WithDomain.
+	var jsOpts []nats.JSOpt
+	if domain := os.Getenv(envs.domain); domain != "" {
+		jsOpts = append(jsOpts, nats.Domain(domain))
+	}
+
 	opts = append(opts, natsOptions...)
 	if nc, err := nats.Connect(url, opts...); err != nil {
 		return nil, fmt.Errorf("failed to connect to nats url=%s: %w", url, err)
 	} else {
-		jsCtx, err = nc.JetStream()
+		jsCtx, err = nc.JetStream(jsOpts...)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create to nats jetstream context: %w", err)
 		}
-		return &NATSClient{nc: nc, jsCtx: jsCtx, log: logging.FromContext(ctx)}, nil
+		return &NATSClient{nc: nc, jsCtx: jsCtx, jsOpts: jsOpts, log: logging.FromContext(ctx)}, nil
+	}
+}
+
+// WithDomain returns a client sharing the connection, whose JetStream contexts are in the given JetStream domain. An
+// empty domain means the domain of the ISB Service, in which case the client itself is returned. The returned client
+// should not be closed separately.
+func (c *NATSClient) WithDomain(domain string) *NATSClient {
+	if domain == "" {
+		return c
+	}
+	jsOpts := []nats.JSOpt{nats.Domain(domain)}
+	// The domain option never fails.
+	jsCtx, _ := c.nc.JetStream(jsOpts...)
+	return &NATSClient{nc: c.nc, jsCtx: jsCtx, jsOpts: jsOpts, toPipeline: c.toPipeline, log: c.log}
+}
+
+// ForToEdge returns the client to access the buffers and the bucket of the given to edge, whose from vertex is in the
+// given JetStream domain. For a cross-pipeline edge, it's the client of the ISB Service of the to pipeline, or the
+// client itself if the to pipeline uses the same ISB Service, where the edge is always in the domain of the ISB Service.
+func (c *NATSClient) ForToEdge(e dfv1.CombinedEdge, domain string) *NATSClient {
+	if e.ToPipeline == nil {
+		return c.WithDomain(domain)
+	}
+	if c.toPipeline != nil {
+		return c.toPipeline
 	}
+	return c
 }
 
 // Subscribe returns a subscription for the given subject and stream
@@ -114,7 +191,7 @@ func (c *NATSClient) Subscribe(subject string, stream string, opts ...nats.SubOp
 		jsContext nats.JetStreamContext
 	)
 
-	jsContext, err = c.nc.JetStream()
+	jsContext, err = c.nc.JetStream(c.jsOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -129,7 +206,7 @@ func (c *NATSClient) BindKVStore(kvName string) (nats.KeyValue, error) {
 		jsContext nats.JetStreamContext
 	)
 
-	jsContext, err = c.nc.JetStream()
+	jsContext, err = c.nc.JetStream(c.jsOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -146,7 +223,7 @@ func (c *NATSClient) CreateKVWatcher(bucketName string, opts ...nats.WatchOpt) (
 		jsContext nats.JetStreamContext
 	)
 
-	jsContext, err = c.nc.JetStream()
+	jsContext, err = c.nc.JetStream(c.jsOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -176,9 +253,20 @@ func (c *NATSClient) PendingForStream(consumer string, stream string) (int64, er
 	return int64(cInfo.NumPending) + int64(cInfo.NumAckPending), nil
 }
 
+// QueueSubscribe subscribes to the core NATS subject as a member of the queue group, each message is only delivered
+// to one of the members.
+func (c *NATSClient) QueueSubscribe(subject string, queue string, handler nats.MsgHandler) (*nats.Subscription, error) {
+	return c.nc.QueueSubscribe(subject, queue, handler)
+}
+
+// Request sends a request to the core NATS subject and waits for the reply.
+func (c *NATSClient) Request(ctx context.Context, subject string, data []byte) (*nats.Msg, error) {
+	return c.nc.RequestWithContext(ctx, subject, data)
+}
+
 // JetStreamContext returns a new JetStreamContext
 func (c *NATSClient) JetStreamContext(opts ...nats.JSOpt) (nats.JetStreamContext, error) {
-	return c.nc.JetStream(opts...)
+	return c.nc.JetStream(append(append([]nats.JSOpt{}, c.jsOpts...), opts...)...)
 }
 
 // Close closes the NATS client
@@ -0,0 +1,73 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pulsar
+
+import (
+	"fmt"
+
+	pulsarlib "github.com/apache/pulsar-client-go/pulsar"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	sharedutil "github.com/numaproj/numaflow/pkg/shared/util"
+)
+
+// NewClient returns a Pulsar client with the given TLS and auth configuration.
+func NewClient(serviceURL string, tls *dfv1.TLS, auth *dfv1.PulsarAuth) (pulsarlib.Client, error) {
+	opts := pulsarlib.ClientOptions{
+		URL: serviceURL,
+	}
+	if tls != nil {
+		opts.TLSAllowInsecureConnection = tls.InsecureSkipVerify
+		opts.TLSValidateHostname = !tls.InsecureSkipVerify
+		if tls.CACertSecret != nil {
+			caCertPath, err := sharedutil.GetSecretVolumePath(tls.CACertSecret)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get ca cert, %w", err)
+			}
+			opts.TLSTrustCertsFilePath = caCertPath
+		}
+		if tls.CertSecret != nil || tls.KeySecret != nil {
+			if tls.CertSecret == nil || tls.KeySecret == nil {
+				return nil, fmt.Errorf("invalid tls config, both certSecret and keySecret need to be configured")
+			}
+			certPath, err := sharedutil.GetSecretVolumePath(tls.CertSecret)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get client cert, %w", err)
+			}
+			keyPath, err := sharedutil.GetSecretVolumePath(tls.KeySecret)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get client key, %w", err)
+			}
+			opts.Authentication = pulsarlib.NewAuthenticationTLS(certPath, keyPath)
+		}
+	}
+	if auth != nil && auth.Token != nil {
+		if opts.Authentication != nil {
+			return nil, fmt.Errorf("invalid pulsar config, token auth can not be used together with tls client certs")
+		}
+		token, err := sharedutil.GetSecretFromVolume(auth.Token)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get auth token, %w", err)
+		}
+		opts.Authentication = pulsarlib.NewAuthenticationToken(token)
+	}
+	client, err := pulsarlib.NewClient(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create pulsar client, %w", err)
+	}
+	return client, nil
+}
@@ -34,6 +34,9 @@ type Options struct {
 	ReadTimeOut time.Duration
 	// CheckBackLog is used to read all the PENDING entries from the stream
 	CheckBackLog bool
+	// ClaimMinIdleTime is the min idle time of the PENDING entries of other consumers to be claimed, 0 means not
+	// claiming them
+	ClaimMinIdleTime time.Duration
 	// MaxLength is the maximum length of the stream before it reaches full
 	MaxLength int64
 	// BufferUsageLimit is the limit of buffer usage before we declare it as full
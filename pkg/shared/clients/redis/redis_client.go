@@ -18,10 +18,13 @@ package redis
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/redis/go-redis/v9"
 
@@ -31,6 +34,15 @@ import (
 const ReadFromEarliest = "0-0"
 const ReadFromLatest = "$"
 
+const (
+	// failoverMaxRetries is the max number of retries of a command during a Sentinel failover.
+	failoverMaxRetries = 10
+	// failoverMinRetryBackoff and failoverMaxRetryBackoff bound the backoff between the retries, with the defaults the
+	// commands are retried for about 15 seconds.
+	failoverMinRetryBackoff = 100 * time.Millisecond
+	failoverMaxRetryBackoff = 2 * time.Second
+)
+
 // RedisContext is used to pass the context specifically for REDIS operations.
 // A cancelled context during SIGTERM or Ctrl-C that is propagated down will throw a context cancelled error because redis uses context to obtain connection from the connection pool.
 // All redis operations will use the below no-op context.Background() to try to process in-flight messages that we have received prior to the cancellation of the context.
@@ -50,7 +62,15 @@ func NewRedisClient(options *redis.UniversalOptions) *RedisClient {
 
 // NewInClusterRedisClient returns a new Redis Client, it assumes it's in a vertex pod,
 // where those required environment variables are available.
-func NewInClusterRedisClient() *RedisClient {
+func NewInClusterRedisClient() (*RedisClient, error) {
+	opts, err := inClusterRedisOptions()
+	if err != nil {
+		return nil, err
+	}
+	return NewRedisClient(opts), nil
+}
+
+func inClusterRedisOptions() (*redis.UniversalOptions, error) {
 	opts := &redis.UniversalOptions{
 		Username:   os.Getenv(v1alpha1.EnvISBSvcRedisUser),
 		Password:   os.Getenv(v1alpha1.EnvISBSvcRedisPassword),
@@ -66,7 +86,14 @@ func NewInClusterRedisClient() *RedisClient {
 		if urls != "" {
 			opts.Addrs = strings.Split(urls, ",")
 		}
+		opts.SentinelUsername = os.Getenv(v1alpha1.EnvISBSvcRedisSentinelUser)
 		opts.SentinelPassword = os.Getenv(v1alpha1.EnvISBSvcRedisSentinelPassword)
+		// The master is discovered from Sentinel, and rediscovered after a failover. The commands failing during the
+		// failover, e.g. with connection errors or READONLY errors from the demoted master, are retried until the new
+		// master is promoted.
+		opts.MaxRetries = failoverMaxRetries
+		opts.MinRetryBackoff = failoverMinRetryBackoff
+		opts.MaxRetryBackoff = failoverMaxRetryBackoff
 	} else {
 		urls := os.Getenv(v1alpha1.EnvISBSvcRedisURL)
 		if urls != "" {
@@ -76,8 +103,41 @@ func NewInClusterRedisClient() *RedisClient {
 	if i, e := strconv.Atoi(os.Getenv(v1alpha1.EnvISBSvcRedisClusterMaxRedirects)); e == nil {
 		opts.MaxRedirects = i
 	}
+	if os.Getenv(v1alpha1.EnvISBSvcRedisTLSEnabled) == "true" {
+		tlsConfig, err := inClusterTLSConfig()
+		if err != nil {
+			return nil, err
+		}
+		opts.TLSConfig = tlsConfig
+	}
+	return opts, nil
+}
 
-	return NewRedisClient(opts)
+// inClusterTLSConfig returns the TLS config with the certificates in the environment variables.
+func inClusterTLSConfig() (*tls.Config, error) {
+	c := &tls.Config{
+		InsecureSkipVerify: os.Getenv(v1alpha1.EnvISBSvcRedisTLSInsecure) == "true",
+		MinVersion:         tls.VersionTLS12,
+	}
+	if caCert := os.Getenv(v1alpha1.EnvISBSvcRedisTLSCACert); caCert != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(caCert)) {
+			return nil, fmt.Errorf("failed to parse the redis ca cert")
+		}
+		c.RootCAs = pool
+	}
+	cert, key := os.Getenv(v1alpha1.EnvISBSvcRedisTLSCert), os.Getenv(v1alpha1.EnvISBSvcRedisTLSKey)
+	if (cert == "") != (key == "") {
+		return nil, fmt.Errorf("invalid redis tls config, both cert and key need to be configured")
+	}
+	if cert != "" {
+		clientCert, err := tls.X509KeyPair([]byte(cert), []byte(key))
+		if err != nil {
+			return nil, fmt.Errorf("failed to load the redis client cert key pair, %w", err)
+		}
+		c.Certificates = []tls.Certificate{clientCert}
+	}
+	return c, nil
 }
 
 // CreateStreamGroup creates a redis stream group and creates an empty stream if it does not exist.
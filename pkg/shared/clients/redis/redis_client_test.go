@@ -19,9 +19,13 @@ package redis
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/redis/go-redis/v9"
 	"github.com/stretchr/testify/assert"
+
+	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	sharedtls "github.com/numaproj/numaflow/pkg/shared/tls"
 )
 
 func TestNewRedisClient(t *testing.T) {
@@ -43,3 +47,44 @@ func TestNewRedisClient(t *testing.T) {
 	err = client.CreateStreamGroup(ctx, stream, streamGroup, ReadFromEarliest)
 	assert.Error(t, err)
 }
+
+func TestInClusterRedisOptions(t *testing.T) {
+	t.Run("test url", func(t *testing.T) {
+		t.Setenv(v1alpha1.EnvISBSvcRedisURL, "redis-0:6379,redis-1:6379")
+		t.Setenv(v1alpha1.EnvISBSvcRedisUser, "user")
+		opts, err := inClusterRedisOptions()
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"redis-0:6379", "redis-1:6379"}, opts.Addrs)
+		assert.Equal(t, "user", opts.Username)
+		assert.Equal(t, 0, opts.MaxRetries)
+		assert.Nil(t, opts.TLSConfig)
+	})
+
+	t.Run("test sentinel with tls", func(t *testing.T) {
+		key, cert, ca, err := sharedtls.CreateCerts("numaproj", []string{"localhost"}, time.Now().Add(time.Hour), false, true)
+		assert.NoError(t, err)
+		t.Setenv(v1alpha1.EnvISBSvcSentinelMaster, "mymaster")
+		t.Setenv(v1alpha1.EnvISBSvcRedisSentinelURL, "sentinel:26379")
+		t.Setenv(v1alpha1.EnvISBSvcRedisSentinelUser, "sentinel-user")
+		t.Setenv(v1alpha1.EnvISBSvcRedisTLSEnabled, "true")
+		t.Setenv(v1alpha1.EnvISBSvcRedisTLSCACert, string(ca))
+		t.Setenv(v1alpha1.EnvISBSvcRedisTLSCert, string(cert))
+		t.Setenv(v1alpha1.EnvISBSvcRedisTLSKey, string(key))
+		opts, err := inClusterRedisOptions()
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"sentinel:26379"}, opts.Addrs)
+		assert.Equal(t, "sentinel-user", opts.SentinelUsername)
+		assert.Equal(t, failoverMaxRetries, opts.MaxRetries)
+		assert.NotNil(t, opts.TLSConfig.RootCAs)
+		assert.Len(t, opts.TLSConfig.Certificates, 1)
+		assert.False(t, opts.TLSConfig.InsecureSkipVerify)
+
+		t.Setenv(v1alpha1.EnvISBSvcRedisTLSKey, "")
+		_, err = inClusterRedisOptions()
+		assert.Error(t, err)
+
+		t.Setenv(v1alpha1.EnvISBSvcRedisTLSCACert, "invalid")
+		_, err = inClusterRedisOptions()
+		assert.Error(t, err)
+	})
+}
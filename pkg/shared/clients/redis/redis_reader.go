@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/redis/go-redis/v9"
 	"go.uber.org/zap"
@@ -41,6 +42,11 @@ type RedisStreamsRead struct {
 	Metrics Metrics
 
 	XStreamToMessages func(xstreams []redis.XStream, messages []*isb.ReadMessage, labels map[string]string) ([]*isb.ReadMessage, error)
+
+	// claimStart is the ID to continue scanning the PENDING entries to be claimed from
+	claimStart string
+	// nextClaimTime is the time to start the next scan of the PENDING entries to be claimed
+	nextClaimTime time.Time
 }
 
 type Metrics struct {
@@ -48,6 +54,7 @@ type Metrics struct {
 	ReadsAdd      metricsAddFunc // number of actual messages read in
 	AcksAdd       metricsAddFunc
 	AckErrorsAdd  metricsAddFunc
+	ClaimsAdd     metricsAddFunc // number of PENDING entries claimed from other consumers
 }
 
 // need a function type which increments a particular counter
@@ -98,10 +105,16 @@ func (br *RedisStreamsRead) Read(_ context.Context, count int64) ([]*isb.ReadMes
 		}
 	}
 	if !br.Options.CheckBackLog {
-		xstreams, err = br.processXReadResult(">", count)
+		xstreams, err = br.claimPendingEntries(count)
 		if err != nil {
 			return br.processReadError(xstreams, messages, err)
 		}
+		if len(xstreams) == 0 {
+			xstreams, err = br.processXReadResult(">", count)
+			if err != nil {
+				return br.processReadError(xstreams, messages, err)
+			}
+		}
 	}
 
 	// Update metric for number of messages read in
@@ -165,9 +178,11 @@ func (br *RedisStreamsRead) Ack(_ context.Context, offsets []isb.Offset) []error
 
 func (br *RedisStreamsRead) NoAck(_ context.Context, _ []isb.Offset) {}
 
+// Pending returns the number of the entries not acknowledged by the consumer group yet, i.e. the entries not delivered
+// plus the PENDING ones.
 func (br *RedisStreamsRead) Pending(_ context.Context) (int64, error) {
 	// try calling XINFO GROUPS <stream> and look for 'Lag' key.
-	// For Redis Server < v7.0, this always returns 0; therefore it's recommended to use >= v7.0
+	// For Redis Server < v7.0, the lag is always 0; therefore it's recommended to use >= v7.0
 
 	result := br.Client.XInfoGroups(RedisContext, br.Stream)
 	groups, err := result.Result()
@@ -177,7 +192,7 @@ func (br *RedisStreamsRead) Pending(_ context.Context) (int64, error) {
 	// find our ConsumerGroup
 	for _, group := range groups {
 		if group.Name == br.Group {
-			return group.Lag, nil
+			return group.Lag + group.Pending, nil
 		}
 	}
 	return isb.PendingNotAvailable, fmt.Errorf("ConsumerGroup %q not found in XInfoGroups result %+v", br.Group, groups)
@@ -194,3 +209,39 @@ func (br *RedisStreamsRead) processXReadResult(startIndex string, count int64) (
 	})
 	return result.Result()
 }
+
+// claimPendingEntries claims the entries PENDING longer than ClaimMinIdleTime, e.g. the ones delivered to a consumer
+// which is gone. The PENDING entries list is scanned once in every ClaimMinIdleTime, up to count entries in each call.
+// It returns nil if nothing is claimed.
+func (br *RedisStreamsRead) claimPendingEntries(count int64) ([]redis.XStream, error) {
+	if br.Options.ClaimMinIdleTime <= 0 || time.Now().Before(br.nextClaimTime) {
+		return nil, nil
+	}
+	if br.claimStart == "" {
+		br.claimStart = "0-0"
+	}
+	messages, next, err := br.Client.XAutoClaim(RedisContext, &redis.XAutoClaimArgs{
+		Stream:   br.Stream,
+		Group:    br.Group,
+		Consumer: br.Consumer,
+		MinIdle:  br.Options.ClaimMinIdleTime,
+		Start:    br.claimStart,
+		Count:    count,
+	}).Result()
+	if err != nil {
+		return nil, fmt.Errorf("XAutoClaim failed, %w", err)
+	}
+	br.claimStart = next
+	if next == "0-0" {
+		// the whole PENDING entries list has been scanned
+		br.nextClaimTime = time.Now().Add(br.Options.ClaimMinIdleTime)
+	}
+	if len(messages) == 0 {
+		return nil, nil
+	}
+	br.Log.Infow("Claimed PENDING entries from other consumers", zap.Int("count", len(messages)))
+	if br.Metrics.ClaimsAdd != nil {
+		br.Metrics.ClaimsAdd(len(messages))
+	}
+	return []redis.XStream{{Stream: br.Stream, Messages: messages}}, nil
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package component provides the gRPC service standardized on the daemon server, the numa container of the vertex
+// pods and the controller, which serves the health, the build information and the live configuration of the
+// component, so that they can be inventoried uniformly across all the pods.
+package component
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+
+	numaflow "github.com/numaproj/numaflow"
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	componentpb "github.com/numaproj/numaflow/pkg/apis/proto/component"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+// Server serves the component service and the gRPC health service of a component.
+type Server struct {
+	componentpb.UnimplementedComponentServer
+	grpc_health_v1.UnimplementedHealthServer
+	component   string
+	startTime   time.Time
+	config      func() interface{}
+	healthCheck func() error
+}
+
+type Option func(*Server)
+
+// WithConfig sets the function returning the live configuration of the component.
+func WithConfig(f func() interface{}) Option {
+	return func(s *Server) {
+		s.config = f
+	}
+}
+
+// WithHealthCheck sets the function checking the health of the component, the component is healthy if it returns nil.
+func WithHealthCheck(f func() error) Option {
+	return func(s *Server) {
+		s.healthCheck = f
+	}
+}
+
+// NewServer returns a new Server of the component.
+func NewServer(component string, opts ...Option) *Server {
+	s := &Server{
+		component: component,
+		startTime: time.Now(),
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(s)
+		}
+	}
+	return s
+}
+
+// Register registers the component service and the health service on the gRPC server.
+func (s *Server) Register(grpcServer *grpc.Server) {
+	componentpb.RegisterComponentServer(grpcServer, s)
+	grpc_health_v1.RegisterHealthServer(grpcServer, s)
+}
+
+// GetBuildInfo returns the build information of the component.
+func (s *Server) GetBuildInfo(context.Context, *componentpb.GetBuildInfoRequest) (*componentpb.BuildInfo, error) {
+	v := numaflow.GetVersion()
+	pod := os.Getenv(dfv1.EnvPod)
+	if pod == "" {
+		// e.g. the controller, the hostname of a pod is the pod name
+		pod, _ = os.Hostname()
+	}
+	namespace := os.Getenv(dfv1.EnvNamespace)
+	if namespace == "" {
+		namespace = os.Getenv("NAMESPACE")
+	}
+	return &componentpb.BuildInfo{
+		Component:    s.component,
+		Version:      v.Version,
+		BuildDate:    v.BuildDate,
+		GitCommit:    v.GitCommit,
+		GitTag:       v.GitTag,
+		GitTreeState: v.GitTreeState,
+		GoVersion:    v.GoVersion,
+		Compiler:     v.Compiler,
+		Platform:     v.Platform,
+		Pod:          pod,
+		Namespace:    namespace,
+		StartTime:    s.startTime.UTC().Format(time.RFC3339),
+	}, nil
+}
+
+// GetConfig returns the live configuration of the component in JSON format.
+func (s *Server) GetConfig(context.Context, *componentpb.GetConfigRequest) (*componentpb.ConfigDump, error) {
+	if s.config == nil {
+		return &componentpb.ConfigDump{Component: s.component, Json: "{}"}, nil
+	}
+	data, err := json.Marshal(s.config())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to marshal the configuration, %v", err)
+	}
+	return &componentpb.ConfigDump{Component: s.component, Json: string(data)}, nil
+}
+
+// Check returns the health of the component, the service name in the request is either empty or the component name.
+func (s *Server) Check(_ context.Context, req *grpc_health_v1.HealthCheckRequest) (*grpc_health_v1.HealthCheckResponse, error) {
+	if req.GetService() != "" && req.GetService() != s.component {
+		return nil, status.Errorf(codes.NotFound, "unknown service %q", req.GetService())
+	}
+	if s.healthCheck != nil {
+		if err := s.healthCheck(); err != nil {
+			return &grpc_health_v1.HealthCheckResponse{Status: grpc_health_v1.HealthCheckResponse_NOT_SERVING}, nil
+		}
+	}
+	return &grpc_health_v1.HealthCheckResponse{Status: grpc_health_v1.HealthCheckResponse_SERVING}, nil
+}
+
+// Serve serves the component service on the port until the context is done, it's used by the components which
+// don't have a gRPC server, e.g. the controller.
+func (s *Server) Serve(ctx context.Context, port int) error {
+	log := logging.FromContext(ctx)
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	if err != nil {
+		return fmt.Errorf("failed to listen on port %d, %w", port, err)
+	}
+	grpcServer := grpc.NewServer()
+	s.Register(grpcServer)
+	go func() {
+		<-ctx.Done()
+		grpcServer.GracefulStop()
+	}()
+	log.Infow("Starting component server", "port", port)
+	return grpcServer.Serve(lis)
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package component
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+
+	numaflow "github.com/numaproj/numaflow"
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	componentpb "github.com/numaproj/numaflow/pkg/apis/proto/component"
+)
+
+func TestServer_GetBuildInfo(t *testing.T) {
+	t.Setenv(dfv1.EnvPod, "my-pod")
+	t.Setenv(dfv1.EnvNamespace, "my-ns")
+	s := NewServer(dfv1.ComponentVertex)
+	info, err := s.GetBuildInfo(context.Background(), &componentpb.GetBuildInfoRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, dfv1.ComponentVertex, info.Component)
+	assert.Equal(t, numaflow.GetVersion().Version, info.Version)
+	assert.Equal(t, "my-pod", info.Pod)
+	assert.Equal(t, "my-ns", info.Namespace)
+	assert.NotEmpty(t, info.StartTime)
+}
+
+func TestServer_GetConfig(t *testing.T) {
+	s := NewServer(dfv1.ComponentController)
+	dump, err := s.GetConfig(context.Background(), &componentpb.GetConfigRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, "{}", dump.Json)
+
+	s = NewServer(dfv1.ComponentDaemon, WithConfig(func() interface{} {
+		return map[string]string{"name": "my-pipeline"}
+	}))
+	dump, err = s.GetConfig(context.Background(), &componentpb.GetConfigRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, dfv1.ComponentDaemon, dump.Component)
+	assert.JSONEq(t, `{"name":"my-pipeline"}`, dump.Json)
+
+	s = NewServer(dfv1.ComponentDaemon, WithConfig(func() interface{} { return make(chan int) }))
+	_, err = s.GetConfig(context.Background(), &componentpb.GetConfigRequest{})
+	assert.Equal(t, codes.Internal, status.Code(err))
+}
+
+func TestServer_Check(t *testing.T) {
+	var healthErr error
+	s := NewServer(dfv1.ComponentVertex, WithHealthCheck(func() error { return healthErr }))
+	resp, err := s.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, resp.Status)
+	resp, err = s.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{Service: dfv1.ComponentVertex})
+	assert.NoError(t, err)
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, resp.Status)
+
+	healthErr = fmt.Errorf("sidecar is down")
+	resp, err = s.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, resp.Status)
+
+	_, err = s.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{Service: "unknown"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
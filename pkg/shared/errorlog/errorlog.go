@@ -0,0 +1,150 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package errorlog keeps the last errors returned by the user-defined containers of a vertex pod in a ring buffer, so
+// that they can be looked up through the daemon service instead of the pod logs.
+package errorlog
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// Path is the path of the error log endpoint served by the metrics server of a vertex pod.
+	Path = "/errors"
+	// DefaultSize is the number of errors kept by the default error log.
+	DefaultSize = 100
+	// maxMessageLength is the max length of a recorded error message, longer ones are truncated.
+	maxMessageLength = 1024
+	// payloadHashLength is the length of the hex encoded payload hash.
+	payloadHashLength = 16
+)
+
+// Entry is an error returned by a user-defined container.
+type Entry struct {
+	Timestamp time.Time `json:"timestamp"`
+	// Container is the name of the container returning the error, e.g. "udf" or "udsink".
+	Container string `json:"container"`
+	// Code is the gRPC status code of the error.
+	Code string `json:"code"`
+	// MessageID is the ID of the message being processed, or the window of a reduce vertex.
+	MessageID string `json:"messageId,omitempty"`
+	Message   string `json:"message"`
+	// PayloadHash is a truncated SHA-256 of the payload of the message, to tell if the errors are caused by the same
+	// payload without exposing it.
+	PayloadHash string `json:"payloadHash,omitempty"`
+}
+
+// Ring keeps the last errors in a ring buffer.
+type Ring struct {
+	lock    sync.RWMutex
+	entries []Entry
+	next    int
+	full    bool
+}
+
+// NewRing returns a Ring keeping the last size errors.
+func NewRing(size int) *Ring {
+	return &Ring{entries: make([]Entry, size)}
+}
+
+// Add adds an error, the oldest one is dropped if the ring is full.
+func (r *Ring) Add(e Entry) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if len(r.entries) == 0 {
+		return
+	}
+	r.entries[r.next] = e
+	r.next = (r.next + 1) % len(r.entries)
+	if r.next == 0 {
+		r.full = true
+	}
+}
+
+// List returns the errors, the newest first.
+func (r *Ring) List() []Entry {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	n := r.next
+	if r.full {
+		n = len(r.entries)
+	}
+	result := make([]Entry, 0, n)
+	for i := 1; i <= n; i++ {
+		result = append(result, r.entries[(r.next-i+len(r.entries))%len(r.entries)])
+	}
+	return result
+}
+
+var defaultRing = NewRing(DefaultSize)
+
+// Record records an error returned by a user-defined container to the default error log.
+func Record(container string, err error, messageID string, payload []byte) {
+	if err == nil {
+		return
+	}
+	defaultRing.Add(NewEntry(container, err, messageID, payload))
+}
+
+// List returns the errors in the default error log, the newest first.
+func List() []Entry {
+	return defaultRing.List()
+}
+
+// NewEntry builds an Entry for an error returned by a user-defined container.
+func NewEntry(container string, err error, messageID string, payload []byte) Entry {
+	code := codes.Unknown
+	msg := err.Error()
+	if c, ok := err.(interface{ Code() codes.Code }); ok {
+		code = c.Code()
+	} else if s, ok := status.FromError(err); ok {
+		code = s.Code()
+		msg = s.Message()
+	}
+	if m, ok := err.(interface{ ErrorMessage() string }); ok {
+		msg = m.ErrorMessage()
+	}
+	if len(msg) > maxMessageLength {
+		msg = msg[:maxMessageLength]
+	}
+	e := Entry{
+		Timestamp: time.Now(),
+		Container: container,
+		Code:      code.String(),
+		MessageID: messageID,
+		Message:   msg,
+	}
+	if len(payload) > 0 {
+		sum := sha256.Sum256(payload)
+		e.PayloadHash = hex.EncodeToString(sum[:])[:payloadHashLength]
+	}
+	return e
+}
+
+// Handler serves the errors in the default error log in JSON.
+func Handler(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(List())
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package errorlog
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdkerr "github.com/numaproj/numaflow/pkg/sdkclient/error"
+)
+
+func TestRing(t *testing.T) {
+	r := NewRing(3)
+	assert.Empty(t, r.List())
+	r.Add(Entry{MessageID: "1"})
+	r.Add(Entry{MessageID: "2"})
+	assert.Equal(t, []Entry{{MessageID: "2"}, {MessageID: "1"}}, r.List())
+	r.Add(Entry{MessageID: "3"})
+	r.Add(Entry{MessageID: "4"})
+	assert.Equal(t, []Entry{{MessageID: "4"}, {MessageID: "3"}, {MessageID: "2"}}, r.List())
+
+	empty := NewRing(0)
+	empty.Add(Entry{MessageID: "1"})
+	assert.Empty(t, empty.List())
+}
+
+func TestNewEntry(t *testing.T) {
+	e := NewEntry("udf", sdkerr.NewWithCode(sdkerr.NonRetryable, codes.InvalidArgument, "bad input"), "msg-1", []byte("hello"))
+	assert.Equal(t, "udf", e.Container)
+	assert.Equal(t, "InvalidArgument", e.Code)
+	assert.Equal(t, "msg-1", e.MessageID)
+	assert.Equal(t, "bad input", e.Message)
+	assert.Equal(t, "2cf24dba5fb0a30e", e.PayloadHash)
+	assert.False(t, e.Timestamp.IsZero())
+
+	e = NewEntry("udsink", status.Error(codes.Unavailable, "connection refused"), "", nil)
+	assert.Equal(t, "Unavailable", e.Code)
+	assert.Equal(t, "connection refused", e.Message)
+	assert.Empty(t, e.PayloadHash)
+
+	e = NewEntry("udsink", fmt.Errorf("%s", strings.Repeat("x", 2*maxMessageLength)), "", nil)
+	assert.Equal(t, "Unknown", e.Code)
+	assert.Len(t, e.Message, maxMessageLength)
+}
+
+func TestHandler(t *testing.T) {
+	Record("udf", fmt.Errorf("failed"), "msg-1", nil)
+	Record("udf", nil, "msg-2", nil)
+	w := httptest.NewRecorder()
+	Handler(w, httptest.NewRequest("GET", "/errors", nil))
+	var entries []Entry
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &entries))
+	assert.Len(t, entries, 1)
+	assert.Equal(t, "msg-1", entries[0].MessageID)
+}
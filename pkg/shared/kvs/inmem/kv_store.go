@@ -57,19 +57,22 @@ func (k kvEntry) Operation() kvs.KVWatchOp {
 type inMemStore struct {
 	bucketName string
 	kv         map[string][]byte
+	revisions  map[string]uint64
+	revision   uint64
 	kvLock     sync.RWMutex
 	kvEntryCh  chan kvs.KVEntry
 	isClosed   bool
 	log        *zap.SugaredLogger
 }
 
-var _ kvs.KVStorer = (*inMemStore)(nil)
+var _ kvs.KVRevisionStorer = (*inMemStore)(nil)
 
 // NewKVInMemKVStore returns inMemStore.
 func NewKVInMemKVStore(ctx context.Context, bucketName string) (kvs.KVStorer, chan kvs.KVEntry, error) {
 	s := &inMemStore{
 		bucketName: bucketName,
 		kv:         make(map[string][]byte),
+		revisions:  make(map[string]uint64),
 		kvEntryCh:  make(chan kvs.KVEntry, 10),
 		log:        logging.FromContext(ctx).With("bucketName", bucketName),
 	}
@@ -112,6 +115,7 @@ func (kv *inMemStore) DeleteKey(_ context.Context, k string) error {
 	defer kv.kvLock.Unlock()
 	if val, ok := kv.kv[k]; ok {
 		delete(kv.kv, k)
+		delete(kv.revisions, k)
 		kv.kvEntryCh <- kvEntry{
 			key:   k,
 			value: val,
@@ -130,15 +134,59 @@ func (kv *inMemStore) PutKV(_ context.Context, k string, v []byte) error {
 	if kv.isClosed {
 		return fmt.Errorf("kv store is closed")
 	}
+	kv.put(k, v)
+	return nil
+}
+
+// put puts an element and returns the new revision, the caller needs to hold the lock.
+func (kv *inMemStore) put(k string, v []byte) uint64 {
 	var val = make([]byte, len(v))
 	copy(val, v)
 	kv.kv[k] = val
+	kv.revision++
+	kv.revisions[k] = kv.revision
 	kv.kvEntryCh <- kvEntry{
 		key:   k,
 		value: val,
 		op:    kvs.KVPut,
 	}
-	return nil
+	return kv.revision
+}
+
+// GetValueWithRevision returns the value and the revision for a given key.
+func (kv *inMemStore) GetValueWithRevision(_ context.Context, k string) ([]byte, uint64, error) {
+	kv.kvLock.RLock()
+	defer kv.kvLock.RUnlock()
+	if val, ok := kv.kv[k]; ok {
+		return val, kv.revisions[k], nil
+	}
+	return nil, 0, kvs.ErrKeyNotFound
+}
+
+// CreateKV puts an element to the in mem key-value store only if the key does not exist.
+func (kv *inMemStore) CreateKV(_ context.Context, k string, v []byte) (uint64, error) {
+	kv.kvLock.Lock()
+	defer kv.kvLock.Unlock()
+	if kv.isClosed {
+		return 0, fmt.Errorf("kv store is closed")
+	}
+	if _, ok := kv.kv[k]; ok {
+		return 0, kvs.ErrRevisionMismatch
+	}
+	return kv.put(k, v), nil
+}
+
+// UpdateKV updates an element in the in mem key-value store only if the revision is the latest.
+func (kv *inMemStore) UpdateKV(_ context.Context, k string, v []byte, revision uint64) (uint64, error) {
+	kv.kvLock.Lock()
+	defer kv.kvLock.Unlock()
+	if kv.isClosed {
+		return 0, fmt.Errorf("kv store is closed")
+	}
+	if r, ok := kv.revisions[k]; !ok || r != revision {
+		return 0, kvs.ErrRevisionMismatch
+	}
+	return kv.put(k, v), nil
 }
 
 // Close closes the channel connection and clean up the bucket.
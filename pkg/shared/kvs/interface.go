@@ -18,6 +18,14 @@ package kvs
 
 import (
 	"context"
+	"errors"
+)
+
+var (
+	// ErrKeyNotFound is returned when the key does not exist.
+	ErrKeyNotFound = errors.New("key not found")
+	// ErrRevisionMismatch is returned when the key has been updated since the revision, or the key to be created exists.
+	ErrRevisionMismatch = errors.New("revision mismatch")
 )
 
 // KVStorer defines the storage for publishing the watermark and sideinput
@@ -36,6 +44,17 @@ type KVStorer interface {
 	Close()
 }
 
+// KVRevisionStorer is a KVStorer supporting the optimistic concurrency control with the revisions of the keys.
+type KVRevisionStorer interface {
+	KVStorer
+	// GetValueWithRevision gets the value and the revision of the given key, returns ErrKeyNotFound if the key does not exist.
+	GetValueWithRevision(context.Context, string) ([]byte, uint64, error)
+	// CreateKV inserts a key-value pair only if the key does not exist, returns ErrRevisionMismatch if it exists.
+	CreateKV(context.Context, string, []byte) (uint64, error)
+	// UpdateKV updates the value of the key only if the revision is the latest, returns ErrRevisionMismatch otherwise.
+	UpdateKV(context.Context, string, []byte, uint64) (uint64, error)
+}
+
 // KVWatchOp is the operation as detected by the KV watcher.
 type KVWatchOp int64
 
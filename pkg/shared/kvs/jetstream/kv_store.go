@@ -21,6 +21,7 @@ package jetstream
 
 import (
 	"context"
+	"errors"
 	"sync"
 
 	"github.com/nats-io/nats.go"
@@ -39,7 +40,7 @@ type jetStreamStore struct {
 	log    *zap.SugaredLogger
 }
 
-var _ kvs.KVStorer = (*jetStreamStore)(nil)
+var _ kvs.KVRevisionStorer = (*jetStreamStore)(nil)
 
 // NewKVJetStreamKVStore returns KVJetStreamStore.
 func NewKVJetStreamKVStore(ctx context.Context, kvName string, client *jsclient.NATSClient, opts ...JSKVStoreOption) (kvs.KVStorer, error) {
@@ -90,6 +91,43 @@ func (jss *jetStreamStore) GetValue(_ context.Context, k string) ([]byte, error)
 	return val, err
 }
 
+// GetValueWithRevision returns the value and the revision for a given key.
+func (jss *jetStreamStore) GetValueWithRevision(_ context.Context, k string) ([]byte, uint64, error) {
+	jss.kvLock.RLock()
+	defer jss.kvLock.RUnlock()
+	kvEntry, err := jss.kv.Get(k)
+	if err != nil {
+		if errors.Is(err, nats.ErrKeyNotFound) {
+			return nil, 0, kvs.ErrKeyNotFound
+		}
+		return nil, 0, err
+	}
+	return kvEntry.Value(), kvEntry.Revision(), nil
+}
+
+// CreateKV puts an element to the JS key-value store only if the key does not exist.
+func (jss *jetStreamStore) CreateKV(_ context.Context, k string, v []byte) (uint64, error) {
+	jss.kvLock.RLock()
+	defer jss.kvLock.RUnlock()
+	revision, err := jss.kv.Create(k, v)
+	if errors.Is(err, nats.ErrKeyExists) {
+		return 0, kvs.ErrRevisionMismatch
+	}
+	return revision, err
+}
+
+// UpdateKV updates an element in the JS key-value store only if the revision is the latest.
+func (jss *jetStreamStore) UpdateKV(_ context.Context, k string, v []byte, revision uint64) (uint64, error) {
+	jss.kvLock.RLock()
+	defer jss.kvLock.RUnlock()
+	// a wrong last sequence error is returned if the revision is not the latest, which is the same error code as ErrKeyExists
+	newRevision, err := jss.kv.Update(k, v, revision)
+	if errors.Is(err, nats.ErrKeyExists) {
+		return 0, kvs.ErrRevisionMismatch
+	}
+	return newRevision, err
+}
+
 // GetStoreName returns the store name.
 func (jss *jetStreamStore) GetStoreName() string {
 	jss.kvLock.RLock()
@@ -18,22 +18,42 @@ package logging
 
 import (
 	"context"
+	"net/http"
 	"os"
 
 	zap "go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 )
 
+// LevelPath is the path of the endpoint to get or change the log level of a pod at runtime, which is served by the
+// metrics server of a vertex pod.
+const LevelPath = "/log-level"
+
+// level is shared by all the loggers, so that the log level of the whole process can be changed at runtime.
+var level = zap.NewAtomicLevelAt(defaultLevel())
+
+func defaultLevel() zapcore.Level {
+	if isDebugMode() {
+		return zapcore.DebugLevel
+	}
+	return zapcore.InfoLevel
+}
+
+func isDebugMode() bool {
+	debugMode, ok := os.LookupEnv("NUMAFLOW_DEBUG")
+	return ok && debugMode == "true"
+}
+
 // NewLogger returns a new zap.SugaredLogger
 func NewLogger() *zap.SugaredLogger {
 	var config zap.Config
-	debugMode, ok := os.LookupEnv("NUMAFLOW_DEBUG")
-	if ok && debugMode == "true" {
+	if isDebugMode() {
 		config = zap.NewDevelopmentConfig()
 	} else {
 		config = zap.NewProductionConfig()
 	}
 	// Config customization goes here if any
+	config.Level = level
 	config.EncoderConfig.EncodeTime = zapcore.RFC3339NanoTimeEncoder
 	config.OutputPaths = []string{"stdout"}
 	logger, err := config.Build()
@@ -43,6 +63,12 @@ func NewLogger() *zap.SugaredLogger {
 	return logger.Named("numaflow").Sugar()
 }
 
+// LevelHandler returns an http handler to get the log level with GET, and change it with PUT, e.g.
+// {"level":"debug"}.
+func LevelHandler() http.Handler {
+	return level
+}
+
 type loggerKey struct{}
 
 // WithLogger returns a copy of parent context in which the
@@ -0,0 +1,135 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package msglog
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+const (
+	// EnvName is the environment variable of the name of the message log written by a user-defined container, it
+	// defaults to the name of the executable.
+	EnvName = "NUMAFLOW_MSGLOG_NAME"
+	// maxFileSize is the size of a message log file before it's rotated, only one rotated file is kept.
+	maxFileSize   = 8 * 1024 * 1024
+	logSuffix     = ".log"
+	rotatedSuffix = ".1"
+)
+
+var (
+	fileCoreOnce sync.Once
+	fileEncoder  zapcore.Encoder
+	fileWriter   zapcore.WriteSyncer
+)
+
+// Logger returns a logger for the user code of a user-defined container, based on the logger in ctx. The logs are
+// tagged with the IDs of the messages being processed, which are passed by the numa container in the gRPC metadata
+// of ctx, and the tagged logs are also written to the message log of the container.
+func Logger(ctx context.Context) *zap.SugaredLogger {
+	logger := logging.FromContext(ctx)
+	ids := MessageIDs(ctx)
+	if len(ids) == 0 {
+		return logger
+	}
+	fileCoreOnce.Do(func() {
+		name := os.Getenv(EnvName)
+		if name == "" {
+			name = filepath.Base(os.Args[0])
+		}
+		w, err := newRotatingWriter(filepath.Join(Dir, name+logSuffix), maxFileSize)
+		if err != nil {
+			logger.Warnw("Failed to open the message log, the logs are not searchable by the message IDs", zap.Error(err))
+			return
+		}
+		config := zap.NewProductionEncoderConfig()
+		config.EncodeTime = zapcore.RFC3339NanoTimeEncoder
+		fileEncoder = zapcore.NewJSONEncoder(config)
+		fileWriter = w
+	})
+	if fileWriter != nil {
+		logger = logger.Desugar().WithOptions(zap.WrapCore(func(c zapcore.Core) zapcore.Core {
+			return zapcore.NewTee(c, zapcore.NewCore(fileEncoder, fileWriter, c))
+		})).Sugar()
+	}
+	return logger.With(messageIDsKey, ids)
+}
+
+// rotatingWriter writes to a file, which is rotated once it reaches the max size.
+type rotatingWriter struct {
+	lock    sync.Mutex
+	path    string
+	maxSize int64
+	file    *os.File
+	size    int64
+}
+
+func newRotatingWriter(path string, maxSize int64) (*rotatingWriter, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, err
+	}
+	w := &rotatingWriter{path: path, maxSize: maxSize}
+	if err := w.open(); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+func (w *rotatingWriter) open() error {
+	f, err := os.OpenFile(w.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return err
+	}
+	w.file = f
+	w.size = info.Size()
+	return nil
+}
+
+func (w *rotatingWriter) Write(p []byte) (int, error) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	if w.size+int64(len(p)) > w.maxSize && w.size > 0 {
+		_ = w.file.Close()
+		if err := os.Rename(w.path, w.path+rotatedSuffix); err != nil {
+			return 0, err
+		}
+		if err := w.open(); err != nil {
+			return 0, err
+		}
+	}
+	n, err := w.file.Write(p)
+	w.size += int64(n)
+	return n, err
+}
+
+func (w *rotatingWriter) Sync() error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	return w.file.Sync()
+}
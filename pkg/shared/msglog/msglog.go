@@ -0,0 +1,178 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package msglog correlates the logs of the user-defined containers with the messages being processed. The numa
+// container passes the IDs of the messages to the user-defined containers in the gRPC metadata, and the Logger helper
+// used by the user code tags the logs with them, and also writes them to a message log in the shared volume of the
+// pod, so that the logs of a message can be searched through the daemon service instead of the pod logs.
+package msglog
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/metadata"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+const (
+	// Path is the path of the message log search endpoint served by the metrics server of a vertex pod.
+	Path = "/message-logs"
+	// QueryMessageID is the query parameter of the message ID to search for.
+	QueryMessageID = "messageId"
+	// DefaultSearchLimit is the max number of log lines returned by a search.
+	DefaultSearchLimit = 100
+	// MaxMessageIDs is the max number of message IDs passed in the gRPC metadata of a call, e.g. for a sink batch.
+	MaxMessageIDs = 100
+	// messageIDsKey is the key of the message IDs in the logs.
+	messageIDsKey = "messageIds"
+)
+
+// Dir is the directory of the message logs in the shared volume of the pod.
+var Dir = filepath.Join(dfv1.PathVarRun, "msglog")
+
+// Entry is a log line of a user-defined container with the ID of the searched message.
+type Entry struct {
+	Timestamp time.Time `json:"timestamp"`
+	// Source is the name of the message log, e.g. the name of the user-defined function.
+	Source string `json:"source"`
+	// Line is the log line in JSON.
+	Line string `json:"line"`
+}
+
+// OutgoingContext returns a copy of ctx with the IDs of the messages being processed appended to the outgoing gRPC
+// metadata, at most MaxMessageIDs of them are passed.
+func OutgoingContext(ctx context.Context, ids ...string) context.Context {
+	if len(ids) > MaxMessageIDs {
+		ids = ids[:MaxMessageIDs]
+	}
+	kv := make([]string, 0, 2*len(ids))
+	for _, id := range ids {
+		if id != "" {
+			kv = append(kv, dfv1.KeyMetaMessageIDs, id)
+		}
+	}
+	if len(kv) == 0 {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, kv...)
+}
+
+// MessageIDs returns the IDs of the messages being processed from the incoming gRPC metadata of ctx, it's used by
+// the user-defined containers.
+func MessageIDs(ctx context.Context) []string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil
+	}
+	return md.Get(dfv1.KeyMetaMessageIDs)
+}
+
+// logLine is the part of a log line used for searching.
+type logLine struct {
+	Timestamp  time.Time `json:"ts"`
+	MessageIDs []string  `json:"messageIds"`
+}
+
+// Search returns the log lines of the message logs in the directories with the message ID, the newest first. At most
+// limit lines are returned.
+func Search(dirs []string, messageID string, limit int) ([]Entry, error) {
+	result := []Entry{}
+	for _, dir := range dirs {
+		files, err := filepath.Glob(filepath.Join(dir, "*.log*"))
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range files {
+			entries, err := searchFile(f, messageID)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, entries...)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Timestamp.After(result[j].Timestamp)
+	})
+	if len(result) > limit {
+		result = result[:limit]
+	}
+	return result, nil
+}
+
+func searchFile(name, messageID string) ([]Entry, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		if os.IsNotExist(err) {
+			// rotated after globbing
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+	source := strings.TrimSuffix(strings.TrimSuffix(filepath.Base(name), rotatedSuffix), logSuffix)
+	var result []Entry
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxFileSize)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !strings.Contains(line, messageID) {
+			continue
+		}
+		var l logLine
+		if err := json.Unmarshal([]byte(line), &l); err != nil {
+			continue
+		}
+		for _, id := range l.MessageIDs {
+			if id == messageID {
+				result = append(result, Entry{Timestamp: l.Timestamp, Source: source, Line: line})
+				break
+			}
+		}
+	}
+	return result, scanner.Err()
+}
+
+// searchDirs returns the directories of the message logs of the pod, including the ones of the transformer stages.
+func searchDirs() []string {
+	dirs := []string{Dir}
+	stages, _ := filepath.Glob(filepath.Join(dfv1.PathTransformerStagesVarRun, "*", "msglog"))
+	return append(dirs, stages...)
+}
+
+// Handler serves the log lines of the message logs of the pod with the message ID in the query, in JSON.
+func Handler(w http.ResponseWriter, r *http.Request) {
+	messageID := r.URL.Query().Get(QueryMessageID)
+	if messageID == "" {
+		http.Error(w, "query parameter "+QueryMessageID+" is required", http.StatusBadRequest)
+		return
+	}
+	entries, err := Search(searchDirs(), messageID, DefaultSearchLimit)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(entries)
+}
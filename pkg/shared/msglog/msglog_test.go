@@ -0,0 +1,126 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package msglog
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestMessageIDs(t *testing.T) {
+	ctx := OutgoingContext(context.Background())
+	_, ok := metadata.FromOutgoingContext(ctx)
+	assert.False(t, ok)
+
+	ctx = OutgoingContext(context.Background(), "msg-1", "", "msg-2")
+	md, _ := metadata.FromOutgoingContext(ctx)
+	assert.Equal(t, []string{"msg-1", "msg-2"}, MessageIDs(metadata.NewIncomingContext(context.Background(), md)))
+	assert.Nil(t, MessageIDs(context.Background()))
+
+	ids := make([]string, MaxMessageIDs+10)
+	for i := range ids {
+		ids[i] = "msg"
+	}
+	md, _ = metadata.FromOutgoingContext(OutgoingContext(context.Background(), ids...))
+	assert.Len(t, MessageIDs(metadata.NewIncomingContext(context.Background(), md)), MaxMessageIDs)
+}
+
+func TestLogger(t *testing.T) {
+	Dir = t.TempDir()
+	t.Setenv(EnvName, "my-udf")
+
+	// no message IDs, nothing is written to the message log
+	Logger(context.Background()).Info("starting")
+	_, err := os.Stat(filepath.Join(Dir, "my-udf.log"))
+	assert.True(t, os.IsNotExist(err))
+
+	md, _ := metadata.FromOutgoingContext(OutgoingContext(context.Background(), "msg-1", "msg-2"))
+	Logger(metadata.NewIncomingContext(context.Background(), md)).Infow("processing", "key", "value")
+	md, _ = metadata.FromOutgoingContext(OutgoingContext(context.Background(), "msg-3"))
+	Logger(metadata.NewIncomingContext(context.Background(), md)).Warn("dropped")
+
+	entries, err := Search([]string{Dir}, "msg-2", DefaultSearchLimit)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+	assert.Equal(t, "my-udf", entries[0].Source)
+	assert.Contains(t, entries[0].Line, `"msg":"processing"`)
+	assert.Contains(t, entries[0].Line, `"key":"value"`)
+	assert.False(t, entries[0].Timestamp.IsZero())
+
+	entries, err = Search([]string{Dir}, "msg", DefaultSearchLimit)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 0)
+}
+
+func TestSearch(t *testing.T) {
+	dir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "udf.log.1"), []byte(strings.Join([]string{
+		`{"level":"info","ts":"2023-10-01T00:00:01Z","msg":"first","messageIds":["msg-1"]}`,
+		`not a json line with msg-1`,
+	}, "\n")), 0o644))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "udf.log"), []byte(strings.Join([]string{
+		`{"level":"info","ts":"2023-10-01T00:00:03Z","msg":"third","messageIds":["msg-1","msg-2"]}`,
+		`{"level":"info","ts":"2023-10-01T00:00:02Z","msg":"second","messageIds":["msg-10"]}`,
+	}, "\n")), 0o644))
+	entries, err := Search([]string{dir, filepath.Join(dir, "not-existing")}, "msg-1", DefaultSearchLimit)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 2)
+	assert.Contains(t, entries[0].Line, "third")
+	assert.Equal(t, "udf", entries[0].Source)
+	assert.Contains(t, entries[1].Line, "first")
+	assert.Equal(t, "udf", entries[1].Source)
+
+	entries, err = Search([]string{dir}, "msg-1", 1)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
+
+func TestRotatingWriter(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "udf.log")
+	w, err := newRotatingWriter(path, 10)
+	assert.NoError(t, err)
+	_, err = w.Write([]byte("0123456\n"))
+	assert.NoError(t, err)
+	_, err = w.Write([]byte("789\n"))
+	assert.NoError(t, err)
+	assert.NoError(t, w.Sync())
+	data, err := os.ReadFile(path + rotatedSuffix)
+	assert.NoError(t, err)
+	assert.Equal(t, "0123456\n", string(data))
+	data, err = os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, "789\n", string(data))
+}
+
+func TestHandler(t *testing.T) {
+	w := httptest.NewRecorder()
+	Handler(w, httptest.NewRequest(http.MethodGet, Path, nil))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = httptest.NewRecorder()
+	Handler(w, httptest.NewRequest(http.MethodGet, Path+"?"+QueryMessageID+"=msg-1", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "[]\n", w.Body.String())
+}
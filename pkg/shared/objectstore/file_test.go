@@ -0,0 +1,39 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package objectstore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileStore(t *testing.T) {
+	s := NewFileStore(t.TempDir())
+	ctx := context.Background()
+	assert.NoError(t, s.Put(ctx, "a/b/1.rec", []byte("one")))
+	assert.NoError(t, s.Put(ctx, "a/c/1.rec", []byte("other")))
+	data, err := s.Get(ctx, "a/b/1.rec")
+	assert.NoError(t, err)
+	assert.Equal(t, "one", string(data))
+	_, err = s.Get(ctx, "a/b/2.rec")
+	assert.ErrorIs(t, err, ErrNotFound)
+	keys, err := s.List(ctx, "a/")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a/b/1.rec", "a/c/1.rec"}, keys)
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package objectstore
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeS3 serves the objects of a bucket in memory, with the list results paginated by one key.
+type fakeS3 struct {
+	lock    sync.Mutex
+	bucket  string
+	objects map[string][]byte
+	auths   []string
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.auths = append(f.auths, r.Header.Get("Authorization"))
+	if !strings.HasPrefix(r.URL.Path, "/"+f.bucket) {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	key := strings.TrimPrefix(strings.TrimPrefix(r.URL.Path, "/"+f.bucket), "/")
+	switch {
+	case r.Method == http.MethodPut:
+		data, _ := io.ReadAll(r.Body)
+		f.objects[key] = data
+	case key == "" && r.URL.Query().Get("list-type") == "2":
+		var keys []string
+		for k := range f.objects {
+			if strings.HasPrefix(k, r.URL.Query().Get("prefix")) && k > r.URL.Query().Get("continuation-token") {
+				keys = append(keys, k)
+			}
+		}
+		sort.Strings(keys)
+		if len(keys) == 0 {
+			_, _ = fmt.Fprint(w, `<ListBucketResult><IsTruncated>false</IsTruncated></ListBucketResult>`)
+			return
+		}
+		_, _ = fmt.Fprintf(w, `<ListBucketResult><Contents><Key>%s</Key></Contents><IsTruncated>%v</IsTruncated><NextContinuationToken>%s</NextContinuationToken></ListBucketResult>`, keys[0], len(keys) > 1, keys[0])
+	default:
+		data, ok := f.objects[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write(data)
+	}
+}
+
+func TestS3Store(t *testing.T) {
+	fake := &fakeS3{bucket: "my-bucket", objects: map[string][]byte{}}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	s, err := NewS3Store(S3Config{Endpoint: server.URL, Bucket: "my-bucket", Region: "us-west-2", AccessKeyID: "AKID", SecretAccessKey: "secret"})
+	assert.NoError(t, err)
+	s.(*s3Store).now = func() time.Time { return time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC) }
+	ctx := context.Background()
+
+	assert.NoError(t, s.Put(ctx, "a/b/1 2.rec", []byte("one")))
+	assert.NoError(t, s.Put(ctx, "a/b/3.rec", []byte("three")))
+	assert.NoError(t, s.Put(ctx, "a/c/1.rec", []byte("other")))
+	data, err := s.Get(ctx, "a/b/1 2.rec")
+	assert.NoError(t, err)
+	assert.Equal(t, "one", string(data))
+	_, err = s.Get(ctx, "a/b/2.rec")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	keys, err := s.List(ctx, "a/b/")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a/b/1 2.rec", "a/b/3.rec"}, keys)
+
+	for _, auth := range fake.auths {
+		assert.True(t, strings.HasPrefix(auth, "AWS4-HMAC-SHA256 Credential=AKID/20231001/us-west-2/s3/aws4_request, SignedHeaders=host;x-amz-content-sha256;x-amz-date, Signature="))
+	}
+}
+
+func TestNewS3Store(t *testing.T) {
+	_, err := NewS3Store(S3Config{Endpoint: "minio:9000", Bucket: "b"})
+	assert.Error(t, err)
+	_, err = NewS3Store(S3Config{Endpoint: "http://minio:9000"})
+	assert.Error(t, err)
+}
+
+func TestEscape(t *testing.T) {
+	assert.Equal(t, "a/b%20c/d~e", escape("a/b c/d~e", false))
+	assert.Equal(t, "a%2Fb%3D", escape("a/b=", true))
+	assert.Equal(t, "list-type=2&prefix=a%2Fb", canonicalQuery(map[string][]string{"prefix": {"a/b"}, "list-type": {"2"}}))
+}
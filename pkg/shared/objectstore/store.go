@@ -0,0 +1,36 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package objectstore provides a minimal client of the object storages, to write and read the objects by keys.
+package objectstore
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrNotFound is returned when the object does not exist.
+var ErrNotFound = errors.New("object not found")
+
+// Store reads and writes the objects by keys, the keys are separated by "/".
+type Store interface {
+	// Put writes an object, an existing one with the same key is overwritten.
+	Put(ctx context.Context, key string, data []byte) error
+	// Get reads an object, ErrNotFound is returned if it doesn't exist.
+	Get(ctx context.Context, key string) ([]byte, error)
+	// List returns the keys of the objects with the prefix, in the lexical order.
+	List(ctx context.Context, prefix string) ([]string, error)
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package pause keeps the paused state of a source vertex pod, which stops reading from the source while it's paused.
+// The state is in memory, a restarted or newly scaled up pod is not paused.
+package pause
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync/atomic"
+)
+
+// Path is the path of the pause endpoint served by the metrics server of a vertex pod.
+const Path = "/pause"
+
+// State is the paused state of a pod.
+type State struct {
+	Paused bool `json:"paused"`
+}
+
+var paused atomic.Bool
+
+// Paused tells if the pod is paused.
+func Paused() bool {
+	return paused.Load()
+}
+
+// Set pauses or resumes the pod.
+func Set(p bool) {
+	paused.Store(p)
+}
+
+// Handler returns the paused state with GET, and changes it with PUT, e.g. {"paused":true}.
+func Handler(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+	case http.MethodPut:
+		var s State
+		if err := json.NewDecoder(r.Body).Decode(&s); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		Set(s.Paused)
+	default:
+		w.Header().Set("Allow", "GET, PUT")
+		http.Error(w, "only GET and PUT are supported", http.StatusMethodNotAllowed)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(State{Paused: Paused()})
+}
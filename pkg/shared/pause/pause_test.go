@@ -0,0 +1,56 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pause
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandler(t *testing.T) {
+	defer Set(false)
+	w := httptest.NewRecorder()
+	Handler(w, httptest.NewRequest(http.MethodGet, Path, nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	var s State
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &s))
+	assert.False(t, s.Paused)
+
+	w = httptest.NewRecorder()
+	Handler(w, httptest.NewRequest(http.MethodPut, Path, strings.NewReader(`{"paused":true}`)))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &s))
+	assert.True(t, s.Paused)
+	assert.True(t, Paused())
+
+	w = httptest.NewRecorder()
+	Handler(w, httptest.NewRequest(http.MethodPut, Path, strings.NewReader(`paused`)))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.True(t, Paused())
+
+	w = httptest.NewRecorder()
+	Handler(w, httptest.NewRequest(http.MethodPost, Path, nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+
+	Set(false)
+	assert.False(t, Paused())
+}
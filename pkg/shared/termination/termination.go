@@ -0,0 +1,93 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package termination categorizes the causes of the container terminations. The numa container writes the cause of
+// its exit to the termination message, which is read by the controller to diagnose the restarts of a vertex.
+package termination
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+// maxMessageLength is the max length of a termination message accepted by Kubernetes.
+const maxMessageLength = 4096
+
+// causeError is an error with a categorized cause.
+type causeError struct {
+	cause dfv1.RestartCause
+	err   error
+}
+
+func (e *causeError) Error() string {
+	return e.err.Error()
+}
+
+func (e *causeError) Unwrap() error {
+	return e.err
+}
+
+// WithCause attaches a cause to an error, the error message is not changed.
+func WithCause(cause dfv1.RestartCause, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &causeError{cause: cause, err: err}
+}
+
+// CauseOf returns the cause attached to an error, or RestartCauseError if there's none.
+func CauseOf(err error) dfv1.RestartCause {
+	var ce *causeError
+	if errors.As(err, &ce) {
+		return ce.cause
+	}
+	return dfv1.RestartCauseError
+}
+
+// FormatMessage formats the termination message of an error, which is the cause followed by the error message.
+func FormatMessage(err error) string {
+	msg := fmt.Sprintf("%s: %s", CauseOf(err), err.Error())
+	if len(msg) > maxMessageLength {
+		msg = msg[:maxMessageLength]
+	}
+	return msg
+}
+
+// WriteMessage writes the termination message of an error to the given path.
+func WriteMessage(path string, err error) error {
+	return os.WriteFile(path, []byte(FormatMessage(err)), 0644)
+}
+
+// Diagnose returns the cause and the message of a container termination.
+func Diagnose(state *corev1.ContainerStateTerminated) (dfv1.RestartCause, string) {
+	if state.Reason == string(dfv1.RestartCauseOOMKilled) {
+		return dfv1.RestartCauseOOMKilled, state.Message
+	}
+	prefix, msg, found := strings.Cut(state.Message, ": ")
+	if found {
+		switch c := dfv1.RestartCause(prefix); c {
+		case dfv1.RestartCauseUDSHandshakeFailed, dfv1.RestartCauseISBConnectFailed, dfv1.RestartCauseError:
+			return c, msg
+		}
+	}
+	return dfv1.RestartCauseError, state.Message
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package termination
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+func TestCauseOf(t *testing.T) {
+	assert.Nil(t, WithCause(dfv1.RestartCauseISBConnectFailed, nil))
+	err := fmt.Errorf("failed to start, %w", WithCause(dfv1.RestartCauseUDSHandshakeFailed, fmt.Errorf("connection refused")))
+	assert.Equal(t, "failed to start, connection refused", err.Error())
+	assert.Equal(t, dfv1.RestartCauseUDSHandshakeFailed, CauseOf(err))
+	assert.Equal(t, dfv1.RestartCauseError, CauseOf(fmt.Errorf("failed")))
+}
+
+func TestWriteMessage(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "termination-log")
+	assert.NoError(t, WriteMessage(path, WithCause(dfv1.RestartCauseISBConnectFailed, fmt.Errorf("nats: no servers available"))))
+	b, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, "ISBConnectFailed: nats: no servers available", string(b))
+
+	assert.Len(t, FormatMessage(fmt.Errorf("%s", strings.Repeat("x", 2*maxMessageLength))), maxMessageLength)
+}
+
+func TestDiagnose(t *testing.T) {
+	cause, msg := Diagnose(&corev1.ContainerStateTerminated{Reason: "OOMKilled", ExitCode: 137})
+	assert.Equal(t, dfv1.RestartCauseOOMKilled, cause)
+	assert.Empty(t, msg)
+
+	cause, msg = Diagnose(&corev1.ContainerStateTerminated{Reason: "Error", ExitCode: 2, Message: "ISBConnectFailed: nats: no servers available"})
+	assert.Equal(t, dfv1.RestartCauseISBConnectFailed, cause)
+	assert.Equal(t, "nats: no servers available", msg)
+
+	cause, msg = Diagnose(&corev1.ContainerStateTerminated{Reason: "Error", ExitCode: 1, Message: "panic: something"})
+	assert.Equal(t, dfv1.RestartCauseError, cause)
+	assert.Equal(t, "panic: something", msg)
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/numaproj/numaflow/pkg/isb"
+)
+
+// Span is the span of a message in a vertex, the phases of processing the message are its child spans.
+// A nil Span is a no-op, which is used for the messages not being traced.
+type Span struct {
+	ctx    context.Context
+	tracer trace.Tracer
+	span   trace.Span
+}
+
+// StartPhase starts a child span of the phase, and returns a context carrying it. The returned function ends the
+// phase span, recording the error if there's any.
+func (s *Span) StartPhase(ctx context.Context, name string) (context.Context, func(error)) {
+	if s == nil {
+		return ctx, func(error) {}
+	}
+	_, span := s.tracer.Start(s.ctx, name)
+	return trace.ContextWithSpan(ctx, span), func(err error) {
+		endSpan(span, err, time.Now())
+	}
+}
+
+// Phase records a child span of the phase which has already been done.
+func (s *Span) Phase(name string, start, end time.Time, err error) {
+	if s == nil {
+		return
+	}
+	_, span := s.tracer.Start(s.ctx, name, trace.WithTimestamp(start))
+	endSpan(span, err, end)
+}
+
+// TraceContext returns the trace context to be carried by the messages written by the vertex.
+func (s *Span) TraceContext() string {
+	if s == nil {
+		return ""
+	}
+	return Inject(s.ctx)
+}
+
+// End ends the span, recording the error if there's any.
+func (s *Span) End(err error) {
+	if s == nil {
+		return
+	}
+	endSpan(s.span, err, time.Now())
+}
+
+func endSpan(span trace.Span, err error, end time.Time) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End(trace.WithTimestamp(end))
+}
+
+// Spans are the spans of a batch of messages, indexed the same as the messages.
+type Spans []*Span
+
+// StartSpans starts a span named after the vertex for each of the messages carrying a trace context, the messages
+// without a trace context are not traced unless root is true, in which case they start new traces. Root should
+// only be used by the sources. It returns nil if tracing is not enabled.
+func StartSpans(ctx context.Context, vertexName string, messages []*isb.ReadMessage, start time.Time, root bool) Spans {
+	t := getTracer()
+	if t == nil {
+		return nil
+	}
+	spans := make(Spans, len(messages))
+	for i, m := range messages {
+		if m.TraceContext == "" && !root {
+			continue
+		}
+		spanCtx, span := t.Start(Extract(ctx, m.TraceContext), vertexName,
+			trace.WithTimestamp(start), trace.WithSpanKind(trace.SpanKindConsumer))
+		spans[i] = &Span{ctx: spanCtx, tracer: t, span: span}
+	}
+	return spans
+}
+
+// Get returns the span of the i-th message, or nil if it's not traced.
+func (ss Spans) Get(i int) *Span {
+	if i < 0 || i >= len(ss) {
+		return nil
+	}
+	return ss[i]
+}
+
+// Phase records a child span of the phase, which has been done for all the messages.
+func (ss Spans) Phase(name string, start, end time.Time, err error) {
+	for _, s := range ss {
+		s.Phase(name, start, end, err)
+	}
+}
+
+// End ends all the spans, recording the error if there's any.
+func (ss Spans) End(err error) {
+	for _, s := range ss {
+		s.End(err)
+	}
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/numaproj/numaflow/pkg/isb"
+)
+
+const testTraceContext = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+
+func TestStartSpans_Disabled(t *testing.T) {
+	setTracerProvider(nil)
+	spans := StartSpans(context.Background(), "v", []*isb.ReadMessage{{}}, time.Now(), true)
+	assert.Nil(t, spans)
+	assert.Nil(t, spans.Get(0))
+	assert.Equal(t, "", spans.Get(0).TraceContext())
+	ctx, end := spans.Get(0).StartPhase(context.Background(), "apply")
+	end(nil)
+	assert.Equal(t, context.Background(), ctx)
+	spans.Phase("write", time.Now(), time.Now(), nil)
+	spans.End(nil)
+}
+
+func TestStartSpans(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	setTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	defer setTracerProvider(nil)
+
+	messages := []*isb.ReadMessage{
+		{Message: isb.Message{Header: isb.Header{MessageInfo: isb.MessageInfo{TraceContext: testTraceContext}}}},
+		{},
+	}
+	start := time.Now()
+	spans := StartSpans(context.Background(), "map", messages, start, false)
+	require.Len(t, spans, 2)
+	assert.Nil(t, spans.Get(1))
+
+	span := spans.Get(0)
+	tc := span.TraceContext()
+	assert.Contains(t, tc, "4bf92f3577b34da6a3ce929d0e0e4736")
+	assert.NotEqual(t, testTraceContext, tc)
+
+	spans.Phase("read", start, time.Now(), nil)
+	ctx, end := span.StartPhase(context.Background(), "apply")
+	md, ok := metadata.FromOutgoingContext(OutgoingContext(ctx))
+	require.True(t, ok)
+	assert.Contains(t, md.Get("traceparent")[0], "4bf92f3577b34da6a3ce929d0e0e4736")
+	end(errors.New("udf error"))
+	spans.End(nil)
+
+	ended := recorder.Ended()
+	require.Len(t, ended, 3)
+	assert.Equal(t, "read", ended[0].Name())
+	assert.Equal(t, "apply", ended[1].Name())
+	assert.Equal(t, "Error", ended[1].Status().Code.String())
+	assert.Equal(t, "map", ended[2].Name())
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", ended[2].Parent().TraceID().String())
+	assert.Equal(t, ended[2].SpanContext().SpanID(), ended[0].Parent().SpanID())
+}
+
+func TestStartSpans_Root(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	setTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	defer setTracerProvider(nil)
+
+	spans := StartSpans(context.Background(), "in", []*isb.ReadMessage{{}}, time.Now(), true)
+	require.NotNil(t, spans.Get(0))
+	assert.NotEqual(t, "", spans.Get(0).TraceContext())
+	spans.End(nil)
+	ended := recorder.Ended()
+	require.Len(t, ended, 1)
+	assert.False(t, ended[0].Parent().IsValid())
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package tracing emits the OpenTelemetry spans of the messages flowing through a pipeline, and propagates the W3C
+// trace context of the messages across the vertices and to the gRPC calls of the user defined containers.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/metadata"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+const instrumentationName = "github.com/numaproj/numaflow"
+
+var (
+	lock       sync.RWMutex
+	tracer     trace.Tracer
+	propagator = propagation.TraceContext{}
+)
+
+// Init initializes the tracer of the vertex with the tracing settings of the pipeline. The returned function flushes
+// the pending spans and shuts the exporter down.
+func Init(ctx context.Context, vertexInstance *dfv1.VertexInstance) (func(context.Context) error, error) {
+	t := vertexInstance.Vertex.Spec.Tracing
+	if t == nil || t.OTLP == nil {
+		return nil, fmt.Errorf("no trace exporter configured")
+	}
+	opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(t.OTLP.Endpoint)}
+	if t.OTLP.Insecure {
+		opts = append(opts, otlptracegrpc.WithInsecure())
+	}
+	if len(t.OTLP.Headers) > 0 {
+		opts = append(opts, otlptracegrpc.WithHeaders(t.OTLP.Headers))
+	}
+	exporter, err := otlptracegrpc.New(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create otlp trace exporter, %w", err)
+	}
+	res := resource.NewWithAttributes(semconv.SchemaURL,
+		semconv.ServiceName(vertexInstance.Vertex.Spec.PipelineName),
+		semconv.ServiceInstanceID(vertexInstance.Hostname),
+		attribute.String("numaflow.vertex", vertexInstance.Vertex.Spec.Name),
+		attribute.Int("numaflow.replica", int(vertexInstance.Replica)),
+	)
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(t.GetSamplingRatio()))),
+	)
+	setTracerProvider(tp)
+	return tp.Shutdown, nil
+}
+
+func setTracerProvider(tp trace.TracerProvider) {
+	lock.Lock()
+	defer lock.Unlock()
+	if tp == nil {
+		tracer = nil
+		return
+	}
+	tracer = tp.Tracer(instrumentationName)
+}
+
+func getTracer() trace.Tracer {
+	lock.RLock()
+	defer lock.RUnlock()
+	return tracer
+}
+
+// Enabled returns true if the tracer has been initialized.
+func Enabled() bool {
+	return getTracer() != nil
+}
+
+// Extract returns a copy of ctx carrying the remote span context of the traceContext.
+func Extract(ctx context.Context, traceContext string) context.Context {
+	if traceContext == "" {
+		return ctx
+	}
+	return propagator.Extract(ctx, propagation.MapCarrier{dfv1.KeyMetaTraceParent: traceContext})
+}
+
+// Inject returns the trace context of the span in ctx, or an empty string if there's no valid span.
+func Inject(ctx context.Context) string {
+	carrier := propagation.MapCarrier{}
+	propagator.Inject(ctx, carrier)
+	return carrier.Get(dfv1.KeyMetaTraceParent)
+}
+
+// OutgoingContext returns a copy of ctx with the trace context of the span in ctx appended to the outgoing gRPC
+// metadata, so that the user defined containers could continue the trace.
+func OutgoingContext(ctx context.Context) context.Context {
+	if tc := Inject(ctx); tc != "" {
+		return metadata.AppendToOutgoingContext(ctx, dfv1.KeyMetaTraceParent, tc)
+	}
+	return ctx
+}
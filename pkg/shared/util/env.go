@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"time"
 )
 
 func LookupEnvStringOr(key, defaultValue string) string {
@@ -41,3 +42,15 @@ func LookupEnvIntOr(key string, defaultValue int) int {
 		return defaultValue
 	}
 }
+
+func LookupEnvDurationOr(key string, defaultValue time.Duration) time.Duration {
+	if valStr, existing := os.LookupEnv(key); existing && valStr != "" {
+		val, err := time.ParseDuration(valStr)
+		if err != nil {
+			panic(fmt.Errorf("invalid value for env variable %q, value %q", key, valStr))
+		}
+		return val
+	} else {
+		return defaultValue
+	}
+}
@@ -17,7 +17,9 @@ limitations under the License.
 package util
 
 import (
+	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -26,3 +28,12 @@ func TestLookupEnvStringOr(t *testing.T) {
 	assert.Equal(t, LookupEnvStringOr("fake_env", "hello"), "hello")
 	assert.Equal(t, LookupEnvStringOr("HOME", "#")[0], "/"[0])
 }
+
+func TestLookupEnvDurationOr(t *testing.T) {
+	assert.Equal(t, 3*time.Second, LookupEnvDurationOr("fake_env", 3*time.Second))
+	os.Setenv("fake_duration_env", "200ms")
+	defer os.Unsetenv("fake_duration_env")
+	assert.Equal(t, 200*time.Millisecond, LookupEnvDurationOr("fake_duration_env", 3*time.Second))
+	os.Setenv("fake_duration_env", "abc")
+	assert.Panics(t, func() { LookupEnvDurationOr("fake_duration_env", 3*time.Second) })
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"log"
+	"strconv"
+
+	"github.com/numaproj/numaflow-go/pkg/info"
+)
+
+// ServerInfoMaxMessageSizeKey is the key of the server info metadata, which is used by the user container
+// to advertise the max message size it is able to receive and send.
+const ServerInfoMaxMessageSizeKey = "maxMessageSize"
+
+// NegotiateMaxMessageSize returns the effective max message size, which is the smaller one of the given value
+// and the max message size advertised in the server info metadata. The given value is returned if the server
+// doesn't advertise a valid one.
+func NegotiateMaxMessageSize(serverInfo *info.ServerInfo, maxMessageSize int) int {
+	if serverInfo == nil || serverInfo.Metadata == nil {
+		return maxMessageSize
+	}
+	v, ok := serverInfo.Metadata[ServerInfoMaxMessageSizeKey]
+	if !ok {
+		return maxMessageSize
+	}
+	serverMaxMessageSize, err := strconv.Atoi(v)
+	if err != nil || serverMaxMessageSize <= 0 {
+		log.Printf("Invalid max message size %q in the server info, using %d\n", v, maxMessageSize)
+		return maxMessageSize
+	}
+	if serverMaxMessageSize < maxMessageSize {
+		log.Printf("Max message size negotiated with the server: %d\n", serverMaxMessageSize)
+		return serverMaxMessageSize
+	}
+	return maxMessageSize
+}
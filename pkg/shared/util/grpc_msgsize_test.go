@@ -0,0 +1,33 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"testing"
+
+	"github.com/numaproj/numaflow-go/pkg/info"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNegotiateMaxMessageSize(t *testing.T) {
+	assert.Equal(t, 1024, NegotiateMaxMessageSize(nil, 1024))
+	assert.Equal(t, 1024, NegotiateMaxMessageSize(&info.ServerInfo{}, 1024))
+	assert.Equal(t, 1024, NegotiateMaxMessageSize(&info.ServerInfo{Metadata: map[string]string{ServerInfoMaxMessageSizeKey: "abc"}}, 1024))
+	assert.Equal(t, 1024, NegotiateMaxMessageSize(&info.ServerInfo{Metadata: map[string]string{ServerInfoMaxMessageSizeKey: "-1"}}, 1024))
+	assert.Equal(t, 1024, NegotiateMaxMessageSize(&info.ServerInfo{Metadata: map[string]string{ServerInfoMaxMessageSizeKey: "2048"}}, 1024))
+	assert.Equal(t, 512, NegotiateMaxMessageSize(&info.ServerInfo{Metadata: map[string]string{ServerInfoMaxMessageSizeKey: "512"}}, 1024))
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/keepalive"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+// GRPCMethod is a gRPC method that the call policy applies to. All the methods of the service are matched if Method is empty.
+type GRPCMethod struct {
+	Service string
+	Method  string
+	// Idempotent indicates if the method is safe to be called more than once with the same request,
+	// only idempotent methods are hedged.
+	Idempotent bool
+}
+
+// GRPCCallPolicy defines the keepalive, retry and hedging policies of a gRPC client connection to the user container.
+type GRPCCallPolicy struct {
+	// KeepAliveTime is the interval of the client keepalive pings, keepalive is disabled if it's 0.
+	// Note that the server closes the connection if pinged more often than its enforcement policy allows (5m by default).
+	KeepAliveTime time.Duration
+	// KeepAliveTimeout is the time to wait for a keepalive ping ack before closing the connection.
+	KeepAliveTimeout time.Duration
+	// RetryMaxAttempts is the max number of attempts (including the original one) of an RPC failing with UNAVAILABLE,
+	// retry is disabled if it's smaller than 2.
+	RetryMaxAttempts int
+	// RetryInitialBackoff is the backoff before the first retry.
+	RetryInitialBackoff time.Duration
+	// RetryMaxBackoff is the max backoff between retries.
+	RetryMaxBackoff time.Duration
+	// HedgingMaxAttempts is the max number of hedged requests sent for an idempotent RPC, hedging is disabled if it's smaller than 2.
+	// Hedging takes precedence over retry for the idempotent methods.
+	HedgingMaxAttempts int
+	// HedgingDelay is the delay before sending the next hedged request.
+	HedgingDelay time.Duration
+}
+
+// LookupGRPCCallPolicyFromEnv returns the gRPC call policy configured by the environment variables.
+func LookupGRPCCallPolicyFromEnv() *GRPCCallPolicy {
+	return &GRPCCallPolicy{
+		KeepAliveTime:       LookupEnvDurationOr(dfv1.EnvGRPCKeepAliveTime, 0),
+		KeepAliveTimeout:    LookupEnvDurationOr(dfv1.EnvGRPCKeepAliveTimeout, 20*time.Second),
+		RetryMaxAttempts:    LookupEnvIntOr(dfv1.EnvGRPCRetryMaxAttempts, 0),
+		RetryInitialBackoff: LookupEnvDurationOr(dfv1.EnvGRPCRetryInitialBackoff, 100*time.Millisecond),
+		RetryMaxBackoff:     LookupEnvDurationOr(dfv1.EnvGRPCRetryMaxBackoff, time.Second),
+		HedgingMaxAttempts:  LookupEnvIntOr(dfv1.EnvGRPCHedgingMaxAttempts, 0),
+		HedgingDelay:        LookupEnvDurationOr(dfv1.EnvGRPCHedgingDelay, 500*time.Millisecond),
+	}
+}
+
+type methodName struct {
+	Service string `json:"service"`
+	Method  string `json:"method,omitempty"`
+}
+
+type retryPolicy struct {
+	MaxAttempts          int      `json:"maxAttempts"`
+	InitialBackoff       string   `json:"initialBackoff"`
+	MaxBackoff           string   `json:"maxBackoff"`
+	BackoffMultiplier    float64  `json:"backoffMultiplier"`
+	RetryableStatusCodes []string `json:"retryableStatusCodes"`
+}
+
+type hedgingPolicy struct {
+	MaxAttempts  int    `json:"maxAttempts"`
+	HedgingDelay string `json:"hedgingDelay"`
+}
+
+type methodConfig struct {
+	Name          []methodName   `json:"name"`
+	RetryPolicy   *retryPolicy   `json:"retryPolicy,omitempty"`
+	HedgingPolicy *hedgingPolicy `json:"hedgingPolicy,omitempty"`
+}
+
+type serviceConfig struct {
+	LoadBalancingConfig []map[string]struct{} `json:"loadBalancingConfig,omitempty"`
+	MethodConfig        []methodConfig        `json:"methodConfig,omitempty"`
+}
+
+// serviceConfig builds the gRPC service config json applying the policy to the given methods.
+func (p *GRPCCallPolicy) serviceConfig(loadBalancingPolicy string, methods []GRPCMethod) (string, error) {
+	sc := serviceConfig{}
+	if loadBalancingPolicy != "" {
+		sc.LoadBalancingConfig = []map[string]struct{}{{loadBalancingPolicy: {}}}
+	}
+	if p != nil {
+		var retryNames, hedgingNames []methodName
+		for _, m := range methods {
+			name := methodName{Service: m.Service, Method: m.Method}
+			if m.Idempotent && p.HedgingMaxAttempts > 1 {
+				hedgingNames = append(hedgingNames, name)
+			} else if p.RetryMaxAttempts > 1 {
+				retryNames = append(retryNames, name)
+			}
+		}
+		if len(retryNames) > 0 {
+			sc.MethodConfig = append(sc.MethodConfig, methodConfig{
+				Name: retryNames,
+				RetryPolicy: &retryPolicy{
+					MaxAttempts:          p.RetryMaxAttempts,
+					InitialBackoff:       durationString(p.RetryInitialBackoff),
+					MaxBackoff:           durationString(p.RetryMaxBackoff),
+					BackoffMultiplier:    2,
+					RetryableStatusCodes: []string{"UNAVAILABLE"},
+				},
+			})
+		}
+		if len(hedgingNames) > 0 {
+			sc.MethodConfig = append(sc.MethodConfig, methodConfig{
+				Name: hedgingNames,
+				HedgingPolicy: &hedgingPolicy{
+					MaxAttempts:  p.HedgingMaxAttempts,
+					HedgingDelay: durationString(p.HedgingDelay),
+				},
+			})
+		}
+	}
+	if len(sc.LoadBalancingConfig) == 0 && len(sc.MethodConfig) == 0 {
+		return "", nil
+	}
+	b, err := json.Marshal(sc)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal gRPC service config, %w", err)
+	}
+	return string(b), nil
+}
+
+// GRPCDialOptions returns the dial options with the max message size, and the call policy applied to the given methods.
+func GRPCDialOptions(maxMessageSize int, loadBalancingPolicy string, policy *GRPCCallPolicy, methods ...GRPCMethod) ([]grpc.DialOption, error) {
+	opts := []grpc.DialOption{
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(maxMessageSize), grpc.MaxCallSendMsgSize(maxMessageSize)),
+	}
+	sc, err := policy.serviceConfig(loadBalancingPolicy, methods)
+	if err != nil {
+		return nil, err
+	}
+	if sc != "" {
+		opts = append(opts, grpc.WithDefaultServiceConfig(sc))
+	}
+	if policy != nil && policy.KeepAliveTime > 0 {
+		opts = append(opts, grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:                policy.KeepAliveTime,
+			Timeout:             policy.KeepAliveTimeout,
+			PermitWithoutStream: true,
+		}))
+	}
+	return opts, nil
+}
+
+// durationString formats the duration in the protobuf json format of google.protobuf.Duration, e.g. "0.1s".
+func durationString(d time.Duration) string {
+	return fmt.Sprintf("%gs", d.Seconds())
+}
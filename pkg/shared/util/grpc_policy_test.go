@@ -0,0 +1,81 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+var testMethods = []GRPCMethod{
+	{Service: "map.v1.Map", Method: "MapFn", Idempotent: true},
+	{Service: "source.v1.Source", Method: "AckFn"},
+}
+
+func TestLookupGRPCCallPolicyFromEnv(t *testing.T) {
+	p := LookupGRPCCallPolicyFromEnv()
+	assert.Equal(t, time.Duration(0), p.KeepAliveTime)
+	assert.Equal(t, 0, p.RetryMaxAttempts)
+	assert.Equal(t, 0, p.HedgingMaxAttempts)
+	os.Setenv(dfv1.EnvGRPCRetryMaxAttempts, "3")
+	os.Setenv(dfv1.EnvGRPCKeepAliveTime, "1m")
+	defer os.Unsetenv(dfv1.EnvGRPCRetryMaxAttempts)
+	defer os.Unsetenv(dfv1.EnvGRPCKeepAliveTime)
+	p = LookupGRPCCallPolicyFromEnv()
+	assert.Equal(t, time.Minute, p.KeepAliveTime)
+	assert.Equal(t, 3, p.RetryMaxAttempts)
+}
+
+func TestGRPCCallPolicy_serviceConfig(t *testing.T) {
+	t.Run("test nil policy", func(t *testing.T) {
+		var p *GRPCCallPolicy
+		sc, err := p.serviceConfig("", testMethods)
+		assert.NoError(t, err)
+		assert.Equal(t, "", sc)
+		sc, err = p.serviceConfig("round_robin", testMethods)
+		assert.NoError(t, err)
+		assert.Equal(t, `{"loadBalancingConfig":[{"round_robin":{}}]}`, sc)
+	})
+
+	t.Run("test retry", func(t *testing.T) {
+		p := &GRPCCallPolicy{RetryMaxAttempts: 3, RetryInitialBackoff: 100 * time.Millisecond, RetryMaxBackoff: time.Second}
+		sc, err := p.serviceConfig("", testMethods)
+		assert.NoError(t, err)
+		assert.Equal(t, `{"methodConfig":[{"name":[{"service":"map.v1.Map","method":"MapFn"},{"service":"source.v1.Source","method":"AckFn"}],"retryPolicy":{"maxAttempts":3,"initialBackoff":"0.1s","maxBackoff":"1s","backoffMultiplier":2,"retryableStatusCodes":["UNAVAILABLE"]}}]}`, sc)
+	})
+
+	t.Run("test hedging only applies to idempotent methods", func(t *testing.T) {
+		p := &GRPCCallPolicy{RetryMaxAttempts: 3, RetryInitialBackoff: 100 * time.Millisecond, RetryMaxBackoff: time.Second, HedgingMaxAttempts: 2, HedgingDelay: 500 * time.Millisecond}
+		sc, err := p.serviceConfig("", testMethods)
+		assert.NoError(t, err)
+		assert.Equal(t, `{"methodConfig":[{"name":[{"service":"source.v1.Source","method":"AckFn"}],"retryPolicy":{"maxAttempts":3,"initialBackoff":"0.1s","maxBackoff":"1s","backoffMultiplier":2,"retryableStatusCodes":["UNAVAILABLE"]}},{"name":[{"service":"map.v1.Map","method":"MapFn"}],"hedgingPolicy":{"maxAttempts":2,"hedgingDelay":"0.5s"}}]}`, sc)
+	})
+}
+
+func TestGRPCDialOptions(t *testing.T) {
+	opts, err := GRPCDialOptions(1024, "", nil, testMethods...)
+	assert.NoError(t, err)
+	assert.Len(t, opts, 1)
+	opts, err = GRPCDialOptions(1024, "round_robin", &GRPCCallPolicy{KeepAliveTime: time.Minute, KeepAliveTimeout: 20 * time.Second, RetryMaxAttempts: 3}, testMethods...)
+	assert.NoError(t, err)
+	assert.Len(t, opts, 3)
+}
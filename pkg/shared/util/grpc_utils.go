@@ -18,6 +18,7 @@ package util
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"log"
 	"time"
@@ -26,6 +27,7 @@ import (
 	"github.com/numaproj/numaflow-go/pkg/shared"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/status"
 
@@ -40,7 +42,7 @@ func ToUDFErr(name string, err error) error {
 	}
 	statusCode, ok := status.FromError(err)
 	// default udfError
-	udfError := sdkerr.New(sdkerr.NonRetryable, statusCode.Message())
+	udfError := sdkerr.NewWithCode(sdkerr.NonRetryable, statusCode.Code(), statusCode.Message())
 	// check if it's a standard status code
 	if !ok {
 		// if not, the status code will be unknown which we consider as non retryable
@@ -53,7 +55,7 @@ func ToUDFErr(name string, err error) error {
 		return nil
 	case codes.DeadlineExceeded, codes.Unavailable, codes.Unknown:
 		// update to retryable err
-		udfError = sdkerr.New(sdkerr.Retryable, statusCode.Message())
+		udfError = sdkerr.NewWithCode(sdkerr.Retryable, statusCode.Code(), statusCode.Message())
 		log.Printf("failed %s: %s", name, udfError.Error())
 		return udfError
 	default:
@@ -80,8 +82,10 @@ func WaitForServerInfo(timeout time.Duration, filePath string) (*info.ServerInfo
 }
 
 // ConnectToServer connects to the server with the given socket address based on the server info protocol.
-func ConnectToServer(udsSockAddr string, tcpSockAddr string, serverInfo *info.ServerInfo, maxMessageSize int) (*grpc.ClientConn, error) {
+// The call policy, if provided, is applied to the given methods.
+func ConnectToServer(udsSockAddr string, tcpSockAddr string, serverInfo *info.ServerInfo, maxMessageSize int, policy *GRPCCallPolicy, methods ...GRPCMethod) (*grpc.ClientConn, error) {
 	var conn *grpc.ClientConn
+	var dialOpts []grpc.DialOption
 	var err error
 	var sockAddr string
 
@@ -93,18 +97,21 @@ func ConnectToServer(udsSockAddr string, tcpSockAddr string, serverInfo *info.Se
 			return nil, fmt.Errorf("failed to start Multiproc Client: %w", err)
 		}
 
+		if dialOpts, err = GRPCDialOptions(maxMessageSize, "round_robin", policy, methods...); err != nil {
+			return nil, err
+		}
 		conn, err = grpc.Dial(
 			fmt.Sprintf("%s:///%s", resolver.CustScheme, resolver.CustServiceName),
-			grpc.WithDefaultServiceConfig(`{"loadBalancingConfig": [{"round_robin":{}}]}`),
-			grpc.WithTransportCredentials(insecure.NewCredentials()),
-			grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(maxMessageSize), grpc.MaxCallSendMsgSize(maxMessageSize)),
+			append(dialOpts, grpc.WithTransportCredentials(insecure.NewCredentials()))...,
 		)
 	} else {
 		sockAddr = getUdsSockAddr(udsSockAddr)
 		log.Println("UDS Client:", sockAddr)
 
-		conn, err = grpc.Dial(sockAddr, grpc.WithTransportCredentials(insecure.NewCredentials()),
-			grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(maxMessageSize), grpc.MaxCallSendMsgSize(maxMessageSize)))
+		if dialOpts, err = GRPCDialOptions(maxMessageSize, "", policy, methods...); err != nil {
+			return nil, err
+		}
+		conn, err = grpc.Dial(sockAddr, append(dialOpts, grpc.WithTransportCredentials(insecure.NewCredentials()))...)
 	}
 
 	if err != nil {
@@ -114,6 +121,27 @@ func ConnectToServer(udsSockAddr string, tcpSockAddr string, serverInfo *info.Se
 	return conn, nil
 }
 
+// ConnectToRemoteServer connects to a server serving remotely at the given "host:port" endpoint, using TLS if the TLS
+// config is provided. The addresses the host resolves to are load balanced in a round-robin manner.
+// The call policy, if provided, is applied to the given methods.
+func ConnectToRemoteServer(endpoint string, tlsConfig *tls.Config, maxMessageSize int, policy *GRPCCallPolicy, methods ...GRPCMethod) (*grpc.ClientConn, error) {
+	log.Println("Remote Client:", endpoint)
+	dialOpts, err := GRPCDialOptions(maxMessageSize, "round_robin", policy, methods...)
+	if err != nil {
+		return nil, err
+	}
+	if tlsConfig != nil {
+		dialOpts = append(dialOpts, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+	} else {
+		dialOpts = append(dialOpts, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	}
+	conn, err := grpc.Dial("dns:///"+endpoint, dialOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute grpc.Dial(%q): %w", endpoint, err)
+	}
+	return conn, nil
+}
+
 func getTcpSockAddr(tcpSock string) string {
 	return fmt.Sprintf("%s%s", resolver.ConnAddr, tcpSock)
 }
@@ -73,10 +73,31 @@ func GetIsbSvcEnvVars(isbSvcConfig dfv1.BufferServiceConfig) (dfv1.ISBSvcType, [
 				},
 			})
 		}
+		if x.SentinelUser != "" {
+			env = append(env, corev1.EnvVar{Name: dfv1.EnvISBSvcRedisSentinelUser, Value: x.SentinelUser})
+		}
+		if x.TLS != nil {
+			env = append(env, corev1.EnvVar{Name: dfv1.EnvISBSvcRedisTLSEnabled, Value: "true"})
+			env = append(env, corev1.EnvVar{Name: dfv1.EnvISBSvcRedisTLSInsecure, Value: strconv.FormatBool(x.TLS.InsecureSkipVerify)})
+			// The certificates are passed in the environment variables, so that they are available to all the pods
+			// connecting to the ISB Service, without mounting the secrets.
+			if x.TLS.CACertSecret != nil {
+				env = append(env, secretEnvVar(dfv1.EnvISBSvcRedisTLSCACert, x.TLS.CACertSecret))
+			}
+			if x.TLS.CertSecret != nil {
+				env = append(env, secretEnvVar(dfv1.EnvISBSvcRedisTLSCert, x.TLS.CertSecret))
+			}
+			if x.TLS.KeySecret != nil {
+				env = append(env, secretEnvVar(dfv1.EnvISBSvcRedisTLSKey, x.TLS.KeySecret))
+			}
+		}
 		isbSvcType = dfv1.ISBSvcTypeRedis
 	} else if x := isbSvcConfig.JetStream; x != nil {
 		env = append(env, corev1.EnvVar{Name: dfv1.EnvISBSvcJetStreamURL, Value: x.URL})
 		env = append(env, corev1.EnvVar{Name: dfv1.EnvISBSvcJetStreamTLSEnabled, Value: strconv.FormatBool(x.TLSEnabled)})
+		if x.Domain != "" {
+			env = append(env, corev1.EnvVar{Name: dfv1.EnvISBSvcJetStreamDomain, Value: x.Domain})
+		}
 		if x.Auth != nil && x.Auth.Basic != nil && x.Auth.Basic.User != nil && x.Auth.Basic.Password != nil {
 			env = append(env, corev1.EnvVar{Name: dfv1.EnvISBSvcJetStreamUser, ValueFrom: &corev1.EnvVarSource{
 				SecretKeyRef: &corev1.SecretKeySelector{
@@ -99,3 +120,40 @@ func GetIsbSvcEnvVars(isbSvcConfig dfv1.BufferServiceConfig) (dfv1.ISBSvcType, [
 	}
 	return isbSvcType, env
 }
+
+// GetToPipelineEnvVars generates the environment variables of the connection to the JetStream ISB Service of the to
+// pipeline of the cross-pipeline edges, it's empty if the to pipeline uses the same ISB Service.
+func GetToPipelineEnvVars(edges []dfv1.CombinedEdge) []corev1.EnvVar {
+	for _, e := range edges {
+		x := e.ToPipelineJetStream
+		if x == nil {
+			continue
+		}
+		env := []corev1.EnvVar{
+			{Name: dfv1.EnvToPipelineJetStreamURL, Value: x.URL},
+			{Name: dfv1.EnvToPipelineJetStreamTLSEnabled, Value: strconv.FormatBool(x.TLSEnabled)},
+		}
+		if x.Domain != "" {
+			env = append(env, corev1.EnvVar{Name: dfv1.EnvToPipelineJetStreamDomain, Value: x.Domain})
+		}
+		if x.Auth != nil && x.Auth.Basic != nil && x.Auth.Basic.User != nil && x.Auth.Basic.Password != nil {
+			env = append(env, secretEnvVar(dfv1.EnvToPipelineJetStreamUser, x.Auth.Basic.User))
+			env = append(env, secretEnvVar(dfv1.EnvToPipelineJetStreamPassword, x.Auth.Basic.Password))
+		}
+		return env
+	}
+	return nil
+}
+
+func secretEnvVar(name string, selector *corev1.SecretKeySelector) corev1.EnvVar {
+	return corev1.EnvVar{
+		Name: name, ValueFrom: &corev1.EnvVarSource{
+			SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{
+					Name: selector.Name,
+				},
+				Key: selector.Key,
+			},
+		},
+	}
+}
@@ -74,6 +74,41 @@ func TestGetRedisIsbSvcEnvVars(t *testing.T) {
 	assert.Contains(t, eNames, dfv1.EnvISBSvcRedisSentinelURL)
 }
 
+func TestGetRedisTLSIsbSvcEnvVars(t *testing.T) {
+	fakeIsbSvcConfig := dfv1.BufferServiceConfig{
+		Redis: &dfv1.RedisConfig{
+			SentinelURL:  "xxx",
+			MasterName:   "master",
+			SentinelUser: "sentinel-user",
+			TLS: &dfv1.TLS{
+				CACertSecret: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "redis-tls"},
+					Key:                  "ca.crt",
+				},
+				CertSecret: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "redis-tls"},
+					Key:                  "tls.crt",
+				},
+				KeySecret: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "redis-tls"},
+					Key:                  "tls.key",
+				},
+			},
+		},
+	}
+	_, env := GetIsbSvcEnvVars(fakeIsbSvcConfig)
+	envMap := make(map[string]corev1.EnvVar)
+	for _, e := range env {
+		envMap[e.Name] = e
+	}
+	assert.Equal(t, "sentinel-user", envMap[dfv1.EnvISBSvcRedisSentinelUser].Value)
+	assert.Equal(t, "true", envMap[dfv1.EnvISBSvcRedisTLSEnabled].Value)
+	assert.Equal(t, "false", envMap[dfv1.EnvISBSvcRedisTLSInsecure].Value)
+	assert.Equal(t, "ca.crt", envMap[dfv1.EnvISBSvcRedisTLSCACert].ValueFrom.SecretKeyRef.Key)
+	assert.Equal(t, "tls.crt", envMap[dfv1.EnvISBSvcRedisTLSCert].ValueFrom.SecretKeyRef.Key)
+	assert.Equal(t, "redis-tls", envMap[dfv1.EnvISBSvcRedisTLSKey].ValueFrom.SecretKeyRef.Name)
+}
+
 func TestGetJSIsbSvcEnvVars(t *testing.T) {
 	fakeIsbsConfig := dfv1.BufferServiceConfig{
 		JetStream: &dfv1.JetStreamConfig{
@@ -109,4 +144,33 @@ func TestGetJSIsbSvcEnvVars(t *testing.T) {
 	assert.Contains(t, eNames, dfv1.EnvISBSvcJetStreamUser)
 	assert.Contains(t, eNames, dfv1.EnvISBSvcJetStreamPassword)
 	assert.Contains(t, eNames, dfv1.EnvISBSvcConfig)
+	assert.NotContains(t, eNames, dfv1.EnvISBSvcJetStreamDomain)
+
+	fakeIsbsConfig.JetStream.Domain = "hub"
+	_, env = GetIsbSvcEnvVars(fakeIsbsConfig)
+	assert.Contains(t, env, corev1.EnvVar{Name: dfv1.EnvISBSvcJetStreamDomain, Value: "hub"})
+}
+
+func TestGetToPipelineEnvVars(t *testing.T) {
+	edges := []dfv1.CombinedEdge{{Edge: dfv1.Edge{From: "a", To: "b"}}}
+	assert.Empty(t, GetToPipelineEnvVars(edges))
+	edges = append(edges, dfv1.CombinedEdge{
+		Edge:       dfv1.Edge{From: "a", To: "c"},
+		ToPipeline: &dfv1.PipelineVertex{Namespace: "ns", Pipeline: "pl", Vertex: "c"},
+		ToPipelineJetStream: &dfv1.JetStreamConfig{
+			URL: "nats://other:4222",
+			Auth: &dfv1.NatsAuth{Basic: &dfv1.BasicAuth{
+				User:     &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "s"}, Key: "user"},
+				Password: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "s"}, Key: "password"},
+			}},
+		},
+	})
+	envs := GetToPipelineEnvVars(edges)
+	names := []string{}
+	for _, e := range envs {
+		names = append(names, e.Name)
+	}
+	assert.Equal(t, []string{dfv1.EnvToPipelineJetStreamURL, dfv1.EnvToPipelineJetStreamTLSEnabled, dfv1.EnvToPipelineJetStreamUser, dfv1.EnvToPipelineJetStreamPassword}, names)
+	assert.Equal(t, "nats://other:4222", envs[0].Value)
+	assert.Equal(t, "s", envs[2].ValueFrom.SecretKeyRef.Name)
 }
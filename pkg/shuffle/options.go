@@ -0,0 +1,48 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shuffle
+
+import (
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+type options struct {
+	// shuffleType is the strategy used to pick the partition
+	shuffleType dfv1.ShuffleType
+	// keyIndexes is the indexes of the keys used to calculate the hash, all the keys are used if empty
+	keyIndexes []int
+	// headerName is the name of the header used to calculate the hash by the header strategy
+	headerName string
+}
+
+// Option is to apply different options
+type Option func(*options)
+
+// WithStrategy sets the shuffle type and the keys or the header to hash on from the edge shuffle strategy.
+func WithStrategy(ss *dfv1.ShuffleStrategy) Option {
+	return func(o *options) {
+		if ss == nil {
+			return
+		}
+		o.shuffleType = ss.GetType()
+		o.headerName = ss.HeaderName
+		o.keyIndexes = make([]int, 0, len(ss.KeyIndexes))
+		for _, idx := range ss.KeyIndexes {
+			o.keyIndexes = append(o.keyIndexes, int(idx))
+		}
+	}
+}
@@ -19,6 +19,7 @@ package shuffle
 import (
 	"hash"
 
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/isb"
 
 	"github.com/spaolacci/murmur3"
@@ -30,6 +31,9 @@ type Shuffle struct {
 	// partitionCount is the number of partitions of the buffer owned by the vertex
 	partitionCount int
 	hash           hash.Hash64
+	opts           *options
+	// nextPartition is the partition to be picked next by the round-robin strategy
+	nextPartition int32
 }
 
 // NewShuffle accepts list of buffer identifiers(unique identifier of isb)
@@ -37,7 +41,15 @@ type Shuffle struct {
 // Shuffling before the Vnth vertex creates a key to edge-buffer-index affinity,
 // which will not change from Vn to Vn+1 Reduce vertices if there is no re-keying between these vertices causing
 // idle partitions.
-func NewShuffle(vertexName string, partitionCount int) *Shuffle {
+// By default, it hashes on all the keys, different strategies can be chosen by passing the options.
+func NewShuffle(vertexName string, partitionCount int, inputOptions ...Option) *Shuffle {
+	opts := &options{
+		shuffleType: dfv1.ShuffleTypeHash,
+	}
+	for _, o := range inputOptions {
+		o(opts)
+	}
+
 	// We use vertex name as seed.
 	vertexHash := murmur3.New64()
 	_, _ = vertexHash.Write([]byte(vertexName))
@@ -49,23 +61,39 @@ func NewShuffle(vertexName string, partitionCount int) *Shuffle {
 		// some cases causing idle partitions in reduce edges. We need to revisit the below link
 		// https://softwareengineering.stackexchange.com/questions/49550/which-hashing-algorithm-is-best-for-uniqueness-and-speed
 		hash: murmur3.New64WithSeed(uint32(vertexHash.Sum64())),
+		opts: opts,
 	}
 }
 
-// Shuffle functions returns a shuffled identifier.
-func (s *Shuffle) Shuffle(keys []string) int32 {
-	// hash of the message keys returns a unique hashValue
-	// mod of hashValue will decide which isb it will belong
-	hashValue := s.generateHash(keys)
-	hashValue = hashValue % uint64(s.partitionCount)
-	return int32(hashValue)
+// Shuffle functions returns a shuffled identifier of a message with the keys and the headers.
+func (s *Shuffle) Shuffle(keys []string, headers map[string]string) int32 {
+	switch s.opts.shuffleType {
+	case dfv1.ShuffleTypeRoundRobin:
+		// keys are not taken into account, the partitions are picked one after another
+		partition := s.nextPartition
+		s.nextPartition = (s.nextPartition + 1) % int32(s.partitionCount)
+		return partition
+	case dfv1.ShuffleTypeConsistentHash:
+		return jumpHash(s.generateHash(keys), s.partitionCount)
+	case dfv1.ShuffleTypeHeader:
+		// the keys are not taken into account, a missing header is hashed as an empty value
+		s.hash.Reset()
+		_, _ = s.hash.Write([]byte(headers[s.opts.headerName]))
+		return int32(s.hash.Sum64() % uint64(s.partitionCount))
+	default:
+		// hash of the message keys returns a unique hashValue
+		// mod of hashValue will decide which isb it will belong
+		hashValue := s.generateHash(keys)
+		hashValue = hashValue % uint64(s.partitionCount)
+		return int32(hashValue)
+	}
 }
 
 // ShuffleMessages accepts list of isb messages and returns the mapping of isb to messages
 func (s *Shuffle) ShuffleMessages(messages []*isb.Message) map[int32][]*isb.Message {
 	hashMap := make(map[int32][]*isb.Message)
 	for _, message := range messages {
-		identifier := s.Shuffle(message.Keys)
+		identifier := s.Shuffle(message.Keys, message.Headers)
 		hashMap[identifier] = append(hashMap[identifier], message)
 	}
 	return hashMap
@@ -73,8 +101,29 @@ func (s *Shuffle) ShuffleMessages(messages []*isb.Message) map[int32][]*isb.Mess
 
 func (s *Shuffle) generateHash(keys []string) uint64 {
 	s.hash.Reset()
-	for _, k := range keys {
-		_, _ = s.hash.Write([]byte(k))
+	if len(s.opts.keyIndexes) == 0 {
+		for _, k := range keys {
+			_, _ = s.hash.Write([]byte(k))
+		}
+		return s.hash.Sum64()
+	}
+	for _, idx := range s.opts.keyIndexes {
+		if idx < 0 || idx >= len(keys) {
+			continue
+		}
+		_, _ = s.hash.Write([]byte(keys[idx]))
 	}
 	return s.hash.Sum64()
 }
+
+// jumpHash is the jump consistent hash algorithm by Lamping and Veach (https://arxiv.org/abs/1406.2294).
+// When the number of buckets grows from n to n+1, only 1/(n+1) of the keys move to the new bucket.
+func jumpHash(key uint64, numBuckets int) int32 {
+	var b, j int64 = -1, 0
+	for j < int64(numBuckets) {
+		b = j
+		key = key*2862933555777941757 + 1
+		j = int64(float64(b+1) * (float64(int64(1)<<31) / float64((key>>33)+1)))
+	}
+	return int32(b)
+}
@@ -23,6 +23,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/isb"
 	"github.com/numaproj/numaflow/pkg/isb/testutils"
 )
@@ -104,6 +105,68 @@ func TestShuffler_UseVertexNameAsSeed(t *testing.T) {
 	}
 }
 
+func TestShuffle_RoundRobin(t *testing.T) {
+	roundRobin := dfv1.ShuffleTypeRoundRobin
+	shuffler := NewShuffle("v1", 3, WithStrategy(&dfv1.ShuffleStrategy{Type: &roundRobin}))
+	var got []int32
+	for i := 0; i < 6; i++ {
+		got = append(got, shuffler.Shuffle([]string{"same-key"}, nil))
+	}
+	assert.Equal(t, []int32{0, 1, 2, 0, 1, 2}, got)
+}
+
+func TestShuffle_Header(t *testing.T) {
+	header := dfv1.ShuffleTypeHeader
+	shuffler := NewShuffle("v1", 10, WithStrategy(&dfv1.ShuffleStrategy{Type: &header, HeaderName: "tenant"}))
+	for i := 0; i < 10; i++ {
+		// the keys are ignored, only the header is hashed
+		assert.Equal(t, shuffler.Shuffle([]string{"key-1"}, map[string]string{"tenant": "t1"}), shuffler.Shuffle([]string{fmt.Sprintf("key-%d", i)}, map[string]string{"tenant": "t1", "region": fmt.Sprintf("r%d", i)}))
+	}
+	partitions := make(map[int32]struct{})
+	for i := 0; i < 100; i++ {
+		partitions[shuffler.Shuffle([]string{"key-1"}, map[string]string{"tenant": fmt.Sprintf("t%d", i)})] = struct{}{}
+	}
+	assert.Greater(t, len(partitions), 1)
+	// the messages without the header go to the same partition
+	assert.Equal(t, shuffler.Shuffle([]string{"key-1"}, nil), shuffler.Shuffle([]string{"key-2"}, map[string]string{"region": "eu"}))
+}
+
+func TestShuffle_KeyIndexes(t *testing.T) {
+	shuffler := NewShuffle("v1", 10, WithStrategy(&dfv1.ShuffleStrategy{KeyIndexes: []int32{0}}))
+	allKeysShuffler := NewShuffle("v1", 10)
+	// messages with the same first key go to the same partition, the other keys are ignored
+	for i := 0; i < 100; i++ {
+		assert.Equal(t, shuffler.Shuffle([]string{"tenant-1"}, nil), shuffler.Shuffle([]string{"tenant-1", fmt.Sprintf("user-%d", i)}, nil))
+	}
+	assert.Equal(t, allKeysShuffler.Shuffle([]string{"tenant-1"}, nil), shuffler.Shuffle([]string{"tenant-1", "user-1"}, nil))
+	// out of range indexes are ignored
+	outOfRange := NewShuffle("v1", 10, WithStrategy(&dfv1.ShuffleStrategy{KeyIndexes: []int32{0, 5}}))
+	assert.Equal(t, shuffler.Shuffle([]string{"tenant-1"}, nil), outOfRange.Shuffle([]string{"tenant-1"}, nil))
+}
+
+func TestShuffle_ConsistentHash(t *testing.T) {
+	consistentHash := dfv1.ShuffleTypeConsistentHash
+	strategy := &dfv1.ShuffleStrategy{Type: &consistentHash}
+	messages := buildTestMessagesWithDistinctKeys(1000)
+	shuffler10 := NewShuffle("v1", 10, WithStrategy(strategy))
+	shuffler11 := NewShuffle("v1", 11, WithStrategy(strategy))
+	moved := 0
+	for _, m := range messages {
+		p10 := shuffler10.Shuffle(m.Keys, nil)
+		p11 := shuffler11.Shuffle(m.Keys, nil)
+		assert.True(t, p10 >= 0 && p10 < 10)
+		assert.True(t, p11 >= 0 && p11 < 11)
+		if p10 != p11 {
+			// keys can only move to the newly added partition
+			assert.Equal(t, int32(10), p11)
+			moved++
+		}
+	}
+	// roughly 1/11 of the keys are expected to move
+	assert.Less(t, moved, 200)
+	assert.Greater(t, moved, 0)
+}
+
 // isSameShuffleDistribution performs a simple count check to ensure that the two input maps have the same distribution of elements.
 // For a more strict verification, one could compare the contents of the two distributions, which would require sorting the elements.
 func isSameShuffleDistribution(a, b map[int32][]*isb.Message) bool {
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/nats-io/nats.go"
 	cronlib "github.com/robfig/cron/v3"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -105,6 +106,23 @@ func (sim *sideInputsManager) Start(ctx context.Context) error {
 		}
 	}
 
+	// Serve the refresh requests sent through the daemon service, only one of the replicas handles each request.
+	sub, err := natsClient.QueueSubscribe(isbsvc.JetStreamSideInputRefreshSubject(sim.sideInputsStore, sim.sideInput.Name), sim.sideInput.Name, func(msg *nats.Msg) {
+		log.Info("Refreshing Side Input on request ...")
+		var reply []byte
+		if err := sim.execute(ctx, sideInputClient, siStore); err != nil {
+			log.Errorw("Failed to refresh Side Input on request.", zap.Error(err))
+			reply = []byte(err.Error())
+		}
+		if err := msg.Respond(reply); err != nil {
+			log.Warnw("Failed to reply the Side Input refresh request", zap.Error(err))
+		}
+	})
+	if err != nil {
+		return fmt.Errorf("failed to subscribe to the refresh requests: %w", err)
+	}
+	defer func() { _ = sub.Unsubscribe() }()
+
 	cron, err := resolveCron(sim.sideInput.Trigger, f)
 	if err != nil {
 		return fmt.Errorf("failed to resolve cron: %w", err)
@@ -39,7 +39,7 @@ var (
 type myForwardToAllTest struct {
 }
 
-func (f myForwardToAllTest) WhereTo(_ []string, _ []string) ([]forward.VertexBuffer, error) {
+func (f myForwardToAllTest) WhereTo(_ []string, _ []string, _ string, _ map[string]string) ([]forward.VertexBuffer, error) {
 	return []forward.VertexBuffer{{
 		ToVertexName:         "to1",
 		ToVertexPartitionIdx: 0,
@@ -150,7 +150,7 @@ func TestBlackhole_ForwardToTwoVertex(t *testing.T) {
 }
 
 func getSinkGoWhereDecider(vertexName string) forward.GoWhere {
-	fsd := forward.GoWhere(func(keys []string, tags []string) ([]forward.VertexBuffer, error) {
+	fsd := forward.GoWhere(func(keys []string, tags []string, schemaVersion string, _ map[string]string) ([]forward.VertexBuffer, error) {
 		var result []forward.VertexBuffer
 		result = append(result, forward.VertexBuffer{
 			ToVertexName:         vertexName,
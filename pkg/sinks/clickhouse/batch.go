@@ -0,0 +1,59 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clickhouse
+
+import (
+	"context"
+	"time"
+
+	"github.com/numaproj/numaflow/pkg/isb"
+)
+
+// batchingReader keeps reading until the batch is full by rows or bytes, or the max wait elapses, so that the
+// inserts are not as small as the reads. The messages are acknowledged by the forwarder after the batch is inserted.
+type batchingReader struct {
+	isb.BufferReader
+	maxRows  int
+	maxBytes int
+	maxWait  time.Duration
+}
+
+func newBatchingReader(r isb.BufferReader, maxRows, maxBytes int, maxWait time.Duration) isb.BufferReader {
+	return &batchingReader{BufferReader: r, maxRows: maxRows, maxBytes: maxBytes, maxWait: maxWait}
+}
+
+// Read returns the batch once it's full or the max wait elapses. An empty read of an empty batch is returned right
+// away, so that the forwarder is able to tell the buffer is idle.
+func (r *batchingReader) Read(ctx context.Context, count int64) ([]*isb.ReadMessage, error) {
+	deadline := time.Now().Add(r.maxWait)
+	var batch []*isb.ReadMessage
+	var size int
+	for {
+		n := int64(r.maxRows - len(batch))
+		if count < n {
+			n = count
+		}
+		messages, err := r.BufferReader.Read(ctx, n)
+		for _, m := range messages {
+			size += len(m.Payload)
+		}
+		batch = append(batch, messages...)
+		if err != nil || len(batch) == 0 || len(batch) >= r.maxRows || size >= r.maxBytes || ctx.Err() != nil || !time.Now().Before(deadline) {
+			return batch, err
+		}
+	}
+}
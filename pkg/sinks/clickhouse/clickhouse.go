@@ -0,0 +1,358 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clickhouse
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/forward"
+	"github.com/numaproj/numaflow/pkg/forward/applier"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/metrics"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+	sharedutil "github.com/numaproj/numaflow/pkg/shared/util"
+	"github.com/numaproj/numaflow/pkg/watermark/fetch"
+	"github.com/numaproj/numaflow/pkg/watermark/publish"
+)
+
+// maxErrorBodyLength is the max length of the response body of a failed insert kept in the error.
+const maxErrorBodyLength = 1024
+
+// ToClickHouse inserts the JSON payloads of the output to a ClickHouse table with the HTTP interface.
+type ToClickHouse struct {
+	name         string
+	pipelineName string
+	url          string
+	database     string
+	table        string
+	// columns are the names of the mapped columns in order, empty if the payloads are inserted as they are.
+	columns []string
+	// paths are the field paths of the mapped columns, keyed by the column names.
+	paths       map[string][]string
+	maxRows     int
+	maxBytes    int
+	asyncInsert bool
+	user        string
+	password    string
+	httpClient  *http.Client
+	isdf        *forward.InterStepDataForward
+	log         *zap.SugaredLogger
+}
+
+type Option func(*ToClickHouse) error
+
+func WithLogger(log *zap.SugaredLogger) Option {
+	return func(t *ToClickHouse) error {
+		t.log = log
+		return nil
+	}
+}
+
+// NewToClickHouse returns ToClickHouse type.
+func NewToClickHouse(vertex *dfv1.Vertex,
+	fromBuffer isb.BufferReader,
+	fetchWatermark fetch.Fetcher,
+	publishWatermark map[string]publish.Publisher,
+	whereToDecider forward.GoWhere,
+	opts ...Option) (*ToClickHouse, error) {
+
+	chSink := vertex.Spec.Sink.ClickHouse
+	toCH := &ToClickHouse{
+		name:         vertex.Spec.Name,
+		pipelineName: vertex.Spec.PipelineName,
+		url:          chSink.URL,
+		database:     chSink.GetDatabase(),
+		table:        chSink.Table,
+		paths:        make(map[string][]string),
+		maxRows:      chSink.Batch.GetMaxRows(),
+		maxBytes:     chSink.Batch.GetMaxBytes(),
+		asyncInsert:  chSink.AsyncInsert,
+	}
+	// apply options for clickhouse sink
+	for _, o := range opts {
+		if err := o(toCH); err != nil {
+			return nil, err
+		}
+	}
+
+	// set default logger
+	if toCH.log == nil {
+		toCH.log = logging.NewLogger()
+	}
+	toCH.log = toCH.log.With("sinkType", "clickhouse").With("table", chSink.Table)
+
+	for column, path := range chSink.Columns {
+		toCH.columns = append(toCH.columns, column)
+		toCH.paths[column] = splitPath(path)
+	}
+	sort.Strings(toCH.columns)
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if chSink.TLS != nil {
+		tlsConfig, err := sharedutil.GetTLSConfig(chSink.TLS)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get tls config, %w", err)
+		}
+		transport.TLSClientConfig = tlsConfig
+	}
+	toCH.httpClient = &http.Client{Transport: transport, Timeout: 60 * time.Second}
+
+	if x := chSink.BasicAuth; x != nil {
+		var err error
+		if x.User != nil {
+			if toCH.user, err = sharedutil.GetSecretFromVolume(x.User); err != nil {
+				return nil, fmt.Errorf("failed to get basic auth user, %w", err)
+			}
+		}
+		if x.Password != nil {
+			if toCH.password, err = sharedutil.GetSecretFromVolume(x.Password); err != nil {
+				return nil, fmt.Errorf("failed to get basic auth password, %w", err)
+			}
+		}
+	}
+
+	forwardOpts := []forward.Option{forward.WithVertexType(dfv1.VertexTypeSink), forward.WithLogger(toCH.log)}
+	if x := vertex.Spec.Limits; x != nil {
+		if x.ReadBatchSize != nil {
+			forwardOpts = append(forwardOpts, forward.WithReadBatchSize(int64(*x.ReadBatchSize)))
+		}
+	}
+
+	reader := newBatchingReader(fromBuffer, toCH.maxRows, toCH.maxBytes, chSink.Batch.GetMaxWait())
+	f, err := forward.NewInterStepDataForward(vertex, reader, map[string][]isb.BufferWriter{vertex.Spec.Name: {toCH}}, whereToDecider, applier.Terminal, applier.TerminalMapStream, fetchWatermark, publishWatermark, forwardOpts...)
+	if err != nil {
+		return nil, err
+	}
+	toCH.isdf = f
+	return toCH, nil
+}
+
+// GetName returns the name.
+func (tc *ToClickHouse) GetName() string {
+	return tc.name
+}
+
+// GetPartitionIdx returns the partition index.
+// for sink it is always 0.
+func (tc *ToClickHouse) GetPartitionIdx() int32 {
+	return 0
+}
+
+// row is a row to be inserted in the JSONEachRow format.
+type row struct {
+	// msgIdx is the index of the message the row is built from.
+	msgIdx int
+	id     string
+	data   []byte
+}
+
+// Write inserts the messages with inserts of at most maxRows rows and maxBytes bytes. The messages with an invalid
+// payload are not inserted, and get an error.
+func (tc *ToClickHouse) Write(ctx context.Context, messages []isb.Message) ([]isb.Offset, []error) {
+	errs := make([]error, len(messages))
+	var rows []row
+	var size int
+	for i, msg := range messages {
+		data, err := tc.toRow(msg.Payload)
+		if err != nil {
+			errs[i] = fmt.Errorf("invalid payload, %w", err)
+			continue
+		}
+		if len(rows) > 0 && (len(rows) >= tc.maxRows || size+len(data) > tc.maxBytes) {
+			tc.insert(ctx, rows, errs)
+			rows, size = nil, 0
+		}
+		rows = append(rows, row{msgIdx: i, id: msg.ID, data: data})
+		size += len(data) + 1
+	}
+	if len(rows) > 0 {
+		tc.insert(ctx, rows, errs)
+	}
+	for _, err := range errs {
+		if err != nil {
+			clickhouseSinkWriteErrors.With(map[string]string{metrics.LabelVertex: tc.name, metrics.LabelPipeline: tc.pipelineName}).Inc()
+		} else {
+			clickhouseSinkWriteCount.With(map[string]string{metrics.LabelVertex: tc.name, metrics.LabelPipeline: tc.pipelineName}).Inc()
+		}
+	}
+	return nil, errs
+}
+
+// toRow converts a JSON payload to a row with the column mapping, or compacts it if there's no mapping.
+func (tc *ToClickHouse) toRow(payload []byte) ([]byte, error) {
+	if len(tc.columns) == 0 {
+		// JSONEachRow takes newline delimited json, so the payload needs to be a single line
+		compacted := &bytes.Buffer{}
+		if err := json.Compact(compacted, payload); err != nil {
+			return nil, err
+		}
+		return compacted.Bytes(), nil
+	}
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	// keep the numbers as they are, e.g. the big integers are not rounded to float64
+	decoder.UseNumber()
+	var doc map[string]interface{}
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, err
+	}
+	result := make(map[string]interface{}, len(tc.columns))
+	for _, column := range tc.columns {
+		if v, ok := lookup(doc, tc.paths[column]); ok {
+			result[column] = v
+		}
+	}
+	return json.Marshal(result)
+}
+
+// splitPath splits the path of a nested field by dots.
+func splitPath(path string) []string {
+	return strings.Split(path, ".")
+}
+
+// lookup returns the value of the field with the path in the document.
+func lookup(doc map[string]interface{}, path []string) (interface{}, bool) {
+	var v interface{} = doc
+	for _, field := range path {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if v, ok = m[field]; !ok {
+			return nil, false
+		}
+	}
+	return v, true
+}
+
+// dedupToken derives the insert deduplication token from the IDs of the messages, so that a retried insert of the
+// same messages is deduplicated by ClickHouse.
+func dedupToken(rows []row) string {
+	h := sha256.New()
+	for _, r := range rows {
+		h.Write([]byte(r.id))
+		h.Write([]byte{'\n'})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// quoteIdentifier quotes a database, table or column name.
+func quoteIdentifier(name string) string {
+	return "`" + strings.ReplaceAll(strings.ReplaceAll(name, `\`, `\\`), "`", "\\`") + "`"
+}
+
+// insertQuery returns the insert statement of the table.
+func (tc *ToClickHouse) insertQuery() string {
+	var b strings.Builder
+	b.WriteString("INSERT INTO ")
+	b.WriteString(quoteIdentifier(tc.database))
+	b.WriteString(".")
+	b.WriteString(quoteIdentifier(tc.table))
+	if len(tc.columns) > 0 {
+		quoted := make([]string, len(tc.columns))
+		for i, column := range tc.columns {
+			quoted[i] = quoteIdentifier(column)
+		}
+		b.WriteString(" (")
+		b.WriteString(strings.Join(quoted, ", "))
+		b.WriteString(")")
+	}
+	b.WriteString(" FORMAT JSONEachRow")
+	return b.String()
+}
+
+// insert inserts the rows in one request, the errors are set to the messages of the rows if it fails.
+func (tc *ToClickHouse) insert(ctx context.Context, rows []row, errs []error) {
+	setErr := func(err error) {
+		clickhouseSinkInsertErrors.With(map[string]string{metrics.LabelVertex: tc.name, metrics.LabelPipeline: tc.pipelineName}).Inc()
+		for _, r := range rows {
+			errs[r.msgIdx] = err
+		}
+	}
+	body := &bytes.Buffer{}
+	for _, r := range rows {
+		body.Write(r.data)
+		body.WriteByte('\n')
+	}
+	params := url.Values{}
+	params.Set("query", tc.insertQuery())
+	params.Set("insert_deduplication_token", dedupToken(rows))
+	if tc.asyncInsert {
+		params.Set("async_insert", "1")
+		// wait for the data to be flushed, so that the messages are only acknowledged after they are persisted
+		params.Set("wait_for_async_insert", "1")
+		params.Set("async_insert_deduplicate", "1")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, strings.TrimSuffix(tc.url, "/")+"/?"+params.Encode(), body)
+	if err != nil {
+		setErr(fmt.Errorf("failed to create insert request, %w", err))
+		return
+	}
+	req.Header.Set("Content-Type", "application/x-ndjson")
+	if tc.user != "" || tc.password != "" {
+		req.SetBasicAuth(tc.user, tc.password)
+	}
+	start := time.Now()
+	resp, err := tc.httpClient.Do(req)
+	if err != nil {
+		setErr(fmt.Errorf("failed to send insert request, %w", err))
+		return
+	}
+	defer func() { _ = resp.Body.Close() }()
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodyLength))
+	clickhouseSinkInsertTime.With(map[string]string{metrics.LabelVertex: tc.name, metrics.LabelPipeline: tc.pipelineName}).Observe(float64(time.Since(start).Microseconds()))
+	if resp.StatusCode >= http.StatusBadRequest {
+		setErr(fmt.Errorf("insert failed, status %d, %s", resp.StatusCode, strings.TrimSpace(string(respBody))))
+		return
+	}
+	tc.log.Debugw("Inserted rows", zap.Int("rows", len(rows)))
+}
+
+func (tc *ToClickHouse) Close() error {
+	tc.httpClient.CloseIdleConnections()
+	return nil
+}
+
+// Start starts sinking to clickhouse.
+func (tc *ToClickHouse) Start() <-chan struct{} {
+	return tc.isdf.Start()
+}
+
+// Stop stops sinking
+func (tc *ToClickHouse) Stop() {
+	tc.isdf.Stop()
+	tc.log.Info("forwarder stopped successfully")
+}
+
+// ForceStop stops sinking
+func (tc *ToClickHouse) ForceStop() {
+	tc.isdf.ForceStop()
+	tc.log.Info("forwarder force stopped successfully")
+}
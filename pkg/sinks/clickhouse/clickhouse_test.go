@@ -0,0 +1,189 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clickhouse
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/isb/stores/simplebuffer"
+	"github.com/numaproj/numaflow/pkg/isb/testutils"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+type insertRequest struct {
+	params url.Values
+	rows   []map[string]interface{}
+}
+
+// fakeClickHouse records the inserts, and fails the ones with a "fail" column.
+type fakeClickHouse struct {
+	lock    sync.Mutex
+	inserts []insertRequest
+}
+
+func (s *fakeClickHouse) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if user, password, _ := r.BasicAuth(); user != "numaflow" || password != "secret" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	insert := insertRequest{params: r.URL.Query()}
+	scanner := bufio.NewScanner(r.Body)
+	for scanner.Scan() {
+		row := map[string]interface{}{}
+		_ = json.Unmarshal(scanner.Bytes(), &row)
+		if _, ok := row["fail"]; ok {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte("Code: 27. DB::Exception: Cannot parse input"))
+			return
+		}
+		insert.rows = append(insert.rows, row)
+	}
+	s.inserts = append(s.inserts, insert)
+}
+
+func newTestToClickHouse(url string, columns map[string]string, maxRows int) *ToClickHouse {
+	toCH := &ToClickHouse{
+		name:         "testVertex",
+		pipelineName: "testPipeline",
+		url:          url,
+		database:     "default",
+		table:        "events",
+		paths:        make(map[string][]string),
+		maxRows:      maxRows,
+		maxBytes:     1024,
+		asyncInsert:  true,
+		user:         "numaflow",
+		password:     "secret",
+		httpClient:   &http.Client{Timeout: 5 * time.Second},
+		log:          logging.NewLogger(),
+	}
+	for column, path := range columns {
+		toCH.columns = append(toCH.columns, column)
+		toCH.paths[column] = splitPath(path)
+	}
+	sort.Strings(toCH.columns)
+	return toCH
+}
+
+func testMessages() []isb.Message {
+	return []isb.Message{
+		{Header: isb.Header{ID: "id-0"}, Body: isb.Body{Payload: []byte("{\n  \"user\": {\"id\": 12345678901234567890},\n  \"name\": \"a\"\n}")}},
+		{Header: isb.Header{ID: "id-1"}, Body: isb.Body{Payload: []byte(`not json`)}},
+		{Header: isb.Header{ID: "id-2"}, Body: isb.Body{Payload: []byte(`{"name": "c"}`)}},
+		{Header: isb.Header{ID: "id-3"}, Body: isb.Body{Payload: []byte(`{"name": "d", "user": {"id": 4}}`)}},
+	}
+}
+
+func TestToClickHouse_Write(t *testing.T) {
+	s := &fakeClickHouse{}
+	server := httptest.NewServer(s)
+	defer server.Close()
+
+	toCH := newTestToClickHouse(server.URL, map[string]string{"user_id": "user.id", "name": "name"}, 2)
+	_, errs := toCH.Write(context.Background(), testMessages())
+	assert.Len(t, errs, 4)
+	assert.NoError(t, errs[0])
+	assert.ErrorContains(t, errs[1], "invalid payload")
+	assert.NoError(t, errs[2])
+	assert.NoError(t, errs[3])
+	// 2 rows in the first insert, 1 in the second
+	assert.Len(t, s.inserts, 2)
+	first := s.inserts[0]
+	assert.Equal(t, "INSERT INTO `default`.`events` (`name`, `user_id`) FORMAT JSONEachRow", first.params.Get("query"))
+	assert.Equal(t, "1", first.params.Get("async_insert"))
+	assert.Equal(t, "1", first.params.Get("wait_for_async_insert"))
+	assert.Equal(t, dedupToken([]row{{id: "id-0"}, {id: "id-2"}}), first.params.Get("insert_deduplication_token"))
+	assert.Equal(t, []map[string]interface{}{{"name": "a", "user_id": 12345678901234567890.0}, {"name": "c"}}, first.rows)
+	assert.Equal(t, []map[string]interface{}{{"name": "d", "user_id": 4.0}}, s.inserts[1].rows)
+
+	// the same messages get the same token when they are retried
+	_, _ = toCH.Write(context.Background(), testMessages())
+	assert.Equal(t, first.params.Get("insert_deduplication_token"), s.inserts[2].params.Get("insert_deduplication_token"))
+}
+
+func TestToClickHouse_WriteWithoutColumns(t *testing.T) {
+	s := &fakeClickHouse{}
+	server := httptest.NewServer(s)
+	defer server.Close()
+
+	toCH := newTestToClickHouse(server.URL, nil, 100)
+	toCH.asyncInsert = false
+	_, errs := toCH.Write(context.Background(), testMessages()[:1])
+	assert.NoError(t, errs[0])
+	assert.Equal(t, "INSERT INTO `default`.`events` FORMAT JSONEachRow", s.inserts[0].params.Get("query"))
+	assert.Empty(t, s.inserts[0].params.Get("async_insert"))
+	assert.Equal(t, "a", s.inserts[0].rows[0]["name"])
+}
+
+func TestToClickHouse_WriteFailure(t *testing.T) {
+	s := &fakeClickHouse{}
+	server := httptest.NewServer(s)
+	defer server.Close()
+
+	toCH := newTestToClickHouse(server.URL, nil, 100)
+	messages := append(testMessages()[:1], isb.Message{Header: isb.Header{ID: "id-4"}, Body: isb.Body{Payload: []byte(`{"fail": true}`)}})
+	_, errs := toCH.Write(context.Background(), messages)
+	// the whole insert fails
+	assert.ErrorContains(t, errs[0], "status 400, Code: 27")
+	assert.ErrorContains(t, errs[1], "status 400, Code: 27")
+}
+
+func TestQuoteIdentifier(t *testing.T) {
+	assert.Equal(t, "`events`", quoteIdentifier("events"))
+	assert.Equal(t, "`a\\`b`", quoteIdentifier("a`b"))
+}
+
+func TestBatchingReader(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	buffer := simplebuffer.NewInMemoryBuffer("test", 100, 0, simplebuffer.WithReadTimeOut(10*time.Millisecond))
+	reader := newBatchingReader(buffer, 5, 1024, time.Second)
+
+	// an empty read is returned right away
+	messages, err := reader.Read(ctx, 2)
+	assert.NoError(t, err)
+	assert.Empty(t, messages)
+
+	_, errs := buffer.Write(ctx, testutils.BuildTestWriteMessages(7, time.Now()))
+	for _, e := range errs {
+		assert.NoError(t, e)
+	}
+	// reads until max rows
+	messages, err = reader.Read(ctx, 2)
+	assert.NoError(t, err)
+	assert.Len(t, messages, 5)
+	// reads the rest until max wait
+	start := time.Now()
+	messages, err = reader.Read(ctx, 2)
+	assert.NoError(t, err)
+	assert.Len(t, messages, 2)
+	assert.GreaterOrEqual(t, time.Since(start), time.Second)
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clickhouse
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/numaproj/numaflow/pkg/metrics"
+)
+
+// clickhouseSinkWriteErrors is used to indicate the number of messages failed to be written to clickhouse
+var clickhouseSinkWriteErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "clickhouse_sink",
+	Name:      "write_error_total",
+	Help:      "Total number of Write Errors",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline})
+
+// clickhouseSinkWriteCount is used to indicate the number of messages written to clickhouse
+var clickhouseSinkWriteCount = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "clickhouse_sink",
+	Name:      "write_total",
+	Help:      "Total number of messages written to clickhouse",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline})
+
+// clickhouseSinkInsertErrors is used to indicate the number of failed inserts
+var clickhouseSinkInsertErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "clickhouse_sink",
+	Name:      "insert_error_total",
+	Help:      "Total number of failed inserts",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline})
+
+// clickhouseSinkInsertTime is a histogram to observe the latencies of the inserts
+var clickhouseSinkInsertTime = promauto.NewHistogramVec(prometheus.HistogramOpts{
+	Subsystem: "clickhouse_sink",
+	Name:      "insert_time",
+	Help:      "Insert latencies (100 microseconds to 5 minutes)",
+	Buckets:   prometheus.ExponentialBucketsRange(100, 60000000*5, 30),
+}, []string{metrics.LabelVertex, metrics.LabelPipeline})
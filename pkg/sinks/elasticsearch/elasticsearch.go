@@ -0,0 +1,422 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elasticsearch
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"text/template"
+	"time"
+
+	"go.uber.org/zap"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/forward"
+	"github.com/numaproj/numaflow/pkg/forward/applier"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/metrics"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+	sharedutil "github.com/numaproj/numaflow/pkg/shared/util"
+	"github.com/numaproj/numaflow/pkg/watermark/fetch"
+	"github.com/numaproj/numaflow/pkg/watermark/publish"
+)
+
+// ToElasticsearch indexes the output to Elasticsearch or OpenSearch with the bulk API.
+type ToElasticsearch struct {
+	name          string
+	pipelineName  string
+	urls          []string
+	next          uint32
+	index         *template.Template
+	fallbackIndex string
+	bulkSize      int
+	authHeader    string
+	writeTimeout  time.Duration
+	httpClient    *http.Client
+	isdf          *forward.InterStepDataForward
+	log           *zap.SugaredLogger
+}
+
+type Option func(*ToElasticsearch) error
+
+func WithLogger(log *zap.SugaredLogger) Option {
+	return func(t *ToElasticsearch) error {
+		t.log = log
+		return nil
+	}
+}
+
+// WithWriteTimeout sets the timeout of a bulk request
+func WithWriteTimeout(timeout time.Duration) Option {
+	return func(t *ToElasticsearch) error {
+		t.writeTimeout = timeout
+		return nil
+	}
+}
+
+// NewToElasticsearch returns ToElasticsearch type.
+func NewToElasticsearch(vertex *dfv1.Vertex,
+	fromBuffer isb.BufferReader,
+	fetchWatermark fetch.Fetcher,
+	publishWatermark map[string]publish.Publisher,
+	whereToDecider forward.GoWhere,
+	opts ...Option) (*ToElasticsearch, error) {
+
+	esSink := vertex.Spec.Sink.Elasticsearch
+	toES := &ToElasticsearch{
+		name:          vertex.Spec.Name,
+		pipelineName:  vertex.Spec.PipelineName,
+		urls:          esSink.URLs,
+		fallbackIndex: esSink.FallbackIndex,
+		bulkSize:      esSink.GetBulkSize(),
+		writeTimeout:  30 * time.Second,
+	}
+	// apply options for elasticsearch sink
+	for _, o := range opts {
+		if err := o(toES); err != nil {
+			return nil, err
+		}
+	}
+
+	// set default logger
+	if toES.log == nil {
+		toES.log = logging.NewLogger()
+	}
+	toES.log = toES.log.With("sinkType", "elasticsearch").With("index", esSink.Index)
+
+	index, err := template.New("index").Parse(esSink.Index)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse index template, %w", err)
+	}
+	toES.index = index
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if esSink.TLS != nil {
+		tlsConfig, err := sharedutil.GetTLSConfig(esSink.TLS)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get tls config, %w", err)
+		}
+		transport.TLSClientConfig = tlsConfig
+	}
+	toES.httpClient = &http.Client{Transport: transport, Timeout: toES.writeTimeout}
+
+	if x := esSink.BasicAuth; x != nil {
+		var user, password string
+		if x.User != nil {
+			if user, err = sharedutil.GetSecretFromVolume(x.User); err != nil {
+				return nil, fmt.Errorf("failed to get basic auth user, %w", err)
+			}
+		}
+		if x.Password != nil {
+			if password, err = sharedutil.GetSecretFromVolume(x.Password); err != nil {
+				return nil, fmt.Errorf("failed to get basic auth password, %w", err)
+			}
+		}
+		toES.authHeader = "Basic " + base64.StdEncoding.EncodeToString([]byte(user+":"+password))
+	} else if x := esSink.APIKey; x != nil {
+		apiKey, err := sharedutil.GetSecretFromVolume(x)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get api key, %w", err)
+		}
+		toES.authHeader = "ApiKey " + apiKey
+	}
+
+	forwardOpts := []forward.Option{forward.WithVertexType(dfv1.VertexTypeSink), forward.WithLogger(toES.log)}
+	if x := vertex.Spec.Limits; x != nil {
+		if x.ReadBatchSize != nil {
+			forwardOpts = append(forwardOpts, forward.WithReadBatchSize(int64(*x.ReadBatchSize)))
+		}
+	}
+
+	f, err := forward.NewInterStepDataForward(vertex, fromBuffer, map[string][]isb.BufferWriter{vertex.Spec.Name: {toES}}, whereToDecider, applier.Terminal, applier.TerminalMapStream, fetchWatermark, publishWatermark, forwardOpts...)
+	if err != nil {
+		return nil, err
+	}
+	toES.isdf = f
+	return toES, nil
+}
+
+// GetName returns the name.
+func (te *ToElasticsearch) GetName() string {
+	return te.name
+}
+
+// GetPartitionIdx returns the partition index.
+// for sink it is always 0.
+func (te *ToElasticsearch) GetPartitionIdx() int32 {
+	return 0
+}
+
+// indexTemplateData is the data the index template is executed with.
+type indexTemplateData struct {
+	Keys      []string
+	ID        string
+	EventTime time.Time
+}
+
+// fallbackDocument wraps a rejected document when it is routed to the fallback index.
+type fallbackDocument struct {
+	Index     string    `json:"index,omitempty"`
+	ID        string    `json:"id,omitempty"`
+	Keys      []string  `json:"keys,omitempty"`
+	EventTime time.Time `json:"eventTime"`
+	ErrorType string    `json:"errorType"`
+	Reason    string    `json:"reason"`
+	Payload   string    `json:"payload"`
+}
+
+type bulkResponse struct {
+	Items []map[string]bulkResponseItem `json:"items"`
+}
+
+type bulkResponseItem struct {
+	Status int `json:"status"`
+	Error  *struct {
+		Type   string `json:"type"`
+		Reason string `json:"reason"`
+	} `json:"error,omitempty"`
+}
+
+// bulkDocument is a document in a bulk request.
+type bulkDocument struct {
+	// msgIdx is the index of the message the document is built from.
+	msgIdx int
+	index  string
+	id     string
+	source []byte
+}
+
+// rejection is a document rejected with a non-retryable error.
+type rejection struct {
+	msgIdx    int
+	index     string
+	errorType string
+	reason    string
+}
+
+// Write indexes the messages with bulk requests of at most bulkSize documents. The documents rejected with a
+// non-retryable error are routed to the fallback index if it is configured.
+func (te *ToElasticsearch) Write(ctx context.Context, messages []isb.Message) ([]isb.Offset, []error) {
+	errs := make([]error, len(messages))
+	for start := 0; start < len(messages); start += te.bulkSize {
+		end := start + te.bulkSize
+		if end > len(messages) {
+			end = len(messages)
+		}
+		te.writeBulk(ctx, messages[start:end], errs[start:end])
+	}
+	for _, err := range errs {
+		if err != nil {
+			elasticsearchSinkWriteErrors.With(map[string]string{metrics.LabelVertex: te.name, metrics.LabelPipeline: te.pipelineName}).Inc()
+		} else {
+			elasticsearchSinkWriteCount.With(map[string]string{metrics.LabelVertex: te.name, metrics.LabelPipeline: te.pipelineName}).Inc()
+		}
+	}
+	return nil, errs
+}
+
+func (te *ToElasticsearch) writeBulk(ctx context.Context, messages []isb.Message, errs []error) {
+	var docs []bulkDocument
+	var rejections []rejection
+	for i, msg := range messages {
+		index, err := te.indexName(msg)
+		if err != nil {
+			rejections = append(rejections, rejection{msgIdx: i, errorType: "index_template_exception", reason: err.Error()})
+			continue
+		}
+		// the bulk api takes newline delimited json, so the payload needs to be a single line
+		source := &bytes.Buffer{}
+		if err := json.Compact(source, msg.Payload); err != nil {
+			rejections = append(rejections, rejection{msgIdx: i, index: index, errorType: "invalid_json_exception", reason: err.Error()})
+			continue
+		}
+		docs = append(docs, bulkDocument{msgIdx: i, index: index, id: msg.ID, source: source.Bytes()})
+	}
+	for i, item := range te.bulk(ctx, docs) {
+		doc := docs[i]
+		switch {
+		case item.err != nil:
+			errs[doc.msgIdx] = item.err
+		case item.Status == http.StatusTooManyRequests || item.Status >= http.StatusInternalServerError:
+			errs[doc.msgIdx] = fmt.Errorf("failed to index document, status %d, %s", item.Status, item.reason())
+		case item.Status >= http.StatusBadRequest:
+			r := rejection{msgIdx: doc.msgIdx, index: doc.index, reason: item.reason()}
+			if item.Error != nil {
+				r.errorType = item.Error.Type
+			}
+			rejections = append(rejections, r)
+		}
+	}
+	if len(rejections) == 0 {
+		return
+	}
+	if te.fallbackIndex == "" {
+		for _, r := range rejections {
+			errs[r.msgIdx] = fmt.Errorf("document rejected, %s: %s", r.errorType, r.reason)
+		}
+		return
+	}
+	te.writeFallback(ctx, messages, rejections, errs)
+}
+
+// writeFallback indexes the rejected documents to the fallback index.
+func (te *ToElasticsearch) writeFallback(ctx context.Context, messages []isb.Message, rejections []rejection, errs []error) {
+	docs := make([]bulkDocument, 0, len(rejections))
+	for _, r := range rejections {
+		msg := messages[r.msgIdx]
+		source, err := json.Marshal(fallbackDocument{
+			Index:     r.index,
+			ID:        msg.ID,
+			Keys:      msg.Keys,
+			EventTime: msg.EventTime,
+			ErrorType: r.errorType,
+			Reason:    r.reason,
+			Payload:   string(msg.Payload),
+		})
+		if err != nil {
+			errs[r.msgIdx] = fmt.Errorf("failed to marshal fallback document, %w", err)
+			continue
+		}
+		docs = append(docs, bulkDocument{msgIdx: r.msgIdx, index: te.fallbackIndex, id: msg.ID, source: source})
+	}
+	for i, item := range te.bulk(ctx, docs) {
+		doc := docs[i]
+		if item.err != nil {
+			errs[doc.msgIdx] = item.err
+		} else if item.Status >= http.StatusBadRequest {
+			errs[doc.msgIdx] = fmt.Errorf("failed to index document to the fallback index, status %d, %s", item.Status, item.reason())
+		} else {
+			te.log.Warnw("Document rejected, routed to the fallback index", zap.String("id", doc.id))
+			elasticsearchSinkFallbackCount.With(map[string]string{metrics.LabelVertex: te.name, metrics.LabelPipeline: te.pipelineName}).Inc()
+		}
+	}
+}
+
+type bulkResult struct {
+	bulkResponseItem
+	err error
+}
+
+func (r bulkResult) reason() string {
+	if r.Error == nil {
+		return ""
+	}
+	return r.Error.Reason
+}
+
+// bulk sends the documents in a bulk request, and returns the results in the same order as the documents.
+func (te *ToElasticsearch) bulk(ctx context.Context, docs []bulkDocument) []bulkResult {
+	results := make([]bulkResult, len(docs))
+	if len(docs) == 0 {
+		return results
+	}
+	setErr := func(err error) []bulkResult {
+		for i := range results {
+			results[i].err = err
+		}
+		return results
+	}
+	body := &bytes.Buffer{}
+	for _, doc := range docs {
+		action := map[string]map[string]string{"index": {"_index": doc.index}}
+		if doc.id != "" {
+			// use the message id as the document id, so that the retries do not create duplicates
+			action["index"]["_id"] = doc.id
+		}
+		actionLine, err := json.Marshal(action)
+		if err != nil {
+			return setErr(fmt.Errorf("failed to marshal bulk action, %w", err))
+		}
+		body.Write(actionLine)
+		body.WriteByte('\n')
+		body.Write(doc.source)
+		body.WriteByte('\n')
+	}
+	// round-robin the nodes, so that a retry goes to a different node
+	url := strings.TrimSuffix(te.urls[int(atomic.AddUint32(&te.next, 1)-1)%len(te.urls)], "/") + "/_bulk"
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, body)
+	if err != nil {
+		return setErr(fmt.Errorf("failed to create bulk request, %w", err))
+	}
+	req.Header.Set("Content-Type", "application/x-ndjson")
+	if te.authHeader != "" {
+		req.Header.Set("Authorization", te.authHeader)
+	}
+	resp, err := te.httpClient.Do(req)
+	if err != nil {
+		return setErr(fmt.Errorf("failed to send bulk request, %w", err))
+	}
+	defer func() { _ = resp.Body.Close() }()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return setErr(fmt.Errorf("failed to read bulk response, %w", err))
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		return setErr(fmt.Errorf("bulk request failed, status %d, %s", resp.StatusCode, string(respBody)))
+	}
+	bulkResp := &bulkResponse{}
+	if err := json.Unmarshal(respBody, bulkResp); err != nil {
+		return setErr(fmt.Errorf("failed to unmarshal bulk response, %w", err))
+	}
+	if len(bulkResp.Items) != len(docs) {
+		return setErr(fmt.Errorf("unexpected number of items in bulk response, expected %d, got %d", len(docs), len(bulkResp.Items)))
+	}
+	for i, item := range bulkResp.Items {
+		results[i].bulkResponseItem = item["index"]
+	}
+	return results
+}
+
+func (te *ToElasticsearch) indexName(msg isb.Message) (string, error) {
+	var b strings.Builder
+	if err := te.index.Execute(&b, indexTemplateData{Keys: msg.Keys, ID: msg.ID, EventTime: msg.EventTime}); err != nil {
+		return "", err
+	}
+	if b.Len() == 0 {
+		return "", fmt.Errorf("empty index name")
+	}
+	return b.String(), nil
+}
+
+func (te *ToElasticsearch) Close() error {
+	te.httpClient.CloseIdleConnections()
+	return nil
+}
+
+// Start starts sinking to elasticsearch.
+func (te *ToElasticsearch) Start() <-chan struct{} {
+	return te.isdf.Start()
+}
+
+// Stop stops sinking
+func (te *ToElasticsearch) Stop() {
+	te.isdf.Stop()
+	te.log.Info("forwarder stopped successfully")
+}
+
+// ForceStop stops sinking
+func (te *ToElasticsearch) ForceStop() {
+	te.isdf.ForceStop()
+	te.log.Info("forwarder force stopped successfully")
+}
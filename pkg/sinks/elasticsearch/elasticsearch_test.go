@@ -0,0 +1,181 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elasticsearch
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"text/template"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+type indexedDocument struct {
+	index  string
+	id     string
+	source map[string]interface{}
+}
+
+// fakeBulkServer rejects the documents with a "reject" field with a mapping error, and throttles the documents
+// with a "throttle" field.
+type fakeBulkServer struct {
+	lock     sync.Mutex
+	requests int
+	indexed  []indexedDocument
+}
+
+func (s *fakeBulkServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.requests++
+	if r.URL.Path != "/_bulk" || r.Header.Get("Authorization") != "ApiKey test" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	var items []map[string]interface{}
+	scanner := bufio.NewScanner(r.Body)
+	for scanner.Scan() {
+		action := map[string]map[string]string{}
+		_ = json.Unmarshal(scanner.Bytes(), &action)
+		scanner.Scan()
+		source := map[string]interface{}{}
+		_ = json.Unmarshal(scanner.Bytes(), &source)
+		item := map[string]interface{}{"status": http.StatusCreated}
+		if _, ok := source["reject"]; ok {
+			item = map[string]interface{}{"status": http.StatusBadRequest, "error": map[string]string{"type": "mapper_parsing_exception", "reason": "failed to parse field [reject]"}}
+		} else if _, ok := source["throttle"]; ok {
+			item = map[string]interface{}{"status": http.StatusTooManyRequests, "error": map[string]string{"type": "es_rejected_execution_exception", "reason": "rejected execution"}}
+		} else {
+			s.indexed = append(s.indexed, indexedDocument{index: action["index"]["_index"], id: action["index"]["_id"], source: source})
+		}
+		items = append(items, map[string]interface{}{"index": item})
+	}
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{"errors": true, "items": items})
+}
+
+func newTestToElasticsearch(t *testing.T, url string, fallbackIndex string, bulkSize int) *ToElasticsearch {
+	index, err := template.New("index").Parse(`logs-{{ index .Keys 0 }}-{{ .EventTime.UTC.Format "2006.01.02" }}`)
+	assert.NoError(t, err)
+	return &ToElasticsearch{
+		name:          "testVertex",
+		pipelineName:  "testPipeline",
+		urls:          []string{url},
+		index:         index,
+		fallbackIndex: fallbackIndex,
+		bulkSize:      bulkSize,
+		authHeader:    "ApiKey test",
+		httpClient:    &http.Client{Timeout: 5 * time.Second},
+		log:           logging.NewLogger(),
+	}
+}
+
+func testMessages() []isb.Message {
+	eventTime := time.Date(2023, 9, 1, 10, 0, 0, 0, time.UTC)
+	return []isb.Message{
+		{
+			Header: isb.Header{ID: "id-0", Keys: []string{"a"}, MessageInfo: isb.MessageInfo{EventTime: eventTime}},
+			Body:   isb.Body{Payload: []byte("{\n  \"name\": \"numaflow\"\n}")},
+		},
+		{
+			Header: isb.Header{ID: "id-1", Keys: []string{"b"}, MessageInfo: isb.MessageInfo{EventTime: eventTime}},
+			Body:   isb.Body{Payload: []byte(`{"reject": "yes"}`)},
+		},
+		{
+			Header: isb.Header{ID: "id-2", Keys: []string{"c"}, MessageInfo: isb.MessageInfo{EventTime: eventTime}},
+			Body:   isb.Body{Payload: []byte(`{"throttle": "yes"}`)},
+		},
+		{
+			Header: isb.Header{ID: "id-3", MessageInfo: isb.MessageInfo{EventTime: eventTime}},
+			Body:   isb.Body{Payload: []byte(`{"name": "no keys"}`)},
+		},
+		{
+			Header: isb.Header{ID: "id-4", Keys: []string{"e"}, MessageInfo: isb.MessageInfo{EventTime: eventTime}},
+			Body:   isb.Body{Payload: []byte(`not json`)},
+		},
+	}
+}
+
+func TestToElasticsearch_Write(t *testing.T) {
+	s := &fakeBulkServer{}
+	server := httptest.NewServer(s)
+	defer server.Close()
+
+	toES := newTestToElasticsearch(t, server.URL, "", 2)
+	_, errs := toES.Write(context.Background(), testMessages())
+	assert.Len(t, errs, 5)
+	assert.NoError(t, errs[0])
+	assert.ErrorContains(t, errs[1], "mapper_parsing_exception")
+	assert.ErrorContains(t, errs[2], "status 429")
+	assert.ErrorContains(t, errs[3], "index_template_exception")
+	assert.ErrorContains(t, errs[4], "invalid_json_exception")
+	// 2 documents in the first bulk, 1 in the second, and none in the third
+	assert.Equal(t, 2, s.requests)
+	assert.Len(t, s.indexed, 1)
+	assert.Equal(t, "logs-a-2023.09.01", s.indexed[0].index)
+	assert.Equal(t, "id-0", s.indexed[0].id)
+	assert.Equal(t, "numaflow", s.indexed[0].source["name"])
+}
+
+func TestToElasticsearch_WriteFallback(t *testing.T) {
+	s := &fakeBulkServer{}
+	server := httptest.NewServer(s)
+	defer server.Close()
+
+	toES := newTestToElasticsearch(t, server.URL, "fallback", 500)
+	_, errs := toES.Write(context.Background(), testMessages())
+	assert.NoError(t, errs[0])
+	assert.NoError(t, errs[1])
+	// retryable errors are not routed to the fallback index
+	assert.ErrorContains(t, errs[2], "status 429")
+	assert.NoError(t, errs[3])
+	assert.NoError(t, errs[4])
+	assert.Len(t, s.indexed, 4)
+	fallbacks := map[string]indexedDocument{}
+	for _, doc := range s.indexed[1:] {
+		assert.Equal(t, "fallback", doc.index)
+		fallbacks[doc.id] = doc
+	}
+	assert.Equal(t, "mapper_parsing_exception", fallbacks["id-1"].source["errorType"])
+	assert.Equal(t, "logs-b-2023.09.01", fallbacks["id-1"].source["index"])
+	assert.Equal(t, `{"reject": "yes"}`, fallbacks["id-1"].source["payload"])
+	assert.Equal(t, "index_template_exception", fallbacks["id-3"].source["errorType"])
+	assert.Equal(t, "invalid_json_exception", fallbacks["id-4"].source["errorType"])
+	assert.Equal(t, "not json", fallbacks["id-4"].source["payload"])
+}
+
+func TestToElasticsearch_WriteFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = w.Write([]byte("unavailable"))
+	}))
+	defer server.Close()
+
+	toES := newTestToElasticsearch(t, server.URL, "fallback", 500)
+	_, errs := toES.Write(context.Background(), testMessages()[:1])
+	assert.Len(t, errs, 1)
+	assert.True(t, strings.Contains(errs[0].Error(), "status 503"))
+}
@@ -0,0 +1,45 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elasticsearch
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/numaproj/numaflow/pkg/metrics"
+)
+
+// elasticsearchSinkWriteErrors is used to indicate the number of errors while writing to elasticsearch sink
+var elasticsearchSinkWriteErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "elasticsearch_sink",
+	Name:      "write_error_total",
+	Help:      "Total number of Write Errors",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline})
+
+// elasticsearchSinkWriteCount is used to indicate the number of messages written to elasticsearch
+var elasticsearchSinkWriteCount = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "elasticsearch_sink",
+	Name:      "write_total",
+	Help:      "Total number of messages written to elasticsearch",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline})
+
+// elasticsearchSinkFallbackCount is used to indicate the number of rejected documents routed to the fallback index
+var elasticsearchSinkFallbackCount = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "elasticsearch_sink",
+	Name:      "fallback_total",
+	Help:      "Total number of rejected documents routed to the fallback index",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline})
@@ -42,9 +42,12 @@ type ToKafka struct {
 	producer     sarama.AsyncProducer
 	connected    bool
 	topic        string
-	isdf         *forward.InterStepDataForward
-	kafkaSink    *dfv1.KafkaSink
-	log          *zap.SugaredLogger
+	// transactionalID is the transactional id of the producer, the producer is not transactional if it's empty.
+	transactionalID string
+	isdf            *forward.InterStepDataForward
+	kafkaSink       *dfv1.KafkaSink
+	replica         int32
+	log             *zap.SugaredLogger
 }
 
 type Option func(*ToKafka) error
@@ -56,6 +59,14 @@ func WithLogger(log *zap.SugaredLogger) Option {
 	}
 }
 
+// WithReplica sets the replica of the vertex, which is used to identify the transactional producer through restarts.
+func WithReplica(replica int32) Option {
+	return func(t *ToKafka) error {
+		t.replica = replica
+		return nil
+	}
+}
+
 // NewToKafka returns ToKafka type.
 func NewToKafka(vertex *dfv1.Vertex,
 	fromBuffer isb.BufferReader,
@@ -82,6 +93,11 @@ func NewToKafka(vertex *dfv1.Vertex,
 	toKafka.pipelineName = vertex.Spec.PipelineName
 	toKafka.topic = kafkaSink.Topic
 	toKafka.kafkaSink = kafkaSink
+	if kafkaSink.Transactional {
+		// the transactional id needs to be stable through the restarts of the pod, so that the transaction left open by
+		// the previous producer is aborted when the new one is initialized.
+		toKafka.transactionalID = fmt.Sprintf("%s-%s-%d-%d", toKafka.pipelineName, toKafka.name, toKafka.replica, fromBuffer.GetPartitionIdx())
+	}
 
 	forwardOpts := []forward.Option{forward.WithVertexType(dfv1.VertexTypeSink), forward.WithLogger(toKafka.log)}
 	if x := vertex.Spec.Limits; x != nil {
@@ -95,7 +111,7 @@ func NewToKafka(vertex *dfv1.Vertex,
 		return nil, err
 	}
 	toKafka.isdf = f
-	producer, err := connect(kafkaSink)
+	producer, err := connect(kafkaSink, toKafka.transactionalID)
 	if err != nil {
 		return nil, err
 	}
@@ -104,7 +120,7 @@ func NewToKafka(vertex *dfv1.Vertex,
 	return toKafka, nil
 }
 
-func connect(kafkaSink *dfv1.KafkaSink) (sarama.AsyncProducer, error) {
+func connect(kafkaSink *dfv1.KafkaSink, transactionalID string) (sarama.AsyncProducer, error) {
 	config, err := util.GetSaramaConfigFromYAMLString(kafkaSink.Config)
 	if err != nil {
 		return nil, err
@@ -126,6 +142,18 @@ func connect(kafkaSink *dfv1.KafkaSink) (sarama.AsyncProducer, error) {
 	}
 	config.Producer.Return.Successes = true
 	config.Producer.Return.Errors = true
+	if kafkaSink.Idempotent || transactionalID != "" {
+		// the idempotent producer requires acks from all the in-sync replicas and one in-flight request per broker.
+		config.Producer.Idempotent = true
+		config.Producer.RequiredAcks = sarama.WaitForAll
+		config.Net.MaxOpenRequests = 1
+		if !config.Version.IsAtLeast(sarama.V0_11_0_0) {
+			config.Version = sarama.V0_11_0_0
+		}
+	}
+	if transactionalID != "" {
+		config.Producer.Transaction.ID = transactionalID
+	}
 	producer, err := sarama.NewAsyncProducer(kafkaSink.Brokers, config)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create kafka producer. %w", err)
@@ -151,7 +179,7 @@ func (tk *ToKafka) Write(_ context.Context, messages []isb.Message) ([]isb.Offse
 		errs[i] = fmt.Errorf("unknown error")
 	}
 	if !tk.connected {
-		producer, err := connect(tk.kafkaSink)
+		producer, err := connect(tk.kafkaSink, tk.transactionalID)
 		if err != nil {
 			for i := 0; i < len(errs); i++ {
 				errs[i] = fmt.Errorf("failed to get kafka producer, %w", err)
@@ -161,6 +189,15 @@ func (tk *ToKafka) Write(_ context.Context, messages []isb.Message) ([]isb.Offse
 		tk.producer = producer
 		tk.connected = true
 	}
+	if tk.producer.IsTransactional() && tk.producer.TxnStatus()&sarama.ProducerTxnFlagInTransaction == 0 {
+		if err := tk.producer.BeginTxn(); err != nil {
+			tk.resetOnFatalTxnError()
+			for i := 0; i < len(errs); i++ {
+				errs[i] = fmt.Errorf("failed to begin kafka transaction, %w", err)
+			}
+			return nil, errs
+		}
+	}
 	done := make(chan struct{})
 	timeout := time.After(5 * time.Second)
 	go func() {
@@ -196,9 +233,18 @@ func (tk *ToKafka) Write(_ context.Context, messages []isb.Message) ([]isb.Offse
 			Value:    sarama.ByteEncoder(msg.Payload),
 			Metadata: index, // Use metadata to identify if it succeeds or fails in the async return.
 		}
+		if msg.SchemaVersion != "" {
+			message.Headers = append(message.Headers, sarama.RecordHeader{Key: []byte(dfv1.KeyMetaSchemaVersion), Value: []byte(msg.SchemaVersion)})
+		}
+		if msg.TraceContext != "" {
+			message.Headers = append(message.Headers, sarama.RecordHeader{Key: []byte(dfv1.KeyMetaTraceParent), Value: []byte(msg.TraceContext)})
+		}
 		tk.producer.Input() <- message
 	}
 	<-done
+	if tk.producer.IsTransactional() {
+		tk.failTransactionOnError(errs)
+	}
 	for _, err := range errs {
 		if err != nil {
 			kafkaSinkWriteErrors.With(map[string]string{metrics.LabelVertex: tk.name, metrics.LabelPipeline: tk.pipelineName}).Inc()
@@ -209,6 +255,71 @@ func (tk *ToKafka) Write(_ context.Context, messages []isb.Message) ([]isb.Offse
 	return nil, errs
 }
 
+// failTransactionOnError aborts the transaction if any of the messages fails to be written, because the messages written
+// in the transaction are discarded by the abort, all the messages are marked as failed to be written again.
+func (tk *ToKafka) failTransactionOnError(errs []error) {
+	var failed error
+	for _, err := range errs {
+		if err != nil {
+			failed = err
+			break
+		}
+	}
+	if failed == nil {
+		return
+	}
+	if tk.connected {
+		if err := tk.producer.AbortTxn(); err != nil {
+			tk.log.Errorw("Failed to abort kafka transaction", zap.Error(err))
+			tk.resetOnFatalTxnError()
+		}
+	}
+	for i := range errs {
+		if errs[i] == nil {
+			errs[i] = fmt.Errorf("kafka transaction aborted, %w", failed)
+		}
+	}
+}
+
+// resetOnFatalTxnError closes the producer if it's in a fatal transaction state, it will be recreated on the next write.
+func (tk *ToKafka) resetOnFatalTxnError() {
+	if tk.producer.TxnStatus()&sarama.ProducerTxnFlagFatalError != 0 {
+		_ = tk.producer.Close()
+		tk.connected = false
+	}
+}
+
+// CommitTransaction commits the messages written in the current transaction, it's a no-op if the producer is not
+// transactional or there's no transaction in progress.
+func (tk *ToKafka) CommitTransaction(_ context.Context) error {
+	if !tk.connected || !tk.producer.IsTransactional() || tk.producer.TxnStatus()&sarama.ProducerTxnFlagInTransaction == 0 {
+		return nil
+	}
+	if err := tk.producer.CommitTxn(); err != nil {
+		kafkaSinkTxnCommitErrors.With(map[string]string{metrics.LabelVertex: tk.name, metrics.LabelPipeline: tk.pipelineName}).Inc()
+		if abortErr := tk.producer.AbortTxn(); abortErr != nil {
+			tk.log.Errorw("Failed to abort kafka transaction after commit failure", zap.Error(abortErr))
+		}
+		tk.resetOnFatalTxnError()
+		return fmt.Errorf("failed to commit kafka transaction, %w", err)
+	}
+	kafkaSinkTxnCommitCount.With(map[string]string{metrics.LabelVertex: tk.name, metrics.LabelPipeline: tk.pipelineName}).Inc()
+	return nil
+}
+
+// AbortTransaction discards the messages written in the current transaction, it's a no-op if the producer is not
+// transactional or there's no transaction in progress.
+func (tk *ToKafka) AbortTransaction(_ context.Context) error {
+	if !tk.connected || !tk.producer.IsTransactional() || tk.producer.TxnStatus()&sarama.ProducerTxnFlagInTransaction == 0 {
+		return nil
+	}
+	if err := tk.producer.AbortTxn(); err != nil {
+		tk.resetOnFatalTxnError()
+		return fmt.Errorf("failed to abort kafka transaction, %w", err)
+	}
+	return nil
+}
+
 func (tk *ToKafka) Close() error {
 	tk.log.Info("Closing kafka producer...")
 	return tk.producer.Close()
@@ -29,6 +29,7 @@ import (
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 	"github.com/numaproj/numaflow/pkg/watermark/generic"
 
+	"github.com/IBM/sarama"
 	mock "github.com/IBM/sarama/mocks"
 	"github.com/stretchr/testify/assert"
 )
@@ -141,8 +142,61 @@ func TestWriteFailureToKafka(t *testing.T) {
 
 }
 
+func TestWriteToKafkaInTransaction(t *testing.T) {
+	toKafka := &ToKafka{
+		name:            "Test",
+		pipelineName:    "testPipeline",
+		topic:           "topic-1",
+		transactionalID: "testPipeline-Test-0-0",
+		log:             logging.NewLogger(),
+	}
+	conf := mock.NewTestConfig()
+	conf.Producer.Return.Successes = true
+	conf.Producer.Return.Errors = true
+	conf.Producer.Idempotent = true
+	conf.Producer.RequiredAcks = sarama.WaitForAll
+	conf.Net.MaxOpenRequests = 1
+	conf.Version = sarama.V0_11_0_0
+	conf.Producer.Transaction.ID = toKafka.transactionalID
+	producer := mock.NewAsyncProducer(t, conf)
+	toKafka.producer = producer
+	toKafka.connected = true
+	msgs := []isb.Message{
+		{Body: isb.Body{Payload: []byte("welcome1")}},
+		{Body: isb.Body{Payload: []byte("welcome2")}},
+	}
+
+	// the messages are written in a transaction, which is committed after the batch is acked.
+	producer.ExpectInputAndSucceed()
+	producer.ExpectInputAndSucceed()
+	_, errs := toKafka.Write(context.Background(), msgs)
+	assert.Equal(t, []error{nil, nil}, errs)
+	assert.NotZero(t, producer.TxnStatus()&sarama.ProducerTxnFlagInTransaction)
+	assert.NoError(t, toKafka.CommitTransaction(context.Background()))
+	assert.Zero(t, producer.TxnStatus()&sarama.ProducerTxnFlagInTransaction)
+
+	// a failed message aborts the transaction, and all the messages need to be written again.
+	producer.ExpectInputAndSucceed()
+	producer.ExpectInputAndFail(fmt.Errorf("test"))
+	_, errs = toKafka.Write(context.Background(), msgs)
+	assert.Error(t, errs[0])
+	assert.Equal(t, "test", errs[1].Error())
+	assert.Zero(t, producer.TxnStatus()&sarama.ProducerTxnFlagInTransaction)
+
+	// the transaction is aborted when the batch is not acked.
+	producer.ExpectInputAndSucceed()
+	producer.ExpectInputAndSucceed()
+	_, errs = toKafka.Write(context.Background(), msgs)
+	assert.Equal(t, []error{nil, nil}, errs)
+	assert.NoError(t, toKafka.AbortTransaction(context.Background()))
+	assert.Zero(t, producer.TxnStatus()&sarama.ProducerTxnFlagInTransaction)
+	// committing without a transaction in progress is a no-op.
+	assert.NoError(t, toKafka.CommitTransaction(context.Background()))
+	assert.NoError(t, producer.Close())
+}
+
 func getSinkGoWhereDecider(vertexName string) forward.GoWhere {
-	fsd := forward.GoWhere(func(keys []string, tags []string) ([]forward.VertexBuffer, error) {
+	fsd := forward.GoWhere(func(keys []string, tags []string, schemaVersion string, _ map[string]string) ([]forward.VertexBuffer, error) {
 		var result []forward.VertexBuffer
 		result = append(result, forward.VertexBuffer{
 			ToVertexName:         vertexName,
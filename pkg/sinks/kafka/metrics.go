@@ -42,3 +42,17 @@ var kafkaSinkWriteTimeouts = promauto.NewCounterVec(prometheus.CounterOpts{
 	Name:      "write_timeout_total",
 	Help:      "Total number of write timeouts on NewToKafka",
 }, []string{metrics.LabelVertex, metrics.LabelPipeline})
+
+// kafkaSinkTxnCommitCount is used to indicate the number of transactions committed to kafka
+var kafkaSinkTxnCommitCount = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "kafka_sink",
+	Name:      "txn_commit_total",
+	Help:      "Total number of transactions committed",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline})
+
+// kafkaSinkTxnCommitErrors is used to indicate the number of errors while committing the transactions to kafka
+var kafkaSinkTxnCommitErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "kafka_sink",
+	Name:      "txn_commit_error_total",
+	Help:      "Total number of transaction commit errors",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline})
@@ -0,0 +1,197 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pulsar
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	pulsarlib "github.com/apache/pulsar-client-go/pulsar"
+	"go.uber.org/zap"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/forward"
+	"github.com/numaproj/numaflow/pkg/forward/applier"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/metrics"
+	pulsarclient "github.com/numaproj/numaflow/pkg/shared/clients/pulsar"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/watermark/fetch"
+	"github.com/numaproj/numaflow/pkg/watermark/publish"
+)
+
+// ToPulsar produce the output to a pulsar topic.
+type ToPulsar struct {
+	name         string
+	pipelineName string
+	client       pulsarlib.Client
+	producer     pulsarlib.Producer
+	writeTimeout time.Duration
+	isdf         *forward.InterStepDataForward
+	log          *zap.SugaredLogger
+}
+
+type Option func(*ToPulsar) error
+
+func WithLogger(log *zap.SugaredLogger) Option {
+	return func(t *ToPulsar) error {
+		t.log = log
+		return nil
+	}
+}
+
+// WithWriteTimeout sets the timeout of sending a batch of messages
+func WithWriteTimeout(timeout time.Duration) Option {
+	return func(t *ToPulsar) error {
+		t.writeTimeout = timeout
+		return nil
+	}
+}
+
+// NewToPulsar returns ToPulsar type.
+func NewToPulsar(vertex *dfv1.Vertex,
+	fromBuffer isb.BufferReader,
+	fetchWatermark fetch.Fetcher,
+	publishWatermark map[string]publish.Publisher,
+	whereToDecider forward.GoWhere,
+	opts ...Option) (*ToPulsar, error) {
+
+	pulsarSink := vertex.Spec.Sink.Pulsar
+	toPulsar := &ToPulsar{
+		name:         vertex.Spec.Name,
+		pipelineName: vertex.Spec.PipelineName,
+		writeTimeout: 5 * time.Second,
+	}
+	// apply options for pulsar sink
+	for _, o := range opts {
+		if err := o(toPulsar); err != nil {
+			return nil, err
+		}
+	}
+
+	// set default logger
+	if toPulsar.log == nil {
+		toPulsar.log = logging.NewLogger()
+	}
+	toPulsar.log = toPulsar.log.With("sinkType", "pulsar").With("topic", pulsarSink.Topic)
+
+	forwardOpts := []forward.Option{forward.WithVertexType(dfv1.VertexTypeSink), forward.WithLogger(toPulsar.log)}
+	if x := vertex.Spec.Limits; x != nil {
+		if x.ReadBatchSize != nil {
+			forwardOpts = append(forwardOpts, forward.WithReadBatchSize(int64(*x.ReadBatchSize)))
+		}
+	}
+
+	f, err := forward.NewInterStepDataForward(vertex, fromBuffer, map[string][]isb.BufferWriter{vertex.Spec.Name: {toPulsar}}, whereToDecider, applier.Terminal, applier.TerminalMapStream, fetchWatermark, publishWatermark, forwardOpts...)
+	if err != nil {
+		return nil, err
+	}
+	toPulsar.isdf = f
+
+	client, err := pulsarclient.NewClient(pulsarSink.ServiceURL, pulsarSink.TLS, pulsarSink.Auth)
+	if err != nil {
+		return nil, err
+	}
+	producer, err := client.CreateProducer(pulsarlib.ProducerOptions{
+		Topic:                   pulsarSink.Topic,
+		DisableBatching:         pulsarSink.Batching.IsDisabled(),
+		BatchingMaxMessages:     pulsarSink.Batching.GetMaxMessages(),
+		BatchingMaxPublishDelay: pulsarSink.Batching.GetMaxPublishDelay(),
+		SendTimeout:             toPulsar.writeTimeout,
+	})
+	if err != nil {
+		client.Close()
+		return nil, fmt.Errorf("failed to create pulsar producer, %w", err)
+	}
+	toPulsar.client = client
+	toPulsar.producer = producer
+	return toPulsar, nil
+}
+
+// GetName returns the name.
+func (tp *ToPulsar) GetName() string {
+	return tp.name
+}
+
+// GetPartitionIdx returns the partition index.
+// for sink it is always 0.
+func (tp *ToPulsar) GetPartitionIdx() int32 {
+	return 0
+}
+
+// Write writes to the pulsar topic, the messages are sent asynchronously and flushed before returning.
+func (tp *ToPulsar) Write(ctx context.Context, messages []isb.Message) ([]isb.Offset, []error) {
+	errs := make([]error, len(messages))
+	var wg sync.WaitGroup
+	for index, msg := range messages {
+		message := &pulsarlib.ProducerMessage{
+			Payload:   msg.Payload,
+			EventTime: msg.EventTime,
+		}
+		if len(msg.Keys) > 0 {
+			message.Key = strings.Join(msg.Keys, ":")
+		}
+		wg.Add(1)
+		idx := index
+		// the callback is invoked once the message is persisted, failed, or timed out with the send timeout.
+		tp.producer.SendAsync(ctx, message, func(_ pulsarlib.MessageID, _ *pulsarlib.ProducerMessage, err error) {
+			defer wg.Done()
+			if err != nil {
+				errs[idx] = fmt.Errorf("failed to send message to pulsar, %w", err)
+			}
+		})
+	}
+	if err := tp.producer.Flush(); err != nil {
+		tp.log.Warnw("Failed to flush pulsar producer", zap.Error(err))
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			pulsarSinkWriteErrors.With(map[string]string{metrics.LabelVertex: tp.name, metrics.LabelPipeline: tp.pipelineName}).Inc()
+		} else {
+			pulsarSinkWriteCount.With(map[string]string{metrics.LabelVertex: tp.name, metrics.LabelPipeline: tp.pipelineName}).Inc()
+		}
+	}
+	return nil, errs
+}
+
+func (tp *ToPulsar) Close() error {
+	tp.log.Info("Closing pulsar producer...")
+	tp.producer.Close()
+	tp.client.Close()
+	return nil
+}
+
+// Start starts sinking to pulsar.
+func (tp *ToPulsar) Start() <-chan struct{} {
+	return tp.isdf.Start()
+}
+
+// Stop stops sinking
+func (tp *ToPulsar) Stop() {
+	tp.isdf.Stop()
+	tp.log.Info("forwarder stopped successfully")
+}
+
+// ForceStop stops sinking
+func (tp *ToPulsar) ForceStop() {
+	tp.isdf.ForceStop()
+	tp.log.Info("forwarder force stopped successfully")
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pulsar
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	pulsarlib "github.com/apache/pulsar-client-go/pulsar"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+type fakeProducer struct {
+	pulsarlib.Producer
+	sent    []*pulsarlib.ProducerMessage
+	failKey string
+}
+
+func (f *fakeProducer) SendAsync(_ context.Context, m *pulsarlib.ProducerMessage, callback func(pulsarlib.MessageID, *pulsarlib.ProducerMessage, error)) {
+	if m.Key == f.failKey {
+		go callback(nil, m, fmt.Errorf("test"))
+		return
+	}
+	f.sent = append(f.sent, m)
+	go callback(pulsarlib.NewMessageID(1, int64(len(f.sent)), 0, 0), m, nil)
+}
+
+func (f *fakeProducer) Flush() error {
+	return nil
+}
+
+func TestToPulsar_Write(t *testing.T) {
+	producer := &fakeProducer{failKey: "bad"}
+	toPulsar := &ToPulsar{
+		name:         "testVertex",
+		pipelineName: "testPipeline",
+		producer:     producer,
+		log:          logging.NewLogger(),
+	}
+	msgs := []isb.Message{
+		{
+			Header: isb.Header{Keys: []string{"k1", "k2"}},
+			Body:   isb.Body{Payload: []byte("welcome1")},
+		},
+		{
+			Header: isb.Header{},
+			Body:   isb.Body{Payload: []byte("welcome2")},
+		},
+		{
+			Header: isb.Header{Keys: []string{"bad"}},
+			Body:   isb.Body{Payload: []byte("welcome3")},
+		},
+	}
+	_, errs := toPulsar.Write(context.Background(), msgs)
+	assert.Len(t, errs, 3)
+	assert.NoError(t, errs[0])
+	assert.NoError(t, errs[1])
+	assert.Error(t, errs[2])
+	assert.Len(t, producer.sent, 2)
+	assert.Equal(t, "k1:k2", producer.sent[0].Key)
+	assert.Equal(t, "", producer.sent[1].Key)
+	assert.Equal(t, []byte("welcome2"), producer.sent[1].Payload)
+}
@@ -31,17 +31,25 @@ import (
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/isb/checksum"
+	"github.com/numaproj/numaflow/pkg/isb/encryption"
+	"github.com/numaproj/numaflow/pkg/isb/signing"
 	jetstreamisb "github.com/numaproj/numaflow/pkg/isb/stores/jetstream"
 	redisisb "github.com/numaproj/numaflow/pkg/isb/stores/redis"
+	"github.com/numaproj/numaflow/pkg/isb/ttl"
 	"github.com/numaproj/numaflow/pkg/isbsvc"
 	"github.com/numaproj/numaflow/pkg/metrics"
 	sinkclient "github.com/numaproj/numaflow/pkg/sdkclient/sinker"
 	jsclient "github.com/numaproj/numaflow/pkg/shared/clients/nats"
 	redisclient "github.com/numaproj/numaflow/pkg/shared/clients/redis"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/shared/termination"
 	"github.com/numaproj/numaflow/pkg/sinks/blackhole"
+	clickhousesink "github.com/numaproj/numaflow/pkg/sinks/clickhouse"
+	elasticsearchsink "github.com/numaproj/numaflow/pkg/sinks/elasticsearch"
 	kafkasink "github.com/numaproj/numaflow/pkg/sinks/kafka"
 	logsink "github.com/numaproj/numaflow/pkg/sinks/logger"
+	pulsarsink "github.com/numaproj/numaflow/pkg/sinks/pulsar"
 	"github.com/numaproj/numaflow/pkg/sinks/udsink"
 	"github.com/numaproj/numaflow/pkg/watermark/fetch"
 	"github.com/numaproj/numaflow/pkg/watermark/generic"
@@ -51,6 +59,9 @@ import (
 type SinkProcessor struct {
 	ISBSvcType     dfv1.ISBSvcType
 	VertexInstance *dfv1.VertexInstance
+	// PackedReaders are the in-memory readers of a sink packed into the pod of its upstream vertex. If set, they replace
+	// the Inter-Step Buffer readers, and the metrics server is left to the upstream vertex.
+	PackedReaders []isb.BufferReader
 }
 
 func (u *SinkProcessor) Start(ctx context.Context) error {
@@ -69,7 +80,7 @@ func (u *SinkProcessor) Start(ctx context.Context) error {
 
 	natsClientPool, err = jsclient.NewClientPool(ctx, jsclient.WithClientPoolSize(2))
 	if err != nil {
-		return fmt.Errorf("failed to create a new NATS client pool: %w", err)
+		return termination.WithCause(dfv1.RestartCauseISBConnectFailed, fmt.Errorf("failed to create a new NATS client pool: %w", err))
 	}
 	defer natsClientPool.CloseAll()
 	// watermark variables no-op initialization
@@ -79,13 +90,20 @@ func (u *SinkProcessor) Start(ctx context.Context) error {
 
 	switch u.ISBSvcType {
 	case dfv1.ISBSvcTypeRedis:
-		redisClient := redisclient.NewInClusterRedisClient()
+		if u.VertexInstance.Vertex.Spec.Sink.WatermarkGate != nil {
+			// there's no watermark with redis isb service, the held messages would never be emitted
+			return fmt.Errorf("watermark gate is not supported with redis isb service")
+		}
+		redisClient, err := redisclient.NewInClusterRedisClient()
+		if err != nil {
+			return termination.WithCause(dfv1.RestartCauseISBConnectFailed, fmt.Errorf("failed to create redis client, %w", err))
+		}
 		readOptions := []redisclient.Option{}
 		if x := u.VertexInstance.Vertex.Spec.Limits; x != nil && x.ReadTimeout != nil {
 			readOptions = append(readOptions, redisclient.WithReadTimeOut(x.ReadTimeout.Duration))
 		}
 		// create reader for each partition. Each partition is a group in redis
-		for index, bufferPartition := range u.VertexInstance.Vertex.OwnedBuffers() {
+		for index, bufferPartition := range u.ownedBuffers() {
 			fromGroup := bufferPartition + "-group"
 			consumer := fmt.Sprintf("%s-%v", u.VertexInstance.Vertex.Name, u.VertexInstance.Replica)
 
@@ -99,10 +117,10 @@ func (u *SinkProcessor) Start(ctx context.Context) error {
 		}
 
 		// create reader for each partition. Each partition is a stream in jetstream
-		for index, bufferPartition := range u.VertexInstance.Vertex.OwnedBuffers() {
+		for index, bufferPartition := range u.ownedBuffers() {
 			fromStreamName := isbsvc.JetStreamName(bufferPartition)
 
-			reader, err := jetstreamisb.NewJetStreamBufferReader(ctx, natsClientPool.NextAvailableClient(), bufferPartition, fromStreamName, fromStreamName, int32(index), readOptions...)
+			reader, err := jetstreamisb.NewJetStreamBufferReader(ctx, natsClientPool.NextAvailableClient().WithDomain(u.VertexInstance.Vertex.GetFromJetStreamDomain()), bufferPartition, fromStreamName, fromStreamName, int32(index), readOptions...)
 			if err != nil {
 				return err
 			}
@@ -135,9 +153,30 @@ func (u *SinkProcessor) Start(ctx context.Context) error {
 	default:
 		return fmt.Errorf("unrecognized isb svc type %q", u.ISBSvcType)
 	}
+	if len(u.PackedReaders) > 0 {
+		readers = u.PackedReaders
+	}
+	if x := u.VertexInstance.Vertex.Spec.MessageChecksum; x != nil {
+		readers = checksum.NewVerifyingReaders(ctx, readers, x)
+	}
+	if u.VertexInstance.Vertex.Spec.MessageSigning != nil {
+		keyring, err := signing.NewKeyring(ctx, dfv1.PathSigningKeysMount)
+		if err != nil {
+			return fmt.Errorf("failed to load the message signing keys, %w", err)
+		}
+		readers = signing.NewVerifyingReaders(ctx, readers, keyring)
+	}
+	if x := u.VertexInstance.Vertex.Spec.MessageEncryption; x != nil {
+		encryptor, err := encryption.NewEncryptor(x)
+		if err != nil {
+			return fmt.Errorf("failed to create the message encryptor, %w", err)
+		}
+		readers = encryption.NewDecryptingReaders(ctx, readers, encryptor)
+	}
+	readers = ttl.NewExpiringReaders(ctx, readers, u.VertexInstance.Vertex)
 	maxMessageSize := sharedutil.LookupEnvIntOr(dfv1.EnvGRPCMaxMessageSize, dfv1.DefaultGRPCMaxMessageSize)
 	if udSink := u.VertexInstance.Vertex.Spec.Sink.UDSink; udSink != nil {
-		sdkClient, err = sinkclient.New(sinkclient.WithMaxMessageSize(maxMessageSize))
+		sdkClient, err = sinkclient.New(sinkclient.WithMaxMessageSize(maxMessageSize), sinkclient.WithCallPolicy(sharedutil.LookupGRPCCallPolicyFromEnv()))
 		if err != nil {
 			return fmt.Errorf("failed to create sdk client, %w", err)
 		}
@@ -148,7 +187,7 @@ func (u *SinkProcessor) Start(ctx context.Context) error {
 		}
 		// Readiness check
 		if err := sinkHandler.WaitUntilReady(ctx); err != nil {
-			return fmt.Errorf("failed on UDSink readiness check, %w", err)
+			return termination.WithCause(dfv1.RestartCauseUDSHandshakeFailed, fmt.Errorf("failed on UDSink readiness check, %w", err))
 		}
 
 		defer func() {
@@ -160,7 +199,7 @@ func (u *SinkProcessor) Start(ctx context.Context) error {
 	}
 
 	var finalWg sync.WaitGroup
-	for index := range u.VertexInstance.Vertex.OwnedBuffers() {
+	for index := range readers {
 		finalWg.Add(1)
 		sinker, err := u.getSinker(readers[index], log, fetchWatermark, publishWatermark, sinkHandler)
 		if err != nil {
@@ -188,13 +227,15 @@ func (u *SinkProcessor) Start(ctx context.Context) error {
 			log.Infow("Exited for partition...", zap.String("fromPartition", fromBufferPartitionName))
 		}(sinker, readers[index].GetName())
 	}
-	// start metrics server and pass the sinkHandler to it, so that it can be used to check the readiness of the sink
-	metricsOpts := metrics.NewMetricsOptions(ctx, u.VertexInstance.Vertex, []metrics.HealthChecker{sinkHandler}, readers)
-	ms := metrics.NewMetricsServer(u.VertexInstance.Vertex, metricsOpts...)
-	if shutdown, err := ms.Start(ctx); err != nil {
-		return fmt.Errorf("failed to start metrics server, error: %w", err)
-	} else {
-		defer func() { _ = shutdown(context.Background()) }()
+	if len(u.PackedReaders) == 0 {
+		// start metrics server and pass the sinkHandler to it, so that it can be used to check the readiness of the sink
+		metricsOpts := metrics.NewMetricsOptions(ctx, u.VertexInstance.Vertex, []metrics.HealthChecker{sinkHandler}, readers)
+		ms := metrics.NewMetricsServer(u.VertexInstance.Vertex, metricsOpts...)
+		if shutdown, err := ms.Start(ctx); err != nil {
+			return fmt.Errorf("failed to start metrics server, error: %w", err)
+		} else {
+			defer func() { _ = shutdown(context.Background()) }()
+		}
 	}
 
 	// wait for all the sinkers to exit
@@ -225,13 +266,27 @@ func (u *SinkProcessor) Start(ctx context.Context) error {
 	return nil
 }
 
+// ownedBuffers returns the Inter-Step Buffers the sink needs to read from, which is none if the sink is packed.
+func (u *SinkProcessor) ownedBuffers() []string {
+	if len(u.PackedReaders) > 0 {
+		return nil
+	}
+	return u.VertexInstance.Vertex.OwnedBuffers()
+}
+
 // getSinker takes in the logger from the parent context
 func (u *SinkProcessor) getSinker(reader isb.BufferReader, logger *zap.SugaredLogger, fetchWM fetch.Fetcher, publishWM map[string]publish.Publisher, sinkHandler udsink.SinkApplier) (Sinker, error) {
 	sink := u.VertexInstance.Vertex.Spec.Sink
 	if x := sink.Log; x != nil {
 		return logsink.NewToLog(u.VertexInstance.Vertex, reader, fetchWM, publishWM, u.getSinkGoWhereDecider(), logsink.WithLogger(logger))
 	} else if x := sink.Kafka; x != nil {
-		return kafkasink.NewToKafka(u.VertexInstance.Vertex, reader, fetchWM, publishWM, u.getSinkGoWhereDecider(), kafkasink.WithLogger(logger))
+		return kafkasink.NewToKafka(u.VertexInstance.Vertex, reader, fetchWM, publishWM, u.getSinkGoWhereDecider(), kafkasink.WithLogger(logger), kafkasink.WithReplica(u.VertexInstance.Replica))
+	} else if x := sink.Pulsar; x != nil {
+		return pulsarsink.NewToPulsar(u.VertexInstance.Vertex, reader, fetchWM, publishWM, u.getSinkGoWhereDecider(), pulsarsink.WithLogger(logger))
+	} else if x := sink.Elasticsearch; x != nil {
+		return elasticsearchsink.NewToElasticsearch(u.VertexInstance.Vertex, reader, fetchWM, publishWM, u.getSinkGoWhereDecider(), elasticsearchsink.WithLogger(logger))
+	} else if x := sink.ClickHouse; x != nil {
+		return clickhousesink.NewToClickHouse(u.VertexInstance.Vertex, reader, fetchWM, publishWM, u.getSinkGoWhereDecider(), clickhousesink.WithLogger(logger))
 	} else if x := sink.Blackhole; x != nil {
 		return blackhole.NewBlackhole(u.VertexInstance.Vertex, reader, fetchWM, publishWM, u.getSinkGoWhereDecider(), blackhole.WithLogger(logger))
 	} else if x := sink.UDSink; x != nil {
@@ -245,7 +300,7 @@ func (u *SinkProcessor) getSinker(reader isb.BufferReader, logger *zap.SugaredLo
 // based on the keys and tags
 // for sink processor, we send the message to the same vertex and partition will be set to 0
 func (u *SinkProcessor) getSinkGoWhereDecider() forward.GoWhere {
-	fsd := forward.GoWhere(func(keys []string, tags []string) ([]forward.VertexBuffer, error) {
+	fsd := forward.GoWhere(func(keys []string, tags []string, schemaVersion string, _ map[string]string) ([]forward.VertexBuffer, error) {
 		var result []forward.VertexBuffer
 		result = append(result, forward.VertexBuffer{
 			ToVertexName:         u.VertexInstance.Vertex.Spec.Name,
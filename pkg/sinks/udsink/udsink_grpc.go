@@ -21,8 +21,11 @@ import (
 	"fmt"
 	"time"
 
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	sinkclient "github.com/numaproj/numaflow/pkg/sdkclient/sinker"
+	"github.com/numaproj/numaflow/pkg/shared/errorlog"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/shared/msglog"
 
 	sinkpb "github.com/numaproj/numaflow-go/pkg/apis/proto/sink/v1"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -74,9 +77,15 @@ func (u *UDSgRPCBasedUDSink) WaitUntilReady(ctx context.Context) error {
 
 func (u *UDSgRPCBasedUDSink) ApplySink(ctx context.Context, requests []*sinkpb.SinkRequest) []error {
 	errs := make([]error, len(requests))
+	ids := make([]string, len(requests))
+	for i, r := range requests {
+		ids[i] = r.GetId()
+	}
 
-	response, err := u.client.SinkFn(ctx, requests)
+	response, err := u.client.SinkFn(msglog.OutgoingContext(ctx, ids...), requests)
 	if err != nil {
+		// the error is not specific to a message of the batch
+		errorlog.Record(dfv1.CtrUdsink, err, "", nil)
 		for i := range requests {
 			errs[i] = ApplyUDSinkErr{
 				UserUDSinkErr: false,
@@ -104,6 +113,7 @@ func (u *UDSgRPCBasedUDSink) ApplySink(ctx context.Context, requests []*sinkpb.S
 				} else {
 					errs[i] = fmt.Errorf("unsuccessful due to unknown reason")
 				}
+				errorlog.Record(dfv1.CtrUdsink, errs[i], m.GetId(), m.GetValue())
 			}
 		}
 	}
@@ -23,7 +23,9 @@ import (
 	"testing"
 	"time"
 
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	sinkclient "github.com/numaproj/numaflow/pkg/sdkclient/sinker"
+	"github.com/numaproj/numaflow/pkg/shared/errorlog"
 
 	"github.com/golang/mock/gomock"
 	sinkpb "github.com/numaproj/numaflow-go/pkg/apis/proto/sink/v1"
@@ -114,6 +116,11 @@ func Test_gRPCBasedUDSink_ApplyWithMockClient(t *testing.T) {
 		assert.Equal(t, 2, len(gotErrList))
 		assert.Equal(t, nil, gotErrList[0])
 		assert.Equal(t, fmt.Errorf("mock sink message error"), gotErrList[1])
+		recorded := errorlog.List()[0]
+		assert.Equal(t, dfv1.CtrUdsink, recorded.Container)
+		assert.Equal(t, "test_id_1", recorded.MessageID)
+		assert.Equal(t, "mock sink message error", recorded.Message)
+		assert.NotEmpty(t, recorded.PayloadHash)
 	})
 
 	t.Run("test err", func(t *testing.T) {
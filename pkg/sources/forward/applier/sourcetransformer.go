@@ -18,6 +18,7 @@ package applier
 
 import (
 	"context"
+	"errors"
 
 	"github.com/numaproj/numaflow/pkg/isb"
 )
@@ -28,6 +29,19 @@ type SourceTransformApplier interface {
 	ApplyTransform(ctx context.Context, message *isb.ReadMessage) ([]*isb.WriteMessage, error)
 }
 
+// ErrBatchNotSupported is returned by a SourceTransformBatchApplier when the batch mode turns out not to be supported,
+// the messages should be transformed one by one instead.
+var ErrBatchNotSupported = errors.New("batch source transform is not supported")
+
+// SourceTransformBatchApplier applies the source transform on a batch of read messages, and gives back the new messages
+// of each of the read messages in the same order.
+type SourceTransformBatchApplier interface {
+	SourceTransformApplier
+	// BatchEnabled returns true if the messages should be transformed in batches.
+	BatchEnabled() bool
+	ApplyTransformBatch(ctx context.Context, messages []*isb.ReadMessage) ([][]*isb.WriteMessage, error)
+}
+
 // ApplySourceTransformFunc is a function type that implements SourceTransformApplier interface.
 type ApplySourceTransformFunc func(ctx context.Context, message *isb.ReadMessage) ([]*isb.WriteMessage, error)
 
@@ -0,0 +1,186 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package forward
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/metrics"
+	"github.com/numaproj/numaflow/pkg/sources/forward/spool"
+	"github.com/numaproj/numaflow/pkg/watermark/wmb"
+)
+
+// openBurstBuffers opens a burst buffer for each of the to buffer partitions, the max size is shared evenly by them.
+func openBurstBuffers(toBuffers map[string][]isb.BufferWriter, dir string, maxSize int64) (map[string]*spool.Spool, error) {
+	burstBuffers := make(map[string]*spool.Spool)
+	if dir == "" {
+		return burstBuffers, nil
+	}
+	count := 0
+	for _, partitions := range toBuffers {
+		count += len(partitions)
+	}
+	for _, partitions := range toBuffers {
+		for _, partition := range partitions {
+			bb, err := spool.Open(filepath.Join(dir, partition.GetName()), maxSize/int64(count))
+			if err != nil {
+				return nil, fmt.Errorf("failed to open burst buffer of partition %q, %w", partition.GetName(), err)
+			}
+			burstBuffers[partition.GetName()] = bb
+		}
+	}
+	return burstBuffers, nil
+}
+
+// burstBufferPending returns true if there are spooled messages of the to buffer partition not drained yet.
+func (isdf *DataForward) burstBufferPending(partitionName string) bool {
+	bb, ok := isdf.burstBuffers[partitionName]
+	return ok && bb.Len() > 0
+}
+
+// trySpool spools the batch to the burst buffer of a to buffer partition, it returns false if the burst buffer is
+// full or failing.
+func (isdf *DataForward) trySpool(toVertexName string, index int, bb *spool.Spool, batch spool.Batch) bool {
+	partitionName := isdf.toBuffers[toVertexName][index].GetName()
+	labels := map[string]string{metrics.LabelVertex: isdf.vertexName, metrics.LabelPipeline: isdf.pipelineName, metrics.LabelPartitionName: partitionName}
+	if err := bb.Append(batch); err != nil {
+		if !errors.Is(err, spool.ErrFull) {
+			isdf.opts.logger.Errorw("Failed to spool messages to the burst buffer", zap.String(metrics.LabelPartitionName, partitionName), zap.Error(err))
+		}
+		return false
+	}
+	burstBufferSpooledCount.With(labels).Add(float64(len(batch.Messages)))
+	burstBufferSize.With(labels).Set(float64(bb.Size()))
+	return true
+}
+
+// spoolToBurstBuffer spools the batch behind the ones not drained yet. It blocks while the burst buffer is full,
+// draining it in the meantime, until the batch is spooled or a shutdown has been initiated.
+func (isdf *DataForward) spoolToBurstBuffer(ctx context.Context, toVertexName string, index int, bb *spool.Spool, batch spool.Batch) error {
+	for !isdf.trySpool(toVertexName, index, bb, batch) {
+		if ok, _ := isdf.IsShuttingDown(); ok {
+			platformError.With(map[string]string{metrics.LabelVertex: isdf.vertexName, metrics.LabelPipeline: isdf.pipelineName}).Inc()
+			return fmt.Errorf("spoolToBurstBuffer failed, Stop called while the burst buffer is full with messages:%d", len(batch.Messages))
+		}
+		time.Sleep(isdf.opts.retryInterval)
+		isdf.drainBurstBuffer(ctx, toVertexName, index, bb)
+	}
+	return nil
+}
+
+// drainBurstBuffers writes the spooled messages to the to buffer partitions in order, until the burst buffers are
+// empty, or the writes fail again.
+func (isdf *DataForward) drainBurstBuffers(ctx context.Context) {
+	for toVertexName, partitions := range isdf.toBuffers {
+		for index, partition := range partitions {
+			bb, ok := isdf.burstBuffers[partition.GetName()]
+			if !ok {
+				continue
+			}
+			for bb.Len() > 0 && ctx.Err() == nil {
+				if !isdf.drainBurstBuffer(ctx, toVertexName, index, bb) {
+					break
+				}
+			}
+		}
+	}
+}
+
+// drainBurstBuffer writes the batch at the head of the burst buffer to the to buffer partition, and publishes the
+// watermark of the batch. It returns true if the batch is drained completely.
+func (isdf *DataForward) drainBurstBuffer(ctx context.Context, toVertexName string, index int, bb *spool.Spool) bool {
+	if bb.Len() == 0 {
+		return false
+	}
+	partition := isdf.toBuffers[toVertexName][index]
+	labels := map[string]string{metrics.LabelVertex: isdf.vertexName, metrics.LabelPipeline: isdf.pipelineName, metrics.LabelPartitionName: partition.GetName()}
+	defer func() { burstBufferSize.With(labels).Set(float64(bb.Size())) }()
+	batch, err := bb.Peek()
+	if err != nil {
+		if !errors.Is(err, spool.ErrCorrupted) {
+			isdf.opts.logger.Errorw("Failed to read the burst buffer", zap.String(metrics.LabelPartitionName, partition.GetName()), zap.Error(err))
+			return false
+		}
+		// a corrupted batch can never be drained, it's dropped to not block the source forever
+		isdf.opts.logger.Errorw("Dropping a corrupted batch of the burst buffer", zap.String(metrics.LabelPartitionName, partition.GetName()), zap.Error(err))
+		return bb.Pop() == nil
+	}
+
+	offsets, errs := partition.Write(ctx, batch.Messages)
+	var (
+		failed     []isb.Message
+		lastOffset isb.Offset
+		drained    int
+		dropped    int
+		writeBytes float64
+	)
+	for idx, msg := range batch.Messages {
+		if err := errs[idx]; err != nil {
+			if errors.As(err, &isb.NoRetryableBufferWriteErr{}) {
+				dropped++
+				dropBytesCount.With(labels).Add(float64(len(msg.Payload)))
+			} else {
+				failed = append(failed, msg)
+				writeMessagesError.With(labels).Inc()
+			}
+			continue
+		}
+		drained++
+		writeBytes += float64(len(msg.Payload))
+		if offsets != nil {
+			lastOffset = offsets[idx]
+		}
+	}
+	burstBufferDrainedCount.With(labels).Add(float64(drained))
+	writeMessagesCount.With(labels).Add(float64(drained))
+	writeBytesCount.With(labels).Add(writeBytes)
+	dropMessagesCount.With(labels).Add(float64(dropped))
+	if lastOffset != nil {
+		if vertexPublishers, ok := isdf.toVertexWMPublishers[toVertexName]; ok {
+			for _, sp := range batch.Partitions {
+				publisher, ok := vertexPublishers[sp]
+				if !ok {
+					publisher = isdf.createToVertexWatermarkPublisher(toVertexName, sp)
+					vertexPublishers[sp] = publisher
+				}
+				publisher.PublishWatermark(wmb.Watermark(batch.Watermark), lastOffset, int32(index))
+			}
+			isdf.idleManager.Reset(partition.GetName())
+		}
+	}
+
+	if len(failed) > 0 {
+		batch.Messages = failed
+		if err := bb.Replace(*batch); err != nil {
+			// the batch is drained again from the beginning, the messages written are duplicated
+			isdf.opts.logger.Errorw("Failed to update the burst buffer", zap.String(metrics.LabelPartitionName, partition.GetName()), zap.Error(err))
+		}
+		return false
+	}
+	if err := bb.Pop(); err != nil {
+		isdf.opts.logger.Errorw("Failed to remove a drained batch of the burst buffer", zap.String(metrics.LabelPartitionName, partition.GetName()), zap.Error(err))
+		return false
+	}
+	return true
+}
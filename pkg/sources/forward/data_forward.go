@@ -27,11 +27,16 @@ import (
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/forward"
+	"github.com/numaproj/numaflow/pkg/forward/schema"
 	"github.com/numaproj/numaflow/pkg/isb"
 	"github.com/numaproj/numaflow/pkg/metrics"
 	"github.com/numaproj/numaflow/pkg/shared/idlehandler"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/shared/msglog"
+	"github.com/numaproj/numaflow/pkg/shared/pause"
+	"github.com/numaproj/numaflow/pkg/shared/tracing"
 	"github.com/numaproj/numaflow/pkg/sources/forward/applier"
+	"github.com/numaproj/numaflow/pkg/sources/forward/spool"
 	"github.com/numaproj/numaflow/pkg/watermark/fetch"
 	"github.com/numaproj/numaflow/pkg/watermark/processor"
 	"github.com/numaproj/numaflow/pkg/watermark/publish"
@@ -63,6 +68,12 @@ type DataForward struct {
 	pipelineName   string
 	// idleManager manages the idle watermark status.
 	idleManager *wmb.IdleManager
+	// originRequired stores the names of the downstream vertices which need to know the origin of the messages.
+	originRequired map[string]bool
+	// schemaValidator validates the payloads against the schemas of the to edges, it's nil if there's no schema.
+	schemaValidator *schema.EdgeValidator
+	// burstBuffers stores the to buffer partition name to its burst buffer mapping, it's empty if burst buffering is disabled.
+	burstBuffers map[string]*spool.Spool
 	Shutdown
 }
 
@@ -78,12 +89,25 @@ func NewDataForward(
 	toVertexWmStores map[string]store.WatermarkStore,
 	opts ...Option) (*DataForward, error) {
 	options := DefaultOptions()
+	if x := vertex.Spec.Limits; x != nil && x.RetryInterval != nil {
+		options.retryInterval = x.RetryInterval.Duration
+	}
 	for _, o := range opts {
 		if err := o(options); err != nil {
 			return nil, err
 		}
 	}
 
+	schemaValidator, err := schema.NewEdgeValidator(vertex)
+	if err != nil {
+		return nil, err
+	}
+
+	burstBuffers, err := openBurstBuffers(toSteps, options.burstBufferDir, options.burstBufferMaxSize)
+	if err != nil {
+		return nil, err
+	}
+
 	var toVertexWMPublishers = make(map[string]map[int32]publish.Publisher)
 	for k := range toVertexWmStores {
 		toVertexWMPublishers[k] = make(map[int32]publish.Publisher)
@@ -105,6 +129,9 @@ func NewDataForward(
 		vertexName:           vertex.Spec.Name,
 		pipelineName:         vertex.Spec.PipelineName,
 		idleManager:          wmb.NewIdleManager(len(toSteps)),
+		originRequired:       forward.OriginRequiredVertices(vertex),
+		schemaValidator:      schemaValidator,
+		burstBuffers:         burstBuffers,
 		Shutdown: Shutdown{
 			rwlock: new(sync.RWMutex),
 		},
@@ -178,11 +205,16 @@ func (isdf *DataForward) Start() <-chan struct{} {
 	return stopped
 }
 
+// pausedCheckInterval is how often a paused forwarder checks if it's resumed.
+const pausedCheckInterval = time.Second
+
 // readWriteMessagePair represents a read message and its processed (via transformer) write messages.
 type readWriteMessagePair struct {
 	readMessage      *isb.ReadMessage
 	writeMessages    []*isb.WriteMessage
 	transformerError error
+	// span is the tracing span of the read message, nil if it's not traced.
+	span *tracing.Span
 }
 
 // forwardAChunk forwards a chunk of message from the reader to the toBuffers. It does the Read -> Process -> Forward -> Ack chain
@@ -190,11 +222,22 @@ type readWriteMessagePair struct {
 // the message after forwarding, barring any platform errors. The platform errors include buffer-full,
 // buffer-not-reachable, etc., but do not include errors due to user code transformer, WhereTo, etc.
 func (isdf *DataForward) forwardAChunk(ctx context.Context) {
+	if pause.Paused() {
+		// paused through the daemon service, the source is not read until it's resumed.
+		select {
+		case <-ctx.Done():
+		case <-time.After(pausedCheckInterval):
+		}
+		return
+	}
+	// the spooled messages are drained before the new ones, which are spooled behind them if the draining is not done.
+	isdf.drainBurstBuffers(ctx)
 	start := time.Now()
 	// There is a chance that we have read the message and the container got forcefully terminated before processing. To provide
 	// at-least-once semantics for reading, during the restart we will have to reprocess all unacknowledged messages. It is the
 	// responsibility of the Read function to do that.
 	readMessages, err := isdf.reader.Read(ctx, isdf.opts.readBatchSize)
+	readEnd := time.Now()
 	if err != nil {
 		isdf.opts.logger.Warnw("failed to read from source", zap.Error(err))
 		readMessagesError.With(map[string]string{metrics.LabelVertex: isdf.vertexName, metrics.LabelPipeline: isdf.pipelineName, metrics.LabelPartitionName: isdf.reader.GetName()}).Inc()
@@ -213,9 +256,15 @@ func (isdf *DataForward) forwardAChunk(ctx context.Context) {
 		readOffsets[idx] = m.ReadOffset
 	}
 
+	// the messages without a trace context start new traces at the source, spanErr is recorded when the chunk fails.
+	spans := tracing.StartSpans(ctx, isdf.vertexName, readMessages, start, true)
+	spans.Phase("read", start, readEnd, nil)
+	var spanErr error
+	defer func() { spans.End(spanErr) }()
+
 	// source data transformer applies filtering and assigns event time to source data, which doesn't require watermarks.
-	// hence we assign time.UnixMilli(-1) to processorWM.
-	processorWM := wmb.Watermark(time.UnixMilli(-1))
+	// hence we assign wmb.InitialWatermark to processorWM.
+	processorWM := wmb.InitialWatermark
 
 	var writeOffsets map[string][][]isb.Offset
 	// create space for writeMessages specific to each step as we could forward to all the steps too.
@@ -225,42 +274,46 @@ func (isdf *DataForward) forwardAChunk(ctx context.Context) {
 		messageToStep[toVertex] = make([][]isb.Message, len(isdf.toBuffers[toVertex]))
 	}
 
-	// user defined transformer concurrent processing request channel
-	transformerCh := make(chan *readWriteMessagePair)
 	// transformerResults stores the results after user defined transformer processing for all read messages. It indexes
 	// a read message to the corresponding write message
 	transformerResults := make([]readWriteMessagePair, len(readMessages))
-	// applyTransformer, if there is an Internal error, it is a blocking call and
-	// will return only if shutdown has been initiated.
-
-	// create a pool of Transformer Processors
-	var wg sync.WaitGroup
-	for i := 0; i < isdf.opts.transformerConcurrency; i++ {
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
-			isdf.concurrentApplyTransformer(ctx, transformerCh)
-		}()
-	}
-	concurrentTransformerProcessingStart := time.Now()
-
-	// send to transformer only the data messages
 	for idx, m := range readMessages {
 		// emit message size metric
 		readBytesCount.With(map[string]string{metrics.LabelVertex: isdf.vertexName, metrics.LabelPipeline: isdf.pipelineName, metrics.LabelPartitionName: isdf.reader.GetName()}).Add(float64(len(m.Payload)))
 		// assign watermark to the message
 		m.Watermark = time.Time(processorWM)
-		// send transformer processing work to the channel
 		transformerResults[idx].readMessage = m
-		transformerCh <- &transformerResults[idx]
-	}
-	// let the go routines know that there is no more work
-	close(transformerCh)
-	// wait till the processing is done. this will not be an infinite wait because the transformer processing will exit if
-	// context.Done() is closed.
-	wg.Wait()
-	isdf.opts.logger.Debugw("concurrent applyTransformer completed", zap.Int("concurrency", isdf.opts.transformerConcurrency), zap.Duration("took", time.Since(concurrentTransformerProcessingStart)))
-	concurrentTransformerProcessingTime.With(map[string]string{metrics.LabelVertex: isdf.vertexName, metrics.LabelPipeline: isdf.pipelineName, metrics.LabelPartitionName: isdf.reader.GetName()}).Observe(float64(time.Since(concurrentTransformerProcessingStart).Microseconds()))
+		transformerResults[idx].span = spans.Get(idx)
+	}
+
+	// applyTransformer and applyTransformerBatch, if there is an Internal error, are blocking calls and
+	// will return only if shutdown has been initiated.
+	if !isdf.applyTransformerBatch(ctx, transformerResults) {
+		// user defined transformer concurrent processing request channel
+		transformerCh := make(chan *readWriteMessagePair)
+		// create a pool of Transformer Processors
+		var wg sync.WaitGroup
+		for i := 0; i < isdf.opts.transformerConcurrency; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				isdf.concurrentApplyTransformer(ctx, transformerCh)
+			}()
+		}
+		concurrentTransformerProcessingStart := time.Now()
+
+		// send transformer processing work to the channel
+		for idx := range transformerResults {
+			transformerCh <- &transformerResults[idx]
+		}
+		// let the go routines know that there is no more work
+		close(transformerCh)
+		// wait till the processing is done. this will not be an infinite wait because the transformer processing will exit if
+		// context.Done() is closed.
+		wg.Wait()
+		isdf.opts.logger.Debugw("concurrent applyTransformer completed", zap.Int("concurrency", isdf.opts.transformerConcurrency), zap.Duration("took", time.Since(concurrentTransformerProcessingStart)))
+		concurrentTransformerProcessingTime.With(map[string]string{metrics.LabelVertex: isdf.vertexName, metrics.LabelPipeline: isdf.pipelineName, metrics.LabelPartitionName: isdf.reader.GetName()}).Observe(float64(time.Since(concurrentTransformerProcessingStart).Microseconds()))
+	}
 	// transformer processing is done.
 
 	// publish source watermark and assign IsLate attribute based on new event time.
@@ -271,9 +324,9 @@ func (isdf *DataForward) forwardAChunk(ctx context.Context) {
 		for _, message := range m.writeMessages {
 			// we convert each writeMessage to isb.ReadMessage by providing its parent ReadMessage's ReadOffset.
 			// since we use message event time instead of the watermark to determine and publish source watermarks,
-			// time.UnixMilli(-1) is assigned to the message watermark. transformedReadMessages are immediately
+			// wmb.InitialWatermark is assigned to the message watermark. transformedReadMessages are immediately
 			// used below for publishing source watermarks.
-			transformedReadMessages = append(transformedReadMessages, message.ToReadMessage(m.readMessage.ReadOffset, time.UnixMilli(-1)))
+			transformedReadMessages = append(transformedReadMessages, message.ToReadMessage(m.readMessage.ReadOffset, time.Time(wmb.InitialWatermark)))
 		}
 	}
 	// publish source watermark
@@ -297,12 +350,21 @@ func (isdf *DataForward) forwardAChunk(ctx context.Context) {
 		if m.transformerError != nil {
 			transformerError.With(map[string]string{metrics.LabelVertex: isdf.vertexName, metrics.LabelPipeline: isdf.pipelineName, metrics.LabelPartitionName: isdf.reader.GetName()}).Inc()
 			isdf.opts.logger.Errorw("failed to apply source transformer", zap.Error(m.transformerError))
+			spanErr = m.transformerError
 			return
 		}
+		// the written messages carry the trace context of the span in this vertex
+		traceContext := m.span.TraceContext()
 		// update toBuffers
 		for _, message := range m.writeMessages {
+			if traceContext != "" {
+				message.TraceContext = traceContext
+			}
+			// the ingest time is carried to the downstream vertices, for the message TTL measured by it
+			message.IngestTime = readEnd
 			if err := isdf.whereToStep(message, messageToStep, m.readMessage); err != nil {
 				isdf.opts.logger.Errorw("failed in whereToStep", zap.Error(err))
+				spanErr = err
 				return
 			}
 		}
@@ -311,9 +373,12 @@ func (isdf *DataForward) forwardAChunk(ctx context.Context) {
 	}
 
 	// forward the messages to the edge buffer (could be multiple edges)
-	writeOffsets, err = isdf.writeToBuffers(ctx, messageToStep)
+	writeStart := time.Now()
+	writeOffsets, err = isdf.writeToBuffers(ctx, messageToStep, processorWM, sourcePartitionsIndices)
+	spans.Phase("write", writeStart, time.Now(), err)
 	if err != nil {
 		isdf.opts.logger.Errorw("failed to write to toBuffers", zap.Error(err))
+		spanErr = err
 		return
 	}
 
@@ -353,6 +418,10 @@ func (isdf *DataForward) forwardAChunk(ctx context.Context) {
 	// send idle watermark only if we have idled out buffers
 	for toVertexName := range isdf.toVertexWMPublishers {
 		for index, activePartition := range activeWatermarkBuffers[toVertexName] {
+			if isdf.burstBufferPending(isdf.toBuffers[toVertexName][index].GetName()) {
+				// the watermark of the partition is published when the spooled messages are drained
+				continue
+			}
 			if !activePartition {
 				// use the watermark of the current read batch for the idle watermark
 				// same as read len==0 because there's no event published to the buffer
@@ -372,10 +441,13 @@ func (isdf *DataForward) forwardAChunk(ctx context.Context) {
 
 	// when we apply transformer, we don't handle partial errors (it's either non or all, non will return early),
 	// so we should be able to ack all the readOffsets including data messages and control messages
+	ackStart := time.Now()
 	err = isdf.ackFromSource(ctx, readOffsets)
+	spans.Phase("ack", ackStart, time.Now(), err)
 	// implicit return for posterity :-)
 	if err != nil {
 		isdf.opts.logger.Errorw("failed to ack from source", zap.Error(err))
+		spanErr = err
 		ackMessageError.With(map[string]string{metrics.LabelVertex: isdf.vertexName, metrics.LabelPipeline: isdf.pipelineName, metrics.LabelPartitionName: isdf.reader.GetName()}).Add(float64(len(readOffsets)))
 		return
 	}
@@ -393,9 +465,10 @@ func (isdf *DataForward) ackFromSource(ctx context.Context, offsets []isb.Offset
 }
 
 // writeToBuffers is a blocking call until all the messages have been forwarded to all the toBuffers, or a shutdown
-// has been initiated while we are stuck looping on an InternalError.
+// has been initiated while we are stuck looping on an InternalError. If burst buffering is enabled, the messages
+// failed to be written are spooled with the watermark and the source partitions, and no offsets are returned for them.
 func (isdf *DataForward) writeToBuffers(
-	ctx context.Context, messageToStep map[string][][]isb.Message,
+	ctx context.Context, messageToStep map[string][][]isb.Message, processorWM wmb.Watermark, sourcePartitions map[int32]bool,
 ) (writeOffsets map[string][][]isb.Offset, err error) {
 	// messageToStep contains all the to buffers, so the messages could be empty (conditional forwarding).
 	// So writeOffsets also contains all the to buffers, but the returned offsets might be empty.
@@ -403,9 +476,26 @@ func (isdf *DataForward) writeToBuffers(
 	for toVertexName, toVertexMessages := range messageToStep {
 		writeOffsets[toVertexName] = make([][]isb.Offset, len(toVertexMessages))
 	}
+	var partitions []int32
+	for p := range sourcePartitions {
+		partitions = append(partitions, p)
+	}
 	for toVertexName, toVertexBuffer := range isdf.toBuffers {
 		for index, partition := range toVertexBuffer {
-			writeOffsets[toVertexName][index], err = isdf.writeToBuffer(ctx, partition, messageToStep[toVertexName][index])
+			messages := messageToStep[toVertexName][index]
+			bb, ok := isdf.burstBuffers[partition.GetName()]
+			if !ok {
+				writeOffsets[toVertexName][index], err = isdf.writeToBuffer(ctx, partition, messages, nil)
+			} else if bb.Len() > 0 {
+				// keep the order, the messages are spooled behind the ones not drained yet
+				if len(messages) > 0 {
+					err = isdf.spoolToBurstBuffer(ctx, toVertexName, index, bb, spool.Batch{Watermark: time.Time(processorWM), Partitions: partitions, Messages: messages})
+				}
+			} else {
+				writeOffsets[toVertexName][index], err = isdf.writeToBuffer(ctx, partition, messages, func(failed []isb.Message) bool {
+					return isdf.trySpool(toVertexName, index, bb, spool.Batch{Watermark: time.Time(processorWM), Partitions: partitions, Messages: failed})
+				})
+			}
 			if err != nil {
 				return nil, err
 			}
@@ -415,7 +505,8 @@ func (isdf *DataForward) writeToBuffers(
 }
 
 // writeToBuffer forwards an array of messages to a single buffer and is a blocking call or until shutdown has been initiated.
-func (isdf *DataForward) writeToBuffer(ctx context.Context, toBufferPartition isb.BufferWriter, messages []isb.Message) (writeOffsets []isb.Offset, err error) {
+// If spoolFailed is not nil, it's called with the messages failed to be written, and the retrying stops if it returns true.
+func (isdf *DataForward) writeToBuffer(ctx context.Context, toBufferPartition isb.BufferWriter, messages []isb.Message, spoolFailed func([]isb.Message) bool) (writeOffsets []isb.Offset, err error) {
 	var (
 		totalCount int
 		writeCount int
@@ -424,8 +515,15 @@ func (isdf *DataForward) writeToBuffer(ctx context.Context, toBufferPartition is
 	)
 	totalCount = len(messages)
 	writeOffsets = make([]isb.Offset, 0, totalCount)
+	labels := map[string]string{metrics.LabelVertex: isdf.vertexName, metrics.LabelPipeline: isdf.pipelineName, metrics.LabelPartitionName: toBufferPartition.GetName()}
+	consecutiveFailures := 0
+	defer func() {
+		writeInFlight.With(labels).Set(0)
+		writeBackoffSeconds.With(labels).Set(0)
+	}()
 
 	for {
+		writeInFlight.With(labels).Set(float64(len(messages)))
 		_writeOffsets, errs := toBufferPartition.Write(ctx, messages)
 		// Note: this is an unwanted memory allocation during a happy path. We want only minimal allocation since using failedMessages is an unlikely path.
 		var failedMessages []isb.Message
@@ -440,7 +538,7 @@ func (isdf *DataForward) writeToBuffer(ctx context.Context, toBufferPartition is
 					needRetry = true
 					// we retry only failed messages
 					failedMessages = append(failedMessages, msg)
-					writeMessagesError.With(map[string]string{metrics.LabelVertex: isdf.vertexName, metrics.LabelPipeline: isdf.pipelineName, metrics.LabelPartitionName: toBufferPartition.GetName()}).Inc()
+					writeMessagesError.With(labels).Inc()
 					// a shutdown can break the blocking loop caused due to InternalErr
 					if ok, _ := isdf.IsShuttingDown(); ok {
 						platformError.With(map[string]string{metrics.LabelVertex: isdf.vertexName, metrics.LabelPipeline: isdf.pipelineName}).Inc()
@@ -457,6 +555,12 @@ func (isdf *DataForward) writeToBuffer(ctx context.Context, toBufferPartition is
 			}
 		}
 
+		if needRetry && spoolFailed != nil && spoolFailed(failedMessages) {
+			writeConsecutiveFailures.With(labels).Set(0)
+			// the spooled messages are neither written nor dropped
+			totalCount -= len(failedMessages)
+			break
+		}
 		if needRetry {
 			isdf.opts.logger.Errorw("Retrying failed messages",
 				zap.Any("errors", errorArrayToMap(errs)),
@@ -466,17 +570,21 @@ func (isdf *DataForward) writeToBuffer(ctx context.Context, toBufferPartition is
 			)
 			// set messages to the failed slice for the retry
 			messages = failedMessages
+			consecutiveFailures++
+			writeConsecutiveFailures.With(labels).Set(float64(consecutiveFailures))
+			writeBackoffSeconds.With(labels).Set(isdf.opts.retryInterval.Seconds())
 			// TODO: implement retry with backoff etc.
 			time.Sleep(isdf.opts.retryInterval)
 		} else {
+			writeConsecutiveFailures.With(labels).Set(0)
 			break
 		}
 	}
 
-	dropMessagesCount.With(map[string]string{metrics.LabelVertex: isdf.vertexName, metrics.LabelPipeline: isdf.pipelineName, metrics.LabelPartitionName: toBufferPartition.GetName()}).Add(float64(totalCount - writeCount))
-	dropBytesCount.With(map[string]string{metrics.LabelVertex: isdf.vertexName, metrics.LabelPipeline: isdf.pipelineName, metrics.LabelPartitionName: toBufferPartition.GetName()}).Add(dropBytes)
-	writeMessagesCount.With(map[string]string{metrics.LabelVertex: isdf.vertexName, metrics.LabelPipeline: isdf.pipelineName, metrics.LabelPartitionName: toBufferPartition.GetName()}).Add(float64(writeCount))
-	writeBytesCount.With(map[string]string{metrics.LabelVertex: isdf.vertexName, metrics.LabelPipeline: isdf.pipelineName, metrics.LabelPartitionName: toBufferPartition.GetName()}).Add(writeBytes)
+	dropMessagesCount.With(labels).Add(float64(totalCount - writeCount))
+	dropBytesCount.With(labels).Add(dropBytes)
+	writeMessagesCount.With(labels).Add(float64(writeCount))
+	writeBytesCount.With(labels).Add(writeBytes)
 	return writeOffsets, nil
 }
 
@@ -485,7 +593,9 @@ func (isdf *DataForward) concurrentApplyTransformer(ctx context.Context, readMes
 	for message := range readMessagePair {
 		start := time.Now()
 		transformerReadMessagesCount.With(map[string]string{metrics.LabelVertex: isdf.vertexName, metrics.LabelPipeline: isdf.pipelineName, metrics.LabelPartitionName: isdf.reader.GetName()}).Inc()
-		writeMessages, err := isdf.applyTransformer(ctx, message.readMessage)
+		udfCtx, endPhase := message.span.StartPhase(ctx, "apply")
+		writeMessages, err := isdf.applyTransformer(msglog.OutgoingContext(tracing.OutgoingContext(udfCtx), message.readMessage.ID), message.readMessage)
+		endPhase(err)
 		transformerWriteMessagesCount.With(map[string]string{metrics.LabelVertex: isdf.vertexName, metrics.LabelPipeline: isdf.pipelineName, metrics.LabelPartitionName: isdf.reader.GetName()}).Add(float64(len(writeMessages)))
 		message.writeMessages = append(message.writeMessages, writeMessages...)
 		message.transformerError = err
@@ -513,22 +623,82 @@ func (isdf *DataForward) applyTransformer(ctx context.Context, readMessage *isb.
 			}
 			continue
 		} else {
-			// if we do not get a time from Transformer, we set it to the time from (N-1)th vertex
-			for index, m := range writeMessages {
-				m.ID = fmt.Sprintf("%s-%s-%d", readMessage.ReadOffset.String(), isdf.vertexName, index)
-				if m.EventTime.IsZero() {
-					m.EventTime = readMessage.EventTime
+			isdf.assignTransformedMessageInfo(readMessage, writeMessages)
+			return writeMessages, nil
+		}
+	}
+}
+
+// applyTransformerBatch applies the transformer to all the messages with one call if the transformer is in batch mode,
+// it returns false if the batch mode is not enabled, and the messages need to be transformed one by one.
+// Same as applyTransformer, it blocks on errors and returns only if shutdown has been initiated.
+func (isdf *DataForward) applyTransformerBatch(ctx context.Context, pairs []readWriteMessagePair) bool {
+	batchApplier, ok := isdf.transformer.(applier.SourceTransformBatchApplier)
+	if !ok || !batchApplier.BatchEnabled() || len(pairs) == 0 {
+		return false
+	}
+	readMessages := make([]*isb.ReadMessage, len(pairs))
+	ids := make([]string, len(pairs))
+	for i := range pairs {
+		readMessages[i] = pairs[i].readMessage
+		ids[i] = pairs[i].readMessage.ID
+	}
+	labels := map[string]string{metrics.LabelVertex: isdf.vertexName, metrics.LabelPipeline: isdf.pipelineName, metrics.LabelPartitionName: isdf.reader.GetName()}
+	for {
+		start := time.Now()
+		transformerReadMessagesCount.With(labels).Add(float64(len(pairs)))
+		endPhases := make([]func(error), len(pairs))
+		for i := range pairs {
+			_, endPhases[i] = pairs[i].span.StartPhase(ctx, "apply")
+		}
+		results, err := batchApplier.ApplyTransformBatch(msglog.OutgoingContext(tracing.OutgoingContext(ctx), ids...), readMessages)
+		for _, endPhase := range endPhases {
+			endPhase(err)
+		}
+		if errors.Is(err, applier.ErrBatchNotSupported) {
+			isdf.opts.logger.Warnw("Transformer batch mode is not supported, falling back to per-message transforms")
+			return false
+		}
+		if err != nil {
+			isdf.opts.logger.Errorw("Transformer.ApplyBatch error", zap.Error(err))
+			time.Sleep(isdf.opts.retryInterval)
+			if ok, _ := isdf.IsShuttingDown(); ok {
+				isdf.opts.logger.Errorw("Transformer.ApplyBatch, Stop called while stuck on an internal error", zap.Error(err))
+				platformError.With(map[string]string{metrics.LabelVertex: isdf.vertexName, metrics.LabelPipeline: isdf.pipelineName}).Inc()
+				for i := range pairs {
+					pairs[i].transformerError = err
 				}
+				return true
 			}
-			return writeMessages, nil
+			continue
+		}
+		for i := range pairs {
+			isdf.assignTransformedMessageInfo(pairs[i].readMessage, results[i])
+			transformerWriteMessagesCount.With(labels).Add(float64(len(results[i])))
+			pairs[i].writeMessages = append(pairs[i].writeMessages, results[i]...)
 		}
+		transformerProcessingTime.With(labels).Observe(float64(time.Since(start).Microseconds()))
+		return true
+	}
+}
+
+// assignTransformedMessageInfo assigns the IDs to the messages transformed from the read message, and if we do not get
+// a time from Transformer, we set it to the time from (N-1)th vertex.
+func (isdf *DataForward) assignTransformedMessageInfo(readMessage *isb.ReadMessage, writeMessages []*isb.WriteMessage) {
+	for index, m := range writeMessages {
+		m.ID = fmt.Sprintf("%s-%s-%d", readMessage.ReadOffset.String(), isdf.vertexName, index)
+		if m.EventTime.IsZero() {
+			m.EventTime = readMessage.EventTime
+		}
+		// the event times are kept in the precision of the watermarks of the pipeline.
+		m.EventTime = m.EventTime.Truncate(wmb.Precision())
 	}
 }
 
 // whereToStep executes the WhereTo interfaces and then updates the to step's writeToBuffers buffer.
 func (isdf *DataForward) whereToStep(writeMessage *isb.WriteMessage, messageToStep map[string][][]isb.Message, readMessage *isb.ReadMessage) error {
 	// call WhereTo and drop it on errors
-	to, err := isdf.toWhichStepDecider.WhereTo(writeMessage.Keys, writeMessage.Tags)
+	to, err := isdf.toWhichStepDecider.WhereTo(writeMessage.Keys, writeMessage.Tags, writeMessage.SchemaVersion, writeMessage.Headers)
 	if err != nil {
 		isdf.opts.logger.Errorw("failed in whereToStep", zap.Error(isb.MessageWriteErr{Name: isdf.reader.GetName(), Header: readMessage.Header, Body: readMessage.Body, Message: fmt.Sprintf("WhereTo failed, %s", err)}))
 		// a shutdown can break the blocking loop caused due to InternalErr
@@ -539,12 +709,17 @@ func (isdf *DataForward) whereToStep(writeMessage *isb.WriteMessage, messageToSt
 		}
 		return err
 	}
+	to = forward.ApplySchemas(isdf.schemaValidator, to, writeMessage.Payload, func(toVertex string) int { return len(messageToStep[toVertex]) }, isdf.opts.logger)
 
 	for _, t := range to {
 		if _, ok := messageToStep[t.ToVertexName]; !ok {
 			isdf.opts.logger.Errorw("failed in whereToStep", zap.Error(isb.MessageWriteErr{Name: isdf.reader.GetName(), Header: readMessage.Header, Body: readMessage.Body, Message: fmt.Sprintf("no such destination (%s)", t.ToVertexName)}))
 		}
-		messageToStep[t.ToVertexName][t.ToVertexPartitionIdx] = append(messageToStep[t.ToVertexName][t.ToVertexPartitionIdx], writeMessage.Message)
+		message := writeMessage.Message
+		if isdf.originRequired[t.ToVertexName] {
+			message.Origin = isdf.vertexName
+		}
+		messageToStep[t.ToVertexName][t.ToVertexPartitionIdx] = append(messageToStep[t.ToVertexName][t.ToVertexPartitionIdx], message)
 	}
 	return nil
 }
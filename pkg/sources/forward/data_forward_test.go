@@ -32,6 +32,8 @@ import (
 	"github.com/numaproj/numaflow/pkg/isb/stores/simplebuffer"
 	"github.com/numaproj/numaflow/pkg/isb/testutils"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/sources/forward/applier"
+	"github.com/numaproj/numaflow/pkg/sources/forward/spool"
 	udfapplier "github.com/numaproj/numaflow/pkg/udf/rpc"
 	"github.com/numaproj/numaflow/pkg/watermark/generic"
 	wmstore "github.com/numaproj/numaflow/pkg/watermark/store"
@@ -78,7 +80,7 @@ func (t *testForwardFetcher) ComputeHeadIdleWMB(int32) wmb.WMB {
 type myForwardTest struct {
 }
 
-func (f myForwardTest) WhereTo(_ []string, _ []string) ([]forward.VertexBuffer, error) {
+func (f myForwardTest) WhereTo(_ []string, _ []string, _ string, _ map[string]string) ([]forward.VertexBuffer, error) {
 	return []forward.VertexBuffer{{
 		ToVertexName:         "to1",
 		ToVertexPartitionIdx: 0,
@@ -89,6 +91,14 @@ func (f myForwardTest) ApplyTransform(ctx context.Context, message *isb.ReadMess
 	return testutils.CopyUDFTestApply(ctx, message)
 }
 
+// withoutIngestTime clears the ingest time stamped by the source forwarder, so that the message info could be compared
+// with the one written.
+func withoutIngestTime(t *testing.T, info isb.MessageInfo) isb.MessageInfo {
+	assert.False(t, info.IngestTime.IsZero())
+	info.IngestTime = time.Time{}
+	return info
+}
+
 func TestNewDataForward(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -149,7 +159,7 @@ func TestNewDataForward(t *testing.T) {
 			assert.NoError(t, err, "expected no error")
 			assert.Len(t, readMessages, updatedBatchSize)
 			for i, j := 0, 0; i < updatedBatchSize; i, j = i+1, j+2 {
-				assert.Equal(t, []interface{}{writeMessages[j].MessageInfo}, []interface{}{readMessages[i].MessageInfo})
+				assert.Equal(t, []interface{}{writeMessages[j].MessageInfo}, []interface{}{withoutIngestTime(t, readMessages[i].MessageInfo)})
 				assert.Equal(t, []interface{}{writeMessages[j].Kind}, []interface{}{readMessages[i].Kind})
 				assert.Equal(t, []interface{}{writeMessages[j].Keys}, []interface{}{readMessages[i].Keys})
 				assert.Equal(t, []interface{}{writeMessages[j].Body}, []interface{}{readMessages[i].Body})
@@ -160,7 +170,7 @@ func TestNewDataForward(t *testing.T) {
 				assert.NoError(t, err, "expected no error")
 				assert.Len(t, readMessages, updatedBatchSize)
 				for i, j := 0, 1; i < updatedBatchSize; i, j = i+1, j+2 {
-					assert.Equal(t, []interface{}{writeMessages[j].MessageInfo}, []interface{}{readMessages[i].MessageInfo})
+					assert.Equal(t, []interface{}{writeMessages[j].MessageInfo}, []interface{}{withoutIngestTime(t, readMessages[i].MessageInfo)})
 					assert.Equal(t, []interface{}{writeMessages[j].Kind}, []interface{}{readMessages[i].Kind})
 					assert.Equal(t, []interface{}{writeMessages[j].Keys}, []interface{}{readMessages[i].Keys})
 					assert.Equal(t, []interface{}{writeMessages[j].Body}, []interface{}{readMessages[i].Body})
@@ -244,7 +254,7 @@ func TestNewDataForward(t *testing.T) {
 			assert.NoError(t, err, "expected no error")
 			assert.Len(t, readMessages, updatedBatchSize)
 			for i, j := 0, 0; i < updatedBatchSize; i, j = i+1, j+2 {
-				assert.Equal(t, []interface{}{writeMessages[j].MessageInfo}, []interface{}{readMessages[i].MessageInfo})
+				assert.Equal(t, []interface{}{writeMessages[j].MessageInfo}, []interface{}{withoutIngestTime(t, readMessages[i].MessageInfo)})
 				assert.Equal(t, []interface{}{writeMessages[j].Kind}, []interface{}{readMessages[i].Kind})
 				assert.Equal(t, []interface{}{writeMessages[j].Keys}, []interface{}{readMessages[i].Keys})
 				assert.Equal(t, []interface{}{writeMessages[j].Body}, []interface{}{readMessages[i].Body})
@@ -256,7 +266,7 @@ func TestNewDataForward(t *testing.T) {
 				assert.NoError(t, err, "expected no error")
 				assert.Len(t, readMessages, updatedBatchSize)
 				for i, j := 0, 1; i < updatedBatchSize; i, j = i+1, j+2 {
-					assert.Equal(t, []interface{}{writeMessages[j].MessageInfo}, []interface{}{readMessages[i].MessageInfo})
+					assert.Equal(t, []interface{}{writeMessages[j].MessageInfo}, []interface{}{withoutIngestTime(t, readMessages[i].MessageInfo)})
 					assert.Equal(t, []interface{}{writeMessages[j].Kind}, []interface{}{readMessages[i].Kind})
 					assert.Equal(t, []interface{}{writeMessages[j].Keys}, []interface{}{readMessages[i].Keys})
 					assert.Equal(t, []interface{}{writeMessages[j].Body}, []interface{}{readMessages[i].Body})
@@ -550,7 +560,7 @@ func TestNewDataForward(t *testing.T) {
 
 			assert.Len(t, readMessages, updatedBatchSize)
 			for i, j := 0, 0; i < updatedBatchSize; i, j = i+1, j+2 {
-				assert.Equal(t, []interface{}{writeMessages[j].MessageInfo}, []interface{}{readMessages[i].MessageInfo})
+				assert.Equal(t, []interface{}{writeMessages[j].MessageInfo}, []interface{}{withoutIngestTime(t, readMessages[i].MessageInfo)})
 				assert.Equal(t, []interface{}{writeMessages[j].Kind}, []interface{}{readMessages[i].Kind})
 				assert.Equal(t, []interface{}{writeMessages[j].Keys}, []interface{}{readMessages[i].Keys})
 				assert.Equal(t, []interface{}{writeMessages[j].Body}, []interface{}{readMessages[i].Body})
@@ -753,7 +763,7 @@ func TestNewDataForward(t *testing.T) {
 type mySourceForwardTest struct {
 }
 
-func (f mySourceForwardTest) WhereTo(_ []string, _ []string) ([]forward.VertexBuffer, error) {
+func (f mySourceForwardTest) WhereTo(_ []string, _ []string, _ string, _ map[string]string) ([]forward.VertexBuffer, error) {
 	return []forward.VertexBuffer{{
 		ToVertexName:         "to1",
 		ToVertexPartitionIdx: 0,
@@ -764,7 +774,7 @@ type mySourceForwardTestRoundRobin struct {
 	count int
 }
 
-func (f *mySourceForwardTestRoundRobin) WhereTo(_ []string, _ []string) ([]forward.VertexBuffer, error) {
+func (f *mySourceForwardTestRoundRobin) WhereTo(_ []string, _ []string, _ string, _ map[string]string) ([]forward.VertexBuffer, error) {
 	var output = []forward.VertexBuffer{{
 		ToVertexName:         "to1",
 		ToVertexPartitionIdx: int32(f.count % 2),
@@ -806,6 +816,33 @@ func (f mySourceForwardTest) ApplyTransform(ctx context.Context, message *isb.Re
 	}(ctx, message)
 }
 
+// mySourceForwardBatchTest transforms the messages in batches, and the batch mode could be turned off.
+type mySourceForwardBatchTest struct {
+	mySourceForwardTest
+	supported  bool
+	batchCalls int
+}
+
+func (f *mySourceForwardBatchTest) BatchEnabled() bool {
+	return true
+}
+
+func (f *mySourceForwardBatchTest) ApplyTransformBatch(ctx context.Context, messages []*isb.ReadMessage) ([][]*isb.WriteMessage, error) {
+	f.batchCalls++
+	if !f.supported {
+		return nil, applier.ErrBatchNotSupported
+	}
+	results := make([][]*isb.WriteMessage, len(messages))
+	for i, m := range messages {
+		r, err := f.ApplyTransform(ctx, m)
+		if err != nil {
+			return nil, err
+		}
+		results[i] = r
+	}
+	return results, nil
+}
+
 // TestSourceWatermarkPublisher is a dummy implementation of isb.SourceWatermarkPublisher interface
 type TestSourceWatermarkPublisher struct {
 }
@@ -1010,7 +1047,7 @@ func TestWriteToBuffer(t *testing.T) {
 			messageToStep["to1"] = make([][]isb.Message, 1)
 			writeMessages := testutils.BuildTestWriteMessages(4*value.batchSize, testStartTime)
 			messageToStep["to1"][0] = append(messageToStep["to1"][0], writeMessages[0:value.batchSize+1]...)
-			_, err = f.writeToBuffers(ctx, messageToStep)
+			_, err = f.writeToBuffers(ctx, messageToStep, wmb.InitialWatermark, nil)
 
 			assert.Equal(t, value.throwError, err != nil)
 			if value.throwError {
@@ -1022,10 +1059,78 @@ func TestWriteToBuffer(t *testing.T) {
 	}
 }
 
+func TestWriteToBufferWithBurstBuffer(t *testing.T) {
+	fromStep := simplebuffer.NewInMemoryBuffer("from", 100, 0)
+	buffer := simplebuffer.NewInMemoryBuffer("to1", 10, 0)
+	toSteps := map[string][]isb.BufferWriter{
+		"to1": {buffer},
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+	vertex := &dfv1.Vertex{Spec: dfv1.VertexSpec{
+		PipelineName: "testPipeline",
+		AbstractVertex: dfv1.AbstractVertex{
+			Name: "testVertex",
+		},
+	}}
+
+	dir := t.TempDir()
+	fetchWatermark, _ := generic.BuildNoOpWatermarkProgressorsFromBufferMap(toSteps)
+	toVertexStores := buildNoOpToVertexStores(toSteps)
+	f, err := NewDataForward(vertex, fromStep, toSteps, myForwardTest{}, myForwardTest{}, fetchWatermark, TestSourceWatermarkPublisher{}, toVertexStores, WithBurstBuffer(dir, 1024*1024))
+	assert.NoError(t, err)
+
+	writeMessages := testutils.BuildTestWriteMessages(18, testStartTime)
+	// the buffer is full after 10 messages, the rest are spooled
+	messageToStep := map[string][][]isb.Message{"to1": {writeMessages[0:15]}}
+	writeOffsets, err := f.writeToBuffers(ctx, messageToStep, wmb.Watermark(testStartTime), map[int32]bool{0: true})
+	assert.NoError(t, err)
+	assert.Len(t, writeOffsets["to1"][0], 10)
+	assert.True(t, f.burstBufferPending("to1"))
+
+	// the new messages are spooled behind the ones not drained yet
+	messageToStep = map[string][][]isb.Message{"to1": {writeMessages[15:18]}}
+	writeOffsets, err = f.writeToBuffers(ctx, messageToStep, wmb.Watermark(testStartTime), map[int32]bool{0: true})
+	assert.NoError(t, err)
+	assert.Len(t, writeOffsets["to1"][0], 0)
+	assert.Equal(t, 2, f.burstBuffers["to1"].Len())
+
+	// the spooled messages are drained in order once the buffer has space
+	var readIDs []string
+	readAndAck := func(count int64) {
+		readMessages, err := buffer.Read(ctx, count)
+		assert.NoError(t, err)
+		var offsets []isb.Offset
+		for _, m := range readMessages {
+			readIDs = append(readIDs, m.ID)
+			offsets = append(offsets, m.ReadOffset)
+		}
+		for _, err := range buffer.Ack(ctx, offsets) {
+			assert.NoError(t, err)
+		}
+	}
+	readAndAck(10)
+	f.drainBurstBuffers(ctx)
+	assert.False(t, f.burstBufferPending("to1"))
+	readAndAck(8)
+	assert.Len(t, readIDs, 18)
+	for i, id := range readIDs {
+		assert.Equal(t, writeMessages[i].ID, id)
+	}
+	// stop the heartbeats of the publishers created when draining
+	f.cancelFn()
+
+	// the spooled messages survive the restart
+	assert.NoError(t, f.burstBuffers["to1"].Append(spool.Batch{Messages: writeMessages[0:1]}))
+	f, err = NewDataForward(vertex, fromStep, toSteps, myForwardTest{}, myForwardTest{}, fetchWatermark, TestSourceWatermarkPublisher{}, toVertexStores, WithBurstBuffer(dir, 1024*1024))
+	assert.NoError(t, err)
+	assert.True(t, f.burstBufferPending("to1"))
+}
+
 type myForwardDropTest struct {
 }
 
-func (f myForwardDropTest) WhereTo(_ []string, _ []string) ([]forward.VertexBuffer, error) {
+func (f myForwardDropTest) WhereTo(_ []string, _ []string, _ string, _ map[string]string) ([]forward.VertexBuffer, error) {
 	return []forward.VertexBuffer{}, nil
 }
 
@@ -1037,7 +1142,7 @@ type myForwardToAllTest struct {
 	count int
 }
 
-func (f *myForwardToAllTest) WhereTo(_ []string, _ []string) ([]forward.VertexBuffer, error) {
+func (f *myForwardToAllTest) WhereTo(_ []string, _ []string, _ string, _ map[string]string) ([]forward.VertexBuffer, error) {
 	var output = []forward.VertexBuffer{{
 		ToVertexName:         "to1",
 		ToVertexPartitionIdx: int32(f.count % 2),
@@ -1057,7 +1162,7 @@ func (f *myForwardToAllTest) ApplyTransform(ctx context.Context, message *isb.Re
 type myForwardInternalErrTest struct {
 }
 
-func (f myForwardInternalErrTest) WhereTo(_ []string, _ []string) ([]forward.VertexBuffer, error) {
+func (f myForwardInternalErrTest) WhereTo(_ []string, _ []string, _ string, _ map[string]string) ([]forward.VertexBuffer, error) {
 	return []forward.VertexBuffer{{
 		ToVertexName:         "to1",
 		ToVertexPartitionIdx: 0,
@@ -1078,7 +1183,7 @@ func (f myForwardInternalErrTest) ApplyTransform(_ context.Context, _ *isb.ReadM
 type myForwardApplyWhereToErrTest struct {
 }
 
-func (f myForwardApplyWhereToErrTest) WhereTo(_ []string, _ []string) ([]forward.VertexBuffer, error) {
+func (f myForwardApplyWhereToErrTest) WhereTo(_ []string, _ []string, _ string, _ map[string]string) ([]forward.VertexBuffer, error) {
 	return []forward.VertexBuffer{{
 		ToVertexName:         "to1",
 		ToVertexPartitionIdx: 0,
@@ -1092,7 +1197,7 @@ func (f myForwardApplyWhereToErrTest) ApplyTransform(ctx context.Context, messag
 type myForwardApplyTransformerErrTest struct {
 }
 
-func (f myForwardApplyTransformerErrTest) WhereTo(_ []string, _ []string) ([]forward.VertexBuffer, error) {
+func (f myForwardApplyTransformerErrTest) WhereTo(_ []string, _ []string, _ string, _ map[string]string) ([]forward.VertexBuffer, error) {
 	return []forward.VertexBuffer{{
 		ToVertexName:         "to1",
 		ToVertexPartitionIdx: 0,
@@ -1180,3 +1285,54 @@ func buildNoOpToVertexStores(toBuffers map[string][]isb.BufferWriter) map[string
 	}
 	return toVertexStores
 }
+
+func TestDataForwardTransformerBatch(t *testing.T) {
+	tests := []struct {
+		name      string
+		supported bool
+	}{
+		{name: "batch", supported: true},
+		{name: "fallback", supported: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fromStep := simplebuffer.NewInMemoryBuffer("from", 25, 0)
+			to1 := simplebuffer.NewInMemoryBuffer("to1", 10, 0, simplebuffer.WithReadTimeOut(time.Second*10))
+			toSteps := map[string][]isb.BufferWriter{
+				"to1": {to1},
+			}
+			vertex := &dfv1.Vertex{Spec: dfv1.VertexSpec{
+				PipelineName: "testPipeline",
+				AbstractVertex: dfv1.AbstractVertex{
+					Name: "receivingVertex",
+				},
+			}}
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+			defer cancel()
+
+			writeMessages := testutils.BuildTestWriteMessages(int64(20), testStartTime)
+			transformer := &mySourceForwardBatchTest{supported: tt.supported}
+			f, err := NewDataForward(vertex, fromStep, toSteps, mySourceForwardTest{}, transformer, &testForwardFetcher{}, TestSourceWatermarkPublisher{}, buildNoOpToVertexStores(toSteps), WithReadBatchSize(5))
+			assert.NoError(t, err)
+
+			stopped := f.Start()
+			count := int64(2)
+			_, errs := fromStep.Write(ctx, writeMessages[0:count])
+			assert.Equal(t, make([]error, count), errs)
+
+			readMessages, err := to1.Read(ctx, count)
+			assert.NoError(t, err, "expected no error")
+			assert.Len(t, readMessages, int(count))
+			assert.Equal(t, []interface{}{"0-0-receivingVertex-0", "1-0-receivingVertex-0"}, []interface{}{readMessages[0].Header.ID, readMessages[1].Header.ID})
+			for _, m := range readMessages {
+				assert.Equal(t, testSourceNewEventTime, m.EventTime)
+				assert.Equal(t, true, m.IsLate)
+			}
+			assert.Greater(t, transformer.batchCalls, 0)
+			f.Stop()
+			time.Sleep(1 * time.Millisecond)
+			f.ForceStop()
+			<-stopped
+		})
+	}
+}
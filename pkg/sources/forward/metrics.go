@@ -144,3 +144,45 @@ var transformerWriteMessagesCount = promauto.NewCounterVec(prometheus.CounterOpt
 	Name:      "transformer_write_total",
 	Help:      "Total number of Messages Written by source transformer",
 }, []string{metrics.LabelVertex, metrics.LabelPipeline, metrics.LabelPartitionName})
+
+// writeInFlight is used to indicate the number of messages being written to a to buffer partition
+var writeInFlight = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Subsystem: "source_forwarder",
+	Name:      "write_in_flight",
+	Help:      "Number of messages being written to a to buffer partition",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline, metrics.LabelPartitionName})
+
+// writeConsecutiveFailures is used to indicate the number of consecutive failed write attempts to a to buffer partition
+var writeConsecutiveFailures = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Subsystem: "source_forwarder",
+	Name:      "write_consecutive_failures",
+	Help:      "Number of consecutive failed write attempts to a to buffer partition, reset on a successful write",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline, metrics.LabelPartitionName})
+
+// writeBackoffSeconds is used to indicate the current backoff before retrying the failed writes to a to buffer partition
+var writeBackoffSeconds = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Subsystem: "source_forwarder",
+	Name:      "write_backoff_seconds",
+	Help:      "Current backoff in seconds before retrying the failed writes to a to buffer partition, 0 if not retrying",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline, metrics.LabelPartitionName})
+
+// burstBufferSpooledCount is used to indicate the number of messages spooled to the burst buffer
+var burstBufferSpooledCount = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "source_forwarder",
+	Name:      "burst_buffer_spooled_total",
+	Help:      "Total number of messages spooled to the burst buffer",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline, metrics.LabelPartitionName})
+
+// burstBufferDrainedCount is used to indicate the number of messages drained from the burst buffer
+var burstBufferDrainedCount = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "source_forwarder",
+	Name:      "burst_buffer_drained_total",
+	Help:      "Total number of messages drained from the burst buffer",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline, metrics.LabelPartitionName})
+
+// burstBufferSize is used to indicate the size of the spooled messages in the burst buffer
+var burstBufferSize = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Subsystem: "source_forwarder",
+	Name:      "burst_buffer_size_bytes",
+	Help:      "Size of the messages spooled in the burst buffer in bytes",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline, metrics.LabelPartitionName})
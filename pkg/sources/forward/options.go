@@ -17,6 +17,7 @@ limitations under the License.
 package forward
 
 import (
+	"fmt"
 	"time"
 
 	"go.uber.org/zap"
@@ -35,6 +36,10 @@ type options struct {
 	retryInterval time.Duration
 	// logger is used to pass the logger variable
 	logger *zap.SugaredLogger
+	// burstBufferDir is the directory to spool the messages when the writes fail, burst buffering is disabled if it's empty
+	burstBufferDir string
+	// burstBufferMaxSize is the max size of the spooled messages in bytes
+	burstBufferMaxSize int64
 }
 
 type Option func(*options) error
@@ -79,3 +84,15 @@ func WithLogger(l *zap.SugaredLogger) Option {
 		return nil
 	}
 }
+
+// WithBurstBuffer enables spooling the messages to the directory when the writes to the buffers fail
+func WithBurstBuffer(dir string, maxSize int64) Option {
+	return func(o *options) error {
+		if maxSize <= 0 {
+			return fmt.Errorf("burst buffer max size should be greater than 0")
+		}
+		o.burstBufferDir = dir
+		o.burstBufferMaxSize = maxSize
+		return nil
+	}
+}
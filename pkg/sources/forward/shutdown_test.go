@@ -42,7 +42,7 @@ func (s myShutdownTest) IsHealthy(ctx context.Context) error {
 	return nil
 }
 
-func (s myShutdownTest) WhereTo(_ []string, _ []string) ([]forward.VertexBuffer, error) {
+func (s myShutdownTest) WhereTo(_ []string, _ []string, _ string, _ map[string]string) ([]forward.VertexBuffer, error) {
 	return []forward.VertexBuffer{}, nil
 }
 
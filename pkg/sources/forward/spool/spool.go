@@ -0,0 +1,274 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package spool provides a FIFO queue of the messages on the local disk, which is used by the source forwarder to
+// absorb the bursts when the writes to the inter-step buffers fail.
+package spool
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/numaproj/numaflow/pkg/isb"
+)
+
+const segmentSuffix = ".seg"
+
+var (
+	// ErrFull is returned when appending a batch makes the spool exceed its max size.
+	ErrFull = errors.New("spool is full")
+	// ErrCorrupted is returned when the batch at the head can not be decoded.
+	ErrCorrupted = errors.New("spooled batch is corrupted")
+)
+
+// Batch is a batch of spooled messages.
+type Batch struct {
+	// Watermark is the watermark of the batch when it was spooled, it's published when the batch is drained.
+	Watermark time.Time
+	// Partitions are the indices of the source partitions the messages were read from.
+	Partitions []int32
+	Messages   []isb.Message
+}
+
+type segment struct {
+	seq  uint64
+	size int64
+}
+
+// Spool is a FIFO queue of the batches of messages persisted in a directory, each batch is saved to a segment file
+// named after its sequence number, so that the batches survive the restarts of the container. It's not safe for
+// concurrent use.
+type Spool struct {
+	dir      string
+	maxSize  int64
+	size     int64
+	segments []segment
+	nextSeq  uint64
+}
+
+// Open opens the spool in the directory, the batches spooled before are loaded in order.
+func Open(dir string, maxSize int64) (*Spool, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create spool directory %q, %w", dir, err)
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read spool directory %q, %w", dir, err)
+	}
+	s := &Spool{dir: dir, maxSize: maxSize}
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+		if !strings.HasSuffix(e.Name(), segmentSuffix) {
+			// e.g. a temporary file left by a crash while writing a segment
+			_ = os.Remove(filepath.Join(dir, e.Name()))
+			continue
+		}
+		seq, err := strconv.ParseUint(strings.TrimSuffix(e.Name(), segmentSuffix), 10, 64)
+		if err != nil {
+			continue
+		}
+		info, err := e.Info()
+		if err != nil {
+			return nil, fmt.Errorf("failed to stat spool segment %q, %w", e.Name(), err)
+		}
+		s.segments = append(s.segments, segment{seq: seq, size: info.Size()})
+		s.size += info.Size()
+	}
+	sort.Slice(s.segments, func(i, j int) bool { return s.segments[i].seq < s.segments[j].seq })
+	if n := len(s.segments); n > 0 {
+		s.nextSeq = s.segments[n-1].seq + 1
+	}
+	return s, nil
+}
+
+// Len returns the number of the spooled batches.
+func (s *Spool) Len() int {
+	return len(s.segments)
+}
+
+// Size returns the total size of the spooled batches in bytes.
+func (s *Spool) Size() int64 {
+	return s.size
+}
+
+// Append spools the batch at the tail, ErrFull is returned if it makes the spool exceed the max size.
+func (s *Spool) Append(b Batch) error {
+	data, err := encode(b)
+	if err != nil {
+		return err
+	}
+	if s.size+int64(len(data)) > s.maxSize {
+		return ErrFull
+	}
+	seg := segment{seq: s.nextSeq, size: int64(len(data))}
+	if err := s.writeSegment(seg.seq, data); err != nil {
+		return err
+	}
+	s.nextSeq++
+	s.segments = append(s.segments, seg)
+	s.size += seg.size
+	return nil
+}
+
+// Peek returns the batch at the head without removing it.
+func (s *Spool) Peek() (*Batch, error) {
+	if len(s.segments) == 0 {
+		return nil, fmt.Errorf("spool is empty")
+	}
+	data, err := os.ReadFile(s.segmentPath(s.segments[0].seq))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read spool segment, %w", err)
+	}
+	b, err := decode(data)
+	if err != nil {
+		return nil, fmt.Errorf("%w, %v", ErrCorrupted, err)
+	}
+	return b, nil
+}
+
+// Replace replaces the batch at the head, e.g. with the messages of it which are not drained yet.
+func (s *Spool) Replace(b Batch) error {
+	if len(s.segments) == 0 {
+		return fmt.Errorf("spool is empty")
+	}
+	data, err := encode(b)
+	if err != nil {
+		return err
+	}
+	head := &s.segments[0]
+	if err := s.writeSegment(head.seq, data); err != nil {
+		return err
+	}
+	s.size += int64(len(data)) - head.size
+	head.size = int64(len(data))
+	return nil
+}
+
+// Pop removes the batch at the head.
+func (s *Spool) Pop() error {
+	if len(s.segments) == 0 {
+		return fmt.Errorf("spool is empty")
+	}
+	if err := os.Remove(s.segmentPath(s.segments[0].seq)); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to remove spool segment, %w", err)
+	}
+	s.size -= s.segments[0].size
+	s.segments = s.segments[1:]
+	return nil
+}
+
+func (s *Spool) segmentPath(seq uint64) string {
+	return filepath.Join(s.dir, fmt.Sprintf("%020d%s", seq, segmentSuffix))
+}
+
+// writeSegment writes the segment to a temporary file and renames it, so that a segment is never partially written.
+func (s *Spool) writeSegment(seq uint64, data []byte) error {
+	path := s.segmentPath(seq)
+	tmp := path + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return fmt.Errorf("failed to create spool segment, %w", err)
+	}
+	if _, err := f.Write(data); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("failed to write spool segment, %w", err)
+	}
+	if err := f.Sync(); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("failed to sync spool segment, %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to close spool segment, %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("failed to rename spool segment, %w", err)
+	}
+	return nil
+}
+
+// encode encodes the batch as the watermark in milliseconds, the source partitions, and the length prefixed messages.
+func encode(b Batch) ([]byte, error) {
+	buf := new(bytes.Buffer)
+	_ = binary.Write(buf, binary.BigEndian, b.Watermark.UnixMilli())
+	_ = binary.Write(buf, binary.BigEndian, uint32(len(b.Partitions)))
+	for _, p := range b.Partitions {
+		_ = binary.Write(buf, binary.BigEndian, p)
+	}
+	_ = binary.Write(buf, binary.BigEndian, uint32(len(b.Messages)))
+	for _, m := range b.Messages {
+		data, err := m.MarshalBinary()
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode message %q, %w", m.ID, err)
+		}
+		_ = binary.Write(buf, binary.BigEndian, uint32(len(data)))
+		buf.Write(data)
+	}
+	return buf.Bytes(), nil
+}
+
+func decode(data []byte) (*Batch, error) {
+	r := bytes.NewReader(data)
+	var (
+		wm    int64
+		count uint32
+	)
+	if err := binary.Read(r, binary.BigEndian, &wm); err != nil {
+		return nil, fmt.Errorf("failed to decode spooled batch, %w", err)
+	}
+	b := &Batch{Watermark: time.UnixMilli(wm)}
+	if err := binary.Read(r, binary.BigEndian, &count); err != nil {
+		return nil, fmt.Errorf("failed to decode spooled batch, %w", err)
+	}
+	if int64(count)*4 > int64(r.Len()) {
+		return nil, fmt.Errorf("failed to decode spooled batch, truncated partitions")
+	}
+	b.Partitions = make([]int32, count)
+	if err := binary.Read(r, binary.BigEndian, b.Partitions); err != nil {
+		return nil, fmt.Errorf("failed to decode spooled batch, %w", err)
+	}
+	if err := binary.Read(r, binary.BigEndian, &count); err != nil {
+		return nil, fmt.Errorf("failed to decode spooled batch, %w", err)
+	}
+	if int64(count)*4 > int64(r.Len()) {
+		return nil, fmt.Errorf("failed to decode spooled batch, truncated messages")
+	}
+	b.Messages = make([]isb.Message, count)
+	for i := range b.Messages {
+		var size uint32
+		if err := binary.Read(r, binary.BigEndian, &size); err != nil {
+			return nil, fmt.Errorf("failed to decode spooled batch, %w", err)
+		}
+		if int64(size) > int64(r.Len()) {
+			return nil, fmt.Errorf("failed to decode spooled batch, truncated message")
+		}
+		msg := make([]byte, size)
+		_, _ = r.Read(msg)
+		if err := b.Messages[i].UnmarshalBinary(msg); err != nil {
+			return nil, fmt.Errorf("failed to decode spooled message, %w", err)
+		}
+	}
+	return b, nil
+}
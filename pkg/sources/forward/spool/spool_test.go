@@ -0,0 +1,105 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package spool
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/numaproj/numaflow/pkg/isb/testutils"
+)
+
+func testBatch(t *testing.T, count int64, start time.Time) Batch {
+	t.Helper()
+	return Batch{Watermark: start, Partitions: []int32{0, 2}, Messages: testutils.BuildTestWriteMessages(count, start)}
+}
+
+func TestSpool(t *testing.T) {
+	dir := t.TempDir()
+	s, err := Open(dir, 1024*1024)
+	require.NoError(t, err)
+	assert.Equal(t, 0, s.Len())
+
+	start := time.UnixMilli(60000)
+	require.NoError(t, s.Append(testBatch(t, 3, start)))
+	require.NoError(t, s.Append(testBatch(t, 2, start.Add(time.Minute))))
+	assert.Equal(t, 2, s.Len())
+	assert.True(t, s.Size() > 0)
+
+	// reopen, the batches survive
+	s, err = Open(dir, 1024*1024)
+	require.NoError(t, err)
+	assert.Equal(t, 2, s.Len())
+
+	b, err := s.Peek()
+	require.NoError(t, err)
+	assert.Equal(t, start.UnixMilli(), b.Watermark.UnixMilli())
+	assert.Equal(t, []int32{0, 2}, b.Partitions)
+	require.Len(t, b.Messages, 3)
+	assert.Equal(t, testBatch(t, 3, start).Messages[1].ID, b.Messages[1].ID)
+	assert.Equal(t, testBatch(t, 3, start).Messages[1].Payload, b.Messages[1].Payload)
+
+	// replace the head with the remaining messages
+	size := s.Size()
+	b.Messages = b.Messages[2:]
+	require.NoError(t, s.Replace(*b))
+	assert.True(t, s.Size() < size)
+	b, err = s.Peek()
+	require.NoError(t, err)
+	assert.Len(t, b.Messages, 1)
+
+	require.NoError(t, s.Pop())
+	b, err = s.Peek()
+	require.NoError(t, err)
+	assert.Equal(t, start.Add(time.Minute).UnixMilli(), b.Watermark.UnixMilli())
+	assert.Len(t, b.Messages, 2)
+	require.NoError(t, s.Pop())
+	assert.Equal(t, 0, s.Len())
+	assert.Equal(t, int64(0), s.Size())
+	_, err = s.Peek()
+	assert.Error(t, err)
+
+	// the sequence numbers are not reused
+	require.NoError(t, s.Append(testBatch(t, 1, start)))
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "00000000000000000002.seg", entries[0].Name())
+}
+
+func TestSpool_Full(t *testing.T) {
+	s, err := Open(t.TempDir(), 1024)
+	require.NoError(t, err)
+	assert.NoError(t, s.Append(testBatch(t, 1, time.Now())))
+	assert.ErrorIs(t, s.Append(testBatch(t, 100, time.Now())), ErrFull)
+	assert.Equal(t, 1, s.Len())
+}
+
+func TestOpen_TemporaryFiles(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "00000000000000000000.seg.tmp"), []byte("partial"), 0o644))
+	s, err := Open(dir, 1024)
+	require.NoError(t, err)
+	assert.Equal(t, 0, s.Len())
+	_, err = os.Stat(filepath.Join(dir, "00000000000000000000.seg.tmp"))
+	assert.True(t, os.IsNotExist(err))
+}
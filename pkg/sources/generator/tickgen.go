@@ -23,6 +23,7 @@ import (
 	"crypto/rand"
 	"encoding/json"
 	"fmt"
+	"path/filepath"
 	"time"
 
 	"go.uber.org/zap"
@@ -216,6 +217,10 @@ func NewMemGen(
 			forwardOpts = append(forwardOpts, sourceforward.WithReadBatchSize(int64(*x.ReadBatchSize)))
 		}
 	}
+	if x := vertexInstance.Vertex.Spec.Source.BurstBuffer; x != nil {
+		dir := filepath.Join(dfv1.PathBurstBufferMount, dfv1.GenerateBurstBufferDirName(vertexInstance.Vertex.Spec.Name, vertexInstance.Replica))
+		forwardOpts = append(forwardOpts, sourceforward.WithBurstBuffer(dir, x.GetMaxSize()))
+	}
 
 	// attach a source publisher so the source can assign the watermarks.
 	genSrc.sourcePublishWM = genSrc.buildSourceWatermarkPublisher(publishWMStores)
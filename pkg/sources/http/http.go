@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"path/filepath"
 	"strconv"
 	"time"
 
@@ -163,8 +164,12 @@ func New(
 		m := &isb.ReadMessage{
 			Message: isb.Message{
 				Header: isb.Header{
-					MessageInfo: isb.MessageInfo{EventTime: eventTime},
-					ID:          id,
+					MessageInfo: isb.MessageInfo{
+						EventTime:     eventTime,
+						SchemaVersion: r.Header.Get(dfv1.KeyMetaSchemaVersion),
+						TraceContext:  r.Header.Get(dfv1.KeyMetaTraceParent),
+					},
+					ID: id,
 				},
 				Body: isb.Body{
 					Payload: msg,
@@ -199,6 +204,10 @@ func New(
 			forwardOpts = append(forwardOpts, sourceforward.WithReadBatchSize(int64(*x.ReadBatchSize)))
 		}
 	}
+	if x := vertexInstance.Vertex.Spec.Source.BurstBuffer; x != nil {
+		dir := filepath.Join(dfv1.PathBurstBufferMount, dfv1.GenerateBurstBufferDirName(vertexInstance.Vertex.Spec.Name, vertexInstance.Replica))
+		forwardOpts = append(forwardOpts, sourceforward.WithBurstBuffer(dir, x.GetMaxSize()))
+	}
 
 	h.forwarder, err = sourceforward.NewDataForward(vertexInstance.Vertex, h, writers, fsd, transformerApplier, fetchWM, h, toVertexPublisherStores, forwardOpts...)
 	if err != nil {
@@ -0,0 +1,102 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafka
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/IBM/sarama"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+// ResetOffsets commits the offsets of the consumer group of a Kafka source, either to the first messages at or after
+// the timestamp of all the partitions, or to the given offsets by the partitions if the timestamp is zero. It requires
+// the consumer group to be empty, i.e. the pods of the source vertex are not running. It returns the committed offsets.
+func ResetOffsets(source *dfv1.KafkaSource, timestamp time.Time, offsets map[int32]int64) (map[int32]int64, error) {
+	config, err := saramaConfig(source)
+	if err != nil {
+		return nil, err
+	}
+	client, err := sarama.NewClient(source.Brokers, config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create a kafka client, %w", err)
+	}
+	defer func() { _ = client.Close() }()
+	partitions, err := client.Partitions(source.Topic)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the partitions of topic %q, %w", source.Topic, err)
+	}
+	targets := make(map[int32]int64)
+	if timestamp.IsZero() {
+		known := make(map[int32]bool)
+		for _, p := range partitions {
+			known[p] = true
+		}
+		for p, o := range offsets {
+			if !known[p] {
+				return nil, fmt.Errorf("partition %d not found from topic %q", p, source.Topic)
+			}
+			targets[p] = o
+		}
+	} else {
+		for _, p := range partitions {
+			o, err := client.GetOffset(source.Topic, p, timestamp.UnixMilli())
+			if err != nil {
+				return nil, fmt.Errorf("failed to get the offset of topic %q, partition %d, %w", source.Topic, p, err)
+			}
+			if o == -1 {
+				// No message at or after the timestamp, start from the next one to be written
+				if o, err = client.GetOffset(source.Topic, p, sarama.OffsetNewest); err != nil {
+					return nil, fmt.Errorf("failed to get the newest offset of topic %q, partition %d, %w", source.Topic, p, err)
+				}
+			}
+			targets[p] = o
+		}
+	}
+	coordinator, err := client.Coordinator(source.ConsumerGroupName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the coordinator of consumer group %q, %w", source.ConsumerGroupName, err)
+	}
+	req := &sarama.OffsetCommitRequest{
+		Version:                 2,
+		ConsumerGroup:           source.ConsumerGroupName,
+		ConsumerGroupGeneration: sarama.GroupGenerationUndefined,
+		RetentionTime:           -1,
+	}
+	sorted := make([]int32, 0, len(targets))
+	for p := range targets {
+		sorted = append(sorted, p)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	for _, p := range sorted {
+		req.AddBlock(source.Topic, p, targets[p], 0, "")
+	}
+	resp, err := coordinator.CommitOffset(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to commit the offsets of consumer group %q, %w", source.ConsumerGroupName, err)
+	}
+	for _, p := range sorted {
+		if kerr := resp.Errors[source.Topic][p]; kerr != sarama.ErrNoError {
+			// Committing fails with errors like UNKNOWN_MEMBER_ID if the consumer group is not empty
+			return nil, fmt.Errorf("failed to commit the offset of partition %d of consumer group %q, %w", p, source.ConsumerGroupName, kerr)
+		}
+	}
+	return targets, nil
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafka
+
+import (
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/stretchr/testify/assert"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+func newOffsetsMockBroker(t *testing.T, commitErr sarama.KError) *sarama.MockBroker {
+	broker := sarama.NewMockBroker(t, 1)
+	broker.SetHandlerByMap(map[string]sarama.MockResponse{
+		"MetadataRequest": sarama.NewMockMetadataResponse(t).
+			SetBroker(broker.Addr(), broker.BrokerID()).
+			SetLeader("topic", 0, broker.BrokerID()).
+			SetLeader("topic", 1, broker.BrokerID()),
+		"OffsetRequest": sarama.NewMockOffsetResponse(t).
+			SetOffset("topic", 0, 1696118400000, 10).
+			SetOffset("topic", 1, 1696118400000, -1).
+			SetOffset("topic", 1, sarama.OffsetNewest, 25),
+		"FindCoordinatorRequest": sarama.NewMockFindCoordinatorResponse(t).
+			SetCoordinator(sarama.CoordinatorGroup, "group", broker),
+		"OffsetCommitRequest": sarama.NewMockOffsetCommitResponse(t).
+			SetError("group", "topic", 0, commitErr).
+			SetError("group", "topic", 1, commitErr),
+	})
+	return broker
+}
+
+func TestResetOffsets(t *testing.T) {
+	t.Run("to a timestamp", func(t *testing.T) {
+		broker := newOffsetsMockBroker(t, sarama.ErrNoError)
+		defer broker.Close()
+		source := &dfv1.KafkaSource{Brokers: []string{broker.Addr()}, Topic: "topic", ConsumerGroupName: "group"}
+		offsets, err := ResetOffsets(source, time.UnixMilli(1696118400000), nil)
+		assert.NoError(t, err)
+		assert.Equal(t, map[int32]int64{0: 10, 1: 25}, offsets)
+	})
+
+	t.Run("to offsets", func(t *testing.T) {
+		broker := newOffsetsMockBroker(t, sarama.ErrNoError)
+		defer broker.Close()
+		source := &dfv1.KafkaSource{Brokers: []string{broker.Addr()}, Topic: "topic", ConsumerGroupName: "group"}
+		offsets, err := ResetOffsets(source, time.Time{}, map[int32]int64{1: 100})
+		assert.NoError(t, err)
+		assert.Equal(t, map[int32]int64{1: 100}, offsets)
+
+		_, err = ResetOffsets(source, time.Time{}, map[int32]int64{2: 100})
+		assert.ErrorContains(t, err, "partition 2 not found")
+	})
+
+	t.Run("consumer group not empty", func(t *testing.T) {
+		broker := newOffsetsMockBroker(t, sarama.ErrUnknownMemberId)
+		defer broker.Close()
+		source := &dfv1.KafkaSource{Brokers: []string{broker.Addr()}, Topic: "topic", ConsumerGroupName: "group"}
+		_, err := ResetOffsets(source, time.UnixMilli(1696118400000), nil)
+		assert.ErrorIs(t, err, sarama.ErrUnknownMemberId)
+	})
+}
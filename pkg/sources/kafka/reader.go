@@ -19,6 +19,7 @@ package kafka
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 	"sync"
 	"time"
 
@@ -79,7 +80,9 @@ type KafkaSource struct {
 	watermarkMaxDelay time.Duration
 	// source watermark publisher stores
 	srcPublishWMStores store.WatermarkStore
-	lock               *sync.RWMutex
+	// names of the headers carried from the kafka messages, used by the header shuffle strategies
+	headerNames []string
+	lock        *sync.RWMutex
 }
 
 // kafkaOffset implements isb.Offset
@@ -168,7 +171,7 @@ loop:
 		select {
 		case m := <-r.handler.messages:
 			kafkaSourceReadCount.With(map[string]string{metrics.LabelVertex: r.vertexName, metrics.LabelPipeline: r.pipelineName}).Inc()
-			msgs = append(msgs, toReadMessage(m))
+			msgs = append(msgs, toReadMessage(m, r.headerNames))
 		case <-timeout:
 			// log that timeout has happened and don't return an error
 			r.logger.Debugw("Timed out waiting for messages to read.", zap.Duration("waited", r.readTimeout))
@@ -346,6 +349,7 @@ func NewKafkaSource(
 		srcPublishWMStores: publishWMStores,
 		sourcePublishWMs:   make(map[int32]publish.Publisher),
 		watermarkMaxDelay:  vertexInstance.Vertex.Spec.Watermark.GetMaxDelay(),
+		headerNames:        vertexInstance.Vertex.Spec.ShuffleHeaderNames,
 		lock:               new(sync.RWMutex),
 		logger:             logging.NewLogger(), // default logger
 	}
@@ -356,27 +360,9 @@ func NewKafkaSource(
 		}
 	}
 
-	sarama.NewConfig()
-
-	config, err := configFromOpts(source.Config)
+	config, err := saramaConfig(source)
 	if err != nil {
-		return nil, fmt.Errorf("error reading kafka source config, %w", err)
-	}
-
-	if t := source.TLS; t != nil {
-		config.Net.TLS.Enable = true
-		if c, err := sharedutil.GetTLSConfig(t); err != nil {
-			return nil, err
-		} else {
-			config.Net.TLS.Config = c
-		}
-	}
-	if s := source.SASL; s != nil {
-		if sasl, err := sharedutil.GetSASL(s); err != nil {
-			return nil, err
-		} else {
-			config.Net.SASL = *sasl
-		}
+		return nil, err
 	}
 
 	sarama.Logger = zap.NewStdLog(kafkaSource.logger.Desugar())
@@ -400,6 +386,10 @@ func NewKafkaSource(
 			forwardOpts = append(forwardOpts, sourceforward.WithReadBatchSize(int64(*x.ReadBatchSize)))
 		}
 	}
+	if x := vertexInstance.Vertex.Spec.Source.BurstBuffer; x != nil {
+		dir := filepath.Join(dfv1.PathBurstBufferMount, dfv1.GenerateBurstBufferDirName(vertexInstance.Vertex.Spec.Name, vertexInstance.Replica))
+		forwardOpts = append(forwardOpts, sourceforward.WithBurstBuffer(dir, x.GetMaxSize()))
+	}
 	forwarder, err := sourceforward.NewDataForward(vertexInstance.Vertex, kafkaSource, writers, fsd, transformerApplier, fetchWM, kafkaSource, toVertexPublisherStores, forwardOpts...)
 	if err != nil {
 		kafkaSource.logger.Errorw("Error instantiating the forwarder", zap.Error(err))
@@ -425,6 +415,30 @@ func (r *KafkaSource) refreshAdminClient() error {
 	return nil
 }
 
+// saramaConfig builds the sarama config of a Kafka source, including the TLS and SASL settings.
+func saramaConfig(source *dfv1.KafkaSource) (*sarama.Config, error) {
+	config, err := configFromOpts(source.Config)
+	if err != nil {
+		return nil, fmt.Errorf("error reading kafka source config, %w", err)
+	}
+	if t := source.TLS; t != nil {
+		config.Net.TLS.Enable = true
+		if c, err := sharedutil.GetTLSConfig(t); err != nil {
+			return nil, err
+		} else {
+			config.Net.TLS.Config = c
+		}
+	}
+	if s := source.SASL; s != nil {
+		if sasl, err := sharedutil.GetSASL(s); err != nil {
+			return nil, err
+		} else {
+			config.Net.SASL = *sasl
+		}
+	}
+	return config, nil
+}
+
 func configFromOpts(yamlConfig string) (*sarama.Config, error) {
 	config, err := sharedutil.GetSaramaConfigFromYAMLString(yamlConfig)
 	if err != nil {
@@ -477,15 +491,37 @@ func (r *KafkaSource) startConsumer() {
 	close(r.stopCh)
 }
 
-func toReadMessage(m *sarama.ConsumerMessage) *isb.ReadMessage {
+// toReadMessage converts a kafka message, only the headers with the given names are carried besides the metadata ones.
+func toReadMessage(m *sarama.ConsumerMessage, headerNames []string) *isb.ReadMessage {
 	readOffset := &kafkaOffset{
 		offset:       m.Offset,
 		partitionIdx: m.Partition,
 		topic:        m.Topic,
 	}
+	var schemaVersion, traceContext string
+	var headers map[string]string
+	for _, h := range m.Headers {
+		if h == nil {
+			continue
+		}
+		switch string(h.Key) {
+		case dfv1.KeyMetaSchemaVersion:
+			schemaVersion = string(h.Value)
+		case dfv1.KeyMetaTraceParent:
+			traceContext = string(h.Value)
+		default:
+			if !sharedutil.StringSliceContains(headerNames, string(h.Key)) {
+				continue
+			}
+			if headers == nil {
+				headers = make(map[string]string)
+			}
+			headers[string(h.Key)] = string(h.Value)
+		}
+	}
 	msg := isb.Message{
 		Header: isb.Header{
-			MessageInfo: isb.MessageInfo{EventTime: m.Timestamp},
+			MessageInfo: isb.MessageInfo{EventTime: m.Timestamp, SchemaVersion: schemaVersion, TraceContext: traceContext, Headers: headers},
 			ID:          readOffset.String(),
 			Keys:        []string{string(m.Key)},
 		},
@@ -20,6 +20,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/IBM/sarama"
 	"github.com/stretchr/testify/assert"
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
@@ -172,3 +173,24 @@ func TestBufferSizeOverrides(t *testing.T) {
 	assert.Equal(t, 110, ks.handlerBuffer)
 
 }
+
+func TestToReadMessage_Headers(t *testing.T) {
+	m := toReadMessage(&sarama.ConsumerMessage{
+		Topic:     "testtopic",
+		Partition: 1,
+		Offset:    10,
+		Key:       []byte("k1"),
+		Value:     []byte("v1"),
+		Timestamp: time.UnixMilli(1676617200000),
+		Headers: []*sarama.RecordHeader{
+			{Key: []byte(dfv1.KeyMetaSchemaVersion), Value: []byte("v2")},
+			{Key: []byte("tenant"), Value: []byte("t1")},
+			{Key: []byte("region"), Value: []byte("r1")},
+			nil,
+		},
+	}, []string{"tenant"})
+	assert.Equal(t, "v2", m.SchemaVersion)
+	assert.Equal(t, map[string]string{"tenant": "t1"}, m.Headers)
+	assert.Nil(t, toReadMessage(&sarama.ConsumerMessage{Key: []byte("k1")}, []string{"tenant"}).Headers)
+	assert.Nil(t, toReadMessage(&sarama.ConsumerMessage{Key: []byte("k1"), Headers: []*sarama.RecordHeader{{Key: []byte("tenant"), Value: []byte("t1")}}}, nil).Headers)
+}
@@ -0,0 +1,38 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mqtt
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/numaproj/numaflow/pkg/metrics"
+)
+
+// mqttSourceReadCount is used to indicate the number of messages read by the mqtt source vertex
+var mqttSourceReadCount = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "mqtt_source",
+	Name:      "read_total",
+	Help:      "Total number of messages read",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline})
+
+// mqttSourceAckErrors is used to indicate the number of errors while acknowledging the messages to the broker
+var mqttSourceAckErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "mqtt_source",
+	Name:      "ack_error_total",
+	Help:      "Total number of errors acknowledging the messages to the broker",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline})
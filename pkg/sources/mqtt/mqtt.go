@@ -0,0 +1,310 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mqtt
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/forward"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/metrics"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+	sharedutil "github.com/numaproj/numaflow/pkg/shared/util"
+	sourceforward "github.com/numaproj/numaflow/pkg/sources/forward"
+	"github.com/numaproj/numaflow/pkg/sources/forward/applier"
+	"github.com/numaproj/numaflow/pkg/watermark/fetch"
+	"github.com/numaproj/numaflow/pkg/watermark/processor"
+	"github.com/numaproj/numaflow/pkg/watermark/publish"
+	"github.com/numaproj/numaflow/pkg/watermark/store"
+	"github.com/numaproj/numaflow/pkg/watermark/wmb"
+)
+
+type mqttSource struct {
+	vertexName   string
+	pipelineName string
+	replica      int32
+	logger       *zap.SugaredLogger
+
+	subscriber subscriber
+
+	bufferSize  int
+	messages    chan *isb.ReadMessage
+	readTimeout time.Duration
+	// pending is the number of the messages received but not yet written to the Inter-Step Buffers
+	pending atomic.Int64
+
+	lifecycleCtx context.Context
+	cancelFn     context.CancelFunc
+	forwarder    *sourceforward.DataForward
+	// source watermark publisher
+	sourcePublishWM publish.Publisher
+}
+
+// mqttOffset implements isb.Offset
+type mqttOffset struct {
+	id           string
+	ack          func() error
+	partitionIdx int32
+}
+
+func (o *mqttOffset) String() string {
+	return o.id
+}
+
+func (o *mqttOffset) Sequence() (int64, error) {
+	return 0, fmt.Errorf("sequence is not supported by mqtt offsets")
+}
+
+// AckIt acking is taken care by the source
+func (o *mqttOffset) AckIt() error {
+	return nil
+}
+
+func (o *mqttOffset) NoAck() error {
+	return nil
+}
+
+func (o *mqttOffset) PartitionIdx() int32 {
+	return o.partitionIdx
+}
+
+type Option func(*mqttSource) error
+
+// WithLogger is used to return logger information
+func WithLogger(l *zap.SugaredLogger) Option {
+	return func(o *mqttSource) error {
+		o.logger = l
+		return nil
+	}
+}
+
+// WithBufferSize sets the size of the buffer of the received messages
+func WithBufferSize(s int) Option {
+	return func(o *mqttSource) error {
+		o.bufferSize = s
+		return nil
+	}
+}
+
+// WithReadTimeout sets the read timeout
+func WithReadTimeout(t time.Duration) Option {
+	return func(o *mqttSource) error {
+		o.readTimeout = t
+		return nil
+	}
+}
+
+// New returns an MQTT source, which subscribes to the topics of an MQTT broker. With QoS 1, the messages are
+// acknowledged to the broker after they are written to the Inter-Step Buffers.
+func New(
+	vertexInstance *dfv1.VertexInstance,
+	writers map[string][]isb.BufferWriter,
+	fsd forward.ToWhichStepDecider,
+	transformerApplier applier.SourceTransformApplier,
+	fetchWM fetch.Fetcher,
+	toVertexPublisherStores map[string]store.WatermarkStore,
+	publishWMStores store.WatermarkStore,
+	opts ...Option) (*mqttSource, error) {
+
+	source := vertexInstance.Vertex.Spec.Source.MQTT
+	ms := &mqttSource{
+		vertexName:   vertexInstance.Vertex.Spec.Name,
+		pipelineName: vertexInstance.Vertex.Spec.PipelineName,
+		replica:      vertexInstance.Replica,
+		bufferSize:   1000,            // default size
+		readTimeout:  1 * time.Second, // default timeout
+	}
+	for _, o := range opts {
+		if err := o(ms); err != nil {
+			return nil, err
+		}
+	}
+	if ms.logger == nil {
+		ms.logger = logging.NewLogger()
+	}
+	ms.logger = ms.logger.With("sourceType", "mqtt")
+	ms.messages = make(chan *isb.ReadMessage, ms.bufferSize)
+	ctx, cancel := context.WithCancel(context.Background())
+	ms.lifecycleCtx = ctx
+	ms.cancelFn = cancel
+
+	forwardOpts := []sourceforward.Option{sourceforward.WithLogger(ms.logger)}
+	if x := vertexInstance.Vertex.Spec.Limits; x != nil {
+		if x.ReadBatchSize != nil {
+			forwardOpts = append(forwardOpts, sourceforward.WithReadBatchSize(int64(*x.ReadBatchSize)))
+		}
+	}
+	if x := vertexInstance.Vertex.Spec.Source.BurstBuffer; x != nil {
+		dir := filepath.Join(dfv1.PathBurstBufferMount, dfv1.GenerateBurstBufferDirName(vertexInstance.Vertex.Spec.Name, vertexInstance.Replica))
+		forwardOpts = append(forwardOpts, sourceforward.WithBurstBuffer(dir, x.GetMaxSize()))
+	}
+	forwarder, err := sourceforward.NewDataForward(vertexInstance.Vertex, ms, writers, fsd, transformerApplier, fetchWM, ms, toVertexPublisherStores, forwardOpts...)
+	if err != nil {
+		ms.logger.Errorw("Error instantiating the forwarder", zap.Error(err))
+		return nil, err
+	}
+	ms.forwarder = forwarder
+	entityName := fmt.Sprintf("%s-%d", vertexInstance.Vertex.Name, vertexInstance.Replica)
+	processorEntity := processor.NewProcessorEntity(entityName)
+	// toVertexPartitionCount is 1 because we publish watermarks within the source itself.
+	ms.sourcePublishWM = publish.NewPublish(ctx, processorEntity, publishWMStores, 1, publish.IsSource(), publish.WithDelay(vertexInstance.Vertex.Spec.Watermark.GetMaxDelay()))
+
+	conf := subscriberConfig{
+		url: source.URL,
+		// the client ID is stable across the restarts of a pod, so that its session is resumed
+		clientID: fmt.Sprintf("%s-%s-%d", vertexInstance.Vertex.Namespace, vertexInstance.Vertex.Name, vertexInstance.Replica),
+		filters:  source.Topics,
+		qos:      source.GetQoS(),
+	}
+	if source.IsSharedSubscription() {
+		conf.filters = sharedFilters(source.GetSharedSubscriptionGroup(vertexInstance.Vertex.Namespace, ms.pipelineName, ms.vertexName), source.Topics)
+	}
+	if source.TLS != nil {
+		if c, err := sharedutil.GetTLSConfig(source.TLS); err != nil {
+			return nil, err
+		} else {
+			conf.tlsConf = c
+		}
+	}
+	if x := source.Auth; x != nil && x.User != nil && x.Password != nil {
+		if conf.username, err = sharedutil.GetSecretFromVolume(x.User); err != nil {
+			return nil, fmt.Errorf("failed to get basic auth user, %w", err)
+		}
+		if conf.password, err = sharedutil.GetSecretFromVolume(x.Password); err != nil {
+			return nil, fmt.Errorf("failed to get basic auth password, %w", err)
+		}
+	}
+	ms.subscriber = newSubscriber(source.GetProtocolVersion(), conf, ms.handle, ms.logger)
+	ms.logger.Infow("Connecting to the mqtt broker...", zap.String("version", string(source.GetProtocolVersion())))
+	if err := ms.subscriber.connect(ctx); err != nil {
+		cancel()
+		return nil, fmt.Errorf("failed to connect to the mqtt broker, %w", err)
+	}
+	return ms, nil
+}
+
+// handle converts a message received from the broker to a read message, it blocks if the buffer is full.
+func (ms *mqttSource) handle(topic string, payload []byte, ack func() error) {
+	offset := &mqttOffset{id: uuid.New().String(), ack: ack, partitionIdx: ms.replica}
+	m := &isb.ReadMessage{
+		Message: isb.Message{
+			Header: isb.Header{
+				MessageInfo: isb.MessageInfo{EventTime: time.Now()},
+				ID:          offset.String(),
+				Keys:        []string{topic},
+			},
+			Body: isb.Body{Payload: payload},
+		},
+		ReadOffset: offset,
+	}
+	ms.pending.Add(1)
+	select {
+	case ms.messages <- m:
+	case <-ms.lifecycleCtx.Done():
+		ms.pending.Add(-1)
+	}
+}
+
+func (ms *mqttSource) GetName() string {
+	return ms.vertexName
+}
+
+// GetPartitionIdx returns the partition number for the source vertex buffer
+// Source is like a buffer with only one partition. So, we always return 0
+func (ms *mqttSource) GetPartitionIdx() int32 {
+	return 0
+}
+
+func (ms *mqttSource) Read(_ context.Context, count int64) ([]*isb.ReadMessage, error) {
+	var msgs []*isb.ReadMessage
+	timeout := time.After(ms.readTimeout)
+loop:
+	for i := int64(0); i < count; i++ {
+		select {
+		case m := <-ms.messages:
+			mqttSourceReadCount.With(map[string]string{metrics.LabelVertex: ms.vertexName, metrics.LabelPipeline: ms.pipelineName}).Inc()
+			msgs = append(msgs, m)
+		case <-timeout:
+			ms.logger.Debugw("Timed out waiting for messages to read.", zap.Duration("waited", ms.readTimeout), zap.Int("read", len(msgs)))
+			break loop
+		}
+	}
+	return msgs, nil
+}
+
+// Pending returns the number of the messages received from the broker, but not yet written to the Inter-Step Buffers.
+func (ms *mqttSource) Pending(_ context.Context) (int64, error) {
+	return ms.pending.Load(), nil
+}
+
+func (ms *mqttSource) PublishSourceWatermarks(msgs []*isb.ReadMessage) {
+	var oldest time.Time
+	for _, m := range msgs {
+		if oldest.IsZero() || m.EventTime.Before(oldest) {
+			oldest = m.EventTime
+		}
+	}
+	if len(msgs) > 0 && !oldest.IsZero() {
+		// toVertexPartitionIdx is 0 because we publish watermarks within the source itself.
+		ms.sourcePublishWM.PublishWatermark(wmb.Watermark(oldest), nil, 0) // Source publisher does not care about the offset
+	}
+}
+
+// Ack acknowledges the messages written to the Inter-Step Buffers to the broker. The broker redelivers the messages
+// failing to be acknowledged after the client reconnects, so the errors are only logged.
+func (ms *mqttSource) Ack(_ context.Context, offsets []isb.Offset) []error {
+	for _, offset := range offsets {
+		ms.pending.Add(-1)
+		o := offset.(*mqttOffset)
+		if err := o.ack(); err != nil {
+			mqttSourceAckErrors.With(map[string]string{metrics.LabelVertex: ms.vertexName, metrics.LabelPipeline: ms.pipelineName}).Inc()
+			ms.logger.Debugw("Failed to acknowledge the message to the mqtt broker", zap.String("offset", o.String()), zap.Error(err))
+		}
+	}
+	return make([]error, len(offsets))
+}
+
+func (ms *mqttSource) NoAck(_ context.Context, _ []isb.Offset) {}
+
+func (ms *mqttSource) Close() error {
+	ms.logger.Info("Shutting down mqtt source...")
+	ms.cancelFn()
+	ms.subscriber.close()
+	ms.logger.Info("Mqtt source shutdown")
+	return nil
+}
+
+func (ms *mqttSource) Stop() {
+	ms.logger.Info("Stopping mqtt reader...")
+	ms.forwarder.Stop()
+}
+
+func (ms *mqttSource) ForceStop() {
+	ms.Stop()
+}
+
+func (ms *mqttSource) Start() <-chan struct{} {
+	return ms.forwarder.Start()
+}
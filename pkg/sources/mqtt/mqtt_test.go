@@ -0,0 +1,84 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mqtt
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+func TestMQTTSource_ReadAndAck(t *testing.T) {
+	ms := &mqttSource{
+		vertexName:   "testVertex",
+		pipelineName: "testPipeline",
+		replica:      2,
+		messages:     make(chan *isb.ReadMessage, 10),
+		readTimeout:  100 * time.Millisecond,
+		logger:       logging.NewLogger(),
+		lifecycleCtx: context.Background(),
+	}
+	var acked []string
+	ack := func(id string, err error) func() error {
+		return func() error {
+			acked = append(acked, id)
+			return err
+		}
+	}
+	ms.handle("sensors/1/temperature", []byte("21.5"), ack("m1", nil))
+	ms.handle("sensors/2/temperature", []byte("22.0"), ack("m2", fmt.Errorf("connection lost")))
+	pending, err := ms.Pending(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), pending)
+
+	msgs, err := ms.Read(context.Background(), 5)
+	assert.NoError(t, err)
+	assert.Len(t, msgs, 2)
+	assert.Equal(t, []string{"sensors/1/temperature"}, msgs[0].Keys)
+	assert.Equal(t, []byte("21.5"), msgs[0].Payload)
+	assert.Equal(t, msgs[0].ReadOffset.String(), msgs[0].ID)
+	assert.Equal(t, int32(2), msgs[0].ReadOffset.PartitionIdx())
+	assert.Equal(t, []string{"sensors/2/temperature"}, msgs[1].Keys)
+
+	// the failures of acknowledging to the broker are not returned, the broker redelivers the messages
+	errs := ms.Ack(context.Background(), []isb.Offset{msgs[0].ReadOffset, msgs[1].ReadOffset})
+	for _, err := range errs {
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, []string{"m1", "m2"}, acked)
+	pending, err = ms.Pending(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), pending)
+}
+
+func TestSharedFilters(t *testing.T) {
+	assert.Equal(t, []string{"$share/g/sensors/+/temperature", "$share/g/alerts/#"}, sharedFilters("g", []string{"sensors/+/temperature", "alerts/#"}))
+}
+
+func TestDial(t *testing.T) {
+	_, err := dial(context.Background(), "ws://localhost:8080", nil)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "unsupported scheme")
+	_, err = dial(context.Background(), "://bad", nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,295 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mqtt
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/eclipse/paho.golang/packets"
+	paho "github.com/eclipse/paho.golang/paho"
+	mqttlib "github.com/eclipse/paho.mqtt.golang"
+	"go.uber.org/zap"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+const (
+	connectTimeout = 30 * time.Second
+	reconnectWait  = 3 * time.Second
+	keepAlive      = 30 * time.Second
+	// sessionExpiryInterval is the seconds the broker keeps the session of a disconnected MQTT v5 client, so that
+	// the messages not acknowledged are redelivered after reconnecting.
+	sessionExpiryInterval = uint32(3600)
+)
+
+// handler handles a message received from the broker, ack acknowledges it to the broker.
+type handler func(topic string, payload []byte, ack func() error)
+
+// subscriber is a client of the MQTT broker, which subscribes to the topics, and keeps reconnecting until it's closed.
+type subscriber interface {
+	// connect connects to the broker and subscribes to the topics, the received messages are passed to the handler.
+	connect(ctx context.Context) error
+	close()
+}
+
+type subscriberConfig struct {
+	url      string
+	clientID string
+	// filters are the topic filters to subscribe to, including the shared subscription prefix
+	filters  []string
+	qos      byte
+	tlsConf  *tls.Config
+	username string
+	password string
+}
+
+// sharedFilters returns the topic filters of a shared subscription.
+func sharedFilters(group string, topics []string) []string {
+	filters := make([]string, 0, len(topics))
+	for _, t := range topics {
+		filters = append(filters, fmt.Sprintf("$share/%s/%s", group, t))
+	}
+	return filters
+}
+
+func newSubscriber(version dfv1.MQTTProtocolVersion, conf subscriberConfig, h handler, logger *zap.SugaredLogger) subscriber {
+	if version == dfv1.MQTTProtocolVersion5 {
+		return &v5Subscriber{conf: conf, handler: h, logger: logger, down: make(chan struct{}, 1), done: make(chan struct{})}
+	}
+	return &v3Subscriber{conf: conf, handler: h, logger: logger}
+}
+
+// v3Subscriber is an MQTT v3.1.1 subscriber, which relies on the auto reconnection of the client.
+type v3Subscriber struct {
+	conf    subscriberConfig
+	handler handler
+	logger  *zap.SugaredLogger
+	client  mqttlib.Client
+}
+
+func (s *v3Subscriber) connect(_ context.Context) error {
+	filters := make(map[string]byte, len(s.conf.filters))
+	for _, f := range s.conf.filters {
+		filters[f] = s.conf.qos
+	}
+	opts := mqttlib.NewClientOptions().
+		AddBroker(s.conf.url).
+		SetClientID(s.conf.clientID).
+		// keep the session, so that the messages not acknowledged are redelivered after reconnecting
+		SetCleanSession(false).
+		SetAutoAckDisabled(true).
+		SetAutoReconnect(true).
+		SetMaxReconnectInterval(reconnectWait).
+		SetKeepAlive(keepAlive).
+		SetConnectTimeout(connectTimeout).
+		SetConnectionLostHandler(func(_ mqttlib.Client, err error) {
+			s.logger.Warnw("MQTT connection lost", zap.Error(err))
+		}).
+		SetOnConnectHandler(func(c mqttlib.Client) {
+			s.logger.Info("MQTT connected, subscribing to the topics...")
+			t := c.SubscribeMultiple(filters, func(_ mqttlib.Client, msg mqttlib.Message) {
+				s.handler(msg.Topic(), msg.Payload(), func() error {
+					msg.Ack()
+					return nil
+				})
+			})
+			if !t.WaitTimeout(connectTimeout) {
+				s.logger.Errorw("Timed out subscribing to the MQTT topics", zap.Strings("filters", s.conf.filters))
+			} else if err := t.Error(); err != nil {
+				s.logger.Errorw("Failed to subscribe to the MQTT topics", zap.Strings("filters", s.conf.filters), zap.Error(err))
+			}
+		})
+	if s.conf.tlsConf != nil {
+		opts.SetTLSConfig(s.conf.tlsConf)
+	}
+	if s.conf.username != "" {
+		opts.SetUsername(s.conf.username).SetPassword(s.conf.password)
+	}
+	s.client = mqttlib.NewClient(opts)
+	t := s.client.Connect()
+	if !t.WaitTimeout(connectTimeout) {
+		return fmt.Errorf("timed out connecting to the mqtt broker")
+	}
+	return t.Error()
+}
+
+func (s *v3Subscriber) close() {
+	if s.client != nil {
+		s.client.Disconnect(uint(time.Second.Milliseconds()))
+	}
+}
+
+// v5Subscriber is an MQTT v5 subscriber, it reconnects to the broker once the connection is lost.
+type v5Subscriber struct {
+	conf    subscriberConfig
+	handler handler
+	logger  *zap.SugaredLogger
+	lock    sync.Mutex
+	client  *paho.Client
+	// down is notified when the connection is lost
+	down chan struct{}
+	done chan struct{}
+}
+
+func (s *v5Subscriber) connect(ctx context.Context) error {
+	if err := s.connectOnce(ctx); err != nil {
+		return err
+	}
+	go s.reconnect(ctx)
+	return nil
+}
+
+// reconnect reconnects to the broker whenever the connection is lost, until the subscriber is closed.
+func (s *v5Subscriber) reconnect(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.done:
+			return
+		case <-s.down:
+		}
+		s.logger.Warn("MQTT connection lost, reconnecting...")
+		for {
+			err := s.connectOnce(ctx)
+			if err == nil {
+				break
+			}
+			s.logger.Errorw("Failed to reconnect to the MQTT broker", zap.Error(err))
+			select {
+			case <-ctx.Done():
+				return
+			case <-s.done:
+				return
+			case <-time.After(reconnectWait):
+			}
+		}
+	}
+}
+
+func (s *v5Subscriber) connectOnce(ctx context.Context) error {
+	conn, err := dial(ctx, s.conf.url, s.conf.tlsConf)
+	if err != nil {
+		return err
+	}
+	var client *paho.Client
+	client = paho.NewClient(paho.ClientConfig{
+		ClientID: s.conf.clientID,
+		Conn:     packets.NewThreadSafeConn(conn),
+		Router: paho.NewSingleHandlerRouter(func(p *paho.Publish) {
+			s.handler(p.Topic, p.Payload, func() error {
+				return client.Ack(p)
+			})
+		}),
+		EnableManualAcknowledgment: true,
+		OnClientError: func(err error) {
+			s.logger.Warnw("MQTT client error", zap.Error(err))
+			s.notifyDown()
+		},
+		OnServerDisconnect: func(d *paho.Disconnect) {
+			s.logger.Warnw("MQTT server disconnected", zap.Uint8("reasonCode", d.ReasonCode))
+			s.notifyDown()
+		},
+	})
+	expiry := sessionExpiryInterval
+	cp := &paho.Connect{
+		ClientID:   s.conf.clientID,
+		KeepAlive:  uint16(keepAlive.Seconds()),
+		CleanStart: false,
+		Properties: &paho.ConnectProperties{SessionExpiryInterval: &expiry},
+	}
+	if s.conf.username != "" {
+		cp.Username, cp.UsernameFlag = s.conf.username, true
+		cp.Password, cp.PasswordFlag = []byte(s.conf.password), true
+	}
+	connectCtx, cancel := context.WithTimeout(ctx, connectTimeout)
+	defer cancel()
+	if _, err := client.Connect(connectCtx, cp); err != nil {
+		_ = conn.Close()
+		return fmt.Errorf("failed to connect to the mqtt broker, %w", err)
+	}
+	subs := make([]paho.SubscribeOptions, 0, len(s.conf.filters))
+	for _, f := range s.conf.filters {
+		subs = append(subs, paho.SubscribeOptions{Topic: f, QoS: s.conf.qos})
+	}
+	if _, err := client.Subscribe(connectCtx, &paho.Subscribe{Subscriptions: subs}); err != nil {
+		_ = client.Disconnect(&paho.Disconnect{})
+		return fmt.Errorf("failed to subscribe to the mqtt topics, %w", err)
+	}
+	s.lock.Lock()
+	s.client = client
+	s.lock.Unlock()
+	s.logger.Infow("MQTT connected", zap.Strings("filters", s.conf.filters))
+	return nil
+}
+
+func (s *v5Subscriber) notifyDown() {
+	select {
+	case s.down <- struct{}{}:
+	default:
+	}
+}
+
+func (s *v5Subscriber) close() {
+	close(s.done)
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.client != nil {
+		_ = s.client.Disconnect(&paho.Disconnect{})
+	}
+}
+
+// dial opens a network connection to the broker, "ssl", "tls" and "mqtts" URLs are connected with TLS.
+func dial(ctx context.Context, brokerURL string, tlsConf *tls.Config) (net.Conn, error) {
+	u, err := url.Parse(brokerURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid mqtt broker url %q, %w", brokerURL, err)
+	}
+	secure := false
+	switch u.Scheme {
+	case "tcp", "mqtt":
+	case "ssl", "tls", "mqtts":
+		secure = true
+	default:
+		return nil, fmt.Errorf("unsupported scheme %q of the mqtt broker url", u.Scheme)
+	}
+	host := u.Host
+	if u.Port() == "" {
+		if secure {
+			host = net.JoinHostPort(u.Hostname(), "8883")
+		} else {
+			host = net.JoinHostPort(u.Hostname(), "1883")
+		}
+	}
+	dialCtx, cancel := context.WithTimeout(ctx, connectTimeout)
+	defer cancel()
+	if !secure {
+		var d net.Dialer
+		return d.DialContext(dialCtx, "tcp", host)
+	}
+	if tlsConf == nil {
+		tlsConf = &tls.Config{MinVersion: tls.VersionTLS12}
+	}
+	d := tls.Dialer{Config: tlsConf}
+	return d.DialContext(dialCtx, "tcp", host)
+}
@@ -19,6 +19,7 @@ package nats
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 	"time"
 
 	"github.com/google/uuid"
@@ -45,6 +46,8 @@ type natsSource struct {
 	name         string
 	pipelineName string
 	logger       *zap.SugaredLogger
+	// names of the headers carried from the nats messages, used by the header shuffle strategies
+	headerNames []string
 
 	natsConn *natslib.Conn
 	sub      *natslib.Subscription
@@ -72,6 +75,7 @@ func New(
 	n := &natsSource{
 		name:         vertexInstance.Vertex.Spec.Name,
 		pipelineName: vertexInstance.Vertex.Spec.PipelineName,
+		headerNames:  vertexInstance.Vertex.Spec.ShuffleHeaderNames,
 		bufferSize:   1000,            // default size
 		readTimeout:  1 * time.Second, // default timeout
 	}
@@ -91,6 +95,10 @@ func New(
 			forwardOpts = append(forwardOpts, sourceforward.WithReadBatchSize(int64(*x.ReadBatchSize)))
 		}
 	}
+	if x := vertexInstance.Vertex.Spec.Source.BurstBuffer; x != nil {
+		dir := filepath.Join(dfv1.PathBurstBufferMount, dfv1.GenerateBurstBufferDirName(vertexInstance.Vertex.Spec.Name, vertexInstance.Replica))
+		forwardOpts = append(forwardOpts, sourceforward.WithBurstBuffer(dir, x.GetMaxSize()))
+	}
 	forwarder, err := sourceforward.NewDataForward(vertexInstance.Vertex, n, writers, fsd, transformerApplier, fetchWM, n, toVertexPublisherStores, forwardOpts...)
 	if err != nil {
 		n.logger.Errorw("Error instantiating the forwarder", zap.Error(err))
@@ -166,7 +174,7 @@ func New(
 			Message: isb.Message{
 				Header: isb.Header{
 					// TODO: Be able to specify event time.
-					MessageInfo: isb.MessageInfo{EventTime: time.Now()},
+					MessageInfo: isb.MessageInfo{EventTime: time.Now(), SchemaVersion: msg.Header.Get(dfv1.KeyMetaSchemaVersion), Headers: toHeaders(msg.Header, n.headerNames)},
 					ID:          readOffset.String(),
 				},
 				Body: isb.Body{
@@ -282,3 +290,19 @@ func (ns *natsSource) ForceStop() {
 func (ns *natsSource) Start() <-chan struct{} {
 	return ns.forwarder.Start()
 }
+
+// toHeaders returns the first values of the NATS message headers with the given names.
+func toHeaders(h natslib.Header, names []string) map[string]string {
+	var headers map[string]string
+	for _, k := range names {
+		v := h[k]
+		if len(v) == 0 {
+			continue
+		}
+		if headers == nil {
+			headers = make(map[string]string, len(names))
+		}
+		headers[k] = v[0]
+	}
+	return headers
+}
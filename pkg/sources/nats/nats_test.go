@@ -38,7 +38,7 @@ import (
 type myForwardToAllTest struct {
 }
 
-func (f myForwardToAllTest) WhereTo(_ []string, _ []string) ([]forward.VertexBuffer, error) {
+func (f myForwardToAllTest) WhereTo(_ []string, _ []string, _ string, _ map[string]string) ([]forward.VertexBuffer, error) {
 	return []forward.VertexBuffer{{
 		ToVertexName:         "test",
 		ToVertexPartitionIdx: 0,
@@ -179,3 +179,15 @@ func Test_Multiple(t *testing.T) {
 		}
 	}
 }
+
+func TestToHeaders(t *testing.T) {
+	assert.Nil(t, toHeaders(nil, []string{"tenant"}))
+	h := natslib.Header{
+		dfv1.KeyMetaSchemaVersion: []string{"v2"},
+		"tenant":                  []string{"t1", "t2"},
+		"region":                  []string{"r1"},
+		"empty":                   []string{},
+	}
+	assert.Equal(t, map[string]string{"tenant": "t1"}, toHeaders(h, []string{"tenant", "empty"}))
+	assert.Nil(t, toHeaders(h, nil))
+}
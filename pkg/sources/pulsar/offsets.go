@@ -0,0 +1,54 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pulsar
+
+import (
+	"fmt"
+	"time"
+
+	pulsarlib "github.com/apache/pulsar-client-go/pulsar"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	pulsarclient "github.com/numaproj/numaflow/pkg/shared/clients/pulsar"
+)
+
+// ResetCursor resets the subscription of a Pulsar source to the first messages published at or after the timestamp.
+// Pulsar message IDs are opaque, so resetting to absolute offsets is not supported.
+func ResetCursor(source *dfv1.PulsarSource, timestamp time.Time) error {
+	client, err := pulsarclient.NewClient(source.ServiceURL, source.TLS, source.Auth)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	subscriptionType := pulsarlib.Shared
+	if source.GetSubscriptionType() == dfv1.PulsarSubscriptionTypeFailover {
+		subscriptionType = pulsarlib.Failover
+	}
+	consumer, err := client.Subscribe(pulsarlib.ConsumerOptions{
+		Topic:            source.Topic,
+		SubscriptionName: source.SubscriptionName,
+		Type:             subscriptionType,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to subscribe to pulsar topic %q, %w", source.Topic, err)
+	}
+	defer consumer.Close()
+	if err := consumer.SeekByTime(timestamp); err != nil {
+		return fmt.Errorf("failed to reset subscription %q of pulsar topic %q, %w", source.SubscriptionName, source.Topic, err)
+	}
+	return nil
+}
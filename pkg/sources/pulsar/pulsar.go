@@ -0,0 +1,325 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pulsar
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"time"
+
+	pulsarlib "github.com/apache/pulsar-client-go/pulsar"
+	"go.uber.org/zap"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/forward"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/metrics"
+	pulsarclient "github.com/numaproj/numaflow/pkg/shared/clients/pulsar"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+	sourceforward "github.com/numaproj/numaflow/pkg/sources/forward"
+	"github.com/numaproj/numaflow/pkg/sources/forward/applier"
+	"github.com/numaproj/numaflow/pkg/watermark/fetch"
+	"github.com/numaproj/numaflow/pkg/watermark/processor"
+	"github.com/numaproj/numaflow/pkg/watermark/publish"
+	"github.com/numaproj/numaflow/pkg/watermark/store"
+	"github.com/numaproj/numaflow/pkg/watermark/wmb"
+)
+
+type pulsarSource struct {
+	// name of the source vertex
+	vertexName string
+	// name of the pipeline
+	pipelineName string
+	// topic to consume messages from
+	topic    string
+	client   pulsarlib.Client
+	consumer pulsarlib.Consumer
+	// read timeout for the from buffer
+	readTimeout time.Duration
+	// size of the receiver queue of the consumer
+	receiverQueueSize int
+	// forwarder that writes the consumed data to destination
+	forwarder *sourceforward.DataForward
+	// context cancel function
+	cancelFn context.CancelFunc
+	// lifecycle context
+	lifecycleCtx context.Context
+	// source watermark publishers for different partitions of the topic
+	sourcePublishWMs map[int32]publish.Publisher
+	// max delay duration of watermark
+	watermarkMaxDelay time.Duration
+	// source watermark publisher stores
+	srcPublishWMStores store.WatermarkStore
+	lock               *sync.RWMutex
+	logger             *zap.SugaredLogger
+}
+
+// pulsarOffset implements isb.Offset
+type pulsarOffset struct {
+	msgID pulsarlib.MessageID
+	topic string
+}
+
+func (p *pulsarOffset) String() string {
+	return fmt.Sprintf("%s:%d:%d:%d:%d", p.topic, p.msgID.PartitionIdx(), p.msgID.LedgerID(), p.msgID.EntryID(), p.msgID.BatchIdx())
+}
+
+func (p *pulsarOffset) Sequence() (int64, error) {
+	return p.msgID.EntryID(), nil
+}
+
+// AckIt acking is taken care by the consumer
+func (p *pulsarOffset) AckIt() error {
+	return nil
+}
+
+func (p *pulsarOffset) NoAck() error {
+	return nil
+}
+
+// PartitionIdx returns the partition index of the topic, for a non-partitioned topic, it's -1.
+func (p *pulsarOffset) PartitionIdx() int32 {
+	return p.msgID.PartitionIdx()
+}
+
+type Option func(*pulsarSource) error
+
+// WithLogger is used to return logger information
+func WithLogger(l *zap.SugaredLogger) Option {
+	return func(o *pulsarSource) error {
+		o.logger = l
+		return nil
+	}
+}
+
+// WithReadTimeout sets the read timeout
+func WithReadTimeout(t time.Duration) Option {
+	return func(o *pulsarSource) error {
+		o.readTimeout = t
+		return nil
+	}
+}
+
+// WithReceiverQueueSize sets the size of the receiver queue of the consumer
+func WithReceiverQueueSize(s int) Option {
+	return func(o *pulsarSource) error {
+		o.receiverQueueSize = s
+		return nil
+	}
+}
+
+// New returns a pulsar source reading from a topic with a shared or failover subscription.
+func New(
+	vertexInstance *dfv1.VertexInstance,
+	writers map[string][]isb.BufferWriter,
+	fsd forward.ToWhichStepDecider,
+	transformerApplier applier.SourceTransformApplier,
+	fetchWM fetch.Fetcher,
+	toVertexPublisherStores map[string]store.WatermarkStore,
+	publishWMStores store.WatermarkStore,
+	opts ...Option) (*pulsarSource, error) {
+
+	source := vertexInstance.Vertex.Spec.Source.Pulsar
+	ps := &pulsarSource{
+		vertexName:         vertexInstance.Vertex.Spec.Name,
+		pipelineName:       vertexInstance.Vertex.Spec.PipelineName,
+		topic:              source.Topic,
+		readTimeout:        1 * time.Second, // default timeout
+		receiverQueueSize:  1000,            // default receiver queue size
+		srcPublishWMStores: publishWMStores,
+		sourcePublishWMs:   make(map[int32]publish.Publisher),
+		watermarkMaxDelay:  vertexInstance.Vertex.Spec.Watermark.GetMaxDelay(),
+		lock:               new(sync.RWMutex),
+	}
+	for _, o := range opts {
+		if err := o(ps); err != nil {
+			return nil, err
+		}
+	}
+	if ps.logger == nil {
+		ps.logger = logging.NewLogger()
+	}
+	ps.logger = ps.logger.With("sourceType", "pulsar").With("topic", source.Topic)
+
+	forwardOpts := []sourceforward.Option{sourceforward.WithLogger(ps.logger)}
+	if x := vertexInstance.Vertex.Spec.Limits; x != nil {
+		if x.ReadBatchSize != nil {
+			forwardOpts = append(forwardOpts, sourceforward.WithReadBatchSize(int64(*x.ReadBatchSize)))
+		}
+	}
+	if x := vertexInstance.Vertex.Spec.Source.BurstBuffer; x != nil {
+		dir := filepath.Join(dfv1.PathBurstBufferMount, dfv1.GenerateBurstBufferDirName(vertexInstance.Vertex.Spec.Name, vertexInstance.Replica))
+		forwardOpts = append(forwardOpts, sourceforward.WithBurstBuffer(dir, x.GetMaxSize()))
+	}
+	forwarder, err := sourceforward.NewDataForward(vertexInstance.Vertex, ps, writers, fsd, transformerApplier, fetchWM, ps, toVertexPublisherStores, forwardOpts...)
+	if err != nil {
+		ps.logger.Errorw("Error instantiating the forwarder", zap.Error(err))
+		return nil, err
+	}
+	ps.forwarder = forwarder
+	ctx, cancel := context.WithCancel(context.Background())
+	ps.cancelFn = cancel
+	ps.lifecycleCtx = ctx
+
+	client, err := pulsarclient.NewClient(source.ServiceURL, source.TLS, source.Auth)
+	if err != nil {
+		return nil, err
+	}
+	subscriptionType := pulsarlib.Shared
+	if source.GetSubscriptionType() == dfv1.PulsarSubscriptionTypeFailover {
+		subscriptionType = pulsarlib.Failover
+	}
+	ps.logger.Infow("Subscribing to pulsar topic...", zap.String("subscription", source.SubscriptionName), zap.String("subscriptionType", string(source.GetSubscriptionType())))
+	consumer, err := client.Subscribe(pulsarlib.ConsumerOptions{
+		Topic:             source.Topic,
+		SubscriptionName:  source.SubscriptionName,
+		Type:              subscriptionType,
+		Name:              fmt.Sprintf("%s-%s-%d", ps.pipelineName, ps.vertexName, vertexInstance.Replica),
+		ReceiverQueueSize: ps.receiverQueueSize,
+	})
+	if err != nil {
+		client.Close()
+		return nil, fmt.Errorf("failed to subscribe to pulsar topic %q, %w", source.Topic, err)
+	}
+	ps.client = client
+	ps.consumer = consumer
+	return ps, nil
+}
+
+func (ps *pulsarSource) GetName() string {
+	return ps.vertexName
+}
+
+// GetPartitionIdx returns the partition number for the source vertex buffer
+// Source is like a buffer with only one partition. So, we always return 0
+func (ps *pulsarSource) GetPartitionIdx() int32 {
+	return 0
+}
+
+func (ps *pulsarSource) Read(_ context.Context, count int64) ([]*isb.ReadMessage, error) {
+	msgs := make([]*isb.ReadMessage, 0, count)
+	timeout := time.After(ps.readTimeout)
+loop:
+	for i := int64(0); i < count; i++ {
+		select {
+		case m := <-ps.consumer.Chan():
+			pulsarSourceReadCount.With(map[string]string{metrics.LabelVertex: ps.vertexName, metrics.LabelPipeline: ps.pipelineName}).Inc()
+			msgs = append(msgs, toReadMessage(m.Message))
+		case <-timeout:
+			ps.logger.Debugw("Timed out waiting for messages to read.", zap.Duration("waited", ps.readTimeout), zap.Int("read", len(msgs)))
+			break loop
+		}
+	}
+	return msgs, nil
+}
+
+func (ps *pulsarSource) PublishSourceWatermarks(msgs []*isb.ReadMessage) {
+	// oldestTimestamps stores the oldest timestamps for different partitions
+	oldestTimestamps := make(map[int32]time.Time)
+	for _, m := range msgs {
+		if t, ok := oldestTimestamps[m.ReadOffset.PartitionIdx()]; !ok || m.EventTime.Before(t) {
+			oldestTimestamps[m.ReadOffset.PartitionIdx()] = m.EventTime
+		}
+	}
+	for p, t := range oldestTimestamps {
+		publisher := ps.loadSourceWatermarkPublisher(p)
+		// toVertexPartitionIdx is 0 because we publish watermarks within the source itself.
+		publisher.PublishWatermark(wmb.Watermark(t), nil, 0) // Source publisher does not care about the offset
+	}
+}
+
+// loadSourceWatermarkPublisher does a lazy load on the watermark publisher
+func (ps *pulsarSource) loadSourceWatermarkPublisher(partitionID int32) publish.Publisher {
+	ps.lock.Lock()
+	defer ps.lock.Unlock()
+	if p, ok := ps.sourcePublishWMs[partitionID]; ok {
+		return p
+	}
+	entityName := fmt.Sprintf("%s-%s-%d", ps.pipelineName, ps.vertexName, partitionID)
+	processorEntity := processor.NewProcessorEntity(entityName)
+	// toVertexPartitionCount is 1 because we publish watermarks within the source itself.
+	sourcePublishWM := publish.NewPublish(ps.lifecycleCtx, processorEntity, ps.srcPublishWMStores, 1, publish.IsSource(), publish.WithDelay(ps.watermarkMaxDelay))
+	ps.sourcePublishWMs[partitionID] = sourcePublishWM
+	return sourcePublishWM
+}
+
+// Ack acknowledges an array of offset.
+func (ps *pulsarSource) Ack(_ context.Context, offsets []isb.Offset) []error {
+	errs := make([]error, len(offsets))
+	for i, offset := range offsets {
+		if err := ps.consumer.AckID(offset.(*pulsarOffset).msgID); err != nil {
+			pulsarSourceAckErrors.With(map[string]string{metrics.LabelVertex: ps.vertexName, metrics.LabelPipeline: ps.pipelineName}).Inc()
+			errs[i] = fmt.Errorf("failed to ack pulsar message %q, %w", offset.String(), err)
+			continue
+		}
+		pulsarSourceAckCount.With(map[string]string{metrics.LabelVertex: ps.vertexName, metrics.LabelPipeline: ps.pipelineName}).Inc()
+	}
+	return errs
+}
+
+func (ps *pulsarSource) NoAck(_ context.Context, _ []isb.Offset) {}
+
+func (ps *pulsarSource) Close() error {
+	ps.logger.Info("Closing pulsar source...")
+	ps.cancelFn()
+	ps.consumer.Close()
+	ps.client.Close()
+	ps.logger.Info("Pulsar source closed")
+	return nil
+}
+
+func (ps *pulsarSource) Stop() {
+	ps.logger.Info("Stopping pulsar reader...")
+	ps.forwarder.Stop()
+}
+
+func (ps *pulsarSource) ForceStop() {
+	ps.Stop()
+}
+
+func (ps *pulsarSource) Start() <-chan struct{} {
+	return ps.forwarder.Start()
+}
+
+func toReadMessage(m pulsarlib.Message) *isb.ReadMessage {
+	readOffset := &pulsarOffset{
+		msgID: m.ID(),
+		topic: m.Topic(),
+	}
+	// event time is optional in pulsar, use the publish time if it's not set.
+	eventTime := m.EventTime()
+	if eventTime.IsZero() {
+		eventTime = m.PublishTime()
+	}
+	var keys []string
+	if m.Key() != "" {
+		keys = []string{m.Key()}
+	}
+	return &isb.ReadMessage{
+		ReadOffset: readOffset,
+		Message: isb.Message{
+			Header: isb.Header{
+				MessageInfo: isb.MessageInfo{EventTime: eventTime},
+				ID:          readOffset.String(),
+				Keys:        keys,
+			},
+			Body: isb.Body{Payload: m.Payload()},
+		},
+	}
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pulsar
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pulsarlib "github.com/apache/pulsar-client-go/pulsar"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+type fakeMessage struct {
+	pulsarlib.Message
+	id          pulsarlib.MessageID
+	key         string
+	payload     []byte
+	eventTime   time.Time
+	publishTime time.Time
+}
+
+func (f *fakeMessage) ID() pulsarlib.MessageID { return f.id }
+func (f *fakeMessage) Topic() string           { return "persistent://public/default/test" }
+func (f *fakeMessage) Key() string             { return f.key }
+func (f *fakeMessage) Payload() []byte         { return f.payload }
+func (f *fakeMessage) EventTime() time.Time    { return f.eventTime }
+func (f *fakeMessage) PublishTime() time.Time  { return f.publishTime }
+
+type fakeConsumer struct {
+	pulsarlib.Consumer
+	ch    chan pulsarlib.ConsumerMessage
+	acked []pulsarlib.MessageID
+}
+
+func (f *fakeConsumer) Chan() <-chan pulsarlib.ConsumerMessage { return f.ch }
+
+func (f *fakeConsumer) AckID(id pulsarlib.MessageID) error {
+	f.acked = append(f.acked, id)
+	return nil
+}
+
+func TestPulsarSource_ReadAndAck(t *testing.T) {
+	consumer := &fakeConsumer{ch: make(chan pulsarlib.ConsumerMessage, 10)}
+	ps := &pulsarSource{
+		vertexName:   "testVertex",
+		pipelineName: "testPipeline",
+		consumer:     consumer,
+		readTimeout:  100 * time.Millisecond,
+		logger:       logging.NewLogger(),
+	}
+	eventTime := time.UnixMilli(1000)
+	publishTime := time.UnixMilli(2000)
+	consumer.ch <- pulsarlib.ConsumerMessage{Message: &fakeMessage{id: pulsarlib.NewMessageID(1, 2, 0, 3), key: "k1", payload: []byte("hello"), eventTime: eventTime, publishTime: publishTime}}
+	consumer.ch <- pulsarlib.ConsumerMessage{Message: &fakeMessage{id: pulsarlib.NewMessageID(1, 3, 0, 3), payload: []byte("world"), publishTime: publishTime}}
+
+	msgs, err := ps.Read(context.Background(), 5)
+	assert.NoError(t, err)
+	assert.Len(t, msgs, 2)
+	assert.Equal(t, []string{"k1"}, msgs[0].Keys)
+	assert.Equal(t, eventTime, msgs[0].EventTime)
+	assert.Equal(t, "persistent://public/default/test:3:1:2:0", msgs[0].ID)
+	assert.Equal(t, int32(3), msgs[0].ReadOffset.PartitionIdx())
+	seq, err := msgs[0].ReadOffset.Sequence()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), seq)
+	assert.Nil(t, msgs[1].Keys)
+	// falls back to the publish time if the event time is not set
+	assert.Equal(t, publishTime, msgs[1].EventTime)
+	assert.Equal(t, []byte("world"), msgs[1].Payload)
+
+	errs := ps.Ack(context.Background(), []isb.Offset{msgs[0].ReadOffset, msgs[1].ReadOffset})
+	for _, err := range errs {
+		assert.NoError(t, err)
+	}
+	assert.Len(t, consumer.acked, 2)
+}
@@ -50,3 +50,10 @@ var redisStreamsSourceAckErrors = promauto.NewCounterVec(prometheus.CounterOpts{
 	Name:      "ack_err_total",
 	Help:      "Total number of Redis Streams messages that failed Ack",
 }, []string{metrics.LabelVertex, metrics.LabelPipeline})
+
+// Total number of Redis Streams messages claimed from other consumers
+var redisStreamsSourceClaimCount = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "redis_streams_source",
+	Name:      "claim_total",
+	Help:      "Total number of Redis Streams messages pending too long and claimed from other consumers",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline})
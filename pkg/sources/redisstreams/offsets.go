@@ -0,0 +1,55 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redisstreams
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+// ResetOffsets sets the last delivered ID of the consumer group of a Redis Streams source, so that the entries after it
+// are delivered again. The ID is either right before the first entry added at or after the timestamp, or the given
+// entry ID if the timestamp is zero. The entries delivered but not acked yet are left in the pending entries list.
+// It returns the ID set.
+func ResetOffsets(ctx context.Context, source *dfv1.RedisStreamsSource, timestamp time.Time, id string) (string, error) {
+	if !timestamp.IsZero() {
+		id = lastIDBefore(timestamp)
+	}
+	client, err := newRedisClient(source)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = client.Client.Close() }()
+	if err := client.Client.XGroupSetID(ctx, source.Stream, source.ConsumerGroup, id).Err(); err != nil {
+		return "", fmt.Errorf("failed to set the ID of group %q of stream %q, %w", source.ConsumerGroup, source.Stream, err)
+	}
+	return id, nil
+}
+
+// lastIDBefore returns the greatest possible entry ID before the timestamp, the entry IDs of Redis Streams are made of
+// the milliseconds when they are added and a sequence number.
+func lastIDBefore(timestamp time.Time) string {
+	ms := timestamp.UnixMilli()
+	if ms <= 0 {
+		return "0"
+	}
+	return fmt.Sprintf("%d-%d", ms-1, uint64(math.MaxUint64))
+}
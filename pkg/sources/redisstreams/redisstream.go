@@ -21,6 +21,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"time"
@@ -99,6 +100,7 @@ func New(
 		InfoRefreshInterval: time.Second,
 		ReadTimeOut:         time.Second,
 		CheckBackLog:        true,
+		ClaimMinIdleTime:    redisSpec.GetClaimMinIdleTime(),
 	}
 
 	// RedisStreamsReader handles reading from Redis Streams
@@ -122,6 +124,9 @@ func New(
 			AckErrorsAdd: func(count int) {
 				redisStreamsSourceAckErrors.With(map[string]string{metrics.LabelVertex: vertexSpec.Name, metrics.LabelPipeline: vertexSpec.PipelineName}).Add(float64(count))
 			},
+			ClaimsAdd: func(count int) {
+				redisStreamsSourceClaimCount.With(map[string]string{metrics.LabelVertex: vertexSpec.Name, metrics.LabelPipeline: vertexSpec.PipelineName}).Add(float64(count))
+			},
 		},
 	}
 
@@ -146,6 +151,10 @@ func New(
 			forwardOpts = append(forwardOpts, sourceforward.WithReadBatchSize(int64(*x.ReadBatchSize)))
 		}
 	}
+	if x := vertexInstance.Vertex.Spec.Source.BurstBuffer; x != nil {
+		dir := filepath.Join(dfv1.PathBurstBufferMount, dfv1.GenerateBurstBufferDirName(vertexInstance.Vertex.Spec.Name, vertexInstance.Replica))
+		forwardOpts = append(forwardOpts, sourceforward.WithBurstBuffer(dir, x.GetMaxSize()))
+	}
 	forwarder, err := sourceforward.NewDataForward(vertexInstance.Vertex, redisStreamsSource, writers, fsd, transformerApplier, fetchWM, redisStreamsSource, toVertexPublisherStores, forwardOpts...)
 	if err != nil {
 		redisStreamsSource.Log.Errorw("Error instantiating the forwarder", zap.Error(err))
@@ -178,7 +187,7 @@ func New(
 				var outMsg *isb.ReadMessage
 				var err error
 				if len(message.Values) >= 1 {
-					outMsg, err = produceMsg(message, vertexInstance.Replica)
+					outMsg, err = produceMsg(message, vertexInstance.Replica, redisSpec.EventTimeField)
 					if err != nil {
 						return nil, err
 					}
@@ -211,6 +220,7 @@ func newRedisClient(sourceSpec *dfv1.RedisStreamsSource) (*redisclient.RedisClie
 		if urls != "" {
 			opts.Addrs = strings.Split(urls, ",")
 		}
+		opts.SentinelUsername = sourceSpec.SentinelUser
 		sentinelPassword, _ := sharedutil.GetSecretFromVolume(sourceSpec.SentinelPassword)
 		opts.SentinelPassword = os.Getenv(sentinelPassword)
 	} else {
@@ -219,11 +229,16 @@ func newRedisClient(sourceSpec *dfv1.RedisStreamsSource) (*redisclient.RedisClie
 			opts.Addrs = strings.Split(urls, ",")
 		}
 	}
+	tlsConfig, err := sharedutil.GetTLSConfig(sourceSpec.TLS)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the tls config, %w", err)
+	}
+	opts.TLSConfig = tlsConfig
 
 	return redisclient.NewRedisClient(opts), nil
 }
 
-func produceMsg(inMsg redis.XMessage, replica int32) (*isb.ReadMessage, error) {
+func produceMsg(inMsg redis.XMessage, replica int32, eventTimeField string) (*isb.ReadMessage, error) {
 	var readOffset = redis2.NewRedisOffset(inMsg.ID, replica)
 
 	jsonSerialized, err := json.Marshal(inMsg.Values)
@@ -235,7 +250,7 @@ func produceMsg(inMsg redis.XMessage, replica int32) (*isb.ReadMessage, error) {
 		keys = append(keys, k)
 	}
 
-	msgTime, err := msgIdToTime(inMsg.ID)
+	msgTime, err := eventTime(inMsg, eventTimeField)
 	if err != nil {
 		return nil, err
 	}
@@ -254,6 +269,22 @@ func produceMsg(inMsg redis.XMessage, replica int32) (*isb.ReadMessage, error) {
 	}, nil
 }
 
+// eventTime returns the event time of an entry from the event time field, either in epoch milliseconds or in RFC3339
+// format, or from the entry ID if the field is not specified, missing or invalid.
+func eventTime(inMsg redis.XMessage, eventTimeField string) (time.Time, error) {
+	if eventTimeField != "" {
+		if v, ok := inMsg.Values[eventTimeField].(string); ok {
+			if ms, err := strconv.ParseInt(v, 10, 64); err == nil {
+				return time.UnixMilli(ms), nil
+			}
+			if t, err := time.Parse(time.RFC3339Nano, v); err == nil {
+				return t, nil
+			}
+		}
+	}
+	return msgIdToTime(inMsg.ID)
+}
+
 // the ID of the message is formatted <msecTime>-<index>
 func msgIdToTime(id string) (time.Time, error) {
 	splitStr := strings.Split(id, "-")
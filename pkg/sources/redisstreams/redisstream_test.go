@@ -61,7 +61,7 @@ func TestProduceMsg(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.testCase, func(t *testing.T) {
-			outMsg, err := produceMsg(tt.inMsg, 0)
+			outMsg, err := produceMsg(tt.inMsg, 0, "")
 			assert.NotNil(t, outMsg)
 			assert.Nil(t, err)
 			assert.Equal(t, tt.expectedBody, string(outMsg.Payload))
@@ -109,3 +109,34 @@ func TestMsgIdToTime(t *testing.T) {
 		})
 	}
 }
+
+func TestEventTime(t *testing.T) {
+	idTime := time.Date(2018, 2, 18, 10, 58, 0, 106000000, time.UTC)
+	msg := redis.XMessage{
+		ID: "1518951480106-0",
+		Values: map[string]interface{}{
+			"humidity": "50",
+			"ms":       "1696118401000",
+			"rfc3339":  "2023-10-01T00:00:01.5Z",
+		},
+	}
+	for field, expected := range map[string]time.Time{
+		"":         idTime,
+		"ms":       time.UnixMilli(1696118401000),
+		"rfc3339":  time.Date(2023, 10, 1, 0, 0, 1, 500000000, time.UTC),
+		"humidity": time.UnixMilli(50),
+		"missing":  idTime,
+	} {
+		tm, err := eventTime(msg, field)
+		assert.NoError(t, err)
+		assert.True(t, expected.Equal(tm), field)
+	}
+	msg.Values["invalid"] = "yesterday"
+	tm, err := eventTime(msg, "invalid")
+	assert.NoError(t, err)
+	assert.True(t, idTime.Equal(tm))
+
+	outMsg, err := produceMsg(msg, 0, "rfc3339")
+	assert.NoError(t, err)
+	assert.True(t, time.Date(2023, 10, 1, 0, 0, 1, 500000000, time.UTC).Equal(outMsg.EventTime))
+}
@@ -26,6 +26,10 @@ import (
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/forward"
 	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/isb/checksum"
+	"github.com/numaproj/numaflow/pkg/isb/encryption"
+	"github.com/numaproj/numaflow/pkg/isb/mirror"
+	"github.com/numaproj/numaflow/pkg/isb/signing"
 	jetstreamisb "github.com/numaproj/numaflow/pkg/isb/stores/jetstream"
 	redisisb "github.com/numaproj/numaflow/pkg/isb/stores/redis"
 	"github.com/numaproj/numaflow/pkg/isbsvc"
@@ -35,16 +39,20 @@ import (
 	jsclient "github.com/numaproj/numaflow/pkg/shared/clients/nats"
 	redisclient "github.com/numaproj/numaflow/pkg/shared/clients/redis"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/shared/termination"
 	sharedutil "github.com/numaproj/numaflow/pkg/shared/util"
 	"github.com/numaproj/numaflow/pkg/shuffle"
 	"github.com/numaproj/numaflow/pkg/sources/forward/applier"
 	"github.com/numaproj/numaflow/pkg/sources/generator"
 	"github.com/numaproj/numaflow/pkg/sources/http"
 	"github.com/numaproj/numaflow/pkg/sources/kafka"
+	"github.com/numaproj/numaflow/pkg/sources/mqtt"
 	"github.com/numaproj/numaflow/pkg/sources/nats"
+	"github.com/numaproj/numaflow/pkg/sources/pulsar"
 	"github.com/numaproj/numaflow/pkg/sources/redisstreams"
 	"github.com/numaproj/numaflow/pkg/sources/transformer"
 	"github.com/numaproj/numaflow/pkg/sources/udsource"
+	"github.com/numaproj/numaflow/pkg/sources/websocket"
 	"github.com/numaproj/numaflow/pkg/watermark/fetch"
 	"github.com/numaproj/numaflow/pkg/watermark/generic"
 	"github.com/numaproj/numaflow/pkg/watermark/generic/jetstream"
@@ -55,6 +63,9 @@ import (
 type SourceProcessor struct {
 	ISBSvcType     dfv1.ISBSvcType
 	VertexInstance *dfv1.VertexInstance
+	// PackedWriters are the in-memory writers of the edges to the vertices packed into the pod, keyed by the to vertex
+	// names. They replace the Inter-Step Buffer writers of these edges.
+	PackedWriters map[string][]isb.BufferWriter
 }
 
 func (sp *SourceProcessor) Start(ctx context.Context) error {
@@ -73,7 +84,7 @@ func (sp *SourceProcessor) Start(ctx context.Context) error {
 	defer cancel()
 	natsClientPool, err := jsclient.NewClientPool(ctx, jsclient.WithClientPoolSize(2))
 	if err != nil {
-		return fmt.Errorf("failed to create a new NATS client pool: %w", err)
+		return termination.WithCause(dfv1.RestartCauseISBConnectFailed, fmt.Errorf("failed to create a new NATS client pool: %w", err))
 	}
 	defer natsClientPool.CloseAll()
 	// watermark variables no-op initialization
@@ -85,9 +96,22 @@ func (sp *SourceProcessor) Start(ctx context.Context) error {
 		toVertexWatermarkStores[e.To], _ = store.BuildNoOpWatermarkStore()
 	}
 
+	// newMirrorWriter creates the writers for the mirror targets of the edges.
+	var newMirrorWriter mirror.WriterFunc
 	switch sp.ISBSvcType {
 	case dfv1.ISBSvcTypeRedis:
+		newMirrorWriter = func(bufferName string, partitionIdx int32) (isb.BufferWriter, error) {
+			redisClient, err := redisclient.NewInClusterRedisClient()
+			if err != nil {
+				return nil, termination.WithCause(dfv1.RestartCauseISBConnectFailed, fmt.Errorf("failed to create redis client, %w", err))
+			}
+			return redisisb.NewBufferWrite(ctx, redisClient, bufferName, bufferName+"-group", partitionIdx), nil
+		}
 		for _, e := range sp.VertexInstance.Vertex.Spec.ToEdges {
+			if writers, ok := sp.PackedWriters[e.To]; ok {
+				writersMap[e.To] = writers
+				continue
+			}
 			writeOpts := []redisclient.Option{
 				redisclient.WithBufferFullWritingStrategy(e.BufferFullWritingStrategy()),
 			}
@@ -97,12 +121,15 @@ func (sp *SourceProcessor) Start(ctx context.Context) error {
 			if x := e.ToVertexLimits; x != nil && x.BufferUsageLimit != nil {
 				writeOpts = append(writeOpts, redisclient.WithBufferUsageLimit(float64(*x.BufferUsageLimit)/100))
 			}
-			partitionedBuffers := dfv1.GenerateBufferNames(sp.VertexInstance.Vertex.Namespace, sp.VertexInstance.Vertex.Spec.PipelineName, e.To, e.GetToVertexPartitionCount())
+			partitionedBuffers := e.GetToBufferNames(sp.VertexInstance.Vertex.Namespace, sp.VertexInstance.Vertex.Spec.PipelineName)
 			var bufferWriters []isb.BufferWriter
 			// create a writer for each partition.
 			for partitionIdx, partition := range partitionedBuffers {
 				group := partition + "-group"
-				redisClient := redisclient.NewInClusterRedisClient()
+				redisClient, err := redisclient.NewInClusterRedisClient()
+				if err != nil {
+					return termination.WithCause(dfv1.RestartCauseISBConnectFailed, fmt.Errorf("failed to create redis client, %w", err))
+				}
 				writer := redisisb.NewBufferWrite(ctx, redisClient, partition, group, int32(partitionIdx), writeOpts...)
 				bufferWriters = append(bufferWriters, writer)
 			}
@@ -129,9 +156,19 @@ func (sp *SourceProcessor) Start(ctx context.Context) error {
 		if err != nil {
 			return err
 		}
+		newMirrorWriter = func(bufferName string, partitionIdx int32) (isb.BufferWriter, error) {
+			streamName := isbsvc.JetStreamName(bufferName)
+			return jetstreamisb.NewJetStreamBufferWriter(ctx, natsClientPool.NextAvailableClient(), bufferName, streamName, streamName, partitionIdx)
+		}
 		for _, e := range sp.VertexInstance.Vertex.Spec.ToEdges {
+			if writers, ok := sp.PackedWriters[e.To]; ok {
+				writersMap[e.To] = writers
+				continue
+			}
 			writeOpts := []jetstreamisb.WriteOption{
 				jetstreamisb.WithBufferFullWritingStrategy(e.BufferFullWritingStrategy()),
+				jetstreamisb.WithWriteAckPolicy(e.GetWriteAckPolicy()),
+				jetstreamisb.WithStrictFIFO(e.StrictFIFO),
 			}
 			if x := e.ToVertexLimits; x != nil && x.BufferMaxLength != nil {
 				writeOpts = append(writeOpts, jetstreamisb.WithMaxLength(int64(*x.BufferMaxLength)))
@@ -139,12 +176,15 @@ func (sp *SourceProcessor) Start(ctx context.Context) error {
 			if x := e.ToVertexLimits; x != nil && x.BufferUsageLimit != nil {
 				writeOpts = append(writeOpts, jetstreamisb.WithBufferUsageLimit(float64(*x.BufferUsageLimit)/100))
 			}
+			if x := sp.VertexInstance.Vertex.Spec.ClaimCheck; x != nil {
+				writeOpts = append(writeOpts, jetstreamisb.WithClaimCheck(isbsvc.JetStreamClaimCheckStoreName(sp.VertexInstance.Vertex.GetPipelineStoreName()), x.GetThreshold()))
+			}
 			var bufferWriters []isb.BufferWriter
-			partitionedBuffers := dfv1.GenerateBufferNames(sp.VertexInstance.Vertex.Namespace, sp.VertexInstance.Vertex.Spec.PipelineName, e.To, e.GetToVertexPartitionCount())
+			partitionedBuffers := e.GetToBufferNames(sp.VertexInstance.Vertex.Namespace, sp.VertexInstance.Vertex.Spec.PipelineName)
 			// create a writer for each partition.
 			for partitionIdx, partition := range partitionedBuffers {
 				streamName := isbsvc.JetStreamName(partition)
-				jetStreamClient := natsClientPool.NextAvailableClient()
+				jetStreamClient := natsClientPool.NextAvailableClient().ForToEdge(e, sp.VertexInstance.Vertex.Spec.GetJetStreamDomain())
 				writer, err := jetstreamisb.NewJetStreamBufferWriter(ctx, jetStreamClient, partition, streamName, streamName, int32(partitionIdx), writeOpts...)
 				if err != nil {
 					return err
@@ -157,6 +197,26 @@ func (sp *SourceProcessor) Start(ctx context.Context) error {
 	default:
 		return fmt.Errorf("unrecognized isb svc type %q", sp.ISBSvcType)
 	}
+	if writersMap, err = mirror.NewMirroringWriters(ctx, writersMap, sp.VertexInstance.Vertex, newMirrorWriter); err != nil {
+		return fmt.Errorf("failed to create the mirror writers, %w", err)
+	}
+	if x := sp.VertexInstance.Vertex.Spec.MessageChecksum; x != nil {
+		writersMap = checksum.NewChecksummingWriters(writersMap, x.GetAlgorithm())
+	}
+	if sp.VertexInstance.Vertex.Spec.MessageSigning != nil {
+		keyring, err := signing.NewKeyring(ctx, dfv1.PathSigningKeysMount)
+		if err != nil {
+			return fmt.Errorf("failed to load the message signing keys, %w", err)
+		}
+		writersMap = signing.NewSigningWriters(writersMap, keyring)
+	}
+	if x := sp.VertexInstance.Vertex.Spec.MessageEncryption; x != nil {
+		encryptor, err := encryption.NewEncryptor(x)
+		if err != nil {
+			return fmt.Errorf("failed to create the message encryptor, %w", err)
+		}
+		writersMap = encryption.NewEncryptingWriters(writersMap, encryptor)
+	}
 
 	// Populate the shuffle function map
 	// we need to shuffle the messages, because we can have a reduce vertex immediately after a source vertex.
@@ -164,16 +224,20 @@ func (sp *SourceProcessor) Start(ctx context.Context) error {
 	shuffleFuncMap := make(map[string]*shuffle.Shuffle)
 	for _, edge := range sp.VertexInstance.Vertex.Spec.ToEdges {
 		if edge.ToVertexType == dfv1.VertexTypeReduceUDF && edge.GetToVertexPartitionCount() > 1 {
-			s := shuffle.NewShuffle(edge.To, edge.GetToVertexPartitionCount())
+			s := shuffle.NewShuffle(edge.To, edge.GetToVertexPartitionCount(), shuffle.WithStrategy(edge.Shuffle))
 			shuffleFuncMap[fmt.Sprintf("%s:%s", edge.From, edge.To)] = s
 		}
 		toVertexPartitionMap[edge.To] = edge.GetToVertexPartitionCount()
 	}
+	degradation := forward.NewDegradationWatcher(sp.VertexInstance.Vertex)
+	if degradation != nil {
+		go degradation.Start(ctx)
+	}
 
 	// if the source is a user-defined source, we create a gRPC client for it.
 	var udsGRPCClient *udsource.GRPCBasedUDSource
 	if sp.VertexInstance.Vertex.IsUDSource() {
-		srcClient, err := sourceclient.New()
+		srcClient, err := sourceclient.New(sourceclient.WithCallPolicy(sharedutil.LookupGRPCCallPolicyFromEnv()))
 		if err != nil {
 			return fmt.Errorf("failed to create a new gRPC client: %w", err)
 		}
@@ -184,7 +248,7 @@ func (sp *SourceProcessor) Start(ctx context.Context) error {
 		}
 		// Readiness check
 		if err = udsGRPCClient.WaitUntilReady(ctx); err != nil {
-			return fmt.Errorf("failed on user defined source readiness check, %w", err)
+			return termination.WithCause(dfv1.RestartCauseUDSHandshakeFailed, fmt.Errorf("failed on user defined source readiness check, %w", err))
 		}
 		defer func() {
 			err = udsGRPCClient.CloseConn(ctx)
@@ -195,8 +259,32 @@ func (sp *SourceProcessor) Start(ctx context.Context) error {
 		readyCheckers = append(readyCheckers, udsGRPCClient)
 	}
 	maxMessageSize := sharedutil.LookupEnvIntOr(dfv1.EnvGRPCMaxMessageSize, dfv1.DefaultGRPCMaxMessageSize)
-	if sp.VertexInstance.Vertex.HasUDTransformer() {
-		sdkClient, err = sourcetransformer.New(sourcetransformer.WithMaxMessageSize(maxMessageSize))
+	if stages := sp.VertexInstance.Vertex.Spec.Source.Transformers; len(stages) > 0 {
+		chainStages := make([]transformer.Stage, 0, len(stages))
+		for _, stage := range stages {
+			stageClient, err := sourcetransformer.New(sourcetransformer.WithMaxMessageSize(maxMessageSize), sourcetransformer.WithCallPolicy(sharedutil.LookupGRPCCallPolicyFromEnv()), sourcetransformer.WithRuntimeDir(stage.RuntimeDir()))
+			if err != nil {
+				return fmt.Errorf("failed to create gRPC client of transformer stage %q, %w", stage.Name, err)
+			}
+			stageGRPCClient := transformer.NewStageGRPCBasedTransformer(stageClient, stage)
+			defer func() {
+				if err := stageGRPCClient.CloseConn(ctx); err != nil {
+					log.Warnw("Failed to close gRPC client conn", zap.Error(err))
+				}
+			}()
+			if err = stageGRPCClient.WaitUntilReady(ctx); err != nil {
+				return termination.WithCause(dfv1.RestartCauseUDSHandshakeFailed, fmt.Errorf("failed on transformer stage %q readiness check, %w", stage.Name, err))
+			}
+			readyCheckers = append(readyCheckers, stageGRPCClient)
+			chainStages = append(chainStages, transformer.Stage{Name: stage.Name, Applier: stageGRPCClient, OnFailure: stage.GetOnFailure(), FailureTag: stage.FailureTag})
+		}
+		if batchEnabled, _ := sp.VertexInstance.Vertex.SourceTransformerBatchEnabled(); batchEnabled {
+			log.Warn("Source transformer batch mode is not supported by a transformer chain, falling back to per-message transforms")
+		}
+		chain := transformer.NewChain(sp.VertexInstance.Vertex.Spec.Name, sp.VertexInstance.Vertex.Spec.PipelineName, chainStages)
+		sourcer, err = sp.getSourcer(writersMap, sp.getTransformerGoWhereDecider(shuffleFuncMap, degradation), chain, udsGRPCClient, fetchWatermark, toVertexWatermarkStores, sourcePublisherStores, log)
+	} else if sp.VertexInstance.Vertex.HasUDTransformer() {
+		sdkClient, err = sourcetransformer.New(sourcetransformer.WithMaxMessageSize(maxMessageSize), sourcetransformer.WithCallPolicy(sharedutil.LookupGRPCCallPolicyFromEnv()))
 		if err != nil {
 			return fmt.Errorf("failed to create gRPC client, %w", err)
 		}
@@ -213,13 +301,21 @@ func (sp *SourceProcessor) Start(ctx context.Context) error {
 
 		// Readiness check
 		if err = transformerGRPCClient.WaitUntilReady(ctx); err != nil {
-			return fmt.Errorf("failed on user defined source readiness check, %w", err)
+			return termination.WithCause(dfv1.RestartCauseUDSHandshakeFailed, fmt.Errorf("failed on user defined source readiness check, %w", err))
+		}
+
+		batchEnabled, err := sp.VertexInstance.Vertex.SourceTransformerBatchEnabled()
+		if err != nil {
+			return err
+		}
+		if batchEnabled && !transformerGRPCClient.EnableBatch() {
+			log.Warn("Source transformer batch mode is not supported by the transformer, falling back to per-message transforms")
 		}
 
 		readyCheckers = append(readyCheckers, transformerGRPCClient)
-		sourcer, err = sp.getSourcer(writersMap, sp.getTransformerGoWhereDecider(shuffleFuncMap), transformerGRPCClient, udsGRPCClient, fetchWatermark, toVertexWatermarkStores, sourcePublisherStores, log)
+		sourcer, err = sp.getSourcer(writersMap, sp.getTransformerGoWhereDecider(shuffleFuncMap, degradation), transformerGRPCClient, udsGRPCClient, fetchWatermark, toVertexWatermarkStores, sourcePublisherStores, log)
 	} else {
-		sourcer, err = sp.getSourcer(writersMap, sp.getSourceGoWhereDecider(shuffleFuncMap), applier.Terminal, udsGRPCClient, fetchWatermark, toVertexWatermarkStores, sourcePublisherStores, log)
+		sourcer, err = sp.getSourcer(writersMap, sp.getSourceGoWhereDecider(shuffleFuncMap, degradation), applier.Terminal, udsGRPCClient, fetchWatermark, toVertexWatermarkStores, sourcePublisherStores, log)
 	}
 	if err != nil {
 		return fmt.Errorf("failed to find a sourcer, error: %w", err)
@@ -321,19 +417,47 @@ func (sp *SourceProcessor) getSourcer(
 			readOptions = append(readOptions, redisstreams.WithReadTimeOut(l.ReadTimeout.Duration))
 		}
 		return redisstreams.New(sp.VertexInstance, writers, fsd, transformerApplier, fetchWM, toVertexPublisherStores, publishWMStores, readOptions...)
+	} else if x := src.Pulsar; x != nil {
+		readOptions := []pulsar.Option{
+			pulsar.WithLogger(logger),
+		}
+		if l := sp.VertexInstance.Vertex.Spec.Limits; l != nil && l.ReadTimeout != nil {
+			readOptions = append(readOptions, pulsar.WithReadTimeout(l.ReadTimeout.Duration))
+		}
+		return pulsar.New(sp.VertexInstance, writers, fsd, transformerApplier, fetchWM, toVertexPublisherStores, publishWMStores, readOptions...)
+	} else if x := src.WebSocket; x != nil {
+		readOptions := []websocket.Option{
+			websocket.WithLogger(logger),
+		}
+		if l := sp.VertexInstance.Vertex.Spec.Limits; l != nil && l.ReadTimeout != nil {
+			readOptions = append(readOptions, websocket.WithReadTimeout(l.ReadTimeout.Duration))
+		}
+		return websocket.New(sp.VertexInstance, writers, fsd, transformerApplier, fetchWM, toVertexPublisherStores, publishWMStores, readOptions...)
+	} else if x := src.MQTT; x != nil {
+		readOptions := []mqtt.Option{
+			mqtt.WithLogger(logger),
+		}
+		if l := sp.VertexInstance.Vertex.Spec.Limits; l != nil && l.ReadTimeout != nil {
+			readOptions = append(readOptions, mqtt.WithReadTimeout(l.ReadTimeout.Duration))
+		}
+		return mqtt.New(sp.VertexInstance, writers, fsd, transformerApplier, fetchWM, toVertexPublisherStores, publishWMStores, readOptions...)
 	}
 	return nil, fmt.Errorf("invalid source spec")
 }
 
-func (sp *SourceProcessor) getSourceGoWhereDecider(shuffleFuncMap map[string]*shuffle.Shuffle) forward.GoWhere {
+func (sp *SourceProcessor) getSourceGoWhereDecider(shuffleFuncMap map[string]*shuffle.Shuffle, degradation *forward.DegradationWatcher) forward.GoWhere {
 	getToBufferPartition := GetPartitionedBufferIdx()
+	edgeRouter := forward.NewEdgeRouter(sp.VertexInstance.Vertex.Spec.ToEdges, degradation)
 
-	fsd := forward.GoWhere(func(keys []string, tags []string) ([]forward.VertexBuffer, error) {
+	fsd := forward.GoWhere(func(keys []string, tags []string, schemaVersion string, headers map[string]string) ([]forward.VertexBuffer, error) {
 		var result []forward.VertexBuffer
 
 		for _, edge := range sp.VertexInstance.Vertex.Spec.ToEdges {
+			if !edge.Conditions.MatchSchemaVersion(schemaVersion) {
+				continue
+			}
 			if edge.ToVertexType == dfv1.VertexTypeReduceUDF && edge.GetToVertexPartitionCount() > 1 { // Need to shuffle
-				toVertexPartition := shuffleFuncMap[fmt.Sprintf("%s:%s", edge.From, edge.To)].Shuffle(keys)
+				toVertexPartition := shuffleFuncMap[fmt.Sprintf("%s:%s", edge.From, edge.To)].Shuffle(keys, headers)
 				result = append(result, forward.VertexBuffer{
 					ToVertexName:         edge.To,
 					ToVertexPartitionIdx: toVertexPartition,
@@ -345,14 +469,15 @@ func (sp *SourceProcessor) getSourceGoWhereDecider(shuffleFuncMap map[string]*sh
 				})
 			}
 		}
-		return result, nil
+		return edgeRouter.Route(result), nil
 	})
 	return fsd
 }
 
-func (sp *SourceProcessor) getTransformerGoWhereDecider(shuffleFuncMap map[string]*shuffle.Shuffle) forward.GoWhere {
+func (sp *SourceProcessor) getTransformerGoWhereDecider(shuffleFuncMap map[string]*shuffle.Shuffle, degradation *forward.DegradationWatcher) forward.GoWhere {
 	getToBufferPartition := GetPartitionedBufferIdx()
-	fsd := forward.GoWhere(func(keys []string, tags []string) ([]forward.VertexBuffer, error) {
+	edgeRouter := forward.NewEdgeRouter(sp.VertexInstance.Vertex.Spec.ToEdges, degradation)
+	fsd := forward.GoWhere(func(keys []string, tags []string, schemaVersion string, headers map[string]string) ([]forward.VertexBuffer, error) {
 		var result []forward.VertexBuffer
 
 		if sharedutil.StringSliceContains(tags, dfv1.MessageTagDrop) {
@@ -360,10 +485,13 @@ func (sp *SourceProcessor) getTransformerGoWhereDecider(shuffleFuncMap map[strin
 		}
 
 		for _, edge := range sp.VertexInstance.Vertex.Spec.ToEdges {
+			if !edge.Conditions.MatchSchemaVersion(schemaVersion) {
+				continue
+			}
 			// If returned tags are not "DROP", and there are no conditions defined in the edge, treat it as "ALL".
 			if edge.Conditions == nil || edge.Conditions.Tags == nil || len(edge.Conditions.Tags.Values) == 0 {
 				if edge.ToVertexType == dfv1.VertexTypeReduceUDF && edge.GetToVertexPartitionCount() > 1 { // Need to shuffle
-					toVertexPartition := shuffleFuncMap[fmt.Sprintf("%s:%s", edge.From, edge.To)].Shuffle(keys)
+					toVertexPartition := shuffleFuncMap[fmt.Sprintf("%s:%s", edge.From, edge.To)].Shuffle(keys, headers)
 					result = append(result, forward.VertexBuffer{
 						ToVertexName:         edge.To,
 						ToVertexPartitionIdx: toVertexPartition,
@@ -377,7 +505,7 @@ func (sp *SourceProcessor) getTransformerGoWhereDecider(shuffleFuncMap map[strin
 			} else {
 				if sharedutil.CompareSlice(edge.Conditions.Tags.GetOperator(), tags, edge.Conditions.Tags.Values) {
 					if edge.ToVertexType == dfv1.VertexTypeReduceUDF && edge.GetToVertexPartitionCount() > 1 { // Need to shuffle
-						toVertexPartition := shuffleFuncMap[fmt.Sprintf("%s:%s", edge.From, edge.To)].Shuffle(keys)
+						toVertexPartition := shuffleFuncMap[fmt.Sprintf("%s:%s", edge.From, edge.To)].Shuffle(keys, headers)
 						result = append(result, forward.VertexBuffer{
 							ToVertexName:         edge.To,
 							ToVertexPartitionIdx: toVertexPartition,
@@ -391,7 +519,7 @@ func (sp *SourceProcessor) getTransformerGoWhereDecider(shuffleFuncMap map[strin
 				}
 			}
 		}
-		return result, nil
+		return edgeRouter.Route(result), nil
 	})
 	return fsd
 }
@@ -26,6 +26,7 @@ import (
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 	eventtime "github.com/numaproj/numaflow/pkg/sources/transformer/builtin/event_time"
 	"github.com/numaproj/numaflow/pkg/sources/transformer/builtin/filter"
+	schemaupconvert "github.com/numaproj/numaflow/pkg/sources/transformer/builtin/schema_upconvert"
 	timeextractionfilter "github.com/numaproj/numaflow/pkg/sources/transformer/builtin/time_extraction_filter"
 )
 
@@ -56,6 +57,8 @@ func (b *Builtin) executor() (sourcetransformer.SourceTransformFunc, error) {
 		return eventtime.New(b.KWArgs)
 	case "timeExtractionFilter":
 		return timeextractionfilter.New(b.KWArgs)
+	case "schemaUpconvert":
+		return schemaupconvert.New(b.KWArgs)
 	default:
 		return nil, fmt.Errorf("unrecognized transformer %q", b.Name)
 	}
@@ -36,6 +36,10 @@ func TestGetExecutors(t *testing.T) {
 				Name:   "filter",
 				KWArgs: map[string]string{"expression": `json(payload).a=="b"`},
 			},
+			{
+				Name:   "schemaUpconvert",
+				KWArgs: map[string]string{"mappings": `[{"from": "v1", "to": "v2", "rename": {"a": "b"}}]`},
+			},
 		}
 		for _, b := range builtins {
 			e, err := b.executor()
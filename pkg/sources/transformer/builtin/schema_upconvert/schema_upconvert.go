@@ -0,0 +1,180 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schemaupconvert
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/numaproj/numaflow-go/pkg/sourcetransformer"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"sigs.k8s.io/yaml"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+// mapping describes how to upconvert the JSON payload of a schema version to the next one.
+type mapping struct {
+	// From is the schema version to be upconverted, an empty string means the messages without a schema version.
+	From string `json:"from"`
+	// To is the schema version after upconverting.
+	To string `json:"to"`
+	// Rename renames the fields, the keys are the old field paths, the values are the new ones.
+	Rename map[string]string `json:"rename,omitempty"`
+	// Set sets the fields if they don't exist, the keys are the field paths.
+	Set map[string]interface{} `json:"set,omitempty"`
+	// Remove removes the fields.
+	Remove []string `json:"remove,omitempty"`
+}
+
+type schemaUpconvert struct {
+	// mappings are indexed by the from schema version.
+	mappings map[string]mapping
+}
+
+func New(args map[string]string) (sourcetransformer.SourceTransformFunc, error) {
+	m, existing := args["mappings"]
+	if !existing {
+		return nil, fmt.Errorf(`missing "mappings"`)
+	}
+	var mappings []mapping
+	if err := yaml.Unmarshal([]byte(m), &mappings); err != nil {
+		return nil, fmt.Errorf(`invalid "mappings", %w`, err)
+	}
+	if len(mappings) == 0 {
+		return nil, fmt.Errorf(`empty "mappings"`)
+	}
+	s := schemaUpconvert{mappings: make(map[string]mapping)}
+	for _, x := range mappings {
+		if x.To == "" || x.To == x.From {
+			return nil, fmt.Errorf(`invalid "to" %q of the mapping from %q`, x.To, x.From)
+		}
+		if _, ok := s.mappings[x.From]; ok {
+			return nil, fmt.Errorf(`duplicate mappings from %q`, x.From)
+		}
+		s.mappings[x.From] = x
+	}
+
+	return func(ctx context.Context, keys []string, datum sourcetransformer.Datum) sourcetransformer.Messages {
+		log := logging.FromContext(ctx)
+		var version string
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if v := md.Get(dfv1.KeyMetaSchemaVersion); len(v) > 0 {
+				version = v[0]
+			}
+		}
+		newVersion, payload, err := s.apply(version, datum.Value())
+		if err != nil {
+			log.Warnf("Schema upconvert got an error: %v, skip upconverting...", err)
+			return sourcetransformer.MessagesBuilder().Append(sourcetransformer.NewMessage(datum.Value(), datum.EventTime()).WithKeys(keys))
+		}
+		if newVersion != version {
+			if err := grpc.SetHeader(ctx, metadata.Pairs(dfv1.KeyMetaSchemaVersion, newVersion)); err != nil {
+				log.Warnf("Schema upconvert failed to set the schema version: %v", err)
+			}
+		}
+		return sourcetransformer.MessagesBuilder().Append(sourcetransformer.NewMessage(payload, datum.EventTime()).WithKeys(keys))
+	}, nil
+}
+
+// apply upconverts the payload by following the mappings from the schema version, until there's no mapping for the
+// reached version. It returns the reached version and the upconverted payload.
+func (s schemaUpconvert) apply(version string, payload []byte) (string, []byte, error) {
+	if _, ok := s.mappings[version]; !ok {
+		return version, payload, nil
+	}
+	var obj map[string]interface{}
+	if err := json.Unmarshal(payload, &obj); err != nil {
+		return version, payload, fmt.Errorf("the payload is not a JSON object, %w", err)
+	}
+	visited := make(map[string]bool)
+	for {
+		m, ok := s.mappings[version]
+		if !ok {
+			break
+		}
+		if visited[version] {
+			return version, payload, fmt.Errorf("circular mappings from %q", version)
+		}
+		visited[version] = true
+		for from, to := range m.Rename {
+			if v, ok := getField(obj, from); ok {
+				deleteField(obj, from)
+				setField(obj, to, v)
+			}
+		}
+		for path, v := range m.Set {
+			if _, ok := getField(obj, path); !ok {
+				setField(obj, path, v)
+			}
+		}
+		for _, path := range m.Remove {
+			deleteField(obj, path)
+		}
+		version = m.To
+	}
+	result, err := json.Marshal(obj)
+	if err != nil {
+		return version, payload, err
+	}
+	return version, result, nil
+}
+
+// getField gets the field of a dot separated path, e.g. "user.id".
+func getField(obj map[string]interface{}, path string) (interface{}, bool) {
+	parts := strings.Split(path, ".")
+	for _, p := range parts[:len(parts)-1] {
+		next, ok := obj[p].(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		obj = next
+	}
+	v, ok := obj[parts[len(parts)-1]]
+	return v, ok
+}
+
+// setField sets the field of a dot separated path, the missing parent objects are created.
+func setField(obj map[string]interface{}, path string, value interface{}) {
+	parts := strings.Split(path, ".")
+	for _, p := range parts[:len(parts)-1] {
+		next, ok := obj[p].(map[string]interface{})
+		if !ok {
+			next = make(map[string]interface{})
+			obj[p] = next
+		}
+		obj = next
+	}
+	obj[parts[len(parts)-1]] = value
+}
+
+// deleteField deletes the field of a dot separated path.
+func deleteField(obj map[string]interface{}, path string) {
+	parts := strings.Split(path, ".")
+	for _, p := range parts[:len(parts)-1] {
+		next, ok := obj[p].(map[string]interface{})
+		if !ok {
+			return
+		}
+		obj = next
+	}
+	delete(obj, parts[len(parts)-1])
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schemaupconvert
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+type testDatum struct {
+	value     []byte
+	eventTime time.Time
+	watermark time.Time
+}
+
+func (h *testDatum) Value() []byte {
+	return h.value
+}
+
+func (h *testDatum) EventTime() time.Time {
+	return h.eventTime
+}
+
+func (h *testDatum) Watermark() time.Time {
+	return h.watermark
+}
+
+const testMappings = `
+- from: ""
+  to: v1
+  set:
+    region: us-west-2
+- from: v1
+  to: v2
+  rename:
+    user_id: user.id
+  remove:
+    - debug
+`
+
+func TestNew(t *testing.T) {
+	t.Run("missing mappings", func(t *testing.T) {
+		_, err := New(map[string]string{})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "missing")
+	})
+
+	t.Run("invalid to", func(t *testing.T) {
+		_, err := New(map[string]string{"mappings": `[{"from": "v1", "to": "v1"}]`})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid")
+	})
+
+	t.Run("duplicate from", func(t *testing.T) {
+		_, err := New(map[string]string{"mappings": `[{"from": "v1", "to": "v2"}, {"from": "v1", "to": "v3"}]`})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "duplicate")
+	})
+
+	t.Run("good", func(t *testing.T) {
+		handle, err := New(map[string]string{"mappings": testMappings})
+		assert.NoError(t, err)
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(dfv1.KeyMetaSchemaVersion, "v1"))
+		result := handle(ctx, []string{"k"}, &testDatum{value: []byte(`{"user_id": 1, "debug": true}`)})
+		assert.Equal(t, 1, len(result.Items()))
+		assert.JSONEq(t, `{"user": {"id": 1}}`, string(result.Items()[0].Value()))
+		assert.Equal(t, []string{"k"}, result.Items()[0].Keys())
+	})
+}
+
+func TestApply(t *testing.T) {
+	u := schemaUpconvert{mappings: map[string]mapping{
+		"":   {From: "", To: "v1", Set: map[string]interface{}{"region": "us-west-2"}},
+		"v1": {From: "v1", To: "v2", Rename: map[string]string{"user_id": "user.id"}, Remove: []string{"debug"}},
+	}}
+
+	t.Run("unversioned", func(t *testing.T) {
+		v, payload, err := u.apply("", []byte(`{"user_id": 1}`))
+		assert.NoError(t, err)
+		assert.Equal(t, "v2", v)
+		assert.JSONEq(t, `{"region": "us-west-2", "user": {"id": 1}}`, string(payload))
+	})
+
+	t.Run("no mapping", func(t *testing.T) {
+		v, payload, err := u.apply("v2", []byte(`not json`))
+		assert.NoError(t, err)
+		assert.Equal(t, "v2", v)
+		assert.Equal(t, `not json`, string(payload))
+	})
+
+	t.Run("not json", func(t *testing.T) {
+		v, payload, err := u.apply("v1", []byte(`not json`))
+		assert.Error(t, err)
+		assert.Equal(t, "v1", v)
+		assert.Equal(t, `not json`, string(payload))
+	})
+
+	t.Run("existing field not overridden", func(t *testing.T) {
+		v, payload, err := u.apply("", []byte(`{"region": "us-east-1"}`))
+		assert.NoError(t, err)
+		assert.Equal(t, "v2", v)
+		assert.JSONEq(t, `{"region": "us-east-1"}`, string(payload))
+	})
+
+	t.Run("circular", func(t *testing.T) {
+		c := schemaUpconvert{mappings: map[string]mapping{
+			"v1": {From: "v1", To: "v2"},
+			"v2": {From: "v2", To: "v1"},
+		}}
+		_, _, err := c.apply("v1", []byte(`{}`))
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transformer
+
+import (
+	"context"
+	"time"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/metrics"
+	sharedutil "github.com/numaproj/numaflow/pkg/shared/util"
+	"github.com/numaproj/numaflow/pkg/sources/forward/applier"
+)
+
+// Stage is a stage of a source transformer chain.
+type Stage struct {
+	Name       string
+	Applier    applier.SourceTransformApplier
+	OnFailure  dfv1.TransformerFailureStrategy
+	FailureTag string
+}
+
+// Chain applies an ordered chain of source transformers, the messages returned by a stage are transformed by the
+// next one, and the ones returned by the last stage are returned.
+type Chain struct {
+	vertexName   string
+	pipelineName string
+	stages       []Stage
+}
+
+// NewChain returns a Chain applying the stages in order.
+func NewChain(vertexName, pipelineName string, stages []Stage) *Chain {
+	return &Chain{vertexName: vertexName, pipelineName: pipelineName, stages: stages}
+}
+
+// ApplyTransform implements applier.SourceTransformApplier. An error is only returned if a stage with the "retry"
+// failure strategy fails, the whole chain is applied again on the retries.
+func (c *Chain) ApplyTransform(ctx context.Context, readMessage *isb.ReadMessage) ([]*isb.WriteMessage, error) {
+	messages := []*isb.WriteMessage{{Message: readMessage.Message}}
+	// the messages routed on failures skip the rest of the stages
+	var routed []*isb.WriteMessage
+	for i, stage := range c.stages {
+		last := i == len(c.stages)-1
+		var results []*isb.WriteMessage
+		for _, m := range messages {
+			input := &isb.ReadMessage{
+				Message:    m.Message,
+				ReadOffset: readMessage.ReadOffset,
+				Watermark:  readMessage.Watermark,
+				Metadata:   readMessage.Metadata,
+			}
+			start := time.Now()
+			output, err := stage.Applier.ApplyTransform(ctx, input)
+			stageProcessingTime.With(map[string]string{metrics.LabelVertex: c.vertexName, metrics.LabelPipeline: c.pipelineName, "stage": stage.Name}).Observe(float64(time.Since(start).Microseconds()))
+			if err != nil {
+				stageErrors.With(map[string]string{metrics.LabelVertex: c.vertexName, metrics.LabelPipeline: c.pipelineName, "stage": stage.Name, "on_failure": string(stage.OnFailure)}).Inc()
+				switch stage.OnFailure {
+				case dfv1.TransformerFailureDrop:
+				case dfv1.TransformerFailureSkip:
+					results = append(results, m)
+				case dfv1.TransformerFailureRoute:
+					routed = append(routed, &isb.WriteMessage{Message: m.Message, Tags: []string{stage.FailureTag}})
+				default:
+					return nil, err
+				}
+				continue
+			}
+			for _, o := range output {
+				// the messages dropped by a stage are not passed to the next one
+				if !last && sharedutil.StringSliceContains(o.Tags, dfv1.MessageTagDrop) {
+					continue
+				}
+				results = append(results, o)
+			}
+		}
+		messages = results
+	}
+	return append(messages, routed...), nil
+}
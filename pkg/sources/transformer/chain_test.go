@@ -0,0 +1,137 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transformer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/isb/testutils"
+	"github.com/numaproj/numaflow/pkg/sources/forward/applier"
+)
+
+// upper returns the payload in upper case, and fails the payloads containing "bad".
+var upper = applier.ApplySourceTransformFunc(func(ctx context.Context, m *isb.ReadMessage) ([]*isb.WriteMessage, error) {
+	if strings.Contains(string(m.Payload), "bad") {
+		return nil, fmt.Errorf("bad payload")
+	}
+	return []*isb.WriteMessage{{Message: isb.Message{Header: m.Header, Body: isb.Body{Payload: []byte(strings.ToUpper(string(m.Payload)))}}}}, nil
+})
+
+// split splits the payload by commas, and drops the empty parts.
+var split = applier.ApplySourceTransformFunc(func(ctx context.Context, m *isb.ReadMessage) ([]*isb.WriteMessage, error) {
+	var result []*isb.WriteMessage
+	for _, p := range strings.Split(string(m.Payload), ",") {
+		wm := &isb.WriteMessage{Message: isb.Message{Header: m.Header, Body: isb.Body{Payload: []byte(p)}}}
+		if p == "" {
+			wm.Tags = []string{dfv1.MessageTagDrop}
+		}
+		result = append(result, wm)
+	}
+	return result, nil
+})
+
+// assignEventTime sets the event time to the watermark of the read message.
+var assignEventTime = applier.ApplySourceTransformFunc(func(ctx context.Context, m *isb.ReadMessage) ([]*isb.WriteMessage, error) {
+	header := m.Header
+	header.EventTime = m.Watermark
+	return []*isb.WriteMessage{{Message: isb.Message{Header: header, Body: m.Body}, Tags: []string{"parsed"}}}, nil
+})
+
+func payloads(messages []*isb.WriteMessage) []string {
+	var result []string
+	for _, m := range messages {
+		result = append(result, string(m.Payload))
+	}
+	return result
+}
+
+func TestChain_ApplyTransform(t *testing.T) {
+	ctx := context.Background()
+	watermark := time.Unix(1700000000, 0)
+	newReadMessage := func(payload string) *isb.ReadMessage {
+		m := testutils.BuildTestReadMessages(1, time.Unix(1600000000, 0))[0]
+		m.Payload = []byte(payload)
+		m.Watermark = watermark
+		return &m
+	}
+
+	t.Run("stages applied in order", func(t *testing.T) {
+		c := NewChain("in", "pl", []Stage{
+			{Name: "split", Applier: split, OnFailure: dfv1.TransformerFailureRetry},
+			{Name: "upper", Applier: upper, OnFailure: dfv1.TransformerFailureRetry},
+			{Name: "assign", Applier: assignEventTime, OnFailure: dfv1.TransformerFailureRetry},
+		})
+		result, err := c.ApplyTransform(ctx, newReadMessage("a,,b"))
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"A", "B"}, payloads(result))
+		for _, m := range result {
+			assert.Equal(t, watermark, m.EventTime)
+			assert.Equal(t, []string{"parsed"}, m.Tags)
+		}
+	})
+
+	t.Run("retry", func(t *testing.T) {
+		c := NewChain("in", "pl", []Stage{
+			{Name: "split", Applier: split, OnFailure: dfv1.TransformerFailureRetry},
+			{Name: "upper", Applier: upper, OnFailure: dfv1.TransformerFailureRetry},
+		})
+		_, err := c.ApplyTransform(ctx, newReadMessage("a,bad"))
+		assert.EqualError(t, err, "bad payload")
+	})
+
+	t.Run("drop", func(t *testing.T) {
+		c := NewChain("in", "pl", []Stage{
+			{Name: "split", Applier: split, OnFailure: dfv1.TransformerFailureRetry},
+			{Name: "upper", Applier: upper, OnFailure: dfv1.TransformerFailureDrop},
+		})
+		result, err := c.ApplyTransform(ctx, newReadMessage("a,bad,c"))
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"A", "C"}, payloads(result))
+	})
+
+	t.Run("skip", func(t *testing.T) {
+		c := NewChain("in", "pl", []Stage{
+			{Name: "split", Applier: split, OnFailure: dfv1.TransformerFailureRetry},
+			{Name: "upper", Applier: upper, OnFailure: dfv1.TransformerFailureSkip},
+			{Name: "assign", Applier: assignEventTime, OnFailure: dfv1.TransformerFailureRetry},
+		})
+		result, err := c.ApplyTransform(ctx, newReadMessage("a,bad"))
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"A", "bad"}, payloads(result))
+		assert.Equal(t, watermark, result[1].EventTime)
+	})
+
+	t.Run("route", func(t *testing.T) {
+		c := NewChain("in", "pl", []Stage{
+			{Name: "upper", Applier: upper, OnFailure: dfv1.TransformerFailureRoute, FailureTag: "failed"},
+			{Name: "assign", Applier: assignEventTime, OnFailure: dfv1.TransformerFailureRetry},
+		})
+		result, err := c.ApplyTransform(ctx, newReadMessage("bad"))
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"bad"}, payloads(result))
+		assert.Equal(t, []string{"failed"}, result[0].Tags)
+		assert.NotEqual(t, watermark, result[0].EventTime)
+	})
+}
@@ -18,29 +18,47 @@ package transformer
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strconv"
+	"sync/atomic"
 	"time"
 
 	v1 "github.com/numaproj/numaflow-go/pkg/apis/proto/sourcetransform/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"k8s.io/apimachinery/pkg/util/wait"
 
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	batchpb "github.com/numaproj/numaflow/pkg/apis/proto/sourcetransformbatch"
 	"github.com/numaproj/numaflow/pkg/isb"
 	sdkerr "github.com/numaproj/numaflow/pkg/sdkclient/error"
 	"github.com/numaproj/numaflow/pkg/sdkclient/sourcetransformer"
+	"github.com/numaproj/numaflow/pkg/shared/errorlog"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/sources/forward/applier"
 	"github.com/numaproj/numaflow/pkg/udf/rpc"
 )
 
 // GRPCBasedTransformer applies user defined transformer over gRPC (over Unix Domain Socket) client/server where server is the transformer.
 type GRPCBasedTransformer struct {
 	client sourcetransformer.Client
+	// containerName is the name of the transformer container, which the errors are recorded for.
+	containerName string
+	// batch indicates the messages are transformed in batches.
+	batch atomic.Bool
 }
 
 // NewGRPCBasedTransformer returns a new gRPCBasedTransformer object.
 func NewGRPCBasedTransformer(client sourcetransformer.Client) *GRPCBasedTransformer {
-	return &GRPCBasedTransformer{client: client}
+	return &GRPCBasedTransformer{client: client, containerName: dfv1.CtrUdtransformer}
+}
+
+// NewStageGRPCBasedTransformer returns a new gRPCBasedTransformer object for a stage of a source transformer chain.
+func NewStageGRPCBasedTransformer(client sourcetransformer.Client, stage dfv1.TransformerStage) *GRPCBasedTransformer {
+	return &GRPCBasedTransformer{client: client, containerName: stage.ContainerName()}
 }
 
 // IsHealthy checks if the transformer container is healthy.
@@ -66,6 +84,17 @@ func (u *GRPCBasedTransformer) WaitUntilReady(ctx context.Context) error {
 	}
 }
 
+// EnableBatch enables the batch mode if it's supported by the transformer, it returns false if not supported.
+func (u *GRPCBasedTransformer) EnableBatch() bool {
+	u.batch.Store(u.client.IsBatchSupported())
+	return u.batch.Load()
+}
+
+// BatchEnabled returns true if the messages are transformed in batches.
+func (u *GRPCBasedTransformer) BatchEnabled() bool {
+	return u.batch.Load()
+}
+
 // CloseConn closes the gRPC client connection.
 func (u *GRPCBasedTransformer) CloseConn(ctx context.Context) error {
 	return u.client.CloseConn(ctx)
@@ -82,8 +111,14 @@ func (u *GRPCBasedTransformer) ApplyTransform(ctx context.Context, readMessage *
 		EventTime: timestamppb.New(parentMessageInfo.EventTime),
 		Watermark: timestamppb.New(readMessage.Watermark),
 	}
+	// The schema version is exchanged through the gRPC metadata, the transformer could change it by setting the
+	// response header.
+	if parentMessageInfo.SchemaVersion != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, dfv1.KeyMetaSchemaVersion, parentMessageInfo.SchemaVersion)
+	}
+	var responseHeader metadata.MD
 
-	response, err := u.client.SourceTransformFn(ctx, req)
+	response, err := u.client.SourceTransformFn(ctx, req, grpc.Header(&responseHeader))
 	if err != nil {
 		udfErr, _ := sdkerr.FromError(err)
 		switch udfErr.ErrorKind() {
@@ -96,7 +131,7 @@ func (u *GRPCBasedTransformer) ApplyTransform(ctx context.Context, readMessage *
 				Jitter:   0.1,
 				Steps:    5,
 			}, func() (done bool, err error) {
-				response, err = u.client.SourceTransformFn(ctx, req)
+				response, err = u.client.SourceTransformFn(ctx, req, grpc.Header(&responseHeader))
 				if err != nil {
 					udfErr, _ = sdkerr.FromError(err)
 					switch udfErr.ErrorKind() {
@@ -112,6 +147,7 @@ func (u *GRPCBasedTransformer) ApplyTransform(ctx context.Context, readMessage *
 				return true, nil
 			})
 			if !success {
+				errorlog.Record(u.containerName, err, readMessage.ID, payload)
 				return nil, rpc.ApplyUDFErr{
 					UserUDFErr: false,
 					Message:    fmt.Sprintf("gRPC client.SourceTransformFn failed, %s", err),
@@ -122,6 +158,7 @@ func (u *GRPCBasedTransformer) ApplyTransform(ctx context.Context, readMessage *
 				}
 			}
 		case sdkerr.NonRetryable:
+			errorlog.Record(u.containerName, err, readMessage.ID, payload)
 			return nil, rpc.ApplyUDFErr{
 				UserUDFErr: false,
 				Message:    fmt.Sprintf("gRPC client.SourceTransformFn failed, %s", err),
@@ -131,6 +168,7 @@ func (u *GRPCBasedTransformer) ApplyTransform(ctx context.Context, readMessage *
 				},
 			}
 		default:
+			errorlog.Record(u.containerName, err, readMessage.ID, payload)
 			return nil, rpc.ApplyUDFErr{
 				UserUDFErr: false,
 				Message:    fmt.Sprintf("gRPC client.SourceTransformFn failed, %s", err),
@@ -142,6 +180,9 @@ func (u *GRPCBasedTransformer) ApplyTransform(ctx context.Context, readMessage *
 		}
 	}
 
+	if v := responseHeader.Get(dfv1.KeyMetaSchemaVersion); len(v) > 0 {
+		parentMessageInfo.SchemaVersion = v[0]
+	}
 	taggedMessages := make([]*isb.WriteMessage, 0)
 	for i, result := range response.GetResults() {
 		keys := result.Keys
@@ -166,3 +207,110 @@ func (u *GRPCBasedTransformer) ApplyTransform(ctx context.Context, readMessage *
 	}
 	return taggedMessages, nil
 }
+
+// ApplyTransformBatch applies the transformer to a batch of messages with one gRPC call. It returns
+// applier.ErrBatchNotSupported if the transformer turns out not to support the batch mode, which is disabled since then.
+func (u *GRPCBasedTransformer) ApplyTransformBatch(ctx context.Context, readMessages []*isb.ReadMessage) ([][]*isb.WriteMessage, error) {
+	req := &batchpb.SourceTransformBatchRequest{Requests: make([]*batchpb.Request, len(readMessages))}
+	for i, m := range readMessages {
+		req.Requests[i] = &batchpb.Request{
+			Id:            strconv.Itoa(i),
+			Keys:          m.Keys,
+			Value:         m.Body.Payload,
+			EventTime:     m.EventTime.UnixMilli(),
+			Watermark:     m.Watermark.UnixMilli(),
+			SchemaVersion: m.SchemaVersion,
+		}
+	}
+	response, err := u.client.SourceTransformBatchFn(ctx, req)
+	if err != nil {
+		if errors.Is(err, sourcetransformer.ErrBatchNotSupported) {
+			u.batch.Store(false)
+			return nil, applier.ErrBatchNotSupported
+		}
+		if udfErr, _ := sdkerr.FromError(err); udfErr.ErrorKind() == sdkerr.Retryable {
+			_ = wait.ExponentialBackoffWithContext(ctx, wait.Backoff{
+				// retry every "duration * factor + [0, jitter]" interval for 5 times
+				Duration: 1 * time.Second,
+				Factor:   1,
+				Jitter:   0.1,
+				Steps:    5,
+			}, func() (done bool, e error) {
+				response, err = u.client.SourceTransformBatchFn(ctx, req)
+				if err != nil {
+					udfErr, _ = sdkerr.FromError(err)
+					return udfErr.ErrorKind() != sdkerr.Retryable, nil
+				}
+				return true, nil
+			})
+		}
+		if err != nil {
+			// the error is not specific to a message of the batch
+			errorlog.Record(u.containerName, err, "", nil)
+			return nil, rpc.ApplyUDFErr{
+				UserUDFErr: false,
+				Message:    fmt.Sprintf("gRPC client.SourceTransformBatchFn failed, %s", err),
+				InternalErr: rpc.InternalErr{
+					Flag:        true,
+					MainCarDown: false,
+				},
+			}
+		}
+	}
+
+	results := make([][]*isb.WriteMessage, len(readMessages))
+	responded := make([]bool, len(readMessages))
+	for _, resp := range response.GetResponses() {
+		idx, err := strconv.Atoi(resp.GetId())
+		if err != nil || idx < 0 || idx >= len(readMessages) {
+			return nil, rpc.ApplyUDFErr{
+				UserUDFErr: false,
+				Message:    fmt.Sprintf("gRPC client.SourceTransformBatchFn failed, unknown response id %q", resp.GetId()),
+				InternalErr: rpc.InternalErr{
+					Flag:        true,
+					MainCarDown: false,
+				},
+			}
+		}
+		responded[idx] = true
+		readMessage := readMessages[idx]
+		parentMessageInfo := readMessage.MessageInfo
+		taggedMessages := make([]*isb.WriteMessage, 0, len(resp.GetResults()))
+		for i, result := range resp.GetResults() {
+			messageInfo := parentMessageInfo
+			if result.GetEventTime() > 0 {
+				messageInfo.EventTime = time.UnixMilli(result.GetEventTime())
+			}
+			if result.GetSchemaVersion() != "" {
+				messageInfo.SchemaVersion = result.GetSchemaVersion()
+			}
+			taggedMessages = append(taggedMessages, &isb.WriteMessage{
+				Message: isb.Message{
+					Header: isb.Header{
+						MessageInfo: messageInfo,
+						ID:          fmt.Sprintf("%s-%d", readMessage.ReadOffset.String(), i),
+						Keys:        result.GetKeys(),
+					},
+					Body: isb.Body{
+						Payload: result.GetValue(),
+					},
+				},
+				Tags: result.GetTags(),
+			})
+		}
+		results[idx] = taggedMessages
+	}
+	for i, ok := range responded {
+		if !ok {
+			return nil, rpc.ApplyUDFErr{
+				UserUDFErr: false,
+				Message:    fmt.Sprintf("gRPC client.SourceTransformBatchFn failed, no response for message %s", readMessages[i].ReadOffset.String()),
+				InternalErr: rpc.InternalErr{
+					Flag:        true,
+					MainCarDown: false,
+				},
+			}
+		}
+	}
+	return results, nil
+}
@@ -35,16 +35,20 @@ import (
 	"google.golang.org/grpc/status"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	batchpb "github.com/numaproj/numaflow/pkg/apis/proto/sourcetransformbatch"
 	"github.com/numaproj/numaflow/pkg/isb"
 	"github.com/numaproj/numaflow/pkg/isb/testutils"
+	"github.com/numaproj/numaflow/pkg/sources/forward/applier"
 )
 
 func NewMockGRPCBasedTransformer(mockClient *transformermock.MockSourceTransformClient) *GRPCBasedTransformer {
 	c, _ := sourcetransformer.NewFromClient(mockClient)
-	return &GRPCBasedTransformer{c}
+	return NewGRPCBasedTransformer(c)
 }
 
 func TestGRPCBasedTransformer_WaitUntilReadyWithMockClient(t *testing.T) {
@@ -97,7 +101,7 @@ func TestGRPCBasedTransformer_BasicApplyWithMockClient(t *testing.T) {
 			EventTime: timestamppb.New(time.Unix(1661169600, 0)),
 			Watermark: timestamppb.New(time.Time{}),
 		}
-		mockClient.EXPECT().SourceTransformFn(gomock.Any(), &rpcMsg{msg: req}).Return(&v1.SourceTransformResponse{
+		mockClient.EXPECT().SourceTransformFn(gomock.Any(), &rpcMsg{msg: req}, gomock.Any()).Return(&v1.SourceTransformResponse{
 			Results: []*v1.SourceTransformResponse_Result{
 				{
 					Keys:  []string{"test_success_key"},
@@ -148,7 +152,7 @@ func TestGRPCBasedTransformer_BasicApplyWithMockClient(t *testing.T) {
 			EventTime: timestamppb.New(time.Unix(1661169660, 0)),
 			Watermark: timestamppb.New(time.Time{}),
 		}
-		mockClient.EXPECT().SourceTransformFn(gomock.Any(), &rpcMsg{msg: req}).Return(nil, fmt.Errorf("mock error"))
+		mockClient.EXPECT().SourceTransformFn(gomock.Any(), &rpcMsg{msg: req}, gomock.Any()).Return(nil, fmt.Errorf("mock error"))
 
 		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 		defer cancel()
@@ -197,12 +201,12 @@ func TestGRPCBasedTransformer_BasicApplyWithMockClient(t *testing.T) {
 			EventTime: timestamppb.New(time.Unix(1661169660, 0)),
 			Watermark: timestamppb.New(time.Time{}),
 		}
-		mockClient.EXPECT().SourceTransformFn(gomock.Any(), &rpcMsg{msg: req}).Return(nil, status.New(codes.DeadlineExceeded, "mock test err").Err())
-		mockClient.EXPECT().SourceTransformFn(gomock.Any(), &rpcMsg{msg: req}).Return(nil, status.New(codes.DeadlineExceeded, "mock test err").Err())
-		mockClient.EXPECT().SourceTransformFn(gomock.Any(), &rpcMsg{msg: req}).Return(nil, status.New(codes.DeadlineExceeded, "mock test err").Err())
-		mockClient.EXPECT().SourceTransformFn(gomock.Any(), &rpcMsg{msg: req}).Return(nil, status.New(codes.DeadlineExceeded, "mock test err").Err())
-		mockClient.EXPECT().SourceTransformFn(gomock.Any(), &rpcMsg{msg: req}).Return(nil, status.New(codes.DeadlineExceeded, "mock test err").Err())
-		mockClient.EXPECT().SourceTransformFn(gomock.Any(), &rpcMsg{msg: req}).Return(nil, status.New(codes.DeadlineExceeded, "mock test err").Err())
+		mockClient.EXPECT().SourceTransformFn(gomock.Any(), &rpcMsg{msg: req}, gomock.Any()).Return(nil, status.New(codes.DeadlineExceeded, "mock test err").Err())
+		mockClient.EXPECT().SourceTransformFn(gomock.Any(), &rpcMsg{msg: req}, gomock.Any()).Return(nil, status.New(codes.DeadlineExceeded, "mock test err").Err())
+		mockClient.EXPECT().SourceTransformFn(gomock.Any(), &rpcMsg{msg: req}, gomock.Any()).Return(nil, status.New(codes.DeadlineExceeded, "mock test err").Err())
+		mockClient.EXPECT().SourceTransformFn(gomock.Any(), &rpcMsg{msg: req}, gomock.Any()).Return(nil, status.New(codes.DeadlineExceeded, "mock test err").Err())
+		mockClient.EXPECT().SourceTransformFn(gomock.Any(), &rpcMsg{msg: req}, gomock.Any()).Return(nil, status.New(codes.DeadlineExceeded, "mock test err").Err())
+		mockClient.EXPECT().SourceTransformFn(gomock.Any(), &rpcMsg{msg: req}, gomock.Any()).Return(nil, status.New(codes.DeadlineExceeded, "mock test err").Err())
 
 		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 		defer cancel()
@@ -251,9 +255,9 @@ func TestGRPCBasedTransformer_BasicApplyWithMockClient(t *testing.T) {
 			EventTime: timestamppb.New(time.Unix(1661169660, 0)),
 			Watermark: timestamppb.New(time.Time{}),
 		}
-		mockClient.EXPECT().SourceTransformFn(gomock.Any(), &rpcMsg{msg: req}).Return(nil, status.New(codes.DeadlineExceeded, "mock test err").Err())
-		mockClient.EXPECT().SourceTransformFn(gomock.Any(), &rpcMsg{msg: req}).Return(nil, status.New(codes.DeadlineExceeded, "mock test err").Err())
-		mockClient.EXPECT().SourceTransformFn(gomock.Any(), &rpcMsg{msg: req}).Return(nil, status.New(codes.InvalidArgument, "mock test err: non retryable").Err())
+		mockClient.EXPECT().SourceTransformFn(gomock.Any(), &rpcMsg{msg: req}, gomock.Any()).Return(nil, status.New(codes.DeadlineExceeded, "mock test err").Err())
+		mockClient.EXPECT().SourceTransformFn(gomock.Any(), &rpcMsg{msg: req}, gomock.Any()).Return(nil, status.New(codes.DeadlineExceeded, "mock test err").Err())
+		mockClient.EXPECT().SourceTransformFn(gomock.Any(), &rpcMsg{msg: req}, gomock.Any()).Return(nil, status.New(codes.InvalidArgument, "mock test err: non retryable").Err())
 		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 		defer cancel()
 		go func() {
@@ -301,8 +305,8 @@ func TestGRPCBasedTransformer_BasicApplyWithMockClient(t *testing.T) {
 			EventTime: timestamppb.New(time.Unix(1661169720, 0)),
 			Watermark: timestamppb.New(time.Time{}),
 		}
-		mockClient.EXPECT().SourceTransformFn(gomock.Any(), &rpcMsg{msg: req}).Return(nil, status.New(codes.DeadlineExceeded, "mock test err").Err())
-		mockClient.EXPECT().SourceTransformFn(gomock.Any(), &rpcMsg{msg: req}).Return(&v1.SourceTransformResponse{
+		mockClient.EXPECT().SourceTransformFn(gomock.Any(), &rpcMsg{msg: req}, gomock.Any()).Return(nil, status.New(codes.DeadlineExceeded, "mock test err").Err())
+		mockClient.EXPECT().SourceTransformFn(gomock.Any(), &rpcMsg{msg: req}, gomock.Any()).Return(&v1.SourceTransformResponse{
 			Results: []*v1.SourceTransformResponse_Result{
 				{
 					Keys:  []string{"test_success_key"},
@@ -353,7 +357,7 @@ func TestGRPCBasedTransformer_BasicApplyWithMockClient(t *testing.T) {
 			EventTime: timestamppb.New(time.Unix(1661169660, 0)),
 			Watermark: timestamppb.New(time.Time{}),
 		}
-		mockClient.EXPECT().SourceTransformFn(gomock.Any(), &rpcMsg{msg: req}).Return(nil, status.New(codes.InvalidArgument, "mock test err: non retryable").Err())
+		mockClient.EXPECT().SourceTransformFn(gomock.Any(), &rpcMsg{msg: req}, gomock.Any()).Return(nil, status.New(codes.InvalidArgument, "mock test err: non retryable").Err())
 		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 		defer cancel()
 		go func() {
@@ -403,7 +407,7 @@ func TestGRPCBasedTransformer_ApplyWithMockClient_ChangePayload(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockClient := transformermock.NewMockSourceTransformClient(ctrl)
-	mockClient.EXPECT().SourceTransformFn(gomock.Any(), gomock.Any()).DoAndReturn(
+	mockClient.EXPECT().SourceTransformFn(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
 		func(_ context.Context, datum *v1.SourceTransformRequest, opts ...grpc.CallOption) (*v1.SourceTransformResponse, error) {
 			var originalValue testutils.PayloadForTest
 			_ = json.Unmarshal(datum.GetValue(), &originalValue)
@@ -474,7 +478,7 @@ func TestGRPCBasedTransformer_ApplyWithMockClient_ChangeEventTime(t *testing.T)
 	defer ctrl.Finish()
 
 	mockClient := transformermock.NewMockSourceTransformClient(ctrl)
-	mockClient.EXPECT().SourceTransformFn(gomock.Any(), gomock.Any()).DoAndReturn(
+	mockClient.EXPECT().SourceTransformFn(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
 		func(_ context.Context, datum *v1.SourceTransformRequest, opts ...grpc.CallOption) (*v1.SourceTransformResponse, error) {
 			var Results []*v1.SourceTransformResponse_Result
 			Results = append(Results, &v1.SourceTransformResponse_Result{
@@ -508,3 +512,101 @@ func TestGRPCBasedTransformer_ApplyWithMockClient_ChangeEventTime(t *testing.T)
 		assert.Equal(t, testEventTime, apply[0].EventTime)
 	}
 }
+
+func TestGRPCBasedTransformer_ApplyWithMockClient_ChangeSchemaVersion(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := transformermock.NewMockSourceTransformClient(ctrl)
+	mockClient.EXPECT().SourceTransformFn(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, datum *v1.SourceTransformRequest, opts ...grpc.CallOption) (*v1.SourceTransformResponse, error) {
+			md, _ := metadata.FromOutgoingContext(ctx)
+			assert.Equal(t, []string{"v1"}, md.Get(dfv1.KeyMetaSchemaVersion))
+			for _, opt := range opts {
+				if o, ok := opt.(grpc.HeaderCallOption); ok {
+					*o.HeaderAddr = metadata.Pairs(dfv1.KeyMetaSchemaVersion, "v2")
+				}
+			}
+			return &v1.SourceTransformResponse{
+				Results: []*v1.SourceTransformResponse_Result{{Keys: datum.Keys, Value: datum.Value}},
+			}, nil
+		},
+	)
+
+	u := NewMockGRPCBasedTransformer(mockClient)
+	readMessages := testutils.BuildTestReadMessages(1, time.Unix(1661169600, 0))
+	readMessages[0].SchemaVersion = "v1"
+	apply, err := u.ApplyTransform(context.Background(), &readMessages[0])
+	assert.NoError(t, err)
+	assert.Equal(t, "v2", apply[0].SchemaVersion)
+}
+
+type fakeBatchClient struct {
+	fn func(*batchpb.SourceTransformBatchRequest) (*batchpb.SourceTransformBatchResponse, error)
+}
+
+func (f *fakeBatchClient) SourceTransformBatchFn(_ context.Context, in *batchpb.SourceTransformBatchRequest, _ ...grpc.CallOption) (*batchpb.SourceTransformBatchResponse, error) {
+	return f.fn(in)
+}
+
+func TestGRPCBasedTransformer_ApplyTransformBatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := transformermock.NewMockSourceTransformClient(ctrl)
+	batchClient := &fakeBatchClient{fn: func(in *batchpb.SourceTransformBatchRequest) (*batchpb.SourceTransformBatchResponse, error) {
+		resp := &batchpb.SourceTransformBatchResponse{}
+		// respond in the reverse order to verify the responses are matched by ids.
+		for i := len(in.Requests) - 1; i >= 0; i-- {
+			r := in.Requests[i]
+			result := &batchpb.Result{Keys: r.Keys, Value: append([]byte("t-"), r.Value...)}
+			if i == 0 {
+				result.EventTime = time.Unix(1661169660, 0).UnixMilli()
+				result.SchemaVersion = "v2"
+			}
+			resp.Responses = append(resp.Responses, &batchpb.Response{Id: r.Id, Results: []*batchpb.Result{result}})
+		}
+		return resp, nil
+	}}
+	c, _ := sourcetransformer.NewFromBatchClient(mockClient, batchClient)
+	u := NewGRPCBasedTransformer(c)
+	assert.False(t, u.BatchEnabled())
+	assert.True(t, u.EnableBatch())
+	assert.True(t, u.BatchEnabled())
+
+	readMessages := testutils.BuildTestReadMessages(3, time.Unix(1661169600, 0))
+	msgs := []*isb.ReadMessage{&readMessages[0], &readMessages[1], &readMessages[2]}
+	results, err := u.ApplyTransformBatch(context.Background(), msgs)
+	assert.NoError(t, err)
+	assert.Len(t, results, 3)
+	for i, r := range results {
+		assert.Len(t, r, 1)
+		assert.Equal(t, append([]byte("t-"), readMessages[i].Payload...), r[0].Payload)
+		assert.Equal(t, readMessages[i].Keys, r[0].Keys)
+	}
+	assert.Equal(t, time.Unix(1661169660, 0), results[0][0].EventTime)
+	assert.Equal(t, "v2", results[0][0].SchemaVersion)
+	assert.Equal(t, readMessages[1].EventTime, results[1][0].EventTime)
+
+	batchClient.fn = func(in *batchpb.SourceTransformBatchRequest) (*batchpb.SourceTransformBatchResponse, error) {
+		return &batchpb.SourceTransformBatchResponse{Responses: []*batchpb.Response{{Id: in.Requests[0].Id}}}, nil
+	}
+	_, err = u.ApplyTransformBatch(context.Background(), msgs)
+	assert.Error(t, err)
+
+	batchClient.fn = func(in *batchpb.SourceTransformBatchRequest) (*batchpb.SourceTransformBatchResponse, error) {
+		return nil, status.Error(codes.Unimplemented, "not implemented")
+	}
+	_, err = u.ApplyTransformBatch(context.Background(), msgs)
+	assert.ErrorIs(t, err, applier.ErrBatchNotSupported)
+	assert.False(t, u.BatchEnabled())
+}
+
+func TestGRPCBasedTransformer_EnableBatchNotSupported(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	u := NewMockGRPCBasedTransformer(transformermock.NewMockSourceTransformClient(ctrl))
+	assert.False(t, u.EnableBatch())
+	assert.False(t, u.BatchEnabled())
+}
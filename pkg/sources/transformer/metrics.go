@@ -0,0 +1,39 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transformer
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/numaproj/numaflow/pkg/metrics"
+)
+
+// stageProcessingTime is a histogram to Observe the processing times of the stages of a source transformer chain
+var stageProcessingTime = promauto.NewHistogramVec(prometheus.HistogramOpts{
+	Subsystem: "source_transformer",
+	Name:      "stage_processing_time",
+	Help:      "Processing times of a stage of the source transformer chain (100 microseconds to 15 minutes)",
+	Buckets:   prometheus.ExponentialBucketsRange(100, 60000000*15, 60),
+}, []string{metrics.LabelVertex, metrics.LabelPipeline, "stage"})
+
+// stageErrors is used to indicate the number of the messages failed by a stage of a source transformer chain
+var stageErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "source_transformer",
+	Name:      "stage_error_total",
+	Help:      "Total number of the messages failed by a stage of the source transformer chain, by the failure strategy: retry, drop, skip or route",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline, "stage", "on_failure"})
@@ -0,0 +1,45 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package websocket
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/numaproj/numaflow/pkg/metrics"
+)
+
+// webSocketSourceReadCount is used to indicate the number of messages read by the websocket source vertex
+var webSocketSourceReadCount = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "websocket_source",
+	Name:      "read_total",
+	Help:      "Total number of messages read",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline})
+
+// webSocketSourceConnections is used to indicate the number of client connections of the websocket source vertex
+var webSocketSourceConnections = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Subsystem: "websocket_source",
+	Name:      "connections",
+	Help:      "Number of client connections",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline})
+
+// webSocketSourceAckErrors is used to indicate the number of errors while sending the acknowledgements to the clients
+var webSocketSourceAckErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "websocket_source",
+	Name:      "ack_error_total",
+	Help:      "Total number of errors sending the acknowledgements to the clients",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline})
@@ -0,0 +1,460 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package websocket
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+	"go.uber.org/zap"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/forward"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/metrics"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+	sharedtls "github.com/numaproj/numaflow/pkg/shared/tls"
+	sharedutil "github.com/numaproj/numaflow/pkg/shared/util"
+	sourceforward "github.com/numaproj/numaflow/pkg/sources/forward"
+	"github.com/numaproj/numaflow/pkg/sources/forward/applier"
+	"github.com/numaproj/numaflow/pkg/watermark/fetch"
+	"github.com/numaproj/numaflow/pkg/watermark/processor"
+	"github.com/numaproj/numaflow/pkg/watermark/publish"
+	"github.com/numaproj/numaflow/pkg/watermark/store"
+	"github.com/numaproj/numaflow/pkg/watermark/wmb"
+)
+
+// ackWriteTimeout is the timeout of sending an acknowledgement to a client.
+const ackWriteTimeout = 5 * time.Second
+
+type webSocketSource struct {
+	vertexName   string
+	pipelineName string
+	replica      int32
+	ready        atomic.Bool
+	readTimeout  time.Duration
+	bufferSize   int
+	// auth is the bearer token of the clients, no authorization if it's empty
+	auth           string
+	eventTimeField string
+	ackOnWrite     bool
+	maxConnections int
+	upgrader       websocket.Upgrader
+	messages       chan *isb.ReadMessage
+	// pending is the number of the messages received but not yet written to the Inter-Step Buffers
+	pending atomic.Int64
+	lock    sync.Mutex
+	clients map[*client]struct{}
+	logger  *zap.SugaredLogger
+	// forwarder that writes the received data to destination
+	forwarder *sourceforward.DataForward
+	// source watermark publisher
+	sourcePublishWM publish.Publisher
+	// lifecycle context
+	lifecycleCtx context.Context
+	// context cancel function
+	cancelFunc context.CancelFunc
+	shutdown   func(context.Context) error
+}
+
+// client is a connection of a WebSocket client.
+type client struct {
+	id   string
+	conn *websocket.Conn
+	// writeLock serializes the writes to the connection, which doesn't support concurrent writers
+	writeLock sync.Mutex
+	// seq is the sequence number of the last received message
+	seq uint64
+}
+
+// sendAck sends the acknowledgement of a message to the client.
+func (c *client) sendAck(seq uint64) error {
+	c.writeLock.Lock()
+	defer c.writeLock.Unlock()
+	_ = c.conn.SetWriteDeadline(time.Now().Add(ackWriteTimeout))
+	return c.conn.WriteJSON(ack{Seq: seq})
+}
+
+// ack is the acknowledgement sent to a client once a message is written to the Inter-Step Buffers.
+type ack struct {
+	Seq uint64 `json:"seq"`
+}
+
+// wsOffset implements isb.Offset
+type wsOffset struct {
+	client       *client
+	seq          uint64
+	partitionIdx int32
+}
+
+func (o *wsOffset) String() string {
+	return fmt.Sprintf("%s-%d", o.client.id, o.seq)
+}
+
+func (o *wsOffset) Sequence() (int64, error) {
+	return int64(o.seq), nil
+}
+
+// AckIt acking is taken care by the source
+func (o *wsOffset) AckIt() error {
+	return nil
+}
+
+func (o *wsOffset) NoAck() error {
+	return nil
+}
+
+func (o *wsOffset) PartitionIdx() int32 {
+	return o.partitionIdx
+}
+
+type Option func(*webSocketSource) error
+
+// WithLogger is used to return logger information
+func WithLogger(l *zap.SugaredLogger) Option {
+	return func(o *webSocketSource) error {
+		o.logger = l
+		return nil
+	}
+}
+
+// WithReadTimeout is used to set the read timeout for the from buffer
+func WithReadTimeout(t time.Duration) Option {
+	return func(o *webSocketSource) error {
+		o.readTimeout = t
+		return nil
+	}
+}
+
+// WithBufferSize sets the size of the buffer of the received messages, the clients are blocked once it's full.
+func WithBufferSize(s int) Option {
+	return func(o *webSocketSource) error {
+		o.bufferSize = s
+		return nil
+	}
+}
+
+// New returns a WebSocket source, which runs a WebSocket server accepting the messages from the client connections.
+func New(
+	vertexInstance *dfv1.VertexInstance,
+	writers map[string][]isb.BufferWriter,
+	fsd forward.ToWhichStepDecider,
+	transformerApplier applier.SourceTransformApplier,
+	fetchWM fetch.Fetcher,
+	toVertexPublisherStores map[string]store.WatermarkStore,
+	publishWMStores store.WatermarkStore,
+	opts ...Option) (*webSocketSource, error) {
+
+	source := vertexInstance.Vertex.Spec.Source.WebSocket
+	ws := &webSocketSource{
+		vertexName:     vertexInstance.Vertex.Spec.Name,
+		pipelineName:   vertexInstance.Vertex.Spec.PipelineName,
+		replica:        vertexInstance.Replica,
+		readTimeout:    1 * time.Second, // default timeout
+		bufferSize:     1000,            // default size
+		eventTimeField: source.EventTimeField,
+		ackOnWrite:     source.AckOnWrite,
+		maxConnections: source.GetMaxConnections(),
+		clients:        make(map[*client]struct{}),
+	}
+	for _, o := range opts {
+		if err := o(ws); err != nil {
+			return nil, err
+		}
+	}
+	if ws.logger == nil {
+		ws.logger = logging.NewLogger()
+	}
+	ws.logger = ws.logger.With("sourceType", "websocket")
+	ws.messages = make(chan *isb.ReadMessage, ws.bufferSize)
+	if x := source.Auth; x != nil && x.Token != nil {
+		s, err := sharedutil.GetSecretFromVolume(x.Token)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get auth token, %w", err)
+		}
+		ws.auth = s
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	ws.lifecycleCtx = ctx
+	ws.cancelFunc = cancel
+
+	forwardOpts := []sourceforward.Option{sourceforward.WithLogger(ws.logger)}
+	if x := vertexInstance.Vertex.Spec.Limits; x != nil {
+		if x.ReadBatchSize != nil {
+			forwardOpts = append(forwardOpts, sourceforward.WithReadBatchSize(int64(*x.ReadBatchSize)))
+		}
+	}
+	if x := vertexInstance.Vertex.Spec.Source.BurstBuffer; x != nil {
+		dir := filepath.Join(dfv1.PathBurstBufferMount, dfv1.GenerateBurstBufferDirName(vertexInstance.Vertex.Spec.Name, vertexInstance.Replica))
+		forwardOpts = append(forwardOpts, sourceforward.WithBurstBuffer(dir, x.GetMaxSize()))
+	}
+	var err error
+	ws.forwarder, err = sourceforward.NewDataForward(vertexInstance.Vertex, ws, writers, fsd, transformerApplier, fetchWM, ws, toVertexPublisherStores, forwardOpts...)
+	if err != nil {
+		ws.logger.Errorw("Error instantiating the forwarder", zap.Error(err))
+		return nil, err
+	}
+	entityName := fmt.Sprintf("%s-%d", vertexInstance.Vertex.Name, vertexInstance.Replica)
+	processorEntity := processor.NewProcessorEntity(entityName)
+	// source publisher toVertexPartitionCount will be 1, because we publish watermarks within the source itself.
+	ws.sourcePublishWM = publish.NewPublish(ctx, processorEntity, publishWMStores, 1, publish.IsSource(), publish.WithDelay(vertexInstance.Vertex.Spec.Watermark.GetMaxDelay()))
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
+		if !ws.ready.Load() {
+			http.Error(w, "websocket source not ready", http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/vertices/"+vertexInstance.Vertex.Spec.Name, ws.serveWebSocket)
+	cer, err := sharedtls.GenerateX509KeyPair()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate cert: %w", err)
+	}
+	server := &http.Server{
+		Addr:      fmt.Sprintf(":%d", dfv1.VertexHTTPSPort),
+		Handler:   mux,
+		TLSConfig: &tls.Config{Certificates: []tls.Certificate{*cer}, MinVersion: tls.VersionTLS12},
+	}
+	go func() {
+		ws.logger.Info("Starting websocket source server")
+		if err := server.ListenAndServeTLS("", ""); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			ws.logger.Fatalw("Failed to listen-and-server on websocket source server", zap.Error(err))
+		}
+		ws.logger.Info("Shutdown websocket source server")
+	}()
+	ws.shutdown = server.Shutdown
+	return ws, nil
+}
+
+// serveWebSocket upgrades a request to a WebSocket connection, and receives the messages from it until it's closed.
+// Each text or binary message is a message of the source.
+func (ws *webSocketSource) serveWebSocket(w http.ResponseWriter, r *http.Request) {
+	if ws.auth != "" && r.Header.Get("Authorization") != "Bearer "+ws.auth {
+		http.Error(w, "request not authorized", http.StatusForbidden)
+		return
+	}
+	if !ws.ready.Load() {
+		http.Error(w, "websocket source not ready", http.StatusServiceUnavailable)
+		return
+	}
+	c := &client{id: uuid.New().String()}
+	if !ws.register(c) {
+		http.Error(w, "too many connections", http.StatusServiceUnavailable)
+		return
+	}
+	defer ws.unregister(c)
+	conn, err := ws.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// the upgrader has replied with an error
+		ws.logger.Debugw("Failed to upgrade the websocket connection", zap.Error(err))
+		return
+	}
+	ws.lock.Lock()
+	c.conn = conn
+	ws.lock.Unlock()
+	defer func() { _ = conn.Close() }()
+	for {
+		messageType, payload, err := conn.ReadMessage()
+		if err != nil {
+			if !websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+				ws.logger.Debugw("Websocket connection closed", zap.String("client", c.id), zap.Error(err))
+			}
+			return
+		}
+		if messageType != websocket.TextMessage && messageType != websocket.BinaryMessage {
+			continue
+		}
+		c.seq++
+		m := ws.toReadMessage(c, c.seq, payload, time.Now())
+		ws.pending.Add(1)
+		select {
+		case ws.messages <- m:
+		case <-ws.lifecycleCtx.Done():
+			return
+		}
+	}
+}
+
+// register registers a client, it returns false if the max number of connections is reached.
+func (ws *webSocketSource) register(c *client) bool {
+	ws.lock.Lock()
+	defer ws.lock.Unlock()
+	if len(ws.clients) >= ws.maxConnections {
+		return false
+	}
+	ws.clients[c] = struct{}{}
+	webSocketSourceConnections.With(map[string]string{metrics.LabelVertex: ws.vertexName, metrics.LabelPipeline: ws.pipelineName}).Set(float64(len(ws.clients)))
+	return true
+}
+
+func (ws *webSocketSource) unregister(c *client) {
+	ws.lock.Lock()
+	defer ws.lock.Unlock()
+	delete(ws.clients, c)
+	webSocketSourceConnections.With(map[string]string{metrics.LabelVertex: ws.vertexName, metrics.LabelPipeline: ws.pipelineName}).Set(float64(len(ws.clients)))
+}
+
+func (ws *webSocketSource) toReadMessage(c *client, seq uint64, payload []byte, received time.Time) *isb.ReadMessage {
+	offset := &wsOffset{client: c, seq: seq, partitionIdx: ws.replica}
+	return &isb.ReadMessage{
+		Message: isb.Message{
+			Header: isb.Header{
+				MessageInfo: isb.MessageInfo{EventTime: ws.eventTime(payload, received)},
+				ID:          offset.String(),
+			},
+			Body: isb.Body{Payload: payload},
+		},
+		ReadOffset: offset,
+	}
+}
+
+// eventTime returns the event time from the event time field of a JSON payload, in epoch milliseconds or RFC3339
+// format, or the receive time if it's not available.
+func (ws *webSocketSource) eventTime(payload []byte, received time.Time) time.Time {
+	if ws.eventTimeField == "" {
+		return received
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(payload, &fields); err != nil {
+		return received
+	}
+	raw, ok := fields[ws.eventTimeField]
+	if !ok {
+		return received
+	}
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		if t, err := time.Parse(time.RFC3339Nano, s); err == nil {
+			return t
+		}
+		return received
+	}
+	if ms, err := strconv.ParseInt(string(raw), 10, 64); err == nil {
+		return time.UnixMilli(ms)
+	}
+	return received
+}
+
+func (ws *webSocketSource) GetName() string {
+	return ws.vertexName
+}
+
+// GetPartitionIdx returns the partition number for the source vertex buffer
+// Source is like a buffer with only one partition. So, we always return 0
+func (ws *webSocketSource) GetPartitionIdx() int32 {
+	return 0
+}
+
+func (ws *webSocketSource) Read(_ context.Context, count int64) ([]*isb.ReadMessage, error) {
+	var msgs []*isb.ReadMessage
+	timeout := time.After(ws.readTimeout)
+loop:
+	for i := int64(0); i < count; i++ {
+		select {
+		case m := <-ws.messages:
+			webSocketSourceReadCount.With(map[string]string{metrics.LabelVertex: ws.vertexName, metrics.LabelPipeline: ws.pipelineName}).Inc()
+			msgs = append(msgs, m)
+		case <-timeout:
+			ws.logger.Debugw("Timed out waiting for messages to read.", zap.Duration("waited", ws.readTimeout), zap.Int("read", len(msgs)))
+			break loop
+		}
+	}
+	return msgs, nil
+}
+
+// Pending returns the number of the messages received from the clients, but not yet written to the Inter-Step Buffers.
+func (ws *webSocketSource) Pending(_ context.Context) (int64, error) {
+	return ws.pending.Load(), nil
+}
+
+func (ws *webSocketSource) PublishSourceWatermarks(msgs []*isb.ReadMessage) {
+	var oldest time.Time
+	for _, m := range msgs {
+		if oldest.IsZero() || m.EventTime.Before(oldest) {
+			oldest = m.EventTime
+		}
+	}
+	if len(msgs) > 0 && !oldest.IsZero() {
+		// toVertexPartitionIdx is 0, because we publish watermarks within the source itself.
+		ws.sourcePublishWM.PublishWatermark(wmb.Watermark(oldest), nil, 0) // Source publisher does not care about the offset
+	}
+}
+
+// Ack is called once the messages are written to the Inter-Step Buffers, the acknowledgements are sent to the clients
+// if ackOnWrite is enabled. The messages of the closed connections are not acknowledged to the clients.
+func (ws *webSocketSource) Ack(_ context.Context, offsets []isb.Offset) []error {
+	for _, offset := range offsets {
+		ws.pending.Add(-1)
+		if !ws.ackOnWrite {
+			continue
+		}
+		o := offset.(*wsOffset)
+		if err := o.client.sendAck(o.seq); err != nil {
+			webSocketSourceAckErrors.With(map[string]string{metrics.LabelVertex: ws.vertexName, metrics.LabelPipeline: ws.pipelineName}).Inc()
+			ws.logger.Debugw("Failed to send the acknowledgement to the websocket client", zap.String("offset", o.String()), zap.Error(err))
+		}
+	}
+	return make([]error, len(offsets))
+}
+
+func (ws *webSocketSource) NoAck(_ context.Context, _ []isb.Offset) {}
+
+func (ws *webSocketSource) Close() error {
+	ws.logger.Info("Shutting down websocket source server...")
+	ws.cancelFunc()
+	// the hijacked connections are not closed by the server shutdown
+	ws.lock.Lock()
+	for c := range ws.clients {
+		if c.conn != nil {
+			_ = c.conn.Close()
+		}
+	}
+	ws.lock.Unlock()
+	if err := ws.shutdown(context.Background()); err != nil {
+		return err
+	}
+	ws.logger.Info("Websocket source server shutdown")
+	return nil
+}
+
+func (ws *webSocketSource) Stop() {
+	ws.logger.Info("Stopping websocket reader...")
+	ws.ready.Store(false)
+	ws.forwarder.Stop()
+}
+
+func (ws *webSocketSource) ForceStop() {
+	ws.Stop()
+}
+
+func (ws *webSocketSource) Start() <-chan struct{} {
+	defer ws.ready.Store(true)
+	return ws.forwarder.Start()
+}
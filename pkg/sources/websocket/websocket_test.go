@@ -0,0 +1,133 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package websocket
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+func newTestSource(maxConnections int) *webSocketSource {
+	ws := &webSocketSource{
+		vertexName:     "test-v",
+		pipelineName:   "test-p",
+		readTimeout:    time.Second,
+		eventTimeField: "ts",
+		ackOnWrite:     true,
+		maxConnections: maxConnections,
+		messages:       make(chan *isb.ReadMessage, 10),
+		clients:        make(map[*client]struct{}),
+		logger:         logging.NewLogger(),
+	}
+	ws.lifecycleCtx, ws.cancelFunc = context.WithCancel(context.Background())
+	ws.ready.Store(true)
+	return ws
+}
+
+func TestWithBufferSize(t *testing.T) {
+	ws := &webSocketSource{bufferSize: 10}
+	assert.NoError(t, WithBufferSize(100)(ws))
+	assert.Equal(t, 100, ws.bufferSize)
+}
+
+func TestWithReadTimeout(t *testing.T) {
+	ws := &webSocketSource{readTimeout: 4 * time.Second}
+	assert.NoError(t, WithReadTimeout(5*time.Second)(ws))
+	assert.Equal(t, 5*time.Second, ws.readTimeout)
+}
+
+func TestEventTime(t *testing.T) {
+	received := time.UnixMilli(1696118400000)
+	ws := &webSocketSource{}
+	assert.Equal(t, received, ws.eventTime([]byte(`{"ts":1696118401000}`), received))
+	ws.eventTimeField = "ts"
+	assert.Equal(t, time.UnixMilli(1696118401000), ws.eventTime([]byte(`{"ts":1696118401000}`), received))
+	assert.True(t, time.UnixMilli(1696118402000).Equal(ws.eventTime([]byte(`{"ts":"2023-10-01T00:00:02Z"}`), received)))
+	assert.Equal(t, received, ws.eventTime([]byte(`{"ts":"yesterday"}`), received))
+	assert.Equal(t, received, ws.eventTime([]byte(`{"other":1}`), received))
+	assert.Equal(t, received, ws.eventTime([]byte(`not json`), received))
+}
+
+func TestServeWebSocket(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	ws := newTestSource(1)
+	defer ws.cancelFunc()
+	server := httptest.NewServer(http.HandlerFunc(ws.serveWebSocket))
+	defer server.Close()
+	url := "ws" + strings.TrimPrefix(server.URL, "http")
+
+	conn, _, err := websocket.DefaultDialer.DialContext(ctx, url, nil)
+	assert.NoError(t, err)
+	defer func() { _ = conn.Close() }()
+	assert.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(`{"ts":1696118401000}`)))
+	assert.NoError(t, conn.WriteMessage(websocket.BinaryMessage, []byte("hello")))
+
+	msgs, err := ws.Read(ctx, 2)
+	assert.NoError(t, err)
+	assert.Len(t, msgs, 2)
+	assert.Equal(t, time.UnixMilli(1696118401000), msgs[0].EventTime)
+	assert.Equal(t, []byte("hello"), msgs[1].Payload)
+	assert.True(t, strings.HasSuffix(msgs[1].ID, "-2"))
+	assert.NotEqual(t, msgs[0].ID, msgs[1].ID)
+	pending, err := ws.Pending(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), pending)
+
+	// The max number of connections is reached.
+	_, resp, err := websocket.DefaultDialer.DialContext(ctx, url, nil)
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+
+	errs := ws.Ack(ctx, []isb.Offset{msgs[0].ReadOffset, msgs[1].ReadOffset})
+	assert.Equal(t, []error{nil, nil}, errs)
+	pending, _ = ws.Pending(ctx)
+	assert.Equal(t, int64(0), pending)
+	for _, seq := range []uint64{1, 2} {
+		var a ack
+		assert.NoError(t, conn.ReadJSON(&a))
+		assert.Equal(t, seq, a.Seq)
+	}
+}
+
+func TestServeWebSocket_Auth(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	ws := newTestSource(1)
+	defer ws.cancelFunc()
+	ws.auth = "secret"
+	server := httptest.NewServer(http.HandlerFunc(ws.serveWebSocket))
+	defer server.Close()
+	url := "ws" + strings.TrimPrefix(server.URL, "http")
+
+	_, resp, err := websocket.DefaultDialer.DialContext(ctx, url, nil)
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	conn, _, err := websocket.DefaultDialer.DialContext(ctx, url, http.Header{"Authorization": []string{"Bearer secret"}})
+	assert.NoError(t, err)
+	_ = conn.Close()
+}
@@ -25,7 +25,9 @@ import (
 
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 	"github.com/numaproj/numaflow/pkg/udf/builtin/cat"
+	"github.com/numaproj/numaflow/pkg/udf/builtin/enrich"
 	"github.com/numaproj/numaflow/pkg/udf/builtin/filter"
+	"github.com/numaproj/numaflow/pkg/udf/builtin/quota"
 )
 
 type Builtin struct {
@@ -53,6 +55,10 @@ func (b *Builtin) executor() (mapsdk.MapperFunc, error) {
 		return cat.New(), nil
 	case "filter":
 		return filter.New(b.KWArgs)
+	case "quota":
+		return quota.New(b.KWArgs)
+	case "enrich":
+		return enrich.New(b.KWArgs)
 	default:
 		return nil, fmt.Errorf("unrecognized function %q", b.Name)
 	}
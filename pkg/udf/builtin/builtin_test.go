@@ -32,6 +32,14 @@ func TestGetExecutors(t *testing.T) {
 				Name:   "filter",
 				KWArgs: map[string]string{"expression": `json(payload).a=="b"`},
 			},
+			{
+				Name:   "quota",
+				KWArgs: map[string]string{"name": "test", "rate": "10"},
+			},
+			{
+				Name:   "enrich",
+				KWArgs: map[string]string{"url": "http://lookup/users/{key}"},
+			},
 		}
 		for _, b := range builtins {
 			e, err := b.executor()
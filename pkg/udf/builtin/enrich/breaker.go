@@ -0,0 +1,76 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package enrich
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+var errBreakerOpen = errors.New("circuit breaker is open")
+
+// breaker is a circuit breaker opened after a number of consecutive failures. Once the cooldown has passed, one call
+// is allowed as a trial, the breaker is closed if it succeeds, or opened again otherwise.
+type breaker struct {
+	lock      sync.Mutex
+	threshold int
+	cooldown  time.Duration
+	failures  int
+	openedAt  time.Time
+	trying    bool
+	now       func() time.Time
+}
+
+func newBreaker(threshold int, cooldown time.Duration) *breaker {
+	return &breaker{threshold: threshold, cooldown: cooldown, now: time.Now}
+}
+
+// allow returns errBreakerOpen if the call is not allowed.
+func (b *breaker) allow() error {
+	if b.threshold <= 0 {
+		return nil
+	}
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if b.failures < b.threshold {
+		return nil
+	}
+	if b.trying || b.now().Sub(b.openedAt) < b.cooldown {
+		return errBreakerOpen
+	}
+	b.trying = true
+	return nil
+}
+
+// done records the result of an allowed call.
+func (b *breaker) done(err error) {
+	if b.threshold <= 0 {
+		return
+	}
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.trying = false
+	if err == nil {
+		b.failures = 0
+		return
+	}
+	b.failures++
+	if b.failures >= b.threshold {
+		b.openedAt = b.now()
+	}
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package enrich
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBreaker(t *testing.T) {
+	now := time.Now()
+	b := newBreaker(2, time.Second)
+	b.now = func() time.Time { return now }
+	failure := fmt.Errorf("failure")
+
+	assert.NoError(t, b.allow())
+	b.done(failure)
+	assert.NoError(t, b.allow())
+	b.done(failure)
+	assert.ErrorIs(t, b.allow(), errBreakerOpen)
+
+	// only one trial is allowed after the cooldown
+	now = now.Add(time.Second)
+	assert.NoError(t, b.allow())
+	assert.ErrorIs(t, b.allow(), errBreakerOpen)
+	b.done(failure)
+	assert.ErrorIs(t, b.allow(), errBreakerOpen)
+
+	now = now.Add(time.Second)
+	assert.NoError(t, b.allow())
+	b.done(nil)
+	assert.NoError(t, b.allow())
+	assert.NoError(t, b.allow())
+}
+
+func TestBreaker_Disabled(t *testing.T) {
+	b := newBreaker(0, time.Second)
+	for i := 0; i < 10; i++ {
+		assert.NoError(t, b.allow())
+		b.done(fmt.Errorf("failure"))
+	}
+}
@@ -0,0 +1,264 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package enrich
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	mapsdk "github.com/numaproj/numaflow-go/pkg/mapper"
+	"go.uber.org/zap"
+
+	"github.com/numaproj/numaflow/pkg/cache"
+	"github.com/numaproj/numaflow/pkg/shared/expr"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+const (
+	keyPlaceholder = "{key}"
+
+	onErrorForward = "forward"
+	onErrorDrop    = "drop"
+	onErrorTag     = "tag"
+
+	defaultField            = "enrichment"
+	defaultTag              = "enrich-failed"
+	defaultTimeout          = time.Second
+	defaultCacheTTL         = time.Minute
+	defaultCacheSize        = 10000
+	defaultBreakerThreshold = 5
+	defaultBreakerCooldown  = 30 * time.Second
+)
+
+// The values in the cache are prefixed with a byte indicating whether the key is found, so that the keys not found
+// are cached too.
+const (
+	cachedNotFound byte = iota
+	cachedFound
+)
+
+type enrich struct {
+	keyExpression string
+	field         string
+	timeout       time.Duration
+	onError       string
+	tag           string
+	lookup        lookuper
+	// cache is nil if caching is disabled
+	cache   *cache.Store
+	breaker *breaker
+}
+
+// New returns a map function enriching the JSON messages with the values looked up from an HTTP or gRPC service.
+func New(args map[string]string) (mapsdk.MapperFunc, error) {
+	e, err := newEnrich(args)
+	if err != nil {
+		return nil, err
+	}
+	return e.apply, nil
+}
+
+func newEnrich(args map[string]string) (*enrich, error) {
+	e := &enrich{
+		keyExpression: args["key"],
+		field:         defaultField,
+		onError:       onErrorForward,
+		tag:           defaultTag,
+	}
+	httpURL, grpcAddress := args["url"], args["address"]
+	switch {
+	case httpURL != "" && grpcAddress != "":
+		return nil, fmt.Errorf(`only one of "url" and "address" can be specified`)
+	case httpURL != "":
+		if !strings.Contains(httpURL, keyPlaceholder) {
+			return nil, fmt.Errorf(`"url" should contain the placeholder %q`, keyPlaceholder)
+		}
+		e.lookup = newHTTPLookup(httpURL)
+	case grpcAddress != "":
+		l, err := newGRPCLookup(grpcAddress)
+		if err != nil {
+			return nil, err
+		}
+		e.lookup = l
+	default:
+		return nil, fmt.Errorf(`missing "url" or "address"`)
+	}
+	if x, ok := args["field"]; ok {
+		if x == "" {
+			return nil, fmt.Errorf(`"field" can not be empty`)
+		}
+		e.field = x
+	}
+	var err error
+	if e.timeout, err = durationArg(args, "timeout", defaultTimeout); err != nil {
+		return nil, err
+	}
+	if e.timeout <= 0 {
+		return nil, fmt.Errorf(`"timeout" should be positive`)
+	}
+	if x, ok := args["onError"]; ok {
+		if x != onErrorForward && x != onErrorDrop && x != onErrorTag {
+			return nil, fmt.Errorf(`invalid "onError" %q, it should be one of %q, %q and %q`, x, onErrorForward, onErrorDrop, onErrorTag)
+		}
+		e.onError = x
+	}
+	if x, ok := args["tag"]; ok {
+		if x == "" {
+			return nil, fmt.Errorf(`"tag" can not be empty`)
+		}
+		e.tag = x
+	}
+	cacheTTL, err := durationArg(args, "cacheTTL", defaultCacheTTL)
+	if err != nil {
+		return nil, err
+	}
+	cacheSize, err := intArg(args, "cacheSize", defaultCacheSize)
+	if err != nil {
+		return nil, err
+	}
+	if cacheTTL > 0 && cacheSize > 0 {
+		if e.cache, err = cache.NewStore(cacheSize, cacheTTL); err != nil {
+			return nil, err
+		}
+	}
+	threshold, err := intArg(args, "breakerThreshold", defaultBreakerThreshold)
+	if err != nil {
+		return nil, err
+	}
+	cooldown, err := durationArg(args, "breakerCooldown", defaultBreakerCooldown)
+	if err != nil {
+		return nil, err
+	}
+	e.breaker = newBreaker(threshold, cooldown)
+	return e, nil
+}
+
+func (e *enrich) apply(ctx context.Context, keys []string, datum mapsdk.Datum) mapsdk.Messages {
+	log := logging.FromContext(ctx)
+	payload := datum.Value()
+	result, err := e.enrich(ctx, keys, payload)
+	if err == nil {
+		return mapsdk.MessagesBuilder().Append(mapsdk.NewMessage(result).WithKeys(keys))
+	}
+	log.Errorw("Failed to enrich the message", zap.Strings("keys", keys), zap.String("onError", e.onError), zap.Error(err))
+	switch e.onError {
+	case onErrorDrop:
+		return mapsdk.MessagesBuilder().Append(mapsdk.MessageToDrop())
+	case onErrorTag:
+		return mapsdk.MessagesBuilder().Append(mapsdk.NewMessage(payload).WithKeys(keys).WithTags([]string{e.tag}))
+	default:
+		return mapsdk.MessagesBuilder().Append(mapsdk.NewMessage(payload).WithKeys(keys))
+	}
+}
+
+// enrich returns the payload with the value of the key set to the field, the payload is returned as it is if the key
+// is empty or not found.
+func (e *enrich) enrich(ctx context.Context, keys []string, payload []byte) ([]byte, error) {
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(payload, &obj); err != nil {
+		return nil, fmt.Errorf("payload is not a JSON object, %w", err)
+	}
+	key, err := e.extractKey(keys, payload)
+	if err != nil {
+		return nil, err
+	}
+	if key == "" {
+		return payload, nil
+	}
+	value, found, err := e.get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return payload, nil
+	}
+	if json.Valid(value) {
+		obj[e.field] = value
+	} else {
+		// not a JSON value, set as a string
+		s, _ := json.Marshal(string(value))
+		obj[e.field] = s
+	}
+	return json.Marshal(obj)
+}
+
+// extractKey evaluates the key expression, or joins the keys of the message if there's no expression.
+func (e *enrich) extractKey(keys []string, payload []byte) (string, error) {
+	if e.keyExpression == "" {
+		return strings.Join(keys, ","), nil
+	}
+	key, err := expr.EvalStr(e.keyExpression, payload)
+	if err != nil {
+		return "", fmt.Errorf("failed to extract the key, %w", err)
+	}
+	return key, nil
+}
+
+// get returns the value of the key from the cache, or from the lookup service with the breaker.
+func (e *enrich) get(ctx context.Context, key string) ([]byte, bool, error) {
+	if e.cache != nil {
+		if v, ok := e.cache.Get(key); ok && len(v) > 0 {
+			return v[1:], v[0] == cachedFound, nil
+		}
+	}
+	if err := e.breaker.allow(); err != nil {
+		return nil, false, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, e.timeout)
+	defer cancel()
+	value, found, err := e.lookup.Lookup(ctx, key)
+	e.breaker.done(err)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to look up the key, %w", err)
+	}
+	if e.cache != nil {
+		flag := cachedNotFound
+		if found {
+			flag = cachedFound
+		}
+		e.cache.Set(key, append([]byte{flag}, value...))
+	}
+	return value, found, nil
+}
+
+func durationArg(args map[string]string, name string, defaultValue time.Duration) (time.Duration, error) {
+	x, ok := args[name]
+	if !ok {
+		return defaultValue, nil
+	}
+	d, err := time.ParseDuration(x)
+	if err != nil || d < 0 {
+		return 0, fmt.Errorf(`invalid %q %q, it should be a non-negative duration`, name, x)
+	}
+	return d, nil
+}
+
+func intArg(args map[string]string, name string, defaultValue int) (int, error) {
+	x, ok := args[name]
+	if !ok {
+		return defaultValue, nil
+	}
+	i, err := strconv.Atoi(x)
+	if err != nil || i < 0 {
+		return 0, fmt.Errorf(`invalid %q %q, it should be a non-negative integer`, name, x)
+	}
+	return i, nil
+}
@@ -0,0 +1,193 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package enrich
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	mapsdk "github.com/numaproj/numaflow-go/pkg/mapper"
+	"github.com/stretchr/testify/assert"
+)
+
+type testDatum struct {
+	value []byte
+}
+
+func (d *testDatum) Value() []byte {
+	return d.value
+}
+
+func (d *testDatum) EventTime() time.Time {
+	return time.Time{}
+}
+
+func (d *testDatum) Watermark() time.Time {
+	return time.Time{}
+}
+
+func newTestServer(t *testing.T, calls *int32) *httptest.Server {
+	t.Helper()
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(calls, 1)
+		switch r.URL.Path {
+		case "/users/u 1":
+			_, _ = w.Write([]byte(`{"name":"numa"}`))
+		case "/users/u2":
+			_, _ = w.Write([]byte(`gold`))
+		case "/users/broken":
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func TestNewEnrich(t *testing.T) {
+	tests := []struct {
+		args map[string]string
+		err  string
+	}{
+		{map[string]string{}, `missing "url" or "address"`},
+		{map[string]string{"url": "http://a/{key}", "address": "a:80"}, `only one of`},
+		{map[string]string{"url": "http://a/b"}, `placeholder`},
+		{map[string]string{"url": "http://a/{key}", "field": ""}, `"field" can not be empty`},
+		{map[string]string{"url": "http://a/{key}", "timeout": "1"}, `invalid "timeout"`},
+		{map[string]string{"url": "http://a/{key}", "timeout": "0s"}, `"timeout" should be positive`},
+		{map[string]string{"url": "http://a/{key}", "onError": "retry"}, `invalid "onError"`},
+		{map[string]string{"url": "http://a/{key}", "cacheSize": "-1"}, `invalid "cacheSize"`},
+		{map[string]string{"url": "http://a/{key}", "breakerThreshold": "x"}, `invalid "breakerThreshold"`},
+	}
+	for _, tt := range tests {
+		_, err := newEnrich(tt.args)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), tt.err)
+	}
+
+	e, err := newEnrich(map[string]string{"address": "localhost:9090"})
+	assert.NoError(t, err)
+	assert.IsType(t, &grpcLookup{}, e.lookup)
+	assert.Equal(t, defaultField, e.field)
+	assert.Equal(t, defaultTimeout, e.timeout)
+	assert.Equal(t, onErrorForward, e.onError)
+	assert.NotNil(t, e.cache)
+
+	e, err = newEnrich(map[string]string{"url": "http://a/{key}", "cacheTTL": "0s"})
+	assert.NoError(t, err)
+	assert.Nil(t, e.cache)
+}
+
+func TestEnrich_HTTP(t *testing.T) {
+	var calls int32
+	s := newTestServer(t, &calls)
+	handle, err := New(map[string]string{"url": s.URL + "/users/{key}", "key": "json(payload).user", "field": "user_info"})
+	assert.NoError(t, err)
+	ctx := context.Background()
+
+	msgs := handle(ctx, []string{"k"}, &testDatum{value: []byte(`{"user":"u 1","a":1}`)})
+	assert.JSONEq(t, `{"user":"u 1","a":1,"user_info":{"name":"numa"}}`, string(msgs.Items()[0].Value()))
+	assert.Equal(t, []string{"k"}, msgs.Items()[0].Keys())
+	// the non-JSON values are set as strings
+	msgs = handle(ctx, nil, &testDatum{value: []byte(`{"user":"u2"}`)})
+	assert.JSONEq(t, `{"user":"u2","user_info":"gold"}`, string(msgs.Items()[0].Value()))
+	// the keys not found are forwarded as they are
+	msgs = handle(ctx, nil, &testDatum{value: []byte(`{"user":"u3"}`)})
+	assert.Equal(t, `{"user":"u3"}`, string(msgs.Items()[0].Value()))
+	assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+
+	// cached, including the keys not found
+	handle(ctx, nil, &testDatum{value: []byte(`{"user":"u 1"}`)})
+	handle(ctx, nil, &testDatum{value: []byte(`{"user":"u3"}`)})
+	assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+}
+
+func TestEnrich_MessageKeys(t *testing.T) {
+	var calls int32
+	s := newTestServer(t, &calls)
+	handle, err := New(map[string]string{"url": s.URL + "/users/{key}"})
+	assert.NoError(t, err)
+	msgs := handle(context.Background(), []string{"u2"}, &testDatum{value: []byte(`{}`)})
+	assert.JSONEq(t, `{"enrichment":"gold"}`, string(msgs.Items()[0].Value()))
+}
+
+func TestEnrich_OnError(t *testing.T) {
+	var calls int32
+	s := newTestServer(t, &calls)
+	ctx := context.Background()
+	for _, onError := range []string{onErrorForward, onErrorDrop, onErrorTag} {
+		handle, err := New(map[string]string{"url": s.URL + "/users/{key}", "key": "json(payload).user", "onError": onError, "tag": "failed"})
+		assert.NoError(t, err)
+		for _, payload := range []string{`{"user":"broken"}`, `not-json`} {
+			msg := handle(ctx, []string{"k"}, &testDatum{value: []byte(payload)}).Items()[0]
+			switch onError {
+			case onErrorForward:
+				assert.Equal(t, payload, string(msg.Value()))
+				assert.Empty(t, msg.Tags())
+			case onErrorDrop:
+				assert.Equal(t, []string{mapsdk.DROP}, msg.Tags())
+			case onErrorTag:
+				assert.Equal(t, payload, string(msg.Value()))
+				assert.Equal(t, []string{"failed"}, msg.Tags())
+			}
+		}
+	}
+}
+
+type testLookup struct {
+	calls int
+	err   error
+}
+
+func (l *testLookup) Lookup(_ context.Context, key string) ([]byte, bool, error) {
+	l.calls++
+	if l.err != nil {
+		return nil, false, l.err
+	}
+	return []byte(`"v"`), true, nil
+}
+
+func TestEnrich_Breaker(t *testing.T) {
+	e, err := newEnrich(map[string]string{"url": "http://a/{key}", "cacheTTL": "0s", "breakerThreshold": "2", "breakerCooldown": "1m"})
+	assert.NoError(t, err)
+	l := &testLookup{err: fmt.Errorf("unavailable")}
+	e.lookup = l
+	now := time.Now()
+	e.breaker.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		_, err = e.enrich(ctx, []string{"k"}, []byte(`{}`))
+		assert.Error(t, err)
+	}
+	// the breaker is opened after 2 failures
+	assert.Equal(t, 2, l.calls)
+	assert.ErrorIs(t, err, errBreakerOpen)
+
+	now = now.Add(time.Minute)
+	l.err = nil
+	result, err := e.enrich(ctx, []string{"k"}, []byte(`{}`))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"enrichment":"v"}`, string(result))
+	assert.Equal(t, 3, l.calls)
+}
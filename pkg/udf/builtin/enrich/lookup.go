@@ -0,0 +1,100 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package enrich
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	lookuppb "github.com/numaproj/numaflow/pkg/apis/proto/lookup"
+)
+
+// maxResponseSize is the max size of a response body of an HTTP lookup service.
+const maxResponseSize = 4 * 1024 * 1024
+
+// lookuper looks up the value of a key from a lookup service.
+type lookuper interface {
+	// Lookup returns the value of the key, and false if the key does not exist.
+	Lookup(ctx context.Context, key string) ([]byte, bool, error)
+}
+
+// httpLookup looks up the keys with GET requests, the placeholder "{key}" in the URL is replaced with the escaped key.
+// A key is treated as not found if the response status is 404.
+type httpLookup struct {
+	url    string
+	client *http.Client
+}
+
+func newHTTPLookup(u string) *httpLookup {
+	return &httpLookup{url: u, client: &http.Client{}}
+}
+
+func (h *httpLookup) Lookup(ctx context.Context, key string) ([]byte, bool, error) {
+	// escaped to be valid in both the path and the query
+	u := strings.ReplaceAll(h.url, keyPlaceholder, strings.ReplaceAll(url.QueryEscape(key), "+", "%20"))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, false, err
+	}
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return nil, false, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, false, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, false, fmt.Errorf("unexpected response status %q", resp.Status)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize+1))
+	if err != nil {
+		return nil, false, err
+	}
+	if len(body) > maxResponseSize {
+		return nil, false, fmt.Errorf("response body exceeds %d bytes", maxResponseSize)
+	}
+	return body, true, nil
+}
+
+// grpcLookup looks up the keys with the Lookup service defined in lookup.proto.
+type grpcLookup struct {
+	client lookuppb.LookupClient
+}
+
+func newGRPCLookup(address string) (*grpcLookup, error) {
+	conn, err := grpc.Dial(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to the lookup service, %w", err)
+	}
+	return &grpcLookup{client: lookuppb.NewLookupClient(conn)}, nil
+}
+
+func (g *grpcLookup) Lookup(ctx context.Context, key string) ([]byte, bool, error) {
+	resp, err := g.client.Lookup(ctx, &lookuppb.LookupRequest{Key: key})
+	if err != nil {
+		return nil, false, err
+	}
+	return resp.GetValue(), resp.GetFound(), nil
+}
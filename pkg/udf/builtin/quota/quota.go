@@ -0,0 +1,222 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quota
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"math"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	mapsdk "github.com/numaproj/numaflow-go/pkg/mapper"
+	"go.uber.org/zap"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/counter"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+const (
+	unitMessages = "messages"
+	unitBytes    = "bytes"
+
+	actionDrop = "drop"
+	actionTag  = "tag"
+
+	defaultTag = "quota-exceeded"
+	// noKey is the bucket key of the messages without keys, it's not in the alphabet of the encoded keys.
+	noKey = "="
+)
+
+var validName = regexp.MustCompile(`^[-_a-zA-Z0-9]+$`)
+
+// counters is the subset of the counter client used by the quota.
+type counters interface {
+	Increment(ctx context.Context, name string, delta int64, sync bool) (int64, error)
+}
+
+// quota enforces a token bucket per key. A bucket is kept as a counter of the consumed tokens, which is shared by all
+// the replicas, and the tokens allowed to be consumed by a given time are burst + rate * (time since the Unix epoch).
+// When a bucket is full, the counter catches up with the allowed tokens minus burst, so that the idle time does not
+// accumulate more tokens than burst.
+type quota struct {
+	name     string
+	rate     float64
+	burst    int64
+	unit     string
+	action   string
+	tag      string
+	counters counters
+	now      func() time.Time
+}
+
+// New returns a map function enforcing the per key quotas, the messages exceeding the quota of their keys are
+// dropped or tagged.
+func New(args map[string]string) (mapsdk.MapperFunc, error) {
+	c, err := counter.NewClient(counter.CounterAddr)
+	if err != nil {
+		return nil, err
+	}
+	q, err := newQuota(args, c, time.Now)
+	if err != nil {
+		_ = c.Close()
+		return nil, err
+	}
+	return q.apply, nil
+}
+
+func newQuota(args map[string]string, c counters, now func() time.Time) (*quota, error) {
+	q := &quota{
+		name:     os.Getenv(dfv1.EnvVertexName),
+		unit:     unitMessages,
+		action:   actionDrop,
+		tag:      defaultTag,
+		counters: c,
+		now:      now,
+	}
+	if x, ok := args["name"]; ok {
+		q.name = x
+	}
+	if !validName.MatchString(q.name) {
+		return nil, fmt.Errorf(`invalid "name" %q, only letters, numbers, "-" and "_" are allowed`, q.name)
+	}
+	x, ok := args["rate"]
+	if !ok {
+		return nil, fmt.Errorf(`missing "rate"`)
+	}
+	rate, err := strconv.ParseFloat(x, 64)
+	if err != nil || rate <= 0 || math.IsInf(rate, 0) {
+		return nil, fmt.Errorf(`invalid "rate" %q, it should be a positive number`, x)
+	}
+	q.rate = rate
+	q.burst = int64(math.Max(1, math.Ceil(rate)))
+	if x, ok := args["burst"]; ok {
+		burst, err := strconv.ParseInt(x, 10, 64)
+		if err != nil || burst <= 0 {
+			return nil, fmt.Errorf(`invalid "burst" %q, it should be a positive integer`, x)
+		}
+		q.burst = burst
+	}
+	if x, ok := args["unit"]; ok {
+		if x != unitMessages && x != unitBytes {
+			return nil, fmt.Errorf(`invalid "unit" %q, it should be %q or %q`, x, unitMessages, unitBytes)
+		}
+		q.unit = x
+	}
+	if x, ok := args["action"]; ok {
+		if x != actionDrop && x != actionTag {
+			return nil, fmt.Errorf(`invalid "action" %q, it should be %q or %q`, x, actionDrop, actionTag)
+		}
+		q.action = x
+	}
+	if x, ok := args["tag"]; ok {
+		if x == "" {
+			return nil, fmt.Errorf(`"tag" can not be empty`)
+		}
+		q.tag = x
+	}
+	return q, nil
+}
+
+func (q *quota) apply(ctx context.Context, keys []string, datum mapsdk.Datum) mapsdk.Messages {
+	log := logging.FromContext(ctx)
+	msg := mapsdk.NewMessage(datum.Value()).WithKeys(keys)
+	exceeded, err := q.consume(ctx, q.counterName(keys), q.cost(datum))
+	if err != nil {
+		// fail open, the quota is not enforced if the counters are not available
+		log.Errorw("Failed to check the quota, forwarding the message", zap.Strings("keys", keys), zap.Error(err))
+		return mapsdk.MessagesBuilder().Append(msg)
+	}
+	if !exceeded {
+		return mapsdk.MessagesBuilder().Append(msg)
+	}
+	if q.action == actionTag {
+		return mapsdk.MessagesBuilder().Append(msg.WithTags([]string{q.tag}))
+	}
+	return mapsdk.MessagesBuilder().Append(mapsdk.MessageToDrop())
+}
+
+// consume takes the tokens from the bucket, and returns true if there are not enough tokens, in which case the tokens
+// are not taken.
+func (q *quota) consume(ctx context.Context, name string, cost int64) (bool, error) {
+	allowed := q.allowed()
+	floor := allowed - q.burst
+	consumed, err := q.counters.Increment(ctx, name, cost, false)
+	if err != nil {
+		return false, err
+	}
+	if consumed-cost < floor {
+		// the bucket is full, catch up with the floor. The increment is committed synchronously, and corrected with
+		// the committed value, in case the counter has been updated by the other replicas.
+		delta := floor + cost - consumed
+		if consumed, err = q.counters.Increment(ctx, name, delta, true); err != nil {
+			return false, err
+		}
+		if target := max64(consumed-delta, floor+cost); target != consumed {
+			if consumed, err = q.counters.Increment(ctx, name, target-consumed, false); err != nil {
+				return false, err
+			}
+		}
+	}
+	if consumed <= allowed {
+		return false, nil
+	}
+	// give the tokens back, the messages exceeding the quota do not consume the tokens
+	if _, err := q.counters.Increment(ctx, name, -cost, false); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// allowed returns the total number of tokens allowed to be consumed by now.
+func (q *quota) allowed() int64 {
+	return int64(q.rate*float64(q.now().UnixNano())/float64(time.Second)) + q.burst
+}
+
+func (q *quota) cost(datum mapsdk.Datum) int64 {
+	if q.unit == unitBytes {
+		return int64(len(datum.Value()))
+	}
+	return 1
+}
+
+// counterName returns the name of the counter of the bucket, the keys are encoded to be valid in a counter name.
+func (q *quota) counterName(keys []string) string {
+	encoded := make([]string, 0, len(keys))
+	for _, k := range keys {
+		if k != "" {
+			encoded = append(encoded, base64.RawURLEncoding.EncodeToString([]byte(k)))
+		}
+	}
+	key := strings.Join(encoded, ".")
+	if key == "" {
+		key = noKey
+	}
+	return fmt.Sprintf("quota/%s/%s", q.name, key)
+}
+
+func max64(a, b int64) int64 {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,174 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quota
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	mapsdk "github.com/numaproj/numaflow-go/pkg/mapper"
+	"github.com/stretchr/testify/assert"
+)
+
+type testDatum struct {
+	value []byte
+}
+
+func (d *testDatum) Value() []byte {
+	return d.value
+}
+
+func (d *testDatum) EventTime() time.Time {
+	return time.Time{}
+}
+
+func (d *testDatum) Watermark() time.Time {
+	return time.Time{}
+}
+
+type testCounters struct {
+	values map[string]int64
+	err    error
+}
+
+func (c *testCounters) Increment(_ context.Context, name string, delta int64, _ bool) (int64, error) {
+	if c.err != nil {
+		return 0, c.err
+	}
+	c.values[name] += delta
+	return c.values[name], nil
+}
+
+type testClock struct {
+	t time.Time
+}
+
+func (c *testClock) now() time.Time {
+	return c.t
+}
+
+func newTestQuota(t *testing.T, args map[string]string) (*quota, *testCounters, *testClock) {
+	t.Helper()
+	c := &testCounters{values: map[string]int64{}}
+	clock := &testClock{t: time.Unix(1700000000, 0)}
+	q, err := newQuota(args, c, clock.now)
+	assert.NoError(t, err)
+	return q, c, clock
+}
+
+func dropped(msgs mapsdk.Messages) bool {
+	tags := msgs.Items()[0].Tags()
+	return len(tags) == 1 && tags[0] == mapsdk.DROP
+}
+
+func TestNewQuota(t *testing.T) {
+	c := &testCounters{values: map[string]int64{}}
+	tests := []struct {
+		args map[string]string
+		err  string
+	}{
+		{map[string]string{"name": "q"}, `missing "rate"`},
+		{map[string]string{"name": "q", "rate": "0"}, `invalid "rate"`},
+		{map[string]string{"name": "q", "rate": "abc"}, `invalid "rate"`},
+		{map[string]string{"name": "q", "rate": "1", "burst": "-1"}, `invalid "burst"`},
+		{map[string]string{"name": "q", "rate": "1", "unit": "kb"}, `invalid "unit"`},
+		{map[string]string{"name": "q", "rate": "1", "action": "delay"}, `invalid "action"`},
+		{map[string]string{"name": "q", "rate": "1", "tag": ""}, `"tag" can not be empty`},
+		{map[string]string{"name": "a/b", "rate": "1"}, `invalid "name"`},
+		{map[string]string{"rate": "1"}, `invalid "name"`},
+	}
+	for _, tt := range tests {
+		_, err := newQuota(tt.args, c, time.Now)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), tt.err)
+	}
+
+	q, err := newQuota(map[string]string{"name": "q", "rate": "0.5"}, c, time.Now)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), q.burst)
+	assert.Equal(t, unitMessages, q.unit)
+	assert.Equal(t, actionDrop, q.action)
+	assert.Equal(t, defaultTag, q.tag)
+
+	t.Setenv("NUMAFLOW_VERTEX_NAME", "my-vertex")
+	q, err = newQuota(map[string]string{"rate": "10", "burst": "20"}, c, time.Now)
+	assert.NoError(t, err)
+	assert.Equal(t, "my-vertex", q.name)
+	assert.Equal(t, int64(20), q.burst)
+}
+
+func TestQuota_Drop(t *testing.T) {
+	q, c, clock := newTestQuota(t, map[string]string{"name": "q", "rate": "2", "burst": "3"})
+	ctx := context.Background()
+	datum := &testDatum{value: []byte("hello")}
+
+	// a full bucket allows a burst
+	for i := 0; i < 3; i++ {
+		msgs := q.apply(ctx, []string{"tenant-a"}, datum)
+		assert.False(t, dropped(msgs))
+		assert.Equal(t, []string{"tenant-a"}, msgs.Items()[0].Keys())
+		assert.Equal(t, "hello", string(msgs.Items()[0].Value()))
+	}
+	assert.True(t, dropped(q.apply(ctx, []string{"tenant-a"}, datum)))
+	// the other keys have their own buckets
+	assert.False(t, dropped(q.apply(ctx, []string{"tenant-b"}, datum)))
+	assert.Len(t, c.values, 2)
+
+	// refilled with the rate
+	clock.t = clock.t.Add(time.Second)
+	assert.False(t, dropped(q.apply(ctx, []string{"tenant-a"}, datum)))
+	assert.False(t, dropped(q.apply(ctx, []string{"tenant-a"}, datum)))
+	assert.True(t, dropped(q.apply(ctx, []string{"tenant-a"}, datum)))
+
+	// the idle time does not accumulate more than burst
+	clock.t = clock.t.Add(time.Hour)
+	for i := 0; i < 3; i++ {
+		assert.False(t, dropped(q.apply(ctx, []string{"tenant-a"}, datum)))
+	}
+	assert.True(t, dropped(q.apply(ctx, []string{"tenant-a"}, datum)))
+}
+
+func TestQuota_Tag(t *testing.T) {
+	q, _, _ := newTestQuota(t, map[string]string{"name": "q", "rate": "100", "burst": "10", "unit": "bytes", "action": "tag", "tag": "over"})
+	ctx := context.Background()
+
+	msgs := q.apply(ctx, nil, &testDatum{value: []byte("12345678")})
+	assert.Empty(t, msgs.Items()[0].Tags())
+	msgs = q.apply(ctx, nil, &testDatum{value: []byte("123")})
+	assert.Equal(t, []string{"over"}, msgs.Items()[0].Tags())
+	assert.Equal(t, "123", string(msgs.Items()[0].Value()))
+	// the tagged messages do not consume the tokens
+	msgs = q.apply(ctx, nil, &testDatum{value: []byte("12")})
+	assert.Empty(t, msgs.Items()[0].Tags())
+}
+
+func TestQuota_CounterError(t *testing.T) {
+	q, c, _ := newTestQuota(t, map[string]string{"name": "q", "rate": "1"})
+	c.err = fmt.Errorf("unavailable")
+	msgs := q.apply(context.Background(), []string{"k"}, &testDatum{value: []byte("hello")})
+	assert.False(t, dropped(msgs))
+	assert.Equal(t, "hello", string(msgs.Items()[0].Value()))
+}
+
+func TestQuota_CounterName(t *testing.T) {
+	q := &quota{name: "q"}
+	assert.Equal(t, "quota/q/=", q.counterName(nil))
+	assert.Equal(t, "quota/q/=", q.counterName([]string{""}))
+	assert.Equal(t, "quota/q/YQ.Yi9j", q.counterName([]string{"a", "b/c"}))
+}
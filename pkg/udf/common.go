@@ -19,20 +19,91 @@ package udf
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 	"strings"
 
+	"go.uber.org/zap"
+
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/cache"
+	"github.com/numaproj/numaflow/pkg/counter"
 	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/isb/mirror"
 	jetstreamisb "github.com/numaproj/numaflow/pkg/isb/stores/jetstream"
 	redisisb "github.com/numaproj/numaflow/pkg/isb/stores/redis"
 	"github.com/numaproj/numaflow/pkg/isbsvc"
 	jsclient "github.com/numaproj/numaflow/pkg/shared/clients/nats"
 	redisclient "github.com/numaproj/numaflow/pkg/shared/clients/redis"
+	"github.com/numaproj/numaflow/pkg/shared/kvs"
+	jetstreamkv "github.com/numaproj/numaflow/pkg/shared/kvs/jetstream"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/shared/termination"
 )
 
+// startCounterServer starts serving the counters shared in the pipeline to the user container, the returned channel is
+// closed once the server exits and the pending increments are committed. The server is not started if the counters
+// store is not available, e.g. the pipeline was created before the counters store was introduced.
+func startCounterServer(ctx context.Context, vertexInstance *dfv1.VertexInstance, client *jsclient.NATSClient) <-chan struct{} {
+	log := logging.FromContext(ctx)
+	done := make(chan struct{})
+	kvName := isbsvc.JetStreamCountersKVName(vertexInstance.Vertex.GetPipelineStoreName())
+	kv, err := jetstreamkv.NewKVJetStreamKVStore(ctx, kvName, client)
+	if err != nil {
+		log.Warnw("Counters store is not available, not serving the counters", zap.String("kvName", kvName), zap.Error(err))
+		close(done)
+		return done
+	}
+	store := counter.NewStore(ctx, kv.(kvs.KVRevisionStorer))
+	storeDone := store.Start(ctx)
+	go func() {
+		defer close(done)
+		if err := counter.NewServer(store).Serve(ctx, counter.CounterAddr); err != nil {
+			log.Errorw("Counter server exited", zap.Error(err))
+		}
+		<-storeDone
+	}()
+	return done
+}
+
+// startCacheServer starts serving the vertex cache to the user container, the returned channel is closed once the
+// server exits and the entries are saved. The server is not started if the vertex has no cache.
+func startCacheServer(ctx context.Context, vertexInstance *dfv1.VertexInstance) (<-chan struct{}, error) {
+	log := logging.FromContext(ctx)
+	done := make(chan struct{})
+	c := vertexInstance.Vertex.Spec.Cache
+	if c == nil {
+		close(done)
+		return done, nil
+	}
+	var opts []cache.Option
+	if x := c.Persistence; x != nil {
+		path := filepath.Join(dfv1.PathCachePersistenceMount, dfv1.GenerateCacheFileName(vertexInstance.Vertex.Spec.Name, vertexInstance.Replica))
+		opts = append(opts, cache.WithPersistence(path, x.GetInterval()))
+	}
+	if c.SideInput != "" {
+		opts = append(opts, cache.WithSideInput(filepath.Join(dfv1.PathSideInputsMount, c.SideInput)))
+	}
+	store, err := cache.NewStore(c.GetMaxEntries(), c.GetTTL(), opts...)
+	if err != nil {
+		return nil, err
+	}
+	storeDone := store.Start(ctx)
+	go func() {
+		defer close(done)
+		if err := cache.NewServer(store).Serve(ctx, cache.CacheAddr); err != nil {
+			log.Errorw("Cache server exited", zap.Error(err))
+		}
+		<-storeDone
+	}()
+	return done, nil
+}
+
 func buildRedisBufferIO(ctx context.Context, vertexInstance *dfv1.VertexInstance) ([]isb.BufferReader, map[string][]isb.BufferWriter, error) {
 	var readers []isb.BufferReader
-	redisClient := redisclient.NewInClusterRedisClient()
+	redisClient, err := redisclient.NewInClusterRedisClient()
+	if err != nil {
+		return nil, nil, termination.WithCause(dfv1.RestartCauseISBConnectFailed, fmt.Errorf("failed to create redis client, %w", err))
+	}
 	var readerOpts []redisclient.Option
 	if x := vertexInstance.Vertex.Spec.Limits; x != nil && x.ReadTimeout != nil {
 		readerOpts = append(readerOpts, redisclient.WithReadTimeOut(x.ReadTimeout.Duration))
@@ -82,7 +153,7 @@ func buildRedisBufferIO(ctx context.Context, vertexInstance *dfv1.VertexInstance
 			writeOpts = append(writeOpts, redisclient.WithBufferUsageLimit(float64(*x.BufferUsageLimit)/100))
 		}
 		var edgeBuffers []isb.BufferWriter
-		partitionedBuffers := dfv1.GenerateBufferNames(vertexInstance.Vertex.Namespace, vertexInstance.Vertex.Spec.PipelineName, e.To, e.GetToVertexPartitionCount())
+		partitionedBuffers := e.GetToBufferNames(vertexInstance.Vertex.Namespace, vertexInstance.Vertex.Spec.PipelineName)
 		for partitionIdx, partition := range partitionedBuffers {
 			writer := redisisb.NewBufferWrite(ctx, redisClient, partition, partition+"-group", int32(partitionIdx), writeOpts...)
 			edgeBuffers = append(edgeBuffers, writer)
@@ -90,6 +161,12 @@ func buildRedisBufferIO(ctx context.Context, vertexInstance *dfv1.VertexInstance
 		writers[e.To] = edgeBuffers
 	}
 
+	writers, err = mirror.NewMirroringWriters(ctx, writers, vertexInstance.Vertex, func(bufferName string, partitionIdx int32) (isb.BufferWriter, error) {
+		return redisisb.NewBufferWrite(ctx, redisClient, bufferName, bufferName+"-group", partitionIdx), nil
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create the mirror writers, %w", err)
+	}
 	return readers, writers, nil
 }
 
@@ -122,7 +199,7 @@ func buildJetStreamBufferIO(ctx context.Context, vertexInstance *dfv1.VertexInst
 		fromStreamName := isbsvc.JetStreamName(fromBufferPartition)
 		// reduce processor only has one buffer partition
 		// since we read from one buffer partition, fromPartitionIdx is 0.
-		reader, err := jetstreamisb.NewJetStreamBufferReader(ctx, clientPool.NextAvailableClient(), fromBufferPartition, fromStreamName, fromStreamName, 0, readOptions...)
+		reader, err := jetstreamisb.NewJetStreamBufferReader(ctx, clientPool.NextAvailableClient().WithDomain(vertexInstance.Vertex.GetFromJetStreamDomain()), fromBufferPartition, fromStreamName, fromStreamName, 0, readOptions...)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -132,7 +209,7 @@ func buildJetStreamBufferIO(ctx context.Context, vertexInstance *dfv1.VertexInst
 		for index, bufferPartition := range vertexInstance.Vertex.OwnedBuffers() {
 			fromStreamName := isbsvc.JetStreamName(bufferPartition)
 
-			reader, err := jetstreamisb.NewJetStreamBufferReader(ctx, clientPool.NextAvailableClient(), bufferPartition, fromStreamName, fromStreamName, int32(index), readOptions...)
+			reader, err := jetstreamisb.NewJetStreamBufferReader(ctx, clientPool.NextAvailableClient().WithDomain(vertexInstance.Vertex.GetFromJetStreamDomain()), bufferPartition, fromStreamName, fromStreamName, int32(index), readOptions...)
 			if err != nil {
 				return nil, nil, err
 			}
@@ -146,6 +223,8 @@ func buildJetStreamBufferIO(ctx context.Context, vertexInstance *dfv1.VertexInst
 	for _, e := range vertexInstance.Vertex.Spec.ToEdges {
 		writeOpts := []jetstreamisb.WriteOption{
 			jetstreamisb.WithBufferFullWritingStrategy(e.BufferFullWritingStrategy()),
+			jetstreamisb.WithWriteAckPolicy(e.GetWriteAckPolicy()),
+			jetstreamisb.WithStrictFIFO(e.StrictFIFO),
 		}
 		if x := e.ToVertexLimits; x != nil && x.BufferMaxLength != nil {
 			writeOpts = append(writeOpts, jetstreamisb.WithMaxLength(int64(*x.BufferMaxLength)))
@@ -153,12 +232,15 @@ func buildJetStreamBufferIO(ctx context.Context, vertexInstance *dfv1.VertexInst
 		if x := e.ToVertexLimits; x != nil && x.BufferUsageLimit != nil {
 			writeOpts = append(writeOpts, jetstreamisb.WithBufferUsageLimit(float64(*x.BufferUsageLimit)/100))
 		}
+		if x := vertexInstance.Vertex.Spec.ClaimCheck; x != nil {
+			writeOpts = append(writeOpts, jetstreamisb.WithClaimCheck(isbsvc.JetStreamClaimCheckStoreName(vertexInstance.Vertex.GetPipelineStoreName()), x.GetThreshold()))
+		}
 
-		partitionedBuffers := dfv1.GenerateBufferNames(vertexInstance.Vertex.Namespace, vertexInstance.Vertex.Spec.PipelineName, e.To, e.GetToVertexPartitionCount())
+		partitionedBuffers := e.GetToBufferNames(vertexInstance.Vertex.Namespace, vertexInstance.Vertex.Spec.PipelineName)
 		var edgeBuffers []isb.BufferWriter
 		for partitionIdx, partition := range partitionedBuffers {
 			streamName := isbsvc.JetStreamName(partition)
-			writer, err := jetstreamisb.NewJetStreamBufferWriter(ctx, clientPool.NextAvailableClient(), partition, streamName, streamName, int32(partitionIdx), writeOpts...)
+			writer, err := jetstreamisb.NewJetStreamBufferWriter(ctx, clientPool.NextAvailableClient().ForToEdge(e, vertexInstance.Vertex.Spec.GetJetStreamDomain()), partition, streamName, streamName, int32(partitionIdx), writeOpts...)
 			if err != nil {
 				return nil, nil, err
 			}
@@ -167,5 +249,12 @@ func buildJetStreamBufferIO(ctx context.Context, vertexInstance *dfv1.VertexInst
 
 		writers[e.To] = edgeBuffers
 	}
+	writers, err := mirror.NewMirroringWriters(ctx, writers, vertexInstance.Vertex, func(bufferName string, partitionIdx int32) (isb.BufferWriter, error) {
+		streamName := isbsvc.JetStreamName(bufferName)
+		return jetstreamisb.NewJetStreamBufferWriter(ctx, clientPool.NextAvailableClient(), bufferName, streamName, streamName, partitionIdx)
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create the mirror writers, %w", err)
+	}
 	return readers, writers, nil
 }
@@ -0,0 +1,54 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package udf
+
+import (
+	"context"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/watermark/fetch"
+	"github.com/numaproj/numaflow/pkg/watermark/publish"
+)
+
+// BufferIO is the Inter-Step Buffer IO of a vertex instance.
+type BufferIO struct {
+	// Readers read the partitions of the buffers owned by the vertex, in the order of the partitions.
+	Readers []isb.BufferReader
+	// Writers write the partitions of the buffers of the to vertices, keyed by the name of the to vertex.
+	Writers map[string][]isb.BufferWriter
+	// FetchWatermark fetches the watermark of the read messages, the watermark is not tracked if it's nil.
+	FetchWatermark fetch.Fetcher
+	// PublishWatermark publishes the watermark to the to vertices, keyed by the name of the to vertex. The watermark
+	// is not published if it's nil.
+	PublishWatermark map[string]publish.Publisher
+}
+
+// BufferIOBuilder builds the Inter-Step Buffer IO of a vertex instance. It's the extension point to embed the
+// processors in a custom binary with a custom Inter-Step Buffer implementation, while reusing the forwarding and the
+// watermark logic.
+type BufferIOBuilder interface {
+	Build(ctx context.Context, vertexInstance *dfv1.VertexInstance) (*BufferIO, error)
+}
+
+// BufferIOBuilderFunc is a function implementing BufferIOBuilder.
+type BufferIOBuilderFunc func(ctx context.Context, vertexInstance *dfv1.VertexInstance) (*BufferIO, error)
+
+// Build builds the Inter-Step Buffer IO of a vertex instance.
+func (f BufferIOBuilderFunc) Build(ctx context.Context, vertexInstance *dfv1.VertexInstance) (*BufferIO, error) {
+	return f(ctx, vertexInstance)
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package udf_test
+
+import (
+	"context"
+	"strings"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/forward/applier"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/isb/stores/simplebuffer"
+	"github.com/numaproj/numaflow/pkg/udf"
+)
+
+// ExampleMapUDFProcessor embeds a map UDF processor with in-memory buffers and an in-process map function.
+func ExampleMapUDFProcessor() {
+	vertexInstance := &dfv1.VertexInstance{
+		Vertex: &dfv1.Vertex{Spec: dfv1.VertexSpec{
+			PipelineName:   "my-pipeline",
+			AbstractVertex: dfv1.AbstractVertex{Name: "upper", UDF: &dfv1.UDF{}},
+			ToEdges:        []dfv1.CombinedEdge{{Edge: dfv1.Edge{From: "upper", To: "out"}}},
+		}},
+		Hostname: "my-pipeline-upper-0",
+	}
+	p := &udf.MapUDFProcessor{
+		VertexInstance: vertexInstance,
+		BufferIOBuilder: udf.BufferIOBuilderFunc(func(ctx context.Context, vi *dfv1.VertexInstance) (*udf.BufferIO, error) {
+			return &udf.BufferIO{
+				Readers: []isb.BufferReader{simplebuffer.NewInMemoryBuffer("my-pipeline-upper-0", 1000, 0)},
+				Writers: map[string][]isb.BufferWriter{"out": {simplebuffer.NewInMemoryBuffer("my-pipeline-out-0", 1000, 0)}},
+			}, nil
+		}),
+		MapApplier: applier.ApplyMapFunc(func(ctx context.Context, m *isb.ReadMessage) ([]*isb.WriteMessage, error) {
+			return []*isb.WriteMessage{{Message: isb.Message{
+				Header: m.Header,
+				Body:   isb.Body{Payload: []byte(strings.ToUpper(string(m.Payload)))},
+			}}}, nil
+		}),
+	}
+	// Start blocks until the context is canceled.
+	_ = p.Start
+}
@@ -18,6 +18,7 @@ package udf
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"sync"
 
@@ -33,18 +34,32 @@ import (
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/forward"
+	"github.com/numaproj/numaflow/pkg/forward/applier"
 	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/isb/checksum"
+	"github.com/numaproj/numaflow/pkg/isb/encryption"
+	"github.com/numaproj/numaflow/pkg/isb/record"
+	"github.com/numaproj/numaflow/pkg/isb/signing"
+	"github.com/numaproj/numaflow/pkg/isb/ttl"
 	"github.com/numaproj/numaflow/pkg/metrics"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/shared/termination"
 	sharedutil "github.com/numaproj/numaflow/pkg/shared/util"
 	"github.com/numaproj/numaflow/pkg/shuffle"
 	"github.com/numaproj/numaflow/pkg/watermark/generic"
 	"github.com/numaproj/numaflow/pkg/watermark/generic/jetstream"
 )
 
+// MapUDFProcessor processes the messages of a map UDF vertex. It can be embedded in a custom binary with a custom
+// Inter-Step Buffer implementation and an in-process map function, see BufferIOBuilder and MapApplier.
 type MapUDFProcessor struct {
 	ISBSvcType     dfv1.ISBSvcType
 	VertexInstance *dfv1.VertexInstance
+	// BufferIOBuilder builds the Inter-Step Buffer IO instead of connecting to the ISB Service of ISBSvcType, optional.
+	BufferIOBuilder BufferIOBuilder
+	// MapApplier applies the map function in process instead of calling the UDF container, optional. It's checked
+	// for the health of the vertex if it implements metrics.HealthChecker. Map streaming is not supported with it.
+	MapApplier applier.MapApplier
 }
 
 func (u *MapUDFProcessor) Start(ctx context.Context) error {
@@ -54,12 +69,6 @@ func (u *MapUDFProcessor) Start(ctx context.Context) error {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
-	natsClientPool, err := jsclient.NewClientPool(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to create a new NATS client pool: %w", err)
-	}
-	defer natsClientPool.CloseAll()
-
 	fromBuffer := u.VertexInstance.Vertex.OwnedBuffers()
 	log = log.With("protocol", "uds-grpc-map-udf")
 
@@ -69,20 +78,42 @@ func (u *MapUDFProcessor) Start(ctx context.Context) error {
 		writers           map[string][]isb.BufferWriter
 		processorManagers map[string]*processor.ProcessorManager
 		wmStores          map[string]store.WatermarkStore
-		mapHandler        *rpc.GRPCBasedMap
+		mapHandler        applier.MapApplier
 		mapStreamHandler  *rpc.GRPCBasedMapStream
+		healthCheckers    []metrics.HealthChecker
+		counterServerDone <-chan struct{}
+		cacheServerDone   <-chan struct{}
+		err               error
 	)
 
 	// watermark variables
 	fetchWatermark, publishWatermark := generic.BuildNoOpWatermarkProgressorsFromBufferList(u.VertexInstance.Vertex.GetToBuffers())
 
-	switch u.ISBSvcType {
-	case dfv1.ISBSvcTypeRedis:
+	switch {
+	case u.BufferIOBuilder != nil:
+		bufferIO, err := u.BufferIOBuilder.Build(ctx, u.VertexInstance)
+		if err != nil {
+			return fmt.Errorf("failed to build the buffer IO, %w", err)
+		}
+		readers, writers = bufferIO.Readers, bufferIO.Writers
+		if bufferIO.FetchWatermark != nil {
+			fetchWatermark = bufferIO.FetchWatermark
+		}
+		if bufferIO.PublishWatermark != nil {
+			publishWatermark = bufferIO.PublishWatermark
+		}
+	case u.ISBSvcType == dfv1.ISBSvcTypeRedis:
 		readers, writers, err = buildRedisBufferIO(ctx, u.VertexInstance)
 		if err != nil {
 			return err
 		}
-	case dfv1.ISBSvcTypeJetStream:
+	case u.ISBSvcType == dfv1.ISBSvcTypeJetStream:
+		natsClientPool, err := jsclient.NewClientPool(ctx)
+		if err != nil {
+			return termination.WithCause(dfv1.RestartCauseISBConnectFailed, fmt.Errorf("failed to create a new NATS client pool: %w", err))
+		}
+		defer natsClientPool.CloseAll()
+		counterServerDone = startCounterServer(ctx, u.VertexInstance, natsClientPool.NextAvailableClient())
 		// build watermark progressors
 		// multiple go routines can share the same set of writers since nats conn is thread safe
 		// https://github.com/nats-io/nats.go/issues/241
@@ -116,6 +147,33 @@ func (u *MapUDFProcessor) Start(ctx context.Context) error {
 	default:
 		return fmt.Errorf("unrecognized isbsvc type %q", u.ISBSvcType)
 	}
+	if x := u.VertexInstance.Vertex.Spec.MessageChecksum; x != nil {
+		readers = checksum.NewVerifyingReaders(ctx, readers, x)
+		writers = checksum.NewChecksummingWriters(writers, x.GetAlgorithm())
+	}
+	if u.VertexInstance.Vertex.Spec.MessageSigning != nil {
+		keyring, err := signing.NewKeyring(ctx, dfv1.PathSigningKeysMount)
+		if err != nil {
+			return fmt.Errorf("failed to load the message signing keys, %w", err)
+		}
+		readers = signing.NewVerifyingReaders(ctx, readers, keyring)
+		writers = signing.NewSigningWriters(writers, keyring)
+	}
+	if x := u.VertexInstance.Vertex.Spec.MessageEncryption; x != nil {
+		encryptor, err := encryption.NewEncryptor(x)
+		if err != nil {
+			return fmt.Errorf("failed to create the message encryptor, %w", err)
+		}
+		readers = encryption.NewDecryptingReaders(ctx, readers, encryptor)
+		writers = encryption.NewEncryptingWriters(writers, encryptor)
+	}
+	readers = ttl.NewExpiringReaders(ctx, readers, u.VertexInstance.Vertex)
+	if readers, err = record.NewRecordingReaders(ctx, readers, u.VertexInstance); err != nil {
+		return fmt.Errorf("failed to create the recording readers, %w", err)
+	}
+	if cacheServerDone, err = startCacheServer(ctx, u.VertexInstance); err != nil {
+		return fmt.Errorf("failed to start the cache server, %w", err)
+	}
 
 	enableMapUdfStream, err := u.VertexInstance.Vertex.MapUdfStreamEnabled()
 	if err != nil {
@@ -123,16 +181,36 @@ func (u *MapUDFProcessor) Start(ctx context.Context) error {
 	}
 
 	maxMessageSize := sharedutil.LookupEnvIntOr(dfv1.EnvGRPCMaxMessageSize, dfv1.DefaultGRPCMaxMessageSize)
-	if enableMapUdfStream {
-		mapStreamClient, err := mapstreamer.New(mapstreamer.WithMaxMessageSize(maxMessageSize))
+	var remoteTLSConfig *tls.Config
+	remote := u.VertexInstance.Vertex.Spec.UDF.Remote
+	if remote != nil {
+		if remoteTLSConfig, err = sharedutil.GetTLSConfig(remote.TLS); err != nil {
+			return fmt.Errorf("failed to get the TLS config of the remote UDF, %w", err)
+		}
+	}
+	if u.MapApplier != nil {
+		if enableMapUdfStream {
+			return fmt.Errorf("map streaming is not supported with an in-process map applier")
+		}
+		mapHandler = u.MapApplier
+		if hc, ok := u.MapApplier.(metrics.HealthChecker); ok {
+			healthCheckers = append(healthCheckers, hc)
+		}
+	} else if enableMapUdfStream {
+		mapStreamOpts := []mapstreamer.Option{mapstreamer.WithMaxMessageSize(maxMessageSize), mapstreamer.WithCallPolicy(sharedutil.LookupGRPCCallPolicyFromEnv())}
+		if remote != nil {
+			mapStreamOpts = append(mapStreamOpts, mapstreamer.WithRemoteEndpoint(remote.Endpoint, remoteTLSConfig))
+		}
+		mapStreamClient, err := mapstreamer.New(mapStreamOpts...)
 		if err != nil {
 			return fmt.Errorf("failed to create map stream client, %w", err)
 		}
 		mapStreamHandler = rpc.NewUDSgRPCBasedMapStream(mapStreamClient)
+		healthCheckers = append(healthCheckers, mapStreamHandler)
 
 		// Readiness check
 		if err := mapStreamHandler.WaitUntilReady(ctx); err != nil {
-			return fmt.Errorf("failed on map stream UDF readiness check, %w", err)
+			return termination.WithCause(dfv1.RestartCauseUDSHandshakeFailed, fmt.Errorf("failed on map stream UDF readiness check, %w", err))
 		}
 		defer func() {
 			err = mapStreamHandler.CloseConn(ctx)
@@ -142,37 +220,55 @@ func (u *MapUDFProcessor) Start(ctx context.Context) error {
 		}()
 
 	} else {
-		mapClient, err := mapper.New(mapper.WithMaxMessageSize(maxMessageSize))
+		poolSize := sharedutil.LookupEnvIntOr(dfv1.EnvMapConnectionPoolSize, 1)
+		if x := u.VertexInstance.Vertex.Spec.Limits; x != nil && x.MapConnectionPoolSize != nil {
+			poolSize = int(*x.MapConnectionPoolSize)
+		}
+		mapOpts := []mapper.Option{mapper.WithMaxMessageSize(maxMessageSize), mapper.WithCallPolicy(sharedutil.LookupGRPCCallPolicyFromEnv()),
+			mapper.WithConnectionPoolSize(poolSize)}
+		if remote != nil {
+			mapOpts = append(mapOpts, mapper.WithRemoteEndpoint(remote.Endpoint, remoteTLSConfig))
+		}
+		mapClient, err := mapper.New(mapOpts...)
 		if err != nil {
 			return fmt.Errorf("failed to create map client, %w", err)
 		}
-		mapHandler = rpc.NewUDSgRPCBasedMap(mapClient)
+		grpcMapHandler := rpc.NewUDSgRPCBasedMap(mapClient)
+		mapHandler = grpcMapHandler
+		healthCheckers = append(healthCheckers, grpcMapHandler)
 
 		// Readiness check
-		if err := mapHandler.WaitUntilReady(ctx); err != nil {
-			return fmt.Errorf("failed on map UDF readiness check, %w", err)
+		if err := grpcMapHandler.WaitUntilReady(ctx); err != nil {
+			return termination.WithCause(dfv1.RestartCauseUDSHandshakeFailed, fmt.Errorf("failed on map UDF readiness check, %w", err))
 		}
 		defer func() {
-			err = mapHandler.CloseConn(ctx)
+			err = grpcMapHandler.CloseConn(ctx)
 			if err != nil {
 				log.Warnw("Failed to close gRPC client conn", zap.Error(err))
 			}
 		}()
 	}
 
+	// the degradation state of the pipeline is shared by the partitions
+	degradation := forward.NewDegradationWatcher(u.VertexInstance.Vertex)
+	if degradation != nil {
+		go degradation.Start(ctx)
+	}
+
 	for index, bufferPartition := range fromBuffer {
 		// Populate shuffle function map
 		shuffleFuncMap := make(map[string]*shuffle.Shuffle)
 		for _, edge := range u.VertexInstance.Vertex.Spec.ToEdges {
 			if edge.ToVertexType == dfv1.VertexTypeReduceUDF && edge.GetToVertexPartitionCount() > 1 {
-				s := shuffle.NewShuffle(edge.To, edge.GetToVertexPartitionCount())
+				s := shuffle.NewShuffle(edge.To, edge.GetToVertexPartitionCount(), shuffle.WithStrategy(edge.Shuffle))
 				shuffleFuncMap[fmt.Sprintf("%s:%s", edge.From, edge.To)] = s
 			}
 		}
 
 		// create a conditional forwarder for each partition
 		getVertexPartitionIdx := GetPartitionedBufferIdx()
-		conditionalForwarder := forward.GoWhere(func(keys []string, tags []string) ([]forward.VertexBuffer, error) {
+		edgeRouter := forward.NewEdgeRouter(u.VertexInstance.Vertex.Spec.ToEdges, degradation)
+		conditionalForwarder := forward.GoWhere(func(keys []string, tags []string, schemaVersion string, headers map[string]string) ([]forward.VertexBuffer, error) {
 			var result []forward.VertexBuffer
 
 			if sharedutil.StringSliceContains(tags, dfv1.MessageTagDrop) {
@@ -180,10 +276,13 @@ func (u *MapUDFProcessor) Start(ctx context.Context) error {
 			}
 
 			for _, edge := range u.VertexInstance.Vertex.Spec.ToEdges {
+				if !edge.Conditions.MatchSchemaVersion(schemaVersion) {
+					continue
+				}
 				// If returned tags is not "DROP", and there's no conditions defined in the edge, treat it as "ALL"?
 				if edge.Conditions == nil || edge.Conditions.Tags == nil || len(edge.Conditions.Tags.Values) == 0 {
 					if edge.ToVertexType == dfv1.VertexTypeReduceUDF && edge.GetToVertexPartitionCount() > 1 { // Need to shuffle
-						toVertexPartition := shuffleFuncMap[fmt.Sprintf("%s:%s", edge.From, edge.To)].Shuffle(keys)
+						toVertexPartition := shuffleFuncMap[fmt.Sprintf("%s:%s", edge.From, edge.To)].Shuffle(keys, headers)
 						result = append(result, forward.VertexBuffer{
 							ToVertexName:         edge.To,
 							ToVertexPartitionIdx: toVertexPartition,
@@ -197,7 +296,7 @@ func (u *MapUDFProcessor) Start(ctx context.Context) error {
 				} else {
 					if sharedutil.CompareSlice(edge.Conditions.Tags.GetOperator(), tags, edge.Conditions.Tags.Values) {
 						if edge.ToVertexType == dfv1.VertexTypeReduceUDF && edge.GetToVertexPartitionCount() > 1 { // Need to shuffle
-							toVertexPartition := shuffleFuncMap[fmt.Sprintf("%s:%s", edge.From, edge.To)].Shuffle(keys)
+							toVertexPartition := shuffleFuncMap[fmt.Sprintf("%s:%s", edge.From, edge.To)].Shuffle(keys, headers)
 							result = append(result, forward.VertexBuffer{
 								ToVertexName:         edge.To,
 								ToVertexPartitionIdx: toVertexPartition,
@@ -211,7 +310,7 @@ func (u *MapUDFProcessor) Start(ctx context.Context) error {
 					}
 				}
 			}
-			return result, nil
+			return edgeRouter.Route(result), nil
 		})
 
 		opts := []forward.Option{forward.WithVertexType(dfv1.VertexTypeMapUDF), forward.WithLogger(log),
@@ -221,6 +320,9 @@ func (u *MapUDFProcessor) Start(ctx context.Context) error {
 				opts = append(opts, forward.WithReadBatchSize(int64(*x.ReadBatchSize)))
 				opts = append(opts, forward.WithUDFConcurrency(int(*x.ReadBatchSize)))
 			}
+			if x.UDFConcurrency != nil {
+				opts = append(opts, forward.WithUDFConcurrency(int(*x.UDFConcurrency)))
+			}
 		}
 		// create a forwarder for each partition
 		forwarder, err := forward.NewInterStepDataForward(u.VertexInstance.Vertex, readers[index], writers, conditionalForwarder, mapHandler, mapStreamHandler, fetchWatermark, publishWatermark, opts...)
@@ -254,13 +356,7 @@ func (u *MapUDFProcessor) Start(ctx context.Context) error {
 		}(bufferPartition, forwarder)
 	}
 
-	var metricsOpts []metrics.Option
-	if enableMapUdfStream {
-		metricsOpts = metrics.NewMetricsOptions(ctx, u.VertexInstance.Vertex, []metrics.HealthChecker{mapStreamHandler}, readers)
-	} else {
-		metricsOpts = metrics.NewMetricsOptions(ctx, u.VertexInstance.Vertex, []metrics.HealthChecker{mapHandler}, readers)
-
-	}
+	metricsOpts := metrics.NewMetricsOptions(ctx, u.VertexInstance.Vertex, healthCheckers, readers)
 	ms := metrics.NewMetricsServer(u.VertexInstance.Vertex, metricsOpts...)
 	if shutdown, err := ms.Start(ctx); err != nil {
 		return fmt.Errorf("failed to start metrics server, error: %w", err)
@@ -269,6 +365,11 @@ func (u *MapUDFProcessor) Start(ctx context.Context) error {
 	}
 	// wait for all the forwarders to exit
 	finalWg.Wait()
+	cancel()
+	if counterServerDone != nil {
+		<-counterServerDone
+	}
+	<-cacheServerDone
 
 	// stop the processor managers, it will stop watching heartbeat and offset timeline updates
 	for _, pm := range processorManagers {
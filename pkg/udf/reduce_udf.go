@@ -23,6 +23,7 @@ import (
 	"sync"
 
 	"github.com/numaproj/numaflow/pkg/sdkclient/reducer"
+	windowerclient "github.com/numaproj/numaflow/pkg/sdkclient/windower"
 	jsclient "github.com/numaproj/numaflow/pkg/shared/clients/nats"
 	"github.com/numaproj/numaflow/pkg/udf/rpc"
 	"github.com/numaproj/numaflow/pkg/watermark/fetch"
@@ -34,18 +35,28 @@ import (
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/forward"
 	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/isb/checksum"
+	"github.com/numaproj/numaflow/pkg/isb/encryption"
+	"github.com/numaproj/numaflow/pkg/isb/record"
+	"github.com/numaproj/numaflow/pkg/isb/signing"
+	"github.com/numaproj/numaflow/pkg/isb/ttl"
 	"github.com/numaproj/numaflow/pkg/metrics"
 	"github.com/numaproj/numaflow/pkg/reduce"
+	"github.com/numaproj/numaflow/pkg/reduce/applier"
+	"github.com/numaproj/numaflow/pkg/reduce/join"
 	"github.com/numaproj/numaflow/pkg/reduce/pbq"
 	"github.com/numaproj/numaflow/pkg/reduce/pbq/store/wal"
 	"github.com/numaproj/numaflow/pkg/reduce/pnf"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/shared/termination"
 	sharedutil "github.com/numaproj/numaflow/pkg/shared/util"
 	"github.com/numaproj/numaflow/pkg/shuffle"
 	"github.com/numaproj/numaflow/pkg/watermark/generic"
 	"github.com/numaproj/numaflow/pkg/watermark/generic/jetstream"
 	"github.com/numaproj/numaflow/pkg/watermark/wmb"
 	"github.com/numaproj/numaflow/pkg/window"
+	"github.com/numaproj/numaflow/pkg/window/strategy/accumulator"
+	"github.com/numaproj/numaflow/pkg/window/strategy/custom"
 	"github.com/numaproj/numaflow/pkg/window/strategy/fixed"
 	"github.com/numaproj/numaflow/pkg/window/strategy/sliding"
 )
@@ -65,6 +76,8 @@ func (u *ReduceUDFProcessor) Start(ctx context.Context) error {
 		windower          window.Windower
 		processorManagers map[string]*processor.ProcessorManager
 		wmStores          map[string]store.WatermarkStore
+		counterServerDone <-chan struct{}
+		cacheServerDone   <-chan struct{}
 	)
 
 	log := logging.FromContext(ctx)
@@ -73,20 +86,27 @@ func (u *ReduceUDFProcessor) Start(ctx context.Context) error {
 
 	natsClientPool, err = jsclient.NewClientPool(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to create a new NATS client pool: %w", err)
+		return termination.WithCause(dfv1.RestartCauseISBConnectFailed, fmt.Errorf("failed to create a new NATS client pool: %w", err))
 	}
 	defer natsClientPool.CloseAll()
 
 	f := u.VertexInstance.Vertex.Spec.UDF.GroupBy.Window.Fixed
 	s := u.VertexInstance.Vertex.Spec.UDF.GroupBy.Window.Sliding
+	c := u.VertexInstance.Vertex.Spec.UDF.GroupBy.Window.Custom
+	a := u.VertexInstance.Vertex.Spec.UDF.GroupBy.Window.Accumulator
+	var accumulatorWindower *accumulator.Windower
 
 	if f != nil {
 		windower = fixed.NewFixed(f.Length.Duration)
 	} else if s != nil {
 		windower = sliding.NewSliding(s.Length.Duration, s.Slide.Duration)
+	} else if a != nil {
+		accumulatorWindower = accumulator.NewWindower(ctx, a.GetCount(), a.GetTimeout())
+		windower = accumulatorWindower
 	}
 
-	if windower == nil {
+	// custom windower is created after the readiness check of the reduce udf
+	if windower == nil && c == nil {
 		return fmt.Errorf("invalid window spec")
 	}
 
@@ -111,6 +131,7 @@ func (u *ReduceUDFProcessor) Start(ctx context.Context) error {
 			return err
 		}
 	case dfv1.ISBSvcTypeJetStream:
+		counterServerDone = startCounterServer(ctx, u.VertexInstance, natsClientPool.NextAvailableClient())
 		// build watermark progressors
 		if u.VertexInstance.Vertex.Spec.Watermark.Disabled {
 			names := u.VertexInstance.Vertex.GetToBuffers()
@@ -142,27 +163,62 @@ func (u *ReduceUDFProcessor) Start(ctx context.Context) error {
 	default:
 		return fmt.Errorf("unrecognized isbsvc type %q", u.ISBSvcType)
 	}
+	if x := u.VertexInstance.Vertex.Spec.MessageChecksum; x != nil {
+		readers = checksum.NewVerifyingReaders(ctx, readers, x)
+		writers = checksum.NewChecksummingWriters(writers, x.GetAlgorithm())
+	}
+	if u.VertexInstance.Vertex.Spec.MessageSigning != nil {
+		keyring, err := signing.NewKeyring(ctx, dfv1.PathSigningKeysMount)
+		if err != nil {
+			return fmt.Errorf("failed to load the message signing keys, %w", err)
+		}
+		readers = signing.NewVerifyingReaders(ctx, readers, keyring)
+		writers = signing.NewSigningWriters(writers, keyring)
+	}
+	if x := u.VertexInstance.Vertex.Spec.MessageEncryption; x != nil {
+		encryptor, err := encryption.NewEncryptor(x)
+		if err != nil {
+			return fmt.Errorf("failed to create the message encryptor, %w", err)
+		}
+		readers = encryption.NewDecryptingReaders(ctx, readers, encryptor)
+		writers = encryption.NewEncryptingWriters(writers, encryptor)
+	}
+	readers = ttl.NewExpiringReaders(ctx, readers, u.VertexInstance.Vertex)
+	if readers, err = record.NewRecordingReaders(ctx, readers, u.VertexInstance); err != nil {
+		return fmt.Errorf("failed to create the recording readers, %w", err)
+	}
+	if cacheServerDone, err = startCacheServer(ctx, u.VertexInstance); err != nil {
+		return fmt.Errorf("failed to start the cache server, %w", err)
+	}
 
 	// Populate shuffle function map
 	shuffleFuncMap := make(map[string]*shuffle.Shuffle)
 	for _, edge := range u.VertexInstance.Vertex.Spec.ToEdges {
 		if edge.ToVertexType == dfv1.VertexTypeReduceUDF && edge.GetToVertexPartitionCount() > 1 {
-			s := shuffle.NewShuffle(edge.To, edge.GetToVertexPartitionCount())
+			s := shuffle.NewShuffle(edge.To, edge.GetToVertexPartitionCount(), shuffle.WithStrategy(edge.Shuffle))
 			shuffleFuncMap[fmt.Sprintf("%s:%s", edge.From, edge.To)] = s
 		}
 	}
 	getVertexPartition := GetPartitionedBufferIdx()
-	conditionalForwarder := forward.GoWhere(func(keys []string, tags []string) ([]forward.VertexBuffer, error) {
+	degradation := forward.NewDegradationWatcher(u.VertexInstance.Vertex)
+	if degradation != nil {
+		go degradation.Start(ctx)
+	}
+	edgeRouter := forward.NewEdgeRouter(u.VertexInstance.Vertex.Spec.ToEdges, degradation)
+	conditionalForwarder := forward.GoWhere(func(keys []string, tags []string, schemaVersion string, headers map[string]string) ([]forward.VertexBuffer, error) {
 		var result []forward.VertexBuffer
 		if sharedutil.StringSliceContains(tags, dfv1.MessageTagDrop) {
 			return result, nil
 		}
 
 		for _, edge := range u.VertexInstance.Vertex.Spec.ToEdges {
+			if !edge.Conditions.MatchSchemaVersion(schemaVersion) {
+				continue
+			}
 			// If returned tags is not "DROP", and there's no conditions defined in the edge, treat it as "ALL"?
 			if edge.Conditions == nil || edge.Conditions.Tags == nil || len(edge.Conditions.Tags.Values) == 0 {
 				if edge.ToVertexType == dfv1.VertexTypeReduceUDF && edge.GetToVertexPartitionCount() > 1 { // Need to shuffle
-					toVertexPartition := shuffleFuncMap[fmt.Sprintf("%s:%s", edge.From, edge.To)].Shuffle(keys)
+					toVertexPartition := shuffleFuncMap[fmt.Sprintf("%s:%s", edge.From, edge.To)].Shuffle(keys, headers)
 					result = append(result, forward.VertexBuffer{
 						ToVertexName:         edge.To,
 						ToVertexPartitionIdx: toVertexPartition,
@@ -176,7 +232,7 @@ func (u *ReduceUDFProcessor) Start(ctx context.Context) error {
 			} else {
 				if sharedutil.CompareSlice(edge.Conditions.Tags.GetOperator(), tags, edge.Conditions.Tags.Values) {
 					if edge.ToVertexType == dfv1.VertexTypeReduceUDF && edge.GetToVertexPartitionCount() > 1 { // Need to shuffle
-						toVertexPartition := shuffleFuncMap[fmt.Sprintf("%s:%s", edge.From, edge.To)].Shuffle(keys)
+						toVertexPartition := shuffleFuncMap[fmt.Sprintf("%s:%s", edge.From, edge.To)].Shuffle(keys, headers)
 						result = append(result, forward.VertexBuffer{
 							ToVertexName:         edge.To,
 							ToVertexPartitionIdx: toVertexPartition,
@@ -191,35 +247,76 @@ func (u *ReduceUDFProcessor) Start(ctx context.Context) error {
 			}
 		}
 
-		return result, nil
+		return edgeRouter.Route(result), nil
 	})
 
-	log = log.With("protocol", "uds-grpc-reduce-udf")
-
+	var (
+		reduceApplier  applier.ReduceApplier
+		healthCheckers []metrics.HealthChecker
+	)
 	maxMessageSize := sharedutil.LookupEnvIntOr(dfv1.EnvGRPCMaxMessageSize, dfv1.DefaultGRPCMaxMessageSize)
-	sdkClient, err := reducer.New(reducer.WithMaxMessageSize(maxMessageSize))
-	if err != nil {
-		return fmt.Errorf("failed to create a new gRPC client: %w", err)
-	}
+	if j := u.VertexInstance.Vertex.Spec.UDF.GroupBy.Join; j != nil {
+		// the join is processed in the main container, there's no user defined container
+		fromVertices := u.VertexInstance.Vertex.GetFromVertexNames()
+		if len(fromVertices) != 2 {
+			return fmt.Errorf("a join requires exactly 2 inbound vertices, got %d", len(fromVertices))
+		}
+		log = log.With("join", string(j.GetType()))
+		reduceApplier = join.NewJoiner(*j, fromVertices[0], fromVertices[1])
+	} else {
+		log = log.With("protocol", "uds-grpc-reduce-udf")
+		sdkClient, err := reducer.New(reducer.WithMaxMessageSize(maxMessageSize), reducer.WithCallPolicy(sharedutil.LookupGRPCCallPolicyFromEnv()))
+		if err != nil {
+			return fmt.Errorf("failed to create a new gRPC client: %w", err)
+		}
 
-	reduceHandler := rpc.NewUDSgRPCBasedReduce(sdkClient)
-	if err != nil {
-		return fmt.Errorf("failed to create gRPC client, %w", err)
+		reduceHandler := rpc.NewUDSgRPCBasedReduce(sdkClient)
+		// Readiness check
+		if err := reduceHandler.WaitUntilReady(ctx); err != nil {
+			return termination.WithCause(dfv1.RestartCauseUDSHandshakeFailed, fmt.Errorf("failed on FIXED_AGGREGATION readiness check, %w", err))
+		}
+		defer func() {
+			err = reduceHandler.CloseConn(ctx)
+			if err != nil {
+				log.Warnw("Failed to close gRPC client conn", zap.Error(err))
+			}
+		}()
+		reduceApplier = reduceHandler
+		healthCheckers = append(healthCheckers, reduceHandler)
 	}
-	// Readiness check
-	if err := reduceHandler.WaitUntilReady(ctx); err != nil {
-		return fmt.Errorf("failed on FIXED_AGGREGATION readiness check, %w", err)
+
+	if c != nil {
+		windowerClient, err := windowerclient.New(windowerclient.WithMaxMessageSize(maxMessageSize), windowerclient.WithCallPolicy(sharedutil.LookupGRPCCallPolicyFromEnv()))
+		if err != nil {
+			return fmt.Errorf("failed to create a new windower gRPC client: %w", err)
+		}
+		customWindower := custom.NewWindower(ctx, windowerClient, c.GetMaxLength())
+		if err := customWindower.WaitUntilReady(ctx); err != nil {
+			return termination.WithCause(dfv1.RestartCauseUDSHandshakeFailed, fmt.Errorf("failed on custom windower readiness check, %w", err))
+		}
+		defer func() {
+			if err := customWindower.CloseConn(ctx); err != nil {
+				log.Warnw("Failed to close windower gRPC client conn", zap.Error(err))
+			}
+		}()
+		windower = customWindower
 	}
-	defer func() {
-		err = reduceHandler.CloseConn(ctx)
+	if a != nil && a.Flush {
+		triggerClient, err := reducer.NewTriggerClient(reducer.WithMaxMessageSize(maxMessageSize), reducer.WithCallPolicy(sharedutil.LookupGRPCCallPolicyFromEnv()))
 		if err != nil {
-			log.Warnw("Failed to close gRPC client conn", zap.Error(err))
+			return fmt.Errorf("failed to create a new trigger gRPC client: %w", err)
 		}
-	}()
+		defer func() {
+			if err := triggerClient.CloseConn(ctx); err != nil {
+				log.Warnw("Failed to close trigger gRPC client conn", zap.Error(err))
+			}
+		}()
+		go accumulatorWindower.WatchFlushes(ctx, triggerClient)
+	}
 	log.Infow("Start processing reduce udf messages", zap.String("isbsvc", string(u.ISBSvcType)), zap.String("from", fromBuffer))
 
 	// start metrics server
-	metricsOpts := metrics.NewMetricsOptions(ctx, u.VertexInstance.Vertex, []metrics.HealthChecker{reduceHandler}, readers)
+	metricsOpts := metrics.NewMetricsOptions(ctx, u.VertexInstance.Vertex, healthCheckers, readers)
 	ms := metrics.NewMetricsServer(u.VertexInstance.Vertex, metricsOpts...)
 	if shutdown, err := ms.Start(ctx); err != nil {
 		return fmt.Errorf("failed to start metrics server, error: %w", err)
@@ -246,7 +343,7 @@ func (u *ReduceUDFProcessor) Start(ctx context.Context) error {
 	}
 	idleManager := wmb.NewIdleManager(len(writers))
 
-	op := pnf.NewOrderedProcessor(ctx, u.VertexInstance, reduceHandler, writers, pbqManager, conditionalForwarder, publishWatermark, idleManager)
+	op := pnf.NewOrderedProcessor(ctx, u.VertexInstance, reduceApplier, writers, pbqManager, conditionalForwarder, publishWatermark, idleManager)
 
 	// for reduce, we read only from one partition
 	dataForwarder, err := reduce.NewDataForward(ctx, u.VertexInstance, readers[0], writers, pbqManager, conditionalForwarder, fetchWatermark, publishWatermark, windower, idleManager, op, opts...)
@@ -273,6 +370,10 @@ func (u *ReduceUDFProcessor) Start(ctx context.Context) error {
 
 	log.Info("SIGTERM, exiting...")
 	wg.Wait()
+	if counterServerDone != nil {
+		<-counterServerDone
+	}
+	<-cacheServerDone
 
 	// stop the processor managers, it will stop watching heartbeat and offset timeline updates
 	for _, pm := range processorManagers {
@@ -0,0 +1,196 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package replay feeds the input messages recorded from a vertex back through a map UDF, batch by batch in the order
+// they were read, to reproduce the problems only appearing with the production inputs and interleavings.
+package replay
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/numaproj/numaflow/pkg/forward/applier"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/isb/record"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/shared/objectstore"
+)
+
+// Result is the result of replaying a recorded message, written as a line of JSON.
+type Result struct {
+	// Recording is the key prefix of the objects recorded from a buffer partition by a replica.
+	Recording string    `json:"recording"`
+	Batch     int       `json:"batch"`
+	Offset    string    `json:"offset"`
+	ID        string    `json:"id"`
+	Keys      []string  `json:"keys,omitempty"`
+	EventTime time.Time `json:"eventTime"`
+	Outputs   []Output  `json:"outputs,omitempty"`
+	Error     string    `json:"error,omitempty"`
+}
+
+// Output is a message returned by the UDF.
+type Output struct {
+	Keys    []string `json:"keys,omitempty"`
+	Tags    []string `json:"tags,omitempty"`
+	Payload string   `json:"payload"`
+}
+
+// Summary summarizes a replay.
+type Summary struct {
+	Recordings int
+	Batches    int
+	Messages   int
+	Errors     int
+}
+
+// Replayer replays the recordings under a prefix.
+type Replayer struct {
+	store   objectstore.Store
+	prefix  string
+	applier applier.MapApplier
+	out     io.Writer
+	// sequential applies the messages of a batch one by one, instead of concurrently as the map forwarder does
+	sequential bool
+	log        *zap.SugaredLogger
+}
+
+// Option to configure a Replayer.
+type Option func(*Replayer)
+
+// WithSequential applies the messages of a batch one by one, instead of concurrently as the map forwarder does.
+func WithSequential(sequential bool) Option {
+	return func(r *Replayer) {
+		r.sequential = sequential
+	}
+}
+
+// NewReplayer returns a Replayer applying the recordings under the prefix with the map applier, the results are
+// written to out.
+func NewReplayer(ctx context.Context, store objectstore.Store, prefix string, mapApplier applier.MapApplier, out io.Writer, opts ...Option) *Replayer {
+	r := &Replayer{
+		store:   store,
+		prefix:  prefix,
+		applier: mapApplier,
+		out:     out,
+		log:     logging.FromContext(ctx),
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// Run replays the recordings one after another, in the lexical order of the key prefixes. The objects of a recording
+// are replayed in the order they were recorded.
+func (r *Replayer) Run(ctx context.Context) (*Summary, error) {
+	keys, err := r.store.List(ctx, r.prefix)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the recording objects, %w", err)
+	}
+	var recordings []string
+	objects := make(map[string][]string)
+	for _, key := range keys {
+		if path.Ext(key) != ".rec" {
+			continue
+		}
+		dir := path.Dir(key)
+		if _, ok := objects[dir]; !ok {
+			recordings = append(recordings, dir)
+		}
+		objects[dir] = append(objects[dir], key)
+	}
+	if len(recordings) == 0 {
+		return nil, fmt.Errorf("no recording found with the prefix %q", r.prefix)
+	}
+	summary := &Summary{Recordings: len(recordings)}
+	encoder := json.NewEncoder(r.out)
+	for _, recording := range recordings {
+		r.log.Infow("Replaying the recording", zap.String("recording", recording), zap.Int("objects", len(objects[recording])))
+		batchIdx := 0
+		for i, key := range objects[recording] {
+			if expected := fmt.Sprintf("%s/%06d.rec", recording, i); key != expected {
+				return nil, fmt.Errorf("the recording %q is incomplete, expecting %q but got %q", recording, expected, key)
+			}
+			data, err := r.store.Get(ctx, key)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read the recording object %q, %w", key, err)
+			}
+			batches, err := record.Decode(data)
+			if err != nil {
+				return nil, fmt.Errorf("failed to decode the recording object %q, %w", key, err)
+			}
+			for _, batch := range batches {
+				for _, result := range r.applyBatch(ctx, batch) {
+					result.Recording = recording
+					result.Batch = batchIdx
+					if result.Error != "" {
+						summary.Errors++
+					}
+					summary.Messages++
+					if err := encoder.Encode(result); err != nil {
+						return nil, fmt.Errorf("failed to write the result, %w", err)
+					}
+				}
+				batchIdx++
+				summary.Batches++
+			}
+		}
+	}
+	return summary, nil
+}
+
+// applyBatch applies the data messages of a batch, the results are returned in the order of the messages.
+func (r *Replayer) applyBatch(ctx context.Context, batch record.Batch) []*Result {
+	var results []*Result
+	var wg sync.WaitGroup
+	for _, m := range batch.Messages {
+		if m.Kind != isb.Data {
+			continue
+		}
+		result := &Result{Offset: m.ReadOffset.String(), ID: m.ID, Keys: m.Keys, EventTime: m.EventTime}
+		results = append(results, result)
+		if r.sequential {
+			r.apply(ctx, m, result)
+			continue
+		}
+		wg.Add(1)
+		go func(m *isb.ReadMessage) {
+			defer wg.Done()
+			r.apply(ctx, m, result)
+		}(m)
+	}
+	wg.Wait()
+	return results
+}
+
+func (r *Replayer) apply(ctx context.Context, m *isb.ReadMessage, result *Result) {
+	outputs, err := r.applier.ApplyMap(ctx, m)
+	if err != nil {
+		result.Error = err.Error()
+		return
+	}
+	for _, o := range outputs {
+		result.Outputs = append(result.Outputs, Output{Keys: o.Keys, Tags: o.Tags, Payload: string(o.Payload)})
+	}
+}
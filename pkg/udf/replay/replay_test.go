@@ -0,0 +1,104 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package replay
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/numaproj/numaflow/pkg/forward/applier"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/isb/record"
+	"github.com/numaproj/numaflow/pkg/isb/testutils"
+	"github.com/numaproj/numaflow/pkg/shared/objectstore"
+)
+
+// upperCase returns the payload in upper case, or an error for the payloads with "bad".
+var upperCase = applier.ApplyMapFunc(func(_ context.Context, m *isb.ReadMessage) ([]*isb.WriteMessage, error) {
+	if strings.Contains(string(m.Payload), "bad") {
+		return nil, fmt.Errorf("bad payload")
+	}
+	return []*isb.WriteMessage{{
+		Message: isb.Message{Header: isb.Header{Keys: m.Keys}, Body: isb.Body{Payload: bytes.ToUpper(m.Payload)}},
+		Tags:    []string{"t"},
+	}}, nil
+})
+
+func writeRecording(t *testing.T, store objectstore.Store, key string, payloads ...string) {
+	messages := testutils.BuildTestReadMessages(int64(len(payloads)), time.Unix(1636470000, 0))
+	batch := record.Batch{ReadAt: time.Now()}
+	for i := range messages {
+		messages[i].Payload = []byte(payloads[i])
+		batch.Messages = append(batch.Messages, &messages[i])
+	}
+	data, err := record.Encode(batch)
+	assert.NoError(t, err)
+	assert.NoError(t, store.Put(context.Background(), key, data))
+}
+
+func TestReplayer_Run(t *testing.T) {
+	ctx := context.Background()
+	store := objectstore.NewFileStore(t.TempDir())
+	writeRecording(t, store, "ns/pl/v/0/20231001T000000Z/ns-pl-v-0/000000.rec", "a", "bad")
+	writeRecording(t, store, "ns/pl/v/0/20231001T000000Z/ns-pl-v-0/000001.rec", "c")
+	writeRecording(t, store, "ns/pl/v/1/20231001T000000Z/ns-pl-v-0/000000.rec", "d")
+
+	for _, sequential := range []bool{true, false} {
+		out := &bytes.Buffer{}
+		summary, err := NewReplayer(ctx, store, "ns/pl/v/", upperCase, out, WithSequential(sequential)).Run(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, &Summary{Recordings: 2, Batches: 3, Messages: 4, Errors: 1}, summary)
+
+		var results []Result
+		decoder := json.NewDecoder(out)
+		for decoder.More() {
+			var r Result
+			assert.NoError(t, decoder.Decode(&r))
+			results = append(results, r)
+		}
+		assert.Len(t, results, 4)
+		assert.Equal(t, "ns/pl/v/0/20231001T000000Z/ns-pl-v-0", results[0].Recording)
+		assert.Equal(t, "A", results[0].Outputs[0].Payload)
+		assert.Equal(t, []string{"t"}, results[0].Outputs[0].Tags)
+		assert.Equal(t, "bad payload", results[1].Error)
+		assert.Equal(t, 0, results[1].Batch)
+		assert.Equal(t, "C", results[2].Outputs[0].Payload)
+		assert.Equal(t, 1, results[2].Batch)
+		assert.Equal(t, "ns/pl/v/1/20231001T000000Z/ns-pl-v-0", results[3].Recording)
+		assert.Equal(t, "D", results[3].Outputs[0].Payload)
+		assert.Equal(t, 0, results[3].Batch)
+	}
+}
+
+func TestReplayer_Run_incomplete(t *testing.T) {
+	ctx := context.Background()
+	store := objectstore.NewFileStore(t.TempDir())
+	_, err := NewReplayer(ctx, store, "", upperCase, &bytes.Buffer{}).Run(ctx)
+	assert.Error(t, err)
+
+	writeRecording(t, store, "rec/000000.rec", "a")
+	writeRecording(t, store, "rec/000002.rec", "c")
+	_, err = NewReplayer(ctx, store, "", upperCase, &bytes.Buffer{}).Run(ctx)
+	assert.ErrorContains(t, err, "incomplete")
+}
@@ -26,9 +26,11 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"k8s.io/apimachinery/pkg/util/wait"
 
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/isb"
 	sdkerr "github.com/numaproj/numaflow/pkg/sdkclient/error"
 	"github.com/numaproj/numaflow/pkg/sdkclient/mapper"
+	"github.com/numaproj/numaflow/pkg/shared/errorlog"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 )
 
@@ -59,11 +61,15 @@ func (u *GRPCBasedMap) WaitUntilReady(ctx context.Context) error {
 		case <-ctx.Done():
 			return fmt.Errorf("failed on readiness check: %w", ctx.Err())
 		default:
-			if _, err := u.client.IsReady(ctx, &emptypb.Empty{}); err == nil {
-				return nil
-			} else {
+			// A remote udf might be reachable but not ready yet, e.g. when it's still loading a model.
+			if ready, err := u.client.IsReady(ctx, &emptypb.Empty{}); err != nil {
 				log.Infof("waiting for map udf to be ready: %v", err)
 				time.Sleep(1 * time.Second)
+			} else if !ready {
+				log.Info("waiting for map udf to be ready: not ready")
+				time.Sleep(1 * time.Second)
+			} else {
+				return nil
 			}
 		}
 	}
@@ -109,6 +115,7 @@ func (u *GRPCBasedMap) ApplyMap(ctx context.Context, readMessage *isb.ReadMessag
 				return true, nil
 			})
 			if !success {
+				errorlog.Record(dfv1.CtrUdf, err, readMessage.ID, payload)
 				return nil, ApplyUDFErr{
 					UserUDFErr: false,
 					Message:    fmt.Sprintf("gRPC client.SourceTransformFn failed, %s", err),
@@ -119,6 +126,7 @@ func (u *GRPCBasedMap) ApplyMap(ctx context.Context, readMessage *isb.ReadMessag
 				}
 			}
 		case sdkerr.NonRetryable:
+			errorlog.Record(dfv1.CtrUdf, err, readMessage.ID, payload)
 			return nil, ApplyUDFErr{
 				UserUDFErr: false,
 				Message:    fmt.Sprintf("gRPC client.SourceTransformFn failed, %s", err),
@@ -128,6 +136,7 @@ func (u *GRPCBasedMap) ApplyMap(ctx context.Context, readMessage *isb.ReadMessag
 				},
 			}
 		default:
+			errorlog.Record(dfv1.CtrUdf, err, readMessage.ID, payload)
 			return nil, ApplyUDFErr{
 				UserUDFErr: false,
 				Message:    fmt.Sprintf("gRPC client.SourceTransformFn failed, %s", err),
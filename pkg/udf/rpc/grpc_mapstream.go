@@ -26,8 +26,10 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/isb"
 	"github.com/numaproj/numaflow/pkg/sdkclient/mapstreamer"
+	"github.com/numaproj/numaflow/pkg/shared/errorlog"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 )
 
@@ -58,11 +60,15 @@ func (u *GRPCBasedMapStream) WaitUntilReady(ctx context.Context) error {
 		case <-ctx.Done():
 			return fmt.Errorf("failed on readiness check: %w", ctx.Err())
 		default:
-			if _, err := u.client.IsReady(ctx, &emptypb.Empty{}); err == nil {
-				return nil
-			} else {
+			// A remote udf might be reachable but not ready yet, e.g. when it's still loading a model.
+			if ready, err := u.client.IsReady(ctx, &emptypb.Empty{}); err != nil {
 				log.Infof("waiting for map stream udf to be ready: %v", err)
 				time.Sleep(1 * time.Second)
+			} else if !ready {
+				log.Info("waiting for map stream udf to be ready: not ready")
+				time.Sleep(1 * time.Second)
+			} else {
+				return nil
 			}
 		}
 	}
@@ -88,6 +94,7 @@ func (u *GRPCBasedMapStream) ApplyMapStream(ctx context.Context, message *isb.Re
 	errs.Go(func() error {
 		err := u.client.MapStreamFn(ctx, d, responseCh)
 		if err != nil {
+			errorlog.Record(dfv1.CtrUdf, err, message.ID, payload)
 			err = ApplyUDFErr{
 				UserUDFErr: false,
 				Message:    fmt.Sprintf("gRPC client.MapStreamFn failed, %s", err),
@@ -28,11 +28,14 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/isb"
 	"github.com/numaproj/numaflow/pkg/reduce/pbq/partition"
 	sdkerr "github.com/numaproj/numaflow/pkg/sdkclient/error"
 	"github.com/numaproj/numaflow/pkg/sdkclient/reducer"
+	"github.com/numaproj/numaflow/pkg/shared/errorlog"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/watermark/wmb"
 )
 
 // GRPCBasedReduce is a reduce applier that uses gRPC client to invoke the reduce UDF. It implements the applier.ReduceApplier interface.
@@ -141,6 +144,7 @@ func (u *GRPCBasedReduce) ApplyReduce(ctx context.Context, partitionID *partitio
 		select {
 		case err = <-errCh:
 			if err != nil {
+				errorlog.Record(dfv1.CtrUdf, err, partitionID.String(), nil)
 				return nil, convertToUdfError(err)
 			}
 		case result = <-responseCh:
@@ -151,7 +155,7 @@ func (u *GRPCBasedReduce) ApplyReduce(ctx context.Context, partitionID *partitio
 					Message: isb.Message{
 						Header: isb.Header{
 							MessageInfo: isb.MessageInfo{
-								EventTime: partitionID.End.Add(-1 * time.Millisecond),
+								EventTime: partitionID.End.Add(-wmb.Precision()),
 								IsLate:    false,
 							},
 							Keys: keys,
@@ -27,7 +27,6 @@ import (
 	"math"
 	"strings"
 	"sync"
-	"time"
 
 	"go.uber.org/zap"
 
@@ -113,7 +112,7 @@ func (e *edgeFetcher) updateWatermark(inputOffset isb.Offset, fromPartitionIdx i
 	e.Unlock()
 
 	e.log.Debugf("%s processed watermark for offset %d: %+v", debugString.String(), offset, epoch)
-	return wmb.Watermark(time.UnixMilli(epoch))
+	return wmb.Watermark(wmb.FromEpoch(epoch))
 }
 
 // ComputeHeadWatermark returns the smallest head watermark among all the processors for given partition
@@ -147,7 +146,7 @@ func (e *edgeFetcher) ComputeHeadWatermark(fromPartitionIdx int32) wmb.Watermark
 		// Use -1 as default watermark value to indicate there is no valid watermark yet.
 		return wmb.InitialWatermark
 	}
-	return wmb.Watermark(time.UnixMilli(headWatermark))
+	return wmb.Watermark(wmb.FromEpoch(headWatermark))
 }
 
 // updateHeadIdleWMB updates the smallest head idle WMB for the given partition and returns the updated head idle WMB if exists.
@@ -206,5 +205,5 @@ func (e *edgeFetcher) getWatermark() wmb.Watermark {
 		}
 	}
 	e.RUnlock()
-	return wmb.Watermark(time.UnixMilli(minWm))
+	return wmb.Watermark(wmb.FromEpoch(minWm))
 }
@@ -19,7 +19,6 @@ package fetch
 import (
 	"context"
 	"math"
-	"time"
 
 	"go.uber.org/zap"
 
@@ -64,7 +63,7 @@ func NewEdgeFetcherSet(ctx context.Context, vertexInstance *dfv1.VertexInstance,
 func (efs *edgeFetcherSet) ComputeWatermark(inputOffset isb.Offset, fromPartitionIdx int32) wmb.Watermark {
 	var (
 		wm               wmb.Watermark
-		overallWatermark = wmb.Watermark(time.UnixMilli(math.MaxInt64))
+		overallWatermark = wmb.Watermark(wmb.FromEpoch(math.MaxInt64))
 	)
 	for fromVertex, fetcher := range efs.edgeFetchers {
 		// we don't need to use the returned updated watermark here
@@ -90,7 +89,7 @@ func (efs *edgeFetcherSet) ComputeHeadIdleWMB(fromPartitionIdx int32) wmb.WMB {
 			Offset:    math.MaxInt64,
 			Watermark: math.MaxInt64,
 		}
-		overallWatermark = wmb.Watermark(time.UnixMilli(math.MaxInt64))
+		overallWatermark = wmb.Watermark(wmb.FromEpoch(math.MaxInt64))
 	)
 
 	for fromVertex, fetcher := range efs.edgeFetchers {
@@ -115,9 +114,24 @@ func (efs *edgeFetcherSet) ComputeHeadIdleWMB(fromPartitionIdx int32) wmb.WMB {
 	}
 
 	// we only consider idle watermark if it is smaller than or equal to min of all the last processed watermarks.
-	if overallHeadWMB.Watermark > overallWatermark.UnixMilli() {
+	if overallHeadWMB.Watermark > overallWatermark.Epoch() {
 		return wmb.WMB{}
 	}
 	return overallHeadWMB
 
 }
+
+// ComputeHeadWatermark returns the smallest head watermark of all the edges for the given partition.
+func (efs *edgeFetcherSet) ComputeHeadWatermark(fromPartitionIdx int32) wmb.Watermark {
+	var overallWatermark = wmb.Watermark(wmb.FromEpoch(math.MaxInt64))
+	for _, fetcher := range efs.edgeFetchers {
+		wm := fetcher.ComputeHeadWatermark(fromPartitionIdx)
+		if wm.BeforeWatermark(overallWatermark) {
+			overallWatermark = wm
+		}
+	}
+	if overallWatermark.Epoch() == math.MaxInt64 {
+		return wmb.InitialWatermark
+	}
+	return overallWatermark
+}
@@ -175,7 +175,7 @@ func Test_EdgeFetcherSet_ComputeWatermark(t *testing.T) {
 				}
 			}
 			if got := efs.ComputeWatermark(isb.SimpleStringOffset(func() string { return strconv.FormatInt(tt.offset, 10) }), tt.partitionIdx); time.Time(got).In(location) != time.UnixMilli(tt.want).In(location) {
-				t.Errorf("ComputeWatermark() = %v, want %v", got, wmb.Watermark(time.UnixMilli(tt.want)))
+				t.Errorf("ComputeWatermark() = %v, want %v", got, wmb.Watermark(wmb.FromEpoch(tt.want)))
 			}
 
 		})
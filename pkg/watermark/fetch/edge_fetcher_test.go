@@ -154,7 +154,7 @@ func TestBuffer_updateWatermarkWithOnePartition(t *testing.T) {
 				lastProcessedWm:  lastProcessed,
 			}
 			if got := b.updateWatermark(isb.SimpleStringOffset(func() string { return strconv.FormatInt(tt.args.offset, 10) }), 0); time.Time(got).In(location) != time.UnixMilli(tt.want).In(location) {
-				t.Errorf("ComputeWatermark() = %v, want %v", got, wmb.Watermark(time.UnixMilli(tt.want)))
+				t.Errorf("ComputeWatermark() = %v, want %v", got, wmb.Watermark(wmb.FromEpoch(tt.want)))
 			}
 			// this will always be 17 because the timeline has been populated ahead of time
 			// ComputeHeadWatermark is only used in UI and test
@@ -316,7 +316,7 @@ func TestBuffer_updateWatermarkWithMultiplePartition(t *testing.T) {
 			}
 			_ = b.updateWatermark(isb.SimpleStringOffset(func() string { return strconv.FormatInt(tt.args.offset, 10) }), tt.partitionIdx)
 			if got := b.getWatermark(); time.Time(got).In(location) != time.UnixMilli(tt.want).In(location) {
-				t.Errorf("ComputeWatermark() = %v, want %v", got, wmb.Watermark(time.UnixMilli(tt.want)))
+				t.Errorf("ComputeWatermark() = %v, want %v", got, wmb.Watermark(wmb.FromEpoch(tt.want)))
 			}
 			// this will always be 27 because the timeline has been populated ahead of time
 			// ComputeHeadWatermark is only used in UI and test
@@ -21,7 +21,6 @@ import (
 	"fmt"
 	"math"
 	"strings"
-	"time"
 
 	"go.uber.org/zap"
 
@@ -74,10 +73,10 @@ func (e *sourceFetcher) getWatermark() wmb.Watermark {
 		}
 	}
 	if epoch == math.MaxInt64 {
-		epoch = wmb.InitialWatermark.UnixMilli()
+		epoch = wmb.InitialWatermark.Epoch()
 	}
 	e.log.Debugf("%s get watermark for offset : %+v", debugString.String(), epoch)
-	return wmb.Watermark(time.UnixMilli(epoch))
+	return wmb.Watermark(wmb.FromEpoch(epoch))
 }
 
 // ComputeHeadWatermark returns the latest watermark of all the processors for the given partition.
@@ -96,7 +95,7 @@ func (e *sourceFetcher) ComputeHeadWatermark(fromPartitionIdx int32) wmb.Waterma
 		// Use -1 as default watermark value to indicate there is no valid watermark yet.
 		return wmb.InitialWatermark
 	}
-	return wmb.Watermark(time.UnixMilli(epoch))
+	return wmb.Watermark(wmb.FromEpoch(epoch))
 }
 
 // ComputeHeadIdleWMB returns the latest idle WMB among all processors
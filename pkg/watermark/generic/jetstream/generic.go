@@ -31,13 +31,14 @@ import (
 )
 
 // BuildProcessorManagers creates a map of ProcessorManagers for all the incoming edges of the given Vertex.
+// The bucket of an edge is in the JetStream domain of the from vertex, and the source bucket is in the domain of the source.
 func BuildProcessorManagers(ctx context.Context, vertexInstance *v1alpha1.VertexInstance, client *jsclient.NATSClient) (map[string]*processor.ProcessorManager, error) {
 	var managers = make(map[string]*processor.ProcessorManager)
 	var fromBucket string
 	vertex := vertexInstance.Vertex
 	if vertex.IsASource() {
 		fromBucket = v1alpha1.GenerateSourceBucketName(vertex.Namespace, vertex.Spec.PipelineName, vertex.Spec.Name)
-		processManager, err := buildProcessorManagerForBucket(ctx, vertexInstance, fromBucket, client)
+		processManager, err := buildProcessorManagerForBucket(ctx, vertexInstance, fromBucket, client.WithDomain(vertex.Spec.GetJetStreamDomain()))
 		if err != nil {
 			return nil, err
 		}
@@ -45,7 +46,7 @@ func BuildProcessorManagers(ctx context.Context, vertexInstance *v1alpha1.Vertex
 	} else {
 		for _, e := range vertex.Spec.FromEdges {
 			fromBucket = v1alpha1.GenerateEdgeBucketName(vertex.Namespace, vertex.Spec.PipelineName, e.From, e.To)
-			processManager, err := buildProcessorManagerForBucket(ctx, vertexInstance, fromBucket, client)
+			processManager, err := buildProcessorManagerForBucket(ctx, vertexInstance, fromBucket, client.WithDomain(e.FromVertexJetStreamDomain))
 			if err != nil {
 				return nil, err
 			}
@@ -71,7 +72,8 @@ func buildProcessorManagerForBucket(ctx context.Context, vertexInstance *v1alpha
 	return processManager, nil
 }
 
-// BuildToVertexWatermarkStores creates a map of WatermarkStore for all the to buckets of the given vertex.
+// BuildToVertexWatermarkStores creates a map of WatermarkStore for all the to buckets of the given vertex, the bucket of
+// a cross-pipeline edge is in the ISB Service of the to pipeline.
 func BuildToVertexWatermarkStores(ctx context.Context, vertexInstance *v1alpha1.VertexInstance, client *jsclient.NATSClient) (map[string]store.WatermarkStore, error) {
 	var wmStores = make(map[string]store.WatermarkStore)
 	vertex := vertexInstance.Vertex
@@ -79,16 +81,16 @@ func BuildToVertexWatermarkStores(ctx context.Context, vertexInstance *v1alpha1.
 	if vertex.IsASink() {
 		toBucket := vertex.GetToBuckets()[0]
 		// build watermark store
-		wmStore, err := store.BuildJetStreamWatermarkStore(ctx, toBucket, client)
+		wmStore, err := store.BuildJetStreamWatermarkStore(ctx, toBucket, client.WithDomain(vertex.Spec.GetJetStreamDomain()))
 		if err != nil {
 			return nil, fmt.Errorf("failed at new JetStream watermark store, %w", err)
 		}
 		wmStores[vertex.Spec.Name] = wmStore
 	} else {
 		for _, e := range vertex.Spec.ToEdges {
-			toBucket := v1alpha1.GenerateEdgeBucketName(vertex.Namespace, vertex.Spec.PipelineName, e.From, e.To)
+			toBucket := e.GetBucketName(vertex.Namespace, vertex.Spec.PipelineName)
 			// build watermark store
-			wmStore, err := store.BuildJetStreamWatermarkStore(ctx, toBucket, client)
+			wmStore, err := store.BuildJetStreamWatermarkStore(ctx, toBucket, client.ForToEdge(e, e.FromVertexJetStreamDomain))
 			if err != nil {
 				return nil, fmt.Errorf("failed at new JetStream watermark store, %w", err)
 			}
@@ -133,7 +135,7 @@ func BuildSourcePublisherStores(ctx context.Context, vertexInstance *v1alpha1.Ve
 		return store.BuildNoOpWatermarkStore()
 	}
 	bucketName := vertexInstance.Vertex.GetFromBuckets()[0]
-	wmStore, err := store.BuildJetStreamWatermarkStore(ctx, bucketName, client)
+	wmStore, err := store.BuildJetStreamWatermarkStore(ctx, bucketName, client.WithDomain(vertexInstance.Vertex.Spec.GetJetStreamDomain()))
 	if err != nil {
 		return nil, fmt.Errorf("failed at new JetStream watermark store, %w", err)
 	}
@@ -145,8 +147,8 @@ func BuildToVertexPublisherStores(ctx context.Context, vertexInstance *v1alpha1.
 	var pipelineName = vertexInstance.Vertex.Spec.PipelineName
 	var publisherStores = make(map[string]store.WatermarkStore)
 	for _, e := range vertexInstance.Vertex.Spec.ToEdges {
-		toBucket := v1alpha1.GenerateEdgeBucketName(vertexInstance.Vertex.Namespace, pipelineName, e.From, e.To)
-		wmStore, err := store.BuildJetStreamWatermarkStore(ctx, toBucket, client)
+		toBucket := e.GetBucketName(vertexInstance.Vertex.Namespace, pipelineName)
+		wmStore, err := store.BuildJetStreamWatermarkStore(ctx, toBucket, client.ForToEdge(e, e.FromVertexJetStreamDomain))
 		if err != nil {
 			return nil, fmt.Errorf("failed at new JetStream watermark store, %w", err)
 		}
@@ -288,16 +288,17 @@ func (v *ProcessorManager) startTimeLineWatcher() {
 					continue
 				}
 				otValue, err := wmb.DecodeToWMB(value.Value())
+				// the decoding error is checked first, so that a WMB in another time unit is always reported
+				if err != nil {
+					v.log.Errorw("Unable to decode the value", zap.String("processorEntity", p.entity.GetName()), zap.Error(err))
+					continue
+				}
 				// if it's a reduce vertex, consider the watermarks only for the partition that the processor is running on. this is because
 				// reduce processors has 1:1 relation with partitions.
 				// also, ignore all events not belonging to this partition.
 				if v.opts.isReduce && otValue.Partition != v.opts.vertexReplica {
 					continue
 				}
-				if err != nil {
-					v.log.Errorw("Unable to decode the value", zap.String("processorEntity", p.entity.GetName()), zap.Error(err))
-					continue
-				}
 				if otValue.Idle {
 					if v.opts.isReduce {
 						p.offsetTimelines[0].PutIdle(otValue)
@@ -143,7 +143,7 @@ func (p *publish) PublishWatermark(wm wmb.Watermark, offset isb.Offset, toVertex
 	}
 	var otValue = wmb.WMB{
 		Offset:    seq,
-		Watermark: validWM.UnixMilli(),
+		Watermark: validWM.Epoch(),
 		Partition: toVertexPartitionIdx,
 	}
 
@@ -204,7 +204,7 @@ func (p *publish) PublishIdleWatermark(wm wmb.Watermark, offset isb.Offset, toVe
 	}
 	var otValue = wmb.WMB{
 		Offset:    seq,
-		Watermark: validWM.UnixMilli(),
+		Watermark: validWM.Epoch(),
 		Idle:      true,
 		Partition: toVertexPartitionIdx,
 	}
@@ -245,7 +245,7 @@ func (p *publish) loadLatestFromStore() wmb.Watermark {
 		p.log.Errorw("Unable to load latest watermark from wmb store (failed to decode wmb value)", zap.String("OT", p.otStore.GetStoreName()), zap.String("processorEntity", p.entity.GetName()), zap.Error(err))
 		return timeWatermark
 	}
-	timeWatermark = wmb.Watermark(time.UnixMilli(otValue.Watermark))
+	timeWatermark = wmb.Watermark(wmb.FromEpoch(otValue.Watermark))
 	return timeWatermark
 }
 
@@ -25,6 +25,52 @@ type Watermark time.Time
 
 var InitialWatermark = Watermark(time.UnixMilli(-1))
 
+// precision is the time unit of the watermark epochs, which is also the precision of the event times and the window
+// boundaries, defaults to milliseconds.
+var precision = time.Millisecond
+
+// SetPrecision sets the time unit of the watermark epochs, one of time.Millisecond, time.Microsecond and
+// time.Nanosecond. It's set from the watermark settings of the pipeline once at the start of a process, before any
+// watermark is published or fetched.
+func SetPrecision(d time.Duration) {
+	switch d {
+	case time.Microsecond, time.Nanosecond:
+		precision = d
+	default:
+		precision = time.Millisecond
+	}
+	InitialWatermark = Watermark(FromEpoch(-1))
+}
+
+// Precision returns the time unit of the watermark epochs.
+func Precision() time.Duration {
+	return precision
+}
+
+// Epoch returns the epoch of the time in the time unit of the watermark epochs.
+func Epoch(t time.Time) int64 {
+	switch precision {
+	case time.Microsecond:
+		return t.UnixMicro()
+	case time.Nanosecond:
+		return t.UnixNano()
+	default:
+		return t.UnixMilli()
+	}
+}
+
+// FromEpoch returns the time of an epoch in the time unit of the watermark epochs.
+func FromEpoch(epoch int64) time.Time {
+	switch precision {
+	case time.Microsecond:
+		return time.UnixMicro(epoch)
+	case time.Nanosecond:
+		return time.Unix(0, epoch)
+	default:
+		return time.UnixMilli(epoch)
+	}
+}
+
 func (w Watermark) String() string {
 	var location, _ = time.LoadLocation("UTC")
 	var t = time.Time(w).In(location)
@@ -35,6 +81,11 @@ func (w Watermark) UnixMilli() int64 {
 	return time.Time(w).UnixMilli()
 }
 
+// Epoch returns the epoch of the watermark in the time unit of the watermark epochs.
+func (w Watermark) Epoch() int64 {
+	return Epoch(time.Time(w))
+}
+
 func (w Watermark) After(t time.Time) bool {
 	return time.Time(w).After(t)
 }
@@ -20,8 +20,14 @@ package wmb
 import (
 	"bytes"
 	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
 )
 
+// ErrTimeUnitMismatch is returned when a WMB or a reduce WAL written in another time unit is decoded.
+var ErrTimeUnitMismatch = errors.New("time unit mismatch")
+
 // WMB is used in the KV offset timeline bucket as the value for the given processor entity key.
 type WMB struct {
 	// Idle is set to true if the given processor entity hasn't published anything
@@ -40,17 +46,24 @@ type WMB struct {
 	Partition int32
 }
 
-// EncodeToBytes encodes a WMB object into byte array.
+// EncodeToBytes encodes a WMB object into byte array. The time unit of the watermark is written after the WMB if it's
+// not milliseconds, so that the WMBs in milliseconds are still compatible with the older versions.
 func (w WMB) EncodeToBytes() ([]byte, error) {
 	buf := new(bytes.Buffer)
 	err := binary.Write(buf, binary.LittleEndian, w)
 	if err != nil {
 		return nil, err
 	}
+	if precision != time.Millisecond {
+		if err = binary.Write(buf, binary.LittleEndian, int64(precision)); err != nil {
+			return nil, err
+		}
+	}
 	return buf.Bytes(), nil
 }
 
-// DecodeToWMB decodes the given byte array into a WMB object.
+// DecodeToWMB decodes the given byte array into a WMB object. An ErrTimeUnitMismatch is returned if the WMB is written
+// in a time unit other than the current precision, a WMB without the time unit is in milliseconds.
 func DecodeToWMB(b []byte) (WMB, error) {
 	var v WMB
 	buf := bytes.NewReader(b)
@@ -58,5 +71,22 @@ func DecodeToWMB(b []byte) (WMB, error) {
 	if err != nil {
 		return WMB{}, err
 	}
+	unit := int64(time.Millisecond)
+	if buf.Len() >= 8 {
+		if err = binary.Read(buf, binary.LittleEndian, &unit); err != nil {
+			return WMB{}, err
+		}
+	}
+	if err = CheckTimeUnit(time.Duration(unit)); err != nil {
+		return WMB{}, err
+	}
 	return v, nil
 }
+
+// CheckTimeUnit returns an ErrTimeUnitMismatch if the time unit of the persisted epochs is not the current precision.
+func CheckTimeUnit(unit time.Duration) error {
+	if unit != precision {
+		return fmt.Errorf("%w, expected %v but got %v", ErrTimeUnitMismatch, precision, unit)
+	}
+	return nil
+}
@@ -21,6 +21,9 @@ import (
 	"encoding/binary"
 	"reflect"
 	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestDecodeToWMB(t *testing.T) {
@@ -207,3 +210,53 @@ func TestWMB_EncodeToBytes(t *testing.T) {
 		})
 	}
 }
+
+func TestPrecision(t *testing.T) {
+	defer SetPrecision(time.Millisecond)
+	ts := time.Unix(1690000000, 123456789)
+
+	assert.Equal(t, time.Millisecond, Precision())
+	assert.Equal(t, int64(1690000000123), Epoch(ts))
+	assert.Equal(t, time.UnixMilli(1690000000123), FromEpoch(Epoch(ts)))
+
+	SetPrecision(time.Microsecond)
+	assert.Equal(t, int64(1690000000123456), Epoch(ts))
+	assert.Equal(t, time.UnixMicro(1690000000123456), FromEpoch(Epoch(ts)))
+	assert.Equal(t, int64(-1), InitialWatermark.Epoch())
+
+	SetPrecision(time.Nanosecond)
+	assert.Equal(t, int64(1690000000123456789), Epoch(ts))
+	assert.True(t, ts.Equal(FromEpoch(Epoch(ts))))
+	assert.Equal(t, int64(-1), InitialWatermark.Epoch())
+
+	// unsupported precisions fall back to milliseconds
+	SetPrecision(time.Second)
+	assert.Equal(t, time.Millisecond, Precision())
+	assert.Equal(t, int64(-1), InitialWatermark.Epoch())
+}
+
+func TestWMB_TimeUnit(t *testing.T) {
+	defer SetPrecision(time.Millisecond)
+	v := WMB{Offset: 100, Watermark: 1690000000123456, Partition: 1}
+
+	msBytes, err := v.EncodeToBytes()
+	assert.NoError(t, err)
+
+	SetPrecision(time.Microsecond)
+	usBytes, err := v.EncodeToBytes()
+	assert.NoError(t, err)
+	assert.Len(t, usBytes, len(msBytes)+8)
+	got, err := DecodeToWMB(usBytes)
+	assert.NoError(t, err)
+	assert.Equal(t, v, got)
+	// the WMB in milliseconds is not read as microseconds
+	_, err = DecodeToWMB(msBytes)
+	assert.ErrorIs(t, err, ErrTimeUnitMismatch)
+
+	SetPrecision(time.Millisecond)
+	got, err = DecodeToWMB(msBytes)
+	assert.NoError(t, err)
+	assert.Equal(t, v, got)
+	_, err = DecodeToWMB(usBytes)
+	assert.ErrorIs(t, err, ErrTimeUnitMismatch)
+}
@@ -0,0 +1,314 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package accumulator implements the accumulator windows. An accumulator window accumulates the messages of a key from
+// its first message, and it's emitted when any of the triggers fires: the count of the messages reaches the limit,
+// there's no new message of the key within the timeout, or the user container requests a flush of the key. The next
+// message of the key starts a new window.
+// Each window has one partition, whose slot is the keys of the window. The start time of a window is unique among the
+// windows which could still be in the PBQs, so that the partitions of the same keys don't collide.
+package accumulator
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/numaproj/numaflow/pkg/reduce/pbq/partition"
+	"github.com/numaproj/numaflow/pkg/sdkclient/reducer"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/watermark/wmb"
+	"github.com/numaproj/numaflow/pkg/window"
+)
+
+// keysDelimiter is used to combine the keys of a message to the slot of its window
+const keysDelimiter = "\x00"
+
+// accumulatorWindow is the window of a key.
+type accumulatorWindow struct {
+	start time.Time
+	// end is the end time when the window is created, which identifies the partition of the window along with start.
+	end  time.Time
+	slot string
+	// deadline is the event time after which the window is closed by inactivity, it's extended by each message.
+	deadline time.Time
+	// closedEnd is the end time of a window closed by a trigger, which is the watermark when it's closed.
+	closedEnd time.Time
+	count     int
+	lock      sync.RWMutex
+}
+
+var _ window.AlignedKeyedWindower = (*accumulatorWindow)(nil)
+
+func newAccumulatorWindow(start, end time.Time, slot string) *accumulatorWindow {
+	return &accumulatorWindow{
+		start:    start,
+		end:      end,
+		slot:     slot,
+		deadline: end,
+	}
+}
+
+// StartTime returns start of the window.
+func (aw *accumulatorWindow) StartTime() time.Time {
+	return aw.start
+}
+
+// EndTime returns the end time of the window closed by a trigger, otherwise the inactivity deadline of the window.
+func (aw *accumulatorWindow) EndTime() time.Time {
+	aw.lock.RLock()
+	defer aw.lock.RUnlock()
+	if !aw.closedEnd.IsZero() {
+		return aw.closedEnd
+	}
+	return aw.deadline
+}
+
+// AddSlot is a no-op, the slot of an accumulator window is always the keys of the window.
+func (aw *accumulatorWindow) AddSlot(string) {}
+
+// Partitions returns the partition of the window.
+func (aw *accumulatorWindow) Partitions() []partition.ID {
+	return []partition.ID{{Start: aw.start, End: aw.end, Slot: aw.slot}}
+}
+
+// Slots returns the slot of the window.
+func (aw *accumulatorWindow) Slots() []string {
+	return []string{aw.slot}
+}
+
+// add accumulates a message to the window, and returns the number of the messages accumulated.
+func (aw *accumulatorWindow) add(eventTime time.Time, timeout time.Duration) int {
+	aw.lock.Lock()
+	defer aw.lock.Unlock()
+	if d := eventTime.Add(timeout); d.After(aw.deadline) {
+		aw.deadline = d
+	}
+	aw.count++
+	return aw.count
+}
+
+// closeAt closes the window triggered at the given watermark.
+func (aw *accumulatorWindow) closeAt(wm time.Time) {
+	aw.lock.Lock()
+	defer aw.lock.Unlock()
+	aw.closedEnd = wm.Add(wmb.Precision())
+	if aw.closedEnd.After(aw.deadline) {
+		aw.closedEnd = aw.deadline
+	}
+}
+
+// Windower implements the accumulator windows.
+type Windower struct {
+	count   int
+	timeout time.Duration
+	// active is the active windows by their slots.
+	active map[string]*accumulatorWindow
+	// triggered is the windows triggered by the count or a flush, which are closed by the next RemoveWindows.
+	triggered []*accumulatorWindow
+	// usedStarts is the start times of the windows which could still be in the PBQs. A new window never starts before
+	// the watermark, so the start times before the watermark are released.
+	usedStarts map[int64]struct{}
+	// wm is the largest watermark seen by RemoveWindows.
+	wm   time.Time
+	lock sync.RWMutex
+	log  *zap.SugaredLogger
+}
+
+var _ window.TriggeredWindower = (*Windower)(nil)
+
+// NewWindower returns an accumulator Windower. The windows are triggered after the given count of messages if it's
+// positive, and after the given timeout of inactivity.
+func NewWindower(ctx context.Context, count int, timeout time.Duration) *Windower {
+	return &Windower{
+		count:      count,
+		timeout:    timeout,
+		active:     make(map[string]*accumulatorWindow),
+		usedStarts: make(map[int64]struct{}),
+		wm:         time.Time(wmb.InitialWatermark),
+		log:        logging.FromContext(ctx),
+	}
+}
+
+// AssignWindow assigns the windows without the keys, AssignWindowWithKeys should be used instead.
+func (w *Windower) AssignWindow(eventTime time.Time) []window.AlignedKeyedWindower {
+	windows, _ := w.AssignWindowWithKeys(context.Background(), nil, eventTime)
+	return windows
+}
+
+// AssignWindowWithKeys assigns the message to the active window of the keys, a new window is started if there isn't
+// one. The window is triggered if the count of its messages reaches the limit.
+func (w *Windower) AssignWindowWithKeys(_ context.Context, keys []string, eventTime time.Time) ([]window.AlignedKeyedWindower, error) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	slot := strings.Join(keys, keysDelimiter)
+	aw, ok := w.active[slot]
+	if !ok {
+		start := w.nextStart(eventTime)
+		aw = newAccumulatorWindow(start, start.Add(w.timeout), slot)
+		w.active[slot] = aw
+	}
+	if n := aw.add(eventTime, w.timeout); w.count > 0 && n >= w.count {
+		w.trigger(aw)
+	}
+	return []window.AlignedKeyedWindower{aw}, nil
+}
+
+// nextStart returns the start time of a new window, which is the event time of its first message unless it's before
+// the watermark or used by another window.
+func (w *Windower) nextStart(eventTime time.Time) time.Time {
+	start := eventTime
+	if start.Before(w.wm) {
+		start = w.wm
+	}
+	for {
+		if _, ok := w.usedStarts[wmb.Epoch(start)]; !ok {
+			break
+		}
+		start = start.Add(wmb.Precision())
+	}
+	w.usedStarts[wmb.Epoch(start)] = struct{}{}
+	return start
+}
+
+// trigger moves an active window to the triggered windows.
+func (w *Windower) trigger(aw *accumulatorWindow) {
+	delete(w.active, aw.slot)
+	w.triggered = append(w.triggered, aw)
+}
+
+// Flush triggers the active window of the keys, it's a no-op if there isn't one.
+func (w *Windower) Flush(keys []string) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	if aw, ok := w.active[strings.Join(keys, keysDelimiter)]; ok {
+		w.trigger(aw)
+	}
+}
+
+// InsertIfNotPresent inserts a window rebuilt from a persisted partition, whose slot should have been added. If there
+// are multiple windows of the same keys, only the latest one is active and the earlier ones are triggered, because
+// they were closed before the restart.
+func (w *Windower) InsertIfNotPresent(kw window.AlignedKeyedWindower) (window.AlignedKeyedWindower, bool) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	var slot string
+	if slots := kw.Slots(); len(slots) > 0 {
+		slot = slots[0]
+	}
+	for _, aw := range w.triggered {
+		if aw.slot == slot && aw.start.Equal(kw.StartTime()) {
+			return aw, true
+		}
+	}
+	current, ok := w.active[slot]
+	if ok && current.start.Equal(kw.StartTime()) {
+		return current, true
+	}
+	aw := newAccumulatorWindow(kw.StartTime(), kw.EndTime(), slot)
+	w.usedStarts[wmb.Epoch(aw.start)] = struct{}{}
+	if ok && current.start.After(aw.start) {
+		w.triggered = append(w.triggered, aw)
+		return aw, false
+	}
+	if ok {
+		w.trigger(current)
+	}
+	w.active[slot] = aw
+	return aw, false
+}
+
+// RemoveWindows returns the triggered windows, and the windows whose inactivity deadline is before or equal to the
+// watermark, ordered by the end time. The triggered windows end at the watermark, so that the results and the
+// watermark of the emission don't go beyond the watermark of the messages.
+func (w *Windower) RemoveWindows(wm time.Time) []window.AlignedKeyedWindower {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	if wm.After(w.wm) {
+		w.wm = wm
+	}
+	closed := make([]window.AlignedKeyedWindower, 0)
+	for slot, aw := range w.active {
+		if !aw.EndTime().After(wm) {
+			closed = append(closed, aw)
+			delete(w.active, slot)
+		}
+	}
+	// keep the triggered windows until the watermark is available
+	if wmb.Epoch(w.wm) >= 0 {
+		for _, aw := range w.triggered {
+			aw.closeAt(w.wm)
+			closed = append(closed, aw)
+		}
+		w.triggered = nil
+	}
+	for s := range w.usedStarts {
+		if s < wmb.Epoch(w.wm) {
+			delete(w.usedStarts, s)
+		}
+	}
+	sort.SliceStable(closed, func(i, j int) bool {
+		return closed[i].EndTime().Before(closed[j].EndTime())
+	})
+	return closed
+}
+
+// NextWindowToBeClosed returns the window with the earliest start time, which is used to decide whether a late
+// message could still be accepted.
+func (w *Windower) NextWindowToBeClosed() window.AlignedKeyedWindower {
+	w.lock.RLock()
+	defer w.lock.RUnlock()
+	var next *accumulatorWindow
+	for _, aw := range w.triggered {
+		if next == nil || aw.start.Before(next.start) {
+			next = aw
+		}
+	}
+	for _, aw := range w.active {
+		if next == nil || aw.start.Before(next.start) {
+			next = aw
+		}
+	}
+	if next == nil {
+		return nil
+	}
+	return next
+}
+
+// HasTriggered returns true if there are triggered windows to be closed.
+func (w *Windower) HasTriggered() bool {
+	w.lock.RLock()
+	defer w.lock.RUnlock()
+	return len(w.triggered) > 0
+}
+
+// WatchFlushes triggers the windows of the keys flushed by the user container, until the context is done. The watch
+// is restarted if the stream ends.
+func (w *Windower) WatchFlushes(ctx context.Context, client reducer.TriggerClient) {
+	for {
+		err := client.WatchFlushes(ctx, w.Flush)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(time.Second):
+			w.log.Warnw("The watch of the accumulator window flushes ended, restarting", zap.Error(err))
+		}
+	}
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accumulator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/numaproj/numaflow/pkg/window/keyed"
+)
+
+func TestWindower_CountTrigger(t *testing.T) {
+	w := NewWindower(context.Background(), 2, time.Minute)
+	windows, err := w.AssignWindowWithKeys(context.Background(), []string{"a"}, time.UnixMilli(1000))
+	assert.NoError(t, err)
+	assert.Len(t, windows, 1)
+	first := windows[0]
+	assert.Equal(t, "a", first.Partitions()[0].Slot)
+	assert.False(t, w.HasTriggered())
+
+	windows, _ = w.AssignWindowWithKeys(context.Background(), []string{"a"}, time.UnixMilli(2000))
+	assert.Same(t, first, windows[0])
+	assert.True(t, w.HasTriggered())
+
+	// the next message of the key starts a new window
+	windows, _ = w.AssignWindowWithKeys(context.Background(), []string{"a"}, time.UnixMilli(2000))
+	assert.NotSame(t, first, windows[0])
+	assert.Equal(t, int64(2000), windows[0].StartTime().UnixMilli())
+
+	// the triggered window ends at the watermark
+	closed := w.RemoveWindows(time.UnixMilli(1500))
+	assert.Len(t, closed, 1)
+	assert.Same(t, first, closed[0])
+	assert.Equal(t, int64(1501), first.EndTime().UnixMilli())
+	assert.Equal(t, int64(61000), first.Partitions()[0].End.UnixMilli())
+	assert.False(t, w.HasTriggered())
+}
+
+func TestWindower_InactivityTimeout(t *testing.T) {
+	w := NewWindower(context.Background(), 0, time.Minute)
+	windows, _ := w.AssignWindowWithKeys(context.Background(), []string{"a", "b"}, time.UnixMilli(1000))
+	_, _ = w.AssignWindowWithKeys(context.Background(), []string{"a", "b"}, time.UnixMilli(30000))
+	_, _ = w.AssignWindowWithKeys(context.Background(), []string{"c"}, time.UnixMilli(5000))
+	assert.Equal(t, int64(90000), windows[0].EndTime().UnixMilli())
+	assert.Equal(t, int64(1000), w.NextWindowToBeClosed().StartTime().UnixMilli())
+
+	closed := w.RemoveWindows(time.UnixMilli(70000))
+	assert.Len(t, closed, 1)
+	assert.Equal(t, "c", closed[0].Slots()[0])
+	closed = w.RemoveWindows(time.UnixMilli(90000))
+	assert.Len(t, closed, 1)
+	assert.Equal(t, "a\x00b", closed[0].Slots()[0])
+	assert.Nil(t, w.NextWindowToBeClosed())
+}
+
+func TestWindower_Flush(t *testing.T) {
+	w := NewWindower(context.Background(), 0, time.Minute)
+	windows, _ := w.AssignWindowWithKeys(context.Background(), []string{"a"}, time.UnixMilli(1000))
+	w.Flush([]string{"b"})
+	assert.False(t, w.HasTriggered())
+	w.Flush([]string{"a"})
+	assert.True(t, w.HasTriggered())
+
+	// the triggered windows are kept until the watermark is available
+	assert.Len(t, w.RemoveWindows(time.UnixMilli(-1)), 0)
+	closed := w.RemoveWindows(time.UnixMilli(500))
+	assert.Len(t, closed, 1)
+	assert.Same(t, windows[0], closed[0])
+	assert.Equal(t, int64(501), closed[0].EndTime().UnixMilli())
+}
+
+func TestWindower_UniqueStart(t *testing.T) {
+	w := NewWindower(context.Background(), 1, time.Minute)
+	a, _ := w.AssignWindowWithKeys(context.Background(), []string{"a"}, time.UnixMilli(1000))
+	b, _ := w.AssignWindowWithKeys(context.Background(), []string{"b"}, time.UnixMilli(1000))
+	a2, _ := w.AssignWindowWithKeys(context.Background(), []string{"a"}, time.UnixMilli(1000))
+	assert.Equal(t, int64(1000), a[0].StartTime().UnixMilli())
+	assert.Equal(t, int64(1001), b[0].StartTime().UnixMilli())
+	assert.Equal(t, int64(1002), a2[0].StartTime().UnixMilli())
+
+	// a new window doesn't start before the watermark
+	assert.Len(t, w.RemoveWindows(time.UnixMilli(5000)), 3)
+	late, _ := w.AssignWindowWithKeys(context.Background(), []string{"a"}, time.UnixMilli(1000))
+	assert.Equal(t, int64(5000), late[0].StartTime().UnixMilli())
+}
+
+func TestWindower_InsertIfNotPresent(t *testing.T) {
+	w := NewWindower(context.Background(), 0, time.Minute)
+	older := keyed.NewKeyedWindow(time.UnixMilli(1000), time.UnixMilli(61000))
+	older.AddSlot("a")
+	newer := keyed.NewKeyedWindow(time.UnixMilli(2000), time.UnixMilli(62000))
+	newer.AddSlot("a")
+
+	newerWin, present := w.InsertIfNotPresent(newer)
+	assert.False(t, present)
+	olderWin, present := w.InsertIfNotPresent(older)
+	assert.False(t, present)
+	again, present := w.InsertIfNotPresent(newer)
+	assert.True(t, present)
+	assert.Same(t, newerWin, again)
+
+	// the earlier window of the keys was closed before the restart
+	assert.True(t, w.HasTriggered())
+	windows, _ := w.AssignWindowWithKeys(context.Background(), []string{"a"}, time.UnixMilli(3000))
+	assert.Same(t, newerWin, windows[0])
+	closed := w.RemoveWindows(time.UnixMilli(1500))
+	assert.Len(t, closed, 1)
+	assert.Same(t, olderWin, closed[0])
+}
+
+type flushClient struct {
+	keys [][]string
+}
+
+func (f *flushClient) CloseConn(context.Context) error {
+	return nil
+}
+
+func (f *flushClient) IsReady(context.Context, *emptypb.Empty) (bool, error) {
+	return true, nil
+}
+
+func (f *flushClient) WatchFlushes(ctx context.Context, flush func(keys []string)) error {
+	for _, k := range f.keys {
+		flush(k)
+	}
+	f.keys = nil
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func TestWindower_WatchFlushes(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	w := NewWindower(ctx, 0, time.Minute)
+	_, _ = w.AssignWindowWithKeys(ctx, []string{"a"}, time.UnixMilli(1000))
+	go w.WatchFlushes(ctx, &flushClient{keys: [][]string{{"a"}}})
+	for !w.HasTriggered() {
+		select {
+		case <-ctx.Done():
+			t.Fatal("expected the window to be triggered")
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
@@ -0,0 +1,333 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package custom implements the custom windows, which are assigned and merged by the Windower gRPC service of the
+// user container. The platform keeps the state of the windows, routes the messages to the partitions of the windows,
+// and closes the windows when the watermark passes their end time.
+// The windows are kept per key, a window has one partition whose slot is the keys of the window, and it's only merged
+// with the windows of the same keys.
+// A window keeps the partition it's created with when it's merged, the merge only extends its end time, so that the
+// messages already written to the partition don't need to be moved. The extended end time is persisted by the data
+// forwarder, and restored by Extend when the window is rebuilt from the persisted partition.
+package custom
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	windowerpb "github.com/numaproj/numaflow/pkg/apis/proto/windower"
+	"github.com/numaproj/numaflow/pkg/reduce/pbq/partition"
+	"github.com/numaproj/numaflow/pkg/sdkclient/windower"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/window"
+)
+
+// keysDelimiter is used to combine the keys of a message to the slot of its window
+const keysDelimiter = "\x00"
+
+// customWindow is a window of a key which could be extended by merging.
+type customWindow struct {
+	start time.Time
+	// end is the end time when the window is created, which identifies the partition of the window along with start.
+	end time.Time
+	// mergedEnd is the end time extended by merging, the window is closed when the watermark passes it.
+	mergedEnd time.Time
+	slot      string
+	lock      sync.RWMutex
+}
+
+var _ window.ExtendableWindower = (*customWindow)(nil)
+
+func newCustomWindow(start, end time.Time, slot string) *customWindow {
+	return &customWindow{
+		start:     start,
+		end:       end,
+		mergedEnd: end,
+		slot:      slot,
+	}
+}
+
+// StartTime returns start of the window.
+func (cw *customWindow) StartTime() time.Time {
+	return cw.start
+}
+
+// EndTime returns the end of the window extended by merging.
+func (cw *customWindow) EndTime() time.Time {
+	cw.lock.RLock()
+	defer cw.lock.RUnlock()
+	return cw.mergedEnd
+}
+
+// AddSlot is a no-op, the slot of a custom window is always the keys of the window.
+func (cw *customWindow) AddSlot(string) {}
+
+// Partitions returns the partition of the window, which is identified by the start and end time when the window is
+// created.
+func (cw *customWindow) Partitions() []partition.ID {
+	return []partition.ID{{Start: cw.start, End: cw.end, Slot: cw.slot}}
+}
+
+// Slots returns the slot of the window.
+func (cw *customWindow) Slots() []string {
+	return []string{cw.slot}
+}
+
+// Extend extends the end time of the window, an earlier end time is ignored.
+func (cw *customWindow) Extend(end time.Time) {
+	cw.lock.Lock()
+	defer cw.lock.Unlock()
+	if end.After(cw.mergedEnd) {
+		cw.mergedEnd = end
+	}
+}
+
+// Windower implements the custom windows.
+// Different from the fixed and sliding windows, the custom windows could have the same start time, and their end
+// time could be extended by merging, so the active windows are sorted by the start time and then the end time when
+// they are created, and all the windows whose end time passes the watermark are closed regardless of their position.
+// The windows of different keys are never merged.
+type Windower struct {
+	client windower.Client
+	// maxLength is the max length a window could be extended to by merging.
+	maxLength time.Duration
+	// windows is the list of active windows.
+	windows []*customWindow
+	lock    sync.RWMutex
+	log     *zap.SugaredLogger
+}
+
+var _ window.KeyedWindower = (*Windower)(nil)
+
+// NewWindower returns a custom Windower calling the given Windower client.
+func NewWindower(ctx context.Context, client windower.Client, maxLength time.Duration) *Windower {
+	return &Windower{
+		client:    client,
+		maxLength: maxLength,
+		log:       logging.FromContext(ctx),
+	}
+}
+
+// AssignWindow assigns the windows without the keys, AssignWindowWithKeys should be used instead.
+func (w *Windower) AssignWindow(eventTime time.Time) []window.AlignedKeyedWindower {
+	windows, err := w.AssignWindowWithKeys(context.Background(), nil, eventTime)
+	if err != nil {
+		w.log.Errorw("Failed to assign custom windows", zap.Time("eventTime", eventTime), zap.Error(err))
+	}
+	return windows
+}
+
+// AssignWindowWithKeys asks the user container for the windows of the message. If a window overlaps with the active
+// windows, the user container is asked whether it's merged into one of them. The calls are retried until they
+// succeed or the context is done.
+func (w *Windower) AssignWindowWithKeys(ctx context.Context, keys []string, eventTime time.Time) ([]window.AlignedKeyedWindower, error) {
+	var assigned *windowerpb.AssignWindowsResponse
+	err := w.retry(ctx, "AssignWindows", func() (err error) {
+		assigned, err = w.client.AssignWindows(ctx, &windowerpb.AssignWindowsRequest{Keys: keys, EventTime: eventTime.UnixMilli()})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	var result []window.AlignedKeyedWindower
+	for _, aw := range assigned.GetWindows() {
+		// a window with invalid time range is a bug of the user container, there's no point retrying it.
+		if aw.GetEnd() <= aw.GetStart() {
+			w.log.Errorw("Ignoring invalid custom window", zap.Strings("keys", keys), zap.Int64("start", aw.GetStart()), zap.Int64("end", aw.GetEnd()))
+			continue
+		}
+		cw, err := w.mergeOrInsert(ctx, keys, aw)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, cw)
+	}
+	return result, nil
+}
+
+// mergeOrInsert merges the assigned window into one of the overlapping active windows of the same keys if the user
+// container says so, otherwise inserts it to the active windows.
+func (w *Windower) mergeOrInsert(ctx context.Context, keys []string, aw *windowerpb.Window) (*customWindow, error) {
+	start, end := time.UnixMilli(aw.GetStart()), time.UnixMilli(aw.GetEnd())
+	slot := strings.Join(keys, keysDelimiter)
+	var overlapping []*customWindow
+	for _, cw := range w.windows {
+		if cw.slot != slot {
+			continue
+		}
+		if cw.start.Equal(start) && cw.EndTime().Equal(end) {
+			return cw, nil
+		}
+		if cw.start.Before(end) && start.Before(cw.EndTime()) {
+			overlapping = append(overlapping, cw)
+		}
+	}
+	if len(overlapping) == 0 {
+		return w.insert(start, end, slot), nil
+	}
+
+	request := &windowerpb.MergeWindowsRequest{Window: aw, Keys: keys}
+	for _, cw := range overlapping {
+		request.ActiveWindows = append(request.ActiveWindows, &windowerpb.Window{Start: cw.start.UnixMilli(), End: cw.EndTime().UnixMilli()})
+	}
+	var merged *windowerpb.MergeWindowsResponse
+	err := w.retry(ctx, "MergeWindows", func() (err error) {
+		merged, err = w.client.MergeWindows(ctx, request)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !merged.GetMerged() {
+		return w.insert(start, end, slot), nil
+	}
+	idx := int(merged.GetActiveIndex())
+	if idx < 0 || idx >= len(overlapping) {
+		w.log.Errorw("Invalid active window index in the merge result, not merging", zap.Int("index", idx), zap.Int("activeWindows", len(overlapping)))
+		return w.insert(start, end, slot), nil
+	}
+	target := overlapping[idx]
+	mergedEnd := end
+	if mw := merged.GetMergedWindow(); mw != nil && mw.GetEnd() > 0 {
+		mergedEnd = time.UnixMilli(mw.GetEnd())
+	}
+	if limit := target.start.Add(w.maxLength); mergedEnd.After(limit) {
+		w.log.Warnw("Merged custom window exceeds the max length, capping it", zap.Int64("start", target.start.UnixMilli()), zap.Int64("end", mergedEnd.UnixMilli()), zap.Duration("maxLength", w.maxLength))
+		mergedEnd = limit
+	}
+	target.Extend(mergedEnd)
+	return target, nil
+}
+
+// insert inserts a new window to the active windows, keeping the windows sorted by the start time and the end time.
+func (w *Windower) insert(start, end time.Time, slot string) *customWindow {
+	cw := newCustomWindow(start, end, slot)
+	idx := sort.Search(len(w.windows), func(i int) bool {
+		win := w.windows[i]
+		return win.start.After(start) || (win.start.Equal(start) && win.end.After(end))
+	})
+	w.windows = append(w.windows, nil)
+	copy(w.windows[idx+1:], w.windows[idx:])
+	w.windows[idx] = cw
+	return cw
+}
+
+// InsertIfNotPresent inserts a window rebuilt from a persisted partition, whose slot should have been added, to the
+// list of active windows if there isn't a window with the same partition.
+func (w *Windower) InsertIfNotPresent(kw window.AlignedKeyedWindower) (window.AlignedKeyedWindower, bool) {
+	var slot string
+	if slots := kw.Slots(); len(slots) > 0 {
+		slot = slots[0]
+	}
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	for _, cw := range w.windows {
+		if cw.slot == slot && cw.start.Equal(kw.StartTime()) && cw.end.Equal(kw.EndTime()) {
+			return cw, true
+		}
+	}
+	return w.insert(kw.StartTime(), kw.EndTime(), slot), false
+}
+
+// RemoveWindows returns the windows whose end time is before or equal to the watermark, ordered by the end time.
+func (w *Windower) RemoveWindows(wm time.Time) []window.AlignedKeyedWindower {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	closed := make([]window.AlignedKeyedWindower, 0)
+	active := w.windows[:0]
+	for _, cw := range w.windows {
+		if cw.EndTime().After(wm) {
+			active = append(active, cw)
+		} else {
+			closed = append(closed, cw)
+		}
+	}
+	// clear the references of the closed windows in the backing array
+	for i := len(active); i < len(w.windows); i++ {
+		w.windows[i] = nil
+	}
+	w.windows = active
+	sort.SliceStable(closed, func(i, j int) bool {
+		return closed[i].EndTime().Before(closed[j].EndTime())
+	})
+	return closed
+}
+
+// NextWindowToBeClosed returns the active window with the earliest start time, which is used to decide whether a late
+// message could still be accepted.
+func (w *Windower) NextWindowToBeClosed() window.AlignedKeyedWindower {
+	w.lock.RLock()
+	defer w.lock.RUnlock()
+	if len(w.windows) == 0 {
+		return nil
+	}
+	return w.windows[0]
+}
+
+// WaitUntilReady waits until the Windower service of the user container is ready.
+func (w *Windower) WaitUntilReady(ctx context.Context) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("failed on readiness check: %w", ctx.Err())
+		default:
+			if ready, err := w.client.IsReady(ctx, &emptypb.Empty{}); err == nil && ready {
+				return nil
+			} else {
+				w.log.Infof("waiting for the windower to be ready: %v", err)
+				time.Sleep(1 * time.Second)
+			}
+		}
+	}
+}
+
+// CloseConn closes the connection to the user container.
+func (w *Windower) CloseConn(ctx context.Context) error {
+	return w.client.CloseConn(ctx)
+}
+
+func (w *Windower) retry(ctx context.Context, method string, call func() error) error {
+	var backoff = wait.Backoff{
+		Steps:    math.MaxInt,
+		Duration: 1 * time.Second,
+		Factor:   1.5,
+		Jitter:   0.1,
+	}
+	var lastErr error
+	err := wait.ExponentialBackoffWithContext(ctx, backoff, func() (bool, error) {
+		if lastErr = call(); lastErr != nil {
+			w.log.Warnw("Failed to call the windower, retrying", zap.String("method", method), zap.Error(lastErr))
+			return false, nil
+		}
+		return true, nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to call %s of the windower, %w, last error: %v", method, err, lastErr)
+	}
+	return nil
+}
@@ -0,0 +1,229 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package custom
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	windowerpb "github.com/numaproj/numaflow/pkg/apis/proto/windower"
+	"github.com/numaproj/numaflow/pkg/reduce/pbq/partition"
+	"github.com/numaproj/numaflow/pkg/window"
+	"github.com/numaproj/numaflow/pkg/window/keyed"
+)
+
+// sessionClient assigns a session window [eventTime, eventTime+gap) and merges it into the first overlapping
+// active window.
+type sessionClient struct {
+	gap       int64
+	failOnce  bool
+	merges    int
+	mergeKeys []string
+}
+
+func (s *sessionClient) CloseConn(context.Context) error {
+	return nil
+}
+
+func (s *sessionClient) IsReady(context.Context, *emptypb.Empty) (bool, error) {
+	return true, nil
+}
+
+func (s *sessionClient) AssignWindows(_ context.Context, req *windowerpb.AssignWindowsRequest) (*windowerpb.AssignWindowsResponse, error) {
+	if s.failOnce {
+		s.failOnce = false
+		return nil, fmt.Errorf("unavailable")
+	}
+	if len(req.Keys) > 0 && req.Keys[0] == "invalid" {
+		return &windowerpb.AssignWindowsResponse{Windows: []*windowerpb.Window{{Start: req.EventTime, End: req.EventTime}}}, nil
+	}
+	return &windowerpb.AssignWindowsResponse{Windows: []*windowerpb.Window{{Start: req.EventTime, End: req.EventTime + s.gap}}}, nil
+}
+
+func (s *sessionClient) MergeWindows(_ context.Context, req *windowerpb.MergeWindowsRequest) (*windowerpb.MergeWindowsResponse, error) {
+	s.merges++
+	s.mergeKeys = req.Keys
+	active := req.ActiveWindows[0]
+	end := active.End
+	if req.Window.End > end {
+		end = req.Window.End
+	}
+	return &windowerpb.MergeWindowsResponse{Merged: true, ActiveIndex: 0, MergedWindow: &windowerpb.Window{Start: active.Start, End: end}}, nil
+}
+
+func TestWindower_AssignAndMerge(t *testing.T) {
+	ctx := context.Background()
+	client := &sessionClient{gap: 10}
+	w := NewWindower(ctx, client, time.Hour)
+
+	windows, err := w.AssignWindowWithKeys(ctx, []string{"k1"}, time.UnixMilli(100))
+	assert.NoError(t, err)
+	assert.Len(t, windows, 1)
+	first := windows[0]
+	assert.Equal(t, int64(100), first.StartTime().UnixMilli())
+	assert.Equal(t, int64(110), first.EndTime().UnixMilli())
+
+	// overlapping, merged into the first window
+	windows, err = w.AssignWindowWithKeys(ctx, []string{"k1"}, time.UnixMilli(105))
+	assert.NoError(t, err)
+	assert.Same(t, first, windows[0])
+	assert.Equal(t, int64(115), first.EndTime().UnixMilli())
+	assert.Equal(t, 1, client.merges)
+	assert.Equal(t, []string{"k1"}, client.mergeKeys)
+	// the partition keeps the time range when the window is created
+	assert.Equal(t, []partition.ID{{Start: time.UnixMilli(100), End: time.UnixMilli(110), Slot: "k1"}}, first.Partitions())
+
+	// not overlapping, a new window
+	windows, err = w.AssignWindowWithKeys(ctx, []string{"k1"}, time.UnixMilli(200))
+	assert.NoError(t, err)
+	assert.NotSame(t, first, windows[0])
+	assert.Equal(t, 1, client.merges)
+
+	// invalid windows are ignored
+	windows, err = w.AssignWindowWithKeys(ctx, []string{"invalid"}, time.UnixMilli(300))
+	assert.NoError(t, err)
+	assert.Len(t, windows, 0)
+
+	assert.Equal(t, int64(100), w.NextWindowToBeClosed().StartTime().UnixMilli())
+	assert.Len(t, w.RemoveWindows(time.UnixMilli(110)), 0)
+	closed := w.RemoveWindows(time.UnixMilli(115))
+	assert.Len(t, closed, 1)
+	assert.Same(t, first, closed[0])
+	assert.Equal(t, int64(200), w.NextWindowToBeClosed().StartTime().UnixMilli())
+	assert.Len(t, w.RemoveWindows(time.UnixMilli(1000)), 1)
+	assert.Nil(t, w.NextWindowToBeClosed())
+}
+
+func TestWindower_PerKey(t *testing.T) {
+	ctx := context.Background()
+	client := &sessionClient{gap: 10}
+	w := NewWindower(ctx, client, time.Hour)
+
+	windows, err := w.AssignWindowWithKeys(ctx, []string{"k1", "k2"}, time.UnixMilli(100))
+	assert.NoError(t, err)
+	first := windows[0]
+	assert.Equal(t, []string{"k1\x00k2"}, first.Slots())
+
+	// overlapping with the window of other keys, not offered to merge
+	windows, err = w.AssignWindowWithKeys(ctx, []string{"k3"}, time.UnixMilli(105))
+	assert.NoError(t, err)
+	second := windows[0]
+	assert.NotSame(t, first, second)
+	assert.Equal(t, 0, client.merges)
+	assert.Equal(t, int64(110), first.EndTime().UnixMilli())
+	assert.Equal(t, []string{"k3"}, second.Slots())
+
+	// merged into the window of the same keys
+	windows, err = w.AssignWindowWithKeys(ctx, []string{"k3"}, time.UnixMilli(112))
+	assert.NoError(t, err)
+	assert.Same(t, second, windows[0])
+	assert.Equal(t, 1, client.merges)
+	assert.Equal(t, []string{"k3"}, client.mergeKeys)
+	assert.Equal(t, int64(110), first.EndTime().UnixMilli())
+	assert.Equal(t, int64(122), second.EndTime().UnixMilli())
+
+	closed := w.RemoveWindows(time.UnixMilli(115))
+	assert.Len(t, closed, 1)
+	assert.Same(t, first, closed[0])
+	assert.Same(t, second, w.NextWindowToBeClosed())
+}
+
+func TestWindower_InsertAndExtend(t *testing.T) {
+	ctx := context.Background()
+	client := &sessionClient{gap: 10}
+	w := NewWindower(ctx, client, time.Hour)
+
+	// rebuild the windows of two keys with the same time range from the persisted partitions
+	kw := keyed.NewKeyedWindow(time.UnixMilli(100), time.UnixMilli(110))
+	kw.AddSlot("k1")
+	k1Window, isPresent := w.InsertIfNotPresent(kw)
+	assert.False(t, isPresent)
+	kw = keyed.NewKeyedWindow(time.UnixMilli(100), time.UnixMilli(110))
+	kw.AddSlot("k2")
+	k2Window, isPresent := w.InsertIfNotPresent(kw)
+	assert.False(t, isPresent)
+	assert.NotSame(t, k1Window, k2Window)
+	kw = keyed.NewKeyedWindow(time.UnixMilli(100), time.UnixMilli(110))
+	kw.AddSlot("k1")
+	present, isPresent := w.InsertIfNotPresent(kw)
+	assert.True(t, isPresent)
+	assert.Same(t, k1Window, present)
+
+	// restore the merged end, an earlier end is ignored
+	ew, ok := k1Window.(window.ExtendableWindower)
+	assert.True(t, ok)
+	ew.Extend(time.UnixMilli(130))
+	ew.Extend(time.UnixMilli(120))
+	assert.Equal(t, int64(130), k1Window.EndTime().UnixMilli())
+	assert.Equal(t, int64(110), k1Window.Partitions()[0].End.UnixMilli())
+
+	// the rebuilt window is merged with the new windows of the same key
+	windows, err := w.AssignWindowWithKeys(ctx, []string{"k1"}, time.UnixMilli(125))
+	assert.NoError(t, err)
+	assert.Same(t, k1Window, windows[0])
+	assert.Equal(t, int64(135), k1Window.EndTime().UnixMilli())
+
+	closed := w.RemoveWindows(time.UnixMilli(130))
+	assert.Len(t, closed, 1)
+	assert.Same(t, k2Window, closed[0])
+}
+
+func TestWindower_MaxLength(t *testing.T) {
+	ctx := context.Background()
+	w := NewWindower(ctx, &sessionClient{gap: 10}, 25*time.Millisecond)
+	for _, et := range []int64{0, 8, 16, 24} {
+		_, err := w.AssignWindowWithKeys(ctx, nil, time.UnixMilli(et))
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, int64(25), w.NextWindowToBeClosed().EndTime().UnixMilli())
+}
+
+func TestWindower_RemoveWindowsOrder(t *testing.T) {
+	ctx := context.Background()
+	w := NewWindower(ctx, &sessionClient{gap: 10}, time.Hour)
+	// same start time, different end time
+	_, _ = w.InsertIfNotPresent(keyed.NewKeyedWindow(time.UnixMilli(0), time.UnixMilli(30)))
+	_, _ = w.InsertIfNotPresent(keyed.NewKeyedWindow(time.UnixMilli(0), time.UnixMilli(20)))
+	_, isPresent := w.InsertIfNotPresent(keyed.NewKeyedWindow(time.UnixMilli(0), time.UnixMilli(20)))
+	assert.True(t, isPresent)
+	_, _ = w.InsertIfNotPresent(keyed.NewKeyedWindow(time.UnixMilli(5), time.UnixMilli(10)))
+	closed := w.RemoveWindows(time.UnixMilli(30))
+	assert.Len(t, closed, 3)
+	assert.Equal(t, int64(10), closed[0].EndTime().UnixMilli())
+	assert.Equal(t, int64(20), closed[1].EndTime().UnixMilli())
+	assert.Equal(t, int64(30), closed[2].EndTime().UnixMilli())
+}
+
+func TestWindower_Retry(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	w := NewWindower(ctx, &sessionClient{gap: 10, failOnce: true}, time.Hour)
+	windows, err := w.AssignWindowWithKeys(ctx, nil, time.UnixMilli(100))
+	assert.NoError(t, err)
+	assert.Len(t, windows, 1)
+
+	cancelledCtx, cancel2 := context.WithCancel(context.Background())
+	cancel2()
+	w = NewWindower(ctx, &sessionClient{gap: 10, failOnce: true}, time.Hour)
+	_, err = w.AssignWindowWithKeys(cancelledCtx, nil, time.UnixMilli(100))
+	assert.Error(t, err)
+}
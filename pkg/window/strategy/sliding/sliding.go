@@ -67,7 +67,7 @@ func (s *Sliding) AssignWindow(eventTime time.Time) []window.AlignedKeyedWindowe
 	// as the start time for the window. For example if the eventTime is 810 and slide
 	// length is 70, use 770 as the startTime of the window. In that way we can be guarantee
 	// consistency while assigning the messages to the windows.
-	startTime := time.UnixMilli((eventTime.UnixMilli() / s.Slide.Milliseconds()) * s.Slide.Milliseconds())
+	startTime := time.Unix(0, (eventTime.UnixNano()/s.Slide.Nanoseconds())*s.Slide.Nanoseconds())
 	endTime := startTime.Add(s.Length)
 
 	// startTime and endTime will be the largest timestamp window for the given eventTime,
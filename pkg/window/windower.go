@@ -17,6 +17,7 @@ limitations under the License.
 package window
 
 import (
+	"context"
 	"time"
 
 	"github.com/numaproj/numaflow/pkg/reduce/pbq/partition"
@@ -41,6 +42,14 @@ type AlignedKeyedWindower interface {
 	Slots() []string
 }
 
+// ExtendableWindower is an AlignedKeyedWindower whose end time could be extended after it's created, e.g. the custom
+// windows extended by merging. The end time of its partitions is still the one when it's created.
+type ExtendableWindower interface {
+	AlignedKeyedWindower
+	// Extend extends the end time of the window, an earlier end time is ignored.
+	Extend(end time.Time)
+}
+
 // Windower manages AlignedKeyedWindower
 // Will be implemented by each of the windowing strategies.
 type Windower interface {
@@ -55,3 +64,22 @@ type Windower interface {
 	// will be used by the data forwarder to check if the late message can be considered for processing.
 	NextWindowToBeClosed() AlignedKeyedWindower
 }
+
+// KeyedWindower is a Windower which assigns the windows by the keys along with the event time of the messages, e.g. the
+// custom windows assigned by the user container. The data forwarder prefers AssignWindowWithKeys if it's implemented.
+type KeyedWindower interface {
+	Windower
+	// AssignWindowWithKeys assigns the message with the keys and the event time to the windows, the windows returned
+	// could be the active windows extended by merging. An error is returned if the windows can't be assigned.
+	AssignWindowWithKeys(ctx context.Context, keys []string, eventTime time.Time) ([]AlignedKeyedWindower, error)
+}
+
+// TriggeredWindower is a KeyedWindower whose windows could be closed by the triggers other than the watermark, e.g. the
+// accumulator windows emitted on the count of the messages or the flushes requested by the user container.
+// RemoveWindows returns the triggered windows along with the windows passed by the watermark.
+type TriggeredWindower interface {
+	KeyedWindower
+	// HasTriggered returns true if any of the active windows is triggered, so that the data forwarder could close it
+	// even when the watermark doesn't progress.
+	HasTriggered() bool
+}
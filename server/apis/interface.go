@@ -34,5 +34,7 @@ type Handler interface {
 	GetVertexBuffers(c *gin.Context)
 	GetPipelineWatermarks(c *gin.Context)
 	GetPipelineStatus(c *gin.Context)
+	GetPipelineEdgeMetrics(c *gin.Context)
+	GetPipelineMetricsHistory(c *gin.Context)
 	ListNamespaces(c *gin.Context)
 }
@@ -157,8 +157,15 @@ func (h *handler) GetVertex(c *gin.Context) {
 // ListVertexPods is used to provide all the pods of a vertex
 func (h *handler) ListVertexPods(c *gin.Context) {
 	limit, _ := strconv.ParseInt(c.Query("limit"), 10, 64)
-	pods, err := h.kubeClient.CoreV1().Pods(c.Param("namespace")).List(context.Background(), metav1.ListOptions{
-		LabelSelector: fmt.Sprintf("%s=%s,%s=%s", dfv1.KeyPipelineName, c.Param("pipeline"), dfv1.KeyVertexName, c.Param("vertex")),
+	ns := c.Param("namespace")
+	labelSelector := fmt.Sprintf("%s=%s,%s=%s", dfv1.KeyPipelineName, c.Param("pipeline"), dfv1.KeyVertexName, c.Param("vertex"))
+	// The pods of the vertex might run in another namespace.
+	if v, err := h.numaflowClient.Vertices(ns).Get(context.Background(), c.Param("pipeline")+"-"+c.Param("vertex"), metav1.GetOptions{}); err == nil && v.IsCrossNamespace() {
+		ns = v.GetPodNamespace()
+		labelSelector += fmt.Sprintf(",%s=%s", dfv1.KeyVertexNamespace, c.Param("namespace"))
+	}
+	pods, err := h.kubeClient.CoreV1().Pods(ns).List(context.Background(), metav1.ListOptions{
+		LabelSelector: labelSelector,
 		Limit:         limit,
 		Continue:      c.Query("continue"),
 	})
@@ -282,6 +289,49 @@ func (h *handler) GetVertexMetrics(c *gin.Context) {
 	c.JSON(http.StatusOK, l)
 }
 
+// GetVertexErrors is used to provide the last errors returned by the user-defined containers of a given vertex
+func (h *handler) GetVertexErrors(c *gin.Context) {
+	ns := c.Param("namespace")
+	pipeline := c.Param("pipeline")
+	vertex := c.Param("vertex")
+	client, err := daemonclient.NewDaemonServiceClient(daemonSvcAddress(ns, pipeline))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, err.Error())
+		return
+	}
+	defer func() {
+		_ = client.Close()
+	}()
+	l, err := client.GetVertexErrors(context.Background(), pipeline, vertex)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, err.Error())
+		return
+	}
+	c.JSON(http.StatusOK, l)
+}
+
+// GetVertexLogs is used to search the recent logs of the user-defined containers of a given vertex tagged with a message ID
+func (h *handler) GetVertexLogs(c *gin.Context) {
+	ns := c.Param("namespace")
+	pipeline := c.Param("pipeline")
+	vertex := c.Param("vertex")
+	messageID := c.Query("messageId")
+	client, err := daemonclient.NewDaemonServiceClient(daemonSvcAddress(ns, pipeline))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, err.Error())
+		return
+	}
+	defer func() {
+		_ = client.Close()
+	}()
+	l, err := client.GetVertexLogs(context.Background(), pipeline, vertex, messageID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, err.Error())
+		return
+	}
+	c.JSON(http.StatusOK, l)
+}
+
 // GetPipelineWatermarks is used to provide the head watermarks for a given pipeline
 func (h *handler) GetPipelineWatermarks(c *gin.Context) {
 	ns := c.Param("namespace")
@@ -302,6 +352,47 @@ func (h *handler) GetPipelineWatermarks(c *gin.Context) {
 	c.JSON(http.StatusOK, l)
 }
 
+// GetPipelineEdgeMetrics is used to provide the watermark lag, buffer fill rate and processing latency of each edge of a given pipeline
+func (h *handler) GetPipelineEdgeMetrics(c *gin.Context) {
+	ns := c.Param("namespace")
+	pipeline := c.Param("pipeline")
+	client, err := daemonclient.NewDaemonServiceClient(daemonSvcAddress(ns, pipeline))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, err.Error())
+		return
+	}
+	defer func() {
+		_ = client.Close()
+	}()
+	l, err := client.GetPipelineEdgeMetrics(context.Background(), pipeline)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, err.Error())
+		return
+	}
+	c.JSON(http.StatusOK, l)
+}
+
+// GetPipelineMetricsHistory is used to provide the history of the processing rates, pending messages and watermark lags of a given pipeline
+func (h *handler) GetPipelineMetricsHistory(c *gin.Context) {
+	ns := c.Param("namespace")
+	pipeline := c.Param("pipeline")
+	since, _ := strconv.ParseInt(c.Query("since"), 10, 64)
+	client, err := daemonclient.NewDaemonServiceClient(daemonSvcAddress(ns, pipeline))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, err.Error())
+		return
+	}
+	defer func() {
+		_ = client.Close()
+	}()
+	l, err := client.GetPipelineMetricsHistory(context.Background(), pipeline, since)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, err.Error())
+		return
+	}
+	c.JSON(http.StatusOK, l)
+}
+
 // GetPipelineStatus is used to provide status check for a given pipeline
 func (h *handler) GetPipelineStatus(c *gin.Context) {
 	ns := c.Param("namespace")
@@ -58,7 +58,11 @@ func v1Routes(r gin.IRouter) {
 	r.GET("/namespaces/:namespace/pipelines/:pipeline/buffers", handler.ListPipelineBuffers)
 	r.GET("/namespaces/:namespace/pipelines/:pipeline/vertices/:vertex/buffers", handler.GetVertexBuffers)
 	r.GET("/namespaces/:namespace/pipelines/:pipeline/vertices/:vertex/metrics", handler.GetVertexMetrics)
+	r.GET("/namespaces/:namespace/pipelines/:pipeline/vertices/:vertex/errors", handler.GetVertexErrors)
+	r.GET("/namespaces/:namespace/pipelines/:pipeline/vertices/:vertex/logs", handler.GetVertexLogs)
 	r.GET("/namespaces/:namespace/pipelines/:pipeline/watermarks", handler.GetPipelineWatermarks)
 	r.GET("/namespaces/:namespace/pipelines/:pipeline/status", handler.GetPipelineStatus)
+	r.GET("/namespaces/:namespace/pipelines/:pipeline/edges/metrics", handler.GetPipelineEdgeMetrics)
+	r.GET("/namespaces/:namespace/pipelines/:pipeline/metrics/history", handler.GetPipelineMetricsHistory)
 	r.GET("/namespaces", handler.ListNamespaces)
 }
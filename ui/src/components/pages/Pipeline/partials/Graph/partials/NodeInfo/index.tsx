@@ -16,6 +16,7 @@ import ReactJson from "react-json-view";
 import TabPanel from "../../../../../../common/Tab-Panel";
 import { a11yProps, handleCopy } from "../../../../../../../utils";
 import { Pods } from "./partials/Pods";
+import { Errors } from "./partials/Errors";
 import { NodeInfoProps } from "../../../../../../../types/declarations/graph";
 
 export default function NodeInfo(props: NodeInfoProps) {
@@ -95,6 +96,14 @@ export default function NodeInfo(props: NodeInfoProps) {
               {...a11yProps(3)}
             />
           )}
+          {node?.id && (
+            <Tab
+              style={fontWeightStyle}
+              data-testid="errors"
+              label="Errors"
+              {...a11yProps(4)}
+            />
+          )}
         </Tabs>
       </Box>
 
@@ -228,6 +237,16 @@ export default function NodeInfo(props: NodeInfoProps) {
           </TableContainer>
         </TabPanel>
       )}
+
+      {node?.id && (
+        <TabPanel value={value} index={4}>
+          <Errors
+            namespaceId={namespaceId}
+            pipelineId={pipelineId}
+            vertexId={node.id}
+          />
+        </TabPanel>
+      )}
     </Box>
   );
 }
@@ -0,0 +1,55 @@
+import { render, screen, waitFor } from "@testing-library/react";
+import { Errors } from "./index";
+import { useFetch } from "../../../../../../../../../utils/fetchWrappers/fetch";
+
+jest.mock("../../../../../../../../../utils/fetchWrappers/fetch");
+const mockedUseFetch = useFetch as jest.MockedFunction<typeof useFetch>;
+
+describe("Errors", () => {
+  it("Load Errors screen", async () => {
+    mockedUseFetch.mockReturnValueOnce({
+      data: [
+        {
+          pipeline: "simple-pipeline",
+          vertex: "cat",
+          pod: "simple-pipeline-cat-0",
+          container: "udf",
+          timestamp: 1696118401000,
+          code: "InvalidArgument",
+          messageID: "msg-1",
+          message: "bad input",
+          payloadHash: "2cf24dba5fb0a30e",
+        },
+      ],
+      error: undefined,
+      loading: false,
+    });
+    render(
+      <Errors
+        namespaceId={"numaflow-system"}
+        pipelineId={"simple-pipeline"}
+        vertexId={"cat"}
+      />
+    );
+    await waitFor(() =>
+      expect(screen.getByTestId("code")).toHaveTextContent("InvalidArgument")
+    );
+    expect(screen.getByTestId("message")).toHaveTextContent("bad input");
+  });
+
+  it("Load Errors screen without errors", async () => {
+    mockedUseFetch.mockReturnValueOnce({
+      data: [],
+      error: undefined,
+      loading: false,
+    });
+    render(
+      <Errors
+        namespaceId={"numaflow-system"}
+        pipelineId={"simple-pipeline"}
+        vertexId={"cat"}
+      />
+    );
+    await waitFor(() => expect(screen.getByTestId("no-errors")).toBeVisible());
+  });
+});
@@ -0,0 +1,92 @@
+import { useEffect } from "react";
+
+import {
+  Box,
+  CircularProgress,
+  Paper,
+  Table,
+  TableBody,
+  TableCell,
+  TableContainer,
+  TableHead,
+  TableRow,
+} from "@mui/material";
+import { useFetch } from "../../../../../../../../../utils/fetchWrappers/fetch";
+import { notifyError } from "../../../../../../../../../utils/error";
+import {
+  ErrorsProps,
+  VertexError,
+} from "../../../../../../../../../types/declarations/graph";
+
+export function Errors(props: ErrorsProps) {
+  const { namespaceId, pipelineId, vertexId } = props;
+  const fontWeightStyle = { fontWeight: "bold" };
+
+  const { data, error, loading } = useFetch(
+    `/api/v1/namespaces/${namespaceId}/pipelines/${pipelineId}/vertices/${vertexId}/errors`
+  );
+
+  // This useEffect notifies about the errors while querying for the errors of the vertex
+  useEffect(() => {
+    if (error)
+      notifyError([
+        {
+          error: `Failed to get errors for ${vertexId} vertex`,
+          options: { toastId: `${vertexId}-errors`, autoClose: 5000 },
+        },
+      ]);
+  }, [error]);
+
+  if (loading) {
+    return (
+      <Box sx={{ display: "flex", justifyContent: "center", my: 2 }}>
+        <CircularProgress />
+      </Box>
+    );
+  }
+
+  const vertexErrors: VertexError[] = data || [];
+  if (vertexErrors.length === 0) {
+    return <Box data-testid="no-errors">{`No errors found`}</Box>;
+  }
+
+  return (
+    <TableContainer
+      component={Paper}
+      sx={{ borderBottom: 1, borderColor: "divider" }}
+    >
+      <Table aria-label="vertex-errors">
+        <TableHead>
+          <TableRow>
+            <TableCell style={fontWeightStyle}>Time</TableCell>
+            <TableCell style={fontWeightStyle}>Pod</TableCell>
+            <TableCell style={fontWeightStyle}>Container</TableCell>
+            <TableCell style={fontWeightStyle}>Code</TableCell>
+            <TableCell style={fontWeightStyle}>Message ID</TableCell>
+            <TableCell style={fontWeightStyle}>Payload Hash</TableCell>
+            <TableCell style={fontWeightStyle}>Error</TableCell>
+          </TableRow>
+        </TableHead>
+        <TableBody>
+          {vertexErrors.map((vertexError, idx) => {
+            return (
+              <TableRow key={`vertex-error-${idx}`}>
+                <TableCell>
+                  {new Date(vertexError.timestamp).toISOString()}
+                </TableCell>
+                <TableCell>{vertexError.pod}</TableCell>
+                <TableCell>{vertexError.container}</TableCell>
+                <TableCell data-testid="code">{vertexError.code}</TableCell>
+                <TableCell>{vertexError.messageID}</TableCell>
+                <TableCell>{vertexError.payloadHash}</TableCell>
+                <TableCell data-testid="message">
+                  {vertexError.message}
+                </TableCell>
+              </TableRow>
+            );
+          })}
+        </TableBody>
+      </Table>
+    </TableContainer>
+  );
+}
@@ -20,3 +20,21 @@ export interface NodeInfoProps {
   namespaceId: string | undefined;
   pipelineId: string | undefined;
 }
+
+export interface VertexError {
+  pipeline: string;
+  vertex: string;
+  pod: string;
+  container: string;
+  timestamp: number;
+  code: string;
+  messageID?: string;
+  message: string;
+  payloadHash?: string;
+}
+
+export interface ErrorsProps {
+  namespaceId: string;
+  pipelineId: string;
+  vertexId: string;
+}
@@ -18,32 +18,47 @@ package validator
 
 import (
 	"context"
+	"fmt"
 
 	admissionv1 "k8s.io/api/admission/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/client/clientset/versioned/typed/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/reconciler"
 	pipelinecontroller "github.com/numaproj/numaflow/pkg/reconciler/pipeline"
 )
 
+const (
+	// controllerConfigMapName is the name of the configmap of the controller, which contains the runtime image policy.
+	controllerConfigMapName = "numaflow-controller-config"
+	controllerConfigKey     = "controller-config.yaml"
+)
+
 type pipelineValidator struct {
 	client   kubernetes.Interface
 	pipeline v1alpha1.PipelineInterface
+	// namespace is where the controller configmap is looked up
+	namespace string
 
 	oldPipeline *dfv1.Pipeline
 	newPipeline *dfv1.Pipeline
 }
 
 // return PipelineValidator
-func NewPipelineValidator(client kubernetes.Interface, pipeline v1alpha1.PipelineInterface, old, new *dfv1.Pipeline) Validator {
-	return &pipelineValidator{client: client, pipeline: pipeline, oldPipeline: old, newPipeline: new}
+func NewPipelineValidator(client kubernetes.Interface, pipeline v1alpha1.PipelineInterface, namespace string, old, new *dfv1.Pipeline) Validator {
+	return &pipelineValidator{client: client, pipeline: pipeline, namespace: namespace, oldPipeline: old, newPipeline: new}
 }
 
 func (v *pipelineValidator) ValidateCreate(ctx context.Context) *admissionv1.AdmissionResponse {
 	if err := pipelinecontroller.ValidatePipeline(v.newPipeline); err != nil {
 		return DeniedResponse(err.Error())
 	}
+	if err := v.validateRuntimeImage(ctx); err != nil {
+		return DeniedResponse(err.Error())
+	}
 	return AllowedResponse()
 }
 
@@ -59,5 +74,32 @@ func (v *pipelineValidator) ValidateUpdate(ctx context.Context) *admissionv1.Adm
 		return DeniedResponse("Cannot update pipeline with different interStepBufferServiceName")
 	}
 
+	// only validate the runtime image when it changes, so that a policy change doesn't block other updates
+	if v.newPipeline.Spec.RuntimeImage != v.oldPipeline.Spec.RuntimeImage {
+		if err := v.validateRuntimeImage(ctx); err != nil {
+			return DeniedResponse(err.Error())
+		}
+	}
+
 	return AllowedResponse()
 }
+
+// validateRuntimeImage validates the runtime image of the new pipeline against the runtime image policy
+// in the controller configmap.
+func (v *pipelineValidator) validateRuntimeImage(ctx context.Context) error {
+	if v.newPipeline.Spec.RuntimeImage == "" {
+		return nil
+	}
+	cm, err := v.client.CoreV1().ConfigMaps(v.namespace).Get(ctx, controllerConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to get the runtime image policy, %w", err)
+	}
+	config, err := reconciler.ParseConfig([]byte(cm.Data[controllerConfigKey]))
+	if err != nil {
+		return fmt.Errorf("failed to get the runtime image policy, %w", err)
+	}
+	return config.RuntimeImagePolicy.ValidateRuntimeImage(v.newPipeline.Spec.RuntimeImage)
+}
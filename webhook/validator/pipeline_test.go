@@ -19,12 +19,30 @@ package validator
 import (
 	"testing"
 
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakeClient "k8s.io/client-go/kubernetes/fake"
 )
 
+const testRuntimeImagePolicy = `
+runtimeImagePolicy:
+  requireDigest: true
+  allowedImages:
+    - quay.io/numaproj/numaflow@sha256:*
+`
+
+func fakeK8sClientWithPolicy() *fakeClient.Clientset {
+	return fakeClient.NewSimpleClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: controllerConfigMapName},
+		Data:       map[string]string{controllerConfigKey: testRuntimeImagePolicy},
+	})
+}
+
 func TestValidatePipelineCreate(t *testing.T) {
 	pipeline := fakePipeline()
-	v := NewPipelineValidator(fakeK8sClient, &fakePipelineClient, nil, pipeline)
+	v := NewPipelineValidator(fakeK8sClient, &fakePipelineClient, testNamespace, nil, pipeline)
 	r := v.ValidateCreate(contextWithLogger(t))
 	assert.True(t, r.Allowed)
 }
@@ -34,7 +52,64 @@ func TestValidatePipelineUpdate(t *testing.T) {
 	t.Run("test Pipeline interStepBufferServiceName change", func(t *testing.T) {
 		newPipeline := pipeline.DeepCopy()
 		newPipeline.Spec.InterStepBufferServiceName = "change-name"
-		v := NewPipelineValidator(fakeK8sClient, &fakePipelineClient, pipeline, newPipeline)
+		v := NewPipelineValidator(fakeK8sClient, &fakePipelineClient, testNamespace, pipeline, newPipeline)
+		r := v.ValidateUpdate(contextWithLogger(t))
+		assert.False(t, r.Allowed)
+	})
+}
+
+func TestValidatePipelineRuntimeImage(t *testing.T) {
+	client := fakeK8sClientWithPolicy()
+
+	t.Run("test no policy", func(t *testing.T) {
+		pipeline := fakePipeline()
+		pipeline.Spec.RuntimeImage = "my-registry/numaflow:latest"
+		v := NewPipelineValidator(fakeK8sClient, &fakePipelineClient, testNamespace, nil, pipeline)
+		r := v.ValidateCreate(contextWithLogger(t))
+		assert.True(t, r.Allowed)
+	})
+
+	t.Run("test allowed image", func(t *testing.T) {
+		pipeline := fakePipeline()
+		pipeline.Spec.RuntimeImage = "quay.io/numaproj/numaflow@sha256:abcd"
+		v := NewPipelineValidator(client, &fakePipelineClient, testNamespace, nil, pipeline)
+		r := v.ValidateCreate(contextWithLogger(t))
+		assert.True(t, r.Allowed)
+	})
+
+	t.Run("test image not pinned by digest", func(t *testing.T) {
+		pipeline := fakePipeline()
+		pipeline.Spec.RuntimeImage = "quay.io/numaproj/numaflow:v1.0.0"
+		v := NewPipelineValidator(client, &fakePipelineClient, testNamespace, nil, pipeline)
+		r := v.ValidateCreate(contextWithLogger(t))
+		assert.False(t, r.Allowed)
+		assert.Contains(t, r.Result.Message, "not pinned by digest")
+	})
+
+	t.Run("test image not allowed", func(t *testing.T) {
+		pipeline := fakePipeline()
+		pipeline.Spec.RuntimeImage = "my-registry/numaflow@sha256:abcd"
+		v := NewPipelineValidator(client, &fakePipelineClient, testNamespace, nil, pipeline)
+		r := v.ValidateCreate(contextWithLogger(t))
+		assert.False(t, r.Allowed)
+	})
+
+	t.Run("test update without image change", func(t *testing.T) {
+		pipeline := fakePipeline()
+		pipeline.Spec.RuntimeImage = "my-registry/numaflow@sha256:abcd"
+		newPipeline := pipeline.DeepCopy()
+		newPipeline.Spec.Vertices[1].Name = "p2"
+		newPipeline.Spec.Edges = []dfv1.Edge{{From: "input", To: "p2"}, {From: "p2", To: "output"}}
+		v := NewPipelineValidator(client, &fakePipelineClient, testNamespace, pipeline, newPipeline)
+		r := v.ValidateUpdate(contextWithLogger(t))
+		assert.True(t, r.Allowed)
+	})
+
+	t.Run("test update with image change", func(t *testing.T) {
+		pipeline := fakePipeline()
+		newPipeline := pipeline.DeepCopy()
+		newPipeline.Spec.RuntimeImage = "quay.io/numaproj/numaflow:v1.0.0"
+		v := NewPipelineValidator(client, &fakePipelineClient, testNamespace, pipeline, newPipeline)
 		r := v.ValidateUpdate(contextWithLogger(t))
 		assert.False(t, r.Allowed)
 	})
@@ -36,8 +36,8 @@ type Validator interface {
 	ValidateUpdate(context.Context) *admissionv1.AdmissionResponse
 }
 
-// GetValidator returns a Validator instance
-func GetValidator(ctx context.Context, client kubernetes.Interface, ISBSVCClient v1alpha1.InterStepBufferServiceInterface, PipelineClient v1alpha1.PipelineInterface, kind metav1.GroupVersionKind, oldBytes []byte, newBytes []byte) (Validator, error) {
+// GetValidator returns a Validator instance, namespace is the one where the webhook is running
+func GetValidator(ctx context.Context, client kubernetes.Interface, ISBSVCClient v1alpha1.InterStepBufferServiceInterface, PipelineClient v1alpha1.PipelineInterface, namespace string, kind metav1.GroupVersionKind, oldBytes []byte, newBytes []byte) (Validator, error) {
 	log := logging.FromContext(ctx)
 	switch kind.Kind {
 	case dfv1.ISBGroupVersionKind.Kind:
@@ -75,7 +75,7 @@ func GetValidator(ctx context.Context, client kubernetes.Interface, ISBSVCClient
 				return nil, err
 			}
 		}
-		return NewPipelineValidator(client, PipelineClient, old, new), nil
+		return NewPipelineValidator(client, PipelineClient, namespace, old, new), nil
 	default:
 		return nil, fmt.Errorf("unrecognized kind: %v", kind)
 	}
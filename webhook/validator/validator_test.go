@@ -113,7 +113,7 @@ func TestGetValidator(t *testing.T) {
 		bytes, err := json.Marshal(fakeISBSvc())
 		assert.NoError(t, err)
 		assert.NotNil(t, bytes)
-		v, err := GetValidator(contextWithLogger(t), fakeK8sClient, &fakeISBSvcClient, &fakePipelineClient, metav1.GroupVersionKind{Group: "numaflow.numaproj.io", Version: "v1alpha1", Kind: "InterStepBufferService"}, nil, bytes)
+		v, err := GetValidator(contextWithLogger(t), fakeK8sClient, &fakeISBSvcClient, &fakePipelineClient, testNamespace, metav1.GroupVersionKind{Group: "numaflow.numaproj.io", Version: "v1alpha1", Kind: "InterStepBufferService"}, nil, bytes)
 		assert.NoError(t, err)
 		assert.NotNil(t, v)
 	})
@@ -122,7 +122,7 @@ func TestGetValidator(t *testing.T) {
 		bytes, err := json.Marshal(fakePipeline())
 		assert.NoError(t, err)
 		assert.NotNil(t, bytes)
-		v, err := GetValidator(contextWithLogger(t), fakeK8sClient, &fakeISBSvcClient, &fakePipelineClient, metav1.GroupVersionKind{Group: "numaflow.numaproj.io", Version: "v1alpha1", Kind: "Pipeline"}, nil, bytes)
+		v, err := GetValidator(contextWithLogger(t), fakeK8sClient, &fakeISBSvcClient, &fakePipelineClient, testNamespace, metav1.GroupVersionKind{Group: "numaflow.numaproj.io", Version: "v1alpha1", Kind: "Pipeline"}, nil, bytes)
 		assert.NoError(t, err)
 		assert.NotNil(t, v)
 	})